@@ -0,0 +1,179 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package acl implements per-job access control for the cron service.
+package acl
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+)
+
+// AdminGroup is a group whose members have Owner role in all jobs.
+const AdminGroup = "administrators"
+
+// Role defines what a principal can do with a job. Each role includes all
+// permissions of the roles below it.
+type Role int
+
+const (
+	// Reader can see the job and its invocations.
+	Reader Role = iota
+	// Triggerer can also trigger new invocations of the job.
+	Triggerer
+	// Owner can also pause and resume the job and abort its invocations.
+	Owner
+)
+
+// String returns the role name, as used in configs.
+func (r Role) String() string {
+	return messages.Acl_Role(r).String()
+}
+
+// GrantsByRole holds principals that were granted each role.
+//
+// A principal is either an identity string (e.g. "user:someone@example.com")
+// or a group reference (e.g. "group:some-group"). It is stored as part of
+// CronJob entity.
+type GrantsByRole struct {
+	Readers    []string `gae:",noindex"`
+	Triggerers []string `gae:",noindex"`
+	Owners     []string `gae:",noindex"`
+}
+
+// FromProto builds GrantsByRole from a list of ACLs defined in the config.
+//
+// Each list is processed in order. It is usually a union of project-wide and
+// job-specific ACLs. Returns an error if some ACL entry is invalid.
+func FromProto(acls ...[]*messages.Acl) (GrantsByRole, error) {
+	g := GrantsByRole{}
+	for _, list := range acls {
+		for _, a := range list {
+			if a.GrantedTo == nil {
+				return GrantsByRole{}, fmt.Errorf("missing 'granted_to' field")
+			}
+			principal := a.GetGrantedTo()
+			if err := validatePrincipal(principal); err != nil {
+				return GrantsByRole{}, err
+			}
+			switch a.GetRole() {
+			case messages.Acl_READER:
+				g.Readers = appendUnique(g.Readers, principal)
+			case messages.Acl_TRIGGERER:
+				g.Triggerers = appendUnique(g.Triggerers, principal)
+			case messages.Acl_OWNER:
+				g.Owners = appendUnique(g.Owners, principal)
+			default:
+				return GrantsByRole{}, fmt.Errorf("unknown role %s", a.GetRole())
+			}
+		}
+	}
+	return g, nil
+}
+
+// IsEmpty returns true if no roles are granted to anyone.
+func (g *GrantsByRole) IsEmpty() bool {
+	return len(g.Readers) == 0 && len(g.Triggerers) == 0 && len(g.Owners) == 0
+}
+
+// Equal returns true if 'g' and 'other' grant same roles to same principals.
+func (g *GrantsByRole) Equal(other *GrantsByRole) bool {
+	return equalStrings(g.Readers, other.Readers) &&
+		equalStrings(g.Triggerers, other.Triggerers) &&
+		equalStrings(g.Owners, other.Owners)
+}
+
+// HasRole returns true if the given identity has the given role (or a more
+// powerful one).
+//
+// Members of AdminGroup have all roles. If no roles are granted at all, Reader
+// role is granted to everyone. Uses the auth DB of the auth.State in the
+// context, returns auth.ErrNoAuthState if it's not there.
+func (g *GrantsByRole) HasRole(c context.Context, id identity.Identity, role Role) (bool, error) {
+	state := auth.GetState(c)
+	if state == nil {
+		return false, auth.ErrNoAuthState
+	}
+	db := state.DB()
+
+	if id == "" {
+		id = identity.AnonymousIdentity
+	}
+	switch admin, err := db.IsMember(c, id, AdminGroup); {
+	case err != nil:
+		return false, err
+	case admin:
+		return true, nil
+	}
+
+	if role == Reader && g.IsEmpty() {
+		return true, nil
+	}
+
+	lists := [][]string{g.Owners}
+	if role <= Triggerer {
+		lists = append(lists, g.Triggerers)
+	}
+	if role <= Reader {
+		lists = append(lists, g.Readers)
+	}
+	for _, principals := range lists {
+		for _, p := range principals {
+			if strings.HasPrefix(p, "group:") {
+				switch yes, err := db.IsMember(c, id, strings.TrimPrefix(p, "group:")); {
+				case err != nil:
+					return false, err
+				case yes:
+					return true, nil
+				}
+			} else if identity.Identity(p) == id {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// validatePrincipal returns an error if 'p' is not a valid identity or group
+// reference.
+func validatePrincipal(p string) error {
+	if strings.HasPrefix(p, "group:") {
+		if p == "group:" {
+			return fmt.Errorf("missing group name in %q", p)
+		}
+		return nil
+	}
+	if _, err := identity.MakeIdentity(p); err != nil {
+		return fmt.Errorf("%q is neither a group nor a valid identity - %s", p, err)
+	}
+	return nil
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, e := range list {
+		if e == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
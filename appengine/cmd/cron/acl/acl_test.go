@@ -0,0 +1,115 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	"github.com/luci/luci-go/server/auth/identity"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeState is auth.State with a fake DB that knows about multiple
+// identities.
+type fakeState struct {
+	authtest.FakeState
+	db authtest.FakeDB
+}
+
+func (s *fakeState) DB() auth.DB { return s.db }
+
+func acl(role messages.Acl_Role, grantedTo string) *messages.Acl {
+	return &messages.Acl{Role: role.Enum(), GrantedTo: proto.String(grantedTo)}
+}
+
+func TestFromProto(t *testing.T) {
+	Convey("works", t, func() {
+		g, err := FromProto(
+			[]*messages.Acl{
+				acl(messages.Acl_READER, "group:readers"),
+				acl(messages.Acl_OWNER, "user:owner@example.com"),
+			},
+			[]*messages.Acl{
+				acl(messages.Acl_TRIGGERER, "group:triggerers"),
+				acl(messages.Acl_READER, "group:readers"),
+			})
+		So(err, ShouldBeNil)
+		So(g, ShouldResemble, GrantsByRole{
+			Readers:    []string{"group:readers"},
+			Triggerers: []string{"group:triggerers"},
+			Owners:     []string{"user:owner@example.com"},
+		})
+	})
+
+	Convey("rejects bad principals", t, func() {
+		_, err := FromProto([]*messages.Acl{acl(messages.Acl_READER, "group:")})
+		So(err, ShouldNotBeNil)
+		_, err = FromProto([]*messages.Acl{acl(messages.Acl_READER, "someone@example.com")})
+		So(err, ShouldNotBeNil)
+		_, err = FromProto([]*messages.Acl{{Role: messages.Acl_READER.Enum()}})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestHasRole(t *testing.T) {
+	Convey("with auth state", t, func() {
+		c := auth.WithState(context.Background(), &fakeState{
+			db: authtest.FakeDB{
+				"user:admin@example.com":     {AdminGroup},
+				"user:reader@example.com":    {"readers"},
+				"user:triggerer@example.com": {"triggerers"},
+			},
+		})
+
+		has := func(g *GrantsByRole, id identity.Identity, r Role) bool {
+			yes, err := g.HasRole(c, id, r)
+			So(err, ShouldBeNil)
+			return yes
+		}
+
+		Convey("no ACLs", func() {
+			g := GrantsByRole{}
+			So(has(&g, "user:someone@example.com", Reader), ShouldBeTrue)
+			So(has(&g, "user:someone@example.com", Triggerer), ShouldBeFalse)
+			So(has(&g, "user:admin@example.com", Owner), ShouldBeTrue)
+		})
+
+		Convey("with ACLs", func() {
+			g := GrantsByRole{
+				Readers:    []string{"group:readers"},
+				Triggerers: []string{"group:triggerers"},
+				Owners:     []string{"user:owner@example.com"},
+			}
+
+			So(has(&g, "user:someone@example.com", Reader), ShouldBeFalse)
+
+			So(has(&g, "user:reader@example.com", Reader), ShouldBeTrue)
+			So(has(&g, "user:reader@example.com", Triggerer), ShouldBeFalse)
+
+			So(has(&g, "user:triggerer@example.com", Reader), ShouldBeTrue)
+			So(has(&g, "user:triggerer@example.com", Triggerer), ShouldBeTrue)
+			So(has(&g, "user:triggerer@example.com", Owner), ShouldBeFalse)
+
+			So(has(&g, "user:owner@example.com", Reader), ShouldBeTrue)
+			So(has(&g, "user:owner@example.com", Owner), ShouldBeTrue)
+
+			So(has(&g, "user:admin@example.com", Owner), ShouldBeTrue)
+		})
+	})
+
+	Convey("without auth state", t, func() {
+		g := GrantsByRole{}
+		_, err := g.HasRole(context.Background(), "user:a@example.com", Reader)
+		So(err, ShouldEqual, auth.ErrNoAuthState)
+	})
+}
@@ -0,0 +1,345 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package apiservers implements pRPC services exposed by the cron service.
+package apiservers
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/luci-go/common/api/cron/v1"
+	"github.com/luci/luci-go/common/grpcutil"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/server/auth"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
+	"github.com/luci/luci-go/appengine/cmd/cron/engine"
+)
+
+const (
+	// defaultPageSize is number of invocations returned by GetInvocations if
+	// page size is not given.
+	defaultPageSize = 50
+
+	// maxPageSize is the largest number of invocations GetInvocations returns.
+	maxPageSize = 500
+)
+
+// CronServer implements cron.CronServer on top of engine.Engine.
+//
+// It uses the job's ACLs to authorize calls, the same way the UI does.
+type CronServer struct {
+	Engine engine.Engine
+}
+
+// GetProjects returns the projects that have at least one enabled job visible
+// to the caller.
+func (s *CronServer) GetProjects(c context.Context, _ *google.Empty) (*cron.GetProjectsResponse, error) {
+	jobs, err := s.Engine.GetAllCronJobs(c)
+	if err != nil {
+		return nil, internalErr(c, err, "failed to fetch the list of jobs")
+	}
+	visible := map[string]struct{}{}
+	for _, job := range jobs {
+		if _, ok := visible[job.ProjectID]; ok {
+			continue
+		}
+		switch yes, err := hasJobRole(c, job, acl.Reader); {
+		case err != nil:
+			return nil, internalErr(c, err, "failed to check ACLs")
+		case yes:
+			visible[job.ProjectID] = struct{}{}
+		}
+	}
+	projects := make([]string, 0, len(visible))
+	for p := range visible {
+		projects = append(projects, p)
+	}
+	sort.Strings(projects)
+	return &cron.GetProjectsResponse{Projects: projects}, nil
+}
+
+// GetJobs returns the enabled jobs visible to the caller.
+func (s *CronServer) GetJobs(c context.Context, req *cron.GetJobsRequest) (*cron.GetJobsResponse, error) {
+	var jobs []*engine.CronJob
+	var err error
+	if req.Project == "" {
+		jobs, err = s.Engine.GetAllCronJobs(c)
+	} else {
+		jobs, err = s.Engine.GetProjectCronJobs(c, req.Project)
+	}
+	if err != nil {
+		return nil, internalErr(c, err, "failed to fetch the list of jobs")
+	}
+	sort.Sort(sortedJobs(jobs))
+
+	out := make([]*cron.Job, 0, len(jobs))
+	for _, job := range jobs {
+		switch yes, err := hasJobRole(c, job, acl.Reader); {
+		case err != nil:
+			return nil, internalErr(c, err, "failed to check ACLs")
+		case yes:
+			msg, err := makeJob(c, job)
+			if err != nil {
+				return nil, internalErr(c, err, "failed to check ACLs")
+			}
+			out = append(out, msg)
+		}
+	}
+	return &cron.GetJobsResponse{Jobs: out}, nil
+}
+
+// GetJob returns the state and the schedule of a single job.
+func (s *CronServer) GetJob(c context.Context, ref *cron.JobRef) (*cron.Job, error) {
+	job, err := s.getJob(c, ref)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := makeJob(c, job)
+	if err != nil {
+		return nil, internalErr(c, err, "failed to check ACLs")
+	}
+	return msg, nil
+}
+
+// GetInvocations returns invocations of a job, most recent first.
+func (s *CronServer) GetInvocations(c context.Context, req *cron.GetInvocationsRequest) (*cron.GetInvocationsResponse, error) {
+	job, err := s.getJob(c, req.Job)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize < 0:
+		return nil, grpcutil.Errf(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	invs, cursor, err := s.Engine.ListInvocations(c, job.JobID, pageSize, req.Cursor)
+	if err != nil {
+		return nil, internalErr(c, err, "failed to fetch invocations")
+	}
+	out := &cron.GetInvocationsResponse{
+		Invocations: make([]*cron.Invocation, len(invs)),
+		NextCursor:  cursor,
+	}
+	for i, inv := range invs {
+		out.Invocations[i] = makeInvocation(job.JobID, inv)
+		out.Invocations[i].DebugLog = ""
+	}
+	return out, nil
+}
+
+// GetInvocation returns a single invocation, including its debug log.
+func (s *CronServer) GetInvocation(c context.Context, ref *cron.InvocationRef) (*cron.Invocation, error) {
+	job, inv, err := s.getInvocation(c, ref)
+	if err != nil {
+		return nil, err
+	}
+	return makeInvocation(job.JobID, inv), nil
+}
+
+// TriggerJob launches an invocation of a job right now.
+func (s *CronServer) TriggerJob(c context.Context, ref *cron.JobRef) (*cron.TriggerJobResponse, error) {
+	job, err := s.getJob(c, ref)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := s.Engine.TriggerInvocation(c, job.JobID, auth.CurrentIdentity(c))
+	if err != nil {
+		return nil, actionErr(c, err, "failed to trigger the job")
+	}
+	return &cron.TriggerJobResponse{InvocationNonce: nonce}, nil
+}
+
+// PauseJob stops the job from running on schedule.
+func (s *CronServer) PauseJob(c context.Context, ref *cron.JobRef) (*google.Empty, error) {
+	job, err := s.getJob(c, ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Engine.PauseJob(c, job.JobID, auth.CurrentIdentity(c)); err != nil {
+		return nil, actionErr(c, err, "failed to pause the job")
+	}
+	return &google.Empty{}, nil
+}
+
+// ResumeJob resumes a paused job.
+func (s *CronServer) ResumeJob(c context.Context, ref *cron.JobRef) (*google.Empty, error) {
+	job, err := s.getJob(c, ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Engine.ResumeJob(c, job.JobID, auth.CurrentIdentity(c)); err != nil {
+		return nil, actionErr(c, err, "failed to resume the job")
+	}
+	return &google.Empty{}, nil
+}
+
+// AbortInvocation forcefully moves an invocation to the ABORTED state.
+func (s *CronServer) AbortInvocation(c context.Context, ref *cron.InvocationRef) (*google.Empty, error) {
+	job, inv, err := s.getInvocation(c, ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Engine.AbortInvocation(c, job.JobID, inv.ID, auth.CurrentIdentity(c)); err != nil {
+		return nil, actionErr(c, err, "failed to abort the invocation")
+	}
+	return &google.Empty{}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Helpers.
+
+// getJob fetches a job and checks the caller can read it.
+func (s *CronServer) getJob(c context.Context, ref *cron.JobRef) (*engine.CronJob, error) {
+	if ref == nil || ref.Project == "" || ref.Job == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "project and job must be set")
+	}
+	if strings.Contains(ref.Project, "/") || strings.Contains(ref.Job, "/") {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "project and job must not contain '/'")
+	}
+	jobID := ref.Project + "/" + ref.Job
+	job, err := s.Engine.GetCronJob(c, jobID)
+	if err != nil {
+		return nil, internalErr(c, err, "failed to fetch the job")
+	}
+	if job == nil {
+		return nil, grpcutil.Errf(codes.NotFound, "no such job %q", jobID)
+	}
+	switch yes, err := hasJobRole(c, job, acl.Reader); {
+	case err != nil:
+		return nil, internalErr(c, err, "failed to check ACLs")
+	case !yes:
+		logging.Warningf(c, "%s doesn't have %s role in %s", auth.CurrentIdentity(c), acl.Reader, jobID)
+		return nil, grpcutil.Errf(codes.PermissionDenied, "no access to job %q", jobID)
+	}
+	return job, nil
+}
+
+// getInvocation fetches an invocation and checks the caller can read its job.
+func (s *CronServer) getInvocation(c context.Context, ref *cron.InvocationRef) (*engine.CronJob, *engine.Invocation, error) {
+	if ref == nil {
+		return nil, nil, grpcutil.Errf(codes.InvalidArgument, "job must be set")
+	}
+	job, err := s.getJob(c, ref.Job)
+	if err != nil {
+		return nil, nil, err
+	}
+	inv, err := s.Engine.GetInvocation(c, job.JobID, ref.InvocationId)
+	if err != nil {
+		return nil, nil, internalErr(c, err, "failed to fetch the invocation")
+	}
+	if inv == nil {
+		return nil, nil, grpcutil.Errf(codes.NotFound, "no such invocation %d", ref.InvocationId)
+	}
+	return job, inv, nil
+}
+
+// hasJobRole returns true if the current caller has the given role in the job.
+func hasJobRole(c context.Context, job *engine.CronJob, role acl.Role) (bool, error) {
+	return job.Acls.HasRole(c, auth.CurrentIdentity(c), role)
+}
+
+// internalErr logs an error and returns it as grpc Internal error.
+func internalErr(c context.Context, err error, msg string) error {
+	logging.Errorf(c, "%s - %s", msg, err)
+	return grpcutil.Errf(codes.Internal, "%s - %s", msg, err)
+}
+
+// actionErr converts an error returned by an engine method that modifies a job
+// into grpc error.
+func actionErr(c context.Context, err error, msg string) error {
+	if err == engine.ErrNoPermission {
+		return grpcutil.Errf(codes.PermissionDenied, "%s - %s", msg, err)
+	}
+	return internalErr(c, err, msg)
+}
+
+// splitJobID splits "<project>/<job>" into a JobRef.
+func splitJobID(jobID string) *cron.JobRef {
+	chunks := strings.SplitN(jobID, "/", 2)
+	if len(chunks) != 2 {
+		return &cron.JobRef{Job: jobID}
+	}
+	return &cron.JobRef{Project: chunks[0], Job: chunks[1]}
+}
+
+func makeJob(c context.Context, j *engine.CronJob) (*cron.Job, error) {
+	canTrigger, err := hasJobRole(c, j, acl.Triggerer)
+	if err != nil {
+		return nil, err
+	}
+	canOwn, err := hasJobRole(c, j, acl.Owner)
+	if err != nil {
+		return nil, err
+	}
+	effective := j.Schedule
+	if j.Paused {
+		effective = "manual"
+	}
+	return &cron.Job{
+		Ref:                 splitJobID(j.JobID),
+		Schedule:            j.Schedule,
+		EffectiveSchedule:   effective,
+		Paused:              j.Paused,
+		State:               string(j.State.State),
+		Revision:            j.Revision,
+		RevisionUrl:         j.RevisionURL,
+		NextTick:            google.NewTimestamp(j.State.TickTime),
+		PrevTime:            google.NewTimestamp(j.State.PrevTime),
+		RunningInvocationId: j.State.InvocationID,
+		Overruns:            int32(j.State.Overruns),
+		RetryTime:           google.NewTimestamp(j.State.RetryTime),
+		FailedAttempts:      int32(j.State.FailedAttempts),
+		CanTrigger:          canTrigger,
+		CanOwn:              canOwn,
+	}, nil
+}
+
+func makeInvocation(jobID string, i *engine.Invocation) *cron.Invocation {
+	out := &cron.Invocation{
+		Ref: &cron.InvocationRef{
+			Job:          splitJobID(jobID),
+			InvocationId: i.ID,
+		},
+		Started:     google.NewTimestamp(i.Started),
+		Finished:    google.NewTimestamp(i.Finished),
+		Status:      string(i.Status),
+		TriggeredBy: string(i.TriggeredBy),
+		Revision:    i.Revision,
+		RevisionUrl: i.RevisionURL,
+		ViewUrl:     i.ViewURL,
+		RetryCount:  i.RetryCount,
+		RetryOf:     i.RetryOf,
+		RetriedAs:   i.RetriedAs,
+		DebugLog:    i.DebugLog,
+	}
+	if i.UpstreamJobID != "" {
+		out.Upstream = &cron.InvocationRef{
+			Job:          splitJobID(i.UpstreamJobID),
+			InvocationId: i.UpstreamInvocationID,
+		}
+	}
+	for idx, id := range i.TriggeredInvocationIDs {
+		out.Downstream = append(out.Downstream, &cron.InvocationRef{
+			Job:          splitJobID(i.TriggeredJobIDs[idx]),
+			InvocationId: id,
+		})
+	}
+	return out
+}
+
+type sortedJobs []*engine.CronJob
+
+func (s sortedJobs) Len() int           { return len(s) }
+func (s sortedJobs) Less(i, j int) bool { return s[i].JobID < s[j].JobID }
+func (s sortedJobs) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
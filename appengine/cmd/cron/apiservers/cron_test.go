@@ -0,0 +1,240 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package apiservers
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/luci-go/common/api/cron/v1"
+	"github.com/luci/luci-go/common/grpcutil"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	"github.com/luci/luci-go/server/auth/identity"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
+	"github.com/luci/luci-go/appengine/cmd/cron/engine"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeEngine implements a subset of engine.Engine used by CronServer.
+type fakeEngine struct {
+	engine.Engine
+
+	jobs   []*engine.CronJob
+	invs   map[string][]*engine.Invocation
+	paused map[string]identity.Identity
+}
+
+func (e *fakeEngine) GetAllCronJobs(c context.Context) ([]*engine.CronJob, error) {
+	return e.jobs, nil
+}
+
+func (e *fakeEngine) GetProjectCronJobs(c context.Context, projectID string) ([]*engine.CronJob, error) {
+	var out []*engine.CronJob
+	for _, job := range e.jobs {
+		if job.ProjectID == projectID {
+			out = append(out, job)
+		}
+	}
+	return out, nil
+}
+
+func (e *fakeEngine) GetCronJob(c context.Context, jobID string) (*engine.CronJob, error) {
+	for _, job := range e.jobs {
+		if job.JobID == jobID {
+			return job, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e *fakeEngine) ListInvocations(c context.Context, jobID string, pageSize int, cursor string) ([]*engine.Invocation, string, error) {
+	invs := e.invs[jobID]
+	if len(invs) > pageSize {
+		return invs[:pageSize], "next", nil
+	}
+	return invs, "", nil
+}
+
+func (e *fakeEngine) GetInvocation(c context.Context, jobID string, invID int64) (*engine.Invocation, error) {
+	for _, inv := range e.invs[jobID] {
+		if inv.ID == invID {
+			return inv, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e *fakeEngine) PauseJob(c context.Context, jobID string, who identity.Identity) error {
+	job, _ := e.GetCronJob(c, jobID)
+	if yes, _ := job.Acls.HasRole(c, who, acl.Owner); !yes {
+		return engine.ErrNoPermission
+	}
+	e.paused[jobID] = who
+	return nil
+}
+
+func TestCronServer(t *testing.T) {
+	Convey("with server", t, func() {
+		epoch := time.Unix(1442270520, 0).UTC()
+		grants := acl.GrantsByRole{
+			Readers: []string{"group:readers"},
+			Owners:  []string{"user:owner@example.com"},
+		}
+		eng := &fakeEngine{
+			jobs: []*engine.CronJob{
+				{JobID: "proj/b", ProjectID: "proj", Schedule: "* * * 1 * * *", Acls: grants},
+				{JobID: "proj/a", ProjectID: "proj", Schedule: "* * * 2 * * *", Paused: true, Acls: grants},
+				{JobID: "secret/job", ProjectID: "secret", Acls: acl.GrantsByRole{Readers: []string{"group:secret"}}},
+				{JobID: "public/job", ProjectID: "public", State: engine.JobState{State: engine.JobStateScheduled, TickTime: epoch}},
+			},
+			invs: map[string][]*engine.Invocation{
+				"proj/a": {
+					{ID: 2, Started: epoch, Status: task.StatusRunning, DebugLog: "log 2", UpstreamJobID: "proj/b", UpstreamInvocationID: 5},
+					{
+						ID:                     1,
+						Started:                epoch,
+						Finished:               epoch,
+						Status:                 task.StatusSucceeded,
+						DebugLog:               "log 1",
+						TriggeredJobIDs:        []string{"public/job"},
+						TriggeredInvocationIDs: []int64{7},
+					},
+				},
+			},
+			paused: map[string]identity.Identity{},
+		}
+		srv := &CronServer{Engine: eng}
+
+		as := func(id identity.Identity, groups ...string) context.Context {
+			return auth.WithState(context.Background(), &authtest.FakeState{
+				Identity:       id,
+				IdentityGroups: groups,
+			})
+		}
+		reader := as("user:reader@example.com", "readers")
+		owner := as("user:owner@example.com")
+		anon := as(identity.AnonymousIdentity)
+
+		Convey("GetProjects filters by ACLs", func() {
+			resp, err := srv.GetProjects(reader, &google.Empty{})
+			So(err, ShouldBeNil)
+			So(resp.Projects, ShouldResemble, []string{"proj", "public"})
+
+			resp, err = srv.GetProjects(as("user:someone@example.com", "secret"), &google.Empty{})
+			So(err, ShouldBeNil)
+			So(resp.Projects, ShouldResemble, []string{"public", "secret"})
+
+			resp, err = srv.GetProjects(anon, &google.Empty{})
+			So(err, ShouldBeNil)
+			So(resp.Projects, ShouldResemble, []string{"public"})
+		})
+
+		Convey("GetJobs filters by ACLs", func() {
+			resp, err := srv.GetJobs(reader, &cron.GetJobsRequest{})
+			So(err, ShouldBeNil)
+			ids := []string{}
+			for _, j := range resp.Jobs {
+				ids = append(ids, j.Ref.Project+"/"+j.Ref.Job)
+			}
+			So(ids, ShouldResemble, []string{"proj/a", "proj/b", "public/job"})
+
+			resp, err = srv.GetJobs(anon, &cron.GetJobsRequest{Project: "proj"})
+			So(err, ShouldBeNil)
+			So(resp.Jobs, ShouldHaveLength, 0)
+		})
+
+		Convey("GetJob works", func() {
+			job, err := srv.GetJob(anon, &cron.JobRef{Project: "public", Job: "job"})
+			So(err, ShouldBeNil)
+			So(job, ShouldResemble, &cron.Job{
+				Ref:      &cron.JobRef{Project: "public", Job: "job"},
+				State:    "SCHEDULED",
+				NextTick: google.NewTimestamp(epoch),
+			})
+
+			job, err = srv.GetJob(owner, &cron.JobRef{Project: "proj", Job: "a"})
+			So(err, ShouldBeNil)
+			So(job.Paused, ShouldBeTrue)
+			So(job.EffectiveSchedule, ShouldEqual, "manual")
+			So(job.CanTrigger, ShouldBeTrue)
+			So(job.CanOwn, ShouldBeTrue)
+		})
+
+		Convey("GetJob errors", func() {
+			_, err := srv.GetJob(anon, &cron.JobRef{Project: "proj"})
+			So(grpcutil.Code(err), ShouldEqual, codes.InvalidArgument)
+			_, err = srv.GetJob(anon, &cron.JobRef{Project: "proj", Job: "missing"})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+			_, err = srv.GetJob(anon, &cron.JobRef{Project: "proj", Job: "a"})
+			So(grpcutil.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey("GetInvocations omits debug logs", func() {
+			resp, err := srv.GetInvocations(reader, &cron.GetInvocationsRequest{
+				Job:      &cron.JobRef{Project: "proj", Job: "a"},
+				PageSize: 1,
+			})
+			So(err, ShouldBeNil)
+			So(resp.NextCursor, ShouldEqual, "next")
+			So(resp.Invocations, ShouldResemble, []*cron.Invocation{
+				{
+					Ref: &cron.InvocationRef{
+						Job:          &cron.JobRef{Project: "proj", Job: "a"},
+						InvocationId: 2,
+					},
+					Started: google.NewTimestamp(epoch),
+					Status:  "RUNNING",
+					Upstream: &cron.InvocationRef{
+						Job:          &cron.JobRef{Project: "proj", Job: "b"},
+						InvocationId: 5,
+					},
+				},
+			})
+		})
+
+		Convey("GetInvocation returns debug log", func() {
+			inv, err := srv.GetInvocation(reader, &cron.InvocationRef{
+				Job:          &cron.JobRef{Project: "proj", Job: "a"},
+				InvocationId: 1,
+			})
+			So(err, ShouldBeNil)
+			So(inv.Status, ShouldEqual, "SUCCEEDED")
+			So(inv.DebugLog, ShouldEqual, "log 1")
+			So(inv.Downstream, ShouldResemble, []*cron.InvocationRef{
+				{
+					Job:          &cron.JobRef{Project: "public", Job: "job"},
+					InvocationId: 7,
+				},
+			})
+
+			_, err = srv.GetInvocation(reader, &cron.InvocationRef{
+				Job:          &cron.JobRef{Project: "proj", Job: "a"},
+				InvocationId: 3,
+			})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+		})
+
+		Convey("PauseJob checks ACLs", func() {
+			ref := &cron.JobRef{Project: "proj", Job: "a"}
+			_, err := srv.PauseJob(reader, ref)
+			So(grpcutil.Code(err), ShouldEqual, codes.PermissionDenied)
+			So(eng.paused, ShouldHaveLength, 0)
+
+			_, err = srv.PauseJob(owner, ref)
+			So(err, ShouldBeNil)
+			So(eng.paused, ShouldResemble, map[string]identity.Identity{
+				"proj/a": "user:owner@example.com",
+			})
+		})
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
@@ -21,6 +22,7 @@ import (
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/logging"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
 	"github.com/luci/luci-go/appengine/cmd/cron/messages"
 	"github.com/luci/luci-go/appengine/cmd/cron/schedule"
 	"github.com/luci/luci-go/appengine/cmd/cron/task"
@@ -31,6 +33,12 @@ var (
 	jobIDRe = regexp.MustCompile(`^[0-9A-Za-z_\-]{1,100}$`)
 )
 
+const (
+	// maxRetryAttempts is maximum allowed value of 'max_attempts' field of
+	// RetryPolicy message.
+	maxRetryAttempts = 10
+)
+
 // Catalog knows how to enumerate all cron job configs across all projects.
 // Methods return errors.Transient on non-fatal errors. Any other error means
 // that retry won't help.
@@ -73,6 +81,47 @@ type Definition struct {
 	// Task is serialized representation of cron job. It can be fed back to
 	// Catalog.UnmarshalTask(...) to get proto.Message describing the task.
 	Task []byte
+
+	// Acls is a union of project-wide and job-specific ACLs.
+	Acls acl.GrantsByRole
+
+	// RetryPolicy defines how to retry failed invocations.
+	RetryPolicy RetryPolicy
+
+	// Triggers defines what jobs to trigger when an invocation finishes.
+	Triggers Triggers
+}
+
+// RetryPolicy defines how to retry failed invocations of a job. Zero value
+// means "do not retry".
+type RetryPolicy struct {
+	// MaxAttempts is maximum number of attempts (including the initial one).
+	MaxAttempts int `gae:",noindex"`
+
+	// Backoff is a delay before the first retry.
+	Backoff time.Duration `gae:",noindex"`
+
+	// MaxBackoff is an upper limit on a delay before a retry.
+	MaxBackoff time.Duration `gae:",noindex"`
+}
+
+// ShouldRetry returns true if an invocation should be retried after given
+// number of failed attempts.
+func (p *RetryPolicy) ShouldRetry(failedAttempts int) bool {
+	return failedAttempts < p.MaxAttempts
+}
+
+// Delay returns how long to wait before retrying an invocation after given
+// number of failed attempts. The delay grows exponentially.
+func (p *RetryPolicy) Delay(failedAttempts int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < failedAttempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
 }
 
 // LazyConfig makes an instance of config.Interface on demand.
@@ -173,6 +222,14 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 	if err = proto.UnmarshalText(rawCfg.Content, &cfg); err != nil {
 		return nil, err
 	}
+	if _, err = acl.FromProto(cfg.Acls); err != nil {
+		logging.Errorf(c, "Invalid project ACLs in %s: %s", projectID, err)
+		return nil, fmt.Errorf("invalid project ACLs - %s", err)
+	}
+	if err = validateTriggers(cfg.Job); err != nil {
+		logging.Errorf(c, "Invalid triggers in %s: %s", projectID, err)
+		return nil, fmt.Errorf("invalid triggers - %s", err)
+	}
 	out := make([]Definition, 0, len(cfg.Job))
 	for _, job := range cfg.Job {
 		if job.GetDisabled() {
@@ -191,12 +248,22 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 			logging.Errorf(c, "Failed to marshal the task: %s/%s: %s", projectID, id, err)
 			continue
 		}
+		acls, err := acl.FromProto(cfg.Acls, job.Acls)
+		if err != nil {
+			logging.Errorf(c, "Invalid ACLs: %s/%s: %s", projectID, id, err)
+			continue
+		}
+		// Validated in validateJobProto already.
+		retryPolicy, _ := retryPolicyFromProto(job.RetryPolicy)
 		out = append(out, Definition{
 			JobID:       fmt.Sprintf("%s/%s", projectID, *job.Id),
 			Revision:    rawCfg.Revision,
 			RevisionURL: revisionURL,
 			Schedule:    *job.Schedule,
 			Task:        packed,
+			Acls:        acls,
+			RetryPolicy: retryPolicy,
+			Triggers:    triggersFromProto(projectID, job.Triggers),
 		})
 	}
 	return out, nil
@@ -247,10 +314,38 @@ func (cat *catalog) validateJobProto(j *messages.Job) error {
 	if _, err := schedule.Parse(*j.Schedule, 0); err != nil {
 		return fmt.Errorf("%s is not valid value for 'schedule' field - %s", *j.Schedule, err)
 	}
+	if _, err := acl.FromProto(j.Acls); err != nil {
+		return fmt.Errorf("bad 'acls' field - %s", err)
+	}
+	if _, err := retryPolicyFromProto(j.RetryPolicy); err != nil {
+		return fmt.Errorf("bad 'retry_policy' field - %s", err)
+	}
 	_, err := cat.extractTaskProto(j.Task)
 	return err
 }
 
+// retryPolicyFromProto validates messages.RetryPolicy and converts it to
+// RetryPolicy. Nil message means "do not retry".
+func retryPolicyFromProto(p *messages.RetryPolicy) (RetryPolicy, error) {
+	switch {
+	case p.GetMaxAttempts() < 0:
+		return RetryPolicy{}, fmt.Errorf("'max_attempts' must be non-negative")
+	case p.GetMaxAttempts() <= 1:
+		return RetryPolicy{}, nil
+	case p.GetMaxAttempts() > maxRetryAttempts:
+		return RetryPolicy{}, fmt.Errorf("'max_attempts' must not exceed %d", maxRetryAttempts)
+	case p.GetBackoffSec() < 0:
+		return RetryPolicy{}, fmt.Errorf("'backoff_sec' must be non-negative")
+	case p.GetMaxBackoffSec() < p.GetBackoffSec():
+		return RetryPolicy{}, fmt.Errorf("'max_backoff_sec' must not be less than 'backoff_sec'")
+	}
+	return RetryPolicy{
+		MaxAttempts: int(p.GetMaxAttempts()),
+		Backoff:     time.Duration(p.GetBackoffSec()) * time.Second,
+		MaxBackoff:  time.Duration(p.GetMaxBackoffSec()) * time.Second,
+	}, nil
+}
+
 // extractTaskProto verifies that messages.Task protobuf message makes sense. It
 // ensures only one of its fields is set, validated that field and returns it.
 func (cat *catalog) extractTaskProto(t *messages.Task) (proto.Message, error) {
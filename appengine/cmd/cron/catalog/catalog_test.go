@@ -7,6 +7,7 @@ package catalog
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
@@ -14,6 +15,7 @@ import (
 
 	memcfg "github.com/luci/luci-go/common/config/impl/memory"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
 	"github.com/luci/luci-go/appengine/cmd/cron/messages"
 	"github.com/luci/luci-go/appengine/cmd/cron/task"
 
@@ -58,6 +60,16 @@ func TestProtoValidation(t *testing.T) {
 			Id:       strPtr("good"),
 			Schedule: strPtr("* * * * *"),
 		}), ShouldErrLike, "missing 'task' field")
+		So(c.validateJobProto(&messages.Job{
+			Id:       strPtr("good"),
+			Schedule: strPtr("* * * * *"),
+			Acls:     []*messages.Acl{{GrantedTo: strPtr("not an identity")}},
+		}), ShouldErrLike, "bad 'acls' field")
+		So(c.validateJobProto(&messages.Job{
+			Id:          strPtr("good"),
+			Schedule:    strPtr("* * * * *"),
+			RetryPolicy: &messages.RetryPolicy{MaxAttempts: int32Ptr(100)},
+		}), ShouldErrLike, "bad 'retry_policy' field")
 		So(c.validateJobProto(&messages.Job{
 			Id:       strPtr("good"),
 			Schedule: strPtr("* * * * *"),
@@ -101,6 +113,58 @@ func TestProtoValidation(t *testing.T) {
 	})
 }
 
+func TestRetryPolicy(t *testing.T) {
+	Convey("retryPolicyFromProto works", t, func() {
+		p, err := retryPolicyFromProto(nil)
+		So(err, ShouldBeNil)
+		So(p, ShouldResemble, RetryPolicy{})
+
+		p, err = retryPolicyFromProto(&messages.RetryPolicy{MaxAttempts: int32Ptr(1)})
+		So(err, ShouldBeNil)
+		So(p, ShouldResemble, RetryPolicy{})
+
+		p, err = retryPolicyFromProto(&messages.RetryPolicy{MaxAttempts: int32Ptr(3)})
+		So(err, ShouldBeNil)
+		So(p, ShouldResemble, RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     30 * time.Second,
+			MaxBackoff:  900 * time.Second,
+		})
+
+		_, err = retryPolicyFromProto(&messages.RetryPolicy{MaxAttempts: int32Ptr(-1)})
+		So(err, ShouldErrLike, "must be non-negative")
+		_, err = retryPolicyFromProto(&messages.RetryPolicy{MaxAttempts: int32Ptr(11)})
+		So(err, ShouldErrLike, "must not exceed 10")
+		_, err = retryPolicyFromProto(&messages.RetryPolicy{
+			MaxAttempts: int32Ptr(3),
+			BackoffSec:  int32Ptr(-1),
+		})
+		So(err, ShouldErrLike, "'backoff_sec' must be non-negative")
+		_, err = retryPolicyFromProto(&messages.RetryPolicy{
+			MaxAttempts:   int32Ptr(3),
+			BackoffSec:    int32Ptr(60),
+			MaxBackoffSec: int32Ptr(30),
+		})
+		So(err, ShouldErrLike, "must not be less than 'backoff_sec'")
+	})
+
+	Convey("Delay and ShouldRetry work", t, func() {
+		p := RetryPolicy{
+			MaxAttempts: 5,
+			Backoff:     10 * time.Second,
+			MaxBackoff:  time.Minute,
+		}
+		So(p.ShouldRetry(1), ShouldBeTrue)
+		So(p.ShouldRetry(4), ShouldBeTrue)
+		So(p.ShouldRetry(5), ShouldBeFalse)
+		So(p.Delay(1), ShouldEqual, 10*time.Second)
+		So(p.Delay(2), ShouldEqual, 20*time.Second)
+		So(p.Delay(3), ShouldEqual, 40*time.Second)
+		So(p.Delay(4), ShouldEqual, time.Minute)
+		So(p.Delay(100), ShouldEqual, time.Minute)
+	})
+}
+
 func TestConfigReading(t *testing.T) {
 	Convey("with mocked config", t, func() {
 		ctx := memcfg.Use(context.Background(), mockedConfigs)
@@ -110,7 +174,7 @@ func TestConfigReading(t *testing.T) {
 		Convey("GetAllProjects works", func() {
 			projects, err := cat.GetAllProjects(ctx)
 			So(err, ShouldBeNil)
-			So(projects, ShouldResemble, []string{"broken", "project1", "project2"})
+			So(projects, ShouldResemble, []string{"bad_acls", "broken", "cycle", "project1", "project2"})
 		})
 
 		Convey("GetProjectJobs works", func() {
@@ -122,12 +186,28 @@ func TestConfigReading(t *testing.T) {
 					Revision: "ca55f19ed79838218e75c9e5e81672d4b48b159a",
 					Schedule: "*/10 * * * * * *",
 					Task:     []uint8{0xa, 0x0},
+					Acls: acl.GrantsByRole{
+						Readers: []string{"group:all"},
+					},
+					Triggers: Triggers{
+						OnSuccess: []string{"project1/noop-job-2"},
+					},
 				},
 				{
 					JobID:    "project1/noop-job-2",
 					Revision: "ca55f19ed79838218e75c9e5e81672d4b48b159a",
 					Schedule: "*/10 * * * * * *",
 					Task:     []uint8{0xa, 0x0},
+					Acls: acl.GrantsByRole{
+						Readers:    []string{"group:all"},
+						Triggerers: []string{"group:triggerers"},
+						Owners:     []string{"user:owner@example.com"},
+					},
+					RetryPolicy: RetryPolicy{
+						MaxAttempts: 3,
+						Backoff:     10 * time.Second,
+						MaxBackoff:  900 * time.Second,
+					},
 				},
 			})
 		})
@@ -144,6 +224,18 @@ func TestConfigReading(t *testing.T) {
 			So(err, ShouldNotBeNil)
 		})
 
+		Convey("GetProjectJobs bad project ACLs", func() {
+			defs, err := cat.GetProjectJobs(ctx, "bad_acls")
+			So(defs, ShouldBeNil)
+			So(err, ShouldErrLike, "invalid project ACLs")
+		})
+
+		Convey("GetProjectJobs triggers cycle", func() {
+			defs, err := cat.GetProjectJobs(ctx, "cycle")
+			So(defs, ShouldBeNil)
+			So(err, ShouldErrLike, "triggers form a cycle: a -> b -> a")
+		})
+
 		Convey("UnmarshalTask works", func() {
 			defs, err := cat.GetProjectJobs(ctx, "project1")
 			So(err, ShouldBeNil)
@@ -163,6 +255,8 @@ func TestConfigReading(t *testing.T) {
 
 func strPtr(s string) *string { return &s }
 
+func int32Ptr(i int32) *int32 { return &i }
+
 type noopTaskManager struct {
 	validationErr error
 }
@@ -206,12 +300,20 @@ func (b brokenTaskManager) ProtoMessageType() proto.Message {
 ////
 
 const project1CronCfg = `
+acls {
+  role: READER
+  granted_to: "group:all"
+}
+
 job {
   id: "noop-job-1"
   schedule: "*/10 * * * * * *"
   task: {
     noop: {}
   }
+  triggers {
+    job: "noop-job-2"
+  }
 }
 
 job {
@@ -220,6 +322,18 @@ job {
   task: {
     noop: {}
   }
+  acls {
+    role: TRIGGERER
+    granted_to: "group:triggerers"
+  }
+  acls {
+    role: OWNER
+    granted_to: "user:owner@example.com"
+  }
+  retry_policy {
+    max_attempts: 3
+    backoff_sec: 10
+  }
 }
 
 job {
@@ -231,6 +345,19 @@ job {
   }
 }
 
+# Will be skipped since its ACLs are invalid.
+job {
+  id: "noop-job-5"
+  schedule: "*/10 * * * * * *"
+  task: {
+    noop: {}
+  }
+  acls {
+    role: OWNER
+    granted_to: "owner@example.com"
+  }
+}
+
 # Will be skipped since UrlFetchTask Manager is not registered.
 job {
   id: "noop-job-4"
@@ -258,6 +385,25 @@ var mockedConfigs = map[string]memcfg.ConfigSet{
 	"projects/project2": {
 		"cron.cfg": project2CronCfg,
 	},
+	"projects/bad_acls": {
+		"cron.cfg": `acls { role: OWNER granted_to: "group:" }`,
+	},
+	"projects/cycle": {
+		"cron.cfg": `
+job {
+  id: "a"
+  schedule: "manual"
+  task: { noop: {} }
+  triggers { job: "b" condition: ALWAYS }
+}
+job {
+  id: "b"
+  schedule: "manual"
+  task: { noop: {} }
+  triggers { job: "a" condition: ON_FAILURE }
+}
+`,
+	},
 	"projects/broken": {
 		"cron.cfg": "broken!!!!111",
 	},
@@ -0,0 +1,175 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package catalog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+)
+
+// Triggers lists jobs to trigger when an invocation of some job finishes,
+// grouped by trigger condition. Each entry is a full job ID
+// ("<ProjectID>/<JobName>").
+type Triggers struct {
+	OnSuccess []string `gae:",noindex"`
+	OnFailure []string `gae:",noindex"`
+	Always    []string `gae:",noindex"`
+}
+
+// IsEmpty returns true if there are no triggers.
+func (t *Triggers) IsEmpty() bool {
+	return len(t.OnSuccess) == 0 && len(t.OnFailure) == 0 && len(t.Always) == 0
+}
+
+// Equal returns true if 't' and 'other' trigger same jobs on same conditions.
+func (t *Triggers) Equal(other *Triggers) bool {
+	return equalStrings(t.OnSuccess, other.OnSuccess) &&
+		equalStrings(t.OnFailure, other.OnFailure) &&
+		equalStrings(t.Always, other.Always)
+}
+
+// ForStatus returns a sorted list of jobs to trigger when an invocation
+// finishes with the given final status.
+func (t *Triggers) ForStatus(status task.Status) []string {
+	var out []string
+	switch status {
+	case task.StatusSucceeded:
+		out = append(out, t.OnSuccess...)
+	case task.StatusFailed:
+		out = append(out, t.OnFailure...)
+	}
+	if status.Final() {
+		for _, job := range t.Always {
+			out = appendUnique(out, job)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// triggersFromProto converts a list of messages.Trigger of some job in the
+// given project to Triggers.
+//
+// Assumes the triggers were validated with validateTriggers already.
+func triggersFromProto(projectID string, triggers []*messages.Trigger) Triggers {
+	t := Triggers{}
+	for _, trigger := range triggers {
+		jobID := projectID + "/" + trigger.GetJob()
+		switch trigger.GetCondition() {
+		case messages.Trigger_ON_SUCCESS:
+			t.OnSuccess = appendUnique(t.OnSuccess, jobID)
+		case messages.Trigger_ON_FAILURE:
+			t.OnFailure = appendUnique(t.OnFailure, jobID)
+		case messages.Trigger_ALWAYS:
+			t.Always = appendUnique(t.Always, jobID)
+		}
+	}
+	return t
+}
+
+// validateTriggers checks that all triggers defined by jobs of a project refer
+// to jobs of the same project and don't form cycles.
+func validateTriggers(jobs []*messages.Job) error {
+	// Map of job name -> names of jobs it triggers.
+	graph := make(map[string][]string, len(jobs))
+	for _, job := range jobs {
+		if job != nil && job.Id != nil {
+			graph[job.GetId()] = nil
+		}
+	}
+	for _, job := range jobs {
+		if len(job.GetTriggers()) == 0 {
+			continue
+		}
+		if job.Id == nil {
+			return fmt.Errorf("a job without 'id' field has triggers")
+		}
+		for _, trigger := range job.Triggers {
+			target := trigger.GetJob()
+			if _, ok := graph[target]; !ok {
+				return fmt.Errorf("job %q triggers unknown job %q", job.GetId(), target)
+			}
+			if _, ok := messages.Trigger_Condition_name[int32(trigger.GetCondition())]; !ok {
+				return fmt.Errorf("job %q has trigger with unknown condition %s", job.GetId(), trigger.GetCondition())
+			}
+			graph[job.GetId()] = append(graph[job.GetId()], target)
+		}
+	}
+
+	// Look for cycles using DFS, visiting jobs in a deterministic order.
+	names := make([]string, 0, len(graph))
+	for name := range graph {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int, len(graph))
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case inProgress:
+			// 'name' is somewhere in 'path', the cycle starts there.
+			cycle := path
+			for i, n := range path {
+				if n == name {
+					cycle = path[i:]
+					break
+				}
+			}
+			return fmt.Errorf("triggers form a cycle: %s -> %s", strings.Join(cycle, " -> "), name)
+		case done:
+			return nil
+		}
+		state[name] = inProgress
+		path = append(path, name)
+		for _, next := range graph[name] {
+			if err := visit(next); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendUnique appends 'item' to 'list' if it's not there yet.
+func appendUnique(list []string, item string) []string {
+	for _, s := range list {
+		if s == item {
+			return list
+		}
+	}
+	return append(list, item)
+}
+
+// equalStrings returns true if two slices of strings are equal.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
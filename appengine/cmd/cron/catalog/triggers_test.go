@@ -0,0 +1,81 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTriggers(t *testing.T) {
+	job := func(id string, triggers ...*messages.Trigger) *messages.Job {
+		return &messages.Job{Id: strPtr(id), Triggers: triggers}
+	}
+	trigger := func(jobID string, cond messages.Trigger_Condition) *messages.Trigger {
+		return &messages.Trigger{Job: strPtr(jobID), Condition: cond.Enum()}
+	}
+
+	Convey("triggersFromProto works", t, func() {
+		tr := triggersFromProto("proj", []*messages.Trigger{
+			trigger("a", messages.Trigger_ON_SUCCESS),
+			trigger("b", messages.Trigger_ON_FAILURE),
+			trigger("c", messages.Trigger_ALWAYS),
+			trigger("a", messages.Trigger_ON_SUCCESS),
+			{Job: strPtr("d")},
+		})
+		So(tr, ShouldResemble, Triggers{
+			OnSuccess: []string{"proj/a", "proj/d"},
+			OnFailure: []string{"proj/b"},
+			Always:    []string{"proj/c"},
+		})
+		So(tr.IsEmpty(), ShouldBeFalse)
+		So(tr.Equal(&tr), ShouldBeTrue)
+		So(tr.Equal(&Triggers{}), ShouldBeFalse)
+	})
+
+	Convey("ForStatus works", t, func() {
+		tr := Triggers{
+			OnSuccess: []string{"proj/b", "proj/a"},
+			OnFailure: []string{"proj/c"},
+			Always:    []string{"proj/d", "proj/a"},
+		}
+		So(tr.ForStatus(task.StatusSucceeded), ShouldResemble, []string{"proj/a", "proj/b", "proj/d"})
+		So(tr.ForStatus(task.StatusFailed), ShouldResemble, []string{"proj/a", "proj/c", "proj/d"})
+		So(tr.ForStatus(task.StatusAborted), ShouldResemble, []string{"proj/a", "proj/d"})
+		So(tr.ForStatus(task.StatusRunning), ShouldBeNil)
+	})
+
+	Convey("validateTriggers works", t, func() {
+		So(validateTriggers([]*messages.Job{
+			job("a", trigger("b", messages.Trigger_ON_SUCCESS), trigger("c", messages.Trigger_ALWAYS)),
+			job("b", trigger("c", messages.Trigger_ON_FAILURE)),
+			job("c"),
+		}), ShouldBeNil)
+	})
+
+	Convey("validateTriggers catches unknown jobs", t, func() {
+		So(validateTriggers([]*messages.Job{
+			job("a", trigger("zzz", messages.Trigger_ON_SUCCESS)),
+		}), ShouldErrLike, `job "a" triggers unknown job "zzz"`)
+	})
+
+	Convey("validateTriggers catches cycles", t, func() {
+		So(validateTriggers([]*messages.Job{
+			job("a", trigger("a", messages.Trigger_ON_SUCCESS)),
+		}), ShouldErrLike, "triggers form a cycle: a -> a")
+
+		So(validateTriggers([]*messages.Job{
+			job("a", trigger("b", messages.Trigger_ON_SUCCESS)),
+			job("b", trigger("c", messages.Trigger_ON_SUCCESS)),
+			job("c", trigger("d", messages.Trigger_ON_FAILURE)),
+			job("d", trigger("b", messages.Trigger_ALWAYS)),
+		}), ShouldErrLike, "triggers form a cycle: b -> c -> d -> b")
+	})
+}
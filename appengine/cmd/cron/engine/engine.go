@@ -37,11 +37,16 @@ import (
 	authinfo "github.com/luci/luci-go/server/auth/info"
 	"github.com/luci/luci-go/server/tokens"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
 	"github.com/luci/luci-go/appengine/cmd/cron/catalog"
 	"github.com/luci/luci-go/appengine/cmd/cron/schedule"
 	"github.com/luci/luci-go/appengine/cmd/cron/task"
 )
 
+// ErrNoPermission is returned by methods that modify a job if the caller
+// doesn't have a sufficient role in the job's ACLs.
+var ErrNoPermission = errors.New("insufficient rights on a job")
+
 // Engine manages all cron jobs: keeps track of their state, runs state machine
 // transactions, starts new invocations, etc. A method returns errors.Transient
 // if the error is non-fatal and the call should be retried later. Any other
@@ -69,6 +74,12 @@ type Engine interface {
 	// GetInvocation returns single invocation of some cron job given its ID.
 	GetInvocation(c context.Context, jobID string, invID int64) (*Invocation, error)
 
+	// DeleteOldInvocations deletes invocations of a job that started more than
+	// 'retention' ago. Zero 'retention' means to keep invocations forever. The
+	// currently running invocation is never deleted. Returns the number of
+	// deleted invocations.
+	DeleteOldInvocations(c context.Context, jobID string, retention time.Duration) (int, error)
+
 	// GetInvocationsByNonce returns a list of Invocations with given nonce.
 	//
 	// Invocation nonce is a random number that identifies an intent to start
@@ -107,15 +118,21 @@ type Engine interface {
 	// Returns new invocation nonce (a random number that identifies an intent to
 	// start an invocation). Normally one nonce corresponds to one Invocation
 	// entity, but there can be more if job fails to start with a transient error.
+	//
+	// Returns ErrNoPermission if 'triggeredBy' doesn't have Triggerer role.
 	TriggerInvocation(c context.Context, jobID string, triggeredBy identity.Identity) (int64, error)
 
 	// PauseJob replaces job's schedule with "manual", effectively preventing it
 	// from running automatically (until it is resumed). Manual invocations are
 	// still allowed. Does nothing if job is already paused. Any pending or
 	// running invocations are still executed.
+	//
+	// Returns ErrNoPermission if 'who' doesn't have Owner role.
 	PauseJob(c context.Context, jobID string, who identity.Identity) error
 
 	// ResumeJob resumed paused job. Doesn't nothing if the job is not paused.
+	//
+	// Returns ErrNoPermission if 'who' doesn't have Owner role.
 	ResumeJob(c context.Context, jobID string, who identity.Identity) error
 
 	// AbortInvocation forcefully moves the invocation to failed state.
@@ -128,6 +145,8 @@ type Engine interface {
 	// to missing PubSub notifications or other kinds of unexpected conditions.
 	//
 	// Does nothing if invocation is already in some final state.
+	//
+	// Returns ErrNoPermission if 'who' doesn't have Owner role.
 	AbortInvocation(c context.Context, jobID string, invID int64, who identity.Identity) error
 }
 
@@ -162,6 +181,11 @@ type actionTaskPayload struct {
 	TriggeredBy         string `json:",omitempty"` // valid for "StartInvocationAction" kind
 	Overruns            int    `json:",omitempty"` // valid for "RecordOverrunAction" kind
 	RunningInvocationID int64  `json:",omitempty"` // valid for "RecordOverrunAction" kind
+	RetryNonce          int64  `json:",omitempty"` // valid for "RetryLaterAction" kind
+
+	// Valid for "StartInvocationAction" and "TriggerJobAction" kinds.
+	UpstreamJobID        string `json:",omitempty"`
+	UpstreamInvocationID int64  `json:",omitempty"`
 }
 
 // CronJob stores the last known definition of a cron job, as well as its
@@ -207,6 +231,18 @@ type CronJob struct {
 
 	// State is cron job state machine state, see StateMachine.
 	State JobState
+
+	// Acls defines who can see and manipulate the job.
+	Acls acl.GrantsByRole
+
+	// RetryPolicy defines how to retry failed invocations.
+	RetryPolicy catalog.RetryPolicy
+
+	// Triggers defines what jobs to trigger when an invocation finishes.
+	Triggers catalog.Triggers
+
+	// Stats holds outcomes of the most recent finished invocations.
+	Stats JobStats
 }
 
 // effectiveSchedule returns schedule string to use for the job, considering its
@@ -246,14 +282,20 @@ func (e *CronJob) isEqual(other *CronJob) bool {
 		e.RevisionURL == other.RevisionURL &&
 		e.Schedule == other.Schedule &&
 		bytes.Equal(e.Task, other.Task) &&
-		e.State == other.State)
+		e.State == other.State &&
+		e.Acls.Equal(&other.Acls) &&
+		e.RetryPolicy == other.RetryPolicy &&
+		e.Triggers.Equal(&other.Triggers) &&
+		e.Stats.Equal(&other.Stats))
 }
 
 // matches returns true if job definition in the entity matches the one
 // specified by catalog.Definition struct. UpdateProjectJobs skips updates for
 // such jobs (assuming they are up-to-date).
 func (e *CronJob) matches(def catalog.Definition) bool {
-	return e.JobID == def.JobID && e.Schedule == def.Schedule && bytes.Equal(e.Task, def.Task)
+	return e.JobID == def.JobID && e.Schedule == def.Schedule &&
+		bytes.Equal(e.Task, def.Task) && e.Acls.Equal(&def.Acls) &&
+		e.RetryPolicy == def.RetryPolicy && e.Triggers.Equal(&def.Triggers)
 }
 
 // Invocation entity stores single attempt to run a cron job. Its parent entity
@@ -286,6 +328,23 @@ type Invocation struct {
 	// Empty identity string if it was triggered by cron service itself.
 	TriggeredBy identity.Identity
 
+	// UpstreamJobID is ID of a job whose invocation triggered this invocation,
+	// or empty string if it wasn't triggered by another job.
+	UpstreamJobID string `gae:",noindex"`
+
+	// UpstreamInvocationID is ID of an invocation of UpstreamJobID that
+	// triggered this invocation, or 0 if it wasn't triggered by another job.
+	UpstreamInvocationID int64 `gae:",noindex"`
+
+	// TriggeredJobIDs is a list of IDs of jobs triggered by this invocation when
+	// it finished. TriggeredInvocationIDs has IDs of the corresponding
+	// invocations. For informational purpose.
+	TriggeredJobIDs []string `gae:",noindex"`
+
+	// TriggeredInvocationIDs is a list of IDs of invocations triggered by this
+	// invocation, matching TriggeredJobIDs element-wise.
+	TriggeredInvocationIDs []int64 `gae:",noindex"`
+
 	// Revision is revision number of cron.cfg when this invocation was created.
 	// For informational purpose.
 	Revision string `gae:",noindex"`
@@ -305,6 +364,14 @@ type Invocation struct {
 	// retry. For informational purposes.
 	RetryCount int64 `gae:",noindex"`
 
+	// RetryOf is ID of a failed invocation this invocation is retrying (according
+	// to the job's retry policy), or 0 if it is not a retry.
+	RetryOf int64 `gae:",noindex"`
+
+	// RetriedAs is ID of an invocation that retries this (failed) invocation, or
+	// 0 if it wasn't retried.
+	RetriedAs int64 `gae:",noindex"`
+
 	// Status is current status of the invocation (e.g. "RUNNING"), see the enum.
 	Status task.Status
 
@@ -328,17 +395,61 @@ func (e *Invocation) isEqual(other *Invocation) bool {
 		e.Started == other.Started &&
 		e.Finished == other.Finished &&
 		e.InvocationNonce == other.InvocationNonce &&
+		e.TriggeredBy == other.TriggeredBy &&
+		e.UpstreamJobID == other.UpstreamJobID &&
+		e.UpstreamInvocationID == other.UpstreamInvocationID &&
+		equalStrings(e.TriggeredJobIDs, other.TriggeredJobIDs) &&
+		equalInt64s(e.TriggeredInvocationIDs, other.TriggeredInvocationIDs) &&
 		e.Revision == other.Revision &&
 		e.RevisionURL == other.RevisionURL &&
 		bytes.Equal(e.Task, other.Task) &&
 		e.DebugLog == other.DebugLog &&
 		e.RetryCount == other.RetryCount &&
+		e.RetryOf == other.RetryOf &&
+		e.RetriedAs == other.RetriedAs &&
 		e.Status == other.Status &&
 		e.ViewURL == other.ViewURL &&
 		bytes.Equal(e.TaskData, other.TaskData) &&
 		e.MutationsCount == other.MutationsCount)
 }
 
+// recordTriggered remembers that the invocation triggered the given invocation
+// of the given job. Returns false if it is already recorded.
+func (e *Invocation) recordTriggered(jobID string, invID int64) bool {
+	for i, id := range e.TriggeredInvocationIDs {
+		if id == invID && e.TriggeredJobIDs[i] == jobID {
+			return false
+		}
+	}
+	e.TriggeredJobIDs = append(e.TriggeredJobIDs, jobID)
+	e.TriggeredInvocationIDs = append(e.TriggeredInvocationIDs, invID)
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalInt64s(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // debugLog appends a line to DebugLog field.
 func (e *Invocation) debugLog(c context.Context, format string, args ...interface{}) {
 	debugLog(c, &e.DebugLog, format, args...)
@@ -387,6 +498,15 @@ func generateInvocationID(c context.Context, parent *datastore.Key) (int64, erro
 	return 0, errors.New("could not find available invocationID after 10 attempts")
 }
 
+// lastInvocationIDAt returns the largest invocation ID that
+// generateInvocationID can produce at the given moment in time. All
+// invocations that started before 't' have larger IDs.
+func lastInvocationIDAt(t time.Time) int64 {
+	invTs := int64(t.UTC().Sub(invocationIDEpoch) / time.Millisecond)
+	invTs = ^invTs & 8796093022207
+	return invTs<<20 | 1048575
+}
+
 // debugLog mutates a string by appending a line to it.
 func debugLog(c context.Context, str *string, format string, args ...interface{}) {
 	prefix := clock.Now(c).UTC().Format("[15:04:05.000] ")
@@ -577,6 +697,66 @@ func (e *engineImpl) GetInvocation(c context.Context, jobID string, invID int64)
 	}
 }
 
+// deleteBatchSize is how many invocations are deleted by a single datastore
+// call in DeleteOldInvocations.
+const deleteBatchSize = 500
+
+// maxDeleteBatches limits how many batches DeleteOldInvocations deletes in
+// a single call. The rest is deleted by next calls.
+const maxDeleteBatches = 20
+
+func (e *engineImpl) DeleteOldInvocations(c context.Context, jobID string, retention time.Duration) (int, error) {
+	if retention <= 0 {
+		return 0, nil
+	}
+	job, err := e.GetCronJob(c, jobID)
+	if err != nil {
+		return 0, err
+	}
+	current := int64(0)
+	if job != nil {
+		current = job.State.InvocationID
+	}
+
+	// Invocations that started before the cutoff have IDs larger than the last
+	// ID that could have been generated at the cutoff time.
+	ds := datastore.Get(c)
+	cutoff := clock.Now(c).UTC().Add(-retention)
+	parent := ds.NewKey("CronJob", jobID, 0, nil)
+	q := datastore.NewQuery("Invocation").
+		Ancestor(parent).
+		Gt("__key__", ds.NewKey("Invocation", "", lastInvocationIDAt(cutoff), parent)).
+		KeysOnly(true).
+		Limit(deleteBatchSize)
+
+	deleted := 0
+	for i := 0; i < maxDeleteBatches; i++ {
+		var keys []*datastore.Key
+		if err := ds.GetAll(q, &keys); err != nil {
+			return deleted, errors.WrapTransient(err)
+		}
+		toDelete := make([]*datastore.Key, 0, len(keys))
+		for _, k := range keys {
+			if k.IntID() != current {
+				toDelete = append(toDelete, k)
+			}
+		}
+		if len(toDelete) != 0 {
+			if err := ds.Delete(toDelete); err != nil {
+				return deleted, errors.WrapTransient(err)
+			}
+			deleted += len(toDelete)
+		}
+		if len(keys) < deleteBatchSize || len(toDelete) == 0 {
+			break
+		}
+	}
+	if deleted != 0 {
+		logging.Infof(c, "Deleted %d invocations older than %s", deleted, cutoff)
+	}
+	return deleted, nil
+}
+
 func (e *engineImpl) GetInvocationsByNonce(c context.Context, invNonce int64) ([]*Invocation, error) {
 	q := datastore.NewQuery("Invocation").Eq("InvocationNonce", invNonce)
 	entities := []*Invocation{}
@@ -754,11 +934,13 @@ func (e *engineImpl) rollSM(c context.Context, job *CronJob, cb func(*StateMachi
 	now := clock.Now(c).UTC()
 	rnd := mathrand.Get(c)
 	sm := StateMachine{
-		State:    job.State,
-		Now:      now,
-		Schedule: sched,
-		Nonce:    func() int64 { return rnd.Int63() + 1 },
-		Context:  c,
+		State:       job.State,
+		Now:         now,
+		Schedule:    sched,
+		RetryPolicy: job.RetryPolicy,
+		Triggers:    job.Triggers,
+		Nonce:       func() int64 { return rnd.Int63() + 1 },
+		Context:     c,
 	}
 	// All errors returned by state machine transition changes are transient.
 	// Fatal errors (when we have them) should be reflected as a state changing
@@ -795,7 +977,7 @@ func (e *engineImpl) enqueueActions(c context.Context, jobID string, actions []A
 			if err != nil {
 				return err
 			}
-			logging.Infof(c, "Scheduling tick %d after %.1f sec", a.TickNonce, a.When.Sub(time.Now()).Seconds())
+			logging.Infof(c, "Scheduling tick %d after %.1f sec", a.TickNonce, a.When.Sub(clock.Now(c)).Seconds())
 			qs[e.TimersQueueName] = append(qs[e.TimersQueueName], &taskqueue.Task{
 				Path:    e.TimersQueuePath,
 				ETA:     a.When,
@@ -803,10 +985,12 @@ func (e *engineImpl) enqueueActions(c context.Context, jobID string, actions []A
 			})
 		case StartInvocationAction:
 			payload, err := json.Marshal(actionTaskPayload{
-				JobID:           jobID,
-				Kind:            "StartInvocationAction",
-				InvocationNonce: a.InvocationNonce,
-				TriggeredBy:     string(a.TriggeredBy),
+				JobID:                jobID,
+				Kind:                 "StartInvocationAction",
+				InvocationNonce:      a.InvocationNonce,
+				TriggeredBy:          string(a.TriggeredBy),
+				UpstreamJobID:        a.UpstreamJobID,
+				UpstreamInvocationID: a.UpstreamInvocationID,
 			})
 			if err != nil {
 				return err
@@ -831,6 +1015,40 @@ func (e *engineImpl) enqueueActions(c context.Context, jobID string, actions []A
 				Delay:   time.Second, // give the transaction time to land
 				Payload: payload,
 			})
+		case RetryLaterAction:
+			payload, err := json.Marshal(actionTaskPayload{
+				JobID:      jobID,
+				Kind:       "RetryLaterAction",
+				RetryNonce: a.RetryNonce,
+			})
+			if err != nil {
+				return err
+			}
+			logging.Infof(c, "Scheduling retry %d after %.1f sec", a.RetryNonce, a.When.Sub(clock.Now(c)).Seconds())
+			qs[e.TimersQueueName] = append(qs[e.TimersQueueName], &taskqueue.Task{
+				Path:    e.TimersQueuePath,
+				ETA:     a.When,
+				Payload: payload,
+			})
+		case TriggerJobsAction:
+			// Each triggered job is handled in a separate task, since each one is
+			// a separate transaction.
+			for _, triggered := range a.JobIDs {
+				payload, err := json.Marshal(actionTaskPayload{
+					JobID:                triggered,
+					Kind:                 "TriggerJobAction",
+					UpstreamJobID:        jobID,
+					UpstreamInvocationID: a.InvocationID,
+				})
+				if err != nil {
+					return err
+				}
+				qs[e.InvocationsQueueName] = append(qs[e.InvocationsQueueName], &taskqueue.Task{
+					Path:    e.InvocationsQueuePath,
+					Delay:   time.Second, // give the transaction time to land
+					Payload: payload,
+				})
+			}
 		default:
 			logging.Errorf(c, "Unexpected action type %T, skipping", a)
 		}
@@ -860,17 +1078,47 @@ func (e *engineImpl) ExecuteSerializedAction(c context.Context, action []byte, r
 	case "TickLaterAction":
 		return e.timerTick(c, payload.JobID, payload.TickNonce)
 	case "StartInvocationAction":
-		return e.startInvocation(
-			c, payload.JobID, payload.InvocationNonce,
-			identity.Identity(payload.TriggeredBy), retryCount)
+		return e.startInvocation(c, payload.JobID, payload.InvocationNonce, invocationTrigger{
+			triggeredBy:          identity.Identity(payload.TriggeredBy),
+			upstreamJobID:        payload.UpstreamJobID,
+			upstreamInvocationID: payload.UpstreamInvocationID,
+		}, retryCount)
 	case "RecordOverrunAction":
 		return e.recordOverrun(c, payload.JobID, payload.Overruns, payload.RunningInvocationID)
+	case "RetryLaterAction":
+		return e.retryTick(c, payload.JobID, payload.RetryNonce)
+	case "TriggerJobAction":
+		return e.triggerJob(c, payload.JobID, payload.UpstreamJobID, payload.UpstreamInvocationID)
 	default:
 		return fmt.Errorf("unexpected action kind %q", payload.Kind)
 	}
 }
 
+// checkPermission returns ErrNoPermission if 'who' doesn't have the given role
+// in the job. Does nothing if there's no such job, letting the caller deal with
+// it.
+//
+// Must be called outside of transactions, since checking group membership may
+// touch the datastore.
+func (e *engineImpl) checkPermission(c context.Context, jobID string, who identity.Identity, role acl.Role) error {
+	job, err := e.GetCronJob(c, jobID)
+	if err != nil || job == nil {
+		return err
+	}
+	switch yes, err := job.Acls.HasRole(c, who, role); {
+	case err != nil:
+		return err
+	case !yes:
+		logging.Warningf(c, "%s doesn't have %s role in %s", who, role, jobID)
+		return ErrNoPermission
+	}
+	return nil
+}
+
 func (e *engineImpl) TriggerInvocation(c context.Context, jobID string, triggeredBy identity.Identity) (int64, error) {
+	if err := e.checkPermission(c, jobID, triggeredBy, acl.Triggerer); err != nil {
+		return 0, err
+	}
 	var err error
 	var invNonce int64
 	err2 := e.txn(c, jobID, func(c context.Context, job *CronJob, isNew bool) error {
@@ -906,6 +1154,9 @@ func (e *engineImpl) ResumeJob(c context.Context, jobID string, who identity.Ide
 }
 
 func (e *engineImpl) setPausedFlag(c context.Context, jobID string, paused bool, who identity.Identity) error {
+	if err := e.checkPermission(c, jobID, who, acl.Owner); err != nil {
+		return err
+	}
 	return e.txn(c, jobID, func(c context.Context, job *CronJob, isNew bool) error {
 		if isNew || !job.Enabled {
 			return errors.New("no such job")
@@ -927,6 +1178,10 @@ func (e *engineImpl) AbortInvocation(c context.Context, jobID string, invID int6
 	c = logging.SetField(c, "JobID", jobID)
 	c = logging.SetField(c, "InvID", invID)
 
+	if err := e.checkPermission(c, jobID, who, acl.Owner); err != nil {
+		return err
+	}
+
 	var inv *Invocation
 	var err error
 	switch inv, err = e.GetInvocation(c, jobID, invID); {
@@ -991,6 +1246,9 @@ func (e *engineImpl) updateJob(c context.Context, def catalog.Definition) error
 		job.Enabled = true
 		job.Schedule = def.Schedule
 		job.Task = def.Task
+		job.Acls = def.Acls
+		job.RetryPolicy = def.RetryPolicy
+		job.Triggers = def.Triggers
 
 		// Do state machine transitions.
 		if !oldEnabled {
@@ -1049,6 +1307,66 @@ func (e *engineImpl) timerTick(c context.Context, jobID string, tickNonce int64)
 	})
 }
 
+// retryTick is invoked via task queue in a task with some ETA when it is time
+// to retry a failed invocation.
+func (e *engineImpl) retryTick(c context.Context, jobID string, retryNonce int64) error {
+	return e.txn(c, jobID, func(c context.Context, job *CronJob, isNew bool) error {
+		if isNew {
+			logging.Errorf(c, "Retried job is unexpectedly gone")
+			return errSkipPut
+		}
+		logging.Infof(c, "Retry %d has arrived", retryNonce)
+		return e.rollSM(c, job, func(sm *StateMachine) error { return sm.OnRetryTick(retryNonce) })
+	})
+}
+
+// triggerJob is invoked via task queue when an invocation of some upstream job
+// finishes and the upstream job is configured to trigger the job.
+//
+// Paused and disabled jobs are not triggered.
+func (e *engineImpl) triggerJob(c context.Context, jobID, upstreamJobID string, upstreamInvID int64) error {
+	return e.txn(c, jobID, func(c context.Context, job *CronJob, isNew bool) error {
+		switch {
+		case isNew:
+			logging.Warningf(c, "Triggered job %s doesn't exist", jobID)
+			return errSkipPut
+		case !job.Enabled || job.Paused:
+			logging.Infof(c, "Triggered job %s is disabled or paused, skipping", jobID)
+			return errSkipPut
+		}
+		logging.Infof(c, "Triggered by %s/%d", upstreamJobID, upstreamInvID)
+		return e.rollSM(c, job, func(sm *StateMachine) error {
+			return sm.OnJobTriggered(upstreamJobID, upstreamInvID)
+		})
+	})
+}
+
+// linkTriggeredInvocation records in the upstream invocation that it
+// triggered the given invocation of the given job.
+func (e *engineImpl) linkTriggeredInvocation(c context.Context, upstreamJobID string, upstreamInvID int64, jobID string, invID int64) error {
+	err := datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+		upstream := Invocation{
+			ID:     upstreamInvID,
+			JobKey: ds.NewKey("CronJob", upstreamJobID, 0, nil),
+		}
+		switch err := ds.Get(&upstream); {
+		case err == datastore.ErrNoSuchEntity:
+			logging.Warningf(c, "Upstream invocation %s/%d is gone", upstreamJobID, upstreamInvID)
+			return nil
+		case err != nil:
+			return err
+		}
+		if !upstream.recordTriggered(jobID, invID) {
+			return nil
+		}
+		upstream.debugLog(c, "Triggered invocation %d of %s", invID, jobID)
+		upstream.MutationsCount++
+		return ds.Put(&upstream)
+	}, &defaultTransactionOptions)
+	return errors.WrapTransient(err)
+}
+
 // recordOverrun is invoked via task queue when a job should have been started,
 // but previous invocation was still running.
 //
@@ -1078,10 +1396,17 @@ func (e *engineImpl) recordOverrun(c context.Context, jobID string, overruns int
 	return errors.WrapTransient(ds.Put(&inv))
 }
 
+// invocationTrigger describes who or what triggered an invocation.
+type invocationTrigger struct {
+	triggeredBy          identity.Identity // set for manually triggered invocations
+	upstreamJobID        string            // set for invocations triggered by jobs
+	upstreamInvocationID int64             // set for invocations triggered by jobs
+}
+
 // startInvocation is called via task queue to start running a job. This call
 // may be retried by task queue service.
 func (e *engineImpl) startInvocation(c context.Context, jobID string, invocationNonce int64,
-	triggeredBy identity.Identity, retryCount int) error {
+	trigger invocationTrigger, retryCount int) error {
 
 	c = logging.SetField(c, "JobID", jobID)
 	c = logging.SetField(c, "InvNonce", invocationNonce)
@@ -1122,24 +1447,54 @@ func (e *engineImpl) startInvocation(c context.Context, jobID string, invocation
 		}
 		// Put new invocation entity, generate its ID.
 		inv = Invocation{
-			ID:              invID,
-			JobKey:          jobKey,
-			Started:         clock.Now(c).UTC(),
-			InvocationNonce: invocationNonce,
-			TriggeredBy:     triggeredBy,
-			Revision:        job.Revision,
-			RevisionURL:     job.RevisionURL,
-			Task:            job.Task,
-			RetryCount:      int64(retryCount),
-			Status:          task.StatusStarting,
+			ID:                   invID,
+			JobKey:               jobKey,
+			Started:              clock.Now(c).UTC(),
+			InvocationNonce:      invocationNonce,
+			TriggeredBy:          trigger.triggeredBy,
+			UpstreamJobID:        trigger.upstreamJobID,
+			UpstreamInvocationID: trigger.upstreamInvocationID,
+			Revision:             job.Revision,
+			RevisionURL:          job.RevisionURL,
+			Task:                 job.Task,
+			RetryCount:           int64(retryCount),
+			RetryOf:              job.State.RetryOf,
+			Status:               task.StatusStarting,
 		}
 		inv.debugLog(c, "Invocation initiated (attempt %d)", retryCount+1)
-		if triggeredBy != "" {
-			inv.debugLog(c, "Manually triggered by %s", triggeredBy)
+		if trigger.triggeredBy != "" {
+			inv.debugLog(c, "Manually triggered by %s", trigger.triggeredBy)
+		}
+		if trigger.upstreamJobID != "" {
+			inv.debugLog(c, "Triggered by invocation %d of %s", trigger.upstreamInvocationID, trigger.upstreamJobID)
+		}
+		if inv.RetryOf != 0 {
+			inv.debugLog(
+				c, "Retrying failed invocation %d (retry %d of %d)",
+				inv.RetryOf, job.State.FailedAttempts, job.RetryPolicy.MaxAttempts-1)
 		}
 		if err := ds.Put(&inv); err != nil {
 			return err
 		}
+		// Link the failed invocation being retried to the new one.
+		if inv.RetryOf != 0 {
+			failed := Invocation{
+				ID:     inv.RetryOf,
+				JobKey: jobKey,
+			}
+			err := ds.Get(&failed)
+			if err != nil && err != datastore.ErrNoSuchEntity {
+				return err
+			}
+			if err == nil {
+				failed.debugLog(c, "Retrying as %d", inv.ID)
+				failed.RetriedAs = inv.ID
+				failed.MutationsCount++
+				if err := ds.Put(&failed); err != nil {
+					return err
+				}
+			}
+		}
 		// Move previous invocation (if any) to failed state. It has failed to
 		// start.
 		if job.State.InvocationID != 0 {
@@ -1171,6 +1526,17 @@ func (e *engineImpl) startInvocation(c context.Context, jobID string, invocation
 	}
 	c = logging.SetField(c, "InvID", inv.ID)
 
+	// Let the upstream invocation know about the new one. It lives in another
+	// entity group, so it's updated in a separate transaction. The link from the
+	// new invocation to the upstream one is already stored, so a failure here is
+	// not fatal.
+	if trigger.upstreamJobID != "" {
+		err := e.linkTriggeredInvocation(c, trigger.upstreamJobID, trigger.upstreamInvocationID, jobID, inv.ID)
+		if err != nil {
+			logging.Errorf(c, "Failed to link to the upstream invocation - %s", err)
+		}
+	}
+
 	// Now we have a new Invocation entity in the datastore in StatusStarting
 	// state. Grab corresponding TaskManager and launch task through it, keeping
 	// track of the progress in created Invocation entity.
@@ -1490,11 +1856,11 @@ func (ctl *taskController) PrepareTopic(publisher string) (topic string, token s
 }
 
 // GetClient is part of task.Controller interface
-func (ctl *taskController) GetClient(timeout time.Duration) (*http.Client, error) {
+func (ctl *taskController) GetClient(timeout time.Duration, scopes ...string) (*http.Client, error) {
 	// TODO(vadimsh): Use per-project service accounts, not a global cron service
 	// account.
 	ctx, _ := clock.WithTimeout(ctl.ctx, timeout)
-	transport, err := client.Transport(ctx, nil, nil)
+	transport, err := client.Transport(ctx, scopes, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1553,7 +1919,9 @@ func (ctl *taskController) saveImpl(updateCronJob bool) (err error) {
 	}
 
 	// Store the invocation entity and mutate CronJob state accordingly.
-	return ctl.eng.txn(ctl.ctx, saving.JobKey.StringID(), func(c context.Context, job *CronJob, isNew bool) error {
+	var stats *JobStats
+	err = ctl.eng.txn(ctl.ctx, saving.JobKey.StringID(), func(c context.Context, job *CronJob, isNew bool) error {
+		stats = nil
 		ds := datastore.Get(c)
 
 		// Grab what's currently in the store to compare MutationsCount to what we
@@ -1607,10 +1975,24 @@ func (ctl *taskController) saveImpl(updateCronJob bool) (err error) {
 			}
 		}
 		if hasFinished {
+			job.Stats.record(
+				saving.Status == task.StatusSucceeded,
+				saving.Finished.Sub(saving.Started),
+				job.State.Overruns)
+			stats = &job.Stats
 			return ctl.eng.rollSM(c, job, func(sm *StateMachine) error {
-				return sm.OnInvocationDone(saving.ID)
+				if saving.Status == task.StatusFailed {
+					return sm.OnInvocationFailed(saving.ID)
+				}
+				return sm.OnInvocationDone(saving.ID, saving.Status)
 			})
 		}
 		return nil
 	})
+	if err == nil && hasFinished {
+		reportInvocationMetrics(
+			ctl.ctx, saving.JobKey.StringID(), saving.Status,
+			saving.Finished.Sub(saving.Started), stats)
+	}
+	return err
 }
@@ -24,8 +24,12 @@ import (
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/mathrand"
 	"github.com/luci/luci-go/common/stringset"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	"github.com/luci/luci-go/server/auth/identity"
 	"github.com/luci/luci-go/server/secrets/testsecrets"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
 	"github.com/luci/luci-go/appengine/cmd/cron/catalog"
 	"github.com/luci/luci-go/appengine/cmd/cron/messages"
 	"github.com/luci/luci-go/appengine/cmd/cron/task"
@@ -482,11 +486,163 @@ func TestFullFlow(t *testing.T) {
 					TickTime:  epoch.Add(10 * time.Second),
 					PrevTime:  epoch.Add(5 * time.Second),
 				},
+				Stats: JobStats{
+					Succeeded: []bool{true},
+					Durations: []int64{0},
+					Overruns:  []int64{0},
+				},
 			},
 		})
 	})
 }
 
+func TestRetries(t *testing.T) {
+	Convey("with a job with retry policy", t, func() {
+		c := newTestContext(epoch)
+		e, mgr := newTestEngine()
+		ds := datastore.Get(c)
+		tq := taskqueue.Get(c)
+
+		So(e.UpdateProjectJobs(c, "abc", []catalog.Definition{
+			{
+				JobID:    "abc/1",
+				Revision: "rev1",
+				Schedule: "with 1h interval",
+				Task:     noopTaskBytes(),
+				RetryPolicy: catalog.RetryPolicy{
+					MaxAttempts: 2,
+					Backoff:     30 * time.Second,
+					MaxBackoff:  30 * time.Second,
+				},
+			}}), ShouldBeNil)
+		tq.Testable().ResetTasks()
+
+		// Launch the invocation manually, it fails.
+		_, err := e.TriggerInvocation(c, "abc/1", testAdmin)
+		So(err, ShouldBeNil)
+		invTask := ensureOneTask(c, "invs-q")
+		tq.Testable().ResetTasks()
+		mgr.launchTask = func(ctl task.Controller) error {
+			ctl.State().Status = task.StatusFailed
+			return nil
+		}
+		So(e.ExecuteSerializedAction(c, invTask.Payload, 0), ShouldBeNil)
+
+		// The job is waiting for the retry now.
+		jobs := allJobs(c)
+		So(jobs[0].State.State, ShouldEqual, JobStateRetrying)
+		So(jobs[0].State.FailedAttempts, ShouldEqual, 1)
+		failedID := jobs[0].State.RetryOf
+		So(failedID, ShouldNotEqual, 0)
+		retryTask := ensureOneTask(c, "timers-q")
+		So(retryTask.ETA, ShouldResemble, epoch.Add(30*time.Second))
+		tq.Testable().ResetTasks()
+
+		// Retry time comes, new invocation is queued.
+		clock.Get(c).(testclock.TestClock).Add(30 * time.Second)
+		So(e.ExecuteSerializedAction(c, retryTask.Payload, 0), ShouldBeNil)
+		So(allJobs(c)[0].State.State, ShouldEqual, JobStateQueued)
+		invTask = ensureOneTask(c, "invs-q")
+		tq.Testable().ResetTasks()
+
+		// It fails again. Retry policy allows only 2 attempts, so the job just
+		// waits for the next tick now.
+		So(e.ExecuteSerializedAction(c, invTask.Payload, 0), ShouldBeNil)
+		jobs = allJobs(c)
+		So(jobs[0].State.State, ShouldEqual, JobStateScheduled)
+		So(jobs[0].State.FailedAttempts, ShouldEqual, 0)
+		So(jobs[0].State.RetryOf, ShouldEqual, 0)
+
+		// Both invocations are linked to each other.
+		ds.Testable().CatchupIndexes()
+		invs, _, err := e.ListInvocations(c, "abc/1", 100, "")
+		So(err, ShouldBeNil)
+		So(len(invs), ShouldEqual, 2)
+		retried, failed := invs[0], invs[1]
+		So(failed.ID, ShouldEqual, failedID)
+		So(failed.RetriedAs, ShouldEqual, retried.ID)
+		So(retried.RetryOf, ShouldEqual, failed.ID)
+		So(retried.Status, ShouldEqual, task.StatusFailed)
+	})
+}
+
+func TestJobTriggers(t *testing.T) {
+	Convey("with two jobs, one triggering another", t, func() {
+		c := newTestContext(epoch)
+		e, mgr := newTestEngine()
+		tq := taskqueue.Get(c)
+
+		So(e.UpdateProjectJobs(c, "abc", []catalog.Definition{
+			{
+				JobID:    "abc/up",
+				Revision: "rev1",
+				Schedule: "manual",
+				Task:     noopTaskBytes(),
+				Triggers: catalog.Triggers{
+					OnSuccess: []string{"abc/down"},
+				},
+			},
+			{
+				JobID:    "abc/down",
+				Revision: "rev1",
+				Schedule: "manual",
+				Task:     noopTaskBytes(),
+			},
+		}), ShouldBeNil)
+		tq.Testable().ResetTasks()
+
+		mgr.launchTask = func(ctl task.Controller) error {
+			ctl.State().Status = task.StatusSucceeded
+			return nil
+		}
+
+		// Run the upstream job, it succeeds.
+		_, err := e.TriggerInvocation(c, "abc/up", testAdmin)
+		So(err, ShouldBeNil)
+		invTask := ensureOneTask(c, "invs-q")
+		tq.Testable().ResetTasks()
+		So(e.ExecuteSerializedAction(c, invTask.Payload, 0), ShouldBeNil)
+
+		// Emitted the task to trigger the downstream job.
+		triggerTask := ensureOneTask(c, "invs-q")
+		payload := actionTaskPayload{}
+		So(json.Unmarshal(triggerTask.Payload, &payload), ShouldBeNil)
+		So(payload.Kind, ShouldEqual, "TriggerJobAction")
+		So(payload.JobID, ShouldEqual, "abc/down")
+		So(payload.UpstreamJobID, ShouldEqual, "abc/up")
+		upInvID := payload.UpstreamInvocationID
+		So(upInvID, ShouldNotEqual, 0)
+		tq.Testable().ResetTasks()
+
+		// The downstream job is queued.
+		So(e.ExecuteSerializedAction(c, triggerTask.Payload, 0), ShouldBeNil)
+		job, err := e.GetCronJob(c, "abc/down")
+		So(err, ShouldBeNil)
+		So(job.State.State, ShouldEqual, JobStateQueued)
+
+		// Its invocation knows what triggered it.
+		invTask = ensureOneTask(c, "invs-q")
+		tq.Testable().ResetTasks()
+		So(e.ExecuteSerializedAction(c, invTask.Payload, 0), ShouldBeNil)
+		datastore.Get(c).Testable().CatchupIndexes()
+		invs, _, err := e.ListInvocations(c, "abc/down", 100, "")
+		So(err, ShouldBeNil)
+		So(len(invs), ShouldEqual, 1)
+		So(invs[0].UpstreamJobID, ShouldEqual, "abc/up")
+		So(invs[0].UpstreamInvocationID, ShouldEqual, upInvID)
+		downInvID := invs[0].ID
+
+		// The upstream invocation knows what it triggered.
+		upInv, err := e.GetInvocation(c, "abc/up", upInvID)
+		So(err, ShouldBeNil)
+		So(upInv.TriggeredJobIDs, ShouldResemble, []string{"abc/down"})
+		So(upInv.TriggeredInvocationIDs, ShouldResemble, []int64{downInvID})
+
+		// The downstream job doesn't trigger anything.
+		ensureZeroTasks(c, "invs-q")
+	})
+}
+
 func TestGenerateInvocationID(t *testing.T) {
 	Convey("generateInvocationID does not collide", t, func() {
 		c := newTestContext(epoch)
@@ -518,6 +674,71 @@ func TestGenerateInvocationID(t *testing.T) {
 	})
 }
 
+func TestDeleteOldInvocations(t *testing.T) {
+	Convey("with some invocations", t, func() {
+		c := newTestContext(epoch)
+		e, _ := newTestEngine()
+		ds := datastore.Get(c)
+		tc := clock.Get(c).(testclock.TestClock)
+
+		jobKey := ds.NewKey("CronJob", "abc/1", 0, nil)
+		newInv := func() int64 {
+			id, err := generateInvocationID(c, jobKey)
+			So(err, ShouldBeNil)
+			So(ds.Put(&Invocation{ID: id, JobKey: jobKey, Started: clock.Now(c)}), ShouldBeNil)
+			return id
+		}
+
+		old := newInv()
+		tc.Add(time.Minute)
+		running := newInv()
+		tc.Add(time.Hour)
+		recent := newInv()
+
+		So(ds.Put(&CronJob{
+			JobID:     "abc/1",
+			ProjectID: "abc",
+			Enabled:   true,
+			State:     JobState{State: JobStateRunning, InvocationID: running},
+		}), ShouldBeNil)
+
+		remaining := func() []int64 {
+			ds.Testable().CatchupIndexes()
+			invs, _, err := e.ListInvocations(c, "abc/1", 100, "")
+			So(err, ShouldBeNil)
+			ids := []int64{}
+			for _, inv := range invs {
+				ids = append(ids, inv.ID)
+			}
+			return ids
+		}
+
+		Convey("keeps recent invocations", func() {
+			deleted, err := e.DeleteOldInvocations(c, "abc/1", 24*time.Hour)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldEqual, 0)
+			So(remaining(), ShouldResemble, []int64{recent, running, old})
+		})
+
+		Convey("deletes old invocations, except the running one", func() {
+			tc.Add(24 * time.Hour)
+			ds.Testable().CatchupIndexes()
+			deleted, err := e.DeleteOldInvocations(c, "abc/1", 24*time.Hour)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldEqual, 1)
+			So(remaining(), ShouldResemble, []int64{recent, running})
+		})
+
+		Convey("does nothing without retention period", func() {
+			tc.Add(48 * time.Hour)
+			ds.Testable().CatchupIndexes()
+			deleted, err := e.DeleteOldInvocations(c, "abc/1", 0)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldEqual, 0)
+		})
+	})
+}
+
 func TestQueries(t *testing.T) {
 	Convey("with mock data", t, func() {
 		c := newTestContext(epoch)
@@ -777,7 +998,7 @@ func TestAbortInvocation(t *testing.T) {
 				So(ctl.Save(), ShouldBeNil)
 				return nil
 			}
-			So(e.startInvocation(c, jobID, invNonce, "", 0), ShouldBeNil)
+			So(e.startInvocation(c, jobID, invNonce, invocationTrigger{}, 0), ShouldBeNil)
 
 			// It is alive and cron job entity tracks it.
 			inv, err := e.GetInvocation(c, jobID, invID)
@@ -788,8 +1009,11 @@ func TestAbortInvocation(t *testing.T) {
 			So(job.State.State, ShouldEqual, JobStateRunning)
 			So(job.State.InvocationID, ShouldEqual, invID)
 
+			// Only owners can kill it.
+			So(e.AbortInvocation(c, jobID, invID, "user:someone@example.com"), ShouldEqual, ErrNoPermission)
+
 			// Kill it.
-			So(e.AbortInvocation(c, jobID, invID, ""), ShouldBeNil)
+			So(e.AbortInvocation(c, jobID, invID, testAdmin), ShouldBeNil)
 
 			// It is dead.
 			inv, err = e.GetInvocation(c, jobID, invID)
@@ -805,13 +1029,56 @@ func TestAbortInvocation(t *testing.T) {
 	})
 }
 
+func TestJobACLs(t *testing.T) {
+	Convey("with a job with ACLs", t, func() {
+		c := newTestContext(epoch)
+		e, _ := newTestEngine()
+		ds := datastore.Get(c)
+
+		jobID := "abc/1"
+		So(ds.Put(&CronJob{
+			JobID:     jobID,
+			ProjectID: "abc",
+			Enabled:   true,
+			Schedule:  "*/5 * * * * * *",
+			State:     JobState{State: JobStateScheduled},
+			Acls: acl.GrantsByRole{
+				Triggerers: []string{"user:triggerer@example.com"},
+				Owners:     []string{"user:owner@example.com"},
+			},
+		}), ShouldBeNil)
+
+		Convey("TriggerInvocation checks ACLs", func() {
+			_, err := e.TriggerInvocation(c, jobID, "user:someone@example.com")
+			So(err, ShouldEqual, ErrNoPermission)
+			_, err = e.TriggerInvocation(c, jobID, "user:triggerer@example.com")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("PauseJob and ResumeJob check ACLs", func() {
+			So(e.PauseJob(c, jobID, "user:triggerer@example.com"), ShouldEqual, ErrNoPermission)
+			So(e.PauseJob(c, jobID, "user:owner@example.com"), ShouldBeNil)
+			So(e.ResumeJob(c, jobID, "user:triggerer@example.com"), ShouldEqual, ErrNoPermission)
+			So(e.ResumeJob(c, jobID, testAdmin), ShouldBeNil)
+		})
+	})
+}
+
 ////
 
+// testAdmin is an identity that is a member of acl.AdminGroup in contexts
+// produced by newTestContext.
+const testAdmin = identity.Identity("user:admin@example.com")
+
 func newTestContext(now time.Time) context.Context {
 	c := memory.Use(context.Background())
 	c = clock.Set(c, testclock.New(now))
 	c = mathrand.Set(c, rand.New(rand.NewSource(1000)))
 	c = testsecrets.Use(c)
+	c = auth.WithState(c, &authtest.FakeState{
+		Identity:       testAdmin,
+		IdentityGroups: []string{acl.AdminGroup},
+	})
 
 	ds := datastore.Get(c)
 	ds.Testable().AddIndexes(&datastore.IndexDefinition{
@@ -899,6 +1166,9 @@ func allJobs(c context.Context) []CronJob {
 		if ent.State.TickTime.IsZero() {
 			ent.State.TickTime = time.Time{}
 		}
+		if ent.State.RetryTime.IsZero() {
+			ent.State.RetryTime = time.Time{}
+		}
 	}
 	return entities
 }
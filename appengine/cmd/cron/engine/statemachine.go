@@ -11,7 +11,9 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/catalog"
 	"github.com/luci/luci-go/appengine/cmd/cron/schedule"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
 	"github.com/luci/luci-go/server/auth/identity"
 )
 
@@ -50,6 +52,11 @@ const (
 	// JobStateSlowQueue means the job's new invocation should have been started
 	// by now, but the previous one is still sitting in the start queue.
 	JobStateSlowQueue StateKind = "SLOW_QUEUE"
+
+	// JobStateRetrying means the job's previous invocation has failed and the
+	// job is waiting for a backoff delay to pass before retrying it (according
+	// to the job's retry policy).
+	JobStateRetrying StateKind = "RETRYING"
 )
 
 // JobState contains the current state of a job state machine.
@@ -81,6 +88,20 @@ type JobState struct {
 
 	// InvocationID is ID of currently running invocation or 0 if none is running.
 	InvocationID int64 `gae:",noindex"`
+
+	// FailedAttempts is how many attempts to run the current invocation have
+	// failed thus far. It is 0 unless the current invocation is a retry.
+	FailedAttempts int `gae:",noindex"`
+
+	// RetryOf is ID of the last failed invocation that is being retried now, or
+	// 0 if the current invocation is not a retry.
+	RetryOf int64 `gae:",noindex"`
+
+	// RetryNonce is id of the next expected OnRetryTick event.
+	RetryNonce int64 `gae:",noindex"`
+
+	// RetryTime is when the OnRetryTick event is expected.
+	RetryTime time.Time `gae:",noindex"`
 }
 
 // IsExpectingInvocation returns true if the state machine accepts
@@ -108,9 +129,14 @@ func (a TickLaterAction) IsAction() bool { return true }
 // StartInvocationAction enqueues invocation of the actual job.
 // OnInvocationDone(invocationNonce) will be called sometime later when the job
 // is done.
+//
+// If the invocation was triggered by another job, UpstreamJobID and
+// UpstreamInvocationID identify the invocation that triggered it.
 type StartInvocationAction struct {
-	InvocationNonce int64
-	TriggeredBy     identity.Identity
+	InvocationNonce      int64
+	TriggeredBy          identity.Identity
+	UpstreamJobID        string
+	UpstreamInvocationID int64
 }
 
 // IsAction makes StartInvocationAction implement Action interface.
@@ -128,6 +154,28 @@ type RecordOverrunAction struct {
 // IsAction makes RecordOverrunAction implement Action interface.
 func (a RecordOverrunAction) IsAction() bool { return true }
 
+// RetryLaterAction schedules an OnRetryTick(retryNonce) call at given moment
+// in time (or close to it). It is emitted when a failed invocation should be
+// retried after a backoff delay.
+type RetryLaterAction struct {
+	When       time.Time
+	RetryNonce int64
+}
+
+// IsAction makes RetryLaterAction implement Action interface.
+func (a RetryLaterAction) IsAction() bool { return true }
+
+// TriggerJobsAction instructs Engine to trigger given jobs, because invocation
+// InvocationID of the current job has finished. OnJobTriggered will be called
+// for each of the jobs sometime later.
+type TriggerJobsAction struct {
+	JobIDs       []string
+	InvocationID int64
+}
+
+// IsAction makes TriggerJobsAction implement Action interface.
+func (a TriggerJobsAction) IsAction() bool { return true }
+
 // StateMachine advances state of some single cron job. It performs a single
 // step only (one On* call). As input it takes the state of the job and state of
 // the world (the schedule is considered to be a part of the world state).
@@ -142,11 +190,16 @@ func (a RecordOverrunAction) IsAction() bool { return true }
 //
 // The lifecycle of a healthy cron job:
 // DISABLED -> SCHEDULED -> QUEUED -> QUEUED (starting) -> RUNNING -> SCHEDULED
+//
+// If the job has a retry policy, failed invocations are retried:
+// RUNNING -> RETRYING -> QUEUED -> ... -> RUNNING -> SCHEDULED
 type StateMachine struct {
 	// Inputs.
-	Now      time.Time          // current time
-	Schedule *schedule.Schedule // knows when to run the job next time
-	Nonce    func() int64       // produces a series of nonces on demand
+	Now         time.Time           // current time
+	Schedule    *schedule.Schedule  // knows when to run the job next time
+	RetryPolicy catalog.RetryPolicy // knows when to retry failed invocations
+	Triggers    catalog.Triggers    // jobs to trigger when invocations finish
+	Nonce       func() int64        // produces a series of nonces on demand
 
 	// Mutated.
 	State   JobState // state of the job, mutated in On* methods
@@ -204,30 +257,49 @@ func (m *StateMachine) OnTimerTick(tickNonce int64) error {
 	}
 
 	// Was waiting for a tick to start a job? Add invocation to the queue.
-	if m.State.State == JobStateScheduled {
+	//
+	// If the job was waiting to retry a failed invocation, give up on the retry
+	// and start a new invocation instead: nothing is running currently, so it is
+	// not an overrun, and the new invocation supersedes the retry anyway.
+	if m.State.State == JobStateScheduled || m.State.State == JobStateRetrying {
 		m.State.State = JobStateQueued
-		m.queueInvocation("")
+		m.resetRetry()
+		m.queueInvocation(StartInvocationAction{})
 		return nil
 	}
 
 	// Already running a job (or have one in the queue) and it's time to launch
-	// a new invocation? Skip this tick completely.
+	// a new invocation? Skip this tick completely. This also applies to retries
+	// of failed invocations that are queued or running.
 	//
 	// TODO(vadimsh): Make overrun policy configurable. Also handle permanently
 	// stuck jobs.
+	m.recordOverrun()
+	return nil
+}
+
+// OnJobTriggered happens when an invocation of some upstream job finishes and
+// the upstream job is configured to trigger this one.
+//
+// Works similar to OnManualInvocation, except if the job is already running or
+// queued, the trigger is skipped and recorded as an overrun.
+func (m *StateMachine) OnJobTriggered(upstreamJobID string, upstreamInvocationID int64) error {
 	switch m.State.State {
-	case JobStateRunning, JobStateOverrun:
-		m.State.State = JobStateOverrun
-	case JobStateQueued, JobStateSlowQueue:
-		m.State.State = JobStateSlowQueue
+	case JobStateDisabled:
+		return nil
+	case JobStateScheduled, JobStateSuspended, JobStateRetrying:
+		m.State.State = JobStateQueued
+		m.resetRetry()
+		m.queueInvocation(StartInvocationAction{
+			UpstreamJobID:        upstreamJobID,
+			UpstreamInvocationID: upstreamInvocationID,
+		})
+		if !m.Schedule.IsAbsolute() {
+			m.resetTick() // will be set again when invocation ends
+		}
 	default:
-		impossible("impossible state %s", m.State.State)
+		m.recordOverrun()
 	}
-	m.State.Overruns++
-	m.emitAction(RecordOverrunAction{
-		Overruns:            m.State.Overruns,
-		RunningInvocationID: m.State.InvocationID,
-	})
 	return nil
 }
 
@@ -257,24 +329,75 @@ func (m *StateMachine) OnInvocationStarted(invocationID int64) error {
 	return nil
 }
 
-// OnInvocationDone happens when invocation completes.
-func (m *StateMachine) OnInvocationDone(invocationID int64) error {
-	// Ignore unexpected events. Can happen if job was moved to disabled state
-	// while invocation was still running.
-	if m.State.State != JobStateRunning && m.State.State != JobStateOverrun {
+// OnInvocationDone happens when invocation completes with the given final
+// status (and it won't be retried). Emits TriggerJobsAction if the job has
+// triggers matching the status.
+func (m *StateMachine) OnInvocationDone(invocationID int64, status task.Status) error {
+	if !m.isCurrentInvocation(invocationID) {
 		return nil
 	}
-	if m.State.InvocationID != invocationID {
-		return nil
+	if jobIDs := m.Triggers.ForStatus(status); len(jobIDs) != 0 {
+		m.emitAction(TriggerJobsAction{
+			JobIDs:       jobIDs,
+			InvocationID: invocationID,
+		})
 	}
 	m.State.State = JobStateScheduled
 	m.State.PrevTime = m.Now
 	m.resetInvocation()      // forget about just finished invocation
+	m.resetRetry()           // and about all previous failed attempts
 	m.scheduleTick()         // start waiting for a new one
 	m.maybeSuspendOrResume() // switch back to suspended state if necessary
 	return nil
 }
 
+// OnInvocationFailed happens when invocation completes with a failure.
+//
+// If the retry policy allows, the job switches to RETRYING state and the
+// invocation is retried after a delay (see OnRetryTick). Otherwise it is
+// treated as any other completed invocation (see OnInvocationDone).
+func (m *StateMachine) OnInvocationFailed(invocationID int64) error {
+	if !m.isCurrentInvocation(invocationID) {
+		return nil
+	}
+	failed := m.State.FailedAttempts + 1
+	if !m.RetryPolicy.ShouldRetry(failed) {
+		return m.OnInvocationDone(invocationID, task.StatusFailed)
+	}
+	m.State.State = JobStateRetrying
+	m.resetInvocation()
+	m.State.FailedAttempts = failed
+	m.State.RetryOf = invocationID
+	m.State.RetryTime = m.Now.Add(m.RetryPolicy.Delay(failed))
+	m.State.RetryNonce = m.Nonce()
+	m.emitAction(RetryLaterAction{
+		When:       m.State.RetryTime,
+		RetryNonce: m.State.RetryNonce,
+	})
+	return nil
+}
+
+// OnRetryTick happens when the retry timer (added with RetryLaterAction)
+// ticks. It queues a new attempt to run the failed invocation.
+func (m *StateMachine) OnRetryTick(retryNonce int64) error {
+	// Skip unexpected, late or canceled retries.
+	if m.State.State != JobStateRetrying || m.State.RetryNonce != retryNonce {
+		return nil
+	}
+
+	// Report error (to trigger retry) if the tick happened unexpectedly soon.
+	delay := m.Now.Sub(m.State.RetryTime)
+	if delay < 0 {
+		return fmt.Errorf("retry tick happened %.1f sec before it was expected", -delay.Seconds())
+	}
+
+	m.State.State = JobStateQueued
+	m.State.RetryNonce = 0
+	m.State.RetryTime = time.Time{}
+	m.queueInvocation(StartInvocationAction{})
+	return nil
+}
+
 // OnScheduleChange happens when job's schedule changes (and the job potentially
 // needs to be rescheduled).
 func (m *StateMachine) OnScheduleChange() error {
@@ -316,13 +439,18 @@ func (m *StateMachine) OnScheduleChange() error {
 
 // OnManualInvocation happens when user starts invocation via "Run now" button.
 // Manual invocation only works if the job is currently not running or not
-// queued for run (i.e. it is in Scheduled state waiting for a timer tick).
+// queued for run (i.e. it is in Scheduled state waiting for a timer tick, or in
+// Retrying state waiting to retry a failed invocation, in which case the retry
+// is canceled).
 func (m *StateMachine) OnManualInvocation(triggeredBy identity.Identity) error {
-	if m.State.State != JobStateScheduled && m.State.State != JobStateSuspended {
+	switch m.State.State {
+	case JobStateScheduled, JobStateSuspended, JobStateRetrying:
+	default:
 		return errors.New("the job is already running or about to start")
 	}
 	m.State.State = JobStateQueued
-	m.queueInvocation(triggeredBy)
+	m.resetRetry()
+	m.queueInvocation(StartInvocationAction{TriggeredBy: triggeredBy})
 	if !m.Schedule.IsAbsolute() {
 		m.resetTick() // will be set again when invocation ends
 	}
@@ -366,18 +494,56 @@ func (m *StateMachine) resetTick() {
 }
 
 // queueInvocation generates a new invocation nonce and asks engine to start
-// a new invocation.
-func (m *StateMachine) queueInvocation(triggeredBy identity.Identity) {
+// a new invocation by emitting given StartInvocationAction (with the nonce
+// filled in).
+func (m *StateMachine) queueInvocation(a StartInvocationAction) {
 	m.State.InvocationTime = m.Now
 	m.State.InvocationNonce = m.Nonce()
 	m.State.InvocationID = 0
 	m.State.Overruns = 0
-	m.emitAction(StartInvocationAction{
-		InvocationNonce: m.State.InvocationNonce,
-		TriggeredBy:     triggeredBy,
+	a.InvocationNonce = m.State.InvocationNonce
+	m.emitAction(a)
+}
+
+// recordOverrun is called when a new invocation should be started, but the
+// previous one is still running or queued. It bumps the overruns counter and
+// emits RecordOverrunAction.
+func (m *StateMachine) recordOverrun() {
+	switch m.State.State {
+	case JobStateRunning, JobStateOverrun:
+		m.State.State = JobStateOverrun
+	case JobStateQueued, JobStateSlowQueue:
+		m.State.State = JobStateSlowQueue
+	default:
+		impossible("impossible state %s", m.State.State)
+	}
+	m.State.Overruns++
+	m.emitAction(RecordOverrunAction{
+		Overruns:            m.State.Overruns,
+		RunningInvocationID: m.State.InvocationID,
 	})
 }
 
+// isCurrentInvocation returns true if the given invocation is the one that
+// is currently running.
+//
+// Unexpected invocations can show up if job was moved to disabled state while
+// invocation was still running.
+func (m *StateMachine) isCurrentInvocation(invocationID int64) bool {
+	if m.State.State != JobStateRunning && m.State.State != JobStateOverrun {
+		return false
+	}
+	return m.State.InvocationID == invocationID
+}
+
+// resetRetry clears retry related part of the state.
+func (m *StateMachine) resetRetry() {
+	m.State.FailedAttempts = 0
+	m.State.RetryOf = 0
+	m.State.RetryNonce = 0
+	m.State.RetryTime = time.Time{}
+}
+
 // resetInvocation clears invocation related part of the state.
 func (m *StateMachine) resetInvocation() {
 	m.State.InvocationNonce = 0
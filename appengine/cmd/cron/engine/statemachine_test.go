@@ -8,7 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/catalog"
 	"github.com/luci/luci-go/appengine/cmd/cron/schedule"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -25,7 +27,7 @@ func TestStateMachine(t *testing.T) {
 		So(m.state.State, ShouldEqual, JobStateDisabled)
 		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(1) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateDisabled)
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateDisabled)
 		So(m.roll(func(sm *StateMachine) error { return sm.OnScheduleChange() }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateDisabled)
@@ -82,12 +84,12 @@ func TestStateMachine(t *testing.T) {
 		So(m.state.State, ShouldEqual, JobStateRunning)
 
 		// Skip wrong invocation ID.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateRunning)
 
 		// End of the cycle. Ends up in scheduled state, waiting for the tick added
 		// when StartInvocationAction was issued.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 		So(m.state.TickNonce, ShouldEqual, 2)
 
@@ -136,7 +138,7 @@ func TestStateMachine(t *testing.T) {
 		So(m.state.Overruns, ShouldEqual, 1)
 
 		// End of the cycle.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 	})
 
@@ -178,7 +180,7 @@ func TestStateMachine(t *testing.T) {
 		m.actions = nil
 
 		// End of the cycle.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 	})
 
@@ -216,7 +218,7 @@ func TestStateMachine(t *testing.T) {
 		m.now = epoch.Add(20 * time.Second)
 
 		// End of the cycle. New tick is scheduled, 10s from current time.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 		So(m.state.TickNonce, ShouldEqual, 3)
 		So(m.state.TickTime, ShouldResemble, m.now.Add(10*time.Second))
@@ -349,14 +351,328 @@ func TestStateMachine(t *testing.T) {
 		err := m.roll(func(sm *StateMachine) error { return sm.OnManualInvocation("user:abc") })
 		So(err, ShouldNotBeNil)
 	})
+
+	Convey("Failure without retry policy", t, func() {
+		m := newTestStateMachine("with 60s interval")
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		m.now = m.state.TickTime
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(2, 100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+		m.actions = nil
+
+		// Just waits for the next tick.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{m.now.Add(60 * time.Second), 3},
+		})
+	})
+
+	Convey("Failure with retry policy", t, func() {
+		m := newTestStateMachine("with 60s interval")
+		m.retryPolicy = catalog.RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     10 * time.Second,
+			MaxBackoff:  15 * time.Second,
+		}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		m.now = m.state.TickTime
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(2, 100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+		m.actions = nil
+
+		// Wrong invocation ID is skipped.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(1001) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRunning)
+
+		// First failure schedules a retry.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRetrying)
+		So(m.state.FailedAttempts, ShouldEqual, 1)
+		So(m.state.RetryOf, ShouldEqual, 100)
+		So(m.state.InvocationID, ShouldEqual, 0)
+		So(m.actions, ShouldResemble, []Action{
+			RetryLaterAction{m.now.Add(10 * time.Second), 3},
+		})
+		m.actions = nil
+
+		// Wrong retry nonce is skipped.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(333) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRetrying)
+		So(m.actions, ShouldBeNil)
+
+		// Early retry tick causes retry.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(3) }), ShouldNotBeNil)
+		So(m.state.State, ShouldEqual, JobStateRetrying)
+
+		// Retry tick on time queues a new invocation.
+		m.now = m.now.Add(10 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(3) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.RetryOf, ShouldEqual, 100)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{InvocationNonce: 4},
+		})
+		m.actions = nil
+
+		// Second failure, the backoff delay grows (but it is capped).
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(4, 101) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(101) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(101) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRetrying)
+		So(m.state.FailedAttempts, ShouldEqual, 2)
+		So(m.state.RetryOf, ShouldEqual, 101)
+		So(m.actions, ShouldResemble, []Action{
+			RetryLaterAction{m.now.Add(15 * time.Second), 5},
+		})
+		m.actions = nil
+
+		// Last attempt fails too. Gives up and waits for the next tick.
+		m.now = m.now.Add(15 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(5) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(6, 102) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(102) }), ShouldBeNil)
+		m.actions = nil
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(102) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.FailedAttempts, ShouldEqual, 0)
+		So(m.state.RetryOf, ShouldEqual, 0)
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{m.now.Add(60 * time.Second), 7},
+		})
+	})
+
+	Convey("Successful retry resets retry state", t, func() {
+		m := newTestStateMachine("with 60s interval")
+		m.retryPolicy = catalog.RetryPolicy{MaxAttempts: 3}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		m.now = m.state.TickTime
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(2, 100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(3) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(4, 101) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(101) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(101, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.FailedAttempts, ShouldEqual, 0)
+		So(m.state.RetryOf, ShouldEqual, 0)
+	})
+
+	Convey("Tick while waiting for retry", t, func() {
+		m := newTestStateMachine("*/5 * * * * * *")
+		m.retryPolicy = catalog.RetryPolicy{
+			MaxAttempts: 2,
+			Backoff:     30 * time.Second,
+			MaxBackoff:  30 * time.Second,
+		}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		m.now = m.now.Add(5 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(3, 100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRetrying)
+		m.actions = nil
+
+		// Regular tick cancels the retry and starts a new invocation. It is not an
+		// overrun.
+		m.now = m.now.Add(5 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(2) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.Overruns, ShouldEqual, 0)
+		So(m.state.FailedAttempts, ShouldEqual, 0)
+		So(m.state.RetryOf, ShouldEqual, 0)
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{epoch.Add(15 * time.Second), 5},
+			StartInvocationAction{InvocationNonce: 6},
+		})
+		m.actions = nil
+
+		// Canceled retry tick is skipped.
+		m.now = m.now.Add(30 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(4) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldBeNil)
+	})
+
+	Convey("Overrun while retrying", t, func() {
+		m := newTestStateMachine("*/5 * * * * * *")
+		m.retryPolicy = catalog.RetryPolicy{MaxAttempts: 2}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		m.now = m.now.Add(5 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(3, 100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(4) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(5, 101) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(101) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRunning)
+		m.actions = nil
+
+		// The retry is running when the next tick arrives. It's an overrun.
+		m.now = m.now.Add(5 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(2) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateOverrun)
+		So(m.state.RetryOf, ShouldEqual, 100)
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{epoch.Add(15 * time.Second), 6},
+			RecordOverrunAction{Overruns: 1, RunningInvocationID: 101},
+		})
+	})
+
+	Convey("OnManualInvocation cancels pending retry", t, func() {
+		m := newTestStateMachine("with 60s interval")
+		m.retryPolicy = catalog.RetryPolicy{MaxAttempts: 2}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		m.now = m.state.TickTime
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(2, 100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRetrying)
+		m.actions = nil
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnManualInvocation("user:abc") }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.RetryNonce, ShouldEqual, 0)
+		So(m.state.RetryOf, ShouldEqual, 0)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 4,
+				TriggeredBy:     "user:abc",
+			},
+		})
+	})
+}
+
+func TestTriggers(t *testing.T) {
+	Convey("Finished invocation triggers jobs", t, func() {
+		m := newTestStateMachine("with 60s interval")
+		m.triggers = catalog.Triggers{
+			OnSuccess: []string{"proj/on-success"},
+			OnFailure: []string{"proj/on-failure"},
+			Always:    []string{"proj/always"},
+		}
+
+		run := func(status task.Status) {
+			m.actions = nil
+			m.now = m.state.TickTime
+			So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(m.state.TickNonce) }), ShouldBeNil)
+			nonce := m.state.InvocationNonce
+			So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(nonce, 100) }), ShouldBeNil)
+			So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+			m.actions = nil
+			if status == task.StatusFailed {
+				So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+			} else {
+				So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100, status) }), ShouldBeNil)
+			}
+			So(m.state.State, ShouldEqual, JobStateScheduled)
+		}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+
+		run(task.StatusSucceeded)
+		So(m.actions[0], ShouldResemble, TriggerJobsAction{
+			JobIDs:       []string{"proj/always", "proj/on-success"},
+			InvocationID: 100,
+		})
+
+		run(task.StatusFailed)
+		So(m.actions[0], ShouldResemble, TriggerJobsAction{
+			JobIDs:       []string{"proj/always", "proj/on-failure"},
+			InvocationID: 100,
+		})
+
+		run(task.StatusAborted)
+		So(m.actions[0], ShouldResemble, TriggerJobsAction{
+			JobIDs:       []string{"proj/always"},
+			InvocationID: 100,
+		})
+	})
+
+	Convey("Failed invocation triggers jobs only after last retry", t, func() {
+		m := newTestStateMachine("with 60s interval")
+		m.retryPolicy = catalog.RetryPolicy{MaxAttempts: 2}
+		m.triggers = catalog.Triggers{OnFailure: []string{"proj/on-failure"}}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		m.now = m.state.TickTime
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(2, 100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(100) }), ShouldBeNil)
+		m.actions = nil
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(100) }), ShouldBeNil)
+		So(m.actions, ShouldResemble, []Action{
+			RetryLaterAction{m.now, 3},
+		})
+		So(m.roll(func(sm *StateMachine) error { return sm.OnRetryTick(3) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(4, 101) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(101) }), ShouldBeNil)
+		m.actions = nil
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationFailed(101) }), ShouldBeNil)
+		So(m.actions, ShouldResemble, []Action{
+			TriggerJobsAction{
+				JobIDs:       []string{"proj/on-failure"},
+				InvocationID: 101,
+			},
+			TickLaterAction{m.now.Add(60 * time.Second), 5},
+		})
+	})
+
+	Convey("OnJobTriggered works", t, func() {
+		m := newTestStateMachine("manual")
+
+		// Noop when in disabled state.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobTriggered("proj/up", 1) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateDisabled)
+		So(m.actions, ShouldBeNil)
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+
+		// Starts an invocation.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobTriggered("proj/up", 1) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce:      2,
+				UpstreamJobID:        "proj/up",
+				UpstreamInvocationID: 1,
+			},
+		})
+		m.actions = nil
+
+		// Triggering a queued job is an overrun.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobTriggered("proj/up", 2) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateSlowQueue)
+		So(m.actions, ShouldResemble, []Action{
+			RecordOverrunAction{Overruns: 1},
+		})
+	})
 }
 
 type testStateMachine struct {
-	state    JobState
-	now      time.Time
-	nonce    int64
-	schedule *schedule.Schedule
-	actions  []Action
+	state       JobState
+	now         time.Time
+	nonce       int64
+	schedule    *schedule.Schedule
+	retryPolicy catalog.RetryPolicy
+	triggers    catalog.Triggers
+	actions     []Action
 }
 
 func newTestStateMachine(scheduleExpr string) *testStateMachine {
@@ -374,9 +690,11 @@ func newTestStateMachine(scheduleExpr string) *testStateMachine {
 func (t *testStateMachine) roll(cb func(sm *StateMachine) error) error {
 	nonce := t.nonce
 	sm := StateMachine{
-		State:    t.state,
-		Now:      t.now,
-		Schedule: t.schedule,
+		State:       t.state,
+		Now:         t.now,
+		Schedule:    t.schedule,
+		RetryPolicy: t.retryPolicy,
+		Triggers:    t.triggers,
 		Nonce: func() int64 {
 			nonce++
 			return nonce
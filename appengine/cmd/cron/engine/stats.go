@@ -0,0 +1,159 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/tsmon/distribution"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/metric"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+)
+
+// statsWindow is how many most recent finished invocations JobStats keeps.
+const statsWindow = 100
+
+var (
+	metricInvocationsFinished = metric.NewCounter(
+		"cron/invocations/finished",
+		"Number of finished invocations.",
+		field.String("job_id"),
+		field.String("status"))
+	metricInvocationDuration = metric.NewCumulativeDistribution(
+		"cron/invocations/duration_ms",
+		"Duration of finished invocations, in milliseconds.",
+		distribution.DefaultBucketer,
+		field.String("job_id"))
+	metricJobSuccessRate = metric.NewFloat(
+		"cron/job/success_rate",
+		"Fraction of recent invocations of a job that have succeeded.",
+		field.String("job_id"))
+	metricJobOverruns = metric.NewInt(
+		"cron/job/overruns",
+		"Number of overruns during recent invocations of a job.",
+		field.String("job_id"))
+)
+
+// JobStats holds outcomes of the most recent finished invocations of a job.
+//
+// All slices have the same length (at most statsWindow) and are ordered from
+// the oldest invocation to the newest one.
+type JobStats struct {
+	// Succeeded is true for invocations that have succeeded.
+	Succeeded []bool `gae:",noindex"`
+
+	// Durations are durations of invocations, in milliseconds.
+	Durations []int64 `gae:",noindex"`
+
+	// Overruns is how many times each invocation overran.
+	Overruns []int64 `gae:",noindex"`
+}
+
+// StatsSummary is computed from JobStats by Summary.
+type StatsSummary struct {
+	Count       int           // number of invocations the summary is based on
+	SuccessRate float64       // fraction of successful invocations, in [0, 1]
+	P50         time.Duration // median invocation duration
+	P95         time.Duration // 95th percentile of invocation duration
+	Overruns    int           // total number of overruns
+}
+
+// Equal returns true if 's' and 'other' hold the same outcomes.
+func (s *JobStats) Equal(other *JobStats) bool {
+	if len(s.Succeeded) != len(other.Succeeded) ||
+		len(s.Durations) != len(other.Durations) ||
+		len(s.Overruns) != len(other.Overruns) {
+		return false
+	}
+	for i := range s.Succeeded {
+		if s.Succeeded[i] != other.Succeeded[i] {
+			return false
+		}
+	}
+	for i := range s.Durations {
+		if s.Durations[i] != other.Durations[i] {
+			return false
+		}
+	}
+	for i := range s.Overruns {
+		if s.Overruns[i] != other.Overruns[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Summary computes statistics of the recorded invocations.
+func (s *JobStats) Summary() StatsSummary {
+	count := len(s.Succeeded)
+	if count == 0 || len(s.Durations) != count || len(s.Overruns) != count {
+		return StatsSummary{}
+	}
+	out := StatsSummary{Count: count}
+	succeeded := 0
+	for i := 0; i < count; i++ {
+		if s.Succeeded[i] {
+			succeeded++
+		}
+		out.Overruns += int(s.Overruns[i])
+	}
+	out.SuccessRate = float64(succeeded) / float64(count)
+
+	durations := make([]int, count)
+	for i, d := range s.Durations {
+		durations[i] = int(d)
+	}
+	sort.Ints(durations)
+	percentile := func(p float64) time.Duration {
+		idx := int(math.Ceil(p*float64(count))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return time.Duration(durations[idx]) * time.Millisecond
+	}
+	out.P50 = percentile(0.50)
+	out.P95 = percentile(0.95)
+	return out
+}
+
+// record appends an outcome of a finished invocation, evicting the oldest one
+// if there are more than statsWindow of them.
+func (s *JobStats) record(succeeded bool, duration time.Duration, overruns int) {
+	// Throw away inconsistent data, if any.
+	if len(s.Durations) != len(s.Succeeded) || len(s.Overruns) != len(s.Succeeded) {
+		*s = JobStats{}
+	}
+	s.Succeeded = append(s.Succeeded, succeeded)
+	s.Durations = append(s.Durations, int64(duration/time.Millisecond))
+	s.Overruns = append(s.Overruns, int64(overruns))
+	if extra := len(s.Succeeded) - statsWindow; extra > 0 {
+		s.Succeeded = append([]bool(nil), s.Succeeded[extra:]...)
+		s.Durations = append([]int64(nil), s.Durations[extra:]...)
+		s.Overruns = append([]int64(nil), s.Overruns[extra:]...)
+	}
+}
+
+// reportInvocationMetrics sends metrics about a finished invocation to tsmon.
+func reportInvocationMetrics(c context.Context, jobID string, status task.Status, duration time.Duration, stats *JobStats) {
+	report := func(err error) {
+		if err != nil {
+			logging.Warningf(c, "Failed to report metrics - %s", err)
+		}
+	}
+	report(metricInvocationsFinished.Add(c, 1, jobID, string(status)))
+	report(metricInvocationDuration.Add(c, float64(duration/time.Millisecond), jobID))
+	if stats != nil {
+		summary := stats.Summary()
+		report(metricJobSuccessRate.Set(c, summary.SuccessRate, jobID))
+		report(metricJobOverruns.Set(c, int64(summary.Overruns), jobID))
+	}
+}
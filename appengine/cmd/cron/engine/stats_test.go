@@ -0,0 +1,56 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestJobStats(t *testing.T) {
+	Convey("empty stats", t, func() {
+		s := JobStats{}
+		So(s.Summary(), ShouldResemble, StatsSummary{})
+	})
+
+	Convey("summary works", t, func() {
+		s := JobStats{}
+		for i := 1; i <= 20; i++ {
+			s.record(i%4 != 0, time.Duration(i)*time.Second, i%10/9)
+		}
+		So(s.Summary(), ShouldResemble, StatsSummary{
+			Count:       20,
+			SuccessRate: 0.75,
+			P50:         10 * time.Second,
+			P95:         19 * time.Second,
+			Overruns:    2,
+		})
+	})
+
+	Convey("keeps only recent invocations", t, func() {
+		s := JobStats{}
+		for i := 0; i < statsWindow+10; i++ {
+			s.record(i >= 10, time.Second, 0)
+		}
+		So(len(s.Succeeded), ShouldEqual, statsWindow)
+		So(len(s.Durations), ShouldEqual, statsWindow)
+		So(len(s.Overruns), ShouldEqual, statsWindow)
+		So(s.Summary().SuccessRate, ShouldEqual, 1.0)
+	})
+
+	Convey("Equal works", t, func() {
+		a := JobStats{}
+		b := JobStats{}
+		So(a.Equal(&b), ShouldBeTrue)
+		a.record(true, time.Second, 0)
+		So(a.Equal(&b), ShouldBeFalse)
+		b.record(true, time.Second, 0)
+		So(a.Equal(&b), ShouldBeTrue)
+		b.record(false, time.Second, 0)
+		So(a.Equal(&b), ShouldBeFalse)
+	})
+}
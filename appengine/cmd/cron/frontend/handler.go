@@ -32,22 +32,28 @@ import (
 	"github.com/luci/gae/service/taskqueue"
 
 	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/discovery"
 	"github.com/luci/luci-go/server/middleware"
+	"github.com/luci/luci-go/server/prpc"
 
 	"github.com/luci/luci-go/appengine/gaeauth/server"
 	"github.com/luci/luci-go/appengine/gaeconfig"
 	"github.com/luci/luci-go/appengine/gaemiddleware"
 
+	"github.com/luci/luci-go/common/api/cron/v1"
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/config/impl/memory"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/apiservers"
 	"github.com/luci/luci-go/appengine/cmd/cron/catalog"
 	"github.com/luci/luci-go/appengine/cmd/cron/engine"
 	"github.com/luci/luci-go/appengine/cmd/cron/task"
 	"github.com/luci/luci-go/appengine/cmd/cron/task/buildbucket"
 	"github.com/luci/luci-go/appengine/cmd/cron/task/noop"
+	prpctask "github.com/luci/luci-go/appengine/cmd/cron/task/prpc"
+	"github.com/luci/luci-go/appengine/cmd/cron/task/pubsub"
 	"github.com/luci/luci-go/appengine/cmd/cron/task/swarming"
 	"github.com/luci/luci-go/appengine/cmd/cron/task/urlfetch"
 	"github.com/luci/luci-go/appengine/cmd/cron/ui"
@@ -63,6 +69,8 @@ var (
 	managers = []task.Manager{
 		&buildbucket.TaskManager{},
 		&noop.TaskManager{},
+		&prpctask.TaskManager{},
+		&pubsub.TaskManager{},
 		&swarming.TaskManager{},
 		&urlfetch.TaskManager{},
 	}
@@ -203,11 +211,25 @@ func init() {
 		TemplatesPath: "templates",
 	})
 
+	// Setup pRPC API. It uses same auth and ACL checks as the UI, except cookies
+	// are not accepted.
+	api := prpc.Server{
+		Authenticator: auth.Authenticator{
+			&server.OAuth2Method{Scopes: []string{server.EmailScope}},
+			&server.InboundAppIDAuthMethod{},
+		},
+	}
+	cron.RegisterCronServer(&api, &apiservers.CronServer{Engine: globalEngine})
+	discovery.Enable(&api)
+	api.InstallHandlers(router, base)
+
 	router.GET("/_ah/warmup", base(wrap(warmupHandler)))
 	router.GET("/_ah/start", base(wrap(warmupHandler)))
 	router.POST("/pubsub", base(wrap(pubsubPushHandler)))
 	router.GET("/internal/cron/read-config", cronHandler(readConfigCron))
 	router.POST("/internal/tasks/read-project-config", taskQueueHandler("read-project-config", readProjectConfigTask))
+	router.GET("/internal/cron/cleanup-invocations", cronHandler(cleanupInvocationsCron))
+	router.POST("/internal/tasks/cleanup-invocations", taskQueueHandler("cleanup-invocations", cleanupInvocationsTask))
 	router.POST("/internal/tasks/timers", taskQueueHandler("timers", actionTask))
 	router.POST("/internal/tasks/invocations", taskQueueHandler("invocations", actionTask))
 
@@ -324,6 +346,49 @@ func readProjectConfigTask(c *requestContext) {
 	c.ok()
 }
 
+// cleanupInvocationsCron dispatches task queue tasks to delete old invocations
+// of each enabled cron job. Invocations of disabled jobs are kept for audit
+// purposes.
+func cleanupInvocationsCron(c *requestContext) {
+	jobs, err := globalEngine.GetAllCronJobs(c.Context)
+	if err != nil {
+		c.err(err, "Failed to grab a list of cron jobs from datastore")
+		return
+	}
+	tasks := make([]*taskqueue.Task, 0, len(jobs))
+	for _, job := range jobs {
+		tasks = append(tasks, &taskqueue.Task{
+			Path: "/internal/tasks/cleanup-invocations?jobID=" + url.QueryEscape(job.JobID),
+		})
+	}
+	tq := taskqueue.Get(c)
+	if err = tq.AddMulti(tasks, "cleanup-invocations"); err != nil {
+		c.err(errors.WrapTransient(err), "Failed to add tasks to task queue")
+	} else {
+		c.ok()
+	}
+}
+
+// cleanupInvocationsTask deletes old invocations of a single cron job.
+func cleanupInvocationsTask(c *requestContext) {
+	jobID := c.r.URL.Query().Get("jobID")
+	if jobID == "" {
+		// Return 202 to avoid retry, it is fatal error.
+		c.fail(202, "Missing jobID query attribute")
+		return
+	}
+	cfg, err := getSettings(c.Context)
+	if err != nil {
+		c.err(err, "Failed to fetch cron settings")
+		return
+	}
+	if _, err := globalEngine.DeleteOldInvocations(c.Context, jobID, cfg.invocationRetention()); err != nil {
+		c.err(err, "Failed to delete old invocations")
+		return
+	}
+	c.ok()
+}
+
 // actionTask is used to route actions emitted by cron job state transitions
 // back into Engine (see enqueueActions).
 func actionTask(c *requestContext) {
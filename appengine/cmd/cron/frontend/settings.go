@@ -0,0 +1,124 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package frontend
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/settings"
+)
+
+// settingsKey is the key of cron service settings in the settings store.
+const settingsKey = "cron"
+
+// cronSettings is the set of tweakable things for the cron service.
+//
+// The JSON annotations are for settings module storage.
+type cronSettings struct {
+	// InvocationRetentionDays is how many days to keep finished invocations
+	// for. Zero means to keep them forever.
+	InvocationRetentionDays uint32 `json:"invocationRetentionDays"`
+}
+
+var defaultSettings = cronSettings{
+	InvocationRetentionDays: 90,
+}
+
+// invocationRetention returns how long to keep invocations for.
+func (s *cronSettings) invocationRetention() time.Duration {
+	return time.Duration(s.InvocationRetentionDays) * 24 * time.Hour
+}
+
+// getSettings returns the current cronSettings, or defaultSettings if there
+// are none.
+func getSettings(c context.Context) (*cronSettings, error) {
+	s := cronSettings{}
+	switch err := settings.Get(c, settingsKey, &s); err {
+	case nil:
+		break
+	case settings.ErrNoSettings:
+		s = defaultSettings
+	default:
+		return nil, errors.WrapTransient(fmt.Errorf("could not fetch cron settings - %s", err))
+	}
+	return &s, nil
+}
+
+// settingsUIPage is a UI page to configure the cron service settings.
+type settingsUIPage struct {
+	settings.BaseUIPage
+}
+
+func (settingsUIPage) Title(c context.Context) (string, error) {
+	return "Cron settings", nil
+}
+
+func (settingsUIPage) Overview(c context.Context) (template.HTML, error) {
+	return template.HTML(`<p>Configuration parameters for the
+<a href="https://github.com/luci/luci-go/tree/master/appengine/cmd/cron">cron
+service</a>.</p>`), nil
+}
+
+func (settingsUIPage) Fields(c context.Context) ([]settings.UIField, error) {
+	return []settings.UIField{
+		{
+			ID:          "InvocationRetentionDays",
+			Title:       "Number of days to keep invocations for (0 to keep forever)",
+			Type:        settings.UIFieldText,
+			Placeholder: strconv.FormatUint(uint64(defaultSettings.InvocationRetentionDays), 10),
+			Validator:   validateUint32,
+		},
+	}, nil
+}
+
+func (settingsUIPage) ReadSettings(c context.Context) (map[string]string, error) {
+	var s cronSettings
+	switch err := settings.GetUncached(c, settingsKey, &s); err {
+	case nil:
+		break
+	case settings.ErrNoSettings:
+		logging.WithError(err).Infof(c, "No settings available, using defaults.")
+		s = defaultSettings
+	default:
+		return nil, err
+	}
+
+	return map[string]string{
+		"InvocationRetentionDays": strconv.FormatUint(uint64(s.InvocationRetentionDays), 10),
+	}, nil
+}
+
+func (settingsUIPage) WriteSettings(c context.Context, values map[string]string, who, why string) error {
+	s := defaultSettings
+	if v := values["InvocationRetentionDays"]; v != "" {
+		days, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("could not parse InvocationRetentionDays: %v", err)
+		}
+		s.InvocationRetentionDays = uint32(days)
+	}
+	return settings.SetIfChanged(c, settingsKey, &s, who, why)
+}
+
+func validateUint32(v string) error {
+	if v == "" {
+		return nil
+	}
+	if _, err := strconv.ParseUint(v, 10, 32); err != nil {
+		return fmt.Errorf("invalid integer %q - %s", v, err)
+	}
+	return nil
+}
+
+func init() {
+	settings.RegisterUIPage(settingsKey, settingsUIPage{})
+}
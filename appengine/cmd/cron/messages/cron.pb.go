@@ -10,11 +10,16 @@ It is generated from these files:
 
 It has these top-level messages:
 	Job
+	Trigger
+	RetryPolicy
+	Acl
 	Task
 	NoopTask
 	UrlFetchTask
 	SwarmingTask
 	BuildbucketTask
+	PubSubTask
+	PRPCTask
 	ProjectConfig
 */
 package messages
@@ -34,6 +39,87 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Trigger_Condition int32
+
+const (
+	// Trigger the job if the invocation succeeds.
+	Trigger_ON_SUCCESS Trigger_Condition = 0
+	// Trigger the job if the invocation fails (after all retries, if any).
+	Trigger_ON_FAILURE Trigger_Condition = 1
+	// Trigger the job when the invocation finishes, regardless of its outcome.
+	Trigger_ALWAYS Trigger_Condition = 2
+)
+
+var Trigger_Condition_name = map[int32]string{
+	0: "ON_SUCCESS",
+	1: "ON_FAILURE",
+	2: "ALWAYS",
+}
+var Trigger_Condition_value = map[string]int32{
+	"ON_SUCCESS": 0,
+	"ON_FAILURE": 1,
+	"ALWAYS":     2,
+}
+
+func (x Trigger_Condition) Enum() *Trigger_Condition {
+	p := new(Trigger_Condition)
+	*p = x
+	return p
+}
+func (x Trigger_Condition) String() string {
+	return proto.EnumName(Trigger_Condition_name, int32(x))
+}
+func (x *Trigger_Condition) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Trigger_Condition_value, data, "Trigger_Condition")
+	if err != nil {
+		return err
+	}
+	*x = Trigger_Condition(value)
+	return nil
+}
+func (Trigger_Condition) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
+type Acl_Role int32
+
+const (
+	// Can see the job and its invocations.
+	Acl_READER Acl_Role = 0
+	// Can see the job and trigger new invocations ("Run now").
+	Acl_TRIGGERER Acl_Role = 1
+	// Can do everything: trigger the job, pause and resume it, abort
+	// invocations.
+	Acl_OWNER Acl_Role = 2
+)
+
+var Acl_Role_name = map[int32]string{
+	0: "READER",
+	1: "TRIGGERER",
+	2: "OWNER",
+}
+var Acl_Role_value = map[string]int32{
+	"READER":    0,
+	"TRIGGERER": 1,
+	"OWNER":     2,
+}
+
+func (x Acl_Role) Enum() *Acl_Role {
+	p := new(Acl_Role)
+	*p = x
+	return p
+}
+func (x Acl_Role) String() string {
+	return proto.EnumName(Acl_Role_name, int32(x))
+}
+func (x *Acl_Role) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Acl_Role_value, data, "Acl_Role")
+	if err != nil {
+		return err
+	}
+	*x = Acl_Role(value)
+	return nil
+}
+func (Acl_Role) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+
 // Job specifies a single cron job belonging to a project.
 type Job struct {
 	// Id is a name of the job (unique for the project).
@@ -43,8 +129,21 @@ type Job struct {
 	// Disables is true to disable this job.
 	Disabled *bool `protobuf:"varint,3,opt,name=disabled" json:"disabled,omitempty"`
 	// Task defines what exactly to execute.
-	Task             *Task  `protobuf:"bytes,4,opt,name=task" json:"task,omitempty"`
-	XXX_unrecognized []byte `json:"-"`
+	Task *Task `protobuf:"bytes,4,opt,name=task" json:"task,omitempty"`
+	// Acls is a list of roles granted on this job, in addition to the ones
+	// specified in the project config.
+	Acls []*Acl `protobuf:"bytes,5,rep,name=acls" json:"acls,omitempty"`
+	// RetryPolicy defines what to do if an invocation fails.
+	//
+	// By default failed invocations are not retried: the job just waits for its
+	// next scheduled run.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy" json:"retry_policy,omitempty"`
+	// Triggers is a list of jobs (in the same project) to trigger when
+	// an invocation of this job finishes.
+	//
+	// Triggers must not form cycles.
+	Triggers         []*Trigger `protobuf:"bytes,7,rep,name=triggers" json:"triggers,omitempty"`
+	XXX_unrecognized []byte     `json:"-"`
 }
 
 func (m *Job) Reset()                    { *m = Job{} }
@@ -80,6 +179,132 @@ func (m *Job) GetTask() *Task {
 	return nil
 }
 
+func (m *Job) GetAcls() []*Acl {
+	if m != nil {
+		return m.Acls
+	}
+	return nil
+}
+
+func (m *Job) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *Job) GetTriggers() []*Trigger {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
+// Trigger defines a job to trigger when an invocation of some other job
+// finishes.
+type Trigger struct {
+	// Job is ID of a job to trigger. The job must be defined in the same project.
+	Job *string `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	// Condition defines when to trigger the job.
+	Condition        *Trigger_Condition `protobuf:"varint,2,opt,name=condition,enum=messages.Trigger_Condition" json:"condition,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *Trigger) Reset()                    { *m = Trigger{} }
+func (m *Trigger) String() string            { return proto.CompactTextString(m) }
+func (*Trigger) ProtoMessage()               {}
+func (*Trigger) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Trigger) GetJob() string {
+	if m != nil && m.Job != nil {
+		return *m.Job
+	}
+	return ""
+}
+
+func (m *Trigger) GetCondition() Trigger_Condition {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return Trigger_ON_SUCCESS
+}
+
+// RetryPolicy defines how to retry failed invocations of a job.
+//
+// An invocation is considered failed if the task can't be launched or if it
+// finishes with FAILED status. Aborted invocations are never retried.
+//
+// The delay before a retry starts at 'backoff_sec' and doubles with each
+// subsequent failed attempt, up to 'max_backoff_sec'.
+type RetryPolicy struct {
+	// MaxAttempts is how many times to attempt an invocation in total, including
+	// the initial attempt. 0 or 1 means "no retries".
+	MaxAttempts *int32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts" json:"max_attempts,omitempty"`
+	// BackoffSec is a delay before the first retry.
+	BackoffSec *int32 `protobuf:"varint,2,opt,name=backoff_sec,json=backoffSec,def=30" json:"backoff_sec,omitempty"`
+	// MaxBackoffSec is the upper limit on a delay before a retry.
+	MaxBackoffSec    *int32 `protobuf:"varint,3,opt,name=max_backoff_sec,json=maxBackoffSec,def=900" json:"max_backoff_sec,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RetryPolicy) Reset()                    { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()               {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+const Default_RetryPolicy_BackoffSec int32 = 30
+const Default_RetryPolicy_MaxBackoffSec int32 = 900
+
+func (m *RetryPolicy) GetMaxAttempts() int32 {
+	if m != nil && m.MaxAttempts != nil {
+		return *m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffSec() int32 {
+	if m != nil && m.BackoffSec != nil {
+		return *m.BackoffSec
+	}
+	return Default_RetryPolicy_BackoffSec
+}
+
+func (m *RetryPolicy) GetMaxBackoffSec() int32 {
+	if m != nil && m.MaxBackoffSec != nil {
+		return *m.MaxBackoffSec
+	}
+	return Default_RetryPolicy_MaxBackoffSec
+}
+
+// Acl grants a role on a job to a principal.
+type Acl struct {
+	// Role is the role to grant.
+	Role *Acl_Role `protobuf:"varint,1,opt,name=role,enum=messages.Acl_Role" json:"role,omitempty"`
+	// GrantedTo is either an identity (e.g. "user:someone@example.com") or
+	// a reference to a group (e.g. "group:some-group").
+	GrantedTo        *string `protobuf:"bytes,2,opt,name=granted_to,json=grantedTo" json:"granted_to,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Acl) Reset()                    { *m = Acl{} }
+func (m *Acl) String() string            { return proto.CompactTextString(m) }
+func (*Acl) ProtoMessage()               {}
+func (*Acl) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Acl) GetRole() Acl_Role {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return Acl_READER
+}
+
+func (m *Acl) GetGrantedTo() string {
+	if m != nil && m.GrantedTo != nil {
+		return *m.GrantedTo
+	}
+	return ""
+}
+
 // Task defines what exactly to do. One and only one field must be set.
 type Task struct {
 	// Noop is used for testing. It is "do nothing" task.
@@ -89,14 +314,18 @@ type Task struct {
 	// SwarmingTask can be used to schedule swarming job.
 	SwarmingTask *SwarmingTask `protobuf:"bytes,3,opt,name=swarming_task,json=swarmingTask" json:"swarming_task,omitempty"`
 	// BuildbucketTask can be used to schedule buildbucket job.
-	BuildbucketTask  *BuildbucketTask `protobuf:"bytes,4,opt,name=buildbucket_task,json=buildbucketTask" json:"buildbucket_task,omitempty"`
-	XXX_unrecognized []byte           `json:"-"`
+	BuildbucketTask *BuildbucketTask `protobuf:"bytes,4,opt,name=buildbucket_task,json=buildbucketTask" json:"buildbucket_task,omitempty"`
+	// PubSubTask can be used to publish a Cloud Pub/Sub message.
+	PubsubTask *PubSubTask `protobuf:"bytes,5,opt,name=pubsub_task,json=pubsubTask" json:"pubsub_task,omitempty"`
+	// PRPCTask can be used to call a pRPC method.
+	PrpcTask         *PRPCTask `protobuf:"bytes,6,opt,name=prpc_task,json=prpcTask" json:"prpc_task,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
 }
 
 func (m *Task) Reset()                    { *m = Task{} }
 func (m *Task) String() string            { return proto.CompactTextString(m) }
 func (*Task) ProtoMessage()               {}
-func (*Task) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *Task) GetNoop() *NoopTask {
 	if m != nil {
@@ -126,6 +355,20 @@ func (m *Task) GetBuildbucketTask() *BuildbucketTask {
 	return nil
 }
 
+func (m *Task) GetPubsubTask() *PubSubTask {
+	if m != nil {
+		return m.PubsubTask
+	}
+	return nil
+}
+
+func (m *Task) GetPrpcTask() *PRPCTask {
+	if m != nil {
+		return m.PrpcTask
+	}
+	return nil
+}
+
 // NoopTask is used for testing. It is "do nothing" task.
 type NoopTask struct {
 	XXX_unrecognized []byte `json:"-"`
@@ -134,7 +377,7 @@ type NoopTask struct {
 func (m *NoopTask) Reset()                    { *m = NoopTask{} }
 func (m *NoopTask) String() string            { return proto.CompactTextString(m) }
 func (*NoopTask) ProtoMessage()               {}
-func (*NoopTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*NoopTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 // UrlFetchTask specifies parameters for simple HTTP call.
 type UrlFetchTask struct {
@@ -150,7 +393,7 @@ type UrlFetchTask struct {
 func (m *UrlFetchTask) Reset()                    { *m = UrlFetchTask{} }
 func (m *UrlFetchTask) String() string            { return proto.CompactTextString(m) }
 func (*UrlFetchTask) ProtoMessage()               {}
-func (*UrlFetchTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*UrlFetchTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 const Default_UrlFetchTask_Method string = "GET"
 const Default_UrlFetchTask_TimeoutSec int32 = 60
@@ -203,7 +446,7 @@ type SwarmingTask struct {
 func (m *SwarmingTask) Reset()                    { *m = SwarmingTask{} }
 func (m *SwarmingTask) String() string            { return proto.CompactTextString(m) }
 func (*SwarmingTask) ProtoMessage()               {}
-func (*SwarmingTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*SwarmingTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 const Default_SwarmingTask_Priority int32 = 200
 const Default_SwarmingTask_GracePeriodSecs int32 = 30
@@ -297,7 +540,7 @@ type SwarmingTask_IsolatedRef struct {
 func (m *SwarmingTask_IsolatedRef) Reset()                    { *m = SwarmingTask_IsolatedRef{} }
 func (m *SwarmingTask_IsolatedRef) String() string            { return proto.CompactTextString(m) }
 func (*SwarmingTask_IsolatedRef) ProtoMessage()               {}
-func (*SwarmingTask_IsolatedRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 0} }
+func (*SwarmingTask_IsolatedRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7, 0} }
 
 func (m *SwarmingTask_IsolatedRef) GetIsolated() string {
 	if m != nil && m.Isolated != nil {
@@ -338,7 +581,7 @@ type BuildbucketTask struct {
 func (m *BuildbucketTask) Reset()                    { *m = BuildbucketTask{} }
 func (m *BuildbucketTask) String() string            { return proto.CompactTextString(m) }
 func (*BuildbucketTask) ProtoMessage()               {}
-func (*BuildbucketTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*BuildbucketTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *BuildbucketTask) GetServer() string {
 	if m != nil && m.Server != nil {
@@ -375,19 +618,123 @@ func (m *BuildbucketTask) GetTags() []string {
 	return nil
 }
 
+// PubSubTask specifies a Cloud Pub/Sub message to publish.
+type PubSubTask struct {
+	// Topic is a full name of the topic to publish the message to:
+	// "projects/<project>/topics/<topic>".
+	Topic *string `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
+	// Data is the message body.
+	Data *string `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	// Attributes is a list of "key:value" pairs to attach to the message.
+	Attributes       []string `protobuf:"bytes,3,rep,name=attributes" json:"attributes,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *PubSubTask) Reset()                    { *m = PubSubTask{} }
+func (m *PubSubTask) String() string            { return proto.CompactTextString(m) }
+func (*PubSubTask) ProtoMessage()               {}
+func (*PubSubTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *PubSubTask) GetTopic() string {
+	if m != nil && m.Topic != nil {
+		return *m.Topic
+	}
+	return ""
+}
+
+func (m *PubSubTask) GetData() string {
+	if m != nil && m.Data != nil {
+		return *m.Data
+	}
+	return ""
+}
+
+func (m *PubSubTask) GetAttributes() []string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// PRPCTask specifies a pRPC method to call.
+//
+// The call is authenticated as the cron service. An invocation succeeds if the
+// method returns OK status code and fails otherwise.
+type PRPCTask struct {
+	// Server is URL of the server that hosts the service, e.g.
+	// "https://luci-config.appspot.com".
+	Server *string `protobuf:"bytes,1,opt,name=server" json:"server,omitempty"`
+	// Service is a full name of the service, e.g. "cron.Cron".
+	Service *string `protobuf:"bytes,2,opt,name=service" json:"service,omitempty"`
+	// Method is a name of the method to call, e.g. "GetJobs".
+	Method *string `protobuf:"bytes,3,opt,name=method" json:"method,omitempty"`
+	// Body is the request message in JSON format. Empty message if not set.
+	Body *string `protobuf:"bytes,4,opt,name=body" json:"body,omitempty"`
+	// TimeoutSec is how long to wait for the call to complete.
+	TimeoutSec       *int32 `protobuf:"varint,5,opt,name=timeout_sec,json=timeoutSec,def=60" json:"timeout_sec,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *PRPCTask) Reset()                    { *m = PRPCTask{} }
+func (m *PRPCTask) String() string            { return proto.CompactTextString(m) }
+func (*PRPCTask) ProtoMessage()               {}
+func (*PRPCTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+const Default_PRPCTask_TimeoutSec int32 = 60
+
+func (m *PRPCTask) GetServer() string {
+	if m != nil && m.Server != nil {
+		return *m.Server
+	}
+	return ""
+}
+
+func (m *PRPCTask) GetService() string {
+	if m != nil && m.Service != nil {
+		return *m.Service
+	}
+	return ""
+}
+
+func (m *PRPCTask) GetMethod() string {
+	if m != nil && m.Method != nil {
+		return *m.Method
+	}
+	return ""
+}
+
+func (m *PRPCTask) GetBody() string {
+	if m != nil && m.Body != nil {
+		return *m.Body
+	}
+	return ""
+}
+
+func (m *PRPCTask) GetTimeoutSec() int32 {
+	if m != nil && m.TimeoutSec != nil {
+		return *m.TimeoutSec
+	}
+	return Default_PRPCTask_TimeoutSec
+}
+
 // ProjectConfig defines a schema for cron.cfg files that describe cron jobs
 // belonging to some project.
 type ProjectConfig struct {
 	// Job is a set of jobs defines in the project. It's singular to make
 	// text-encoded proto definitions more readable.
-	Job              []*Job `protobuf:"bytes,1,rep,name=job" json:"job,omitempty"`
+	Job []*Job `protobuf:"bytes,1,rep,name=job" json:"job,omitempty"`
+	// Acls is a list of roles granted on all jobs of the project.
+	//
+	// If neither the project nor a job define any ACLs, the job is visible to
+	// everyone, and only administrators can trigger, pause or abort it.
+	Acls             []*Acl `protobuf:"bytes,2,rep,name=acls" json:"acls,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *ProjectConfig) Reset()                    { *m = ProjectConfig{} }
 func (m *ProjectConfig) String() string            { return proto.CompactTextString(m) }
 func (*ProjectConfig) ProtoMessage()               {}
-func (*ProjectConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*ProjectConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *ProjectConfig) GetJob() []*Job {
 	if m != nil {
@@ -396,56 +743,96 @@ func (m *ProjectConfig) GetJob() []*Job {
 	return nil
 }
 
+func (m *ProjectConfig) GetAcls() []*Acl {
+	if m != nil {
+		return m.Acls
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Job)(nil), "messages.Job")
+	proto.RegisterType((*Trigger)(nil), "messages.Trigger")
+	proto.RegisterType((*RetryPolicy)(nil), "messages.RetryPolicy")
+	proto.RegisterType((*Acl)(nil), "messages.Acl")
 	proto.RegisterType((*Task)(nil), "messages.Task")
 	proto.RegisterType((*NoopTask)(nil), "messages.NoopTask")
 	proto.RegisterType((*UrlFetchTask)(nil), "messages.UrlFetchTask")
 	proto.RegisterType((*SwarmingTask)(nil), "messages.SwarmingTask")
 	proto.RegisterType((*SwarmingTask_IsolatedRef)(nil), "messages.SwarmingTask.IsolatedRef")
 	proto.RegisterType((*BuildbucketTask)(nil), "messages.BuildbucketTask")
+	proto.RegisterType((*PubSubTask)(nil), "messages.PubSubTask")
+	proto.RegisterType((*PRPCTask)(nil), "messages.PRPCTask")
 	proto.RegisterType((*ProjectConfig)(nil), "messages.ProjectConfig")
+	proto.RegisterEnum("messages.Trigger_Condition", Trigger_Condition_name, Trigger_Condition_value)
+	proto.RegisterEnum("messages.Acl_Role", Acl_Role_name, Acl_Role_value)
 }
 
 var fileDescriptor0 = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x54, 0xdb, 0x6e, 0xd3, 0x40,
-	0x10, 0x55, 0xea, 0xb4, 0x75, 0xc6, 0x49, 0x53, 0x56, 0xa8, 0x32, 0xe5, 0x2a, 0x23, 0x15, 0x9e,
-	0xa2, 0x28, 0x45, 0x3c, 0x94, 0x27, 0x2e, 0x05, 0xc1, 0x03, 0xaa, 0xdc, 0xf2, 0x6c, 0xf9, 0xb2,
-	0x49, 0xb6, 0x8d, 0xbd, 0x66, 0x77, 0x5d, 0xca, 0x47, 0x20, 0xfe, 0x92, 0xef, 0x60, 0x76, 0xbd,
-	0xbe, 0xb4, 0x82, 0xb7, 0x9d, 0x73, 0x66, 0x66, 0x77, 0xce, 0x19, 0x1b, 0x20, 0x15, 0xbc, 0x98,
-	0x95, 0x82, 0x2b, 0x4e, 0xdc, 0x9c, 0x4a, 0x19, 0xaf, 0xa8, 0x0c, 0xbe, 0x83, 0xf3, 0x85, 0x27,
-	0x64, 0x0f, 0xb6, 0x58, 0xe6, 0x0f, 0x9e, 0x0d, 0x5e, 0x8e, 0x42, 0x3c, 0x91, 0x43, 0x70, 0x65,
-	0xba, 0xa6, 0x59, 0xb5, 0xa1, 0xfe, 0x96, 0x41, 0xdb, 0x58, 0x73, 0x19, 0x93, 0x71, 0xb2, 0xa1,
-	0x99, 0xef, 0x20, 0xe7, 0x86, 0x6d, 0x4c, 0x02, 0x18, 0xaa, 0x58, 0x5e, 0xf9, 0x43, 0xc4, 0xbd,
-	0xc5, 0xde, 0xac, 0xb9, 0x67, 0x76, 0x81, 0x68, 0x68, 0xb8, 0xe0, 0xcf, 0x00, 0x86, 0x3a, 0x24,
-	0x47, 0x30, 0x2c, 0x38, 0x2f, 0xcd, 0xb5, 0xde, 0x82, 0x74, 0xc9, 0x5f, 0x11, 0xad, 0x0b, 0x34,
-	0x4f, 0x8e, 0x61, 0x54, 0x89, 0x4d, 0xb4, 0xa4, 0x2a, 0x5d, 0x9b, 0xd7, 0x78, 0x8b, 0x83, 0x2e,
-	0xf9, 0x9b, 0xd8, 0x7c, 0xd4, 0x8c, 0x29, 0x70, 0x2b, 0x1b, 0x91, 0x37, 0x30, 0x91, 0x3f, 0x62,
-	0x91, 0xb3, 0x62, 0x15, 0x99, 0x27, 0x39, 0x77, 0x0b, 0xcf, 0x2d, 0x6d, 0x0a, 0xc7, 0xb2, 0x17,
-	0x91, 0x0f, 0xb0, 0x9f, 0x54, 0x6c, 0x93, 0x25, 0x55, 0x7a, 0x45, 0x55, 0xd4, 0x1b, 0xe9, 0x41,
-	0x57, 0xff, 0xae, 0xcb, 0x30, 0x2d, 0xa6, 0xc9, 0x6d, 0x20, 0x00, 0x70, 0x9b, 0x49, 0x82, 0x04,
-	0xc6, 0xfd, 0x87, 0x92, 0x87, 0xb0, 0x93, 0x53, 0xb5, 0xe6, 0x56, 0xf4, 0x13, 0xe7, 0xd3, 0xe9,
-	0x45, 0x68, 0x21, 0xb2, 0x0f, 0x0e, 0xce, 0x61, 0x85, 0xd7, 0x47, 0xf2, 0x1c, 0x3c, 0xc5, 0x72,
-	0xca, 0x2b, 0x15, 0x49, 0x9a, 0x9a, 0x59, 0xb6, 0x4f, 0xb6, 0x5e, 0xcf, 0x43, 0xb0, 0xf0, 0x39,
-	0x4d, 0x83, 0x5f, 0x43, 0x18, 0xf7, 0x87, 0x22, 0x07, 0xb0, 0x23, 0xa9, 0xb8, 0xa6, 0xc2, 0x3a,
-	0x6b, 0x23, 0xe2, 0xc3, 0x6e, 0xca, 0xf3, 0x3c, 0x2e, 0x32, 0xbc, 0xc3, 0x41, 0xa2, 0x09, 0xc9,
-	0x29, 0x8c, 0x99, 0xe4, 0x9b, 0x58, 0xd1, 0x2c, 0x12, 0x74, 0x69, 0x45, 0x0b, 0xfe, 0x2d, 0xda,
-	0xec, 0xb3, 0x4d, 0x0d, 0xe9, 0x32, 0xf4, 0x58, 0x17, 0x90, 0xc7, 0x00, 0xf4, 0x46, 0x89, 0x38,
-	0x8a, 0xc5, 0x4a, 0xa2, 0x72, 0xfa, 0x8e, 0x91, 0x41, 0xde, 0x22, 0xa0, 0xe7, 0xa3, 0xc5, 0xb5,
-	0xbf, 0x6d, 0x70, 0x7d, 0x24, 0x4f, 0x00, 0x32, 0x1c, 0xa4, 0x90, 0x8c, 0x17, 0xd2, 0xdf, 0x31,
-	0x44, 0x0f, 0x21, 0x44, 0xef, 0x15, 0xb6, 0xda, 0x35, 0x8c, 0x39, 0x93, 0xa7, 0xe0, 0x96, 0x82,
-	0x71, 0xc1, 0xd4, 0x4f, 0xdf, 0x35, 0x82, 0x38, 0x8b, 0xf9, 0x3c, 0x6c, 0x41, 0xf2, 0x0a, 0x0e,
-	0xe8, 0x0d, 0x4d, 0x2b, 0x85, 0x2d, 0xa2, 0x9e, 0x7c, 0xd2, 0x1f, 0xe9, 0xf4, 0xf0, 0x7e, 0xcb,
-	0x5e, 0xb4, 0x22, 0x4a, 0x32, 0x83, 0x7b, 0x2b, 0x11, 0xa7, 0x34, 0x2a, 0x29, 0x36, 0xca, 0xea,
-	0x02, 0xa8, 0x05, 0x3f, 0x9e, 0x87, 0x53, 0x43, 0x9e, 0x19, 0xce, 0xe4, 0x1f, 0xc1, 0x94, 0xf1,
-	0xdb, 0xed, 0x3d, 0xd3, 0x7e, 0xc2, 0x78, 0xaf, 0xef, 0x61, 0x09, 0x5e, 0x4f, 0x2f, 0xfd, 0x15,
-	0x35, 0x8a, 0x59, 0x77, 0xda, 0x98, 0xbc, 0xc0, 0x96, 0x8d, 0x0b, 0xd6, 0xc0, 0x7a, 0x17, 0xf6,
-	0x1a, 0xf8, 0xbc, 0x36, 0xf2, 0x11, 0x8c, 0x8a, 0x18, 0xbd, 0x29, 0xf1, 0x49, 0xc6, 0x2b, 0x94,
-	0xb9, 0x05, 0x82, 0xdf, 0x03, 0x98, 0xde, 0x59, 0xd2, 0xff, 0xae, 0x04, 0xe2, 0x75, 0x96, 0xbd,
-	0xc9, 0x46, 0x7a, 0x55, 0xcc, 0x5a, 0x63, 0x41, 0xdd, 0xbf, 0x09, 0xb5, 0x65, 0xf8, 0x33, 0x41,
-	0x91, 0x14, 0xa3, 0x8d, 0xc7, 0x3d, 0xa4, 0xb5, 0x6c, 0xbb, 0xb3, 0x2c, 0x98, 0xc3, 0xe4, 0x4c,
-	0xf0, 0x4b, 0x9a, 0xaa, 0xf7, 0xbc, 0x58, 0xb2, 0x15, 0x7a, 0xe8, 0x5c, 0xf2, 0x04, 0xdf, 0xe2,
-	0xe0, 0x9a, 0x4d, 0xba, 0x35, 0xc3, 0x7f, 0x52, 0xa8, 0x99, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0x65, 0x64, 0x3c, 0xb6, 0x04, 0x00, 0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x55, 0x59, 0x6f, 0xdc, 0x36,
+	0x10, 0xce, 0x9e, 0x59, 0x8d, 0xf6, 0x0a, 0x91, 0x1a, 0x6a, 0xd2, 0x36, 0x8e, 0x0a, 0xa4, 0x06,
+	0xda, 0x6e, 0x17, 0x9b, 0x5e, 0x49, 0x9f, 0x36, 0xce, 0xc6, 0x70, 0x10, 0x38, 0x0b, 0xae, 0xdd,
+	0xa0, 0x4f, 0x82, 0x0e, 0xee, 0x9a, 0xb1, 0x24, 0x0a, 0x24, 0x95, 0xda, 0x7d, 0xec, 0x7b, 0xd0,
+	0x7f, 0xd6, 0x7f, 0x54, 0xa0, 0x20, 0x45, 0x1d, 0x76, 0x93, 0xbe, 0xcd, 0x7c, 0xf3, 0xcd, 0x0c,
+	0x39, 0x07, 0x09, 0x10, 0x72, 0x96, 0xce, 0x32, 0xce, 0x24, 0x43, 0x83, 0x84, 0x08, 0xe1, 0xef,
+	0x88, 0x70, 0xff, 0x69, 0x41, 0xe7, 0x25, 0x0b, 0xd0, 0x18, 0xda, 0x34, 0x72, 0x5a, 0xfb, 0xad,
+	0x03, 0x0b, 0xb7, 0x69, 0x84, 0xee, 0xc1, 0x40, 0x84, 0xe7, 0x24, 0xca, 0x63, 0xe2, 0xb4, 0x35,
+	0x5a, 0xe9, 0xca, 0x16, 0x51, 0xe1, 0x07, 0x31, 0x89, 0x9c, 0xce, 0x7e, 0xeb, 0x60, 0x80, 0x2b,
+	0x1d, 0xb9, 0xd0, 0x95, 0xbe, 0xb8, 0x70, 0xba, 0xfb, 0xad, 0x03, 0x7b, 0x31, 0x9e, 0x95, 0x89,
+	0x66, 0xa7, 0xbe, 0xb8, 0xc0, 0xda, 0x86, 0x1e, 0x42, 0xd7, 0x0f, 0x63, 0xe1, 0xf4, 0xf6, 0x3b,
+	0x07, 0xf6, 0x62, 0x54, 0x73, 0x96, 0x61, 0x8c, 0xb5, 0x09, 0xfd, 0x0c, 0x43, 0x4e, 0x24, 0xbf,
+	0xf2, 0x32, 0x16, 0xd3, 0xf0, 0xca, 0xe9, 0xeb, 0x70, 0x9f, 0xd4, 0x54, 0xac, 0xac, 0x6b, 0x6d,
+	0xc4, 0x36, 0xaf, 0x15, 0xf4, 0x2d, 0x0c, 0x24, 0xa7, 0xbb, 0x1d, 0xe1, 0xc2, 0xb9, 0xad, 0x13,
+	0xdc, 0x69, 0x1c, 0xa2, 0xb0, 0xe0, 0x8a, 0xe2, 0xfe, 0xd5, 0x82, 0xdb, 0x06, 0x45, 0x53, 0xe8,
+	0xbc, 0x65, 0x81, 0x29, 0x82, 0x12, 0xd1, 0x13, 0xb0, 0x42, 0x96, 0x46, 0x54, 0x52, 0x96, 0xea,
+	0x32, 0x8c, 0x17, 0xf7, 0xff, 0x13, 0x6d, 0x76, 0x58, 0x52, 0x70, 0xcd, 0x76, 0x7f, 0x02, 0xab,
+	0xc2, 0xd1, 0x18, 0xe0, 0xf5, 0x89, 0xb7, 0x39, 0x3b, 0x3c, 0x5c, 0x6d, 0x36, 0xd3, 0x5b, 0x46,
+	0x7f, 0xb1, 0x3c, 0x7e, 0x75, 0x86, 0x57, 0xd3, 0x16, 0x02, 0xe8, 0x2f, 0x5f, 0xbd, 0x59, 0xfe,
+	0xb6, 0x99, 0xb6, 0xdd, 0x3f, 0x5b, 0x60, 0x37, 0x6e, 0x87, 0x1e, 0xc2, 0x30, 0xf1, 0x2f, 0x3d,
+	0x5f, 0x4a, 0x92, 0x64, 0x52, 0xe8, 0xe3, 0xf5, 0xb0, 0x9d, 0xf8, 0x97, 0x4b, 0x03, 0xa1, 0x2f,
+	0xc1, 0x0e, 0xfc, 0xf0, 0x82, 0x6d, 0xb7, 0x9e, 0x20, 0xa1, 0x3e, 0x68, 0xef, 0x69, 0xfb, 0xf1,
+	0x1c, 0x83, 0x81, 0x37, 0x24, 0x44, 0x5f, 0xc3, 0x44, 0xc5, 0x69, 0x12, 0x3b, 0x9a, 0xd8, 0x79,
+	0x32, 0x9f, 0xe3, 0x51, 0xe2, 0x5f, 0x3e, 0xab, 0xc8, 0xee, 0x1f, 0xd0, 0x59, 0x86, 0x31, 0x7a,
+	0x04, 0x5d, 0xce, 0x62, 0xa2, 0x73, 0x8e, 0x17, 0xe8, 0x5a, 0xa7, 0x66, 0x98, 0xc5, 0x04, 0x6b,
+	0x3b, 0xfa, 0x1c, 0x60, 0xc7, 0xfd, 0x54, 0x92, 0xc8, 0x93, 0xcc, 0xcc, 0x8b, 0x65, 0x90, 0x53,
+	0xe6, 0x7e, 0x03, 0x5d, 0x45, 0x56, 0xd7, 0xc4, 0xab, 0xe5, 0xf3, 0x15, 0x9e, 0xde, 0x42, 0x23,
+	0xb0, 0x4e, 0xf1, 0xf1, 0xd1, 0xd1, 0x0a, 0xaf, 0xf0, 0xb4, 0x85, 0x2c, 0xe8, 0xbd, 0x7e, 0x73,
+	0xb2, 0xc2, 0xd3, 0xb6, 0xfb, 0x77, 0x1b, 0xba, 0x6a, 0x5a, 0x54, 0xf6, 0x94, 0xb1, 0x4c, 0x67,
+	0xb7, 0x9b, 0xd9, 0x4f, 0x18, 0xcb, 0x8a, 0x79, 0x52, 0x76, 0xf4, 0x18, 0xac, 0x9c, 0xc7, 0xde,
+	0x96, 0xc8, 0xf0, 0x5c, 0x27, 0xb7, 0x17, 0x7b, 0x35, 0xf9, 0x8c, 0xc7, 0x2f, 0x94, 0x45, 0x3b,
+	0x0c, 0x72, 0xa3, 0xa1, 0x5f, 0x60, 0x24, 0x7e, 0xf7, 0x79, 0x42, 0xd3, 0x9d, 0xa7, 0x27, 0xb6,
+	0x73, 0xd3, 0x71, 0x63, 0xcc, 0xda, 0x71, 0x28, 0x1a, 0x1a, 0x7a, 0x0e, 0xd3, 0x20, 0xa7, 0x71,
+	0x14, 0xe4, 0xe1, 0x05, 0x91, 0x5e, 0x63, 0xe2, 0x3f, 0xad, 0xfd, 0x9f, 0xd5, 0x0c, 0x1d, 0x62,
+	0x12, 0x5c, 0x07, 0xd0, 0x0f, 0x60, 0x67, 0x79, 0x20, 0xf2, 0xa0, 0x08, 0xd0, 0xd3, 0x01, 0xee,
+	0xd6, 0x01, 0xd6, 0x79, 0xb0, 0xc9, 0x03, 0xed, 0x0b, 0x05, 0x51, 0xbb, 0x7d, 0x07, 0x56, 0xc6,
+	0xb3, 0xb0, 0x70, 0xea, 0xdf, 0xac, 0xcd, 0x1a, 0xaf, 0x0f, 0x8b, 0xab, 0x2a, 0x92, 0x92, 0x5c,
+	0x80, 0x41, 0x59, 0x31, 0x37, 0x80, 0x61, 0xb3, 0x20, 0xe8, 0x3e, 0xf4, 0x13, 0x22, 0xcf, 0x99,
+	0xd9, 0xfd, 0xa7, 0x9d, 0xa3, 0xd5, 0x29, 0x36, 0x90, 0x5a, 0x88, 0x9c, 0xc7, 0xa6, 0x9f, 0x4a,
+	0x54, 0x93, 0x26, 0x69, 0x42, 0x58, 0x2e, 0x1b, 0x03, 0xd4, 0xfe, 0x71, 0x8e, 0xc1, 0xc0, 0x6a,
+	0x78, 0xde, 0x77, 0x61, 0xd8, 0x2c, 0x1e, 0xda, 0x83, 0xbe, 0x20, 0xfc, 0x1d, 0xe1, 0x66, 0xb7,
+	0x8c, 0x86, 0x1c, 0xb8, 0x1d, 0xb2, 0x24, 0xf1, 0xd3, 0xc8, 0x69, 0xef, 0x77, 0x0e, 0x2c, 0x5c,
+	0xaa, 0x68, 0x05, 0x43, 0x2a, 0x58, 0xec, 0xab, 0x89, 0xe2, 0x64, 0x6b, 0x9a, 0xe3, 0x7e, 0xb8,
+	0x39, 0xb3, 0x63, 0x43, 0xc5, 0x64, 0x8b, 0x6d, 0x5a, 0x2b, 0x6a, 0x2e, 0xc9, 0xa5, 0xe4, 0xbe,
+	0xe7, 0xf3, 0x9d, 0x70, 0xba, 0x3a, 0x87, 0xa5, 0x91, 0x25, 0xdf, 0x09, 0x75, 0x3f, 0x92, 0xbe,
+	0xd3, 0xef, 0x90, 0x85, 0x95, 0x88, 0xbe, 0x00, 0x88, 0x68, 0x42, 0x52, 0x41, 0x59, 0x2a, 0x9c,
+	0xbe, 0x36, 0x34, 0x10, 0x84, 0xd4, 0xf3, 0xb6, 0x2b, 0x5e, 0x16, 0x0b, 0x6b, 0x19, 0x3d, 0x80,
+	0x41, 0xc6, 0x29, 0xe3, 0x54, 0x5e, 0x39, 0x83, 0x62, 0xa3, 0x16, 0xf3, 0x39, 0xae, 0x40, 0xf4,
+	0x3d, 0xec, 0x91, 0x4b, 0x12, 0xe6, 0xea, 0x29, 0xf0, 0x1a, 0xe5, 0x13, 0x8e, 0xa5, 0x77, 0xf9,
+	0x6e, 0x65, 0x3d, 0xad, 0x8a, 0x28, 0xd0, 0x0c, 0xee, 0xec, 0xb8, 0x1f, 0x12, 0x2f, 0x23, 0x9c,
+	0xb2, 0xa8, 0x70, 0x80, 0x6a, 0xb5, 0x27, 0xda, 0xb8, 0xd6, 0x36, 0xcd, 0x7f, 0x04, 0x13, 0xca,
+	0xae, 0x87, 0xb7, 0x75, 0xf8, 0x11, 0x65, 0x8d, 0xb8, 0xf7, 0x32, 0xb0, 0x1b, 0xf5, 0x52, 0x8f,
+	0x79, 0x59, 0x31, 0xd3, 0x9d, 0x4a, 0x47, 0x5f, 0xc1, 0xa4, 0xea, 0x82, 0x69, 0x60, 0x31, 0x0b,
+	0xe3, 0x12, 0xde, 0x14, 0x8d, 0xfc, 0x0c, 0xac, 0xd4, 0x4f, 0x88, 0xc8, 0xfc, 0x90, 0xe8, 0x5e,
+	0x59, 0xb8, 0x06, 0xd4, 0x1b, 0x3b, 0xb9, 0xb1, 0x0c, 0x1f, 0x1d, 0x89, 0x3d, 0xe8, 0x17, 0x2c,
+	0x93, 0xc9, 0x68, 0x6a, 0x54, 0xf4, 0xfa, 0x10, 0x6e, 0xe2, 0x97, 0xaa, 0x6a, 0x59, 0xc6, 0x59,
+	0x46, 0xb8, 0xa4, 0xa4, 0xec, 0x71, 0x03, 0xa9, 0x5a, 0xd6, 0xab, 0x5b, 0xe6, 0xfe, 0x0a, 0x50,
+	0x2f, 0x17, 0xba, 0x0b, 0x3d, 0xc9, 0x32, 0x1a, 0x9a, 0xa3, 0x14, 0x8a, 0xf2, 0x8b, 0x7c, 0xe9,
+	0x9b, 0x73, 0x68, 0x59, 0xe5, 0xf2, 0xa5, 0xe4, 0x34, 0xc8, 0x25, 0x11, 0x4e, 0xa7, 0xc8, 0x55,
+	0x23, 0xee, 0xfb, 0x16, 0x0c, 0xca, 0x05, 0xfc, 0xbf, 0xa9, 0x57, 0x12, 0x0d, 0xcb, 0x9f, 0xb5,
+	0x54, 0x95, 0x87, 0x59, 0xc6, 0xe2, 0x8e, 0x46, 0x53, 0x47, 0x09, 0x58, 0x74, 0xa5, 0x9f, 0x18,
+	0x0b, 0x6b, 0xf9, 0xe6, 0x26, 0xf6, 0x3e, 0xb8, 0x89, 0x1b, 0x18, 0xad, 0x39, 0x7b, 0x4b, 0x42,
+	0x79, 0xc8, 0xd2, 0x2d, 0xdd, 0xa1, 0x07, 0xe5, 0x17, 0x77, 0xe3, 0xe7, 0x7d, 0xc9, 0x82, 0xe2,
+	0xc7, 0x2b, 0xff, 0xe6, 0xf6, 0x47, 0xff, 0xe6, 0x7f, 0x07, 0x00, 0xaa, 0x10, 0x7b, 0xa0, 0x49,
+	0x08, 0x00, 0x00,
 }
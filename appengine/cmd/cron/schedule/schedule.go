@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +29,7 @@ type Schedule struct {
 	randSeed uint64
 
 	cronExpr *cronexpr.Expression // set for absolute schedules
+	location *time.Location       // time zone of cronExpr, UTC by default
 	interval time.Duration        // set for relative schedules
 	manual   bool                 // set for manual schedule
 }
@@ -53,9 +56,22 @@ func (s *Schedule) Next(now, prev time.Time) time.Time {
 		return DistantFuture
 	}
 
-	// For an absolute schedule just look at the time table.
+	// For an absolute schedule just look at the time table. It is evaluated in
+	// the schedule's time zone, so that e.g. "0 2 * * *" fires at 2 AM local
+	// time both in winter and in summer.
 	if s.cronExpr != nil {
-		return s.cronExpr.Next(now)
+		next := s.cronExpr.Next(now.In(s.location))
+		// Time table entries that fall into a DST gap (e.g. 2:30 AM on a spring
+		// forward day) may be normalized to a moment before 'now'. Skip past the
+		// gap in this case, so that the schedule always moves forward.
+		for t := now; !next.IsZero() && !next.After(now); {
+			t = t.Add(time.Minute)
+			next = s.cronExpr.Next(t.In(s.location))
+		}
+		if next.IsZero() {
+			return next
+		}
+		return next.UTC()
 	}
 
 	// Using relative schedule and this is a first invocation ever? Randomize
@@ -91,6 +107,16 @@ func (s *Schedule) String() string {
 //     be recorded (and next attempt to start a job happens based on the
 //     schedule, not when the previous invocation finishes). This is absolute
 //     schedule (i.e. doesn't depend on job state).
+//   - "TZ=America/Los_Angeles 0 2 * * *": same as above, but the expression
+//     is evaluated in the given time zone (using tz database names), with
+//     daylight saving time transitions taken into account.
+//   - "H H(0-5) * * *": cron-like expression where 'H' is replaced with
+//     a stable pseudorandom value derived from 'randSeed' (normally a hash of
+//     the job ID). It allows spreading many jobs over a time window. 'H' can be
+//     used instead of a number in any field but the year. "H(a-b)" limits the
+//     value to the given range, "H/n" (or "H(a-b)/n") means "every n units,
+//     starting at some stable offset". In the day-of-month field 'H' picks
+//     values from 1-28 range, so the job runs every month.
 //   - "with 10s interval": runs invocations in a loop, waiting 10s after
 //     finishing invocation before starting a new one. This is relative
 //     schedule. Overruns are not possible.
@@ -112,10 +138,13 @@ func Parse(expr string, randSeed uint64) (sched *Schedule, err error) {
 	default:
 		toParse = expr
 	}
-	if strings.HasPrefix(toParse, "with ") {
+	switch {
+	case strings.HasPrefix(toParse, "with "):
 		sched, err = parseWithSchedule(toParse, randSeed)
-	} else {
-		sched, err = parseCronSchedule(toParse, randSeed)
+	case strings.HasPrefix(toParse, "TZ="):
+		sched, err = parseZonedCronSchedule(toParse, randSeed)
+	default:
+		sched, err = parseCronSchedule(toParse, randSeed, time.UTC)
 	}
 	if sched != nil {
 		sched.asString = expr
@@ -140,11 +169,129 @@ func parseWithSchedule(expr string, randSeed uint64) (*Schedule, error) {
 	return &Schedule{interval: interval}, nil
 }
 
-// parseCronSchedule parses crontab-like schedule string.
-func parseCronSchedule(expr string, randSeed uint64) (*Schedule, error) {
+// parseZonedCronSchedule parses "TZ=<zone> <crontab-like expression>"
+// schedule string.
+func parseZonedCronSchedule(expr string, randSeed uint64) (*Schedule, error) {
+	tokens := strings.SplitN(expr, " ", 2)
+	if len(tokens) != 2 {
+		return nil, errors.New("expecting format \"TZ=<zone> <cron expression>\"")
+	}
+	zone := strings.TrimPrefix(tokens[0], "TZ=")
+	if zone == "" {
+		return nil, errors.New("empty time zone name")
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("bad time zone %q - %s", zone, err)
+	}
+	return parseCronSchedule(strings.TrimSpace(tokens[1]), randSeed, loc)
+}
+
+// parseCronSchedule parses crontab-like schedule string, evaluated in the given
+// time zone.
+func parseCronSchedule(expr string, randSeed uint64, loc *time.Location) (*Schedule, error) {
+	expr, err := expandHashes(expr, randSeed)
+	if err != nil {
+		return nil, err
+	}
 	exp, err := cronexpr.Parse(expr)
 	if err != nil {
 		return nil, err
 	}
-	return &Schedule{cronExpr: exp}, nil
+	return &Schedule{cronExpr: exp, location: loc}, nil
+}
+
+// hashRe matches 'H' items in cron expression fields: "H", "H(a-b)", "H/n" or
+// "H(a-b)/n".
+var hashRe = regexp.MustCompile(`^H(?:\((\d+)-(\d+)\))?(?:/(\d+))?$`)
+
+// fieldRange is [min, max] range of values a cron expression field can have
+// when using 'H'.
+type fieldRange struct {
+	name     string
+	min, max int
+}
+
+var (
+	secondsRange    = fieldRange{"seconds", 0, 59}
+	minutesRange    = fieldRange{"minutes", 0, 59}
+	hoursRange      = fieldRange{"hours", 0, 23}
+	dayOfMonthRange = fieldRange{"day of month", 1, 28}
+	monthRange      = fieldRange{"month", 1, 12}
+	dayOfWeekRange  = fieldRange{"day of week", 0, 6}
+	yearRange       = fieldRange{"year", 0, -1} // 'H' is not allowed
+)
+
+// expandHashes replaces 'H' items in a crontab-like expression with stable
+// pseudorandom values derived from 'randSeed'.
+//
+// Expressions without 'H' are returned as is.
+func expandHashes(expr string, randSeed uint64) (string, error) {
+	if !strings.Contains(expr, "H") {
+		return expr, nil
+	}
+
+	// See cronexpr docs for how it interprets expressions with different number
+	// of fields.
+	fields := strings.Fields(expr)
+	var ranges []fieldRange
+	switch len(fields) {
+	case 5:
+		ranges = []fieldRange{minutesRange, hoursRange, dayOfMonthRange, monthRange, dayOfWeekRange}
+	case 6:
+		ranges = []fieldRange{minutesRange, hoursRange, dayOfMonthRange, monthRange, dayOfWeekRange, yearRange}
+	case 7:
+		ranges = []fieldRange{secondsRange, minutesRange, hoursRange, dayOfMonthRange, monthRange, dayOfWeekRange, yearRange}
+	default:
+		return "", errors.New("'H' can only be used in expressions with 5, 6 or 7 fields")
+	}
+
+	// Pass seed through math/rand to make small seeds (used by unit tests) less
+	// special.
+	rnd := rand.New(rand.NewSource(int64(randSeed)))
+	for i, field := range fields {
+		items := strings.Split(field, ",")
+		for j, item := range items {
+			if !strings.HasPrefix(item, "H") {
+				continue
+			}
+			expanded, err := expandHash(item, ranges[i], rnd)
+			if err != nil {
+				return "", err
+			}
+			items[j] = expanded
+		}
+		fields[i] = strings.Join(items, ",")
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// expandHash converts single 'H' item of a field to a regular cron expression
+// item.
+func expandHash(item string, r fieldRange, rnd *rand.Rand) (string, error) {
+	if r.max < r.min {
+		return "", fmt.Errorf("'H' is not allowed in the %s field", r.name)
+	}
+	m := hashRe.FindStringSubmatch(item)
+	if m == nil {
+		return "", fmt.Errorf("bad 'H' item %q in the %s field", item, r.name)
+	}
+
+	min, max := r.min, r.max
+	if m[1] != "" {
+		min, _ = strconv.Atoi(m[1])
+		max, _ = strconv.Atoi(m[2])
+		if min > max || min < r.min || max > r.max {
+			return "", fmt.Errorf("bad range in %q, must be within [%d-%d] for the %s field", item, r.min, r.max, r.name)
+		}
+	}
+
+	if m[3] == "" {
+		return strconv.Itoa(min + rnd.Intn(max-min+1)), nil
+	}
+	step, _ := strconv.Atoi(m[3])
+	if step <= 0 || step > max-min+1 {
+		return "", fmt.Errorf("bad step in %q for the %s field", item, r.name)
+	}
+	return fmt.Sprintf("%d-%d/%d", min+rnd.Intn(step), max, step), nil
 }
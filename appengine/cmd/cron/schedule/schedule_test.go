@@ -67,3 +67,115 @@ func TestRelativeSchedule(t *testing.T) {
 		So(sched.Next(epoch.Add(31*time.Second), epoch.Add(15*time.Second)), ShouldResemble, epoch.Add(31*time.Second))
 	})
 }
+
+func TestZonedSchedule(t *testing.T) {
+	Convey("Parsing success", t, func() {
+		sched, err := Parse("TZ=America/Los_Angeles 0 2 * * *", 0)
+		So(err, ShouldBeNil)
+		So(sched.IsAbsolute(), ShouldBeTrue)
+		So(sched.String(), ShouldEqual, "TZ=America/Los_Angeles 0 2 * * *")
+	})
+
+	Convey("Parsing error", t, func() {
+		sched, err := Parse("TZ=Not/A_Zone 0 2 * * *", 0)
+		So(err, ShouldNotBeNil)
+		So(sched, ShouldBeNil)
+
+		sched, err = Parse("TZ=America/Los_Angeles", 0)
+		So(err, ShouldNotBeNil)
+		So(sched, ShouldBeNil)
+
+		sched, err = Parse("TZ= 0 2 * * *", 0)
+		So(err, ShouldNotBeNil)
+		So(sched, ShouldBeNil)
+	})
+
+	Convey("Next works across DST transitions", t, func() {
+		sched, _ := Parse("TZ=America/Los_Angeles 0 10 * * *", 0)
+
+		// 10 AM PST is 18:00 UTC.
+		now := time.Date(2016, 3, 12, 12, 0, 0, 0, time.UTC)
+		next := sched.Next(now, time.Time{})
+		So(next, ShouldResemble, time.Date(2016, 3, 12, 18, 0, 0, 0, time.UTC))
+
+		// 10 AM PDT (after spring forward on March 13) is 17:00 UTC.
+		next = sched.Next(next, time.Time{})
+		So(next, ShouldResemble, time.Date(2016, 3, 13, 17, 0, 0, 0, time.UTC))
+
+		// Back to PST after November 6.
+		now = time.Date(2016, 11, 6, 12, 0, 0, 0, time.UTC)
+		next = sched.Next(now, time.Time{})
+		So(next, ShouldResemble, time.Date(2016, 11, 6, 18, 0, 0, 0, time.UTC))
+	})
+
+	Convey("Next always moves forward", t, func() {
+		// 2:30 AM doesn't exist on March 13, 2016 in Los Angeles.
+		sched, _ := Parse("TZ=America/Los_Angeles 30 2 * * *", 0)
+		now := time.Date(2016, 3, 12, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < 5; i++ {
+			next := sched.Next(now, time.Time{})
+			So(next.After(now), ShouldBeTrue)
+			now = next
+		}
+	})
+}
+
+func TestHashedSchedule(t *testing.T) {
+	Convey("H is stable for a seed", t, func() {
+		s1, err := Parse("H H * * *", 123)
+		So(err, ShouldBeNil)
+		s2, err := Parse("H H * * *", 123)
+		So(err, ShouldBeNil)
+		So(s1.String(), ShouldEqual, "H H * * *")
+		So(s1.Next(epoch, time.Time{}), ShouldResemble, s2.Next(epoch, time.Time{}))
+	})
+
+	Convey("H depends on a seed", t, func() {
+		ticks := map[time.Time]bool{}
+		for seed := uint64(0); seed < 10; seed++ {
+			sched, err := Parse("H H * * *", seed)
+			So(err, ShouldBeNil)
+			ticks[sched.Next(epoch, time.Time{})] = true
+		}
+		So(len(ticks), ShouldBeGreaterThan, 1)
+	})
+
+	Convey("H respects ranges and steps", t, func() {
+		for seed := uint64(0); seed < 20; seed++ {
+			sched, err := Parse("0 H(2-4) * * *", seed)
+			So(err, ShouldBeNil)
+			hour := sched.Next(epoch, time.Time{}).Hour()
+			So(hour, ShouldBeBetweenOrEqual, 2, 4)
+
+			sched, err = Parse("H/15 * * * *", seed)
+			So(err, ShouldBeNil)
+			first := sched.Next(epoch, time.Time{})
+			second := sched.Next(first, time.Time{})
+			So(second.Sub(first), ShouldEqual, 15*time.Minute)
+		}
+	})
+
+	Convey("H works with time zones", t, func() {
+		sched, err := Parse("TZ=Europe/Berlin H H * * *", 42)
+		So(err, ShouldBeNil)
+		So(sched.Next(epoch, time.Time{}).After(epoch), ShouldBeTrue)
+	})
+
+	Convey("Bad H usage", t, func() {
+		bad := []string{
+			"H * * * * H",       // year
+			"Hx * * * *",        // garbage
+			"H(5-1) * * * *",    // inverted range
+			"H(0-60) * * * *",   // out of range
+			"H/0 * * * *",       // zero step
+			"H/100 * * * *",     // step is too large
+			"0 0 H(1-29)/2 * *", // out of range
+			"H H",               // wrong number of fields
+		}
+		for _, expr := range bad {
+			sched, err := Parse(expr, 0)
+			So(err, ShouldNotBeNil)
+			So(sched, ShouldBeNil)
+		}
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package prpc implements cron tasks that call pRPC methods.
+package prpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/grpcutil"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/prpc"
+)
+
+// maxLoggedResponse is how many bytes of the response to put in the debug log.
+const maxLoggedResponse = 4096
+
+// TaskManager implements task.Manager interface for tasks defined with
+// PRPCTask proto message.
+type TaskManager struct {
+}
+
+// Name is part of Manager interface.
+func (m TaskManager) Name() string {
+	return "prpc"
+}
+
+// ProtoMessageType is part of Manager interface.
+func (m TaskManager) ProtoMessageType() proto.Message {
+	return (*messages.PRPCTask)(nil)
+}
+
+// ValidateProtoMessage is part of Manager interface.
+func (m TaskManager) ValidateProtoMessage(msg proto.Message) error {
+	cfg, ok := msg.(*messages.PRPCTask)
+	if !ok {
+		return fmt.Errorf("wrong type %T, expecting *messages.PRPCTask", msg)
+	}
+
+	// Validate 'server' field.
+	server := cfg.GetServer()
+	if server == "" {
+		return fmt.Errorf("field 'server' is required")
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %s", server, err)
+	}
+	if !u.IsAbs() {
+		return fmt.Errorf("not an absolute url: %q", server)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("unsupported scheme %q, expecting https or http", u.Scheme)
+	}
+	if u.Path != "" {
+		return fmt.Errorf("not a host root url: %q", server)
+	}
+
+	// Service and method fields are required.
+	if cfg.GetService() == "" {
+		return fmt.Errorf("field 'service' is required")
+	}
+	if cfg.GetMethod() == "" {
+		return fmt.Errorf("field 'method' is required")
+	}
+
+	// Validate 'body' field, it must be a JSON object.
+	if cfg.GetBody() != "" {
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(cfg.GetBody()), &body); err != nil {
+			return fmt.Errorf("field 'body' is not a JSON object: %s", err)
+		}
+	}
+
+	// Validate 'timeout_sec' field. GAE task queue request deadline is 10 min, so
+	// limit the call duration to 8 min (giving 2 min to spare).
+	if cfg.GetTimeoutSec() < 1 {
+		return fmt.Errorf("minimum allowed 'timeout_sec' is 1 sec, got %d", cfg.GetTimeoutSec())
+	}
+	if cfg.GetTimeoutSec() > 480 {
+		return fmt.Errorf("maximum allowed 'timeout_sec' is 480 sec, got %d", cfg.GetTimeoutSec())
+	}
+
+	return nil
+}
+
+// LaunchTask is part of Manager interface.
+func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
+	// At this point config is already validated by ValidateProtoMessage.
+	cfg := ctl.Task().(*messages.PRPCTask)
+	u, err := url.Parse(cfg.GetServer())
+	if err != nil {
+		return err
+	}
+	body := cfg.GetBody()
+	if body == "" {
+		body = "{}"
+	}
+	ctl.DebugLog("Calling %s.%s on %s", cfg.GetService(), cfg.GetMethod(), cfg.GetServer())
+	ctl.DebugLog("Request:\n%s", body)
+
+	timeout := time.Duration(cfg.GetTimeoutSec()) * time.Second
+	httpClient, err := ctl.GetClient(timeout)
+	if err != nil {
+		return err
+	}
+	options := prpc.DefaultOptions()
+	options.Insecure = u.Scheme == "http"
+	client := prpc.Client{
+		C:       httpClient,
+		Host:    u.Host,
+		Options: options,
+	}
+
+	// Notify outside world that the task is running (since the call can take up
+	// to 8 minutes). Ignore errors. As long as final Save is OK, we don't care
+	// about this one.
+	ctl.State().Status = task.StatusRunning
+	if err := ctl.Save(); err != nil {
+		logging.Warningf(c, "Failed to save invocation state: %s", err)
+	}
+
+	started := clock.Now(c)
+	ctx, _ := clock.WithTimeout(c, timeout)
+	resp, err := client.CallRaw(ctx, cfg.GetService(), cfg.GetMethod(), []byte(body), prpc.FormatJSONPB, prpc.FormatJSONPB)
+	duration := clock.Now(c).Sub(started)
+
+	// Any status code other than OK (including failures to get one) means the
+	// invocation has failed.
+	code := codes.OK
+	if err != nil {
+		code = grpcutil.Code(err)
+	}
+	status := task.StatusSucceeded
+	if code != codes.OK {
+		status = task.StatusFailed
+	}
+
+	ctl.DebugLog("Finished with overall status %s in %s", status, duration)
+	if err != nil {
+		ctl.DebugLog("pRPC error (code %s): %s", code, err)
+	} else {
+		if len(resp) > maxLoggedResponse {
+			resp = append(resp[:maxLoggedResponse:maxLoggedResponse], "\n<truncated>"...)
+		}
+		ctl.DebugLog("Response:\n%s", resp)
+	}
+	ctl.State().Status = status
+	return nil
+}
+
+// AbortTask is part of Manager interface.
+func (m TaskManager) AbortTask(c context.Context, ctl task.Controller) error {
+	return nil
+}
+
+// HandleNotification is part of Manager interface.
+func (m TaskManager) HandleNotification(c context.Context, ctl task.Controller, msg *pubsub.PubsubMessage) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,140 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package prpc
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+	"github.com/luci/luci-go/appengine/cmd/cron/task/utils/tasktest"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/prpc"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateProtoMessage(t *testing.T) {
+	tm := TaskManager{}
+
+	good := func() *messages.PRPCTask {
+		return &messages.PRPCTask{
+			Server:  strPtr("https://blah.com"),
+			Service: strPtr("cron.Cron"),
+			Method:  strPtr("GetJobs"),
+			Body:    strPtr(`{"project": "abc"}`),
+		}
+	}
+
+	Convey("ValidateProtoMessage passes good msg", t, func() {
+		So(tm.ValidateProtoMessage(good()), ShouldBeNil)
+	})
+
+	Convey("ValidateProtoMessage wrong type", t, func() {
+		So(tm.ValidateProtoMessage(&messages.NoopTask{}), ShouldErrLike, "wrong type")
+	})
+
+	Convey("ValidateProtoMessage empty", t, func() {
+		So(tm.ValidateProtoMessage(tm.ProtoMessageType()), ShouldErrLike, "field 'server' is required")
+	})
+
+	Convey("ValidateProtoMessage validates URL", t, func() {
+		call := func(url string) error {
+			msg := good()
+			msg.Server = &url
+			return tm.ValidateProtoMessage(msg)
+		}
+		So(call("%%%%"), ShouldErrLike, "invalid URL")
+		So(call("/abc"), ShouldErrLike, "not an absolute url")
+		So(call("ftp://host"), ShouldErrLike, "unsupported scheme")
+		So(call("https://host/not-root"), ShouldErrLike, "not a host root url")
+	})
+
+	Convey("ValidateProtoMessage needs service and method", t, func() {
+		msg := good()
+		msg.Service = nil
+		So(tm.ValidateProtoMessage(msg), ShouldErrLike, "field 'service' is required")
+		msg = good()
+		msg.Method = nil
+		So(tm.ValidateProtoMessage(msg), ShouldErrLike, "field 'method' is required")
+	})
+
+	Convey("ValidateProtoMessage validates body", t, func() {
+		msg := good()
+		msg.Body = strPtr("[1, 2]")
+		So(tm.ValidateProtoMessage(msg), ShouldErrLike, "field 'body' is not a JSON object")
+	})
+
+	Convey("ValidateProtoMessage validates timeout", t, func() {
+		msg := good()
+		msg.TimeoutSec = intPtr(0)
+		So(tm.ValidateProtoMessage(msg), ShouldErrLike, "minimum allowed 'timeout_sec' is 1 sec")
+		msg.TimeoutSec = intPtr(10000)
+		So(tm.ValidateProtoMessage(msg), ShouldErrLike, "maximum allowed 'timeout_sec' is 480 sec")
+	})
+}
+
+func TestLaunchTask(t *testing.T) {
+	tm := TaskManager{}
+
+	launch := func(c C, code codes.Code, reply string) *tasktest.TestController {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.So(r.URL.Path, ShouldEqual, "/prpc/cron.Cron/GetJobs")
+			body, err := ioutil.ReadAll(r.Body)
+			c.So(err, ShouldBeNil)
+			c.So(string(body), ShouldEqual, "{}")
+			w.Header().Set(prpc.HeaderGRPCCode, strconv.Itoa(int(code)))
+			w.Header().Set("Content-Type", prpc.ContentTypeJSON)
+			w.Write([]byte(reply))
+		}))
+		defer ts.Close()
+
+		ctl := &tasktest.TestController{
+			TaskMessage: &messages.PRPCTask{
+				Server:  strPtr(ts.URL),
+				Service: strPtr("cron.Cron"),
+				Method:  strPtr("GetJobs"),
+			},
+			Client:       http.DefaultClient,
+			SaveCallback: func() error { return nil },
+		}
+		ctx := clock.Set(context.Background(), testclock.New(time.Unix(0, 1)))
+		c.So(tm.LaunchTask(ctx, ctl), ShouldBeNil)
+		return ctl
+	}
+
+	Convey("LaunchTask works", t, func(c C) {
+		ctl := launch(c, codes.OK, prpc.JSONPBPrefix+`{"jobs": []}`)
+		So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+		So(ctl.Log[2], ShouldStartWith, "Finished with overall status SUCCEEDED")
+		So(ctl.Log[3], ShouldEqual, "Response:\n"+`{"jobs": []}`)
+	})
+
+	Convey("LaunchTask fails on error codes", t, func(c C) {
+		ctl := launch(c, codes.PermissionDenied, "not allowed")
+		So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+		So(ctl.Log[2], ShouldStartWith, "Finished with overall status FAILED")
+		So(ctl.Log[3], ShouldEqual, "pRPC error (code PermissionDenied): rpc error: code = 7 desc = not allowed")
+	})
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int32 {
+	j := int32(i)
+	return &j
+}
@@ -0,0 +1,106 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package pubsub implements cron tasks that publish Cloud Pub/Sub messages.
+package pubsub
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+	"github.com/luci/luci-go/appengine/cmd/cron/task/utils"
+)
+
+// topicRe matches full names of Pub/Sub topics.
+var topicRe = regexp.MustCompile(`^projects/[^/]+/topics/[^/]+$`)
+
+// TaskManager implements task.Manager interface for tasks defined with
+// PubSubTask proto message.
+type TaskManager struct {
+}
+
+// Name is part of Manager interface.
+func (m TaskManager) Name() string {
+	return "pubsub"
+}
+
+// ProtoMessageType is part of Manager interface.
+func (m TaskManager) ProtoMessageType() proto.Message {
+	return (*messages.PubSubTask)(nil)
+}
+
+// ValidateProtoMessage is part of Manager interface.
+func (m TaskManager) ValidateProtoMessage(msg proto.Message) error {
+	cfg, ok := msg.(*messages.PubSubTask)
+	if !ok {
+		return fmt.Errorf("wrong type %T, expecting *messages.PubSubTask", msg)
+	}
+
+	// Validate 'topic' field.
+	if cfg.GetTopic() == "" {
+		return fmt.Errorf("field 'topic' is required")
+	}
+	if !topicRe.MatchString(cfg.GetTopic()) {
+		return fmt.Errorf("bad topic name %q, expecting projects/<project>/topics/<topic>", cfg.GetTopic())
+	}
+
+	// Validate 'attributes' field.
+	return utils.ValidateKVList("attribute", cfg.GetAttributes(), ':')
+}
+
+// LaunchTask is part of Manager interface.
+func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
+	// At this point config is already validated by ValidateProtoMessage.
+	cfg := ctl.Task().(*messages.PubSubTask)
+
+	msg := &pubsub.PubsubMessage{
+		Data: base64.StdEncoding.EncodeToString([]byte(cfg.GetData())),
+	}
+	if len(cfg.Attributes) != 0 {
+		msg.Attributes = make(map[string]string, len(cfg.Attributes))
+		for _, kv := range utils.UnpackKVList(cfg.Attributes, ':') {
+			msg.Attributes[kv.Key] = kv.Value
+		}
+	}
+	ctl.DebugLog("Publishing a message to %q (%d bytes)", cfg.GetTopic(), len(cfg.GetData()))
+
+	client, err := ctl.GetClient(time.Minute, pubsub.PubsubScope)
+	if err != nil {
+		return err
+	}
+	service, err := pubsub.New(client)
+	if err != nil {
+		return err
+	}
+	resp, err := service.Projects.Topics.Publish(cfg.GetTopic(), &pubsub.PublishRequest{
+		Messages: []*pubsub.PubsubMessage{msg},
+	}).Context(c).Do()
+	if err != nil {
+		ctl.DebugLog("Failed to publish the message - %s", err)
+		return utils.WrapAPIError(err)
+	}
+
+	ctl.DebugLog("Published as %v", resp.MessageIds)
+	ctl.State().Status = task.StatusSucceeded
+	return nil
+}
+
+// AbortTask is part of Manager interface.
+func (m TaskManager) AbortTask(c context.Context, ctl task.Controller) error {
+	return nil
+}
+
+// HandleNotification is part of Manager interface.
+func (m TaskManager) HandleNotification(c context.Context, ctl task.Controller, msg *pubsub.PubsubMessage) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,132 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package pubsub
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/messages"
+	"github.com/luci/luci-go/appengine/cmd/cron/task"
+	"github.com/luci/luci-go/appengine/cmd/cron/task/utils/tasktest"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateProtoMessage(t *testing.T) {
+	tm := TaskManager{}
+
+	Convey("ValidateProtoMessage passes good msg", t, func() {
+		So(tm.ValidateProtoMessage(&messages.PubSubTask{
+			Topic:      strPtr("projects/proj/topics/topic"),
+			Data:       strPtr("hello"),
+			Attributes: []string{"a:b"},
+		}), ShouldBeNil)
+	})
+
+	Convey("ValidateProtoMessage wrong type", t, func() {
+		So(tm.ValidateProtoMessage(&messages.NoopTask{}), ShouldErrLike, "wrong type")
+	})
+
+	Convey("ValidateProtoMessage empty", t, func() {
+		So(tm.ValidateProtoMessage(tm.ProtoMessageType()), ShouldErrLike, "field 'topic' is required")
+	})
+
+	Convey("ValidateProtoMessage bad topic", t, func() {
+		So(tm.ValidateProtoMessage(&messages.PubSubTask{
+			Topic: strPtr("topic"),
+		}), ShouldErrLike, "bad topic name")
+	})
+
+	Convey("ValidateProtoMessage bad attributes", t, func() {
+		So(tm.ValidateProtoMessage(&messages.PubSubTask{
+			Topic:      strPtr("projects/proj/topics/topic"),
+			Attributes: []string{"a"},
+		}), ShouldErrLike, "bad attribute")
+	})
+}
+
+func TestLaunchTask(t *testing.T) {
+	tm := TaskManager{}
+
+	Convey("LaunchTask works", t, func(c C) {
+		var path string
+		var req pubsub.PublishRequest
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			body, err := ioutil.ReadAll(r.Body)
+			c.So(err, ShouldBeNil)
+			c.So(json.Unmarshal(body, &req), ShouldBeNil)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"messageIds": ["123"]}`))
+		}))
+		defer ts.Close()
+
+		ctl := &tasktest.TestController{
+			TaskMessage: &messages.PubSubTask{
+				Topic:      strPtr("projects/proj/topics/topic"),
+				Data:       strPtr("hello"),
+				Attributes: []string{"a:b"},
+			},
+			Client: &http.Client{Transport: redirectTransport(ts.URL)},
+		}
+		So(tm.LaunchTask(context.Background(), ctl), ShouldBeNil)
+		So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+		So(ctl.Scopes, ShouldResemble, []string{pubsub.PubsubScope})
+		So(path, ShouldEqual, "/v1/projects/proj/topics/topic:publish")
+		So(req.Messages, ShouldResemble, []*pubsub.PubsubMessage{
+			{
+				Data:       "aGVsbG8=",
+				Attributes: map[string]string{"a": "b"},
+			},
+		})
+		So(ctl.Log, ShouldResemble, []string{
+			`Publishing a message to "projects/proj/topics/topic" (5 bytes)`,
+			"Published as [123]",
+		})
+	})
+
+	Convey("LaunchTask fails on fatal errors", t, func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer ts.Close()
+
+		ctl := &tasktest.TestController{
+			TaskMessage: &messages.PubSubTask{
+				Topic: strPtr("projects/proj/topics/topic"),
+			},
+			Client: &http.Client{Transport: redirectTransport(ts.URL)},
+		}
+		err := tm.LaunchTask(context.Background(), ctl)
+		So(err, ShouldErrLike, "403")
+		So(ctl.TaskState.Status, ShouldEqual, "")
+	})
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// redirectTransport sends all requests to the given test server.
+type redirectTransport string
+
+func (t redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	u, err := url.Parse(string(t))
+	if err != nil {
+		return nil, err
+	}
+	r.URL.Scheme = u.Scheme
+	r.URL.Host = u.Host
+	return http.DefaultTransport.RoundTrip(r)
+}
@@ -153,8 +153,9 @@ type Controller interface {
 	// account credentials to talk to other services.
 	//
 	// All requests made by the client must finish before given deadline time
-	// (or they will be forcefully aborted).
-	GetClient(timeout time.Duration) (*http.Client, error)
+	// (or they will be forcefully aborted). If no OAuth scopes are given, only
+	// the email scope is requested.
+	GetClient(timeout time.Duration, scopes ...string) (*http.Client, error)
 
 	// DebugLog appends a line to the free form text log of the task.
 	// For debugging.
@@ -23,6 +23,7 @@ type TestController struct {
 	TaskMessage proto.Message // return value of Task
 	TaskState   task.State    // return value of State(), mutated in place
 	Client      *http.Client  // return value by GetClient()
+	Scopes      []string      // OAuth scopes passed to GetClient()
 	Log         []string      // individual log lines passed to DebugLog()
 
 	SaveCallback         func() error                         // mock for Save()
@@ -85,8 +86,9 @@ func (c *TestController) PrepareTopic(publisher string) (topic string, token str
 }
 
 // GetClient is part of Controller interface.
-func (c *TestController) GetClient(timeout time.Duration) (*http.Client, error) {
+func (c *TestController) GetClient(timeout time.Duration, scopes ...string) (*http.Client, error) {
 	if c.Client != nil {
+		c.Scopes = scopes
 		return c.Client, nil
 	}
 	return nil, errors.New("GetClient must not be called (not mocked)")
@@ -8,13 +8,34 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/luci/luci-go/server/auth"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
+	"github.com/luci/luci-go/appengine/cmd/cron/engine"
 )
 
-func isJobOwner(c context.Context, projectID, jobID string) bool {
-	// TODO(vadimsh): Do real ACLs.
-	ok, err := auth.IsMember(c, "administrators")
+// hasJobRole returns true if the current caller has the given role in the job.
+func hasJobRole(c context.Context, job *engine.CronJob, role acl.Role) bool {
+	ok, err := job.Acls.HasRole(c, auth.CurrentIdentity(c), role)
 	if err != nil {
 		panic(err)
 	}
 	return ok
 }
+
+// readableJobs returns only the jobs the current caller can see.
+func readableJobs(c context.Context, jobs []*engine.CronJob) []*engine.CronJob {
+	out := make([]*engine.CronJob, 0, len(jobs))
+	for _, job := range jobs {
+		if hasJobRole(c, job, acl.Reader) {
+			out = append(out, job)
+		}
+	}
+	return out
+}
+
+// fillJobPermissions populates fields of cronJob that define what UI actions
+// are available to the current caller.
+func fillJobPermissions(c context.Context, job *engine.CronJob, out *cronJob) {
+	out.CanTrigger = hasJobRole(c, job, acl.Triggerer)
+	out.CanOwn = hasJobRole(c, job, acl.Owner)
+}
@@ -20,6 +20,6 @@ func indexPage(c context.Context, w http.ResponseWriter, r *http.Request, p http
 		panic(err)
 	}
 	templates.MustRender(c, w, "pages/index.html", map[string]interface{}{
-		"Jobs": convertToSortedCronJobs(jobs, clock.Now(c).UTC()),
+		"Jobs": convertToSortedCronJobs(c, jobs, clock.Now(c).UTC()),
 	})
 }
@@ -15,6 +15,9 @@ import (
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/server/auth"
 	"github.com/luci/luci-go/server/templates"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
+	"github.com/luci/luci-go/appengine/cmd/cron/engine"
 )
 
 func invocationPage(c context.Context, w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -26,7 +29,20 @@ func invocationPage(c context.Context, w http.ResponseWriter, r *http.Request, p
 		return
 	}
 
-	inv, err := config(c).Engine.GetInvocation(c, projectID+"/"+jobID, invID)
+	job, err := config(c).Engine.GetCronJob(c, projectID+"/"+jobID)
+	if err != nil {
+		panic(err)
+	}
+	if job == nil {
+		http.Error(w, "No such job", http.StatusNotFound)
+		return
+	}
+	if !hasJobRole(c, job, acl.Reader) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	inv, err := config(c).Engine.GetInvocation(c, job.JobID, invID)
 	if err != nil {
 		panic(err)
 	}
@@ -36,10 +52,12 @@ func invocationPage(c context.Context, w http.ResponseWriter, r *http.Request, p
 	}
 
 	now := clock.Now(c).UTC()
+	invocation := makeInvocation(projectID, jobID, inv, now)
+	invocation.CanAbort = hasJobRole(c, job, acl.Owner)
 	templates.MustRender(c, w, "pages/invocation.html", map[string]interface{}{
 		"ProjectID": projectID,
 		"JobID":     jobID,
-		"Inv":       makeInvocation(projectID, jobID, inv, now),
+		"Inv":       invocation,
 	})
 }
 
@@ -57,16 +75,16 @@ func handleInvAction(c context.Context, w http.ResponseWriter, r *http.Request,
 	projectID := p.ByName("ProjectID")
 	jobID := p.ByName("JobID")
 	invID := p.ByName("InvID")
-	if !isJobOwner(c, projectID, jobID) {
-		http.Error(w, "Forbidden", 403)
-		return
-	}
 	invIDAsInt, err := strconv.ParseInt(invID, 10, 64)
 	if err != nil {
 		http.Error(w, "Bad invocation ID", 400)
 		return
 	}
-	if err := cb(projectID+"/"+jobID, invIDAsInt); err != nil {
+	switch err := cb(projectID+"/"+jobID, invIDAsInt); {
+	case err == engine.ErrNoPermission:
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	case err != nil:
 		panic(err)
 	}
 	http.Redirect(w, r, fmt.Sprintf("/jobs/%s/%s/%s", projectID, jobID, invID), http.StatusFound)
@@ -18,6 +18,9 @@ import (
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/server/auth"
 	"github.com/luci/luci-go/server/templates"
+
+	"github.com/luci/luci-go/appengine/cmd/cron/acl"
+	"github.com/luci/luci-go/appengine/cmd/cron/engine"
 )
 
 func jobPage(c context.Context, w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -34,6 +37,10 @@ func jobPage(c context.Context, w http.ResponseWriter, r *http.Request, p httpro
 		http.Error(w, "No such job", http.StatusNotFound)
 		return
 	}
+	if !hasJobRole(c, job, acl.Reader) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
 
 	// Grab latest invocations from the datastore.
 	invs, nextCursor, err := config(c).Engine.ListInvocations(c, job.JobID, 50, cursor)
@@ -72,8 +79,10 @@ func jobPage(c context.Context, w http.ResponseWriter, r *http.Request, p httpro
 	}
 
 	now := clock.Now(c).UTC()
+	cronJob := makeCronJob(job, now)
+	fillJobPermissions(c, job, cronJob)
 	templates.MustRender(c, w, "pages/job.html", map[string]interface{}{
-		"Job":         makeCronJob(job, now),
+		"Job":         cronJob,
 		"Invocations": convertToInvocations(job.ProjectID, job.JobID, invs, now),
 		"PrevCursor":  prevCursor,
 		"NextCursor":  nextCursor,
@@ -86,10 +95,6 @@ func jobPage(c context.Context, w http.ResponseWriter, r *http.Request, p httpro
 func runJobAction(c context.Context, w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 	projectID := p.ByName("ProjectID")
 	jobID := p.ByName("JobID")
-	if !isJobOwner(c, projectID, jobID) {
-		http.Error(w, "Forbidden", 403)
-		return
-	}
 
 	// genericReply renders "we did something (or we failed to do something)"
 	// page, shown on error or if invocation is starting for too long.
@@ -106,7 +111,11 @@ func runJobAction(c context.Context, w http.ResponseWriter, r *http.Request, p h
 	e := config(c).Engine
 	fullJobID := projectID + "/" + jobID
 	invNonce, err := e.TriggerInvocation(c, fullJobID, auth.CurrentIdentity(c))
-	if err != nil {
+	switch {
+	case err == engine.ErrNoPermission:
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	case err != nil:
 		genericReply(err)
 		return
 	}
@@ -157,11 +166,11 @@ func resumeJobAction(c context.Context, w http.ResponseWriter, r *http.Request,
 func handleJobAction(c context.Context, w http.ResponseWriter, r *http.Request, p httprouter.Params, cb func(string) error) {
 	projectID := p.ByName("ProjectID")
 	jobID := p.ByName("JobID")
-	if !isJobOwner(c, projectID, jobID) {
-		http.Error(w, "Forbidden", 403)
+	switch err := cb(projectID + "/" + jobID); {
+	case err == engine.ErrNoPermission:
+		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
-	}
-	if err := cb(projectID + "/" + jobID); err != nil {
+	case err != nil:
 		panic(err)
 	}
 	http.Redirect(w, r, fmt.Sprintf("/jobs/%s/%s", projectID, jobID), http.StatusFound)
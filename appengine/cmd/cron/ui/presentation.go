@@ -13,7 +13,9 @@ import (
 
 	"github.com/dustin/go-humanize"
 	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
 
+	"github.com/luci/luci-go/appengine/cmd/cron/catalog"
 	"github.com/luci/luci-go/appengine/cmd/cron/engine"
 	"github.com/luci/luci-go/appengine/cmd/cron/messages"
 	"github.com/luci/luci-go/appengine/cmd/cron/schedule"
@@ -32,10 +34,59 @@ type cronJob struct {
 	NextRun     string
 	Paused      bool
 	LabelClass  string
+	Triggers    []jobTrigger
+	Stats       *jobStats
+
+	// CanTrigger is true if the current caller can trigger the job.
+	CanTrigger bool
+	// CanOwn is true if the current caller can pause or resume the job.
+	CanOwn bool
 
 	sortKey string
 }
 
+// jobTrigger is a job triggered when an invocation of some job finishes.
+type jobTrigger struct {
+	JobID     string // "<project>/<job>"
+	Condition string // "on success", "on failure" or "always"
+}
+
+func makeJobTriggers(t *catalog.Triggers) []jobTrigger {
+	var out []jobTrigger
+	add := func(jobIDs []string, cond string) {
+		for _, id := range jobIDs {
+			out = append(out, jobTrigger{JobID: id, Condition: cond})
+		}
+	}
+	add(t.OnSuccess, "on success")
+	add(t.OnFailure, "on failure")
+	add(t.Always, "always")
+	return out
+}
+
+// jobStats summarizes the most recent finished invocations of a job.
+type jobStats struct {
+	Count       int    // number of invocations the stats are based on
+	SuccessRate string // e.g. "95%"
+	P50         string // median duration
+	P95         string // 95th percentile of duration
+	Overruns    int    // total number of overruns
+}
+
+func makeJobStats(s *engine.JobStats) *jobStats {
+	summary := s.Summary()
+	if summary.Count == 0 {
+		return nil
+	}
+	return &jobStats{
+		Count:       summary.Count,
+		SuccessRate: fmt.Sprintf("%.0f%%", summary.SuccessRate*100),
+		P50:         summary.P50.String(),
+		P95:         summary.P95.String(),
+		Overruns:    summary.Overruns,
+	}
+}
+
 var stateToLabelClass = map[engine.StateKind]string{
 	engine.JobStateDisabled:  "label-default",
 	engine.JobStateScheduled: "label-primary",
@@ -44,11 +95,14 @@ var stateToLabelClass = map[engine.StateKind]string{
 	engine.JobStateRunning:   "label-info",
 	engine.JobStateOverrun:   "label-warning",
 	engine.JobStateSlowQueue: "label-warning",
+	engine.JobStateRetrying:  "label-warning",
 }
 
 func makeCronJob(j *engine.CronJob, now time.Time) *cronJob {
 	nextRun := ""
 	switch ts := j.State.TickTime; {
+	case j.State.State == engine.JobStateRetrying:
+		nextRun = "retry " + humanize.RelTime(j.State.RetryTime, now, "ago", "from now")
 	case ts == schedule.DistantFuture:
 		nextRun = "-"
 	case !ts.IsZero():
@@ -72,6 +126,8 @@ func makeCronJob(j *engine.CronJob, now time.Time) *cronJob {
 		NextRun:     nextRun,
 		Paused:      j.Paused,
 		LabelClass:  stateToLabelClass[j.State.State],
+		Triggers:    makeJobTriggers(&j.Triggers),
+		Stats:       makeJobStats(&j.Stats),
 
 		sortKey: j.JobID,
 	}
@@ -98,10 +154,14 @@ func (s sortedCronJobs) Len() int           { return len(s) }
 func (s sortedCronJobs) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s sortedCronJobs) Less(i, j int) bool { return s[i].sortKey < s[j].sortKey }
 
-func convertToSortedCronJobs(jobs []*engine.CronJob, now time.Time) sortedCronJobs {
+// convertToSortedCronJobs converts jobs visible to the current caller to
+// cronJob structs, sorted by job ID.
+func convertToSortedCronJobs(c context.Context, jobs []*engine.CronJob, now time.Time) sortedCronJobs {
+	jobs = readableJobs(c, jobs)
 	out := make(sortedCronJobs, len(jobs))
 	for i, job := range jobs {
 		out[i] = makeCronJob(job, now)
+		fillJobPermissions(c, job, out[i])
 	}
 	sort.Sort(out)
 	return out
@@ -123,6 +183,30 @@ type invocation struct {
 	RowClass    string
 	LabelClass  string
 	ViewURL     string
+
+	// RetryOf is ID of a failed invocation this one retries, or 0.
+	RetryOf int64
+	// RetriedAs is ID of an invocation that retries this one, or 0.
+	RetriedAs int64
+
+	// UpstreamJobID is "<project>/<job>" of a job that triggered this
+	// invocation, or "".
+	UpstreamJobID string
+	// UpstreamInvID is ID of an invocation that triggered this one, or 0.
+	UpstreamInvID int64
+
+	// Triggered is a list of invocations triggered by this one.
+	Triggered []triggeredInvocation
+
+	// CanAbort is true if the current caller can abort the invocation.
+	CanAbort bool
+}
+
+// triggeredInvocation is a reference to an invocation triggered by another
+// invocation.
+type triggeredInvocation struct {
+	JobID string // "<project>/<job>"
+	InvID int64
 }
 
 var statusToRowClass = map[task.Status]string{
@@ -151,10 +235,20 @@ func makeInvocation(projecID, jobID string, i *engine.Invocation, now time.Time)
 			triggeredBy = i.TriggeredBy.Email() // triggered by a user (not a service)
 		}
 	}
+	if i.UpstreamJobID != "" {
+		triggeredBy = i.UpstreamJobID // triggered by another job
+	}
 	finished := i.Finished
 	if finished.IsZero() {
 		finished = now
 	}
+	var triggered []triggeredInvocation
+	for idx, id := range i.TriggeredInvocationIDs {
+		triggered = append(triggered, triggeredInvocation{
+			JobID: i.TriggeredJobIDs[idx],
+			InvID: id,
+		})
+	}
 	duration := humanize.RelTime(i.Started, finished, "", "")
 	if duration == "now" {
 		duration = "1 second" // "now" looks weird for durations
@@ -175,6 +269,12 @@ func makeInvocation(projecID, jobID string, i *engine.Invocation, now time.Time)
 		RowClass:    statusToRowClass[i.Status],
 		LabelClass:  statusToLabelClass[i.Status],
 		ViewURL:     i.ViewURL,
+		RetryOf:     i.RetryOf,
+		RetriedAs:   i.RetriedAs,
+
+		UpstreamJobID: i.UpstreamJobID,
+		UpstreamInvID: i.UpstreamInvocationID,
+		Triggered:     triggered,
 	}
 }
 
@@ -22,6 +22,6 @@ func projectPage(c context.Context, w http.ResponseWriter, r *http.Request, p ht
 	}
 	templates.MustRender(c, w, "pages/project.html", map[string]interface{}{
 		"ProjectID": projectID,
-		"Jobs":      convertToSortedCronJobs(jobs, clock.Now(c).UTC()),
+		"Jobs":      convertToSortedCronJobs(c, jobs, clock.Now(c).UTC()),
 	})
 }
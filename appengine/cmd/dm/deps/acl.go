@@ -0,0 +1,28 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"github.com/luci/luci-go/common/grpcutil"
+	"github.com/luci/luci-go/server/auth"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// adminGroup is the group whose members may make changes to arbitrary
+// Attempts, e.g. cancel or invalidate them.
+const adminGroup = "administrators"
+
+// requireAdmin returns a PermissionDenied error if the caller isn't a member
+// of adminGroup.
+func requireAdmin(c context.Context) error {
+	switch admin, err := auth.IsMember(c, adminGroup); {
+	case err != nil:
+		return grpcutil.MaybeLogErr(c, err, codes.Internal, "failed to check group membership")
+	case !admin:
+		return grpcutil.Errf(codes.PermissionDenied, "%s is not a member of %q", auth.CurrentIdentity(c), adminGroup)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/luci/luci-go/appengine/cmd/dm/model"
 	"github.com/luci/luci-go/appengine/tumble"
 	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock/testclock"
 	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -114,6 +115,63 @@ func TestAddDeps(t *testing.T) {
 				So(ds.Get(fwd), ShouldBeNil)
 			})
 
+			Convey("deps with expired results", func() {
+				to.State = dm.Attempt_FINISHED
+				to.ResultExpiration = testclock.TestTimeUTC.Add(-time.Hour)
+				So(ds.Put(fwd, to), ShouldBeNil)
+
+				Convey("are re-executed", func() {
+					rsp, err := s.EnsureGraphData(c, req)
+					So(err, ShouldBeNil)
+					So(rsp, ShouldResemble, &dm.EnsureGraphDataRsp{ShouldHalt: true})
+
+					So(ds.Get(a, fwd), ShouldBeNil)
+					So(a.State, ShouldEqual, dm.Attempt_ADDING_DEPS)
+
+					ttest.Drain(c)
+					So(ds.Get(to), ShouldBeNil)
+					So(to.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				})
+
+				Convey("with the NEW_ATTEMPT policy", func() {
+					toQuest.Desc.ExpiredResultPolicy = dm.Quest_Desc_NEW_ATTEMPT
+					So(ds.Put(toQuest), ShouldBeNil)
+					next := &model.Attempt{ID: *dm.NewAttemptID(toQuest.ID, 2)}
+					nextFwd := &model.FwdDep{Depender: ak, Dependee: next.ID}
+
+					Convey("are superseded by a new attempt", func() {
+						rsp, err := s.EnsureGraphData(c, req)
+						So(err, ShouldBeNil)
+						So(rsp, ShouldResemble, &dm.EnsureGraphDataRsp{ShouldHalt: true})
+
+						So(ds.Get(a), ShouldBeNil)
+						So(a.State, ShouldEqual, dm.Attempt_ADDING_DEPS)
+						So(ds.Get(nextFwd), ShouldBeNil)
+
+						ttest.Drain(c)
+						So(ds.Get(a, next, to), ShouldBeNil)
+						So(a.State, ShouldEqual, dm.Attempt_BLOCKED)
+						So(next.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+						So(to.State, ShouldEqual, dm.Attempt_FINISHED)
+					})
+
+					Convey("are answered with the new attempt's result", func() {
+						next.State = dm.Attempt_FINISHED
+						So(ds.Put(next, nextFwd), ShouldBeNil)
+
+						rsp, err := s.EnsureGraphData(c, req)
+						So(err, ShouldBeNil)
+						So(rsp.Accepted, ShouldBeTrue)
+						So(rsp.ShouldHalt, ShouldBeFalse)
+						So(rsp.Result.Quests[toQuest.ID].Attempts, ShouldContainKey, uint32(2))
+						So(rsp.Result.Quests[toQuest.ID].Attempts, ShouldNotContainKey, uint32(1))
+
+						So(ds.Get(a), ShouldBeNil)
+						So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+					})
+				})
+			})
+
 			Convey("adding new deps", func() {
 				So(ds.Put(&model.Quest{ID: "to"}), ShouldBeNil)
 
@@ -0,0 +1,22 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"github.com/luci/luci-go/appengine/cmd/dm/mutate"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	google_pb "github.com/luci/luci-go/common/proto/google"
+	"golang.org/x/net/context"
+)
+
+func (d *deps) CancelAttempt(c context.Context, req *dm.CancelAttemptReq) (*google_pb.Empty, error) {
+	if err := requireAdmin(c); err != nil {
+		return nil, err
+	}
+	return &google_pb.Empty{}, tumbleNow(c, &mutate.CancelAttempt{
+		ID:     req.Attempt,
+		Reason: req.Reason,
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestCancelAttempt(t *testing.T) {
+	t.Parallel()
+
+	Convey("CancelAttempt", t, func() {
+		c := memory.Use(context.Background())
+		ds := datastore.Get(c)
+		s := newDecoratedDeps()
+
+		a := &model.Attempt{ID: *dm.NewAttemptID("quest", 1), State: dm.Attempt_BLOCKED}
+		So(ds.Put(&model.Quest{ID: "quest"}, a), ShouldBeNil)
+
+		req := &dm.CancelAttemptReq{Attempt: &a.ID, Reason: "not needed"}
+
+		Convey("bad", func() {
+			Convey("not an admin", func() {
+				c = auth.WithState(c, &authtest.FakeState{Identity: "user:someone@example.com"})
+				_, err := s.CancelAttempt(c, req)
+				So(err, ShouldBeRPCPermissionDenied, `not a member of "administrators"`)
+			})
+
+			Convey("failed membership check", func() {
+				c = auth.WithState(c, &authtest.FakeState{
+					Identity: "user:someone@example.com",
+					Error:    errors.New("boom"),
+				})
+				_, err := s.CancelAttempt(c, req)
+				So(err, ShouldBeRPCInternal, "failed to check group membership")
+			})
+
+			Convey("no auth state", func() {
+				_, err := s.CancelAttempt(c, req)
+				So(err, ShouldBeRPCInternal)
+			})
+
+			So(ds.Get(a), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_BLOCKED)
+		})
+
+		Convey("good", func() {
+			c = auth.WithState(c, &authtest.FakeState{
+				Identity:       "user:admin@example.com",
+				IdentityGroups: []string{"administrators"},
+			})
+			_, err := s.CancelAttempt(c, req)
+			So(err, ShouldBeNil)
+
+			So(ds.Get(a), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+			So(a.AbnormalFinishReason, ShouldEqual, "cancelled: not needed")
+		})
+	})
+}
@@ -7,12 +7,14 @@ package deps
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 
 	"github.com/luci/gae/service/datastore"
 
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/grpcutil"
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/parallel"
@@ -54,13 +56,61 @@ func (d *deps) runEnsureGraphDepsWalk(c context.Context, req *dm.EnsureGraphData
 	return qryRsp, nil
 }
 
-func allFinished(gd *dm.GraphData) bool {
+// resultExpired returns true iff atmpt is finished, but its result expired at
+// or before now.
+func resultExpired(now time.Time, atmpt *dm.Attempt) bool {
+	f := atmpt.Data.GetFinished()
+	if f == nil {
+		return false
+	}
+	exp := f.Expiration.Time()
+	return !exp.IsZero() && !now.Before(exp)
+}
+
+// supersedeExpired returns a copy of atmpts in which every Attempt of a Quest
+// with the NEW_ATTEMPT ExpiredResultPolicy whose result expired (according to
+// gd) is replaced by the next Attempt of its Quest. It returns nil if there are
+// no such Attempts.
+func supersedeExpired(c context.Context, gd *dm.GraphData, atmpts *dm.AttemptList) *dm.AttemptList {
+	now := clock.Now(c)
+	var ret *dm.AttemptList
+	for qid, nums := range atmpts.To {
+		qst := gd.Quests[qid]
+		desc := qst.GetData().GetDesc()
+		if desc == nil || desc.ExpiredResultPolicy != dm.Quest_Desc_NEW_ATTEMPT {
+			continue
+		}
+		for i, num := range nums.Nums {
+			if atmpt := qst.GetAttempts()[num]; atmpt != nil && resultExpired(now, atmpt) {
+				if ret == nil {
+					ret = atmpts.Dup()
+				}
+				ret.To[qid].Nums[i] = num + 1
+			}
+		}
+	}
+	if ret != nil {
+		if err := ret.Normalize(); err != nil {
+			panic(err)
+		}
+	}
+	return ret
+}
+
+// allFinished returns true iff all of the Attempts in gd are finished. Attempts
+// whose results have expired don't count (see resultExpired), since depending
+// on them will re-execute them.
+func allFinished(c context.Context, gd *dm.GraphData) bool {
+	now := clock.Now(c)
 	for _, qst := range gd.Quests {
 		if qst.DNE {
 			return false
 		}
 		for _, atmpt := range qst.Attempts {
-			if atmpt.DNE || atmpt.Data.GetFinished() == nil {
+			if atmpt.DNE || resultExpired(now, atmpt) {
+				return false
+			}
+			if atmpt.Data.GetFinished() == nil && atmpt.Data.GetAbnormalFinish() == nil {
 				return false
 			}
 		}
@@ -117,6 +167,31 @@ func depsFromMissing(c context.Context, fwdDepKeys []*datastore.Key, exists data
 	return
 }
 
+// depsFromExpired returns the deps in fwdDepKeys which already exist, but
+// whose Attempts' results in gd have expired since (see resultExpired).
+func depsFromExpired(c context.Context, gd *dm.GraphData, fwdDepKeys []*datastore.Key, exists datastore.BoolList) (ret *dm.AttemptList) {
+	ret = dm.NewAttemptList(nil)
+	now := clock.Now(c)
+	tmpAID := &dm.Attempt_ID{}
+	for i, fkey := range fwdDepKeys {
+		if !exists[i] {
+			continue
+		}
+		if err := tmpAID.SetDMEncoded(fkey.StringID()); err != nil {
+			logging.Fields{
+				logging.ErrorKey:  err,
+				"FwdDep.StringID": fkey.StringID(),
+			}.Errorf(c, "impossible parsing error")
+			panic(err)
+		}
+		qst := gd.Quests[tmpAID.Quest]
+		if atmpt := qst.GetAttempts()[tmpAID.Id]; atmpt != nil && resultExpired(now, atmpt) {
+			ret.AddAIDs(tmpAID)
+		}
+	}
+	return
+}
+
 func journalQuestAttempts(c context.Context, newQuests []*model.Quest, newAttempts *dm.AttemptList) error {
 	if len(newQuests) == 0 && len(newAttempts.To) == 0 {
 		return nil
@@ -176,6 +251,15 @@ func (d *deps) ensureGraphData(c context.Context, req *dm.EnsureGraphDataReq, ne
 		return grpcutil.MaybeLogErr(c, err, codes.Internal, "failed to gather prerequisites")
 	}
 
+	// Dependants don't get expired results of NEW_ATTEMPT Quests: they depend on
+	// the next Attempt of the Quest instead, which computes a fresh result.
+	if req.ForExecution != nil {
+		if next := supersedeExpired(c, rsp.Result, newAttempts); next != nil {
+			logging.Fields{"atmpts": next}.Infof(c, "superseding attempts with expired results")
+			return d.ensureGraphData(c, req, newQuests, next, rsp)
+		}
+	}
+
 	// Now that we've walked the graph, prune the lists of new Quest and Attempts
 	// by the information retrieved in the graph walk. newQuest and newAttempts
 	// will be reduced to contain only the missing information.
@@ -197,8 +281,16 @@ func (d *deps) ensureGraphData(c context.Context, req *dm.EnsureGraphDataReq, ne
 	// we're asserting nodes+edges
 	missingDeps := depsFromMissing(c, fwdDepKeys, fwdDepExists.List(0))
 
-	// we have no missing deps, or all the attempts we want are finished already
-	if len(missingDeps.To) == 0 || allFinished(rsp.Result) {
+	// the results of deps we already have may have expired, in which case we
+	// have to wait for them again.
+	expiredDeps := depsFromExpired(c, rsp.Result, fwdDepKeys, fwdDepExists.List(0))
+	if len(expiredDeps.To) > 0 {
+		logging.Fields{"deps": expiredDeps}.Infof(c, "re-adding deps with expired results")
+	}
+
+	// we have no missing or expired deps, or all the attempts we want are
+	// finished already
+	if len(expiredDeps.To) == 0 && (len(missingDeps.To) == 0 || allFinished(c, rsp.Result)) {
 		// if we have new quests to journal, or deps to add, journal them.
 		if len(newQuests) > 0 || len(missingDeps.To) > 0 {
 			err = tumbleNow(c, &mutate.AddFinishedDeps{
@@ -226,6 +318,8 @@ func (d *deps) ensureGraphData(c context.Context, req *dm.EnsureGraphDataReq, ne
 		Atmpts: newAttempts,
 		// Deps we think are missing (>= newAttempts)
 		Deps: missingDeps,
+		// Deps we already have, but whose results expired
+		StaleDeps: expiredDeps,
 	})
 }
 
@@ -0,0 +1,115 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/cmd/dm/mutate"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/cryptorand"
+	"github.com/luci/luci-go/common/grpcutil"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/mathrand"
+	google_pb "github.com/luci/luci-go/common/proto/google"
+	"golang.org/x/net/context"
+)
+
+const claimRetries = 8
+
+// ClaimExecution searches for an Attempt whose state is NeedsExecution, and
+// leases a new Execution for it to the caller.
+func (d *deps) ClaimExecution(c context.Context, req *dm.ClaimExecutionReq) (*dm.ClaimExecutionRsp, error) {
+	ds := datastore.Get(c)
+
+	actTok := make([]byte, 32)
+	if _, err := cryptorand.Read(c, actTok); err != nil {
+		return nil, grpcutil.MaybeLogErr(c, err, codes.Internal, "generating activation token")
+	}
+	expiration := clock.Now(c).UTC().Add(req.LeaseDuration.Duration())
+
+	for attempts := 0; attempts < claimRetries; attempts++ {
+		q := datastore.NewQuery("Attempt").Eq("State", dm.Attempt_NEEDS_EXECUTION).Limit(32)
+
+		if attempts < claimRetries-1 {
+			prefixBytes := make([]byte, 2)
+			if _, err := cryptorand.Read(c, prefixBytes); err != nil {
+				return nil, grpcutil.MaybeLogErr(c, err, codes.Internal, "generating query prefix")
+			}
+			prefix := hex.EncodeToString(prefixBytes)
+			logging.Infof(c, "Making query (prefix=%s)", prefix)
+			q = q.Gte("__key__", ds.MakeKey("Attempt", prefix))
+		} else {
+			logging.Infof(c, "Making query (no prefix)")
+		}
+
+		as := []*model.Attempt{}
+		if err := ds.GetAll(q, &as); err != nil {
+			return nil, grpcutil.MaybeLogErr(c, err, codes.Internal, "querying attempts")
+		}
+		logging.Infof(c, "Got %d attempts", len(as))
+
+		// Try them in a random order, so that concurrent claimers are unlikely to
+		// contend on the same Attempt.
+		for _, i := range mathrand.Get(c).Perm(len(as)) {
+			a := as[i]
+			if a.State != dm.Attempt_NEEDS_EXECUTION {
+				continue
+			}
+
+			eid := dm.NewExecutionID(a.ID.Quest, a.ID.Id, a.CurExecution+1)
+			err := tumbleNow(c, &mutate.ClaimExecution{
+				EID:             eid,
+				Token:           actTok,
+				LeaseExpiration: expiration,
+			})
+			if grpc.Code(err) == codes.FailedPrecondition {
+				// Someone else got to it first, try the next one.
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			qst := &model.Quest{ID: a.ID.Quest}
+			if err := ds.Get(qst); err != nil {
+				return nil, grpcutil.MaybeLogErr(c, err, codes.Internal, "loading quest")
+			}
+			return &dm.ClaimExecutionRsp{
+				Quest:           qst.ToProto(),
+				Auth:            &dm.Execution_Auth{Id: eid, Token: actTok},
+				LeaseExpiration: google_pb.NewTimestamp(expiration),
+			}, nil
+		}
+	}
+
+	return nil, grpcutil.Errf(codes.NotFound, "no Attempts need execution")
+}
+
+// RenewExecutionLease extends the lease on an Execution claimed with
+// ClaimExecution.
+func (d *deps) RenewExecutionLease(c context.Context, req *dm.RenewExecutionLeaseReq) (*dm.RenewExecutionLeaseRsp, error) {
+	expiration := clock.Now(c).UTC().Add(req.LeaseDuration.Duration())
+	err := tumbleNow(c, &mutate.RenewExecutionLease{
+		Auth:            req.Auth,
+		LeaseExpiration: expiration,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dm.RenewExecutionLeaseRsp{LeaseExpiration: google_pb.NewTimestamp(expiration)}, nil
+}
+
+// ReleaseExecutionLease gives up the lease on an Execution claimed with
+// ClaimExecution.
+func (d *deps) ReleaseExecutionLease(c context.Context, req *dm.ReleaseExecutionLeaseReq) (*google_pb.Empty, error) {
+	return &google_pb.Empty{}, tumbleNow(c, &mutate.ReleaseExecutionLease{Auth: req.Auth})
+}
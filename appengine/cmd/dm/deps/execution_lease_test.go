@@ -0,0 +1,124 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	dm "github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	google_pb "github.com/luci/luci-go/common/proto/google"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExecutionLease(t *testing.T) {
+	t.Parallel()
+
+	Convey("Test execution leases", t, func() {
+		ttest := &tumble.Testing{}
+		c := ttest.Context()
+		ttest.EnableDelayedMutations(c)
+		clk := clock.Get(c).(testclock.TestClock)
+		ds := datastore.Get(c)
+		s := newDecoratedDeps()
+
+		Convey("no attempts to claim", func() {
+			_, err := s.ClaimExecution(c, &dm.ClaimExecutionReq{})
+			So(err, ShouldBeRPCNotFound, "no Attempts need execution")
+		})
+
+		Convey("claimed", func() {
+			qid := ensureQuest(c, "foo", 1)
+			ttest.Drain(c)
+
+			rsp, err := s.ClaimExecution(c, &dm.ClaimExecutionReq{
+				LeaseDuration: google_pb.NewDuration(time.Minute)})
+			So(err, ShouldBeNil)
+			So(rsp.Quest.Id.Id, ShouldEqual, qid)
+			So(rsp.Auth.Id, ShouldResemble, dm.NewExecutionID(qid, 1, 1))
+			So(rsp.LeaseExpiration.Time(), ShouldResemble, clk.Now().UTC().Add(time.Minute))
+
+			a := &model.Attempt{ID: *dm.NewAttemptID(qid, 1)}
+			e := &model.Execution{ID: 1, Attempt: ds.KeyForObj(a)}
+			So(ds.Get(a, e), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+			So(e.State, ShouldEqual, dm.Execution_SCHEDULED)
+
+			_, err = s.ClaimExecution(c, &dm.ClaimExecutionReq{})
+			So(err, ShouldBeRPCNotFound)
+
+			Convey("can be renewed", func() {
+				clk.Add(30 * time.Second)
+				rrsp, err := s.RenewExecutionLease(c, &dm.RenewExecutionLeaseReq{
+					Auth: rsp.Auth, LeaseDuration: google_pb.NewDuration(2 * time.Minute)})
+				So(err, ShouldBeNil)
+				So(rrsp.LeaseExpiration.Time(), ShouldResemble, clk.Now().UTC().Add(2*time.Minute))
+
+				// The original lease's timeout doesn't do anything.
+				clk.Add(45 * time.Second)
+				ttest.Drain(c)
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+				So(e.State, ShouldEqual, dm.Execution_SCHEDULED)
+
+				Convey("with the activated token", func() {
+					auth := activate(c, rsp.Auth)
+					_, err := s.RenewExecutionLease(c, &dm.RenewExecutionLeaseReq{Auth: rsp.Auth})
+					So(err, ShouldBeRPCUnauthenticated, "execution lease Auth")
+					_, err = s.RenewExecutionLease(c, &dm.RenewExecutionLeaseReq{Auth: auth})
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey("times out", func() {
+				clk.Add(time.Minute)
+				ttest.Drain(c)
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(a.FailedExecutions, ShouldEqual, 1)
+				So(e.State, ShouldEqual, dm.Execution_MISSING)
+				So(e.StateReason, ShouldEqual, "execution lease expired")
+				So(e.Token, ShouldBeNil)
+
+				_, err := s.RenewExecutionLease(c, &dm.RenewExecutionLeaseReq{Auth: rsp.Auth})
+				So(err, ShouldBeRPCUnauthenticated, "execution lease Auth")
+
+				Convey("and can be claimed again", func() {
+					rsp, err := s.ClaimExecution(c, &dm.ClaimExecutionReq{})
+					So(err, ShouldBeNil)
+					So(rsp.Auth.Id, ShouldResemble, dm.NewExecutionID(qid, 1, 2))
+				})
+			})
+
+			Convey("can be released", func() {
+				_, err := s.ReleaseExecutionLease(c, &dm.ReleaseExecutionLeaseReq{Auth: rsp.Auth})
+				So(err, ShouldBeNil)
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(e.State, ShouldEqual, dm.Execution_CANCELLED)
+
+				_, err = s.ReleaseExecutionLease(c, &dm.ReleaseExecutionLeaseReq{Auth: rsp.Auth})
+				So(err, ShouldBeRPCUnauthenticated, "execution lease Auth")
+			})
+		})
+
+		Convey("bad requests", func() {
+			_, err := s.ClaimExecution(c, &dm.ClaimExecutionReq{
+				LeaseDuration: google_pb.NewDuration(-time.Minute)})
+			So(err, ShouldBeRPCInvalidArgument, "lease_duration must be positive")
+
+			_, err = s.RenewExecutionLease(c, &dm.RenewExecutionLeaseReq{})
+			So(err, ShouldBeRPCInvalidArgument, "auth is required")
+		})
+	})
+}
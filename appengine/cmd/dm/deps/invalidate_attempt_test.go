@@ -0,0 +1,64 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestInvalidateAttempt(t *testing.T) {
+	t.Parallel()
+
+	Convey("InvalidateAttempt", t, func() {
+		c := memory.Use(context.Background())
+		ds := datastore.Get(c)
+		s := newDecoratedDeps()
+
+		a := &model.Attempt{ID: *dm.NewAttemptID("quest", 1), State: dm.Attempt_FINISHED}
+		ar := &model.AttemptResult{Attempt: ds.KeyForObj(a), Data: `{"result": true}`}
+		So(ds.Put(&model.Quest{ID: "quest"}, a, ar), ShouldBeNil)
+
+		req := &dm.InvalidateAttemptReq{Attempt: &a.ID, Reason: "bad result"}
+
+		Convey("bad", func() {
+			Convey("not an admin", func() {
+				c = auth.WithState(c, &authtest.FakeState{Identity: "user:someone@example.com"})
+				_, err := s.InvalidateAttempt(c, req)
+				So(err, ShouldBeRPCPermissionDenied, `not a member of "administrators"`)
+			})
+
+			Convey("no auth state", func() {
+				_, err := s.InvalidateAttempt(c, req)
+				So(err, ShouldBeRPCInternal)
+			})
+
+			So(ds.Get(a, ar), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_FINISHED)
+		})
+
+		Convey("good", func() {
+			c = auth.WithState(c, &authtest.FakeState{
+				Identity:       "user:admin@example.com",
+				IdentityGroups: []string{"administrators"},
+			})
+			_, err := s.InvalidateAttempt(c, req)
+			So(err, ShouldBeNil)
+
+			So(ds.Get(a), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+			So(ds.Get(ar), ShouldEqual, datastore.ErrNoSuchEntity)
+		})
+	})
+}
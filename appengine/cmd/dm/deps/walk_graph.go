@@ -213,7 +213,9 @@ func attemptLoader(c context.Context, req *dm.WalkGraphReq, aid *dm.Attempt_ID,
 
 		errChan := parallel.Run(0, func(ch chan<- func() error) {
 			if req.Include.AttemptResult {
-				if atmpt.State == dm.Attempt_FINISHED {
+				if atmpt.Expired || atmpt.ResultExpired(clock.Now(c)) {
+					dst.Partial.Result = dm.Attempt_Partial_EXPIRED
+				} else if atmpt.State == dm.Attempt_FINISHED {
 					ch <- attemptResultLoader(c, aid, authedForResult, atmpt.ResultSize, lim, akey, req.Auth, dst)
 				} else {
 					dst.Partial.Result = dm.Attempt_Partial_LOADED
@@ -0,0 +1,150 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package distributor contains the interface for the various supported
+// distributor protocols, as well as the registry which maps distributor
+// configuration names to their implementations. At a high level, it works
+// like this:
+//   * Quests specify a distributor configuration by name as part of their
+//     identity.
+//   * When an Attempt for that Quest needs execution, DM reads the
+//     configuration (distributor.proto) from the "distributors.cfg" file in its
+//     luci-config service config set.
+//   * DM records a new Execution, then uses the selected distributor
+//     implementation to start a task, and records its Token in the Execution.
+//     The distributor MUST publish status updates for the task to DM's Pub/Sub
+//     topic, and MUST include the 'auth_token' attribute that was handed to it
+//     in the TaskDescription.
+//   * When DM gets a hit on Pub/Sub, it loads the Execution and calls
+//     HandleNotification for the adapter to return the state of the task. When
+//     the task is done, DM finishes the Execution (and, if the task didn't do
+//     so itself, the Attempt).
+//
+// Adding a new distributor requires:
+//   * Add a new subdir of common/api/dm/distributor with the protos which
+//     configure this type of distributor.
+//   * Add a new member to the Distributor message in distributor.proto.
+//   * Add a new subdir of this package to implement the D interface.
+//   * Add the new Factory to the FactoryMap passed to NewRegistry in DM's
+//     frontend.
+package distributor
+
+import (
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+)
+
+// Token is an opaque token that distributors should use to uniquely identify
+// a single DM execution.
+type Token string
+
+// TaskDescription is the parameters for a single task that DM hands to a
+// distributor's Run method.
+type TaskDescription struct {
+	// Payload is the Quest description of the task to run.
+	Payload *dm.Quest_Desc
+
+	// ExecutionAuth is the Auth that the task must use to activate the
+	// Execution with DM's ActivateExecution RPC. Its token is only valid for
+	// activation, which replaces it with a token of the task's choosing.
+	ExecutionAuth *dm.Execution_Auth
+
+	// PubsubTopic is the full name of the Pub/Sub topic that the distributor
+	// should publish task status notifications to.
+	PubsubTopic string
+
+	// PubsubAuth is the value of the 'auth_token' attribute that the
+	// distributor must include in all of the notifications that it publishes
+	// for this task.
+	PubsubAuth string
+}
+
+// TaskResult describes the final state of a task, as seen by its distributor.
+type TaskResult struct {
+	// State is the terminal state of the Execution.
+	State dm.Execution_State
+
+	// Reason is a human-readable explanation of State.
+	Reason string
+
+	// Result is the JSON-encoded result of the task. It's only used if State
+	// is FINISHED and the task didn't already finish its Attempt with
+	// FinishAttempt.
+	Result string
+}
+
+// Notification represents a Pub/Sub notification about a task, as received
+// by DM.
+type Notification struct {
+	// ID is the Execution that the notification is about.
+	ID *dm.Execution_ID
+
+	// Data is the body of the Pub/Sub message.
+	Data []byte
+
+	// Attrs are the attributes of the Pub/Sub message.
+	Attrs map[string]string
+}
+
+// D is the interface for all distributor implementations.
+//
+// D implementations are bound to a context and a Config by their Factory, and
+// should not be retained beyond the request which created them.
+type D interface {
+	// Run prepares and runs a new task from the given description. It returns
+	// an opaque Token identifying the task.
+	//
+	// If Run returns a transient error, it will be retried. Any other error
+	// marks the Execution as REJECTED.
+	//
+	// Run may be called more than once for the same Execution (e.g. if DM fails
+	// to record its Token). Only one of the resulting tasks will be able to
+	// activate the Execution, so implementations don't need to deduplicate
+	// them, although they may do so based on desc.ExecutionAuth.Id.
+	Run(desc *TaskDescription) (tok Token, err error)
+
+	// Cancel attempts to cancel the task identified by tok. It's not an error
+	// to cancel a task which has already finished.
+	Cancel(tok Token) error
+
+	// GetStatus retrieves the current state of the task identified by tok. It
+	// returns a nil TaskResult if the task hasn't finished yet.
+	GetStatus(tok Token) (*TaskResult, error)
+
+	// InfoURL returns a URL, suitable for a human to view, which describes the
+	// task identified by tok.
+	InfoURL(tok Token) string
+
+	// HandleNotification is called whenever DM receives a Pub/Sub message for
+	// the task identified by tok. It returns the task's final TaskResult, or
+	// nil if the task hasn't finished yet.
+	//
+	// Notifications are only hints: implementations should not trust their
+	// contents to determine the state of the task.
+	HandleNotification(tok Token, n *Notification) (*TaskResult, error)
+}
+
+// Config is the configuration of a single named distributor, as handed to its
+// Factory.
+type Config struct {
+	// DMHost is the hostname of the current DM service.
+	DMHost string
+
+	// Name is the name of this distributor configuration, i.e. the
+	// distributor_config_name of Quests which use it.
+	Name string
+
+	// Version is the version of the configuration file which defined this
+	// distributor configuration.
+	Version string
+
+	// Content is the distributor-specific configuration message, e.g.
+	// *swarmingV1.Config.
+	Content proto.Message
+}
+
+// Factory instantiates a D bound to the given context and configuration.
+type Factory func(c context.Context, cfg *Config) (D, error)
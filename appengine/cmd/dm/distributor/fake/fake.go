@@ -0,0 +1,134 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package fake implements an in-memory distributor.D for tests.
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+)
+
+// Task is a single task run by the fake Distributor.
+type Task struct {
+	// Desc is the TaskDescription the task was run with.
+	Desc *distributor.TaskDescription
+
+	// Result is the final result of the task, or nil if the task is still
+	// running.
+	Result *distributor.TaskResult
+}
+
+// Distributor is a distributor.D which keeps its tasks in memory. It's safe
+// for concurrent use.
+type Distributor struct {
+	// RunErr, if not nil, is returned by Run instead of running the task.
+	RunErr error
+
+	mu    sync.Mutex
+	tasks map[distributor.Token]*Task
+}
+
+var _ distributor.D = (*Distributor)(nil)
+
+// Factory returns a distributor.Factory which always returns d, so that tests
+// can inspect the tasks that DM ran.
+func (d *Distributor) Factory() distributor.Factory {
+	return func(context.Context, *distributor.Config) (distributor.D, error) {
+		return d, nil
+	}
+}
+
+// Run implements distributor.D.
+func (d *Distributor) Run(desc *distributor.TaskDescription) (distributor.Token, error) {
+	if d.RunErr != nil {
+		return "", d.RunErr
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tok := distributor.Token(desc.ExecutionAuth.Id.DMEncoded())
+	if d.tasks == nil {
+		d.tasks = map[distributor.Token]*Task{}
+	}
+	d.tasks[tok] = &Task{Desc: desc}
+	return tok, nil
+}
+
+// Cancel implements distributor.D.
+func (d *Distributor) Cancel(tok distributor.Token) error {
+	return d.Finish(tok, &distributor.TaskResult{
+		State:  dm.Execution_CANCELLED,
+		Reason: "cancelled",
+	})
+}
+
+// GetStatus implements distributor.D.
+func (d *Distributor) GetStatus(tok distributor.Token) (*distributor.TaskResult, error) {
+	t := d.Task(tok)
+	if t == nil {
+		return nil, fmt.Errorf("unknown task %q", tok)
+	}
+	return t.Result, nil
+}
+
+// InfoURL implements distributor.D.
+func (d *Distributor) InfoURL(tok distributor.Token) string {
+	return "https://fake.example.com/task/" + string(tok)
+}
+
+// HandleNotification implements distributor.D.
+func (d *Distributor) HandleNotification(tok distributor.Token, n *distributor.Notification) (*distributor.TaskResult, error) {
+	return d.GetStatus(tok)
+}
+
+// Finish sets the result of the task identified by tok, unless it has already
+// finished.
+func (d *Distributor) Finish(tok distributor.Token, rslt *distributor.TaskResult) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	t := d.tasks[tok]
+	if t == nil {
+		return fmt.Errorf("unknown task %q", tok)
+	}
+	if t.Result == nil {
+		t.Result = rslt
+	}
+	return nil
+}
+
+// Task returns a copy of the task identified by tok, or nil if there's no
+// such task.
+func (d *Distributor) Task(tok distributor.Token) *Task {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	t := d.tasks[tok]
+	if t == nil {
+		return nil
+	}
+	ret := *t
+	return &ret
+}
+
+// Tokens returns the sorted tokens of all tasks run by d.
+func (d *Distributor) Tokens() []distributor.Token {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ret := make([]string, 0, len(d.tasks))
+	for tok := range d.tasks {
+		ret = append(ret, string(tok))
+	}
+	sort.Strings(ret)
+	toks := make([]distributor.Token, len(ret))
+	for i, tok := range ret {
+		toks[i] = distributor.Token(tok)
+	}
+	return toks
+}
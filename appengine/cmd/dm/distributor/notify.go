@@ -0,0 +1,155 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package distributor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/gae/service/info"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/middleware"
+	"github.com/luci/luci-go/server/tokens"
+)
+
+// notifyTopicName is the name of DM's Pub/Sub topic for distributor
+// notifications. A push subscription on it must deliver messages to
+// notifyPath.
+const notifyTopicName = "dm-distributor-notify"
+
+// notifyPath is the path of DM's Pub/Sub push handler.
+const notifyPath = "/_ah/push-handlers/" + notifyTopicName
+
+// pubsubAuthToken describes how to generate HMAC protected tokens used to
+// authenticate distributor notifications. Executions can legitimately run for
+// a long time, so the tokens must outlive them.
+var pubsubAuthToken = tokens.TokenKind{
+	Algo:       tokens.TokenAlgoHmacSHA256,
+	Expiration: 7 * 24 * time.Hour,
+	SecretKey:  "dm_distributor_pubsub_auth_token",
+	Version:    1,
+}
+
+// NotifyTopic returns the full name of the Pub/Sub topic that distributors
+// should publish task notifications to.
+func NotifyTopic(c context.Context) string {
+	return fmt.Sprintf("projects/%s/topics/%s", info.Get(c).AppID(), notifyTopicName)
+}
+
+// NewPubsubAuth generates the value of the 'auth_token' attribute that
+// distributors must include in notifications about the given Execution.
+func NewPubsubAuth(c context.Context, eid *dm.Execution_ID) (string, error) {
+	return pubsubAuthToken.Generate(c, nil, map[string]string{
+		"eid": eid.DMEncoded(),
+	}, 0)
+}
+
+// InstallHandlers installs the Pub/Sub push handler for distributor
+// notifications. The Context provided by base must contain a Registry.
+func InstallHandlers(r *httprouter.Router, base middleware.Base) {
+	r.POST(notifyPath, base(pubsubPushHandler))
+}
+
+func pubsubPushHandler(c context.Context, rw http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var pushBody struct {
+		Message pubsub.PubsubMessage `json:"message"`
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &pushBody)
+	}
+	if err != nil {
+		logging.WithError(err).Errorf(c, "failed to read Pub/Sub push body")
+		// Returning 200 acks the message; it's never going to parse.
+		rw.WriteHeader(http.StatusOK)
+		return
+	}
+
+	err = handlePubsubMessage(c, &pushBody.Message)
+	switch {
+	case err == nil:
+		rw.WriteHeader(http.StatusOK)
+	case errors.IsTransient(err):
+		// Non-2xx status makes Pub/Sub redeliver the message later.
+		logging.WithError(err).Warningf(c, "transient error while handling notification")
+		rw.WriteHeader(http.StatusInternalServerError)
+	default:
+		logging.WithError(err).Errorf(c, "failed to handle notification")
+		rw.WriteHeader(http.StatusOK)
+	}
+}
+
+func handlePubsubMessage(c context.Context, msg *pubsub.PubsubMessage) error {
+	logging.Infof(c, "received Pub/Sub message %q", msg.MessageId)
+
+	data, err := pubsubAuthToken.Validate(c, msg.Attributes["auth_token"], nil)
+	if err != nil {
+		return fmt.Errorf("bad auth_token attribute: %s", err)
+	}
+	eid := &dm.Execution_ID{}
+	if err := eid.SetDMEncoded(data["eid"]); err != nil {
+		return fmt.Errorf("bad execution id %q: %s", data["eid"], err)
+	}
+	c = logging.SetField(c, "eid", eid.DMEncoded())
+
+	msgData, err := base64.StdEncoding.DecodeString(msg.Data)
+	if err != nil {
+		return fmt.Errorf("bad message data: %s", err)
+	}
+
+	ds := datastore.Get(c)
+	e := &model.Execution{ID: eid.Id, Attempt: ds.KeyForObj(&model.Attempt{ID: *eid.AttemptID()})}
+	if err := ds.Get(e); err != nil {
+		return maybeTransient(err, "execution")
+	}
+	q := &model.Quest{ID: eid.Quest}
+	if err := ds.Get(q); err != nil {
+		return maybeTransient(err, "quest")
+	}
+	if e.State.Terminal() {
+		logging.Infof(c, "execution is already %s, ignoring notification", e.State)
+		return nil
+	}
+
+	reg := GetRegistry(c)
+	if reg == nil {
+		return errors.New("no distributor Registry in context")
+	}
+	d, _, err := reg.MakeDistributor(c, q.Desc.DistributorConfigName)
+	if err != nil {
+		return err
+	}
+	rslt, err := d.HandleNotification(Token(e.DistributorToken), &Notification{
+		ID:    eid,
+		Data:  msgData,
+		Attrs: msg.Attributes,
+	})
+	if err != nil || rslt == nil {
+		return err
+	}
+	logging.Infof(c, "task finished with state %s", rslt.State)
+	return reg.FinishExecution(c, eid, rslt)
+}
+
+// maybeTransient marks datastore errors other than ErrNoSuchEntity as
+// transient.
+func maybeTransient(err error, what string) error {
+	if err == datastore.ErrNoSuchEntity {
+		return fmt.Errorf("no such %s", what)
+	}
+	return errors.WrapTransient(err)
+}
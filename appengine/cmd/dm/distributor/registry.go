@@ -0,0 +1,177 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package distributor
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/info"
+	"github.com/luci/luci-go/common/api/dm/distributor"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/errors"
+	luciproto "github.com/luci/luci-go/common/proto"
+)
+
+// ConfigPath is the path of the distributor configuration file (a text
+// distributor.Config proto) in DM's luci-config service config set.
+const ConfigPath = "distributors.cfg"
+
+type registryKeyType int
+
+// FinishExecutionFn is called by the notification handler when a distributor
+// reports that the task of an Execution has finished. It's provided by the
+// code which constructs the Registry, since the mutations which finish
+// Executions live outside of this package.
+type FinishExecutionFn func(c context.Context, eid *dm.Execution_ID, rslt *TaskResult) error
+
+// FactoryMap maps nil proto.Message instances (e.g. (*swarmingV1.Config)(nil))
+// to the Factory which can instantiate a D from a configuration message of
+// that type.
+type FactoryMap map[proto.Message]Factory
+
+// TestFactoryMap maps distributor configuration names directly to the
+// Factories which should be used for them. It's used with NewTestingRegistry.
+type TestFactoryMap map[string]Factory
+
+// Registry holds a collection of all of the available distributor types.
+type Registry interface {
+	// MakeDistributor builds a D for the distributor configuration named
+	// cfgName. It also returns the version of the configuration.
+	MakeDistributor(c context.Context, cfgName string) (d D, ver string, err error)
+
+	// FinishExecution should be called when the task of an Execution has
+	// finished. It invokes the FinishExecutionFn provided to the Registry.
+	FinishExecution(c context.Context, eid *dm.Execution_ID, rslt *TaskResult) error
+}
+
+// WithRegistry adds the registry to the Context.
+func WithRegistry(c context.Context, r Registry) context.Context {
+	if r == nil {
+		panic(errors.New("you may not use WithRegistry on a nil Registry"))
+	}
+	return context.WithValue(c, registryKeyType(0), r)
+}
+
+// GetRegistry gets the registry from the Context. It returns nil if the
+// Context doesn't have a Registry.
+func GetRegistry(c context.Context) Registry {
+	ret, _ := c.Value(registryKeyType(0)).(Registry)
+	return ret
+}
+
+// NewRegistry builds a new Registry which loads distributor configurations
+// from luci-config, and instantiates them with the Factories in mapping.
+func NewRegistry(mapping FactoryMap, fFn FinishExecutionFn) Registry {
+	ret := &registry{finishFn: fFn, mapping: make(map[reflect.Type]Factory, len(mapping))}
+	for msg, factory := range mapping {
+		ret.mapping[reflect.TypeOf(msg)] = factory
+	}
+	return ret
+}
+
+type registry struct {
+	finishFn FinishExecutionFn
+	mapping  map[reflect.Type]Factory
+}
+
+var _ Registry = (*registry)(nil)
+
+func (r *registry) FinishExecution(c context.Context, eid *dm.Execution_ID, rslt *TaskResult) error {
+	return r.finishFn(c, eid, rslt)
+}
+
+func (r *registry) MakeDistributor(c context.Context, cfgName string) (D, string, error) {
+	cfg, err := loadConfig(c, cfgName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	factory, ok := r.mapping[reflect.TypeOf(cfg.Content)]
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported distributor type %T for %q", cfg.Content, cfgName)
+	}
+	d, err := factory(c, cfg)
+	return d, cfg.Version, err
+}
+
+// loadConfig loads the distributor configuration named cfgName from
+// luci-config.
+//
+// Errors which indicate a bad (or missing) configuration are fatal, all others
+// are transient.
+func loadConfig(c context.Context, cfgName string) (*Config, error) {
+	inf := info.Get(c)
+	cfgSet := "services/" + inf.AppID()
+	data, err := config.Get(c).GetConfig(cfgSet, ConfigPath, false)
+	switch {
+	case err == config.ErrNoConfig:
+		return nil, fmt.Errorf("no distributor configuration %q in %q", ConfigPath, cfgSet)
+	case err != nil:
+		return nil, errors.WrapTransient(err)
+	}
+
+	file := &distributor.Config{}
+	if err := luciproto.UnmarshalTextML(data.Content, file); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %s", ConfigPath, err)
+	}
+
+	dist, ok := file.DistributorConfigs[cfgName]
+	if !ok {
+		return nil, fmt.Errorf("unknown distributor configuration %q", cfgName)
+	}
+	// The oneof wrapper (e.g. *distributor.Distributor_SwarmingV1) always has
+	// exactly one field: the distributor-specific configuration message.
+	v := reflect.ValueOf(dist.GetDistributorType())
+	if !v.IsValid() || v.IsNil() {
+		return nil, fmt.Errorf("distributor configuration %q has no distributor type", cfgName)
+	}
+	content, ok := v.Elem().Field(0).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("distributor configuration %q has unexpected type %s", cfgName, v.Type())
+	}
+
+	return &Config{
+		DMHost:  inf.DefaultVersionHostname(),
+		Name:    cfgName,
+		Version: data.ContentHash,
+		Content: content,
+	}, nil
+}
+
+// NewTestingRegistry returns a Registry which instantiates distributors
+// directly from mapping, keyed by configuration name, without loading any
+// configuration. It's intended for tests.
+func NewTestingRegistry(mapping TestFactoryMap, fFn FinishExecutionFn) Registry {
+	return &testRegistry{finishFn: fFn, mapping: mapping}
+}
+
+type testRegistry struct {
+	finishFn FinishExecutionFn
+	mapping  TestFactoryMap
+}
+
+var _ Registry = (*testRegistry)(nil)
+
+func (r *testRegistry) FinishExecution(c context.Context, eid *dm.Execution_ID, rslt *TaskResult) error {
+	return r.finishFn(c, eid, rslt)
+}
+
+func (r *testRegistry) MakeDistributor(c context.Context, cfgName string) (D, string, error) {
+	factory, ok := r.mapping[cfgName]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown distributor configuration %q", cfgName)
+	}
+	d, err := factory(c, &Config{
+		DMHost:  "dm.example.com",
+		Name:    cfgName,
+		Version: "testing",
+	})
+	return d, "testing", err
+}
@@ -0,0 +1,93 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package distributor
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/luci-go/common/api/dm/distributor/swarming/v1"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	memcfg "github.com/luci/luci-go/common/config/impl/memory"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	"github.com/luci/luci-go/server/secrets/testsecrets"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	Convey("Registry", t, func() {
+		c := memory.Use(context.Background())
+		c = memcfg.Use(c, map[string]memcfg.ConfigSet{
+			"services/dev~app": {
+				ConfigPath: `
+					distributor_configs: <
+						key: "swarm"
+						value: < swarming_v1: < host: "swarming.example.com" > >
+					>
+					distributor_configs: <
+						key: "empty"
+						value: < >
+					>
+				`,
+			},
+		})
+
+		var got *Config
+		reg := NewRegistry(FactoryMap{
+			(*swarmingV1.Config)(nil): func(c context.Context, cfg *Config) (D, error) {
+				got = cfg
+				return nil, nil
+			},
+		}, nil)
+
+		Convey("makes configured distributors", func() {
+			_, ver, err := reg.MakeDistributor(c, "swarm")
+			So(err, ShouldBeNil)
+			So(ver, ShouldNotEqual, "")
+			So(got.Name, ShouldEqual, "swarm")
+			So(got.Version, ShouldEqual, ver)
+			So(got.Content, ShouldResemble, &swarmingV1.Config{Host: "swarming.example.com"})
+		})
+
+		Convey("rejects unknown configurations", func() {
+			_, _, err := reg.MakeDistributor(c, "nope")
+			So(err, ShouldErrLike, `unknown distributor configuration "nope"`)
+		})
+
+		Convey("rejects configurations without a type", func() {
+			_, _, err := reg.MakeDistributor(c, "empty")
+			So(err, ShouldErrLike, "has no distributor type")
+		})
+
+		Convey("rejects unsupported types", func() {
+			_, _, err := NewRegistry(nil, nil).MakeDistributor(c, "swarm")
+			So(err, ShouldErrLike, "unsupported distributor type")
+		})
+
+		Convey("is stored in the context", func() {
+			So(GetRegistry(c), ShouldBeNil)
+			So(GetRegistry(WithRegistry(c, reg)), ShouldEqual, reg)
+		})
+	})
+}
+
+func TestPubsubAuth(t *testing.T) {
+	t.Parallel()
+
+	Convey("pubsub auth tokens round trip", t, func() {
+		c := testsecrets.Use(memory.Use(context.Background()))
+		eid := dm.NewExecutionID("quest", 1, 2)
+
+		tok, err := NewPubsubAuth(c, eid)
+		So(err, ShouldBeNil)
+		data, err := pubsubAuthToken.Validate(c, tok, nil)
+		So(err, ShouldBeNil)
+		So(data["eid"], ShouldEqual, eid.DMEncoded())
+	})
+}
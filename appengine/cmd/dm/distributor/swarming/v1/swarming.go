@@ -0,0 +1,297 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package swarming implements a DM distributor which runs Executions as
+// Swarming tasks.
+//
+// The json_payload of Quests using this distributor is a JSONPB-encoded
+// swarmingV1.Parameters message. The task receives its DM host and its
+// execution Auth (JSONPB-encoded) in the DM_HOST and DM_EXECUTION_AUTH
+// environment variables.
+package swarming
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"golang.org/x/net/context"
+	"google.golang.org/api/googleapi"
+
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/gaeauth/client"
+	"github.com/luci/luci-go/common/api/dm/distributor/swarming/v1"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+)
+
+const (
+	defaultPriority         = 100
+	defaultExpiration       = time.Hour
+	defaultExecutionTimeout = time.Hour
+)
+
+type swarmingDist struct {
+	c    context.Context
+	cfg  *distributor.Config
+	sCfg *swarmingV1.Config
+
+	// basePath is the root URL of the Swarming API.
+	basePath string
+	// client, if not nil, is used instead of an authenticated GAE client.
+	client *http.Client
+}
+
+var _ distributor.D = (*swarmingDist)(nil)
+
+// Factory is a distributor.Factory for *swarmingV1.Config configurations.
+func Factory(c context.Context, cfg *distributor.Config) (distributor.D, error) {
+	sCfg, ok := cfg.Content.(*swarmingV1.Config)
+	if !ok {
+		return nil, fmt.Errorf("wrong config type %T, expecting *swarmingV1.Config", cfg.Content)
+	}
+	if sCfg.Host == "" {
+		return nil, fmt.Errorf("distributor configuration %q has no host", cfg.Name)
+	}
+	return &swarmingDist{
+		c:        c,
+		cfg:      cfg,
+		sCfg:     sCfg,
+		basePath: fmt.Sprintf("https://%s/_ah/api/swarming/v1/", sCfg.Host),
+	}, nil
+}
+
+func (d *swarmingDist) newSwarmService() (*swarming.Service, error) {
+	cl := d.client
+	if cl == nil {
+		c, _ := clock.WithTimeout(d.c, time.Minute)
+		tr, err := client.Transport(c, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		cl = &http.Client{Transport: tr}
+	}
+	svc, err := swarming.New(cl)
+	if err != nil {
+		return nil, err
+	}
+	svc.BasePath = d.basePath
+	return svc, nil
+}
+
+// parseParams parses and validates the Parameters in a Quest's json_payload.
+func parseParams(payload string) (*swarmingV1.Parameters, error) {
+	params := &swarmingV1.Parameters{}
+	if err := jsonpb.UnmarshalString(payload, params); err != nil {
+		return nil, fmt.Errorf("bad swarming parameters: %s", err)
+	}
+
+	switch hasCommand, hasIsolated := len(params.Command) != 0, params.Isolated != nil; {
+	case !hasCommand && !hasIsolated:
+		return nil, fmt.Errorf("one of 'command' or 'isolated' is required")
+	case hasCommand && hasIsolated:
+		return nil, fmt.Errorf("only one of 'command' or 'isolated' must be specified, not both")
+	}
+	if len(params.Dimensions) == 0 {
+		return nil, fmt.Errorf("at least one dimension is required")
+	}
+	if params.Priority > 255 {
+		return nil, fmt.Errorf("bad priority, must be [0, 255]: %d", params.Priority)
+	}
+	for key := range params.Env {
+		if key == "DM_HOST" || key == "DM_EXECUTION_AUTH" {
+			return nil, fmt.Errorf("environment variable %q is reserved", key)
+		}
+	}
+	return params, nil
+}
+
+func mapToStringPairs(m map[string]string) []*swarming.SwarmingRpcsStringPair {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ret := make([]*swarming.SwarmingRpcsStringPair, len(keys))
+	for i, k := range keys {
+		ret[i] = &swarming.SwarmingRpcsStringPair{Key: k, Value: m[k]}
+	}
+	return ret
+}
+
+func durationOr(d, def time.Duration) int64 {
+	if d <= 0 {
+		d = def
+	}
+	return int64(d / time.Second)
+}
+
+func (d *swarmingDist) Run(desc *distributor.TaskDescription) (distributor.Token, error) {
+	params, err := parseParams(desc.Payload.JsonPayload)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := (&jsonpb.Marshaler{}).MarshalToString(desc.ExecutionAuth)
+	if err != nil {
+		return "", err
+	}
+	env := make(map[string]string, len(params.Env)+2)
+	for k, v := range params.Env {
+		env[k] = v
+	}
+	env["DM_HOST"] = d.cfg.DMHost
+	env["DM_EXECUTION_AUTH"] = auth
+
+	priority := int64(params.Priority)
+	if priority == 0 {
+		priority = int64(d.sCfg.DefaultPriority)
+	}
+	if priority == 0 {
+		priority = defaultPriority
+	}
+
+	eid := desc.ExecutionAuth.Id
+	req := &swarming.SwarmingRpcsNewTaskRequest{
+		Name:            fmt.Sprintf("dm:%s", eid.DMEncoded()),
+		ExpirationSecs:  durationOr(params.Expiration.Duration(), defaultExpiration),
+		Priority:        priority,
+		PubsubAuthToken: desc.PubsubAuth,
+		PubsubTopic:     desc.PubsubTopic,
+		Tags: []string{
+			"dm_host:" + d.cfg.DMHost,
+			"dm_distributor_config:" + d.cfg.Name,
+			"dm_quest:" + eid.Quest,
+			fmt.Sprintf("dm_attempt:%d", eid.Attempt),
+			fmt.Sprintf("dm_execution:%d", eid.Id),
+		},
+		Properties: &swarming.SwarmingRpcsTaskProperties{
+			Command:              params.Command,
+			Dimensions:           mapToStringPairs(params.Dimensions),
+			Env:                  mapToStringPairs(env),
+			ExecutionTimeoutSecs: durationOr(params.ExecutionTimeout.Duration(), defaultExecutionTimeout),
+			ExtraArgs:            params.ExtraArgs,
+			IoTimeoutSecs:        int64(params.IoTimeout.Duration() / time.Second),
+		},
+	}
+	if iso := params.Isolated; iso != nil {
+		req.Properties.InputsRef = &swarming.SwarmingRpcsFilesRef{
+			Isolated:       iso.Hash,
+			Isolatedserver: iso.Server,
+			Namespace:      iso.Namespace,
+		}
+	}
+
+	svc, err := d.newSwarmService()
+	if err != nil {
+		return "", err
+	}
+	rsp, err := svc.Tasks.New(req).Do()
+	if err != nil {
+		return "", wrapAPIError(err)
+	}
+	return distributor.Token(rsp.TaskId), nil
+}
+
+func (d *swarmingDist) Cancel(tok distributor.Token) error {
+	svc, err := d.newSwarmService()
+	if err != nil {
+		return err
+	}
+	_, err = svc.Task.Cancel(string(tok)).Do()
+	return wrapAPIError(err)
+}
+
+func (d *swarmingDist) GetStatus(tok distributor.Token) (*distributor.TaskResult, error) {
+	svc, err := d.newSwarmService()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := svc.Task.Result(string(tok)).Do()
+	if err != nil {
+		return nil, wrapAPIError(err)
+	}
+	return taskResultToDM(rsp)
+}
+
+func (d *swarmingDist) InfoURL(tok distributor.Token) string {
+	return fmt.Sprintf("https://%s/user/task/%s", d.sCfg.Host, tok)
+}
+
+func (d *swarmingDist) HandleNotification(tok distributor.Token, _ *distributor.Notification) (*distributor.TaskResult, error) {
+	// Swarming notifications only say that the task changed; ask Swarming
+	// for its actual state.
+	return d.GetStatus(tok)
+}
+
+// taskResult is the result that DM records for Attempts whose tasks finish
+// without calling FinishAttempt themselves.
+type taskResult struct {
+	TaskID     string                         `json:"task_id"`
+	ExitCode   int64                          `json:"exit_code"`
+	OutputsRef *swarming.SwarmingRpcsFilesRef `json:"outputs_ref,omitempty"`
+}
+
+// taskResultToDM converts the result of a Swarming task to a TaskResult. It
+// returns nil if the task hasn't finished yet.
+func taskResultToDM(r *swarming.SwarmingRpcsTaskResult) (*distributor.TaskResult, error) {
+	switch r.State {
+	case "PENDING", "RUNNING":
+		return nil, nil
+
+	case "COMPLETED":
+		switch {
+		case r.InternalFailure:
+			return &distributor.TaskResult{State: dm.Execution_FAILED, Reason: "internal failure"}, nil
+		case r.Failure:
+			return &distributor.TaskResult{
+				State:  dm.Execution_FAILED,
+				Reason: fmt.Sprintf("task failed with exit code %d", r.ExitCode),
+			}, nil
+		}
+		result, err := json.Marshal(&taskResult{
+			TaskID:     r.TaskId,
+			ExitCode:   r.ExitCode,
+			OutputsRef: r.OutputsRef,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &distributor.TaskResult{State: dm.Execution_FINISHED, Result: string(result)}, nil
+
+	case "EXPIRED":
+		return &distributor.TaskResult{State: dm.Execution_TIMED_OUT, Reason: "no bot ran the task before it expired"}, nil
+	case "TIMED_OUT":
+		return &distributor.TaskResult{State: dm.Execution_FAILED, Reason: "task timed out"}, nil
+	case "BOT_DIED":
+		return &distributor.TaskResult{State: dm.Execution_FAILED, Reason: "bot died while running the task"}, nil
+	case "CANCELED":
+		return &distributor.TaskResult{State: dm.Execution_CANCELLED, Reason: "task was cancelled"}, nil
+	default:
+		return &distributor.TaskResult{
+			State:  dm.Execution_MISSING,
+			Reason: fmt.Sprintf("unknown task state %q", r.State),
+		}, nil
+	}
+}
+
+// wrapAPIError wraps error from Google API client in transient wrapper if
+// necessary.
+func wrapAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	apiErr, _ := err.(*googleapi.Error)
+	if apiErr == nil || apiErr.Code >= 500 || apiErr.Code == 429 || apiErr.Code == 0 {
+		// No HTTP code means a connectivity error, which is transient too.
+		return errors.WrapTransient(err)
+	}
+	return err
+}
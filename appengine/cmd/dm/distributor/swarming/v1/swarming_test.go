@@ -0,0 +1,156 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package swarming
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/common/api/dm/distributor/swarming/v1"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseParams(t *testing.T) {
+	t.Parallel()
+
+	Convey("parseParams", t, func() {
+		Convey("works", func() {
+			params, err := parseParams(`{
+				"command": ["echo", "hi"],
+				"dimensions": {"os": "Linux"},
+				"executionTimeout": "600s"
+			}`)
+			So(err, ShouldBeNil)
+			So(params.Command, ShouldResemble, []string{"echo", "hi"})
+			So(params.Dimensions, ShouldResemble, map[string]string{"os": "Linux"})
+			So(params.ExecutionTimeout.Seconds, ShouldEqual, 600)
+		})
+
+		Convey("rejects bad parameters", func() {
+			_, err := parseParams(`{"dimensions": {"os": "Linux"}}`)
+			So(err, ShouldErrLike, "one of 'command' or 'isolated' is required")
+
+			_, err = parseParams(`{"command": ["a"], "isolated": {"hash": "abc"}, "dimensions": {"os": "Linux"}}`)
+			So(err, ShouldErrLike, "not both")
+
+			_, err = parseParams(`{"command": ["a"]}`)
+			So(err, ShouldErrLike, "at least one dimension")
+
+			_, err = parseParams(`{"command": ["a"], "dimensions": {"os": "Linux"}, "priority": 256}`)
+			So(err, ShouldErrLike, "bad priority")
+
+			_, err = parseParams(`{"command": ["a"], "dimensions": {"os": "Linux"}, "env": {"DM_HOST": "x"}}`)
+			So(err, ShouldErrLike, "is reserved")
+
+			_, err = parseParams(`{"bogus": 1}`)
+			So(err, ShouldErrLike, "bad swarming parameters")
+		})
+	})
+}
+
+func TestTaskResultToDM(t *testing.T) {
+	t.Parallel()
+
+	Convey("taskResultToDM", t, func() {
+		state := func(r *swarming.SwarmingRpcsTaskResult) interface{} {
+			rslt, err := taskResultToDM(r)
+			So(err, ShouldBeNil)
+			if rslt == nil {
+				return nil
+			}
+			return rslt.State
+		}
+
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "PENDING"}), ShouldBeNil)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "RUNNING"}), ShouldBeNil)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "COMPLETED", Failure: true}), ShouldEqual, dm.Execution_FAILED)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "COMPLETED", InternalFailure: true}), ShouldEqual, dm.Execution_FAILED)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "EXPIRED"}), ShouldEqual, dm.Execution_TIMED_OUT)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "TIMED_OUT"}), ShouldEqual, dm.Execution_FAILED)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "BOT_DIED"}), ShouldEqual, dm.Execution_FAILED)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "CANCELED"}), ShouldEqual, dm.Execution_CANCELLED)
+		So(state(&swarming.SwarmingRpcsTaskResult{State: "WAT"}), ShouldEqual, dm.Execution_MISSING)
+
+		rslt, err := taskResultToDM(&swarming.SwarmingRpcsTaskResult{
+			State:    "COMPLETED",
+			TaskId:   "1234",
+			ExitCode: 0,
+		})
+		So(err, ShouldBeNil)
+		So(rslt, ShouldResemble, &distributor.TaskResult{
+			State:  dm.Execution_FINISHED,
+			Result: `{"task_id":"1234","exit_code":0}`,
+		})
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	Convey("Run", t, func() {
+		var request *swarming.SwarmingRpcsNewTaskRequest
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			So(err, ShouldBeNil)
+			So(r.URL.Path, ShouldEqual, "/_ah/api/swarming/v1/tasks/new")
+			request = &swarming.SwarmingRpcsNewTaskRequest{}
+			So(json.Unmarshal(body, request), ShouldBeNil)
+			w.Write([]byte(`{"task_id": "task1234"}`))
+		}))
+		defer srv.Close()
+
+		d := &swarmingDist{
+			c:        context.Background(),
+			cfg:      &distributor.Config{DMHost: "dm.example.com", Name: "swarm"},
+			sCfg:     &swarmingV1.Config{Host: "swarming.example.com", DefaultPriority: 50},
+			basePath: srv.URL + "/_ah/api/swarming/v1/",
+			client:   http.DefaultClient,
+		}
+
+		tok, err := d.Run(&distributor.TaskDescription{
+			Payload: dm.NewQuestDesc("swarm", `{"command": ["run"], "dimensions": {"os": "Linux"}}`),
+			ExecutionAuth: &dm.Execution_Auth{
+				Id:    dm.NewExecutionID("quest", 1, 2),
+				Token: []byte("secret"),
+			},
+			PubsubTopic: "projects/app/topics/notify",
+			PubsubAuth:  "auth",
+		})
+		So(err, ShouldBeNil)
+		So(tok, ShouldEqual, "task1234")
+		So(d.InfoURL(tok), ShouldEqual, "https://swarming.example.com/user/task/task1234")
+
+		So(request.Name, ShouldEqual, "dm:quest|fffffffe|fffffffd")
+		So(request.Priority, ShouldEqual, 50)
+		So(request.ExpirationSecs, ShouldEqual, 3600)
+		So(request.PubsubTopic, ShouldEqual, "projects/app/topics/notify")
+		So(request.PubsubAuthToken, ShouldEqual, "auth")
+		So(request.Tags, ShouldResemble, []string{
+			"dm_host:dm.example.com",
+			"dm_distributor_config:swarm",
+			"dm_quest:quest",
+			"dm_attempt:1",
+			"dm_execution:2",
+		})
+		So(request.Properties.Command, ShouldResemble, []string{"run"})
+		So(request.Properties.ExecutionTimeoutSecs, ShouldEqual, 3600)
+		So(request.Properties.Dimensions, ShouldResemble, []*swarming.SwarmingRpcsStringPair{
+			{Key: "os", Value: "Linux"},
+		})
+		So(request.Properties.Env, ShouldResemble, []*swarming.SwarmingRpcsStringPair{
+			{Key: "DM_EXECUTION_AUTH", Value: `{"id":{"quest":"quest","attempt":1,"id":2},"token":"c2VjcmV0"}`},
+			{Key: "DM_HOST", Value: "dm.example.com"},
+		})
+	})
+}
@@ -11,9 +11,13 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/luci/luci-go/appengine/cmd/dm/deps"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor/swarming/v1"
+	"github.com/luci/luci-go/appengine/cmd/dm/mutate"
 	"github.com/luci/luci-go/appengine/gaeconfig"
 	"github.com/luci/luci-go/appengine/gaemiddleware"
 	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/distributor/swarming/v1"
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/server/discovery"
@@ -21,25 +25,49 @@ import (
 	"github.com/luci/luci-go/server/prpc"
 )
 
+// registry holds all of the distributor types that DM supports.
+var registry = distributor.NewRegistry(distributor.FactoryMap{
+	(*swarmingV1.Config)(nil): swarming.Factory,
+}, mutate.FinishExecutionFn)
+
+// addServices installs luci-config and the distributor registry into the
+// context.
+func addServices(c context.Context) context.Context {
+	cfg, err := gaeconfig.New(c)
+	switch err {
+	case nil:
+		c = config.Set(c, cfg)
+	case gaeconfig.ErrNotConfigured:
+		logging.Warningf(c, "luci-config service url not configured. Configure this at /admin/settings/gaeconfig.")
+	default:
+		panic(err)
+	}
+	return distributor.WithRegistry(c, registry)
+}
+
 func base(h middleware.Handler) httprouter.Handle {
 	newH := func(c context.Context, rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
-		cfg, err := gaeconfig.New(c)
-		switch err {
-		case nil:
-			c = config.Set(c, cfg)
-		case gaeconfig.ErrNotConfigured:
-			logging.Warningf(c, "luci-config service url not configured. Configure this at /admin/settings/gaeconfig.")
-		default:
-			panic(err)
-		}
-		h(c, rw, r, p)
+		h(addServices(c), rw, r, p)
 	}
 	return gaemiddleware.BaseProd(newH)
 }
 
+// expireResultsHandler garbage-collects the results of Attempts which have
+// expired.
+func expireResultsHandler(c context.Context, rw http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	n, err := mutate.SweepExpiredResults(c)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "failed to sweep expired results")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	logging.Infof(c, "expiring the results of %d attempts", n)
+	rw.Write([]byte("ok"))
+}
+
 func init() {
 	router := httprouter.New()
-	tmb := tumble.Service{}
+	tmb := tumble.Service{Middleware: addServices}
 
 	svr := prpc.Server{}
 	deps.RegisterDepsServer(&svr)
@@ -47,7 +75,9 @@ func init() {
 
 	svr.InstallHandlers(router, base)
 	tmb.InstallHandlers(router)
+	distributor.InstallHandlers(router, base)
 	gaemiddleware.InstallHandlers(router, base)
+	router.GET("/internal/cron/expire-results", base(gaemiddleware.RequireCron(expireResultsHandler)))
 
 	http.Handle("/", router)
 }
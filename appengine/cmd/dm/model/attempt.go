@@ -54,12 +54,22 @@ type Attempt struct {
 	// A field value of 0 means that the dep is currently waiting.
 	WaitingDepBitmap bf.BitField `gae:",noindex" json:"-"`
 
+	// FailedExecutions is the number of Executions of this Attempt which ended
+	// without moving it out of Executing (e.g. their lease expired). Once this
+	// exceeds the configured retry limit, the Attempt is AbnormalFinished.
+	FailedExecutions uint32 `gae:",noindex"`
+
 	// Only valid while Attempt is Finished
 	ResultExpiration time.Time
 	ResultSize       uint32
 
+	// Only valid while Attempt is AbnormalFinished
+	AbnormalFinishReason string `gae:",noindex"`
+
 	// A lazily-updated boolean to reflect that this Attempt is expired for
-	// queries.
+	// queries. It's set when the expired result is garbage-collected (see
+	// mutate.ExpireResult), so check ResultExpired to tell whether the result
+	// is still usable.
 	Expired bool
 }
 
@@ -102,6 +112,14 @@ func (a *Attempt) MustModifyState(c context.Context, newState dm.Attempt_State)
 	}
 }
 
+// ResultExpired returns true iff this Attempt is Finished, and its result
+// expired at or before now. Expired results are treated as missing: depending
+// on such an Attempt re-executes it.
+func (a *Attempt) ResultExpired(now time.Time) bool {
+	return (a.State == dm.Attempt_FINISHED && !a.ResultExpiration.IsZero() &&
+		!now.Before(a.ResultExpiration))
+}
+
 // ToProto returns a dm proto version of this Attempt.
 func (a *Attempt) ToProto(withData bool) *dm.Attempt {
 	ret := dm.Attempt{Id: &a.ID}
@@ -138,6 +156,9 @@ func (a *Attempt) DataProto() *dm.Attempt_Data {
 	case dm.Attempt_FINISHED:
 		ret = dm.NewAttemptFinished(a.ResultExpiration, a.ResultSize, "").Data
 
+	case dm.Attempt_ABNORMAL_FINISHED:
+		ret = dm.NewAttemptAbnormalFinish(a.AbnormalFinishReason).Data
+
 	default:
 		panic(fmt.Errorf("unknown Attempt_State: %s", a.State))
 	}
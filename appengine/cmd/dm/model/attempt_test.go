@@ -47,10 +47,23 @@ func TestAttempt(t *testing.T) {
 			So(a.ModifyState(c, dm.Attempt_EXECUTING), ShouldBeNil)
 			So(a.ModifyState(c, dm.Attempt_FINISHED), ShouldBeNil)
 
-			So(a.ModifyState(c, dm.Attempt_NEEDS_EXECUTION), ShouldErrLike, "invalid")
+			So(a.ModifyState(c, dm.Attempt_ADDING_DEPS), ShouldErrLike, "invalid")
 			So(a.State, ShouldEqual, dm.Attempt_FINISHED)
 		})
 
+		Convey("ResultExpired", func() {
+			a := MakeAttempt(c, dm.NewAttemptID("quest", 5))
+			a.ResultExpiration = clk.Now().Add(time.Hour)
+			So(a.ResultExpired(clk.Now().Add(2*time.Hour)), ShouldBeFalse)
+
+			a.State = dm.Attempt_FINISHED
+			So(a.ResultExpired(clk.Now()), ShouldBeFalse)
+			So(a.ResultExpired(clk.Now().Add(time.Hour)), ShouldBeTrue)
+
+			a.ResultExpiration = time.Time{}
+			So(a.ResultExpired(clk.Now().Add(2*time.Hour)), ShouldBeFalse)
+		})
+
 		Convey("ToProto", func() {
 			Convey("NeedsExecution", func() {
 				a := MakeAttempt(c, dm.NewAttemptID("quest", 10))
@@ -155,6 +168,24 @@ func TestAttempt(t *testing.T) {
 					},
 				})
 			})
+
+			Convey("AbnormalFinished", func() {
+				a := MakeAttempt(c, dm.NewAttemptID("quest", 10))
+				a.State = dm.Attempt_ABNORMAL_FINISHED
+				a.CurExecution = 4
+				a.AbnormalFinishReason = "too many failed executions"
+
+				So(a.ToProto(true), ShouldResemble, &dm.Attempt{
+					Id: &dm.Attempt_ID{Quest: "quest", Id: 10},
+					Data: &dm.Attempt_Data{
+						Created:       google_pb.NewTimestamp(testclock.TestTimeUTC),
+						Modified:      google_pb.NewTimestamp(testclock.TestTimeUTC),
+						NumExecutions: 4,
+						AttemptType: &dm.Attempt_Data_AbnormalFinish_{AbnormalFinish: &dm.Attempt_Data_AbnormalFinish{
+							Reason: "too many failed executions"}},
+					},
+				})
+			})
 		})
 	})
 }
@@ -17,6 +17,7 @@ import (
 
 	"github.com/luci/gae/service/datastore"
 	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/grpcutil"
 	"github.com/luci/luci-go/common/logging"
 	google_pb "github.com/luci/luci-go/common/proto/google"
@@ -37,13 +38,20 @@ type Execution struct {
 	DistributorToken string
 	DistributorURL   string `gae:",noindex"`
 
+	// LeaseExpiration is set for Executions which were claimed with the
+	// ClaimExecution rpc instead of being run by a distributor. The executor
+	// must renew the lease before this time, or the Execution is considered
+	// lost.
+	LeaseExpiration time.Time `gae:",noindex"`
+
 	// Token is a randomized nonce that's used to verify that RPCs verify from the
 	// expected client (the client that's currently running the Execution). The
 	// Token has 2 modes.
 	//
-	// When the Execution is handed to the distributor, the Token is randomly
-	// generated by DM and passed to the distributor. The State of the Execution
-	// starts as Scheduled. This token may be used by the client to "activate" the
+	// When the Execution is created, the Token is randomly generated by DM, and
+	// is then passed to the distributor along with the task. The State of the
+	// Execution starts as Scheduling, and becomes Scheduled once the distributor
+	// accepts the task. This token may be used by the client to "activate" the
 	// Execution with the ActivateExecution rpc. At that point, the client
 	// provides a new random token, the Execution State moves from Scheduled to
 	// Running, and Token assumes the new value. As long as the Execution State is
@@ -134,6 +142,53 @@ func InvalidateExecution(c context.Context, auth *dm.Execution_Auth) (a *Attempt
 	return
 }
 
+func verifyLeaseHolder(c context.Context, auth *dm.Execution_Auth) (a *Attempt, e *Execution, err error) {
+	a, e, err = loadExecution(c, auth.Id)
+	if err != nil {
+		return
+	}
+
+	if a.State != dm.Attempt_EXECUTING {
+		err = errors.New("Attempt is not executing")
+		return
+	}
+
+	if e.LeaseExpiration.IsZero() {
+		err = errors.New("Execution is not leased")
+		return
+	}
+
+	if e.State != dm.Execution_SCHEDULED && e.State != dm.Execution_RUNNING {
+		err = fmt.Errorf("Execution is in wrong state: %s", e.State)
+		return
+	}
+
+	if !clock.Now(c).Before(e.LeaseExpiration) {
+		err = errors.New("Execution lease has expired")
+		return
+	}
+
+	if subtle.ConstantTimeCompare(e.Token, auth.Token) != 1 {
+		err = errors.New("incorrect Token")
+	}
+	return
+}
+
+// AuthenticateLeaseHolder verifies that auth belongs to the holder of an
+// unexpired lease on the current Execution of an Executing Attempt. While the
+// Execution is Scheduled, auth must contain the activation token returned from
+// ClaimExecution. After activation, it must contain the activated Token.
+//
+// As a bonus, it will return the loaded Attempt and Execution.
+func AuthenticateLeaseHolder(c context.Context, auth *dm.Execution_Auth) (a *Attempt, e *Execution, err error) {
+	a, e, err = verifyLeaseHolder(c, auth)
+	if err != nil {
+		logging.Fields{ek: err, "eid": auth.Id}.Errorf(c, "failed to verify lease holder")
+		err = grpcutil.Errf(codes.Unauthenticated, "requires execution lease Auth")
+	}
+	return a, e, err
+}
+
 func verifyExecutionAndActivate(c context.Context, auth *dm.Execution_Auth, actTok []byte) (a *Attempt, e *Execution, err error) {
 	a, e, err = loadExecution(c, auth.Id)
 	if err != nil {
@@ -196,6 +251,9 @@ func (e *Execution) ToProto(includeID bool) *dm.Execution {
 			DistributorInfoUrl: e.DistributorURL,
 		},
 	}
+	if !e.LeaseExpiration.IsZero() {
+		ret.Data.LeaseExpiration = google_pb.NewTimestamp(e.LeaseExpiration)
+	}
 	if includeID {
 		aid := &dm.Attempt_ID{}
 		if err := aid.SetDMEncoded(e.Attempt.StringID()); err != nil {
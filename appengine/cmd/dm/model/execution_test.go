@@ -6,6 +6,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -85,6 +86,55 @@ func TestExecutions(t *testing.T) {
 			So(exe, ShouldResemble, e1)
 		})
 
+		Convey("AuthenticateLeaseHolder", func() {
+			c, clk := testclock.UseTime(c, testclock.TestTimeUTC)
+			e1 := &Execution{
+				ID:              1,
+				Attempt:         ak,
+				Token:           []byte("activation tok"),
+				LeaseExpiration: testclock.TestTimeUTC.Add(time.Minute),
+			}
+			a.CurExecution = 1
+			a.State = dm.Attempt_EXECUTING
+			So(ds.Put(a, e1), ShouldBeNil)
+
+			auth := &dm.Execution_Auth{
+				Id:    dm.NewExecutionID("q", a.ID.Id, uint32(e1.ID)),
+				Token: []byte("activation tok"),
+			}
+
+			Convey("works while scheduled", func() {
+				_, e, err := AuthenticateLeaseHolder(c, auth)
+				So(err, ShouldBeNil)
+				So(e, ShouldResemble, e1)
+			})
+
+			Convey("works while running", func() {
+				_, _, err := ActivateExecution(c, auth, []byte("new tok"))
+				So(err, ShouldBeNil)
+
+				_, _, err = AuthenticateLeaseHolder(c, auth)
+				So(err, ShouldBeRPCUnauthenticated, "execution lease Auth")
+
+				auth.Token = []byte("new tok")
+				_, _, err = AuthenticateLeaseHolder(c, auth)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("fails when expired", func() {
+				clk.Add(time.Minute)
+				_, _, err := AuthenticateLeaseHolder(c, auth)
+				So(err, ShouldBeRPCUnauthenticated, "execution lease Auth")
+			})
+
+			Convey("fails when not leased", func() {
+				e1.LeaseExpiration = time.Time{}
+				So(ds.Put(e1), ShouldBeNil)
+				_, _, err := AuthenticateLeaseHolder(c, auth)
+				So(err, ShouldBeRPCUnauthenticated, "execution lease Auth")
+			})
+		})
+
 		Convey("Activate", func() {
 			e1 := &Execution{
 				ID:      1,
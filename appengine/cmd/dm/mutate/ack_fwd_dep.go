@@ -34,8 +34,14 @@ func (f *AckFwdDep) RollForward(c context.Context) (muts []tumble.Mutation, err
 	ds := datastore.Get(c)
 
 	atmpt, fdep := f.Dep.Fwd(c)
-	err = ds.Get(atmpt, fdep)
-	if err != nil {
+	if err = ds.Get(fdep); err != nil {
+		if err == datastore.ErrNoSuchEntity {
+			// The dependency was removed, or redirected (see RedirectFwdDep).
+			err = nil
+		}
+		return
+	}
+	if err = ds.Get(atmpt); err != nil {
 		return
 	}
 
@@ -44,10 +50,27 @@ func (f *AckFwdDep) RollForward(c context.Context) (muts []tumble.Mutation, err
 		return
 	}
 
-	needPut := false
+	// if the attempt isn't waiting on its deps anymore (e.g. it was cancelled),
+	// then bail
+	if atmpt.State != dm.Attempt_ADDING_DEPS && atmpt.State != dm.Attempt_BLOCKED {
+		return
+	}
+
+	muts, needPut := ackDepBit(c, atmpt, fdep.BitIndex, f.DepIsFinished)
+	if needPut {
+		err = ds.Put(atmpt)
+	}
 
-	idx := uint32(fdep.BitIndex)
+	return
+}
 
+// ackDepBit records in atmpt, which is AddingDeps or Blocked, that the
+// dependency with the given BitIndex was added and, if finished is true, that
+// it's finished. It returns the mutations to run once atmpt isn't waiting on
+// any dependency anymore, and true iff atmpt was modified.
+//
+// The caller is responsible for Put'ing atmpt.
+func ackDepBit(c context.Context, atmpt *model.Attempt, idx uint32, finished bool) (muts []tumble.Mutation, changed bool) {
 	if !atmpt.AddingDepsBitmap.IsSet(idx) {
 		atmpt.AddingDepsBitmap.Set(idx)
 
@@ -55,26 +78,22 @@ func (f *AckFwdDep) RollForward(c context.Context) (muts []tumble.Mutation, err
 			atmpt.MustModifyState(c, dm.Attempt_BLOCKED)
 		}
 
-		needPut = true
+		changed = true
 	}
 
-	if f.DepIsFinished {
+	if finished {
 		if !atmpt.WaitingDepBitmap.IsSet(idx) {
 			atmpt.WaitingDepBitmap.Set(idx)
 
 			if atmpt.WaitingDepBitmap.All(true) {
 				atmpt.MustModifyState(c, dm.Attempt_NEEDS_EXECUTION)
-				muts = append(muts, &ScheduleExecution{For: f.Dep.From})
+				muts = append(muts, &ScheduleExecution{For: &atmpt.ID})
 			}
 
-			needPut = true
+			changed = true
 		}
 	}
 
-	if needPut {
-		err = ds.Put(atmpt)
-	}
-
 	return
 }
 
@@ -140,6 +140,33 @@ func TestAckFwdDep(t *testing.T) {
 						So(a.WaitingDepBitmap.CountSet(), ShouldEqual, 0)
 					})
 
+					Convey("cancelled attempt -> NOP", func() {
+						a.State = dm.Attempt_ABNORMAL_FINISHED
+						a.CurExecution = 0
+						So(ds.Put(a), ShouldBeNil)
+
+						afd.DepIsFinished = true
+						muts, err := afd.RollForward(c)
+						So(err, ShouldBeNil)
+						So(muts, ShouldBeNil)
+
+						So(ds.Get(a), ShouldBeNil)
+						So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+						So(a.WaitingDepBitmap.CountSet(), ShouldEqual, 0)
+					})
+
+					Convey("removed dependency -> NOP", func() {
+						So(ds.Delete(ds.KeyForObj(fwd)), ShouldBeNil)
+
+						afd.DepIsFinished = true
+						muts, err := afd.RollForward(c)
+						So(err, ShouldBeNil)
+						So(muts, ShouldBeNil)
+
+						So(ds.Get(a), ShouldBeNil)
+						So(a.WaitingDepBitmap.CountSet(), ShouldEqual, 0)
+					})
+
 					Convey("Missing data", func() {
 						So(ds.Delete(ds.KeyForObj(a)), ShouldBeNil)
 
@@ -8,6 +8,8 @@ import (
 	"github.com/luci/gae/service/datastore"
 	"github.com/luci/luci-go/appengine/cmd/dm/model"
 	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
 	"golang.org/x/net/context"
 )
 
@@ -15,6 +17,9 @@ import (
 // is true, this mutation will chain to an AckFwdDep. It should only be false
 // if this AddBackDep is spawned from an AddFinishedDeps, where the originating
 // Attempt already knows that this dependency is Finished.
+//
+// If the depended-on Attempt's result has expired, the BackDep isn't
+// propagated, and AddBackDep chains to a ReexecuteExpiredAttempt instead.
 type AddBackDep struct {
 	Dep      *model.FwdEdge
 	NeedsAck bool // make AckFwdDep iff true
@@ -39,14 +44,35 @@ func (a *AddBackDep) RollForward(c context.Context) (muts []tumble.Mutation, err
 		return
 	}
 
-	bd.Propagated = bdg.AttemptFinished
+	finished := bdg.AttemptFinished
+	if finished {
+		// The Attempt's result may have expired since it finished, in which case
+		// the new dependency must wait for it to be re-executed.
+		atmpt := &model.Attempt{ID: *a.Dep.To}
+		if err = datastore.GetNoTxn(c).Get(atmpt); err != nil {
+			return
+		}
+		switch {
+		case atmpt.ResultExpired(clock.Now(c)):
+			finished = false
+			muts = append(muts, &ReexecuteExpiredAttempt{ID: a.Dep.To, Dep: a.Dep})
+		case atmpt.State != dm.Attempt_FINISHED && atmpt.State != dm.Attempt_ABNORMAL_FINISHED:
+			// The Attempt is being re-executed, but its BackDepGroup hasn't caught
+			// up yet.
+			finished = false
+		}
+	}
+
+	bd.Propagated = finished
 
-	err = ds.Put(bd)
+	if err = ds.Put(bd); err != nil {
+		return
+	}
 
 	if a.NeedsAck {
 		muts = append(muts, &AckFwdDep{
 			Dep:           a.Dep,
-			DepIsFinished: bdg.AttemptFinished,
+			DepIsFinished: finished,
 		})
 	}
 	return
@@ -6,6 +6,7 @@ package mutate
 
 import (
 	"testing"
+	"time"
 
 	"github.com/luci/gae/filter/featureBreaker"
 	"github.com/luci/gae/impl/memory"
@@ -13,6 +14,7 @@ import (
 	"github.com/luci/luci-go/appengine/cmd/dm/model"
 	"github.com/luci/luci-go/appengine/tumble"
 	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock/testclock"
 	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
@@ -23,6 +25,7 @@ func TestAddBackDep(t *testing.T) {
 
 	Convey("AddBackDep", t, func() {
 		c := memory.Use(context.Background())
+		c, clk := testclock.UseTime(c, testclock.TestTimeUTC)
 		ds := datastore.Get(c)
 
 		abd := &AddBackDep{
@@ -42,7 +45,12 @@ func TestAddBackDep(t *testing.T) {
 
 			Convey("attempt finished", func() {
 				bdg.AttemptFinished = true
-				So(ds.Put(bdg), ShouldBeNil)
+				a := &model.Attempt{
+					ID:               *abd.Dep.To,
+					State:            dm.Attempt_FINISHED,
+					ResultExpiration: clk.Now().Add(time.Hour),
+				}
+				So(ds.Put(bdg, a), ShouldBeNil)
 
 				Convey("no need completion", func() {
 					muts, err := abd.RollForward(c)
@@ -65,6 +73,33 @@ func TestAddBackDep(t *testing.T) {
 					So(bd.Edge(), ShouldResemble, abd.Dep)
 					So(bd.Propagated, ShouldBeTrue)
 				})
+
+				Convey("result expired", func() {
+					clk.Add(time.Hour)
+					abd.NeedsAck = true
+					muts, err := abd.RollForward(c)
+					So(err, ShouldBeNil)
+					So(muts, ShouldResemble, []tumble.Mutation{
+						&ReexecuteExpiredAttempt{ID: abd.Dep.To, Dep: abd.Dep},
+						&AckFwdDep{abd.Dep, false}})
+
+					So(ds.Get(bd), ShouldBeNil)
+					So(bd.Propagated, ShouldBeFalse)
+				})
+
+				Convey("attempt is being re-executed", func() {
+					a.State = dm.Attempt_NEEDS_EXECUTION
+					So(ds.Put(a), ShouldBeNil)
+
+					abd.NeedsAck = true
+					muts, err := abd.RollForward(c)
+					So(err, ShouldBeNil)
+					So(muts, ShouldResemble, []tumble.Mutation{
+						&AckFwdDep{abd.Dep, false}})
+
+					So(ds.Get(bd), ShouldBeNil)
+					So(bd.Propagated, ShouldBeFalse)
+				})
 			})
 
 			Convey("attempt not finished, need completion", func() {
@@ -27,6 +27,11 @@ type AddDeps struct {
 
 	// Deps are fwddeps we think are missing from the auth'd attempt.
 	Deps *dm.AttemptList
+
+	// StaleDeps are fwddeps which the auth'd attempt already has, but whose
+	// results aren't available anymore (e.g. they expired). They're added
+	// again, so that the attempt waits for them to finish again.
+	StaleDeps *dm.AttemptList
 }
 
 // Root implements tumble.Mutation
@@ -46,7 +51,13 @@ func (a *AddDeps) RollForward(c context.Context) (muts []tumble.Mutation, err er
 
 	fwdDeps, err := filterExisting(c, model.FwdDepsFromList(c, a.Auth.Id.AttemptID(), a.Deps))
 	err = grpcutil.MaybeLogErr(c, err, codes.Internal, "while filtering deps")
-	if err != nil || len(fwdDeps) == 0 {
+	if err != nil {
+		return
+	}
+	if a.StaleDeps != nil {
+		fwdDeps = append(fwdDeps, model.FwdDepsFromList(c, a.Auth.Id.AttemptID(), a.StaleDeps)...)
+	}
+	if len(fwdDeps) == 0 {
 		return
 	}
 
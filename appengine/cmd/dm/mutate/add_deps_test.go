@@ -10,6 +10,7 @@ import (
 	"github.com/luci/gae/impl/memory"
 	"github.com/luci/gae/service/datastore"
 	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
 	"github.com/luci/luci-go/common/api/dm/service/v1"
 	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
@@ -89,6 +90,24 @@ func TestAddDeps(t *testing.T) {
 					So(fds[0].ForExecution, ShouldEqual, 1)
 				})
 
+				Convey("re-adding stale deps", func() {
+					So(ds.Put(fds), ShouldBeNil)
+					ad.StaleDeps = dm.NewAttemptList(map[string][]uint32{"tp": {1}})
+					stale := model.FwdDepsFromList(c, aid, ad.StaleDeps)
+
+					muts, err := ad.RollForward(c)
+					So(err, ShouldBeNil)
+					So(muts, ShouldResemble, []tumble.Mutation{&AddBackDep{
+						Dep: stale[0].Edge(), NeedsAck: true}})
+
+					So(ds.Get(a, stale), ShouldBeNil)
+					So(a.AddingDepsBitmap.Size(), ShouldEqual, 1)
+					So(a.WaitingDepBitmap.Size(), ShouldEqual, 1)
+					So(a.State, ShouldEqual, dm.Attempt_ADDING_DEPS)
+					So(stale[0].BitIndex, ShouldEqual, 0)
+					So(stale[0].ForExecution, ShouldEqual, 1)
+				})
+
 				Convey("adding new Attempts at the same time", func() {
 					ad.Atmpts = dm.NewAttemptList(map[string][]uint32{
 						"to": {2, 3},
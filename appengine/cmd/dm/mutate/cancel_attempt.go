@@ -0,0 +1,108 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/grpcutil"
+	"golang.org/x/net/context"
+)
+
+// CancelAttempt moves an unfinished Attempt to AbnormalFinished. If the
+// Attempt is Executing, its current Execution is cancelled, and its task is
+// cancelled through its distributor by a separate CancelTask mutation. It then
+// starts the RecordCompletion state machine, so that Attempts which depend on
+// it are unblocked.
+type CancelAttempt struct {
+	ID     *dm.Attempt_ID
+	Reason string
+}
+
+// Root implements tumble.Mutation
+func (ca *CancelAttempt) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *ca.ID})
+}
+
+// RollForward implements tumble.Mutation
+//
+// This mutation is called directly from CancelAttempt, so we use
+// grpcutil.MaybeLogErr
+func (ca *CancelAttempt) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+	a := &model.Attempt{ID: *ca.ID}
+	if err = ds.Get(a); err != nil {
+		err = attemptLoadErr(c, err)
+		return
+	}
+	switch a.State {
+	case dm.Attempt_FINISHED, dm.Attempt_ABNORMAL_FINISHED:
+		err = grpcutil.Errf(codes.FailedPrecondition, "attempt %v is already %s", a.ID, a.State)
+		return
+	}
+
+	reason := "cancelled"
+	if ca.Reason != "" {
+		reason += ": " + ca.Reason
+	}
+
+	toPut := []interface{}{a}
+	if a.State == dm.Attempt_EXECUTING {
+		e := &model.Execution{ID: a.CurExecution, Attempt: ds.KeyForObj(a)}
+		if err = ds.Get(e); err != nil {
+			err = grpcutil.MaybeLogErr(c, err, codes.Internal, "while loading execution")
+			return
+		}
+		eid := dm.NewExecutionID(a.ID.Quest, a.ID.Id, e.ID)
+		if mut := cancelExecution(c, eid, e, "attempt "+reason); mut != nil {
+			muts = append(muts, mut)
+		}
+		toPut = append(toPut, e)
+	}
+
+	a.MustModifyState(c, dm.Attempt_ABNORMAL_FINISHED)
+	a.AbnormalFinishReason = reason
+	if err = grpcutil.MaybeLogErr(c, ds.Put(toPut...), codes.Internal, "while trying to PutMulti"); err != nil {
+		return
+	}
+	muts = append(muts, &RecordCompletion{For: &a.ID})
+	return
+}
+
+// cancelExecution marks a Scheduled or Running Execution e CANCELLED and
+// revokes its Token, so that its task can't affect the Attempt anymore. If e
+// has a distributor task, it returns a CancelTask mutation to cancel it, so
+// that a failing distributor doesn't hold back the cancellation.
+//
+// The caller is responsible for Put'ing e.
+func cancelExecution(c context.Context, eid *dm.Execution_ID, e *model.Execution, reason string) tumble.Mutation {
+	if e.State.Terminal() {
+		return nil
+	}
+
+	e.State.MustEvolve(dm.Execution_CANCELLED)
+	e.StateReason = reason
+	e.Token = nil
+	if e.DistributorToken == "" {
+		return nil
+	}
+	return &CancelTask{For: eid, DistributorToken: e.DistributorToken}
+}
+
+// attemptLoadErr converts an error from loading an Attempt to a grpc error.
+func attemptLoadErr(c context.Context, err error) error {
+	if err == datastore.ErrNoSuchEntity {
+		return grpcutil.Errf(codes.NotFound, "no such attempt")
+	}
+	return grpcutil.MaybeLogErr(c, err, codes.Internal, "while loading attempt")
+}
+
+func init() {
+	tumble.Register((*CancelAttempt)(nil))
+}
@@ -0,0 +1,101 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor/fake"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestCancelAttempt(t *testing.T) {
+	t.Parallel()
+
+	Convey("CancelAttempt", t, func() {
+		c := memory.Use(context.Background())
+		ca := &CancelAttempt{ID: dm.NewAttemptID("quest", 1), Reason: "not needed"}
+
+		Convey("Root", func() {
+			So(ca.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			ds := datastore.Get(c)
+
+			dist := &fake.Distributor{}
+			c = distributor.WithRegistry(c, distributor.NewTestingRegistry(distributor.TestFactoryMap{
+				"fake": dist.Factory(),
+			}, FinishExecutionFn))
+
+			qst := &model.Quest{ID: "quest", Desc: *dm.NewQuestDesc("fake", `{}`)}
+			a := &model.Attempt{ID: *ca.ID, State: dm.Attempt_BLOCKED}
+			So(ds.Put(qst, a), ShouldBeNil)
+
+			Convey("cancels a blocked attempt", func() {
+				muts, err := ca.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RecordCompletion{For: &a.ID}})
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(a.AbnormalFinishReason, ShouldEqual, "cancelled: not needed")
+			})
+
+			Convey("cancels the current execution", func() {
+				eid := dm.NewExecutionID("quest", 1, 1)
+				tok, err := dist.Run(&distributor.TaskDescription{
+					ExecutionAuth: &dm.Execution_Auth{Id: eid}})
+				So(err, ShouldBeNil)
+
+				a.State = dm.Attempt_EXECUTING
+				a.CurExecution = 1
+				e := &model.Execution{
+					ID: 1, Attempt: ds.KeyForObj(a), State: dm.Execution_RUNNING,
+					Token: []byte("exekey"), DistributorToken: string(tok)}
+				So(ds.Put(a, e), ShouldBeNil)
+
+				muts, err := ca.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&CancelTask{For: eid, DistributorToken: string(tok)},
+					&RecordCompletion{For: &a.ID},
+				})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(e.State, ShouldEqual, dm.Execution_CANCELLED)
+				So(e.StateReason, ShouldEqual, "attempt cancelled: not needed")
+				So(e.Token, ShouldBeNil)
+
+				// The task is only cancelled by the CancelTask mutation.
+				So(dist.Task(tok).Result, ShouldBeNil)
+			})
+
+			Convey("can't cancel finished attempts", func() {
+				a.State = dm.Attempt_FINISHED
+				So(ds.Put(a), ShouldBeNil)
+
+				_, err := ca.RollForward(c)
+				So(err, ShouldBeRPCFailedPrecondition, "already FINISHED")
+			})
+
+			Convey("missing attempt", func() {
+				So(ds.Delete(ds.KeyForObj(a)), ShouldBeNil)
+
+				_, err := ca.RollForward(c)
+				So(err, ShouldBeRPCNotFound, "no such attempt")
+			})
+		})
+	})
+}
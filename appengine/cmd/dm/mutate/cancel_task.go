@@ -0,0 +1,66 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"fmt"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// CancelTask cancels the distributor task of an Execution which was already
+// marked CANCELLED (see cancelExecution).
+//
+// It runs in its own tumble transaction, after the one which cancelled the
+// Execution was committed. Like RunExecution, it calls the distributor inside
+// that transaction, but it doesn't modify any entities, so it's safe to retry.
+// Cancelling a task is best-effort: the Execution's Token was already revoked,
+// so a task which keeps running can't affect its Attempt anymore.
+type CancelTask struct {
+	For              *dm.Execution_ID
+	DistributorToken string
+}
+
+// Root implements tumble.Mutation
+func (ct *CancelTask) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *ct.For.AttemptID()})
+}
+
+// RollForward implements tumble.Mutation
+func (ct *CancelTask) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	reg := distributor.GetRegistry(c)
+	if reg == nil {
+		err = fmt.Errorf("no distributor registry, can't cancel %v", ct.For)
+		return
+	}
+
+	// Quests are immutable, and live in their own entity group.
+	q := &model.Quest{ID: ct.For.Quest}
+	if err = datastore.GetNoTxn(c).Get(q); err != nil {
+		err = errors.WrapTransient(err)
+		return
+	}
+	d, _, err := reg.MakeDistributor(c, q.Desc.DistributorConfigName)
+	if err == nil {
+		err = d.Cancel(distributor.Token(ct.DistributorToken))
+	}
+	if err != nil && !errors.IsTransient(err) {
+		logging.Fields{logging.ErrorKey: err, "eid": ct.For, "token": ct.DistributorToken}.Warningf(
+			c, "failed to cancel distributor task")
+		err = nil
+	}
+	return
+}
+
+func init() {
+	tumble.Register((*CancelTask)(nil))
+}
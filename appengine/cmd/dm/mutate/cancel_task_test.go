@@ -0,0 +1,69 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor/fake"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestCancelTask(t *testing.T) {
+	t.Parallel()
+
+	Convey("CancelTask", t, func() {
+		c := memory.Use(context.Background())
+		eid := dm.NewExecutionID("quest", 1, 1)
+
+		Convey("Root", func() {
+			ct := &CancelTask{For: eid, DistributorToken: "tok"}
+			So(ct.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			ds := datastore.Get(c)
+			So(ds.Put(&model.Quest{ID: "quest", Desc: *dm.NewQuestDesc("fake", `{}`)}), ShouldBeNil)
+
+			dist := &fake.Distributor{}
+			reg := distributor.NewTestingRegistry(distributor.TestFactoryMap{
+				"fake": dist.Factory(),
+			}, FinishExecutionFn)
+
+			tok, err := dist.Run(&distributor.TaskDescription{
+				ExecutionAuth: &dm.Execution_Auth{Id: eid}})
+			So(err, ShouldBeNil)
+			ct := &CancelTask{For: eid, DistributorToken: string(tok)}
+
+			Convey("without a registry, fails", func() {
+				_, err := ct.RollForward(c)
+				So(err, ShouldNotBeNil)
+				So(dist.Task(tok).Result, ShouldBeNil)
+			})
+
+			Convey("cancels the task", func() {
+				c = distributor.WithRegistry(c, reg)
+				muts, err := ct.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+				So(dist.Task(tok).Result.State, ShouldEqual, dm.Execution_CANCELLED)
+			})
+
+			Convey("ignores tasks the distributor can't cancel", func() {
+				c = distributor.WithRegistry(c, reg)
+				ct.DistributorToken = "unknown"
+				muts, err := ct.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+			})
+		})
+	})
+}
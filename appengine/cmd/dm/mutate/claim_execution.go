@@ -0,0 +1,73 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/grpcutil"
+	"golang.org/x/net/context"
+)
+
+// ClaimExecution leases a new Execution of an Attempt which NeedsExecution to
+// an executor, and schedules a TimeoutExecutionLease for it.
+//
+// EID must be the ID of the next Execution of the Attempt. If the Attempt no
+// longer NeedsExecution, or already moved on to a different Execution, this
+// returns a FailedPrecondition error.
+type ClaimExecution struct {
+	EID             *dm.Execution_ID
+	Token           []byte
+	LeaseExpiration time.Time
+}
+
+// Root implements tumble.Mutation
+func (cl *ClaimExecution) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *cl.EID.AttemptID()})
+}
+
+// RollForward implements tumble.Mutation
+//
+// This mutation is called directly from ClaimExecution, so we use
+// grpcutil.MaybeLogErr
+func (cl *ClaimExecution) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+	a := &model.Attempt{ID: *cl.EID.AttemptID()}
+	if err = ds.Get(a); err != nil {
+		err = grpcutil.MaybeLogErr(c, err, codes.Internal, "while loading attempt")
+		return
+	}
+	if a.State != dm.Attempt_NEEDS_EXECUTION || a.CurExecution+1 != cl.EID.Id {
+		err = grpcutil.Errf(codes.FailedPrecondition, "attempt %v is no longer claimable", a.ID)
+		return
+	}
+
+	a.CurExecution++
+	a.MustModifyState(c, dm.Attempt_EXECUTING)
+	e := &model.Execution{
+		ID:              a.CurExecution,
+		Attempt:         ds.KeyForObj(a),
+		Created:         clock.Now(c).UTC(),
+		State:           dm.Execution_SCHEDULED,
+		Token:           cl.Token,
+		LeaseExpiration: cl.LeaseExpiration,
+	}
+	err = grpcutil.MaybeLogErr(c, ds.Put(a, e), codes.Internal, "while trying to PutMulti")
+	if err == nil {
+		muts = []tumble.Mutation{&TimeoutExecutionLease{EID: cl.EID, Expiration: cl.LeaseExpiration}}
+	}
+	return
+}
+
+func init() {
+	tumble.Register((*ClaimExecution)(nil))
+}
@@ -0,0 +1,69 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestClaimExecution(t *testing.T) {
+	t.Parallel()
+
+	Convey("ClaimExecution", t, func() {
+		c := memory.Use(context.Background())
+		c, _ = testclock.UseTime(c, testclock.TestTimeUTC)
+		exp := testclock.TestTimeUTC.Add(time.Minute)
+		cl := &ClaimExecution{
+			EID:             dm.NewExecutionID("quest", 1, 1),
+			Token:           []byte("acttok"),
+			LeaseExpiration: exp,
+		}
+
+		Convey("Root", func() {
+			So(cl.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			ds := datastore.Get(c)
+			a := &model.Attempt{ID: *cl.EID.AttemptID(), State: dm.Attempt_NEEDS_EXECUTION}
+			e := &model.Execution{ID: 1, Attempt: ds.KeyForObj(a)}
+			So(ds.Put(a), ShouldBeNil)
+
+			Convey("leases a new execution", func() {
+				muts, err := cl.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&TimeoutExecutionLease{EID: cl.EID, Expiration: exp}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+				So(a.CurExecution, ShouldEqual, 1)
+				So(e.State, ShouldEqual, dm.Execution_SCHEDULED)
+				So(e.Token, ShouldResemble, []byte("acttok"))
+				So(e.LeaseExpiration, ShouldResemble, exp)
+			})
+
+			Convey("fails if the attempt was already claimed", func() {
+				a.State = dm.Attempt_EXECUTING
+				a.CurExecution = 1
+				So(ds.Put(a), ShouldBeNil)
+
+				_, err := cl.RollForward(c)
+				So(err, ShouldBeRPCFailedPrecondition, "no longer claimable")
+			})
+		})
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+const (
+	// sweepLimit is the maximum number of expired Attempts that a single
+	// SweepExpiredResults call will find.
+	sweepLimit = 1000
+
+	// sweepBatchSize is the number of ExpireResult mutations which are journaled
+	// together. Each one is a datastore write in the journaling transaction.
+	sweepBatchSize = 100
+)
+
+// ExpireResult garbage-collects the AttemptResult of a Finished Attempt whose
+// result has expired, and marks the Attempt as Expired.
+//
+// The Attempt stays Finished; depending on it again re-executes it (see
+// AddBackDep).
+type ExpireResult struct {
+	ID *dm.Attempt_ID
+}
+
+// Root implements tumble.Mutation
+func (e *ExpireResult) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *e.ID})
+}
+
+// RollForward implements tumble.Mutation
+func (e *ExpireResult) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+	a := &model.Attempt{ID: *e.ID}
+	if err = ds.Get(a); err != nil {
+		return
+	}
+	if a.Expired || !a.ResultExpired(clock.Now(c)) {
+		return
+	}
+
+	if err = ds.Delete(ds.KeyForObj(&model.AttemptResult{Attempt: ds.KeyForObj(a)})); err != nil {
+		return
+	}
+	a.Expired = true
+	err = ds.Put(a)
+	return
+}
+
+// SweepExpiredResults finds Finished Attempts whose results have expired, but
+// haven't been garbage-collected yet, and journals an ExpireResult mutation for
+// each of them. It returns the number of Attempts found.
+//
+// It's intended to be run periodically from a cron job, and finds at most
+// sweepLimit Attempts per call.
+func SweepExpiredResults(c context.Context) (int, error) {
+	ds := datastore.Get(c)
+	q := (datastore.NewQuery("Attempt").
+		Eq("State", dm.Attempt_FINISHED).
+		Eq("Expired", false).
+		Gt("ResultExpiration", time.Time{}).
+		Lte("ResultExpiration", clock.Now(c).UTC()).
+		Limit(sweepLimit).
+		KeysOnly(true))
+
+	var keys []*datastore.Key
+	if err := ds.GetAll(q, &keys); err != nil {
+		return 0, err
+	}
+
+	muts := make([]tumble.Mutation, 0, sweepBatchSize)
+	for _, k := range keys {
+		aid := &dm.Attempt_ID{}
+		if err := aid.SetDMEncoded(k.StringID()); err != nil {
+			logging.Fields{logging.ErrorKey: err, "key": k}.Errorf(c, "bad Attempt key")
+			continue
+		}
+		muts = append(muts, &ExpireResult{ID: aid})
+		if len(muts) == sweepBatchSize {
+			if err := tumble.AddToJournal(c, muts...); err != nil {
+				return 0, err
+			}
+			muts = muts[:0]
+		}
+	}
+	if err := tumble.AddToJournal(c, muts...); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+func init() {
+	tumble.Register((*ExpireResult)(nil))
+}
@@ -0,0 +1,98 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExpireResult(t *testing.T) {
+	t.Parallel()
+
+	Convey("ExpireResult", t, func() {
+		ttest := &tumble.Testing{}
+		c := ttest.Context()
+		clk := clock.Get(c).(testclock.TestClock)
+		ds := datastore.Get(c)
+		ds.Testable().AddIndexes(&datastore.IndexDefinition{
+			Kind: "Attempt",
+			SortBy: []datastore.IndexColumn{
+				{Property: "State"},
+				{Property: "Expired"},
+				{Property: "ResultExpiration"},
+			},
+		})
+
+		mkFinished := func(qid string, exp time.Time) (*model.Attempt, *model.AttemptResult) {
+			a := &model.Attempt{
+				ID:               *dm.NewAttemptID(qid, 1),
+				State:            dm.Attempt_FINISHED,
+				ResultExpiration: exp,
+				ResultSize:       2,
+			}
+			ar := &model.AttemptResult{Attempt: ds.KeyForObj(a), Data: "{}", Size: 2}
+			So(ds.Put(a, ar), ShouldBeNil)
+			return a, ar
+		}
+
+		expiring, expiringResult := mkFinished("expiring", clk.Now().Add(time.Hour))
+		forever, foreverResult := mkFinished("forever", time.Time{})
+		later, laterResult := mkFinished("later", clk.Now().Add(2*time.Hour))
+
+		Convey("RollForward", func() {
+			e := &ExpireResult{&expiring.ID}
+
+			Convey("doesn't expire fresh results", func() {
+				_, err := e.RollForward(c)
+				So(err, ShouldBeNil)
+				So(ds.Get(expiring, expiringResult), ShouldBeNil)
+				So(expiring.Expired, ShouldBeFalse)
+			})
+
+			Convey("expires results", func() {
+				clk.Add(time.Hour)
+				_, err := e.RollForward(c)
+				So(err, ShouldBeNil)
+
+				So(ds.Get(expiring), ShouldBeNil)
+				So(expiring.State, ShouldEqual, dm.Attempt_FINISHED)
+				So(expiring.Expired, ShouldBeTrue)
+				So(ds.Get(expiringResult), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+		})
+
+		Convey("SweepExpiredResults", func() {
+			n, err := SweepExpiredResults(c)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 0)
+
+			clk.Add(time.Hour)
+			n, err = SweepExpiredResults(c)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 1)
+			ttest.Drain(c)
+
+			So(ds.Get(expiring, forever, later), ShouldBeNil)
+			So(expiring.Expired, ShouldBeTrue)
+			So(forever.Expired, ShouldBeFalse)
+			So(later.Expired, ShouldBeFalse)
+			So(ds.Get(expiringResult), ShouldEqual, datastore.ErrNoSuchEntity)
+			So(ds.Get(foreverResult, laterResult), ShouldBeNil)
+
+			n, err = SweepExpiredResults(c)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 0)
+		})
+	})
+}
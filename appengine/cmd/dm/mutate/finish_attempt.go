@@ -43,29 +43,32 @@ func (f *FinishAttempt) RollForward(c context.Context) (muts []tumble.Mutation,
 		return
 	}
 
-	ds := datastore.Get(c)
+	rslt, muts := finishAttempt(c, atmpt, f.Result, f.ResultExpiration)
+	err = grpcutil.MaybeLogErr(c, datastore.Get(c).Put(atmpt, rslt),
+		codes.Internal, "while trying to PutMulti")
+	return
+}
 
-	// Executing -> Finished is valid, and we know we're already Executing because
-	// the InvalidateExecution call above asserts that or errors out.
+// finishAttempt moves an Executing Attempt to Finished with the given result.
+// It returns the AttemptResult to Put along with the Attempt, and the
+// mutations which record the Attempt's completion.
+func finishAttempt(c context.Context, atmpt *model.Attempt, result string, expiration time.Time) (*model.AttemptResult, []tumble.Mutation) {
+	// Executing -> Finished is valid, and callers ensure that we're already
+	// Executing.
 	atmpt.MustModifyState(c, dm.Attempt_FINISHED)
 
-	atmpt.ResultSize = uint32(len(f.Result))
-	atmpt.ResultExpiration = f.ResultExpiration
+	atmpt.ResultSize = uint32(len(result))
+	atmpt.ResultExpiration = expiration
 	rslt := &model.AttemptResult{
-		Attempt:    ds.KeyForObj(atmpt),
-		Data:       f.Result,
+		Attempt:    datastore.Get(c).KeyForObj(atmpt),
+		Data:       result,
 		Expiration: atmpt.ResultExpiration,
 		Size:       atmpt.ResultSize,
 	}
 
-	err = grpcutil.MaybeLogErr(c, ds.Put(atmpt, rslt),
-		codes.Internal, "while trying to PutMulti")
-
 	// TODO(iannucci): also include mutations to generate index entries for
 	// the attempt results.
-	muts = append(muts, &RecordCompletion{For: f.Auth.Id.AttemptID()})
-
-	return
+	return rslt, []tumble.Mutation{&RecordCompletion{For: &atmpt.ID}}
 }
 
 func init() {
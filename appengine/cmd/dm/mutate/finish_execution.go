@@ -0,0 +1,139 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// FinishExecution records the final state of an Execution, as reported by its
+// distributor, and revokes the Execution's Token.
+//
+// If the Execution's task ended without moving its Attempt out of Executing,
+// the Attempt is finished with the distributor's result if the task finished
+// successfully, and retried (see retryAttempt) otherwise.
+type FinishExecution struct {
+	EID    *dm.Execution_ID
+	Result *distributor.TaskResult
+}
+
+// FinishExecutionFn is a distributor.FinishExecutionFn which applies the
+// FinishExecution mutation.
+func FinishExecutionFn(c context.Context, eid *dm.Execution_ID, rslt *distributor.TaskResult) error {
+	return tumble.RunMutation(c, &FinishExecution{EID: eid, Result: rslt})
+}
+
+// Root implements tumble.Mutation
+func (f *FinishExecution) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *f.EID.AttemptID()})
+}
+
+// RollForward implements tumble.Mutation
+func (f *FinishExecution) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+	a := &model.Attempt{ID: *f.EID.AttemptID()}
+	e := &model.Execution{ID: f.EID.Id, Attempt: ds.KeyForObj(a)}
+	if err = ds.Get(a, e); err != nil {
+		return
+	}
+
+	if e.State.Terminal() {
+		logging.Infof(c, "execution %v is already %s", f.EID, e.State)
+		return
+	}
+	if err = evolveFinishedExecution(c, &e.State, f.Result.State); err != nil {
+		return
+	}
+	e.StateReason = f.Result.Reason
+	e.Token = nil
+	toPut := []interface{}{a, e}
+
+	if a.CurExecution == e.ID && a.State == dm.Attempt_EXECUTING {
+		if e.State == dm.Execution_FINISHED {
+			result := f.Result.Result
+			if result == "" {
+				result = "{}"
+			}
+			var rslt *model.AttemptResult
+			rslt, muts = finishAttempt(c, a, result, time.Time{})
+			toPut = append(toPut, rslt)
+		} else {
+			var retryMuts []tumble.Mutation
+			if retryMuts, err = retryAttempt(c, a, e); err != nil {
+				return
+			}
+			muts = append(muts, retryMuts...)
+		}
+	}
+
+	err = ds.Put(toPut...)
+	return
+}
+
+// evolveFinishedExecution moves s to the state reported by the distributor for
+// a task which has ended.
+//
+// The report can arrive before DM recorded every step of the task's life: an
+// Execution is still SCHEDULING until RunExecution commits, even though the
+// distributor has already accepted (and maybe run) its task, and a SCHEDULED
+// Execution may have failed without ever being seen RUNNING. s is walked
+// through the states which the report implies it skipped, and ends up MISSING
+// if the reported state still isn't reachable.
+func evolveFinishedExecution(c context.Context, s *dm.Execution_State, to dm.Execution_State) error {
+	if *s == dm.Execution_SCHEDULING && to != dm.Execution_REJECTED && to != dm.Execution_CANCELLED {
+		if err := s.Evolve(dm.Execution_SCHEDULED); err != nil {
+			return err
+		}
+	}
+	if *s == dm.Execution_SCHEDULED && to == dm.Execution_FAILED {
+		if err := s.Evolve(dm.Execution_RUNNING); err != nil {
+			return err
+		}
+	}
+	if err := s.Evolve(to); err != nil {
+		logging.WithError(err).Warningf(c, "impossible execution state from distributor, assuming MISSING")
+		return s.Evolve(dm.Execution_MISSING)
+	}
+	return nil
+}
+
+// retryAttempt is called when Execution e of the Executing Attempt a ended
+// without moving a out of Executing. It moves a back to NeedsExecution and
+// schedules a new Execution for it, unless a has already used up its retries,
+// in which case it's AbnormalFinished so that its dependents don't wait on it
+// forever.
+//
+// The caller is responsible for Put'ing a.
+func retryAttempt(c context.Context, a *model.Attempt, e *model.Execution) ([]tumble.Mutation, error) {
+	s, err := getSettings(c)
+	if err != nil {
+		return nil, err
+	}
+
+	a.FailedExecutions++
+	if a.FailedExecutions > s.ExecutionRetryLimit {
+		logging.Warningf(c, "attempt %v failed %d executions, giving up", a.ID, a.FailedExecutions)
+		a.MustModifyState(c, dm.Attempt_ABNORMAL_FINISHED)
+		a.AbnormalFinishReason = fmt.Sprintf("gave up after %d failed executions; last was %s: %s",
+			a.FailedExecutions, e.State, e.StateReason)
+		return []tumble.Mutation{&RecordCompletion{For: &a.ID}}, nil
+	}
+
+	a.MustModifyState(c, dm.Attempt_NEEDS_EXECUTION)
+	return []tumble.Mutation{&ScheduleExecution{For: &a.ID}}, nil
+}
+
+func init() {
+	tumble.Register((*FinishExecution)(nil))
+}
@@ -0,0 +1,174 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestFinishExecution(t *testing.T) {
+	t.Parallel()
+
+	Convey("FinishExecution", t, func() {
+		c := memory.Use(context.Background())
+		fe := &FinishExecution{
+			EID:    dm.NewExecutionID("quest", 1, 1),
+			Result: &distributor.TaskResult{State: dm.Execution_FINISHED, Result: `{"ok": true}`},
+		}
+
+		Convey("Root", func() {
+			So(fe.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			ds := datastore.Get(c)
+			a := &model.Attempt{
+				ID:           *fe.EID.AttemptID(),
+				State:        dm.Attempt_EXECUTING,
+				CurExecution: 1,
+			}
+			ak := ds.KeyForObj(a)
+			ar := &model.AttemptResult{Attempt: ak}
+			e := &model.Execution{
+				ID: 1, Attempt: ak, State: dm.Execution_RUNNING, Token: []byte("exekey")}
+			So(ds.Put(a, e), ShouldBeNil)
+
+			Convey("finishes the attempt with the distributor's result", func() {
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RecordCompletion{For: &a.ID}})
+
+				So(ds.Get(a, e, ar), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_FINISHED)
+				So(e.Token, ShouldBeEmpty)
+				So(a.State, ShouldEqual, dm.Attempt_FINISHED)
+				So(ar.Data, ShouldEqual, `{"ok": true}`)
+			})
+
+			Convey("reschedules the attempt if the task failed", func() {
+				fe.Result = &distributor.TaskResult{State: dm.Execution_FAILED, Reason: "bot died"}
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&ScheduleExecution{For: &a.ID}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_FAILED)
+				So(e.StateReason, ShouldEqual, "bot died")
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(ds.Get(ar), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("gives up on the attempt once it's out of retries", func() {
+				a.FailedExecutions = defaultSettings.ExecutionRetryLimit
+				So(ds.Put(a), ShouldBeNil)
+
+				fe.Result = &distributor.TaskResult{State: dm.Execution_FAILED, Reason: "bot died"}
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RecordCompletion{For: &a.ID}})
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(a.AbnormalFinishReason, ShouldEqual, "gave up after 4 failed executions; last was FAILED: bot died")
+			})
+
+			Convey("only records the execution state if the attempt moved on", func() {
+				a.State = dm.Attempt_FINISHED
+				So(ds.Put(a), ShouldBeNil)
+
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_FINISHED)
+				So(ds.Get(ar), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("assumes MISSING for impossible states", func() {
+				fe.Result = &distributor.TaskResult{State: dm.Execution_TIMED_OUT}
+				_, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+
+				So(ds.Get(e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_MISSING)
+			})
+
+			Convey("finishes a SCHEDULING execution", func() {
+				// RunExecution hasn't committed yet, but the task is already done.
+				e.State = dm.Execution_SCHEDULING
+				So(ds.Put(e), ShouldBeNil)
+
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RecordCompletion{For: &a.ID}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_FINISHED)
+				So(a.State, ShouldEqual, dm.Attempt_FINISHED)
+			})
+
+			Convey("fails a SCHEDULING execution", func() {
+				e.State = dm.Execution_SCHEDULING
+				So(ds.Put(e), ShouldBeNil)
+
+				fe.Result = &distributor.TaskResult{State: dm.Execution_FAILED, Reason: "bot died"}
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&ScheduleExecution{For: &a.ID}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_FAILED)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+			})
+
+			Convey("rejects a SCHEDULING execution", func() {
+				e.State = dm.Execution_SCHEDULING
+				So(ds.Put(e), ShouldBeNil)
+
+				fe.Result = &distributor.TaskResult{State: dm.Execution_REJECTED}
+				_, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+
+				So(ds.Get(e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_REJECTED)
+			})
+
+			Convey("fails a SCHEDULED execution", func() {
+				e.State = dm.Execution_SCHEDULED
+				So(ds.Put(e), ShouldBeNil)
+
+				fe.Result = &distributor.TaskResult{State: dm.Execution_FAILED}
+				_, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+
+				So(ds.Get(e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_FAILED)
+			})
+
+			Convey("ignores finished executions", func() {
+				e.State = dm.Execution_CANCELLED
+				So(ds.Put(e), ShouldBeNil)
+
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_CANCELLED)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+			})
+		})
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/grpcutil"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// InvalidateAttempt discards the result of a Finished (or AbnormalFinished)
+// Attempt, and moves it back to NeedsExecution. It also starts the
+// InvalidateBackDeps state machine, which tells all of the Attempts that
+// depend on it about the invalidation.
+type InvalidateAttempt struct {
+	ID     *dm.Attempt_ID
+	Reason string
+}
+
+// Root implements tumble.Mutation
+func (i *InvalidateAttempt) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *i.ID})
+}
+
+// RollForward implements tumble.Mutation
+//
+// This mutation is called directly from InvalidateAttempt, so we use
+// grpcutil.MaybeLogErr
+func (i *InvalidateAttempt) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	a := &model.Attempt{ID: *i.ID}
+	if err = datastore.Get(c).Get(a); err != nil {
+		err = attemptLoadErr(c, err)
+		return
+	}
+	switch a.State {
+	case dm.Attempt_FINISHED, dm.Attempt_ABNORMAL_FINISHED:
+	default:
+		err = grpcutil.Errf(codes.FailedPrecondition, "attempt %v is %s, not finished", a.ID, a.State)
+		return
+	}
+
+	muts, err = invalidateAttempt(c, a, i.Reason)
+	err = grpcutil.MaybeLogErr(c, err, codes.Internal, "while invalidating attempt")
+	return
+}
+
+// invalidateAttempt moves the Finished or AbnormalFinished Attempt a back to
+// NeedsExecution (see resetAttempt). It returns the mutations which re-execute
+// a and notify its dependents.
+func invalidateAttempt(c context.Context, a *model.Attempt, reason string) ([]tumble.Mutation, error) {
+	logging.Infof(c, "invalidating %s attempt %v: %s", a.State, a.ID, reason)
+	if err := resetAttempt(c, a); err != nil {
+		return nil, err
+	}
+	return []tumble.Mutation{
+		&ScheduleExecution{For: &a.ID},
+		&InvalidateBackDeps{For: &a.ID},
+	}, nil
+}
+
+// resetAttempt deletes the AttemptResult of the Finished or AbnormalFinished
+// Attempt a, moves it back to NeedsExecution and Puts it. The caller is
+// responsible for scheduling its execution.
+func resetAttempt(c context.Context, a *model.Attempt) error {
+	ds := datastore.Get(c)
+	if a.State == dm.Attempt_FINISHED {
+		if err := ds.Delete(ds.KeyForObj(&model.AttemptResult{Attempt: ds.KeyForObj(a)})); err != nil {
+			return err
+		}
+	}
+
+	a.MustModifyState(c, dm.Attempt_NEEDS_EXECUTION)
+	a.ResultExpiration = time.Time{}
+	a.ResultSize = 0
+	a.Expired = false
+	a.AbnormalFinishReason = ""
+	a.FailedExecutions = 0
+	return ds.Put(a)
+}
+
+func init() {
+	tumble.Register((*InvalidateAttempt)(nil))
+}
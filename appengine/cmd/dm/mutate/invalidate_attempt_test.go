@@ -0,0 +1,66 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestInvalidateAttempt(t *testing.T) {
+	t.Parallel()
+
+	Convey("InvalidateAttempt", t, func() {
+		c := memory.Use(context.Background())
+		ia := &InvalidateAttempt{ID: dm.NewAttemptID("quest", 1), Reason: "bad result"}
+
+		Convey("Root", func() {
+			So(ia.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			ds := datastore.Get(c)
+			a := &model.Attempt{
+				ID:           *ia.ID,
+				State:        dm.Attempt_FINISHED,
+				CurExecution: 2,
+				ResultSize:   10,
+			}
+			ar := &model.AttemptResult{Attempt: ds.KeyForObj(a), Data: `{"bad": 1}`, Size: 10}
+			So(ds.Put(a, ar), ShouldBeNil)
+
+			Convey("invalidates the result", func() {
+				muts, err := ia.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&ScheduleExecution{For: &a.ID},
+					&InvalidateBackDeps{For: &a.ID},
+				})
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(a.ResultSize, ShouldEqual, 0)
+				So(a.CurExecution, ShouldEqual, 2)
+				So(ds.Get(ar), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("can't invalidate unfinished attempts", func() {
+				a.State = dm.Attempt_EXECUTING
+				So(ds.Put(a), ShouldBeNil)
+
+				_, err := ia.RollForward(c)
+				So(err, ShouldBeRPCFailedPrecondition, "not finished")
+			})
+		})
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"golang.org/x/net/context"
+)
+
+// InvalidateBackDeps is the inverse of RecordCompletion. It marks the
+// BackDepGroup of an invalidated Attempt as no longer finished, and fires off
+// an InvalidateFwdDep mutation for each dependency which RecordCompletion (or
+// AddBackDep) told about the Attempt being finished.
+//
+// Like RecordCompletion, it processes completionLimit edges at a time, and
+// tail-calls itself until it runs out of edges.
+type InvalidateBackDeps struct {
+	For *dm.Attempt_ID `datastore:",noindex"`
+}
+
+// Root implements tumble.Mutation.
+func (i *InvalidateBackDeps) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.BackDepGroup{Dependee: *i.For})
+}
+
+// RollForward implements tumble.Mutation.
+func (i *InvalidateBackDeps) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+
+	bdg := &model.BackDepGroup{Dependee: *i.For}
+	if err = ds.Get(bdg); err != nil && err != datastore.ErrNoSuchEntity {
+		return
+	}
+
+	propagated := make([]*model.BackDep, 0, completionLimit)
+
+	q := (datastore.NewQuery("BackDep").
+		Ancestor(ds.KeyForObj(bdg)).
+		Eq("Propagated", true).
+		Limit(completionLimit))
+
+	if err = ds.GetAll(q, &propagated); err != nil {
+		return
+	}
+
+	if len(propagated) > 0 {
+		muts = make([]tumble.Mutation, len(propagated))
+
+		for i, bdep := range propagated {
+			bdep.Propagated = false
+			muts[i] = &InvalidateFwdDep{Dep: bdep.Edge()}
+		}
+
+		if len(propagated) == completionLimit {
+			// Append ourself if there might be more to do!
+			muts = append(muts, i)
+		}
+
+		if err = ds.Put(propagated); err != nil {
+			return
+		}
+	}
+
+	if bdg.AttemptFinished {
+		bdg.AttemptFinished = false
+		if err = ds.Put(bdg); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func init() {
+	tumble.Register((*InvalidateBackDeps)(nil))
+}
@@ -0,0 +1,63 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestInvalidateBackDeps(t *testing.T) {
+	t.Parallel()
+
+	Convey("InvalidateBackDeps", t, func() {
+		c := memory.Use(context.Background())
+		ib := &InvalidateBackDeps{dm.NewAttemptID("quest", 1)}
+
+		bdg := &model.BackDepGroup{Dependee: *ib.For, AttemptFinished: true}
+
+		ds := datastore.Get(c)
+
+		Convey("Root", func() {
+			So(ib.Root(c).String(), ShouldEqual, `dev~app::/BackDepGroup,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			propagated := &model.BackDep{
+				Depender:      *dm.NewAttemptID("from", 1),
+				DependeeGroup: ib.Root(c),
+				Propagated:    true,
+			}
+			pending := &model.BackDep{
+				Depender:      *dm.NewAttemptID("from", 2),
+				DependeeGroup: ib.Root(c),
+			}
+			So(ds.Put(bdg, propagated, pending), ShouldBeNil)
+
+			muts, err := ib.RollForward(c)
+			So(err, ShouldBeNil)
+			So(muts, ShouldResemble, []tumble.Mutation{
+				&InvalidateFwdDep{Dep: propagated.Edge()},
+			})
+
+			So(ds.Get(bdg, propagated), ShouldBeNil)
+			So(bdg.AttemptFinished, ShouldBeFalse)
+			So(propagated.Propagated, ShouldBeFalse)
+
+			Convey("and is idempotent", func() {
+				muts, err := ib.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// InvalidateFwdDep tells an Attempt (A) that an Attempt it depends on (B) was
+// invalidated. It's the inverse of the AckFwdDep which told A that B was
+// finished.
+//
+// If A is still waiting on the set of dependencies which includes B, A is
+// simply blocked on B again. Otherwise A has already consumed B's result, so
+// the dependency is removed (so that A will add it again the next time it
+// needs B), and A's own progress is undone:
+//   * If A is Executing, the Execution is cancelled and A is executed again.
+//   * If A is Finished, A is invalidated as well.
+type InvalidateFwdDep struct {
+	Dep *model.FwdEdge
+}
+
+// Root implements tumble.Mutation.
+func (f *InvalidateFwdDep) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).MakeKey("Attempt", f.Dep.From.DMEncoded())
+}
+
+// RollForward implements tumble.Mutation.
+func (f *InvalidateFwdDep) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+
+	atmpt, fdep := f.Dep.Fwd(c)
+	if err = ds.Get(fdep); err != nil {
+		if err == datastore.ErrNoSuchEntity {
+			// The dependency was already removed.
+			err = nil
+		}
+		return
+	}
+	if err = ds.Get(atmpt); err != nil {
+		return
+	}
+
+	idx := uint32(fdep.BitIndex)
+	if atmpt.CurExecution == fdep.ForExecution {
+		switch atmpt.State {
+		case dm.Attempt_ADDING_DEPS, dm.Attempt_BLOCKED, dm.Attempt_NEEDS_EXECUTION:
+			atmpt.WaitingDepBitmap.Clear(idx)
+			if atmpt.State == dm.Attempt_NEEDS_EXECUTION {
+				atmpt.MustModifyState(c, dm.Attempt_BLOCKED)
+			}
+			err = ds.Put(atmpt)
+			return
+		}
+	}
+
+	if err = ds.Delete(ds.KeyForObj(fdep)); err != nil {
+		return
+	}
+	muts = append(muts, &RemoveBackDep{Dep: f.Dep})
+
+	switch atmpt.State {
+	case dm.Attempt_EXECUTING:
+		e := &model.Execution{ID: atmpt.CurExecution, Attempt: ds.KeyForObj(atmpt)}
+		if err = ds.Get(e); err != nil {
+			return
+		}
+		eid := dm.NewExecutionID(atmpt.ID.Quest, atmpt.ID.Id, e.ID)
+		if mut := cancelExecution(c, eid, e, "dependency was invalidated"); mut != nil {
+			muts = append(muts, mut)
+		}
+		atmpt.MustModifyState(c, dm.Attempt_NEEDS_EXECUTION)
+		muts = append(muts, &ScheduleExecution{For: &atmpt.ID})
+		err = ds.Put(atmpt, e)
+
+	case dm.Attempt_FINISHED:
+		var invMuts []tumble.Mutation
+		if invMuts, err = invalidateAttempt(c, atmpt, "dependency was invalidated"); err != nil {
+			return
+		}
+		muts = append(muts, invMuts...)
+
+	default:
+		logging.Infof(c, "attempt %v is %s, nothing to undo", atmpt.ID, atmpt.State)
+	}
+	return
+}
+
+func init() {
+	tumble.Register((*InvalidateFwdDep)(nil))
+}
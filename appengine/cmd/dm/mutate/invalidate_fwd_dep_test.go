@@ -0,0 +1,111 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/bit_field"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestInvalidateFwdDep(t *testing.T) {
+	t.Parallel()
+
+	Convey("InvalidateFwdDep", t, func() {
+		c := memory.Use(context.Background())
+		ds := datastore.Get(c)
+
+		ifd := &InvalidateFwdDep{
+			Dep: &model.FwdEdge{
+				From: dm.NewAttemptID("quest", 1),
+				To:   dm.NewAttemptID("to", 1),
+			},
+		}
+
+		Convey("Root", func() {
+			So(ifd.Root(c), ShouldResemble, ds.MakeKey("Attempt", "quest|fffffffe"))
+		})
+
+		Convey("RollForward", func() {
+			a, fwd := ifd.Dep.Fwd(c)
+			fwd.BitIndex = 1
+			fwd.ForExecution = 1
+			a.CurExecution = 1
+			a.WaitingDepBitmap = bf.Make(2)
+			a.WaitingDepBitmap.Set(0)
+			a.WaitingDepBitmap.Set(1)
+
+			Convey("re-blocks an attempt which didn't run yet", func() {
+				a.State = dm.Attempt_NEEDS_EXECUTION
+				So(ds.Put(a, fwd), ShouldBeNil)
+
+				muts, err := ifd.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(a, fwd), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_BLOCKED)
+				So(a.WaitingDepBitmap.IsSet(0), ShouldBeTrue)
+				So(a.WaitingDepBitmap.IsSet(1), ShouldBeFalse)
+			})
+
+			Convey("re-executes an executing attempt", func() {
+				a.State = dm.Attempt_EXECUTING
+				a.CurExecution = 2
+				e := &model.Execution{
+					ID: 2, Attempt: ds.KeyForObj(a), State: dm.Execution_RUNNING,
+					Token: []byte("exekey"), DistributorToken: "tok"}
+				So(ds.Put(a, e, fwd), ShouldBeNil)
+
+				muts, err := ifd.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&RemoveBackDep{Dep: ifd.Dep},
+					&CancelTask{For: dm.NewExecutionID(a.ID.Quest, a.ID.Id, 2), DistributorToken: "tok"},
+					&ScheduleExecution{For: &a.ID},
+				})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(e.State, ShouldEqual, dm.Execution_CANCELLED)
+				So(e.Token, ShouldBeNil)
+				So(ds.Get(fwd), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("invalidates a finished attempt", func() {
+				a.State = dm.Attempt_FINISHED
+				a.CurExecution = 2
+				So(ds.Put(a, fwd), ShouldBeNil)
+
+				muts, err := ifd.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&RemoveBackDep{Dep: ifd.Dep},
+					&ScheduleExecution{For: &a.ID},
+					&InvalidateBackDeps{For: &a.ID},
+				})
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+			})
+
+			Convey("does nothing if the dependency is gone", func() {
+				a.State = dm.Attempt_FINISHED
+				So(ds.Put(a), ShouldBeNil)
+
+				muts, err := ifd.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// RedirectFwdDep moves the dependency of an Attempt (A) on an Attempt whose
+// result expired (B) to another Attempt of B's Quest (C), so that A waits for
+// C's result instead. C is created if it doesn't exist yet.
+//
+// It's emitted by ReexecuteExpiredAttempt for Quests with the NEW_ATTEMPT
+// ExpiredResultPolicy. If A isn't waiting for B anymore, the dependency is left
+// alone.
+type RedirectFwdDep struct {
+	Dep *model.FwdEdge
+	To  *dm.Attempt_ID
+}
+
+// Root implements tumble.Mutation.
+func (r *RedirectFwdDep) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).MakeKey("Attempt", r.Dep.From.DMEncoded())
+}
+
+// RollForward implements tumble.Mutation.
+func (r *RedirectFwdDep) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+
+	atmpt, fdep := r.Dep.Fwd(c)
+	if err = ds.Get(fdep); err != nil {
+		if err == datastore.ErrNoSuchEntity {
+			// The dependency was already redirected or removed.
+			err = nil
+		}
+		return
+	}
+	if err = ds.Get(atmpt); err != nil {
+		return
+	}
+
+	if atmpt.CurExecution != fdep.ForExecution ||
+		(atmpt.State != dm.Attempt_ADDING_DEPS && atmpt.State != dm.Attempt_BLOCKED) {
+		logging.Infof(c, "attempt %v is %s, not redirecting its dependency on %v",
+			atmpt.ID, atmpt.State, r.Dep.To)
+		return
+	}
+
+	to := &model.FwdEdge{From: r.Dep.From, To: r.To}
+	_, toDep := to.Fwd(c)
+	switch err = ds.Get(toDep); err {
+	case nil:
+		// A already depends on C through another FwdDep, which stands in for
+		// this one.
+		var changed bool
+		if muts, changed = ackDepBit(c, atmpt, fdep.BitIndex, true); changed {
+			if err = ds.Put(atmpt); err != nil {
+				return
+			}
+		}
+
+	case datastore.ErrNoSuchEntity:
+		toDep.BitIndex = fdep.BitIndex
+		toDep.ForExecution = fdep.ForExecution
+		if err = ds.Put(toDep); err != nil {
+			return
+		}
+		muts = append(muts,
+			&EnsureAttempt{ID: r.To},
+			&AddBackDep{Dep: to, NeedsAck: true})
+
+	default:
+		return
+	}
+
+	if err = ds.Delete(ds.KeyForObj(fdep)); err != nil {
+		return
+	}
+	muts = append(muts, &RemoveBackDep{Dep: r.Dep})
+	return
+}
+
+func init() {
+	tumble.Register((*RedirectFwdDep)(nil))
+}
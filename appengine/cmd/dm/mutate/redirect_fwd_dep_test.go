@@ -0,0 +1,127 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/bit_field"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestRedirectFwdDep(t *testing.T) {
+	t.Parallel()
+
+	Convey("RedirectFwdDep", t, func() {
+		c := memory.Use(context.Background())
+		ds := datastore.Get(c)
+
+		r := &RedirectFwdDep{
+			Dep: &model.FwdEdge{
+				From: dm.NewAttemptID("quest", 1),
+				To:   dm.NewAttemptID("to", 1),
+			},
+			To: dm.NewAttemptID("to", 2),
+		}
+
+		Convey("Root", func() {
+			So(r.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			a, fwd := r.Dep.Fwd(c)
+			a.State = dm.Attempt_BLOCKED
+			a.CurExecution = 1
+			a.AddingDepsBitmap = bf.Make(2)
+			a.AddingDepsBitmap.Set(0)
+			a.AddingDepsBitmap.Set(1)
+			a.WaitingDepBitmap = bf.Make(2)
+			fwd.BitIndex = 1
+			fwd.ForExecution = 1
+			So(ds.Put(a, fwd), ShouldBeNil)
+
+			newEdge := &model.FwdEdge{From: r.Dep.From, To: r.To}
+			_, newFwd := newEdge.Fwd(c)
+
+			Convey("moves the dependency to the new attempt", func() {
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&EnsureAttempt{ID: r.To},
+					&AddBackDep{Dep: newEdge, NeedsAck: true},
+					&RemoveBackDep{Dep: r.Dep},
+				})
+
+				So(ds.Get(fwd), ShouldEqual, datastore.ErrNoSuchEntity)
+				So(ds.Get(newFwd), ShouldBeNil)
+				So(newFwd.BitIndex, ShouldEqual, 1)
+				So(newFwd.ForExecution, ShouldEqual, 1)
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_BLOCKED)
+				So(a.WaitingDepBitmap.CountSet(), ShouldEqual, 0)
+			})
+
+			Convey("drops the dependency if the attempt already depends on the new attempt", func() {
+				newFwd.BitIndex = 0
+				newFwd.ForExecution = 1
+				So(ds.Put(newFwd), ShouldBeNil)
+
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RemoveBackDep{Dep: r.Dep}})
+
+				So(ds.Get(fwd), ShouldEqual, datastore.ErrNoSuchEntity)
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_BLOCKED)
+				So(a.WaitingDepBitmap.IsSet(1), ShouldBeTrue)
+
+				Convey("and unblocks it if that was the last dependency", func() {
+					So(ds.Put(fwd), ShouldBeNil)
+					a.WaitingDepBitmap = bf.Make(2)
+					a.WaitingDepBitmap.Set(0)
+					So(ds.Put(a), ShouldBeNil)
+
+					muts, err := r.RollForward(c)
+					So(err, ShouldBeNil)
+					So(muts, ShouldResemble, []tumble.Mutation{
+						&ScheduleExecution{For: &a.ID},
+						&RemoveBackDep{Dep: r.Dep},
+					})
+
+					So(ds.Get(a), ShouldBeNil)
+					So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				})
+			})
+
+			Convey("leaves the dependency alone if the attempt moved on", func() {
+				a.State = dm.Attempt_EXECUTING
+				a.CurExecution = 2
+				So(ds.Put(a), ShouldBeNil)
+
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(fwd), ShouldBeNil)
+				So(ds.Get(newFwd), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("ignores dependencies which were already redirected", func() {
+				So(ds.Delete(ds.KeyForObj(fwd)), ShouldBeNil)
+
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// ReexecuteExpiredAttempt is emitted by AddBackDep when a dependency is added
+// on an Attempt whose result has expired. What happens next depends on the
+// ExpiredResultPolicy of the Attempt's Quest:
+//   * REEXECUTE moves the Attempt back to NeedsExecution, so that a fresh
+//     result is computed for the new dependant.
+//   * NEW_ATTEMPT leaves the Attempt alone, and redirects the new dependant to
+//     the Quest's next Attempt (see RedirectFwdDep), which computes a fresh
+//     result.
+// In neither case is the expired result propagated to the new dependant.
+//
+// Unlike InvalidateAttempt, this doesn't affect the Attempts which already
+// consumed the old result: they were correct at the time. On re-execution, only
+// the Attempt's BackDepGroup is reopened (see ReopenBackDepGroup), so that new
+// dependants wait for the Attempt to finish again.
+type ReexecuteExpiredAttempt struct {
+	ID *dm.Attempt_ID
+
+	// Dep is the new dependency on the Attempt.
+	Dep *model.FwdEdge
+}
+
+// Root implements tumble.Mutation
+func (r *ReexecuteExpiredAttempt) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *r.ID})
+}
+
+// RollForward implements tumble.Mutation
+func (r *ReexecuteExpiredAttempt) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	a := &model.Attempt{ID: *r.ID}
+	if err = datastore.Get(c).Get(a); err != nil {
+		return
+	}
+
+	// Quests are immutable, and live in their own entity group.
+	q := &model.Quest{ID: r.ID.Quest}
+	if err = datastore.GetNoTxn(c).Get(q); err != nil {
+		return
+	}
+	expired := a.ResultExpired(clock.Now(c))
+
+	switch {
+	case expired && q.Desc.ExpiredResultPolicy == dm.Quest_Desc_NEW_ATTEMPT:
+		next := dm.NewAttemptID(a.ID.Quest, a.ID.Id+1)
+		logging.Infof(c, "redirecting %v to attempt %v, the result of %v expired at %s",
+			r.Dep.From, next, a.ID, a.ResultExpiration)
+		muts = []tumble.Mutation{&RedirectFwdDep{Dep: r.Dep, To: next}}
+
+	case expired:
+		logging.Infof(c, "re-executing attempt %v, its result expired at %s", a.ID, a.ResultExpiration)
+		if err = resetAttempt(c, a); err != nil {
+			return
+		}
+		muts = []tumble.Mutation{
+			&ScheduleExecution{For: &a.ID},
+			&ReopenBackDepGroup{For: &a.ID},
+		}
+
+	case a.State == dm.Attempt_FINISHED || a.State == dm.Attempt_ABNORMAL_FINISHED:
+		// Someone else already got a fresh result, or AddBackDep was wrong about
+		// the expiration. Make sure that the new BackDep is propagated.
+		muts = []tumble.Mutation{&RecordCompletion{For: &a.ID}}
+
+	default:
+		// The Attempt is already on its way to finishing again, at which point
+		// RecordCompletion will propagate the new BackDep.
+	}
+	return
+}
+
+func init() {
+	tumble.Register((*ReexecuteExpiredAttempt)(nil))
+}
@@ -0,0 +1,105 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestReexecuteExpiredAttempt(t *testing.T) {
+	t.Parallel()
+
+	Convey("ReexecuteExpiredAttempt", t, func() {
+		c := memory.Use(context.Background())
+		c, clk := testclock.UseTime(c, testclock.TestTimeUTC)
+		ds := datastore.Get(c)
+
+		r := &ReexecuteExpiredAttempt{
+			ID: dm.NewAttemptID("quest", 1),
+			Dep: &model.FwdEdge{
+				From: dm.NewAttemptID("dependant", 1),
+				To:   dm.NewAttemptID("quest", 1),
+			},
+		}
+
+		Convey("Root", func() {
+			So(r.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			qst := &model.Quest{ID: "quest", Desc: *dm.NewQuestDesc("fake", `{}`)}
+			So(ds.Put(qst), ShouldBeNil)
+			a := &model.Attempt{
+				ID:               *r.ID,
+				State:            dm.Attempt_FINISHED,
+				CurExecution:     1,
+				ResultExpiration: clk.Now().Add(time.Hour),
+				ResultSize:       10,
+			}
+			ar := &model.AttemptResult{Attempt: ds.KeyForObj(a), Data: `{"old": true}`, Size: 10}
+			So(ds.Put(a, ar), ShouldBeNil)
+
+			Convey("re-executes expired attempts", func() {
+				clk.Add(time.Hour)
+
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&ScheduleExecution{For: r.ID},
+					&ReopenBackDepGroup{For: r.ID},
+				})
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(a.ResultExpiration.IsZero(), ShouldBeTrue)
+				So(a.ResultSize, ShouldEqual, 0)
+				So(ds.Get(ar), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("redirects the dependant to a new attempt with the NEW_ATTEMPT policy", func() {
+				qst.Desc.ExpiredResultPolicy = dm.Quest_Desc_NEW_ATTEMPT
+				So(ds.Put(qst), ShouldBeNil)
+				clk.Add(time.Hour)
+
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&RedirectFwdDep{Dep: r.Dep, To: dm.NewAttemptID("quest", 2)},
+				})
+
+				So(ds.Get(a, ar), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_FINISHED)
+			})
+
+			Convey("propagates a fresh result", func() {
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RecordCompletion{For: r.ID}})
+
+				So(ds.Get(a, ar), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_FINISHED)
+			})
+
+			Convey("waits for an unfinished attempt", func() {
+				a.State = dm.Attempt_EXECUTING
+				So(ds.Put(a), ShouldBeNil)
+
+				muts, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+			})
+		})
+	})
+}
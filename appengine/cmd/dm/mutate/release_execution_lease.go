@@ -0,0 +1,52 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/grpcutil"
+	"golang.org/x/net/context"
+)
+
+// ReleaseExecutionLease gives up the lease on a claimed Execution. The
+// Execution is CANCELLED, and its Attempt is retried (see retryAttempt).
+type ReleaseExecutionLease struct {
+	Auth *dm.Execution_Auth
+}
+
+// Root implements tumble.Mutation
+func (r *ReleaseExecutionLease) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *r.Auth.Id.AttemptID()})
+}
+
+// RollForward implements tumble.Mutation
+//
+// This mutation is called directly from ReleaseExecutionLease, so we use
+// grpcutil.MaybeLogErr
+func (r *ReleaseExecutionLease) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	a, e, err := model.AuthenticateLeaseHolder(c, r.Auth)
+	if err != nil {
+		return
+	}
+
+	e.State.MustEvolve(dm.Execution_CANCELLED)
+	e.StateReason = "lease released by executor"
+	e.Token = nil
+	if muts, err = retryAttempt(c, a, e); err != nil {
+		err = grpcutil.MaybeLogErr(c, err, codes.Internal, "while retrying attempt")
+		return
+	}
+	err = grpcutil.MaybeLogErr(c, datastore.Get(c).Put(a, e), codes.Internal, "while trying to PutMulti")
+	return
+}
+
+func init() {
+	tumble.Register((*ReleaseExecutionLease)(nil))
+}
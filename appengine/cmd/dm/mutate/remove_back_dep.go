@@ -0,0 +1,53 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"golang.org/x/net/context"
+)
+
+// RemoveBackDep removes the BackDep for a FwdDep which was removed by
+// InvalidateFwdDep or RedirectFwdDep. If the FwdDep was added again in the meantime, the BackDep
+// is left alone.
+type RemoveBackDep struct {
+	Dep *model.FwdEdge
+}
+
+// Root implements tumble.Mutation.
+func (r *RemoveBackDep) Root(c context.Context) *datastore.Key {
+	bdg, _ := r.Dep.Back(c)
+	return datastore.Get(c).KeyForObj(bdg)
+}
+
+// RollForward implements tumble.Mutation.
+func (r *RemoveBackDep) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+
+	_, bd := r.Dep.Back(c)
+	if err = ds.Get(bd); err != nil {
+		if err == datastore.ErrNoSuchEntity {
+			err = nil
+		}
+		return
+	}
+
+	// The FwdDep lives in a different entity group.
+	_, fdep := r.Dep.Fwd(c)
+	dsNoTxn := datastore.GetNoTxn(c)
+	exists, err := dsNoTxn.Exists(dsNoTxn.KeyForObj(fdep))
+	if err != nil || exists.Any() {
+		return
+	}
+
+	err = ds.Delete(ds.KeyForObj(bd))
+	return
+}
+
+func init() {
+	tumble.Register((*RemoveBackDep)(nil))
+}
@@ -0,0 +1,56 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestRemoveBackDep(t *testing.T) {
+	t.Parallel()
+
+	Convey("RemoveBackDep", t, func() {
+		c := memory.Use(context.Background())
+		ds := datastore.Get(c)
+
+		rbd := &RemoveBackDep{
+			Dep: &model.FwdEdge{
+				From: dm.NewAttemptID("quest", 1),
+				To:   dm.NewAttemptID("to", 1),
+			},
+		}
+
+		Convey("Root", func() {
+			So(rbd.Root(c).String(), ShouldEqual, `dev~app::/BackDepGroup,"to|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			_, fwd := rbd.Dep.Fwd(c)
+			_, bd := rbd.Dep.Back(c)
+			So(ds.Put(bd), ShouldBeNil)
+
+			Convey("removes the BackDep", func() {
+				_, err := rbd.RollForward(c)
+				So(err, ShouldBeNil)
+				So(ds.Get(bd), ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("keeps the BackDep if the FwdDep was added again", func() {
+				So(ds.Put(fwd), ShouldBeNil)
+
+				_, err := rbd.RollForward(c)
+				So(err, ShouldBeNil)
+				So(ds.Get(bd), ShouldBeNil)
+			})
+		})
+	})
+}
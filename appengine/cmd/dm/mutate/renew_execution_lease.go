@@ -0,0 +1,52 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/grpcutil"
+	"golang.org/x/net/context"
+)
+
+// RenewExecutionLease extends the lease on a claimed Execution to
+// LeaseExpiration, and schedules a new TimeoutExecutionLease for it.
+type RenewExecutionLease struct {
+	Auth            *dm.Execution_Auth
+	LeaseExpiration time.Time
+}
+
+// Root implements tumble.Mutation
+func (r *RenewExecutionLease) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *r.Auth.Id.AttemptID()})
+}
+
+// RollForward implements tumble.Mutation
+//
+// This mutation is called directly from RenewExecutionLease, so we use
+// grpcutil.MaybeLogErr
+func (r *RenewExecutionLease) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	_, e, err := model.AuthenticateLeaseHolder(c, r.Auth)
+	if err != nil {
+		return
+	}
+
+	e.LeaseExpiration = r.LeaseExpiration
+	err = grpcutil.MaybeLogErr(c, datastore.Get(c).Put(e), codes.Internal, "while trying to Put")
+	if err == nil {
+		muts = []tumble.Mutation{&TimeoutExecutionLease{EID: r.Auth.Id, Expiration: r.LeaseExpiration}}
+	}
+	return
+}
+
+func init() {
+	tumble.Register((*RenewExecutionLease)(nil))
+}
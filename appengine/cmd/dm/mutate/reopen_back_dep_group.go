@@ -0,0 +1,58 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"golang.org/x/net/context"
+)
+
+// ReopenBackDepGroup clears AttemptFinished on the BackDepGroup of an Attempt
+// which is being re-executed, so that new BackDeps wait for the Attempt to
+// finish again. Existing BackDeps are left alone.
+type ReopenBackDepGroup struct {
+	For *dm.Attempt_ID
+}
+
+// Root implements tumble.Mutation
+func (r *ReopenBackDepGroup) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.BackDepGroup{Dependee: *r.For})
+}
+
+// RollForward implements tumble.Mutation
+func (r *ReopenBackDepGroup) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+	bdg := &model.BackDepGroup{Dependee: *r.For}
+	if err = ds.Get(bdg); err != nil {
+		if err == datastore.ErrNoSuchEntity {
+			err = nil
+		}
+		return
+	}
+	if !bdg.AttemptFinished {
+		return
+	}
+
+	// The Attempt lives in a different entity group. If it has already finished
+	// again, RecordCompletion has already (or will) set AttemptFinished.
+	a := &model.Attempt{ID: *r.For}
+	if err = datastore.GetNoTxn(c).Get(a); err != nil {
+		return
+	}
+	if a.State == dm.Attempt_FINISHED || a.State == dm.Attempt_ABNORMAL_FINISHED {
+		return
+	}
+
+	bdg.AttemptFinished = false
+	err = ds.Put(bdg)
+	return
+}
+
+func init() {
+	tumble.Register((*ReopenBackDepGroup)(nil))
+}
@@ -0,0 +1,62 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestReopenBackDepGroup(t *testing.T) {
+	t.Parallel()
+
+	Convey("ReopenBackDepGroup", t, func() {
+		c := memory.Use(context.Background())
+		ds := datastore.Get(c)
+
+		r := &ReopenBackDepGroup{dm.NewAttemptID("quest", 1)}
+
+		Convey("Root", func() {
+			So(r.Root(c).String(), ShouldEqual, `dev~app::/BackDepGroup,"quest|fffffffe"`)
+		})
+
+		Convey("RollForward", func() {
+			bdg := &model.BackDepGroup{Dependee: *r.For, AttemptFinished: true}
+			bd := &model.BackDep{
+				Depender:      *dm.NewAttemptID("from", 1),
+				DependeeGroup: ds.KeyForObj(bdg),
+				Propagated:    true,
+			}
+			a := &model.Attempt{ID: *r.For, State: dm.Attempt_NEEDS_EXECUTION}
+			So(ds.Put(bdg, bd, a), ShouldBeNil)
+
+			Convey("reopens the group", func() {
+				_, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+
+				So(ds.Get(bdg, bd), ShouldBeNil)
+				So(bdg.AttemptFinished, ShouldBeFalse)
+				So(bd.Propagated, ShouldBeTrue)
+			})
+
+			Convey("leaves it alone if the attempt finished again", func() {
+				a.State = dm.Attempt_FINISHED
+				So(ds.Put(a), ShouldBeNil)
+
+				_, err := r.RollForward(c)
+				So(err, ShouldBeNil)
+
+				So(ds.Get(bdg), ShouldBeNil)
+				So(bdg.AttemptFinished, ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// RunExecution hands the task of a SCHEDULING Execution to the distributor of
+// its Quest, and moves the Execution to SCHEDULED.
+//
+// It does nothing if the Execution is no longer SCHEDULING, so it's safe to
+// retry. If the distributor rejects the task, the Execution is REJECTED and
+// its Attempt is retried (see retryAttempt).
+type RunExecution struct {
+	For *dm.Execution_ID
+
+	// After is the earliest time to run the task at. It's used to back off
+	// between Executions of an Attempt whose previous Executions failed.
+	After time.Time
+}
+
+var _ tumble.DelayedMutation = (*RunExecution)(nil)
+
+// Root implements tumble.Mutation
+func (r *RunExecution) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *r.For.AttemptID()})
+}
+
+// ProcessAfter implements tumble.DelayedMutation
+func (r *RunExecution) ProcessAfter() time.Time {
+	return r.After
+}
+
+// HighPriority implements tumble.DelayedMutation
+func (r *RunExecution) HighPriority() bool {
+	return false
+}
+
+// RollForward implements tumble.Mutation
+func (r *RunExecution) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	reg := distributor.GetRegistry(c)
+	if reg == nil {
+		err = fmt.Errorf("no distributor registry, can't run %v", r.For)
+		return
+	}
+
+	ds := datastore.Get(c)
+	a := &model.Attempt{ID: *r.For.AttemptID()}
+	e := &model.Execution{ID: r.For.Id, Attempt: ds.KeyForObj(a)}
+	if err = ds.Get(a, e); err != nil {
+		return
+	}
+	if e.State != dm.Execution_SCHEDULING {
+		logging.Infof(c, "execution %v is %s, not running it", r.For, e.State)
+		return
+	}
+
+	// Quests are immutable, and live in their own entity group.
+	q := &model.Quest{ID: r.For.Quest}
+	if err = datastore.GetNoTxn(c).Get(q); err != nil {
+		return
+	}
+
+	switch err = runTask(c, reg, q, r.For, e); {
+	case err == nil:
+		e.State.MustEvolve(dm.Execution_SCHEDULED)
+
+	case errors.IsTransient(err):
+		return
+
+	default:
+		logging.Fields{logging.ErrorKey: err, "eid": r.For}.Errorf(c, "distributor rejected the execution")
+		e.State.MustEvolve(dm.Execution_REJECTED)
+		e.StateReason = err.Error()
+		e.Token = nil
+		err = nil
+
+		if a.CurExecution == e.ID && a.State == dm.Attempt_EXECUTING {
+			if muts, err = retryAttempt(c, a, e); err != nil {
+				return
+			}
+		}
+	}
+
+	err = ds.Put(a, e)
+	return
+}
+
+// runTask starts the task for Execution e with its Quest's distributor, and
+// fills in e's distributor information.
+func runTask(c context.Context, reg distributor.Registry, q *model.Quest, eid *dm.Execution_ID, e *model.Execution) error {
+	d, _, err := reg.MakeDistributor(c, q.Desc.DistributorConfigName)
+	if err != nil {
+		return err
+	}
+
+	pubsubAuth, err := distributor.NewPubsubAuth(c, eid)
+	if err != nil {
+		return errors.WrapTransient(fmt.Errorf("generating pubsub auth token: %s", err))
+	}
+
+	tok, err := d.Run(&distributor.TaskDescription{
+		Payload:       &q.Desc,
+		ExecutionAuth: &dm.Execution_Auth{Id: eid, Token: e.Token},
+		PubsubTopic:   distributor.NotifyTopic(c),
+		PubsubAuth:    pubsubAuth,
+	})
+	if err != nil {
+		return err
+	}
+	e.DistributorToken = string(tok)
+	e.DistributorURL = d.InfoURL(tok)
+	return nil
+}
+
+func init() {
+	tumble.Register((*RunExecution)(nil))
+}
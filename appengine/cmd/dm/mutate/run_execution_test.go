@@ -0,0 +1,169 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor/fake"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/server/secrets/testsecrets"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestRunExecution(t *testing.T) {
+	t.Parallel()
+
+	Convey("RunExecution", t, func() {
+		c := memory.Use(context.Background())
+		after := time.Date(2016, time.February, 3, 4, 5, 6, 0, time.UTC)
+		re := &RunExecution{For: dm.NewExecutionID("quest", 1, 1), After: after}
+
+		Convey("Root", func() {
+			So(re.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("ProcessAfter", func() {
+			So(re.ProcessAfter(), ShouldResemble, after)
+			So(re.HighPriority(), ShouldBeFalse)
+		})
+
+		Convey("RollForward", func() {
+			ds := datastore.Get(c)
+			c = testsecrets.Use(c)
+
+			qst := &model.Quest{ID: "quest", Desc: *dm.NewQuestDesc("fake", `{"data":"yes"}`)}
+			a := &model.Attempt{
+				ID:           *re.For.AttemptID(),
+				State:        dm.Attempt_EXECUTING,
+				CurExecution: 1,
+			}
+			e := &model.Execution{
+				ID:      1,
+				Attempt: ds.KeyForObj(a),
+				State:   dm.Execution_SCHEDULING,
+				Token:   []byte("exekey"),
+			}
+			So(ds.Put(qst, a, e), ShouldBeNil)
+
+			dist := &fake.Distributor{}
+			reg := distributor.NewTestingRegistry(distributor.TestFactoryMap{
+				"fake": dist.Factory(),
+			}, FinishExecutionFn)
+
+			Convey("without a registry, fails", func() {
+				_, err := re.RollForward(c)
+				So(err, ShouldNotBeNil)
+
+				So(ds.Get(e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_SCHEDULING)
+			})
+
+			Convey("runs the task on the distributor", func() {
+				c = distributor.WithRegistry(c, reg)
+				muts, err := re.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+				So(e.State, ShouldEqual, dm.Execution_SCHEDULED)
+				So(e.Token, ShouldResemble, []byte("exekey"))
+				So(e.DistributorToken, ShouldEqual, "quest|fffffffe|fffffffe")
+				So(e.DistributorURL, ShouldEqual, "https://fake.example.com/task/quest|fffffffe|fffffffe")
+
+				So(dist.Tokens(), ShouldResemble, []distributor.Token{"quest|fffffffe|fffffffe"})
+				task := dist.Task("quest|fffffffe|fffffffe")
+				So(task.Desc.Payload.JsonPayload, ShouldEqual, `{"data":"yes"}`)
+				So(task.Desc.ExecutionAuth, ShouldResemble, &dm.Execution_Auth{
+					Id:    re.For,
+					Token: []byte("exekey"),
+				})
+				So(task.Desc.PubsubTopic, ShouldEqual, "projects/dev~app/topics/dm-distributor-notify")
+				So(task.Desc.PubsubAuth, ShouldNotEqual, "")
+
+				Convey("and doesn't run it twice", func() {
+					muts, err := re.RollForward(c)
+					So(err, ShouldBeNil)
+					So(muts, ShouldBeNil)
+					So(len(dist.Tokens()), ShouldEqual, 1)
+				})
+			})
+
+			Convey("doesn't run cancelled executions", func() {
+				c = distributor.WithRegistry(c, reg)
+				e.State = dm.Execution_CANCELLED
+				So(ds.Put(e), ShouldBeNil)
+
+				muts, err := re.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+				So(dist.Tokens(), ShouldBeEmpty)
+			})
+
+			Convey("leaves the execution for a retry on transient errors", func() {
+				c = distributor.WithRegistry(c, reg)
+				dist.RunErr = errors.WrapTransient(errors.New("try later"))
+				_, err := re.RollForward(c)
+				So(errors.IsTransient(err), ShouldBeTrue)
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+				So(e.State, ShouldEqual, dm.Execution_SCHEDULING)
+			})
+
+			Convey("retries the attempt if the task is rejected", func() {
+				c = distributor.WithRegistry(c, reg)
+				dist.RunErr = errors.New("nope")
+				muts, err := re.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&ScheduleExecution{For: &a.ID}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(a.FailedExecutions, ShouldEqual, 1)
+				So(e.State, ShouldEqual, dm.Execution_REJECTED)
+				So(e.StateReason, ShouldEqual, "nope")
+				So(e.Token, ShouldBeEmpty)
+			})
+
+			Convey("fails the attempt if its tasks keep getting rejected", func() {
+				c = distributor.WithRegistry(c, reg)
+				a.FailedExecutions = defaultSettings.ExecutionRetryLimit
+				So(ds.Put(a), ShouldBeNil)
+
+				dist.RunErr = errors.New("nope")
+				muts, err := re.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RecordCompletion{For: &a.ID}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(a.AbnormalFinishReason, ShouldContainSubstring, "REJECTED: nope")
+				So(e.State, ShouldEqual, dm.Execution_REJECTED)
+			})
+
+			Convey("rejects unknown distributor configurations", func() {
+				c = distributor.WithRegistry(c, reg)
+				qst.Desc.DistributorConfigName = "unknown"
+				So(ds.Put(qst), ShouldBeNil)
+				_, err := re.RollForward(c)
+				So(err, ShouldBeNil)
+
+				So(ds.Get(e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_REJECTED)
+				So(e.StateReason, ShouldContainSubstring, `unknown distributor configuration "unknown"`)
+			})
+		})
+	})
+}
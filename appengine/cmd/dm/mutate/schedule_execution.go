@@ -5,15 +5,37 @@
 package mutate
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
 	"github.com/luci/luci-go/appengine/cmd/dm/model"
 	"github.com/luci/luci-go/appengine/tumble"
 	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/cryptorand"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
 	"golang.org/x/net/context"
 )
 
-// ScheduleExecution is a placeholder mutation that will be an entry into the
-// Distributor scheduling state-machine.
+const (
+	// minExecutionRetryDelay is how long to wait before running the task of an
+	// Attempt whose previous Execution failed.
+	minExecutionRetryDelay = 10 * time.Second
+
+	// maxExecutionRetryDelay caps the exponential backoff between Executions of
+	// the same Attempt.
+	maxExecutionRetryDelay = 10 * time.Minute
+)
+
+// ScheduleExecution creates a new Execution for an Attempt which
+// NeedsExecution, and moves the Attempt to Executing.
+//
+// The Execution starts out SCHEDULING. Its task is handed to the distributor
+// of the Attempt's Quest by the RunExecution mutation, after the Execution has
+// been committed.
 type ScheduleExecution struct {
 	For *dm.Attempt_ID
 }
@@ -25,9 +47,64 @@ func (s *ScheduleExecution) Root(c context.Context) *datastore.Key {
 
 // RollForward implements tumble.Mutation
 func (s *ScheduleExecution) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	if distributor.GetRegistry(c) == nil {
+		// Without distributors, executors must claim Attempts with
+		// ClaimExecution.
+		logging.Warningf(c, "no distributor registry, not scheduling %v", s.For)
+		return
+	}
+
+	ds := datastore.Get(c)
+	a := &model.Attempt{ID: *s.For}
+	if err = ds.Get(a); err != nil {
+		return
+	}
+	if a.State != dm.Attempt_NEEDS_EXECUTION {
+		logging.Infof(c, "attempt %v is %s, not scheduling", s.For, a.State)
+		return
+	}
+
+	now := clock.Now(c).UTC()
+	a.CurExecution++
+	e := &model.Execution{
+		ID:      a.CurExecution,
+		Attempt: ds.KeyForObj(a),
+		Created: now,
+		State:   dm.Execution_SCHEDULING,
+		Token:   make([]byte, 32),
+	}
+	if _, err = cryptorand.Read(c, e.Token); err != nil {
+		err = errors.WrapTransient(fmt.Errorf("generating execution token: %s", err))
+		return
+	}
+	a.MustModifyState(c, dm.Attempt_EXECUTING)
+
+	if err = ds.Put(a, e); err != nil {
+		return
+	}
+	muts = []tumble.Mutation{&RunExecution{
+		For:   dm.NewExecutionID(a.ID.Quest, a.ID.Id, a.CurExecution),
+		After: now.Add(executionRetryDelay(a.FailedExecutions)),
+	}}
 	return
 }
 
+// executionRetryDelay returns how long to wait before running the task of an
+// Attempt which already had 'failed' failed Executions.
+func executionRetryDelay(failed uint32) time.Duration {
+	if failed == 0 {
+		return 0
+	}
+	delay := minExecutionRetryDelay
+	for i := uint32(1); i < failed && delay < maxExecutionRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxExecutionRetryDelay {
+		delay = maxExecutionRetryDelay
+	}
+	return delay
+}
+
 func init() {
 	tumble.Register((*ScheduleExecution)(nil))
 }
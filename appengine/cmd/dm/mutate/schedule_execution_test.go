@@ -6,9 +6,16 @@ package mutate
 
 import (
 	"testing"
+	"time"
 
 	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor"
+	"github.com/luci/luci-go/appengine/cmd/dm/distributor/fake"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
 	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock/testclock"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
 )
@@ -25,9 +32,80 @@ func TestScheduleExecution(t *testing.T) {
 		})
 
 		Convey("RollForward", func() {
-			muts, err := se.RollForward(c)
-			So(err, ShouldBeNil)
-			So(muts, ShouldBeNil)
+			now := time.Date(2016, time.February, 3, 4, 5, 6, 0, time.UTC)
+			c, _ = testclock.UseTime(c, now)
+			ds := datastore.Get(c)
+
+			a := &model.Attempt{ID: *se.For, State: dm.Attempt_NEEDS_EXECUTION}
+			So(ds.Put(a), ShouldBeNil)
+			e := &model.Execution{ID: 1, Attempt: ds.KeyForObj(a)}
+
+			dist := &fake.Distributor{}
+			reg := distributor.NewTestingRegistry(distributor.TestFactoryMap{
+				"fake": dist.Factory(),
+			}, FinishExecutionFn)
+
+			Convey("without a registry, does nothing", func() {
+				muts, err := se.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(a.CurExecution, ShouldEqual, 0)
+			})
+
+			Convey("records a new execution, without running its task", func() {
+				c = distributor.WithRegistry(c, reg)
+				muts, err := se.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RunExecution{
+					For:   dm.NewExecutionID("quest", 1, 1),
+					After: now,
+				}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+				So(a.CurExecution, ShouldEqual, 1)
+				So(e.State, ShouldEqual, dm.Execution_SCHEDULING)
+				So(e.Created, ShouldResemble, now)
+				So(e.Token, ShouldNotBeEmpty)
+				So(e.DistributorToken, ShouldEqual, "")
+				So(dist.Tokens(), ShouldBeEmpty)
+
+				Convey("and doesn't schedule it twice", func() {
+					muts, err := se.RollForward(c)
+					So(err, ShouldBeNil)
+					So(muts, ShouldBeNil)
+
+					So(ds.Get(a), ShouldBeNil)
+					So(a.CurExecution, ShouldEqual, 1)
+				})
+			})
+
+			Convey("backs off after failed executions", func() {
+				c = distributor.WithRegistry(c, reg)
+				a.FailedExecutions = 3
+				So(ds.Put(a), ShouldBeNil)
+
+				muts, err := se.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&RunExecution{
+					For:   dm.NewExecutionID("quest", 1, 1),
+					After: now.Add(40 * time.Second),
+				}})
+			})
 		})
 	})
 }
+
+func TestExecutionRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	Convey("executionRetryDelay", t, func() {
+		So(executionRetryDelay(0), ShouldEqual, 0)
+		So(executionRetryDelay(1), ShouldEqual, minExecutionRetryDelay)
+		So(executionRetryDelay(2), ShouldEqual, 2*minExecutionRetryDelay)
+		So(executionRetryDelay(100), ShouldEqual, maxExecutionRetryDelay)
+	})
+}
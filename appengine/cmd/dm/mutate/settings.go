@@ -0,0 +1,119 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/settings"
+	"golang.org/x/net/context"
+)
+
+// settingsKey is the key of DM's mutation settings in the settings store.
+const settingsKey = "dm_mutate"
+
+// Settings is the set of tweakable things for DM's mutations.
+//
+// The JSON annotations are for settings module storage.
+type Settings struct {
+	// ExecutionRetryLimit is the number of times that an Attempt may be
+	// retried after one of its Executions ends without finishing it (e.g. its
+	// lease expired, or its distributor task failed). Once this is exceeded, the
+	// Attempt is moved to AbnormalFinished.
+	ExecutionRetryLimit uint32 `json:"executionRetryLimit,omitempty"`
+}
+
+var defaultSettings = Settings{
+	ExecutionRetryLimit: 3,
+}
+
+// getSettings returns the current Settings, or defaultSettings if there are
+// none.
+func getSettings(c context.Context) (*Settings, error) {
+	s := Settings{}
+	switch err := settings.Get(c, settingsKey, &s); err {
+	case nil:
+		break
+	case settings.ErrNoSettings:
+		s = defaultSettings
+	default:
+		return nil, errors.WrapTransient(fmt.Errorf("could not fetch DM settings - %s", err))
+	}
+	return &s, nil
+}
+
+// settingsUIPage is a UI page to configure DM's mutation settings.
+type settingsUIPage struct {
+	settings.BaseUIPage
+}
+
+func (settingsUIPage) Title(c context.Context) (string, error) {
+	return "DM settings", nil
+}
+
+func (settingsUIPage) Overview(c context.Context) (template.HTML, error) {
+	return template.HTML(`<p>Configuration parameters for the
+<a href="https://github.com/luci/luci-go/tree/master/appengine/cmd/dm">DM
+service</a>.</p>`), nil
+}
+
+func (settingsUIPage) Fields(c context.Context) ([]settings.UIField, error) {
+	return []settings.UIField{
+		{
+			ID:          "ExecutionRetryLimit",
+			Title:       "Number of times to retry an Attempt whose Executions fail",
+			Type:        settings.UIFieldText,
+			Placeholder: strconv.FormatUint(uint64(defaultSettings.ExecutionRetryLimit), 10),
+			Validator:   validateUint32,
+		},
+	}, nil
+}
+
+func (settingsUIPage) ReadSettings(c context.Context) (map[string]string, error) {
+	var s Settings
+	switch err := settings.GetUncached(c, settingsKey, &s); err {
+	case nil:
+		break
+	case settings.ErrNoSettings:
+		logging.WithError(err).Infof(c, "No settings available, using defaults.")
+		s = defaultSettings
+	default:
+		return nil, err
+	}
+
+	return map[string]string{
+		"ExecutionRetryLimit": strconv.FormatUint(uint64(s.ExecutionRetryLimit), 10),
+	}, nil
+}
+
+func (settingsUIPage) WriteSettings(c context.Context, values map[string]string, who, why string) error {
+	s := defaultSettings
+	if v := values["ExecutionRetryLimit"]; v != "" {
+		limit, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("could not parse ExecutionRetryLimit: %v", err)
+		}
+		s.ExecutionRetryLimit = uint32(limit)
+	}
+	return settings.SetIfChanged(c, settingsKey, &s, who, why)
+}
+
+func validateUint32(v string) error {
+	if v == "" {
+		return nil
+	}
+	if _, err := strconv.ParseUint(v, 10, 32); err != nil {
+		return fmt.Errorf("invalid integer %q - %s", v, err)
+	}
+	return nil
+}
+
+func init() {
+	settings.RegisterUIPage("dm", settingsUIPage{})
+}
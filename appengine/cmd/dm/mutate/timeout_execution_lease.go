@@ -0,0 +1,78 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// TimeoutExecutionLease checks whether the lease on a claimed Execution has
+// expired, and if so, marks the Execution as MISSING and retries its Attempt
+// (see retryAttempt).
+//
+// A new TimeoutExecutionLease is scheduled every time that a lease is claimed
+// or renewed. Ones whose lease was renewed in the meantime don't do anything.
+type TimeoutExecutionLease struct {
+	EID        *dm.Execution_ID
+	Expiration time.Time
+}
+
+var _ tumble.DelayedMutation = (*TimeoutExecutionLease)(nil)
+
+// Root implements tumble.Mutation
+func (t *TimeoutExecutionLease) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).KeyForObj(&model.Attempt{ID: *t.EID.AttemptID()})
+}
+
+// ProcessAfter implements tumble.DelayedMutation
+func (t *TimeoutExecutionLease) ProcessAfter() time.Time {
+	return t.Expiration
+}
+
+// HighPriority implements tumble.DelayedMutation
+func (t *TimeoutExecutionLease) HighPriority() bool {
+	return false
+}
+
+// RollForward implements tumble.Mutation
+func (t *TimeoutExecutionLease) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	ds := datastore.Get(c)
+	a := &model.Attempt{ID: *t.EID.AttemptID()}
+	e := &model.Execution{ID: t.EID.Id, Attempt: ds.KeyForObj(a)}
+	if err = ds.Get(a, e); err != nil {
+		return
+	}
+
+	if a.State != dm.Attempt_EXECUTING || a.CurExecution != e.ID || e.State.Terminal() {
+		return
+	}
+	if e.LeaseExpiration.IsZero() || clock.Now(c).Before(e.LeaseExpiration) {
+		// The lease was renewed; a later TimeoutExecutionLease will check it.
+		return
+	}
+
+	logging.Infof(c, "lease on execution %v expired at %s", t.EID, e.LeaseExpiration)
+	e.State.MustEvolve(dm.Execution_MISSING)
+	e.StateReason = "execution lease expired"
+	e.Token = nil
+
+	if muts, err = retryAttempt(c, a, e); err != nil {
+		return
+	}
+	err = ds.Put(a, e)
+	return
+}
+
+func init() {
+	tumble.Register((*TimeoutExecutionLease)(nil))
+}
@@ -0,0 +1,88 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/cmd/dm/model"
+	"github.com/luci/luci-go/appengine/tumble"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestTimeoutExecutionLease(t *testing.T) {
+	t.Parallel()
+
+	Convey("TimeoutExecutionLease", t, func() {
+		c := memory.Use(context.Background())
+		c, clk := testclock.UseTime(c, testclock.TestTimeUTC)
+		exp := testclock.TestTimeUTC.Add(time.Minute)
+		tl := &TimeoutExecutionLease{EID: dm.NewExecutionID("quest", 1, 1), Expiration: exp}
+
+		Convey("Root", func() {
+			So(tl.Root(c).String(), ShouldEqual, `dev~app::/Attempt,"quest|fffffffe"`)
+		})
+
+		Convey("ProcessAfter", func() {
+			So(tl.ProcessAfter(), ShouldResemble, exp)
+			So(tl.HighPriority(), ShouldBeFalse)
+		})
+
+		Convey("RollForward", func() {
+			ds := datastore.Get(c)
+			a := &model.Attempt{
+				ID:           *tl.EID.AttemptID(),
+				State:        dm.Attempt_EXECUTING,
+				CurExecution: 1,
+			}
+			e := &model.Execution{
+				ID: 1, Attempt: ds.KeyForObj(a), State: dm.Execution_RUNNING,
+				Token: []byte("exekey"), LeaseExpiration: exp}
+			So(ds.Put(a, e), ShouldBeNil)
+
+			Convey("does nothing if the lease hasn't expired", func() {
+				muts, err := tl.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+				So(e.State, ShouldEqual, dm.Execution_RUNNING)
+			})
+
+			Convey("retries the attempt if the lease expired", func() {
+				clk.Add(time.Minute)
+				muts, err := tl.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{&ScheduleExecution{For: &a.ID}})
+
+				So(ds.Get(a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_NEEDS_EXECUTION)
+				So(a.FailedExecutions, ShouldEqual, 1)
+				So(e.State, ShouldEqual, dm.Execution_MISSING)
+				So(e.Token, ShouldBeNil)
+			})
+
+			Convey("does nothing if the attempt moved on", func() {
+				clk.Add(time.Minute)
+				a.CurExecution = 2
+				So(ds.Put(a), ShouldBeNil)
+
+				muts, err := tl.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(e), ShouldBeNil)
+				So(e.State, ShouldEqual, dm.Execution_RUNNING)
+			})
+		})
+	})
+}
@@ -10,8 +10,10 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/luci/luci-go/appengine/gaemiddleware"
 	"github.com/luci/luci-go/appengine/logdog/coordinator"
 	"github.com/luci/luci-go/appengine/logdog/coordinator/config"
+	"github.com/luci/luci-go/appengine/logdog/coordinator/retention"
 	"github.com/luci/luci-go/appengine/tumble"
 
 	// Include mutations package so its Mutations will register with tumble via
@@ -33,5 +35,11 @@ func init() {
 	router := httprouter.New()
 	tmb.InstallHandlers(router)
 
+	// Purge log streams according to project retention policies. This is
+	// invoked from cron.
+	router.GET("/internal/logdog/retention/purge",
+		gaemiddleware.BaseProd(gaemiddleware.RequireCron(
+			config.WithConfig(coordinator.WithProdServices(retention.PurgeHandler)))))
+
 	http.Handle("/", router)
 }
@@ -65,8 +65,14 @@ const (
 	SignatureCheckError
 
 	// CertificateRevoked is returned by CheckCertificate if the certificate is
-	// in the CA's Certificate Revocation List.
+	// in the CA's Certificate Revocation List or OCSP responder says it is
+	// revoked.
 	CertificateRevoked
+
+	// UnknownRevocationStatus is returned by CheckCertificate if OCSP responder
+	// doesn't know about the certificate (or the certificate doesn't specify
+	// OCSP responder to ask).
+	UnknownRevocationStatus
 )
 
 // Error is returned by CertChecker methods in case the certificate is invalid.
@@ -192,7 +198,8 @@ func (ch *CertChecker) GetCA(c context.Context) (*model.CA, error) {
 // revocation status.
 //
 // It returns nil error iff cert was directly signed by the CA, not expired yet,
-// and its serial number is not in the CA's CRL.
+// its serial number is not in the CA's CRL and OCSP responder (if configured)
+// says it is good.
 //
 // On success also returns *model.CA instance used to check the certificate,
 // since 'GetCA' may return another instance (in case model.CA cache happened
@@ -235,14 +242,23 @@ func (ch *CertChecker) CheckCertificate(c context.Context, cert *x509.Certificat
 		}
 	}
 
-	// Check the revocation list.
-	switch revoked, err := ch.CRL.IsRevokedSN(c, cert.SerialNumber); {
-	case err != nil:
-		return nil, err
-	case revoked:
-		return nil, Error{
-			error:  fmt.Errorf("certificate with SN %s has been revoked", cert.SerialNumber),
-			Reason: CertificateRevoked,
+	// Check the revocation list, unless the CA relies only on OCSP.
+	if ca.ParsedConfig.CrlUrl != "" || !ca.ParsedConfig.UseOcsp {
+		switch revoked, err := ch.CRL.IsRevokedSN(c, cert.SerialNumber); {
+		case err != nil:
+			return nil, err
+		case revoked:
+			return nil, Error{
+				error:  fmt.Errorf("certificate with SN %s has been revoked", cert.SerialNumber),
+				Reason: CertificateRevoked,
+			}
+		}
+	}
+
+	// Ask OCSP responder, if configured.
+	if ca.ParsedConfig.UseOcsp {
+		if err = ch.checkOCSP(c, ca, cert); err != nil {
+			return nil, err
 		}
 	}
 
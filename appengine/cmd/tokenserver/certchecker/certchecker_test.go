@@ -8,19 +8,28 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"io/ioutil"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
 	"github.com/luci/gae/service/datastore"
+	"github.com/luci/gae/service/urlfetch"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/certchecker/ocsp"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
 	"github.com/luci/luci-go/appengine/gaetesting"
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/clock/testclock"
 	"github.com/luci/luci-go/common/cryptorand"
 
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
 	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -109,6 +118,135 @@ func TestCertChecker(t *testing.T) {
 	})
 }
 
+func TestCertCheckerOCSP(t *testing.T) {
+	Convey("CertChecker with OCSP works", t, func() {
+		ctx := gaetesting.TestingContext()
+		ctx = cryptorand.MockForTest(ctx, 0)
+		ctx = urlfetch.Set(ctx, http.DefaultTransport) // mock URLFetch service
+		ctx, clk := testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC))
+
+		pkey, caCert, err := generateCA(ctx, "Some CA: ca-name.fake")
+		So(err, ShouldBeNil)
+		parsedCA, err := x509.ParseCertificate(caCert)
+		So(err, ShouldBeNil)
+
+		// Fake OCSP responder that knows about some certs.
+		lock := sync.Mutex{}
+		calls := 0
+		statuses := map[int64]ocsp.Status{2: ocsp.Good}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			calls++
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			sn, err := ocsp.ParseRequest(body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			status, known := statuses[sn.Int64()]
+			if !known {
+				status = ocsp.Unknown
+			}
+			now := clock.Now(ctx)
+			resp, err := ocsp.CreateResponse(parsedCA, pkey, &ocsp.Response{
+				Status:       status,
+				SerialNumber: sn,
+				ThisUpdate:   now,
+				NextUpdate:   now.Add(30 * time.Minute),
+				RevokedAt:    now,
+			})
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/ocsp-response")
+			w.Write(resp)
+		}))
+		defer ts.Close()
+		setStatus := func(sn int64, s ocsp.Status) {
+			lock.Lock()
+			defer lock.Unlock()
+			statuses[sn] = s
+		}
+		callCount := func() int {
+			lock.Lock()
+			defer lock.Unlock()
+			return calls
+		}
+
+		// Put OCSP-only CA into the datastore. It doesn't have CRL.
+		cfg, err := proto.Marshal(&admin.CertificateAuthorityConfig{
+			Cn:      "Some CA: ca-name.fake",
+			UseOcsp: true,
+			OcspUrl: ts.URL,
+		})
+		So(err, ShouldBeNil)
+		err = datastore.Get(ctx).Put(&model.CA{
+			CN:     "Some CA: ca-name.fake",
+			Config: cfg,
+			Cert:   caCert,
+			Ready:  true,
+		})
+		So(err, ShouldBeNil)
+
+		checker, err := GetCertChecker(ctx, "Some CA: ca-name.fake")
+		So(err, ShouldBeNil)
+
+		makeCert := func(sn int64) *x509.Certificate {
+			der, err := generateCert(ctx, sn, "some-cert-name.fake", caCert, pkey)
+			So(err, ShouldBeNil)
+			cert, err := x509.ParseCertificate(der)
+			So(err, ShouldBeNil)
+			return cert
+		}
+
+		Convey("good, then revoked", func() {
+			cert := makeCert(2)
+
+			_, err := checker.CheckCertificate(ctx, cert)
+			So(err, ShouldBeNil)
+			So(callCount(), ShouldEqual, 1)
+
+			// The response is cached.
+			_, err = checker.CheckCertificate(ctx, cert)
+			So(err, ShouldBeNil)
+			So(callCount(), ShouldEqual, 1)
+
+			// Revoked, but the cached response is still used.
+			setStatus(2, ocsp.Revoked)
+			clk.Add(10 * time.Minute)
+			_, err = checker.CheckCertificate(ctx, cert)
+			So(err, ShouldBeNil)
+			So(callCount(), ShouldEqual, 1)
+
+			// Past NextUpdate, the responder is asked again.
+			clk.Add(25 * time.Minute)
+			_, err = checker.CheckCertificate(ctx, cert)
+			So(err, ShouldErrLike, "certificate with SN 2 has been revoked (per OCSP)")
+			So(err.(Error).Reason, ShouldEqual, CertificateRevoked)
+			So(callCount(), ShouldEqual, 2)
+		})
+
+		Convey("unknown cert", func() {
+			_, err := checker.CheckCertificate(ctx, makeCert(3))
+			So(err, ShouldErrLike, "OCSP responder doesn't know certificate with SN 3")
+			So(err.(Error).Reason, ShouldEqual, UnknownRevocationStatus)
+		})
+
+		Convey("responder is down", func() {
+			ts.Close()
+			_, err := checker.CheckCertificate(ctx, makeCert(2))
+			So(err, ShouldNotBeNil)
+			So(IsCertInvalidError(err), ShouldBeFalse)
+		})
+	})
+}
+
 func generateCA(c context.Context, name string) (*rsa.PrivateKey, []byte, error) {
 	// See https://golang.org/src/crypto/tls/generate_cert.go.
 	rand := cryptorand.Get(c)
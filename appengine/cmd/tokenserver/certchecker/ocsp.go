@@ -0,0 +1,161 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package certchecker
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/urlfetch"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/certchecker/ocsp"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
+)
+
+const (
+	// MaxOCSPCacheDuration is the maximum time to cache OCSP responses for.
+	//
+	// Responses are cached until their NextUpdate time, but no longer than this.
+	MaxOCSPCacheDuration = time.Hour
+
+	// DefaultOCSPCacheDuration is how long to cache OCSP responses that don't
+	// specify NextUpdate time.
+	DefaultOCSPCacheDuration = 5 * time.Minute
+
+	// ocspFetchTimeout is a deadline for a request to OCSP responder.
+	ocspFetchTimeout = 10 * time.Second
+)
+
+// checkOCSP asks OCSP responder (or the cache) about the certificate.
+//
+// Returns nil if the responder vouches for the certificate, Error if the
+// certificate is revoked or unknown to the responder, and other errors if the
+// responder can't be contacted or replies with garbage.
+func (ch *CertChecker) checkOCSP(c context.Context, ca *model.CA, cert *x509.Certificate) error {
+	resp, err := ch.ocspResponse(c, ca, cert)
+	if err != nil {
+		return err
+	}
+	switch resp.Status {
+	case ocsp.Good:
+		return nil
+	case ocsp.Revoked:
+		return Error{
+			error:  fmt.Errorf("certificate with SN %s has been revoked (per OCSP)", cert.SerialNumber),
+			Reason: CertificateRevoked,
+		}
+	default:
+		return Error{
+			error:  fmt.Errorf("OCSP responder doesn't know certificate with SN %s", cert.SerialNumber),
+			Reason: UnknownRevocationStatus,
+		}
+	}
+}
+
+// ocspResponse returns verified OCSP response for the certificate.
+//
+// Uses the cached response if it is still fresh, otherwise asks the OCSP
+// responder and caches its response.
+func (ch *CertChecker) ocspResponse(c context.Context, ca *model.CA, cert *x509.Certificate) (*ocsp.Response, error) {
+	now := clock.Now(c)
+
+	// Have a fresh response cached?
+	cached, err := model.GetOCSPResponse(c, ca.CN, cert.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil && now.Before(cached.Expiry) {
+		resp, err := ocsp.ParseResponse(cached.Response, ca.ParsedCert, cert.SerialNumber)
+		if err == nil {
+			return resp, nil
+		}
+		logging.Warningf(c, "Ignoring broken cached OCSP response for SN %s - %s", cert.SerialNumber, err)
+	}
+
+	// Pick the responder.
+	url := ca.ParsedConfig.OcspUrl
+	if url == "" {
+		if len(cert.OCSPServer) == 0 {
+			return nil, Error{
+				error:  fmt.Errorf("certificate with SN %s doesn't specify OCSP responder", cert.SerialNumber),
+				Reason: UnknownRevocationStatus,
+			}
+		}
+		url = cert.OCSPServer[0]
+	}
+
+	// Ask it.
+	req, err := ocsp.CreateRequest(cert, ca.ParsedCert)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := fetchOCSP(c, url, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ocsp.ParseResponse(blob, ca.ParsedCert, cert.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.NextUpdate.IsZero() && !now.Before(resp.NextUpdate) {
+		return nil, fmt.Errorf("OCSP response for SN %s is stale, next update was at %s", cert.SerialNumber, resp.NextUpdate)
+	}
+
+	// Cache it. It is fine if this fails, the response is still usable.
+	expiry := now.Add(DefaultOCSPCacheDuration)
+	if !resp.NextUpdate.IsZero() {
+		expiry = resp.NextUpdate
+		if maxExpiry := now.Add(MaxOCSPCacheDuration); expiry.After(maxExpiry) {
+			expiry = maxExpiry
+		}
+	}
+	err = model.StoreOCSPResponse(c, ca.CN, cert.SerialNumber, &model.OCSPResponse{
+		Response:  blob,
+		FetchTime: now.UTC(),
+		Expiry:    expiry.UTC(),
+	})
+	if err != nil {
+		logging.Warningf(c, "Failed to cache OCSP response for SN %s - %s", cert.SerialNumber, err)
+	}
+
+	return resp, nil
+}
+
+// fetchOCSP sends OCSP request to the responder and returns the raw response.
+//
+// May return transient and fatal errors.
+func fetchOCSP(c context.Context, url string, req []byte) ([]byte, error) {
+	c, _ = clock.WithTimeout(c, ocspFetchTimeout)
+	cl := http.Client{Transport: urlfetch.Get(c)}
+	resp, err := cl.Post(url, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+	defer resp.Body.Close()
+
+	blob, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		logging.Warningf(c, "POST %s - HTTP %d; %q", url, resp.StatusCode, string(blob))
+		return nil, errors.WrapTransient(fmt.Errorf("OCSP responder replied with HTTP %d", resp.StatusCode))
+	case resp.StatusCode != http.StatusOK:
+		logging.Errorf(c, "POST %s - HTTP %d; %q", url, resp.StatusCode, string(blob))
+		return nil, fmt.Errorf("unexpected status HTTP %d from OCSP responder", resp.StatusCode)
+	}
+	return blob, nil
+}
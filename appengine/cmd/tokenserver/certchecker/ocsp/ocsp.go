@@ -0,0 +1,399 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package ocsp implements a subset of Online Certificate Status Protocol
+// (RFC 6960) needed by the token server.
+//
+// It knows how to make OCSP requests for a single certificate and how to parse
+// and verify basic OCSP responses. It also knows how to produce responses, to
+// be able to implement a fake OCSP responder in tests.
+package ocsp
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Status is revocation status of a certificate as reported by OCSP responder.
+type Status int
+
+const (
+	// Good means the certificate is not revoked.
+	Good Status = 0
+	// Revoked means the certificate has been revoked.
+	Revoked Status = 1
+	// Unknown means the responder doesn't know about the certificate.
+	Unknown Status = 2
+)
+
+func (s Status) String() string {
+	switch s {
+	case Good:
+		return "good"
+	case Revoked:
+		return "revoked"
+	case Unknown:
+		return "unknown"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Response is a parsed and verified OCSP response about a single certificate.
+type Response struct {
+	Status       Status
+	SerialNumber *big.Int
+	ProducedAt   time.Time
+	ThisUpdate   time.Time
+	NextUpdate   time.Time // zero if the responder didn't specify it
+	RevokedAt    time.Time // set only for Revoked status
+}
+
+var (
+	oidSHA1            = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidBasicResponse   = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 1}
+	oidSHA1WithRSA     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 5}
+	oidSHA256WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidSHA384WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 12}
+	oidSHA512WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 13}
+	oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+)
+
+// responderIDByKeyTag is ASN.1 tag of 'byKey' ResponderID choice.
+const responderIDByKeyTag = 2
+
+var signatureAlgos = []struct {
+	oid  asn1.ObjectIdentifier
+	algo x509.SignatureAlgorithm
+}{
+	{oidSHA1WithRSA, x509.SHA1WithRSA},
+	{oidSHA256WithRSA, x509.SHA256WithRSA},
+	{oidSHA384WithRSA, x509.SHA384WithRSA},
+	{oidSHA512WithRSA, x509.SHA512WithRSA},
+	{oidECDSAWithSHA256, x509.ECDSAWithSHA256},
+	{oidECDSAWithSHA384, x509.ECDSAWithSHA384},
+	{oidECDSAWithSHA512, x509.ECDSAWithSHA512},
+}
+
+// ASN.1 structures from RFC 6960, only fields we care about.
+
+type certID struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	NameHash      []byte
+	IssuerKeyHash []byte
+	SerialNumber  *big.Int
+}
+
+type request struct {
+	Cert certID
+}
+
+type tbsRequest struct {
+	Version     int `asn1:"explicit,tag:0,default:0,optional"`
+	RequestList []request
+}
+
+type ocspRequest struct {
+	TBSRequest tbsRequest
+}
+
+type responseASN1 struct {
+	Status   asn1.Enumerated
+	Response responseBytes `asn1:"explicit,tag:0,optional"`
+}
+
+type responseBytes struct {
+	ResponseType asn1.ObjectIdentifier
+	Response     []byte
+}
+
+type basicResponse struct {
+	TBSResponseData    asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+	Certificates       []asn1.RawValue `asn1:"explicit,tag:0,optional"`
+}
+
+type responseData struct {
+	Version        int `asn1:"optional,default:0,explicit,tag:0"`
+	RawResponderID asn1.RawValue
+	ProducedAt     time.Time `asn1:"generalized"`
+	Responses      []singleResponse
+}
+
+type singleResponse struct {
+	CertID     certID
+	Good       asn1.Flag   `asn1:"tag:0,optional"`
+	Revoked    revokedInfo `asn1:"tag:1,optional"`
+	Unknown    asn1.Flag   `asn1:"tag:2,optional"`
+	ThisUpdate time.Time   `asn1:"generalized"`
+	NextUpdate time.Time   `asn1:"generalized,explicit,tag:0,optional"`
+}
+
+type revokedInfo struct {
+	RevocationTime time.Time       `asn1:"generalized"`
+	Reason         asn1.Enumerated `asn1:"explicit,tag:0,optional"`
+}
+
+// CreateRequest returns DER-encoded OCSP request for the certificate.
+//
+// 'issuer' is a certificate of the CA that signed 'cert'.
+func CreateRequest(cert, issuer *x509.Certificate) ([]byte, error) {
+	id, err := makeCertID(issuer, cert.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(ocspRequest{
+		TBSRequest: tbsRequest{
+			RequestList: []request{{Cert: id}},
+		},
+	})
+}
+
+// ParseRequest parses DER-encoded OCSP request and returns the serial number
+// of the certificate it asks about.
+//
+// Only requests produced by CreateRequest are supported. Used by fake OCSP
+// responders.
+func ParseRequest(der []byte) (*big.Int, error) {
+	req := ocspRequest{}
+	if rest, err := asn1.Unmarshal(der, &req); err != nil {
+		return nil, fmt.Errorf("malformed OCSP request - %s", err)
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("trailing data in OCSP request")
+	}
+	if len(req.TBSRequest.RequestList) != 1 {
+		return nil, fmt.Errorf("expecting exactly one certificate in OCSP request")
+	}
+	return req.TBSRequest.RequestList[0].Cert.SerialNumber, nil
+}
+
+// ParseResponse parses DER-encoded OCSP response and verifies its signature.
+//
+// The response must be signed either by the issuer directly, or by a delegated
+// responder certificate issued by the issuer for OCSP signing. It also must be
+// about the certificate with the given serial number.
+func ParseResponse(der []byte, issuer *x509.Certificate, sn *big.Int) (*Response, error) {
+	resp := responseASN1{}
+	if rest, err := asn1.Unmarshal(der, &resp); err != nil {
+		return nil, fmt.Errorf("malformed OCSP response - %s", err)
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("trailing data in OCSP response")
+	}
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("OCSP responder replied with error status %d", resp.Status)
+	}
+	if !resp.Response.ResponseType.Equal(oidBasicResponse) {
+		return nil, fmt.Errorf("unsupported OCSP response type %s", resp.Response.ResponseType)
+	}
+
+	basic := basicResponse{}
+	if _, err := asn1.Unmarshal(resp.Response.Response, &basic); err != nil {
+		return nil, fmt.Errorf("malformed basic OCSP response - %s", err)
+	}
+	data := responseData{}
+	if _, err := asn1.Unmarshal(basic.TBSResponseData.FullBytes, &data); err != nil {
+		return nil, fmt.Errorf("malformed OCSP response data - %s", err)
+	}
+
+	// Check the signature.
+	algo := x509.UnknownSignatureAlgorithm
+	for _, a := range signatureAlgos {
+		if a.oid.Equal(basic.SignatureAlgorithm.Algorithm) {
+			algo = a.algo
+			break
+		}
+	}
+	if algo == x509.UnknownSignatureAlgorithm {
+		return nil, fmt.Errorf("unsupported OCSP signature algorithm %s", basic.SignatureAlgorithm.Algorithm)
+	}
+	signer := issuer
+	if len(basic.Certificates) != 0 {
+		responder, err := x509.ParseCertificate(basic.Certificates[0].FullBytes)
+		if err != nil {
+			return nil, fmt.Errorf("bad OCSP responder certificate - %s", err)
+		}
+		if !bytes.Equal(responder.Raw, issuer.Raw) {
+			if err := responder.CheckSignatureFrom(issuer); err != nil {
+				return nil, fmt.Errorf("OCSP responder certificate is not signed by the issuer - %s", err)
+			}
+			if !hasOCSPSigningUsage(responder) {
+				return nil, fmt.Errorf("OCSP responder certificate is not authorized to sign OCSP responses")
+			}
+			signer = responder
+		}
+	}
+	if err := signer.CheckSignature(algo, basic.TBSResponseData.FullBytes, basic.Signature.RightAlign()); err != nil {
+		return nil, fmt.Errorf("bad OCSP response signature - %s", err)
+	}
+
+	// Find the response about our certificate.
+	expected, err := makeCertID(issuer, sn)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range data.Responses {
+		if !sameCertID(&r.CertID, &expected) {
+			continue
+		}
+		out := &Response{
+			SerialNumber: r.CertID.SerialNumber,
+			ProducedAt:   data.ProducedAt,
+			ThisUpdate:   r.ThisUpdate,
+			NextUpdate:   r.NextUpdate,
+		}
+		switch {
+		case bool(r.Good):
+			out.Status = Good
+		case bool(r.Unknown):
+			out.Status = Unknown
+		default:
+			out.Status = Revoked
+			out.RevokedAt = r.Revoked.RevocationTime
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("OCSP response doesn't have information about certificate with SN %s", sn)
+}
+
+// CreateResponse returns DER-encoded OCSP response signed by the issuer.
+//
+// Only Status, SerialNumber, ThisUpdate, NextUpdate and RevokedAt fields of the
+// template are used (ThisUpdate is also used as ProducedAt). Used by fake OCSP
+// responders.
+func CreateResponse(issuer *x509.Certificate, key crypto.Signer, tmpl *Response) ([]byte, error) {
+	id, err := makeCertID(issuer, tmpl.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+	single := singleResponse{
+		CertID:     id,
+		ThisUpdate: tmpl.ThisUpdate.UTC(),
+		NextUpdate: tmpl.NextUpdate.UTC(),
+	}
+	switch tmpl.Status {
+	case Good:
+		single.Good = true
+	case Revoked:
+		single.Revoked = revokedInfo{RevocationTime: tmpl.RevokedAt.UTC()}
+	case Unknown:
+		single.Unknown = true
+	default:
+		return nil, fmt.Errorf("unknown status %d", tmpl.Status)
+	}
+
+	keyHash, err := publicKeyHash(issuer)
+	if err != nil {
+		return nil, err
+	}
+	keyHashDer, err := asn1.Marshal(keyHash)
+	if err != nil {
+		return nil, err
+	}
+	tbs, err := asn1.Marshal(responseData{
+		RawResponderID: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        responderIDByKeyTag,
+			IsCompound: true,
+			Bytes:      keyHashDer,
+		},
+		ProducedAt: tmpl.ThisUpdate.UTC(),
+		Responses:  []singleResponse{single},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sigOID asn1.ObjectIdentifier
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		sigOID = oidSHA256WithRSA
+	case *ecdsa.PublicKey:
+		sigOID = oidECDSAWithSHA256
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key.Public())
+	}
+	digest := crypto.SHA256.New()
+	digest.Write(tbs)
+	sig, err := key.Sign(rand.Reader, digest.Sum(nil), crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	basic, err := asn1.Marshal(basicResponse{
+		TBSResponseData:    asn1.RawValue{FullBytes: tbs},
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: sigOID},
+		Signature:          asn1.BitString{Bytes: sig, BitLength: 8 * len(sig)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(responseASN1{
+		Response: responseBytes{
+			ResponseType: oidBasicResponse,
+			Response:     basic,
+		},
+	})
+}
+
+// makeCertID returns CertID that identifies a certificate issued by 'issuer'.
+func makeCertID(issuer *x509.Certificate, sn *big.Int) (certID, error) {
+	keyHash, err := publicKeyHash(issuer)
+	if err != nil {
+		return certID{}, err
+	}
+	nameHash := sha1.Sum(issuer.RawSubject)
+	return certID{
+		HashAlgorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  oidSHA1,
+			Parameters: asn1.RawValue{Tag: asn1.TagNull},
+		},
+		NameHash:      nameHash[:],
+		IssuerKeyHash: keyHash,
+		SerialNumber:  sn,
+	}, nil
+}
+
+// publicKeyHash returns SHA1 of the issuer's public key bits.
+func publicKeyHash(issuer *x509.Certificate) ([]byte, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, fmt.Errorf("bad issuer public key - %s", err)
+	}
+	h := sha1.Sum(spki.PublicKey.RightAlign())
+	return h[:], nil
+}
+
+// sameCertID returns true if two CertIDs identify the same certificate.
+//
+// Only SHA1-based CertIDs are supported.
+func sameCertID(a, b *certID) bool {
+	return a.HashAlgorithm.Algorithm.Equal(b.HashAlgorithm.Algorithm) &&
+		bytes.Equal(a.NameHash, b.NameHash) &&
+		bytes.Equal(a.IssuerKeyHash, b.IssuerKeyHash) &&
+		a.SerialNumber.Cmp(b.SerialNumber) == 0
+}
+
+func hasOCSPSigningUsage(cert *x509.Certificate) bool {
+	for _, u := range cert.ExtKeyUsage {
+		if u == x509.ExtKeyUsageOCSPSigning {
+			return true
+		}
+	}
+	return false
+}
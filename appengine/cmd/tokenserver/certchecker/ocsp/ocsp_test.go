@@ -0,0 +1,139 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ocsp
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOCSP(t *testing.T) {
+	Convey("with CA and cert", t, func() {
+		now := time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC)
+		caKey, ca := makeCert(nil, nil, 1, "Fake CA", now)
+		_, cert := makeCert(ca, caKey, 2, "host.fake.domain", now)
+
+		Convey("request roundtrip", func() {
+			req, err := CreateRequest(cert, ca)
+			So(err, ShouldBeNil)
+			sn, err := ParseRequest(req)
+			So(err, ShouldBeNil)
+			So(sn, ShouldResemble, big.NewInt(2))
+		})
+
+		Convey("good response roundtrip", func() {
+			der, err := CreateResponse(ca, caKey, &Response{
+				Status:       Good,
+				SerialNumber: big.NewInt(2),
+				ThisUpdate:   now,
+				NextUpdate:   now.Add(time.Hour),
+			})
+			So(err, ShouldBeNil)
+			resp, err := ParseResponse(der, ca, cert.SerialNumber)
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &Response{
+				Status:       Good,
+				SerialNumber: big.NewInt(2),
+				ProducedAt:   now,
+				ThisUpdate:   now,
+				NextUpdate:   now.Add(time.Hour),
+			})
+		})
+
+		Convey("revoked response roundtrip", func() {
+			der, err := CreateResponse(ca, caKey, &Response{
+				Status:       Revoked,
+				SerialNumber: big.NewInt(2),
+				ThisUpdate:   now,
+				RevokedAt:    now.Add(-time.Hour),
+			})
+			So(err, ShouldBeNil)
+			resp, err := ParseResponse(der, ca, cert.SerialNumber)
+			So(err, ShouldBeNil)
+			So(resp.Status, ShouldEqual, Revoked)
+			So(resp.RevokedAt, ShouldResemble, now.Add(-time.Hour))
+			So(resp.NextUpdate.IsZero(), ShouldBeTrue)
+		})
+
+		Convey("unknown response roundtrip", func() {
+			der, err := CreateResponse(ca, caKey, &Response{
+				Status:       Unknown,
+				SerialNumber: big.NewInt(2),
+				ThisUpdate:   now,
+			})
+			So(err, ShouldBeNil)
+			resp, err := ParseResponse(der, ca, cert.SerialNumber)
+			So(err, ShouldBeNil)
+			So(resp.Status, ShouldEqual, Unknown)
+		})
+
+		Convey("wrong serial number", func() {
+			der, err := CreateResponse(ca, caKey, &Response{
+				Status:       Good,
+				SerialNumber: big.NewInt(3),
+				ThisUpdate:   now,
+			})
+			So(err, ShouldBeNil)
+			_, err = ParseResponse(der, ca, cert.SerialNumber)
+			So(err, ShouldErrLike, "doesn't have information about certificate with SN 2")
+		})
+
+		Convey("wrong signer", func() {
+			anotherKey, _ := makeCert(nil, nil, 1, "Fake CA", now)
+			der, err := CreateResponse(ca, anotherKey, &Response{
+				Status:       Good,
+				SerialNumber: big.NewInt(2),
+				ThisUpdate:   now,
+			})
+			So(err, ShouldBeNil)
+			_, err = ParseResponse(der, ca, cert.SerialNumber)
+			So(err, ShouldErrLike, "bad OCSP response signature")
+		})
+
+		Convey("garbage", func() {
+			_, err := ParseResponse([]byte("zzz"), ca, cert.SerialNumber)
+			So(err, ShouldErrLike, "malformed OCSP response")
+		})
+	})
+}
+
+// makeCert generates a key and a certificate signed by 'parent' or self-signed
+// CA certificate if 'parent' is nil.
+func makeCert(parent *x509.Certificate, parentKey *rsa.PrivateKey, sn int64, cn string, now time.Time) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 512) // use short key in tests
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(sn),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             now,
+		NotAfter:              now.Add(5 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		panic(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+	return key, cert
+}
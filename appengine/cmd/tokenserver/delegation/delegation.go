@@ -0,0 +1,157 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package delegation implements generation of LUCI delegation tokens.
+//
+// Delegation tokens allow LUCI services to call other LUCI services on behalf
+// of some end user. They are minted by MintDelegationToken RPC according to
+// the delegation rules in the token server config, and are verified by
+// server/auth/delegation package.
+package delegation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/server/auth/identity"
+	"github.com/luci/luci-go/server/auth/signing"
+
+	"github.com/luci/luci-go/common/api/tokenserver"
+)
+
+// MintParams is passed to Mint.
+type MintParams struct {
+	// DelegatedIdentity is the identity the token conveys.
+	DelegatedIdentity identity.Identity
+
+	// RequestorIdentity is the identity of whoever requested the token.
+	RequestorIdentity identity.Identity
+
+	// Services is a list of identities of services that accept the token, or
+	// "*" for all services.
+	Services []string
+
+	// Lifetime is how long the token should be valid. Will be rounded to
+	// seconds.
+	Lifetime time.Duration
+
+	// SignerServiceAccount is GAE service account email of the token server.
+	//
+	// It will be put into the token (as issued_by field). Token consumers will
+	// use it to fetch public keys from Google backends to verify the token
+	// signature.
+	SignerServiceAccount string
+
+	// Signer produces RSA-SHA256 signatures using a token server key.
+	//
+	// Usually it is using SignBytes GAE API.
+	Signer signing.Signer
+}
+
+// Validate checks that token minting parameters are well-formed.
+//
+// It doesn't check that they are allowed by delegation rules. Use
+// FindMatchingRule for that.
+func (p *MintParams) Validate() error {
+	if err := p.DelegatedIdentity.Validate(); err != nil {
+		return fmt.Errorf("bad delegated identity - %s", err)
+	}
+	if p.DelegatedIdentity.Kind() == identity.Anonymous {
+		return fmt.Errorf("can't delegate anonymous identity")
+	}
+	if err := p.RequestorIdentity.Validate(); err != nil {
+		return fmt.Errorf("bad requestor identity - %s", err)
+	}
+	if err := ValidateServices(p.Services); err != nil {
+		return err
+	}
+	if p.Lifetime < time.Second {
+		return fmt.Errorf("invalid token lifetime %s", p.Lifetime)
+	}
+	return nil
+}
+
+// ValidateServices checks that a list of services to put into a token is
+// well-formed.
+func ValidateServices(services []string) error {
+	if len(services) == 0 {
+		return fmt.Errorf("at least one service is required")
+	}
+	for _, s := range services {
+		if s == "*" {
+			continue
+		}
+		if _, err := identity.MakeIdentity(s); err != nil {
+			return fmt.Errorf("bad service %q - %s", s, err)
+		}
+	}
+	return nil
+}
+
+// Mint generates a new delegation token.
+//
+// Returns its body as a proto, and as a signed base64-encoded final token.
+func Mint(c context.Context, params MintParams) (*tokenserver.DelegationTokenBody, string, error) {
+	if err := params.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	body := tokenserver.DelegationTokenBody{
+		DelegatedIdentity: string(params.DelegatedIdentity),
+		RequestorIdentity: string(params.RequestorIdentity),
+		IssuedBy:          params.SignerServiceAccount,
+		IssuedAt:          uint64(clock.Now(c).Unix()),
+		Lifetime:          uint64(params.Lifetime / time.Second),
+		Services:          params.Services,
+		TokenType:         tokenserver.DelegationTokenBody_DELEGATION_TOKEN,
+	}
+	serializedBody, err := proto.Marshal(&body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keyID, signature, err := params.Signer.SignBytes(c, serializedBody)
+	if err != nil {
+		return nil, "", errors.WrapTransient(err)
+	}
+
+	tokenBinBlob, err := proto.Marshal(&tokenserver.DelegationTokenEnvelope{
+		TokenBody: serializedBody,
+		KeyId:     keyID,
+		RsaSha256: signature,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return &body, base64.RawStdEncoding.EncodeToString(tokenBinBlob), nil
+}
+
+// Parse takes serialized DelegationTokenEnvelope and parses it.
+//
+// It returns both deserialized envelope and deserialized DelegationTokenBody.
+// It does not check the signature or expiration.
+//
+// If the envelope can't be deserialized, returns (nil, nil, error).
+// If DelegationTokenBody can't be deserialized returns (envelope, nil, error).
+func Parse(token string) (*tokenserver.DelegationTokenEnvelope, *tokenserver.DelegationTokenBody, error) {
+	tokenBinBlob, err := base64.RawStdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	envelope := &tokenserver.DelegationTokenEnvelope{}
+	if err := proto.Unmarshal(tokenBinBlob, envelope); err != nil {
+		return nil, nil, err
+	}
+	body := &tokenserver.DelegationTokenBody{}
+	if err := proto.Unmarshal(envelope.TokenBody, body); err != nil {
+		return envelope, nil, err
+	}
+	return envelope, body, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package delegation
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/server/auth/signing"
+
+	"github.com/luci/luci-go/common/api/tokenserver"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMintParamsValidation(t *testing.T) {
+	Convey("with token params", t, func() {
+		params := MintParams{
+			DelegatedIdentity:    "user:delegated@example.com",
+			RequestorIdentity:    "user:requestor@example.com",
+			Services:             []string{"service:abc", "*"},
+			Lifetime:             time.Hour,
+			SignerServiceAccount: "token-server@example.com",
+		}
+
+		Convey("good params", func() {
+			So(params.Validate(), ShouldBeNil)
+		})
+
+		Convey("bad delegated identity", func() {
+			params.DelegatedIdentity = "bad"
+			So(params.Validate(), ShouldErrLike, "bad delegated identity")
+		})
+
+		Convey("anonymous delegated identity", func() {
+			params.DelegatedIdentity = "anonymous:anonymous"
+			So(params.Validate(), ShouldErrLike, "can't delegate anonymous identity")
+		})
+
+		Convey("bad requestor identity", func() {
+			params.RequestorIdentity = "bad"
+			So(params.Validate(), ShouldErrLike, "bad requestor identity")
+		})
+
+		Convey("no services", func() {
+			params.Services = nil
+			So(params.Validate(), ShouldErrLike, "at least one service is required")
+		})
+
+		Convey("bad service", func() {
+			params.Services = []string{"abc"}
+			So(params.Validate(), ShouldErrLike, `bad service "abc"`)
+		})
+
+		Convey("bad lifetime", func() {
+			params.Lifetime = 0
+			So(params.Validate(), ShouldErrLike, "invalid token lifetime")
+		})
+	})
+}
+
+func TestMintAndParse(t *testing.T) {
+	Convey("with mock context", t, func() {
+		ctx := context.Background()
+		ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 7, time.UTC))
+
+		body, token, err := Mint(ctx, MintParams{
+			DelegatedIdentity:    "user:delegated@example.com",
+			RequestorIdentity:    "user:requestor@example.com",
+			Services:             []string{"service:abc"},
+			Lifetime:             time.Hour,
+			SignerServiceAccount: "token-server@example.com",
+			Signer:               fakeSigner{},
+		})
+		So(err, ShouldBeNil)
+		So(body, ShouldResemble, &tokenserver.DelegationTokenBody{
+			DelegatedIdentity: "user:delegated@example.com",
+			RequestorIdentity: "user:requestor@example.com",
+			IssuedBy:          "token-server@example.com",
+			IssuedAt:          1422936306,
+			Lifetime:          3600,
+			Services:          []string{"service:abc"},
+			TokenType:         tokenserver.DelegationTokenBody_DELEGATION_TOKEN,
+		})
+
+		envelope, parsed, err := Parse(token)
+		So(err, ShouldBeNil)
+		So(parsed, ShouldResemble, body)
+		So(envelope.KeyId, ShouldEqual, "key_id")
+		So(envelope.RsaSha256, ShouldResemble, []byte("signature"))
+
+		_, _, err = Parse("???")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+type fakeSigner struct{}
+
+func (fakeSigner) SignBytes(c context.Context, blob []byte) (keyID string, sig []byte, err error) {
+	return "key_id", []byte("signature"), nil
+}
+
+func (fakeSigner) Certificates(c context.Context) (*signing.PublicCertificates, error) {
+	panic("not implemented yet")
+}
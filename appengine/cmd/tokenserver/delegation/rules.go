@@ -0,0 +1,182 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package delegation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/stringset"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+)
+
+// Requestor is a special value that can be used in place of a delegated
+// identity (in requests and in allowed_to_impersonate rule sets) to refer to
+// the identity of the requestor.
+const Requestor = "REQUESTOR"
+
+// ValidateRules checks that a list of delegation rules is well-formed.
+//
+// Rule names must be unique.
+func ValidateRules(rules []*admin.DelegationRule) error {
+	names := stringset.New(len(rules))
+	for _, r := range rules {
+		if err := ValidateRule(r); err != nil {
+			return err
+		}
+		if !names.Add(r.Name) {
+			return fmt.Errorf("duplicate delegation rule name %q", r.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateRule checks that a single delegation rule is well-formed.
+func ValidateRule(r *admin.DelegationRule) error {
+	if r.Name == "" {
+		return fmt.Errorf("delegation rule name is required")
+	}
+	if r.MaxValidityDuration <= 0 {
+		return fmt.Errorf("rule %q: max_validity_duration must be positive", r.Name)
+	}
+	sets := []struct {
+		field        string
+		set          []string
+		allowSpecial string
+	}{
+		{"requestor", r.Requestor, ""},
+		{"allowed_to_impersonate", r.AllowedToImpersonate, Requestor},
+		{"allowed_audience", r.AllowedAudience, ""},
+	}
+	for _, s := range sets {
+		if len(s.set) == 0 {
+			return fmt.Errorf("rule %q: %s is required", r.Name, s.field)
+		}
+		for _, entry := range s.set {
+			if err := validateSetEntry(entry, s.allowSpecial); err != nil {
+				return fmt.Errorf("rule %q: bad %s entry - %s", r.Name, s.field, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateSetEntry(entry, allowSpecial string) error {
+	switch {
+	case entry == "*":
+		return nil
+	case allowSpecial != "" && entry == allowSpecial:
+		return nil
+	case strings.HasPrefix(entry, "group:"):
+		if strings.TrimPrefix(entry, "group:") == "" {
+			return fmt.Errorf("empty group name")
+		}
+		return nil
+	}
+	_, err := identity.MakeIdentity(entry)
+	return err
+}
+
+// Query describes a token minting request to check against the rules.
+type Query struct {
+	// Requestor is the identity of the caller.
+	Requestor identity.Identity
+
+	// Delegated is the identity to put into the token.
+	Delegated identity.Identity
+
+	// Services are the services to put into the token.
+	Services []string
+
+	// ValidityDuration is the requested token lifetime, or 0 to use the
+	// maximum allowed by the rule.
+	ValidityDuration time.Duration
+}
+
+// FindMatchingRule returns the first rule that allows the query.
+//
+// Returns (nil, nil) if no rule matches. Uses 'db' to resolve group
+// membership.
+func FindMatchingRule(c context.Context, db auth.DB, rules []*admin.DelegationRule, q *Query) (*admin.DelegationRule, error) {
+	for _, r := range rules {
+		switch ok, err := ruleMatches(c, db, r, q); {
+		case err != nil:
+			return nil, err
+		case ok:
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+func ruleMatches(c context.Context, db auth.DB, r *admin.DelegationRule, q *Query) (bool, error) {
+	if q.ValidityDuration > time.Duration(r.MaxValidityDuration)*time.Second {
+		return false, nil
+	}
+
+	ok, err := identityInSet(c, db, q.Requestor, r.Requestor)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// Check 'REQUESTOR' first, since it doesn't need any DB lookups.
+	if q.Delegated != q.Requestor || !contains(r.AllowedToImpersonate, Requestor) {
+		ok, err = identityInSet(c, db, q.Delegated, r.AllowedToImpersonate)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	for _, s := range q.Services {
+		if ok, err = serviceInSet(c, db, s, r.AllowedAudience); err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// identityInSet returns true if the identity matches some entry in the set.
+func identityInSet(c context.Context, db auth.DB, id identity.Identity, set []string) (bool, error) {
+	for _, entry := range set {
+		switch {
+		case entry == "*" || entry == string(id):
+			return true, nil
+		case strings.HasPrefix(entry, "group:"):
+			switch ok, err := db.IsMember(c, id, strings.TrimPrefix(entry, "group:")); {
+			case err != nil:
+				return false, err
+			case ok:
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// serviceInSet returns true if the requested service matches some entry in
+// the set.
+//
+// A request for all services ("*") is matched only by "*" entry.
+func serviceInSet(c context.Context, db auth.DB, service string, set []string) (bool, error) {
+	if service == "*" {
+		return contains(set, "*"), nil
+	}
+	return identityInSet(c, db, identity.Identity(service), set)
+}
+
+func contains(set []string, val string) bool {
+	for _, entry := range set {
+		if entry == val {
+			return true
+		}
+	}
+	return false
+}
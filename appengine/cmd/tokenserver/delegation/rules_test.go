@@ -0,0 +1,163 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package delegation
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/auth/authtest"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateRules(t *testing.T) {
+	Convey("ValidateRules", t, func() {
+		rule := &admin.DelegationRule{
+			Name:                 "rule",
+			Requestor:            []string{"user:a@example.com", "group:g"},
+			AllowedToImpersonate: []string{"REQUESTOR", "*"},
+			AllowedAudience:      []string{"service:abc"},
+			MaxValidityDuration:  3600,
+		}
+
+		Convey("good rule", func() {
+			So(ValidateRules([]*admin.DelegationRule{rule}), ShouldBeNil)
+		})
+
+		Convey("no name", func() {
+			rule.Name = ""
+			So(ValidateRule(rule), ShouldErrLike, "name is required")
+		})
+
+		Convey("bad duration", func() {
+			rule.MaxValidityDuration = 0
+			So(ValidateRule(rule), ShouldErrLike, "must be positive")
+		})
+
+		Convey("empty set", func() {
+			rule.AllowedAudience = nil
+			So(ValidateRule(rule), ShouldErrLike, "allowed_audience is required")
+		})
+
+		Convey("bad identity", func() {
+			rule.Requestor = []string{"blah"}
+			So(ValidateRule(rule), ShouldErrLike, "bad requestor entry")
+		})
+
+		Convey("empty group", func() {
+			rule.Requestor = []string{"group:"}
+			So(ValidateRule(rule), ShouldErrLike, "empty group name")
+		})
+
+		Convey("REQUESTOR outside of allowed_to_impersonate", func() {
+			rule.AllowedAudience = []string{"REQUESTOR"}
+			So(ValidateRule(rule), ShouldErrLike, "bad allowed_audience entry")
+		})
+
+		Convey("duplicate names", func() {
+			So(ValidateRules([]*admin.DelegationRule{rule, rule}), ShouldErrLike, "duplicate delegation rule name")
+		})
+	})
+}
+
+func TestFindMatchingRule(t *testing.T) {
+	Convey("FindMatchingRule", t, func() {
+		ctx := context.Background()
+		db := authtest.FakeDB{
+			"user:requestor@example.com": {"requestors"},
+			"user:someone@example.com":   {"impersonated"},
+			"service:in-group":           {"services"},
+		}
+
+		rules := []*admin.DelegationRule{
+			{
+				Name:                 "self",
+				Requestor:            []string{"*"},
+				AllowedToImpersonate: []string{"REQUESTOR"},
+				AllowedAudience:      []string{"group:services"},
+				MaxValidityDuration:  3600,
+			},
+			{
+				Name:                 "impersonate",
+				Requestor:            []string{"group:requestors"},
+				AllowedToImpersonate: []string{"group:impersonated"},
+				AllowedAudience:      []string{"*"},
+				MaxValidityDuration:  600,
+			},
+		}
+
+		find := func(q *Query) string {
+			r, err := FindMatchingRule(ctx, db, rules, q)
+			So(err, ShouldBeNil)
+			if r == nil {
+				return ""
+			}
+			return r.Name
+		}
+
+		Convey("delegating own identity", func() {
+			So(find(&Query{
+				Requestor: "user:requestor@example.com",
+				Delegated: "user:requestor@example.com",
+				Services:  []string{"service:in-group"},
+			}), ShouldEqual, "self")
+		})
+
+		Convey("delegating own identity to a service outside of the group", func() {
+			So(find(&Query{
+				Requestor: "user:another@example.com",
+				Delegated: "user:another@example.com",
+				Services:  []string{"service:other"},
+			}), ShouldEqual, "")
+		})
+
+		Convey("impersonating someone", func() {
+			So(find(&Query{
+				Requestor: "user:requestor@example.com",
+				Delegated: "user:someone@example.com",
+				Services:  []string{"*"},
+			}), ShouldEqual, "impersonate")
+		})
+
+		Convey("impersonating someone for too long", func() {
+			So(find(&Query{
+				Requestor:        "user:requestor@example.com",
+				Delegated:        "user:someone@example.com",
+				Services:         []string{"*"},
+				ValidityDuration: time.Hour,
+			}), ShouldEqual, "")
+		})
+
+		Convey("impersonating by a stranger", func() {
+			So(find(&Query{
+				Requestor: "user:another@example.com",
+				Delegated: "user:someone@example.com",
+				Services:  []string{"service:in-group"},
+			}), ShouldEqual, "")
+		})
+
+		Convey("impersonating someone not allowed", func() {
+			So(find(&Query{
+				Requestor: "user:requestor@example.com",
+				Delegated: "user:another@example.com",
+				Services:  []string{"*"},
+			}), ShouldEqual, "")
+		})
+
+		Convey("all services require '*' in the rule", func() {
+			So(find(&Query{
+				Requestor: "user:another@example.com",
+				Delegated: "user:another@example.com",
+				Services:  []string{"*"},
+			}), ShouldEqual, "")
+		})
+	})
+}
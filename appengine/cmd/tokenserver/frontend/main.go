@@ -32,7 +32,9 @@ import (
 	"github.com/luci/luci-go/common/api/tokenserver/identity/v1"
 	"github.com/luci/luci-go/common/api/tokenserver/minter/v1"
 
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/services/admin/certauthorities"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/services/admin/mintlog"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/services/admin/serviceaccounts"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/services/identity/identityfetcher"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/services/minter/tokenminter"
@@ -57,6 +59,13 @@ var (
 		Prelude: adminPrelude("admin.ServiceAccounts"),
 	}
 
+	// mintLogServerWithAuth implements admin.MintLog RPC interface, with admin
+	// check.
+	mintLogServerWithAuth = &admin.DecoratedMintLog{
+		Service: &mintlog.Server{},
+		Prelude: adminPrelude("admin.MintLog"),
+	}
+
 	// identityFetcher implements identity.IdentityFetcher RPC interface.
 	identityFetcher = &identityfetcher.Server{}
 
@@ -100,6 +109,8 @@ func init() {
 	// Backend routes used for cron and task queues.
 	router.GET("/internal/cron/read-config", base(gaemiddleware.RequireCron(readConfigCron)))
 	router.GET("/internal/cron/fetch-crl", base(gaemiddleware.RequireCron(fetchCRLCron)))
+	router.GET("/internal/cron/cleanup-mint-log", base(gaemiddleware.RequireCron(cleanupMintLogCron)))
+	router.GET("/internal/cron/cleanup-ocsp-cache", base(gaemiddleware.RequireCron(cleanupOCSPCacheCron)))
 
 	// Install all RPC servers.
 	api := prpc.Server{
@@ -111,6 +122,7 @@ func init() {
 	}
 	admin.RegisterCertificateAuthoritiesServer(&api, caServerWithAuth)
 	admin.RegisterServiceAccountsServer(&api, serviceAccountsServerWithAuth)
+	admin.RegisterMintLogServer(&api, mintLogServerWithAuth)
 	identity.RegisterIdentityFetcherServer(&api, identityFetcher)
 	minter.RegisterTokenMinterServer(&api, tokenMinterServerWithoutAuth) // auth inside
 	discovery.Enable(&api)
@@ -159,7 +171,10 @@ func fetchCRLCron(c context.Context, w http.ResponseWriter, r *http.Request, _ h
 		go func(i int, cn string) {
 			defer wg.Done()
 			_, err := caServerWithoutAuth.FetchCRL(c, &admin.FetchCRLRequest{Cn: cn})
-			if err != nil {
+			switch {
+			case grpc.Code(err) == codes.NotFound:
+				logging.Infof(c, "Skipping %q - %s", cn, grpc.ErrorDesc(err)) // e.g. OCSP-only CA
+			case err != nil:
 				logging.Errorf(c, "FetchCRL(%q) failed - %s", cn, err)
 				errs[i] = err
 			}
@@ -178,3 +193,49 @@ func fetchCRLCron(c context.Context, w http.ResponseWriter, r *http.Request, _ h
 	}
 	w.WriteHeader(status)
 }
+
+// cleanupMintLogCron is handler for /internal/cron/cleanup-mint-log GAE cron
+// task.
+//
+// It deletes mint log entries older than model.MintLogRetention.
+func cleanupMintLogCron(c context.Context, w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	total := cleanupInBatches(c, model.CleanupMintLog)
+	logging.Infof(c, "Deleted %d old mint log entries", total)
+	w.WriteHeader(http.StatusOK)
+}
+
+// cleanupOCSPCacheCron is handler for /internal/cron/cleanup-ocsp-cache GAE
+// cron task.
+//
+// It deletes expired cached OCSP responses.
+func cleanupOCSPCacheCron(c context.Context, w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	total := cleanupInBatches(c, model.CleanupOCSPResponses)
+	logging.Infof(c, "Deleted %d expired OCSP responses", total)
+	w.WriteHeader(http.StatusOK)
+}
+
+const (
+	cleanupBatchSize  = 500 // number of entities deleted at once
+	cleanupMaxBatches = 20  // number of batches deleted per cron run
+)
+
+// cleanupInBatches calls 'cleanup' until it deletes less than a full batch or
+// cleanupMaxBatches batches are deleted, whatever comes first. Leftovers are
+// picked up by the next cron run.
+//
+// Returns the total number of deleted entities.
+func cleanupInBatches(c context.Context, cleanup func(context.Context, int) (int, error)) int {
+	total := 0
+	for i := 0; i < cleanupMaxBatches; i++ {
+		deleted, err := cleanup(c, cleanupBatchSize)
+		if err != nil {
+			panic(err) // let panic catcher deal with it
+		}
+		total += deleted
+		if deleted < cleanupBatchSize {
+			return total
+		}
+	}
+	logging.Warningf(c, "Hit the limit of %d batches, the rest is left for the next run", cleanupMaxBatches)
+	return total
+}
@@ -0,0 +1,91 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package jwtchecker
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// KeySet is a set of RSA public keys parsed from a JSON Web Key Set document.
+type KeySet struct {
+	keys map[string]*rsa.PublicKey // key ID => the key
+}
+
+// jsonWebKey is a single key in JWKS document (only fields we care about).
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// ParseKeySet parses JSON Web Key Set document (RFC 7517).
+//
+// Only RSA signing keys are used, all other keys are skipped. Returns an error
+// if there are no usable keys.
+func ParseKeySet(blob []byte) (*KeySet, error) {
+	doc := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	if err := json.Unmarshal(blob, &doc); err != nil {
+		return nil, fmt.Errorf("can't parse JWKS - %s", err)
+	}
+	ks := &KeySet{keys: make(map[string]*rsa.PublicKey, len(doc.Keys))}
+	for _, k := range doc.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") || (k.Alg != "" && k.Alg != "RS256") {
+			continue
+		}
+		if _, dup := ks.keys[k.Kid]; dup {
+			return nil, fmt.Errorf("duplicate key ID %q in JWKS", k.Kid)
+		}
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("bad modulus of key %q - %s", k.Kid, err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("bad exponent of key %q - %s", k.Kid, err)
+		}
+		if e.BitLen() > 31 || e.Int64() <= 1 {
+			return nil, fmt.Errorf("unsupported exponent of key %q", k.Kid)
+		}
+		ks.keys[k.Kid] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+	}
+	if len(ks.keys) == 0 {
+		return nil, fmt.Errorf("no RSA signing keys in JWKS")
+	}
+	return ks, nil
+}
+
+// Key returns a key with the given ID or nil if there's no such key.
+//
+// An empty key ID is accepted only if the set has exactly one key.
+func (ks *KeySet) Key(kid string) *rsa.PublicKey {
+	if kid == "" && len(ks.keys) == 1 {
+		for _, k := range ks.keys {
+			return k
+		}
+	}
+	return ks.keys[kid]
+}
+
+// decodeBigInt decodes base64url-encoded big-endian integer.
+func decodeBigInt(s string) (*big.Int, error) {
+	blob, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(blob) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return big.NewInt(0).SetBytes(blob), nil
+}
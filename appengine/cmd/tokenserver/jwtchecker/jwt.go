@@ -0,0 +1,150 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package jwtchecker
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// allowedClockDrift is how much clock difference we tolerate.
+const allowedClockDrift = 10 * time.Second
+
+// jwt is a parsed, but not yet verified, JWT.
+type jwt struct {
+	header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	claims    map[string]interface{}
+	signed    []byte // "<header>.<payload>" part, what the signature covers
+	signature []byte
+}
+
+// parseJWT parses JWT in compact serialization form without verifying it.
+func parseJWT(token string) (*jwt, error) {
+	chunks := strings.Split(token, ".")
+	if len(chunks) != 3 {
+		return nil, fmt.Errorf("expecting 3 components in JWT, got %d", len(chunks))
+	}
+	out := &jwt{signed: []byte(chunks[0] + "." + chunks[1])}
+
+	blob, err := base64.RawURLEncoding.DecodeString(chunks[0])
+	if err != nil {
+		return nil, fmt.Errorf("bad JWT header encoding - %s", err)
+	}
+	if err := json.Unmarshal(blob, &out.header); err != nil {
+		return nil, fmt.Errorf("bad JWT header - %s", err)
+	}
+
+	if blob, err = base64.RawURLEncoding.DecodeString(chunks[1]); err != nil {
+		return nil, fmt.Errorf("bad JWT payload encoding - %s", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(blob))
+	dec.UseNumber()
+	if err := dec.Decode(&out.claims); err != nil {
+		return nil, fmt.Errorf("bad JWT payload - %s", err)
+	}
+
+	if out.signature, err = base64.RawURLEncoding.DecodeString(chunks[2]); err != nil {
+		return nil, fmt.Errorf("bad JWT signature encoding - %s", err)
+	}
+	return out, nil
+}
+
+// checkSignature verifies RS256 signature of the JWT using a key from the set.
+func (t *jwt) checkSignature(keys *KeySet) error {
+	if t.header.Alg != "RS256" {
+		return fmt.Errorf("unsupported JWT signature algorithm %q", t.header.Alg)
+	}
+	key := keys.Key(t.header.Kid)
+	if key == nil {
+		return fmt.Errorf("unknown signing key %q", t.header.Kid)
+	}
+	digest := sha256.Sum256(t.signed)
+	return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], t.signature)
+}
+
+// stringClaim returns a value of a string claim or "" if it is not set.
+func (t *jwt) stringClaim(name string) (string, error) {
+	switch v := t.claims[name].(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf("claim %q is not a string", name)
+	}
+}
+
+// timeClaim returns a value of a NumericDate claim or zero time if it is not
+// set.
+func (t *jwt) timeClaim(name string) (time.Time, error) {
+	switch v := t.claims[name].(type) {
+	case nil:
+		return time.Time{}, nil
+	case json.Number:
+		secs, err := v.Float64()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("bad %q claim - %s", name, err)
+		}
+		return time.Unix(int64(secs), 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("claim %q is not a number", name)
+	}
+}
+
+// audience returns a list of values of "aud" claim.
+func (t *jwt) audience() ([]string, error) {
+	switch v := t.claims["aud"].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		out := make([]string, len(v))
+		for i, aud := range v {
+			s, ok := aud.(string)
+			if !ok {
+				return nil, fmt.Errorf("claim \"aud\" is not a list of strings")
+			}
+			out[i] = s
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("claim \"aud\" is not a string or a list")
+	}
+}
+
+// checkExpiration returns nil if the token is non-expired yet.
+//
+// "exp" claim is required, "nbf" is optional. Allows some clock drift, see
+// allowedClockDrift.
+func (t *jwt) checkExpiration(now time.Time) error {
+	exp, err := t.timeClaim("exp")
+	switch {
+	case err != nil:
+		return err
+	case exp.IsZero():
+		return fmt.Errorf("claim \"exp\" is required")
+	case now.After(exp.Add(allowedClockDrift)):
+		return fmt.Errorf("token expired %s ago", now.Sub(exp))
+	}
+	nbf, err := t.timeClaim("nbf")
+	switch {
+	case err != nil:
+		return err
+	case !nbf.IsZero() && now.Before(nbf.Add(-allowedClockDrift)):
+		return fmt.Errorf("token is not valid yet, will be valid in %s", nbf.Sub(now))
+	}
+	return nil
+}
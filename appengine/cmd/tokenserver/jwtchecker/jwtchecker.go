@@ -0,0 +1,218 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package jwtchecker knows how to check JWT identity tokens issued by trusted
+// JWT issuers.
+//
+// Trusted issuers and their public keys (as JSON Web Key Sets) are defined in
+// the token server config. Only RS256 signatures are supported.
+package jwtchecker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/lazyslot"
+	"github.com/luci/luci-go/server/proccache"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
+)
+
+// RefetchIssuersPeriod is how often to check JWTIssuers entity in the
+// datastore.
+const RefetchIssuersPeriod = time.Minute
+
+// ErrorReason is part of Error struct.
+type ErrorReason int
+
+const (
+	// BadFormat is returned by CheckJWT if the JWT can't be parsed.
+	BadFormat ErrorReason = iota
+
+	// UnknownIssuer is returned by CheckJWT if the JWT was issued by an issuer
+	// not defined in the config.
+	UnknownIssuer
+
+	// SignatureCheckError is returned by CheckJWT if the JWT signature is not
+	// valid.
+	SignatureCheckError
+
+	// TokenExpired is returned by CheckJWT if the JWT has expired already or
+	// not yet active.
+	TokenExpired
+
+	// BadAudience is returned by CheckJWT if the JWT is not intended for the
+	// token server.
+	BadAudience
+
+	// BadClaims is returned by CheckJWT if the JWT has no valid FQDN claim.
+	BadClaims
+)
+
+// Error is returned by CheckJWT in case the JWT is invalid.
+//
+// Datastore errors are not wrapped in Error, but returned as is. You may use
+// type cast to Error to distinguish JWT related errors from other kinds of
+// errors.
+type Error struct {
+	error              // inner error with text description
+	Reason ErrorReason // enumeration that can be used in switches
+}
+
+// NewError instantiates Error.
+//
+// It is needed because initializing 'error' field on Error is not allowed
+// outside of this package (it is lowercase - "unexported").
+func NewError(e error, reason ErrorReason) error {
+	return Error{e, reason}
+}
+
+// IsJWTInvalidError returns true for errors from CheckJWT that indicate
+// malformed or expired or otherwise invalid JWTs.
+//
+// Such errors can be safely cast to Error.
+func IsJWTInvalidError(err error) bool {
+	_, ok := err.(Error)
+	return ok
+}
+
+// Result is returned by CheckJWT if the JWT is valid.
+type Result struct {
+	// Config is the config of the issuer of the JWT.
+	Config *admin.JWTIssuerConfig
+
+	// FQDN is machine FQDN extracted from the JWT claims (in lowercase).
+	FQDN string
+}
+
+// issuer is parsed model.JWTIssuer.
+type issuer struct {
+	config *admin.JWTIssuerConfig
+	keys   *KeySet
+}
+
+type proccacheKey int
+
+// CheckJWT verifies the JWT and extracts machine FQDN from it.
+//
+// It checks that the JWT is signed by some trusted issuer, not expired and
+// intended for the token server (has one of configured audiences). The FQDN
+// is not validated beyond being non-empty, it is done when minting the token.
+func CheckJWT(c context.Context, token string) (*Result, error) {
+	tok, err := parseJWT(token)
+	if err != nil {
+		return nil, Error{err, BadFormat}
+	}
+	iss, err := tok.stringClaim("iss")
+	if err != nil {
+		return nil, Error{err, BadFormat}
+	}
+
+	issuers, err := getIssuers(c)
+	if err != nil {
+		return nil, err
+	}
+	known := issuers[iss]
+	if known == nil {
+		return nil, Error{fmt.Errorf("unknown JWT issuer %q", iss), UnknownIssuer}
+	}
+
+	if err := tok.checkSignature(known.keys); err != nil {
+		return nil, Error{err, SignatureCheckError}
+	}
+	if err := tok.checkExpiration(clock.Now(c)); err != nil {
+		return nil, Error{err, TokenExpired}
+	}
+
+	aud, err := tok.audience()
+	if err != nil {
+		return nil, Error{err, BadAudience}
+	}
+	if !intersects(aud, known.config.Audience) {
+		return nil, Error{fmt.Errorf("the JWT is not intended for the token server, aud is %q", aud), BadAudience}
+	}
+
+	fqdnClaim := known.config.FqdnClaim
+	if fqdnClaim == "" {
+		fqdnClaim = "sub"
+	}
+	fqdn, err := tok.stringClaim(fqdnClaim)
+	switch {
+	case err != nil:
+		return nil, Error{err, BadClaims}
+	case fqdn == "":
+		return nil, Error{fmt.Errorf("claim %q with machine FQDN is required", fqdnClaim), BadClaims}
+	}
+
+	return &Result{
+		Config: known.config,
+		FQDN:   strings.ToLower(fqdn),
+	}, nil
+}
+
+// getIssuers returns all trusted issuers, keyed by their name.
+//
+// It caches them in local memory, refetching every RefetchIssuersPeriod.
+func getIssuers(c context.Context) (map[string]*issuer, error) {
+	slot, err := proccache.GetOrMake(c, proccacheKey(0), func() (interface{}, time.Duration, error) {
+		return &lazyslot.Slot{
+			Fetcher: func(c context.Context, _ lazyslot.Value) (lazyslot.Value, error) {
+				issuers, err := loadIssuers(c)
+				if err != nil {
+					return lazyslot.Value{}, err
+				}
+				return lazyslot.Value{
+					Value:      issuers,
+					Expiration: clock.Now(c).Add(RefetchIssuersPeriod),
+				}, nil
+			},
+		}, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	val, err := slot.(*lazyslot.Slot).Get(c)
+	if err != nil {
+		return nil, err
+	}
+	return val.Value.(map[string]*issuer), nil
+}
+
+// loadIssuers fetches JWTIssuers from the datastore and parses them.
+func loadIssuers(c context.Context) (map[string]*issuer, error) {
+	stored, err := model.LoadJWTIssuers(c)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*issuer, len(stored))
+	for _, iss := range stored {
+		// JWKS was validated when importing the config, so this should not fail.
+		keys, err := ParseKeySet(iss.JWKS)
+		if err != nil {
+			return nil, fmt.Errorf("bad JWKS of %q - %s", iss.Config.Issuer, err)
+		}
+		out[iss.Config.Issuer] = &issuer{
+			config: iss.Config,
+			keys:   keys,
+		}
+	}
+	return out, nil
+}
+
+func intersects(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package jwtchecker
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/appengine/gaetesting"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/server/proccache"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseKeySet(t *testing.T) {
+	Convey("ParseKeySet", t, func() {
+		key := makeKey()
+
+		Convey("works", func() {
+			ks, err := ParseKeySet(makeJWKS(map[string]*rsa.PrivateKey{"key-1": key}))
+			So(err, ShouldBeNil)
+			So(ks.Key("key-1"), ShouldResemble, &key.PublicKey)
+			So(ks.Key(""), ShouldResemble, &key.PublicKey) // the only key
+			So(ks.Key("key-2"), ShouldBeNil)
+		})
+
+		Convey("skips non-RSA keys", func() {
+			_, err := ParseKeySet([]byte(`{"keys": [{"kty": "EC", "kid": "a"}]}`))
+			So(err, ShouldErrLike, "no RSA signing keys")
+		})
+
+		Convey("bad JSON", func() {
+			_, err := ParseKeySet([]byte(`{`))
+			So(err, ShouldErrLike, "can't parse JWKS")
+		})
+
+		Convey("bad modulus", func() {
+			_, err := ParseKeySet([]byte(`{"keys": [{"kty": "RSA", "kid": "a", "n": "???", "e": "AQAB"}]}`))
+			So(err, ShouldErrLike, "bad modulus")
+		})
+	})
+}
+
+func TestCheckJWT(t *testing.T) {
+	Convey("with mock context", t, func() {
+		ctx := gaetesting.TestingContext()
+		ctx = proccache.Use(ctx, &proccache.Cache{})
+		ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 7, time.UTC))
+
+		key := makeKey()
+		cfg := &admin.JWTIssuerConfig{
+			UniqueId:  10,
+			Issuer:    "https://issuer.example.com",
+			Audience:  []string{"token-server"},
+			FqdnClaim: "hostname",
+		}
+		err := model.StoreJWTIssuers(ctx, []model.JWTIssuer{
+			{
+				Config: cfg,
+				JWKS:   makeJWKS(map[string]*rsa.PrivateKey{"key-1": key}),
+			},
+		}, "rev")
+		So(err, ShouldBeNil)
+
+		now := clock.Now(ctx).Unix()
+		claims := func() map[string]interface{} {
+			return map[string]interface{}{
+				"iss":      "https://issuer.example.com",
+				"aud":      "token-server",
+				"exp":      now + 3600,
+				"hostname": "Host.Domain",
+			}
+		}
+
+		Convey("valid JWT works", func() {
+			res, err := CheckJWT(ctx, makeJWT(key, "key-1", claims()))
+			So(err, ShouldBeNil)
+			So(res.Config, ShouldResemble, cfg)
+			So(res.FQDN, ShouldEqual, "host.domain")
+		})
+
+		Convey("audience as a list works", func() {
+			c := claims()
+			c["aud"] = []string{"something-else", "token-server"}
+			_, err := CheckJWT(ctx, makeJWT(key, "key-1", c))
+			So(err, ShouldBeNil)
+		})
+
+		check := func(tok string) ErrorReason {
+			_, err := CheckJWT(ctx, tok)
+			So(IsJWTInvalidError(err), ShouldBeTrue)
+			return err.(Error).Reason
+		}
+
+		Convey("garbage", func() {
+			So(check("not-a-jwt"), ShouldEqual, BadFormat)
+		})
+
+		Convey("unknown issuer", func() {
+			c := claims()
+			c["iss"] = "https://another.example.com"
+			So(check(makeJWT(key, "key-1", c)), ShouldEqual, UnknownIssuer)
+		})
+
+		Convey("wrong key", func() {
+			So(check(makeJWT(makeKey(), "key-1", claims())), ShouldEqual, SignatureCheckError)
+		})
+
+		Convey("unknown key ID", func() {
+			So(check(makeJWT(key, "key-2", claims())), ShouldEqual, SignatureCheckError)
+		})
+
+		Convey("expired", func() {
+			c := claims()
+			c["exp"] = now - 60
+			So(check(makeJWT(key, "key-1", c)), ShouldEqual, TokenExpired)
+		})
+
+		Convey("no expiration", func() {
+			c := claims()
+			delete(c, "exp")
+			So(check(makeJWT(key, "key-1", c)), ShouldEqual, TokenExpired)
+		})
+
+		Convey("not yet valid", func() {
+			c := claims()
+			c["nbf"] = now + 60
+			So(check(makeJWT(key, "key-1", c)), ShouldEqual, TokenExpired)
+		})
+
+		Convey("wrong audience", func() {
+			c := claims()
+			c["aud"] = "another-server"
+			So(check(makeJWT(key, "key-1", c)), ShouldEqual, BadAudience)
+		})
+
+		Convey("no FQDN claim", func() {
+			c := claims()
+			delete(c, "hostname")
+			So(check(makeJWT(key, "key-1", c)), ShouldEqual, BadClaims)
+		})
+	})
+}
+
+func makeKey() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 512) // use short key in tests
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func makeJWKS(keys map[string]*rsa.PrivateKey) []byte {
+	type jwk struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+	doc := struct {
+		Keys []jwk `json:"keys"`
+	}{}
+	for kid, key := range keys {
+		doc.Keys = append(doc.Keys, jwk{
+			Kty: "RSA",
+			Kid: kid,
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	blob, err := json.Marshal(&doc)
+	if err != nil {
+		panic(err)
+	}
+	return blob
+}
+
+func makeJWT(key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+	if err != nil {
+		panic(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		panic(err)
+	}
+	signed := fmt.Sprintf("%s.%s",
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(payload))
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		panic(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
@@ -36,13 +36,14 @@ type MintParams struct {
 
 	// Cert is the certificate used when authenticating the token requester.
 	//
-	// It's serial number will be put in the token.
+	// It's serial number will be put in the token. It is nil if the requester
+	// was authenticated with a JWT, cert_sn is 0 in that case.
 	Cert *x509.Certificate
 
 	// Config is a chunk of configuration related to the machine domain.
 	//
 	// It describes parameters for the token. Fetched from luci-config as part of
-	// CA configuration.
+	// CA configuration (or JWT issuer configuration, see JWTIssuerConfig).
 	Config *admin.CertificateAuthorityConfig
 
 	// SignerServiceAccount is GAE service account email of the token server.
@@ -84,9 +85,11 @@ func (p *MintParams) Validate() error {
 
 	// Make sure cert serial number fits into uint64. We don't support negative or
 	// giant SNs.
-	sn := p.Cert.SerialNumber
-	if sn.Sign() <= 0 || sn.Cmp(maxUint64) >= 0 {
-		return fmt.Errorf("invalid certificate serial number: %s", sn)
+	if p.Cert != nil {
+		sn := p.Cert.SerialNumber
+		if sn.Sign() <= 0 || sn.Cmp(maxUint64) >= 0 {
+			return fmt.Errorf("invalid certificate serial number: %s", sn)
+		}
 	}
 	return nil
 }
@@ -127,7 +130,10 @@ func Mint(c context.Context, params MintParams) (*tokenserver.MachineTokenBody,
 		IssuedAt:    uint64(clock.Now(c).Unix()),
 		Lifetime:    uint64(cfg.MachineTokenLifetime),
 		CaId:        params.Config.UniqueId,
-		CertSn:      params.Cert.SerialNumber.Uint64(), // already validated, fits uint64
+		TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
+	}
+	if params.Cert != nil {
+		body.CertSn = params.Cert.SerialNumber.Uint64() // already validated, fits uint64
 	}
 	serializedBody, err := proto.Marshal(&body)
 	if err != nil {
@@ -107,9 +107,37 @@ func TestMint(t *testing.T) {
 				Lifetime:    3600,
 				CaId:        0,
 				CertSn:      12345,
+				TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
+			})
+			So(token, ShouldEqual, "CjUKC2hvc3QuZG9tYWluEhh0b2tlbi1zZXJ2ZXJAZXhhbXB"+
+				"sZS5jb20Y8pHBpgUgkBwwuWA4ARIGa2V5X2lkGglzaWduYXR1cmU")
+		})
+
+		Convey("works without a certificate", func() {
+			params := MintParams{
+				FQDN: "host.domain",
+				Config: &admin.CertificateAuthorityConfig{
+					UniqueId: 10,
+					KnownDomains: []*admin.DomainConfig{
+						{
+							Domain:               []string{"domain"},
+							MachineTokenLifetime: 3600,
+						},
+					},
+				},
+				SignerServiceAccount: "token-server@example.com",
+				Signer:               fakeSigner{},
+			}
+			body, _, err := Mint(ctx, params)
+			So(err, ShouldBeNil)
+			So(body, ShouldResemble, &tokenserver.MachineTokenBody{
+				MachineFqdn: "host.domain",
+				IssuedBy:    "token-server@example.com",
+				IssuedAt:    1422936306,
+				Lifetime:    3600,
+				CaId:        10,
+				TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
 			})
-			So(token, ShouldEqual, "CjMKC2hvc3QuZG9tYWluEhh0b2tlbi1zZXJ2ZXJAZXhhbXB"+
-				"sZS5jb20Y8pHBpgUgkBwwuWASBmtleV9pZBoJc2lnbmF0dXJl")
 		})
 	})
 }
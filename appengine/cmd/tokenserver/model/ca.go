@@ -46,6 +46,8 @@ type CA struct {
 	Removed bool
 
 	// Ready is false before this CA's CRL is fetched for the first time.
+	//
+	// CAs that rely only on OCSP are ready right away.
 	Ready bool
 
 	AddedRev   string `gae:",noindex"` // config rev when this CA appeared
@@ -68,6 +68,33 @@ type CRL struct {
 
 	// RevokedCertsCount is a number of revoked certificates in CRL. FYI only.
 	RevokedCertsCount int `gae:",noindex"`
+
+	// CRLNumber is a decimal CRL number extension of the base CRL, if present.
+	//
+	// Delta CRLs are applied only if they are based on this (or older) CRL.
+	CRLNumber string `gae:",noindex"`
+
+	// NextUpdateTime is extracted from corresponding field of the base CRL.
+	//
+	// If the CA publishes delta CRLs, the base CRL is refetched only after this
+	// time.
+	NextUpdateTime time.Time `gae:",noindex"`
+
+	// LastDeltaUpdateTime is when the last applied delta CRL was generated.
+	LastDeltaUpdateTime time.Time `gae:",noindex"`
+
+	// LastDeltaFetchTime is when the last applied delta CRL was fetched.
+	LastDeltaFetchTime time.Time `gae:",noindex"`
+
+	// LastDeltaFetchETag is ETag header of last downloaded delta CRL file.
+	LastDeltaFetchETag string `gae:",noindex"`
+
+	// DeltaCRLNumber is a decimal CRL number of the last applied delta CRL.
+	DeltaCRLNumber string `gae:",noindex"`
+
+	// DeltaRevokedCertsCount is a number of entries in the last applied delta
+	// CRL. FYI only.
+	DeltaRevokedCertsCount int `gae:",noindex"`
 }
 
 // GetStatusProto returns populated CRLStatus proto message.
@@ -77,6 +104,11 @@ func (crl *CRL) GetStatusProto() *admin.CRLStatus {
 		LastFetchTime:     google.NewTimestamp(crl.LastFetchTime),
 		LastFetchEtag:     crl.LastFetchETag,
 		RevokedCertsCount: int64(crl.RevokedCertsCount),
+
+		LastDeltaUpdateTime:    google.NewTimestamp(crl.LastDeltaUpdateTime),
+		LastDeltaFetchTime:     google.NewTimestamp(crl.LastDeltaFetchTime),
+		LastDeltaFetchEtag:     crl.LastDeltaFetchETag,
+		DeltaRevokedCertsCount: int64(crl.DeltaRevokedCertsCount),
 	}
 }
 
@@ -177,6 +209,118 @@ func updateCRLShard(c context.Context, cn string, shard shards.Shard, count, idx
 	}, nil)
 }
 
+// ApplyCRLDelta adds 'revoked' serial numbers to the CRL set and removes
+// 'unrevoked' ones from it.
+//
+// Unlike UpdateCRLSet, it touches only shards affected by the change. It is
+// used to apply delta CRLs on top of a set built by UpdateCRLSet.
+func ApplyCRLDelta(c context.Context, cn string, shardCount int, revoked, unrevoked []*big.Int) error {
+	// Group the changes by shard.
+	type shardDelta struct {
+		add    [][]byte
+		remove [][]byte
+	}
+	deltas := map[int]*shardDelta{}
+	group := func(sns []*big.Int, remove bool) error {
+		for _, sn := range sns {
+			blob, err := utils.SerializeSN(sn)
+			if err != nil {
+				return err
+			}
+			idx := shards.ShardIndex(blob, shardCount)
+			d := deltas[idx]
+			if d == nil {
+				d = &shardDelta{}
+				deltas[idx] = d
+			}
+			if remove {
+				d.remove = append(d.remove, blob)
+			} else {
+				d.add = append(d.add, blob)
+			}
+		}
+		return nil
+	}
+	if err := group(revoked, false); err != nil {
+		return err
+	}
+	if err := group(unrevoked, true); err != nil {
+		return err
+	}
+
+	// Update affected shards in parallel via a bunch of independent
+	// transactions.
+	wg := sync.WaitGroup{}
+	er := errors.NewLazyMultiError(shardCount)
+	for idx, d := range deltas {
+		wg.Add(1)
+		go func(idx int, d *shardDelta) {
+			defer wg.Done()
+			er.Assign(idx, applyCRLShardDelta(c, cn, shardCount, idx, d.add, d.remove))
+		}(idx, d)
+	}
+	wg.Wait()
+	return er.Get()
+}
+
+// applyCRLShardDelta transactionally modifies a single shard of a CRL set.
+func applyCRLShardDelta(c context.Context, cn string, count, idx int, add, remove [][]byte) error {
+	return datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+
+		// Load the current shard, if any.
+		header := CRLShardHeader{ID: shardEntityID(cn, count, idx)}
+		body := CRLShardBody{Parent: ds.KeyForObj(&header)}
+		shard := shards.Shard{}
+		switch err := ds.Get(&body); {
+		case err == nil:
+			blob, err := utils.ZlibDecompress(body.ZippedData)
+			if err != nil {
+				return err
+			}
+			if shard, err = shards.ParseShard(blob); err != nil {
+				return err
+			}
+		case err != datastore.ErrNoSuchEntity:
+			return err
+		}
+
+		// Modify it, skipping the update if nothing has changed.
+		changed := false
+		for _, sn := range add {
+			if _, ok := shard[string(sn)]; !ok {
+				shard[string(sn)] = struct{}{}
+				changed = true
+			}
+		}
+		for _, sn := range remove {
+			if _, ok := shard[string(sn)]; ok {
+				delete(shard, string(sn))
+				changed = true
+			}
+		}
+		if !changed {
+			logging.Infof(c, "CRL for %q: shard %d/%d is up-to-date", cn, idx, count)
+			return nil
+		}
+
+		blob := shard.Serialize()
+		hash := sha1.Sum(blob)
+		zipped, err := utils.ZlibCompress(blob)
+		if err != nil {
+			return err
+		}
+		logging.Infof(
+			c, "CRL for %q: shard %d/%d updated by delta (+%d, -%d entries)",
+			cn, idx, count, len(add), len(remove))
+
+		header.SHA1 = hex.EncodeToString(hash[:])
+		body.SHA1 = header.SHA1
+		body.ZippedData = zipped
+		return ds.Put(&header, &body)
+	}, nil)
+}
+
 // shardEntityID returns an ID of CRLShardHeader entity for given shard.
 //
 // 'cn' is Common Name of the CRL. 'total' is total number of shards expected,
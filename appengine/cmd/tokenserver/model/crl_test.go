@@ -86,3 +86,64 @@ func TestCRL(t *testing.T) {
 		So(revoked, ShouldEqual, (sn%3) == 0)
 	})
 }
+
+func TestCRLDelta(t *testing.T) {
+	Convey("ApplyCRLDelta works", t, func() {
+		caName := "CA"
+		shardCount := 4
+		cachingTime := 10 * time.Second
+
+		ctx := gaetesting.TestingContext()
+		ctx, clk := testclock.UseTime(ctx, testclock.TestTimeUTC)
+
+		// Base CRL revokes 3, 6, 9, ..., 297.
+		crl := &pkix.CertificateList{}
+		for i := 1; i < 100; i++ {
+			crl.TBSCertList.RevokedCertificates = append(crl.TBSCertList.RevokedCertificates, pkix.RevokedCertificate{
+				SerialNumber: big.NewInt(int64(i * 3)),
+			})
+		}
+		So(UpdateCRLSet(ctx, caName, shardCount, crl), ShouldBeNil)
+
+		// Delta CRL revokes 1 and 2, and unrevokes 3.
+		So(ApplyCRLDelta(
+			ctx, caName, shardCount,
+			[]*big.Int{big.NewInt(1), big.NewInt(2)},
+			[]*big.Int{big.NewInt(3)}), ShouldBeNil)
+
+		// Applying the same delta again is noop.
+		So(ApplyCRLDelta(
+			ctx, caName, shardCount,
+			[]*big.Int{big.NewInt(1), big.NewInt(2)},
+			[]*big.Int{big.NewInt(3)}), ShouldBeNil)
+
+		clk.Add(cachingTime * 2)
+
+		checker := NewCRLChecker(caName, shardCount, cachingTime)
+		for i := 1; i < 300; i++ {
+			revoked, err := checker.IsRevokedSN(ctx, big.NewInt(int64(i)))
+			So(err, ShouldBeNil)
+			So(revoked, ShouldEqual, i == 1 || i == 2 || (i != 3 && (i%3) == 0))
+		}
+
+		// Full update overwrites changes made by the delta.
+		So(UpdateCRLSet(ctx, caName, shardCount, crl), ShouldBeNil)
+		clk.Add(cachingTime * 2)
+		for _, sn := range []int64{1, 3} {
+			revoked, err := checker.IsRevokedSN(ctx, big.NewInt(sn))
+			So(err, ShouldBeNil)
+			So(revoked, ShouldEqual, sn == 3)
+		}
+	})
+
+	Convey("ApplyCRLDelta works without base CRL", t, func() {
+		ctx := gaetesting.TestingContext()
+
+		So(ApplyCRLDelta(ctx, "CA", 4, []*big.Int{big.NewInt(1)}, nil), ShouldBeNil)
+
+		checker := NewCRLChecker("CA", 4, time.Second)
+		revoked, err := checker.IsRevokedSN(ctx, big.NewInt(1))
+		So(err, ShouldBeNil)
+		So(revoked, ShouldBeTrue)
+	})
+}
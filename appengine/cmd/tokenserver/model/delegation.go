@@ -0,0 +1,94 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/lazyslot"
+	"github.com/luci/luci-go/server/proccache"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+)
+
+// DelegationRules is a singleton entity with delegation rules (imported from
+// the config).
+//
+// It's loaded in memory in full and kept cached there (for 1 min).
+// See GetDelegationRules below.
+type DelegationRules struct {
+	_id int64 `gae:"$id,1"`
+
+	// Config is serialized TokenServerConfig proto message with only
+	// delegation_rule field set.
+	Config []byte `gae:",noindex"`
+
+	// Revision is config revision the rules were imported from.
+	Revision string `gae:",noindex"`
+}
+
+// StoreDelegationRules overwrites DelegationRules with new content.
+func StoreDelegationRules(c context.Context, rules []*admin.DelegationRule, rev string) error {
+	blob, err := proto.Marshal(&admin.TokenServerConfig{DelegationRule: rules})
+	if err != nil {
+		return err
+	}
+	return errors.WrapTransient(datastore.Get(c).Put(&DelegationRules{
+		Config:   blob,
+		Revision: rev,
+	}))
+}
+
+// LoadDelegationRules loads DelegationRules from the datastore.
+//
+// Returns nil if there are no rules.
+func LoadDelegationRules(c context.Context) ([]*admin.DelegationRule, error) {
+	ent := DelegationRules{}
+	switch err := datastore.Get(c).Get(&ent); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, nil
+	case err != nil:
+		return nil, errors.WrapTransient(err)
+	}
+	cfg := admin.TokenServerConfig{}
+	if err := proto.Unmarshal(ent.Config, &cfg); err != nil {
+		return nil, err
+	}
+	return cfg.DelegationRule, nil
+}
+
+// GetDelegationRules returns the current delegation rules.
+//
+// It uses cached DelegationRules entity. The returned slice must not be
+// modified.
+func GetDelegationRules(c context.Context) ([]*admin.DelegationRule, error) {
+	slot, err := proccache.GetOrMake(c, rulesCacheKey(0), func() (interface{}, time.Duration, error) {
+		return &lazyslot.Slot{
+			Fetcher: func(c context.Context, _ lazyslot.Value) (lazyslot.Value, error) {
+				rules, err := LoadDelegationRules(c)
+				return lazyslot.Value{
+					Value:      rules,
+					Expiration: clock.Now(c).Add(time.Minute),
+				}, err
+			},
+		}, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	val, err := slot.(*lazyslot.Slot).Get(c)
+	if err != nil {
+		return nil, err
+	}
+	return val.Value.([]*admin.DelegationRule), nil
+}
+
+type rulesCacheKey int
@@ -0,0 +1,62 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/appengine/gaetesting"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/server/proccache"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDelegationRules(t *testing.T) {
+	Convey("DelegationRules Load, Store and Get work", t, func() {
+		ctx := gaetesting.TestingContext()
+		ctx = proccache.Use(ctx, &proccache.Cache{})
+		ctx, clk := testclock.UseTime(ctx, testclock.TestTimeUTC)
+
+		// Empty.
+		rules, err := LoadDelegationRules(ctx)
+		So(err, ShouldBeNil)
+		So(rules, ShouldBeNil)
+		rules, err = GetDelegationRules(ctx)
+		So(err, ShouldBeNil)
+		So(rules, ShouldBeNil)
+
+		// Store some.
+		toStore := []*admin.DelegationRule{
+			{
+				Name:                 "rule",
+				Requestor:            []string{"*"},
+				AllowedToImpersonate: []string{"REQUESTOR"},
+				AllowedAudience:      []string{"*"},
+				MaxValidityDuration:  3600,
+			},
+		}
+		So(StoreDelegationRules(ctx, toStore, "rev"), ShouldBeNil)
+
+		// Not empty now.
+		rules, err = LoadDelegationRules(ctx)
+		So(err, ShouldBeNil)
+		So(rules, ShouldResemble, toStore)
+
+		// Still empty (cached old value).
+		rules, err = GetDelegationRules(ctx)
+		So(err, ShouldBeNil)
+		So(rules, ShouldBeNil)
+
+		// Updated after cache expires.
+		clk.Add(2 * time.Minute)
+		rules, err = GetDelegationRules(ctx)
+		So(err, ShouldBeNil)
+		So(rules, ShouldResemble, toStore)
+	})
+}
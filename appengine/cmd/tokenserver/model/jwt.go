@@ -0,0 +1,100 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/errors"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+)
+
+// JWTIssuers is a singleton entity with trusted JWT issuers (imported from
+// the config), along with their public keys.
+//
+// It is loaded in full by jwtchecker package and kept cached there.
+type JWTIssuers struct {
+	_id int64 `gae:"$id,1"`
+
+	// Config is serialized TokenServerConfig proto message with only jwt_issuer
+	// field set.
+	Config []byte `gae:",noindex"`
+
+	// GobEncodedJWKS is gob-encoded map[string][]byte with JSON Web Key Sets,
+	// keyed by the issuer name.
+	GobEncodedJWKS []byte `gae:",noindex"`
+
+	// Revision is config revision the issuers were imported from.
+	Revision string `gae:",noindex"`
+}
+
+// JWTIssuer is a single trusted JWT issuer.
+type JWTIssuer struct {
+	// Config is the issuer config.
+	Config *admin.JWTIssuerConfig
+
+	// JWKS is JSON Web Key Set document with public keys of the issuer.
+	//
+	// It is read from luci-config from path specified in the config.
+	JWKS []byte
+}
+
+// StoreJWTIssuers overwrites JWTIssuers with new content.
+func StoreJWTIssuers(c context.Context, issuers []JWTIssuer, rev string) error {
+	cfg := admin.TokenServerConfig{}
+	jwks := make(map[string][]byte, len(issuers))
+	for _, iss := range issuers {
+		cfg.JwtIssuer = append(cfg.JwtIssuer, iss.Config)
+		jwks[iss.Config.Issuer] = iss.JWKS
+	}
+	blob, err := proto.Marshal(&cfg)
+	if err != nil {
+		return err
+	}
+	buf := bytes.Buffer{}
+	if err := gob.NewEncoder(&buf).Encode(jwks); err != nil {
+		return err
+	}
+	return errors.WrapTransient(datastore.Get(c).Put(&JWTIssuers{
+		Config:         blob,
+		GobEncodedJWKS: buf.Bytes(),
+		Revision:       rev,
+	}))
+}
+
+// LoadJWTIssuers loads JWTIssuers from the datastore.
+//
+// Returns nil if there are no issuers.
+func LoadJWTIssuers(c context.Context) ([]JWTIssuer, error) {
+	ent := JWTIssuers{}
+	switch err := datastore.Get(c).Get(&ent); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, nil
+	case err != nil:
+		return nil, errors.WrapTransient(err)
+	}
+	cfg := admin.TokenServerConfig{}
+	if err := proto.Unmarshal(ent.Config, &cfg); err != nil {
+		return nil, err
+	}
+	jwks := map[string][]byte{}
+	if err := gob.NewDecoder(bytes.NewReader(ent.GobEncodedJWKS)).Decode(&jwks); err != nil {
+		return nil, err
+	}
+	out := make([]JWTIssuer, len(cfg.JwtIssuer))
+	for i, issCfg := range cfg.JwtIssuer {
+		out[i] = JWTIssuer{
+			Config: issCfg,
+			JWKS:   jwks[issCfg.Issuer],
+		}
+	}
+	return out, nil
+}
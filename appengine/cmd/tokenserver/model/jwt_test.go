@@ -0,0 +1,52 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/luci/luci-go/appengine/gaetesting"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestJWTIssuersLoadStore(t *testing.T) {
+	Convey("JWTIssuers Load and Store works", t, func() {
+		ctx := gaetesting.TestingContext()
+
+		// Empty.
+		issuers, err := LoadJWTIssuers(ctx)
+		So(err, ShouldBeNil)
+		So(issuers, ShouldBeNil)
+
+		// Store some.
+		toStore := []JWTIssuer{
+			{
+				Config: &admin.JWTIssuerConfig{
+					UniqueId: 1,
+					Issuer:   "https://issuer-1.example.com",
+					Audience: []string{"token-server"},
+				},
+				JWKS: []byte(`{"keys": []}`),
+			},
+			{
+				Config: &admin.JWTIssuerConfig{
+					UniqueId: 2,
+					Issuer:   "https://issuer-2.example.com",
+					Audience: []string{"token-server"},
+				},
+				JWKS: []byte(`{"keys": [{}]}`),
+			},
+		}
+		So(StoreJWTIssuers(ctx, toStore, "rev"), ShouldBeNil)
+
+		// Not empty now.
+		issuers, err = LoadJWTIssuers(ctx)
+		So(err, ShouldBeNil)
+		So(issuers, ShouldResemble, toStore)
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/proto/google"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+)
+
+// MintLogRetention is how long MintLogEntry entities are kept.
+const MintLogRetention = 90 * 24 * time.Hour
+
+// MintLogEntry is an audit log record about a single attempt to mint a machine
+// or delegation token.
+//
+// Entities are never updated. They are deleted by CleanupMintLog after
+// MintLogRetention.
+type MintLogEntry struct {
+	ID int64 `gae:"$id"`
+
+	// Timestamp is when the attempt was made.
+	Timestamp time.Time
+
+	RPC       string `gae:",noindex"` // e.g. "MintMachineToken"
+	TokenType string `gae:",noindex"` // requested minter.TokenType
+	PeerIP    string `gae:",noindex"` // IP address of the caller
+
+	FQDN   string // FQDN of the machine, if known
+	CA     string // CN of a CA or a JWT issuer, if known
+	CaID   int64  `gae:",noindex"` // unique_id of the CA or the issuer
+	CertSN string // decimal serial number of the cert, if known
+
+	OAuth2Scopes []string `gae:",noindex"` // requested OAuth2 scopes
+
+	ErrorCode    string `gae:",noindex"` // minter.ErrorCode or "RPC_ERROR"
+	ErrorMessage string `gae:",noindex"` // details of the error, if any
+
+	ServiceAccount string    `gae:",noindex"` // for OAuth2 access tokens
+	TokenExpiry    time.Time `gae:",noindex"` // when the minted token expires
+	TokenSHA256    string    `gae:",noindex"` // hex SHA256 of the minted token
+	ServiceVersion string    `gae:",noindex"` // version of the token server
+
+	Requestor         string   `gae:",noindex"` // caller of MintDelegationToken
+	DelegatedIdentity string   `gae:",noindex"` // identity in the delegation token
+	Services          []string `gae:",noindex"` // services the delegation token is for
+}
+
+// ToProto converts the entry to admin.MintLogEntry proto.
+func (e *MintLogEntry) ToProto() *admin.MintLogEntry {
+	out := &admin.MintLogEntry{
+		Timestamp:         google.NewTimestamp(e.Timestamp),
+		Rpc:               e.RPC,
+		TokenType:         e.TokenType,
+		PeerIp:            e.PeerIP,
+		Fqdn:              e.FQDN,
+		Ca:                e.CA,
+		CaId:              e.CaID,
+		Oauth2Scopes:      e.OAuth2Scopes,
+		ErrorCode:         e.ErrorCode,
+		ErrorMessage:      e.ErrorMessage,
+		ServiceAccount:    e.ServiceAccount,
+		TokenSha256:       e.TokenSHA256,
+		ServiceVersion:    e.ServiceVersion,
+		Requestor:         e.Requestor,
+		DelegatedIdentity: e.DelegatedIdentity,
+		Services:          e.Services,
+	}
+	if e.CertSN != "" {
+		out.CertSn, _ = strconv.ParseUint(e.CertSN, 10, 64)
+	}
+	if !e.TokenExpiry.IsZero() {
+		out.TokenExpiry = google.NewTimestamp(e.TokenExpiry)
+	}
+	return out
+}
+
+// RecordMint stores the entry in the datastore.
+func RecordMint(c context.Context, e *MintLogEntry) error {
+	return errors.WrapTransient(datastore.Get(c).Put(e))
+}
+
+// MintLogQuery is passed to QueryMintLog.
+type MintLogQuery struct {
+	FQDN   string    // if not empty, return entries only for this FQDN
+	CA     string    // if not empty, return entries only for this CA
+	CertSN uint64    // if not 0, return entries only for this cert
+	Since  time.Time // if not zero, return entries starting from this time
+	Until  time.Time // if not zero, return entries before this time
+}
+
+// QueryMintLog returns entries matching the query, most recent first.
+//
+// Returns a cursor to use to fetch the next page of results, or "" if there are
+// no more results. Only filter combinations allowed by QueryMintLogRequest have
+// composite indexes defined in index.yaml.
+func QueryMintLog(c context.Context, q *MintLogQuery, limit int, cursor string) ([]*MintLogEntry, string, error) {
+	ds := datastore.Get(c)
+
+	dsq := datastore.NewQuery("MintLogEntry").Order("-Timestamp")
+	if q.FQDN != "" {
+		dsq = dsq.Eq("FQDN", q.FQDN)
+	}
+	if q.CA != "" {
+		dsq = dsq.Eq("CA", q.CA)
+	}
+	if q.CertSN != 0 {
+		dsq = dsq.Eq("CertSN", strconv.FormatUint(q.CertSN, 10))
+	}
+	if !q.Since.IsZero() {
+		dsq = dsq.Gte("Timestamp", q.Since)
+	}
+	if !q.Until.IsZero() {
+		dsq = dsq.Lt("Timestamp", q.Until)
+	}
+	if cursor != "" {
+		cur, err := ds.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		dsq = dsq.Start(cur)
+	}
+	dsq = dsq.Limit(int32(limit))
+
+	out := make([]*MintLogEntry, 0, limit)
+	next := ""
+	err := ds.Run(dsq, func(e *MintLogEntry, cb datastore.CursorCB) error {
+		out = append(out, e)
+		if len(out) == limit {
+			cur, err := cb()
+			if err != nil {
+				return err
+			}
+			next = cur.String()
+			return datastore.Stop
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", errors.WrapTransient(err)
+	}
+	return out, next, nil
+}
+
+// CleanupMintLog deletes log entries older than MintLogRetention.
+//
+// Deletes at most 'limit' entries, returns how many were deleted.
+func CleanupMintLog(c context.Context, limit int) (int, error) {
+	ds := datastore.Get(c)
+	q := datastore.NewQuery("MintLogEntry").
+		Lt("Timestamp", clock.Now(c).Add(-MintLogRetention)).
+		KeysOnly(true).
+		Limit(int32(limit))
+	var keys []*datastore.Key
+	if err := ds.GetAll(q, &keys); err != nil {
+		return 0, errors.WrapTransient(err)
+	}
+	if err := ds.Delete(keys); err != nil {
+		return 0, errors.WrapTransient(err)
+	}
+	return len(keys), nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/gaetesting"
+	"github.com/luci/luci-go/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// addMintLogIndexes adds composite indexes from index.yaml to the testing
+// datastore.
+func addMintLogIndexes(ds datastore.Interface) {
+	desc := datastore.IndexColumn{Property: "Timestamp", Descending: true}
+	ds.Testable().AddIndexes(
+		&datastore.IndexDefinition{
+			Kind:   "MintLogEntry",
+			SortBy: []datastore.IndexColumn{{Property: "FQDN"}, desc},
+		},
+		&datastore.IndexDefinition{
+			Kind:   "MintLogEntry",
+			SortBy: []datastore.IndexColumn{{Property: "CA"}, desc},
+		},
+		&datastore.IndexDefinition{
+			Kind:   "MintLogEntry",
+			SortBy: []datastore.IndexColumn{{Property: "CertSN"}, desc},
+		},
+		&datastore.IndexDefinition{
+			Kind:   "MintLogEntry",
+			SortBy: []datastore.IndexColumn{{Property: "CA"}, {Property: "CertSN"}, desc},
+		},
+	)
+}
+
+func TestMintLog(t *testing.T) {
+	Convey("with mock context", t, func() {
+		testTime := time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC)
+		ctx := gaetesting.TestingContext()
+		ctx, clk := testclock.UseTime(ctx, testTime)
+		ds := datastore.Get(ctx)
+		ds.Testable().Consistent(true)
+		addMintLogIndexes(ds)
+
+		// Entries 1 minute apart: #0 is the oldest.
+		entries := []*MintLogEntry{
+			{FQDN: "a.domain", CA: "ca-1", CertSN: "1"},
+			{FQDN: "b.domain", CA: "ca-1", CertSN: "2"},
+			{FQDN: "a.domain", CA: "ca-2", CertSN: "1"},
+			{FQDN: "c.domain", CA: "ca-1", CertSN: "1"},
+		}
+		for _, e := range entries {
+			e.Timestamp = clk.Now().UTC()
+			So(RecordMint(ctx, e), ShouldBeNil)
+			clk.Add(time.Minute)
+		}
+
+		query := func(q *MintLogQuery, limit int, cursor string) ([]string, string) {
+			res, next, err := QueryMintLog(ctx, q, limit, cursor)
+			So(err, ShouldBeNil)
+			out := make([]string, len(res))
+			for i, e := range res {
+				out[i] = e.FQDN + "/" + e.CA + "/" + e.CertSN
+			}
+			return out, next
+		}
+
+		Convey("QueryMintLog without filters", func() {
+			out, _ := query(&MintLogQuery{}, 100, "")
+			So(out, ShouldResemble, []string{
+				"c.domain/ca-1/1",
+				"a.domain/ca-2/1",
+				"b.domain/ca-1/2",
+				"a.domain/ca-1/1",
+			})
+		})
+
+		Convey("QueryMintLog by FQDN", func() {
+			out, _ := query(&MintLogQuery{FQDN: "a.domain"}, 100, "")
+			So(out, ShouldResemble, []string{"a.domain/ca-2/1", "a.domain/ca-1/1"})
+		})
+
+		Convey("QueryMintLog by CA and serial number", func() {
+			out, _ := query(&MintLogQuery{CA: "ca-1", CertSN: 1}, 100, "")
+			So(out, ShouldResemble, []string{"c.domain/ca-1/1", "a.domain/ca-1/1"})
+		})
+
+		Convey("QueryMintLog by time range", func() {
+			out, _ := query(&MintLogQuery{
+				Since: testTime.Add(time.Minute),
+				Until: testTime.Add(3 * time.Minute),
+			}, 100, "")
+			So(out, ShouldResemble, []string{"a.domain/ca-2/1", "b.domain/ca-1/2"})
+		})
+
+		Convey("QueryMintLog pagination", func() {
+			out, cursor := query(&MintLogQuery{CA: "ca-1"}, 2, "")
+			So(out, ShouldResemble, []string{"c.domain/ca-1/1", "b.domain/ca-1/2"})
+			So(cursor, ShouldNotEqual, "")
+			out, _ = query(&MintLogQuery{CA: "ca-1"}, 2, cursor)
+			So(out, ShouldResemble, []string{"a.domain/ca-1/1"})
+		})
+
+		Convey("ToProto works", func() {
+			msg := entries[1].ToProto()
+			So(msg.Fqdn, ShouldEqual, "b.domain")
+			So(msg.CertSn, ShouldEqual, 2)
+			So(msg.Timestamp.Time(), ShouldResemble, testTime.Add(time.Minute))
+			So(msg.TokenExpiry, ShouldBeNil)
+		})
+
+		Convey("CleanupMintLog works", func() {
+			clk.Set(testTime.Add(MintLogRetention + 90*time.Second))
+			deleted, err := CleanupMintLog(ctx, 100)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldEqual, 2)
+			out, _ := query(&MintLogQuery{}, 100, "")
+			So(out, ShouldResemble, []string{"c.domain/ca-1/1", "a.domain/ca-2/1"})
+		})
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+)
+
+// OCSPResponse is a cached OCSP response for some certificate.
+//
+// It is used by CertChecker to avoid hitting OCSP responder for each request.
+// Responses are stored as is (der-encoded) and reverified when loaded.
+//
+// ID is "<CA cn>|<cert serial number>" (see ocspResponseID). Expired responses
+// are deleted by CleanupOCSPResponses.
+type OCSPResponse struct {
+	ID string `gae:"$id"`
+
+	// Response is der-encoded OCSP response as returned by the responder.
+	Response []byte `gae:",noindex"`
+
+	// FetchTime is when the response was fetched from the responder.
+	FetchTime time.Time `gae:",noindex"`
+
+	// Expiry is when the response should be refetched.
+	Expiry time.Time
+}
+
+// GetOCSPResponse returns a cached OCSP response for a certificate issued by
+// the given CA.
+//
+// Returns nil if there's no cached response. It doesn't check expiration time.
+func GetOCSPResponse(c context.Context, cn string, sn *big.Int) (*OCSPResponse, error) {
+	resp := &OCSPResponse{ID: ocspResponseID(cn, sn)}
+	switch err := datastore.Get(c).Get(resp); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, nil
+	case err != nil:
+		return nil, errors.WrapTransient(err)
+	}
+	return resp, nil
+}
+
+// StoreOCSPResponse puts an OCSP response into the cache, overwriting an
+// existing one.
+func StoreOCSPResponse(c context.Context, cn string, sn *big.Int, resp *OCSPResponse) error {
+	resp.ID = ocspResponseID(cn, sn)
+	if err := datastore.Get(c).Put(resp); err != nil {
+		return errors.WrapTransient(err)
+	}
+	return nil
+}
+
+// CleanupOCSPResponses deletes cached OCSP responses that have expired.
+//
+// They are never used once expired: CertChecker refetches and overwrites them.
+// Deletes at most 'limit' entities, returns how many were deleted.
+func CleanupOCSPResponses(c context.Context, limit int) (int, error) {
+	ds := datastore.Get(c)
+	q := datastore.NewQuery("OCSPResponse").
+		Lt("Expiry", clock.Now(c).UTC()).
+		KeysOnly(true).
+		Limit(int32(limit))
+	var keys []*datastore.Key
+	if err := ds.GetAll(q, &keys); err != nil {
+		return 0, errors.WrapTransient(err)
+	}
+	if err := ds.Delete(keys); err != nil {
+		return 0, errors.WrapTransient(err)
+	}
+	return len(keys), nil
+}
+
+// ocspResponseID returns an ID of OCSPResponse entity for given certificate.
+func ocspResponseID(cn string, sn *big.Int) string {
+	return fmt.Sprintf("%s|%s", cn, sn)
+}
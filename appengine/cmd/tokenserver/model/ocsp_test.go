@@ -0,0 +1,80 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/gaetesting"
+	"github.com/luci/luci-go/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOCSPResponse(t *testing.T) {
+	Convey("OCSP response cache works", t, func() {
+		ctx := gaetesting.TestingContext()
+		testTime := time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC)
+
+		resp, err := GetOCSPResponse(ctx, "CA", big.NewInt(123))
+		So(err, ShouldBeNil)
+		So(resp, ShouldBeNil)
+
+		So(StoreOCSPResponse(ctx, "CA", big.NewInt(123), &OCSPResponse{
+			Response:  []byte("blah"),
+			FetchTime: testTime,
+			Expiry:    testTime.Add(time.Hour),
+		}), ShouldBeNil)
+
+		resp, err = GetOCSPResponse(ctx, "CA", big.NewInt(123))
+		So(err, ShouldBeNil)
+		So(resp, ShouldResemble, &OCSPResponse{
+			ID:        "CA|123",
+			Response:  []byte("blah"),
+			FetchTime: testTime,
+			Expiry:    testTime.Add(time.Hour),
+		})
+
+		// Different CA.
+		resp, err = GetOCSPResponse(ctx, "Another CA", big.NewInt(123))
+		So(err, ShouldBeNil)
+		So(resp, ShouldBeNil)
+	})
+}
+
+func TestCleanupOCSPResponses(t *testing.T) {
+	Convey("CleanupOCSPResponses works", t, func() {
+		testTime := time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC)
+		ctx, clk := testclock.UseTime(gaetesting.TestingContext(), testTime)
+		datastore.Get(ctx).Testable().Consistent(true)
+
+		for i, exp := range []time.Duration{time.Hour, 2 * time.Hour, 3 * time.Hour} {
+			So(StoreOCSPResponse(ctx, "CA", big.NewInt(int64(i)), &OCSPResponse{
+				Response:  []byte("blah"),
+				FetchTime: testTime,
+				Expiry:    testTime.Add(exp),
+			}), ShouldBeNil)
+		}
+
+		// Nothing has expired yet.
+		deleted, err := CleanupOCSPResponses(ctx, 100)
+		So(err, ShouldBeNil)
+		So(deleted, ShouldEqual, 0)
+
+		clk.Set(testTime.Add(2*time.Hour + time.Second))
+		deleted, err = CleanupOCSPResponses(ctx, 100)
+		So(err, ShouldBeNil)
+		So(deleted, ShouldEqual, 2)
+
+		for i, alive := range []bool{false, false, true} {
+			resp, err := GetOCSPResponse(ctx, "CA", big.NewInt(int64(i)))
+			So(err, ShouldBeNil)
+			So(resp != nil, ShouldEqual, alive)
+		}
+	})
+}
@@ -6,9 +6,13 @@ package certauthorities
 
 import (
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -29,11 +33,29 @@ var crlFetchScopes = []string{
 	"https://www.googleapis.com/auth/userinfo.email",
 }
 
-// fetchCRL fetches a blob with der-encoded CRL from the CRL endpoint.
+var (
+	// oidCRLNumber is OID of CRL number extension, see RFC 5280 5.2.3.
+	oidCRLNumber = asn1.ObjectIdentifier{2, 5, 29, 20}
+	// oidDeltaCRLIndicator is OID of delta CRL indicator, see RFC 5280 5.2.4.
+	oidDeltaCRLIndicator = asn1.ObjectIdentifier{2, 5, 29, 27}
+	// oidReasonCode is OID of CRL entry reason code, see RFC 5280 5.3.1.
+	oidReasonCode = asn1.ObjectIdentifier{2, 5, 29, 21}
+)
+
+// reasonRemoveFromCRL is a reason code used in delta CRLs to indicate that
+// a certificate is no longer revoked (e.g. it was on hold).
+const reasonRemoveFromCRL = 8
+
+// errStaleBaseCRL is returned by validateAndApplyDeltaCRL if the delta CRL is
+// based on a newer CRL than the one that is imported.
+var errStaleBaseCRL = errors.New("the imported base CRL is older than the one the delta CRL is based on")
+
+// fetchCRL fetches a blob with der-encoded CRL from the given URL.
 //
-// It knows how to use ETag headers to avoid fetching already known data.
-// May return transient and fatal errors.
-func fetchCRL(c context.Context, cfg *admin.CertificateAuthorityConfig, knownETag string) (blob []byte, etag string, err error) {
+// It is used to fetch both base and delta CRLs. It knows how to use ETag
+// headers to avoid fetching already known data. May return transient and fatal
+// errors.
+func fetchCRL(c context.Context, cfg *admin.CertificateAuthorityConfig, url, knownETag string) (blob []byte, etag string, err error) {
 	// Pick auth or non-auth transport.
 	var transport http.RoundTripper
 	if cfg.UseOauth {
@@ -46,7 +68,7 @@ func fetchCRL(c context.Context, cfg *admin.CertificateAuthorityConfig, knownETa
 	}
 
 	// Send the request with ETag related headers.
-	req, err := http.NewRequest("GET", cfg.CrlUrl, nil)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, "", err
 	}
@@ -76,13 +98,13 @@ func fetchCRL(c context.Context, cfg *admin.CertificateAuthorityConfig, knownETa
 
 	// Transient error?
 	if resp.StatusCode >= http.StatusInternalServerError {
-		logging.Warningf(c, "GET %s - HTTP %d; %q", cfg.CrlUrl, resp.StatusCode, string(blob))
+		logging.Warningf(c, "GET %s - HTTP %d; %q", url, resp.StatusCode, string(blob))
 		return nil, "", errors.WrapTransient(fmt.Errorf("server replied with HTTP %d", resp.StatusCode))
 	}
 
 	// Something we don't support or expect?
 	if resp.StatusCode != http.StatusOK {
-		logging.Errorf(c, "GET %s - HTTP %d; %q", cfg.CrlUrl, resp.StatusCode, string(blob))
+		logging.Errorf(c, "GET %s - HTTP %d; %q", url, resp.StatusCode, string(blob))
 		return nil, "", fmt.Errorf("unexpected status HTTP %d", resp.StatusCode)
 	}
 
@@ -104,11 +126,19 @@ func validateAndStoreCRL(c context.Context, crlDer []byte, etag string, ca *mode
 	if err = caCert.CheckCRLSignature(crl); err != nil {
 		return nil, fmt.Errorf("CRL is not signed by the CA - %s", err)
 	}
+	if hasExtension(crl.TBSCertList.Extensions, oidDeltaCRLIndicator) {
+		return nil, fmt.Errorf("expecting a full CRL, got a delta CRL")
+	}
+	crlNumber, err := extensionInt(crl.TBSCertList.Extensions, oidCRLNumber)
+	if err != nil {
+		return nil, fmt.Errorf("bad CRL number extension - %s", err)
+	}
 
 	// The CRL is peachy. Update a sharded set of all revoked certs.
 	logging.Infof(c, "CRL last updated %s", crl.TBSCertList.ThisUpdate)
 	logging.Infof(c, "Found %d entries in the CRL", len(crl.TBSCertList.RevokedCertificates))
 	if err = model.UpdateCRLSet(c, ca.CN, model.CRLShardCount, crl); err != nil {
+		forgetCRL(c, ca.CN, prev)
 		return nil, err
 	}
 	logging.Infof(c, "All CRL entries stored")
@@ -130,6 +160,19 @@ func validateAndStoreCRL(c context.Context, crlDer []byte, etag string, ca *mode
 		entity.LastFetchTime = clock.Now(c).UTC()
 		entity.LastFetchETag = etag
 		entity.RevokedCertsCount = len(crl.TBSCertList.RevokedCertificates)
+		entity.CRLNumber = ""
+		if crlNumber != nil {
+			entity.CRLNumber = crlNumber.String()
+		}
+		entity.NextUpdateTime = crl.TBSCertList.NextUpdate.UTC()
+
+		// The full CRL has overwritten changes made by the delta CRL, it must be
+		// applied again.
+		entity.LastDeltaUpdateTime = time.Time{}
+		entity.LastDeltaFetchTime = time.Time{}
+		entity.LastDeltaFetchETag = ""
+		entity.DeltaCRLNumber = ""
+		entity.DeltaRevokedCertsCount = 0
 
 		updated = &entity // used outside of this function
 		toPut := []interface{}{updated}
@@ -150,9 +193,217 @@ func validateAndStoreCRL(c context.Context, crlDer []byte, etag string, ca *mode
 		return ds.Put(toPut)
 	}, nil)
 	if err != nil {
+		forgetCRL(c, ca.CN, prev)
 		return nil, errors.WrapTransient(err)
 	}
 
 	logging.Infof(c, "CRL for %q is updated, entity version is %d", ca.CN, updated.EntityVersion)
 	return updated, nil
 }
+
+// validateAndApplyDeltaCRL handles incoming delta CRL blob fetched by
+// 'fetchCRL'.
+//
+// It applies changes listed in the delta CRL on top of the imported base CRL.
+// Returns errStaleBaseCRL if the base CRL must be refetched first.
+func validateAndApplyDeltaCRL(c context.Context, crlDer []byte, etag string, ca *model.CA, prev *model.CRL) (*model.CRL, error) {
+	// Make sure it is signed by the CA.
+	caCert, err := x509.ParseCertificate(ca.Cert)
+	if err != nil {
+		return nil, fmt.Errorf("cert in the datastore is broken - %s", err)
+	}
+	crl, err := x509.ParseDERCRL(crlDer)
+	if err != nil {
+		return nil, fmt.Errorf("not a valid x509 CRL - %s", err)
+	}
+	if err = caCert.CheckCRLSignature(crl); err != nil {
+		return nil, fmt.Errorf("delta CRL is not signed by the CA - %s", err)
+	}
+
+	// Make sure it can be applied to the imported base CRL.
+	exts := crl.TBSCertList.Extensions
+	baseNumber, err := extensionInt(exts, oidDeltaCRLIndicator)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("bad delta CRL indicator extension - %s", err)
+	case baseNumber == nil:
+		return nil, fmt.Errorf("expecting a delta CRL, got a full CRL")
+	}
+	deltaNumber, err := extensionInt(exts, oidCRLNumber)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("bad CRL number extension - %s", err)
+	case deltaNumber == nil:
+		return nil, fmt.Errorf("delta CRL doesn't have CRL number extension")
+	}
+	if prev.CRLNumber == "" {
+		return nil, fmt.Errorf("the imported base CRL doesn't have CRL number, can't apply delta CRLs to it")
+	}
+	imported, ok := new(big.Int).SetString(prev.CRLNumber, 10)
+	if !ok {
+		return nil, fmt.Errorf("broken CRL number in the datastore %q", prev.CRLNumber)
+	}
+	if baseNumber.Cmp(imported) > 0 {
+		logging.Warningf(c, "Delta CRL is based on CRL #%s, but CRL #%s is imported", baseNumber, imported)
+		return nil, errStaleBaseCRL
+	}
+
+	// Full and delta CRLs share a single CRL number sequence (RFC 5280 5.2.3),
+	// so a delta CRL that isn't newer than the imported CRL has nothing to add.
+	if deltaNumber.Cmp(imported) <= 0 {
+		logging.Infof(c, "Delta CRL #%s is not newer than imported CRL #%s, skipping", deltaNumber, imported)
+		return prev, nil
+	}
+
+	// Already applied this or newer delta CRL?
+	if prev.DeltaCRLNumber != "" {
+		applied, ok := new(big.Int).SetString(prev.DeltaCRLNumber, 10)
+		if !ok {
+			return nil, fmt.Errorf("broken delta CRL number in the datastore %q", prev.DeltaCRLNumber)
+		}
+		if deltaNumber.Cmp(applied) <= 0 {
+			logging.Infof(c, "Delta CRL #%s is not newer than already applied #%s, skipping", deltaNumber, applied)
+			return prev, nil
+		}
+	}
+
+	// Split entries into revoked and no longer revoked.
+	var revoked, unrevoked []*big.Int
+	for _, entry := range crl.TBSCertList.RevokedCertificates {
+		reason, err := extensionEnum(entry.Extensions, oidReasonCode)
+		if err != nil {
+			return nil, fmt.Errorf("bad reason code of entry with SN %s - %s", entry.SerialNumber, err)
+		}
+		if reason == reasonRemoveFromCRL {
+			unrevoked = append(unrevoked, entry.SerialNumber)
+		} else {
+			revoked = append(revoked, entry.SerialNumber)
+		}
+	}
+
+	// Unlike the full CRL, the delta CRL modifies the shards in place. Don't
+	// touch them if someone updated the CRL entity while we were fetching the
+	// delta CRL. This check is only an optimization: the CRL can still be
+	// updated while the shards are being modified. The transaction below detects
+	// that, and forgetCRL then makes the next fetch rebuild the shards.
+	cur := *prev
+	if err := datastore.Get(c).Get(&cur); err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+	if cur.EntityVersion != prev.EntityVersion {
+		return nil, errors.WrapTransient(fmt.Errorf("CRL for %q was updated concurrently while we were working on it", ca.CN))
+	}
+
+	logging.Infof(c, "Delta CRL #%s last updated %s", deltaNumber, crl.TBSCertList.ThisUpdate)
+	logging.Infof(c, "Found %d revoked and %d unrevoked entries in the delta CRL", len(revoked), len(unrevoked))
+	if err = model.ApplyCRLDelta(c, ca.CN, model.CRLShardCount, revoked, unrevoked); err != nil {
+		forgetCRL(c, ca.CN, prev)
+		return nil, err
+	}
+	logging.Infof(c, "All delta CRL entries applied")
+
+	// Update the CRL entity. Use EntityVersion to make sure we are not
+	// overwriting someone else's changes.
+	var updated *model.CRL
+	err = datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+		entity := *prev
+		if err := ds.Get(&entity); err != nil {
+			return err
+		}
+		if entity.EntityVersion != prev.EntityVersion {
+			return fmt.Errorf("CRL for %q was updated concurrently while we were working on it", ca.CN)
+		}
+		entity.EntityVersion++
+		entity.LastDeltaUpdateTime = crl.TBSCertList.ThisUpdate.UTC()
+		entity.LastDeltaFetchTime = clock.Now(c).UTC()
+		entity.LastDeltaFetchETag = etag
+		entity.DeltaCRLNumber = deltaNumber.String()
+		entity.DeltaRevokedCertsCount = len(crl.TBSCertList.RevokedCertificates)
+		updated = &entity // used outside of this function
+		return ds.Put(updated)
+	}, nil)
+	if err != nil {
+		forgetCRL(c, ca.CN, prev)
+		return nil, errors.WrapTransient(err)
+	}
+
+	logging.Infof(c, "Delta CRL for %q is applied, entity version is %d", ca.CN, updated.EntityVersion)
+	return updated, nil
+}
+
+// forgetCRL resets the fetch state of the CRL entity, so that the next FetchCRL
+// call refetches the full CRL (rebuilding the shards from scratch) and then
+// reapplies the latest delta CRL.
+//
+// It is called when the shards were (perhaps partially) modified, but the CRL
+// entity wasn't updated to reflect that, e.g. due to a concurrent update. The
+// shards may then be inconsistent with any CRL, which only a full reimport can
+// repair. Errors are logged and ignored.
+func forgetCRL(c context.Context, cn string, prev *model.CRL) {
+	err := datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+		entity := *prev
+		if err := ds.Get(&entity); err != nil {
+			return err
+		}
+		entity.EntityVersion++
+		entity.LastFetchETag = ""
+		entity.NextUpdateTime = time.Time{}
+		entity.LastDeltaUpdateTime = time.Time{}
+		entity.LastDeltaFetchTime = time.Time{}
+		entity.LastDeltaFetchETag = ""
+		entity.DeltaCRLNumber = ""
+		entity.DeltaRevokedCertsCount = 0
+		return ds.Put(&entity)
+	}, nil)
+	if err != nil {
+		logging.Errorf(c, "Failed to reset the CRL state of %q - %s", cn, err)
+	}
+}
+
+// hasExtension returns true if an extension with given OID is present.
+func hasExtension(exts []pkix.Extension, oid asn1.ObjectIdentifier) bool {
+	for _, ext := range exts {
+		if ext.Id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
+
+// extensionInt extracts INTEGER value of an extension.
+//
+// Returns nil if there's no such extension.
+func extensionInt(exts []pkix.Extension, oid asn1.ObjectIdentifier) (*big.Int, error) {
+	for _, ext := range exts {
+		if ext.Id.Equal(oid) {
+			val := new(big.Int)
+			if rest, err := asn1.Unmarshal(ext.Value, &val); err != nil {
+				return nil, err
+			} else if len(rest) != 0 {
+				return nil, fmt.Errorf("trailing data")
+			}
+			return val, nil
+		}
+	}
+	return nil, nil
+}
+
+// extensionEnum extracts ENUMERATED value of an extension.
+//
+// Returns 0 if there's no such extension.
+func extensionEnum(exts []pkix.Extension, oid asn1.ObjectIdentifier) (asn1.Enumerated, error) {
+	for _, ext := range exts {
+		if ext.Id.Equal(oid) {
+			var val asn1.Enumerated
+			if rest, err := asn1.Unmarshal(ext.Value, &val); err != nil {
+				return 0, err
+			} else if len(rest) != 0 {
+				return 0, fmt.Errorf("trailing data")
+			}
+			return val, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,250 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package certauthorities
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/gaetesting"
+	"github.com/luci/luci-go/common/clock/testclock"
+
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeltaCRL(t *testing.T) {
+	Convey("with fake CA", t, func() {
+		testTime := time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC)
+		ctx := gaetesting.TestingContext()
+		ctx, _ = testclock.UseTime(ctx, testTime)
+		ds := datastore.Get(ctx)
+
+		fake := newFakeCA("Fake CA", testTime)
+		ca := &model.CA{CN: "Fake CA", Cert: fake.der}
+		So(ds.Put(ca), ShouldBeNil)
+
+		isRevoked := func(sn int64) bool {
+			checker := model.NewCRLChecker("Fake CA", model.CRLShardCount, time.Second)
+			revoked, err := checker.IsRevokedSN(ctx, big.NewInt(sn))
+			So(err, ShouldBeNil)
+			return revoked
+		}
+
+		// Import base CRL #5 that revokes 1 and 2.
+		crl, err := validateAndStoreCRL(
+			ctx, fake.crl(testTime, 5, 0, []int64{1, 2}, nil), "etag",
+			ca, &model.CRL{Parent: ds.KeyForObj(ca)})
+		So(err, ShouldBeNil)
+		So(crl.CRLNumber, ShouldEqual, "5")
+		So(crl.NextUpdateTime, ShouldResemble, testTime.Add(time.Hour))
+
+		Convey("delta CRL is applied", func() {
+			// Delta CRL #6 revokes 3 and unrevokes 2.
+			updated, err := validateAndApplyDeltaCRL(
+				ctx, fake.crl(testTime, 6, 5, []int64{3}, []int64{2}), "delta-etag", ca, crl)
+			So(err, ShouldBeNil)
+			So(updated.EntityVersion, ShouldEqual, crl.EntityVersion+1)
+			So(updated.DeltaCRLNumber, ShouldEqual, "6")
+			So(updated.LastDeltaFetchETag, ShouldEqual, "delta-etag")
+			So(updated.DeltaRevokedCertsCount, ShouldEqual, 2)
+
+			So(isRevoked(1), ShouldBeTrue)
+			So(isRevoked(2), ShouldBeFalse)
+			So(isRevoked(3), ShouldBeTrue)
+
+			// Same delta CRL again is skipped.
+			again, err := validateAndApplyDeltaCRL(
+				ctx, fake.crl(testTime, 6, 5, []int64{3}, []int64{2}), "delta-etag", ca, updated)
+			So(err, ShouldBeNil)
+			So(again.EntityVersion, ShouldEqual, updated.EntityVersion)
+
+			// Reimport of the base CRL resets the delta.
+			crl, err = validateAndStoreCRL(
+				ctx, fake.crl(testTime, 5, 0, []int64{1, 2}, nil), "etag", ca, updated)
+			So(err, ShouldBeNil)
+			So(crl.DeltaCRLNumber, ShouldEqual, "")
+			So(isRevoked(2), ShouldBeTrue)
+			So(isRevoked(3), ShouldBeFalse)
+		})
+
+		Convey("concurrent update is detected before touching the shards", func() {
+			concurrent := *crl
+			So(ds.Get(&concurrent), ShouldBeNil)
+			concurrent.EntityVersion++
+			So(ds.Put(&concurrent), ShouldBeNil)
+
+			_, err := validateAndApplyDeltaCRL(
+				ctx, fake.crl(testTime, 6, 5, []int64{3}, []int64{2}), "delta-etag", ca, crl)
+			So(err, ShouldErrLike, "was updated concurrently")
+			So(isRevoked(2), ShouldBeTrue)
+			So(isRevoked(3), ShouldBeFalse)
+		})
+
+		Convey("forgetCRL forces the full CRL to be refetched", func() {
+			updated, err := validateAndApplyDeltaCRL(
+				ctx, fake.crl(testTime, 6, 5, []int64{3}, []int64{2}), "delta-etag", ca, crl)
+			So(err, ShouldBeNil)
+
+			forgetCRL(ctx, ca.CN, updated)
+			forgotten := *updated
+			So(ds.Get(&forgotten), ShouldBeNil)
+			So(forgotten.EntityVersion, ShouldEqual, updated.EntityVersion+1)
+			So(forgotten.LastFetchETag, ShouldEqual, "")
+			So(forgotten.NextUpdateTime.IsZero(), ShouldBeTrue)
+			So(forgotten.DeltaCRLNumber, ShouldEqual, "")
+			So(forgotten.LastDeltaFetchETag, ShouldEqual, "")
+		})
+
+		Convey("delta CRL not newer than the imported CRL is skipped", func() {
+			skipped, err := validateAndApplyDeltaCRL(
+				ctx, fake.crl(testTime, 5, 4, []int64{3}, []int64{2}), "delta-etag", ca, crl)
+			So(err, ShouldBeNil)
+			So(skipped.EntityVersion, ShouldEqual, crl.EntityVersion)
+			So(skipped.DeltaCRLNumber, ShouldEqual, "")
+
+			So(isRevoked(2), ShouldBeTrue)
+			So(isRevoked(3), ShouldBeFalse)
+		})
+
+		Convey("delta CRL based on newer CRL is rejected", func() {
+			_, err := validateAndApplyDeltaCRL(
+				ctx, fake.crl(testTime, 8, 7, []int64{3}, nil), "", ca, crl)
+			So(err, ShouldEqual, errStaleBaseCRL)
+		})
+
+		Convey("full CRL is not accepted as delta", func() {
+			_, err := validateAndApplyDeltaCRL(
+				ctx, fake.crl(testTime, 6, 0, []int64{3}, nil), "", ca, crl)
+			So(err, ShouldErrLike, "expecting a delta CRL, got a full CRL")
+		})
+
+		Convey("delta CRL is not accepted as full", func() {
+			_, err := validateAndStoreCRL(
+				ctx, fake.crl(testTime, 6, 5, []int64{3}, nil), "", ca, crl)
+			So(err, ShouldErrLike, "expecting a full CRL, got a delta CRL")
+		})
+
+		Convey("delta CRL must be signed by the CA", func() {
+			another := newFakeCA("Fake CA", testTime)
+			_, err := validateAndApplyDeltaCRL(
+				ctx, another.crl(testTime, 6, 5, []int64{3}, nil), "", ca, crl)
+			So(err, ShouldErrLike, "delta CRL is not signed by the CA")
+		})
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// fakeCA is a self-signed CA that can sign CRLs.
+type fakeCA struct {
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+	der  []byte
+}
+
+// newFakeCA generates a new CA key and certificate.
+func newFakeCA(cn string, now time.Time) *fakeCA {
+	key, err := rsa.GenerateKey(rand.Reader, 512) // use short key in tests
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             now,
+		NotAfter:              now.Add(30 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		panic(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+	return &fakeCA{key: key, cert: cert, der: der}
+}
+
+// crl generates der-encoded CRL with CRL number extension.
+//
+// If 'deltaBase' is not 0, the CRL is a delta CRL based on CRL with that
+// number. 'unrevoked' entries are marked with removeFromCRL reason code.
+func (ca *fakeCA) crl(now time.Time, number, deltaBase int64, revoked, unrevoked []int64) []byte {
+	mustMarshal := func(val interface{}) []byte {
+		blob, err := asn1.Marshal(val)
+		if err != nil {
+			panic(err)
+		}
+		return blob
+	}
+
+	var issuer pkix.RDNSequence
+	if _, err := asn1.Unmarshal(ca.cert.RawSubject, &issuer); err != nil {
+		panic(err)
+	}
+	sigAlgo := pkix.AlgorithmIdentifier{
+		Algorithm:  asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}, // sha256WithRSAEncryption
+		Parameters: asn1.RawValue{Tag: asn1.TagNull},
+	}
+
+	tbs := pkix.TBSCertificateList{
+		Version:    1, // v2
+		Signature:  sigAlgo,
+		Issuer:     issuer,
+		ThisUpdate: now,
+		NextUpdate: now.Add(time.Hour),
+		Extensions: []pkix.Extension{
+			{Id: oidCRLNumber, Value: mustMarshal(big.NewInt(number))},
+		},
+	}
+	if deltaBase != 0 {
+		tbs.Extensions = append(tbs.Extensions, pkix.Extension{
+			Id:       oidDeltaCRLIndicator,
+			Critical: true,
+			Value:    mustMarshal(big.NewInt(deltaBase)),
+		})
+	}
+	for _, sn := range revoked {
+		tbs.RevokedCertificates = append(tbs.RevokedCertificates, pkix.RevokedCertificate{
+			SerialNumber:   big.NewInt(sn),
+			RevocationTime: now,
+		})
+	}
+	for _, sn := range unrevoked {
+		tbs.RevokedCertificates = append(tbs.RevokedCertificates, pkix.RevokedCertificate{
+			SerialNumber:   big.NewInt(sn),
+			RevocationTime: now,
+			Extensions: []pkix.Extension{
+				{Id: oidReasonCode, Value: mustMarshal(asn1.Enumerated(reasonRemoveFromCRL))},
+			},
+		})
+	}
+
+	digest := sha256.Sum256(mustMarshal(tbs))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, ca.key, crypto.SHA256, digest[:])
+	if err != nil {
+		panic(err)
+	}
+	return mustMarshal(pkix.CertificateList{
+		TBSCertList:        tbs,
+		SignatureAlgorithm: sigAlgo,
+		SignatureValue:     asn1.BitString{Bytes: sig, BitLength: len(sig) * 8},
+	})
+}
@@ -35,6 +35,8 @@ import (
 	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
 
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/certchecker"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/delegation"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/jwtchecker"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/utils"
 )
@@ -62,6 +64,9 @@ func (s *Server) ImportConfig(c context.Context, req *admin.ImportConfigRequest)
 	if err = proto.UnmarshalText(cfg.Content, &msg); err != nil {
 		return nil, grpc.Errorf(codes.Internal, "can't parse config file - %s", err)
 	}
+	if err = delegation.ValidateRules(msg.DelegationRule); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "bad delegation rules - %s", err)
+	}
 
 	seenIDs, err := model.LoadCAUniqueIDToCNMap(c)
 	if err != nil {
@@ -92,6 +97,13 @@ func (s *Server) ImportConfig(c context.Context, req *admin.ImportConfigRequest)
 		}
 	}
 
+	// JWT issuers must not reuse CA IDs, since both are put into ca_id field of
+	// machine tokens.
+	jwtIssuers, err := fetchJWTIssuers(c, req, msg.JwtIssuer, seenIDs)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "bad JWT issuers - %s", err)
+	}
+
 	// Update the mapping CA unique_id -> CA CN. Unique integer ids are used in
 	// various tokens in place of a full CN name to save space. This mapping is
 	// additive (all new CAs should have different IDs).
@@ -153,6 +165,14 @@ func (s *Server) ImportConfig(c context.Context, req *admin.ImportConfigRequest)
 		return nil, grpc.Errorf(codes.Internal, "datastore error - %s", err)
 	}
 
+	// JWT issuers and delegation rules are replaced as a whole.
+	if err = model.StoreJWTIssuers(c, jwtIssuers, cfg.Revision); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "can't store JWT issuers - %s", err)
+	}
+	if err = model.StoreDelegationRules(c, msg.DelegationRule, cfg.Revision); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "can't store delegation rules - %s", err)
+	}
+
 	return &admin.ImportConfigResponse{
 		Revision: cfg.Revision,
 	}, nil
@@ -186,36 +206,111 @@ func (s *Server) FetchCRL(c context.Context, r *admin.FetchCRLRequest) (*admin.F
 		return nil, grpc.Errorf(codes.Internal, "datastore error - %s", err)
 	}
 
-	// Fetch latest CRL blob.
+	// If the CA publishes delta CRLs, the base CRL needs to be refetched only
+	// when it expires.
+	fetchBase := cfg.DeltaCrlUrl == "" || r.Force || !clock.Now(c).Before(crl.NextUpdateTime)
+	baseChanged := false
+	if fetchBase {
+		if crl, baseChanged, err = s.fetchBaseCRL(c, ca, cfg, crl, r.Force); err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply the delta on top of the base CRL. It must be reapplied if the base
+	// CRL has changed.
+	if cfg.DeltaCrlUrl != "" {
+		updated, err := s.fetchDeltaCRL(c, ca, cfg, crl, r.Force || baseChanged)
+		if err == errStaleBaseCRL && !fetchBase {
+			logging.Warningf(c, "Refetching the base CRL for %q", ca.CN)
+			if crl, _, err = s.fetchBaseCRL(c, ca, cfg, crl, false); err != nil {
+				return nil, err
+			}
+			updated, err = s.fetchDeltaCRL(c, ca, cfg, crl, true)
+		}
+		switch {
+		case err == errStaleBaseCRL:
+			return nil, grpc.Errorf(codes.Unknown, "bad delta CRL - %s", err)
+		case err != nil:
+			return nil, err
+		}
+		crl = updated
+	}
+
+	return &admin.FetchCRLResponse{CrlStatus: crl.GetStatusProto()}, nil
+}
+
+// fetchBaseCRL fetches the full CRL and rebuilds the sharded CRL set.
+//
+// Returns the updated CRL entity and true if the CRL has changed. Errors are
+// gRPC errors.
+func (s *Server) fetchBaseCRL(c context.Context, ca *model.CA, cfg *admin.CertificateAuthorityConfig, crl *model.CRL, force bool) (*model.CRL, bool, error) {
 	logging.Infof(c, "Fetching CRL for %q from %s", ca.CN, cfg.CrlUrl)
 	knownETag := crl.LastFetchETag
-	if r.Force {
+	if force {
 		knownETag = ""
 	}
 	fetchCtx, _ := clock.WithTimeout(c, time.Minute)
-	crlDer, newEtag, err := fetchCRL(fetchCtx, cfg, knownETag)
+	crlDer, newEtag, err := fetchCRL(fetchCtx, cfg, cfg.CrlUrl, knownETag)
 	switch {
 	case errors.IsTransient(err):
-		return nil, grpc.Errorf(codes.Internal, "transient error when fetching CRL - %s", err)
+		return nil, false, grpc.Errorf(codes.Internal, "transient error when fetching CRL - %s", err)
 	case err != nil:
-		return nil, grpc.Errorf(codes.Unknown, "can't fetch CRL - %s", err)
+		return nil, false, grpc.Errorf(codes.Unknown, "can't fetch CRL - %s", err)
 	}
 
 	// No changes?
 	if knownETag != "" && knownETag == newEtag {
 		logging.Infof(c, "No changes to CRL (etag is %s), skipping", knownETag)
-	} else {
-		logging.Infof(c, "Fetched CRL size is %d bytes, etag is %s", len(crlDer), newEtag)
-		crl, err = validateAndStoreCRL(c, crlDer, newEtag, ca, crl)
-		switch {
-		case errors.IsTransient(err):
-			return nil, grpc.Errorf(codes.Internal, "transient error when storing CRL - %s", err)
-		case err != nil:
-			return nil, grpc.Errorf(codes.Unknown, "bad CRL - %s", err)
-		}
+		return crl, false, nil
 	}
 
-	return &admin.FetchCRLResponse{CrlStatus: crl.GetStatusProto()}, nil
+	logging.Infof(c, "Fetched CRL size is %d bytes, etag is %s", len(crlDer), newEtag)
+	crl, err = validateAndStoreCRL(c, crlDer, newEtag, ca, crl)
+	switch {
+	case errors.IsTransient(err):
+		return nil, false, grpc.Errorf(codes.Internal, "transient error when storing CRL - %s", err)
+	case err != nil:
+		return nil, false, grpc.Errorf(codes.Unknown, "bad CRL - %s", err)
+	}
+	return crl, true, nil
+}
+
+// fetchDeltaCRL fetches the delta CRL and applies it to the sharded CRL set.
+//
+// Returns the updated CRL entity. Errors are gRPC errors, except
+// errStaleBaseCRL which is returned as is.
+func (s *Server) fetchDeltaCRL(c context.Context, ca *model.CA, cfg *admin.CertificateAuthorityConfig, crl *model.CRL, force bool) (*model.CRL, error) {
+	logging.Infof(c, "Fetching delta CRL for %q from %s", ca.CN, cfg.DeltaCrlUrl)
+	knownETag := crl.LastDeltaFetchETag
+	if force {
+		knownETag = ""
+	}
+	fetchCtx, _ := clock.WithTimeout(c, time.Minute)
+	crlDer, newEtag, err := fetchCRL(fetchCtx, cfg, cfg.DeltaCrlUrl, knownETag)
+	switch {
+	case errors.IsTransient(err):
+		return nil, grpc.Errorf(codes.Internal, "transient error when fetching delta CRL - %s", err)
+	case err != nil:
+		return nil, grpc.Errorf(codes.Unknown, "can't fetch delta CRL - %s", err)
+	}
+
+	// No changes?
+	if knownETag != "" && knownETag == newEtag {
+		logging.Infof(c, "No changes to delta CRL (etag is %s), skipping", knownETag)
+		return crl, nil
+	}
+
+	logging.Infof(c, "Fetched delta CRL size is %d bytes, etag is %s", len(crlDer), newEtag)
+	crl, err = validateAndApplyDeltaCRL(c, crlDer, newEtag, ca, crl)
+	switch {
+	case err == errStaleBaseCRL:
+		return nil, err
+	case errors.IsTransient(err):
+		return nil, grpc.Errorf(codes.Internal, "transient error when applying delta CRL - %s", err)
+	case err != nil:
+		return nil, grpc.Errorf(codes.Unknown, "bad delta CRL - %s", err)
+	}
+	return crl, nil
 }
 
 // ListCAs returns a list of Common Names of registered CAs.
@@ -368,6 +463,44 @@ func fetchConfigFile(c context.Context, req *admin.ImportConfigRequest, path str
 	return cfg.GetConfig("services/"+inf.AppID(), path, false)
 }
 
+// fetchJWTIssuers validates JWT issuer configs and fetches their JWKS files.
+//
+// 'caIDs' is a mapping of CA unique IDs to CA names, JWT issuers must not reuse
+// them.
+func fetchJWTIssuers(c context.Context, req *admin.ImportConfigRequest, issuers []*admin.JWTIssuerConfig, caIDs map[int64]string) ([]model.JWTIssuer, error) {
+	seenIssuers := stringset.New(len(issuers))
+	seenIDs := map[int64]bool{}
+	out := make([]model.JWTIssuer, 0, len(issuers))
+	for _, iss := range issuers {
+		switch {
+		case iss.Issuer == "":
+			return nil, fmt.Errorf("issuer is required")
+		case !seenIssuers.Add(iss.Issuer):
+			return nil, fmt.Errorf("duplicate issuer %q", iss.Issuer)
+		case len(iss.Audience) == 0:
+			return nil, fmt.Errorf("issuer %q: audience is required", iss.Issuer)
+		case caIDs[iss.UniqueId] != "":
+			return nil, fmt.Errorf("issuer %q: unique_id %d is used by CA %q", iss.Issuer, iss.UniqueId, caIDs[iss.UniqueId])
+		case seenIDs[iss.UniqueId]:
+			return nil, fmt.Errorf("issuer %q: duplicate unique_id %d", iss.Issuer, iss.UniqueId)
+		}
+		seenIDs[iss.UniqueId] = true
+
+		jwksCfg, err := fetchConfigFile(c, req, iss.JwksPath)
+		if err != nil {
+			return nil, fmt.Errorf("issuer %q: can't fetch %q - %s", iss.Issuer, iss.JwksPath, err)
+		}
+		if _, err := jwtchecker.ParseKeySet([]byte(jwksCfg.Content)); err != nil {
+			return nil, fmt.Errorf("issuer %q: %s", iss.Issuer, err)
+		}
+		out = append(out, model.JWTIssuer{
+			Config: iss,
+			JWKS:   []byte(jwksCfg.Content),
+		})
+	}
+	return out, nil
+}
+
 // importCA imports CA definition from the config (or updates an existing one).
 func (s *Server) importCA(c context.Context, ca *admin.CertificateAuthorityConfig, certPem string, rev string) error {
 	// Read CA certificate file, convert it to der.
@@ -388,6 +521,17 @@ func (s *Server) importCA(c context.Context, ca *admin.CertificateAuthorityConfi
 		return fmt.Errorf("bad CN in the certificate, expecting %q, got %q", ca.Cn, cert.Subject.CommonName)
 	}
 
+	// Check revocation checking options make sense.
+	if ca.DeltaCrlUrl != "" && ca.CrlUrl == "" {
+		return fmt.Errorf("delta_crl_url requires crl_url")
+	}
+	if ca.OcspUrl != "" && !ca.UseOcsp {
+		return fmt.Errorf("ocsp_url requires use_ocsp")
+	}
+
+	// CAs that rely only on OCSP have no CRL to wait for.
+	ocspOnly := ca.CrlUrl == "" && ca.UseOcsp
+
 	// Serialize the config back to proto to store it in the entity.
 	cfgBlob, err := proto.Marshal(ca)
 	if err != nil {
@@ -411,10 +555,12 @@ func (s *Server) importCA(c context.Context, ca *admin.CertificateAuthorityConfi
 				Cert:       certDer,
 				AddedRev:   rev,
 				UpdatedRev: rev,
+				Ready:      ocspOnly,
 			})
 		}
 		// Exists already? Check whether we should update it.
 		if !existing.Removed &&
+			(existing.Ready || !ocspOnly) &&
 			bytes.Equal(existing.Config, cfgBlob) &&
 			bytes.Equal(existing.Cert, certDer) {
 			return nil
@@ -423,6 +569,7 @@ func (s *Server) importCA(c context.Context, ca *admin.CertificateAuthorityConfi
 		existing.Config = cfgBlob
 		existing.Cert = certDer
 		existing.Removed = false
+		existing.Ready = existing.Ready || ocspOnly
 		existing.UpdatedRev = rev
 		existing.RemovedRev = ""
 		return ds.Put(&existing)
@@ -10,10 +10,13 @@ import (
 	"net/http/httptest"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/luci/gae/service/datastore"
 	"github.com/luci/gae/service/urlfetch"
 	"github.com/luci/luci-go/appengine/gaetesting"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
 
 	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
 
@@ -188,6 +191,133 @@ func TestImportConfig(t *testing.T) {
 		`))
 		So(err, ShouldErrLike, "bad CN in the certificat")
 	})
+
+	Convey("imports delegation rules", t, func() {
+		ctx := gaetesting.TestingContext()
+		srv := &Server{}
+		_, err := srv.ImportConfig(ctx, prepareCfg(`
+			delegation_rule {
+				name: "rule"
+				requestor: "group:requestors"
+				allowed_to_impersonate: "REQUESTOR"
+				allowed_audience: "*"
+				max_validity_duration: 3600
+			}
+		`))
+		So(err, ShouldBeNil)
+
+		rules, err := model.LoadDelegationRules(ctx)
+		So(err, ShouldBeNil)
+		So(rules, ShouldResemble, []*admin.DelegationRule{
+			{
+				Name:                 "rule",
+				Requestor:            []string{"group:requestors"},
+				AllowedToImpersonate: []string{"REQUESTOR"},
+				AllowedAudience:      []string{"*"},
+				MaxValidityDuration:  3600,
+			},
+		})
+	})
+
+	Convey("imports JWT issuers", t, func() {
+		ctx := gaetesting.TestingContext()
+		srv := &Server{}
+		_, err := srv.ImportConfig(ctx, prepareCfg(`
+			jwt_issuer {
+				unique_id: 10
+				issuer: "https://issuer.example.com"
+				jwks_path: "jwks/issuer.json"
+				audience: "token-server"
+			}
+		`))
+		So(err, ShouldBeNil)
+
+		issuers, err := model.LoadJWTIssuers(ctx)
+		So(err, ShouldBeNil)
+		So(issuers, ShouldHaveLength, 1)
+		So(issuers[0].Config.Issuer, ShouldEqual, "https://issuer.example.com")
+		So(string(issuers[0].JWKS), ShouldEqual, fakeJWKS)
+	})
+
+	Convey("rejects JWT issuers reusing CA IDs", t, func() {
+		ctx := gaetesting.TestingContext()
+		srv := &Server{}
+		_, err := srv.ImportConfig(ctx, prepareCfg(`
+			certificate_authority {
+				unique_id: 10
+				cn: "Puppet CA: fake.ca"
+				cert_path: "certs/fake.ca.crt"
+			}
+			jwt_issuer {
+				unique_id: 10
+				issuer: "https://issuer.example.com"
+				jwks_path: "jwks/issuer.json"
+				audience: "token-server"
+			}
+		`))
+		So(err, ShouldErrLike, "unique_id 10 is used by CA")
+	})
+
+	Convey("rejects JWT issuers with bad JWKS", t, func() {
+		ctx := gaetesting.TestingContext()
+		srv := &Server{}
+		_, err := srv.ImportConfig(ctx, prepareCfg(`
+			jwt_issuer {
+				unique_id: 10
+				issuer: "https://issuer.example.com"
+				jwks_path: "certs/fake.ca.crt"
+				audience: "token-server"
+			}
+		`))
+		So(err, ShouldErrLike, "can't parse JWKS")
+	})
+
+	Convey("rejects bad delegation rules", t, func() {
+		ctx := gaetesting.TestingContext()
+		srv := &Server{}
+		_, err := srv.ImportConfig(ctx, prepareCfg(`
+			delegation_rule {
+				name: "rule"
+				requestor: "group:requestors"
+				allowed_to_impersonate: "REQUESTOR"
+				allowed_audience: "*"
+			}
+		`))
+		So(err, ShouldErrLike, "bad delegation rules")
+	})
+
+	Convey("delta_crl_url requires crl_url", t, func() {
+		ctx := gaetesting.TestingContext()
+		srv := &Server{}
+		_, err := srv.ImportConfig(ctx, prepareCfg(`
+			certificate_authority {
+				cn: "Puppet CA: fake.ca"
+				cert_path: "certs/fake.ca.crt"
+				delta_crl_url: "https://blah"
+			}
+		`))
+		So(err, ShouldErrLike, "delta_crl_url requires crl_url")
+	})
+
+	Convey("OCSP-only CA is ready right away", t, func() {
+		ctx := gaetesting.TestingContext()
+		srv := &Server{}
+		_, err := srv.ImportConfig(ctx, prepareCfg(`
+			certificate_authority {
+				cn: "Puppet CA: fake.ca"
+				cert_path: "certs/fake.ca.crt"
+				use_ocsp: true
+			}
+		`))
+		So(err, ShouldBeNil)
+
+		resp, err := srv.GetCAStatus(ctx, &admin.GetCAStatusRequest{
+			Cn: "Puppet CA: fake.ca",
+		})
+		So(err, ShouldBeNil)
+		So(resp.Ready, ShouldBeTrue)
+		So(resp.Config.UseOcsp, ShouldBeTrue)
+	})
 }
 
 func TestFetchCRL(t *testing.T) {
@@ -324,6 +454,102 @@ func TestFetchCRL(t *testing.T) {
 		So(crl.LastFetchETag, ShouldEqual, `"etag2"`)
 		So(crl.EntityVersion, ShouldEqual, 2)
 	})
+
+	Convey("FetchCRL works (with delta CRL)", t, func() {
+		testTime := time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC)
+		fake := newFakeCA("Fake CA", testTime)
+
+		base := serveCRL()
+		defer base.Close()
+		delta := serveCRL()
+		defer delta.Close()
+
+		ctx := gaetesting.TestingContext()
+		ctx = urlfetch.Set(ctx, http.DefaultTransport) // mock URLFetch service
+		ctx, clk := testclock.UseTime(ctx, testTime)
+
+		srv := &Server{}
+
+		// Prepare config.
+		req := prepareCfg(fmt.Sprintf(`
+			certificate_authority {
+				cn: "Fake CA"
+				cert_path: "certs/generated.crt"
+				crl_url: %q
+				delta_crl_url: %q
+			}
+		`, base.URL, delta.URL))
+		req.DevConfig["certs/generated.crt"] = utils.DumpPEM(fake.der, "CERTIFICATE")
+		_, err := srv.ImportConfig(ctx, req)
+		So(err, ShouldBeNil)
+
+		setCRL := func(s *crlServer, der []byte) {
+			s.Lock.Lock()
+			defer s.Lock.Unlock()
+			s.CRL = utils.DumpPEM(der, "X509 CRL")
+		}
+		fetch := func() *admin.CRLStatus {
+			resp, err := srv.FetchCRL(ctx, &admin.FetchCRLRequest{Cn: "Fake CA"})
+			So(err, ShouldBeNil)
+			return resp.CrlStatus
+		}
+		isRevoked := func(sn string) bool {
+			resp, err := srv.IsRevokedCert(ctx, &admin.IsRevokedCertRequest{Ca: "Fake CA", Sn: sn})
+			So(err, ShouldBeNil)
+			return resp.Revoked
+		}
+		crlEntity := func() *model.CRL {
+			ds := datastore.Get(ctx)
+			crl := &model.CRL{Parent: ds.NewKey("CA", "Fake CA", 0, nil)}
+			So(ds.Get(crl), ShouldBeNil)
+			return crl
+		}
+
+		// Initial import fetches both CRLs.
+		setCRL(base, fake.crl(testTime, 5, 0, []int64{1, 2}, nil))
+		setCRL(delta, fake.crl(testTime, 6, 5, []int64{3}, []int64{2}))
+		status := fetch()
+		So(status.RevokedCertsCount, ShouldEqual, 2)
+		So(status.DeltaRevokedCertsCount, ShouldEqual, 2)
+		So(isRevoked("1"), ShouldBeTrue)
+		So(isRevoked("2"), ShouldBeFalse)
+		So(isRevoked("3"), ShouldBeTrue)
+
+		// The base CRL isn't refetched until it expires, only the delta is.
+		setCRL(base, fake.crl(testTime, 7, 0, []int64{1}, nil))
+		setCRL(delta, fake.crl(testTime, 7, 5, []int64{3, 4}, []int64{2}))
+		fetch()
+		So(crlEntity().CRLNumber, ShouldEqual, "5")
+		So(crlEntity().DeltaCRLNumber, ShouldEqual, "7")
+
+		// The delta is based on a newer base CRL, the base CRL is refetched.
+		setCRL(delta, fake.crl(testTime, 8, 7, []int64{5}, nil))
+		fetch()
+		So(crlEntity().CRLNumber, ShouldEqual, "7")
+		So(crlEntity().DeltaCRLNumber, ShouldEqual, "8")
+
+		// The base CRL is refetched if the shards may be inconsistent.
+		setCRL(base, fake.crl(testTime, 8, 0, []int64{1, 5}, nil))
+		forgetCRL(ctx, "Fake CA", crlEntity())
+		fetch()
+		So(crlEntity().CRLNumber, ShouldEqual, "8")
+		So(crlEntity().DeltaCRLNumber, ShouldEqual, "")
+		clk.Add(time.Minute)
+		So(isRevoked("5"), ShouldBeTrue)
+
+		// The base CRL is refetched when it expires.
+		clk.Add(2 * time.Hour)
+		setCRL(base, fake.crl(clock.Now(ctx), 9, 0, []int64{1, 6}, nil))
+		setCRL(delta, fake.crl(clock.Now(ctx), 10, 9, nil, nil))
+		fetch()
+		So(crlEntity().CRLNumber, ShouldEqual, "9")
+		So(crlEntity().DeltaCRLNumber, ShouldEqual, "10")
+
+		// IsRevokedCert caches shards for a while.
+		clk.Add(time.Minute)
+		So(isRevoked("5"), ShouldBeFalse)
+		So(isRevoked("6"), ShouldBeTrue)
+	})
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -419,6 +645,14 @@ PkoYH9WC8tSbqNof3g==
 -----END CERTIFICATE-----
 `
 
+// fakeJWKS is JSON Web Key Set with some RSA key.
+const fakeJWKS = `{"keys": [{
+  "kty": "RSA",
+  "kid": "key-1",
+  "n": "wRt5s71_vlHx_o_IpqjxvAJCS6YpGXHqrF76biMOhVQ7GpRnmIsQUAbwQyqydzGLvx1tc9T4CVWaDIqJjERFnA",
+  "e": "AQAB"
+}]}`
+
 // prepareCfg makes ImportConfigRequest with a bunch of config files.
 func prepareCfg(configFile string) *admin.ImportConfigRequest {
 	return &admin.ImportConfigRequest{
@@ -426,6 +660,7 @@ func prepareCfg(configFile string) *admin.ImportConfigRequest {
 			"tokenserver.cfg":           configFile,
 			"certs/fake.ca.crt":         fakeCACrt,
 			"certs/another-fake.ca.crt": anotherFakeCACrt,
+			"jwks/issuer.json":          fakeJWKS,
 		},
 	}
 }
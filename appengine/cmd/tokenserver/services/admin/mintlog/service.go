@@ -0,0 +1,166 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mintlog implements MintLog API.
+//
+// Code defined here is invoked by an administrator to examine the audit log of
+// token minting.
+package mintlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/luci-go/common/errors"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
+)
+
+const (
+	// defaultLimit is used if the request doesn't specify the limit.
+	defaultLimit = 100
+
+	// maxLimit is the maximum number of entries returned per request.
+	maxLimit = 1000
+)
+
+// Server implements admin.MintLogServer RPC interface.
+//
+// It assumes authorization has happened already.
+type Server struct {
+}
+
+// QueryMintLog returns log entries matching the query, most recent first.
+func (s *Server) QueryMintLog(c context.Context, r *admin.QueryMintLogRequest) (*admin.QueryMintLogResponse, error) {
+	entries, cursor, err := s.query(c, r)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.QueryMintLogResponse{
+		Entries: make([]*admin.MintLogEntry, len(entries)),
+		Cursor:  cursor,
+	}
+	for i, e := range entries {
+		resp.Entries[i] = e.ToProto()
+	}
+	return resp, nil
+}
+
+// ExportMintLog returns log entries matching the query as newline-delimited
+// JSON.
+func (s *Server) ExportMintLog(c context.Context, r *admin.QueryMintLogRequest) (*admin.ExportMintLogResponse, error) {
+	entries, cursor, err := s.query(c, r)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.Buffer{}
+	enc := json.NewEncoder(&buf) // appends "\n" after each entry
+	for _, e := range entries {
+		if err := enc.Encode(toExported(e)); err != nil {
+			return nil, grpc.Errorf(codes.Internal, "can't serialize log entry - %s", err)
+		}
+	}
+	return &admin.ExportMintLogResponse{
+		Ndjson: buf.String(),
+		Cursor: cursor,
+	}, nil
+}
+
+// query validates the request and fetches log entries from the datastore.
+func (s *Server) query(c context.Context, r *admin.QueryMintLogRequest) ([]*model.MintLogEntry, string, error) {
+	if err := validateRequest(r); err != nil {
+		return nil, "", grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	q := &model.MintLogQuery{
+		FQDN:   r.Fqdn,
+		CA:     r.Ca,
+		CertSN: r.CertSn,
+	}
+	if r.Since != nil {
+		q.Since = r.Since.Time()
+	}
+	if r.Until != nil {
+		q.Until = r.Until.Time()
+	}
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	entries, cursor, err := model.QueryMintLog(c, q, limit, r.Cursor)
+	switch {
+	case errors.IsTransient(err):
+		return nil, "", grpc.Errorf(codes.Internal, "datastore error - %s", err)
+	case err != nil:
+		return nil, "", grpc.Errorf(codes.InvalidArgument, "bad cursor - %s", err)
+	}
+	return entries, cursor, nil
+}
+
+// validateRequest checks that the request uses supported combination of
+// filters.
+//
+// Each combination requires a composite index, see index.yaml.
+func validateRequest(r *admin.QueryMintLogRequest) error {
+	switch {
+	case r.Limit < 0 || r.Limit > maxLimit:
+		return fmt.Errorf("limit must be in range [0, %d], got %d", maxLimit, r.Limit)
+	case r.Fqdn != "" && (r.Ca != "" || r.CertSn != 0):
+		return fmt.Errorf("'fqdn' filter can't be combined with 'ca' or 'cert_sn'")
+	case r.Since != nil && r.Until != nil && !r.Since.Time().Before(r.Until.Time()):
+		return fmt.Errorf("'since' must be before 'until'")
+	}
+	return nil
+}
+
+// exportedEntry is JSON representation of model.MintLogEntry.
+//
+// Timestamps are in RFC3339 format, empty fields are omitted.
+type exportedEntry struct {
+	Timestamp      string   `json:"timestamp"`
+	RPC            string   `json:"rpc"`
+	TokenType      string   `json:"token_type,omitempty"`
+	PeerIP         string   `json:"peer_ip,omitempty"`
+	FQDN           string   `json:"fqdn,omitempty"`
+	CA             string   `json:"ca,omitempty"`
+	CaID           int64    `json:"ca_id,omitempty"`
+	CertSN         string   `json:"cert_sn,omitempty"`
+	OAuth2Scopes   []string `json:"oauth2_scopes,omitempty"`
+	ErrorCode      string   `json:"error_code"`
+	ErrorMessage   string   `json:"error_message,omitempty"`
+	ServiceAccount string   `json:"service_account,omitempty"`
+	TokenExpiry    string   `json:"token_expiry,omitempty"`
+	TokenSHA256    string   `json:"token_sha256,omitempty"`
+	ServiceVersion string   `json:"service_version,omitempty"`
+}
+
+func toExported(e *model.MintLogEntry) *exportedEntry {
+	out := &exportedEntry{
+		Timestamp:      e.Timestamp.UTC().Format(time.RFC3339Nano),
+		RPC:            e.RPC,
+		TokenType:      e.TokenType,
+		PeerIP:         e.PeerIP,
+		FQDN:           e.FQDN,
+		CA:             e.CA,
+		CaID:           e.CaID,
+		CertSN:         e.CertSN,
+		OAuth2Scopes:   e.OAuth2Scopes,
+		ErrorCode:      e.ErrorCode,
+		ErrorMessage:   e.ErrorMessage,
+		ServiceAccount: e.ServiceAccount,
+		TokenSHA256:    e.TokenSHA256,
+		ServiceVersion: e.ServiceVersion,
+	}
+	if !e.TokenExpiry.IsZero() {
+		out.TokenExpiry = e.TokenExpiry.UTC().Format(time.RFC3339Nano)
+	}
+	return out
+}
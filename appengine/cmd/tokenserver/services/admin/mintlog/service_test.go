@@ -0,0 +1,123 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mintlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/gaetesting"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/proto/google"
+
+	"github.com/luci/luci-go/common/api/tokenserver/admin/v1"
+
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMintLog(t *testing.T) {
+	Convey("with mock context", t, func() {
+		testTime := time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC)
+		ctx := gaetesting.TestingContext()
+		ctx, clk := testclock.UseTime(ctx, testTime)
+		datastore.Get(ctx).Testable().Consistent(true)
+
+		srv := &Server{}
+
+		So(model.RecordMint(ctx, &model.MintLogEntry{
+			Timestamp: testTime,
+			RPC:       "MintMachineToken",
+			TokenType: "LUCI_MACHINE_TOKEN",
+			FQDN:      "a.domain",
+			CA:        "Fake CA",
+			CaID:      123,
+			CertSN:    "4096",
+			ErrorCode: "SUCCESS",
+		}), ShouldBeNil)
+		clk.Add(time.Minute)
+		So(model.RecordMint(ctx, &model.MintLogEntry{
+			Timestamp:    testTime.Add(time.Minute),
+			RPC:          "MintMachineToken",
+			TokenType:    "LUCI_MACHINE_TOKEN",
+			FQDN:         "b.domain",
+			ErrorCode:    "BAD_TOKEN_ARGUMENTS",
+			ErrorMessage: "boom",
+		}), ShouldBeNil)
+
+		Convey("QueryMintLog works", func() {
+			resp, err := srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{})
+			So(err, ShouldBeNil)
+			So(len(resp.Entries), ShouldEqual, 2)
+			So(resp.Entries[0].Fqdn, ShouldEqual, "b.domain")
+			So(resp.Entries[1], ShouldResemble, &admin.MintLogEntry{
+				Timestamp: google.NewTimestamp(testTime),
+				Rpc:       "MintMachineToken",
+				TokenType: "LUCI_MACHINE_TOKEN",
+				Fqdn:      "a.domain",
+				Ca:        "Fake CA",
+				CaId:      123,
+				CertSn:    4096,
+				ErrorCode: "SUCCESS",
+			})
+		})
+
+		Convey("QueryMintLog pagination", func() {
+			resp, err := srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{Limit: 1})
+			So(err, ShouldBeNil)
+			So(len(resp.Entries), ShouldEqual, 1)
+			So(resp.Entries[0].Fqdn, ShouldEqual, "b.domain")
+
+			resp, err = srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{
+				Limit:  1,
+				Cursor: resp.Cursor,
+			})
+			So(err, ShouldBeNil)
+			So(len(resp.Entries), ShouldEqual, 1)
+			So(resp.Entries[0].Fqdn, ShouldEqual, "a.domain")
+		})
+
+		Convey("QueryMintLog time range", func() {
+			resp, err := srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{
+				Since: google.NewTimestamp(testTime.Add(30 * time.Second)),
+			})
+			So(err, ShouldBeNil)
+			So(len(resp.Entries), ShouldEqual, 1)
+			So(resp.Entries[0].Fqdn, ShouldEqual, "b.domain")
+		})
+
+		Convey("QueryMintLog validates the request", func() {
+			_, err := srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{Limit: 1001})
+			So(err, ShouldErrLike, "limit must be in range")
+
+			_, err = srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{Fqdn: "a.domain", Ca: "Fake CA"})
+			So(err, ShouldErrLike, "'fqdn' filter can't be combined")
+
+			_, err = srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{
+				Since: google.NewTimestamp(testTime),
+				Until: google.NewTimestamp(testTime),
+			})
+			So(err, ShouldErrLike, "'since' must be before 'until'")
+
+			_, err = srv.QueryMintLog(ctx, &admin.QueryMintLogRequest{Cursor: "zzz"})
+			So(err, ShouldErrLike, "bad cursor")
+		})
+
+		Convey("ExportMintLog works", func() {
+			resp, err := srv.ExportMintLog(ctx, &admin.QueryMintLogRequest{})
+			So(err, ShouldBeNil)
+			So(resp.Ndjson, ShouldEqual,
+				`{"timestamp":"2015-02-03T04:06:06Z","rpc":"MintMachineToken",`+
+					`"token_type":"LUCI_MACHINE_TOKEN","fqdn":"b.domain",`+
+					`"error_code":"BAD_TOKEN_ARGUMENTS","error_message":"boom"}`+"\n"+
+					`{"timestamp":"2015-02-03T04:05:06Z","rpc":"MintMachineToken",`+
+					`"token_type":"LUCI_MACHINE_TOKEN","fqdn":"a.domain","ca":"Fake CA",`+
+					`"ca_id":123,"cert_sn":"4096","error_code":"SUCCESS"}`+"\n")
+		})
+	})
+}
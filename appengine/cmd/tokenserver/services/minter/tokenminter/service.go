@@ -8,7 +8,9 @@
 package tokenminter
 
 import (
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strings"
@@ -27,6 +29,7 @@ import (
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
 	"github.com/luci/luci-go/server/auth/signing"
 
 	"github.com/luci/luci-go/common/api/tokenserver"
@@ -34,6 +37,8 @@ import (
 	"github.com/luci/luci-go/common/api/tokenserver/minter/v1"
 
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/certchecker"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/delegation"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/jwtchecker"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/machinetoken"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/services/admin/serviceaccounts"
@@ -53,6 +58,11 @@ type Server struct {
 	// In prod it is certchecker.CheckCertificate.
 	certChecker func(c context.Context, cert *x509.Certificate) (*model.CA, error)
 
+	// jwtChecker is mocked in tests.
+	//
+	// In prod it is jwtchecker.CheckJWT.
+	jwtChecker func(c context.Context, token string) (*jwtchecker.Result, error)
+
 	// signer is mocked in tests.
 	//
 	// In prod it is gaesigner.Signer.
@@ -68,6 +78,21 @@ type Server struct {
 	// Mocked in tests. In prod it is info.Get().ServiceAccount().
 	signerServiceAccount func(context.Context) (string, error)
 
+	// delegationRules returns delegation rules imported from the config.
+	//
+	// Mocked in tests. In prod it is model.GetDelegationRules.
+	delegationRules func(context.Context) ([]*admin.DelegationRule, error)
+
+	// authDB returns auth.DB to use for group membership checks.
+	//
+	// Mocked in tests. In prod it is auth.GetState(c).DB().
+	authDB func(context.Context) (auth.DB, error)
+
+	// mintLog records an attempt to mint a token in the audit log.
+	//
+	// Mocked in tests. In prod it is model.RecordMint.
+	mintLog func(context.Context, *model.MintLogEntry) error
+
 	// initLock protects ensureInitialized and 'initialized'.
 	initLock sync.RWMutex
 
@@ -85,6 +110,7 @@ func NewServer(sa *serviceaccounts.Server) *Server {
 	return &Server{
 		mintOAuthToken: sa.DoMintAccessToken,
 		certChecker:    certchecker.CheckCertificate,
+		jwtChecker:     jwtchecker.CheckJWT,
 		signer:         gaesigner.Signer{},
 		isAdmin: func(c context.Context) (bool, error) {
 			return auth.IsMember(c, "administrators")
@@ -92,6 +118,14 @@ func NewServer(sa *serviceaccounts.Server) *Server {
 		signerServiceAccount: func(c context.Context) (string, error) {
 			return info.Get(c).ServiceAccount()
 		},
+		delegationRules: model.GetDelegationRules,
+		authDB: func(c context.Context) (auth.DB, error) {
+			if s := auth.GetState(c); s != nil {
+				return s.DB(), nil
+			}
+			return nil, fmt.Errorf("no auth state in the context")
+		},
+		mintLog: model.RecordMint,
 	}
 }
 
@@ -133,6 +167,15 @@ func (s *Server) ensureInitialized(c context.Context) (out initializedState, err
 
 // MintMachineToken generates a new token for an authenticated machine.
 func (s *Server) MintMachineToken(c context.Context, req *minter.MintMachineTokenRequest) (*minter.MintMachineTokenResponse, error) {
+	entry := newMintLogEntry(c, "MintMachineToken")
+	resp, err := s.mintMachineToken(c, req, entry)
+	return s.recordMintAttempt(c, entry, resp, err)
+}
+
+// mintMachineToken implements MintMachineToken.
+//
+// It fills in 'entry' with details of the request as they become known.
+func (s *Server) mintMachineToken(c context.Context, req *minter.MintMachineTokenRequest, entry *model.MintLogEntry) (*minter.MintMachineTokenResponse, error) {
 	// Parse serialized portion of the request and do minimal validation before
 	// checking the signature to reject obviously bad requests.
 	if len(req.SerializedTokenRequest) == 0 {
@@ -142,6 +185,8 @@ func (s *Server) MintMachineToken(c context.Context, req *minter.MintMachineToke
 	if err := proto.Unmarshal(req.SerializedTokenRequest, &tokenReq); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "failed to unmarshal TokenRequest - %s", err)
 	}
+	entry.TokenType = tokenReq.TokenType.String()
+	entry.OAuth2Scopes = tokenReq.Oauth2Scopes
 
 	switch tokenReq.TokenType {
 	case minter.TokenType_GOOGLE_OAUTH2_ACCESS_TOKEN:
@@ -176,6 +221,8 @@ func (s *Server) MintMachineToken(c context.Context, req *minter.MintMachineToke
 			c, minter.ErrorCode_BAD_CERTIFICATE_FORMAT,
 			"failed to parse the certificate (expecting x509 cert DER)")
 	}
+	entry.FQDN = strings.ToLower(cert.Subject.CommonName)
+	entry.CertSN = cert.SerialNumber.String()
 
 	// Check the signature before proceeding. Use switch when picking an algo
 	// as a reminder to add a new branch if new signature scheme is added.
@@ -211,11 +258,14 @@ func (s *Server) MintMachineToken(c context.Context, req *minter.MintMachineToke
 		}
 		return nil, grpc.Errorf(codes.Internal, "failed to check the certificate - %s", err)
 	}
+	entry.CA = ca.CN
+	entry.CaID = ca.ParsedConfig.UniqueId
 
 	// At this point we trust what's in MachineTokenRequest, proceed with
 	// generating the token.
 	args := mintTokenArgs{
 		Config:  ca.ParsedConfig,
+		FQDN:    entry.FQDN,
 		Cert:    cert,
 		Request: &tokenReq,
 	}
@@ -229,10 +279,145 @@ func (s *Server) MintMachineToken(c context.Context, req *minter.MintMachineToke
 	}
 }
 
+// MintMachineTokenByJWT generates a new token for a machine authenticated
+// with a JWT.
+func (s *Server) MintMachineTokenByJWT(c context.Context, req *minter.MintMachineTokenByJWTRequest) (*minter.MintMachineTokenResponse, error) {
+	entry := newMintLogEntry(c, "MintMachineTokenByJWT")
+	resp, err := s.mintMachineTokenByJWT(c, req, entry)
+	return s.recordMintAttempt(c, entry, resp, err)
+}
+
+// mintMachineTokenByJWT implements MintMachineTokenByJWT.
+//
+// It fills in 'entry' with details of the request as they become known.
+func (s *Server) mintMachineTokenByJWT(c context.Context, req *minter.MintMachineTokenByJWTRequest, entry *model.MintLogEntry) (*minter.MintMachineTokenResponse, error) {
+	if req.Jwt == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "empty request")
+	}
+	entry.TokenType = req.TokenType.String()
+
+	switch req.TokenType {
+	case minter.TokenType_LUCI_MACHINE_TOKEN:
+		// supported
+	default:
+		return s.mintingErrorResponse(
+			c, minter.ErrorCode_UNSUPPORTED_TOKEN_TYPE,
+			"token_type %s is not supported", req.TokenType)
+	}
+
+	// Check the JWT is signed by a trusted issuer, not expired, etc. Recognize
+	// error codes related to JWT checking. Everything else is transient errors.
+	res, err := s.jwtChecker(c, req.Jwt)
+	if err != nil {
+		if jwtchecker.IsJWTInvalidError(err) {
+			return s.mintingErrorResponse(c, minter.ErrorCode_UNTRUSTED_JWT, "%s", err)
+		}
+		return nil, grpc.Errorf(codes.Internal, "failed to check the JWT - %s", err)
+	}
+	entry.FQDN = res.FQDN
+	entry.CA = res.Config.Issuer
+	entry.CaID = res.Config.UniqueId
+
+	// At this point we trust the FQDN in the JWT. JWT issuers use the same domain
+	// rules as CAs, and their unique_id is put into the token in place of CA ID.
+	return s.mintLuciMachineToken(c, mintTokenArgs{
+		Config: &admin.CertificateAuthorityConfig{
+			UniqueId:     res.Config.UniqueId,
+			KnownDomains: res.Config.KnownDomains,
+		},
+		FQDN: res.FQDN,
+	})
+}
+
+// newMintLogEntry returns a mint log entry prefilled with details of the call.
+func newMintLogEntry(c context.Context, rpc string) *model.MintLogEntry {
+	entry := &model.MintLogEntry{
+		Timestamp: clock.Now(c).UTC(),
+		RPC:       rpc,
+	}
+	if state := auth.GetState(c); state != nil && state.PeerIP() != nil {
+		entry.PeerIP = state.PeerIP().String()
+	}
+	return entry
+}
+
+// recordMintAttempt puts the outcome of the minting into the entry and stores
+// it in the audit log.
+//
+// Tokens are not returned to the caller if they can't be recorded. Failures to
+// record failed attempts are just logged.
+func (s *Server) recordMintAttempt(c context.Context, entry *model.MintLogEntry, resp *minter.MintMachineTokenResponse, err error) (*minter.MintMachineTokenResponse, error) {
+	switch {
+	case err != nil:
+		entry.ErrorCode = "RPC_ERROR"
+		entry.ErrorMessage = err.Error()
+	default:
+		entry.ErrorCode = resp.ErrorCode.String()
+		entry.ErrorMessage = resp.ErrorMessage
+		entry.ServiceVersion = resp.ServiceVersion
+		if tok := resp.TokenResponse; tok != nil {
+			var token string
+			switch tt := tok.TokenType.(type) {
+			case *minter.MachineTokenResponse_GoogleOauth2AccessToken:
+				token = tt.GoogleOauth2AccessToken.AccessToken
+				entry.TokenExpiry = tt.GoogleOauth2AccessToken.Expiry.Time()
+			case *minter.MachineTokenResponse_LuciMachineToken:
+				token = tt.LuciMachineToken.MachineToken
+				entry.TokenExpiry = tt.LuciMachineToken.Expiry.Time()
+			}
+			if tok.ServiceAccount != nil {
+				entry.ServiceAccount = tok.ServiceAccount.Email
+			}
+			digest := sha256.Sum256([]byte(token))
+			entry.TokenSHA256 = hex.EncodeToString(digest[:])
+		}
+	}
+
+	if logErr := s.storeMintLogEntry(c, entry, err == nil && resp.TokenResponse != nil); logErr != nil {
+		return nil, logErr
+	}
+	return resp, err
+}
+
+// recordDelegationMintAttempt is recordMintAttempt for MintDelegationToken.
+func (s *Server) recordDelegationMintAttempt(c context.Context, entry *model.MintLogEntry, resp *minter.MintDelegationTokenResponse, err error) (*minter.MintDelegationTokenResponse, error) {
+	switch {
+	case err != nil:
+		entry.ErrorCode = "RPC_ERROR"
+		entry.ErrorMessage = err.Error()
+	default:
+		entry.ErrorCode = minter.ErrorCode_SUCCESS.String()
+		entry.ServiceVersion = resp.ServiceVersion
+		entry.TokenExpiry = resp.Expiry.Time()
+		digest := sha256.Sum256([]byte(resp.Token))
+		entry.TokenSHA256 = hex.EncodeToString(digest[:])
+	}
+
+	if logErr := s.storeMintLogEntry(c, entry, err == nil); logErr != nil {
+		return nil, logErr
+	}
+	return resp, err
+}
+
+// storeMintLogEntry stores the entry in the audit log.
+//
+// It returns a gRPC error only if the entry describes a minted token, since
+// such tokens must not be handed out unrecorded.
+func (s *Server) storeMintLogEntry(c context.Context, entry *model.MintLogEntry, minted bool) error {
+	if logErr := s.mintLog(c, entry); logErr != nil {
+		logging.Errorf(c, "Failed to record the mint attempt in the audit log - %s", logErr)
+		if minted {
+			return grpc.Errorf(codes.Internal, "failed to record the token in the audit log - %s", logErr)
+		}
+	}
+	return nil
+}
+
 type mintTokenArgs struct {
 	Config  *admin.CertificateAuthorityConfig
-	Cert    *x509.Certificate
-	Request *minter.MachineTokenRequest
+	FQDN    string                      // lowercase machine FQDN
+	Cert    *x509.Certificate           // nil if authenticated with a JWT
+	Request *minter.MachineTokenRequest // nil if authenticated with a JWT
 }
 
 func (s *Server) mintGoogleOAuth2AccessToken(c context.Context, args mintTokenArgs) (*minter.MintMachineTokenResponse, error) {
@@ -240,7 +425,7 @@ func (s *Server) mintGoogleOAuth2AccessToken(c context.Context, args mintTokenAr
 	// the config).
 	params := serviceaccounts.MintAccessTokenParams{
 		Config: args.Config,
-		FQDN:   strings.ToLower(args.Cert.Subject.CommonName),
+		FQDN:   args.FQDN,
 		Scopes: args.Request.Oauth2Scopes,
 	}
 	if err := params.Validate(); err != nil {
@@ -284,7 +469,7 @@ func (s *Server) mintLuciMachineToken(c context.Context, args mintTokenArgs) (*m
 
 	// Validate FQDN and whether it is allowed by config.
 	params := machinetoken.MintParams{
-		FQDN:                 strings.ToLower(args.Cert.Subject.CommonName),
+		FQDN:                 args.FQDN,
 		Cert:                 args.Cert,
 		Config:               args.Config,
 		SignerServiceAccount: state.signerServiceAccount,
@@ -374,6 +559,15 @@ func (s *Server) InspectMachineToken(c context.Context, req *minter.InspectMachi
 		},
 	}
 
+	// Delegation tokens are signed by the same key, don't mistake them for
+	// machine tokens.
+	switch body.TokenType {
+	case tokenserver.MachineTokenBody_MACHINE_TOKEN, tokenserver.MachineTokenBody_UNKNOWN_TOKEN_TYPE:
+	default:
+		resp.InvalidityReason = fmt.Sprintf("not a machine token - %s", body.TokenType)
+		return resp, nil
+	}
+
 	// Check that the token was signed by our private key.
 	certs, err := s.signer.Certificates(c)
 	if err != nil {
@@ -389,6 +583,13 @@ func (s *Server) InspectMachineToken(c context.Context, req *minter.InspectMachi
 	// Check the expiration time. Allow 10 sec clock drift.
 	resp.NonExpired = !machinetoken.IsExpired(body, clock.Now(c))
 
+	// Tokens minted based on a JWT (see MintMachineTokenByJWT) are not
+	// associated with any certificate, there's nothing to check for revocation.
+	if body.CertSn == 0 {
+		resp.NonRevoked = true
+		return finishInspection(resp), nil
+	}
+
 	// Check revocation status. Find CA name that signed the certificate used when
 	// minting the token.
 	caName, err := model.GetCAByUniqueID(c, body.CaId)
@@ -419,7 +620,11 @@ func (s *Server) InspectMachineToken(c context.Context, req *minter.InspectMachi
 	}
 	resp.NonRevoked = !revoked
 
-	// Pick invalidity reason (if any).
+	return finishInspection(resp), nil
+}
+
+// finishInspection picks invalidity reason (if any) based on the checks done.
+func finishInspection(resp *minter.InspectMachineTokenResponse) *minter.InspectMachineTokenResponse {
 	switch {
 	case !resp.NonExpired:
 		resp.InvalidityReason = "expired"
@@ -429,6 +634,104 @@ func (s *Server) InspectMachineToken(c context.Context, req *minter.InspectMachi
 		resp.Valid = true
 		resp.InvalidityReason = ""
 	}
+	return resp
+}
+
+// MintDelegationToken generates a new delegation token for the caller.
+//
+// The caller becomes the requestor of the token. What identity it can delegate
+// and to which services is defined by the delegation rules in the config.
+func (s *Server) MintDelegationToken(c context.Context, req *minter.MintDelegationTokenRequest) (*minter.MintDelegationTokenResponse, error) {
+	entry := newMintLogEntry(c, "MintDelegationToken")
+	resp, err := s.mintDelegationToken(c, req, entry)
+	return s.recordDelegationMintAttempt(c, entry, resp, err)
+}
+
+// mintDelegationToken implements MintDelegationToken.
+//
+// It fills in 'entry' with details of the request as they become known.
+func (s *Server) mintDelegationToken(c context.Context, req *minter.MintDelegationTokenRequest, entry *model.MintLogEntry) (*minter.MintDelegationTokenResponse, error) {
+	requestor := auth.CurrentIdentity(c)
+	entry.Requestor = string(requestor)
+	entry.Services = req.Services
+	if requestor.Kind() == identity.Anonymous {
+		return nil, grpc.Errorf(codes.Unauthenticated, "delegation tokens can't be minted anonymously")
+	}
+
+	// Validate the request.
+	delegated := requestor
+	if req.DelegatedIdentity != delegation.Requestor {
+		var err error
+		if delegated, err = identity.MakeIdentity(req.DelegatedIdentity); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "bad delegated_identity - %s", err)
+		}
+	}
+	if delegated.Kind() == identity.Anonymous {
+		return nil, grpc.Errorf(codes.InvalidArgument, "can't delegate anonymous identity")
+	}
+	entry.DelegatedIdentity = string(delegated)
+	if err := delegation.ValidateServices(req.Services); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad services - %s", err)
+	}
+	if req.ValidityDuration < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "validity_duration must not be negative")
+	}
 
-	return resp, nil
+	// Find a rule that allows the request.
+	rules, err := s.delegationRules(c)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "can't load delegation rules - %s", err)
+	}
+	db, err := s.authDB(c)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "can't get auth DB - %s", err)
+	}
+	rule, err := delegation.FindMatchingRule(c, db, rules, &delegation.Query{
+		Requestor:        requestor,
+		Delegated:        delegated,
+		Services:         req.Services,
+		ValidityDuration: time.Duration(req.ValidityDuration) * time.Second,
+	})
+	switch {
+	case err != nil:
+		return nil, grpc.Errorf(codes.Internal, "can't check delegation rules - %s", err)
+	case rule == nil:
+		logging.Errorf(c, "No delegation rule allows %s to delegate %s to %v", requestor, delegated, req.Services)
+		return nil, grpc.Errorf(codes.PermissionDenied, "the delegation is not allowed by the token server config")
+	}
+
+	lifetime := req.ValidityDuration
+	if lifetime == 0 {
+		lifetime = rule.MaxValidityDuration
+	}
+
+	state, err := s.ensureInitialized(c)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "can't initialize service guts - %s", err)
+	}
+
+	// Make the token.
+	body, signedToken, err := delegation.Mint(c, delegation.MintParams{
+		DelegatedIdentity:    delegated,
+		RequestorIdentity:    requestor,
+		Services:             req.Services,
+		Lifetime:             time.Duration(lifetime) * time.Second,
+		SignerServiceAccount: state.signerServiceAccount,
+		Signer:               s.signer,
+	})
+	switch {
+	case errors.IsTransient(err):
+		return nil, grpc.Errorf(codes.Internal, "failed to generate delegation token - %s", err)
+	case err != nil:
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	logging.Infof(c, "Minted delegation token for %s (requested by %s, rule %q)", delegated, requestor, rule.Name)
+	expiry := time.Unix(int64(body.IssuedAt), 0).Add(time.Duration(body.Lifetime) * time.Second)
+	return &minter.MintDelegationTokenResponse{
+		Token:           signedToken,
+		Expiry:          google.NewTimestamp(expiry),
+		DelegationToken: body,
+		ServiceVersion:  state.serviceVersion,
+	}, nil
 }
@@ -10,6 +10,7 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"math/big"
@@ -26,6 +27,8 @@ import (
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/clock/testclock"
 	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
 	"github.com/luci/luci-go/server/auth/signing/signingtest"
 
 	"github.com/luci/luci-go/common/api/tokenserver"
@@ -33,6 +36,7 @@ import (
 	"github.com/luci/luci-go/common/api/tokenserver/minter/v1"
 
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/certchecker"
+	"github.com/luci/luci-go/appengine/cmd/tokenserver/jwtchecker"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/model"
 	"github.com/luci/luci-go/appengine/cmd/tokenserver/services/admin/serviceaccounts"
 
@@ -316,10 +320,10 @@ func TestLuciMachineToken(t *testing.T) {
 			So(err, ShouldBeNil)
 
 			tok := resp.TokenResponse.GetLuciMachineToken().MachineToken
-			So(tok, ShouldEqual, `CkkKJGx1Y2ktdG9rZW4tc2VydmVyLXRlc3QtMS5mYWtlLmRvbW`+
-				`FpbhITc2lnbmVyQHRlc3RpbmcuaG9zdBjykcGmBSCQHCh7MIAgEihmOWRhNWEwZDA5MDN`+
-				`iZGE1OGM2ZDY2NGUzODUyYTg5YzI4M2Q3ZmU5GkCrjVagpGYIIVo/OsbQD4Jg8Jbp1l/6`+
-				`Q+9t+jHpBXcennnPVb0tKWojPYN3195lZ1ESofXz8rDnXoYF4/2X2pDn`)
+			So(tok, ShouldEqual, `CksKJGx1Y2ktdG9rZW4tc2VydmVyLXRlc3QtMS5mYWtlLmRvbW`+
+				`FpbhITc2lnbmVyQHRlc3RpbmcuaG9zdBjykcGmBSCQHCh7MIAgOAESKGY5ZGE1YTBkMDkw`+
+				`M2JkYTU4YzZkNjY0ZTM4NTJhODljMjgzZDdmZTkaQHdoZGx0N/XtpmN5jOtJPmbAgZ5SoJ`+
+				`rINA8hk5aHHCqJZJUTMBKCQPBazmokDqf6pRoHTrCiqtXu0qiDDLPgi6g`)
 
 			// Works!
 			reply, err := server.InspectMachineToken(ctx, &minter.InspectMachineTokenRequest{
@@ -342,6 +346,7 @@ func TestLuciMachineToken(t *testing.T) {
 						Lifetime:    3600,
 						CaId:        123,
 						CertSn:      4096,
+						TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
 					},
 				},
 			})
@@ -367,6 +372,7 @@ func TestLuciMachineToken(t *testing.T) {
 						Lifetime:    3600,
 						CaId:        123,
 						CertSn:      4096,
+						TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
 					},
 				},
 			})
@@ -394,6 +400,7 @@ func TestLuciMachineToken(t *testing.T) {
 						Lifetime:    3600,
 						CaId:        123,
 						CertSn:      4096,
+						TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
 					},
 				},
 			})
@@ -429,16 +436,461 @@ func TestLuciMachineToken(t *testing.T) {
 						Lifetime:    3600,
 						CaId:        123,
 						CertSn:      4096,
+						TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
 					},
 				},
 			})
 
 		})
+
+		Convey("rejects tokens of other types", func() {
+			body, err := proto.Marshal(&tokenserver.MachineTokenBody{
+				MachineFqdn: "luci-token-server-test-1.fake.domain",
+				IssuedBy:    "signer@testing.host",
+				IssuedAt:    uint64(clock.Now(ctx).Unix()),
+				Lifetime:    3600,
+				TokenType:   tokenserver.MachineTokenBody_DELEGATION_TOKEN,
+			})
+			So(err, ShouldBeNil)
+			keyID, sig, err := server.signer.SignBytes(ctx, body)
+			So(err, ShouldBeNil)
+			envelope, err := proto.Marshal(&tokenserver.MachineTokenEnvelope{
+				TokenBody: body,
+				KeyId:     keyID,
+				RsaSha256: sig,
+			})
+			So(err, ShouldBeNil)
+
+			reply, err := server.InspectMachineToken(ctx, &minter.InspectMachineTokenRequest{
+				TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
+				Token:     base64.RawStdEncoding.EncodeToString(envelope),
+			})
+			So(err, ShouldBeNil)
+			So(reply.Valid, ShouldBeFalse)
+			So(reply.Signed, ShouldBeFalse)
+			So(reply.InvalidityReason, ShouldEqual, "not a machine token - DELEGATION_TOKEN")
+		})
+	})
+}
+
+func TestMintMachineTokenByJWT(t *testing.T) {
+	Convey("with mock context and server", t, func() {
+		ctx := gaetesting.TestingContext()
+		ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 7, time.UTC))
+
+		var checkErr error
+		server := makeTestServer(&Server{
+			jwtChecker: func(_ context.Context, token string) (*jwtchecker.Result, error) {
+				if checkErr != nil {
+					return nil, checkErr
+				}
+				return &jwtchecker.Result{
+					Config: &admin.JWTIssuerConfig{
+						UniqueId: 10,
+						Issuer:   "https://issuer.example.com",
+						KnownDomains: []*admin.DomainConfig{
+							{
+								Domain:               []string{"fake.domain"},
+								MachineTokenLifetime: 3600,
+							},
+						},
+					},
+					FQDN: token,
+				}, nil
+			},
+			signer:  signingtest.NewSigner(0),
+			isAdmin: func(context.Context) (bool, error) { return true, nil },
+		})
+
+		Convey("success", func() {
+			resp, err := server.MintMachineTokenByJWT(ctx, &minter.MintMachineTokenByJWTRequest{
+				Jwt:       "host.fake.domain", // the mocked checker returns it as FQDN
+				TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
+			})
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_SUCCESS)
+
+			tok := resp.TokenResponse.GetLuciMachineToken().MachineToken
+			reply, err := server.InspectMachineToken(ctx, &minter.InspectMachineTokenRequest{
+				TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
+				Token:     tok,
+			})
+			So(err, ShouldBeNil)
+			So(reply.Valid, ShouldBeTrue)
+			So(reply.NonRevoked, ShouldBeTrue)
+			So(reply.GetLuciMachineToken(), ShouldResemble, &tokenserver.MachineTokenBody{
+				MachineFqdn: "host.fake.domain",
+				IssuedBy:    "signer@testing.host",
+				IssuedAt:    1422936306,
+				Lifetime:    3600,
+				CaId:        10,
+				TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
+			})
+		})
+
+		Convey("unsupported token type", func() {
+			resp, err := server.MintMachineTokenByJWT(ctx, &minter.MintMachineTokenByJWTRequest{
+				Jwt:       "host.fake.domain",
+				TokenType: minter.TokenType_GOOGLE_OAUTH2_ACCESS_TOKEN,
+			})
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_UNSUPPORTED_TOKEN_TYPE)
+		})
+
+		Convey("domain not whitelisted", func() {
+			resp, err := server.MintMachineTokenByJWT(ctx, &minter.MintMachineTokenByJWTRequest{
+				Jwt:       "host.another.domain",
+				TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
+			})
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_BAD_TOKEN_ARGUMENTS)
+		})
+
+		Convey("untrusted JWT", func() {
+			checkErr = jwtchecker.NewError(fmt.Errorf("expired"), jwtchecker.TokenExpired)
+			resp, err := server.MintMachineTokenByJWT(ctx, &minter.MintMachineTokenByJWTRequest{
+				Jwt:       "host.fake.domain",
+				TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
+			})
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_UNTRUSTED_JWT)
+			So(resp.ErrorMessage, ShouldEqual, "expired")
+		})
+
+		Convey("transient error", func() {
+			checkErr = fmt.Errorf("datastore is down")
+			_, err := server.MintMachineTokenByJWT(ctx, &minter.MintMachineTokenByJWTRequest{
+				Jwt:       "host.fake.domain",
+				TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
+			})
+			So(grpc.Code(err), ShouldEqual, codes.Internal)
+		})
+
+		Convey("empty request", func() {
+			_, err := server.MintMachineTokenByJWT(ctx, &minter.MintMachineTokenByJWTRequest{})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+	})
+}
+
+func TestMintDelegationToken(t *testing.T) {
+	Convey("with mock context and server", t, func() {
+		ctx := gaetesting.TestingContext()
+		ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 7, time.UTC))
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity: "user:requestor@example.com",
+		})
+
+		server := makeTestServer(&Server{
+			signer: signingtest.NewSigner(0),
+			delegationRules: func(context.Context) ([]*admin.DelegationRule, error) {
+				return []*admin.DelegationRule{
+					{
+						Name:                 "self",
+						Requestor:            []string{"*"},
+						AllowedToImpersonate: []string{"REQUESTOR"},
+						AllowedAudience:      []string{"service:abc"},
+						MaxValidityDuration:  3600,
+					},
+					{
+						Name:                 "impersonation",
+						Requestor:            []string{"group:impersonators"},
+						AllowedToImpersonate: []string{"*"},
+						AllowedAudience:      []string{"*"},
+						MaxValidityDuration:  600,
+					},
+				}, nil
+			},
+			authDB: func(context.Context) (auth.DB, error) {
+				return authtest.FakeDB{
+					"user:requestor@example.com": {"impersonators"},
+				}, nil
+			},
+		})
+
+		Convey("delegating own identity", func() {
+			resp, err := server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: "REQUESTOR",
+				Services:          []string{"service:abc"},
+			})
+			So(err, ShouldBeNil)
+			So(resp.Token, ShouldNotEqual, "")
+			So(resp.Expiry.Time(), ShouldResemble, time.Unix(1422936306+3600, 0).UTC())
+			So(resp.ServiceVersion, ShouldEqual, "app/testVersionID")
+			So(resp.DelegationToken, ShouldResemble, &tokenserver.DelegationTokenBody{
+				DelegatedIdentity: "user:requestor@example.com",
+				RequestorIdentity: "user:requestor@example.com",
+				IssuedBy:          "signer@testing.host",
+				IssuedAt:          1422936306,
+				Lifetime:          3600,
+				Services:          []string{"service:abc"},
+				TokenType:         tokenserver.DelegationTokenBody_DELEGATION_TOKEN,
+			})
+		})
+
+		Convey("impersonating someone", func() {
+			resp, err := server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: "user:someone@example.com",
+				ValidityDuration:  300,
+				Services:          []string{"*"},
+			})
+			So(err, ShouldBeNil)
+			So(resp.DelegationToken.DelegatedIdentity, ShouldEqual, "user:someone@example.com")
+			So(resp.DelegationToken.Lifetime, ShouldEqual, 300)
+		})
+
+		Convey("too long validity duration", func() {
+			_, err := server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: "user:someone@example.com",
+				ValidityDuration:  3600,
+				Services:          []string{"*"},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey("anonymous caller", func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{})
+			_, err := server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: "REQUESTOR",
+				Services:          []string{"service:abc"},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.Unauthenticated)
+		})
+
+		Convey("bad delegated identity", func() {
+			_, err := server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: "blah",
+				Services:          []string{"service:abc"},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("bad services", func() {
+			_, err := server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: "REQUESTOR",
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("negative validity duration", func() {
+			_, err := server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: "REQUESTOR",
+				ValidityDuration:  -1,
+				Services:          []string{"service:abc"},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
 	})
 }
 
 ////
 
+func TestMintLog(t *testing.T) {
+	Convey("with mock context and server", t, func() {
+		ctx := gaetesting.TestingContext()
+		ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC))
+
+		var logged []*model.MintLogEntry
+		var logErr error
+		server := makeTestServer(&Server{
+			mintOAuthToken: func(_ context.Context, p serviceaccounts.MintAccessTokenParams) (*tokenserver.ServiceAccount, *minter.OAuth2AccessToken, error) {
+				sa := &tokenserver.ServiceAccount{Email: "blah@email.com"}
+				tok := &minter.OAuth2AccessToken{
+					AccessToken: "access-token",
+					Expiry:      google.NewTimestamp(clock.Now(ctx).Add(time.Hour)),
+				}
+				return sa, tok, nil
+			},
+			certChecker: func(_ context.Context, cert *x509.Certificate) (*model.CA, error) {
+				return &model.CA{
+					CN: "Fake CA: fake.ca",
+					ParsedConfig: &admin.CertificateAuthorityConfig{
+						UniqueId: 123,
+						KnownDomains: []*admin.DomainConfig{
+							{
+								Domain:             []string{"fake.domain"},
+								AllowedOauth2Scope: []string{"scope1"},
+							},
+						},
+					},
+				}, nil
+			},
+			jwtChecker: func(_ context.Context, token string) (*jwtchecker.Result, error) {
+				return nil, jwtchecker.NewError(fmt.Errorf("bad JWT"), jwtchecker.BadFormat)
+			},
+			mintLog: func(_ context.Context, e *model.MintLogEntry) error {
+				logged = append(logged, e)
+				return logErr
+			},
+		})
+
+		cert, err := x509.ParseCertificate(getTestCertDER())
+		So(err, ShouldBeNil)
+
+		mint := func(scopes ...string) (*minter.MintMachineTokenResponse, error) {
+			return server.MintMachineToken(ctx, makeTestRequest(&minter.MachineTokenRequest{
+				Certificate:        getTestCertDER(),
+				SignatureAlgorithm: minter.SignatureAlgorithm_SHA256_RSA_ALGO,
+				IssuedAt:           google.NewTimestamp(clock.Now(ctx)),
+				TokenType:          minter.TokenType_GOOGLE_OAUTH2_ACCESS_TOKEN,
+				Oauth2Scopes:       scopes,
+			}))
+		}
+
+		Convey("records successful mint", func() {
+			resp, err := mint("scope1")
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_SUCCESS)
+			So(logged, ShouldResemble, []*model.MintLogEntry{
+				{
+					Timestamp:      clock.Now(ctx).UTC(),
+					RPC:            "MintMachineToken",
+					TokenType:      "GOOGLE_OAUTH2_ACCESS_TOKEN",
+					FQDN:           "luci-token-server-test-1.fake.domain",
+					CA:             "Fake CA: fake.ca",
+					CaID:           123,
+					CertSN:         cert.SerialNumber.String(),
+					OAuth2Scopes:   []string{"scope1"},
+					ErrorCode:      "SUCCESS",
+					ServiceAccount: "blah@email.com",
+					TokenExpiry:    clock.Now(ctx).Add(time.Hour).UTC(),
+					TokenSHA256:    fmt.Sprintf("%x", sha256.Sum256([]byte("access-token"))),
+					ServiceVersion: "app/testVersionID",
+				},
+			})
+		})
+
+		Convey("records failed mint", func() {
+			resp, err := mint("scope2")
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_BAD_TOKEN_ARGUMENTS)
+			So(len(logged), ShouldEqual, 1)
+			So(logged[0].ErrorCode, ShouldEqual, "BAD_TOKEN_ARGUMENTS")
+			So(logged[0].ErrorMessage, ShouldEqual, resp.ErrorMessage)
+			So(logged[0].CertSN, ShouldEqual, cert.SerialNumber.String())
+			So(logged[0].TokenSHA256, ShouldEqual, "")
+		})
+
+		Convey("records RPC errors", func() {
+			_, err := server.MintMachineToken(ctx, &minter.MintMachineTokenRequest{})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(len(logged), ShouldEqual, 1)
+			So(logged[0].ErrorCode, ShouldEqual, "RPC_ERROR")
+			So(logged[0].ErrorMessage, ShouldContainSubstring, "empty request")
+		})
+
+		Convey("records JWT based mint", func() {
+			resp, err := server.MintMachineTokenByJWT(ctx, &minter.MintMachineTokenByJWTRequest{
+				Jwt:       "zzz",
+				TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
+			})
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_UNTRUSTED_JWT)
+			So(len(logged), ShouldEqual, 1)
+			So(logged[0].RPC, ShouldEqual, "MintMachineTokenByJWT")
+			So(logged[0].TokenType, ShouldEqual, "LUCI_MACHINE_TOKEN")
+			So(logged[0].ErrorCode, ShouldEqual, "UNTRUSTED_JWT")
+		})
+
+		Convey("doesn't return the token if can't record it", func() {
+			logErr = fmt.Errorf("boom")
+			_, err := mint("scope1")
+			So(grpc.Code(err), ShouldEqual, codes.Internal)
+		})
+
+		Convey("ignores recording errors for failed mints", func() {
+			logErr = fmt.Errorf("boom")
+			resp, err := mint("scope2")
+			So(err, ShouldBeNil)
+			So(resp.ErrorCode, ShouldEqual, minter.ErrorCode_BAD_TOKEN_ARGUMENTS)
+		})
+	})
+}
+
+func TestMintDelegationLog(t *testing.T) {
+	Convey("with mock context and server", t, func() {
+		ctx := gaetesting.TestingContext()
+		ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC))
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity: "user:requestor@example.com",
+		})
+
+		var logged []*model.MintLogEntry
+		var logErr error
+		server := makeTestServer(&Server{
+			signer: signingtest.NewSigner(0),
+			delegationRules: func(context.Context) ([]*admin.DelegationRule, error) {
+				return []*admin.DelegationRule{
+					{
+						Name:                 "self",
+						Requestor:            []string{"*"},
+						AllowedToImpersonate: []string{"REQUESTOR"},
+						AllowedAudience:      []string{"service:abc"},
+						MaxValidityDuration:  3600,
+					},
+				}, nil
+			},
+			authDB: func(context.Context) (auth.DB, error) {
+				return authtest.FakeDB{}, nil
+			},
+			mintLog: func(_ context.Context, e *model.MintLogEntry) error {
+				logged = append(logged, e)
+				return logErr
+			},
+		})
+
+		mint := func(delegated string) (*minter.MintDelegationTokenResponse, error) {
+			return server.MintDelegationToken(ctx, &minter.MintDelegationTokenRequest{
+				DelegatedIdentity: delegated,
+				Services:          []string{"service:abc"},
+			})
+		}
+
+		Convey("records successful mint", func() {
+			resp, err := mint("REQUESTOR")
+			So(err, ShouldBeNil)
+			So(logged, ShouldResemble, []*model.MintLogEntry{
+				{
+					Timestamp:         clock.Now(ctx).UTC(),
+					RPC:               "MintDelegationToken",
+					ErrorCode:         "SUCCESS",
+					TokenExpiry:       clock.Now(ctx).Add(time.Hour).UTC(),
+					TokenSHA256:       fmt.Sprintf("%x", sha256.Sum256([]byte(resp.Token))),
+					ServiceVersion:    "app/testVersionID",
+					Requestor:         "user:requestor@example.com",
+					DelegatedIdentity: "user:requestor@example.com",
+					Services:          []string{"service:abc"},
+				},
+			})
+		})
+
+		Convey("records failed mint", func() {
+			_, err := mint("user:someone@example.com")
+			So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+			So(len(logged), ShouldEqual, 1)
+			So(logged[0].RPC, ShouldEqual, "MintDelegationToken")
+			So(logged[0].ErrorCode, ShouldEqual, "RPC_ERROR")
+			So(logged[0].ErrorMessage, ShouldContainSubstring, "not allowed")
+			So(logged[0].Requestor, ShouldEqual, "user:requestor@example.com")
+			So(logged[0].DelegatedIdentity, ShouldEqual, "user:someone@example.com")
+			So(logged[0].TokenSHA256, ShouldEqual, "")
+		})
+
+		Convey("doesn't return the token if can't record it", func() {
+			logErr = fmt.Errorf("boom")
+			resp, err := mint("REQUESTOR")
+			So(grpc.Code(err), ShouldEqual, codes.Internal)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("ignores recording errors for failed mints", func() {
+			logErr = fmt.Errorf("boom")
+			_, err := mint("user:someone@example.com")
+			So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+	})
+}
+
 func makeTestServer(s *Server) *Server {
 	if s.mintOAuthToken == nil {
 		s.mintOAuthToken = func(context.Context, serviceaccounts.MintAccessTokenParams) (*tokenserver.ServiceAccount, *minter.OAuth2AccessToken, error) {
@@ -452,12 +904,37 @@ func makeTestServer(s *Server) *Server {
 		}
 	}
 
+	if s.jwtChecker == nil {
+		s.jwtChecker = func(context.Context, string) (*jwtchecker.Result, error) {
+			panic("must not be called")
+		}
+	}
+
+	if s.delegationRules == nil {
+		s.delegationRules = func(context.Context) ([]*admin.DelegationRule, error) {
+			panic("must not be called")
+		}
+	}
+
+	if s.authDB == nil {
+		s.authDB = func(context.Context) (auth.DB, error) {
+			panic("must not be called")
+		}
+	}
+
 	if s.isAdmin == nil {
 		s.isAdmin = func(context.Context) (bool, error) {
 			panic("must not be called")
 		}
 	}
 
+	// Audit log is checked only in TestMintLog.
+	if s.mintLog == nil {
+		s.mintLog = func(context.Context, *model.MintLogEntry) error {
+			return nil
+		}
+	}
+
 	// This is called in almost all test cases, make it the default.
 	if s.signerServiceAccount == nil {
 		s.signerServiceAccount = func(context.Context) (string, error) {
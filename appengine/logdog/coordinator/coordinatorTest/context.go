@@ -170,6 +170,7 @@ func Install() (context.Context, *Environment) {
 		{"Name", "-Created"},
 		{"State", "-Created"},
 		{"Purged", "-Created"},
+		{"Purged", "Created"},
 		{"ProtoVersion", "-Created"},
 		{"ContentType", "-Created"},
 		{"StreamType", "-Created"},
@@ -274,6 +275,9 @@ func Install() (context.Context, *Environment) {
 		GS: func() (gs.Client, error) {
 			return &e.GSClient, nil
 		},
+		GSRW: func() (gs.Client, error) {
+			return &e.GSClient, nil
+		},
 		AP: func() (coordinator.ArchivalPublisher, error) {
 			return &e.ArchivalPublisher, nil
 		},
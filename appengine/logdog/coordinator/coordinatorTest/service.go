@@ -30,9 +30,13 @@ type Services struct {
 	// The caller must close the returned instance if successful.
 	IS func() (storage.Storage, error)
 
-	// GSClient instantiates a Google Storage client.
+	// GSClient instantiates a read-only Google Storage client.
 	GS func() (gs.Client, error)
 
+	// GSReadWriteClient instantiates a Google Storage client that can also
+	// modify Google Storage data.
+	GSRW func() (gs.Client, error)
+
 	// ArchivalPublisher returns an ArchivalPublisher instance.
 	AP func() (coordinator.ArchivalPublisher, error)
 }
@@ -71,6 +75,14 @@ func (s *Services) GSClient(context.Context) (gs.Client, error) {
 	panic("not implemented")
 }
 
+// GSReadWriteClient implements coordinator.Services.
+func (s *Services) GSReadWriteClient(context.Context) (gs.Client, error) {
+	if s.GSRW != nil {
+		return s.GSRW()
+	}
+	panic("not implemented")
+}
+
 // ArchivalPublisher implements coordinator.Services.
 func (s *Services) ArchivalPublisher(context.Context) (coordinator.ArchivalPublisher, error) {
 	if s.AP != nil {
@@ -0,0 +1,73 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package admin
+
+import (
+	"github.com/luci/luci-go/appengine/logdog/coordinator"
+	"github.com/luci/luci-go/appengine/logdog/coordinator/retention"
+	"github.com/luci/luci-go/common/api/logdog_coordinator/admin/v1"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/grpcutil"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// defaultPurgeReportResults is the default maximum number of log streams
+	// returned by PurgeReport.
+	defaultPurgeReportResults = 100
+	// maxPurgeReportResults is the maximum number of log streams that a single
+	// PurgeReport request may return.
+	maxPurgeReportResults = 1000
+)
+
+// PurgeReport returns the set of log streams that would be purged by the
+// project's retention policies, without actually purging them.
+func (s *server) PurgeReport(c context.Context, req *logdog.PurgeReportRequest) (*logdog.PurgeReportResponse, error) {
+	project := config.ProjectName(req.Project)
+	if err := coordinator.WithProjectNamespace(&c, project, coordinator.NamespaceAccessNoAuth); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid project %q", req.Project)
+	}
+
+	pcfg, err := coordinator.CurrentProjectConfig(c)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to load project configuration.")
+		return nil, grpcutil.Errf(codes.NotFound, "failed to load project configuration")
+	}
+
+	limit := int(req.MaxResults)
+	switch {
+	case limit <= 0:
+		limit = defaultPurgeReportResults
+	case limit > maxPurgeReportResults:
+		limit = maxPurgeReportResults
+	}
+
+	cands, next, err := retention.Candidates(c, pcfg, limit, req.Cursor)
+	if err != nil {
+		return nil, grpcutil.Internal
+	}
+
+	resp := logdog.PurgeReportResponse{
+		Streams: make([]*logdog.PurgeReportResponse_Stream, len(cands)),
+		Next:    next,
+	}
+	for i, cand := range cands {
+		urls := cand.ArchiveURLs()
+		stream := logdog.PurgeReportResponse_Stream{
+			Path:       string(cand.Stream.Path()),
+			Policy:     cand.Policy.Name,
+			Created:    google.NewTimestamp(cand.Stream.Created),
+			ArchiveUrl: make([]string, len(urls)),
+		}
+		for j, u := range urls {
+			stream.ArchiveUrl[j] = string(u)
+		}
+		resp.Streams[i] = &stream
+	}
+	return &resp, nil
+}
@@ -5,6 +5,8 @@
 package registration
 
 import (
+	"net/url"
+
 	ds "github.com/luci/gae/service/datastore"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -76,25 +78,40 @@ func (s *server) RegisterPrefix(c context.Context, req *logdog.RegisterPrefixReq
 		return nil, grpcutil.Errf(codes.InvalidArgument, "no prefix expiration defined")
 	}
 
-	// Determine our Pub/Sub topic.
+	// Determine our transport endpoint.
 	cfgTransport := cfg.Transport
 	if cfgTransport == nil {
 		log.Errorf(c, "Missing transport configuration.")
 		return nil, grpcutil.Internal
 	}
 
-	cfgTransportPubSub := cfgTransport.GetPubsub()
-	if cfgTransportPubSub == nil {
-		log.Errorf(c, "Missing transport Pub/Sub configuration.")
-		return nil, grpcutil.Internal
-	}
+	var resp logdog.RegisterPrefixResponse
+	switch {
+	case cfgTransport.GetPubsub() != nil:
+		cfgTransportPubSub := cfgTransport.GetPubsub()
+		pubsubTopic := pubsub.NewTopic(cfgTransportPubSub.Project, cfgTransportPubSub.Topic)
+		if err := pubsubTopic.Validate(); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"topic":      pubsubTopic,
+			}.Errorf(c, "Invalid transport Pub/Sub topic.")
+			return nil, grpcutil.Internal
+		}
+		resp.LogBundleTopic = string(pubsubTopic)
+
+	case cfgTransport.GetHttp() != nil:
+		cfgTransportHTTP := cfgTransport.GetHttp()
+		if u, err := url.Parse(cfgTransportHTTP.Url); err != nil || !u.IsAbs() {
+			log.Fields{
+				log.ErrorKey: err,
+				"url":        cfgTransportHTTP.Url,
+			}.Errorf(c, "Invalid transport HTTP URL.")
+			return nil, grpcutil.Internal
+		}
+		resp.LogBundleUrl = cfgTransportHTTP.Url
 
-	pubsubTopic := pubsub.NewTopic(cfgTransportPubSub.Project, cfgTransportPubSub.Topic)
-	if err := pubsubTopic.Validate(); err != nil {
-		log.Fields{
-			log.ErrorKey: err,
-			"topic":      pubsubTopic,
-		}.Errorf(c, "Invalid transport Pub/Sub topic.")
+	default:
+		log.Errorf(c, "Missing transport Pub/Sub or HTTP configuration.")
 		return nil, grpcutil.Internal
 	}
 
@@ -166,8 +183,6 @@ func (s *server) RegisterPrefix(c context.Context, req *logdog.RegisterPrefixReq
 		return nil, err
 	}
 
-	return &logdog.RegisterPrefixResponse{
-		Secret:         []byte(secret),
-		LogBundleTopic: string(pubsubTopic),
-	}, nil
+	resp.Secret = []byte(secret)
+	return &resp, nil
 }
@@ -108,6 +108,25 @@ func TestRegisterPrefix(t *testing.T) {
 			})
 		})
 
+		Convey(`With an HTTP transport, will return the log bundle URL.`, func() {
+			env.ModServiceConfig(c, func(cfg *svcconfig.Config) {
+				cfg.Transport = &svcconfig.Transport{
+					Type: &svcconfig.Transport_Http{
+						Http: &svcconfig.Transport_HTTP{
+							Url: "https://collector.example.com/ingest",
+						},
+					},
+				}
+			})
+
+			resp, err := svr.RegisterPrefix(c, &req)
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &logdog.RegisterPrefixResponse{
+				LogBundleUrl: "https://collector.example.com/ingest",
+				Secret:       randSecret,
+			})
+		})
+
 		Convey(`Uses the correct prefix expiration`, func() {
 
 			Convey(`When service, project, and request have expiration, chooses smallest.`, func() {
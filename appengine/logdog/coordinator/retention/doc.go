@@ -0,0 +1,16 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package retention implements the LogDog Coordinator's log stream retention
+// policies.
+//
+// Each project may define a set of svcconfig.RetentionPolicy entries in its
+// project configuration. A log stream that matches a policy is retained for
+// that policy's maximum age. Once that age has elapsed and the stream has been
+// archived, its archived data is deleted from Google Storage and its LogStream
+// entity is marked purged.
+//
+// Purging is performed by a backend cron handler (see PurgeHandler). The same
+// selection logic backs the Admin service's dry-run PurgeReport endpoint.
+package retention
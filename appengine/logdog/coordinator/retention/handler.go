@@ -0,0 +1,133 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retention
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/luci/luci-go/appengine/logdog/coordinator"
+	"github.com/luci/luci-go/appengine/logdog/coordinator/config"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/logdog/svcconfig"
+	"golang.org/x/net/context"
+)
+
+const (
+	// maxPurgePerProject is the maximum number of log streams that will be
+	// purged in a single project by a single PurgeAll invocation. Any remaining
+	// candidates will be purged on subsequent invocations.
+	maxPurgePerProject = 100
+
+	// maxScanPagesPerProject is the maximum number of Candidates pages that
+	// will be examined in a single project by a single PurgeAll invocation.
+	maxScanPagesPerProject = 20
+)
+
+// PurgeHandler is an HTTP handler that purges eligible log streams in all
+// projects. It is intended to be invoked periodically from cron.
+//
+// It expects Coordinator configuration and Services to be installed into the
+// Context.
+func PurgeHandler(c context.Context, rw http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	count, err := PurgeAll(c)
+	if err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(rw, "purge failed after %d log stream(s): %s", count, err)
+		return
+	}
+	fmt.Fprintf(rw, "ok: purged %d log stream(s)", count)
+}
+
+// PurgeAll purges eligible log streams in all projects that define retention
+// policies. It returns the number of log streams that were purged.
+//
+// A failure in one project does not prevent other projects from being purged.
+func PurgeAll(c context.Context) (int, error) {
+	pcfgs, err := config.AllProjectConfigs(c)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	var merr errors.MultiError
+	for project, pcfg := range pcfgs {
+		if len(pcfg.RetentionPolicies) == 0 {
+			continue
+		}
+
+		c := c
+		if err := coordinator.WithProjectNamespace(&c, project, coordinator.NamespaceAccessNoAuth); err != nil {
+			merr = append(merr, err)
+			continue
+		}
+
+		cands, err := projectCandidates(c, pcfg)
+		if err != nil {
+			merr = append(merr, err)
+			continue
+		}
+		if len(cands) == 0 {
+			continue
+		}
+
+		// Purging deletes archived data, so it needs a read-write client.
+		gsClient, err := coordinator.GetServices(c).GSReadWriteClient(c)
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to create Google Storage client.")
+			merr = append(merr, err)
+			continue
+		}
+
+		count := 0
+		for _, cand := range cands {
+			if err := Purge(c, gsClient, cand); err != nil {
+				merr = append(merr, err)
+				continue
+			}
+			count++
+		}
+		gsClient.Close()
+
+		log.Fields{
+			"project":    project,
+			"candidates": len(cands),
+			"purged":     count,
+		}.Infof(c, "Purged project log streams.")
+		total += count
+	}
+
+	if len(merr) > 0 {
+		return total, merr
+	}
+	return total, nil
+}
+
+// projectCandidates pages through the current project's log streams, returning
+// up to maxPurgePerProject purge candidates.
+//
+// Each invocation scans from the oldest log stream. Since purged log streams
+// drop out of the scan, successive invocations work their way through the
+// backlog.
+func projectCandidates(c context.Context, pcfg *svcconfig.ProjectConfig) ([]*Candidate, error) {
+	var (
+		cands  []*Candidate
+		cursor string
+	)
+	for i := 0; i < maxScanPagesPerProject && len(cands) < maxPurgePerProject; i++ {
+		page, next, err := Candidates(c, pcfg, maxPurgePerProject-len(cands), cursor)
+		if err != nil {
+			return nil, err
+		}
+		cands = append(cands, page...)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	return cands, nil
+}
@@ -0,0 +1,251 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retention
+
+import (
+	"strings"
+	"time"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/logdog/coordinator"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/logdog/svcconfig"
+	"golang.org/x/net/context"
+)
+
+// stateBatchSize is the number of LogStream entities to accumulate before
+// loading their LogStreamState entities in a single batch.
+const stateBatchSize = 64
+
+// candidatesPageSize is the maximum number of LogStream entities examined by a
+// single Candidates call. It is a variable so tests can lower it.
+var candidatesPageSize = 500
+
+// Candidate is a log stream that is eligible to be purged.
+type Candidate struct {
+	// Stream is the candidate's LogStream entity.
+	Stream *coordinator.LogStream
+	// State is the candidate's LogStreamState entity.
+	State *coordinator.LogStreamState
+	// Policy is the retention policy that applies to the candidate.
+	Policy *svcconfig.RetentionPolicy
+}
+
+// ArchiveURLs returns the Google Storage paths of the candidate's archived
+// data.
+func (cand *Candidate) ArchiveURLs() []gs.Path {
+	urls := make([]gs.Path, 0, 3)
+	for _, u := range []string{cand.State.ArchiveIndexURL, cand.State.ArchiveStreamURL, cand.State.ArchiveDataURL} {
+		if u != "" {
+			urls = append(urls, gs.Path(u))
+		}
+	}
+	return urls
+}
+
+// Match returns the first of the project's retention policies that applies to
+// the supplied log stream. If no policy applies, Match returns nil.
+//
+// Policies without a positive maximum age are ignored.
+func Match(pcfg *svcconfig.ProjectConfig, ls *coordinator.LogStream) *svcconfig.RetentionPolicy {
+	for _, p := range pcfg.RetentionPolicies {
+		if maxAge(p) > 0 && matches(p, ls) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Candidates returns up to limit log streams in the current project namespace
+// that are eligible to be purged under the project's retention policies.
+//
+// A log stream is eligible if it has not been purged, it has been archived,
+// and it is older than the maximum age of the first policy that matches it.
+//
+// Log streams are examined oldest first, so the streams that have been eligible
+// the longest are found first. A single call examines at most one page of log
+// streams, starting at cursor (or at the oldest log stream, if cursor is
+// empty). It returns the cursor to continue the scan from, or an empty string
+// if there are no more log streams to examine.
+func Candidates(c context.Context, pcfg *svcconfig.ProjectConfig, limit int, cursor string) ([]*Candidate, string, error) {
+	// Identify the shortest maximum age. No log stream younger than this can be
+	// eligible under any policy.
+	var minAge time.Duration
+	for _, p := range pcfg.RetentionPolicies {
+		if d := maxAge(p); d > 0 && (minAge <= 0 || d < minAge) {
+			minAge = d
+		}
+	}
+	if minAge <= 0 || limit <= 0 {
+		return nil, "", nil
+	}
+
+	di := ds.Get(c)
+	now := clock.Now(c).UTC()
+	q := ds.NewQuery("LogStream")
+	q = coordinator.AddLogStreamPurgedFilter(q, false)
+	q = q.Lt("Created", now.Add(-minAge)).Order("Created")
+	if cursor != "" {
+		cur, err := di.DecodeCursor(cursor)
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to decode cursor.")
+			return nil, "", err
+		}
+		q = q.Start(cur)
+	}
+	q = q.Limit(int32(candidatesPageSize))
+
+	// pending is a log stream awaiting its state, along with the cursor that
+	// points right after it.
+	type pending struct {
+		cand   *Candidate
+		cursor ds.Cursor
+	}
+
+	var (
+		cands   []*Candidate
+		batch   []pending
+		scanned int
+		next    string
+	)
+
+	// resolveBatch loads the LogStreamState entities for the current batch and
+	// adds the archived ones to our candidates.
+	resolveBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		states := make([]*coordinator.LogStreamState, len(batch))
+		for i, p := range batch {
+			states[i] = p.cand.Stream.State(di)
+		}
+		if err := di.Get(states); err != nil {
+			log.WithError(err).Errorf(c, "Failed to load log stream states.")
+			return err
+		}
+
+		for i, p := range batch {
+			if !states[i].ArchivalState().Archived() {
+				// Only archived log streams are eligible. Any others will become
+				// eligible once archived.
+				continue
+			}
+
+			p.cand.State = states[i]
+			cands = append(cands, p.cand)
+			if len(cands) >= limit {
+				// Resume right after the last returned candidate.
+				next = p.cursor.String()
+				return ds.Stop
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := di.Run(q, func(ls *coordinator.LogStream, getCursor ds.CursorCB) error {
+		cur, err := getCursor()
+		if err != nil {
+			return err
+		}
+		if scanned++; scanned >= candidatesPageSize {
+			// This is the last log stream of a full page. There may be more.
+			next = cur.String()
+		}
+
+		p := Match(pcfg, ls)
+		if p == nil || !ls.Created.Before(now.Add(-maxAge(p))) {
+			return nil
+		}
+
+		batch = append(batch, pending{
+			cand: &Candidate{
+				Stream: ls,
+				Policy: p,
+			},
+			cursor: cur,
+		})
+		if len(batch) < stateBatchSize {
+			return nil
+		}
+		return resolveBatch()
+	})
+	if err == nil {
+		err = resolveBatch()
+	}
+	if err != nil && err != ds.Stop {
+		log.WithError(err).Errorf(c, "Failed to query purge candidates.")
+		return nil, "", err
+	}
+	return cands, next, nil
+}
+
+// Purge deletes a candidate's archived data from Google Storage and marks its
+// log stream as purged.
+//
+// The archived data is deleted before the log stream is marked, so a failed
+// Purge may be safely retried.
+func Purge(c context.Context, client gs.Client, cand *Candidate) error {
+	c = log.SetFields(c, log.Fields{
+		"path":   cand.Stream.Path(),
+		"policy": cand.Policy.Name,
+	})
+
+	for _, u := range cand.ArchiveURLs() {
+		if err := client.Delete(u); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"url":        u,
+			}.Errorf(c, "Failed to delete archived log stream data.")
+			return err
+		}
+	}
+
+	now := clock.Now(c).UTC()
+	err := ds.Get(c).RunInTransaction(func(c context.Context) error {
+		di := ds.Get(c)
+
+		ls := coordinator.LogStream{ID: cand.Stream.ID}
+		if err := di.Get(&ls); err != nil {
+			log.WithError(err).Errorf(c, "Failed to load log stream.")
+			return err
+		}
+		if ls.Purged {
+			// Already purged (idempotent).
+			return nil
+		}
+
+		ls.Purged = true
+		ls.PurgedTime = now
+		return di.Put(&ls)
+	}, nil)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to mark log stream as purged.")
+		return err
+	}
+
+	log.Infof(c, "Purged log stream.")
+	return nil
+}
+
+func matches(p *svcconfig.RetentionPolicy, ls *coordinator.LogStream) bool {
+	if p.Prefix != "" && ls.Prefix != p.Prefix && !strings.HasPrefix(ls.Prefix, p.Prefix+"/") {
+		return false
+	}
+	for k, v := range p.Tags {
+		lv, ok := ls.Tags[k]
+		if !ok || (v != "" && lv != v) {
+			return false
+		}
+	}
+	return true
+}
+
+func maxAge(p *svcconfig.RetentionPolicy) time.Duration {
+	return p.MaxAge.Duration()
+}
@@ -0,0 +1,207 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/logdog/coordinator"
+	ct "github.com/luci/luci-go/appengine/logdog/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/common/proto/logdog/svcconfig"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRetention(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration`, t, func() {
+		c, env := ct.Install()
+		ds.Get(c).Testable().Consistent(true)
+		env.GSClient = ct.GSClient{}
+
+		const project = config.ProjectName("proj-foo")
+		env.ModProjectConfig(c, project, func(pcfg *svcconfig.ProjectConfig) {
+			pcfg.RetentionPolicies = []*svcconfig.RetentionPolicy{
+				{
+					Name:   "short",
+					Tags:   map[string]string{"retention": "short"},
+					MaxAge: google.NewDuration(time.Hour),
+				},
+				{
+					Name:   "testing",
+					Prefix: "testing",
+					MaxAge: google.NewDuration(24 * time.Hour),
+				},
+				{
+					// No maximum age; this policy should be ignored.
+					Name: "invalid",
+				},
+			}
+		})
+
+		// makeStream creates a log stream. If archived, its archived data will be
+		// added to Google Storage.
+		makeStream := func(path types.StreamPath, archived bool, tags map[string]string) *ct.TestStream {
+			ts := ct.MakeStream(c, project, path)
+			ts.Desc.Tags = tags
+			ts.Reload(c)
+
+			if archived {
+				ts.State.ArchivedTime = clock.Now(c).UTC()
+				ts.State.TerminalIndex = 0
+				ts.State.TerminatedTime = ts.State.ArchivedTime
+				ts.State.ArchiveIndexURL = "gs://testbucket/" + string(path) + "/index"
+				ts.State.ArchiveStreamURL = "gs://testbucket/" + string(path) + "/stream"
+				env.GSClient.Put(gs.Path(ts.State.ArchiveIndexURL), []byte("index"))
+				env.GSClient.Put(gs.Path(ts.State.ArchiveStreamURL), []byte("stream"))
+			}
+			So(ts.Put(c), ShouldBeNil)
+
+			env.Clock.Add(time.Minute)
+			return ts
+		}
+
+		oldStream := makeStream("testing/+/old", true, nil)
+		makeStream("testing/+/unarchived", false, nil)
+		makeStream("testing2/+/foo", true, nil)
+		shortStream := makeStream("other/+/short", true, map[string]string{"retention": "short"})
+
+		pcfg, err := coordinator.GetServices(c).ProjectConfig(c, project)
+		So(err, ShouldBeNil)
+
+		candidatePaths := func(limit int) (paths []string) {
+			ct.WithProjectNamespace(c, project, func(c context.Context) {
+				cands, next, err := Candidates(c, pcfg, limit, "")
+				So(err, ShouldBeNil)
+				So(next, ShouldEqual, "")
+
+				for _, cand := range cands {
+					paths = append(paths, string(cand.Stream.Path())+":"+cand.Policy.Name)
+				}
+			})
+			return
+		}
+
+		Convey(`Matches the first applicable policy.`, func() {
+			So(Match(pcfg, shortStream.Stream).Name, ShouldEqual, "short")
+			So(Match(pcfg, oldStream.Stream).Name, ShouldEqual, "testing")
+
+			// "testing2" is not beneath the "testing" prefix.
+			ts := ct.MakeStream(c, project, "testing2/+/foo")
+			So(Match(pcfg, ts.Stream), ShouldBeNil)
+		})
+
+		Convey(`Has no candidates before any policy has elapsed.`, func() {
+			So(candidatePaths(10), ShouldBeNil)
+		})
+
+		Convey(`After two hours, only the short-lived stream is a candidate.`, func() {
+			env.Clock.Add(2 * time.Hour)
+			So(candidatePaths(10), ShouldResemble, []string{"other/+/short:short"})
+		})
+
+		Convey(`After two days`, func() {
+			env.Clock.Add(48 * time.Hour)
+
+			Convey(`Only archived, matching streams are candidates, oldest first.`, func() {
+				So(candidatePaths(10), ShouldResemble, []string{
+					"testing/+/old:testing",
+					"other/+/short:short",
+				})
+			})
+
+			Convey(`Will respect the candidate limit and resume from the cursor.`, func() {
+				ct.WithProjectNamespace(c, project, func(c context.Context) {
+					cands, next, err := Candidates(c, pcfg, 1, "")
+					So(err, ShouldBeNil)
+					So(len(cands), ShouldEqual, 1)
+					So(cands[0].Stream.Path(), ShouldEqual, "testing/+/old")
+					So(next, ShouldNotEqual, "")
+
+					cands, _, err = Candidates(c, pcfg, 1, next)
+					So(err, ShouldBeNil)
+					So(len(cands), ShouldEqual, 1)
+					So(cands[0].Stream.Path(), ShouldEqual, "other/+/short")
+				})
+			})
+
+			Convey(`Will page through log streams.`, func() {
+				defer func(v int) { candidatesPageSize = v }(candidatesPageSize)
+				candidatesPageSize = 1
+
+				var (
+					paths  []string
+					pages  int
+					cursor string
+				)
+				ct.WithProjectNamespace(c, project, func(c context.Context) {
+					for {
+						cands, next, err := Candidates(c, pcfg, 10, cursor)
+						So(err, ShouldBeNil)
+						for _, cand := range cands {
+							paths = append(paths, string(cand.Stream.Path()))
+						}
+						pages++
+						if next == "" {
+							break
+						}
+						cursor = next
+					}
+				})
+
+				// Four log streams, one per page, plus the final empty page.
+				So(pages, ShouldEqual, 5)
+				So(paths, ShouldResemble, []string{"testing/+/old", "other/+/short"})
+
+				Convey(`And PurgeAll will purge candidates from all pages.`, func() {
+					count, err := PurgeAll(c)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 2)
+				})
+			})
+
+			Convey(`PurgeAll will purge the candidates.`, func() {
+				count, err := PurgeAll(c)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				So(oldStream.Get(c), ShouldBeNil)
+				So(oldStream.Stream.Purged, ShouldBeTrue)
+				So(oldStream.Stream.PurgedTime, ShouldResemble, ds.RoundTime(clock.Now(c).UTC()))
+				So(env.GSClient.Get(gs.Path(oldStream.State.ArchiveIndexURL)), ShouldBeNil)
+				So(env.GSClient.Get(gs.Path(oldStream.State.ArchiveStreamURL)), ShouldBeNil)
+
+				So(shortStream.Get(c), ShouldBeNil)
+				So(shortStream.Stream.Purged, ShouldBeTrue)
+
+				// The non-matching stream's data is retained.
+				So(env.GSClient.Get("gs://testbucket/testing2/+/foo/stream"), ShouldNotBeNil)
+
+				Convey(`And will have no candidates afterwards.`, func() {
+					So(candidatePaths(10), ShouldBeNil)
+				})
+			})
+
+			Convey(`Will not mark a stream purged if its data could not be deleted.`, func() {
+				env.GSClient["error"] = []byte("test error")
+
+				_, err := PurgeAll(c)
+				So(err, ShouldNotBeNil)
+
+				So(oldStream.Get(c), ShouldBeNil)
+				So(oldStream.Stream.Purged, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 	gaeauthClient "github.com/luci/luci-go/appengine/gaeauth/client"
@@ -20,6 +21,7 @@ import (
 	"github.com/luci/luci-go/common/proto/logdog/svcconfig"
 	"github.com/luci/luci-go/server/logdog/storage"
 	"github.com/luci/luci-go/server/logdog/storage/bigtable"
+	"github.com/luci/luci-go/server/logdog/storage/local"
 	"github.com/luci/luci-go/server/middleware"
 	"golang.org/x/net/context"
 	"google.golang.org/cloud"
@@ -56,9 +58,13 @@ type Services interface {
 	// The caller must close the returned instance if successful.
 	IntermediateStorage(context.Context) (storage.Storage, error)
 
-	// GSClient instantiates a Google Storage client.
+	// GSClient instantiates a read-only Google Storage client.
 	GSClient(context.Context) (gs.Client, error)
 
+	// GSReadWriteClient instantiates a Google Storage client that can also
+	// modify Google Storage data.
+	GSReadWriteClient(context.Context) (gs.Client, error)
+
 	// ArchivalPublisher returns an ArchivalPublisher instance.
 	ArchivalPublisher(context.Context) (ArchivalPublisher, error)
 }
@@ -159,16 +165,23 @@ func (s *prodServicesInst) IntermediateStorage(c context.Context) (storage.Stora
 		return nil, err
 	}
 
-	// Is BigTable configured?
 	if cfg.Storage == nil {
 		return nil, errors.New("no storage configuration")
 	}
 
-	bt := cfg.Storage.GetBigtable()
-	if bt == nil {
-		return nil, errors.New("no BigTable configuration")
+	switch {
+	case cfg.Storage.GetBigtable() != nil:
+		return s.bigTableStorage(c, cfg, cfg.Storage.GetBigtable())
+
+	case cfg.Storage.GetLocal() != nil:
+		return s.localStorage(c, cfg.Storage.GetLocal(), cfg.Storage.GetMaxLogAge().Duration())
+
+	default:
+		return nil, errors.New("no BigTable or local storage configuration")
 	}
+}
 
+func (s *prodServicesInst) bigTableStorage(c context.Context, cfg *config.Config, bt *svcconfig.Storage_BigTable) (storage.Storage, error) {
 	// Validate the BigTable configuration.
 	log.Fields{
 		"project":      bt.Project,
@@ -221,10 +234,42 @@ func (s *prodServicesInst) IntermediateStorage(c context.Context) (storage.Stora
 	return st, nil
 }
 
+func (s *prodServicesInst) localStorage(c context.Context, lcfg *svcconfig.Storage_Local, maxLogAge time.Duration) (storage.Storage, error) {
+	if lcfg.Path == "" {
+		return nil, errors.New("missing local storage path")
+	}
+
+	// The Coordinator only reads intermediate storage. The store is owned by the
+	// collector, which writes, purges and compacts it.
+	st, err := local.New(c, local.Options{
+		Path:     lcfg.Path,
+		ReadOnly: true,
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to open local storage.")
+		return nil, err
+	}
+
+	// Hide log entries past the configured maximum log age.
+	if err := st.Config(storage.Config{MaxLogAge: maxLogAge}); err != nil {
+		st.Close()
+		return nil, err
+	}
+	return st, nil
+}
+
 func (s *prodServicesInst) GSClient(c context.Context) (gs.Client, error) {
+	return s.gsClient(c, gs.ReadOnlyScopes)
+}
+
+func (s *prodServicesInst) GSReadWriteClient(c context.Context) (gs.Client, error) {
+	return s.gsClient(c, gs.ReadWriteScopes)
+}
+
+func (s *prodServicesInst) gsClient(c context.Context, scopes []string) (gs.Client, error) {
 	// Get an Authenticator bound to the token scopes that we need for
 	// authenticated Cloud Storage access.
-	rt, err := gaeauthClient.Transport(c, gs.ReadOnlyScopes, nil)
+	rt, err := gaeauthClient.Transport(c, scopes, nil)
 	if err != nil {
 		log.WithError(err).Errorf(c, "Failed to create Cloud Storage transport.")
 		return nil, errors.New("failed to create Cloud Storage transport")
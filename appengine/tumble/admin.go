@@ -0,0 +1,185 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/gae/service/info"
+	"github.com/luci/luci-go/appengine/gaeauth/server"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/middleware"
+	"golang.org/x/net/context"
+)
+
+// defaultDeadMutationListLimit is the number of dead mutations returned by the
+// list handler if no limit is specified.
+const defaultDeadMutationListLimit = 100
+
+// adminGroup is the group whose members may use the admin handlers.
+const adminGroup = "administrators"
+
+// adminAuth is the list of methods used to authenticate requests to the admin
+// handlers.
+//
+// The handlers are an API without a UI, so only OAuth2 is accepted. Cookie
+// based authentication would expose the POST handlers to cross-site request
+// forgery.
+var adminAuth = auth.Authenticator{
+	&server.OAuth2Method{Scopes: []string{server.EmailScope}},
+}
+
+// requireAdmin ensures that the current user is a member of adminGroup.
+// Otherwise it aborts the request with a StatusForbidden.
+//
+// It expects the request to be authenticated already (see adminAuth).
+func requireAdmin(h middleware.Handler) middleware.Handler {
+	return func(c context.Context, rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		switch admin, err := auth.IsMember(c, adminGroup); {
+		case err != nil:
+			logging.WithError(err).Errorf(c, "failed to check group membership")
+			writeError(rw, http.StatusInternalServerError, "failed to check group membership")
+			return
+		case !admin:
+			logging.Errorf(c, "%s is not a member of %q", auth.CurrentIdentity(c), adminGroup)
+			writeError(rw, http.StatusForbidden, "must be a member of %q", adminGroup)
+			return
+		}
+		h(c, rw, r, p)
+	}
+}
+
+func writeError(rw http.ResponseWriter, status int, format string, args ...interface{}) {
+	rw.WriteHeader(status)
+	fmt.Fprintf(rw, "error: "+format, args...)
+}
+
+func writeJSON(c context.Context, rw http.ResponseWriter, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		logging.WithError(err).Errorf(c, "failed to encode JSON response")
+		writeError(rw, http.StatusInternalServerError, "failed to encode response: %s", err)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write(data)
+}
+
+// deadMutationKey decodes the "key" parameter, and writes an error response if
+// it's invalid.
+func deadMutationKey(c context.Context, rw http.ResponseWriter, p httprouter.Params) *datastore.Key {
+	key, err := datastore.NewKeyEncoded(p.ByName("key"))
+	if err != nil || key.Kind() != "tumble.DeadMutation" {
+		logging.Fields{
+			logging.ErrorKey: err,
+			"key":            p.ByName("key"),
+		}.Errorf(c, "bad dead mutation key")
+		writeError(rw, http.StatusBadRequest, "bad key")
+		return nil
+	}
+	return key
+}
+
+// writeDeadMutationError writes the error response for an error returned by
+// one of the dead mutation functions.
+func writeDeadMutationError(c context.Context, rw http.ResponseWriter, key *datastore.Key, err error) {
+	if err == ErrNoSuchDeadMutation {
+		writeError(rw, http.StatusNotFound, "no such dead mutation")
+		return
+	}
+	logging.Fields{
+		logging.ErrorKey: err,
+		"key":            key,
+	}.Errorf(c, "dead mutation operation failed")
+	writeError(rw, http.StatusInternalServerError, "%s", err)
+}
+
+// ListDeadMutationsHandler is a http handler which lists the dead mutations as
+// JSON. It accepts the following optional query parameters:
+//   * namespace: the datastore namespace to list (defaults to the default
+//     namespace).
+//   * limit: the maximum number of dead mutations to return.
+func (s *Service) ListDeadMutationsHandler(c context.Context, rw http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if ns := r.FormValue("namespace"); ns != "" {
+		var err error
+		if c, err = info.Get(c).Namespace(ns); err != nil {
+			writeError(rw, http.StatusBadRequest, "bad namespace %q: %s", ns, err)
+			return
+		}
+	}
+
+	limit := int32(defaultDeadMutationListLimit)
+	if v := r.FormValue("limit"); v != "" {
+		l, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || l <= 0 {
+			writeError(rw, http.StatusBadRequest, "bad limit %q", v)
+			return
+		}
+		limit = int32(l)
+	}
+
+	dms, err := ListDeadMutations(c, limit)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "failed to list dead mutations")
+		writeError(rw, http.StatusInternalServerError, "%s", err)
+		return
+	}
+	if dms == nil {
+		dms = []*DeadMutation{}
+	}
+	writeJSON(c, rw, dms)
+}
+
+// GetDeadMutationHandler is a http handler which returns the dead mutation
+// named by the "key" parameter, including its decoded Mutation, as JSON.
+func (s *Service) GetDeadMutationHandler(c context.Context, rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	key := deadMutationKey(c, rw, p)
+	if key == nil {
+		return
+	}
+
+	dm, err := GetDeadMutation(c, key)
+	if err != nil {
+		writeDeadMutationError(c, rw, key, err)
+		return
+	}
+	writeJSON(c, rw, dm)
+}
+
+// RetryDeadMutationHandler is a http handler which moves the dead mutation
+// named by the "key" parameter back into tumble's queue.
+func (s *Service) RetryDeadMutationHandler(c context.Context, rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	key := deadMutationKey(c, rw, p)
+	if key == nil {
+		return
+	}
+
+	if err := RetryDeadMutation(c, key); err != nil {
+		writeDeadMutationError(c, rw, key, err)
+		return
+	}
+	rw.Write([]byte("ok"))
+}
+
+// DiscardDeadMutationHandler is a http handler which deletes the dead
+// mutation named by the "key" parameter.
+func (s *Service) DiscardDeadMutationHandler(c context.Context, rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	key := deadMutationKey(c, rw, p)
+	if key == nil {
+		return
+	}
+
+	if err := DiscardDeadMutation(c, key); err != nil {
+		writeDeadMutationError(c, rw, key, err)
+		return
+	}
+	rw.Write([]byte("ok"))
+}
@@ -22,6 +22,7 @@ const (
 	baseURL             = "/internal/" + baseName
 	fireAllTasksURL     = baseURL + "/fire_all_tasks"
 	processShardPattern = baseURL + "/process_shard/:shard_id/at/:timestamp"
+	deadMutationsURL    = baseURL + "/admin/dead_mutations"
 )
 
 // Config is the set of tweakable things for tumble. If you use something other
@@ -60,6 +61,15 @@ type Config struct {
 	// It defaults to 128. A negative value means no limit.
 	ProcessMaxBatchSize int32 `json:"processMaxBatchSize,omitempty"`
 
+	// MaxRetries is the number of times that a Mutation whose RollForward fails
+	// will be retried. Once a Mutation fails more than MaxRetries times, it's
+	// moved to the dead-letter kind ("tumble.DeadMutation"), where it's no longer
+	// processed. Dead mutations can be inspected, retried or discarded with the
+	// admin handlers (see Service.InstallHandlers).
+	//
+	// If MaxRetries is 0 (the default), failing Mutations are retried forever.
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+
 	// DelayedMutations enables the 'DelayedMutation' mutation subtype.
 	//
 	// If you set this to true, you MUST also add the second index mentioned
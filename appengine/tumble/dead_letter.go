@@ -0,0 +1,238 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/gae/service/info"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// ErrNoSuchDeadMutation is returned by the dead mutation functions if the
+// requested dead mutation doesn't exist.
+var ErrNoSuchDeadMutation = errors.New("tumble: no such dead mutation")
+
+// mutationFailure is a failed RollForward of the Mutation stored at key.
+type mutationFailure struct {
+	key *datastore.Key
+	typ string
+	err error
+}
+
+// recordFailures updates the failure counters of the failed mutations, moving
+// any which exceeded Config.MaxRetries to the dead-letter kind.
+//
+// This runs outside of the processRoot transaction, so that the failure is
+// recorded even though the RollForward's changes were discarded. Errors are
+// logged, but otherwise ignored; the mutation will simply be retried.
+func recordFailures(c context.Context, cfg *Config, failures []mutationFailure) {
+	for _, f := range failures {
+		metricMutationsFailed.Add(c, 1, f.typ)
+
+		dead, err := recordFailure(c, cfg, f.key, f.err)
+		if err != nil {
+			logging.Fields{
+				logging.ErrorKey: err,
+				"key":            f.key,
+			}.Warningf(c, "failed to record mutation failure")
+			continue
+		}
+		if dead {
+			logging.Fields{
+				"key":  f.key,
+				"type": f.typ,
+			}.Errorf(c, "mutation exceeded %d retries, moved to dead-letter", cfg.MaxRetries)
+			metricMutationsDeadLettered.Add(c, 1, f.typ)
+		}
+	}
+}
+
+func recordFailure(c context.Context, cfg *Config, key *datastore.Key, rfErr error) (dead bool, err error) {
+	now := clock.Now(c).UTC()
+	err = datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		dead = false
+
+		ds := datastore.Get(c)
+		rm := &realMutation{ID: key.StringID(), Parent: key.Parent()}
+		if err := ds.Get(rm); err != nil {
+			if err == datastore.ErrNoSuchEntity {
+				// Someone else already handled it (e.g. a named mutation which was
+				// cancelled).
+				return nil
+			}
+			return err
+		}
+
+		rm.FailureCount++
+		rm.LastError = rfErr.Error()
+		if cfg.MaxRetries > 0 && rm.FailureCount > int64(cfg.MaxRetries) {
+			dead = true
+			if err := ds.Put(rm.toDead(now)); err != nil {
+				return err
+			}
+			return ds.Delete(key)
+		}
+		return ds.Put(rm)
+	}, nil)
+	return
+}
+
+// DeadMutation describes a Mutation which was moved to the dead-letter kind
+// after exceeding Config.MaxRetries.
+type DeadMutation struct {
+	// Key is the encoded datastore key of the dead mutation.
+	Key string `json:"key"`
+
+	// TargetRoot is the encoded root key of the entity group the mutation
+	// operates on.
+	TargetRoot string `json:"targetRoot"`
+	// Version is the application version that recorded the mutation.
+	Version string `json:"version"`
+	// Type is the registered type name of the mutation.
+	Type string `json:"type"`
+
+	// FailureCount is the number of times RollForward failed.
+	FailureCount int64 `json:"failureCount"`
+	// LastError is the text of the last RollForward error.
+	LastError string `json:"lastError"`
+	// DeadAt is the time that the mutation was dead-lettered.
+	DeadAt time.Time `json:"deadAt"`
+
+	// Mutation is the decoded mutation. It's only populated by
+	// GetDeadMutation, and is nil if the mutation couldn't be decoded.
+	Mutation Mutation `json:"mutation,omitempty"`
+	// DecodeError is set if GetDeadMutation was unable to decode the mutation.
+	DecodeError string `json:"decodeError,omitempty"`
+}
+
+func (d *deadMutation) toDeadMutation(c context.Context) *DeadMutation {
+	return &DeadMutation{
+		Key:          datastore.Get(c).KeyForObj(d).Encode(),
+		TargetRoot:   d.TargetRoot.Encode(),
+		Version:      d.Version,
+		Type:         d.Type,
+		FailureCount: d.FailureCount,
+		LastError:    d.LastError,
+		DeadAt:       d.DeadAt,
+	}
+}
+
+// deadMutationForKey returns an empty deadMutation for key, along with a
+// context in key's namespace.
+func deadMutationForKey(c context.Context, key *datastore.Key) (context.Context, *deadMutation, error) {
+	if key.Kind() != "tumble.DeadMutation" {
+		return nil, nil, fmt.Errorf("tumble: %s is not a dead mutation key", key)
+	}
+	c = info.Get(c).MustNamespace(key.Namespace())
+	return c, &deadMutation{ID: key.StringID(), Parent: key.Parent()}, nil
+}
+
+// ListDeadMutations returns up to limit dead mutations in the current
+// namespace, most recently dead-lettered first.
+func ListDeadMutations(c context.Context, limit int32) ([]*DeadMutation, error) {
+	q := datastore.NewQuery("tumble.DeadMutation").Order("-DeadAt")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	ret := []*DeadMutation(nil)
+	err := datastore.Get(c).Run(q, func(d *deadMutation) error {
+		ret = append(ret, d.toDeadMutation(c))
+		return nil
+	})
+	return ret, err
+}
+
+// GetDeadMutation returns the dead mutation stored at key, including its
+// decoded Mutation.
+//
+// If there is no such dead mutation, this returns ErrNoSuchDeadMutation.
+func GetDeadMutation(c context.Context, key *datastore.Key) (*DeadMutation, error) {
+	c, d, err := deadMutationForKey(c, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := datastore.Get(c).Get(d); err != nil {
+		if err == datastore.ErrNoSuchEntity {
+			err = ErrNoSuchDeadMutation
+		}
+		return nil, err
+	}
+
+	ret := d.toDeadMutation(c)
+	if ret.Mutation, err = d.GetMutation(); err != nil {
+		ret.DecodeError = err.Error()
+	}
+	return ret, nil
+}
+
+// RetryDeadMutation moves the dead mutation stored at key back to tumble's
+// queue with its failure counters reset, and schedules it for processing.
+//
+// If there is no such dead mutation, this returns ErrNoSuchDeadMutation.
+func RetryDeadMutation(c context.Context, key *datastore.Key) error {
+	c, d, err := deadMutationForKey(c, key)
+	if err != nil {
+		return err
+	}
+
+	cfg := getConfig(c)
+	now := clock.Now(c).UTC()
+	rm := (*realMutation)(nil)
+	err = datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+		if err := ds.Get(d); err != nil {
+			if err == datastore.ErrNoSuchEntity {
+				err = ErrNoSuchDeadMutation
+			}
+			return err
+		}
+
+		rm = d.toReal(now)
+		if err := ds.Put(rm); err != nil {
+			return err
+		}
+		return ds.Delete(key)
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	logging.Fields{
+		"key":  key,
+		"type": rm.Type,
+	}.Infof(c, "retrying dead mutation")
+	fireTasks(c, cfg, map[taskShard]struct{}{rm.shard(cfg): {}})
+	return nil
+}
+
+// DiscardDeadMutation permanently deletes the dead mutation stored at key.
+//
+// If there is no such dead mutation, this returns ErrNoSuchDeadMutation.
+func DiscardDeadMutation(c context.Context, key *datastore.Key) error {
+	c, _, err := deadMutationForKey(c, key)
+	if err != nil {
+		return err
+	}
+
+	return datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+		switch ex, err := ds.Exists(key); {
+		case err != nil:
+			return err
+		case !ex.All():
+			return ErrNoSuchDeadMutation
+		}
+
+		logging.Fields{"key": key}.Infof(c, "discarding dead mutation")
+		return ds.Delete(key)
+	}, nil)
+}
@@ -0,0 +1,206 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+type FlakyGroup struct {
+	_id int64 `gae:"$id,1"`
+
+	Broken bool
+	Count  int64
+}
+
+type FlakyMutation struct {
+	Name string
+}
+
+func (f *FlakyMutation) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).MakeKey("FlakyGroup", 1)
+}
+
+func (f *FlakyMutation) RollForward(c context.Context) ([]Mutation, error) {
+	ds := datastore.Get(c)
+
+	grp := &FlakyGroup{}
+	if err := ds.Get(grp); err != nil {
+		return nil, err
+	}
+	if grp.Broken {
+		return nil, errors.New("group is broken")
+	}
+	grp.Count++
+	return nil, ds.Put(grp)
+}
+
+func init() {
+	Register((*FlakyMutation)(nil))
+}
+
+func TestDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	Convey("Dead-letter", t, func() {
+		tt := &Testing{}
+		c := tt.Context()
+		ds := datastore.Get(c)
+
+		cfg := tt.GetConfig(c)
+		cfg.MaxRetries = 2
+		tt.UpdateSettings(c, cfg)
+
+		grp := &FlakyGroup{Broken: true}
+		So(ds.Put(grp), ShouldBeNil)
+
+		So(AddToJournal(c, &FlakyMutation{"hello"}), ShouldBeNil)
+		tt.Drain(c)
+
+		countKind := func(kind string) int64 {
+			n, err := ds.Count(datastore.NewQuery(kind))
+			So(err, ShouldBeNil)
+			return n
+		}
+
+		Convey("records failures of a failing mutation", func() {
+			rms := []*realMutation(nil)
+			So(ds.GetAll(datastore.NewQuery("tumble.Mutation"), &rms), ShouldBeNil)
+			So(rms, ShouldHaveLength, 1)
+			So(rms[0].FailureCount, ShouldEqual, 1)
+			So(rms[0].LastError, ShouldEqual, "group is broken")
+			So(countKind("tumble.DeadMutation"), ShouldEqual, 0)
+		})
+
+		Convey("moves it to the dead-letter kind after MaxRetries", func() {
+			for i := 0; i < 2; i++ {
+				tt.FireAllTasks(c)
+				tt.Drain(c)
+			}
+			So(countKind("tumble.Mutation"), ShouldEqual, 0)
+
+			dms, err := ListDeadMutations(c, 0)
+			So(err, ShouldBeNil)
+			So(dms, ShouldHaveLength, 1)
+			So(dms[0].Type, ShouldEqual, "*tumble.FlakyMutation")
+			So(dms[0].FailureCount, ShouldEqual, 3)
+			So(dms[0].LastError, ShouldEqual, "group is broken")
+			So(dms[0].Mutation, ShouldBeNil)
+
+			key, err := datastore.NewKeyEncoded(dms[0].Key)
+			So(err, ShouldBeNil)
+
+			// It's no longer processed.
+			tt.FireAllTasks(c)
+			tt.Drain(c)
+			So(countKind("tumble.DeadMutation"), ShouldEqual, 1)
+
+			Convey("can be inspected", func() {
+				dm, err := GetDeadMutation(c, key)
+				So(err, ShouldBeNil)
+				So(dm.DecodeError, ShouldEqual, "")
+				So(dm.Mutation, ShouldResemble, &FlakyMutation{"hello"})
+			})
+
+			Convey("can be retried", func() {
+				grp.Broken = false
+				So(ds.Put(grp), ShouldBeNil)
+
+				So(RetryDeadMutation(c, key), ShouldBeNil)
+				tt.Drain(c)
+
+				So(ds.Get(grp), ShouldBeNil)
+				So(grp.Count, ShouldEqual, 1)
+				So(countKind("tumble.Mutation"), ShouldEqual, 0)
+				So(countKind("tumble.DeadMutation"), ShouldEqual, 0)
+
+				So(RetryDeadMutation(c, key), ShouldEqual, ErrNoSuchDeadMutation)
+			})
+
+			Convey("can be discarded", func() {
+				So(DiscardDeadMutation(c, key), ShouldBeNil)
+				So(countKind("tumble.DeadMutation"), ShouldEqual, 0)
+
+				So(DiscardDeadMutation(c, key), ShouldEqual, ErrNoSuchDeadMutation)
+				_, err := GetDeadMutation(c, key)
+				So(err, ShouldEqual, ErrNoSuchDeadMutation)
+			})
+
+			Convey("admin handlers", func() {
+				svc := &Service{}
+
+				Convey("require an administrator", func() {
+					rec := httptest.NewRecorder()
+					ac := auth.WithState(c, &authtest.FakeState{Identity: "user:someone@example.com"})
+					requireAdmin(svc.ListDeadMutationsHandler)(ac, rec, &http.Request{}, nil)
+					So(rec.Code, ShouldEqual, http.StatusForbidden)
+
+					rec = httptest.NewRecorder()
+					ac = auth.WithState(c, &authtest.FakeState{
+						Identity: "user:someone@example.com",
+						Error:    errors.New("boom"),
+					})
+					requireAdmin(svc.ListDeadMutationsHandler)(ac, rec, &http.Request{}, nil)
+					So(rec.Code, ShouldEqual, http.StatusInternalServerError)
+
+					rec = httptest.NewRecorder()
+					ac = auth.WithState(c, &authtest.FakeState{
+						Identity:       "user:admin@example.com",
+						IdentityGroups: []string{"administrators"},
+					})
+					requireAdmin(svc.ListDeadMutationsHandler)(ac, rec, &http.Request{}, nil)
+					So(rec.Code, ShouldEqual, http.StatusOK)
+				})
+
+				Convey("can list and inspect", func() {
+					rec := httptest.NewRecorder()
+					svc.ListDeadMutationsHandler(c, rec, &http.Request{}, nil)
+					So(rec.Code, ShouldEqual, http.StatusOK)
+
+					listed := []*DeadMutation(nil)
+					So(json.Unmarshal(rec.Body.Bytes(), &listed), ShouldBeNil)
+					So(listed, ShouldHaveLength, 1)
+					So(listed[0].Key, ShouldEqual, dms[0].Key)
+
+					rec = httptest.NewRecorder()
+					svc.GetDeadMutationHandler(c, rec, &http.Request{},
+						httprouter.Params{{Key: "key", Value: dms[0].Key}})
+					So(rec.Code, ShouldEqual, http.StatusOK)
+					So(rec.Body.String(), ShouldContainSubstring, `"Name": "hello"`)
+				})
+
+				Convey("reject bad keys", func() {
+					rec := httptest.NewRecorder()
+					svc.DiscardDeadMutationHandler(c, rec, &http.Request{},
+						httprouter.Params{{Key: "key", Value: ds.MakeKey("FlakyGroup", 1).Encode()}})
+					So(rec.Code, ShouldEqual, http.StatusBadRequest)
+				})
+
+				Convey("can discard", func() {
+					rec := httptest.NewRecorder()
+					svc.DiscardDeadMutationHandler(c, rec, &http.Request{},
+						httprouter.Params{{Key: "key", Value: dms[0].Key}})
+					So(rec.Code, ShouldEqual, http.StatusOK)
+
+					rec = httptest.NewRecorder()
+					svc.DiscardDeadMutationHandler(c, rec, &http.Request{},
+						httprouter.Params{{Key: "key", Value: dms[0].Key}})
+					So(rec.Code, ShouldEqual, http.StatusNotFound)
+				})
+			})
+		})
+	})
+}
@@ -104,4 +104,38 @@
 //   - description: tumble fire_all_tasks invocation
 //     url: /internal/tumble/fire_all_tasks  # NOTE: must match tumble.Config.FireAllTasksURL()
 //     schedule: every 5 minutes             # maximium task latency you can tolerate.
+//
+// The fire_all_tasks cron also reports the number of pending Mutations in each
+// shard as the "tumble/shard/backlog" tsmon metric.
+//
+// Failing Mutations
+//
+// By default, a Mutation whose RollForward returns an error is retried
+// forever. If you set MaxRetries in the tumble configuration, a Mutation which
+// fails more than MaxRetries times is moved to the "tumble.DeadMutation" kind
+// and is no longer processed. Dead mutations can be listed, inspected, retried
+// or discarded by application administrators via the handlers installed by
+// Service.InstallHandlers (see ListDeadMutations and friends), e.g.:
+//
+//   GET  /internal/tumble/admin/dead_mutations?namespace=ns&limit=10
+//   GET  /internal/tumble/admin/dead_mutations/<key>
+//   POST /internal/tumble/admin/dead_mutations/<key>/retry
+//   POST /internal/tumble/admin/dead_mutations/<key>/discard
+//
+// Running outside of App Engine
+//
+// Tumble can also run in a plain Go server, using any implementation of the
+// luci/gae datastore, memcache and info services (e.g. luci/gae/impl/memory).
+// Instead of installing the handlers and setting up the task queue and cron,
+// run a Loop and install it into the contexts used to record Mutations:
+//
+//   loop := &tumble.Loop{}
+//   go loop.Run(ctx)
+//
+//   ctx = tumble.UseLoop(ctx, loop)
+//   err := tumble.RunMutation(ctx, &MyMutation{...})
+//
+// Mutations recorded with a context which doesn't have the Loop installed are
+// still processed, but only once the Loop polls their shard (see
+// Loop.PollInterval).
 package tumble
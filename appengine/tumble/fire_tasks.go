@@ -45,6 +45,12 @@ func fireTasks(c context.Context, cfg *Config, shards map[taskShard]struct{}) bo
 		return true
 	}
 
+	// If we're running in-process, wake up the Loop instead.
+	if l := getLoop(c); l != nil {
+		l.schedule(c, cfg, shards)
+		return true
+	}
+
 	// If namespacing is enabled, Tumble will fire tasks into the Tumble task
 	// namespace.
 	if cfg.Namespaced {
@@ -0,0 +1,253 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// DefaultLoopPollInterval is the default Loop.PollInterval.
+const DefaultLoopPollInterval = time.Minute
+
+// loopTimerTag is the clock tag of the timers that the Loop's shards wait on.
+const loopTimerTag = "tumble-loop-wait"
+
+var loopKey = "holds a *tumble.Loop"
+
+// Loop processes tumble Mutations in-process, for use outside of App Engine.
+// It replaces the task queue and cron handlers installed by
+// Service.InstallHandlers.
+//
+// A Loop runs one goroutine per shard (Config.NumShards). When Mutations are
+// recorded with a context that the Loop is installed into (see UseLoop), the
+// affected shards are woken up directly instead of firing task queue tasks.
+// Each shard is then processed once its next time slot arrives, i.e. after
+// TemporalMinDelay, rounded to TemporalRoundFactor, exactly as the equivalent
+// task would have been. Additionally, every shard is polled every PollInterval
+// so that no work languishes forever (like the fire_all_tasks cron).
+//
+// The Loop only uses the luci/gae datastore, memcache and info services, the
+// settings and the clock.Clock installed in its context, so it works with
+// luci/gae/impl/memory or any other implementation of those services.
+type Loop struct {
+	// Namespaces is a function that returns the datastore namespaces that the
+	// Loop will poll.
+	//
+	// If nil, the Loop will be executed against all namespaces registered in
+	// the datastore.
+	Namespaces func(context.Context) ([]string, error)
+
+	// PollInterval is the interval at which each shard is processed even if it
+	// wasn't woken up. If zero, DefaultLoopPollInterval will be used.
+	PollInterval time.Duration
+
+	mu     sync.Mutex
+	shards []*loopShard
+}
+
+// UseLoop installs l into c. Mutations recorded with the returned context
+// (e.g. by RunMutation or AddToJournal) will wake up l's shards instead of
+// firing task queue tasks.
+func UseLoop(c context.Context, l *Loop) context.Context {
+	return context.WithValue(c, &loopKey, l)
+}
+
+func getLoop(c context.Context) *Loop {
+	l, _ := c.Value(&loopKey).(*Loop)
+	return l
+}
+
+// Run processes tumble shards until c is cancelled. It blocks until all of the
+// shard goroutines have exited.
+//
+// The number of shards is read from the configuration when Run starts, so
+// changes to NumShards take effect the next time the Loop is started.
+//
+// It's an error to Run the same Loop more than once at a time.
+func (l *Loop) Run(c context.Context) {
+	c = UseLoop(c, l)
+	cfg := getConfig(c)
+
+	shards := make([]*loopShard, cfg.NumShards)
+	for i := range shards {
+		shards[i] = &loopShard{
+			id:    uint64(i),
+			wakeC: make(chan struct{}, 1),
+		}
+	}
+
+	l.mu.Lock()
+	if l.shards != nil {
+		l.mu.Unlock()
+		panic("tumble: Loop is already running")
+	}
+	l.shards = shards
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		l.shards = nil
+		l.mu.Unlock()
+	}()
+
+	logging.Infof(c, "Starting tumble loop with %d shards.", len(shards))
+
+	wg := sync.WaitGroup{}
+	for _, s := range shards {
+		s := s
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.runShard(c, s)
+		}()
+	}
+	wg.Wait()
+
+	logging.Infof(c, "Tumble loop stopped.")
+}
+
+// schedule wakes up the loop's shards. It is the Loop equivalent of firing
+// task queue tasks for them.
+func (l *Loop) schedule(c context.Context, cfg *Config, shards map[taskShard]struct{}) {
+	l.mu.Lock()
+	running := l.shards
+	l.mu.Unlock()
+
+	if running == nil {
+		// The Loop isn't running. The shards will be polled when it starts.
+		return
+	}
+
+	nextSlot := mkTimestamp(cfg, clock.Now(c).UTC())
+	for shard := range shards {
+		if shard.shard >= uint64(len(running)) {
+			logging.Warningf(c, "not waking up shard %d, loop has %d shards", shard.shard, len(running))
+			continue
+		}
+
+		eta := nextSlot
+		if cfg.DelayedMutations && shard.time > eta {
+			eta = shard.time
+		}
+		running[shard.shard].wake(eta.Unix())
+	}
+}
+
+func (l *Loop) runShard(c context.Context, s *loopShard) {
+	c = logging.SetField(c, "shard", s.id)
+
+	pollInterval := l.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultLoopPollInterval
+	}
+
+	waitCtx := clock.Tag(c, loopTimerTag)
+
+	// Poll once at startup, to pick up any work that was recorded while the
+	// Loop wasn't running.
+	nextPoll := clock.Now(c).UTC()
+	for c.Err() == nil {
+		now := clock.Now(c).UTC()
+		wait := nextPoll.Sub(now)
+		if eta := s.getETA(); !eta.IsZero() && eta.Sub(now) < wait {
+			wait = eta.Sub(now)
+		}
+
+		if wait > 0 {
+			waitC, cancel := context.WithCancel(waitCtx)
+			select {
+			case <-s.wakeC:
+				// Our ETA may have changed; re-evaluate.
+				cancel()
+				continue
+
+			case tr := <-clock.After(waitC, wait):
+				cancel()
+				if tr.Incomplete() {
+					continue
+				}
+			}
+		}
+
+		cfg := getConfig(c)
+		now = clock.Now(c).UTC()
+		if !now.Before(nextPoll) {
+			// Schedule the shard, just like fire_all_tasks would.
+			nextPoll = now.Add(pollInterval)
+			s.wake(mkTimestamp(cfg, now).Unix())
+			continue
+		}
+
+		if ts := s.takeETA(now); !ts.IsZero() {
+			l.processShard(c, cfg, s, ts)
+		}
+	}
+}
+
+func (l *Loop) processShard(c context.Context, cfg *Config, s *loopShard, ts time.Time) {
+	namespaces, err := getNamespaces(c, cfg, l.Namespaces)
+	if err == nil {
+		err = processShard(c, cfg, namespaces, ts, s.id)
+	}
+	if err != nil && c.Err() == nil {
+		// Retry in the next time slot, like the task queue would.
+		logging.WithError(err).Errorf(c, "Failed to process shard, retrying.")
+		s.wake(mkTimestamp(cfg, clock.Now(c).UTC()).Unix())
+	}
+}
+
+// loopShard is the state of a single shard's goroutine in a Loop.
+type loopShard struct {
+	id uint64
+
+	mu sync.Mutex
+	// eta is the time at which this shard should next be processed. If it's
+	// zero, the shard isn't scheduled.
+	eta time.Time
+
+	// wakeC is signalled when eta is changed.
+	wakeC chan struct{}
+}
+
+// wake schedules the shard to be processed at eta, unless it's already
+// scheduled to be processed earlier.
+func (s *loopShard) wake(eta time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.eta.IsZero() || eta.Before(s.eta) {
+		s.eta = eta
+		select {
+		case s.wakeC <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *loopShard) getETA() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.eta
+}
+
+// takeETA returns the shard's eta and unschedules it if the eta is not after
+// now. Otherwise it returns the zero time.
+func (s *loopShard) takeETA(now time.Time) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	eta := s.eta
+	if eta.IsZero() || eta.After(now) {
+		return time.Time{}
+	}
+	s.eta = time.Time{}
+	return eta
+}
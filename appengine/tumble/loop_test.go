@@ -0,0 +1,135 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/clockflag"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestLoop(t *testing.T) {
+	t.Parallel()
+
+	Convey("Loop", t, func() {
+		tt := &Testing{}
+		c := tt.Context()
+		clk := clock.Get(c).(testclock.TestClock)
+		ds := datastore.Get(c)
+
+		cfg := tt.GetConfig(c)
+		cfg.NumShards = 4
+		cfg.TemporalMinDelay = 0
+		cfg.TemporalRoundFactor = clockflag.Duration(time.Second)
+		tt.UpdateSettings(c, cfg)
+
+		grp := &FlakyGroup{}
+		So(ds.Put(grp), ShouldBeNil)
+
+		l := &Loop{PollInterval: time.Hour}
+
+		// A shard which waits for less than PollInterval is waiting for its next
+		// time slot, so we advance the clock to it right away. A shard which
+		// waits for longer has nothing left to do, and is waiting for its next
+		// poll: report it as idle.
+		idleC := make(chan struct{}, 16)
+		clk.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			if !testclock.HasTags(t, loopTimerTag) {
+				return
+			}
+			if d < l.PollInterval/2 {
+				clk.Add(d)
+			} else {
+				idleC <- struct{}{}
+			}
+		})
+
+		// waitIdle waits for n shards to become idle.
+		waitIdle := func(n uint64) {
+			for i := uint64(0); i < n; i++ {
+				<-idleC
+			}
+		}
+
+		count := func() int64 {
+			So(ds.Get(grp), ShouldBeNil)
+			return grp.Count
+		}
+
+		runLoop := func() func() {
+			c, cancel := context.WithCancel(c)
+			doneC := make(chan struct{})
+			go func() {
+				defer close(doneC)
+				l.Run(c)
+			}()
+			return func() {
+				cancel()
+				<-doneC
+			}
+		}
+
+		Convey("processes mutations recorded while it's running", func() {
+			stop := runLoop()
+			defer stop()
+			waitIdle(cfg.NumShards)
+
+			// All FlakyMutations have the same root, so they're in the same shard.
+			lc := UseLoop(c, l)
+			So(AddToJournal(lc, &FlakyMutation{"a"}), ShouldBeNil)
+			waitIdle(1)
+			So(count(), ShouldEqual, 1)
+
+			So(AddToJournal(lc, &FlakyMutation{"b"}, &FlakyMutation{"c"}), ShouldBeNil)
+			waitIdle(1)
+			So(count(), ShouldEqual, 3)
+		})
+
+		Convey("picks up mutations recorded before it started", func() {
+			So(AddToJournal(c, &FlakyMutation{"a"}), ShouldBeNil)
+
+			stop := runLoop()
+			defer stop()
+			waitIdle(cfg.NumShards)
+			So(count(), ShouldEqual, 1)
+		})
+
+		Convey("doesn't process mutations before their time slot", func() {
+			stop := runLoop()
+			defer stop()
+			waitIdle(cfg.NumShards)
+
+			// Without a Loop in the context, nothing wakes up the shard.
+			So(AddToJournal(c, &FlakyMutation{"a"}), ShouldBeNil)
+			So(count(), ShouldEqual, 0)
+
+			// Until it's polled.
+			clk.Add(l.PollInterval)
+			waitIdle(cfg.NumShards)
+			So(count(), ShouldEqual, 1)
+		})
+
+		Convey("stops when its context is cancelled", func() {
+			stop := runLoop()
+			waitIdle(cfg.NumShards)
+			stop()
+
+			// It can be started again once stopped.
+			stop = runLoop()
+			defer stop()
+			waitIdle(cfg.NumShards)
+
+			So(AddToJournal(UseLoop(c, l), &FlakyMutation{"a"}), ShouldBeNil)
+			waitIdle(1)
+			So(count(), ShouldEqual, 1)
+		})
+	})
+}
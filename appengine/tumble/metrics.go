@@ -0,0 +1,84 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/gae/service/info"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/parallel"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/metric"
+	"golang.org/x/net/context"
+)
+
+// backlogCountLimit is the maximum number of Mutations counted per shard when
+// reporting the shard backlog. Counting stops here to bound the cost of the
+// query; a backlog this large is already a problem.
+const backlogCountLimit = 10000
+
+var (
+	metricMutationsFailed = metric.NewCounter(
+		"tumble/mutations/failed",
+		"Number of times a Mutation's RollForward returned an error.",
+		field.String("type"))
+	metricMutationsDeadLettered = metric.NewCounter(
+		"tumble/mutations/dead_lettered",
+		"Number of Mutations moved to the dead-letter kind.",
+		field.String("type"))
+	metricShardBacklog = metric.NewInt(
+		"tumble/shard/backlog",
+		"Number of pending Mutations in a shard (capped at 10000).",
+		field.String("namespace"),
+		field.Int("shard"))
+)
+
+func backlogQuery(c context.Context, cfg *Config, shard uint64) *datastore.Query {
+	low, high := expandedShardBounds(c, cfg, shard)
+	if low > high {
+		return nil
+	}
+
+	return datastore.NewQuery("tumble.Mutation").
+		Gte("ExpandedShard", low).Lte("ExpandedShard", high).
+		KeysOnly(true).Limit(backlogCountLimit)
+}
+
+// reportShardBacklog counts the pending Mutations of every shard in each of the
+// namespaces and reports them to tsmon.
+//
+// Errors are logged, but otherwise ignored.
+func reportShardBacklog(c context.Context, cfg *Config, namespaces []string) {
+	err := parallel.WorkPool(cfg.NumGoroutines, func(ch chan<- func() error) {
+		for _, ns := range namespaces {
+			c := c
+			if ns != "" {
+				c = info.Get(c).MustNamespace(ns)
+			}
+
+			for i := uint64(0); i < cfg.NumShards; i++ {
+				ns, shard := ns, i
+				ch <- func() error {
+					q := backlogQuery(c, cfg, shard)
+					if q == nil {
+						return nil
+					}
+					amt, err := datastore.Get(c).Count(q)
+					if err != nil {
+						logging.Fields{
+							logging.ErrorKey: err,
+							"shard":          shard,
+						}.Warningf(c, "failed to count shard backlog")
+						return err
+					}
+					return metricShardBacklog.Set(c, amt, ns, int64(shard))
+				}
+			}
+		}
+	})
+	if err != nil {
+		logging.WithError(err).Warningf(c, "failed to report shard backlog")
+	}
+}
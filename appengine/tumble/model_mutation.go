@@ -55,7 +55,9 @@ type Mutation interface {
 	// It is only considered sucessful if it returns nil. If it returns non-nil,
 	// then it will be retried at a later time. If it never returns nil, then it
 	// will never be flushed from tumble's queue, and you'll have to manually
-	// delete it or fix the code so that it can be handled without error.
+	// delete it or fix the code so that it can be handled without error. If
+	// Config.MaxRetries is set, it will instead be moved to the dead-letter kind
+	// once it has failed too many times.
 	//
 	// This method runs inside of a single-group transaction. It must modify only
 	// the entity group specified by Root().
@@ -119,6 +121,78 @@ type realMutation struct {
 	Version string
 	Type    string
 	Data    []byte `gae:",noindex"`
+
+	// FailureCount is the number of times that this Mutation's RollForward has
+	// returned an error.
+	FailureCount int64 `gae:",noindex"`
+	// LastError is the text of the most recent RollForward error.
+	LastError string `gae:",noindex"`
+}
+
+// deadMutation is a realMutation which has failed more than Config.MaxRetries
+// times. It's stored with the same ID and Parent as the realMutation it was
+// created from, but under a different kind so that tumble no longer processes
+// it.
+type deadMutation struct {
+	_kind  string         `gae:"$kind,tumble.DeadMutation"`
+	ID     string         `gae:"$id"`
+	Parent *datastore.Key `gae:"$parent"`
+
+	ExpandedShard int64     `gae:",noindex"`
+	ProcessAfter  time.Time `gae:",noindex"`
+	TargetRoot    *datastore.Key
+
+	Version string `gae:",noindex"`
+	Type    string
+	Data    []byte `gae:",noindex"`
+
+	FailureCount int64  `gae:",noindex"`
+	LastError    string `gae:",noindex"`
+
+	// DeadAt is the time when this mutation was dead-lettered.
+	DeadAt time.Time
+}
+
+func (r *realMutation) toDead(now time.Time) *deadMutation {
+	return &deadMutation{
+		ID:     r.ID,
+		Parent: r.Parent,
+
+		ExpandedShard: r.ExpandedShard,
+		ProcessAfter:  r.ProcessAfter,
+		TargetRoot:    r.TargetRoot,
+
+		Version: r.Version,
+		Type:    r.Type,
+		Data:    r.Data,
+
+		FailureCount: r.FailureCount,
+		LastError:    r.LastError,
+
+		DeadAt: now,
+	}
+}
+
+// toReal returns a fresh realMutation for this deadMutation, with its failure
+// counters reset. The mutation will be processed no earlier than now.
+func (d *deadMutation) toReal(now time.Time) *realMutation {
+	return &realMutation{
+		ID:     d.ID,
+		Parent: d.Parent,
+
+		ExpandedShard: d.ExpandedShard,
+		ProcessAfter:  now,
+		TargetRoot:    d.TargetRoot,
+
+		Version: d.Version,
+		Type:    d.Type,
+		Data:    d.Data,
+	}
+}
+
+// GetMutation decodes the Mutation held by this deadMutation.
+func (d *deadMutation) GetMutation() (Mutation, error) {
+	return decodeMutation(d.Type, d.Data)
 }
 
 func (r *realMutation) shard(cfg *Config) taskShard {
@@ -230,13 +304,17 @@ func newRealMutation(c context.Context, cfg *Config, id string, parent *datastor
 }
 
 func (r *realMutation) GetMutation() (Mutation, error) {
-	typ, ok := registry[r.Type]
+	return decodeMutation(r.Type, r.Data)
+}
+
+func decodeMutation(typName string, data []byte) (Mutation, error) {
+	typ, ok := registry[typName]
 	if !ok {
-		return nil, fmt.Errorf("unable to load reflect.Type for %q", r.Type)
+		return nil, fmt.Errorf("unable to load reflect.Type for %q", typName)
 	}
 
 	ret := reflect.New(typ)
-	if err := gob.NewDecoder(bytes.NewBuffer(r.Data)).DecodeValue(ret); err != nil {
+	if err := gob.NewDecoder(bytes.NewBuffer(data)).DecodeValue(ret); err != nil {
 		return nil, err
 	}
 
@@ -346,11 +346,13 @@ func processRoot(c context.Context, cfg *Config, root *datastore.Key, banSet str
 	allShards := map[taskShard]struct{}{}
 
 	toDel := make([]*datastore.Key, 0, len(muts))
+	failures := []mutationFailure(nil)
 	numMuts := uint64(0)
 	deletedMuts := uint64(0)
 	processedMuts := uint64(0)
 	err = datastore.Get(txnBuf.FilterRDS(c)).RunInTransaction(func(c context.Context) error {
 		toDel = toDel[:0]
+		failures = failures[:0]
 		numMuts = 0
 		deletedMuts = 0
 		processedMuts = 0
@@ -365,6 +367,7 @@ func processRoot(c context.Context, cfg *Config, root *datastore.Key, banSet str
 			shards, newMuts, newMutKeys, err := enterTransactionInternal(c, cfg, overrideRoot{m, root}, uint64(i))
 			if err != nil {
 				l.Errorf("Executing decoded gob(%T) failed: %q: %+v", m, err, m)
+				failures = append(failures, mutationFailure{iterMutKeys[i], fmt.Sprintf("%T", m), err})
 				continue
 			}
 			processedMuts++
@@ -411,6 +414,15 @@ func processRoot(c context.Context, cfg *Config, root *datastore.Key, banSet str
 	fireTasks(c, cfg, allShards)
 	l.Infof("successfully processed %d mutations (%d tail-call), adding %d more", processedMuts, deletedMuts, numMuts)
 
+	if len(failures) > 0 {
+		// Don't retry failed mutations again in this task; they'll be picked up by
+		// a later one.
+		for _, f := range failures {
+			banSet.Add(f.key.Encode())
+		}
+		recordFailures(c, cfg, failures)
+	}
+
 	if len(toDel) > 0 {
 		atomic.StoreInt64(counter, int64(len(toDel)))
 
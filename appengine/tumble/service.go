@@ -18,6 +18,8 @@ import (
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/parallel"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/middleware"
 	"golang.org/x/net/context"
 )
 
@@ -41,6 +43,14 @@ type Service struct {
 }
 
 // InstallHandlers installs http handlers.
+//
+// In addition to the cron and task queue handlers, this installs the following
+// dead mutation admin handlers, which may only be used by members of the
+// "administrators" group, authenticated with an OAuth2 access token:
+//   * GET  /internal/tumble/admin/dead_mutations
+//   * GET  /internal/tumble/admin/dead_mutations/:key
+//   * POST /internal/tumble/admin/dead_mutations/:key/retry
+//   * POST /internal/tumble/admin/dead_mutations/:key/discard
 func (s *Service) InstallHandlers(r *httprouter.Router) {
 	// GET so that this can be invoked from cron
 	r.GET(fireAllTasksURL,
@@ -48,6 +58,14 @@ func (s *Service) InstallHandlers(r *httprouter.Router) {
 
 	r.POST(processShardPattern,
 		gaemiddleware.BaseProd(gaemiddleware.RequireTaskQueue(baseName, s.ProcessShardHandler)))
+
+	admin := func(h middleware.Handler) httprouter.Handle {
+		return gaemiddleware.BaseProd(auth.Use(auth.Authenticate(requireAdmin(h)), adminAuth))
+	}
+	r.GET(deadMutationsURL, admin(s.ListDeadMutationsHandler))
+	r.GET(deadMutationsURL+"/:key", admin(s.GetDeadMutationHandler))
+	r.POST(deadMutationsURL+"/:key/retry", admin(s.RetryDeadMutationHandler))
+	r.POST(deadMutationsURL+"/:key/discard", admin(s.DiscardDeadMutationHandler))
 }
 
 // FireAllTasksHandler is a http handler suitable for installation into
@@ -78,6 +96,7 @@ func (s *Service) FireAllTasks(c context.Context) error {
 	if err != nil {
 		return err
 	}
+	reportShardBacklog(c, cfg, nspaces)
 
 	err = parallel.WorkPool(cfg.NumGoroutines, func(ch chan<- func() error) {
 		// Since shards are cross-namespace, missingShards represents the total
@@ -157,10 +176,15 @@ func (s *Service) FireAllTasks(c context.Context) error {
 	return err
 }
 
-func (s *Service) getNamespaces(c context.Context, cfg *Config) (namespaces []string, err error) {
+func (s *Service) getNamespaces(c context.Context, cfg *Config) ([]string, error) {
+	return getNamespaces(c, cfg, s.Namespaces)
+}
+
+// getNamespaces returns the namespaces that tumble should process. If nsFn is
+// nil, all of the namespaces registered in the datastore are used.
+func getNamespaces(c context.Context, cfg *Config, nsFn func(context.Context) ([]string, error)) (namespaces []string, err error) {
 	// Get the set of namespaces to handle.
 	if cfg.Namespaced {
-		nsFn := s.Namespaces
 		if nsFn == nil {
 			nsFn = getDatastoreNamespaces
 		}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"html/template"
 	"strconv"
+	"strings"
 
 	"github.com/luci/luci-go/common/clock/clockflag"
 	"github.com/luci/luci-go/common/logging"
@@ -81,6 +82,13 @@ func (settingsUIPage) Fields(c context.Context) ([]settings.UIField, error) {
 			Placeholder: strconv.Itoa(int(defaultConfig.ProcessMaxBatchSize)),
 			Validator:   intValidator(false),
 		},
+		{
+			ID:          "MaxRetries",
+			Title:       "Number of times to retry a failing mutation before dead-lettering it (0 for unlimited)",
+			Type:        settings.UIFieldText,
+			Placeholder: strconv.Itoa(int(defaultConfig.MaxRetries)),
+			Validator:   nonNegativeIntValidator,
+		},
 		{
 			ID:             "DelayedMutations",
 			Title:          "Delayed mutations (index MUST be present)",
@@ -128,6 +136,9 @@ func (settingsUIPage) ReadSettings(c context.Context) (map[string]string, error)
 	if cfg.ProcessMaxBatchSize != 0 {
 		values["ProcessMaxBatchSize"] = strconv.FormatInt(int64(cfg.ProcessMaxBatchSize), 10)
 	}
+	if cfg.MaxRetries != 0 {
+		values["MaxRetries"] = strconv.FormatInt(int64(cfg.MaxRetries), 10)
+	}
 
 	values["DelayedMutations"] = getToggleSetting(cfg.DelayedMutations)
 	values["Namespaced"] = getToggleSetting(cfg.Namespaced)
@@ -176,6 +187,13 @@ func (settingsUIPage) WriteSettings(c context.Context, values map[string]string,
 		}
 		cfg.ProcessMaxBatchSize = int32(val)
 	}
+	if v := values["MaxRetries"]; v != "" {
+		val, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("could not parse MaxRetries: %v", err)
+		}
+		cfg.MaxRetries = int32(val)
+	}
 	cfg.DelayedMutations = values["DelayedMutations"] == settingEnabled
 	cfg.Namespaced = values["Namespaced"] == settingEnabled
 
@@ -198,6 +216,16 @@ func intValidator(positive bool) func(string) error {
 	}
 }
 
+func nonNegativeIntValidator(v string) error {
+	if err := intValidator(false)(v); err != nil {
+		return err
+	}
+	if strings.HasPrefix(v, "-") {
+		return fmt.Errorf("value %q must not be negative", v)
+	}
+	return nil
+}
+
 func validateDuration(v string) error {
 	if v == "" {
 		return nil
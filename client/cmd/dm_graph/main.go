@@ -0,0 +1,118 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Command dm_graph walks the graph of a DM service, and exports it as
+// Graphviz DOT or JSON, to make it easier to debug Quest fan-out.
+//
+// Example:
+//   dm_graph -host luci-dm.appspot.com export -format dot -depth 3 \
+//     QUEST_ID:1 | dot -Tsvg > graph.svg
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/client/authcli"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	"github.com/luci/luci-go/common/auth"
+	"github.com/luci/luci-go/common/cli"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/logging/gologger"
+	"github.com/luci/luci-go/common/prpc"
+)
+
+func init() {
+	prpc.DefaultUserAgent = "dm_graph"
+}
+
+type application struct {
+	cli.Application
+	context.Context
+
+	authFlags authcli.Flags
+	host      string
+	insecure  bool
+
+	deps dm.DepsClient
+}
+
+func (a *application) addToFlagSet(fs *flag.FlagSet) {
+	fs.StringVar(&a.host, "host", "",
+		"The DM service [host][:port].")
+	fs.BoolVar(&a.insecure, "insecure", false,
+		"Use insecure transport for RPC.")
+}
+
+func mainImpl() int {
+	ctx := context.Background()
+	ctx = gologger.StdConfig.Use(ctx)
+
+	authOptions := auth.Options{}
+
+	a := application{
+		Application: cli.Application{
+			Name:    "dm_graph",
+			Title:   "DM graph export CLI",
+			Context: func(context.Context) context.Context { return ctx },
+
+			Commands: []*subcommands.Command{
+				subcommands.CmdHelp,
+				newExportCommand(),
+				authcli.SubcommandLogin(authOptions, "auth-login"),
+				authcli.SubcommandLogout(authOptions, "auth-logout"),
+				authcli.SubcommandInfo(authOptions, "auth-info"),
+			},
+		},
+	}
+	loggingConfig := log.Config{
+		Level: log.Level(log.Info),
+	}
+
+	flags := &flag.FlagSet{}
+	a.addToFlagSet(flags)
+	loggingConfig.AddFlags(flags)
+	a.authFlags.Register(flags, authOptions)
+
+	if err := flags.Parse(os.Args[1:]); err != nil {
+		log.Errorf(log.SetError(ctx, err), "Failed to parse command-line.")
+		return 1
+	}
+
+	ctx = loggingConfig.Set(ctx)
+
+	if a.host == "" {
+		log.Errorf(ctx, "Missing DM host (-host).")
+		return 1
+	}
+
+	authOpts, err := a.authFlags.Options()
+	if err != nil {
+		log.Errorf(log.SetError(ctx, err), "Failed to create auth options.")
+		return 1
+	}
+	httpClient, err := auth.NewAuthenticator(ctx, auth.OptionalLogin, authOpts).Client()
+	if err != nil {
+		log.Errorf(log.SetError(ctx, err), "Failed to create authenticated client.")
+		return 1
+	}
+
+	prpcClient := &prpc.Client{
+		C:       httpClient,
+		Host:    a.host,
+		Options: prpc.DefaultOptions(),
+	}
+	prpcClient.Options.Insecure = a.insecure
+
+	a.deps = dm.NewDepsPRPCClient(prpcClient)
+	a.Context = ctx
+	return subcommands.Run(&a, flags.Args())
+}
+
+func main() {
+	os.Exit(mainImpl())
+}
@@ -0,0 +1,171 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maruel/subcommands"
+
+	"github.com/luci/luci-go/common/api/dm/graphexport"
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	log "github.com/luci/luci-go/common/logging"
+	google_pb "github.com/luci/luci-go/common/proto/google"
+)
+
+var directions = map[string]dm.WalkGraphReq_Mode_Direction{
+	"forwards":  dm.WalkGraphReq_Mode_FORWARDS,
+	"backwards": dm.WalkGraphReq_Mode_BACKWARDS,
+	"both":      dm.WalkGraphReq_Mode_BOTH,
+}
+
+type exportCommandRun struct {
+	subcommands.CommandRunBase
+
+	format    string
+	output    string
+	direction string
+	depth     int64
+	maxTime   time.Duration
+	expired   bool
+}
+
+func newExportCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "export [options] QUEST[:ATTEMPT[,ATTEMPT...]]...",
+		ShortDesc: "Walks the DM graph from the given Attempts and exports it.",
+		LongDesc: "Walks the DM graph from the given Attempts, and writes it as Graphviz DOT or JSON. " +
+			"A QUEST without attempt numbers starts from all of its Attempts.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &exportCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.format, "format", "dot", "The export format (dot or json).")
+			fs.StringVar(&cmd.output, "o", "-", "The file to write the export to, or '-' for stdout.")
+			fs.StringVar(&cmd.direction, "direction", "forwards",
+				"The direction to walk dependencies in (forwards, backwards or both).")
+			fs.Int64Var(&cmd.depth, "depth", -1,
+				"The number of dependencies to walk from the given Attempts (-1 for no limit).")
+			fs.DurationVar(&cmd.maxTime, "max-time", 0,
+				"The maximum amount of time that DM should spend walking the graph.")
+			fs.BoolVar(&cmd.expired, "expired", false, "Include Attempts whose results have expired.")
+			return cmd
+		},
+	}
+}
+
+// parseAttempts parses QUEST[:ATTEMPT[,ATTEMPT...]] arguments into an
+// AttemptList. A bare QUEST selects all of its attempts, even if other
+// arguments name specific attempts of the same quest.
+func parseAttempts(args []string) (*dm.AttemptList, error) {
+	ret := &dm.AttemptList{To: map[string]*dm.AttemptList_Nums{}}
+	allAttempts := map[string]bool{}
+	for _, arg := range args {
+		quest, nums := arg, ""
+		if idx := strings.IndexRune(arg, ':'); idx >= 0 {
+			quest, nums = arg[:idx], arg[idx+1:]
+		}
+		if quest == "" {
+			return nil, fmt.Errorf("missing quest in %q", arg)
+		}
+		if nums == "" {
+			// All attempts of the quest.
+			allAttempts[quest] = true
+			ret.To[quest] = &dm.AttemptList_Nums{}
+			continue
+		}
+		for _, n := range strings.Split(nums, ",") {
+			num, err := strconv.ParseUint(n, 10, 32)
+			if err != nil || num == 0 {
+				return nil, fmt.Errorf("invalid attempt number %q in %q", n, arg)
+			}
+			if !allAttempts[quest] {
+				ret.AddAIDs(dm.NewAttemptID(quest, uint32(num)))
+			}
+		}
+	}
+	return ret, ret.Normalize()
+}
+
+func (cmd *exportCommandRun) Run(scApp subcommands.Application, args []string) int {
+	a := scApp.(*application)
+
+	if len(args) == 0 {
+		log.Errorf(a, "At least one QUEST must be specified.")
+		return 1
+	}
+	if cmd.format != "dot" && cmd.format != "json" {
+		log.Errorf(a, "Unknown export format %q.", cmd.format)
+		return 1
+	}
+	dir, ok := directions[cmd.direction]
+	if !ok {
+		log.Errorf(a, "Unknown direction %q.", cmd.direction)
+		return 1
+	}
+	al, err := parseAttempts(args)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid attempts.")
+		return 1
+	}
+
+	req := &dm.WalkGraphReq{
+		Query: dm.AttemptListQuery(al),
+		Mode:  &dm.WalkGraphReq_Mode{Direction: dir},
+		Limit: &dm.WalkGraphReq_Limit{MaxDepth: cmd.depth},
+		Include: &dm.WalkGraphReq_Include{
+			AttemptData:     true,
+			ExpiredAttempts: cmd.expired,
+			FwdDeps:         dir != dm.WalkGraphReq_Mode_BACKWARDS,
+			BackDeps:        dir != dm.WalkGraphReq_Mode_FORWARDS,
+		},
+	}
+	if cmd.maxTime > 0 {
+		req.Limit.MaxTime = google_pb.NewDuration(cmd.maxTime)
+	}
+	if err := req.Normalize(); err != nil {
+		log.WithError(err).Errorf(a, "Invalid WalkGraph request.")
+		return 1
+	}
+
+	gd, err := a.deps.WalkGraph(a, req)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to walk the DM graph.")
+		return 1
+	}
+	g := graphexport.FromGraphData(gd)
+	if g.HadMore {
+		log.Fields{
+			"hadErrors": g.HadErrors,
+		}.Warningf(a, "The exported graph is incomplete.")
+	}
+
+	var w io.Writer = os.Stdout
+	if cmd.output != "-" {
+		f, err := os.Create(cmd.output)
+		if err != nil {
+			log.WithError(err).Errorf(a, "Failed to create output file.")
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if cmd.format == "dot" {
+		err = g.WriteDOT(w)
+	} else {
+		err = g.WriteJSON(w)
+	}
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to write the export.")
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,61 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseAttempts(t *testing.T) {
+	t.Parallel()
+
+	Convey("parseAttempts", t, func() {
+		good := []struct {
+			name   string
+			args   []string
+			expect map[string][]uint32
+		}{
+			{"all attempts", []string{"q"}, map[string][]uint32{"q": nil}},
+			{"one attempt", []string{"q:1"}, map[string][]uint32{"q": {1}}},
+			{"several attempts", []string{"q:3,1", "q:2"}, map[string][]uint32{"q": {1, 2, 3}}},
+			{"duplicate attempts", []string{"q:1,1", "q:1"}, map[string][]uint32{"q": {1}}},
+			{"several quests", []string{"q:1", "r"}, map[string][]uint32{"q": {1}, "r": nil}},
+			{"all, then some attempts", []string{"q", "q:1"}, map[string][]uint32{"q": nil}},
+			{"some attempts, then all", []string{"q:1", "q"}, map[string][]uint32{"q": nil}},
+			{"all, then some, then more", []string{"q:2", "q", "q:1,3"}, map[string][]uint32{"q": nil}},
+		}
+		for _, tc := range good {
+			tc := tc
+			Convey(tc.name, func() {
+				al, err := parseAttempts(tc.args)
+				So(err, ShouldBeNil)
+				So(al, ShouldResemble, dm.NewAttemptList(tc.expect))
+			})
+		}
+
+		bad := []struct {
+			name string
+			args []string
+			err  string
+		}{
+			{"missing quest", []string{":1"}, `missing quest in ":1"`},
+			{"zero attempt", []string{"q:0"}, `invalid attempt number "0" in "q:0"`},
+			{"bad attempt", []string{"q:x"}, `invalid attempt number "x" in "q:x"`},
+			{"empty attempt", []string{"q:1,"}, `invalid attempt number "" in "q:1,"`},
+			{"bad attempt after all", []string{"q", "q:x"}, `invalid attempt number "x" in "q:x"`},
+		}
+		for _, tc := range bad {
+			tc := tc
+			Convey(tc.name, func() {
+				_, err := parseAttempts(tc.args)
+				So(err, ShouldErrLike, tc.err)
+			})
+		}
+	})
+}
@@ -7,11 +7,13 @@ package main
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"runtime"
 	"strings"
 	"time"
 
 	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	"github.com/luci/luci-go/client/internal/logdog/butler/output/ingest"
 	out "github.com/luci/luci-go/client/internal/logdog/butler/output/pubsub"
 	api "github.com/luci/luci-go/common/api/logdog_coordinator/registration/v1"
 	"github.com/luci/luci-go/common/auth"
@@ -108,8 +110,31 @@ func (f *logdogOutputFactory) configOutput(a *application) (output.Output, error
 	log.Fields{
 		"prefix":      a.prefix,
 		"bundleTopic": resp.LogBundleTopic,
+		"bundleURL":   resp.LogBundleUrl,
 	}.Debugf(a, "Successfully registered log stream prefix.")
 
+	// If the Coordinator directed us to a direct ingestion URL, POST our bundles
+	// to it instead of using Pub/Sub.
+	//
+	// Note that we use our non-cancelling context here.
+	if resp.LogBundleUrl != "" {
+		if _, err := url.Parse(resp.LogBundleUrl); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"url":        resp.LogBundleUrl,
+			}.Errorf(a, "Coordinator returned invalid log bundle URL.")
+			return nil, err
+		}
+
+		return ingest.New(a.ncCtx, ingest.Config{
+			URL:      resp.LogBundleUrl,
+			Client:   httpClient,
+			Secret:   resp.Secret,
+			Compress: true,
+			Track:    f.track,
+		}), nil
+	}
+
 	// Validate the response topic.
 	fullTopic := ps.Topic(resp.LogBundleTopic)
 	if err := fullTopic.Validate(); err != nil {
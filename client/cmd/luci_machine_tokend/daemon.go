@@ -0,0 +1,302 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry"
+
+	"github.com/luci/luci-go/client/tokenclient"
+	"github.com/luci/luci-go/common/api/tokenserver"
+)
+
+// SecretHeader is HTTP header with the daemon secret.
+//
+// Requests to /token must have it set to the content of the secret file.
+const SecretHeader = "X-Luci-Machine-Tokend-Secret"
+
+// minRefreshDelay is the minimum delay between two refresh attempts.
+//
+// It stops refreshLoop from spinning if the minted token needs to be refreshed
+// right away (e.g. its lifetime is shorter than the refresh margin).
+const minRefreshDelay = 10 * time.Second
+
+// daemon keeps the token fresh and serves it over HTTP.
+//
+// It is used when tokend is launched with -daemon flag.
+type daemon struct {
+	ctx          context.Context // the root context, used by HTTP handlers
+	opts         commandLine
+	clientParams tokenclient.ClientParameters
+	secret       []byte // per-boot secret, see makeSecret
+
+	// refresher is called by refreshLoop to refresh the token. If nil, refresh
+	// is used. Mocked in tests.
+	refresher func(ctx context.Context) (next time.Time, err error)
+
+	lock   sync.RWMutex
+	token  *tokenserver.TokenFile // the last successfully minted token or nil
+	status *StatusReport          // the status of the last refresh attempt or nil
+}
+
+// runDaemon refreshes the token and serves it until interrupted.
+func runDaemon(ctx context.Context, clientParams tokenclient.ClientParameters, opts commandLine) error {
+	secret, err := makeSecret(ctx, opts.SecretFile)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to generate the secret - %s", err)
+		return err
+	}
+
+	var l net.Listener
+	if opts.UnixSocket != "" {
+		os.Remove(opts.UnixSocket) // a stale socket from the previous run, if any
+		l, err = net.Listen("unix", opts.UnixSocket)
+	} else {
+		l, err = net.Listen("tcp", opts.Listen)
+	}
+	if err != nil {
+		logging.Errorf(ctx, "Failed to start listening - %s", err)
+		return err
+	}
+	logging.Infof(ctx, "Serving on %s", l.Addr())
+
+	d := &daemon{
+		ctx:          ctx,
+		opts:         opts,
+		clientParams: clientParams,
+		secret:       secret,
+	}
+
+	// On interrupt, stop refreshing the token and close the listener. It makes
+	// http.Serve return.
+	ctx, cancel := context.WithCancel(ctx)
+	catchInterrupt(func() {
+		cancel()
+		l.Close()
+	})
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		d.refreshLoop(ctx)
+	}()
+
+	err = http.Serve(l, d)
+	cancel()
+	wg.Wait()
+	if ctx.Err() != nil {
+		logging.Infof(ctx, "Interrupted, exiting")
+		return nil
+	}
+	logging.Errorf(ctx, "Failed to serve - %s", err)
+	return err
+}
+
+// makeSecret generates a random secret and saves it to a file.
+//
+// The file is readable only by the owner. Whoever can read it, can fetch the
+// token from the daemon.
+func makeSecret(ctx context.Context, path string) ([]byte, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	secret := []byte(hex.EncodeToString(buf))
+	if err := AtomicWriteFile(ctx, path, secret, 0600); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// refreshLoop refreshes the token ahead of its expiration until the context is
+// canceled.
+//
+// Failed refresh attempts are retried with exponential backoff. Attempts are
+// at least minRefreshDelay apart.
+func (d *daemon) refreshLoop(ctx context.Context) {
+	refresh := d.refresher
+	if refresh == nil {
+		refresh = d.refresh
+	}
+	var backoff retry.Iterator
+	for ctx.Err() == nil {
+		var sleep time.Duration
+		switch next, err := refresh(ctx); {
+		case err == nil:
+			backoff = nil
+			sleep = next.Sub(clock.Now(ctx))
+		case ctx.Err() != nil:
+			return
+		default:
+			if backoff == nil {
+				backoff = &retry.ExponentialBackoff{
+					Limited: retry.Limited{
+						Delay:   minRefreshDelay,
+						Retries: -1, // retry until the daemon is stopped
+					},
+					MaxDelay:   5 * time.Minute,
+					Multiplier: 2,
+				}
+			}
+			sleep = backoff.Next(ctx, err)
+		}
+		if sleep < minRefreshDelay {
+			sleep = minRefreshDelay
+		}
+		logging.Infof(ctx, "Next refresh in %s", sleep)
+		clock.Sleep(ctx, sleep)
+	}
+}
+
+// refresh mints a new token and reports the status of the attempt.
+//
+// Returns when the token should be refreshed next time.
+func (d *daemon) refresh(ctx context.Context) (next time.Time, err error) {
+	d.lock.RLock()
+	prev := d.token
+	d.lock.RUnlock()
+
+	status := &StatusReport{
+		Version:      Version,
+		Started:      clock.Now(ctx),
+		UpdateReason: UpdateReasonExpiration,
+		LastToken:    prev,
+	}
+	if prev == nil {
+		status.UpdateReason = UpdateReasonNewToken
+	}
+	defer func() {
+		status.Finished = clock.Now(ctx)
+		if err := status.SendMetrics(ctx); err != nil {
+			logging.Errorf(ctx, "Failed to send tsmon metrics - %s", err)
+		}
+		d.lock.Lock()
+		d.status = status
+		d.lock.Unlock()
+	}()
+
+	// Recreate the client each time to pick up updated key and certificate.
+	rpcCtx, cancel := context.WithTimeout(ctx, d.opts.Timeout)
+	defer cancel()
+	client, err := tokenclient.NewClient(d.clientParams)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to initialize the client - %s", err)
+		status.FailureError = err
+		status.UpdateOutcome = OutcomeCantReadKey
+		return time.Time{}, err
+	}
+	tok, err := mintToken(rpcCtx, client, status)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	status.LastToken = tok
+	status.UpdateOutcome = OutcomeUpdateSuccess
+
+	// Keep the token file up-to-date too, if asked. It is not fatal if this
+	// fails, the token is still served over HTTP. Note that writeTokenFile
+	// modifies 'tok', so do it before publishing the token.
+	if d.opts.TokenFile != "" {
+		state := stateInToken{
+			InputsDigest: calcInputsDigest(client, d.clientParams),
+			Version:      Version,
+		}
+		if err := writeTokenFile(ctx, tok, &state, d.opts.TokenFile); err != nil {
+			logging.Errorf(ctx, "Failed to save token file - %s", err)
+			status.FailureError = err
+			if os.IsPermission(err) {
+				status.UpdateOutcome = OutcomePermissionError
+			} else {
+				status.UpdateOutcome = OutcomeUnknownSaveTokenError
+			}
+		}
+	}
+
+	d.lock.Lock()
+	d.token = tok
+	d.lock.Unlock()
+	return time.Unix(tok.NextUpdate, 0), nil
+}
+
+// ServeHTTP implements http.Handler.
+//
+// It serves two endpoints:
+//   * GET /token returns the token in the same format as the token file.
+//     Requires SecretHeader.
+//   * GET /status returns the status of the last refresh attempt.
+func (d *daemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "only GET is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	switch r.URL.Path {
+	case "/token":
+		d.serveToken(w, r)
+	case "/status":
+		d.serveStatus(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (d *daemon) serveToken(w http.ResponseWriter, r *http.Request) {
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(SecretHeader)), d.secret) != 1 {
+		http.Error(w, "wrong or missing secret", http.StatusForbidden)
+		return
+	}
+
+	d.lock.RLock()
+	tok := d.token
+	d.lock.RUnlock()
+	if tok == nil || clock.Now(d.ctx).Unix() >= tok.Expiry {
+		http.Error(w, "no valid token yet, check /status", http.StatusServiceUnavailable)
+		return
+	}
+
+	// Don't expose the tokend state, it is private.
+	out := *tok
+	out.TokendState = nil
+	buf := bytes.Buffer{}
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, &out); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(buf.Bytes())
+}
+
+func (d *daemon) serveStatus(w http.ResponseWriter, r *http.Request) {
+	d.lock.RLock()
+	status := d.status
+	d.lock.RUnlock()
+	if status == nil {
+		http.Error(w, "the first refresh is still in progress", http.StatusServiceUnavailable)
+		return
+	}
+	blob, err := json.MarshalIndent(status.Report(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(blob)
+}
@@ -0,0 +1,253 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/tokenserver"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a daemon", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		d := &daemon{
+			ctx:    ctx,
+			secret: []byte("secret"),
+		}
+		d.token = &tokenserver.TokenFile{
+			LuciMachineToken: "token",
+			Expiry:           clock.Now(ctx).Add(time.Hour).Unix(),
+			TokendState:      []byte("private state"),
+		}
+
+		get := func(path, secret string) *httptest.ResponseRecorder {
+			r, err := http.NewRequest("GET", "http://localhost"+path, nil)
+			So(err, ShouldBeNil)
+			if secret != "" {
+				r.Header.Set(SecretHeader, secret)
+			}
+			w := httptest.NewRecorder()
+			d.ServeHTTP(w, r)
+			return w
+		}
+
+		Convey("serves the token", func() {
+			w := get("/token", "secret")
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+
+			out := map[string]interface{}{}
+			So(json.Unmarshal(w.Body.Bytes(), &out), ShouldBeNil)
+			So(out["luciMachineToken"], ShouldEqual, "token")
+			So(out, ShouldNotContainKey, "tokendState")
+		})
+
+		Convey("missing secret", func() {
+			So(get("/token", "").Code, ShouldEqual, http.StatusForbidden)
+		})
+
+		Convey("wrong secret", func() {
+			So(get("/token", "not a secret").Code, ShouldEqual, http.StatusForbidden)
+		})
+
+		Convey("no token yet", func() {
+			d.token = nil
+			So(get("/token", "secret").Code, ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		Convey("expired token", func() {
+			tc.Add(time.Hour)
+			So(get("/token", "secret").Code, ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		Convey("no status yet", func() {
+			So(get("/status", "").Code, ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		Convey("serves the status", func() {
+			d.status = &StatusReport{
+				Version:       Version,
+				UpdateOutcome: OutcomeUpdateSuccess,
+			}
+			w := get("/status", "")
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Body.String(), ShouldContainSubstring, string(OutcomeUpdateSuccess))
+		})
+
+		Convey("unknown path", func() {
+			So(get("/unknown", "secret").Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("only GET", func() {
+			r, err := http.NewRequest("POST", "http://localhost/token", nil)
+			So(err, ShouldBeNil)
+			r.Header.Set(SecretHeader, "secret")
+			w := httptest.NewRecorder()
+			d.ServeHTTP(w, r)
+			So(w.Code, ShouldEqual, http.StatusMethodNotAllowed)
+		})
+	})
+}
+
+func TestRefreshLoop(t *testing.T) {
+	t.Parallel()
+
+	Convey("refreshLoop backs off on errors", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// Sleep instantly, recording how long refreshLoop wanted to sleep.
+		var sleeps []time.Duration
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			sleeps = append(sleeps, d)
+			tc.Add(d)
+		})
+
+		// Fail 7 times, succeed, fail once more and stop the loop.
+		calls := 0
+		d := &daemon{
+			refresher: func(ctx context.Context) (time.Time, error) {
+				calls++
+				switch {
+				case calls <= 7:
+					return time.Time{}, errors.New("boom")
+				case calls == 8:
+					return clock.Now(ctx).Add(30 * time.Minute), nil
+				default:
+					cancel()
+					return time.Time{}, ctx.Err()
+				}
+			},
+		}
+		d.refreshLoop(ctx)
+
+		So(calls, ShouldEqual, 9)
+		So(sleeps, ShouldResemble, []time.Duration{
+			10 * time.Second,
+			20 * time.Second,
+			40 * time.Second,
+			80 * time.Second,
+			160 * time.Second,
+			5 * time.Minute, // capped by MaxDelay
+			5 * time.Minute,
+			30 * time.Minute, // the successful refresh resets the backoff
+		})
+	})
+
+	Convey("refreshLoop doesn't spin if the token is due for a refresh", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var sleeps []time.Duration
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			sleeps = append(sleeps, d)
+			tc.Add(d)
+		})
+
+		// Return a refresh time in the past twice and stop the loop.
+		calls := 0
+		d := &daemon{
+			refresher: func(ctx context.Context) (time.Time, error) {
+				calls++
+				if calls <= 2 {
+					return clock.Now(ctx).Add(-time.Minute), nil
+				}
+				cancel()
+				return time.Time{}, ctx.Err()
+			},
+		}
+		d.refreshLoop(ctx)
+
+		So(calls, ShouldEqual, 3)
+		So(sleeps, ShouldResemble, []time.Duration{minRefreshDelay, minRefreshDelay})
+	})
+}
+
+func TestCheckDaemon(t *testing.T) {
+	t.Parallel()
+
+	Convey("checkDaemon", t, func() {
+		opts := commandLine{
+			Daemon:     true,
+			Listen:     "localhost:8900",
+			SecretFile: "/secret",
+		}
+
+		Convey("OK with -listen", func() {
+			So(opts.checkDaemon(), ShouldBeNil)
+		})
+
+		Convey("OK with loopback IP", func() {
+			opts.Listen = "127.0.0.1:8900"
+			So(opts.checkDaemon(), ShouldBeNil)
+			opts.Listen = "[::1]:8900"
+			So(opts.checkDaemon(), ShouldBeNil)
+		})
+
+		Convey("OK with -unix-socket", func() {
+			opts.Listen = ""
+			opts.UnixSocket = "/socket"
+			So(opts.checkDaemon(), ShouldBeNil)
+		})
+
+		Convey("needs -listen or -unix-socket", func() {
+			opts.Listen = ""
+			So(opts.checkDaemon(), ShouldErrLike, "exactly one of -listen or -unix-socket")
+		})
+
+		Convey("not both -listen and -unix-socket", func() {
+			opts.UnixSocket = "/socket"
+			So(opts.checkDaemon(), ShouldErrLike, "exactly one of -listen or -unix-socket")
+		})
+
+		Convey("bad -listen", func() {
+			opts.Listen = "localhost"
+			So(opts.checkDaemon(), ShouldErrLike, "bad -listen value")
+		})
+
+		Convey("non-local -listen", func() {
+			opts.Listen = "0.0.0.0:8900"
+			So(opts.checkDaemon(), ShouldErrLike, "-listen must be a localhost address")
+			opts.Listen = "example.com:8900"
+			So(opts.checkDaemon(), ShouldErrLike, "-listen must be a localhost address")
+		})
+
+		Convey("needs -secret-file", func() {
+			opts.SecretFile = ""
+			So(opts.checkDaemon(), ShouldErrLike, "-secret-file is required")
+		})
+
+		Convey("no -status-file", func() {
+			opts.StatusFile = "/status"
+			So(opts.checkDaemon(), ShouldErrLike, "-status-file is not supported")
+		})
+
+		Convey("check calls checkDaemon", func() {
+			opts.PrivateKeyPath = "/pkey"
+			opts.CertificatePath = "/cert"
+			opts.Backend = "example.com"
+			So(opts.check(), ShouldBeNil)
+			opts.SecretFile = ""
+			So(opts.check(), ShouldErrLike, "-secret-file is required")
+		})
+	})
+}
@@ -10,6 +10,12 @@
 // It also dumps information about its run into a status file (as JSON), that
 // can be picked up sysmon and transformed into ts_mon metrics (most important
 // one being "time since last successful token refresh").
+//
+// Alternatively it can run as a daemon (with -daemon flag). In this mode it
+// keeps the token in memory, refreshes it ahead of expiration and serves it
+// over a local HTTP endpoint (TCP or UNIX socket) to whoever knows the secret
+// generated when the daemon starts. The status of the daemon is reported to
+// tsmon after each refresh and served over the same endpoint. See daemon.go.
 package main
 
 import (
@@ -17,6 +23,7 @@ import (
 	"encoding/hex"
 	"flag"
 	"fmt"
+	"net"
 	"os"
 	"sort"
 	"strings"
@@ -40,7 +47,7 @@ import (
 // Version identifies the major revision of the tokend code.
 //
 // It is put in the status file (and subsequently reported to monitoring).
-const Version = "1.2"
+const Version = "1.3"
 
 // commandLine contains all command line flags.
 //
@@ -53,6 +60,10 @@ type commandLine struct {
 	StatusFile      string
 	Timeout         time.Duration
 	ForceRefresh    bool
+	Daemon          bool
+	Listen          string
+	UnixSocket      string
+	SecretFile      string
 }
 
 func defaults() commandLine {
@@ -69,6 +80,10 @@ func (c *commandLine) registerFlags(f *flag.FlagSet) {
 	f.StringVar(&c.StatusFile, "status-file", c.StatusFile, "where to put details about this run (optional)")
 	f.DurationVar(&c.Timeout, "timeout", c.Timeout, "how long to retry on errors before giving up")
 	f.BoolVar(&c.ForceRefresh, "force-refresh", c.ForceRefresh, "forcefully refresh the token even if it is still valid")
+	f.BoolVar(&c.Daemon, "daemon", c.Daemon, "run as a daemon serving the token over HTTP")
+	f.StringVar(&c.Listen, "listen", c.Listen, "in daemon mode, localhost address to serve the token on, e.g. localhost:8900")
+	f.StringVar(&c.UnixSocket, "unix-socket", c.UnixSocket, "in daemon mode, path to a UNIX socket to serve the token on")
+	f.StringVar(&c.SecretFile, "secret-file", c.SecretFile, "in daemon mode, where to put the secret required to fetch the token")
 }
 
 func (c *commandLine) check() error {
@@ -81,12 +96,37 @@ func (c *commandLine) check() error {
 	if c.Backend == "" {
 		return fmt.Errorf("-backend is required")
 	}
+	if c.Daemon {
+		return c.checkDaemon()
+	}
 	if c.TokenFile == "" {
 		return fmt.Errorf("-token-file is required")
 	}
 	return nil
 }
 
+func (c *commandLine) checkDaemon() error {
+	if (c.Listen == "") == (c.UnixSocket == "") {
+		return fmt.Errorf("exactly one of -listen or -unix-socket is required in daemon mode")
+	}
+	if c.Listen != "" {
+		host, _, err := net.SplitHostPort(c.Listen)
+		if err != nil {
+			return fmt.Errorf("bad -listen value - %s", err)
+		}
+		if host != "localhost" && !net.ParseIP(host).IsLoopback() {
+			return fmt.Errorf("-listen must be a localhost address, got %q", c.Listen)
+		}
+	}
+	if c.SecretFile == "" {
+		return fmt.Errorf("-secret-file is required in daemon mode")
+	}
+	if c.StatusFile != "" {
+		return fmt.Errorf("-status-file is not supported in daemon mode, the status is served over HTTP")
+	}
+	return nil
+}
+
 func main() {
 	os.Exit(realMain())
 }
@@ -131,11 +171,17 @@ func realMain() int {
 
 	log := &memlogger.MemLogger{}
 
-	// Write Debug log to both memlogger and gologger.
-	memLogFactory := func(context.Context) logging.Logger {
-		return log
+	// Write Debug log to both memlogger and gologger. The daemon runs until
+	// interrupted, so don't accumulate its logs in memory.
+	var root context.Context
+	if opts.Daemon {
+		root = gologger.StdConfig.Use(context.Background())
+	} else {
+		memLogFactory := func(context.Context) logging.Logger {
+			return log
+		}
+		root = teelogger.Use(context.Background(), memLogFactory, gologger.StdConfig.NewLogger)
 	}
-	root := teelogger.Use(context.Background(), memLogFactory, gologger.StdConfig.NewLogger)
 	root = logging.SetLevel(root, logging.Debug)
 
 	// Apply tsmon config.
@@ -144,6 +190,13 @@ func realMain() int {
 		return 1
 	}
 
+	if opts.Daemon {
+		if err := runDaemon(root, clientParams, opts); err != nil {
+			return 1
+		}
+		return 0
+	}
+
 	ctx, cancel := context.WithTimeout(root, opts.Timeout)
 	catchInterrupt(cancel)
 
@@ -202,13 +255,7 @@ func run(ctx context.Context, clientParams tokenclient.ClientParameters, opts co
 	// Generate a hash of all input parameters. It is used to detect that we
 	// need to refresh the token file even if the token is still valid. It
 	// happens if we change a key or backend URL.
-	signer := client.Signer.(*tokenclient.X509Signer)
-	inputsDigest := calcDigest(map[string][]byte{
-		"forceBump": {1}, // bump this to forcefully regenerate all tokens
-		"pkey":      signer.PrivateKeyPEM,
-		"cert":      signer.CertificatePEM,
-		"backend":   []byte(clientParams.Backend),
-	})
+	inputsDigest := calcInputsDigest(client, clientParams)
 
 	// Record a reason for token update (if we need to update the token).
 	now := clock.Now(ctx)
@@ -228,12 +275,53 @@ func run(ctx context.Context, clientParams tokenclient.ClientParameters, opts co
 		return nil
 	}
 
-	// Grab a new token. MintMachineToken does retries internally, until success
-	// or context deadline.
+	// Grab a new token.
+	newTokenFile, err := mintToken(ctx, client, status)
+	if err != nil {
+		return err
+	}
+	newState := stateInToken{
+		InputsDigest: inputsDigest,
+		Version:      Version,
+	}
+	if err = writeTokenFile(ctx, newTokenFile, &newState, opts.TokenFile); err != nil {
+		logging.Errorf(ctx, "Failed to save token file - %s", err)
+		status.FailureError = err
+		if os.IsPermission(err) {
+			status.UpdateOutcome = OutcomePermissionError
+		} else {
+			status.UpdateOutcome = OutcomeUnknownSaveTokenError
+		}
+		return err
+	}
+
+	status.LastToken = newTokenFile
+	status.UpdateOutcome = OutcomeUpdateSuccess
+	return nil
+}
+
+// calcInputsDigest returns a digest of all parameters used to generate a token.
+func calcInputsDigest(client *tokenclient.Client, clientParams tokenclient.ClientParameters) string {
+	signer := client.Signer.(*tokenclient.X509Signer)
+	return calcDigest(map[string][]byte{
+		"forceBump": {1}, // bump this to forcefully regenerate all tokens
+		"pkey":      signer.PrivateKeyPEM,
+		"cert":      signer.CertificatePEM,
+		"backend":   []byte(clientParams.Backend),
+	})
+}
+
+// mintToken grabs a new token from the token server.
+//
+// MintMachineToken does retries internally, until success or context deadline.
+// Updates MintTokenDuration and ServiceVersion in the status. On errors, also
+// updates FailureError and UpdateOutcome.
+func mintToken(ctx context.Context, client *tokenclient.Client, status *StatusReport) (*tokenserver.TokenFile, error) {
+	started := clock.Now(ctx)
 	resp, err := client.MintMachineToken(ctx, &minter.MachineTokenRequest{
 		TokenType: minter.TokenType_LUCI_MACHINE_TOKEN,
 	})
-	status.MintTokenDuration = clock.Now(ctx).Sub(now)
+	status.MintTokenDuration = clock.Now(ctx).Sub(started)
 	if err != nil {
 		logging.Errorf(ctx, "Failed to generate a new token - %s", err)
 		status.FailureError = err
@@ -241,7 +329,7 @@ func run(ctx context.Context, clientParams tokenclient.ClientParameters, opts co
 		if details, ok := err.(tokenclient.RPCError); ok {
 			status.ServiceVersion = details.ServiceVersion
 		}
-		return err
+		return nil, err
 	}
 	status.ServiceVersion = resp.ServiceVersion
 
@@ -255,10 +343,10 @@ func run(ctx context.Context, clientParams tokenclient.ClientParameters, opts co
 		logging.Errorf(ctx, "%s", err)
 		status.FailureError = err
 		status.UpdateOutcome = OutcomeMalformedReponse
-		return err
+		return nil, err
 	}
 
-	now = clock.Now(ctx)
+	now := clock.Now(ctx)
 	expiry := tok.Expiry.Time()
 	lifetime := expiry.Sub(now)
 
@@ -272,30 +360,12 @@ func run(ctx context.Context, clientParams tokenclient.ClientParameters, opts co
 	// We start to attempt to refresh the token after half of its lifetime has
 	// passed, to be able survive short (~30 min) backend outages in exchange for
 	// 2x RPC rate.
-	newTokenFile := tokenserver.TokenFile{
+	return &tokenserver.TokenFile{
 		LuciMachineToken: tok.MachineToken,
 		Expiry:           expiry.Unix(),
 		LastUpdate:       now.Unix(),
 		NextUpdate:       now.Add(lifetime / 2).Unix(),
-	}
-	newState := stateInToken{
-		InputsDigest: inputsDigest,
-		Version:      Version,
-	}
-	if err = writeTokenFile(ctx, &newTokenFile, &newState, opts.TokenFile); err != nil {
-		logging.Errorf(ctx, "Failed to save token file - %s", err)
-		status.FailureError = err
-		if os.IsPermission(err) {
-			status.UpdateOutcome = OutcomePermissionError
-		} else {
-			status.UpdateOutcome = OutcomeUnknownSaveTokenError
-		}
-		return err
-	}
-
-	status.LastToken = &newTokenFile
-	status.UpdateOutcome = OutcomeUpdateSuccess
-	return nil
+	}, nil
 }
 
 // calcDigest produces a digest of a given map using some stable serialization.
@@ -0,0 +1,10 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package ingest implements the "ingest" Output.
+//
+// The "ingest" Output POSTs ButlerLogBundle protobufs directly to a LogDog
+// Collector's HTTP ingestion endpoint using the protocol defined in:
+//   github.com/luci/luci-go/common/logdog/butlerproto
+package ingest
@@ -0,0 +1,244 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ingest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	lerr "github.com/luci/luci-go/common/errors"
+	gcps "github.com/luci/luci-go/common/gcloud/pubsub"
+	"github.com/luci/luci-go/common/logdog/butlerproto"
+	"github.com/luci/luci-go/common/logdog/types"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/recordio"
+	"github.com/luci/luci-go/common/retry"
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+// Config is a configuration structure for ingest output.
+type Config struct {
+	// URL is the Collector ingestion URL to POST bundles to.
+	URL string
+
+	// Client is the HTTP client to use. If nil, http.DefaultClient will be used.
+	Client *http.Client
+
+	// Secret, if not nil, is the prefix secret to attach to each outgoing bundle.
+	Secret types.PrefixSecret
+
+	// Compress, if true, enables zlib compression.
+	Compress bool
+
+	// Track, if true, tracks all log entries that have been successfully
+	// submitted.
+	Track bool
+}
+
+// buffer
+type buffer struct {
+	bytes.Buffer // Output buffer for request body data.
+
+	frameWriter recordio.Writer
+	protoWriter *butlerproto.Writer
+}
+
+// Butler Output that POSTs messages directly to a LogDog Collector as
+// compressed protocol buffer blobs.
+type ingestOutput struct {
+	*Config
+	context.Context
+
+	bufferPool sync.Pool // Pool of reusable buffer instances.
+
+	statsMu sync.Mutex
+	stats   output.StatsBase
+
+	et *output.EntryTracker
+}
+
+// New instantiates a new ingest output.
+func New(ctx context.Context, c Config) output.Output {
+	o := ingestOutput{
+		Config: &c,
+	}
+	o.bufferPool.New = func() interface{} { return &buffer{} }
+
+	if c.Track {
+		o.et = &output.EntryTracker{}
+	}
+
+	o.Context = log.SetField(ctx, "ingest", &o)
+	return &o
+}
+
+func (o *ingestOutput) String() string {
+	return fmt.Sprintf("ingest(%s)", o.URL)
+}
+
+func (o *ingestOutput) SendBundle(bundle *logpb.ButlerLogBundle) error {
+	st := output.StatsBase{}
+	defer o.mergeStats(&st)
+
+	b := o.bufferPool.Get().(*buffer)
+	defer o.bufferPool.Put(b)
+
+	bundle.Secret = []byte(o.Secret)
+	data, err := o.buildMessage(b, bundle)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+		}.Errorf(o, "Failed to build ingest message from bundle.")
+		st.F.DiscardedMessages++
+		st.F.Errors++
+		return err
+	}
+	if len(data) > gcps.MaxPublishSize {
+		log.Fields{
+			"messageSize":    len(data),
+			"maxMessageSize": gcps.MaxPublishSize,
+		}.Errorf(o, "Constructed message exceeds maximum ingest size.")
+		st.F.DiscardedMessages++
+		st.F.Errors++
+		return errors.New("ingest: bundle contents violate ingest size limit")
+	}
+	if err := o.postMessage(data); err != nil {
+		st.F.DiscardedMessages++
+		st.F.Errors++
+		return err
+	}
+
+	if o.et != nil {
+		o.et.Track(bundle)
+	}
+
+	st.F.SentBytes += int64(len(data))
+	st.F.SentMessages++
+	return nil
+}
+
+// MaxSize returns the same maximum size as the Pub/Sub Output, since the
+// Collector enforces the same message size limit for both transports.
+func (*ingestOutput) MaxSize() int {
+	return gcps.MaxPublishSize / 2
+}
+
+func (o *ingestOutput) Stats() output.Stats {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+
+	statsCopy := o.stats
+	return &statsCopy
+}
+
+func (o *ingestOutput) Record() *output.EntryRecord {
+	if o.et == nil {
+		return nil
+	}
+	return o.et.Record()
+}
+
+func (o *ingestOutput) Close() {
+	// Nothing to do.
+}
+
+// buildMessage constructs ingest message data out of LogDog frames.
+//
+// The first frame will be a ButlerMetadata message describing the second
+// frame. The second frame will be a ButlerLogBundle containing the bundle
+// data.
+func (o *ingestOutput) buildMessage(buf *buffer, bundle *logpb.ButlerLogBundle) ([]byte, error) {
+	if buf.protoWriter == nil {
+		buf.protoWriter = &butlerproto.Writer{
+			Compress:          o.Compress,
+			CompressThreshold: butlerproto.DefaultCompressThreshold,
+		}
+	}
+
+	// Clear our buffer and (re)initialize our frame writer.
+	buf.Reset()
+	if buf.frameWriter == nil {
+		buf.frameWriter = recordio.NewWriter(buf)
+	} else {
+		buf.frameWriter.Reset(buf)
+	}
+
+	if err := buf.protoWriter.WriteWith(buf.frameWriter, bundle); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// postMessage handles an individual ingest request. It will indefinitely
+// retry transient errors until the request succeeds.
+func (o *ingestOutput) postMessage(data []byte) error {
+	client := o.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	err := retry.Retry(o, retry.TransientOnly(indefiniteRetry), func() error {
+		resp, err := ctxhttp.Post(o, client, o.URL, "application/octet-stream", bytes.NewReader(data))
+		if err != nil {
+			// Treat connection-level failures as transient.
+			return lerr.WrapTransient(err)
+		}
+		defer resp.Body.Close()
+		io.Copy(ioutil.Discard, resp.Body)
+
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			return nil
+
+		case resp.StatusCode >= 500, resp.StatusCode == http.StatusRequestTimeout,
+			resp.StatusCode == http.StatusTooManyRequests:
+			return lerr.WrapTransient(fmt.Errorf("ingest: transient HTTP status %d", resp.StatusCode))
+
+		default:
+			return fmt.Errorf("ingest: HTTP status %d", resp.StatusCode)
+		}
+	}, func(err error, d time.Duration) {
+		log.Fields{
+			log.ErrorKey: err,
+			"delay":      d,
+		}.Warningf(o, "TRANSIENT error sending ingest message; retrying...")
+	})
+	if err != nil {
+		log.WithError(err).Errorf(o, "Failed to send ingest message.")
+		return err
+	}
+
+	log.Fields{
+		"size": len(data),
+	}.Debugf(o, "Sent ingest message.")
+	return nil
+}
+
+func (o *ingestOutput) mergeStats(s output.Stats) {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+
+	o.stats.Merge(s)
+}
+
+// indefiniteRetry is a retry.Iterator that will indefinitely retry errors with
+// a maximum backoff.
+func indefiniteRetry() retry.Iterator {
+	return &retry.ExponentialBackoff{
+		Limited: retry.Limited{
+			Retries: -1,
+		},
+		MaxDelay: 30 * time.Second,
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ingest
+
+import (
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	gcps "github.com/luci/luci-go/common/gcloud/pubsub"
+	"github.com/luci/luci-go/common/logdog/butlerproto"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOutput(t *testing.T) {
+	Convey(`An Output using a test ingestion server`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			// Instantly elapse retry delays.
+			tc.Add(d)
+		})
+
+		var (
+			statuses []int
+			received []*butlerproto.Reader
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if len(statuses) > 0 {
+				status := statuses[0]
+				statuses = statuses[1:]
+				if status != http.StatusOK {
+					rw.WriteHeader(status)
+					return
+				}
+			}
+
+			r := butlerproto.Reader{}
+			if err := r.Read(req.Body); err != nil {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			received = append(received, &r)
+		}))
+		defer srv.Close()
+
+		o := New(ctx, Config{
+			URL:      srv.URL,
+			Compress: true,
+		}).(*ingestOutput)
+		So(o, ShouldNotBeNil)
+		defer o.Close()
+
+		bundle := &logpb.ButlerLogBundle{
+			Source:    "Ingest Test",
+			Timestamp: google.NewTimestamp(clock.Now(ctx)),
+			Entries: []*logpb.ButlerLogBundle_Entry{
+				{},
+			},
+		}
+
+		Convey(`Can send/receive a bundle.`, func() {
+			So(o.SendBundle(bundle), ShouldBeNil)
+
+			So(len(received), ShouldEqual, 1)
+			So(received[0].Bundle, ShouldResemble, bundle)
+
+			Convey(`And records stats.`, func() {
+				st := o.Stats()
+				So(st.Errors(), ShouldEqual, 0)
+				So(st.SentBytes(), ShouldBeGreaterThan, 0)
+				So(st.SentMessages(), ShouldEqual, 1)
+				So(st.DiscardedMessages(), ShouldEqual, 0)
+			})
+		})
+
+		Convey(`Will retry transient server errors.`, func() {
+			statuses = []int{http.StatusServiceUnavailable, http.StatusInternalServerError}
+			So(o.SendBundle(bundle), ShouldBeNil)
+			So(len(received), ShouldEqual, 1)
+		})
+
+		Convey(`Will return an error if the server rejects the bundle.`, func() {
+			statuses = []int{http.StatusBadRequest}
+			So(o.SendBundle(bundle), ShouldNotBeNil)
+			So(received, ShouldBeNil)
+			So(o.Stats().Errors(), ShouldEqual, 1)
+		})
+
+		Convey(`Will discard a bundle that exceeds the maximum size.`, func() {
+			// Random data doesn't compress. The bundle itself fits the size limit,
+			// but its framing pushes the message over it.
+			data := make([]byte, gcps.MaxPublishSize-48)
+			rand.New(rand.NewSource(0)).Read(data)
+			bundle.Entries[0].Logs = []*logpb.LogEntry{
+				{Content: &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: data}}},
+			}
+
+			So(o.SendBundle(bundle), ShouldErrLike, "violate ingest size limit")
+			So(received, ShouldBeNil)
+
+			st := o.Stats()
+			So(st.Errors(), ShouldEqual, 1)
+			So(st.DiscardedMessages(), ShouldEqual, 1)
+			So(st.SentMessages(), ShouldEqual, 0)
+		})
+	})
+}
@@ -5,6 +5,7 @@
 package annotation
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"strconv"
@@ -44,6 +45,12 @@ type State struct {
 	Execution *Execution
 	// Clock is the clock implementation to use for time information.
 	Clock clock.Clock
+	// TestResultFormats maps step log labels to the test result formats that are
+	// written to them. Logs with these labels will be parsed when they end, and
+	// their results will be summarized in their Step's component.
+	//
+	// If nil, DefaultTestResultFormats will be used.
+	TestResultFormats map[string]TestResultFormat
 
 	// stepMap is a map of step name to Step instance.
 	//
@@ -234,12 +241,27 @@ func (s *State) Append(annotation string) error {
 
 		// @@@STEP_LOG_END@<label>@@@
 	case "STEP_LOG_END":
-		s.CurrentStep().LogEnd(params)
+		step := s.CurrentStep()
+		u, err := step.LogEnd(params)
+		updatedIf(step, u)
+		if err != nil {
+			s.notifyUpdated(updated)
+			return err
+		}
 
-		// @@@STEP_LOG_END_PERF@<label>@@@
+		// @@@STEP_LOG_END_PERF@<label>@<perf_dashboard_name>@@@
 	case "STEP_LOG_END_PERF":
-		// Ignore for now. Ideally would emit a link to the perf dashboard.
-		break
+		// The log itself is already linked from the step. We don't know where the
+		// perf dashboard lives, so the dashboard name is dropped and the log is
+		// ended the same way STEP_LOG_END would.
+		step := s.CurrentStep()
+		label := strings.SplitN(params, "@", 2)[0]
+		u, err := step.LogEnd(label)
+		updatedIf(step, u)
+		if err != nil {
+			s.notifyUpdated(updated)
+			return err
+		}
 
 		// @@@STEP_CLEAR@@@
 	case "STEP_CLEAR":
@@ -305,12 +327,16 @@ func (s *State) Append(annotation string) error {
 		break
 	}
 
-	if updated != nil {
-		s.Callbacks.Updated(updated)
-	}
+	s.notifyUpdated(updated)
 	return nil
 }
 
+func (s *State) notifyUpdated(step *Step) {
+	if step != nil {
+		s.Callbacks.Updated(step)
+	}
+}
+
 // Finish closes the top-level annotation state and any outstanding steps.
 func (s *State) Finish() {
 	s.initialize()
@@ -412,6 +438,16 @@ func (s *State) ForEachStep(f func(*Step)) {
 	}
 }
 
+// testResultFormat returns the TestResultFormat for the named log label, or nil
+// if the label does not hold test results.
+func (s *State) testResultFormat(label string) TestResultFormat {
+	formats := s.TestResultFormats
+	if formats == nil {
+		formats = DefaultTestResultFormats
+	}
+	return formats[label]
+}
+
 func (s *State) annotationNow() *google.Timestamp {
 	c := s.Clock
 	if c == nil {
@@ -437,6 +473,9 @@ type Step struct {
 	// with the same label may be emitted, which would cause duplicate log stream
 	// names.
 	logLineCount map[string]int
+	// testResultData is a map of log line label to the buffered content of that
+	// log, for logs that hold test results.
+	testResultData map[string]*bytes.Buffer
 
 	// LogNameBase is the LogDog stream name root for this step.
 	logNameBase types.StreamName
@@ -460,6 +499,7 @@ func (as *Step) initialize(s *State, parent *Step, name string, index int, logNa
 	as.stepIndex = map[string]int{}
 	as.logLines = map[string]types.StreamName{}
 	as.logLineCount = map[string]int{}
+	as.testResultData = map[string]*bytes.Buffer{}
 
 	return as
 }
@@ -557,7 +597,8 @@ func (as *Step) closeWithStatus(sp *milo.Status) bool {
 		}
 	}
 
-	// Close any ourstanding log streams.
+	// Close any ourstanding log streams. Any test results that they hold will
+	// be summarized on a best-effort basis.
 	for l := range as.logLines {
 		as.LogEnd(l)
 	}
@@ -613,19 +654,56 @@ func (as *Step) LogLine(label, line string) bool {
 		updated = true
 	}
 
+	// If this log holds test results, buffer its content for parsing.
+	if as.s.testResultFormat(label) != nil {
+		buf := as.testResultData[label]
+		if buf == nil {
+			buf = &bytes.Buffer{}
+			as.testResultData[label] = buf
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+
 	as.s.Callbacks.StepLogLine(as, name, label, line)
 	return updated
 }
 
 // LogEnd ends the log for the specified label.
-func (as *Step) LogEnd(label string) {
+//
+// If the log holds test results, they will be parsed and added to the Step's
+// test result summary, and LogEnd will return true. If the test results could
+// not be parsed, the log will still be ended and an error will be returned.
+func (as *Step) LogEnd(label string) (bool, error) {
 	name, ok := as.logLines[label]
 	if !ok {
-		return
+		return false, nil
 	}
 
 	delete(as.logLines, label)
 	as.s.Callbacks.StepLogEnd(as, name)
+
+	buf := as.testResultData[label]
+	if buf == nil {
+		return false, nil
+	}
+	delete(as.testResultData, label)
+
+	tr, err := as.s.testResultFormat(label)(buf.Bytes())
+	if err != nil {
+		return false, fmt.Errorf("failed to parse test results from log %q: %v", label, err)
+	}
+	return as.AddTestResults(tr), nil
+}
+
+// AddTestResults adds the supplied test results to this Step's test result
+// summary.
+func (as *Step) AddTestResults(tr *milo.TestResults) bool {
+	if as.StepComponent.TestResults == nil {
+		as.StepComponent.TestResults = &milo.TestResults{}
+	}
+	mergeTestResults(as.StepComponent.TestResults, tr)
+	return true
 }
 
 // AddText adds a line of step component text.
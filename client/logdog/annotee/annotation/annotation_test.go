@@ -293,6 +293,7 @@ func TestState(t *testing.T) {
 			},
 		}},
 		{"coverage", nil},
+		{"testresults", nil},
 	}
 
 	if *generate {
@@ -0,0 +1,199 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package annotation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/luci/luci-go/common/proto/milo"
+)
+
+// TestResultFormat parses the content of a test result log into a test result
+// summary.
+type TestResultFormat func(data []byte) (*milo.TestResults, error)
+
+// DefaultTestResultFormats maps well-known step log labels to the test result
+// formats that are written to them.
+var DefaultTestResultFormats = map[string]TestResultFormat{
+	"gtest.json":        ParseGTestResults,
+	"test_results.json": ParseJSONTestResults,
+	"full_results.json": ParseJSONTestResults,
+}
+
+// testOutcome is the cumulative outcome of a single test.
+type testOutcome struct {
+	// passed is true if the test's final result was a pass.
+	passed bool
+	// failed is true if the test encountered any failure.
+	failed bool
+}
+
+// testSummary builds a milo.TestResults from a set of test outcomes.
+type testSummary map[string]*testOutcome
+
+func (ts testSummary) record(name string, passed bool) {
+	o := ts[name]
+	if o == nil {
+		o = &testOutcome{}
+		ts[name] = o
+	}
+	o.passed = passed
+	if !passed {
+		o.failed = true
+	}
+}
+
+func (ts testSummary) results() *milo.TestResults {
+	tr := milo.TestResults{}
+	for name, o := range ts {
+		switch {
+		case !o.passed:
+			tr.Failed++
+			tr.FailingTests = append(tr.FailingTests, name)
+		case o.failed:
+			tr.Flaky++
+		default:
+			tr.Passed++
+		}
+	}
+	sort.Strings(tr.FailingTests)
+	return &tr
+}
+
+// ParseGTestResults parses a GTest test launcher JSON summary (the output of
+// "--test-launcher-summary-output").
+//
+// A test that fails in every run is considered failed. A test that fails in
+// some runs, but passes in its final run, is considered flaky. Tests that were
+// skipped or not run are not counted.
+func ParseGTestResults(data []byte) (*milo.TestResults, error) {
+	type gtestRun struct {
+		Status string `json:"status"`
+	}
+	var results struct {
+		PerIterationData []map[string][]*gtestRun `json:"per_iteration_data"`
+	}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal GTest results: %v", err)
+	}
+	if results.PerIterationData == nil {
+		return nil, fmt.Errorf("GTest results have no iteration data")
+	}
+
+	ts := testSummary{}
+	for _, iteration := range results.PerIterationData {
+		for name, runs := range iteration {
+			for _, run := range runs {
+				switch run.Status {
+				case "SKIPPED", "NOTRUN", "UNKNOWN":
+					break
+
+				case "SUCCESS":
+					ts.record(name, true)
+
+				default:
+					ts.record(name, false)
+				}
+			}
+		}
+	}
+	return ts.results(), nil
+}
+
+// ParseJSONTestResults parses a version 3 JSON Test Results Format document.
+//
+// Each test's "actual" field lists the results of its runs, in order. A test
+// whose final result is one of its expected results is considered passed, or
+// flaky if an earlier run had an unexpected result. Otherwise, the test is
+// considered failed. Skipped tests are not counted.
+func ParseJSONTestResults(data []byte) (*milo.TestResults, error) {
+	var results struct {
+		Version       int                        `json:"version"`
+		PathDelimiter string                     `json:"path_delimiter"`
+		Tests         map[string]json.RawMessage `json:"tests"`
+	}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON test results: %v", err)
+	}
+	if results.Version != 3 {
+		return nil, fmt.Errorf("unsupported JSON test results version: %d", results.Version)
+	}
+
+	delim := results.PathDelimiter
+	if delim == "" {
+		delim = "/"
+	}
+
+	ts := testSummary{}
+	var walk func(string, map[string]json.RawMessage) error
+	walk = func(base string, node map[string]json.RawMessage) error {
+		for k, v := range node {
+			name := k
+			if base != "" {
+				name = base + delim + k
+			}
+
+			var child map[string]json.RawMessage
+			if err := json.Unmarshal(v, &child); err != nil {
+				return fmt.Errorf("invalid test node %q: %v", name, err)
+			}
+
+			if _, ok := child["actual"]; !ok {
+				// This is an intermediate node.
+				if err := walk(name, child); err != nil {
+					return err
+				}
+				continue
+			}
+
+			var leaf struct {
+				Actual   string `json:"actual"`
+				Expected string `json:"expected"`
+			}
+			if err := json.Unmarshal(v, &leaf); err != nil {
+				return fmt.Errorf("invalid test result %q: %v", name, err)
+			}
+			recordJSONTestResult(ts, name, strings.Fields(leaf.Actual), strings.Fields(leaf.Expected))
+		}
+		return nil
+	}
+	if err := walk("", results.Tests); err != nil {
+		return nil, err
+	}
+	return ts.results(), nil
+}
+
+func recordJSONTestResult(ts testSummary, name string, actual, expected []string) {
+	if len(expected) == 0 {
+		expected = []string{"PASS"}
+	}
+	isExpected := func(r string) bool {
+		for _, e := range expected {
+			if r == e {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, r := range actual {
+		if r == "SKIP" {
+			continue
+		}
+		ts.record(name, isExpected(r))
+	}
+}
+
+// mergeTestResults merges the test results in "src" into "dst".
+func mergeTestResults(dst, src *milo.TestResults) {
+	dst.Passed += src.Passed
+	dst.Failed += src.Failed
+	dst.Flaky += src.Flaky
+	dst.FailingTests = append(dst.FailingTests, src.FailingTests...)
+	sort.Strings(dst.FailingTests)
+}
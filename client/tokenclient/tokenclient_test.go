@@ -95,10 +95,18 @@ func (f *fakeRPCClient) MintMachineToken(ctx context.Context, in *minter.MintMac
 	return &f.Out, nil
 }
 
+func (f *fakeRPCClient) MintMachineTokenByJWT(context.Context, *minter.MintMachineTokenByJWTRequest, ...grpc.CallOption) (*minter.MintMachineTokenResponse, error) {
+	panic("not implemented")
+}
+
 func (f *fakeRPCClient) InspectMachineToken(context.Context, *minter.InspectMachineTokenRequest, ...grpc.CallOption) (*minter.InspectMachineTokenResponse, error) {
 	panic("not implemented")
 }
 
+func (f *fakeRPCClient) MintDelegationToken(context.Context, *minter.MintDelegationTokenRequest, ...grpc.CallOption) (*minter.MintDelegationTokenResponse, error) {
+	panic("not implemented")
+}
+
 // fakeSigner implements Signer.
 type fakeSigner struct{}
 
@@ -0,0 +1,1042 @@
+// Code generated by protoc-gen-go.
+// source: cron.proto
+// DO NOT EDIT!
+
+/*
+Package cron is a generated protocol buffer package.
+
+It is generated from these files:
+	cron.proto
+
+It has these top-level messages:
+	JobRef
+	InvocationRef
+	Job
+	Invocation
+	GetProjectsResponse
+	GetJobsRequest
+	GetJobsResponse
+	GetInvocationsRequest
+	GetInvocationsResponse
+	TriggerJobResponse
+*/
+package cron
+
+import prpccommon "github.com/luci/luci-go/common/prpc"
+import prpc "github.com/luci/luci-go/server/prpc"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/luci/luci-go/common/proto/google"
+import google_protobuf1 "github.com/luci/luci-go/common/proto/google"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// JobRef identifies a cron job.
+type JobRef struct {
+	// The project the job belongs to.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The name of the job within the project.
+	Job string `protobuf:"bytes,2,opt,name=job" json:"job,omitempty"`
+}
+
+func (m *JobRef) Reset()                    { *m = JobRef{} }
+func (m *JobRef) String() string            { return proto.CompactTextString(m) }
+func (*JobRef) ProtoMessage()               {}
+func (*JobRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *JobRef) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *JobRef) GetJob() string {
+	if m != nil {
+		return m.Job
+	}
+	return ""
+}
+
+// InvocationRef identifies a single invocation of a cron job.
+type InvocationRef struct {
+	// The job the invocation belongs to.
+	Job *JobRef `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	// The ID of the invocation.
+	InvocationId int64 `protobuf:"varint,2,opt,name=invocation_id,json=invocationId" json:"invocation_id,omitempty"`
+}
+
+func (m *InvocationRef) Reset()                    { *m = InvocationRef{} }
+func (m *InvocationRef) String() string            { return proto.CompactTextString(m) }
+func (*InvocationRef) ProtoMessage()               {}
+func (*InvocationRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *InvocationRef) GetJob() *JobRef {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *InvocationRef) GetInvocationId() int64 {
+	if m != nil {
+		return m.InvocationId
+	}
+	return 0
+}
+
+// Job describes the current state and configuration of a cron job.
+type Job struct {
+	// The job identifier.
+	Ref *JobRef `protobuf:"bytes,1,opt,name=ref" json:"ref,omitempty"`
+	// The job's schedule, as defined in the project config.
+	Schedule string `protobuf:"bytes,2,opt,name=schedule" json:"schedule,omitempty"`
+	// The schedule actually used by the job. It is "manual" if the job is paused.
+	EffectiveSchedule string `protobuf:"bytes,3,opt,name=effective_schedule,json=effectiveSchedule" json:"effective_schedule,omitempty"`
+	// True if the job is paused.
+	Paused bool `protobuf:"varint,4,opt,name=paused" json:"paused,omitempty"`
+	// The state of the job state machine (e.g. "SCHEDULED" or "RUNNING").
+	State string `protobuf:"bytes,5,opt,name=state" json:"state,omitempty"`
+	// The config revision the job definition was read from.
+	Revision string `protobuf:"bytes,6,opt,name=revision" json:"revision,omitempty"`
+	// URL of a human readable page with the job definition at that revision.
+	RevisionUrl string `protobuf:"bytes,7,opt,name=revision_url,json=revisionUrl" json:"revision_url,omitempty"`
+	// When the next scheduled tick is expected, if any.
+	NextTick *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=next_tick,json=nextTick" json:"next_tick,omitempty"`
+	// When the last invocation finished, if any.
+	PrevTime *google_protobuf1.Timestamp `protobuf:"bytes,9,opt,name=prev_time,json=prevTime" json:"prev_time,omitempty"`
+	// The ID of the currently running invocation, or 0 if none is running.
+	RunningInvocationId int64 `protobuf:"varint,10,opt,name=running_invocation_id,json=runningInvocationId" json:"running_invocation_id,omitempty"`
+	// How many times the current invocation overran.
+	Overruns int32 `protobuf:"varint,11,opt,name=overruns" json:"overruns,omitempty"`
+	// When a failed invocation will be retried, if a retry is pending.
+	RetryTime *google_protobuf1.Timestamp `protobuf:"bytes,12,opt,name=retry_time,json=retryTime" json:"retry_time,omitempty"`
+	// How many attempts of the current invocation have failed thus far.
+	FailedAttempts int32 `protobuf:"varint,13,opt,name=failed_attempts,json=failedAttempts" json:"failed_attempts,omitempty"`
+	// Whether the caller is allowed to trigger the job.
+	CanTrigger bool `protobuf:"varint,14,opt,name=can_trigger,json=canTrigger" json:"can_trigger,omitempty"`
+	// Whether the caller is allowed to pause, resume and abort the job.
+	CanOwn bool `protobuf:"varint,15,opt,name=can_own,json=canOwn" json:"can_own,omitempty"`
+}
+
+func (m *Job) Reset()                    { *m = Job{} }
+func (m *Job) String() string            { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()               {}
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Job) GetRef() *JobRef {
+	if m != nil {
+		return m.Ref
+	}
+	return nil
+}
+
+func (m *Job) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *Job) GetEffectiveSchedule() string {
+	if m != nil {
+		return m.EffectiveSchedule
+	}
+	return ""
+}
+
+func (m *Job) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Job) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Job) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *Job) GetRevisionUrl() string {
+	if m != nil {
+		return m.RevisionUrl
+	}
+	return ""
+}
+
+func (m *Job) GetNextTick() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.NextTick
+	}
+	return nil
+}
+
+func (m *Job) GetPrevTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.PrevTime
+	}
+	return nil
+}
+
+func (m *Job) GetRunningInvocationId() int64 {
+	if m != nil {
+		return m.RunningInvocationId
+	}
+	return 0
+}
+
+func (m *Job) GetOverruns() int32 {
+	if m != nil {
+		return m.Overruns
+	}
+	return 0
+}
+
+func (m *Job) GetRetryTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.RetryTime
+	}
+	return nil
+}
+
+func (m *Job) GetFailedAttempts() int32 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *Job) GetCanTrigger() bool {
+	if m != nil {
+		return m.CanTrigger
+	}
+	return false
+}
+
+func (m *Job) GetCanOwn() bool {
+	if m != nil {
+		return m.CanOwn
+	}
+	return false
+}
+
+// Invocation describes a single invocation of a cron job.
+type Invocation struct {
+	// The invocation identifier.
+	Ref *InvocationRef `protobuf:"bytes,1,opt,name=ref" json:"ref,omitempty"`
+	// When the invocation started.
+	Started *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=started" json:"started,omitempty"`
+	// When the invocation finished, if it has finished.
+	Finished *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=finished" json:"finished,omitempty"`
+	// The status of the invocation (e.g. "RUNNING" or "SUCCEEDED").
+	Status string `protobuf:"bytes,4,opt,name=status" json:"status,omitempty"`
+	// Identity of a user who triggered the invocation manually, if any.
+	TriggeredBy string `protobuf:"bytes,5,opt,name=triggered_by,json=triggeredBy" json:"triggered_by,omitempty"`
+	// The invocation of another job that triggered this one, if any.
+	Upstream *InvocationRef `protobuf:"bytes,6,opt,name=upstream" json:"upstream,omitempty"`
+	// The config revision the job definition was read from.
+	Revision string `protobuf:"bytes,7,opt,name=revision" json:"revision,omitempty"`
+	// URL of a human readable page with the job definition at that revision.
+	RevisionUrl string `protobuf:"bytes,8,opt,name=revision_url,json=revisionUrl" json:"revision_url,omitempty"`
+	// URL of a human readable page with the invocation status, if any.
+	ViewUrl string `protobuf:"bytes,9,opt,name=view_url,json=viewUrl" json:"view_url,omitempty"`
+	// How many times the invocation was retried due to transient errors.
+	RetryCount int64 `protobuf:"varint,10,opt,name=retry_count,json=retryCount" json:"retry_count,omitempty"`
+	// ID of the failed invocation this one retries, if any.
+	RetryOf int64 `protobuf:"varint,11,opt,name=retry_of,json=retryOf" json:"retry_of,omitempty"`
+	// ID of the invocation that retries this one, if any.
+	RetriedAs int64 `protobuf:"varint,12,opt,name=retried_as,json=retriedAs" json:"retried_as,omitempty"`
+	// The debug log of the invocation. Populated only by GetInvocation.
+	DebugLog string `protobuf:"bytes,13,opt,name=debug_log,json=debugLog" json:"debug_log,omitempty"`
+	// The invocations of other jobs triggered by this one when it finished.
+	Downstream []*InvocationRef `protobuf:"bytes,14,rep,name=downstream" json:"downstream,omitempty"`
+}
+
+func (m *Invocation) Reset()                    { *m = Invocation{} }
+func (m *Invocation) String() string            { return proto.CompactTextString(m) }
+func (*Invocation) ProtoMessage()               {}
+func (*Invocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Invocation) GetRef() *InvocationRef {
+	if m != nil {
+		return m.Ref
+	}
+	return nil
+}
+
+func (m *Invocation) GetStarted() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *Invocation) GetFinished() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+func (m *Invocation) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Invocation) GetTriggeredBy() string {
+	if m != nil {
+		return m.TriggeredBy
+	}
+	return ""
+}
+
+func (m *Invocation) GetUpstream() *InvocationRef {
+	if m != nil {
+		return m.Upstream
+	}
+	return nil
+}
+
+func (m *Invocation) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *Invocation) GetRevisionUrl() string {
+	if m != nil {
+		return m.RevisionUrl
+	}
+	return ""
+}
+
+func (m *Invocation) GetViewUrl() string {
+	if m != nil {
+		return m.ViewUrl
+	}
+	return ""
+}
+
+func (m *Invocation) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *Invocation) GetRetryOf() int64 {
+	if m != nil {
+		return m.RetryOf
+	}
+	return 0
+}
+
+func (m *Invocation) GetRetriedAs() int64 {
+	if m != nil {
+		return m.RetriedAs
+	}
+	return 0
+}
+
+func (m *Invocation) GetDebugLog() string {
+	if m != nil {
+		return m.DebugLog
+	}
+	return ""
+}
+
+func (m *Invocation) GetDownstream() []*InvocationRef {
+	if m != nil {
+		return m.Downstream
+	}
+	return nil
+}
+
+// GetProjectsResponse is the response message for the GetProjects RPC.
+type GetProjectsResponse struct {
+	// Projects that have at least one enabled job visible to the caller.
+	Projects []string `protobuf:"bytes,1,rep,name=projects" json:"projects,omitempty"`
+}
+
+func (m *GetProjectsResponse) Reset()                    { *m = GetProjectsResponse{} }
+func (m *GetProjectsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProjectsResponse) ProtoMessage()               {}
+func (*GetProjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *GetProjectsResponse) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+// GetJobsRequest is the request message for the GetJobs RPC.
+type GetJobsRequest struct {
+	// If not empty, only jobs of this project are returned.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+}
+
+func (m *GetJobsRequest) Reset()                    { *m = GetJobsRequest{} }
+func (m *GetJobsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetJobsRequest) ProtoMessage()               {}
+func (*GetJobsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *GetJobsRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+// GetJobsResponse is the response message for the GetJobs RPC.
+type GetJobsResponse struct {
+	// Jobs visible to the caller, sorted by project and job name.
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs" json:"jobs,omitempty"`
+}
+
+func (m *GetJobsResponse) Reset()                    { *m = GetJobsResponse{} }
+func (m *GetJobsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetJobsResponse) ProtoMessage()               {}
+func (*GetJobsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *GetJobsResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+// GetInvocationsRequest is the request message for the GetInvocations RPC.
+type GetInvocationsRequest struct {
+	// The job to list invocations of.
+	Job *JobRef `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	// The cursor returned by the previous call, if any.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+	// The maximum number of invocations to return. If zero, a default maximum
+	// will be used.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+}
+
+func (m *GetInvocationsRequest) Reset()                    { *m = GetInvocationsRequest{} }
+func (m *GetInvocationsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInvocationsRequest) ProtoMessage()               {}
+func (*GetInvocationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *GetInvocationsRequest) GetJob() *JobRef {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *GetInvocationsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetInvocationsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+// GetInvocationsResponse is the response message for the GetInvocations RPC.
+type GetInvocationsResponse struct {
+	// Invocations of the job, most recent first. Debug logs are not included.
+	Invocations []*Invocation `protobuf:"bytes,1,rep,name=invocations" json:"invocations,omitempty"`
+	// The cursor to pass to the next call, or empty if there are no more
+	// invocations.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+}
+
+func (m *GetInvocationsResponse) Reset()                    { *m = GetInvocationsResponse{} }
+func (m *GetInvocationsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetInvocationsResponse) ProtoMessage()               {}
+func (*GetInvocationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *GetInvocationsResponse) GetInvocations() []*Invocation {
+	if m != nil {
+		return m.Invocations
+	}
+	return nil
+}
+
+func (m *GetInvocationsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+// TriggerJobResponse is the response message for the TriggerJob RPC.
+type TriggerJobResponse struct {
+	// A random number identifying the request to start an invocation. All
+	// invocations it produces have this nonce.
+	InvocationNonce int64 `protobuf:"varint,1,opt,name=invocation_nonce,json=invocationNonce" json:"invocation_nonce,omitempty"`
+}
+
+func (m *TriggerJobResponse) Reset()                    { *m = TriggerJobResponse{} }
+func (m *TriggerJobResponse) String() string            { return proto.CompactTextString(m) }
+func (*TriggerJobResponse) ProtoMessage()               {}
+func (*TriggerJobResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *TriggerJobResponse) GetInvocationNonce() int64 {
+	if m != nil {
+		return m.InvocationNonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*JobRef)(nil), "cron.JobRef")
+	proto.RegisterType((*InvocationRef)(nil), "cron.InvocationRef")
+	proto.RegisterType((*Job)(nil), "cron.Job")
+	proto.RegisterType((*Invocation)(nil), "cron.Invocation")
+	proto.RegisterType((*GetProjectsResponse)(nil), "cron.GetProjectsResponse")
+	proto.RegisterType((*GetJobsRequest)(nil), "cron.GetJobsRequest")
+	proto.RegisterType((*GetJobsResponse)(nil), "cron.GetJobsResponse")
+	proto.RegisterType((*GetInvocationsRequest)(nil), "cron.GetInvocationsRequest")
+	proto.RegisterType((*GetInvocationsResponse)(nil), "cron.GetInvocationsResponse")
+	proto.RegisterType((*TriggerJobResponse)(nil), "cron.TriggerJobResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Cron service
+
+type CronClient interface {
+	// GetProjects returns the projects that have at least one enabled job
+	// visible to the caller.
+	GetProjects(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*GetProjectsResponse, error)
+	// GetJobs returns the enabled jobs visible to the caller.
+	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+	// GetJob returns the state and the schedule of a single job.
+	GetJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*Job, error)
+	// GetInvocations returns invocations of a job, most recent first.
+	GetInvocations(ctx context.Context, in *GetInvocationsRequest, opts ...grpc.CallOption) (*GetInvocationsResponse, error)
+	// GetInvocation returns a single invocation, including its debug log.
+	GetInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*Invocation, error)
+	// TriggerJob launches an invocation of a job right now, unless it is
+	// already running.
+	TriggerJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*TriggerJobResponse, error)
+	// PauseJob stops the job from running on schedule. It can still be
+	// triggered manually.
+	PauseJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ResumeJob resumes a paused job.
+	ResumeJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// AbortInvocation forcefully moves an invocation to the ABORTED state.
+	AbortInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+type cronPRPCClient struct {
+	client *prpccommon.Client
+}
+
+func NewCronPRPCClient(client *prpccommon.Client) CronClient {
+	return &cronPRPCClient{client}
+}
+
+func (c *cronPRPCClient) GetProjects(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*GetProjectsResponse, error) {
+	out := new(GetProjectsResponse)
+	err := c.client.Call(ctx, "cron.Cron", "GetProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
+	out := new(GetJobsResponse)
+	err := c.client.Call(ctx, "cron.Cron", "GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) GetJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.client.Call(ctx, "cron.Cron", "GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) GetInvocations(ctx context.Context, in *GetInvocationsRequest, opts ...grpc.CallOption) (*GetInvocationsResponse, error) {
+	out := new(GetInvocationsResponse)
+	err := c.client.Call(ctx, "cron.Cron", "GetInvocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) GetInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*Invocation, error) {
+	out := new(Invocation)
+	err := c.client.Call(ctx, "cron.Cron", "GetInvocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) TriggerJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*TriggerJobResponse, error) {
+	out := new(TriggerJobResponse)
+	err := c.client.Call(ctx, "cron.Cron", "TriggerJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) PauseJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "cron.Cron", "PauseJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) ResumeJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "cron.Cron", "ResumeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronPRPCClient) AbortInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "cron.Cron", "AbortInvocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type cronClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCronClient(cc *grpc.ClientConn) CronClient {
+	return &cronClient{cc}
+}
+
+func (c *cronClient) GetProjects(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*GetProjectsResponse, error) {
+	out := new(GetProjectsResponse)
+	err := grpc.Invoke(ctx, "/cron.Cron/GetProjects", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
+	out := new(GetJobsResponse)
+	err := grpc.Invoke(ctx, "/cron.Cron/GetJobs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) GetJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := grpc.Invoke(ctx, "/cron.Cron/GetJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) GetInvocations(ctx context.Context, in *GetInvocationsRequest, opts ...grpc.CallOption) (*GetInvocationsResponse, error) {
+	out := new(GetInvocationsResponse)
+	err := grpc.Invoke(ctx, "/cron.Cron/GetInvocations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) GetInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*Invocation, error) {
+	out := new(Invocation)
+	err := grpc.Invoke(ctx, "/cron.Cron/GetInvocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) TriggerJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*TriggerJobResponse, error) {
+	out := new(TriggerJobResponse)
+	err := grpc.Invoke(ctx, "/cron.Cron/TriggerJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) PauseJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/cron.Cron/PauseJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) ResumeJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/cron.Cron/ResumeJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) AbortInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/cron.Cron/AbortInvocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Cron service
+
+type CronServer interface {
+	// GetProjects returns the projects that have at least one enabled job
+	// visible to the caller.
+	GetProjects(context.Context, *google_protobuf.Empty) (*GetProjectsResponse, error)
+	// GetJobs returns the enabled jobs visible to the caller.
+	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+	// GetJob returns the state and the schedule of a single job.
+	GetJob(context.Context, *JobRef) (*Job, error)
+	// GetInvocations returns invocations of a job, most recent first.
+	GetInvocations(context.Context, *GetInvocationsRequest) (*GetInvocationsResponse, error)
+	// GetInvocation returns a single invocation, including its debug log.
+	GetInvocation(context.Context, *InvocationRef) (*Invocation, error)
+	// TriggerJob launches an invocation of a job right now, unless it is
+	// already running.
+	TriggerJob(context.Context, *JobRef) (*TriggerJobResponse, error)
+	// PauseJob stops the job from running on schedule. It can still be
+	// triggered manually.
+	PauseJob(context.Context, *JobRef) (*google_protobuf.Empty, error)
+	// ResumeJob resumes a paused job.
+	ResumeJob(context.Context, *JobRef) (*google_protobuf.Empty, error)
+	// AbortInvocation forcefully moves an invocation to the ABORTED state.
+	AbortInvocation(context.Context, *InvocationRef) (*google_protobuf.Empty, error)
+}
+
+func RegisterCronServer(s prpc.Registrar, srv CronServer) {
+	s.RegisterService(&_Cron_serviceDesc, srv)
+}
+
+func _Cron_GetProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetProjects(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetJobs(ctx, req.(*GetJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_GetInvocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetInvocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetInvocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetInvocations(ctx, req.(*GetInvocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_GetInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvocationRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetInvocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetInvocation(ctx, req.(*InvocationRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/TriggerJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).TriggerJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/PauseJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).PauseJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/ResumeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).ResumeJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_AbortInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvocationRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).AbortInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/AbortInvocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).AbortInvocation(ctx, req.(*InvocationRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Cron_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cron.Cron",
+	HandlerType: (*CronServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProjects",
+			Handler:    _Cron_GetProjects_Handler,
+		},
+		{
+			MethodName: "GetJobs",
+			Handler:    _Cron_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _Cron_GetJob_Handler,
+		},
+		{
+			MethodName: "GetInvocations",
+			Handler:    _Cron_GetInvocations_Handler,
+		},
+		{
+			MethodName: "GetInvocation",
+			Handler:    _Cron_GetInvocation_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _Cron_TriggerJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _Cron_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _Cron_ResumeJob_Handler,
+		},
+		{
+			MethodName: "AbortInvocation",
+			Handler:    _Cron_AbortInvocation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cron.proto",
+}
+
+var fileDescriptor0 = []byte{
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x55, 0xdf, 0x6f, 0x1b, 0x45,
+	0x10, 0x56, 0x38, 0xc7, 0xbe, 0x1b, 0x27, 0x71, 0xd9, 0x34, 0xe1, 0xea, 0x50, 0xe2, 0x1e, 0x42,
+	0x04, 0x24, 0x9c, 0xd6, 0x8d, 0x8a, 0x78, 0x01, 0x42, 0x84, 0xa2, 0x04, 0x44, 0xab, 0x6b, 0x78,
+	0x3e, 0xdd, 0x8f, 0x39, 0x77, 0x53, 0x7b, 0xd7, 0xec, 0xee, 0x39, 0xa4, 0xaf, 0xfc, 0x0b, 0xfc,
+	0x3b, 0xfc, 0x6f, 0x68, 0x77, 0xef, 0xce, 0x76, 0x6c, 0x63, 0xf1, 0xe6, 0xf9, 0xf6, 0x9b, 0xdd,
+	0x99, 0xf9, 0xbe, 0x1b, 0x03, 0xa4, 0x82, 0xb3, 0xfe, 0x44, 0x70, 0xc5, 0x49, 0x43, 0xff, 0xee,
+	0x1e, 0x0d, 0x39, 0x1f, 0x8e, 0xf0, 0xd4, 0x60, 0x49, 0x91, 0x9f, 0xe2, 0x78, 0xa2, 0xee, 0x2d,
+	0xa5, 0x7b, 0xfc, 0xf0, 0x50, 0xd1, 0x31, 0x4a, 0x15, 0x8f, 0x27, 0x96, 0x10, 0x9c, 0x41, 0xf3,
+	0x9a, 0x27, 0x21, 0xe6, 0xc4, 0x87, 0xd6, 0x44, 0xf0, 0x5b, 0x4c, 0x95, 0xbf, 0xd5, 0xdb, 0x3a,
+	0xf1, 0xc2, 0x2a, 0x24, 0x8f, 0xc0, 0xb9, 0xe5, 0x89, 0xff, 0x91, 0x41, 0xf5, 0xcf, 0xe0, 0x06,
+	0x76, 0xaf, 0xd8, 0x94, 0xa7, 0xb1, 0xa2, 0x9c, 0xe9, 0xe4, 0xcf, 0x2c, 0x45, 0x27, 0xb6, 0x07,
+	0x3b, 0x7d, 0x53, 0xa4, 0xbd, 0xd7, 0x24, 0x90, 0xcf, 0x61, 0x97, 0xd6, 0x09, 0x11, 0xcd, 0xcc,
+	0x65, 0x4e, 0xb8, 0x33, 0x03, 0xaf, 0xb2, 0xe0, 0x9f, 0x06, 0x38, 0xd7, 0x3c, 0xd1, 0x97, 0x09,
+	0xcc, 0x57, 0x5f, 0x26, 0x30, 0x27, 0x5d, 0x70, 0x65, 0xfa, 0x0e, 0xb3, 0x62, 0x84, 0x65, 0x51,
+	0x75, 0x4c, 0xbe, 0x01, 0x82, 0x79, 0x8e, 0xa9, 0xa2, 0x53, 0x8c, 0x6a, 0x96, 0x63, 0x58, 0x1f,
+	0xd7, 0x27, 0x6f, 0x2b, 0xfa, 0x21, 0x34, 0x27, 0x71, 0x21, 0x31, 0xf3, 0x1b, 0xbd, 0xad, 0x13,
+	0x37, 0x2c, 0x23, 0xf2, 0x18, 0xb6, 0xa5, 0x8a, 0x15, 0xfa, 0xdb, 0x26, 0xd3, 0x06, 0xfa, 0x61,
+	0x81, 0x53, 0x2a, 0x29, 0x67, 0x7e, 0xd3, 0x3e, 0x5c, 0xc5, 0xe4, 0x19, 0xec, 0x54, 0xbf, 0xa3,
+	0x42, 0x8c, 0xfc, 0x96, 0x39, 0x6f, 0x57, 0xd8, 0xef, 0x62, 0x44, 0xbe, 0x05, 0x8f, 0xe1, 0x9f,
+	0x2a, 0x52, 0x34, 0x7d, 0xef, 0xbb, 0xa6, 0xbb, 0x6e, 0xdf, 0x0a, 0xd4, 0xaf, 0x04, 0xea, 0xdf,
+	0x54, 0x02, 0x85, 0xae, 0x26, 0xdf, 0xd0, 0xf4, 0xbd, 0x4e, 0x9c, 0x08, 0x9c, 0x46, 0x5a, 0x3c,
+	0xdf, 0xdb, 0x9c, 0xa8, 0xc9, 0x3a, 0x24, 0x03, 0x38, 0x10, 0x05, 0x63, 0x94, 0x0d, 0xa3, 0xc5,
+	0xf1, 0x83, 0x19, 0xff, 0x7e, 0x79, 0x78, 0x35, 0xa7, 0x82, 0x6e, 0x92, 0x4f, 0x51, 0x88, 0x82,
+	0x49, 0xbf, 0xdd, 0xdb, 0x3a, 0xd9, 0x0e, 0xeb, 0x98, 0x7c, 0x07, 0x20, 0x50, 0x89, 0x7b, 0x5b,
+	0xc9, 0xce, 0xc6, 0x4a, 0x3c, 0xc3, 0x36, 0xa5, 0x7c, 0x09, 0x9d, 0x3c, 0xa6, 0x23, 0xcc, 0xa2,
+	0x58, 0x29, 0x6d, 0x51, 0xe9, 0xef, 0x9a, 0xdb, 0xf7, 0x2c, 0x7c, 0x5e, 0xa2, 0xe4, 0x18, 0xda,
+	0x69, 0xcc, 0x22, 0x25, 0xe8, 0x70, 0x88, 0xc2, 0xdf, 0x33, 0xba, 0x40, 0x1a, 0xb3, 0x1b, 0x8b,
+	0x90, 0x4f, 0xa0, 0xa5, 0x09, 0xfc, 0x8e, 0xf9, 0x1d, 0x2b, 0x5a, 0x1a, 0xb3, 0xd7, 0x77, 0x2c,
+	0xf8, 0xbb, 0x01, 0x30, 0x6b, 0x85, 0x7c, 0x31, 0x6f, 0xa3, 0x7d, 0x6b, 0xa3, 0x05, 0xd7, 0x5a,
+	0x37, 0x9d, 0x41, 0x4b, 0xaa, 0x58, 0x28, 0xb4, 0xa6, 0xfc, 0xef, 0x86, 0x2a, 0x2a, 0x79, 0x05,
+	0x6e, 0x4e, 0x19, 0x95, 0xef, 0x30, 0xf3, 0x9d, 0x8d, 0x69, 0x35, 0x57, 0x1b, 0x4e, 0x7b, 0xa9,
+	0x90, 0xc6, 0x70, 0x5e, 0x58, 0x46, 0xda, 0x3e, 0x65, 0xc7, 0x98, 0x45, 0xc9, 0x7d, 0xe9, 0xbb,
+	0x76, 0x8d, 0xfd, 0x74, 0x4f, 0x4e, 0xc1, 0x2d, 0x26, 0x52, 0x09, 0x8c, 0xc7, 0x7e, 0x73, 0x7d,
+	0x53, 0x35, 0x69, 0xc1, 0xae, 0xad, 0x0d, 0x76, 0x75, 0x97, 0xed, 0xfa, 0x04, 0xdc, 0x29, 0xc5,
+	0x3b, 0x73, 0xec, 0xd9, 0x8d, 0xa0, 0x63, 0x7d, 0x74, 0x0c, 0x6d, 0xeb, 0x83, 0x94, 0x17, 0x4c,
+	0x95, 0x6e, 0xb2, 0xd6, 0xb8, 0xd0, 0x88, 0xce, 0xb5, 0x04, 0x9e, 0x1b, 0x13, 0x39, 0x61, 0xcb,
+	0xc4, 0xaf, 0x73, 0xf2, 0xd4, 0x7a, 0x88, 0x6a, 0x27, 0x48, 0xe3, 0x21, 0xc7, 0xfa, 0x84, 0x62,
+	0x76, 0x2e, 0xc9, 0x11, 0x78, 0x19, 0x26, 0xc5, 0x30, 0x1a, 0xf1, 0xa1, 0x71, 0x88, 0x17, 0xba,
+	0x06, 0xf8, 0x95, 0x0f, 0xc9, 0x4b, 0x80, 0x8c, 0xdf, 0xb1, 0x72, 0x08, 0x7b, 0x3d, 0x67, 0xdd,
+	0x10, 0xe6, 0x68, 0xc1, 0x0b, 0xd8, 0xbf, 0x44, 0xf5, 0xc6, 0x2e, 0x33, 0x19, 0xa2, 0x9c, 0x70,
+	0x26, 0xcd, 0xc7, 0x5c, 0x2e, 0x38, 0xe9, 0x6f, 0xf5, 0x1c, 0xfd, 0x4e, 0x15, 0x07, 0x5f, 0xc3,
+	0xde, 0x25, 0xaa, 0x6b, 0x9e, 0xc8, 0x10, 0xff, 0x28, 0x50, 0xaa, 0xf5, 0xdb, 0x31, 0x78, 0x0e,
+	0x9d, 0x9a, 0x5b, 0x5e, 0xfd, 0x14, 0x1a, 0xb7, 0x3c, 0xb1, 0xd7, 0xb6, 0x07, 0xde, 0x6c, 0x83,
+	0x19, 0x38, 0x18, 0xc1, 0xc1, 0x25, 0xaa, 0x59, 0xc1, 0xf5, 0x23, 0x9b, 0xb6, 0xe8, 0x21, 0x34,
+	0xd3, 0x42, 0x48, 0x2e, 0xca, 0xb5, 0x57, 0x46, 0x7a, 0x66, 0x93, 0x78, 0x88, 0x91, 0xa4, 0x1f,
+	0xec, 0xae, 0xdb, 0x0e, 0x5d, 0x0d, 0xbc, 0xa5, 0x1f, 0x30, 0x18, 0xc3, 0xe1, 0xc3, 0xd7, 0xca,
+	0x32, 0x07, 0xd0, 0x9e, 0x6d, 0x85, 0xaa, 0xda, 0x47, 0x4b, 0xe3, 0x9c, 0x27, 0x69, 0xe5, 0xcd,
+	0x0e, 0x5b, 0xa8, 0x03, 0x34, 0x74, 0x61, 0x90, 0xe0, 0x07, 0x20, 0xe5, 0x87, 0x6a, 0x2a, 0x2f,
+	0x9f, 0xfa, 0x0a, 0x1e, 0xcd, 0x2d, 0x20, 0xc6, 0x59, 0x8a, 0xa6, 0x4d, 0x27, 0xec, 0xcc, 0xf0,
+	0xdf, 0x34, 0x3c, 0xf8, 0xab, 0x01, 0x8d, 0x0b, 0xc1, 0x19, 0xf9, 0x11, 0xda, 0x73, 0xba, 0x91,
+	0xc3, 0xa5, 0xef, 0xeb, 0x67, 0xfd, 0x47, 0xd7, 0x7d, 0x62, 0x0b, 0x5e, 0x25, 0xf1, 0x2b, 0x68,
+	0x95, 0xd2, 0x90, 0xc7, 0x35, 0x6b, 0x4e, 0xd5, 0xee, 0xc1, 0x03, 0xb4, 0xcc, 0x7b, 0x06, 0x4d,
+	0x0b, 0x91, 0x05, 0x11, 0xba, 0x33, 0x25, 0xc9, 0x2f, 0xc6, 0x21, 0x73, 0x53, 0x25, 0x47, 0xf5,
+	0x5d, 0xcb, 0xca, 0x76, 0x3f, 0x5d, 0x7d, 0x58, 0xd7, 0xb9, 0xbb, 0x70, 0x42, 0x56, 0x79, 0xba,
+	0xbb, 0xa4, 0x0c, 0x39, 0x03, 0x98, 0xcd, 0xfa, 0x41, 0xad, 0xbe, 0x8d, 0x56, 0x68, 0xf1, 0x1c,
+	0xdc, 0x37, 0xfa, 0x5f, 0x6e, 0x39, 0x67, 0xcd, 0x88, 0xc9, 0x0b, 0xf0, 0x42, 0x94, 0xc5, 0xf8,
+	0x7f, 0xa4, 0x7c, 0x0f, 0x9d, 0xf3, 0x84, 0x8b, 0x8d, 0x4d, 0xad, 0xc9, 0x4f, 0x9a, 0x26, 0x7e,
+	0xf9, 0xef, 0x00, 0xf0, 0xe0, 0x21, 0xa1, 0xf0, 0x08, 0x00, 0x00,
+}
@@ -0,0 +1,90 @@
+// Code generated by svcdec; DO NOT EDIT
+
+package cron
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+
+	google_protobuf "github.com/luci/luci-go/common/proto/google"
+)
+
+type DecoratedCron struct {
+	// Service is the service to decorate.
+	Service CronServer
+	// Prelude is called in each method before forwarding the call to Service.
+	// If Prelude returns an error, it is returned without forwarding the call.
+	Prelude func(c context.Context, methodName string, req proto.Message) (context.Context, error)
+}
+
+func (s *DecoratedCron) GetProjects(c context.Context, req *google_protobuf.Empty) (*GetProjectsResponse, error) {
+	c, err := s.Prelude(c, "GetProjects", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.GetProjects(c, req)
+}
+
+func (s *DecoratedCron) GetJobs(c context.Context, req *GetJobsRequest) (*GetJobsResponse, error) {
+	c, err := s.Prelude(c, "GetJobs", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.GetJobs(c, req)
+}
+
+func (s *DecoratedCron) GetJob(c context.Context, req *JobRef) (*Job, error) {
+	c, err := s.Prelude(c, "GetJob", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.GetJob(c, req)
+}
+
+func (s *DecoratedCron) GetInvocations(c context.Context, req *GetInvocationsRequest) (*GetInvocationsResponse, error) {
+	c, err := s.Prelude(c, "GetInvocations", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.GetInvocations(c, req)
+}
+
+func (s *DecoratedCron) GetInvocation(c context.Context, req *InvocationRef) (*Invocation, error) {
+	c, err := s.Prelude(c, "GetInvocation", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.GetInvocation(c, req)
+}
+
+func (s *DecoratedCron) TriggerJob(c context.Context, req *JobRef) (*TriggerJobResponse, error) {
+	c, err := s.Prelude(c, "TriggerJob", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.TriggerJob(c, req)
+}
+
+func (s *DecoratedCron) PauseJob(c context.Context, req *JobRef) (*google_protobuf.Empty, error) {
+	c, err := s.Prelude(c, "PauseJob", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.PauseJob(c, req)
+}
+
+func (s *DecoratedCron) ResumeJob(c context.Context, req *JobRef) (*google_protobuf.Empty, error) {
+	c, err := s.Prelude(c, "ResumeJob", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.ResumeJob(c, req)
+}
+
+func (s *DecoratedCron) AbortInvocation(c context.Context, req *InvocationRef) (*google_protobuf.Empty, error) {
+	c, err := s.Prelude(c, "AbortInvocation", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.AbortInvocation(c, req)
+}
@@ -0,0 +1,18 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+//go:generate cproto
+//go:generate svcdec -type CronServer
+
+// Package cron contains Version 1 of the LUCI Cron service API.
+//
+// The package name here must match the protobuf package name, as the generated
+// files will reside in the same directory.
+package cron
+
+import (
+	"github.com/golang/protobuf/proto"
+)
+
+var _ = proto.Marshal
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go.
+// source: distributor.proto
+// DO NOT EDIT!
+
+/*
+Package distributor is a generated protocol buffer package.
+
+It is generated from these files:
+	distributor.proto
+
+It has these top-level messages:
+	Distributor
+	Config
+*/
+package distributor
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import swarmingV1 "github.com/luci/luci-go/common/api/dm/distributor/swarming/v1"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Distributor is the configuration of a single distributor, as referenced by
+// the distributor_config_name of a Quest.
+type Distributor struct {
+	// Types that are valid to be assigned to DistributorType:
+	//	*Distributor_SwarmingV1
+	DistributorType isDistributor_DistributorType `protobuf_oneof:"distributor_type"`
+}
+
+func (m *Distributor) Reset()                    { *m = Distributor{} }
+func (m *Distributor) String() string            { return proto.CompactTextString(m) }
+func (*Distributor) ProtoMessage()               {}
+func (*Distributor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isDistributor_DistributorType interface {
+	isDistributor_DistributorType()
+}
+
+type Distributor_SwarmingV1 struct {
+	SwarmingV1 *swarmingV1.Config `protobuf:"bytes,1,opt,name=swarming_v1,json=swarmingV1,oneof"`
+}
+
+func (*Distributor_SwarmingV1) isDistributor_DistributorType() {}
+
+func (m *Distributor) GetDistributorType() isDistributor_DistributorType {
+	if m != nil {
+		return m.DistributorType
+	}
+	return nil
+}
+
+func (m *Distributor) GetSwarmingV1() *swarmingV1.Config {
+	if x, ok := m.GetDistributorType().(*Distributor_SwarmingV1); ok {
+		return x.SwarmingV1
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Distributor) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Distributor_OneofMarshaler, _Distributor_OneofUnmarshaler, _Distributor_OneofSizer, []interface{}{
+		(*Distributor_SwarmingV1)(nil),
+	}
+}
+
+func _Distributor_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Distributor)
+	// distributor_type
+	switch x := m.DistributorType.(type) {
+	case *Distributor_SwarmingV1:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SwarmingV1); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Distributor.DistributorType has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Distributor_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Distributor)
+	switch tag {
+	case 1: // distributor_type.swarming_v1
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(swarmingV1.Config)
+		err := b.DecodeMessage(msg)
+		m.DistributorType = &Distributor_SwarmingV1{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Distributor_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Distributor)
+	// distributor_type
+	switch x := m.DistributorType.(type) {
+	case *Distributor_SwarmingV1:
+		s := proto.Size(x.SwarmingV1)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Config is the content of DM's "distributors.cfg" service config file.
+type Config struct {
+	// distributor_configs maps distributor configuration names to their
+	// configurations.
+	DistributorConfigs map[string]*Distributor `protobuf:"bytes,1,rep,name=distributor_configs,json=distributorConfigs" json:"distributor_configs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *Config) Reset()                    { *m = Config{} }
+func (m *Config) String() string            { return proto.CompactTextString(m) }
+func (*Config) ProtoMessage()               {}
+func (*Config) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Config) GetDistributorConfigs() map[string]*Distributor {
+	if m != nil {
+		return m.DistributorConfigs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Distributor)(nil), "distributor.Distributor")
+	proto.RegisterType((*Config)(nil), "distributor.Config")
+}
+
+var fileDescriptor0 = []byte{
+	// 250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0xc9, 0x2c, 0x2e,
+	0x29, 0xca, 0x4c, 0x2a, 0x2d, 0xc9, 0x2f, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x46,
+	0x12, 0x92, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x29,
+	0x4d, 0xce, 0x04, 0x13, 0xba, 0xe9, 0xf9, 0xfa, 0xc9, 0xf9, 0xb9, 0xb9, 0xf9, 0x79, 0xfa, 0x89,
+	0x05, 0x99, 0xfa, 0x29, 0xb9, 0xfa, 0x48, 0x5a, 0xf4, 0x8b, 0xcb, 0x13, 0x8b, 0x72, 0x33, 0xf3,
+	0xd2, 0xf5, 0xcb, 0x0c, 0xf5, 0x93, 0xf3, 0xf3, 0xd2, 0x32, 0xd3, 0x21, 0x06, 0x2b, 0x45, 0x70,
+	0x71, 0xbb, 0x20, 0xd4, 0x09, 0x99, 0x72, 0x71, 0xc3, 0x94, 0xc6, 0x97, 0x19, 0x4a, 0x30, 0x2a,
+	0x30, 0x6a, 0x70, 0x1b, 0x09, 0xe9, 0xc1, 0xc4, 0xc2, 0x0c, 0xf5, 0x9c, 0xc1, 0xba, 0x3d, 0x18,
+	0x82, 0xb8, 0x10, 0x82, 0x4e, 0x42, 0x5c, 0x02, 0x48, 0xb6, 0xc5, 0x97, 0x54, 0x16, 0xa4, 0x2a,
+	0x1d, 0x67, 0xe4, 0x62, 0x83, 0x28, 0x16, 0x8a, 0xe1, 0x12, 0x46, 0x96, 0x86, 0x38, 0xa0, 0x58,
+	0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x5b, 0x0f, 0xd9, 0xbb, 0x10, 0x1d, 0x7a, 0x48, 0x6e,
+	0x82, 0x88, 0x14, 0xbb, 0xe6, 0x95, 0x14, 0x55, 0x06, 0x09, 0xa5, 0x60, 0x48, 0x48, 0xc5, 0x73,
+	0x89, 0xe3, 0x50, 0x2e, 0x24, 0xc0, 0xc5, 0x9c, 0x9d, 0x5a, 0x09, 0xf6, 0x06, 0x67, 0x10, 0x88,
+	0x29, 0xa4, 0xc7, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a, 0xc1, 0x04, 0xf6, 0x9a, 0x04, 0x8a,
+	0xe5, 0x48, 0xc6, 0x04, 0x41, 0x94, 0x59, 0x31, 0x59, 0x30, 0x26, 0xb1, 0x81, 0x83, 0xca, 0x18,
+	0x30, 0x00, 0xce, 0xd2, 0xfa, 0x1b, 0x98, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,75 @@
+// Code generated by protoc-gen-go.
+// source: config.proto
+// DO NOT EDIT!
+
+/*
+Package swarmingV1 is a generated protocol buffer package.
+
+It is generated from these files:
+	config.proto
+	params.proto
+
+It has these top-level messages:
+	Config
+	Parameters
+*/
+package swarmingV1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Config is the configuration of a Swarming distributor.
+type Config struct {
+	// host is the hostname of the Swarming service, e.g.
+	// "chromium-swarm.appspot.com".
+	Host string `protobuf:"bytes,1,opt,name=host" json:"host,omitempty"`
+	// default_priority is the priority of tasks whose Parameters don't specify
+	// one. Lower values are more important.
+	DefaultPriority uint32 `protobuf:"varint,2,opt,name=default_priority,json=defaultPriority" json:"default_priority,omitempty"`
+}
+
+func (m *Config) Reset()                    { *m = Config{} }
+func (m *Config) String() string            { return proto.CompactTextString(m) }
+func (*Config) ProtoMessage()               {}
+func (*Config) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Config) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *Config) GetDefaultPriority() uint32 {
+	if m != nil {
+		return m.DefaultPriority
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Config)(nil), "swarmingV1.Config")
+}
+
+var fileDescriptor0 = []byte{
+	// 108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x4b,
+	0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2a, 0x2e, 0x4f, 0x2c, 0xca, 0xcd,
+	0xcc, 0x4b, 0x0f, 0x33, 0x54, 0x72, 0xe7, 0x62, 0x73, 0x06, 0xcb, 0x09, 0x09, 0x71, 0xb1, 0x64,
+	0xe4, 0x17, 0x97, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9, 0x42, 0x9a, 0x5c, 0x02,
+	0x29, 0xa9, 0x69, 0x89, 0xa5, 0x39, 0x25, 0xf1, 0x05, 0x45, 0x99, 0xf9, 0x45, 0x99, 0x25, 0x95,
+	0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xbc, 0x41, 0xfc, 0x50, 0xf1, 0x00, 0xa8, 0x70, 0x12, 0x1b, 0xd8,
+	0x6c, 0x63, 0xc0, 0x00, 0x7b, 0x67, 0x33, 0xee, 0x6b, 0x00, 0x00, 0x00,
+}
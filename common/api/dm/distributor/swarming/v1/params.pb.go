@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go.
+// source: params.proto
+// DO NOT EDIT!
+
+package swarmingV1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/luci/luci-go/common/proto/google"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Parameters is the json_payload of a Quest which is run by a Swarming
+// distributor.
+type Parameters struct {
+	// Exactly one of command or isolated must be specified.
+	Command   []string             `protobuf:"bytes,1,rep,name=command" json:"command,omitempty"`
+	Isolated  *Parameters_Isolated `protobuf:"bytes,2,opt,name=isolated" json:"isolated,omitempty"`
+	ExtraArgs []string             `protobuf:"bytes,3,rep,name=extra_args,json=extraArgs" json:"extra_args,omitempty"`
+	// dimensions select the bots which may run the task. At least one dimension
+	// is required.
+	Dimensions map[string]string `protobuf:"bytes,4,rep,name=dimensions" json:"dimensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Env        map[string]string `protobuf:"bytes,5,rep,name=env" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// priority of the task, [0, 255]. If 0, the distributor's default priority
+	// is used.
+	Priority uint32 `protobuf:"varint,6,opt,name=priority" json:"priority,omitempty"`
+	// expiration is how long the task may wait for a bot. Defaults to 1 hour.
+	Expiration *google_protobuf.Duration `protobuf:"bytes,7,opt,name=expiration" json:"expiration,omitempty"`
+	// execution_timeout is how long the task may run. Defaults to 1 hour.
+	ExecutionTimeout *google_protobuf.Duration `protobuf:"bytes,8,opt,name=execution_timeout,json=executionTimeout" json:"execution_timeout,omitempty"`
+	// io_timeout is how long the task may go without producing output. If 0,
+	// there is no limit.
+	IoTimeout *google_protobuf.Duration `protobuf:"bytes,9,opt,name=io_timeout,json=ioTimeout" json:"io_timeout,omitempty"`
+}
+
+func (m *Parameters) Reset()                    { *m = Parameters{} }
+func (m *Parameters) String() string            { return proto.CompactTextString(m) }
+func (*Parameters) ProtoMessage()               {}
+func (*Parameters) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *Parameters) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *Parameters) GetIsolated() *Parameters_Isolated {
+	if m != nil {
+		return m.Isolated
+	}
+	return nil
+}
+
+func (m *Parameters) GetExtraArgs() []string {
+	if m != nil {
+		return m.ExtraArgs
+	}
+	return nil
+}
+
+func (m *Parameters) GetDimensions() map[string]string {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+func (m *Parameters) GetEnv() map[string]string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *Parameters) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Parameters) GetExpiration() *google_protobuf.Duration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *Parameters) GetExecutionTimeout() *google_protobuf.Duration {
+	if m != nil {
+		return m.ExecutionTimeout
+	}
+	return nil
+}
+
+func (m *Parameters) GetIoTimeout() *google_protobuf.Duration {
+	if m != nil {
+		return m.IoTimeout
+	}
+	return nil
+}
+
+// Isolated is a reference to an isolated tree to run.
+type Parameters_Isolated struct {
+	Hash      string `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
+	Server    string `protobuf:"bytes,2,opt,name=server" json:"server,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *Parameters_Isolated) Reset()                    { *m = Parameters_Isolated{} }
+func (m *Parameters_Isolated) String() string            { return proto.CompactTextString(m) }
+func (*Parameters_Isolated) ProtoMessage()               {}
+func (*Parameters_Isolated) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0, 0} }
+
+func (m *Parameters_Isolated) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Parameters_Isolated) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *Parameters_Isolated) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Parameters)(nil), "swarmingV1.Parameters")
+	proto.RegisterType((*Parameters_Isolated)(nil), "swarmingV1.Parameters.Isolated")
+}
+
+var fileDescriptor1 = []byte{
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x91, 0x5f, 0x8b, 0xd3, 0x40,
+	0x14, 0xc5, 0xc9, 0x66, 0xdb, 0x4d, 0xee, 0x2a, 0xae, 0x17, 0x91, 0x31, 0xf8, 0x27, 0xf8, 0x20,
+	0x79, 0xca, 0xb2, 0x2b, 0xc8, 0xaa, 0xf8, 0x20, 0x6c, 0x0b, 0xbe, 0x49, 0x28, 0xbe, 0x96, 0x69,
+	0x73, 0x4d, 0x07, 0x9b, 0x99, 0x30, 0x33, 0x89, 0xed, 0xe7, 0xf1, 0x8b, 0x4a, 0x26, 0x7f, 0x5a,
+	0x04, 0x2d, 0xbe, 0xcd, 0x99, 0x39, 0xe7, 0x37, 0x33, 0xe7, 0xc2, 0x83, 0x8a, 0x6b, 0x5e, 0x9a,
+	0xb4, 0xd2, 0xca, 0x2a, 0x04, 0xf3, 0x93, 0xeb, 0x52, 0xc8, 0xe2, 0xdb, 0x4d, 0xf4, 0xb2, 0x50,
+	0xaa, 0xd8, 0xd2, 0xb5, 0x3b, 0x59, 0xd5, 0xdf, 0xaf, 0xf3, 0x5a, 0x73, 0x2b, 0x94, 0xec, 0xbc,
+	0xaf, 0x7f, 0x4d, 0x00, 0xbe, 0xb6, 0x61, 0xb2, 0xa4, 0x0d, 0x32, 0xb8, 0x58, 0xab, 0xb2, 0xe4,
+	0x32, 0x67, 0x5e, 0xec, 0x27, 0x61, 0x36, 0x48, 0xfc, 0x08, 0x81, 0x30, 0x6a, 0xcb, 0x2d, 0xe5,
+	0xec, 0x2c, 0xf6, 0x92, 0xcb, 0xdb, 0x57, 0xe9, 0xe1, 0x9e, 0xf4, 0xc0, 0x48, 0xbf, 0xf4, 0xb6,
+	0x6c, 0x0c, 0xe0, 0x0b, 0x00, 0xda, 0x59, 0xcd, 0x97, 0x5c, 0x17, 0x86, 0xf9, 0x8e, 0x1c, 0xba,
+	0x9d, 0xcf, 0xba, 0x30, 0x38, 0x07, 0xc8, 0x45, 0x49, 0xd2, 0x08, 0x25, 0x0d, 0x3b, 0x8f, 0xfd,
+	0xe4, 0xf2, 0xf6, 0xcd, 0x5f, 0xe8, 0xf7, 0xa3, 0x71, 0x26, 0xad, 0xde, 0x67, 0x47, 0x49, 0xbc,
+	0x01, 0x9f, 0x64, 0xc3, 0x26, 0xb1, 0xff, 0x8f, 0xe7, 0xcd, 0x64, 0xd3, 0x25, 0x5b, 0x2f, 0x46,
+	0x10, 0x54, 0x5a, 0x28, 0x2d, 0xec, 0x9e, 0x4d, 0x63, 0x2f, 0x79, 0x98, 0x8d, 0x1a, 0xdf, 0xb7,
+	0xaf, 0xae, 0x44, 0xd7, 0x17, 0xbb, 0x70, 0x9f, 0x7e, 0x96, 0x76, 0x85, 0xa6, 0x43, 0xa1, 0xe9,
+	0x7d, 0x5f, 0x68, 0x76, 0x64, 0xc6, 0x39, 0x3c, 0xa6, 0x1d, 0xad, 0xeb, 0x56, 0x2c, 0xad, 0x28,
+	0x49, 0xd5, 0x96, 0x05, 0xa7, 0x08, 0x57, 0x63, 0x66, 0xd1, 0x45, 0xf0, 0x0e, 0x40, 0xa8, 0x11,
+	0x10, 0x9e, 0x02, 0x84, 0x42, 0xf5, 0xc9, 0x68, 0x01, 0xc1, 0x30, 0x08, 0x44, 0x38, 0xdf, 0x70,
+	0xb3, 0x61, 0x5e, 0xec, 0x25, 0x61, 0xe6, 0xd6, 0xf8, 0x14, 0xa6, 0x86, 0x74, 0x43, 0xda, 0x4d,
+	0x33, 0xcc, 0x7a, 0x85, 0xcf, 0x21, 0x94, 0xbc, 0x24, 0x53, 0xf1, 0x35, 0x31, 0xdf, 0x1d, 0x1d,
+	0x36, 0xa2, 0x4f, 0xf0, 0xe8, 0x8f, 0x01, 0xe0, 0x15, 0xf8, 0x3f, 0x68, 0xdf, 0xb3, 0xdb, 0x25,
+	0x3e, 0x81, 0x49, 0xc3, 0xb7, 0x35, 0xf5, 0xe4, 0x4e, 0x7c, 0x38, 0xbb, 0xf3, 0xa2, 0x77, 0x10,
+	0x0c, 0xf5, 0xff, 0x4f, 0x6e, 0x35, 0x75, 0x5f, 0x7d, 0xfb, 0x7b, 0x00, 0x5a, 0x34, 0x34, 0x13,
+	0xe8, 0x02, 0x00, 0x00,
+}
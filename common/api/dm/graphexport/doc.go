@@ -0,0 +1,14 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package graphexport converts DM GraphData (e.g. the result of a WalkGraph
+// RPC) into formats which are easier to inspect when debugging: Graphviz DOT
+// and a flat JSON list of nodes and edges.
+//
+// Every Attempt in the GraphData becomes a node, coloured by its state, and
+// every dependency (forwards or backwards) between two Attempts becomes an
+// edge from the depending Attempt to the depended-on one. Attempts which are
+// only known as the endpoint of a dependency are included as nodes without a
+// state.
+package graphexport
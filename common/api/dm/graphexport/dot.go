@@ -0,0 +1,76 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package graphexport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// dotQuote returns s as a quoted DOT ID.
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
+
+// WriteDOT writes g to w in the Graphviz DOT format. The Attempts of each
+// Quest are grouped into a cluster.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph dm {")
+	fmt.Fprintln(bw, "  node [shape=box, style=filled];")
+	switch {
+	case g.HadErrors:
+		fmt.Fprintln(bw, `  label="incomplete graph (DM had errors)";`)
+	case g.HadMore:
+		fmt.Fprintln(bw, `  label="incomplete graph (DM had more data)";`)
+	}
+
+	for i := 0; i < len(g.Nodes); {
+		quest := g.Nodes[i].Quest
+		fmt.Fprintf(bw, "\n  subgraph %s {\n", dotQuote("cluster_"+quest))
+		fmt.Fprintf(bw, "    label=%s;\n", dotQuote(quest))
+		for ; i < len(g.Nodes) && g.Nodes[i].Quest == quest; i++ {
+			writeDOTNode(bw, g.Nodes[i])
+		}
+		fmt.Fprintln(bw, "  }")
+	}
+
+	if len(g.Edges) > 0 {
+		fmt.Fprintln(bw)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(bw, "  %s -> %s;\n", dotQuote(e.From), dotQuote(e.To))
+	}
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+func writeDOTNode(w io.Writer, n *Node) {
+	label := fmt.Sprintf("#%d", n.Attempt)
+	switch {
+	case n.DNE:
+		label += "\nDNE"
+	case n.State != "":
+		label += "\n" + n.State
+		if n.NumExecutions > 0 {
+			label += fmt.Sprintf("\nexecutions: %d", n.NumExecutions)
+		}
+	}
+
+	attrs := []string{
+		"label=" + dotQuote(label),
+		"fillcolor=" + dotQuote(n.Color),
+	}
+	if n.DNE || n.Partial {
+		attrs = append(attrs, `style="filled,dashed"`)
+	}
+	fmt.Fprintf(w, "    %s [%s];\n", dotQuote(n.ID), strings.Join(attrs, ", "))
+}
@@ -0,0 +1,183 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package graphexport
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+)
+
+// stateColors maps Attempt states to the Graphviz colour of their nodes.
+var stateColors = map[dm.Attempt_State]string{
+	dm.Attempt_NEEDS_EXECUTION:          "lightgrey",
+	dm.Attempt_EXECUTING:                "yellow",
+	dm.Attempt_ADDING_DEPS:              "khaki",
+	dm.Attempt_BLOCKED:                  "orange",
+	dm.Attempt_AWAITING_EXECUTION_STATE: "plum",
+	dm.Attempt_FINISHED:                 "palegreen",
+	dm.Attempt_ABNORMAL_FINISHED:        "salmon",
+}
+
+// unknownColor is the colour of nodes whose state is not known, either because
+// the Attempt data wasn't loaded, or because the Attempt doesn't exist.
+const unknownColor = "white"
+
+// Node is a single Attempt in a Graph.
+type Node struct {
+	// ID is the "<quest>|<attempt>" identifier of this node, which Edges refer
+	// to.
+	ID      string `json:"id"`
+	Quest   string `json:"quest"`
+	Attempt uint32 `json:"attempt"`
+
+	// State is the name of the Attempt's state, or empty if it's not known.
+	State string `json:"state,omitempty"`
+	Color string `json:"color"`
+
+	NumExecutions uint32 `json:"num_executions,omitempty"`
+
+	// DNE is true if DM reported that this Attempt doesn't exist.
+	DNE bool `json:"dne,omitempty"`
+
+	// Partial is true if DM couldn't load all of the requested data for this
+	// Attempt.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// Edge is a dependency of the Attempt From on the Attempt To.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Graph is a flattened view of a dm.GraphData.
+type Graph struct {
+	// Nodes are sorted by quest, then by attempt number.
+	Nodes []*Node `json:"nodes"`
+
+	// Edges are sorted by From, then by To.
+	Edges []*Edge `json:"edges"`
+
+	// HadMore and HadErrors are copied from the GraphData.
+	HadMore   bool `json:"had_more,omitempty"`
+	HadErrors bool `json:"had_errors,omitempty"`
+}
+
+// NodeID returns the ID of the Node for the Attempt aid.
+func NodeID(aid *dm.Attempt_ID) string {
+	return fmt.Sprintf("%s|%d", aid.Quest, aid.Id)
+}
+
+// FromGraphData flattens gd into a Graph.
+func FromGraphData(gd *dm.GraphData) *Graph {
+	b := builder{nodes: map[string]*Node{}, edges: map[Edge]struct{}{}}
+
+	for qid, qst := range gd.Quests {
+		for num, atmpt := range qst.Attempts {
+			aid := &dm.Attempt_ID{Quest: qid, Id: num}
+			n := b.node(aid)
+			n.DNE = qst.DNE || atmpt.DNE
+
+			// NormalizePartial drops Partial if all of its fields are unset.
+			partial := *atmpt
+			partial.NormalizePartial()
+			n.Partial = partial.Partial != nil
+
+			if d := atmpt.Data; d != nil {
+				n.State = d.State().String()
+				n.Color = stateColors[d.State()]
+				n.NumExecutions = d.NumExecutions
+			}
+
+			forEachAttempt(atmpt.FwdDeps, func(to *dm.Attempt_ID) {
+				b.edge(aid, to)
+			})
+			forEachAttempt(atmpt.BackDeps, func(from *dm.Attempt_ID) {
+				b.edge(from, aid)
+			})
+		}
+	}
+
+	return b.graph(gd)
+}
+
+// forEachAttempt calls cb for every Attempt in al, in no particular order.
+func forEachAttempt(al *dm.AttemptList, cb func(*dm.Attempt_ID)) {
+	for qid, nums := range al.GetTo() {
+		for _, num := range nums.Nums {
+			cb(&dm.Attempt_ID{Quest: qid, Id: num})
+		}
+	}
+}
+
+type builder struct {
+	nodes map[string]*Node
+	edges map[Edge]struct{}
+}
+
+func (b *builder) node(aid *dm.Attempt_ID) *Node {
+	id := NodeID(aid)
+	n := b.nodes[id]
+	if n == nil {
+		n = &Node{ID: id, Quest: aid.Quest, Attempt: aid.Id, Color: unknownColor}
+		b.nodes[id] = n
+	}
+	return n
+}
+
+func (b *builder) edge(from, to *dm.Attempt_ID) {
+	b.edges[Edge{b.node(from).ID, b.node(to).ID}] = struct{}{}
+}
+
+func (b *builder) graph(gd *dm.GraphData) *Graph {
+	g := &Graph{
+		Nodes:     make([]*Node, 0, len(b.nodes)),
+		Edges:     make([]*Edge, 0, len(b.edges)),
+		HadMore:   gd.HadMore,
+		HadErrors: gd.HadErrors,
+	}
+	for _, n := range b.nodes {
+		g.Nodes = append(g.Nodes, n)
+	}
+	sort.Sort(nodeSlice(g.Nodes))
+
+	for e := range b.edges {
+		e := e
+		g.Edges = append(g.Edges, &e)
+	}
+	sort.Sort(edgeSlice{g.Edges, b.nodes})
+	return g
+}
+
+type nodeSlice []*Node
+
+func (s nodeSlice) Len() int           { return len(s) }
+func (s nodeSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s nodeSlice) Less(i, j int) bool { return nodeLess(s[i], s[j]) }
+
+func nodeLess(a, b *Node) bool {
+	if a.Quest != b.Quest {
+		return a.Quest < b.Quest
+	}
+	return a.Attempt < b.Attempt
+}
+
+// edgeSlice sorts edges in the same order as their nodes.
+type edgeSlice struct {
+	edges []*Edge
+	nodes map[string]*Node
+}
+
+func (s edgeSlice) Len() int      { return len(s.edges) }
+func (s edgeSlice) Swap(i, j int) { s.edges[i], s.edges[j] = s.edges[j], s.edges[i] }
+func (s edgeSlice) Less(i, j int) bool {
+	a, b := s.edges[i], s.edges[j]
+	if a.From != b.From {
+		return nodeLess(s.nodes[a.From], s.nodes[b.From])
+	}
+	return nodeLess(s.nodes[a.To], s.nodes[b.To])
+}
@@ -0,0 +1,100 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package graphexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/api/dm/service/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testGraphData() *dm.GraphData {
+	finished := dm.NewAttemptFinished(time.Time{}, 10, "")
+	finished.Data.NumExecutions = 1
+	finished.BackDeps = dm.NewAttemptList(map[string][]uint32{"a": {1}})
+
+	blocked := dm.NewAttemptBlocked(1)
+	blocked.FwdDeps = dm.NewAttemptList(map[string][]uint32{"b": {1}, "c": {2}})
+	blocked.Partial = &dm.Attempt_Partial{}
+
+	return &dm.GraphData{
+		Quests: map[string]*dm.Quest{
+			"b": {Attempts: map[uint32]*dm.Attempt{1: finished}},
+			"a": {Attempts: map[uint32]*dm.Attempt{
+				1:  blocked,
+				10: {DNE: true},
+			}},
+		},
+		HadMore: true,
+	}
+}
+
+func TestGraphExport(t *testing.T) {
+	t.Parallel()
+
+	Convey("Graph export", t, func() {
+		g := FromGraphData(testGraphData())
+
+		Convey("FromGraphData", func() {
+			So(g, ShouldResemble, &Graph{
+				Nodes: []*Node{
+					{ID: "a|1", Quest: "a", Attempt: 1, State: "BLOCKED", Color: "orange"},
+					{ID: "a|10", Quest: "a", Attempt: 10, Color: "white", DNE: true},
+					{ID: "b|1", Quest: "b", Attempt: 1, State: "FINISHED", Color: "palegreen",
+						NumExecutions: 1},
+					{ID: "c|2", Quest: "c", Attempt: 2, Color: "white"},
+				},
+				Edges: []*Edge{
+					{From: "a|1", To: "b|1"},
+					{From: "a|1", To: "c|2"},
+				},
+				HadMore: true,
+			})
+		})
+
+		Convey("WriteDOT", func() {
+			buf := &bytes.Buffer{}
+			So(g.WriteDOT(buf), ShouldBeNil)
+			So(buf.String(), ShouldEqual, `digraph dm {
+  node [shape=box, style=filled];
+  label="incomplete graph (DM had more data)";
+
+  subgraph "cluster_a" {
+    label="a";
+    "a|1" [label="#1\nBLOCKED", fillcolor="orange"];
+    "a|10" [label="#10\nDNE", fillcolor="white", style="filled,dashed"];
+  }
+
+  subgraph "cluster_b" {
+    label="b";
+    "b|1" [label="#1\nFINISHED\nexecutions: 1", fillcolor="palegreen"];
+  }
+
+  subgraph "cluster_c" {
+    label="c";
+    "c|2" [label="#2", fillcolor="white"];
+  }
+
+  "a|1" -> "b|1";
+  "a|1" -> "c|2";
+}
+`)
+		})
+
+		Convey("WriteJSON", func() {
+			buf := &bytes.Buffer{}
+			So(g.WriteJSON(buf), ShouldBeNil)
+
+			decoded := &Graph{}
+			So(json.Unmarshal(buf.Bytes(), decoded), ShouldBeNil)
+			So(decoded, ShouldResemble, g)
+		})
+	})
+}
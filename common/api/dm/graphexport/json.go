@@ -0,0 +1,22 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package graphexport
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// WriteJSON writes g to w as an indented JSON object with "nodes" and "edges"
+// lists.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = w.Write(data)
+	return err
+}
@@ -7,27 +7,35 @@ Package dm is a generated protocol buffer package.
 
 It is generated from these files:
 	activate_execution.proto
-	claim_execution.proto
+	cancel_attempt.proto
 	ensure_graph_data.proto
+	execution_lease.proto
 	finish_attempt.proto
 	graph_data.proto
 	graph_query.proto
+	invalidate_attempt.proto
 	service.proto
 	types.proto
 	walk_graph.proto
 
 It has these top-level messages:
 	ActivateExecutionReq
-	ClaimExecutionRsp
+	CancelAttemptReq
 	TemplateInstantiation
 	EnsureGraphDataReq
 	EnsureGraphDataRsp
+	ClaimExecutionReq
+	ClaimExecutionRsp
+	RenewExecutionLeaseReq
+	RenewExecutionLeaseRsp
+	ReleaseExecutionLeaseReq
 	FinishAttemptReq
 	Quest
 	Attempt
 	Execution
 	GraphData
 	GraphQuery
+	InvalidateAttemptReq
 	MultiPropertyValue
 	PropertyValue
 	AttemptList
@@ -63,11 +71,11 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 // may use the ExecutionToken with any RPCs that have an ExecutionAuth field.
 //
 // This RPC may return:
-//   * OK - The Execution is now activated.
-//   * InvalidArgmument - The request was malformed. Retrying will not help.
-//   * PermissionDenied - The provided activation token was incorrect.
+//   - OK - The Execution is now activated.
+//   - InvalidArgmument - The request was malformed. Retrying will not help.
+//   - PermissionDenied - The provided activation token was incorrect.
 //     Retrying will not help.
-//   * AlreadyExists - The activation token was correct, but some other entity
+//   - AlreadyExists - The activation token was correct, but some other entity
 //     already activated this Execution. The client should cease operations.
 //     Retrying will not help.
 //
@@ -102,14 +110,14 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 139 bytes of a gzipped FileDescriptorProto
+	// 138 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x4c, 0x2e, 0xc9,
 	0x2c, 0x4b, 0x2c, 0x49, 0x8d, 0x4f, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b,
 	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4a, 0xc9, 0x95, 0x12, 0x48, 0x2f, 0x4a, 0x2c, 0xc8, 0x88,
 	0x4f, 0x49, 0x2c, 0x49, 0x84, 0x88, 0x2a, 0xa5, 0x73, 0x89, 0x38, 0x42, 0x75, 0xb8, 0xc2, 0x34,
 	0x04, 0xa5, 0x16, 0x0a, 0xa9, 0x71, 0xb1, 0x24, 0x96, 0x96, 0x64, 0x48, 0x30, 0x2a, 0x30, 0x6a,
-	0x70, 0x1b, 0x09, 0xe9, 0xa5, 0xe4, 0xea, 0xc1, 0xe5, 0xf5, 0x1c, 0x81, 0x32, 0x41, 0x60, 0x79,
-	0x21, 0x75, 0x2e, 0x7e, 0xb8, 0x45, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x12, 0x4c, 0x40, 0x2d,
-	0x3c, 0x41, 0x7c, 0x70, 0xe1, 0x10, 0x90, 0x68, 0x12, 0x1b, 0xd8, 0x3e, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x8e, 0xfe, 0x42, 0x6f, 0xa1, 0x00, 0x00, 0x00,
+	0x70, 0x1b, 0x09, 0xe9, 0xa5, 0xe4, 0xea, 0xc1, 0xe5, 0xf5, 0x1c, 0x4b, 0x4b, 0x32, 0x82, 0xc0,
+	0xf2, 0x42, 0xea, 0x5c, 0xfc, 0x70, 0x8b, 0xe2, 0x4b, 0xf2, 0xb3, 0x53, 0xf3, 0x24, 0x98, 0x14,
+	0x18, 0x35, 0x78, 0x82, 0xf8, 0xe0, 0xc2, 0x21, 0x20, 0xd1, 0x24, 0x36, 0xb0, 0x7d, 0xc6, 0x80,
+	0x01, 0x00, 0x8e, 0xfe, 0x42, 0x6f, 0xa1, 0x00, 0x00, 0x00,
 }
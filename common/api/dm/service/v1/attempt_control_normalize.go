@@ -0,0 +1,29 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"github.com/luci/luci-go/common/errors"
+)
+
+func normalizeAttemptID(a *Attempt_ID) error {
+	if a == nil {
+		return errors.New("attempt is required")
+	}
+	if a.Quest == "" || a.Id == 0 {
+		return errors.New("attempt must have a quest and a non-zero id")
+	}
+	return nil
+}
+
+// Normalize returns an error iff the CancelAttemptReq is invalid.
+func (r *CancelAttemptReq) Normalize() error {
+	return normalizeAttemptID(r.Attempt)
+}
+
+// Normalize returns an error iff the InvalidateAttemptReq is invalid.
+func (r *InvalidateAttemptReq) Normalize() error {
+	return normalizeAttemptID(r.Attempt)
+}
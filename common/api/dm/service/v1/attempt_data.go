@@ -55,6 +55,15 @@ func NewAttemptFinished(expiration time.Time, jsonResultSize uint32, jsonResult
 					google_pb.NewTimestamp(expiration), jsonResultSize, jsonResult}}}}
 }
 
+// NewAttemptAbnormalFinish creates an Attempt in the AbnormalFinished state.
+func NewAttemptAbnormalFinish(reason string) *Attempt {
+	return &Attempt{
+		Data: &Attempt_Data{
+			AttemptType: &Attempt_Data_AbnormalFinish_{
+				AbnormalFinish: &Attempt_Data_AbnormalFinish{
+					reason}}}}
+}
+
 // State computes the Attempt_State for the current Attempt_Data
 func (d *Attempt_Data) State() Attempt_State {
 	switch d.AttemptType.(type) {
@@ -66,6 +75,8 @@ func (d *Attempt_Data) State() Attempt_State {
 		return Attempt_BLOCKED
 	case *Attempt_Data_Finished_:
 		return Attempt_FINISHED
+	case *Attempt_Data_AbnormalFinish_:
+		return Attempt_ABNORMAL_FINISHED
 	}
 	// NEEDS_EXECUTION is the default
 	return Attempt_NEEDS_EXECUTION
@@ -27,6 +27,36 @@ func TestAttemptState(t *testing.T) {
 			So(s, ShouldEqual, Attempt_ADDING_DEPS)
 		})
 
+		Convey("Executing can be retried", func() {
+			s := Attempt_EXECUTING
+			So(s.Evolve(Attempt_NEEDS_EXECUTION), ShouldBeNil)
+			So(s, ShouldEqual, Attempt_NEEDS_EXECUTION)
+		})
+
+		Convey("Executing can give up", func() {
+			s := Attempt_EXECUTING
+			So(s.Evolve(Attempt_ABNORMAL_FINISHED), ShouldBeNil)
+			So(s, ShouldEqual, Attempt_ABNORMAL_FINISHED)
+		})
+
+		Convey("Unfinished attempts can be cancelled", func() {
+			s := Attempt_BLOCKED
+			So(s.Evolve(Attempt_ABNORMAL_FINISHED), ShouldBeNil)
+			So(s, ShouldEqual, Attempt_ABNORMAL_FINISHED)
+		})
+
+		Convey("Finished attempts can be invalidated", func() {
+			s := Attempt_FINISHED
+			So(s.Evolve(Attempt_NEEDS_EXECUTION), ShouldBeNil)
+			So(s, ShouldEqual, Attempt_NEEDS_EXECUTION)
+		})
+
+		Convey("Attempts which need execution can be re-blocked", func() {
+			s := Attempt_NEEDS_EXECUTION
+			So(s.Evolve(Attempt_BLOCKED), ShouldBeNil)
+			So(s, ShouldEqual, Attempt_BLOCKED)
+		})
+
 		Convey("Invalid starting transistion", func() {
 			s := Attempt_NEEDS_EXECUTION
 			So(s.Evolve(Attempt_FINISHED), ShouldErrLike, "invalid state transition NEEDS_EXECUTION -> FINISHED")
@@ -41,7 +71,7 @@ func TestAttemptState(t *testing.T) {
 
 		Convey("MustEvolve", func() {
 			s := Attempt_FINISHED
-			So(func() { s.MustEvolve(Attempt_NEEDS_EXECUTION) }, ShouldPanic)
+			So(func() { s.MustEvolve(Attempt_ADDING_DEPS) }, ShouldPanic)
 		})
 	})
 }
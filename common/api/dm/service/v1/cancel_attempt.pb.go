@@ -0,0 +1,50 @@
+// Code generated by protoc-gen-go.
+// source: cancel_attempt.proto
+// DO NOT EDIT!
+
+package dm
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// CancelAttemptReq cancels an Attempt which hasn't finished yet.
+type CancelAttemptReq struct {
+	// required
+	Attempt *Attempt_ID `protobuf:"bytes,1,opt,name=attempt" json:"attempt,omitempty"`
+	// Reason is a human-readable explanation of why the Attempt was cancelled.
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *CancelAttemptReq) Reset()                    { *m = CancelAttemptReq{} }
+func (m *CancelAttemptReq) String() string            { return proto.CompactTextString(m) }
+func (*CancelAttemptReq) ProtoMessage()               {}
+func (*CancelAttemptReq) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *CancelAttemptReq) GetAttempt() *Attempt_ID {
+	if m != nil {
+		return m.Attempt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CancelAttemptReq)(nil), "dm.CancelAttemptReq")
+}
+
+var fileDescriptor1 = []byte{
+	// 124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x4e, 0xcc, 0x4b,
+	0x4e, 0xcd, 0x89, 0x4f, 0x2c, 0x29, 0x49, 0xcd, 0x2d, 0x28, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x62, 0x4a, 0xc9, 0x95, 0x12, 0x48, 0x2f, 0x4a, 0x2c, 0xc8, 0x88, 0x4f, 0x49, 0x2c, 0x49,
+	0x84, 0x88, 0x2a, 0x85, 0x70, 0x09, 0x38, 0x83, 0x55, 0x3b, 0x42, 0x14, 0x07, 0xa5, 0x16, 0x0a,
+	0x69, 0x70, 0xb1, 0x43, 0xb5, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xf1, 0xe9, 0xa5, 0xe4,
+	0xea, 0x41, 0x15, 0xe8, 0x79, 0xba, 0x04, 0xc1, 0xa4, 0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x52, 0x13,
+	0x8b, 0xf3, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0xa0, 0xbc, 0x24, 0x36, 0xb0, 0xe1,
+	0xc6, 0x80, 0x01, 0x00, 0xf4, 0x28, 0x20, 0xee, 0x8a, 0x00, 0x00, 0x00,
+}
@@ -144,7 +144,7 @@ func (g *GraphData) GetQuest(qid string) (*Quest, bool) {
 
 // NewQuestDesc is a shorthand method for building a new *Quest_Desc.
 func NewQuestDesc(cfg string, js string) *Quest_Desc {
-	return &Quest_Desc{cfg, js}
+	return &Quest_Desc{DistributorConfigName: cfg, JsonPayload: js}
 }
 
 // NewTemplateSpec is a shorthand method for building a new *Quest_TemplateSpec.
@@ -49,10 +49,42 @@ func (s *DecoratedDeps) WalkGraph(c context.Context, req *WalkGraphReq) (*GraphD
 	return s.Service.WalkGraph(c, req)
 }
 
-func (s *DecoratedDeps) ClaimExecution(c context.Context, req *google_protobuf1.Empty) (*ClaimExecutionRsp, error) {
+func (s *DecoratedDeps) ClaimExecution(c context.Context, req *ClaimExecutionReq) (*ClaimExecutionRsp, error) {
 	c, err := s.Prelude(c, "ClaimExecution", req)
 	if err != nil {
 		return nil, err
 	}
 	return s.Service.ClaimExecution(c, req)
 }
+
+func (s *DecoratedDeps) RenewExecutionLease(c context.Context, req *RenewExecutionLeaseReq) (*RenewExecutionLeaseRsp, error) {
+	c, err := s.Prelude(c, "RenewExecutionLease", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.RenewExecutionLease(c, req)
+}
+
+func (s *DecoratedDeps) ReleaseExecutionLease(c context.Context, req *ReleaseExecutionLeaseReq) (*google_protobuf1.Empty, error) {
+	c, err := s.Prelude(c, "ReleaseExecutionLease", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.ReleaseExecutionLease(c, req)
+}
+
+func (s *DecoratedDeps) CancelAttempt(c context.Context, req *CancelAttemptReq) (*google_protobuf1.Empty, error) {
+	c, err := s.Prelude(c, "CancelAttempt", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.CancelAttempt(c, req)
+}
+
+func (s *DecoratedDeps) InvalidateAttempt(c context.Context, req *InvalidateAttemptReq) (*google_protobuf1.Empty, error) {
+	c, err := s.Prelude(c, "InvalidateAttempt", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.InvalidateAttempt(c, req)
+}
@@ -41,10 +41,10 @@ func (m *TemplateInstantiation) GetSpecifier() *template.Specifier {
 
 // EnsureGraphDataReq allows you to assert some things about the state of DM's
 // graph:
-//   * That 0 or more quest descriptions exist (the `quests` and
+//   - That 0 or more quest descriptions exist (the `quests` and
 //     `template_quest` field).
-//   * That 0 or more attempts exist (the `attempts` field)
-//     * That those `attempts` are dependencies of a particular execution
+//   - That 0 or more attempts exist (the `attempts` field)
+//   - That those `attempts` are dependencies of a particular execution
 //
 // One of quests or attempts MUST be provided, it's an error for them to both
 // be empty. Any quest description must have at least one corresponding Attempt
@@ -246,41 +246,41 @@ func init() {
 }
 
 var fileDescriptor2 = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x53, 0x5d, 0x8b, 0xd3, 0x40,
-	0x14, 0xa5, 0x1f, 0x69, 0xd2, 0x49, 0xd3, 0x0d, 0xa3, 0x62, 0x0c, 0xa2, 0x52, 0x14, 0x16, 0xc4,
-	0x14, 0xeb, 0xc3, 0x8a, 0x2f, 0xba, 0xd0, 0xa2, 0x95, 0x45, 0x70, 0xd6, 0x27, 0x5f, 0x42, 0x36,
-	0x99, 0x36, 0x23, 0xf9, 0xda, 0xcc, 0x0c, 0xac, 0xe2, 0xef, 0xf1, 0xf7, 0xf8, 0x93, 0xbc, 0x33,
-	0xf9, 0xe8, 0xb2, 0xeb, 0xbe, 0x5c, 0x9a, 0x33, 0xe7, 0x9c, 0x7b, 0xef, 0xcc, 0x29, 0x7a, 0x48,
-	0x0b, 0x2e, 0x6b, 0x1a, 0xee, 0xeb, 0xa8, 0x4a, 0xc3, 0x24, 0x12, 0x51, 0x50, 0xd5, 0xa5, 0x28,
-	0xf1, 0x30, 0xc9, 0x7d, 0x5b, 0xfc, 0xac, 0x28, 0x6f, 0x00, 0xdf, 0xbd, 0x49, 0xf1, 0xdf, 0xed,
-	0x99, 0x48, 0xe5, 0x45, 0x10, 0x97, 0xf9, 0x32, 0x93, 0x31, 0xd3, 0xe5, 0xd5, 0xbe, 0x5c, 0x02,
-	0x90, 0x97, 0xc5, 0x32, 0xaa, 0xd8, 0x52, 0xd0, 0xbc, 0xca, 0x22, 0x41, 0xfb, 0x1f, 0x8d, 0x76,
-	0xf1, 0x1b, 0x3d, 0xf8, 0xd6, 0x22, 0xdb, 0x82, 0x8b, 0xa8, 0x10, 0x2c, 0x12, 0xac, 0x2c, 0xb0,
-	0x87, 0x4c, 0x60, 0xfc, 0xa0, 0xb1, 0xf0, 0x06, 0xcf, 0x06, 0xc7, 0x53, 0xd2, 0x7d, 0x62, 0x17,
-	0x8d, 0x6a, 0xba, 0xf3, 0x86, 0x1a, 0x55, 0x3f, 0xf1, 0x6b, 0x34, 0xe5, 0x15, 0x8d, 0xd9, 0x8e,
-	0xd1, 0xda, 0x1b, 0x03, 0x6e, 0xaf, 0xee, 0x05, 0x7d, 0xa3, 0xf3, 0xee, 0x88, 0x1c, 0x58, 0x9f,
-	0xc7, 0xd6, 0xc8, 0x1d, 0x2f, 0xfe, 0x8c, 0x11, 0xde, 0xe8, 0xc5, 0x3f, 0xaa, 0xa5, 0xd6, 0xb0,
-	0x13, 0xa1, 0x97, 0xf8, 0x39, 0x32, 0x2e, 0x25, 0xe5, 0xaa, 0xf3, 0x08, 0xbc, 0xe6, 0x41, 0x92,
-	0x07, 0x5f, 0x15, 0x10, 0xac, 0x29, 0x8f, 0x49, 0x73, 0x88, 0x5f, 0x22, 0x2b, 0x12, 0xaa, 0x8b,
-	0xe0, 0x7a, 0x18, 0x7b, 0x75, 0xa4, 0x88, 0xa7, 0x0d, 0x76, 0xc6, 0xb8, 0x20, 0x3d, 0x01, 0x7f,
-	0x40, 0xf3, 0x6e, 0xa0, 0xb0, 0xf1, 0x1e, 0x69, 0xef, 0x47, 0x4a, 0xf2, 0xdf, 0x1b, 0x20, 0x4e,
-	0x27, 0xd0, 0xad, 0xf1, 0x7b, 0xe4, 0xf6, 0x0e, 0xad, 0x2d, 0xec, 0xaa, 0x3c, 0xee, 0xdf, 0x68,
-	0x1b, 0x7c, 0x91, 0x39, 0x27, 0x47, 0x1d, 0xbb, 0x3d, 0xc1, 0x27, 0xc8, 0xd9, 0x95, 0x75, 0x48,
-	0xaf, 0x68, 0x2c, 0x55, 0x03, 0xcf, 0xd0, 0x43, 0x63, 0xa5, 0xde, 0x74, 0x60, 0x70, 0x2a, 0x45,
-	0x4a, 0x66, 0x40, 0xec, 0x21, 0xbc, 0x42, 0x46, 0xc6, 0x72, 0x26, 0xbc, 0x89, 0x16, 0x3c, 0xd6,
-	0x82, 0x5b, 0xb7, 0x16, 0x9c, 0x29, 0x0e, 0x69, 0xa8, 0xf8, 0x2d, 0x32, 0x59, 0x11, 0x67, 0x32,
-	0xa1, 0x9e, 0xa9, 0x55, 0x4f, 0xee, 0x50, 0x6d, 0x1b, 0x16, 0xe9, 0xe8, 0xfe, 0x09, 0x32, 0xb4,
-	0x13, 0x5e, 0x20, 0x27, 0x8f, 0xae, 0x74, 0xd0, 0x42, 0xce, 0x7e, 0x51, 0xb8, 0xb1, 0xc1, 0xb1,
-	0x43, 0x6c, 0x00, 0x95, 0xf8, 0x1c, 0x20, 0x78, 0xc6, 0x81, 0x3b, 0x84, 0x3a, 0x74, 0x47, 0xfe,
-	0x77, 0x64, 0xb6, 0x66, 0xf8, 0x05, 0x9a, 0xb7, 0x57, 0x14, 0xd6, 0x94, 0xcb, 0x4c, 0xe8, 0x54,
-	0x58, 0xc4, 0x69, 0x51, 0xa2, 0xc1, 0xeb, 0xea, 0x26, 0x10, 0x50, 0x0d, 0x77, 0x02, 0x75, 0xe2,
-	0x9a, 0x50, 0x4d, 0xd7, 0x82, 0x6a, 0xb9, 0xd3, 0xc5, 0xdf, 0xc1, 0xed, 0xa0, 0xf0, 0x0a, 0xfb,
-	0x10, 0x81, 0x38, 0xa6, 0x95, 0xa0, 0x89, 0x4e, 0xa9, 0x45, 0xfa, 0x6f, 0xbc, 0x44, 0xb3, 0xfe,
-	0xbd, 0x58, 0xa2, 0x22, 0xa2, 0xde, 0x6a, 0x76, 0xc8, 0xd2, 0x76, 0x4d, 0xec, 0x8e, 0xb1, 0x4d,
-	0xb8, 0x1a, 0xba, 0x17, 0xd0, 0xba, 0x2e, 0x6b, 0x1d, 0x91, 0xe9, 0x21, 0x07, 0x1b, 0x05, 0x02,
-	0x6d, 0x72, 0x6d, 0x27, 0x7b, 0xe5, 0x28, 0xc7, 0xc3, 0x54, 0xed, 0x21, 0x7e, 0x8a, 0x6c, 0x9e,
-	0x96, 0x32, 0x4b, 0xc2, 0x34, 0x02, 0xae, 0xa1, 0xa7, 0x43, 0x0d, 0xf4, 0x09, 0x90, 0x8b, 0x89,
-	0xfe, 0x03, 0xbe, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0x68, 0x1b, 0xe7, 0xe4, 0xfa, 0x03, 0x00,
-	0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x53, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x18, 0x55, 0x9a, 0xb4, 0x71, 0x9d, 0x65, 0x8b, 0xfc, 0xfb, 0x21, 0x42, 0x84, 0x60, 0xaa, 0x98,
+	0x54, 0x09, 0x91, 0x8a, 0x72, 0x31, 0xc4, 0x0d, 0x4c, 0xda, 0x04, 0x8d, 0x26, 0x24, 0x3c, 0xae,
+	0xb8, 0x89, 0xbc, 0xc4, 0x6d, 0x8d, 0x92, 0x38, 0xb3, 0x1d, 0xa9, 0x4c, 0x3c, 0x0f, 0xcf, 0xc3,
+	0x23, 0x21, 0x3b, 0x7f, 0x3a, 0x6d, 0x70, 0x13, 0xd9, 0xe7, 0x3b, 0xe7, 0x7c, 0x9f, 0xed, 0x13,
+	0xf8, 0x98, 0x56, 0xb2, 0x11, 0x34, 0xdd, 0x08, 0x52, 0x6f, 0xd3, 0x9c, 0x28, 0x12, 0xd7, 0x82,
+	0x2b, 0x8e, 0x46, 0x79, 0x19, 0x79, 0xea, 0x47, 0x4d, 0x65, 0x0b, 0x44, 0xc1, 0x7d, 0x4a, 0xf4,
+	0x6e, 0xc3, 0xd4, 0xb6, 0xb9, 0x8e, 0x33, 0x5e, 0x2e, 0x8a, 0x26, 0x63, 0xe6, 0xf3, 0x6a, 0xc3,
+	0x17, 0x19, 0x2f, 0x4b, 0x5e, 0x2d, 0x48, 0xcd, 0x16, 0x8a, 0x96, 0x75, 0x41, 0x14, 0x1d, 0x16,
+	0xad, 0x76, 0xf6, 0x13, 0x3e, 0xfa, 0xda, 0x21, 0xab, 0x4a, 0x2a, 0x52, 0x29, 0x46, 0x14, 0xe3,
+	0x15, 0x0a, 0xa1, 0x5b, 0x0b, 0xfe, 0x9d, 0x66, 0x2a, 0xb4, 0x8e, 0xad, 0xf9, 0x14, 0xf7, 0x5b,
+	0x14, 0x40, 0x5b, 0xd0, 0x75, 0x38, 0x32, 0xa8, 0x5e, 0xa2, 0xd7, 0x70, 0x2a, 0x6b, 0x9a, 0xb1,
+	0x35, 0xa3, 0x22, 0x74, 0x8e, 0xad, 0xb9, 0xb7, 0xfc, 0x2f, 0x1e, 0x1a, 0x5d, 0xf5, 0x25, 0xbc,
+	0x67, 0x25, 0x0e, 0xb0, 0x03, 0x67, 0xf6, 0xcb, 0x81, 0xe8, 0xc2, 0x1c, 0xfc, 0xa3, 0x3e, 0xd4,
+	0x39, 0x51, 0x04, 0xd3, 0x1b, 0xf4, 0x02, 0x8e, 0x6f, 0x1a, 0x2a, 0x75, 0x67, 0x7b, 0xee, 0x2d,
+	0x0f, 0xe3, 0xbc, 0x8c, 0xbf, 0x68, 0x20, 0x3e, 0xa7, 0x32, 0xc3, 0x6d, 0x11, 0xbd, 0x84, 0x80,
+	0x28, 0xdd, 0x45, 0x49, 0x33, 0x8c, 0xb7, 0x3c, 0xd2, 0xc4, 0xb3, 0x16, 0xbb, 0x64, 0x52, 0xe1,
+	0x81, 0x80, 0x3e, 0xc0, 0xc3, 0x7e, 0xa0, 0xb4, 0xf5, 0xb6, 0x8d, 0xf7, 0x13, 0x2d, 0xf9, 0xeb,
+	0x0d, 0x60, 0xbf, 0x17, 0x98, 0xd6, 0xe8, 0x3d, 0x0c, 0x06, 0x87, 0xce, 0x36, 0x74, 0x8c, 0xc7,
+	0xff, 0xf7, 0xda, 0xc6, 0x9f, 0x9b, 0x52, 0xe2, 0xa3, 0x9e, 0xdd, 0x55, 0xd0, 0x29, 0xf4, 0xd7,
+	0x5c, 0xa4, 0x74, 0x47, 0xb3, 0x46, 0x37, 0x08, 0xc7, 0x66, 0x68, 0xa4, 0xd5, 0x17, 0x3d, 0x18,
+	0x9f, 0x35, 0x6a, 0x8b, 0x0f, 0xd6, 0x5c, 0x0c, 0x10, 0x5a, 0xc2, 0x71, 0xc1, 0x4a, 0xa6, 0xc2,
+	0x89, 0x11, 0x3c, 0x35, 0x82, 0x07, 0xb7, 0x16, 0x5f, 0x6a, 0x0e, 0x6e, 0xa9, 0xe8, 0x2d, 0x74,
+	0x59, 0x95, 0x15, 0x4d, 0x4e, 0x43, 0xd7, 0xa8, 0x9e, 0xfd, 0x43, 0xb5, 0x6a, 0x59, 0xb8, 0xa7,
+	0x47, 0xa7, 0x70, 0x6c, 0x9c, 0xd0, 0x0c, 0xfa, 0x25, 0xd9, 0x99, 0xa0, 0xa5, 0x92, 0xdd, 0xd2,
+	0xd0, 0x3e, 0xb6, 0xe6, 0x3e, 0xf6, 0x4a, 0xb2, 0xd3, 0xe2, 0x2b, 0x76, 0x4b, 0x13, 0x07, 0x58,
+	0xc1, 0x28, 0x71, 0xc0, 0x28, 0xb0, 0xa3, 0x6f, 0xd0, 0xed, 0xcc, 0xd0, 0x09, 0x3c, 0xec, 0xae,
+	0x28, 0x15, 0x54, 0x36, 0x85, 0x32, 0xa9, 0x00, 0xd8, 0xef, 0x50, 0x6c, 0xc0, 0xbb, 0xea, 0x36,
+	0x10, 0x89, 0x03, 0xc6, 0xc1, 0x24, 0x71, 0xc0, 0x24, 0x70, 0x13, 0x07, 0xb8, 0x01, 0x48, 0x1c,
+	0x00, 0x82, 0xe9, 0xec, 0xb7, 0xf5, 0x30, 0x28, 0xb2, 0x46, 0x11, 0x04, 0x24, 0xcb, 0x68, 0xad,
+	0x68, 0x6e, 0x52, 0x0a, 0xf0, 0xb0, 0x47, 0x0b, 0x78, 0x30, 0xbc, 0x17, 0xcb, 0x75, 0x44, 0xf4,
+	0x5b, 0x1d, 0xec, 0xb3, 0xb4, 0x3a, 0xc7, 0x5e, 0xcf, 0x58, 0xe5, 0x52, 0x0f, 0x3d, 0x08, 0xa8,
+	0x10, 0x5c, 0x98, 0x88, 0x4c, 0xf7, 0x39, 0xb8, 0xd0, 0x20, 0x3a, 0x81, 0x93, 0x3b, 0x67, 0xf2,
+	0x96, 0xbe, 0x76, 0xdc, 0x4f, 0xd5, 0x15, 0xd1, 0x73, 0xe8, 0xc9, 0x2d, 0x6f, 0x8a, 0x3c, 0xdd,
+	0x92, 0x42, 0x99, 0xb7, 0x06, 0x18, 0xb6, 0xd0, 0x27, 0x52, 0xa8, 0xeb, 0x89, 0xf9, 0x01, 0xdf,
+	0xfc, 0x19, 0x00, 0x68, 0x1b, 0xe7, 0xe4, 0xfa, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go.
+// source: execution_lease.proto
+// DO NOT EDIT!
+
+package dm
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf2 "github.com/luci/luci-go/common/proto/google"
+import google_protobuf "github.com/luci/luci-go/common/proto/google"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// ClaimExecutionReq is the request for the ClaimExecution rpc.
+type ClaimExecutionReq struct {
+	// LeaseDuration is how long the executor would like to hold the lease on the
+	// claimed Execution before it needs to be renewed with RenewExecutionLease.
+	//
+	// If this is unset, a default of 5 minutes will be used. If it exceeds 1
+	// hour, it will be reduced to 1 hour.
+	LeaseDuration *google_protobuf2.Duration `protobuf:"bytes,1,opt,name=lease_duration,json=leaseDuration" json:"lease_duration,omitempty"`
+}
+
+func (m *ClaimExecutionReq) Reset()                    { *m = ClaimExecutionReq{} }
+func (m *ClaimExecutionReq) String() string            { return proto.CompactTextString(m) }
+func (*ClaimExecutionReq) ProtoMessage()               {}
+func (*ClaimExecutionReq) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+
+func (m *ClaimExecutionReq) GetLeaseDuration() *google_protobuf2.Duration {
+	if m != nil {
+		return m.LeaseDuration
+	}
+	return nil
+}
+
+type ClaimExecutionRsp struct {
+	Quest *Quest `protobuf:"bytes,1,opt,name=quest" json:"quest,omitempty"`
+	// Auth is the auth with an Activation Token to be used with the
+	// ActivateExecution rpc.
+	Auth *Execution_Auth `protobuf:"bytes,2,opt,name=auth" json:"auth,omitempty"`
+	// LeaseExpiration is the time at which the lease on this Execution expires.
+	// If the lease isn't renewed by then, the Execution will be considered lost,
+	// and the Attempt will be made available for a new Execution.
+	LeaseExpiration *google_protobuf.Timestamp `protobuf:"bytes,3,opt,name=lease_expiration,json=leaseExpiration" json:"lease_expiration,omitempty"`
+}
+
+func (m *ClaimExecutionRsp) Reset()                    { *m = ClaimExecutionRsp{} }
+func (m *ClaimExecutionRsp) String() string            { return proto.CompactTextString(m) }
+func (*ClaimExecutionRsp) ProtoMessage()               {}
+func (*ClaimExecutionRsp) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
+
+func (m *ClaimExecutionRsp) GetQuest() *Quest {
+	if m != nil {
+		return m.Quest
+	}
+	return nil
+}
+
+func (m *ClaimExecutionRsp) GetAuth() *Execution_Auth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func (m *ClaimExecutionRsp) GetLeaseExpiration() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.LeaseExpiration
+	}
+	return nil
+}
+
+// RenewExecutionLeaseReq is the request for the RenewExecutionLease rpc.
+type RenewExecutionLeaseReq struct {
+	// Auth is either the Auth returned from ClaimExecution (if the Execution
+	// hasn't been activated yet), or the activated Execution Auth.
+	Auth *Execution_Auth `protobuf:"bytes,1,opt,name=auth" json:"auth,omitempty"`
+	// LeaseDuration is the new duration of the lease, starting from now. It has
+	// the same defaults and limits as ClaimExecutionReq.lease_duration.
+	LeaseDuration *google_protobuf2.Duration `protobuf:"bytes,2,opt,name=lease_duration,json=leaseDuration" json:"lease_duration,omitempty"`
+}
+
+func (m *RenewExecutionLeaseReq) Reset()                    { *m = RenewExecutionLeaseReq{} }
+func (m *RenewExecutionLeaseReq) String() string            { return proto.CompactTextString(m) }
+func (*RenewExecutionLeaseReq) ProtoMessage()               {}
+func (*RenewExecutionLeaseReq) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
+
+func (m *RenewExecutionLeaseReq) GetAuth() *Execution_Auth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func (m *RenewExecutionLeaseReq) GetLeaseDuration() *google_protobuf2.Duration {
+	if m != nil {
+		return m.LeaseDuration
+	}
+	return nil
+}
+
+type RenewExecutionLeaseRsp struct {
+	LeaseExpiration *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=lease_expiration,json=leaseExpiration" json:"lease_expiration,omitempty"`
+}
+
+func (m *RenewExecutionLeaseRsp) Reset()                    { *m = RenewExecutionLeaseRsp{} }
+func (m *RenewExecutionLeaseRsp) String() string            { return proto.CompactTextString(m) }
+func (*RenewExecutionLeaseRsp) ProtoMessage()               {}
+func (*RenewExecutionLeaseRsp) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
+
+func (m *RenewExecutionLeaseRsp) GetLeaseExpiration() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.LeaseExpiration
+	}
+	return nil
+}
+
+// ReleaseExecutionLeaseReq is the request for the ReleaseExecutionLease rpc.
+type ReleaseExecutionLeaseReq struct {
+	// Auth is either the Auth returned from ClaimExecution (if the Execution
+	// hasn't been activated yet), or the activated Execution Auth.
+	Auth *Execution_Auth `protobuf:"bytes,1,opt,name=auth" json:"auth,omitempty"`
+}
+
+func (m *ReleaseExecutionLeaseReq) Reset()                    { *m = ReleaseExecutionLeaseReq{} }
+func (m *ReleaseExecutionLeaseReq) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseExecutionLeaseReq) ProtoMessage()               {}
+func (*ReleaseExecutionLeaseReq) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
+
+func (m *ReleaseExecutionLeaseReq) GetAuth() *Execution_Auth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ClaimExecutionReq)(nil), "dm.ClaimExecutionReq")
+	proto.RegisterType((*ClaimExecutionRsp)(nil), "dm.ClaimExecutionRsp")
+	proto.RegisterType((*RenewExecutionLeaseReq)(nil), "dm.RenewExecutionLeaseReq")
+	proto.RegisterType((*RenewExecutionLeaseRsp)(nil), "dm.RenewExecutionLeaseRsp")
+	proto.RegisterType((*ReleaseExecutionLeaseReq)(nil), "dm.ReleaseExecutionLeaseReq")
+}
+
+var fileDescriptor3 = []byte{
+	// 278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x50, 0xcb, 0x4a, 0xc4, 0x30,
+	0x14, 0x25, 0xf5, 0x01, 0x46, 0xd4, 0x31, 0xa0, 0xd4, 0x2e, 0x1c, 0xe9, 0x42, 0x5c, 0x65, 0x40,
+	0x7f, 0xc0, 0x57, 0x77, 0x6e, 0x0c, 0xba, 0x2e, 0x19, 0x7b, 0x6d, 0x0b, 0xed, 0x34, 0xd3, 0x24,
+	0x38, 0x6b, 0x7f, 0xc5, 0x1f, 0x95, 0xbc, 0xba, 0xb0, 0x03, 0x32, 0xb3, 0xcc, 0xb9, 0xe7, 0x95,
+	0x83, 0xcf, 0x60, 0x05, 0x1f, 0x5a, 0xd5, 0xdd, 0x22, 0x6f, 0x80, 0x4b, 0xa0, 0xa2, 0xef, 0x54,
+	0x47, 0xa2, 0xa2, 0x4d, 0x2e, 0xcb, 0xae, 0x2b, 0x1b, 0x98, 0x59, 0x64, 0xae, 0x3f, 0x67, 0x85,
+	0xee, 0xb9, 0x61, 0x3a, 0x4e, 0x32, 0xfd, 0x7b, 0x57, 0x75, 0x0b, 0x52, 0xf1, 0x56, 0x78, 0xc2,
+	0xa4, 0xec, 0xb9, 0xa8, 0xf2, 0x82, 0x2b, 0xee, 0x90, 0xf4, 0x1d, 0x9f, 0x3e, 0x35, 0xbc, 0x6e,
+	0xb3, 0x10, 0xca, 0x60, 0x49, 0xee, 0xf1, 0xb1, 0x8d, 0xce, 0x83, 0x7f, 0x8c, 0xae, 0xd0, 0xcd,
+	0xe1, 0xed, 0x05, 0x75, 0x01, 0x34, 0x04, 0xd0, 0x67, 0x4f, 0x60, 0x47, 0x56, 0x10, 0x9e, 0xe9,
+	0x0f, 0x1a, 0xf9, 0x4a, 0x41, 0xa6, 0x78, 0x6f, 0xa9, 0x41, 0x2a, 0x6f, 0x77, 0x40, 0x8b, 0x96,
+	0xbe, 0x1a, 0x80, 0x39, 0x9c, 0x5c, 0xe3, 0x5d, 0xae, 0x55, 0x15, 0x47, 0xf6, 0x4e, 0xcc, 0x7d,
+	0x30, 0xa0, 0x0f, 0x5a, 0x55, 0xcc, 0xde, 0x49, 0x86, 0x27, 0xae, 0x20, 0xac, 0x44, 0xed, 0x2b,
+	0xee, 0x58, 0x4d, 0x32, 0xaa, 0xf8, 0x16, 0x36, 0x60, 0x27, 0x56, 0x93, 0x0d, 0x92, 0xf4, 0x1b,
+	0xe1, 0x73, 0x06, 0x0b, 0xf8, 0x1a, 0x42, 0x5e, 0x0c, 0xc3, 0x4c, 0x10, 0x9a, 0xa0, 0x7f, 0x9a,
+	0x8c, 0xa7, 0x8a, 0x36, 0x9c, 0x2a, 0x5f, 0xdf, 0x41, 0x8a, 0xb5, 0xbf, 0x44, 0x9b, 0xff, 0xf2,
+	0x11, 0xc7, 0x0c, 0x3c, 0xb8, 0xe5, 0x37, 0xe7, 0xfb, 0x36, 0xe8, 0xee, 0x77, 0x00, 0xc3, 0x37,
+	0x4d, 0xc1, 0x9d, 0x02, 0x00, 0x00,
+}
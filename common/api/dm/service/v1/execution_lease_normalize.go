@@ -0,0 +1,62 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"time"
+
+	"github.com/luci/luci-go/common/errors"
+	google_pb "github.com/luci/luci-go/common/proto/google"
+)
+
+const (
+	// DefaultLeaseDuration is the default execution lease duration.
+	DefaultLeaseDuration = 5 * time.Minute
+
+	// MaxLeaseDuration is the maximum execution lease duration.
+	MaxLeaseDuration = time.Hour
+)
+
+// normalizeLeaseDuration fills in the default lease duration, and clamps it to
+// MaxLeaseDuration.
+func normalizeLeaseDuration(d **google_pb.Duration) error {
+	switch dur := (*d).Duration(); {
+	case dur < 0:
+		return errors.New("lease_duration must be positive")
+	case dur == 0:
+		*d = google_pb.NewDuration(DefaultLeaseDuration)
+	case dur > MaxLeaseDuration:
+		*d = google_pb.NewDuration(MaxLeaseDuration)
+	}
+	return nil
+}
+
+func normalizeLeaseAuth(a *Execution_Auth) error {
+	if a == nil || a.Id == nil {
+		return errors.New("auth is required")
+	}
+	if len(a.Token) == 0 {
+		return errors.New("auth.token is required")
+	}
+	return nil
+}
+
+// Normalize returns an error iff the ClaimExecutionReq is invalid.
+func (r *ClaimExecutionReq) Normalize() error {
+	return normalizeLeaseDuration(&r.LeaseDuration)
+}
+
+// Normalize returns an error iff the RenewExecutionLeaseReq is invalid.
+func (r *RenewExecutionLeaseReq) Normalize() error {
+	if err := normalizeLeaseAuth(r.Auth); err != nil {
+		return err
+	}
+	return normalizeLeaseDuration(&r.LeaseDuration)
+}
+
+// Normalize returns an error iff the ReleaseExecutionLeaseReq is invalid.
+func (r *ReleaseExecutionLeaseReq) Normalize() error {
+	return normalizeLeaseAuth(r.Auth)
+}
@@ -12,8 +12,9 @@ import (
 // transitions. The identity transition (X -> X) is implied, as long as X has an
 // entry in this mapping.
 var validExecutionStateEvolution = map[Execution_State][]Execution_State{
-	Execution_SCHEDULED: {Execution_RUNNING, Execution_FINISHED, Execution_MISSING, Execution_CANCELLED, Execution_TIMED_OUT},
-	Execution_RUNNING:   {Execution_FINISHED, Execution_FAILED, Execution_MISSING, Execution_CANCELLED},
+	Execution_SCHEDULING: {Execution_SCHEDULED, Execution_REJECTED, Execution_CANCELLED},
+	Execution_SCHEDULED:  {Execution_RUNNING, Execution_FINISHED, Execution_MISSING, Execution_CANCELLED, Execution_TIMED_OUT},
+	Execution_RUNNING:    {Execution_FINISHED, Execution_FAILED, Execution_MISSING, Execution_CANCELLED},
 
 	Execution_CANCELLED: {},
 	Execution_FINISHED:  {},
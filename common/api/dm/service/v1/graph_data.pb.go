@@ -15,6 +15,37 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// ExpiredResultPolicy controls what DM does when an Attempt depends on an
+// Attempt of this Quest whose result has expired.
+type Quest_Desc_ExpiredResultPolicy int32
+
+const (
+	// REEXECUTE re-executes the expired Attempt, and blocks the dependant
+	// until the Attempt has a fresh result.
+	Quest_Desc_REEXECUTE Quest_Desc_ExpiredResultPolicy = 0
+	// NEW_ATTEMPT leaves the expired Attempt alone, and has the dependant
+	// depend on the next Attempt of this Quest instead, creating it if
+	// needed. The dependant is blocked until that Attempt has a fresh result,
+	// and EnsureGraphData returns that Attempt in place of the expired one.
+	Quest_Desc_NEW_ATTEMPT Quest_Desc_ExpiredResultPolicy = 1
+)
+
+var Quest_Desc_ExpiredResultPolicy_name = map[int32]string{
+	0: "REEXECUTE",
+	1: "NEW_ATTEMPT",
+}
+var Quest_Desc_ExpiredResultPolicy_value = map[string]int32{
+	"REEXECUTE":   0,
+	"NEW_ATTEMPT": 1,
+}
+
+func (x Quest_Desc_ExpiredResultPolicy) String() string {
+	return proto.EnumName(Quest_Desc_ExpiredResultPolicy_name, int32(x))
+}
+func (Quest_Desc_ExpiredResultPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{0, 1, 0}
+}
+
 type Attempt_State int32
 
 const (
@@ -24,6 +55,9 @@ const (
 	Attempt_BLOCKED                  Attempt_State = 3
 	Attempt_AWAITING_EXECUTION_STATE Attempt_State = 4
 	Attempt_FINISHED                 Attempt_State = 5
+	// ABNORMAL_FINISHED is set when the Attempt's Executions kept failing to
+	// finish it, and DM gave up on retrying them.
+	Attempt_ABNORMAL_FINISHED Attempt_State = 6
 )
 
 var Attempt_State_name = map[int32]string{
@@ -33,6 +67,7 @@ var Attempt_State_name = map[int32]string{
 	3: "BLOCKED",
 	4: "AWAITING_EXECUTION_STATE",
 	5: "FINISHED",
+	6: "ABNORMAL_FINISHED",
 }
 var Attempt_State_value = map[string]int32{
 	"NEEDS_EXECUTION":          0,
@@ -41,12 +76,13 @@ var Attempt_State_value = map[string]int32{
 	"BLOCKED":                  3,
 	"AWAITING_EXECUTION_STATE": 4,
 	"FINISHED":                 5,
+	"ABNORMAL_FINISHED":        6,
 }
 
 func (x Attempt_State) String() string {
 	return proto.EnumName(Attempt_State_name, int32(x))
 }
-func (Attempt_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 0} }
+func (Attempt_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 0} }
 
 type Attempt_Partial_Result int32
 
@@ -61,6 +97,10 @@ const (
 	Attempt_Partial_NOT_AUTHORIZED Attempt_Partial_Result = 2
 	// DATA_SIZE_LIMIT is set if the max_data_size limit was reached.
 	Attempt_Partial_DATA_SIZE_LIMIT Attempt_Partial_Result = 3
+	// EXPIRED is set if the Attempt's result has expired. Expired results
+	// are garbage-collected, and depending on the Attempt again will
+	// re-execute it.
+	Attempt_Partial_EXPIRED Attempt_Partial_Result = 4
 )
 
 var Attempt_Partial_Result_name = map[int32]string{
@@ -68,18 +108,22 @@ var Attempt_Partial_Result_name = map[int32]string{
 	1: "NOT_LOADED",
 	2: "NOT_AUTHORIZED",
 	3: "DATA_SIZE_LIMIT",
+	4: "EXPIRED",
 }
 var Attempt_Partial_Result_value = map[string]int32{
 	"LOADED":          0,
 	"NOT_LOADED":      1,
 	"NOT_AUTHORIZED":  2,
 	"DATA_SIZE_LIMIT": 3,
+	"EXPIRED":         4,
 }
 
 func (x Attempt_Partial_Result) String() string {
 	return proto.EnumName(Attempt_Partial_Result_name, int32(x))
 }
-func (Attempt_Partial_Result) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 3, 0} }
+func (Attempt_Partial_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{1, 3, 0}
+}
 
 type Execution_State int32
 
@@ -100,6 +144,8 @@ const (
 	Execution_MISSING Execution_State = 6
 	// Some entity (DM, Human, Distributor) requested that this execution not run.
 	Execution_CANCELLED Execution_State = 7
+	// DM recorded the execution, but hasn't handed it to the distributor yet.
+	Execution_SCHEDULING Execution_State = 8
 )
 
 var Execution_State_name = map[int32]string{
@@ -111,28 +157,30 @@ var Execution_State_name = map[int32]string{
 	5: "FAILED",
 	6: "MISSING",
 	7: "CANCELLED",
+	8: "SCHEDULING",
 }
 var Execution_State_value = map[string]int32{
-	"SCHEDULED": 0,
-	"RUNNING":   1,
-	"REJECTED":  2,
-	"TIMED_OUT": 3,
-	"FINISHED":  4,
-	"FAILED":    5,
-	"MISSING":   6,
-	"CANCELLED": 7,
+	"SCHEDULED":  0,
+	"RUNNING":    1,
+	"REJECTED":   2,
+	"TIMED_OUT":  3,
+	"FINISHED":   4,
+	"FAILED":     5,
+	"MISSING":    6,
+	"CANCELLED":  7,
+	"SCHEDULING": 8,
 }
 
 func (x Execution_State) String() string {
 	return proto.EnumName(Execution_State_name, int32(x))
 }
-func (Execution_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{2, 0} }
+func (Execution_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor5, []int{2, 0} }
 
 type Quest struct {
 	Id *Quest_ID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	// DNE is set to true if this Quest does not exist. None of the following
 	// fields are valid if this is set to true.
-	DNE  bool        `protobuf:"varint,2,opt,name=DNE,json=dNE" json:"DNE,omitempty"`
+	DNE  bool        `protobuf:"varint,2,opt,name=DNE" json:"DNE,omitempty"`
 	Data *Quest_Data `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
 	// key is the `id` field of the Attempt.ID
 	Attempts map[uint32]*Attempt `protobuf:"bytes,4,rep,name=attempts" json:"attempts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -144,7 +192,7 @@ type Quest struct {
 func (m *Quest) Reset()                    { *m = Quest{} }
 func (m *Quest) String() string            { return proto.CompactTextString(m) }
 func (*Quest) ProtoMessage()               {}
-func (*Quest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0} }
+func (*Quest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
 
 func (m *Quest) GetId() *Quest_ID {
 	if m != nil {
@@ -174,17 +222,18 @@ type Quest_ID struct {
 func (m *Quest_ID) Reset()                    { *m = Quest_ID{} }
 func (m *Quest_ID) String() string            { return proto.CompactTextString(m) }
 func (*Quest_ID) ProtoMessage()               {}
-func (*Quest_ID) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 0} }
+func (*Quest_ID) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 0} }
 
 type Quest_Desc struct {
-	DistributorConfigName string `protobuf:"bytes,1,opt,name=distributor_config_name,json=distributorConfigName" json:"distributor_config_name,omitempty"`
-	JsonPayload           string `protobuf:"bytes,2,opt,name=json_payload,json=jsonPayload" json:"json_payload,omitempty"`
+	DistributorConfigName string                         `protobuf:"bytes,1,opt,name=distributor_config_name,json=distributorConfigName" json:"distributor_config_name,omitempty"`
+	JsonPayload           string                         `protobuf:"bytes,2,opt,name=json_payload,json=jsonPayload" json:"json_payload,omitempty"`
+	ExpiredResultPolicy   Quest_Desc_ExpiredResultPolicy `protobuf:"varint,3,opt,name=expired_result_policy,json=expiredResultPolicy,enum=dm.Quest_Desc_ExpiredResultPolicy" json:"expired_result_policy,omitempty"`
 }
 
 func (m *Quest_Desc) Reset()                    { *m = Quest_Desc{} }
 func (m *Quest_Desc) String() string            { return proto.CompactTextString(m) }
 func (*Quest_Desc) ProtoMessage()               {}
-func (*Quest_Desc) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 1} }
+func (*Quest_Desc) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 1} }
 
 type Quest_TemplateSpec struct {
 	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
@@ -196,7 +245,7 @@ type Quest_TemplateSpec struct {
 func (m *Quest_TemplateSpec) Reset()                    { *m = Quest_TemplateSpec{} }
 func (m *Quest_TemplateSpec) String() string            { return proto.CompactTextString(m) }
 func (*Quest_TemplateSpec) ProtoMessage()               {}
-func (*Quest_TemplateSpec) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 2} }
+func (*Quest_TemplateSpec) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 2} }
 
 type Quest_Data struct {
 	Created *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=created" json:"created,omitempty"`
@@ -207,7 +256,7 @@ type Quest_Data struct {
 func (m *Quest_Data) Reset()                    { *m = Quest_Data{} }
 func (m *Quest_Data) String() string            { return proto.CompactTextString(m) }
 func (*Quest_Data) ProtoMessage()               {}
-func (*Quest_Data) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 3} }
+func (*Quest_Data) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 3} }
 
 func (m *Quest_Data) GetCreated() *google_protobuf.Timestamp {
 	if m != nil {
@@ -234,7 +283,7 @@ type Attempt struct {
 	Id *Attempt_ID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	// DNE is set to true if this Attempt does not exist. None of the following
 	// fields are valid if this is set to true.
-	DNE  bool          `protobuf:"varint,2,opt,name=DNE,json=dNE" json:"DNE,omitempty"`
+	DNE  bool          `protobuf:"varint,2,opt,name=DNE" json:"DNE,omitempty"`
 	Data *Attempt_Data `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
 	// key is the `id` field of the Execution.ID
 	Executions map[uint32]*Execution `protobuf:"bytes,4,rep,name=executions" json:"executions,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -249,7 +298,7 @@ type Attempt struct {
 func (m *Attempt) Reset()                    { *m = Attempt{} }
 func (m *Attempt) String() string            { return proto.CompactTextString(m) }
 func (*Attempt) ProtoMessage()               {}
-func (*Attempt) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1} }
+func (*Attempt) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1} }
 
 func (m *Attempt) GetId() *Attempt_ID {
 	if m != nil {
@@ -301,7 +350,7 @@ type Attempt_ID struct {
 func (m *Attempt_ID) Reset()                    { *m = Attempt_ID{} }
 func (m *Attempt_ID) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_ID) ProtoMessage()               {}
-func (*Attempt_ID) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 0} }
+func (*Attempt_ID) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 0} }
 
 type Attempt_Data struct {
 	Created       *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=created" json:"created,omitempty"`
@@ -313,13 +362,14 @@ type Attempt_Data struct {
 	//	*Attempt_Data_AddingDeps_
 	//	*Attempt_Data_Blocked_
 	//	*Attempt_Data_Finished_
+	//	*Attempt_Data_AbnormalFinish_
 	AttemptType isAttempt_Data_AttemptType `protobuf_oneof:"attempt_type"`
 }
 
 func (m *Attempt_Data) Reset()                    { *m = Attempt_Data{} }
 func (m *Attempt_Data) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data) ProtoMessage()               {}
-func (*Attempt_Data) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1} }
+func (*Attempt_Data) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 1} }
 
 type isAttempt_Data_AttemptType interface {
 	isAttempt_Data_AttemptType()
@@ -340,12 +390,16 @@ type Attempt_Data_Blocked_ struct {
 type Attempt_Data_Finished_ struct {
 	Finished *Attempt_Data_Finished `protobuf:"bytes,8,opt,name=finished,oneof"`
 }
+type Attempt_Data_AbnormalFinish_ struct {
+	AbnormalFinish *Attempt_Data_AbnormalFinish `protobuf:"bytes,9,opt,name=abnormal_finish,json=abnormalFinish,oneof"`
+}
 
 func (*Attempt_Data_NeedsExecution_) isAttempt_Data_AttemptType() {}
 func (*Attempt_Data_Executing_) isAttempt_Data_AttemptType()      {}
 func (*Attempt_Data_AddingDeps_) isAttempt_Data_AttemptType()     {}
 func (*Attempt_Data_Blocked_) isAttempt_Data_AttemptType()        {}
 func (*Attempt_Data_Finished_) isAttempt_Data_AttemptType()       {}
+func (*Attempt_Data_AbnormalFinish_) isAttempt_Data_AttemptType() {}
 
 func (m *Attempt_Data) GetAttemptType() isAttempt_Data_AttemptType {
 	if m != nil {
@@ -403,6 +457,13 @@ func (m *Attempt_Data) GetFinished() *Attempt_Data_Finished {
 	return nil
 }
 
+func (m *Attempt_Data) GetAbnormalFinish() *Attempt_Data_AbnormalFinish {
+	if x, ok := m.GetAttemptType().(*Attempt_Data_AbnormalFinish_); ok {
+		return x.AbnormalFinish
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Attempt_Data) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Attempt_Data_OneofMarshaler, _Attempt_Data_OneofUnmarshaler, _Attempt_Data_OneofSizer, []interface{}{
@@ -411,6 +472,7 @@ func (*Attempt_Data) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer)
 		(*Attempt_Data_AddingDeps_)(nil),
 		(*Attempt_Data_Blocked_)(nil),
 		(*Attempt_Data_Finished_)(nil),
+		(*Attempt_Data_AbnormalFinish_)(nil),
 	}
 }
 
@@ -443,6 +505,11 @@ func _Attempt_Data_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Finished); err != nil {
 			return err
 		}
+	case *Attempt_Data_AbnormalFinish_:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AbnormalFinish); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Attempt_Data.AttemptType has unexpected type %T", x)
@@ -493,6 +560,14 @@ func _Attempt_Data_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.AttemptType = &Attempt_Data_Finished_{msg}
 		return true, err
+	case 9: // attempt_type.abnormal_finish
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Attempt_Data_AbnormalFinish)
+		err := b.DecodeMessage(msg)
+		m.AttemptType = &Attempt_Data_AbnormalFinish_{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -527,6 +602,11 @@ func _Attempt_Data_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(8<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Attempt_Data_AbnormalFinish_:
+		s := proto.Size(x.AbnormalFinish)
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -542,7 +622,7 @@ func (m *Attempt_Data_NeedsExecution) Reset()         { *m = Attempt_Data_NeedsE
 func (m *Attempt_Data_NeedsExecution) String() string { return proto.CompactTextString(m) }
 func (*Attempt_Data_NeedsExecution) ProtoMessage()    {}
 func (*Attempt_Data_NeedsExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{1, 1, 0}
+	return fileDescriptor5, []int{1, 1, 0}
 }
 
 func (m *Attempt_Data_NeedsExecution) GetPending() *google_protobuf.Timestamp {
@@ -559,7 +639,7 @@ type Attempt_Data_Executing struct {
 func (m *Attempt_Data_Executing) Reset()                    { *m = Attempt_Data_Executing{} }
 func (m *Attempt_Data_Executing) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_Executing) ProtoMessage()               {}
-func (*Attempt_Data_Executing) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1, 1} }
+func (*Attempt_Data_Executing) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 1, 1} }
 
 type Attempt_Data_AddingDeps struct {
 	NumAdding  uint32 `protobuf:"varint,1,opt,name=num_adding,json=numAdding" json:"num_adding,omitempty"`
@@ -569,7 +649,7 @@ type Attempt_Data_AddingDeps struct {
 func (m *Attempt_Data_AddingDeps) Reset()                    { *m = Attempt_Data_AddingDeps{} }
 func (m *Attempt_Data_AddingDeps) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_AddingDeps) ProtoMessage()               {}
-func (*Attempt_Data_AddingDeps) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1, 2} }
+func (*Attempt_Data_AddingDeps) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 1, 2} }
 
 type Attempt_Data_Blocked struct {
 	NumWaiting uint32 `protobuf:"varint,1,opt,name=num_waiting,json=numWaiting" json:"num_waiting,omitempty"`
@@ -578,7 +658,7 @@ type Attempt_Data_Blocked struct {
 func (m *Attempt_Data_Blocked) Reset()                    { *m = Attempt_Data_Blocked{} }
 func (m *Attempt_Data_Blocked) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_Blocked) ProtoMessage()               {}
-func (*Attempt_Data_Blocked) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1, 3} }
+func (*Attempt_Data_Blocked) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 1, 3} }
 
 type Attempt_Data_Finished struct {
 	Expiration     *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=expiration" json:"expiration,omitempty"`
@@ -589,7 +669,7 @@ type Attempt_Data_Finished struct {
 func (m *Attempt_Data_Finished) Reset()                    { *m = Attempt_Data_Finished{} }
 func (m *Attempt_Data_Finished) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_Finished) ProtoMessage()               {}
-func (*Attempt_Data_Finished) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1, 4} }
+func (*Attempt_Data_Finished) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 1, 4} }
 
 func (m *Attempt_Data_Finished) GetExpiration() *google_protobuf.Timestamp {
 	if m != nil {
@@ -598,6 +678,17 @@ func (m *Attempt_Data_Finished) GetExpiration() *google_protobuf.Timestamp {
 	return nil
 }
 
+type Attempt_Data_AbnormalFinish struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *Attempt_Data_AbnormalFinish) Reset()         { *m = Attempt_Data_AbnormalFinish{} }
+func (m *Attempt_Data_AbnormalFinish) String() string { return proto.CompactTextString(m) }
+func (*Attempt_Data_AbnormalFinish) ProtoMessage()    {}
+func (*Attempt_Data_AbnormalFinish) Descriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{1, 1, 5}
+}
+
 type Attempt_Partial struct {
 	// Data is true iff the AttemptData should have been filled, but wasn't
 	Data bool `protobuf:"varint,1,opt,name=data" json:"data,omitempty"`
@@ -618,7 +709,7 @@ type Attempt_Partial struct {
 func (m *Attempt_Partial) Reset()                    { *m = Attempt_Partial{} }
 func (m *Attempt_Partial) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Partial) ProtoMessage()               {}
-func (*Attempt_Partial) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 3} }
+func (*Attempt_Partial) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1, 3} }
 
 type Execution struct {
 	Id   *Execution_ID   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -631,7 +722,7 @@ type Execution struct {
 func (m *Execution) Reset()                    { *m = Execution{} }
 func (m *Execution) String() string            { return proto.CompactTextString(m) }
 func (*Execution) ProtoMessage()               {}
-func (*Execution) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
+func (*Execution) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2} }
 
 func (m *Execution) GetId() *Execution_ID {
 	if m != nil {
@@ -657,7 +748,7 @@ type Execution_Auth struct {
 func (m *Execution_Auth) Reset()                    { *m = Execution_Auth{} }
 func (m *Execution_Auth) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Auth) ProtoMessage()               {}
-func (*Execution_Auth) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2, 0} }
+func (*Execution_Auth) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2, 0} }
 
 func (m *Execution_Auth) GetId() *Execution_ID {
 	if m != nil {
@@ -675,7 +766,7 @@ type Execution_ID struct {
 func (m *Execution_ID) Reset()                    { *m = Execution_ID{} }
 func (m *Execution_ID) String() string            { return proto.CompactTextString(m) }
 func (*Execution_ID) ProtoMessage()               {}
-func (*Execution_ID) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2, 1} }
+func (*Execution_ID) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2, 1} }
 
 type Execution_Data struct {
 	State              Execution_State            `protobuf:"varint,1,opt,name=state,enum=dm.Execution_State" json:"state,omitempty"`
@@ -683,12 +774,15 @@ type Execution_Data struct {
 	Created            *google_protobuf.Timestamp `protobuf:"bytes,3,opt,name=created" json:"created,omitempty"`
 	DistributorToken   string                     `protobuf:"bytes,4,opt,name=distributor_token,json=distributorToken" json:"distributor_token,omitempty"`
 	DistributorInfoUrl string                     `protobuf:"bytes,5,opt,name=distributor_info_url,json=distributorInfoUrl" json:"distributor_info_url,omitempty"`
+	// LeaseExpiration is set for Executions which were claimed with
+	// ClaimExecution, and is the time at which the executor's lease expires.
+	LeaseExpiration *google_protobuf.Timestamp `protobuf:"bytes,6,opt,name=lease_expiration,json=leaseExpiration" json:"lease_expiration,omitempty"`
 }
 
 func (m *Execution_Data) Reset()                    { *m = Execution_Data{} }
 func (m *Execution_Data) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Data) ProtoMessage()               {}
-func (*Execution_Data) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2, 2} }
+func (*Execution_Data) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2, 2} }
 
 func (m *Execution_Data) GetCreated() *google_protobuf.Timestamp {
 	if m != nil {
@@ -697,6 +791,13 @@ func (m *Execution_Data) GetCreated() *google_protobuf.Timestamp {
 	return nil
 }
 
+func (m *Execution_Data) GetLeaseExpiration() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.LeaseExpiration
+	}
+	return nil
+}
+
 // GraphData defines all of the DM graph data that may be returned from DM.
 //
 // Currently only WalkGraph returns GraphData, but in the future other APIs will
@@ -732,7 +833,7 @@ type GraphData struct {
 func (m *GraphData) Reset()                    { *m = GraphData{} }
 func (m *GraphData) String() string            { return proto.CompactTextString(m) }
 func (*GraphData) ProtoMessage()               {}
-func (*GraphData) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+func (*GraphData) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
 
 func (m *GraphData) GetQuests() map[string]*Quest {
 	if m != nil {
@@ -755,105 +856,119 @@ func init() {
 	proto.RegisterType((*Attempt_Data_AddingDeps)(nil), "dm.Attempt.Data.AddingDeps")
 	proto.RegisterType((*Attempt_Data_Blocked)(nil), "dm.Attempt.Data.Blocked")
 	proto.RegisterType((*Attempt_Data_Finished)(nil), "dm.Attempt.Data.Finished")
+	proto.RegisterType((*Attempt_Data_AbnormalFinish)(nil), "dm.Attempt.Data.AbnormalFinish")
 	proto.RegisterType((*Attempt_Partial)(nil), "dm.Attempt.Partial")
 	proto.RegisterType((*Execution)(nil), "dm.Execution")
 	proto.RegisterType((*Execution_Auth)(nil), "dm.Execution.Auth")
 	proto.RegisterType((*Execution_ID)(nil), "dm.Execution.ID")
 	proto.RegisterType((*Execution_Data)(nil), "dm.Execution.Data")
 	proto.RegisterType((*GraphData)(nil), "dm.GraphData")
+	proto.RegisterEnum("dm.Quest_Desc_ExpiredResultPolicy", Quest_Desc_ExpiredResultPolicy_name, Quest_Desc_ExpiredResultPolicy_value)
 	proto.RegisterEnum("dm.Attempt_State", Attempt_State_name, Attempt_State_value)
 	proto.RegisterEnum("dm.Attempt_Partial_Result", Attempt_Partial_Result_name, Attempt_Partial_Result_value)
 	proto.RegisterEnum("dm.Execution_State", Execution_State_name, Execution_State_value)
 }
 
-var fileDescriptor4 = []byte{
-	// 1406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x92, 0xdb, 0x44,
-	0x13, 0x8e, 0xcf, 0x76, 0x7b, 0xed, 0xf5, 0x3f, 0xc9, 0xff, 0xc7, 0x51, 0x7e, 0xb2, 0xc1, 0x1c,
-	0x2a, 0x04, 0xe2, 0x25, 0x1b, 0x08, 0x54, 0xa8, 0x4a, 0x95, 0x63, 0x29, 0x59, 0x05, 0xaf, 0x37,
-	0xc8, 0xde, 0x0a, 0xc5, 0x8d, 0x4a, 0xb6, 0x66, 0xbd, 0xca, 0xda, 0x92, 0xd1, 0x21, 0x61, 0xb9,
-	0xe7, 0x01, 0x28, 0xde, 0x87, 0xf7, 0xe0, 0x11, 0xb8, 0xa0, 0xb8, 0xe5, 0x92, 0x9e, 0x83, 0xe4,
-	0x51, 0x36, 0x09, 0xa9, 0xe2, 0x46, 0xa5, 0xee, 0xfe, 0xba, 0xa7, 0xa7, 0xfb, 0x9b, 0x9e, 0x81,
-	0xce, 0x22, 0x74, 0xd6, 0x27, 0xb6, 0xeb, 0xc4, 0x4e, 0x7f, 0x1d, 0x06, 0x71, 0x40, 0x8a, 0xee,
-	0x4a, 0xdb, 0x59, 0x04, 0xc1, 0x62, 0x49, 0x77, 0xb9, 0x66, 0x96, 0x1c, 0xef, 0xc6, 0xde, 0x8a,
-	0x46, 0xb1, 0xb3, 0x5a, 0x0b, 0x90, 0x76, 0x6f, 0xe1, 0xc5, 0x27, 0xc9, 0xac, 0x3f, 0x0f, 0x56,
-	0xbb, 0xcb, 0x64, 0xee, 0xf1, 0xcf, 0xad, 0x45, 0xb0, 0x8b, 0x8a, 0x55, 0xe0, 0xef, 0x3a, 0x6b,
-	0x6f, 0x37, 0xa6, 0xab, 0xf5, 0xd2, 0x89, 0x69, 0xf6, 0x23, 0x7d, 0x9b, 0xf1, 0xd9, 0x9a, 0x46,
-	0x42, 0xe8, 0xfd, 0x51, 0x86, 0xca, 0x37, 0x09, 0xc6, 0x26, 0xff, 0x87, 0xa2, 0xe7, 0x76, 0x0b,
-	0xd7, 0x0b, 0x37, 0x9a, 0x7b, 0x5b, 0x7d, 0x77, 0xd5, 0xe7, 0xea, 0xbe, 0xa9, 0x5b, 0xa8, 0x27,
-	0x1d, 0x28, 0xe9, 0x63, 0xa3, 0x5b, 0x44, 0x73, 0xdd, 0x2a, 0xb9, 0x63, 0x83, 0xf4, 0xa0, 0xcc,
-	0xb2, 0xee, 0x96, 0xb8, 0x47, 0x7b, 0xe3, 0xa1, 0xa3, 0xd6, 0xe2, 0x36, 0x72, 0x07, 0xea, 0x4e,
-	0xcc, 0x96, 0x8f, 0xa3, 0x6e, 0xf9, 0x7a, 0x09, 0x71, 0x97, 0x37, 0xb8, 0x81, 0xb4, 0x18, 0x7e,
-	0x1c, 0x9e, 0x59, 0x19, 0x90, 0x74, 0xa1, 0xb6, 0x76, 0xc2, 0xd8, 0x73, 0x96, 0xdd, 0x0e, 0x5f,
-	0x2e, 0x15, 0xb5, 0x4b, 0x50, 0x34, 0x75, 0xd2, 0xce, 0x12, 0x6d, 0xb0, 0xd4, 0x34, 0x07, 0xca,
-	0x3a, 0x8d, 0xe6, 0xe4, 0x2e, 0x5c, 0x76, 0xbd, 0x28, 0x0e, 0xbd, 0x59, 0x12, 0x07, 0xa1, 0x3d,
-	0x0f, 0xfc, 0x63, 0x6f, 0x61, 0xfb, 0xce, 0x8a, 0x4a, 0xf0, 0x7f, 0x15, 0xf3, 0x90, 0x5b, 0xc7,
-	0x68, 0x24, 0xef, 0xc2, 0xd6, 0xb3, 0x28, 0xf0, 0xed, 0xb5, 0x73, 0xb6, 0x0c, 0x1c, 0x97, 0xef,
-	0xb1, 0x61, 0x35, 0x99, 0xee, 0x89, 0x50, 0x69, 0x27, 0xb0, 0x35, 0x95, 0x45, 0x9c, 0xac, 0xe9,
-	0x9c, 0xa7, 0x18, 0x06, 0xcf, 0xe8, 0x3c, 0x96, 0xa1, 0x53, 0x91, 0xd5, 0x29, 0xa4, 0xc7, 0x32,
-	0x06, 0xfb, 0x65, 0xd8, 0xe7, 0x34, 0x8c, 0xbc, 0xc0, 0xe7, 0xa5, 0x42, 0xac, 0x14, 0x09, 0x81,
-	0x32, 0xcf, 0xae, 0xcc, 0xd5, 0xfc, 0x5f, 0xfb, 0xa5, 0x80, 0xbb, 0x61, 0xa5, 0xfb, 0x0c, 0x6a,
-	0xf3, 0x90, 0xe2, 0x82, 0x69, 0x4f, 0xb4, 0xbe, 0x20, 0x45, 0x3f, 0x25, 0x45, 0x7f, 0x9a, 0x92,
-	0xc2, 0x4a, 0xa1, 0xbc, 0x29, 0x58, 0x0b, 0xbe, 0x7e, 0xbe, 0x29, 0xa8, 0xb5, 0xb8, 0x8d, 0xdc,
-	0x86, 0xfa, 0x2c, 0xf1, 0x96, 0xb1, 0x3d, 0x3b, 0xc3, 0x8c, 0x58, 0x53, 0xfe, 0xb7, 0xc1, 0xa9,
-	0xdb, 0xb4, 0x6a, 0x1c, 0xf7, 0xe0, 0x4c, 0xdb, 0x87, 0x56, 0xae, 0x5b, 0x6c, 0x9b, 0xa7, 0xf4,
-	0x8c, 0x67, 0xd6, 0xb2, 0xd8, 0x2f, 0x56, 0xb1, 0xf2, 0xdc, 0x59, 0x26, 0x54, 0x2e, 0xdd, 0x64,
-	0x21, 0xa5, 0x8f, 0x25, 0x2c, 0xf7, 0x8a, 0x5f, 0x16, 0x7a, 0x3f, 0xb5, 0xa0, 0x26, 0xd5, 0xe4,
-	0x9a, 0xc2, 0xb8, 0xb6, 0x82, 0x7f, 0x3d, 0xe7, 0xde, 0xcf, 0x71, 0xae, 0xa3, 0xfa, 0x28, 0xac,
-	0xfb, 0x0a, 0x80, 0xfe, 0x40, 0xe7, 0x49, 0x8c, 0x45, 0x4e, 0x79, 0x77, 0x55, 0xc5, 0x1a, 0x99,
-	0x55, 0x70, 0x4f, 0x81, 0x93, 0x9b, 0x50, 0x3f, 0x7e, 0xe1, 0xda, 0x2e, 0x5d, 0x47, 0xdd, 0x0a,
-	0x5f, 0x66, 0x5b, 0x71, 0x1d, 0x21, 0x83, 0xac, 0x1a, 0x02, 0x74, 0xb4, 0x93, 0x4f, 0xa0, 0x31,
-	0x73, 0xe6, 0xa7, 0x02, 0x5c, 0x7d, 0x35, 0xb8, 0xce, 0x10, 0x1c, 0x7d, 0x2b, 0xcf, 0xeb, 0xe6,
-	0xde, 0x45, 0x35, 0xa7, 0x27, 0xc2, 0xb4, 0x21, 0xfb, 0x4d, 0x4e, 0xf6, 0x4b, 0x50, 0xf9, 0x9e,
-	0x35, 0x46, 0xf2, 0x4c, 0x08, 0xf2, 0x08, 0x14, 0x79, 0xf5, 0xd9, 0x11, 0xf8, 0xb3, 0xfa, 0xaf,
-	0x58, 0x73, 0x17, 0xea, 0xab, 0xc0, 0xf5, 0x8e, 0x3d, 0xea, 0xca, 0xf6, 0xbd, 0xc9, 0x2d, 0xc3,
-	0x92, 0x0f, 0xa0, 0xed, 0x27, 0x2b, 0x5b, 0x29, 0x76, 0x89, 0xa7, 0xd4, 0x42, 0xed, 0xa6, 0xc6,
-	0xe4, 0x31, 0x6c, 0xfb, 0x94, 0xba, 0xd1, 0x06, 0xc8, 0x29, 0xdf, 0xdc, 0xdb, 0x79, 0xb9, 0x81,
-	0xfd, 0x31, 0xc3, 0x65, 0xae, 0xfb, 0x17, 0xac, 0xb6, 0x9f, 0xd3, 0x90, 0x7b, 0xd0, 0x90, 0x51,
-	0xfc, 0x85, 0xec, 0x8f, 0x76, 0x2e, 0x8a, 0x91, 0x22, 0x30, 0xc0, 0x06, 0x4e, 0xee, 0x43, 0xd3,
-	0x71, 0x5d, 0xfc, 0x53, 0x1b, 0x76, 0xf5, 0x9c, 0xf7, 0x80, 0x63, 0x58, 0xcb, 0xd0, 0x1d, 0x9c,
-	0x4c, 0x62, 0xc5, 0x9d, 0x2d, 0x83, 0xf9, 0x29, 0x56, 0xa9, 0xc6, 0x7d, 0xbb, 0xe7, 0x7c, 0x1f,
-	0x08, 0x3b, 0x3a, 0xa6, 0x50, 0xf2, 0x05, 0x12, 0xca, 0xf3, 0xbd, 0xe8, 0x04, 0xdd, 0xea, 0xdc,
-	0xed, 0xca, 0x39, 0xb7, 0x87, 0x12, 0x80, 0x7e, 0x19, 0x58, 0x7b, 0x08, 0xed, 0x7c, 0x39, 0x58,
-	0x02, 0x6b, 0xea, 0xb3, 0x7c, 0xde, 0xa6, 0xbb, 0x12, 0xaa, 0x7d, 0x0e, 0x8d, 0xac, 0x20, 0xe4,
-	0x06, 0x74, 0xe6, 0x49, 0xb8, 0xe9, 0x84, 0x2d, 0x4f, 0x60, 0xcb, 0x6a, 0xa3, 0x3e, 0x5b, 0xca,
-	0x74, 0xb5, 0x11, 0xc0, 0xa6, 0x12, 0xe4, 0x1d, 0x00, 0xd6, 0x6a, 0x51, 0x0d, 0xe9, 0xd1, 0x40,
-	0x8d, 0x80, 0x90, 0x1d, 0x68, 0x32, 0xf3, 0x0b, 0xc7, 0xe3, 0x8d, 0x11, 0xcc, 0x64, 0x1e, 0x4f,
-	0x85, 0x06, 0xd9, 0x5c, 0x93, 0xb5, 0x79, 0x19, 0x5b, 0x38, 0x87, 0xfd, 0xb9, 0x00, 0xf5, 0xb4,
-	0x22, 0xd8, 0x70, 0x3c, 0x9d, 0x6b, 0x2f, 0x74, 0x38, 0x6f, 0xfe, 0x79, 0xdb, 0x0a, 0x9a, 0x6d,
-	0x96, 0x4f, 0xf6, 0x90, 0x46, 0x09, 0xce, 0xbb, 0xc8, 0xfb, 0x91, 0xca, 0xd4, 0xda, 0x4c, 0x6f,
-	0x71, 0xf5, 0x04, 0xb5, 0x2c, 0x27, 0x05, 0x29, 0x07, 0x35, 0x6c, 0x40, 0x0f, 0xda, 0xb0, 0x25,
-	0x2f, 0x28, 0x9b, 0x5d, 0x9f, 0x58, 0x9d, 0xed, 0x97, 0xa6, 0xc8, 0x2b, 0x66, 0xe2, 0x7b, 0xf9,
-	0x99, 0xd8, 0x62, 0x7d, 0xcf, 0xbc, 0x94, 0xa9, 0xa8, 0xfd, 0x55, 0x80, 0x9a, 0x1c, 0x00, 0xec,
-	0x56, 0xe0, 0x33, 0xae, 0xc0, 0xc7, 0x9e, 0x98, 0x68, 0xd7, 0x72, 0x13, 0x4d, 0x0c, 0x44, 0x75,
-	0x68, 0x5d, 0x51, 0x86, 0x56, 0x49, 0xdc, 0x99, 0xe9, 0x8c, 0xba, 0xaa, 0xce, 0xa8, 0x32, 0xb7,
-	0x6d, 0x46, 0xd2, 0x1e, 0x54, 0xe5, 0x8e, 0xd9, 0x51, 0x6a, 0xe7, 0x8f, 0x92, 0x4c, 0xa8, 0x2f,
-	0x2a, 0x60, 0x49, 0x64, 0xef, 0x00, 0xaa, 0x42, 0x43, 0x00, 0xaa, 0xa3, 0xc3, 0x81, 0x6e, 0xe8,
-	0x9d, 0x0b, 0x38, 0x91, 0x60, 0x7c, 0x38, 0xb5, 0xa5, 0x5c, 0xc0, 0x5d, 0xb4, 0x99, 0x3c, 0x38,
-	0x9a, 0xee, 0x1f, 0x5a, 0xe6, 0x77, 0xa8, 0x2b, 0x92, 0x8b, 0xb0, 0xad, 0x0f, 0xa6, 0x03, 0x7b,
-	0x82, 0xb2, 0x3d, 0x32, 0x0f, 0xcc, 0x69, 0xa7, 0xd4, 0x4b, 0xa0, 0x32, 0x89, 0x71, 0x0a, 0x31,
-	0xeb, 0xd8, 0x30, 0xf4, 0x89, 0x6d, 0x7c, 0x6b, 0x0c, 0x8f, 0xa6, 0xe6, 0xe1, 0x18, 0xc3, 0xb6,
-	0x90, 0xbb, 0x42, 0x1c, 0x3f, 0xc2, 0xa8, 0xdb, 0xd0, 0x1c, 0xe8, 0x3a, 0xfe, 0xdb, 0xba, 0xf1,
-	0x64, 0x82, 0x21, 0x9b, 0x48, 0xab, 0xd1, 0xe1, 0xf0, 0x6b, 0x8c, 0x5f, 0xc2, 0x17, 0x4c, 0x77,
-	0xf0, 0x74, 0x60, 0x32, 0xec, 0x26, 0x88, 0x3d, 0x99, 0x0e, 0xa6, 0x46, 0xa7, 0x4c, 0xb6, 0x90,
-	0x54, 0xe6, 0xd8, 0x9c, 0xec, 0x23, 0xb6, 0xd2, 0xfb, 0xad, 0x9c, 0x9d, 0x0a, 0x24, 0xca, 0x75,
-	0xe5, 0x26, 0xea, 0xe4, 0xba, 0x94, 0xde, 0x45, 0x1f, 0xca, 0xae, 0x88, 0x4e, 0x92, 0x3c, 0x46,
-	0xb9, 0x7b, 0x5e, 0xff, 0x78, 0xb9, 0x0f, 0xe5, 0x41, 0x12, 0x9f, 0xbc, 0xc5, 0x5a, 0x38, 0xf3,
-	0xe3, 0xe0, 0x94, 0xfa, 0x7c, 0xb1, 0x2d, 0x4b, 0x08, 0x9a, 0xfe, 0x86, 0xfb, 0x00, 0x57, 0x95,
-	0xec, 0x94, 0xfc, 0x4e, 0x45, 0x79, 0x53, 0x94, 0xb2, 0x9b, 0xe2, 0xf7, 0xf4, 0x7d, 0xf1, 0x11,
-	0x54, 0x22, 0x56, 0x77, 0x1e, 0xa8, 0x2d, 0xee, 0xa2, 0x4d, 0x26, 0xbc, 0x25, 0x96, 0x40, 0xb0,
-	0x07, 0x12, 0xff, 0xc1, 0xd3, 0xe1, 0xe0, 0x89, 0x48, 0x1f, 0x48, 0x5c, 0x67, 0x71, 0x95, 0x7a,
-	0xef, 0x94, 0xde, 0xfe, 0xde, 0xf9, 0x18, 0xfe, 0xa3, 0xbe, 0xd8, 0xc4, 0xa6, 0xc5, 0x6b, 0xa8,
-	0xa3, 0x18, 0xa6, 0x4c, 0x4f, 0x3e, 0x85, 0x4b, 0x2a, 0xd8, 0xf3, 0x8f, 0x03, 0x3b, 0x09, 0x97,
-	0x9c, 0xb9, 0x0d, 0x8b, 0x28, 0x36, 0x13, 0x4d, 0x47, 0xe1, 0xb2, 0xf7, 0x3c, 0xa5, 0x16, 0xb2,
-	0x68, 0x32, 0xc4, 0xbe, 0x1f, 0x8d, 0x38, 0x57, 0x91, 0x34, 0xd6, 0xd1, 0x78, 0x2c, 0x28, 0x85,
-	0xb4, 0xb0, 0x8c, 0xc7, 0xc6, 0x70, 0xca, 0x29, 0x8a, 0xc8, 0xa9, 0x79, 0x60, 0xe8, 0xf6, 0xe1,
-	0x11, 0x92, 0x33, 0xc7, 0x99, 0x32, 0xe3, 0xfb, 0xc3, 0x81, 0xc9, 0x62, 0x54, 0x58, 0x8c, 0x03,
-	0x73, 0x32, 0x61, 0x31, 0xaa, 0xcc, 0x6b, 0x38, 0x18, 0x0f, 0x8d, 0x11, 0xb3, 0xd5, 0x7a, 0xbf,
-	0x16, 0xa0, 0xf1, 0x88, 0x3d, 0xeb, 0x79, 0xa1, 0x6f, 0x43, 0x95, 0x37, 0x29, 0xc2, 0x4a, 0x97,
-	0xd2, 0xe9, 0x9f, 0x99, 0xc5, 0xb3, 0x4b, 0xbe, 0x43, 0x24, 0x90, 0x0d, 0xdb, 0x13, 0xc7, 0xb5,
-	0x69, 0x18, 0x06, 0x61, 0x7a, 0xdc, 0x1b, 0xa8, 0x31, 0xb8, 0x82, 0x9d, 0x76, 0x66, 0x5e, 0x05,
-	0x21, 0x4d, 0x4f, 0x3b, 0xca, 0x07, 0x28, 0x22, 0x49, 0x9a, 0x4a, 0x40, 0x75, 0x24, 0x35, 0xc4,
-	0x48, 0xda, 0xc9, 0x8f, 0xa4, 0x46, 0xf6, 0xf2, 0x53, 0xc6, 0xd1, 0xac, 0xca, 0x9b, 0x76, 0xe7,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x30, 0x3f, 0xa1, 0x3a, 0x9d, 0x0c, 0x00, 0x00,
+var fileDescriptor5 = []byte{
+	// 1591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x6e, 0xdb, 0x46,
+	0x16, 0xd6, 0xbf, 0xc4, 0x23, 0x4b, 0x66, 0xc6, 0x4e, 0xc2, 0x30, 0xd9, 0xd8, 0xd1, 0xfe, 0xc0,
+	0x9b, 0xdd, 0xc8, 0x1b, 0x67, 0x93, 0x5d, 0x78, 0x81, 0x00, 0xb4, 0x49, 0x47, 0xcc, 0xca, 0xb2,
+	0x77, 0x24, 0x6f, 0x82, 0xdc, 0x10, 0x14, 0x39, 0x92, 0x18, 0x8b, 0xa4, 0xca, 0x9f, 0x24, 0xce,
+	0x7d, 0x7b, 0xd5, 0x9b, 0xa2, 0x2f, 0xd0, 0x47, 0x68, 0x5f, 0xa0, 0x6f, 0xd3, 0xfb, 0xbe, 0x40,
+	0x81, 0x62, 0x86, 0x43, 0x8a, 0x8a, 0x9d, 0x26, 0x40, 0x6f, 0x04, 0xce, 0x39, 0xdf, 0x99, 0x99,
+	0xf3, 0xf7, 0x9d, 0x11, 0x88, 0xd3, 0xc0, 0x5c, 0xcc, 0x0c, 0xdb, 0x8c, 0xcc, 0xee, 0x22, 0xf0,
+	0x23, 0x1f, 0x95, 0x6c, 0x57, 0xde, 0x9a, 0xfa, 0xfe, 0x74, 0x4e, 0x76, 0x99, 0x64, 0x1c, 0x4f,
+	0x76, 0x23, 0xc7, 0x25, 0x61, 0x64, 0xba, 0x8b, 0x04, 0x24, 0xef, 0x4f, 0x9d, 0x68, 0x16, 0x8f,
+	0xbb, 0x96, 0xef, 0xee, 0xce, 0x63, 0xcb, 0x61, 0x3f, 0x0f, 0xa6, 0xfe, 0xae, 0xe5, 0xbb, 0xae,
+	0xef, 0xed, 0x9a, 0x0b, 0x67, 0x37, 0x22, 0xee, 0x62, 0x6e, 0x46, 0x24, 0xfb, 0xe0, 0xb6, 0xcd,
+	0xe8, 0x62, 0x41, 0xc2, 0x64, 0xd1, 0xf9, 0xa5, 0x0a, 0xd5, 0xff, 0xc5, 0x24, 0x8c, 0xd0, 0x1d,
+	0x28, 0x39, 0xb6, 0x54, 0xdc, 0x2e, 0xee, 0x34, 0xf7, 0xd6, 0xba, 0xb6, 0xdb, 0x65, 0xe2, 0xae,
+	0xae, 0xe2, 0x92, 0x63, 0x23, 0x11, 0xca, 0xea, 0x40, 0x93, 0x4a, 0xdb, 0xc5, 0x9d, 0x06, 0xa6,
+	0x9f, 0xa8, 0x03, 0x15, 0x7a, 0x6b, 0xa9, 0xcc, 0x2c, 0xda, 0x4b, 0x0b, 0xd5, 0x8c, 0x4c, 0xcc,
+	0x74, 0xe8, 0x11, 0x34, 0xcc, 0x88, 0x1e, 0x1f, 0x85, 0x52, 0x65, 0xbb, 0xbc, 0xd3, 0xdc, 0xbb,
+	0xb9, 0xc4, 0x29, 0x5c, 0xa3, 0x79, 0x51, 0x70, 0x81, 0x33, 0x20, 0x92, 0xa0, 0xbe, 0x30, 0x83,
+	0xc8, 0x31, 0xe7, 0x92, 0xc8, 0x8e, 0x4b, 0x97, 0xf2, 0x26, 0x94, 0x74, 0x15, 0xb5, 0xb3, 0x8b,
+	0x0a, 0xf4, 0x6a, 0xf2, 0xcf, 0x45, 0xa8, 0xa8, 0x24, 0xb4, 0xd0, 0x13, 0xb8, 0x69, 0x3b, 0x61,
+	0x14, 0x38, 0xe3, 0x38, 0xf2, 0x03, 0xc3, 0xf2, 0xbd, 0x89, 0x33, 0x35, 0x3c, 0xd3, 0x25, 0x1c,
+	0x7d, 0x3d, 0xa7, 0x3e, 0x64, 0xda, 0x81, 0xe9, 0x12, 0x74, 0x0f, 0xd6, 0x5e, 0x87, 0xbe, 0x67,
+	0x2c, 0xcc, 0x8b, 0xb9, 0x6f, 0xda, 0xcc, 0x49, 0x01, 0x37, 0xa9, 0xec, 0x34, 0x11, 0xa1, 0xff,
+	0xc3, 0x75, 0xf2, 0x6e, 0xe1, 0x04, 0xc4, 0x36, 0x02, 0x12, 0xc6, 0xf3, 0xc8, 0x58, 0xf8, 0x73,
+	0xc7, 0xba, 0x60, 0xde, 0xb7, 0xf7, 0x3a, 0x39, 0xef, 0x49, 0x68, 0x75, 0xb5, 0x04, 0x8b, 0x19,
+	0xf4, 0x94, 0x21, 0xf1, 0x06, 0xb9, 0x2c, 0xec, 0x3c, 0x86, 0x8d, 0x2b, 0xb0, 0xa8, 0x05, 0x02,
+	0xd6, 0xb4, 0x97, 0xda, 0xe1, 0xd9, 0x48, 0x13, 0x0b, 0x68, 0x1d, 0x9a, 0x03, 0xed, 0x85, 0xa1,
+	0x8c, 0x46, 0xda, 0xf1, 0xe9, 0x48, 0x2c, 0xca, 0x33, 0x58, 0x1b, 0xf1, 0xa4, 0x0e, 0x17, 0xc4,
+	0x62, 0x21, 0x0b, 0xfc, 0xd7, 0xc4, 0x8a, 0xb8, 0xa7, 0xe9, 0x92, 0xe6, 0x2d, 0x20, 0x13, 0xee,
+	0x12, 0xfd, 0xa4, 0xd8, 0x37, 0x24, 0x08, 0x1d, 0xdf, 0x63, 0x97, 0x17, 0x70, 0xba, 0x44, 0x08,
+	0x2a, 0x2c, 0x58, 0x15, 0x26, 0x66, 0xdf, 0xf2, 0xb7, 0x34, 0xb8, 0x34, 0x95, 0xff, 0x84, 0xba,
+	0x15, 0x10, 0x33, 0x22, 0x69, 0x8d, 0xc8, 0xdd, 0xa4, 0x48, 0xbb, 0x69, 0x91, 0x76, 0x47, 0x69,
+	0x91, 0xe2, 0x14, 0xca, 0x8a, 0x84, 0x84, 0x96, 0x54, 0xba, 0x54, 0x24, 0x24, 0xb4, 0x30, 0xd3,
+	0xa1, 0x87, 0xd0, 0x18, 0xc7, 0xce, 0x3c, 0x32, 0xc6, 0x34, 0x9c, 0xb4, 0x48, 0x6e, 0x2c, 0x71,
+	0x79, 0x37, 0x71, 0x9d, 0xe1, 0x0e, 0x2e, 0xe4, 0x1e, 0xb4, 0x56, 0xaa, 0x87, 0xba, 0x79, 0x4e,
+	0x2e, 0xd8, 0xcd, 0x5a, 0x98, 0x7e, 0xa2, 0x7b, 0x50, 0x7d, 0x63, 0xce, 0x63, 0xc2, 0x8f, 0x6e,
+	0xd2, 0x2d, 0xb9, 0x0d, 0x4e, 0x34, 0xfb, 0xa5, 0x7f, 0x17, 0x3b, 0xdf, 0xb5, 0xa1, 0xce, 0xc5,
+	0xe8, 0x6e, 0xae, 0x03, 0xda, 0x39, 0xfc, 0xc7, 0x7b, 0xe0, 0x4f, 0x2b, 0x3d, 0x20, 0xe6, 0x6d,
+	0x72, 0x5d, 0xf0, 0x1f, 0x00, 0xf2, 0x8e, 0x58, 0x71, 0xe4, 0xf8, 0x5e, 0xda, 0x07, 0xb7, 0xf3,
+	0x58, 0x2d, 0xd3, 0x26, 0xbd, 0x90, 0x83, 0xa3, 0xfb, 0xd0, 0x98, 0xbc, 0xb5, 0x0d, 0x9b, 0x2c,
+	0x42, 0xa9, 0xca, 0x8e, 0x59, 0xcf, 0x99, 0xf6, 0x9d, 0x30, 0xc2, 0xf5, 0xc9, 0x5b, 0x5b, 0x25,
+	0x8b, 0x10, 0xfd, 0x1d, 0x84, 0xb1, 0x69, 0x9d, 0x27, 0xe0, 0xda, 0xd5, 0xe0, 0x06, 0x45, 0x30,
+	0xf4, 0x83, 0xd5, 0x3e, 0x6b, 0xee, 0x6d, 0xe4, 0xef, 0x74, 0x9a, 0xa8, 0x96, 0xcd, 0x77, 0x9f,
+	0x35, 0xdf, 0x26, 0x54, 0xbf, 0xa0, 0x89, 0xe1, 0x75, 0x96, 0x2c, 0x78, 0x4b, 0x96, 0x58, 0xf4,
+	0x69, 0x4b, 0xfe, 0x54, 0xff, 0x5d, 0x55, 0xf3, 0x04, 0x1a, 0xae, 0x6f, 0x3b, 0x13, 0x87, 0xd8,
+	0x52, 0xe9, 0x93, 0x66, 0x19, 0x16, 0xfd, 0x19, 0xda, 0x5e, 0xec, 0x1a, 0xb9, 0x60, 0x97, 0xd9,
+	0x95, 0x5a, 0x5e, 0xec, 0x2e, 0x63, 0x8c, 0x9e, 0xc3, 0xba, 0x47, 0x88, 0x1d, 0x2e, 0x81, 0xac,
+	0xe4, 0x9b, 0x7b, 0x5b, 0x1f, 0x26, 0xb0, 0x3b, 0xa0, 0xb8, 0xcc, 0xb4, 0x57, 0xc0, 0x6d, 0x6f,
+	0x45, 0x82, 0xf6, 0x41, 0xe0, 0xbb, 0x78, 0x53, 0x9e, 0x1f, 0xf9, 0xd2, 0x2e, 0x5a, 0x8a, 0xe8,
+	0x15, 0xf0, 0x12, 0x8e, 0x9e, 0x42, 0xd3, 0xb4, 0x6d, 0xc7, 0x9b, 0xe6, 0x13, 0x76, 0xfb, 0x92,
+	0xb5, 0xc2, 0x30, 0x34, 0x65, 0xbd, 0x02, 0x06, 0x33, 0x5b, 0xd1, 0xe0, 0x8e, 0xe7, 0xbe, 0x75,
+	0x4e, 0x6c, 0xa9, 0xce, 0x6c, 0xa5, 0x4b, 0xb6, 0x07, 0x89, 0xbe, 0x57, 0xc0, 0x29, 0x14, 0xfd,
+	0x0b, 0x1a, 0x13, 0xc7, 0x73, 0xc2, 0x19, 0xb1, 0xa5, 0x06, 0x33, 0xbb, 0x75, 0xc9, 0xec, 0x88,
+	0x03, 0x7a, 0x05, 0x9c, 0x81, 0x69, 0xd8, 0xcc, 0xb1, 0xe7, 0x07, 0xae, 0x39, 0x37, 0x12, 0xa1,
+	0x24, 0x7c, 0x24, 0x6c, 0x0a, 0xc7, 0x25, 0xfb, 0xd0, 0xb0, 0x99, 0x2b, 0x12, 0xf9, 0x08, 0xda,
+	0xab, 0xa1, 0xa5, 0xce, 0x2c, 0x88, 0x47, 0x7d, 0xfb, 0x9c, 0x4a, 0xe1, 0x50, 0xf9, 0x31, 0x08,
+	0x59, 0x70, 0xd1, 0x0e, 0x88, 0x56, 0x1c, 0x2c, 0xb3, 0x6a, 0xf0, 0x6e, 0x6e, 0xe1, 0xb6, 0x15,
+	0x07, 0xd9, 0x51, 0xba, 0x2d, 0xf7, 0x01, 0x96, 0x51, 0x45, 0x7f, 0x00, 0xa0, 0x65, 0x93, 0x44,
+	0x96, 0x5b, 0x08, 0x5e, 0xec, 0x26, 0x10, 0xb4, 0x05, 0x4d, 0xaa, 0x7e, 0x6b, 0x3a, 0x2c, 0xc9,
+	0x49, 0x95, 0x53, 0x8b, 0x17, 0x89, 0x44, 0xbe, 0x0f, 0x75, 0x1e, 0xe7, 0x0f, 0xb1, 0xc5, 0x4b,
+	0xd8, 0x6f, 0x8a, 0xd0, 0x48, 0xa3, 0x8b, 0xf6, 0x29, 0x31, 0x2c, 0x9c, 0xc0, 0x64, 0x35, 0xf8,
+	0x69, 0xb7, 0x73, 0x68, 0xea, 0x2c, 0x1b, 0x5a, 0x7c, 0x1c, 0x85, 0xce, 0x7b, 0xc2, 0xaf, 0xd6,
+	0xa6, 0xf2, 0x64, 0x9c, 0x0c, 0x9d, 0xf7, 0x84, 0xde, 0x29, 0x87, 0xe4, 0xa4, 0x0f, 0x4b, 0x90,
+	0xbc, 0x03, 0xed, 0xd5, 0x84, 0xa1, 0x1b, 0x50, 0x0b, 0x88, 0x19, 0xf2, 0x4b, 0x09, 0x98, 0xaf,
+	0x0e, 0xda, 0xb0, 0xc6, 0xc7, 0xb4, 0x41, 0x1f, 0x11, 0x72, 0x1f, 0xd6, 0x3f, 0xe0, 0xae, 0x2b,
+	0x98, 0xf8, 0x8f, 0xab, 0x4c, 0xdc, 0xa2, 0xd5, 0x92, 0x59, 0xe5, 0xb8, 0x58, 0xfe, 0xb2, 0x04,
+	0x75, 0x4e, 0x3b, 0x74, 0x16, 0x31, 0x66, 0x2d, 0x32, 0xb2, 0x65, 0xdf, 0xe8, 0xee, 0x0a, 0x8f,
+	0x26, 0x34, 0x9c, 0x93, 0xa0, 0x5b, 0x39, 0xaa, 0x2c, 0x33, 0x6d, 0xc6, 0x8c, 0xb7, 0xf3, 0xcc,
+	0x58, 0x61, 0xba, 0x25, 0x11, 0xee, 0x51, 0x6f, 0x59, 0x6c, 0xaa, 0x6c, 0x9a, 0xcb, 0x57, 0xf0,
+	0x60, 0x37, 0x89, 0x15, 0xe6, 0xc8, 0xce, 0x2b, 0xa8, 0x25, 0x12, 0x04, 0x50, 0xeb, 0x9f, 0x28,
+	0xaa, 0xa6, 0x8a, 0x05, 0xd4, 0x06, 0x18, 0x9c, 0x8c, 0x0c, 0xbe, 0x2e, 0x22, 0x04, 0x6d, 0xba,
+	0x56, 0xce, 0x46, 0xbd, 0x13, 0xac, 0xbf, 0xd2, 0x54, 0xb1, 0x84, 0x36, 0x60, 0x5d, 0x55, 0x46,
+	0x8a, 0x31, 0xd4, 0x5f, 0x69, 0x46, 0x5f, 0x3f, 0xd6, 0x47, 0x62, 0x19, 0x35, 0xa1, 0xae, 0xbd,
+	0x3c, 0xd5, 0xb1, 0xa6, 0x8a, 0x95, 0xce, 0xd7, 0x45, 0xa8, 0x0e, 0x23, 0x33, 0x22, 0x14, 0x3b,
+	0xd0, 0x34, 0x75, 0x68, 0x24, 0x6f, 0x01, 0xfd, 0x64, 0x20, 0x16, 0xe8, 0xe3, 0x80, 0x2f, 0x07,
+	0xcf, 0xc4, 0x22, 0x7d, 0x1c, 0x28, 0xaa, 0xaa, 0x0f, 0x9e, 0x19, 0xaa, 0x76, 0x3a, 0x14, 0x4b,
+	0x74, 0xaf, 0x83, 0xfe, 0xc9, 0xe1, 0x7f, 0x35, 0x55, 0x2c, 0xa3, 0x3b, 0x20, 0x29, 0x2f, 0x14,
+	0x9d, 0x62, 0x97, 0x9b, 0x18, 0xc3, 0x91, 0x32, 0xd2, 0xc4, 0x0a, 0x5a, 0x83, 0xc6, 0x91, 0x3e,
+	0xd0, 0x87, 0x3d, 0x4d, 0x15, 0xab, 0xe8, 0x3a, 0x5c, 0x53, 0x0e, 0x06, 0x27, 0xf8, 0x58, 0xe9,
+	0x1b, 0x99, 0xb8, 0xd6, 0xf9, 0xa1, 0x9a, 0x35, 0x99, 0xef, 0xa1, 0xed, 0xdc, 0x90, 0x14, 0x57,
+	0x52, 0x99, 0x8e, 0xc9, 0xbf, 0xf0, 0xd4, 0x25, 0xe9, 0x46, 0xab, 0x98, 0xdc, 0x58, 0xfc, 0xf8,
+	0x3b, 0xef, 0x29, 0x54, 0x94, 0x38, 0x9a, 0x7d, 0xc6, 0x59, 0x9b, 0x50, 0x8d, 0xfc, 0x73, 0xe2,
+	0xb1, 0xc3, 0xd6, 0x70, 0xb2, 0x90, 0xd5, 0xdf, 0x18, 0x55, 0x12, 0xd4, 0x79, 0x09, 0xf3, 0x76,
+	0x49, 0x97, 0x7c, 0x88, 0x95, 0xb3, 0x21, 0xf6, 0x7d, 0x89, 0x0f, 0xb1, 0xbf, 0x42, 0x35, 0xa4,
+	0xe9, 0x60, 0x1b, 0xb5, 0xf7, 0x36, 0x56, 0x6f, 0xc2, 0x32, 0x85, 0x13, 0x04, 0x7d, 0x4a, 0xb2,
+	0x0f, 0x83, 0xb7, 0x0f, 0x7f, 0x4a, 0x32, 0x19, 0x66, 0xa2, 0xfc, 0x48, 0x2c, 0x7f, 0xfe, 0x48,
+	0xfc, 0x1b, 0x5c, 0xcb, 0xbf, 0x6d, 0x13, 0xa7, 0x93, 0x87, 0x9a, 0x98, 0x53, 0x8c, 0xa8, 0x1c,
+	0xfd, 0x03, 0x36, 0xf3, 0x60, 0xc7, 0x9b, 0xf8, 0x46, 0x1c, 0xcc, 0x59, 0x79, 0x0b, 0x18, 0xe5,
+	0x74, 0xba, 0x37, 0xf1, 0xcf, 0x82, 0x39, 0xd2, 0x40, 0x9c, 0x13, 0x33, 0x24, 0x46, 0x8e, 0x8f,
+	0x6a, 0x9f, 0xbc, 0xdd, 0x3a, 0xb3, 0xd1, 0x32, 0x93, 0xce, 0x57, 0x59, 0xe5, 0xb6, 0x40, 0x18,
+	0x1e, 0xf6, 0x34, 0xf5, 0xac, 0xcf, 0x1a, 0xa3, 0x09, 0x75, 0x7c, 0x36, 0x18, 0x24, 0x15, 0xbb,
+	0x06, 0x0d, 0xac, 0x3d, 0xd7, 0x0e, 0x47, 0xac, 0x1f, 0x5a, 0x20, 0x8c, 0xf4, 0x63, 0x4d, 0x35,
+	0x4e, 0xce, 0x68, 0x27, 0xe4, 0x4b, 0xb2, 0x42, 0x9b, 0xeb, 0x48, 0xd1, 0xfb, 0xac, 0x3c, 0x9b,
+	0x50, 0x3f, 0xd6, 0x87, 0x43, 0xba, 0x47, 0x8d, 0x5a, 0x1d, 0x2a, 0x83, 0x43, 0xad, 0x4f, 0x75,
+	0x75, 0xda, 0x78, 0xfc, 0x38, 0xaa, 0x6e, 0x74, 0x7e, 0x2c, 0x82, 0xf0, 0x8c, 0xfe, 0xb3, 0x62,
+	0x09, 0x7c, 0x08, 0x35, 0x96, 0xfc, 0x50, 0x2a, 0x6e, 0x97, 0xd3, 0x81, 0x97, 0xa9, 0x93, 0x97,
+	0x26, 0x7f, 0x7a, 0x71, 0x20, 0x9d, 0x09, 0x33, 0xd3, 0x36, 0x48, 0x10, 0xf8, 0x41, 0xca, 0x35,
+	0xc2, 0xcc, 0xb4, 0x35, 0x26, 0xa0, 0x54, 0x43, 0xd5, 0xae, 0x1f, 0x90, 0x94, 0x6a, 0x66, 0xa6,
+	0x7d, 0xec, 0x07, 0x44, 0x56, 0xa1, 0x99, 0xdb, 0x30, 0xcf, 0x87, 0x42, 0xc2, 0x87, 0x5b, 0xab,
+	0x7c, 0x28, 0x64, 0x8f, 0xdd, 0x1c, 0x17, 0x8e, 0x6b, 0x2c, 0xdc, 0x8f, 0x7e, 0x1d, 0x00, 0x1f,
+	0x69, 0xd6, 0x3c, 0x20, 0x0e, 0x00, 0x00,
 }
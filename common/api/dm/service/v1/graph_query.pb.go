@@ -33,7 +33,7 @@ func (x GraphQuery_Search_Domain) String() string {
 	return proto.EnumName(GraphQuery_Search_Domain_name, int32(x))
 }
 func (GraphQuery_Search_Domain) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor5, []int{0, 1, 0}
+	return fileDescriptor6, []int{0, 1, 0}
 }
 
 // GraphQuery represents a single query into the state of DM's dependency graph.
@@ -54,7 +54,7 @@ type GraphQuery struct {
 func (m *GraphQuery) Reset()                    { *m = GraphQuery{} }
 func (m *GraphQuery) String() string            { return proto.CompactTextString(m) }
 func (*GraphQuery) ProtoMessage()               {}
-func (*GraphQuery) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
+func (*GraphQuery) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
 
 func (m *GraphQuery) GetAttemptList() *AttemptList {
 	if m != nil {
@@ -86,46 +86,47 @@ type GraphQuery_AttemptRange struct {
 func (m *GraphQuery_AttemptRange) Reset()                    { *m = GraphQuery_AttemptRange{} }
 func (m *GraphQuery_AttemptRange) String() string            { return proto.CompactTextString(m) }
 func (*GraphQuery_AttemptRange) ProtoMessage()               {}
-func (*GraphQuery_AttemptRange) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 0} }
+func (*GraphQuery_AttemptRange) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0, 0} }
 
 // A Search allows you to query objects whose properties match all of the
 // provided filters. Filters take the form of a dot-delimited path. For
 // example, say that we had the following objects:
 //
-//   Quest(id=deadbeef):
-//     created = <timestamp>  #sort
-//     descriptor.distributor_config_name = "foo"
-//     descriptor.json_payload = {
-//       "key": "value",
-//       "multi": ["some", 10, "values", true],
-//       "sub": [{"msg": 11}, {"msg": 12}],
-//     }
+//	Quest(id=deadbeef):
+//	  created = <timestamp>  #sort
+//	  descriptor.distributor_config_name = "foo"
+//	  descriptor.json_payload = {
+//	    "key": "value",
+//	    "multi": ["some", 10, "values", true],
+//	    "sub": [{"msg": 11}, {"msg": 12}],
+//	  }
 //
-//   Attempt(id=deadbeef|1):
-//     created = <timestamp>  #sort
-//     attempt_type = Finished
-//     finished.expiration = <timestamp>
-//     finished.json_result = {
-//       "rslt": "yes",
-//       "ok": true,
-//     }
+//	Attempt(id=deadbeef|1):
+//	  created = <timestamp>  #sort
+//	  attempt_type = Finished
+//	  finished.expiration = <timestamp>
+//	  finished.json_result = {
+//	    "rslt": "yes",
+//	    "ok": true,
+//	  }
 //
 // Then you could query (in pseudo-proto):
-//   domain: Attempt
-//   approx_filters: {
-//     "attempt_type": ["Finished"],
-//     "$quest.descriptor.json_payload.multi": [true, 10],
-//     "$quest.descriptor.json_payload.sub.msg": [11, 10],
-//     "finished.json_result.ok": [true],
-//   }
+//
+//	domain: Attempt
+//	approx_filters: {
+//	  "attempt_type": ["Finished"],
+//	  "$quest.descriptor.json_payload.multi": [true, 10],
+//	  "$quest.descriptor.json_payload.sub.msg": [11, 10],
+//	  "finished.json_result.ok": [true],
+//	}
 //
 // Or:
 //
-//   domain: Attempt
-//   exact_filters: {
-//     "$quest.descriptor.json_payload.multi[1]": [10],
-//     "$quest.descriptor.json_payload.sub[0].msg": [11],
-//   }
+//	domain: Attempt
+//	exact_filters: {
+//	  "$quest.descriptor.json_payload.multi[1]": [10],
+//	  "$quest.descriptor.json_payload.sub[0].msg": [11],
+//	}
 //
 // Literal '.' and '[' characters may be escaped with a backslash.
 type GraphQuery_Search struct {
@@ -182,7 +183,7 @@ type GraphQuery_Search struct {
 func (m *GraphQuery_Search) Reset()                    { *m = GraphQuery_Search{} }
 func (m *GraphQuery_Search) String() string            { return proto.CompactTextString(m) }
 func (*GraphQuery_Search) ProtoMessage()               {}
-func (*GraphQuery_Search) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 1} }
+func (*GraphQuery_Search) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0, 1} }
 
 func (m *GraphQuery_Search) GetStart() *PropertyValue {
 	if m != nil {
@@ -219,34 +220,34 @@ func init() {
 	proto.RegisterEnum("dm.GraphQuery_Search_Domain", GraphQuery_Search_Domain_name, GraphQuery_Search_Domain_value)
 }
 
-var fileDescriptor5 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
+var fileDescriptor6 = []byte{
+	// 434 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x71, 0x1c, 0xbb, 0x74, 0x1c, 0x17, 0x67, 0x04, 0xc8, 0x32, 0x1c, 0xa2, 0x72, 0x48,
-	0x0e, 0xe0, 0x83, 0xe1, 0x80, 0x38, 0x11, 0x09, 0x83, 0x54, 0xb5, 0xa2, 0xdd, 0x1a, 0xc4, 0xcd,
-	0xda, 0xd6, 0x4b, 0x62, 0xe1, 0x7f, 0xac, 0x37, 0x50, 0x7f, 0x85, 0x7e, 0x6a, 0xbc, 0x6b, 0xa7,
-	0x4d, 0x5b, 0x73, 0xdb, 0x9d, 0x79, 0xef, 0x37, 0xe3, 0xb7, 0x32, 0x4c, 0x57, 0x9c, 0x56, 0xeb,
-	0xf8, 0xf7, 0x86, 0xf1, 0xc6, 0xaf, 0x78, 0x29, 0x4a, 0x1c, 0x25, 0xb9, 0x67, 0x89, 0xa6, 0x62,
-	0x75, 0x57, 0x38, 0xbc, 0x36, 0x01, 0xbe, 0x48, 0xd9, 0x99, 0x54, 0x61, 0x00, 0x13, 0x2a, 0x04,
-	0xcb, 0x2b, 0x11, 0x67, 0x69, 0x2d, 0x5c, 0x6d, 0xa6, 0x2d, 0xac, 0xe0, 0x89, 0x9f, 0xe4, 0xfe,
-	0xb2, 0xab, 0x1f, 0xb7, 0x65, 0x62, 0xd1, 0xdb, 0x0b, 0x7e, 0x04, 0x7b, 0xeb, 0xe1, 0xb4, 0x58,
-	0x31, 0x77, 0x34, 0xd3, 0x5b, 0xd3, 0x0b, 0x69, 0xba, 0x45, 0x6f, 0xfd, 0x44, 0x4a, 0xc8, 0x76,
-	0x8a, 0xba, 0xe1, 0x1b, 0x30, 0x6b, 0x46, 0xf9, 0xe5, 0xda, 0xd5, 0x95, 0xf5, 0xd9, 0x3d, 0xeb,
-	0xb9, 0x6a, 0x92, 0x5e, 0xe4, 0x1d, 0xc1, 0x64, 0x17, 0x86, 0x4f, 0xc1, 0x68, 0xbf, 0xb1, 0xdf,
-	0x76, 0x9f, 0x74, 0x17, 0x74, 0x40, 0xcf, 0xca, 0xbf, 0xed, 0x32, 0xda, 0xc2, 0x26, 0xf2, 0x88,
-	0x08, 0xe3, 0x75, 0xba, 0x92, 0x43, 0x64, 0x49, 0x9d, 0xbd, 0xeb, 0x31, 0x98, 0x1d, 0x1e, 0xdf,
-	0x81, 0x99, 0x94, 0x39, 0x4d, 0x0b, 0xc5, 0x39, 0x08, 0x5e, 0x0e, 0x6e, 0xe1, 0x7f, 0x52, 0x1a,
-	0xd2, 0x6b, 0x71, 0x0e, 0x46, 0x2d, 0x28, 0x17, 0x8a, 0x6a, 0x05, 0x53, 0x69, 0x3a, 0xe5, 0x65,
-	0xc5, 0xb8, 0x68, 0xbe, 0xd3, 0x6c, 0xc3, 0x48, 0xd7, 0xc7, 0x57, 0xa0, 0xb3, 0x22, 0x71, 0xc7,
-	0xff, 0x93, 0xc9, 0x2e, 0x7e, 0x85, 0x03, 0x5a, 0xb5, 0x2f, 0x73, 0x15, 0xff, 0x4c, 0x33, 0xc1,
-	0x78, 0xed, 0x1a, 0x2a, 0x91, 0xc5, 0xf0, 0x2e, 0x4b, 0xa5, 0xfd, 0xdc, 0x49, 0xc3, 0x42, 0xf0,
-	0x86, 0xd8, 0x74, 0xb7, 0x86, 0xc7, 0x60, 0xb3, 0x2b, 0x7a, 0x29, 0x6e, 0x78, 0xa6, 0xe2, 0xcd,
-	0x87, 0x79, 0xa1, 0x94, 0xde, 0xc1, 0x4d, 0xd8, 0x4e, 0xc9, 0xfb, 0x01, 0xf8, 0x70, 0xa4, 0x4c,
-	0xfa, 0x17, 0x6b, 0xfa, 0xf4, 0xe5, 0x11, 0x5f, 0x83, 0xf1, 0x47, 0x7e, 0x94, 0x4a, 0xdf, 0x0a,
-	0x9e, 0xcb, 0x69, 0x27, 0x9b, 0x4c, 0xa4, 0xf7, 0x92, 0x51, 0xa2, 0x0f, 0xa3, 0xf7, 0x9a, 0x47,
-	0x60, 0xfa, 0x60, 0xf8, 0x00, 0x78, 0x7e, 0x17, 0x3c, 0x94, 0xf6, 0x0d, 0xf3, 0x70, 0x06, 0x66,
-	0xf7, 0x58, 0xb8, 0x0f, 0xc6, 0xd9, 0xb7, 0xf0, 0x3c, 0x72, 0x1e, 0xa1, 0x05, 0x7b, 0xcb, 0x28,
-	0x0a, 0x4f, 0x4e, 0x23, 0x47, 0x3b, 0x1a, 0x3f, 0x1e, 0x39, 0x3a, 0xd9, 0xab, 0x4b, 0x2e, 0xe2,
-	0x8b, 0xe6, 0xc2, 0x54, 0xff, 0xc4, 0xdb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x93, 0xcb, 0xfe,
-	0x26, 0x39, 0x03, 0x00, 0x00,
+	0x10, 0xc5, 0xb1, 0x1d, 0xbb, 0x74, 0x9c, 0x94, 0x64, 0x04, 0xc8, 0x32, 0x1c, 0xa2, 0x72, 0x88,
+	0x0f, 0xe0, 0x83, 0xe1, 0x80, 0x38, 0x11, 0x09, 0x83, 0x54, 0xb5, 0xa2, 0xdd, 0x1a, 0xc4, 0xcd,
+	0xda, 0xd6, 0x4b, 0x62, 0xe1, 0x7f, 0x5d, 0xaf, 0xa1, 0xfe, 0x0a, 0xfd, 0xd4, 0x68, 0xd7, 0x6e,
+	0xeb, 0xb6, 0xee, 0x6d, 0x67, 0xe6, 0xbd, 0xdf, 0x4c, 0x5e, 0x64, 0x58, 0x6c, 0x38, 0xad, 0xb6,
+	0xf1, 0x45, 0xc3, 0x78, 0xeb, 0x57, 0xbc, 0x14, 0x25, 0xea, 0x49, 0xee, 0xda, 0xa2, 0xad, 0x58,
+	0xdd, 0x35, 0xf6, 0xaf, 0x2c, 0x80, 0x6f, 0x52, 0x76, 0x22, 0x55, 0x18, 0xc0, 0x94, 0x0a, 0xc1,
+	0xf2, 0x4a, 0xc4, 0x59, 0x5a, 0x0b, 0x47, 0x5b, 0x6a, 0x9e, 0x1d, 0x3c, 0xf3, 0x93, 0xdc, 0x5f,
+	0x77, 0xfd, 0xc3, 0xb4, 0x16, 0xc4, 0xa6, 0xb7, 0x05, 0x7e, 0x86, 0xd9, 0xb5, 0x87, 0xd3, 0x62,
+	0xc3, 0x1c, 0x7d, 0x69, 0x78, 0x76, 0xf0, 0x4a, 0x9a, 0x6e, 0xd1, 0xd7, 0x7e, 0x22, 0x25, 0x64,
+	0x4a, 0x07, 0x15, 0xbe, 0x03, 0xab, 0x66, 0x94, 0x9f, 0x6f, 0x1d, 0x43, 0x59, 0x5f, 0xdc, 0xb3,
+	0x9e, 0xaa, 0x21, 0xe9, 0x45, 0xee, 0x01, 0x4c, 0x87, 0x30, 0x7c, 0x0e, 0xe6, 0x45, 0xc3, 0xfa,
+	0x6b, 0x77, 0x49, 0x57, 0xe0, 0x1c, 0x8c, 0xac, 0xfc, 0xe7, 0xe8, 0x4b, 0xcd, 0x9b, 0x11, 0xf9,
+	0x44, 0x84, 0xc9, 0x36, 0xdd, 0xc8, 0x25, 0xb2, 0xa5, 0xde, 0xee, 0xd5, 0x04, 0xac, 0x0e, 0x8f,
+	0x1f, 0xc0, 0x4a, 0xca, 0x9c, 0xa6, 0x85, 0xe2, 0xec, 0x05, 0xaf, 0x47, 0xaf, 0xf0, 0xbf, 0x28,
+	0x0d, 0xe9, 0xb5, 0xb8, 0x02, 0xb3, 0x16, 0x94, 0x0b, 0x45, 0xb5, 0x83, 0x85, 0x34, 0x1d, 0xf3,
+	0xb2, 0x62, 0x5c, 0xb4, 0x3f, 0x69, 0xd6, 0x30, 0xd2, 0xcd, 0xf1, 0x0d, 0x18, 0xac, 0x48, 0x9c,
+	0xc9, 0x63, 0x32, 0x39, 0xc5, 0xef, 0xb0, 0x47, 0xab, 0x8a, 0x97, 0x97, 0xf1, 0xef, 0x34, 0x13,
+	0x8c, 0xd7, 0x8e, 0xa9, 0x12, 0xf1, 0xc6, 0x6f, 0x59, 0x2b, 0xed, 0xd7, 0x4e, 0x1a, 0x16, 0x82,
+	0xb7, 0x64, 0x46, 0x87, 0x3d, 0x3c, 0x84, 0x19, 0xbb, 0xa4, 0xe7, 0xe2, 0x86, 0x67, 0x29, 0xde,
+	0x6a, 0x9c, 0x17, 0x4a, 0xe9, 0x1d, 0xdc, 0x94, 0x0d, 0x5a, 0xee, 0x2f, 0xc0, 0x87, 0x2b, 0x65,
+	0xd2, 0x7f, 0x58, 0xdb, 0xa7, 0x2f, 0x9f, 0xf8, 0x16, 0xcc, 0xbf, 0xf2, 0x47, 0xa9, 0xf4, 0xed,
+	0xe0, 0xa5, 0xdc, 0x76, 0xd4, 0x64, 0x22, 0xbd, 0x97, 0x8c, 0x12, 0x7d, 0xd2, 0x3f, 0x6a, 0x2e,
+	0x81, 0xc5, 0x83, 0xe5, 0x23, 0xe0, 0xd5, 0x5d, 0xf0, 0x58, 0xda, 0x37, 0xcc, 0xfd, 0x25, 0x58,
+	0xdd, 0x9f, 0x85, 0xbb, 0x60, 0x9e, 0xfc, 0x08, 0x4f, 0xa3, 0xf9, 0x13, 0xb4, 0x61, 0x67, 0x1d,
+	0x45, 0xe1, 0xd1, 0x71, 0x34, 0xd7, 0x0e, 0x26, 0x4f, 0xf5, 0xb9, 0x41, 0x76, 0xea, 0x92, 0x8b,
+	0xf8, 0xac, 0x3d, 0xb3, 0xd4, 0x37, 0xf1, 0xfe, 0xff, 0x00, 0x93, 0xcb, 0xfe, 0x26, 0x39, 0x03,
+	0x00, 0x00,
 }
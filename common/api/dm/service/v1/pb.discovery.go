@@ -10,1599 +10,1726 @@ func init() {
 			"dm.Deps",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 253, 9, 112, 156, 203,
-			118, 24, 6, 227, 251, 230, 195, 0, 104, 108, 131, 193, 202, 1,
-			9, 126, 4, 23, 0, 228, 96, 8, 146, 247, 242, 93, 242, 110,
-			15, 203, 144, 28, 94, 108, 119, 6, 32, 31, 121, 23, 114, 48,
-			243, 1, 152, 199, 193, 12, 238, 44, 196, 197, 125, 226, 147, 252,
-			22, 235, 183, 252, 43, 165, 181, 84, 78, 20, 109, 150, 165, 72,
-			142, 43, 42, 219, 81, 89, 149, 88, 94, 100, 73, 81, 61, 69,
-			21, 201, 85, 118, 28, 39, 74, 169, 18, 151, 34, 87, 165, 84,
-			46, 203, 46, 85, 185, 82, 201, 89, 122, 251, 6, 0, 239, 125,
-			239, 149, 82, 121, 201, 99, 221, 75, 78, 247, 215, 125, 250, 244,
-			233, 238, 211, 167, 79, 159, 115, 90, 252, 255, 166, 196, 217, 157,
-			106, 117, 167, 28, 92, 221, 175, 85, 27, 213, 173, 230, 246, 213,
-			70, 105, 47, 168, 55, 242, 123, 251, 41, 202, 138, 247, 115, 129,
-			148, 42, 48, 249, 186, 232, 218, 80, 101, 226, 99, 162, 163, 30,
-			20, 170, 149, 98, 125, 204, 241, 157, 233, 72, 86, 37, 227, 67,
-			162, 189, 146, 175, 84, 235, 99, 46, 228, 183, 103, 57, 177, 176,
-			33, 6, 11, 213, 189, 84, 11, 204, 133, 62, 13, 113, 29, 179,
-			214, 157, 159, 113, 156, 63, 119, 156, 159, 115, 35, 119, 215, 23,
-			126, 217, 157, 184, 203, 229, 215, 101, 249, 212, 195, 160, 92, 126,
-			167, 82, 61, 168, 108, 28, 238, 7, 245, 251, 127, 239, 162, 136,
-			198, 189, 137, 182, 61, 71, 252, 110, 143, 112, 122, 226, 145, 137,
-			182, 248, 245, 127, 212, 227, 83, 133, 66, 181, 236, 47, 52, 183,
-			183, 131, 90, 221, 159, 245, 25, 212, 84, 221, 47, 230, 27, 121,
-			191, 84, 105, 4, 181, 194, 110, 190, 178, 19, 248, 219, 213, 218,
-			94, 190, 33, 252, 197, 234, 254, 97, 173, 180, 179, 219, 240, 175,
-			207, 205, 189, 38, 43, 248, 153, 74, 33, 229, 251, 243, 229, 178,
-			79, 223, 234, 126, 45, 168, 7, 181, 231, 65, 49, 37, 252, 221,
-			70, 99, 191, 126, 251, 234, 213, 98, 240, 60, 40, 87, 247, 161,
-			33, 213, 67, 232, 44, 147, 22, 144, 152, 221, 98, 36, 174, 10,
-			225, 103, 131, 98, 169, 222, 168, 149, 182, 154, 141, 82, 181, 226,
-			231, 43, 69, 191, 89, 15, 0, 29, 191, 94, 109, 214, 10, 1,
-			229, 108, 149, 42, 249, 218, 33, 225, 85, 79, 250, 7, 165, 198,
-			174, 95, 173, 209, 191, 213, 38, 224, 185, 87, 45, 150, 182, 75,
-			133, 60, 66, 72, 250, 249, 90, 224, 67, 203, 123, 165, 70, 35,
-			40, 250, 208, 230, 243, 82, 17, 126, 52, 118, 243, 13, 248, 11,
-			123, 87, 46, 87, 15, 74, 149, 29, 31, 199, 167, 132, 149, 234,
-			88, 9, 224, 4, 141, 219, 128, 18, 254, 185, 220, 130, 88, 221,
-			175, 110, 43, 140, 10, 213, 98, 224, 239, 53, 235, 13, 232, 121,
-			35, 15, 152, 34, 212, 252, 86, 245, 57, 126, 146, 20, 19, 126,
-			165, 218, 40, 21, 130, 36, 124, 44, 213, 253, 50, 128, 66, 8,
-			118, 139, 149, 98, 11, 58, 208, 94, 161, 156, 135, 241, 175, 165,
-			78, 66, 2, 26, 179, 104, 161, 144, 128, 62, 22, 155, 128, 152,
-			198, 67, 24, 68, 190, 45, 60, 132, 47, 123, 87, 172, 22, 154,
-			123, 65, 165, 145, 87, 131, 116, 21, 232, 95, 133, 47, 53, 31,
-			102, 74, 80, 43, 229, 203, 117, 67, 106, 26, 32, 248, 40, 124,
-			27, 123, 221, 169, 213, 160, 68, 53, 17, 112, 37, 191, 23, 32,
-			66, 246, 220, 170, 84, 205, 55, 162, 123, 169, 81, 199, 30, 85,
-			24, 84, 21, 102, 239, 94, 254, 208, 223, 10, 112, 166, 0, 242,
-			85, 63, 168, 20, 33, 55, 192, 73, 1, 72, 236, 85, 27, 129,
-			207, 52, 129, 217, 89, 4, 236, 96, 114, 250, 219, 240, 65, 48,
-			21, 234, 213, 237, 198, 1, 78, 19, 57, 131, 252, 250, 126, 80,
-			192, 25, 4, 181, 74, 56, 177, 106, 56, 119, 42, 60, 139, 234,
-			117, 194, 93, 248, 27, 247, 50, 57, 63, 183, 118, 103, 227, 225,
-			124, 54, 237, 195, 239, 245, 236, 218, 131, 204, 82, 122, 201, 95,
-			120, 4, 31, 211, 254, 226, 218, 250, 163, 108, 230, 238, 189, 13,
-			255, 222, 218, 242, 82, 58, 155, 243, 231, 87, 151, 32, 119, 117,
-			35, 155, 89, 216, 220, 88, 203, 230, 132, 63, 57, 159, 131, 170,
-			147, 244, 101, 126, 245, 145, 159, 254, 194, 122, 54, 157, 203, 249,
-			107, 89, 63, 179, 178, 190, 156, 1, 104, 0, 62, 59, 191, 186,
-			145, 73, 231, 146, 126, 102, 117, 113, 121, 115, 41, 179, 122, 55,
-			233, 3, 4, 127, 117, 109, 67, 248, 203, 153, 149, 204, 6, 148,
-			219, 88, 75, 82, 179, 71, 235, 249, 107, 119, 252, 149, 116, 118,
-			241, 30, 36, 231, 23, 50, 203, 153, 141, 71, 212, 224, 157, 204,
-			198, 42, 54, 118, 103, 45, 43, 252, 121, 127, 125, 62, 187, 145,
-			89, 220, 92, 158, 207, 250, 235, 155, 217, 245, 181, 92, 218, 199,
-			158, 45, 101, 114, 139, 203, 243, 153, 149, 244, 82, 10, 218, 135,
-			54, 253, 244, 131, 244, 234, 134, 159, 187, 55, 191, 188, 28, 238,
-			168, 240, 215, 30, 174, 166, 179, 136, 189, 221, 77, 127, 33, 13,
-			88, 206, 47, 44, 167, 177, 41, 234, 231, 82, 38, 155, 94, 220,
-			192, 14, 153, 95, 139, 64, 60, 64, 112, 57, 41, 252, 220, 122,
-			122, 49, 3, 191, 128, 30, 105, 232, 206, 124, 246, 81, 82, 2,
-			205, 165, 223, 221, 132, 82, 240, 209, 95, 154, 95, 153, 191, 11,
-			189, 155, 254, 52, 170, 192, 192, 44, 110, 102, 211, 43, 136, 53,
-			144, 34, 183, 185, 144, 219, 200, 108, 108, 110, 164, 253, 187, 107,
-			107, 75, 68, 236, 92, 58, 251, 32, 179, 152, 206, 189, 238, 47,
-			175, 229, 136, 96, 155, 185, 52, 32, 178, 52, 191, 49, 79, 77,
-			3, 12, 32, 23, 124, 135, 223, 11, 155, 185, 12, 17, 46, 179,
-			186, 145, 206, 102, 55, 215, 55, 50, 107, 171, 51, 48, 202, 15,
-			129, 50, 128, 229, 60, 212, 93, 34, 10, 175, 173, 98, 111, 113,
-			174, 164, 215, 178, 143, 16, 44, 210, 129, 70, 32, 233, 63, 188,
-			151, 134, 252, 44, 18, 149, 168, 53, 143, 100, 200, 1, 213, 22,
-			55, 236, 98, 208, 32, 16, 17, 186, 100, 250, 233, 175, 166, 239,
-			46, 103, 238, 166, 87, 23, 211, 248, 121, 13, 193, 60, 204, 228,
-			210, 51, 48, 96, 153, 28, 22, 200, 80, 195, 48, 7, 160, 209,
-			77, 234, 53, 14, 20, 224, 37, 248, 183, 53, 117, 147, 52, 158,
-			126, 230, 142, 63, 191, 244, 32, 131, 152, 203, 210, 48, 3, 114,
-			25, 57, 93, 136, 108, 139, 247, 36, 205, 97, 250, 119, 10, 199,
-			141, 71, 252, 206, 81, 252, 213, 25, 143, 76, 182, 189, 46, 186,
-			133, 215, 249, 199, 29, 109, 156, 232, 17, 237, 152, 128, 66, 147,
-			29, 163, 162, 87, 68, 41, 213, 198, 201, 62, 209, 193, 73, 135,
-			211, 178, 112, 7, 164, 18, 183, 37, 196, 243, 109, 103, 37, 68,
-			135, 19, 92, 8, 155, 61, 175, 33, 58, 8, 241, 188, 134, 232,
-			16, 196, 243, 26, 162, 19, 129, 84, 98, 66, 66, 188, 208, 150,
-			148, 16, 93, 78, 112, 33, 23, 83, 29, 131, 18, 162, 139, 16,
-			49, 201, 16, 93, 130, 136, 105, 89, 24, 112, 188, 48, 114, 69,
-			66, 188, 216, 118, 85, 66, 140, 112, 130, 11, 69, 0, 226, 197,
-			142, 113, 9, 49, 130, 16, 49, 201, 16, 35, 4, 17, 211, 178,
-			48, 64, 188, 56, 145, 146, 16, 47, 181, 77, 74, 136, 30, 39,
-			184, 144, 7, 16, 47, 117, 36, 36, 68, 15, 33, 98, 146, 33,
-			122, 4, 17, 211, 178, 48, 32, 115, 233, 204, 57, 9, 113, 74,
-			247, 186, 157, 19, 92, 168, 29, 32, 78, 117, 92, 144, 16, 219,
-			17, 34, 38, 25, 98, 59, 65, 196, 180, 44, 12, 16, 167, 166,
-			84, 175, 167, 219, 206, 73, 136, 81, 78, 112, 161, 40, 64, 156,
-			238, 24, 147, 16, 163, 8, 17, 147, 12, 49, 74, 16, 49, 45,
-			11, 67, 175, 167, 199, 125, 241, 131, 49, 225, 122, 109, 113, 47,
-			15, 50, 74, 226, 251, 98, 192, 134, 180, 196, 67, 59, 25, 200,
-			18, 21, 224, 218, 121, 127, 191, 10, 34, 9, 237, 63, 240, 29,
-			254, 45, 6, 251, 192, 230, 225, 35, 238, 8, 249, 202, 33, 231,
-			127, 82, 173, 192, 54, 3, 60, 187, 144, 47, 195, 231, 124, 45,
-			105, 160, 0, 211, 207, 3, 179, 103, 49, 140, 246, 185, 237, 90,
-			190, 96, 118, 115, 245, 1, 55, 107, 148, 201, 40, 141, 210, 76,
-			181, 204, 194, 8, 52, 190, 185, 177, 232, 167, 247, 171, 133, 93,
-			106, 14, 24, 34, 160, 84, 135, 253, 6, 101, 0, 148, 84, 112,
-			191, 164, 157, 14, 4, 172, 114, 176, 15, 91, 173, 127, 183, 22,
-			236, 84, 97, 71, 172, 248, 139, 18, 39, 255, 96, 183, 4, 16,
-			130, 143, 97, 83, 129, 6, 113, 111, 51, 133, 20, 226, 194, 223,
-			202, 23, 158, 193, 182, 132, 37, 170, 254, 97, 0, 245, 160, 115,
-			173, 77, 230, 235, 245, 230, 30, 182, 154, 7, 1, 12, 126, 52,
-			27, 1, 73, 47, 254, 205, 57, 161, 187, 84, 174, 86, 118, 146,
-			126, 41, 5, 181, 203, 65, 126, 223, 116, 21, 202, 77, 214, 247,
-			0, 116, 80, 156, 132, 109, 144, 133, 162, 74, 213, 46, 5, 123,
-			100, 126, 11, 182, 98, 104, 179, 18, 4, 216, 36, 136, 26, 44,
-			30, 238, 163, 188, 67, 91, 185, 159, 37, 65, 17, 202, 240, 182,
-			58, 55, 55, 119, 109, 150, 254, 219, 152, 155, 187, 77, 255, 61,
-			198, 94, 220, 130, 63, 179, 215, 174, 207, 222, 184, 182, 113, 253,
-			198, 237, 87, 111, 193, 127, 169, 91, 234, 207, 99, 144, 8, 22,
-			14, 145, 224, 176, 177, 195, 184, 32, 41, 37, 74, 53, 4, 15,
-			146, 94, 0, 253, 174, 55, 107, 1, 231, 66, 178, 128, 20, 171,
-			86, 158, 7, 53, 16, 230, 170, 66, 142, 106, 117, 207, 247, 179,
-			119, 22, 253, 27, 55, 110, 220, 66, 113, 54, 240, 17, 100, 101,
-			167, 14, 45, 228, 130, 192, 127, 79, 201, 165, 7, 7, 7, 169,
-			82, 208, 216, 78, 85, 107, 59, 87, 107, 219, 5, 252, 31, 43,
-			165, 26, 31, 55, 62, 152, 254, 44, 165, 102, 80, 20, 72, 127,
-			12, 211, 21, 104, 116, 237, 54, 200, 200, 123, 251, 48, 6, 214,
-			44, 38, 116, 128, 151, 102, 190, 224, 63, 197, 73, 51, 61, 243,
-			52, 37, 165, 74, 83, 72, 31, 46, 94, 231, 47, 230, 176, 81,
-			15, 26, 79, 228, 120, 77, 83, 245, 213, 205, 229, 229, 153, 153,
-			99, 203, 209, 180, 157, 158, 131, 143, 6, 167, 235, 159, 134, 211,
-			78, 208, 64, 40, 213, 237, 98, 254, 208, 194, 13, 40, 6, 242,
-			18, 53, 240, 60, 95, 246, 27, 207, 101, 139, 161, 226, 151, 26,
-			207, 147, 62, 33, 244, 250, 183, 218, 165, 231, 169, 198, 115, 76,
-			189, 172, 71, 92, 8, 164, 187, 2, 136, 139, 215, 96, 114, 133,
-			122, 120, 227, 196, 30, 62, 44, 85, 110, 92, 247, 159, 222, 13,
-			26, 185, 195, 122, 35, 216, 195, 207, 243, 245, 59, 165, 114, 176,
-			17, 30, 136, 59, 153, 229, 244, 6, 136, 56, 254, 118, 67, 162,
-			113, 82, 157, 75, 219, 13, 133, 233, 38, 108, 255, 55, 95, 1,
-			132, 11, 207, 234, 254, 155, 254, 244, 244, 52, 231, 204, 108, 55,
-			82, 197, 131, 123, 32, 109, 47, 193, 212, 195, 90, 51, 254, 27,
-			111, 248, 55, 174, 207, 248, 223, 227, 211, 183, 229, 234, 129, 250,
-			164, 232, 118, 245, 42, 176, 62, 192, 183, 88, 61, 168, 19, 72,
-			92, 76, 208, 85, 139, 21, 213, 83, 186, 64, 64, 44, 232, 218,
-			205, 163, 171, 76, 67, 195, 234, 215, 110, 190, 242, 202, 43, 159,
-			187, 113, 19, 192, 168, 37, 191, 21, 192, 242, 13, 252, 205, 74,
-			233, 99, 5, 229, 214, 231, 230, 90, 161, 164, 190, 181, 193, 156,
-			230, 254, 3, 41, 152, 40, 87, 105, 176, 240, 207, 12, 28, 48,
-			45, 116, 62, 101, 6, 35, 28, 36, 151, 130, 115, 209, 130, 67,
-			19, 96, 38, 52, 1, 94, 57, 113, 2, 220, 207, 63, 207, 251,
-			79, 121, 32, 83, 133, 102, 173, 6, 219, 0, 22, 89, 41, 149,
-			225, 232, 99, 77, 0, 228, 144, 192, 62, 49, 23, 134, 242, 228,
-			10, 47, 153, 230, 80, 79, 231, 166, 42, 193, 193, 66, 179, 84,
-			134, 195, 198, 244, 12, 118, 44, 39, 41, 36, 155, 96, 194, 204,
-			48, 44, 252, 131, 101, 86, 185, 239, 192, 90, 177, 231, 178, 36,
-			119, 93, 118, 155, 40, 48, 147, 218, 66, 200, 132, 139, 161, 193,
-			171, 39, 210, 64, 246, 66, 237, 155, 254, 250, 33, 28, 114, 42,
-			170, 227, 149, 234, 1, 32, 78, 155, 25, 51, 39, 185, 250, 229,
-			116, 121, 19, 57, 253, 52, 20, 146, 249, 52, 62, 50, 23, 179,
-			97, 88, 101, 81, 70, 241, 242, 229, 91, 51, 45, 227, 106, 211,
-			101, 90, 22, 126, 83, 254, 155, 100, 128, 111, 210, 223, 51, 130,
-			254, 68, 60, 148, 20, 242, 157, 3, 226, 103, 29, 225, 121, 36,
-			51, 110, 187, 67, 137, 31, 117, 224, 248, 171, 5, 2, 133, 32,
-			108, 220, 184, 39, 83, 231, 96, 247, 45, 216, 83, 91, 28, 63,
-			183, 253, 21, 60, 38, 195, 113, 145, 200, 67, 127, 157, 176, 95,
-			137, 227, 54, 172, 199, 208, 249, 66, 25, 182, 250, 231, 1, 16,
-			177, 87, 180, 35, 138, 94, 220, 219, 118, 243, 36, 36, 98, 178,
-			29, 81, 238, 80, 41, 232, 206, 118, 103, 191, 74, 129, 40, 181,
-			29, 31, 20, 255, 43, 119, 14, 190, 149, 221, 120, 226, 159, 57,
-			254, 106, 181, 50, 91, 9, 118, 96, 67, 125, 30, 132, 37, 147,
-			188, 236, 173, 143, 155, 243, 113, 146, 73, 10, 206, 208, 92, 81,
-			237, 249, 62, 240, 236, 38, 72, 2, 116, 244, 54, 192, 72, 65,
-			80, 111, 192, 212, 242, 119, 243, 208, 76, 197, 110, 147, 199, 150,
-			43, 10, 222, 97, 11, 213, 38, 204, 28, 224, 24, 40, 135, 40,
-			225, 171, 133, 128, 115, 114, 99, 79, 202, 255, 197, 49, 244, 113,
-			128, 62, 101, 119, 123, 72, 210, 192, 105, 199, 94, 43, 250, 56,
-			72, 131, 206, 94, 149, 2, 250, 148, 99, 3, 91, 81, 210, 14,
-			221, 16, 127, 127, 88, 140, 183, 170, 226, 130, 189, 253, 198, 225,
-			73, 106, 184, 14, 209, 158, 198, 239, 11, 235, 199, 171, 212, 4,
-			125, 253, 150, 212, 105, 191, 52, 196, 234, 180, 27, 177, 239, 170,
-			211, 190, 171, 78, 251, 174, 58, 237, 187, 234, 180, 239, 170, 211,
-			190, 171, 78, 251, 78, 81, 167, 37, 109, 117, 90, 50, 164, 78,
-			27, 12, 171, 211, 6, 91, 212, 105, 74, 249, 229, 0, 196, 243,
-			90, 249, 117, 161, 237, 138, 173, 78, 187, 18, 82, 167, 141, 135,
-			213, 105, 227, 45, 234, 180, 113, 91, 157, 54, 113, 89, 171, 211,
-			38, 109, 117, 218, 100, 72, 157, 150, 8, 171, 211, 18, 45, 234,
-			52, 165, 252, 138, 96, 85, 173, 252, 186, 164, 123, 237, 113, 194,
-			86, 167, 93, 8, 171, 211, 46, 180, 168, 211, 46, 216, 234, 52,
-			173, 252, 154, 210, 202, 175, 118, 78, 216, 234, 180, 177, 176, 58,
-			109, 172, 69, 157, 166, 148, 95, 237, 208, 235, 169, 113, 95, 171,
-			211, 206, 218, 234, 180, 179, 33, 117, 218, 104, 88, 157, 54, 218,
-			162, 78, 83, 99, 29, 5, 28, 167, 19, 19, 226, 63, 184, 164,
-			78, 139, 220, 104, 139, 37, 254, 212, 5, 46, 180, 19, 84, 128,
-			67, 23, 124, 18, 153, 96, 11, 174, 215, 243, 59, 82, 125, 114,
-			88, 109, 146, 254, 164, 22, 204, 162, 76, 0, 140, 62, 255, 188,
-			90, 42, 2, 75, 223, 46, 85, 104, 167, 106, 238, 151, 113, 223,
-			15, 138, 34, 92, 159, 118, 74, 168, 94, 243, 231, 215, 51, 112,
-			44, 157, 247, 27, 135, 251, 80, 178, 236, 7, 242, 56, 82, 34,
-			165, 21, 137, 26, 13, 212, 186, 225, 134, 83, 11, 62, 2, 201,
-			178, 65, 234, 57, 78, 215, 247, 97, 139, 12, 176, 114, 192, 90,
-			60, 132, 135, 114, 194, 110, 181, 152, 242, 239, 144, 158, 9, 206,
-			13, 32, 213, 43, 193, 1, 69, 33, 216, 111, 225, 91, 213, 255,
-			146, 58, 62, 213, 246, 11, 254, 66, 190, 54, 221, 34, 233, 165,
-			72, 208, 155, 65, 49, 162, 89, 131, 173, 248, 132, 239, 242, 28,
-			250, 2, 247, 32, 192, 234, 126, 14, 184, 140, 214, 25, 242, 142,
-			140, 26, 175, 167, 84, 250, 41, 233, 222, 136, 22, 84, 176, 186,
-			245, 197, 160, 208, 240, 159, 126, 233, 5, 30, 37, 213, 225, 229,
-			70, 103, 175, 150, 95, 127, 104, 86, 220, 222, 129, 141, 176, 185,
-			69, 66, 90, 185, 89, 40, 209, 95, 179, 59, 213, 171, 144, 177,
-			87, 173, 92, 205, 239, 151, 174, 194, 121, 115, 191, 12, 164, 214,
-			63, 164, 120, 219, 169, 210, 137, 151, 73, 193, 147, 127, 230, 136,
-			246, 7, 40, 185, 199, 227, 34, 2, 194, 38, 95, 58, 223, 107,
-			203, 98, 34, 62, 36, 188, 38, 102, 226, 141, 179, 7, 153, 148,
-			138, 143, 136, 246, 237, 114, 53, 223, 24, 139, 64, 182, 3, 217,
-			156, 196, 210, 91, 213, 106, 121, 204, 131, 236, 78, 44, 141, 41,
-			132, 11, 194, 198, 88, 59, 100, 118, 33, 92, 72, 32, 132, 173,
-			195, 70, 80, 31, 139, 66, 110, 15, 66, 160, 100, 124, 76, 68,
-			153, 48, 99, 29, 178, 184, 76, 99, 141, 124, 173, 150, 63, 28,
-			235, 148, 31, 56, 25, 79, 10, 175, 210, 44, 151, 199, 186, 32,
-			187, 251, 250, 72, 171, 208, 206, 67, 133, 184, 96, 169, 5, 16,
-			241, 233, 152, 50, 249, 231, 158, 136, 230, 10, 187, 193, 94, 62,
-			62, 99, 250, 221, 125, 125, 56, 165, 201, 200, 159, 83, 243, 141,
-			234, 158, 34, 199, 21, 139, 28, 47, 41, 203, 84, 154, 181, 169,
-			244, 146, 210, 146, 120, 87, 44, 226, 189, 12, 54, 209, 116, 214,
-			208, 180, 251, 250, 169, 35, 101, 115, 184, 96, 96, 242, 43, 114,
-			95, 179, 201, 253, 41, 21, 228, 72, 92, 22, 94, 80, 105, 238,
-			209, 56, 116, 95, 31, 58, 166, 70, 3, 177, 193, 50, 241, 171,
-			122, 212, 58, 79, 64, 30, 167, 188, 53, 152, 179, 106, 48, 187,
-			94, 94, 158, 75, 37, 118, 69, 4, 154, 139, 207, 137, 118, 88,
-			88, 181, 67, 24, 170, 8, 212, 74, 28, 135, 83, 42, 141, 37,
-			178, 92, 48, 113, 21, 78, 116, 248, 35, 30, 19, 17, 16, 149,
-			105, 140, 187, 178, 248, 19, 141, 41, 26, 213, 103, 65, 133, 198,
-			178, 43, 203, 137, 196, 69, 225, 97, 211, 241, 51, 66, 236, 229,
-			63, 126, 82, 14, 42, 59, 141, 93, 170, 214, 155, 237, 130, 156,
-			101, 202, 72, 204, 136, 78, 69, 177, 79, 43, 26, 21, 30, 142,
-			220, 66, 167, 136, 214, 9, 203, 201, 127, 19, 17, 30, 106, 5,
-			227, 175, 9, 189, 70, 101, 151, 78, 155, 46, 97, 137, 212, 134,
-			76, 113, 167, 204, 138, 254, 239, 93, 209, 169, 190, 29, 211, 183,
-			56, 78, 165, 226, 161, 236, 26, 253, 134, 198, 218, 247, 243, 181,
-			252, 30, 204, 70, 108, 105, 242, 132, 150, 82, 235, 88, 72, 18,
-			145, 42, 36, 254, 35, 71, 116, 81, 110, 0, 7, 138, 99, 90,
-			155, 17, 29, 192, 248, 243, 205, 178, 90, 23, 253, 6, 54, 113,
-			150, 172, 250, 30, 79, 136, 78, 92, 134, 120, 45, 64, 171, 162,
-			51, 171, 211, 241, 105, 69, 32, 185, 2, 98, 173, 195, 155, 149,
-			223, 19, 239, 9, 97, 176, 68, 132, 158, 5, 135, 10, 33, 248,
-			25, 255, 156, 92, 228, 18, 157, 115, 47, 237, 42, 118, 42, 203,
-			229, 111, 187, 175, 57, 137, 13, 209, 27, 162, 250, 49, 240, 103,
-			195, 240, 71, 79, 128, 111, 65, 157, 252, 47, 129, 134, 57, 62,
-			204, 0, 13, 207, 139, 94, 85, 229, 9, 30, 166, 36, 240, 30,
-			149, 185, 10, 121, 208, 139, 40, 209, 31, 205, 125, 112, 196, 206,
-			90, 244, 80, 144, 184, 11, 117, 30, 46, 89, 60, 113, 95, 116,
-			91, 217, 199, 224, 127, 49, 140, 255, 145, 225, 50, 120, 223, 255,
-			154, 143, 122, 11, 175, 237, 35, 71, 252, 178, 67, 122, 11, 144,
-			17, 174, 255, 180, 19, 82, 65, 92, 187, 73, 251, 223, 226, 46,
-			156, 228, 74, 205, 61, 127, 190, 9, 219, 112, 13, 119, 247, 99,
-			117, 17, 155, 117, 218, 179, 229, 137, 207, 156, 220, 33, 185, 3,
-			103, 228, 90, 5, 14, 133, 91, 135, 126, 222, 95, 200, 45, 205,
-			214, 27, 135, 32, 20, 128, 48, 17, 208, 126, 79, 170, 37, 216,
-			237, 183, 224, 12, 187, 93, 109, 86, 138, 234, 32, 188, 12, 135,
-			6, 56, 147, 248, 219, 56, 2, 66, 116, 9, 55, 2, 194, 76,
-			20, 68, 49, 22, 144, 59, 59, 99, 98, 155, 47, 12, 123, 218,
-			198, 157, 196, 99, 159, 250, 202, 34, 11, 94, 124, 153, 179, 38,
-			245, 158, 118, 238, 188, 191, 175, 166, 72, 146, 206, 227, 128, 34,
-			29, 109, 1, 11, 53, 202, 32, 224, 124, 188, 159, 175, 224, 97,
-			148, 117, 92, 102, 51, 239, 33, 213, 20, 80, 175, 19, 90, 237,
-			117, 19, 17, 86, 84, 117, 226, 183, 94, 248, 214, 173, 148, 148,
-			125, 222, 176, 165, 255, 235, 243, 186, 44, 253, 95, 95, 119, 159,
-			165, 255, 235, 27, 28, 146, 213, 224, 83, 191, 174, 134, 106, 177,
-			126, 79, 88, 106, 177, 254, 238, 126, 75, 45, 214, 175, 171, 1,
-			41, 98, 186, 154, 219, 142, 41, 85, 13, 65, 198, 186, 99, 42,
-			5, 213, 98, 186, 26, 36, 6, 116, 181, 72, 59, 166, 58, 85,
-			10, 170, 13, 232, 214, 80, 136, 30, 208, 213, 64, 116, 30, 212,
-			213, 188, 118, 76, 169, 214, 60, 168, 54, 168, 251, 134, 146, 242,
-			160, 174, 6, 5, 135, 116, 181, 118, 74, 41, 146, 180, 67, 181,
-			33, 141, 36, 222, 46, 15, 233, 106, 32, 4, 143, 120, 35, 242,
-			83, 180, 29, 83, 170, 181, 40, 84, 27, 233, 30, 80, 41, 168,
-			54, 50, 52, 44, 171, 129, 72, 61, 170, 171, 161, 108, 62, 170,
-			171, 225, 137, 103, 84, 183, 134, 103, 139, 81, 93, 13, 228, 239,
-			83, 222, 121, 249, 169, 51, 138, 169, 83, 42, 5, 213, 78, 129,
-			76, 45, 83, 80, 237, 212, 185, 73, 152, 22, 216, 105, 239, 76,
-			219, 29, 135, 166, 8, 14, 211, 153, 206, 62, 154, 34, 14, 204,
-			86, 111, 194, 189, 196, 83, 4, 82, 240, 109, 2, 4, 248, 62,
-			17, 197, 20, 126, 61, 235, 157, 111, 71, 25, 158, 211, 240, 253,
-			108, 207, 128, 184, 47, 58, 57, 141, 211, 232, 92, 116, 56, 113,
-			203, 95, 201, 215, 158, 21, 171, 7, 149, 89, 214, 22, 162, 174,
-			45, 172, 37, 218, 38, 41, 26, 23, 31, 177, 83, 159, 182, 74,
-			152, 182, 3, 162, 75, 193, 242, 226, 222, 185, 232, 217, 65, 17,
-			51, 89, 237, 8, 191, 199, 206, 1, 20, 206, 245, 198, 236, 28,
-			232, 232, 57, 24, 141, 126, 141, 20, 158, 42, 163, 163, 22, 100,
-			84, 231, 78, 70, 207, 13, 91, 181, 112, 238, 78, 134, 32, 35,
-			97, 38, 123, 227, 118, 14, 64, 158, 28, 30, 17, 247, 152, 28,
-			116, 142, 243, 206, 64, 103, 9, 119, 210, 158, 241, 73, 97, 191,
-			90, 175, 151, 240, 210, 153, 182, 243, 186, 82, 46, 98, 103, 3,
-			230, 28, 168, 189, 134, 206, 74, 66, 98, 87, 1, 84, 143, 73,
-			195, 64, 94, 132, 182, 117, 26, 143, 136, 131, 99, 38, 141, 135,
-			196, 241, 211, 114, 208, 96, 56, 167, 221, 148, 26, 52, 196, 123,
-			26, 74, 126, 64, 88, 210, 137, 248, 138, 151, 72, 172, 234, 139,
-			105, 198, 17, 100, 131, 210, 30, 176, 201, 252, 30, 233, 200, 129,
-			21, 146, 196, 197, 184, 230, 37, 95, 210, 131, 4, 108, 190, 92,
-			68, 189, 26, 94, 167, 63, 67, 212, 251, 9, 21, 135, 70, 233,
-			138, 55, 29, 147, 184, 57, 52, 70, 87, 60, 97, 210, 128, 207,
-			149, 238, 97, 147, 6, 220, 175, 140, 157, 146, 184, 187, 113, 111,
-			206, 125, 69, 225, 142, 67, 53, 39, 226, 18, 119, 58, 123, 223,
-			248, 11, 195, 221, 37, 220, 111, 120, 115, 67, 18, 55, 151, 112,
-			191, 161, 113, 231, 195, 254, 13, 141, 187, 75, 184, 223, 0, 220,
-			187, 9, 119, 72, 220, 116, 7, 36, 234, 200, 128, 110, 10, 185,
-			142, 144, 213, 190, 230, 166, 101, 183, 136, 213, 190, 6, 107, 140,
-			170, 97, 167, 110, 121, 178, 26, 141, 244, 45, 201, 197, 152, 86,
-			183, 186, 122, 84, 10, 26, 184, 213, 31, 147, 213, 224, 211, 109,
-			47, 46, 63, 57, 81, 76, 169, 106, 56, 228, 183, 187, 122, 85,
-			10, 170, 221, 142, 13, 200, 106, 192, 106, 95, 247, 6, 229, 39,
-			100, 78, 175, 235, 106, 8, 242, 245, 174, 62, 149, 130, 106, 175,
-			15, 196, 101, 53, 72, 188, 161, 91, 139, 68, 49, 165, 170, 97,
-			79, 223, 208, 173, 33, 21, 222, 208, 173, 193, 60, 126, 75, 242,
-			76, 72, 68, 49, 165, 122, 131, 172, 246, 173, 222, 152, 74, 65,
-			181, 183, 36, 207, 116, 144, 213, 190, 237, 141, 202, 79, 237, 81,
-			76, 169, 106, 200, 106, 223, 238, 85, 152, 32, 171, 125, 27, 150,
-			224, 20, 85, 131, 130, 243, 222, 192, 100, 2, 22, 220, 126, 19,
-			183, 65, 169, 92, 166, 9, 240, 36, 215, 168, 9, 89, 45, 74,
-			37, 59, 84, 10, 64, 206, 119, 170, 6, 144, 13, 207, 107, 42,
-			3, 27, 94, 244, 134, 228, 39, 84, 104, 44, 234, 126, 35, 27,
-			94, 236, 234, 87, 41, 168, 182, 24, 31, 148, 213, 128, 13, 47,
-			105, 42, 35, 27, 94, 210, 213, 144, 13, 47, 105, 42, 35, 27,
-			94, 2, 42, 47, 0, 27, 134, 185, 159, 105, 43, 59, 137, 155,
-			62, 74, 108, 97, 115, 33, 148, 32, 252, 109, 144, 74, 73, 72,
-			145, 187, 188, 84, 109, 144, 102, 94, 238, 240, 56, 134, 25, 232,
-			203, 13, 64, 3, 133, 13, 239, 29, 247, 139, 145, 196, 69, 45,
-			24, 216, 162, 69, 169, 130, 74, 116, 45, 102, 49, 113, 92, 226,
-			226, 239, 192, 162, 67, 182, 230, 50, 91, 91, 241, 6, 191, 29,
-			30, 142, 235, 203, 149, 28, 124, 197, 123, 135, 215, 151, 43, 249,
-			247, 138, 92, 95, 174, 228, 107, 43, 176, 253, 234, 52, 80, 103,
-			5, 168, 243, 127, 58, 18, 23, 248, 158, 243, 134, 18, 127, 234,
-			248, 120, 124, 32, 181, 12, 173, 127, 144, 183, 88, 127, 209, 108,
-			236, 55, 27, 6, 17, 221, 57, 52, 51, 162, 251, 15, 186, 39,
-			228, 27, 140, 218, 158, 64, 106, 130, 100, 85, 42, 250, 95, 172,
-			227, 109, 208, 94, 181, 216, 44, 87, 233, 123, 189, 185, 85, 111,
-			148, 26, 124, 209, 164, 37, 46, 144, 31, 51, 21, 191, 90, 43,
-			6, 53, 221, 138, 48, 212, 109, 84, 241, 162, 129, 32, 38, 25,
-			69, 106, 20, 170, 131, 156, 152, 175, 203, 182, 16, 85, 16, 221,
-			182, 1, 30, 218, 57, 137, 80, 91, 117, 70, 113, 43, 8, 42,
-			126, 126, 127, 191, 92, 66, 241, 84, 147, 16, 183, 170, 156, 183,
-			50, 168, 73, 132, 27, 85, 206, 34, 33, 174, 253, 28, 8, 62,
-			58, 13, 36, 204, 197, 7, 105, 207, 118, 121, 207, 222, 244, 242,
-			237, 234, 59, 141, 246, 102, 207, 48, 237, 217, 174, 218, 179, 31,
-			126, 251, 123, 182, 171, 247, 236, 135, 209, 205, 81, 218, 53, 93,
-			189, 103, 63, 148, 59, 171, 171, 247, 236, 135, 114, 207, 118, 245,
-			158, 253, 80, 238, 217, 174, 218, 179, 31, 69, 199, 44, 200, 72,
-			136, 71, 209, 135, 195, 86, 45, 100, 130, 143, 162, 221, 118, 14,
-			86, 235, 25, 180, 115, 0, 242, 163, 145, 81, 241, 79, 28, 13,
-			26, 56, 226, 135, 209, 177, 196, 223, 118, 124, 117, 234, 67, 115,
-			60, 82, 20, 214, 253, 210, 182, 63, 133, 217, 83, 56, 215, 242,
-			114, 252, 158, 135, 55, 20, 61, 57, 82, 112, 226, 192, 233, 192,
-			103, 1, 125, 223, 132, 215, 90, 176, 214, 154, 165, 250, 174, 31,
-			164, 118, 82, 254, 228, 36, 95, 53, 87, 170, 141, 217, 122, 83,
-			142, 177, 159, 217, 246, 229, 137, 212, 71, 32, 196, 180, 190, 132,
-			109, 223, 246, 191, 244, 226, 133, 188, 146, 219, 147, 87, 213, 141,
-			90, 51, 8, 81, 26, 88, 173, 247, 97, 244, 209, 152, 213, 87,
-			100, 163, 31, 70, 133, 157, 3, 244, 248, 176, 219, 166, 7, 50,
-			246, 15, 129, 30, 134, 210, 144, 241, 52, 68, 233, 8, 64, 126,
-			26, 253, 208, 134, 140, 27, 192, 211, 208, 24, 226, 38, 240, 180,
-			215, 134, 140, 27, 193, 83, 128, 252, 159, 187, 114, 237, 2, 157,
-			75, 222, 197, 196, 79, 186, 76, 48, 38, 104, 153, 105, 67, 234,
-			82, 201, 220, 80, 96, 82, 20, 45, 125, 130, 199, 174, 82, 163,
-			174, 78, 84, 122, 165, 225, 226, 74, 177, 138, 19, 78, 143, 76,
-			23, 152, 164, 133, 93, 169, 139, 221, 9, 62, 246, 175, 190, 127,
-			225, 75, 239, 125, 248, 226, 131, 43, 47, 174, 166, 252, 92, 213,
-			159, 188, 240, 165, 237, 106, 245, 197, 164, 127, 80, 109, 130, 8,
-			0, 100, 172, 62, 131, 101, 138, 151, 194, 147, 240, 97, 50, 9,
-			37, 248, 95, 24, 90, 40, 172, 75, 194, 64, 225, 157, 30, 31,
-			101, 205, 205, 229, 110, 80, 11, 244, 136, 72, 102, 141, 136, 46,
-			224, 194, 7, 209, 163, 28, 228, 241, 2, 21, 173, 56, 74, 146,
-			105, 8, 57, 109, 130, 99, 121, 134, 181, 208, 113, 60, 75, 214,
-			66, 165, 189, 186, 228, 37, 76, 26, 40, 94, 26, 247, 77, 26,
-			232, 93, 58, 127, 129, 54, 32, 146, 148, 158, 185, 23, 201, 92,
-			129, 229, 154, 103, 176, 15, 48, 131, 39, 25, 227, 153, 59, 170,
-			82, 0, 231, 217, 152, 175, 82, 0, 229, 25, 64, 193, 99, 65,
-			36, 238, 85, 240, 140, 141, 251, 10, 142, 111, 165, 147, 183, 117,
-			186, 177, 168, 186, 227, 4, 61, 66, 208, 171, 110, 133, 119, 187,
-			8, 173, 239, 170, 219, 169, 82, 80, 175, 218, 53, 162, 82, 0,
-			189, 122, 42, 33, 161, 192, 167, 125, 215, 151, 80, 112, 61, 239,
-			187, 213, 113, 89, 18, 215, 242, 190, 59, 164, 82, 88, 116, 88,
-			127, 3, 40, 251, 19, 103, 181, 74, 250, 239, 128, 144, 130, 58,
-			247, 186, 212, 49, 187, 197, 189, 196, 167, 185, 59, 189, 92, 253,
-			252, 182, 136, 175, 192, 58, 44, 173, 215, 208, 42, 161, 113, 200,
-			170, 232, 25, 17, 101, 107, 18, 169, 23, 27, 72, 21, 247, 82,
-			161, 34, 89, 89, 96, 242, 247, 28, 209, 27, 174, 44, 245, 205,
-			142, 173, 111, 134, 188, 98, 158, 245, 83, 168, 109, 198, 4, 230,
-			161, 130, 51, 162, 244, 221, 168, 201, 132, 188, 173, 82, 133, 244,
-			170, 168, 192, 198, 68, 124, 78, 120, 216, 37, 169, 59, 77, 28,
-			209, 48, 107, 27, 37, 212, 135, 98, 73, 173, 147, 238, 248, 166,
-			116, 210, 247, 189, 78, 47, 214, 62, 249, 215, 29, 209, 61, 223,
-			192, 237, 180, 177, 12, 75, 54, 62, 37, 220, 70, 85, 234, 128,
-			70, 145, 14, 214, 199, 212, 70, 149, 117, 63, 80, 4, 206, 155,
-			222, 106, 115, 175, 30, 31, 193, 214, 247, 152, 116, 189, 11, 110,
-			204, 201, 82, 58, 241, 142, 232, 144, 197, 143, 209, 9, 93, 14,
-			235, 132, 134, 90, 27, 66, 200, 182, 98, 232, 103, 251, 88, 49,
-			116, 227, 59, 85, 49, 52, 77, 63, 97, 182, 119, 104, 29, 81,
-			87, 167, 160, 213, 8, 27, 119, 119, 91, 175, 163, 245, 56, 221,
-			157, 9, 163, 171, 233, 113, 47, 40, 125, 140, 135, 41, 165, 42,
-			192, 165, 222, 211, 61, 102, 105, 110, 122, 78, 157, 181, 52, 55,
-			61, 147, 231, 149, 6, 160, 191, 109, 210, 104, 0, 250, 59, 135,
-			197, 143, 184, 152, 232, 66, 205, 70, 111, 226, 235, 174, 162, 137,
-			255, 10, 177, 48, 186, 30, 168, 243, 142, 135, 180, 168, 74, 11,
-			154, 173, 160, 144, 199, 123, 178, 224, 163, 38, 112, 180, 198, 225,
-			108, 161, 186, 7, 124, 179, 4, 226, 21, 9, 227, 66, 214, 36,
-			3, 108, 152, 121, 181, 82, 181, 89, 47, 31, 194, 38, 185, 189,
-			93, 42, 192, 162, 35, 1, 11, 105, 68, 146, 109, 190, 236, 151,
-			240, 6, 206, 8, 29, 48, 22, 75, 43, 73, 100, 198, 135, 0,
-			72, 90, 190, 3, 110, 80, 85, 217, 135, 113, 3, 7, 104, 22,
-			182, 133, 123, 1, 106, 247, 113, 135, 189, 7, 11, 18, 101, 232,
-			67, 132, 127, 56, 85, 67, 131, 49, 180, 189, 33, 59, 112, 214,
-			165, 29, 160, 245, 74, 101, 170, 65, 153, 200, 154, 113, 127, 174,
-			214, 200, 22, 104, 15, 70, 138, 196, 251, 174, 54, 36, 138, 60,
-			48, 116, 181, 57, 161, 148, 171, 82, 242, 220, 55, 226, 158, 179,
-			207, 125, 35, 82, 197, 70, 231, 190, 81, 125, 70, 104, 179, 52,
-			60, 124, 238, 27, 237, 238, 179, 206, 125, 163, 250, 36, 6, 159,
-			198, 116, 53, 148, 254, 198, 164, 26, 138, 207, 125, 99, 186, 26,
-			178, 201, 49, 93, 205, 69, 85, 144, 62, 247, 181, 99, 170, 203,
-			58, 247, 157, 234, 182, 207, 125, 167, 236, 115, 95, 66, 87, 67,
-			21, 91, 34, 116, 238, 75, 232, 106, 184, 221, 39, 160, 218, 143,
-			56, 234, 224, 55, 225, 77, 37, 190, 230, 144, 177, 164, 95, 223,
-			165, 125, 180, 90, 41, 27, 75, 28, 101, 247, 227, 3, 155, 170,
-			53, 174, 6, 64, 253, 16, 203, 196, 61, 95, 248, 119, 107, 249,
-			253, 221, 119, 155, 65, 237, 48, 23, 228, 107, 133, 93, 146, 147,
-			164, 86, 52, 160, 245, 68, 135, 163, 6, 217, 22, 237, 39, 241,
-			126, 86, 13, 124, 105, 167, 82, 173, 145, 244, 108, 206, 159, 19,
-			222, 25, 235, 252, 57, 49, 49, 105, 157, 63, 39, 46, 94, 50,
-			231, 79, 95, 106, 209, 248, 252, 233, 75, 45, 26, 159, 63, 125,
-			169, 69, 227, 243, 167, 127, 110, 82, 252, 93, 135, 207, 111, 83,
-			192, 122, 18, 191, 232, 248, 22, 147, 194, 137, 94, 174, 238, 224,
-			117, 114, 249, 48, 44, 241, 52, 43, 165, 143, 64, 144, 204, 115,
-			97, 180, 85, 35, 71, 138, 221, 60, 74, 70, 180, 110, 10, 48,
-			61, 91, 46, 111, 37, 7, 225, 187, 86, 144, 188, 131, 10, 172,
-			174, 42, 130, 83, 144, 247, 112, 235, 194, 91, 107, 5, 88, 138,
-			85, 112, 206, 64, 251, 41, 186, 181, 158, 174, 207, 88, 39, 198,
-			169, 206, 65, 154, 176, 116, 98, 156, 118, 47, 71, 172, 163, 32,
-			234, 142, 122, 205, 81, 112, 198, 187, 98, 142, 103, 30, 166, 123,
-			66, 199, 185, 153, 222, 193, 208, 113, 110, 102, 104, 44, 116, 156,
-			155, 25, 63, 109, 210, 112, 76, 158, 57, 115, 25, 100, 77, 33,
-			211, 100, 31, 50, 51, 49, 3, 199, 207, 110, 147, 229, 98, 222,
-			5, 1, 56, 90, 121, 109, 156, 57, 36, 122, 237, 76, 135, 115,
-			67, 213, 177, 213, 139, 51, 226, 27, 142, 146, 140, 174, 187, 227,
-			137, 191, 15, 51, 179, 202, 50, 40, 204, 27, 164, 16, 209, 165,
-			62, 219, 168, 206, 106, 186, 161, 240, 78, 126, 57, 62, 26, 165,
-			130, 4, 9, 199, 1, 52, 51, 56, 200, 163, 1, 178, 250, 8,
-			167, 72, 105, 213, 199, 245, 72, 152, 252, 158, 107, 40, 78, 226,
-			143, 235, 147, 196, 89, 38, 183, 242, 53, 202, 164, 157, 132, 229,
-			202, 114, 181, 250, 12, 134, 237, 89, 112, 27, 47, 237, 165, 1,
-			0, 137, 162, 183, 253, 247, 174, 37, 253, 235, 31, 36, 101, 30,
-			84, 166, 60, 206, 120, 33, 44, 201, 238, 186, 30, 47, 98, 247,
-			215, 221, 65, 75, 178, 187, 62, 52, 98, 73, 118, 215, 79, 37,
-			180, 212, 244, 51, 187, 34, 182, 131, 139, 235, 9, 90, 116, 126,
-			51, 162, 211, 183, 113, 255, 159, 176, 5, 181, 201, 255, 221, 19,
-			237, 239, 34, 213, 227, 167, 133, 91, 42, 202, 203, 238, 30, 220,
-			229, 41, 59, 149, 89, 202, 66, 62, 10, 6, 75, 171, 105, 18,
-			2, 58, 65, 74, 90, 77, 199, 39, 133, 135, 88, 203, 75, 236,
-			62, 83, 99, 9, 114, 179, 244, 45, 126, 67, 116, 170, 129, 28,
-			243, 140, 160, 194, 229, 228, 2, 149, 151, 84, 186, 32, 250, 185,
-			195, 110, 133, 251, 206, 88, 140, 154, 83, 201, 196, 144, 112, 51,
-			75, 241, 62, 141, 104, 23, 162, 150, 200, 11, 111, 41, 168, 23,
-			226, 55, 197, 168, 182, 94, 172, 214, 158, 20, 170, 149, 237, 210,
-			142, 125, 141, 54, 108, 125, 94, 164, 175, 116, 159, 118, 78, 244,
-			160, 230, 225, 137, 220, 205, 228, 229, 104, 55, 230, 173, 115, 86,
-			98, 87, 244, 168, 179, 2, 94, 177, 17, 138, 181, 42, 93, 108,
-			51, 104, 149, 68, 58, 213, 130, 109, 9, 3, 127, 98, 89, 16,
-			81, 240, 26, 136, 72, 5, 101, 101, 18, 111, 99, 9, 59, 143,
-			111, 99, 241, 119, 226, 71, 97, 133, 32, 1, 227, 175, 136, 142,
-			66, 45, 64, 5, 154, 28, 147, 151, 200, 151, 89, 85, 148, 6,
-			5, 104, 33, 133, 53, 123, 80, 32, 55, 75, 223, 226, 215, 68,
-			39, 154, 251, 55, 158, 108, 29, 202, 59, 223, 17, 83, 206, 238,
-			102, 182, 131, 202, 45, 28, 38, 238, 137, 222, 208, 104, 217, 114,
-			98, 47, 203, 137, 231, 194, 114, 98, 183, 37, 39, 218, 247, 157,
-			95, 239, 21, 29, 50, 59, 62, 97, 205, 184, 62, 171, 252, 201,
-			115, 238, 66, 104, 206, 197, 236, 58, 214, 172, 123, 93, 136, 224,
-			227, 160, 192, 170, 28, 57, 239, 198, 237, 178, 105, 253, 149, 231,
-			158, 85, 28, 228, 221, 206, 237, 131, 226, 19, 96, 43, 117, 105,
-			69, 209, 223, 34, 242, 102, 59, 160, 192, 18, 124, 7, 113, 190,
-			11, 61, 234, 184, 112, 244, 248, 194, 157, 88, 130, 74, 207, 134,
-			231, 117, 247, 245, 65, 27, 167, 117, 254, 100, 38, 251, 101, 154,
-			236, 67, 162, 157, 152, 162, 156, 103, 156, 144, 75, 192, 37, 234,
-			227, 18, 248, 211, 232, 183, 53, 107, 110, 138, 78, 182, 200, 14,
-			138, 114, 248, 94, 86, 77, 151, 141, 95, 20, 125, 112, 128, 120,
-			98, 17, 59, 66, 40, 245, 66, 174, 161, 113, 252, 190, 232, 71,
-			81, 174, 110, 10, 202, 155, 252, 179, 173, 3, 152, 90, 197, 114,
-			186, 42, 156, 132, 250, 42, 161, 156, 248, 109, 209, 37, 161, 84,
-			118, 228, 248, 36, 142, 64, 73, 171, 18, 0, 192, 20, 143, 191,
-			37, 186, 243, 197, 34, 252, 178, 7, 108, 252, 72, 237, 121, 42,
-			131, 67, 6, 213, 69, 94, 167, 144, 184, 91, 229, 106, 225, 25,
-			80, 137, 15, 112, 99, 71, 234, 46, 240, 119, 168, 168, 138, 198,
-			63, 7, 19, 10, 228, 232, 250, 46, 84, 235, 148, 86, 54, 173,
-			213, 238, 200, 2, 80, 79, 23, 78, 220, 17, 125, 97, 114, 32,
-			2, 184, 217, 97, 215, 63, 195, 232, 202, 162, 137, 87, 69, 151,
-			38, 72, 124, 90, 196, 10, 205, 154, 25, 137, 39, 114, 5, 246,
-			102, 251, 32, 95, 55, 149, 41, 38, 150, 133, 48, 148, 64, 99,
-			22, 28, 106, 166, 134, 50, 102, 129, 28, 46, 18, 63, 43, 186,
-			241, 243, 65, 190, 68, 3, 195, 51, 19, 107, 60, 228, 28, 152,
-			205, 29, 146, 54, 173, 101, 157, 35, 101, 127, 216, 17, 157, 138,
-			34, 48, 224, 176, 58, 247, 75, 53, 146, 188, 62, 67, 183, 173,
-			210, 216, 89, 226, 236, 124, 52, 121, 82, 47, 125, 18, 72, 212,
-			250, 48, 63, 75, 217, 57, 200, 69, 156, 172, 146, 146, 81, 11,
-			83, 104, 1, 100, 108, 185, 65, 61, 193, 237, 19, 168, 211, 223,
-			194, 69, 142, 225, 137, 231, 195, 60, 177, 23, 199, 93, 215, 178,
-			109, 75, 254, 220, 17, 29, 146, 1, 224, 174, 64, 60, 206, 33,
-			182, 199, 28, 109, 34, 196, 209, 152, 33, 218, 76, 235, 148, 197,
-			180, 216, 124, 70, 243, 168, 113, 155, 71, 121, 108, 90, 163, 89,
-			210, 117, 17, 149, 61, 198, 165, 212, 23, 94, 74, 18, 161, 20,
-			83, 32, 43, 75, 78, 174, 136, 40, 231, 196, 133, 136, 46, 175,
-			205, 47, 165, 151, 98, 109, 192, 145, 196, 234, 218, 198, 19, 153,
-			118, 160, 23, 125, 152, 158, 223, 220, 184, 183, 150, 205, 60, 134,
-			60, 55, 62, 40, 250, 209, 90, 251, 73, 14, 210, 79, 200, 252,
-			59, 22, 153, 108, 138, 246, 92, 3, 173, 149, 224, 235, 106, 58,
-			189, 148, 123, 146, 254, 66, 122, 113, 19, 13, 182, 1, 108, 47,
-			204, 93, 78, 174, 222, 5, 168, 253, 162, 123, 126, 9, 237, 171,
-			159, 44, 165, 215, 115, 0, 178, 27, 166, 213, 242, 218, 226, 59,
-			0, 63, 2, 18, 204, 216, 252, 195, 249, 12, 150, 53, 64, 158,
-			228, 54, 230, 55, 210, 49, 47, 222, 3, 147, 42, 179, 154, 201,
-			221, 131, 178, 237, 147, 255, 194, 211, 171, 2, 38, 138, 111, 237,
-			68, 177, 208, 40, 169, 189, 232, 146, 28, 21, 30, 201, 120, 184,
-			140, 181, 247, 156, 44, 188, 188, 37, 60, 84, 115, 124, 134, 182,
-			66, 22, 104, 61, 202, 2, 109, 233, 37, 251, 1, 180, 42, 103,
-			167, 156, 223, 42, 41, 119, 138, 136, 222, 41, 254, 68, 201, 23,
-			51, 162, 189, 222, 96, 3, 51, 28, 249, 193, 48, 38, 52, 36,
-			89, 46, 129, 2, 18, 253, 128, 213, 145, 135, 21, 161, 4, 36,
-			202, 203, 82, 150, 189, 239, 68, 62, 251, 190, 115, 69, 12, 216,
-			18, 27, 119, 154, 165, 161, 152, 245, 97, 3, 243, 227, 115, 98,
-			200, 46, 92, 170, 108, 87, 159, 52, 107, 101, 54, 31, 205, 198,
-			173, 111, 25, 248, 180, 89, 43, 79, 62, 87, 83, 11, 102, 81,
-			110, 17, 198, 125, 115, 153, 230, 42, 76, 154, 236, 230, 234, 42,
-			79, 41, 152, 22, 217, 244, 253, 244, 226, 6, 77, 81, 40, 137,
-			78, 187, 75, 79, 214, 54, 97, 114, 134, 230, 140, 135, 243, 253,
-			206, 124, 6, 97, 180, 35, 140, 149, 76, 14, 45, 249, 99, 81,
-			172, 181, 56, 191, 186, 152, 94, 198, 111, 29, 147, 191, 238, 136,
-			46, 58, 51, 19, 161, 175, 137, 40, 31, 107, 164, 202, 146, 184,
-			191, 254, 204, 98, 151, 50, 212, 226, 130, 200, 108, 119, 243, 197,
-			39, 65, 173, 86, 173, 169, 229, 222, 5, 57, 105, 202, 192, 213,
-			142, 159, 247, 224, 100, 173, 86, 59, 164, 87, 32, 9, 147, 164,
-			219, 2, 120, 140, 58, 239, 108, 152, 37, 117, 105, 201, 207, 214,
-			225, 125, 223, 154, 232, 136, 183, 123, 109, 255, 206, 249, 142, 87,
-			226, 117, 118, 44, 210, 79, 56, 191, 138, 142, 33, 169, 207, 235,
-			49, 250, 188, 190, 182, 107, 70, 159, 215, 167, 236, 178, 240, 12,
-			222, 239, 14, 72, 187, 44, 58, 131, 227, 37, 51, 158, 193, 229,
-			245, 28, 78, 7, 188, 39, 80, 55, 108, 49, 175, 159, 239, 76,
-			213, 253, 90, 76, 94, 8, 170, 219, 181, 88, 119, 175, 73, 163,
-			9, 149, 188, 160, 39, 104, 113, 183, 199, 114, 251, 140, 235, 150,
-			233, 52, 25, 119, 61, 75, 121, 24, 111, 239, 176, 148, 135, 168,
-			35, 250, 94, 101, 246, 53, 226, 246, 39, 106, 62, 136, 204, 190,
-			52, 107, 193, 88, 7, 181, 102, 128, 71, 102, 34, 59, 13, 180,
-			95, 172, 6, 164, 247, 243, 131, 143, 81, 129, 139, 94, 162, 129,
-			186, 245, 209, 62, 101, 130, 109, 52, 56, 160, 3, 95, 185, 41,
-			40, 97, 224, 33, 135, 204, 17, 55, 222, 99, 89, 158, 141, 184,
-			81, 203, 242, 108, 164, 67, 88, 150, 103, 35, 189, 125, 146, 214,
-			78, 220, 27, 115, 199, 21, 173, 73, 129, 38, 245, 29, 109, 108,
-			43, 115, 202, 155, 146, 180, 102, 219, 150, 83, 222, 88, 76, 210,
-			146, 181, 118, 167, 52, 173, 89, 111, 119, 170, 123, 210, 164, 81,
-			151, 118, 241, 146, 134, 135, 26, 51, 239, 180, 134, 135, 88, 39,
-			188, 83, 83, 186, 188, 67, 10, 54, 3, 207, 33, 21, 219, 168,
-			73, 163, 146, 45, 49, 46, 113, 119, 227, 222, 25, 119, 82, 225,
-			142, 192, 207, 136, 17, 217, 22, 233, 57, 38, 188, 81, 217, 22,
-			107, 10, 38, 188, 51, 99, 18, 22, 219, 182, 76, 232, 182, 88,
-			91, 48, 209, 29, 55, 105, 212, 136, 13, 27, 120, 104, 219, 229,
-			13, 106, 120, 136, 251, 89, 111, 98, 84, 151, 71, 220, 207, 90,
-			240, 16, 247, 179, 221, 125, 38, 13, 240, 206, 14, 196, 53, 60,
-			244, 15, 178, 240, 195, 251, 46, 223, 59, 59, 168, 203, 179, 18,
-			206, 192, 67, 4, 124, 11, 63, 84, 77, 250, 22, 126, 104, 230,
-			229, 13, 105, 120, 120, 107, 121, 206, 243, 13, 126, 168, 188, 60,
-			103, 193, 195, 5, 122, 78, 94, 148, 83, 26, 1, 196, 7, 37,
-			109, 35, 113, 239, 130, 59, 173, 104, 139, 101, 47, 232, 121, 193,
-			30, 59, 222, 101, 217, 22, 223, 128, 93, 244, 46, 168, 121, 17,
-			97, 123, 45, 239, 140, 73, 163, 189, 214, 196, 69, 147, 70, 123,
-			173, 233, 25, 13, 15, 253, 115, 188, 49, 13, 15, 105, 123, 201,
-			187, 120, 89, 151, 199, 219, 176, 75, 94, 159, 73, 99, 133, 254,
-			65, 147, 70, 151, 158, 145, 81, 13, 15, 157, 119, 188, 89, 253,
-			25, 181, 177, 83, 82, 79, 71, 105, 0, 55, 213, 123, 214, 164,
-			81, 5, 232, 79, 155, 52, 134, 199, 185, 146, 52, 198, 153, 51,
-			110, 76, 46, 52, 28, 166, 25, 77, 24, 130, 52, 163, 23, 26,
-			194, 153, 233, 232, 182, 108, 53, 103, 250, 250, 197, 77, 101, 171,
-			153, 116, 47, 36, 102, 232, 182, 86, 218, 109, 60, 45, 21, 159,
-			74, 115, 44, 201, 2, 204, 33, 92, 173, 108, 28, 199, 164, 59,
-			163, 172, 29, 241, 230, 57, 233, 142, 88, 86, 158, 201, 209, 179,
-			150, 149, 103, 114, 242, 188, 120, 166, 172, 60, 231, 220, 161, 196,
-			135, 190, 20, 37, 169, 81, 102, 72, 219, 182, 255, 142, 159, 175,
-			63, 147, 81, 96, 136, 69, 225, 198, 72, 151, 194, 254, 65, 190,
-			142, 55, 1, 121, 182, 220, 19, 164, 145, 45, 7, 141, 160, 124,
-			136, 44, 31, 0, 54, 52, 255, 241, 60, 180, 91, 75, 94, 176,
-			140, 74, 231, 52, 89, 80, 211, 60, 215, 97, 27, 149, 206, 13,
-			160, 161, 16, 126, 104, 191, 209, 246, 31, 59, 230, 222, 229, 6,
-			249, 213, 75, 203, 203, 87, 220, 207, 217, 150, 151, 175, 200, 77,
-			64, 154, 26, 190, 234, 13, 75, 195, 53, 102, 220, 175, 122, 175,
-			244, 25, 131, 192, 118, 44, 32, 66, 6, 131, 175, 118, 199, 66,
-			6, 131, 175, 14, 14, 105, 120, 104, 172, 38, 55, 21, 71, 26,
-			87, 220, 244, 94, 29, 214, 229, 113, 113, 223, 180, 224, 33, 174,
-			55, 229, 166, 226, 72, 179, 138, 155, 218, 234, 11, 176, 123, 77,
-			110, 42, 204, 52, 95, 211, 61, 161, 165, 241, 154, 220, 84, 152,
-			97, 190, 214, 222, 97, 93, 116, 188, 6, 48, 254, 146, 163, 110,
-			58, 222, 128, 93, 165, 241, 178, 93, 69, 78, 152, 191, 152, 125,
-			133, 57, 244, 27, 238, 107, 61, 214, 117, 203, 27, 114, 92, 153,
-			59, 191, 209, 33, 172, 235, 150, 55, 228, 190, 226, 224, 238, 254,
-			150, 54, 248, 163, 253, 253, 173, 14, 57, 124, 188, 235, 190, 237,
-			73, 243, 77, 185, 181, 190, 237, 13, 154, 52, 44, 185, 183, 229,
-			114, 118, 120, 123, 253, 188, 23, 215, 159, 29, 74, 247, 154, 52,
-			20, 255, 60, 16, 95, 21, 119, 209, 184, 109, 72, 127, 198, 234,
-			243, 94, 191, 73, 227, 247, 248, 160, 46, 14, 120, 47, 120, 49,
-			253, 25, 215, 212, 130, 215, 109, 210, 80, 124, 1, 150, 177, 42,
-			238, 161, 13, 220, 57, 253, 25, 39, 247, 162, 119, 218, 164, 161,
-			248, 226, 89, 95, 23, 111, 71, 219, 183, 1, 253, 25, 175, 79,
-			150, 148, 101, 43, 166, 161, 248, 82, 127, 204, 88, 174, 222, 85,
-			118, 10, 108, 185, 122, 87, 114, 93, 105, 185, 122, 79, 114, 93,
-			101, 105, 122, 207, 187, 107, 89, 154, 70, 177, 192, 153, 144, 165,
-			233, 61, 201, 117, 149, 165, 233, 61, 201, 117, 29, 222, 141, 51,
-			222, 21, 13, 15, 199, 58, 227, 221, 187, 172, 203, 35, 215, 205,
-			88, 240, 16, 159, 204, 196, 37, 147, 6, 120, 153, 153, 203, 26,
-			30, 116, 229, 190, 55, 161, 225, 33, 171, 188, 239, 101, 174, 232,
-			242, 72, 139, 251, 150, 37, 44, 34, 112, 191, 251, 148, 73, 3,
-			188, 251, 167, 207, 72, 243, 106, 98, 1, 203, 222, 170, 50, 175,
-			102, 38, 176, 220, 147, 144, 150, 204, 146, 13, 172, 68, 147, 210,
-			146, 89, 49, 130, 149, 232, 242, 105, 105, 165, 172, 108, 135, 87,
-			162, 103, 237, 28, 180, 178, 243, 167, 236, 28, 180, 179, 187, 124,
-			69, 183, 12, 227, 176, 238, 101, 77, 203, 216, 243, 245, 158, 97,
-			221, 50, 141, 197, 187, 209, 73, 221, 50, 143, 198, 187, 209, 245,
-			81, 13, 149, 165, 163, 119, 181, 13, 181, 26, 145, 119, 123, 207,
-			216, 57, 208, 242, 187, 254, 57, 221, 50, 200, 52, 27, 222, 67,
-			211, 50, 210, 104, 163, 103, 68, 183, 76, 114, 205, 102, 244, 180,
-			110, 153, 37, 155, 205, 232, 198, 152, 134, 202, 178, 205, 166, 213,
-			50, 75, 55, 155, 189, 163, 118, 14, 180, 188, 9, 178, 148, 129,
-			12, 69, 30, 68, 207, 88, 144, 113, 70, 60, 136, 110, 158, 182,
-			106, 33, 7, 120, 16, 130, 140, 180, 121, 208, 107, 183, 142, 243,
-			226, 1, 93, 115, 113, 159, 64, 150, 120, 228, 189, 103, 250, 20,
-			145, 86, 105, 170, 101, 146, 39, 30, 91, 45, 179, 68, 241, 56,
-			250, 104, 88, 67, 101, 187, 154, 199, 86, 203, 44, 85, 60, 182,
-			90, 102, 185, 226, 177, 213, 50, 64, 249, 64, 217, 16, 81, 26,
-			106, 124, 208, 51, 164, 91, 38, 79, 225, 15, 163, 87, 117, 203,
-			30, 181, 252, 97, 244, 131, 17, 13, 213, 163, 25, 244, 161, 53,
-			131, 216, 161, 248, 67, 255, 178, 157, 131, 118, 100, 179, 41, 11,
-			50, 20, 121, 98, 205, 16, 143, 168, 249, 36, 250, 225, 85, 171,
-			22, 82, 243, 137, 213, 39, 143, 168, 249, 196, 154, 33, 30, 81,
-			243, 9, 204, 16, 3, 217, 69, 227, 179, 51, 22, 100, 151, 44,
-			212, 158, 76, 90, 181, 112, 173, 61, 13, 65, 118, 201, 66, 109,
-			204, 206, 65, 11, 53, 67, 45, 188, 172, 47, 120, 37, 77, 45,
-			186, 174, 47, 72, 137, 26, 231, 42, 20, 47, 70, 103, 244, 114,
-			69, 81, 164, 24, 29, 50, 105, 40, 94, 28, 190, 96, 210, 88,
-			126, 106, 90, 87, 7, 198, 25, 68, 39, 244, 103, 188, 141, 14,
-			162, 253, 38, 13, 213, 131, 152, 225, 6, 40, 39, 4, 192, 13,
-			84, 117, 140, 193, 19, 61, 167, 63, 227, 173, 244, 118, 212, 48,
-			63, 100, 172, 219, 3, 167, 77, 26, 227, 242, 40, 62, 28, 33,
-			219, 232, 157, 104, 66, 127, 142, 82, 186, 215, 164, 161, 250, 78,
-			159, 177, 202, 71, 43, 232, 157, 177, 83, 186, 122, 71, 60, 178,
-			27, 53, 208, 209, 18, 122, 55, 218, 103, 210, 80, 125, 183, 127,
-			212, 164, 161, 250, 46, 44, 46, 109, 123, 240, 76, 74, 144, 204,
-			22, 181, 49, 26, 155, 160, 63, 211, 91, 42, 14, 210, 51, 41,
-			65, 50, 67, 124, 6, 91, 207, 107, 202, 20, 161, 226, 78, 39,
-			174, 188, 92, 130, 180, 21, 90, 106, 23, 199, 117, 80, 113, 159,
-			197, 44, 243, 245, 138, 59, 102, 153, 49, 84, 78, 157, 183, 204,
-			24, 42, 151, 166, 140, 249, 250, 190, 59, 33, 161, 120, 100, 161,
-			86, 153, 182, 172, 9, 246, 221, 152, 101, 77, 176, 63, 112, 202,
-			178, 38, 216, 135, 177, 211, 214, 4, 31, 185, 103, 37, 148, 118,
-			128, 242, 145, 187, 63, 97, 25, 23, 124, 164, 161, 224, 32, 126,
-			52, 144, 176, 140, 11, 62, 58, 131, 205, 179, 119, 65, 123, 221,
-			253, 113, 39, 98, 249, 23, 212, 97, 13, 108, 210, 0, 17, 11,
-			105, 122, 241, 196, 29, 127, 137, 226, 243, 180, 200, 186, 82, 76,
-			162, 111, 210, 22, 195, 88, 25, 163, 48, 139, 246, 47, 70, 230,
-			85, 46, 17, 204, 136, 154, 94, 93, 202, 38, 146, 13, 53, 189,
-			78, 147, 6, 60, 154, 93, 189, 38, 13, 72, 55, 65, 24, 41,
-			75, 188, 224, 243, 161, 55, 150, 248, 192, 12, 76, 253, 8, 118,
-			214, 167, 3, 52, 169, 148, 146, 185, 194, 9, 197, 58, 54, 14,
-			21, 100, 134, 131, 97, 156, 216, 124, 19, 239, 71, 181, 121, 180,
-			35, 79, 78, 135, 94, 51, 174, 209, 65, 14, 115, 104, 161, 139,
-			252, 229, 176, 203, 116, 7, 185, 203, 33, 136, 90, 91, 18, 93,
-			152, 170, 47, 188, 145, 68, 206, 191, 195, 42, 236, 16, 174, 42,
-			239, 229, 72, 134, 113, 20, 71, 145, 196, 21, 240, 194, 59, 28,
-			211, 72, 224, 12, 121, 97, 33, 137, 68, 123, 209, 53, 96, 210,
-			128, 228, 139, 161, 97, 17, 72, 36, 97, 67, 249, 75, 142, 55,
-			154, 216, 244, 23, 164, 50, 61, 132, 166, 206, 252, 22, 241, 140,
-			201, 102, 97, 217, 180, 67, 59, 47, 70, 52, 230, 112, 134, 198,
-			150, 59, 77, 134, 131, 25, 93, 113, 147, 65, 184, 13, 227, 246,
-			65, 184, 130, 32, 28, 253, 170, 227, 253, 176, 211, 174, 138, 160,
-			48, 236, 125, 213, 233, 234, 23, 247, 136, 163, 71, 72, 30, 246,
-			190, 238, 68, 7, 18, 55, 125, 214, 225, 147, 205, 87, 41, 168,
-			155, 48, 76, 172, 255, 199, 233, 153, 68, 245, 219, 118, 190, 208,
-			72, 26, 140, 121, 43, 136, 176, 40, 141, 160, 122, 236, 44, 23,
-			179, 64, 198, 252, 126, 71, 55, 8, 165, 254, 138, 19, 29, 78,
-			52, 125, 115, 113, 160, 206, 0, 165, 109, 187, 197, 237, 124, 169,
-			204, 150, 96, 216, 154, 54, 111, 107, 144, 237, 47, 160, 35, 252,
-			60, 80, 30, 93, 45, 209, 248, 151, 180, 166, 38, 32, 4, 31,
-			48, 225, 43, 58, 38, 16, 201, 217, 23, 211, 32, 231, 48, 38,
-			49, 59, 203, 197, 44, 56, 156, 125, 221, 224, 11, 89, 63, 232,
-			68, 79, 37, 234, 126, 248, 98, 163, 5, 231, 143, 208, 78, 10,
-			177, 242, 243, 77, 72, 87, 26, 28, 238, 130, 173, 205, 1, 11,
-			189, 208, 132, 127, 176, 91, 173, 7, 161, 227, 19, 158, 117, 181,
-			181, 11, 159, 138, 48, 64, 171, 141, 45, 210, 13, 240, 24, 178,
-			179, 8, 181, 209, 49, 145, 213, 200, 194, 20, 248, 33, 39, 154,
-			72, 124, 222, 111, 185, 113, 105, 193, 22, 29, 212, 241, 222, 130,
-			238, 198, 252, 114, 105, 175, 68, 3, 12, 116, 203, 23, 118, 91,
-			198, 21, 39, 26, 0, 29, 182, 179, 92, 204, 130, 61, 234, 39,
-			28, 185, 46, 96, 93, 253, 152, 3, 171, 247, 47, 59, 106, 244,
-			76, 131, 202, 28, 64, 25, 10, 182, 44, 13, 21, 215, 202, 190,
-			103, 35, 51, 124, 117, 23, 200, 171, 7, 181, 4, 245, 234, 30,
-			86, 197, 171, 7, 123, 162, 88, 26, 3, 107, 94, 242, 170, 128,
-			173, 35, 250, 99, 161, 85, 128, 219, 7, 34, 107, 184, 2, 218,
-			96, 254, 152, 211, 61, 96, 50, 34, 152, 1, 203, 254, 95, 58,
-			210, 187, 201, 251, 107, 142, 59, 154, 248, 93, 71, 171, 52, 100,
-			84, 60, 60, 206, 126, 138, 106, 195, 218, 0, 146, 22, 191, 165,
-			224, 37, 200, 43, 142, 211, 120, 28, 81, 120, 144, 13, 36, 218,
-			223, 89, 42, 21, 105, 247, 153, 20, 104, 121, 183, 23, 228, 149,
-			123, 99, 165, 234, 203, 203, 39, 142, 24, 71, 231, 114, 105, 198,
-			111, 206, 237, 196, 21, 201, 235, 10, 40, 4, 189, 195, 253, 173,
-			87, 185, 104, 97, 119, 187, 84, 210, 193, 164, 24, 80, 201, 8,
-			38, 201, 182, 9, 79, 156, 209, 159, 116, 218, 254, 169, 227, 192,
-			126, 75, 130, 167, 247, 147, 78, 231, 128, 248, 65, 71, 90, 180,
-			69, 127, 218, 113, 127, 206, 137, 36, 94, 152, 142, 63, 193, 251,
-			2, 182, 254, 106, 52, 209, 84, 174, 26, 34, 28, 90, 209, 153,
-			235, 232, 37, 51, 59, 10, 141, 210, 115, 14, 32, 163, 63, 251,
-			116, 67, 228, 79, 215, 3, 246, 43, 154, 151, 101, 76, 129, 218,
-			126, 97, 70, 106, 20, 200, 164, 206, 251, 105, 71, 244, 41, 135,
-			28, 228, 125, 63, 227, 120, 227, 52, 87, 164, 81, 93, 20, 50,
-			126, 218, 137, 25, 191, 169, 40, 149, 25, 52, 25, 14, 102, 12,
-			141, 152, 140, 8, 102, 156, 74, 104, 168, 80, 224, 103, 29, 111,
-			72, 67, 133, 141, 49, 10, 25, 63, 227, 140, 27, 87, 162, 118,
-			42, 211, 101, 50, 168, 18, 36, 117, 70, 4, 51, 200, 121, 8,
-			41, 233, 196, 163, 63, 239, 184, 255, 153, 28, 34, 23, 79, 133,
-			222, 207, 59, 162, 71, 54, 138, 135, 66, 239, 111, 56, 222, 176,
-			108, 148, 206, 132, 81, 200, 248, 121, 167, 79, 130, 164, 51, 33,
-			150, 17, 38, 195, 193, 140, 238, 152, 201, 136, 96, 198, 224, 144,
-			134, 10, 5, 126, 1, 118, 59, 13, 21, 187, 2, 25, 127, 195,
-			25, 214, 117, 176, 43, 191, 96, 67, 117, 168, 82, 119, 220, 100,
-			68, 48, 3, 246, 37, 5, 21, 152, 199, 47, 2, 36, 13, 21,
-			152, 71, 20, 50, 126, 193, 25, 213, 117, 96, 91, 198, 50, 6,
-			42, 162, 242, 139, 78, 119, 175, 201, 136, 96, 70, 108, 128, 141,
-			39, 177, 255, 191, 228, 184, 61, 52, 165, 233, 92, 26, 253, 37,
-			139, 94, 52, 140, 144, 225, 169, 164, 131, 201, 246, 14, 149, 140,
-			96, 82, 116, 51, 177, 113, 223, 252, 155, 142, 251, 27, 170, 50,
-			237, 154, 127, 211, 233, 232, 17, 239, 99, 7, 120, 207, 252, 91,
-			208, 129, 196, 50, 93, 132, 233, 251, 120, 178, 30, 101, 231, 177,
-			66, 33, 216, 111, 240, 197, 22, 69, 229, 51, 151, 148, 188, 218,
-			217, 2, 219, 175, 53, 43, 20, 30, 233, 48, 144, 114, 159, 43,
-			247, 81, 0, 223, 107, 50, 92, 204, 128, 174, 94, 150, 237, 67,
-			129, 95, 113, 188, 88, 34, 209, 210, 62, 64, 149, 16, 13, 52,
-			135, 11, 119, 155, 12, 23, 51, 64, 180, 95, 150, 208, 32, 253,
-			119, 28, 111, 32, 241, 70, 11, 52, 220, 15, 154, 21, 197, 144,
-			182, 130, 151, 245, 202, 180, 135, 200, 1, 184, 30, 147, 65, 240,
-			65, 6, 120, 44, 219, 3, 98, 255, 42, 82, 239, 254, 49, 237,
-			125, 42, 225, 72, 102, 66, 70, 9, 29, 213, 81, 68, 77, 235,
-			184, 87, 253, 170, 77, 59, 220, 169, 126, 21, 105, 119, 77, 182,
-			14, 27, 213, 175, 97, 111, 207, 181, 180, 142, 146, 2, 242, 26,
-			197, 120, 139, 6, 40, 110, 15, 191, 102, 119, 9, 205, 141, 127,
-			13, 187, 148, 147, 64, 97, 182, 254, 215, 142, 215, 159, 88, 60,
-			10, 148, 209, 110, 233, 139, 79, 97, 27, 235, 200, 183, 139, 37,
-			242, 113, 210, 237, 154, 102, 225, 68, 130, 80, 173, 12, 23, 51,
-			122, 251, 96, 191, 231, 102, 97, 82, 255, 3, 156, 7, 11, 212,
-			236, 49, 240, 97, 220, 16, 246, 51, 12, 175, 155, 175, 28, 2,
-			251, 199, 240, 238, 91, 213, 166, 244, 129, 215, 152, 154, 70, 144,
-			219, 255, 3, 123, 190, 68, 93, 204, 128, 249, 242, 129, 108, 181,
-			35, 238, 253, 99, 28, 191, 21, 63, 135, 219, 49, 138, 58, 141,
-			67, 127, 122, 105, 37, 233, 223, 107, 238, 97, 143, 151, 12, 38,
-			51, 102, 167, 183, 124, 239, 13, 133, 228, 42, 176, 134, 16, 206,
-			179, 8, 223, 12, 97, 135, 139, 25, 177, 1, 201, 10, 97, 203,
-			249, 77, 199, 253, 111, 53, 43, 196, 9, 247, 155, 134, 171, 19,
-			43, 248, 45, 195, 127, 153, 25, 64, 198, 111, 106, 174, 206, 236,
-			224, 183, 12, 255, 101, 134, 240, 91, 134, 255, 50, 75, 248, 45,
-			71, 59, 111, 210, 180, 254, 109, 199, 59, 173, 161, 34, 43, 132,
-			140, 223, 114, 134, 116, 29, 100, 133, 191, 109, 152, 22, 233, 167,
-			32, 163, 123, 212, 100, 68, 48, 35, 49, 174, 161, 66, 215, 126,
-			199, 241, 46, 107, 168, 200, 10, 33, 227, 183, 157, 211, 186, 14,
-			142, 50, 100, 157, 49, 25, 14, 102, 76, 92, 52, 25, 17, 204,
-			152, 158, 209, 80, 33, 253, 13, 199, 59, 167, 161, 194, 105, 34,
-			10, 25, 191, 227, 92, 214, 117, 240, 56, 241, 13, 27, 87, 92,
-			57, 223, 112, 186, 77, 187, 17, 130, 114, 214, 215, 80, 97, 229,
-			252, 174, 227, 93, 208, 80, 81, 178, 130, 140, 111, 56, 231, 116,
-			29, 175, 157, 202, 24, 168, 184, 116, 126, 215, 233, 62, 107, 50,
-			34, 152, 49, 121, 94, 178, 109, 248, 252, 123, 142, 27, 147, 108,
-			27, 105, 250, 123, 214, 216, 162, 26, 24, 191, 71, 85, 146, 138,
-			119, 116, 171, 100, 4, 147, 48, 51, 171, 4, 11, 104, 249, 7,
-			142, 59, 148, 200, 127, 19, 215, 78, 70, 28, 251, 108, 247, 78,
-			210, 21, 133, 209, 197, 193, 130, 22, 127, 207, 137, 73, 132, 144,
-			15, 252, 129, 65, 23, 123, 247, 7, 78, 71, 159, 74, 70, 48,
-			57, 48, 40, 254, 117, 132, 60, 252, 162, 127, 232, 160, 165, 69,
-			226, 95, 70, 124, 109, 22, 98, 124, 196, 141, 71, 230, 210, 138,
-			79, 198, 226, 44, 204, 209, 34, 146, 81, 85, 57, 28, 157, 58,
-			100, 44, 173, 160, 163, 228, 34, 199, 232, 6, 132, 201, 227, 227,
-			97, 190, 252, 140, 160, 235, 216, 117, 186, 45, 185, 19, 177, 36,
-			189, 221, 108, 224, 99, 8, 28, 41, 22, 67, 241, 145, 43, 7,
-			197, 178, 41, 87, 107, 44, 94, 49, 22, 232, 78, 73, 165, 14,
-			242, 135, 117, 45, 189, 31, 78, 161, 241, 7, 53, 33, 77, 61,
-			208, 223, 129, 48, 230, 240, 255, 0, 61, 37, 227, 226, 21, 131,
-			122, 105, 167, 162, 141, 66, 84, 36, 65, 188, 10, 170, 224, 59,
-			22, 124, 230, 203, 227, 205, 17, 48, 178, 18, 185, 225, 99, 236,
-			216, 124, 189, 132, 110, 28, 133, 66, 115, 175, 201, 145, 118, 158,
-			231, 201, 135, 201, 162, 31, 81, 2, 29, 154, 2, 138, 84, 206,
-			6, 39, 117, 237, 3, 138, 112, 208, 101, 157, 162, 1, 231, 113,
-			7, 153, 221, 11, 246, 170, 181, 67, 244, 28, 134, 147, 15, 34,
-			101, 200, 108, 4, 103, 100, 110, 7, 116, 238, 164, 15, 69, 169,
-			232, 193, 0, 188, 104, 195, 130, 17, 128, 10, 181, 106, 29, 132,
-			122, 237, 1, 146, 93, 95, 172, 147, 215, 18, 121, 112, 122, 127,
-			136, 114, 113, 89, 186, 112, 122, 127, 228, 184, 126, 226, 67, 190,
-			245, 172, 135, 124, 233, 57, 128, 9, 63, 81, 66, 33, 135, 120,
-			214, 89, 83, 32, 37, 94, 174, 134, 35, 160, 168, 129, 235, 87,
-			62, 162, 81, 104, 238, 15, 157, 65, 229, 52, 26, 165, 246, 135,
-			84, 210, 193, 228, 176, 118, 41, 141, 96, 114, 226, 172, 248, 85,
-			87, 122, 138, 122, 127, 226, 184, 35, 137, 95, 116, 253, 123, 202,
-			18, 233, 216, 27, 65, 57, 218, 246, 27, 41, 68, 48, 146, 244,
-			213, 121, 228, 121, 41, 56, 16, 45, 18, 63, 250, 182, 211, 212,
-			111, 146, 160, 65, 241, 173, 43, 24, 0, 146, 218, 74, 209, 148,
-			81, 43, 87, 79, 252, 253, 128, 207, 2, 242, 188, 207, 211, 132,
-			62, 23, 232, 116, 77, 227, 252, 44, 240, 235, 123, 64, 64, 24,
-			119, 60, 158, 151, 104, 34, 52, 170, 50, 48, 76, 77, 135, 139,
-			38, 7, 29, 38, 48, 199, 45, 65, 223, 98, 140, 39, 92, 217,
-			193, 57, 11, 167, 174, 35, 93, 191, 199, 134, 87, 236, 240, 148,
-			47, 215, 73, 64, 130, 15, 41, 69, 118, 228, 101, 64, 184, 63,
-			114, 180, 151, 109, 59, 81, 50, 170, 146, 68, 216, 142, 1, 149,
-			140, 96, 18, 78, 156, 191, 30, 33, 178, 3, 51, 251, 51, 100,
-			102, 255, 69, 68, 55, 117, 60, 209, 21, 101, 234, 141, 234, 254,
-			62, 144, 178, 190, 139, 46, 115, 234, 250, 21, 163, 86, 144, 102,
-			66, 168, 179, 50, 130, 144, 148, 38, 113, 160, 110, 156, 96, 46,
-			163, 90, 192, 196, 210, 52, 170, 1, 243, 145, 130, 227, 179, 186,
-			96, 154, 252, 233, 53, 127, 201, 6, 31, 165, 86, 242, 31, 243,
-			27, 21, 91, 1, 10, 26, 187, 170, 38, 198, 134, 111, 96, 136,
-			102, 51, 172, 244, 234, 76, 19, 135, 26, 135, 191, 89, 147, 175,
-			221, 232, 238, 76, 27, 138, 107, 18, 179, 63, 206, 150, 58, 112,
-			75, 70, 83, 200, 215, 3, 122, 58, 101, 21, 163, 73, 27, 25,
-			131, 150, 167, 226, 3, 144, 205, 124, 78, 109, 13, 24, 52, 17,
-			79, 204, 183, 217, 139, 178, 22, 96, 152, 115, 161, 99, 126, 145,
-			195, 24, 186, 32, 150, 26, 205, 188, 84, 143, 238, 130, 96, 255,
-			174, 156, 70, 197, 170, 146, 92, 172, 195, 58, 135, 159, 230, 225,
-			199, 189, 1, 6, 240, 79, 156, 17, 57, 192, 184, 55, 252, 153,
-			25, 126, 92, 87, 127, 230, 116, 244, 171, 100, 4, 147, 241, 65,
-			237, 34, 244, 203, 49, 49, 166, 142, 188, 198, 106, 219, 114, 21,
-			58, 226, 64, 52, 185, 35, 134, 142, 28, 128, 97, 84, 208, 132,
-			21, 85, 82, 210, 244, 180, 197, 132, 21, 15, 227, 89, 250, 30,
-			159, 18, 253, 198, 60, 220, 54, 68, 237, 211, 217, 116, 222, 190,
-			255, 151, 251, 217, 213, 55, 249, 157, 106, 37, 120, 74, 199, 128,
-			19, 226, 79, 58, 217, 32, 240, 28, 116, 39, 241, 63, 118, 30,
-			213, 33, 0, 9, 121, 47, 34, 207, 64, 181, 187, 206, 170, 163,
-			155, 209, 53, 224, 158, 37, 43, 99, 32, 244, 160, 188, 13, 253,
-			90, 170, 242, 204, 46, 213, 21, 16, 216, 206, 161, 36, 137, 228,
-			90, 197, 154, 14, 29, 33, 201, 41, 83, 105, 198, 136, 183, 4,
-			116, 12, 163, 167, 20, 130, 214, 22, 97, 123, 171, 219, 251, 54,
-			46, 103, 179, 44, 147, 254, 124, 177, 200, 90, 166, 60, 186, 203,
-			177, 78, 205, 168, 128, 142, 209, 153, 168, 168, 10, 184, 8, 108,
-			95, 209, 86, 173, 140, 172, 201, 136, 192, 188, 144, 15, 61, 64,
-			255, 76, 124, 121, 92, 51, 97, 156, 15, 76, 7, 13, 104, 235,
-			232, 34, 25, 238, 81, 170, 224, 122, 147, 135, 107, 169, 9, 74,
-			182, 130, 150, 206, 167, 123, 249, 218, 51, 122, 207, 74, 248, 83,
-			178, 48, 12, 194, 148, 20, 85, 74, 146, 163, 72, 97, 5, 230,
-			205, 218, 59, 52, 215, 96, 106, 74, 214, 65, 187, 111, 146, 195,
-			18, 243, 206, 34, 104, 107, 145, 218, 103, 211, 36, 235, 159, 168,
-			27, 248, 110, 24, 110, 249, 76, 4, 186, 225, 177, 181, 190, 164,
-			244, 162, 109, 154, 165, 32, 104, 5, 74, 19, 84, 70, 68, 114,
-			96, 192, 101, 150, 22, 78, 218, 62, 213, 211, 249, 77, 117, 59,
-			197, 37, 51, 21, 50, 163, 153, 175, 237, 236, 81, 92, 24, 89,
-			79, 49, 80, 164, 51, 236, 125, 24, 63, 6, 253, 175, 179, 1,
-			136, 21, 56, 21, 169, 239, 72, 203, 221, 160, 188, 47, 65, 173,
-			235, 240, 249, 75, 65, 5, 93, 123, 25, 148, 30, 198, 124, 203,
-			72, 19, 240, 18, 48, 112, 88, 12, 133, 134, 124, 46, 224, 165,
-			45, 204, 151, 107, 65, 190, 120, 152, 102, 129, 138, 193, 31, 11,
-			85, 194, 100, 217, 148, 212, 189, 60, 181, 249, 148, 201, 45, 229,
-			25, 152, 161, 8, 143, 154, 97, 106, 4, 94, 138, 4, 242, 202,
-			173, 0, 210, 35, 192, 218, 15, 216, 69, 164, 254, 41, 72, 11,
-			98, 83, 178, 105, 222, 134, 36, 32, 22, 187, 107, 37, 123, 250,
-			6, 31, 3, 42, 44, 241, 30, 199, 62, 132, 20, 219, 180, 189,
-			241, 185, 206, 211, 226, 7, 244, 139, 52, 23, 220, 51, 137, 23,
-			190, 210, 138, 134, 230, 23, 235, 74, 241, 65, 133, 60, 199, 209,
-			182, 180, 156, 90, 255, 153, 196, 232, 70, 106, 172, 132, 47, 229,
-			27, 91, 27, 112, 194, 50, 148, 230, 248, 161, 167, 103, 46, 184,
-			231, 38, 44, 27, 228, 11, 238, 160, 101, 131, 124, 97, 104, 204,
-			178, 65, 190, 48, 126, 90, 252, 99, 87, 25, 33, 95, 113, 79,
-			39, 254, 174, 219, 186, 52, 44, 162, 193, 234, 171, 238, 193, 174,
-			74, 97, 196, 243, 150, 142, 103, 15, 4, 111, 56, 243, 104, 101,
-			24, 73, 99, 122, 203, 161, 128, 48, 71, 181, 65, 58, 124, 119,
-			189, 89, 144, 82, 27, 10, 68, 7, 85, 104, 168, 16, 226, 203,
-			192, 5, 182, 170, 184, 64, 229, 125, 11, 208, 71, 13, 146, 229,
-			7, 143, 99, 119, 50, 131, 147, 116, 38, 204, 142, 44, 126, 152,
-			40, 211, 64, 254, 45, 96, 209, 135, 40, 250, 88, 98, 135, 12,
-			56, 179, 167, 31, 196, 163, 51, 25, 218, 199, 241, 233, 234, 160,
-			84, 9, 217, 81, 95, 113, 47, 156, 177, 236, 168, 175, 132, 30,
-			182, 185, 210, 57, 106, 217, 81, 95, 73, 140, 107, 97, 225, 127,
-			242, 196, 48, 105, 124, 62, 163, 164, 240, 190, 24, 88, 196, 226,
-			102, 146, 214, 247, 209, 67, 192, 120, 152, 132, 61, 4, 216, 217,
-			68, 201, 17, 199, 186, 194, 24, 57, 226, 254, 111, 71, 88, 60,
-			232, 255, 127, 131, 120, 32, 221, 5, 4, 246, 70, 45, 95, 209,
-			121, 202, 152, 240, 119, 187, 195, 214, 242, 233, 118, 197, 184, 181,
-			124, 186, 77, 52, 16, 12, 27, 162, 3, 135, 226, 242, 233, 30,
-			28, 18, 69, 181, 122, 250, 128, 7, 60, 12, 241, 0, 36, 165,
-			218, 89, 142, 44, 121, 169, 144, 13, 197, 114, 56, 110, 43, 175,
-			237, 23, 66, 243, 171, 207, 237, 214, 17, 98, 163, 216, 234, 160,
-			53, 191, 250, 244, 234, 198, 249, 213, 55, 126, 90, 207, 175, 127,
-			179, 40, 70, 249, 181, 196, 39, 199, 186, 173, 219, 158, 229, 71,
-			167, 219, 183, 227, 180, 62, 249, 61, 98, 88, 185, 38, 103, 40,
-			128, 63, 72, 239, 228, 186, 245, 205, 184, 98, 95, 19, 93, 42,
-			108, 69, 77, 186, 160, 14, 30, 19, 60, 57, 107, 74, 221, 247,
-			58, 35, 49, 111, 242, 23, 60, 17, 79, 83, 199, 181, 134, 1,
-			37, 234, 11, 102, 169, 68, 142, 113, 183, 150, 235, 229, 138, 229,
-			4, 239, 158, 224, 36, 172, 157, 223, 63, 47, 250, 116, 4, 104,
-			134, 29, 49, 94, 67, 199, 82, 32, 171, 67, 70, 179, 31, 255,
-			219, 34, 166, 33, 40, 167, 48, 246, 129, 62, 62, 118, 79, 191,
-			42, 173, 220, 178, 63, 39, 122, 129, 95, 89, 206, 186, 237, 39,
-			46, 244, 30, 40, 104, 188, 232, 174, 139, 118, 58, 18, 74, 207,
-			218, 211, 84, 225, 8, 213, 82, 203, 88, 38, 203, 69, 227, 175,
-			137, 14, 122, 219, 171, 24, 72, 159, 218, 137, 19, 106, 101, 184,
-			84, 86, 21, 79, 124, 78, 180, 19, 164, 248, 164, 232, 13, 93,
-			99, 75, 111, 183, 110, 200, 196, 202, 232, 223, 9, 195, 232, 196,
-			92, 248, 219, 141, 69, 18, 143, 69, 135, 4, 134, 14, 204, 234,
-			182, 89, 250, 65, 178, 135, 100, 111, 222, 190, 170, 182, 107, 243,
-			132, 128, 191, 219, 99, 81, 248, 59, 26, 235, 128, 191, 59, 98,
-			157, 240, 119, 103, 172, 107, 242, 191, 115, 142, 78, 20, 224, 169,
-			9, 152, 2, 242, 70, 67, 250, 117, 234, 116, 252, 170, 208, 225,
-			189, 159, 148, 138, 42, 168, 119, 56, 2, 67, 183, 42, 145, 41,
-			214, 17, 105, 93, 129, 100, 18, 154, 34, 93, 102, 30, 208, 121,
-			25, 138, 69, 173, 62, 73, 239, 83, 131, 149, 252, 136, 158, 175,
-			188, 57, 63, 217, 205, 75, 63, 208, 206, 172, 224, 172, 123, 144,
-			115, 255, 207, 94, 103, 103, 176, 159, 254, 142, 117, 6, 27, 50,
-			17, 157, 78, 41, 103, 48, 244, 11, 99, 238, 46, 12, 119, 239,
-			105, 59, 237, 88, 65, 186, 207, 136, 21, 29, 136, 219, 29, 78,
-			124, 222, 151, 156, 69, 177, 102, 98, 95, 28, 102, 66, 127, 226,
-			240, 49, 74, 123, 139, 165, 172, 224, 158, 102, 127, 232, 115, 123,
-			116, 12, 40, 140, 236, 237, 118, 90, 251, 67, 95, 151, 189, 63,
-			96, 100, 239, 63, 215, 47, 251, 13, 185, 3, 137, 127, 141, 182,
-			21, 219, 10, 11, 96, 170, 148, 84, 113, 251, 36, 34, 172, 159,
-			36, 60, 164, 92, 108, 5, 226, 220, 182, 108, 6, 204, 73, 72,
-			29, 107, 142, 137, 109, 202, 250, 84, 11, 254, 236, 1, 72, 153,
-			62, 119, 190, 201, 178, 52, 63, 149, 139, 178, 51, 7, 199, 177,
-			62, 249, 229, 42, 91, 193, 224, 91, 100, 172, 4, 44, 52, 203,
-			249, 26, 33, 62, 141, 39, 85, 56, 162, 193, 239, 250, 213, 93,
-			144, 232, 235, 87, 247, 242, 245, 70, 80, 155, 154, 9, 109, 90,
-			67, 110, 159, 29, 214, 124, 72, 211, 12, 55, 173, 161, 174, 30,
-			107, 211, 26, 234, 143, 137, 171, 56, 142, 93, 24, 196, 169, 55,
-			113, 94, 71, 206, 186, 65, 178, 152, 61, 114, 50, 26, 7, 199,
-			149, 106, 195, 184, 82, 163, 50, 146, 84, 91, 23, 7, 129, 210,
-			41, 151, 83, 223, 239, 40, 167, 156, 113, 247, 124, 226, 19, 29,
-			10, 169, 166, 127, 213, 149, 169, 66, 19, 223, 185, 85, 228, 196,
-			0, 31, 36, 164, 31, 4, 168, 201, 170, 211, 89, 209, 142, 59,
-			42, 172, 32, 167, 114, 38, 177, 106, 64, 65, 216, 163, 213, 134,
-			71, 187, 82, 205, 76, 40, 188, 145, 25, 119, 71, 123, 45, 135,
-			160, 113, 119, 200, 114, 8, 26, 31, 158, 176, 28, 130, 198, 207,
-			77, 138, 95, 141, 114, 196, 177, 84, 91, 201, 73, 252, 82, 212,
-			63, 202, 112, 149, 154, 2, 99, 237, 160, 126, 161, 94, 199, 7,
-			145, 233, 36, 38, 245, 133, 234, 254, 144, 162, 71, 53, 2, 142,
-			10, 54, 85, 151, 26, 84, 121, 142, 221, 64, 252, 231, 80, 118,
-			71, 119, 85, 14, 240, 131, 138, 255, 66, 173, 180, 207, 250, 52,
-			82, 174, 251, 211, 164, 195, 102, 255, 215, 167, 172, 162, 192, 63,
-			79, 195, 155, 161, 212, 112, 207, 164, 142, 5, 174, 99, 6, 217,
-			32, 85, 166, 170, 170, 222, 180, 219, 96, 157, 11, 234, 136, 173,
-			50, 168, 241, 85, 207, 128, 23, 74, 129, 124, 43, 211, 154, 176,
-			214, 77, 169, 191, 198, 254, 45, 140, 52, 98, 161, 17, 88, 217,
-			204, 109, 112, 64, 74, 62, 141, 97, 76, 172, 41, 28, 111, 105,
-			157, 166, 207, 3, 40, 191, 85, 49, 34, 27, 20, 230, 232, 135,
-			254, 60, 76, 138, 35, 100, 178, 2, 231, 90, 65, 45, 3, 62,
-			38, 163, 158, 22, 163, 48, 40, 139, 30, 225, 87, 154, 123, 91,
-			48, 29, 167, 233, 125, 110, 28, 193, 60, 7, 90, 179, 158, 89,
-			196, 49, 45, 194, 145, 94, 58, 133, 170, 135, 245, 72, 205, 196,
-			81, 30, 232, 217, 114, 211, 148, 178, 83, 163, 231, 253, 72, 209,
-			154, 169, 104, 37, 113, 18, 213, 61, 196, 68, 26, 56, 183, 41,
-			64, 147, 82, 106, 101, 150, 234, 42, 182, 168, 138, 180, 42, 47,
-			60, 174, 170, 160, 50, 2, 17, 76, 41, 125, 143, 53, 30, 100,
-			35, 198, 90, 100, 121, 74, 197, 117, 99, 143, 144, 138, 25, 135,
-			227, 207, 250, 0, 253, 6, 162, 178, 27, 227, 249, 41, 163, 62,
-			41, 189, 8, 238, 32, 212, 217, 42, 159, 5, 165, 88, 33, 37,
-			232, 176, 165, 90, 177, 132, 186, 136, 242, 161, 60, 186, 35, 191,
-			73, 117, 38, 196, 255, 223, 81, 174, 86, 215, 128, 15, 124, 175,
-			164, 164, 142, 169, 170, 231, 134, 30, 72, 218, 16, 21, 161, 236,
-			103, 199, 169, 209, 240, 88, 202, 43, 33, 233, 75, 197, 148, 57,
-			228, 192, 120, 73, 125, 209, 134, 218, 57, 125, 120, 239, 81, 190,
-			94, 128, 142, 176, 92, 189, 174, 117, 143, 89, 174, 94, 215, 78,
-			77, 88, 174, 94, 215, 128, 23, 252, 190, 118, 245, 186, 233, 78,
-			36, 126, 67, 71, 78, 171, 91, 93, 225, 235, 16, 226, 1, 150,
-			69, 168, 121, 129, 82, 207, 124, 22, 28, 132, 194, 123, 99, 87,
-			173, 121, 16, 109, 180, 170, 158, 64, 210, 140, 150, 15, 75, 66,
-			63, 80, 17, 74, 231, 25, 188, 98, 131, 229, 129, 115, 157, 244,
-			156, 165, 90, 152, 95, 200, 129, 210, 187, 190, 92, 126, 124, 245,
-			69, 143, 105, 134, 92, 199, 110, 186, 215, 148, 97, 57, 30, 117,
-			110, 106, 67, 111, 242, 159, 211, 230, 226, 228, 61, 119, 250, 140,
-			248, 62, 71, 153, 203, 223, 118, 95, 73, 212, 117, 136, 88, 30,
-			91, 201, 19, 137, 185, 23, 249, 61, 78, 139, 3, 48, 199, 102,
-			22, 98, 63, 97, 169, 96, 212, 147, 60, 121, 121, 79, 230, 173,
-			48, 168, 205, 170, 29, 123, 43, 95, 47, 213, 245, 56, 162, 225,
-			251, 109, 61, 142, 200, 211, 111, 119, 251, 150, 137, 254, 237, 115,
-			87, 45, 19, 253, 219, 215, 111, 136, 127, 225, 40, 27, 253, 183,
-			1, 245, 111, 56, 186, 93, 181, 114, 79, 192, 94, 143, 221, 167,
-			226, 47, 67, 77, 42, 197, 48, 197, 151, 196, 97, 224, 39, 142,
-			72, 227, 20, 98, 215, 192, 166, 211, 121, 0, 201, 119, 153, 28,
-			170, 55, 191, 95, 247, 175, 221, 190, 102, 41, 205, 96, 51, 123,
-			158, 47, 163, 154, 68, 206, 148, 202, 17, 48, 202, 51, 192, 195,
-			190, 9, 203, 135, 224, 237, 238, 9, 203, 135, 224, 237, 179, 87,
-			45, 31, 130, 183, 129, 38, 191, 28, 81, 78, 4, 247, 221, 75,
-			137, 159, 140, 224, 11, 112, 33, 141, 42, 114, 1, 99, 167, 203,
-			113, 198, 97, 198, 77, 27, 213, 91, 102, 41, 201, 135, 237, 25,
-			173, 146, 230, 91, 37, 235, 161, 90, 56, 137, 171, 160, 135, 44,
-			102, 72, 243, 223, 106, 173, 244, 137, 29, 167, 91, 46, 87, 38,
-			188, 160, 253, 95, 239, 121, 106, 94, 75, 117, 63, 126, 50, 124,
-			80, 69, 195, 46, 106, 229, 54, 222, 223, 161, 102, 59, 180, 91,
-			169, 86, 140, 250, 199, 66, 89, 137, 124, 140, 111, 200, 58, 154,
-			5, 138, 58, 111, 239, 32, 6, 214, 174, 110, 161, 141, 122, 136,
-			48, 73, 161, 17, 99, 148, 175, 234, 137, 99, 189, 35, 171, 52,
-			239, 69, 146, 10, 42, 197, 124, 25, 247, 14, 190, 35, 132, 13,
-			104, 87, 233, 88, 69, 200, 206, 57, 216, 155, 209, 139, 22, 125,
-			60, 238, 187, 111, 191, 98, 249, 120, 220, 119, 7, 45, 31, 143,
-			251, 67, 231, 44, 31, 143, 251, 23, 46, 26, 119, 221, 101, 247,
-			145, 237, 174, 187, 44, 250, 197, 143, 57, 156, 4, 73, 112, 173,
-			55, 158, 248, 186, 35, 117, 38, 20, 219, 177, 65, 79, 130, 208,
-			84, 12, 93, 96, 210, 193, 50, 169, 180, 150, 7, 32, 234, 227,
-			155, 185, 252, 160, 15, 75, 211, 194, 167, 50, 202, 104, 1, 3,
-			155, 30, 4, 207, 241, 57, 32, 245, 136, 239, 1, 214, 91, 91,
-			93, 126, 68, 114, 117, 232, 132, 74, 125, 37, 175, 95, 20, 55,
-			215, 122, 181, 211, 48, 9, 156, 161, 180, 203, 105, 85, 28, 63,
-			199, 6, 244, 103, 167, 53, 237, 114, 250, 7, 35, 198, 73, 249,
-			11, 222, 153, 196, 255, 225, 250, 43, 230, 52, 140, 115, 231, 164,
-			87, 59, 166, 176, 208, 20, 72, 14, 21, 126, 192, 99, 38, 124,
-			222, 82, 150, 38, 73, 212, 178, 202, 25, 155, 10, 31, 161, 229,
-			253, 116, 74, 207, 59, 190, 38, 134, 31, 187, 37, 222, 186, 42,
-			124, 127, 149, 223, 135, 121, 179, 95, 43, 161, 236, 56, 37, 13,
-			3, 166, 100, 96, 119, 123, 150, 243, 164, 70, 165, 41, 189, 17,
-			103, 12, 233, 128, 97, 2, 74, 242, 189, 68, 142, 230, 200, 15,
-			145, 99, 224, 209, 150, 93, 130, 113, 179, 151, 131, 70, 107, 14,
-			86, 156, 14, 251, 206, 185, 64, 136, 107, 55, 87, 22, 52, 26,
-			168, 96, 107, 233, 16, 110, 113, 5, 12, 207, 229, 223, 152, 91,
-			89, 8, 5, 61, 173, 5, 248, 184, 51, 137, 88, 248, 77, 187,
-			157, 240, 209, 239, 11, 222, 90, 60, 228, 36, 254, 133, 22, 39,
-			241, 47, 116, 135, 95, 149, 249, 194, 248, 105, 227, 197, 244, 216,
-			141, 91, 94, 76, 143, 245, 124, 39, 47, 166, 199, 110, 135, 229,
-			197, 244, 184, 179, 215, 242, 98, 122, 44, 77, 100, 201, 179, 244,
-			125, 119, 199, 246, 240, 125, 95, 12, 136, 191, 198, 235, 4, 131,
-			13, 63, 233, 61, 151, 248, 129, 207, 180, 78, 164, 30, 229, 83,
-			86, 138, 44, 245, 153, 215, 74, 120, 62, 233, 197, 66, 49, 127,
-			159, 232, 197, 193, 81, 127, 67, 105, 151, 211, 170, 56, 126, 214,
-			139, 195, 161, 197, 18, 74, 187, 156, 86, 197, 49, 57, 168, 125,
-			9, 187, 200, 123, 210, 78, 203, 239, 170, 56, 250, 68, 142, 104,
-			119, 187, 46, 220, 127, 66, 105, 151, 211, 170, 56, 108, 65, 79,
-			78, 105, 231, 191, 46, 100, 100, 161, 180, 203, 105, 85, 28, 189,
-			50, 79, 107, 191, 231, 46, 28, 207, 80, 218, 229, 180, 42, 14,
-			131, 255, 228, 172, 175, 63, 163, 43, 97, 40, 237, 114, 250, 231,
-			92, 227, 199, 189, 237, 157, 78, 252, 136, 27, 22, 117, 121, 10,
-			91, 162, 176, 150, 250, 229, 218, 214, 17, 159, 201, 36, 9, 182,
-			2, 35, 193, 243, 221, 75, 67, 219, 151, 217, 34, 249, 241, 18,
-			185, 117, 197, 171, 111, 43, 105, 26, 201, 43, 217, 61, 54, 56,
-			3, 214, 67, 43, 46, 21, 118, 86, 145, 22, 90, 242, 54, 166,
-			22, 236, 201, 171, 177, 124, 8, 99, 169, 218, 80, 79, 142, 192,
-			214, 174, 194, 154, 177, 153, 24, 73, 141, 225, 176, 3, 182, 115,
-			251, 182, 247, 196, 248, 123, 226, 98, 221, 214, 62, 98, 44, 78,
-			111, 119, 141, 134, 156, 219, 183, 181, 199, 37, 186, 103, 186, 35,
-			202, 89, 2, 96, 237, 234, 69, 71, 174, 159, 187, 174, 10, 254,
-			140, 163, 181, 43, 212, 243, 67, 232, 246, 185, 75, 143, 137, 161,
-			125, 113, 251, 51, 82, 188, 169, 40, 193, 207, 224, 216, 241, 191,
-			181, 171, 32, 186, 135, 238, 80, 226, 127, 104, 55, 198, 210, 202,
-			206, 145, 238, 249, 240, 188, 85, 9, 14, 108, 155, 65, 188, 236,
-			251, 98, 181, 137, 86, 85, 56, 6, 77, 178, 25, 160, 104, 220,
-			234, 217, 12, 203, 123, 68, 9, 38, 73, 75, 14, 73, 30, 57,
-			144, 219, 57, 178, 24, 159, 218, 4, 33, 128, 154, 142, 89, 190,
-			123, 102, 233, 1, 70, 20, 95, 42, 41, 54, 165, 71, 215, 22,
-			201, 162, 200, 93, 55, 240, 124, 67, 131, 53, 5, 204, 161, 130,
-			170, 147, 242, 225, 148, 220, 117, 242, 69, 50, 197, 124, 94, 202,
-			219, 38, 14, 211, 97, 27, 135, 25, 105, 187, 41, 197, 155, 67,
-			108, 205, 156, 149, 244, 129, 130, 14, 164, 181, 96, 187, 76, 33,
-			201, 245, 161, 68, 29, 101, 229, 172, 180, 132, 47, 50, 10, 170,
-			145, 237, 161, 148, 244, 89, 185, 70, 65, 202, 89, 215, 160, 206,
-			160, 152, 163, 165, 33, 140, 157, 109, 168, 200, 186, 6, 117, 84,
-			144, 91, 5, 158, 132, 24, 135, 167, 60, 95, 159, 26, 193, 24,
-			67, 110, 108, 151, 243, 59, 210, 156, 140, 144, 106, 85, 135, 236,
-			230, 139, 234, 94, 122, 90, 90, 170, 105, 225, 25, 168, 1, 95,
-			249, 193, 140, 164, 31, 52, 10, 169, 25, 185, 88, 2, 203, 160,
-			138, 92, 56, 89, 180, 68, 44, 194, 154, 229, 167, 180, 233, 89,
-			54, 168, 234, 115, 157, 223, 211, 41, 138, 208, 44, 74, 154, 3,
-			25, 157, 247, 120, 231, 198, 110, 215, 80, 70, 69, 19, 175, 35,
-			61, 208, 145, 194, 145, 88, 182, 34, 252, 105, 202, 142, 176, 124,
-			232, 62, 59, 173, 162, 40, 163, 63, 169, 116, 87, 230, 168, 2,
-			135, 29, 253, 86, 132, 229, 195, 248, 160, 248, 159, 29, 105, 86,
-			28, 249, 178, 59, 157, 248, 125, 199, 183, 33, 235, 54, 91, 222,
-			168, 210, 70, 147, 41, 165, 178, 0, 6, 103, 122, 44, 15, 110,
-			130, 110, 161, 67, 189, 80, 236, 77, 133, 203, 151, 220, 12, 95,
-			60, 170, 171, 195, 17, 106, 182, 155, 48, 22, 149, 134, 140, 106,
-			79, 47, 165, 240, 132, 148, 69, 172, 103, 165, 24, 110, 82, 26,
-			247, 161, 125, 97, 64, 26, 28, 101, 12, 41, 31, 138, 194, 19,
-			46, 244, 80, 167, 128, 173, 124, 185, 91, 69, 155, 198, 253, 238,
-			203, 163, 231, 85, 10, 40, 243, 229, 75, 83, 226, 135, 29, 101,
-			36, 253, 21, 199, 189, 152, 248, 138, 69, 26, 214, 77, 161, 89,
-			62, 159, 202, 73, 33, 117, 20, 5, 173, 141, 100, 92, 146, 210,
-			246, 82, 156, 216, 13, 10, 5, 51, 203, 208, 240, 226, 158, 148,
-			135, 181, 192, 244, 165, 87, 153, 81, 35, 78, 194, 50, 162, 254,
-			10, 58, 227, 25, 35, 234, 175, 56, 113, 223, 50, 162, 254, 138,
-			115, 254, 130, 248, 249, 40, 245, 7, 146, 63, 238, 184, 137, 196,
-			143, 71, 213, 86, 181, 91, 197, 48, 51, 97, 147, 89, 60, 111,
-			107, 19, 138, 170, 189, 243, 36, 109, 235, 10, 24, 119, 101, 7,
-			202, 170, 77, 86, 127, 200, 189, 140, 86, 60, 191, 245, 73, 75,
-			2, 77, 33, 229, 242, 64, 83, 41, 214, 224, 33, 231, 197, 22,
-			229, 190, 72, 62, 45, 69, 105, 209, 108, 112, 98, 232, 211, 138,
-			129, 191, 249, 38, 178, 239, 25, 105, 233, 184, 191, 31, 228, 107,
-			242, 193, 134, 167, 192, 12, 248, 43, 46, 11, 21, 200, 141, 120,
-			58, 91, 69, 162, 17, 118, 216, 20, 92, 110, 208, 239, 218, 186,
-			9, 214, 202, 48, 47, 49, 141, 110, 231, 203, 168, 193, 83, 143,
-			183, 151, 42, 168, 198, 150, 38, 80, 20, 97, 157, 109, 54, 113,
-			95, 57, 148, 106, 93, 9, 117, 90, 169, 13, 17, 149, 25, 31,
-			205, 143, 224, 164, 104, 76, 58, 90, 181, 175, 138, 113, 242, 163,
-			15, 248, 135, 217, 167, 217, 163, 91, 58, 186, 193, 6, 115, 245,
-			106, 235, 131, 78, 100, 212, 43, 245, 148, 161, 35, 112, 158, 137,
-			90, 14, 208, 8, 155, 223, 87, 155, 213, 246, 254, 5, 68, 79,
-			118, 65, 107, 185, 140, 249, 241, 116, 190, 94, 111, 238, 41, 251,
-			154, 99, 244, 113, 210, 18, 124, 70, 30, 97, 241, 143, 156, 54,
-			82, 184, 144, 118, 129, 82, 190, 65, 50, 75, 225, 55, 213, 34,
-			103, 229, 121, 171, 150, 12, 153, 158, 156, 183, 229, 149, 16, 115,
-			154, 54, 230, 41, 198, 75, 215, 208, 186, 20, 58, 204, 51, 176,
-			208, 35, 97, 51, 218, 83, 1, 29, 64, 96, 153, 124, 197, 81,
-			207, 18, 69, 162, 180, 110, 148, 107, 2, 158, 13, 126, 220, 233,
-			31, 86, 73, 90, 85, 99, 167, 196, 223, 142, 208, 34, 131, 5,
-			250, 83, 232, 254, 250, 215, 35, 112, 64, 132, 221, 40, 116, 77,
-			252, 148, 77, 250, 212, 179, 16, 51, 126, 78, 223, 33, 90, 143,
-			121, 89, 222, 228, 18, 221, 86, 119, 47, 212, 255, 41, 103, 79,
-			227, 31, 164, 35, 38, 0, 180, 105, 165, 66, 173, 5, 179, 92,
-			34, 172, 182, 101, 9, 160, 132, 58, 42, 56, 189, 4, 232, 189,
-			38, 131, 49, 207, 164, 108, 5, 142, 30, 128, 74, 184, 49, 188,
-			195, 32, 179, 11, 182, 177, 80, 51, 176, 22, 60, 175, 62, 147,
-			114, 140, 52, 19, 131, 173, 179, 178, 131, 46, 16, 213, 218, 51,
-			126, 146, 165, 169, 164, 19, 226, 5, 117, 181, 111, 31, 67, 41,
-			75, 76, 8, 137, 192, 218, 58, 17, 223, 201, 228, 149, 169, 220,
-			122, 133, 33, 159, 161, 12, 217, 186, 195, 36, 44, 85, 154, 58,
-			155, 236, 213, 121, 200, 209, 59, 231, 167, 208, 171, 55, 33, 7,
-			21, 125, 115, 126, 202, 56, 167, 224, 45, 208, 79, 57, 29, 113,
-			149, 140, 96, 114, 120, 68, 219, 124, 252, 251, 118, 49, 196, 33,
-			169, 149, 124, 247, 205, 188, 83, 112, 212, 230, 232, 39, 28, 17,
-			11, 25, 159, 126, 51, 166, 201, 45, 97, 154, 221, 214, 48, 205,
-			45, 209, 162, 63, 61, 20, 173, 85, 250, 254, 215, 60, 182, 87,
-			26, 252, 78, 189, 231, 182, 130, 158, 158, 178, 46, 183, 23, 249,
-			114, 187, 23, 58, 150, 248, 156, 223, 74, 123, 163, 12, 218, 38,
-			179, 124, 185, 123, 146, 244, 99, 185, 136, 171, 91, 241, 222, 206,
-			49, 49, 170, 110, 197, 251, 221, 51, 9, 161, 180, 135, 69, 251,
-			126, 187, 223, 237, 77, 88, 246, 79, 253, 33, 243, 193, 254, 144,
-			249, 96, 191, 84, 111, 208, 245, 246, 128, 123, 202, 186, 241, 29,
-			112, 251, 109, 51, 184, 129, 208, 141, 239, 64, 215, 144, 117, 227,
-			59, 48, 58, 102, 98, 37, 198, 221, 43, 214, 213, 104, 220, 29,
-			56, 101, 93, 141, 198, 221, 113, 235, 106, 52, 126, 250, 146, 117,
-			53, 26, 159, 185, 172, 39, 254, 215, 103, 197, 0, 207, 94, 114,
-			156, 56, 193, 204, 105, 242, 7, 162, 66, 152, 7, 114, 226, 215,
-			77, 156, 112, 148, 237, 229, 140, 62, 98, 240, 211, 157, 183, 30,
-			242, 250, 188, 232, 213, 42, 143, 60, 240, 19, 105, 2, 50, 174,
-			13, 54, 8, 180, 222, 65, 176, 72, 86, 181, 66, 169, 248, 172,
-			136, 214, 233, 105, 30, 105, 45, 52, 220, 82, 149, 223, 237, 201,
-			202, 66, 137, 251, 162, 199, 6, 118, 66, 80, 233, 152, 136, 148,
-			171, 7, 50, 160, 52, 254, 196, 208, 228, 187, 48, 197, 165, 129,
-			13, 253, 78, 252, 0, 172, 26, 6, 31, 127, 69, 68, 139, 85,
-			60, 134, 203, 152, 210, 167, 143, 197, 34, 181, 68, 101, 178, 178,
-			108, 124, 138, 2, 81, 215, 26, 114, 181, 30, 243, 162, 27, 127,
-			143, 159, 23, 17, 216, 230, 165, 53, 203, 49, 197, 240, 107, 124,
-			77, 244, 145, 94, 241, 227, 39, 252, 34, 17, 62, 226, 128, 20,
-			153, 62, 30, 151, 121, 42, 123, 135, 139, 114, 16, 230, 222, 188,
-			157, 23, 95, 22, 189, 100, 214, 171, 225, 69, 9, 222, 212, 241,
-			240, 210, 88, 52, 4, 174, 39, 176, 178, 18, 95, 16, 241, 163,
-			77, 30, 19, 166, 57, 25, 14, 211, 76, 15, 116, 28, 125, 14,
-			207, 14, 33, 159, 21, 3, 71, 26, 63, 6, 240, 84, 24, 240,
-			113, 212, 54, 143, 117, 248, 34, 202, 131, 21, 239, 18, 237, 239,
-			110, 166, 115, 27, 28, 61, 123, 126, 99, 35, 189, 178, 190, 17,
-			115, 216, 38, 42, 219, 81, 175, 214, 240, 57, 145, 251, 191, 115,
-			137, 109, 133, 190, 254, 29, 108, 43, 100, 108, 62, 255, 138, 67,
-			156, 179, 189, 7, 251, 147, 248, 30, 235, 29, 172, 240, 195, 192,
-			242, 21, 95, 142, 252, 162, 29, 203, 66, 70, 17, 70, 70, 61,
-			100, 209, 31, 250, 149, 33, 147, 0, 205, 62, 141, 237, 7, 73,
-			18, 33, 61, 171, 176, 172, 146, 226, 226, 119, 180, 201, 120, 204,
-			157, 76, 252, 87, 225, 167, 174, 194, 102, 27, 124, 15, 141, 118,
-			10, 210, 70, 66, 57, 54, 153, 91, 191, 124, 221, 82, 144, 107,
-			159, 60, 230, 121, 116, 78, 144, 7, 122, 41, 232, 202, 251, 67,
-			41, 105, 73, 67, 131, 57, 58, 4, 226, 201, 17, 15, 121, 40,
-			176, 40, 85, 210, 20, 42, 15, 116, 91, 250, 189, 86, 2, 57,
-			21, 50, 137, 138, 185, 61, 67, 214, 150, 17, 115, 99, 214, 150,
-			17, 27, 56, 99, 109, 25, 49, 255, 156, 137, 211, 29, 119, 71,
-			236, 56, 221, 113, 29, 127, 153, 40, 52, 40, 67, 180, 170, 56,
-			221, 131, 94, 92, 197, 95, 102, 237, 251, 96, 75, 156, 238, 193,
-			238, 152, 176, 227, 116, 15, 74, 149, 175, 124, 1, 119, 72, 199,
-			95, 150, 70, 73, 222, 224, 176, 46, 79, 102, 73, 22, 60, 50,
-			76, 234, 54, 113, 193, 201, 52, 73, 199, 95, 166, 173, 106, 88,
-			199, 75, 230, 205, 10, 210, 131, 186, 60, 42, 252, 135, 45, 120,
-			136, 192, 176, 142, 151, 204, 91, 214, 112, 124, 80, 124, 77, 91,
-			223, 143, 187, 151, 19, 255, 222, 241, 67, 219, 201, 113, 83, 34,
-			239, 243, 39, 251, 250, 151, 222, 113, 51, 115, 153, 174, 111, 209,
-			245, 86, 93, 247, 190, 69, 55, 37, 176, 132, 144, 239, 91, 185,
-			80, 70, 58, 103, 18, 72, 24, 221, 247, 32, 43, 73, 197, 64,
-			224, 190, 71, 165, 241, 169, 119, 40, 141, 121, 65, 205, 215, 110,
-			120, 148, 70, 124, 24, 9, 134, 79, 141, 178, 131, 39, 252, 180,
-			139, 99, 18, 207, 12, 106, 250, 77, 171, 112, 90, 115, 51, 41,
-			29, 92, 220, 67, 50, 8, 203, 188, 121, 188, 123, 212, 146, 27,
-			198, 199, 46, 90, 114, 195, 248, 244, 140, 248, 137, 46, 25, 135,
-			188, 61, 237, 126, 213, 137, 36, 190, 191, 203, 159, 247, 153, 161,
-			183, 208, 142, 151, 56, 223, 56, 213, 101, 252, 166, 125, 230, 158,
-			120, 246, 228, 199, 111, 141, 186, 76, 24, 33, 95, 238, 30, 41,
-			95, 242, 103, 122, 247, 94, 26, 81, 212, 246, 216, 204, 168, 88,
-			109, 204, 22, 3, 58, 222, 17, 59, 104, 236, 166, 240, 190, 26,
-			111, 154, 242, 232, 88, 152, 244, 235, 249, 67, 169, 79, 8, 72,
-			245, 23, 54, 194, 144, 120, 129, 12, 12, 39, 64, 58, 175, 78,
-			151, 138, 111, 22, 131, 124, 113, 43, 8, 182, 103, 110, 203, 3,
-			171, 180, 163, 121, 211, 127, 67, 75, 235, 111, 249, 254, 121, 228,
-			225, 242, 240, 168, 205, 85, 82, 39, 60, 168, 5, 149, 233, 137,
-			180, 35, 229, 237, 135, 180, 160, 144, 124, 78, 205, 247, 39, 97,
-			239, 153, 188, 237, 79, 210, 222, 50, 153, 212, 217, 228, 37, 141,
-			175, 170, 77, 226, 213, 243, 100, 210, 191, 6, 115, 108, 82, 190,
-			224, 154, 164, 19, 218, 7, 166, 116, 189, 185, 133, 101, 191, 52,
-			185, 87, 223, 129, 31, 215, 174, 189, 72, 250, 58, 113, 253, 133,
-			42, 249, 130, 8, 32, 153, 162, 77, 130, 239, 185, 246, 217, 137,
-			16, 138, 66, 245, 166, 190, 185, 224, 143, 234, 141, 158, 148, 57,
-			62, 132, 65, 181, 20, 179, 14, 45, 33, 170, 212, 234, 229, 6,
-			146, 229, 16, 123, 171, 115, 171, 207, 32, 143, 14, 167, 166, 63,
-			27, 120, 74, 37, 13, 13, 157, 131, 121, 34, 226, 45, 237, 126,
-			61, 104, 22, 171, 179, 36, 140, 114, 239, 88, 174, 186, 109, 172,
-			193, 124, 63, 44, 18, 221, 214, 207, 220, 217, 157, 164, 81, 80,
-			221, 156, 212, 175, 222, 93, 96, 86, 112, 194, 24, 167, 244, 0,
-			242, 97, 250, 218, 220, 103, 173, 8, 99, 153, 226, 145, 123, 239,
-			218, 181, 80, 197, 227, 168, 150, 34, 154, 188, 103, 230, 3, 210,
-			100, 173, 198, 83, 253, 152, 14, 135, 68, 54, 211, 223, 207, 210,
-			153, 247, 174, 125, 64, 72, 125, 51, 61, 121, 111, 238, 3, 211,
-			25, 131, 224, 50, 44, 228, 26, 156, 169, 166, 82, 236, 116, 56,
-			245, 222, 148, 95, 216, 133, 109, 190, 64, 44, 64, 242, 56, 0,
-			155, 223, 87, 58, 232, 188, 143, 143, 243, 212, 203, 249, 250, 174,
-			226, 104, 116, 69, 155, 22, 49, 10, 192, 1, 41, 60, 204, 221,
-			241, 50, 237, 114, 27, 224, 208, 214, 119, 186, 250, 41, 60, 43,
-			165, 113, 227, 187, 27, 229, 215, 220, 101, 6, 198, 113, 150, 239,
-			178, 203, 28, 216, 124, 238, 246, 217, 149, 48, 84, 115, 52, 110,
-			21, 113, 40, 167, 215, 206, 129, 74, 247, 98, 3, 226, 191, 113,
-			205, 235, 12, 235, 222, 72, 226, 239, 185, 62, 11, 138, 150, 166,
-			135, 53, 142, 5, 232, 9, 233, 210, 21, 211, 36, 103, 74, 158,
-			190, 252, 180, 62, 234, 24, 121, 255, 224, 200, 224, 192, 221, 159,
-			231, 75, 101, 21, 111, 72, 22, 173, 5, 218, 112, 74, 233, 22,
-			81, 169, 160, 244, 138, 250, 69, 78, 75, 101, 39, 172, 112, 16,
-			234, 234, 25, 29, 250, 164, 233, 19, 185, 118, 63, 157, 87, 119,
-			86, 252, 226, 39, 137, 71, 48, 98, 12, 245, 130, 188, 148, 36,
-			62, 15, 195, 108, 66, 151, 11, 14, 36, 32, 95, 170, 247, 91,
-			156, 255, 14, 20, 155, 6, 128, 188, 141, 208, 222, 170, 158, 223,
-			32, 66, 161, 202, 168, 46, 111, 27, 213, 195, 22, 235, 214, 168,
-			146, 16, 180, 222, 242, 176, 197, 122, 247, 128, 176, 31, 182, 88,
-			31, 26, 22, 127, 228, 240, 36, 17, 20, 93, 57, 241, 7, 142,
-			127, 221, 47, 25, 1, 90, 198, 203, 35, 249, 156, 182, 19, 186,
-			110, 199, 235, 247, 67, 99, 141, 67, 5, 144, 176, 147, 146, 49,
-			78, 202, 55, 217, 75, 58, 212, 194, 126, 181, 94, 47, 201, 49,
-			145, 183, 251, 90, 95, 117, 181, 90, 59, 114, 65, 28, 138, 39,
-			34, 180, 227, 27, 221, 203, 161, 68, 30, 224, 245, 27, 236, 149,
-			20, 228, 1, 4, 101, 196, 96, 150, 98, 115, 104, 51, 166, 189,
-			122, 80, 126, 30, 212, 165, 1, 0, 118, 17, 3, 79, 247, 142,
-			168, 101, 209, 133, 81, 159, 251, 213, 71, 180, 14, 120, 208, 171,
-			132, 45, 182, 14, 8, 165, 93, 78, 255, 115, 199, 188, 4, 242,
-			158, 119, 54, 241, 219, 142, 159, 195, 243, 37, 173, 208, 180, 188,
-			163, 172, 238, 243, 20, 193, 110, 193, 6, 88, 144, 33, 34, 100,
-			159, 74, 53, 12, 180, 128, 36, 147, 155, 255, 161, 220, 168, 43,
-			40, 247, 64, 17, 52, 98, 0, 40, 95, 108, 146, 113, 213, 81,
-			8, 83, 146, 204, 83, 190, 222, 50, 168, 211, 124, 241, 34, 204,
-			77, 148, 111, 226, 6, 38, 229, 25, 130, 118, 122, 6, 195, 50,
-			10, 175, 60, 107, 42, 161, 92, 250, 158, 247, 160, 95, 79, 21,
-			20, 130, 222, 147, 225, 232, 213, 155, 38, 239, 13, 39, 132, 253,
-			166, 201, 123, 20, 229, 150, 41, 3, 148, 122, 95, 6, 26, 106,
-			147, 81, 212, 223, 247, 222, 59, 171, 203, 163, 26, 229, 125, 11,
-			30, 146, 242, 253, 225, 49, 147, 6, 120, 239, 143, 159, 22, 127,
-			236, 73, 128, 24, 139, 215, 187, 157, 248, 231, 158, 31, 58, 253,
-			182, 72, 87, 242, 13, 97, 232, 220, 20, 111, 88, 37, 16, 168,
-			130, 41, 201, 23, 82, 178, 46, 101, 134, 158, 17, 64, 82, 32,
-			71, 38, 145, 73, 93, 176, 144, 12, 145, 52, 118, 205, 21, 100,
-			34, 181, 60, 27, 220, 19, 31, 175, 183, 74, 87, 252, 178, 108,
-			77, 43, 207, 233, 109, 88, 88, 65, 28, 24, 195, 126, 30, 150,
-			132, 21, 220, 47, 81, 152, 158, 68, 33, 164, 186, 77, 105, 228,
-			73, 248, 232, 44, 204, 37, 252, 167, 222, 104, 110, 111, 79, 126,
-			240, 194, 108, 9, 27, 74, 219, 108, 217, 206, 154, 110, 41, 49,
-			81, 218, 65, 144, 60, 73, 123, 58, 183, 8, 178, 131, 108, 242,
-			3, 157, 153, 130, 150, 41, 159, 154, 62, 38, 31, 81, 57, 38,
-			91, 162, 118, 210, 7, 217, 135, 79, 251, 14, 255, 200, 118, 133,
-			126, 134, 188, 89, 199, 23, 190, 89, 29, 78, 221, 161, 59, 26,
-			73, 211, 186, 14, 159, 19, 200, 72, 60, 176, 55, 24, 175, 7,
-			193, 156, 146, 143, 173, 53, 28, 171, 50, 191, 223, 140, 23, 235,
-			100, 34, 76, 39, 77, 21, 43, 117, 15, 5, 152, 154, 53, 245,
-			49, 204, 244, 190, 247, 254, 25, 61, 21, 209, 72, 116, 223, 187,
-			100, 210, 24, 36, 122, 234, 85, 147, 198, 169, 249, 218, 45, 241,
-			125, 106, 170, 210, 37, 164, 247, 74, 226, 79, 35, 190, 173, 79,
-			57, 121, 170, 18, 223, 55, 147, 148, 42, 181, 204, 78, 113, 226,
-			244, 100, 83, 27, 154, 5, 199, 77, 78, 53, 55, 213, 212, 20,
-			255, 119, 207, 205, 64, 246, 230, 37, 179, 18, 100, 31, 156, 24,
-			220, 156, 201, 189, 246, 1, 204, 24, 245, 141, 154, 110, 253, 118,
-			141, 191, 33, 58, 173, 159, 174, 243, 39, 70, 241, 47, 118, 106,
-			241, 20, 170, 203, 152, 203, 52, 3, 188, 120, 59, 76, 129, 253,
-			219, 122, 86, 97, 160, 216, 175, 96, 32, 53, 157, 65, 151, 209,
-			147, 87, 77, 6, 93, 71, 95, 191, 193, 106, 9, 188, 93, 255,
-			154, 227, 158, 177, 148, 208, 95, 83, 23, 219, 164, 132, 134, 164,
-			188, 216, 230, 64, 137, 95, 115, 226, 99, 42, 25, 193, 164, 229,
-			116, 251, 111, 207, 137, 137, 214, 107, 150, 162, 244, 171, 146, 74,
-			233, 254, 150, 11, 142, 201, 219, 162, 115, 73, 22, 65, 7, 217,
-			122, 0, 103, 183, 98, 157, 244, 128, 168, 173, 227, 36, 170, 125,
-			43, 249, 74, 149, 189, 82, 219, 179, 156, 88, 88, 23, 131, 133,
-			234, 94, 235, 157, 201, 66, 175, 2, 184, 142, 57, 235, 206, 207,
-			56, 206, 207, 185, 145, 187, 235, 11, 191, 236, 78, 220, 229, 178,
-			235, 234, 126, 229, 97, 80, 46, 191, 131, 139, 117, 3, 21, 229,
-			247, 127, 201, 199, 139, 149, 137, 182, 39, 142, 248, 221, 30, 82,
-			10, 78, 180, 197, 175, 255, 163, 30, 159, 42, 20, 170, 101, 127,
-			161, 137, 158, 242, 24, 16, 130, 65, 161, 194, 140, 66, 107, 97,
-			96, 32, 144, 152, 81, 175, 128, 231, 100, 244, 72, 177, 53, 137,
-			115, 175, 201, 10, 120, 205, 154, 242, 79, 80, 32, 238, 54, 26,
-			251, 245, 219, 87, 175, 22, 65, 234, 41, 227, 126, 93, 87, 189,
-			67, 79, 228, 125, 137, 196, 236, 22, 35, 113, 21, 230, 91, 54,
-			208, 7, 95, 60, 218, 161, 100, 128, 134, 137, 48, 129, 164, 2,
-			18, 115, 182, 74, 149, 60, 136, 166, 136, 87, 157, 55, 26, 156,
-			87, 114, 195, 17, 62, 191, 146, 43, 109, 167, 137, 19, 24, 231,
-			27, 203, 116, 252, 136, 43, 5, 142, 77, 137, 165, 5, 18, 52,
-			97, 246, 242, 2, 199, 75, 235, 48, 98, 36, 88, 219, 42, 209,
-			61, 22, 56, 212, 133, 52, 187, 67, 192, 39, 73, 49, 20, 80,
-			26, 165, 130, 114, 133, 81, 78, 42, 118, 139, 149, 86, 165, 2,
-			134, 54, 195, 24, 1, 200, 102, 79, 64, 2, 109, 133, 13, 45,
-			20, 18, 208, 71, 52, 131, 53, 120, 8, 131, 200, 183, 133, 135,
-			246, 239, 9, 93, 114, 43, 57, 148, 205, 195, 112, 51, 5, 241,
-			188, 28, 178, 77, 87, 254, 241, 54, 246, 186, 83, 171, 210, 232,
-			5, 1, 147, 138, 3, 16, 178, 231, 86, 165, 106, 190, 213, 217,
-			9, 170, 46, 72, 41, 42, 245, 35, 250, 60, 167, 76, 20, 64,
-			86, 131, 92, 82, 189, 2, 18, 123, 24, 149, 138, 105, 130, 30,
-			69, 33, 7, 11, 169, 218, 222, 110, 28, 228, 107, 129, 22, 89,
-			180, 170, 118, 191, 86, 194, 137, 85, 195, 185, 83, 225, 89, 68,
-			65, 90, 200, 64, 226, 94, 38, 231, 231, 214, 238, 108, 60, 156,
-			207, 166, 125, 248, 189, 158, 93, 123, 144, 193, 80, 234, 11, 143,
-			224, 99, 218, 95, 92, 91, 127, 148, 205, 220, 189, 183, 225, 223,
-			91, 91, 94, 74, 103, 115, 254, 252, 234, 18, 228, 174, 110, 100,
-			51, 11, 155, 27, 107, 217, 156, 240, 39, 231, 115, 80, 117, 146,
-			190, 204, 175, 62, 242, 211, 95, 88, 207, 166, 115, 57, 127, 45,
-			235, 103, 86, 214, 151, 51, 0, 13, 192, 103, 231, 87, 55, 50,
-			233, 92, 210, 207, 172, 46, 46, 111, 226, 43, 172, 73, 31, 32,
-			96, 56, 116, 56, 234, 98, 112, 113, 40, 183, 177, 150, 164, 102,
-			143, 214, 243, 215, 238, 248, 43, 233, 236, 226, 61, 72, 206, 47,
-			100, 150, 51, 27, 143, 168, 193, 59, 153, 141, 85, 108, 236, 206,
-			90, 22, 14, 103, 254, 250, 124, 118, 35, 179, 184, 185, 60, 159,
-			245, 215, 55, 179, 235, 107, 185, 180, 143, 61, 91, 202, 228, 22,
-			151, 231, 241, 105, 206, 20, 180, 15, 109, 250, 233, 7, 233, 213,
-			13, 63, 119, 111, 126, 121, 57, 220, 81, 225, 175, 61, 92, 77,
-			103, 17, 123, 187, 155, 254, 66, 26, 176, 156, 95, 88, 78, 99,
-			83, 212, 207, 165, 76, 54, 189, 184, 129, 29, 50, 191, 22, 129,
-			120, 128, 224, 50, 236, 138, 185, 245, 244, 98, 6, 126, 1, 61,
-			210, 208, 157, 249, 236, 163, 164, 4, 154, 75, 191, 187, 9, 165,
-			224, 163, 191, 52, 191, 50, 127, 23, 122, 55, 253, 105, 84, 129,
-			129, 89, 220, 204, 166, 87, 16, 107, 32, 69, 110, 115, 33, 183,
-			145, 217, 216, 220, 72, 251, 119, 215, 214, 150, 136, 216, 185, 116,
-			246, 65, 102, 49, 157, 123, 221, 95, 94, 203, 17, 193, 54, 115,
-			105, 64, 4, 99, 184, 83, 211, 0, 3, 200, 5, 223, 225, 247,
-			194, 102, 46, 67, 132, 203, 172, 110, 164, 179, 217, 205, 117, 124,
-			237, 118, 6, 70, 249, 33, 80, 6, 176, 156, 135, 186, 75, 68,
-			225, 181, 85, 236, 45, 206, 149, 244, 90, 246, 17, 130, 69, 58,
-			208, 8, 36, 253, 135, 247, 210, 144, 159, 69, 162, 18, 181, 230,
-			145, 12, 57, 160, 218, 226, 134, 93, 12, 26, 4, 34, 66, 151,
-			76, 63, 253, 213, 244, 221, 229, 204, 221, 244, 234, 98, 26, 63,
-			175, 33, 152, 135, 153, 92, 122, 6, 6, 44, 131, 47, 163, 34,
-			76, 36, 243, 195, 121, 104, 116, 147, 122, 141, 3, 5, 120, 9,
-			254, 109, 77, 221, 36, 141, 167, 159, 185, 227, 207, 47, 61, 200,
-			32, 230, 178, 52, 204, 128, 92, 70, 78, 23, 34, 219, 226, 61,
-			73, 115, 152, 254, 124, 87, 227, 119, 142, 226, 175, 206, 120, 100,
-			178, 237, 117, 188, 5, 238, 252, 227, 142, 54, 78, 192, 73, 17,
-			19, 80, 104, 178, 131, 108, 177, 41, 213, 198, 73, 144, 1, 57,
-			233, 112, 90, 22, 238, 128, 84, 226, 182, 132, 120, 190, 45, 41,
-			33, 58, 156, 224, 66, 216, 236, 249, 142, 65, 9, 145, 244, 32,
-			152, 100, 136, 124, 86, 199, 180, 44, 12, 16, 207, 143, 92, 145,
-			16, 47, 180, 165, 36, 68, 151, 19, 92, 8, 15, 93, 23, 58,
-			198, 37, 68, 50, 239, 197, 36, 67, 100, 51, 71, 76, 203, 194,
-			0, 241, 194, 196, 172, 132, 120, 177, 109, 82, 66, 140, 112, 130,
-			11, 161, 5, 250, 197, 142, 132, 132, 200, 47, 38, 118, 36, 36,
-			68, 249, 32, 98, 71, 66, 21, 198, 170, 103, 206, 73, 136, 151,
-			116, 175, 61, 78, 112, 33, 52, 82, 191, 212, 113, 65, 66, 164,
-			151, 139, 48, 201, 16, 249, 73, 34, 76, 203, 194, 248, 32, 226,
-			148, 234, 245, 84, 219, 57, 9, 177, 157, 19, 92, 8, 237, 216,
-			167, 58, 198, 36, 196, 118, 132, 136, 73, 134, 216, 78, 16, 49,
-			45, 11, 67, 175, 167, 198, 125, 241, 191, 72, 119, 253, 44, 72,
-			20, 137, 127, 134, 76, 67, 201, 38, 173, 247, 114, 59, 228, 170,
-			178, 93, 250, 56, 40, 206, 74, 235, 196, 250, 126, 190, 162, 158,
-			129, 176, 131, 84, 10, 242, 217, 242, 11, 202, 17, 70, 10, 73,
-			180, 19, 109, 215, 242, 5, 179, 223, 170, 15, 176, 139, 161, 192,
-			68, 73, 10, 171, 88, 45, 203, 176, 83, 153, 6, 7, 49, 85,
-			151, 126, 13, 101, 76, 90, 64, 63, 183, 98, 190, 38, 35, 76,
-			87, 208, 234, 73, 70, 106, 3, 25, 249, 112, 146, 226, 88, 78,
-			238, 193, 166, 178, 59, 169, 192, 212, 2, 182, 2, 131, 29, 69,
-			219, 173, 240, 14, 152, 87, 241, 164, 57, 204, 80, 1, 61, 101,
-			27, 7, 1, 249, 215, 28, 216, 165, 229, 27, 5, 228, 206, 169,
-			73, 133, 40, 148, 212, 237, 168, 52, 78, 100, 185, 184, 65, 10,
-			74, 232, 19, 191, 89, 97, 0, 165, 252, 172, 186, 226, 177, 14,
-			173, 229, 67, 255, 202, 236, 181, 185, 228, 220, 220, 156, 127, 24,
-			228, 73, 150, 198, 99, 16, 29, 92, 174, 221, 6, 129, 109, 111,
-			31, 77, 180, 116, 203, 28, 75, 192, 198, 144, 100, 8, 214, 100,
-			115, 8, 54, 41, 240, 152, 46, 144, 9, 0, 156, 9, 82, 169,
-			212, 235, 173, 223, 208, 141, 204, 254, 162, 27, 82, 98, 178, 250,
-			42, 175, 43, 148, 240, 172, 70, 242, 77, 132, 160, 83, 179, 220,
-			150, 74, 191, 222, 82, 137, 198, 92, 86, 225, 223, 170, 2, 165,
-			84, 35, 112, 96, 155, 62, 210, 208, 27, 254, 156, 127, 233, 82,
-			43, 172, 183, 252, 185, 25, 115, 39, 112, 164, 210, 149, 55, 253,
-			107, 175, 31, 249, 42, 155, 134, 111, 115, 234, 143, 44, 244, 194,
-			15, 202, 245, 32, 132, 64, 93, 3, 123, 235, 88, 12, 222, 120,
-			57, 6, 179, 47, 193, 224, 202, 113, 24, 88, 227, 127, 221, 140,
-			191, 25, 48, 154, 0, 38, 121, 197, 140, 216, 55, 63, 13, 78,
-			28, 236, 147, 39, 137, 180, 60, 181, 198, 252, 205, 240, 152, 3,
-			70, 173, 68, 120, 221, 84, 82, 51, 192, 26, 117, 187, 194, 145,
-			105, 96, 234, 132, 233, 28, 154, 116, 54, 137, 77, 133, 99, 169,
-			107, 198, 215, 20, 124, 203, 46, 120, 66, 27, 87, 142, 111, 227,
-			216, 57, 36, 140, 197, 65, 182, 51, 38, 246, 149, 193, 193, 3,
-			119, 40, 81, 240, 115, 196, 88, 53, 39, 148, 38, 3, 54, 103,
-			77, 249, 43, 242, 126, 152, 198, 122, 246, 198, 181, 87, 147, 175,
-			126, 238, 38, 242, 8, 252, 159, 162, 211, 93, 105, 201, 100, 101,
-			72, 189, 244, 60, 28, 42, 229, 129, 155, 141, 91, 161, 82, 30,
-			232, 88, 104, 164, 224, 237, 236, 183, 236, 2, 30, 196, 7, 197,
-			87, 35, 234, 46, 252, 67, 55, 158, 248, 119, 174, 66, 54, 196,
-			195, 243, 18, 247, 48, 19, 183, 120, 184, 221, 39, 97, 58, 165,
-			38, 27, 90, 245, 215, 235, 124, 47, 141, 214, 21, 10, 90, 45,
-			180, 165, 168, 27, 29, 232, 238, 83, 73, 43, 117, 95, 65, 190,
-			48, 168, 83, 135, 243, 206, 115, 58, 34, 84, 130, 157, 60, 253,
-			126, 74, 8, 201, 130, 172, 5, 210, 235, 152, 80, 177, 26, 148,
-			86, 29, 232, 192, 136, 177, 117, 63, 9, 106, 85, 233, 64, 169,
-			60, 129, 67, 208, 212, 165, 189, 182, 242, 160, 219, 10, 10, 194,
-			45, 173, 64, 90, 240, 108, 29, 198, 91, 183, 110, 37, 229, 255,
-			60, 132, 86, 198, 49, 195, 135, 138, 232, 15, 221, 7, 67, 150,
-			13, 223, 135, 161, 80, 118, 31, 118, 246, 90, 119, 241, 31, 198,
-			6, 180, 214, 227, 231, 95, 17, 177, 131, 124, 249, 25, 7, 26,
-			179, 140, 239, 62, 69, 23, 242, 45, 152, 164, 38, 142, 154, 249,
-			133, 77, 252, 126, 60, 42, 122, 108, 115, 156, 207, 108, 174, 202,
-			241, 193, 106, 135, 210, 216, 170, 47, 108, 51, 150, 229, 143, 241,
-			25, 225, 237, 1, 179, 147, 246, 111, 100, 186, 23, 142, 166, 12,
-			31, 179, 84, 4, 205, 194, 56, 194, 150, 103, 204, 194, 142, 58,
-			46, 171, 216, 90, 215, 77, 108, 45, 14, 225, 53, 118, 164, 252,
-			145, 168, 90, 127, 213, 17, 30, 54, 136, 166, 99, 197, 237, 186,
-			140, 86, 133, 63, 227, 111, 138, 46, 14, 132, 129, 166, 181, 46,
-			89, 248, 157, 61, 22, 217, 212, 146, 42, 150, 53, 53, 38, 175,
-			139, 46, 157, 31, 239, 17, 157, 112, 8, 3, 193, 127, 41, 23,
-			107, 139, 247, 138, 174, 133, 249, 197, 119, 56, 233, 196, 59, 133,
-			183, 0, 167, 137, 152, 155, 248, 178, 138, 241, 53, 46, 186, 200,
-			251, 15, 228, 165, 93, 169, 198, 234, 196, 248, 94, 152, 142, 191,
-			34, 240, 247, 19, 28, 104, 73, 233, 83, 71, 76, 126, 213, 202,
-			205, 118, 236, 113, 112, 234, 207, 18, 54, 44, 241, 159, 184, 38,
-			86, 216, 25, 33, 248, 14, 147, 162, 116, 49, 93, 186, 56, 7,
-			163, 114, 193, 103, 186, 15, 35, 128, 132, 6, 124, 254, 72, 189,
-			102, 29, 63, 103, 236, 66, 169, 64, 132, 10, 40, 51, 80, 42,
-			242, 217, 130, 145, 193, 116, 137, 145, 213, 65, 80, 124, 162, 195,
-			202, 113, 212, 174, 126, 153, 175, 60, 27, 16, 98, 165, 105, 5,
-			131, 172, 83, 100, 182, 222, 108, 47, 228, 154, 184, 152, 241, 83,
-			162, 115, 251, 160, 136, 196, 173, 83, 16, 182, 206, 108, 199, 54,
-			63, 179, 135, 116, 199, 251, 104, 254, 214, 201, 145, 203, 182, 228,
-			219, 118, 247, 255, 60, 201, 6, 211, 223, 251, 157, 106, 236, 55,
-			101, 2, 131, 77, 171, 192, 96, 93, 50, 70, 24, 240, 35, 1,
-			167, 35, 252, 9, 103, 160, 110, 109, 24, 216, 219, 41, 196, 239,
-			187, 124, 0, 25, 129, 174, 39, 254, 137, 27, 178, 213, 107, 185,
-			40, 64, 30, 198, 204, 211, 182, 194, 123, 87, 185, 17, 214, 170,
-			205, 157, 93, 246, 29, 209, 238, 11, 83, 116, 1, 122, 144, 175,
-			21, 67, 158, 45, 104, 4, 72, 164, 132, 77, 167, 136, 161, 236,
-			57, 138, 12, 178, 123, 188, 57, 178, 156, 244, 100, 140, 251, 164,
-			241, 6, 160, 219, 84, 18, 174, 242, 53, 140, 230, 92, 6, 74,
-			211, 245, 2, 70, 231, 80, 198, 84, 213, 154, 188, 209, 149, 154,
-			59, 125, 211, 78, 108, 202, 120, 184, 67, 18, 54, 152, 60, 117,
-			77, 152, 80, 186, 198, 79, 191, 82, 69, 151, 112, 188, 136, 199,
-			213, 46, 189, 105, 170, 50, 124, 11, 250, 218, 78, 235, 181, 44,
-			253, 18, 45, 3, 199, 145, 206, 33, 177, 164, 196, 141, 49, 247,
-			76, 226, 115, 250, 50, 54, 229, 231, 130, 224, 4, 135, 154, 109,
-			190, 130, 214, 55, 173, 33, 17, 98, 204, 29, 25, 177, 76, 11,
-			199, 66, 214, 232, 99, 33, 107, 244, 177, 241, 211, 226, 103, 181,
-			8, 225, 187, 163, 137, 31, 141, 248, 108, 243, 105, 130, 123, 133,
-			226, 251, 212, 216, 132, 65, 138, 166, 198, 183, 11, 78, 81, 24,
-			90, 44, 15, 195, 194, 145, 136, 133, 149, 69, 132, 172, 22, 10,
-			205, 26, 31, 59, 155, 21, 75, 232, 248, 72, 154, 152, 146, 63,
-			16, 134, 108, 46, 194, 92, 206, 99, 240, 105, 29, 150, 222, 196,
-			185, 166, 55, 131, 232, 61, 196, 188, 54, 108, 240, 119, 131, 252,
-			243, 67, 50, 49, 120, 157, 175, 250, 37, 78, 56, 50, 210, 119,
-			137, 163, 83, 161, 235, 38, 12, 52, 93, 243, 80, 68, 218, 2,
-			141, 220, 14, 76, 62, 146, 112, 0, 163, 93, 178, 198, 67, 79,
-			72, 220, 72, 113, 22, 176, 103, 154, 186, 226, 23, 173, 248, 26,
-			239, 126, 137, 120, 225, 208, 138, 213, 67, 228, 34, 204, 245, 43,
-			142, 28, 51, 165, 70, 15, 173, 112, 76, 229, 231, 172, 97, 71,
-			157, 47, 46, 3, 90, 51, 165, 10, 223, 0, 176, 226, 211, 8,
-			24, 190, 59, 118, 198, 50, 246, 243, 221, 30, 75, 192, 240, 123,
-			227, 150, 128, 225, 15, 143, 24, 187, 209, 73, 247, 154, 109, 55,
-			58, 41, 250, 196, 223, 114, 141, 225, 232, 148, 55, 144, 248, 79,
-			93, 127, 233, 78, 142, 61, 36, 14, 118, 3, 169, 31, 46, 169,
-			147, 52, 78, 224, 89, 54, 6, 152, 46, 213, 119, 103, 40, 4,
-			152, 191, 128, 94, 130, 45, 31, 200, 78, 72, 128, 40, 90, 145,
-			10, 113, 178, 143, 51, 75, 135, 130, 234, 148, 27, 165, 89, 224,
-			8, 65, 190, 168, 66, 154, 75, 227, 17, 190, 204, 42, 161, 127,
-			31, 26, 63, 6, 219, 64, 8, 25, 6, 131, 189, 255, 10, 236,
-			44, 77, 1, 51, 48, 130, 203, 238, 225, 86, 173, 84, 84, 218,
-			0, 234, 3, 146, 115, 225, 78, 78, 70, 240, 129, 30, 53, 200,
-			66, 134, 244, 215, 213, 50, 11, 127, 91, 165, 188, 138, 117, 101,
-			205, 70, 9, 12, 49, 166, 123, 202, 242, 78, 181, 6, 114, 237,
-			158, 190, 117, 229, 53, 54, 229, 77, 198, 66, 134, 181, 83, 210,
-			83, 94, 25, 214, 78, 117, 245, 132, 12, 107, 167, 250, 99, 162,
-			192, 244, 70, 94, 122, 197, 187, 218, 158, 200, 249, 90, 68, 104,
-			245, 25, 11, 225, 21, 242, 248, 107, 117, 41, 211, 49, 168, 144,
-			65, 165, 180, 245, 44, 113, 151, 43, 244, 78, 106, 39, 167, 113,
-			152, 147, 209, 65, 105, 223, 212, 38, 249, 65, 50, 218, 103, 231,
-			0, 203, 79, 14, 216, 149, 160, 200, 108, 116, 200, 42, 226, 80,
-			78, 191, 157, 3, 149, 102, 227, 131, 86, 37, 200, 72, 133, 138,
-			32, 152, 84, 84, 216, 57, 88, 70, 70, 175, 144, 198, 198, 115,
-			222, 233, 144, 177, 241, 28, 208, 200, 24, 19, 71, 177, 64, 111,
-			200, 216, 120, 174, 111, 52, 100, 108, 60, 39, 163, 17, 16, 2,
-			215, 229, 251, 207, 124, 33, 121, 93, 79, 127, 50, 231, 184, 46,
-			29, 170, 25, 179, 235, 242, 253, 103, 54, 49, 190, 222, 215, 47,
-			151, 141, 19, 247, 94, 113, 151, 35, 150, 93, 218, 43, 128, 224,
-			63, 116, 140, 53, 216, 45, 111, 44, 241, 43, 14, 69, 155, 193,
-			229, 97, 188, 139, 164, 165, 184, 109, 112, 76, 33, 23, 136, 35,
-			6, 175, 251, 115, 202, 96, 188, 180, 183, 23, 20, 49, 40, 204,
-			148, 240, 167, 43, 213, 208, 88, 207, 36, 253, 217, 107, 170, 32,
-			186, 223, 161, 112, 56, 197, 86, 195, 111, 205, 169, 120, 101, 24,
-			43, 2, 13, 189, 200, 13, 87, 158, 170, 248, 84, 4, 11, 168,
-			84, 132, 41, 3, 64, 74, 38, 28, 95, 139, 21, 214, 45, 239,
-			21, 203, 202, 170, 29, 251, 212, 21, 178, 194, 186, 37, 6, 67,
-			86, 88, 183, 70, 70, 197, 63, 117, 141, 85, 209, 188, 119, 57,
-			241, 27, 20, 113, 7, 5, 205, 151, 69, 219, 33, 37, 164, 158,
-			191, 204, 71, 37, 87, 70, 85, 156, 156, 199, 104, 42, 76, 214,
-			47, 101, 197, 249, 149, 132, 132, 134, 173, 101, 244, 204, 133, 223,
-			83, 91, 228, 160, 74, 204, 97, 42, 41, 205, 239, 84, 60, 135,
-			64, 189, 254, 130, 119, 84, 100, 122, 156, 135, 229, 222, 80, 254,
-			139, 210, 192, 26, 125, 34, 1, 35, 182, 151, 81, 193, 116, 172,
-			120, 233, 50, 46, 135, 140, 98, 175, 111, 229, 143, 121, 94, 101,
-			167, 182, 95, 208, 176, 48, 166, 39, 238, 7, 140, 146, 140, 146,
-			133, 198, 113, 187, 192, 220, 133, 233, 196, 118, 248, 209, 222, 22,
-			139, 166, 121, 239, 214, 88, 200, 162, 105, 222, 59, 29, 178, 104,
-			154, 63, 115, 49, 100, 209, 52, 63, 61, 35, 254, 97, 196, 152,
-			52, 189, 227, 157, 73, 252, 74, 228, 47, 50, 16, 146, 57, 0,
-			36, 143, 4, 71, 226, 92, 190, 164, 20, 39, 133, 78, 162, 240,
-			24, 47, 143, 157, 196, 91, 238, 255, 199, 98, 39, 41, 43, 180,
-			119, 188, 249, 203, 198, 202, 172, 29, 199, 84, 132, 172, 208, 222,
-			233, 14, 91, 161, 189, 163, 157, 11, 33, 177, 34, 99, 39, 181,
-			145, 153, 208, 138, 102, 100, 100, 36, 180, 162, 21, 19, 120, 32,
-			88, 209, 138, 9, 60, 8, 172, 200, 216, 73, 109, 248, 138, 228,
-			154, 251, 37, 197, 0, 177, 197, 53, 49, 32, 126, 136, 25, 32,
-			93, 218, 100, 129, 1, 126, 159, 227, 175, 169, 131, 33, 189, 23,
-			199, 147, 108, 42, 83, 156, 50, 79, 116, 189, 203, 97, 7, 230,
-			109, 207, 7, 19, 154, 78, 154, 203, 166, 66, 17, 231, 164, 59,
-			176, 156, 6, 104, 148, 42, 237, 140, 20, 245, 100, 136, 55, 77,
-			53, 142, 157, 145, 245, 214, 20, 195, 226, 232, 25, 89, 189, 53,
-			243, 197, 82, 182, 203, 250, 14, 253, 205, 2, 67, 43, 202, 46,
-			161, 146, 13, 186, 180, 201, 248, 242, 203, 246, 21, 126, 202, 173,
-			30, 218, 117, 195, 49, 52, 125, 43, 188, 14, 78, 66, 245, 194,
-			89, 197, 178, 202, 181, 208, 196, 5, 254, 192, 203, 142, 105, 52,
-			80, 87, 244, 192, 66, 19, 23, 248, 3, 11, 77, 92, 224, 15,
-			0, 205, 93, 137, 38, 172, 239, 199, 94, 34, 241, 40, 244, 204,
-			254, 183, 130, 168, 9, 104, 4, 179, 255, 56, 84, 113, 30, 62,
-			246, 30, 24, 84, 41, 44, 151, 133, 42, 146, 236, 113, 215, 176,
-			73, 99, 244, 173, 177, 83, 226, 71, 60, 137, 107, 132, 131, 47,
-			125, 197, 251, 54, 131, 47, 9, 19, 195, 160, 37, 248, 146, 20,
-			243, 248, 224, 196, 222, 235, 234, 57, 119, 139, 62, 71, 227, 47,
-			177, 19, 23, 238, 139, 230, 217, 13, 179, 51, 149, 171, 59, 165,
-			130, 60, 186, 224, 251, 21, 18, 85, 253, 164, 24, 163, 165, 177,
-			150, 15, 147, 89, 110, 245, 230, 173, 115, 218, 211, 201, 28, 22,
-			249, 26, 217, 108, 28, 148, 144, 35, 89, 172, 96, 171, 156, 175,
-			60, 131, 158, 84, 233, 181, 56, 11, 50, 147, 203, 194, 204, 138,
-			125, 75, 42, 78, 33, 77, 116, 77, 136, 193, 82, 227, 255, 193,
-			193, 166, 218, 248, 105, 78, 111, 219, 123, 156, 208, 179, 38, 98,
-			5, 155, 106, 227, 119, 57, 117, 176, 169, 54, 126, 150, 147, 130,
-			77, 125, 93, 241, 30, 47, 30, 249, 162, 55, 145, 120, 14, 76,
-			36, 164, 5, 106, 81, 72, 48, 45, 195, 33, 63, 9, 97, 169,
-			60, 82, 93, 73, 10, 43, 30, 104, 5, 79, 94, 120, 194, 0,
-			190, 205, 91, 131, 142, 7, 46, 189, 25, 117, 71, 48, 124, 227,
-			23, 189, 237, 211, 26, 81, 175, 29, 17, 51, 29, 193, 200, 103,
-			95, 236, 58, 101, 210, 208, 145, 47, 158, 62, 35, 126, 84, 117,
-			4, 202, 87, 160, 35, 95, 117, 172, 183, 91, 84, 176, 115, 35,
-			69, 90, 223, 172, 5, 189, 31, 232, 21, 172, 247, 28, 33, 55,
-			170, 163, 129, 30, 172, 8, 42, 134, 121, 90, 111, 125, 90, 221,
-			194, 104, 123, 21, 239, 139, 19, 26, 237, 118, 66, 83, 152, 52,
-			116, 171, 210, 109, 186, 133, 49, 247, 42, 208, 173, 191, 234, 202,
-			110, 193, 30, 211, 240, 70, 18, 255, 193, 241, 239, 176, 114, 205,
-			98, 79, 230, 117, 78, 171, 47, 71, 148, 64, 135, 246, 25, 88,
-			152, 33, 144, 251, 179, 92, 243, 184, 50, 217, 100, 142, 73, 198,
-			215, 17, 5, 154, 195, 86, 125, 125, 122, 231, 218, 175, 171, 229,
-			42, 189, 88, 235, 116, 122, 194, 41, 174, 208, 200, 135, 214, 174,
-			10, 190, 204, 222, 104, 164, 81, 96, 249, 129, 149, 26, 24, 193,
-			139, 33, 90, 59, 151, 69, 78, 140, 135, 214, 240, 42, 134, 156,
-			209, 118, 36, 143, 153, 37, 24, 19, 173, 209, 53, 96, 210, 64,
-			206, 198, 208, 176, 248, 170, 34, 103, 71, 60, 242, 137, 55, 154,
-			248, 183, 142, 191, 32, 245, 145, 159, 70, 79, 22, 123, 10, 207,
-			176, 55, 182, 67, 174, 8, 169, 22, 190, 37, 42, 42, 34, 138,
-			19, 169, 168, 26, 254, 52, 50, 50, 21, 197, 103, 36, 99, 7,
-			144, 241, 19, 175, 49, 162, 201, 132, 214, 21, 159, 88, 100, 68,
-			171, 149, 79, 186, 226, 38, 13, 100, 252, 100, 120, 68, 124, 141,
-			93, 136, 41, 122, 212, 72, 226, 64, 135, 104, 108, 9, 250, 142,
-			1, 194, 141, 86, 201, 238, 183, 142, 158, 196, 98, 176, 237, 107,
-			195, 108, 3, 23, 236, 225, 190, 124, 65, 17, 79, 116, 205, 189,
-			217, 70, 117, 182, 156, 175, 237, 112, 28, 27, 243, 222, 20, 178,
-			140, 47, 107, 169, 138, 34, 190, 126, 89, 6, 199, 163, 71, 183,
-			35, 95, 150, 193, 241, 218, 136, 89, 124, 121, 104, 88, 95, 19,
-			253, 171, 78, 209, 43, 95, 128, 178, 238, 136, 198, 91, 239, 128,
-			56, 180, 250, 73, 247, 63, 39, 189, 105, 147, 56, 241, 229, 197,
-			196, 177, 33, 81, 18, 71, 110, 172, 18, 199, 63, 199, 116, 253,
-			215, 93, 225, 145, 126, 125, 94, 244, 183, 132, 222, 143, 143, 28,
-			255, 0, 74, 226, 216, 252, 250, 126, 60, 45, 6, 142, 60, 248,
-			19, 167, 155, 158, 227, 222, 32, 3, 48, 173, 87, 35, 105, 36,
-			78, 252, 77, 209, 27, 10, 13, 18, 167, 215, 98, 90, 163, 133,
-			156, 88, 61, 41, 186, 244, 162, 139, 199, 90, 175, 133, 18, 225,
-			199, 71, 226, 111, 139, 190, 240, 195, 83, 241, 19, 224, 38, 232,
-			58, 236, 200, 35, 85, 247, 255, 149, 140, 213, 114, 250, 59, 245,
-			234, 225, 130, 29, 171, 69, 94, 61, 136, 14, 95, 93, 61, 116,
-			119, 156, 83, 87, 15, 61, 29, 103, 232, 39, 172, 236, 94, 89,
-			22, 159, 108, 234, 152, 144, 23, 18, 49, 126, 191, 36, 138, 174,
-			240, 242, 253, 146, 40, 57, 194, 119, 246, 224, 209, 39, 202, 110,
-			240, 238, 10, 174, 160, 168, 244, 113, 143, 14, 171, 20, 0, 24,
-			28, 185, 162, 82, 232, 239, 126, 243, 29, 89, 13, 157, 205, 221,
-			119, 229, 39, 148, 191, 135, 163, 163, 42, 133, 158, 235, 99, 87,
-			85, 10, 221, 208, 111, 175, 201, 106, 240, 105, 196, 205, 200, 79,
-			8, 100, 36, 58, 168, 82, 248, 109, 104, 74, 165, 160, 218, 200,
-			245, 187, 178, 26, 234, 222, 221, 215, 228, 39, 36, 204, 88, 180,
-			95, 165, 160, 218, 88, 236, 172, 74, 97, 201, 203, 55, 197, 45,
-			170, 70, 206, 222, 239, 36, 146, 126, 120, 142, 176, 42, 168, 81,
-			109, 240, 3, 174, 48, 123, 171, 53, 52, 80, 222, 5, 54, 44,
-			36, 32, 228, 44, 227, 209, 33, 149, 194, 231, 60, 134, 47, 171,
-			20, 186, 134, 191, 154, 81, 124, 230, 255, 10, 0, 0, 255, 255,
-			172, 223, 159, 112, 190, 231, 0, 0},
+			8, 0, 0, 9, 110, 136, 0, 255, 236, 253, 11, 144, 28, 71,
+			122, 24, 8, 119, 85, 214, 244, 244, 228, 188, 115, 30, 24, 52,
+			30, 76, 52, 1, 206, 12, 209, 211, 243, 0, 9, 46, 193, 37,
+			185, 3, 204, 128, 24, 16, 175, 237, 25, 44, 150, 224, 114, 7,
+			53, 221, 213, 51, 181, 232, 174, 106, 86, 85, 99, 208, 228, 146,
+			43, 175, 118, 37, 91, 242, 58, 108, 233, 87, 72, 10, 201, 246,
+			111, 251, 252, 144, 226, 124, 126, 132, 31, 231, 176, 173, 147, 189,
+			58, 91, 103, 159, 21, 182, 229, 147, 45, 203, 17, 14, 251, 206,
+			210, 249, 17, 225, 135, 206, 58, 69, 220, 57, 238, 226, 251, 242,
+			203, 172, 234, 153, 1, 64, 174, 78, 23, 142, 61, 35, 8, 176,
+			51, 43, 159, 95, 126, 249, 229, 151, 223, 43, 249, 95, 154, 231,
+			207, 237, 134, 225, 110, 211, 91, 108, 71, 97, 18, 238, 116, 26,
+			139, 137, 223, 242, 226, 196, 109, 181, 43, 152, 37, 70, 85, 129,
+			138, 46, 80, 122, 141, 15, 108, 233, 50, 98, 134, 247, 199, 94,
+			45, 12, 234, 241, 140, 37, 173, 57, 86, 213, 73, 49, 201, 251,
+			2, 55, 8, 227, 25, 91, 90, 115, 125, 85, 149, 184, 252, 77,
+			139, 79, 212, 194, 86, 229, 64, 163, 151, 71, 76, 147, 119, 32,
+			235, 142, 117, 127, 133, 138, 236, 134, 77, 55, 216, 173, 132, 209,
+			110, 102, 140, 221, 182, 23, 47, 62, 12, 194, 253, 32, 29, 111,
+			123, 231, 55, 45, 235, 15, 219, 236, 173, 59, 151, 127, 218, 62,
+			253, 150, 170, 125, 135, 170, 84, 238, 121, 205, 230, 219, 80, 97,
+			11, 234, 94, 255, 139, 179, 60, 47, 156, 145, 220, 215, 44, 254,
+			11, 14, 183, 134, 4, 27, 201, 137, 149, 111, 59, 242, 74, 216,
+			238, 70, 254, 238, 94, 34, 87, 150, 86, 150, 22, 86, 150, 86,
+			94, 146, 151, 59, 13, 185, 229, 213, 246, 130, 176, 25, 238, 250,
+			94, 92, 150, 27, 65, 173, 194, 185, 188, 225, 215, 188, 32, 246,
+			234, 178, 19, 212, 189, 72, 38, 123, 158, 92, 109, 187, 181, 61,
+			79, 127, 41, 203, 47, 120, 81, 236, 135, 129, 92, 169, 44, 201,
+			57, 40, 80, 162, 79, 165, 249, 215, 184, 236, 134, 29, 217, 114,
+			187, 50, 8, 19, 217, 137, 61, 153, 236, 249, 177, 108, 248, 77,
+			79, 122, 143, 107, 94, 59, 145, 126, 32, 107, 97, 171, 221, 244,
+			221, 160, 230, 201, 125, 63, 217, 147, 73, 218, 124, 133, 203, 119,
+			168, 133, 112, 39, 113, 253, 64, 186, 178, 22, 182, 187, 50, 108,
+			100, 139, 73, 55, 225, 92, 226, 159, 189, 36, 105, 95, 90, 92,
+			220, 223, 223, 175, 184, 56, 82, 4, 108, 83, 149, 139, 23, 111,
+			108, 92, 89, 191, 181, 185, 190, 176, 82, 89, 226, 92, 222, 13,
+			154, 94, 28, 203, 200, 123, 191, 227, 71, 94, 93, 238, 116, 165,
+			219, 110, 55, 253, 154, 187, 211, 244, 100, 211, 221, 151, 97, 36,
+			221, 221, 200, 243, 234, 50, 9, 97, 172, 251, 145, 159, 248, 193,
+			110, 89, 198, 97, 35, 217, 119, 35, 143, 203, 186, 31, 39, 145,
+			191, 211, 73, 122, 192, 164, 71, 230, 199, 61, 5, 194, 64, 186,
+			129, 44, 173, 110, 202, 141, 205, 146, 188, 188, 186, 185, 177, 89,
+			230, 242, 222, 198, 214, 181, 219, 119, 183, 228, 189, 213, 106, 117,
+			245, 214, 214, 198, 250, 166, 188, 93, 149, 87, 110, 223, 90, 219,
+			216, 218, 184, 125, 107, 83, 222, 190, 42, 87, 111, 189, 35, 223,
+			222, 184, 181, 86, 150, 158, 159, 236, 121, 145, 244, 30, 183, 35,
+			24, 125, 24, 73, 31, 0, 232, 213, 43, 92, 110, 122, 94, 79,
+			247, 141, 80, 173, 90, 220, 246, 106, 126, 195, 175, 73, 192, 181,
+			142, 187, 235, 201, 221, 240, 145, 23, 5, 126, 176, 43, 219, 94,
+			212, 242, 99, 88, 196, 88, 186, 65, 157, 203, 166, 223, 242, 19,
+			55, 193, 140, 67, 51, 170, 112, 94, 224, 150, 45, 216, 88, 110,
+			6, 126, 21, 4, 19, 185, 231, 248, 0, 183, 11, 207, 169, 159,
+			42, 115, 34, 183, 129, 153, 131, 234, 167, 202, 156, 204, 149, 49,
+			211, 82, 63, 85, 230, 84, 110, 17, 51, 233, 167, 202, 156, 206,
+			149, 48, 147, 171, 159, 42, 243, 88, 238, 12, 102, 158, 85, 63,
+			85, 230, 76, 238, 53, 204, 60, 167, 126, 254, 163, 83, 220, 118,
+			114, 194, 73, 114, 95, 179, 138, 127, 251, 148, 92, 149, 102, 231,
+			201, 200, 3, 144, 121, 65, 18, 75, 87, 182, 67, 63, 64, 252,
+			131, 13, 38, 253, 160, 238, 181, 189, 160, 238, 5, 9, 32, 151,
+			27, 116, 85, 254, 7, 97, 224, 201, 48, 146, 205, 176, 230, 54,
+			185, 172, 185, 77, 47, 168, 187, 81, 89, 122, 65, 45, 172, 123,
+			117, 233, 66, 91, 181, 176, 163, 234, 17, 113, 0, 56, 202, 70,
+			228, 214, 20, 16, 179, 31, 18, 46, 145, 82, 96, 90, 70, 94,
+			28, 54, 59, 80, 170, 34, 183, 246, 60, 106, 200, 7, 156, 108,
+			186, 137, 255, 200, 3, 188, 115, 3, 233, 181, 195, 218, 158, 116,
+			19, 121, 119, 235, 138, 108, 249, 245, 0, 119, 112, 24, 112, 121,
+			221, 13, 58, 110, 212, 149, 203, 101, 185, 252, 234, 43, 75, 101,
+			156, 209, 158, 39, 219, 81, 216, 244, 218, 137, 95, 147, 111, 69,
+			222, 110, 24, 249, 110, 96, 70, 47, 247, 247, 252, 218, 158, 244,
+			30, 39, 30, 12, 54, 217, 243, 248, 81, 165, 118, 220, 218, 195,
+			125, 55, 130, 18, 161, 236, 122, 110, 36, 195, 192, 3, 178, 176,
+			218, 108, 202, 150, 31, 116, 18, 47, 150, 110, 228, 201, 139, 75,
+			102, 126, 205, 48, 216, 173, 200, 27, 158, 219, 78, 167, 28, 121,
+			178, 20, 183, 60, 55, 242, 234, 37, 25, 135, 50, 217, 115, 19,
+			25, 132, 178, 233, 185, 109, 78, 197, 100, 130, 123, 206, 143, 101,
+			224, 121, 0, 87, 192, 92, 63, 72, 188, 168, 29, 121, 10, 25,
+			203, 178, 19, 3, 190, 186, 242, 221, 149, 151, 22, 246, 194, 78,
+			36, 155, 126, 224, 185, 17, 151, 216, 250, 123, 115, 176, 249, 227,
+			75, 139, 139, 117, 239, 145, 215, 12, 219, 94, 20, 107, 58, 92,
+			11, 91, 72, 72, 23, 177, 228, 60, 76, 2, 192, 29, 185, 193,
+			46, 238, 209, 70, 20, 182, 228, 210, 210, 210, 242, 2, 254, 183,
+			181, 180, 116, 9, 255, 187, 15, 83, 127, 245, 213, 87, 95, 93,
+			88, 94, 89, 184, 176, 188, 181, 114, 225, 210, 203, 175, 94, 122,
+			249, 213, 202, 171, 250, 207, 253, 138, 188, 220, 229, 176, 144, 73,
+			228, 215, 128, 56, 64, 21, 156, 34, 182, 94, 150, 251, 158, 244,
+			130, 184, 19, 193, 206, 116, 19, 72, 214, 0, 202, 97, 240, 200,
+			139, 18, 40, 172, 144, 37, 108, 201, 119, 171, 87, 175, 112, 121,
+			225, 194, 133, 87, 211, 185, 0, 37, 243, 189, 164, 129, 116, 44,
+			106, 212, 22, 163, 70, 13, 74, 84, 146, 199, 201, 188, 172, 187,
+			137, 39, 129, 254, 4, 187, 49, 76, 234, 121, 185, 254, 216, 109,
+			181, 155, 94, 204, 185, 254, 41, 151, 47, 201, 43, 97, 171, 221,
+			73, 188, 204, 94, 192, 14, 239, 220, 222, 220, 248, 162, 124, 0,
+			144, 153, 155, 127, 80, 33, 34, 154, 22, 50, 103, 207, 107, 234,
+			139, 73, 87, 98, 47, 217, 166, 5, 158, 131, 220, 185, 91, 119,
+			111, 220, 152, 159, 63, 178, 28, 226, 251, 220, 210, 252, 107, 153,
+			49, 173, 60, 107, 76, 187, 94, 2, 173, 132, 141, 186, 219, 205,
+			140, 45, 78, 162, 78, 45, 193, 189, 249, 200, 109, 202, 228, 17,
+			245, 216, 83, 252, 133, 228, 81, 89, 226, 128, 94, 251, 78, 167,
+			244, 168, 146, 60, 130, 9, 62, 109, 70, 170, 80, 39, 246, 106,
+			242, 69, 185, 188, 180, 212, 59, 195, 11, 79, 156, 225, 61, 63,
+			184, 176, 34, 31, 188, 229, 37, 155, 221, 56, 241, 90, 240, 121,
+			53, 190, 234, 55, 189, 173, 222, 133, 184, 186, 113, 99, 125, 107,
+			227, 230, 186, 108, 36, 52, 140, 39, 213, 121, 161, 145, 232, 145,
+			222, 221, 184, 181, 117, 241, 37, 153, 248, 181, 135, 177, 124, 93,
+			206, 205, 205, 169, 156, 249, 70, 82, 169, 239, 95, 243, 119, 247,
+			214, 220, 4, 107, 205, 203, 207, 126, 86, 94, 88, 153, 151, 95,
+			149, 248, 237, 70, 184, 175, 63, 105, 184, 45, 46, 202, 85, 121,
+			207, 15, 234, 225, 126, 140, 77, 194, 102, 89, 94, 90, 202, 208,
+			176, 184, 98, 10, 40, 42, 181, 124, 241, 240, 54, 50, 173, 65,
+			245, 229, 139, 47, 189, 244, 210, 43, 23, 46, 46, 165, 100, 99,
+			199, 107, 132, 145, 39, 239, 6, 254, 99, 162, 117, 64, 204, 14,
+			182, 82, 249, 206, 22, 115, 78, 205, 95, 206, 205, 193, 12, 98,
+			185, 136, 139, 5, 255, 205, 203, 133, 236, 112, 158, 129, 193, 208,
+			206, 133, 149, 180, 157, 115, 153, 118, 16, 1, 230, 123, 16, 224,
+			165, 39, 34, 192, 117, 247, 145, 43, 31, 168, 197, 175, 212, 58,
+			81, 228, 5, 9, 20, 185, 233, 55, 155, 126, 156, 65, 0, 160,
+			166, 178, 133, 185, 242, 117, 249, 228, 10, 79, 65, 115, 249, 122,
+			154, 91, 9, 188, 253, 203, 29, 191, 89, 247, 162, 185, 121, 152,
+			216, 38, 65, 136, 186, 80, 128, 153, 39, 86, 74, 74, 9, 101,
+			110, 33, 174, 207, 249, 65, 2, 51, 167, 146, 106, 234, 52, 109,
+			0, 193, 252, 124, 101, 7, 90, 158, 235, 1, 193, 203, 207, 0,
+			193, 70, 16, 39, 110, 144, 84, 130, 112, 63, 51, 107, 202, 149,
+			65, 184, 47, 95, 151, 61, 101, 158, 58, 209, 116, 220, 207, 158,
+			113, 16, 238, 87, 118, 189, 100, 29, 112, 77, 229, 205, 205, 103,
+			38, 222, 59, 121, 42, 12, 137, 185, 163, 39, 122, 241, 137, 19,
+			165, 213, 210, 92, 134, 188, 211, 77, 246, 194, 64, 79, 245, 200,
+			101, 154, 155, 63, 240, 177, 242, 150, 151, 92, 73, 87, 125, 110,
+			30, 41, 253, 245, 205, 219, 183, 228, 77, 183, 221, 246, 131, 93,
+			206, 229, 70, 160, 114, 26, 97, 212, 114, 147, 50, 178, 125, 233,
+			88, 146, 110, 27, 15, 186, 30, 182, 69, 29, 28, 196, 49, 112,
+			60, 126, 62, 213, 233, 163, 186, 2, 206, 197, 77, 164, 31, 99,
+			159, 156, 114, 161, 179, 210, 135, 192, 53, 124, 180, 240, 97, 43,
+			12, 146, 189, 143, 22, 62, 172, 187, 221, 143, 182, 62, 132, 163,
+			251, 163, 75, 31, 182, 252, 224, 163, 75, 31, 198, 94, 237, 163,
+			119, 43, 31, 2, 179, 4, 244, 246, 163, 247, 238, 151, 184, 220,
+			223, 243, 34, 79, 170, 218, 208, 144, 219, 220, 119, 187, 177, 102,
+			121, 129, 209, 70, 78, 160, 1, 60, 64, 221, 223, 245, 147, 24,
+			88, 154, 166, 39, 169, 167, 178, 196, 174, 202, 92, 170, 206, 202,
+			18, 123, 43, 35, 95, 134, 93, 34, 87, 242, 129, 23, 133, 11,
+			109, 183, 14, 0, 129, 67, 123, 63, 212, 173, 121, 110, 109, 15,
+			230, 229, 25, 46, 14, 184, 63, 34, 40, 101, 226, 159, 106, 110,
+			32, 119, 67, 217, 105, 195, 33, 254, 170, 174, 58, 231, 87, 188,
+			10, 101, 46, 31, 205, 235, 205, 151, 57, 246, 31, 182, 33, 229,
+			54, 85, 79, 165, 251, 37, 25, 119, 26, 13, 255, 49, 112, 163,
+			126, 205, 5, 246, 10, 86, 17, 144, 4, 249, 208, 185, 210, 221,
+			173, 43, 165, 249, 215, 122, 114, 185, 244, 211, 43, 76, 69, 174,
+			2, 231, 151, 132, 23, 20, 50, 196, 94, 228, 187, 77, 255, 3,
+			47, 146, 241, 94, 216, 105, 214, 53, 40, 225, 50, 118, 119, 235,
+			138, 156, 115, 99, 211, 27, 92, 128, 184, 44, 221, 47, 205, 195,
+			2, 4, 178, 29, 249, 129, 98, 104, 14, 163, 18, 0, 210, 237,
+			233, 170, 237, 70, 113, 218, 205, 142, 199, 37, 114, 116, 192, 223,
+			212, 240, 170, 183, 19, 38, 123, 200, 191, 66, 221, 16, 239, 48,
+			122, 14, 241, 161, 113, 192, 53, 41, 108, 52, 98, 47, 65, 102,
+			237, 106, 8, 23, 30, 220, 107, 101, 89, 90, 89, 90, 126, 101,
+			97, 105, 121, 97, 249, 229, 173, 165, 229, 75, 23, 150, 46, 45,
+			191, 92, 89, 90, 190, 95, 34, 166, 60, 150, 152, 54, 135, 75,
+			219, 141, 19, 46, 177, 36, 246, 31, 6, 41, 215, 252, 114, 89,
+			66, 107, 21, 218, 64, 238, 35, 119, 179, 22, 249, 237, 164, 12,
+			188, 110, 15, 163, 230, 74, 56, 28, 101, 184, 243, 21, 15, 24,
+			144, 144, 238, 178, 10, 217, 21, 103, 138, 232, 15, 212, 170, 238,
+			70, 117, 46, 223, 77, 194, 141, 205, 219, 155, 184, 201, 230, 230,
+			143, 96, 79, 43, 173, 240, 3, 191, 217, 116, 145, 183, 243, 130,
+			133, 187, 155, 139, 245, 176, 22, 47, 222, 243, 118, 22, 211, 161,
+			44, 86, 189, 134, 23, 121, 65, 205, 91, 124, 171, 25, 238, 184,
+			205, 237, 219, 56, 134, 120, 17, 6, 180, 152, 233, 100, 158, 203,
+			150, 151, 236, 133, 245, 10, 80, 3, 69, 105, 202, 210, 53, 67,
+			146, 15, 128, 95, 4, 160, 87, 244, 143, 7, 122, 66, 48, 213,
+			29, 79, 207, 214, 171, 243, 35, 167, 200, 229, 187, 15, 226, 36,
+			106, 96, 213, 204, 140, 194, 90, 92, 105, 99, 127, 56, 151, 149,
+			197, 166, 191, 19, 185, 81, 23, 153, 238, 202, 94, 210, 106, 62,
+			143, 191, 116, 221, 121, 188, 234, 115, 131, 200, 186, 19, 184, 167,
+			202, 217, 115, 239, 44, 156, 107, 45, 156, 171, 111, 157, 187, 118,
+			233, 220, 205, 75, 231, 54, 43, 231, 26, 247, 103, 43, 242, 134,
+			255, 208, 219, 247, 65, 234, 224, 195, 18, 62, 114, 211, 85, 234,
+			196, 158, 106, 237, 122, 88, 119, 17, 89, 103, 99, 249, 238, 131,
+			141, 205, 219, 154, 165, 185, 138, 61, 224, 196, 137, 205, 122, 111,
+			142, 107, 121, 193, 87, 194, 186, 187, 0, 3, 171, 196, 97, 39,
+			170, 1, 55, 178, 235, 85, 2, 47, 89, 116, 219, 62, 174, 9,
+			76, 11, 74, 225, 140, 22, 213, 112, 23, 15, 55, 143, 83, 77,
+			251, 224, 114, 30, 224, 104, 132, 23, 170, 94, 226, 69, 178, 230,
+			182, 113, 127, 132, 13, 185, 235, 5, 94, 228, 170, 157, 166, 119,
+			25, 236, 202, 44, 248, 43, 28, 254, 48, 39, 103, 9, 150, 20,
+			198, 249, 79, 90, 220, 113, 114, 118, 78, 176, 199, 246, 100, 241,
+			247, 88, 178, 154, 222, 109, 53, 222, 135, 13, 68, 119, 24, 176,
+			140, 253, 160, 150, 229, 175, 248, 209, 12, 150, 188, 217, 137, 19,
+			185, 227, 61, 245, 66, 196, 143, 186, 17, 221, 151, 126, 80, 107,
+			118, 98, 255, 17, 92, 17, 135, 120, 31, 140, 174, 15, 134, 215,
+			175, 83, 150, 96, 143, 11, 163, 58, 197, 4, 123, 44, 38, 248,
+			255, 162, 38, 98, 9, 246, 177, 45, 138, 191, 100, 201, 91, 97,
+			176, 16, 120, 187, 234, 246, 171, 169, 47, 78, 198, 165, 153, 193,
+			61, 248, 72, 186, 90, 145, 183, 168, 162, 185, 86, 62, 114, 155,
+			29, 47, 70, 108, 203, 52, 214, 130, 89, 198, 137, 223, 108, 202,
+			61, 247, 145, 39, 131, 108, 159, 216, 52, 85, 4, 156, 114, 19,
+			186, 150, 55, 194, 8, 174, 195, 90, 102, 112, 16, 88, 116, 85,
+			44, 211, 95, 126, 4, 64, 172, 62, 152, 166, 6, 136, 5, 147,
+			46, 12, 235, 20, 19, 236, 227, 177, 241, 157, 188, 34, 170, 252,
+			119, 112, 126, 226, 160, 236, 210, 107, 181, 147, 238, 147, 228, 150,
+			253, 188, 111, 29, 190, 95, 254, 232, 104, 17, 36, 199, 175, 90,
+			252, 88, 249, 132, 226, 71, 236, 242, 83, 137, 30, 127, 182, 160,
+			68, 143, 165, 177, 255, 34, 122, 252, 47, 162, 199, 223, 110, 209,
+			227, 186, 150, 50, 194, 79, 45, 122, 52, 82, 198, 9, 35, 101,
+			156, 204, 157, 215, 82, 70, 248, 169, 69, 143, 70, 202, 56, 101,
+			164, 140, 211, 169, 148, 113, 218, 72, 25, 143, 165, 82, 70, 248,
+			169, 69, 143, 70, 240, 9, 63, 255, 178, 141, 162, 71, 86, 202,
+			141, 21, 255, 148, 45, 87, 21, 97, 247, 107, 18, 247, 144, 108,
+			121, 113, 12, 146, 87, 164, 40, 32, 24, 135, 115, 43, 242, 22,
+			16, 57, 67, 233, 62, 10, 253, 186, 172, 123, 13, 31, 65, 83,
+			239, 32, 54, 224, 73, 220, 83, 31, 79, 134, 46, 112, 190, 171,
+			119, 54, 98, 96, 248, 146, 110, 219, 175, 185, 77, 205, 32, 1,
+			63, 152, 132, 136, 243, 126, 2, 242, 73, 0, 33, 32, 154, 7,
+			108, 16, 173, 74, 228, 197, 237, 16, 176, 4, 246, 58, 224, 180,
+			27, 200, 213, 59, 27, 134, 127, 0, 134, 203, 199, 155, 87, 205,
+			187, 68, 24, 30, 123, 209, 35, 191, 230, 201, 171, 97, 40, 63,
+			212, 119, 165, 168, 93, 147, 151, 221, 104, 238, 0, 181, 169, 32,
+			177, 153, 151, 145, 151, 116, 162, 32, 150, 79, 248, 78, 183, 237,
+			143, 50, 167, 91, 169, 48, 108, 104, 224, 31, 63, 207, 47, 237,
+			250, 201, 94, 103, 7, 37, 120, 205, 78, 205, 199, 127, 22, 118,
+			195, 197, 90, 216, 106, 133, 1, 156, 207, 139, 137, 215, 106, 55,
+			145, 23, 162, 31, 68, 34, 11, 250, 67, 241, 105, 148, 180, 244,
+			191, 89, 188, 239, 11, 64, 238, 133, 224, 204, 15, 18, 165, 233,
+			185, 150, 171, 66, 66, 76, 114, 167, 3, 153, 160, 230, 113, 174,
+			229, 170, 152, 18, 211, 188, 175, 209, 12, 221, 100, 134, 73, 107,
+			206, 186, 150, 171, 170, 36, 148, 222, 9, 195, 230, 140, 35, 173,
+			185, 2, 148, 134, 20, 180, 27, 39, 209, 76, 159, 180, 230, 6,
+			160, 221, 56, 137, 160, 133, 157, 110, 226, 197, 51, 121, 105, 205,
+			13, 65, 11, 152, 20, 51, 60, 175, 88, 178, 153, 126, 42, 78,
+			105, 168, 225, 70, 145, 219, 157, 41, 208, 7, 149, 20, 101, 238,
+			4, 157, 102, 115, 102, 64, 90, 115, 131, 43, 211, 7, 9, 191,
+			2, 53, 140, 5, 74, 93, 238, 231, 125, 120, 182, 149, 126, 211,
+			225, 249, 205, 218, 158, 215, 114, 197, 124, 58, 239, 193, 149, 169,
+			138, 6, 91, 69, 125, 174, 172, 38, 97, 75, 131, 227, 124, 6,
+			28, 79, 41, 139, 133, 196, 66, 22, 74, 79, 41, 77, 192, 59,
+			159, 1, 222, 211, 218, 134, 66, 98, 33, 133, 233, 224, 202, 241,
+			67, 101, 55, 1, 225, 131, 154, 167, 193, 189, 156, 5, 247, 51,
+			42, 208, 74, 188, 200, 29, 47, 232, 180, 112, 29, 6, 87, 38,
+			143, 168, 145, 192, 104, 160, 140, 88, 52, 171, 86, 120, 194, 224,
+			225, 146, 148, 89, 204, 5, 189, 152, 3, 79, 47, 175, 74, 21,
+			247, 56, 219, 244, 18, 177, 196, 251, 188, 32, 137, 186, 51, 150,
+			100, 115, 131, 43, 197, 67, 181, 54, 189, 164, 178, 14, 37, 170,
+			170, 96, 113, 145, 247, 97, 90, 140, 113, 86, 15, 107, 136, 219,
+			3, 85, 248, 9, 26, 204, 36, 124, 232, 5, 184, 150, 3, 85,
+			149, 40, 158, 227, 14, 116, 45, 78, 113, 222, 114, 31, 111, 55,
+			189, 96, 55, 217, 195, 106, 195, 213, 129, 150, 251, 248, 6, 102,
+			20, 231, 121, 65, 67, 236, 89, 69, 243, 220, 129, 149, 187, 92,
+			224, 249, 24, 231, 86, 250, 247, 140, 59, 32, 239, 20, 159, 225,
+			102, 143, 210, 148, 78, 166, 83, 130, 18, 149, 45, 74, 225, 36,
+			170, 233, 142, 254, 71, 54, 47, 232, 111, 71, 204, 77, 0, 42,
+			213, 187, 52, 53, 252, 45, 62, 195, 251, 218, 110, 228, 182, 102,
+			24, 246, 84, 122, 66, 79, 149, 59, 80, 136, 128, 136, 21, 138,
+			191, 199, 226, 3, 152, 235, 37, 94, 116, 68, 111, 243, 188, 191,
+			238, 53, 220, 78, 83, 239, 139, 209, 180, 109, 164, 44, 85, 253,
+			93, 20, 121, 1, 182, 33, 28, 243, 72, 59, 10, 85, 147, 22,
+			115, 26, 64, 180, 3, 198, 210, 86, 212, 242, 86, 233, 123, 241,
+			93, 206, 211, 81, 194, 128, 30, 122, 93, 61, 160, 135, 94, 87,
+			188, 66, 155, 156, 134, 115, 230, 169, 83, 133, 73, 85, 85, 249,
+			75, 246, 103, 172, 226, 22, 31, 214, 5, 158, 212, 254, 66, 111,
+			251, 199, 158, 208, 126, 166, 213, 210, 159, 183, 248, 192, 166, 226,
+			5, 188, 72, 60, 207, 135, 117, 149, 237, 192, 109, 121, 212, 248,
+			144, 206, 188, 229, 182, 60, 241, 10, 207, 35, 252, 65, 199, 14,
+			43, 246, 92, 218, 141, 105, 73, 173, 86, 140, 3, 173, 82, 241,
+			226, 117, 62, 152, 201, 62, 98, 252, 231, 122, 199, 127, 104, 185,
+			210, 113, 95, 255, 137, 211, 192, 206, 58, 185, 247, 45, 254, 231,
+			45, 212, 164, 59, 57, 177, 242, 211, 86, 15, 59, 187, 124, 17,
+			69, 56, 55, 238, 94, 217, 144, 171, 157, 100, 47, 140, 224, 100,
+			110, 54, 37, 170, 218, 129, 207, 131, 195, 19, 249, 164, 187, 49,
+			158, 183, 120, 183, 83, 151, 77, 9, 34, 57, 56, 181, 149, 78,
+			246, 147, 242, 188, 250, 110, 162, 46, 238, 141, 176, 19, 212, 181,
+			242, 143, 120, 77, 212, 180, 87, 56, 240, 40, 44, 39, 88, 62,
+			119, 142, 24, 168, 66, 110, 156, 55, 144, 89, 113, 134, 114, 39,
+			172, 226, 125, 137, 88, 170, 184, 15, 80, 234, 165, 92, 27, 2,
+			2, 153, 57, 87, 182, 53, 182, 40, 65, 154, 143, 98, 36, 188,
+			142, 233, 5, 231, 32, 163, 115, 3, 28, 34, 222, 145, 210, 115,
+			125, 8, 111, 58, 142, 147, 43, 228, 132, 51, 108, 23, 153, 186,
+			247, 20, 224, 219, 112, 97, 152, 15, 234, 11, 237, 136, 51, 69,
+			87, 162, 92, 31, 164, 6, 116, 202, 18, 108, 100, 112, 68, 167,
+			152, 96, 35, 19, 147, 84, 205, 18, 108, 212, 84, 131, 91, 214,
+			168, 99, 238, 92, 240, 109, 80, 95, 59, 45, 38, 216, 168, 169,
+			6, 106, 108, 83, 205, 238, 131, 148, 174, 102, 91, 130, 141, 13,
+			142, 233, 20, 19, 108, 204, 84, 99, 130, 141, 155, 106, 172, 15,
+			82, 5, 157, 178, 4, 27, 55, 189, 49, 40, 105, 170, 57, 130,
+			77, 152, 106, 78, 31, 164, 116, 111, 14, 240, 170, 102, 110, 14,
+			19, 108, 194, 84, 235, 19, 108, 210, 84, 235, 195, 148, 6, 73,
+			31, 40, 210, 205, 32, 251, 152, 96, 147, 166, 90, 94, 176, 105,
+			103, 154, 62, 229, 251, 32, 165, 123, 203, 91, 130, 77, 15, 142,
+			235, 20, 19, 108, 122, 114, 138, 170, 245, 11, 118, 204, 84, 235,
+			239, 131, 148, 174, 214, 111, 9, 118, 204, 244, 214, 207, 4, 59,
+			102, 170, 21, 4, 59, 238, 60, 79, 159, 10, 121, 72, 29, 215,
+			41, 75, 176, 227, 197, 211, 58, 197, 4, 59, 126, 166, 196, 57,
+			183, 29, 75, 56, 167, 114, 87, 45, 68, 17, 184, 12, 159, 42,
+			0, 4, 28, 199, 98, 57, 225, 156, 182, 95, 80, 40, 98, 49,
+			64, 145, 211, 124, 152, 143, 240, 60, 124, 131, 175, 207, 57, 207,
+			247, 241, 17, 222, 175, 210, 150, 96, 207, 13, 141, 243, 235, 188,
+			160, 210, 128, 70, 103, 242, 83, 197, 87, 229, 77, 55, 122, 88,
+			15, 247, 131, 5, 45, 121, 169, 203, 122, 88, 235, 180, 188, 64,
+			41, 149, 233, 154, 2, 123, 17, 41, 171, 196, 83, 179, 194, 249,
+			24, 31, 208, 109, 245, 65, 99, 67, 217, 28, 75, 176, 51, 195,
+			99, 217, 28, 38, 216, 153, 137, 73, 62, 106, 70, 0, 156, 108,
+			254, 88, 166, 8, 96, 101, 169, 167, 25, 152, 114, 105, 88, 100,
+			115, 152, 96, 165, 169, 105, 126, 77, 77, 20, 166, 113, 206, 57,
+			85, 124, 85, 141, 74, 54, 253, 56, 81, 236, 124, 59, 140, 99,
+			31, 196, 71, 120, 102, 67, 158, 155, 208, 52, 60, 37, 203, 3,
+			185, 70, 133, 107, 16, 217, 57, 7, 154, 26, 74, 211, 121, 193,
+			206, 13, 139, 52, 109, 9, 118, 110, 98, 38, 77, 51, 193, 206,
+			157, 56, 73, 203, 97, 9, 103, 206, 174, 232, 229, 128, 113, 207,
+			241, 17, 254, 30, 142, 210, 130, 81, 158, 119, 138, 197, 91, 70,
+			9, 14, 227, 241, 100, 203, 125, 236, 183, 58, 45, 233, 182, 180,
+			117, 4, 178, 85, 106, 172, 46, 81, 28, 3, 254, 134, 239, 53,
+			235, 112, 221, 230, 50, 113, 31, 166, 67, 183, 16, 254, 231, 157,
+			76, 218, 18, 236, 252, 224, 84, 154, 102, 130, 157, 159, 57, 78,
+			67, 181, 133, 179, 100, 191, 164, 135, 10, 187, 120, 137, 11, 26,
+			170, 13, 67, 189, 240, 219, 54, 84, 27, 135, 122, 193, 12, 213,
+			70, 168, 94, 48, 67, 181, 113, 168, 23, 102, 142, 227, 158, 177,
+			128, 56, 92, 180, 199, 105, 164, 204, 18, 236, 34, 39, 252, 7,
+			18, 249, 25, 123, 157, 102, 129, 36, 242, 51, 133, 17, 85, 13,
+			230, 240, 170, 67, 213, 112, 29, 95, 37, 234, 163, 64, 243, 234,
+			192, 144, 78, 49, 193, 94, 29, 29, 163, 106, 150, 96, 151, 28,
+			65, 159, 172, 60, 164, 116, 53, 88, 208, 75, 3, 195, 58, 197,
+			4, 187, 52, 54, 78, 213, 108, 193, 94, 115, 38, 232, 147, 157,
+			135, 148, 174, 6, 192, 125, 109, 96, 68, 167, 152, 96, 175, 141,
+			11, 170, 198, 4, 251, 172, 233, 141, 229, 33, 165, 171, 193, 76,
+			63, 107, 122, 3, 40, 124, 214, 244, 230, 8, 246, 6, 209, 58,
+			203, 118, 242, 144, 210, 179, 1, 18, 249, 198, 240, 152, 78, 49,
+			193, 222, 32, 90, 103, 1, 229, 126, 211, 57, 70, 159, 250, 242,
+			144, 210, 213, 128, 68, 190, 57, 172, 71, 2, 36, 242, 205, 169,
+			105, 62, 139, 213, 242, 130, 173, 58, 227, 165, 162, 108, 135, 237,
+			14, 28, 95, 117, 37, 40, 196, 245, 222, 222, 76, 34, 78, 213,
+			242, 88, 178, 95, 167, 44, 193, 86, 11, 186, 131, 60, 19, 108,
+			213, 64, 185, 95, 176, 43, 206, 36, 125, 234, 207, 67, 74, 207,
+			27, 200, 231, 149, 129, 81, 157, 98, 130, 93, 17, 19, 84, 173,
+			32, 216, 154, 129, 50, 144, 207, 53, 83, 173, 96, 9, 182, 102,
+			160, 92, 96, 130, 173, 141, 11, 126, 153, 219, 142, 45, 156, 141,
+			92, 211, 42, 94, 148, 192, 116, 245, 90, 55, 193, 201, 47, 27,
+			157, 102, 19, 246, 157, 102, 110, 72, 186, 0, 116, 47, 70, 129,
+			50, 115, 96, 13, 55, 10, 67, 252, 2, 119, 28, 27, 8, 235,
+			219, 246, 87, 88, 241, 156, 57, 208, 179, 44, 129, 31, 236, 54,
+			61, 211, 24, 9, 53, 109, 164, 190, 111, 115, 129, 68, 203, 86,
+			68, 235, 166, 51, 241, 91, 161, 189, 176, 179, 109, 162, 188, 55,
+			105, 59, 217, 68, 164, 110, 14, 142, 164, 105, 38, 216, 205, 113,
+			193, 255, 47, 139, 186, 182, 4, 219, 116, 38, 139, 255, 214, 146,
+			192, 240, 3, 75, 165, 8, 145, 79, 186, 209, 176, 147, 180, 59,
+			73, 218, 175, 153, 139, 220, 72, 148, 80, 24, 200, 38, 146, 4,
+			24, 51, 7, 224, 61, 114, 155, 126, 93, 126, 37, 14, 131, 178,
+			108, 133, 245, 78, 19, 212, 34, 158, 140, 59, 59, 113, 226, 39,
+			40, 119, 78, 25, 163, 24, 53, 47, 97, 4, 146, 43, 221, 11,
+			79, 129, 153, 132, 192, 170, 97, 139, 101, 53, 68, 236, 20, 85,
+			103, 32, 169, 193, 47, 56, 212, 178, 116, 27, 160, 38, 112, 155,
+			77, 222, 211, 87, 172, 36, 214, 59, 158, 23, 40, 99, 69, 96,
+			42, 13, 68, 224, 144, 217, 204, 64, 12, 118, 246, 230, 224, 104,
+			154, 102, 130, 109, 138, 9, 60, 73, 109, 117, 146, 222, 117, 220,
+			62, 253, 29, 215, 242, 238, 208, 20, 158, 164, 182, 62, 73, 239,
+			253, 214, 79, 82, 106, 171, 79, 176, 123, 249, 161, 108, 142, 37,
+			216, 189, 225, 177, 108, 14, 19, 236, 30, 157, 164, 182, 62, 73,
+			223, 201, 207, 100, 138, 0, 241, 122, 39, 63, 152, 205, 129, 50,
+			67, 19, 217, 28, 38, 216, 59, 211, 199, 248, 207, 89, 166, 29,
+			91, 176, 47, 231, 103, 138, 127, 214, 146, 250, 194, 101, 52, 142,
+			177, 244, 27, 114, 22, 178, 103, 1, 105, 92, 90, 136, 148, 247,
+			197, 121, 153, 85, 6, 125, 45, 172, 43, 177, 222, 200, 4, 39,
+			160, 59, 142, 65, 66, 219, 241, 227, 61, 233, 85, 118, 43, 178,
+			84, 82, 122, 148, 32, 76, 22, 226, 14, 45, 150, 220, 104, 72,
+			186, 12, 74, 104, 4, 137, 205, 135, 208, 247, 37, 249, 225, 71,
+			31, 129, 18, 221, 39, 13, 197, 142, 39, 147, 168, 227, 245, 192,
+			16, 104, 221, 151, 243, 61, 57, 150, 96, 95, 30, 204, 78, 222,
+			102, 130, 125, 121, 250, 88, 6, 134, 76, 176, 7, 61, 48, 4,
+			146, 252, 160, 103, 41, 128, 44, 63, 24, 206, 54, 3, 164, 249,
+			193, 244, 49, 254, 95, 219, 180, 189, 108, 193, 124, 231, 92, 241,
+			39, 109, 133, 240, 10, 84, 192, 148, 248, 193, 46, 108, 21, 151,
+			200, 205, 158, 151, 194, 202, 255, 0, 164, 219, 160, 24, 167, 187,
+			137, 217, 12, 128, 255, 21, 101, 135, 247, 208, 163, 157, 208, 114,
+			147, 218, 30, 9, 40, 119, 189, 199, 114, 241, 75, 103, 63, 124,
+			247, 203, 31, 189, 119, 254, 163, 197, 138, 220, 12, 101, 233, 236,
+			135, 141, 48, 252, 168, 36, 247, 73, 203, 44, 195, 135, 101, 185,
+			211, 73, 184, 44, 53, 194, 176, 84, 150, 165, 179, 234, 255, 97,
+			4, 133, 77, 201, 32, 76, 42, 156, 171, 97, 197, 160, 183, 126,
+			228, 131, 214, 31, 237, 12, 52, 172, 137, 124, 194, 37, 234, 50,
+			236, 77, 55, 1, 19, 197, 56, 145, 33, 40, 8, 124, 218, 215,
+			156, 16, 194, 59, 114, 91, 103, 246, 34, 28, 150, 190, 83, 76,
+			211, 150, 96, 254, 9, 153, 166, 153, 96, 254, 243, 103, 241, 4,
+			64, 206, 228, 161, 125, 78, 145, 84, 60, 213, 31, 218, 199, 116,
+			202, 18, 236, 225, 140, 212, 41, 38, 216, 195, 231, 207, 34, 3,
+			205, 132, 19, 192, 197, 20, 24, 104, 88, 191, 160, 160, 14, 82,
+			6, 205, 133, 246, 9, 172, 194, 144, 45, 9, 237, 130, 78, 89,
+			130, 133, 3, 211, 58, 197, 4, 11, 143, 23, 169, 154, 37, 88,
+			219, 150, 84, 13, 246, 89, 219, 158, 212, 41, 248, 54, 165, 155,
+			132, 123, 84, 251, 244, 115, 70, 82, 251, 143, 199, 249, 32, 136,
+			146, 99, 18, 189, 218, 245, 86, 241, 89, 166, 247, 79, 151, 202,
+			190, 201, 197, 205, 78, 51, 241, 239, 68, 96, 201, 153, 116, 113,
+			159, 136, 121, 158, 199, 93, 25, 147, 184, 104, 188, 82, 111, 85,
+			122, 138, 84, 169, 64, 233, 23, 44, 62, 220, 91, 153, 196, 176,
+			86, 86, 12, 43, 56, 171, 187, 74, 108, 51, 4, 178, 194, 186,
+			155, 128, 184, 22, 228, 126, 76, 139, 129, 65, 192, 39, 56, 219,
+			241, 3, 20, 225, 22, 160, 220, 142, 31, 136, 37, 238, 192, 37,
+			151, 68, 138, 197, 67, 130, 87, 99, 51, 113, 45, 87, 197, 146,
+			70, 84, 219, 255, 169, 68, 181, 215, 157, 130, 51, 214, 87, 250,
+			35, 22, 31, 92, 77, 224, 204, 74, 110, 248, 113, 34, 102, 185,
+			157, 132, 36, 26, 57, 6, 112, 200, 124, 172, 108, 133, 40, 251,
+			168, 218, 73, 88, 60, 205, 157, 91, 157, 86, 44, 166, 161, 247,
+			150, 2, 221, 240, 101, 123, 204, 2, 145, 112, 43, 46, 190, 205,
+			251, 183, 194, 39, 137, 74, 94, 236, 21, 149, 76, 30, 236, 8,
+			90, 206, 200, 121, 174, 255, 252, 176, 146, 151, 92, 248, 46, 144,
+			151, 204, 163, 232, 196, 18, 172, 223, 136, 78, 6, 114, 131, 184,
+			245, 114, 194, 25, 204, 13, 91, 70, 188, 49, 88, 40, 210, 85,
+			56, 39, 216, 144, 125, 150, 174, 187, 112, 239, 26, 178, 245, 13,
+			26, 246, 245, 208, 224, 140, 78, 89, 130, 13, 29, 127, 78, 167,
+			152, 96, 67, 165, 231, 245, 197, 120, 52, 87, 74, 47, 198, 163,
+			133, 41, 254, 67, 54, 220, 146, 7, 4, 155, 180, 135, 139, 223,
+			180, 53, 136, 228, 75, 72, 143, 80, 128, 30, 171, 131, 9, 64,
+			19, 182, 124, 60, 162, 119, 188, 154, 11, 154, 32, 239, 253, 142,
+			219, 244, 147, 238, 2, 120, 91, 184, 145, 31, 135, 1, 153, 96,
+			168, 154, 80, 39, 8, 147, 48, 242, 195, 78, 220, 236, 202, 186,
+			223, 104, 248, 181, 78, 51, 65, 134, 6, 8, 50, 50, 142, 110,
+			19, 53, 126, 94, 122, 234, 135, 13, 185, 118, 179, 12, 148, 181,
+			219, 12, 93, 50, 125, 143, 188, 184, 211, 76, 180, 218, 93, 117,
+			176, 15, 218, 246, 29, 32, 236, 32, 255, 134, 131, 240, 90, 216,
+			246, 128, 69, 237, 194, 26, 116, 103, 35, 208, 195, 131, 121, 60,
+			26, 128, 43, 17, 211, 190, 39, 235, 97, 48, 155, 96, 38, 208,
+			89, 56, 70, 67, 48, 155, 222, 243, 90, 21, 142, 16, 183, 6,
+			114, 32, 5, 33, 126, 124, 32, 103, 245, 164, 108, 157, 162, 107,
+			213, 180, 125, 38, 123, 173, 154, 38, 201, 19, 222, 98, 143, 25,
+			22, 60, 151, 17, 124, 168, 107, 213, 49, 146, 206, 88, 184, 80,
+			199, 204, 69, 199, 18, 108, 198, 84, 3, 246, 107, 134, 4, 86,
+			234, 90, 53, 99, 170, 1, 197, 156, 49, 213, 108, 144, 144, 232,
+			106, 112, 166, 31, 55, 213, 128, 37, 63, 110, 170, 193, 89, 126,
+			220, 84, 99, 130, 21, 77, 53, 144, 60, 21, 205, 61, 1, 232,
+			127, 209, 84, 131, 179, 187, 56, 46, 248, 15, 89, 250, 94, 117,
+			218, 153, 45, 126, 195, 66, 211, 24, 109, 164, 21, 6, 205, 174,
+			97, 96, 140, 2, 60, 78, 220, 40, 89, 244, 130, 186, 236, 161,
+			158, 112, 128, 131, 155, 128, 219, 222, 251, 124, 199, 139, 186, 155,
+			158, 27, 129, 145, 220, 70, 67, 11, 11, 61, 220, 79, 46, 236,
+			34, 96, 93, 91, 110, 187, 44, 253, 196, 44, 188, 191, 27, 132,
+			17, 108, 105, 26, 162, 147, 23, 236, 180, 115, 74, 167, 64, 198,
+			115, 186, 164, 83, 76, 176, 211, 231, 94, 160, 89, 247, 9, 38,
+			73, 184, 164, 174, 119, 146, 132, 75, 234, 122, 39, 73, 184, 164,
+			174, 119, 242, 76, 137, 255, 57, 75, 93, 143, 102, 115, 23, 172,
+			226, 31, 183, 100, 134, 94, 1, 162, 55, 195, 93, 80, 152, 54,
+			187, 189, 236, 75, 39, 240, 223, 239, 128, 70, 31, 11, 27, 51,
+			191, 61, 114, 241, 104, 181, 221, 90, 194, 211, 107, 150, 194, 127,
+			162, 32, 74, 27, 41, 227, 154, 23, 184, 145, 31, 66, 115, 186,
+			229, 22, 156, 98, 237, 102, 218, 48, 241, 72, 92, 198, 110, 203,
+			147, 168, 151, 157, 139, 231, 51, 23, 178, 217, 2, 44, 49, 93,
+			200, 230, 236, 23, 89, 230, 166, 5, 130, 151, 97, 226, 199, 114,
+			130, 205, 59, 231, 13, 67, 1, 180, 102, 222, 25, 234, 185, 62,
+			205, 15, 79, 244, 92, 159, 230, 39, 103, 122, 174, 79, 243, 39,
+			78, 166, 233, 130, 96, 243, 167, 94, 212, 108, 163, 157, 43, 216,
+			130, 205, 159, 158, 231, 127, 219, 210, 44, 202, 138, 125, 162, 248,
+			87, 45, 185, 21, 2, 24, 93, 217, 114, 219, 48, 25, 156, 66,
+			188, 144, 132, 11, 102, 138, 192, 14, 163, 67, 141, 12, 3, 228,
+			220, 252, 6, 90, 100, 236, 131, 97, 175, 249, 200, 193, 42, 33,
+			217, 51, 160, 65, 38, 238, 171, 203, 192, 198, 193, 143, 149, 18,
+			18, 129, 210, 142, 27, 97, 38, 158, 1, 138, 243, 107, 134, 225,
+			67, 217, 244, 31, 122, 151, 64, 131, 76, 218, 104, 168, 93, 186,
+			36, 223, 93, 46, 203, 149, 247, 202, 148, 183, 227, 70, 152, 167,
+			50, 62, 162, 91, 43, 178, 88, 43, 246, 68, 134, 197, 90, 153,
+			156, 206, 176, 88, 43, 199, 139, 134, 185, 249, 251, 109, 62, 182,
+			27, 185, 237, 189, 237, 186, 155, 184, 159, 134, 195, 249, 45, 104,
+			175, 139, 89, 126, 170, 244, 159, 250, 120, 223, 231, 1, 204, 226,
+			36, 183, 253, 58, 169, 106, 135, 224, 48, 198, 236, 202, 198, 90,
+			213, 246, 235, 160, 234, 88, 187, 181, 142, 236, 76, 161, 202, 214,
+			110, 173, 139, 18, 119, 96, 212, 164, 130, 29, 73, 107, 172, 185,
+			137, 91, 197, 111, 226, 2, 47, 232, 149, 155, 113, 82, 126, 66,
+			149, 163, 205, 67, 42, 22, 83, 16, 92, 35, 219, 110, 148, 248,
+			110, 115, 102, 12, 187, 211, 201, 226, 36, 183, 55, 214, 196, 136,
+			25, 232, 0, 12, 173, 248, 239, 44, 238, 172, 121, 113, 77, 92,
+			228, 199, 140, 97, 74, 24, 109, 215, 194, 160, 225, 239, 102, 181,
+			64, 83, 153, 207, 87, 240, 43, 170, 131, 206, 240, 33, 184, 134,
+			111, 211, 81, 67, 186, 189, 65, 200, 187, 163, 178, 196, 23, 248,
+			148, 247, 184, 13, 22, 53, 219, 234, 12, 218, 110, 135, 77, 191,
+			214, 197, 217, 143, 172, 148, 50, 179, 247, 226, 90, 101, 93, 149,
+			173, 98, 209, 59, 88, 178, 58, 225, 29, 206, 44, 189, 204, 39,
+			142, 40, 43, 134, 249, 64, 117, 125, 253, 139, 235, 87, 238, 110,
+			173, 143, 229, 196, 40, 31, 188, 181, 126, 111, 123, 117, 107, 107,
+			253, 230, 157, 173, 49, 171, 184, 199, 135, 244, 37, 1, 20, 86,
+			8, 178, 40, 132, 243, 143, 102, 170, 147, 176, 110, 145, 215, 160,
+			41, 177, 200, 107, 64, 217, 71, 138, 121, 193, 193, 15, 84, 117,
+			82, 8, 238, 32, 176, 28, 4, 22, 254, 46, 254, 48, 0, 23,
+			150, 242, 37, 222, 95, 139, 60, 144, 101, 205, 88, 207, 98, 75,
+			171, 186, 40, 34, 137, 23, 215, 102, 236, 67, 72, 226, 197, 181,
+			170, 83, 135, 101, 91, 230, 5, 48, 241, 79, 182, 119, 186, 164,
+			65, 157, 78, 203, 101, 167, 89, 237, 199, 114, 151, 187, 197, 107,
+			124, 184, 7, 123, 178, 236, 229, 176, 98, 47, 207, 244, 178, 151,
+			131, 25, 246, 50, 195, 85, 150, 254, 255, 35, 188, 159, 178, 197,
+			233, 204, 14, 24, 201, 148, 127, 242, 30, 56, 219, 179, 7, 198,
+			178, 117, 50, 187, 224, 53, 206, 189, 199, 94, 77, 137, 89, 104,
+			31, 156, 200, 150, 93, 55, 95, 113, 54, 213, 76, 113, 241, 34,
+			47, 52, 246, 235, 219, 117, 175, 29, 147, 77, 194, 104, 166, 42,
+			240, 235, 213, 254, 198, 126, 125, 205, 107, 199, 162, 204, 7, 192,
+			183, 78, 21, 206, 31, 93, 184, 0, 37, 176, 244, 66, 239, 62,
+			27, 92, 153, 200, 148, 5, 245, 39, 236, 185, 116, 243, 189, 136,
+			155, 111, 146, 247, 33, 85, 38, 60, 83, 9, 218, 146, 0, 233,
+			97, 220, 146, 255, 162, 255, 183, 132, 53, 23, 121, 161, 21, 214,
+			65, 13, 91, 159, 177, 159, 89, 205, 148, 21, 231, 248, 72, 208,
+			105, 109, 103, 128, 13, 24, 62, 92, 29, 14, 58, 173, 20, 198,
+			226, 58, 31, 5, 182, 47, 78, 11, 146, 94, 252, 185, 44, 0,
+			96, 252, 149, 91, 80, 206, 84, 189, 150, 171, 142, 4, 61, 57,
+			226, 18, 31, 160, 86, 130, 93, 90, 159, 226, 161, 86, 168, 120,
+			176, 123, 45, 87, 77, 139, 139, 55, 248, 160, 91, 175, 251, 193,
+			110, 118, 193, 122, 16, 3, 107, 175, 98, 25, 88, 178, 107, 185,
+			42, 119, 77, 10, 128, 187, 211, 12, 107, 15, 189, 58, 153, 146,
+			204, 28, 170, 123, 89, 125, 191, 150, 171, 234, 162, 226, 21, 94,
+			104, 248, 129, 31, 239, 121, 117, 52, 248, 1, 155, 149, 131, 213,
+			174, 82, 129, 107, 185, 170, 41, 12, 96, 115, 119, 2, 80, 119,
+			53, 183, 85, 230, 204, 192, 19, 192, 182, 74, 229, 84, 59, 0,
+			54, 93, 83, 229, 20, 175, 242, 145, 94, 208, 194, 100, 224, 88,
+			247, 131, 221, 79, 130, 41, 84, 180, 248, 50, 31, 48, 192, 21,
+			115, 124, 172, 214, 137, 210, 85, 221, 166, 221, 60, 92, 29, 169,
+			117, 34, 211, 213, 70, 189, 120, 131, 243, 20, 170, 96, 102, 2,
+			104, 163, 32, 75, 53, 6, 130, 78, 75, 21, 17, 207, 241, 65,
+			248, 188, 239, 250, 176, 106, 136, 144, 195, 85, 30, 116, 90, 247,
+			84, 78, 241, 69, 222, 79, 112, 62, 88, 214, 58, 84, 246, 247,
+			89, 188, 160, 161, 43, 46, 1, 97, 104, 251, 96, 163, 29, 6,
+			159, 96, 218, 153, 210, 48, 89, 56, 160, 244, 113, 20, 251, 31,
+			120, 52, 180, 17, 200, 87, 71, 207, 166, 255, 129, 7, 99, 202,
+			148, 36, 162, 207, 211, 66, 197, 57, 62, 210, 187, 96, 98, 154,
+			231, 35, 207, 141, 105, 80, 3, 85, 74, 93, 30, 225, 67, 116,
+			76, 111, 3, 19, 81, 188, 193, 71, 13, 88, 159, 72, 137, 159,
+			239, 165, 196, 195, 128, 45, 166, 86, 134, 22, 23, 191, 105, 243,
+			126, 34, 59, 112, 22, 1, 237, 196, 254, 11, 68, 71, 79, 247,
+			208, 81, 104, 173, 208, 67, 42, 143, 103, 72, 37, 204, 178, 144,
+			82, 198, 19, 89, 202, 8, 155, 189, 144, 33, 132, 43, 48, 91,
+			128, 4, 110, 224, 145, 149, 226, 17, 116, 176, 162, 96, 85, 165,
+			146, 165, 251, 60, 175, 114, 4, 231, 249, 27, 183, 87, 215, 214,
+			215, 198, 114, 98, 132, 243, 91, 183, 183, 182, 41, 109, 9, 193,
+			71, 32, 189, 122, 119, 235, 218, 237, 234, 198, 253, 245, 181, 49,
+			91, 76, 240, 209, 181, 213, 173, 213, 237, 205, 141, 251, 235, 219,
+			55, 54, 110, 110, 108, 141, 49, 49, 200, 251, 215, 191, 120, 103,
+			163, 186, 190, 54, 230, 148, 126, 183, 197, 251, 54, 19, 55, 241,
+			160, 236, 173, 245, 245, 181, 205, 109, 197, 11, 108, 220, 190, 53,
+			150, 3, 230, 128, 146, 183, 222, 26, 179, 128, 57, 88, 93, 91,
+			219, 184, 245, 214, 246, 218, 250, 157, 205, 49, 27, 218, 186, 124,
+			227, 246, 149, 183, 215, 215, 198, 152, 56, 201, 103, 86, 239, 173,
+			110, 108, 193, 119, 211, 200, 246, 230, 214, 234, 214, 250, 152, 35,
+			134, 120, 225, 234, 198, 173, 141, 205, 107, 235, 107, 99, 125, 98,
+			138, 143, 175, 94, 190, 117, 187, 122, 115, 245, 198, 182, 201, 206,
+			151, 254, 76, 159, 217, 100, 97, 32, 100, 230, 144, 28, 235, 89,
+			74, 125, 76, 190, 64, 75, 7, 11, 52, 184, 34, 122, 203, 100,
+			142, 197, 39, 243, 121, 111, 112, 7, 132, 55, 159, 160, 175, 30,
+			83, 179, 33, 109, 106, 182, 246, 148, 163, 106, 134, 247, 19, 10,
+			211, 118, 209, 73, 58, 196, 152, 57, 196, 254, 180, 77, 135, 216,
+			60, 239, 139, 97, 57, 112, 36, 35, 43, 19, 189, 35, 193, 149,
+			170, 170, 18, 226, 12, 31, 194, 31, 219, 180, 125, 136, 149, 196,
+			188, 42, 102, 101, 143, 68, 246, 201, 143, 196, 243, 124, 60, 203,
+			219, 226, 60, 241, 212, 26, 168, 142, 101, 62, 108, 65, 190, 88,
+			226, 147, 217, 194, 126, 208, 8, 183, 59, 81, 19, 209, 123, 160,
+			42, 50, 223, 54, 130, 70, 120, 55, 106, 138, 117, 62, 6, 34,
+			105, 111, 59, 67, 143, 242, 207, 164, 71, 163, 88, 103, 221, 84,
+			41, 125, 159, 193, 220, 97, 62, 176, 121, 229, 218, 250, 218, 221,
+			27, 184, 49, 6, 121, 127, 245, 238, 173, 91, 10, 99, 135, 120,
+			161, 186, 126, 125, 253, 202, 22, 238, 135, 97, 62, 0, 190, 200,
+			107, 219, 183, 239, 194, 78, 200, 162, 164, 3, 155, 235, 234, 234,
+			6, 180, 209, 7, 120, 125, 115, 99, 115, 19, 218, 200, 195, 38,
+			184, 178, 122, 235, 202, 250, 13, 248, 214, 15, 27, 143, 186, 131,
+			207, 133, 210, 207, 88, 124, 0, 69, 10, 184, 128, 203, 60, 143,
+			152, 160, 133, 187, 120, 224, 153, 207, 138, 211, 212, 150, 94, 170,
+			32, 156, 9, 123, 110, 125, 219, 139, 162, 48, 210, 180, 102, 96,
+			207, 173, 175, 99, 134, 56, 206, 11, 240, 185, 21, 70, 218, 218,
+			174, 127, 207, 173, 223, 12, 35, 175, 184, 198, 7, 51, 13, 30,
+			33, 248, 124, 174, 151, 30, 14, 24, 102, 55, 67, 11, 175, 255,
+			169, 45, 222, 47, 250, 156, 220, 143, 219, 223, 77, 226, 206, 66,
+			110, 13, 127, 218, 130, 241, 220, 20, 73, 62, 135, 82, 201, 231,
+			72, 238, 115, 169, 228, 115, 68, 27, 118, 129, 54, 113, 212, 30,
+			39, 195, 46, 212, 37, 130, 182, 27, 164, 21, 164, 73, 28, 115,
+			148, 197, 137, 86, 6, 142, 145, 170, 82, 171, 2, 199, 6, 135,
+			211, 52, 152, 92, 145, 97, 0, 86, 22, 246, 144, 22, 149, 230,
+			5, 19, 182, 163, 83, 150, 96, 162, 207, 120, 25, 49, 193, 64,
+			90, 246, 53, 109, 23, 54, 109, 143, 22, 35, 185, 118, 107, 93,
+			146, 117, 12, 248, 181, 69, 29, 15, 84, 126, 8, 101, 92, 83,
+			89, 15, 61, 148, 128, 74, 239, 49, 72, 181, 193, 13, 137, 22,
+			2, 128, 215, 108, 134, 251, 232, 254, 134, 102, 42, 49, 122, 111,
+			42, 29, 161, 110, 165, 183, 113, 18, 114, 229, 80, 34, 56, 109,
+			231, 117, 202, 18, 108, 186, 223, 124, 3, 195, 171, 225, 17, 2,
+			158, 37, 156, 25, 45, 234, 201, 49, 148, 29, 146, 168, 39, 199,
+			80, 58, 121, 220, 153, 37, 224, 88, 40, 218, 57, 110, 128, 167,
+			36, 148, 199, 7, 75, 105, 26, 164, 134, 231, 94, 48, 213, 45,
+			144, 20, 158, 52, 159, 97, 84, 197, 76, 117, 232, 173, 56, 120,
+			44, 77, 131, 244, 176, 120, 130, 255, 128, 165, 234, 195, 154, 159,
+			118, 230, 250, 138, 31, 200, 35, 174, 193, 178, 22, 6, 73, 20,
+			54, 193, 9, 215, 77, 228, 218, 77, 5, 76, 244, 33, 5, 183,
+			2, 146, 237, 168, 0, 43, 49, 136, 124, 220, 128, 155, 108, 141,
+			236, 106, 25, 246, 247, 194, 24, 100, 196, 112, 100, 203, 61, 23,
+			125, 126, 253, 200, 232, 184, 113, 44, 32, 41, 28, 56, 201, 63,
+			226, 5, 53, 54, 0, 206, 153, 252, 100, 177, 41, 205, 101, 92,
+			70, 222, 130, 226, 56, 200, 135, 150, 154, 209, 189, 42, 233, 50,
+			178, 218, 234, 187, 26, 156, 27, 36, 92, 118, 130, 196, 111, 98,
+			166, 30, 34, 140, 195, 149, 141, 200, 139, 247, 104, 104, 164, 146,
+			165, 238, 193, 28, 44, 63, 154, 205, 177, 5, 59, 35, 38, 248,
+			79, 217, 102, 140, 150, 96, 179, 249, 233, 226, 143, 218, 50, 35,
+			34, 0, 253, 226, 163, 163, 71, 40, 221, 102, 24, 120, 106, 156,
+			123, 238, 161, 65, 26, 225, 26, 214, 13, 188, 199, 201, 19, 0,
+			10, 78, 28, 158, 91, 47, 75, 60, 204, 252, 96, 23, 68, 178,
+			126, 67, 139, 217, 65, 99, 144, 105, 24, 246, 8, 93, 64, 12,
+			28, 220, 228, 41, 128, 0, 79, 230, 160, 46, 215, 49, 192, 137,
+			33, 213, 198, 237, 163, 167, 182, 31, 200, 118, 211, 173, 153, 109,
+			165, 103, 172, 162, 202, 164, 208, 3, 108, 156, 205, 143, 103, 115,
+			108, 193, 102, 39, 167, 12, 58, 131, 92, 210, 89, 49, 232, 10,
+			154, 214, 121, 39, 69, 95, 216, 246, 243, 51, 11, 105, 154, 9,
+			54, 191, 180, 76, 27, 205, 22, 78, 217, 94, 214, 27, 13, 202,
+			150, 249, 52, 53, 141, 66, 206, 5, 211, 148, 178, 232, 90, 48,
+			59, 197, 198, 141, 182, 48, 168, 169, 152, 18, 21, 46, 76, 165,
+			213, 45, 193, 42, 206, 132, 169, 14, 27, 173, 146, 169, 14, 83,
+			171, 144, 5, 11, 126, 103, 130, 85, 198, 133, 169, 110, 11, 182,
+			152, 233, 29, 20, 3, 139, 153, 234, 48, 216, 197, 76, 239, 160,
+			28, 88, 204, 244, 206, 4, 91, 114, 38, 205, 103, 80, 16, 44,
+			101, 170, 3, 105, 95, 34, 107, 16, 232, 29, 148, 4, 75, 98,
+			130, 224, 194, 132, 115, 193, 126, 69, 195, 5, 202, 94, 48, 4,
+			8, 21, 202, 47, 57, 47, 82, 85, 134, 226, 211, 151, 156, 83,
+			105, 218, 18, 236, 165, 211, 231, 210, 52, 19, 236, 165, 185, 121,
+			83, 221, 18, 236, 101, 103, 198, 84, 7, 213, 242, 203, 142, 134,
+			131, 82, 46, 191, 60, 170, 225, 166, 212, 203, 47, 79, 31, 51,
+			213, 109, 193, 46, 58, 122, 65, 153, 109, 59, 144, 30, 74, 211,
+			121, 193, 46, 14, 63, 151, 166, 193, 152, 78, 206, 165, 105, 48,
+			181, 59, 95, 166, 179, 196, 22, 236, 51, 182, 54, 99, 5, 220,
+			249, 140, 33, 208, 0, 224, 207, 244, 15, 234, 20, 19, 236, 51,
+			35, 163, 252, 162, 182, 250, 125, 205, 62, 91, 156, 151, 15, 61,
+			99, 90, 244, 192, 175, 63, 32, 123, 64, 66, 106, 66, 246, 202,
+			198, 154, 38, 243, 96, 106, 241, 154, 173, 77, 106, 1, 174, 175,
+			29, 211, 74, 63, 88, 129, 215, 74, 207, 243, 135, 218, 64, 248,
+			77, 123, 178, 248, 101, 73, 151, 25, 216, 141, 116, 84, 53, 200,
+			40, 2, 217, 30, 233, 198, 15, 41, 68, 18, 82, 77, 96, 158,
+			208, 10, 66, 238, 187, 49, 104, 203, 200, 243, 158, 163, 214, 162,
+			233, 37, 94, 179, 11, 135, 125, 83, 250, 137, 57, 153, 192, 0,
+			249, 77, 51, 113, 176, 174, 123, 179, 63, 107, 128, 252, 230, 56,
+			24, 167, 129, 94, 166, 239, 114, 238, 47, 90, 169, 50, 242, 114,
+			97, 52, 181, 210, 189, 98, 95, 37, 117, 26, 158, 247, 87, 232,
+			188, 39, 227, 213, 53, 71, 219, 62, 170, 243, 126, 205, 225, 61,
+			22, 167, 107, 131, 99, 105, 26, 44, 219, 38, 38, 77, 117, 75,
+			176, 117, 98, 23, 44, 178, 108, 90, 207, 84, 135, 145, 172, 19,
+			187, 96, 145, 193, 207, 186, 177, 35, 204, 9, 246, 22, 177, 11,
+			22, 226, 234, 91, 196, 46, 168, 163, 242, 45, 98, 23, 148, 50,
+			239, 45, 62, 200, 127, 135, 165, 181, 121, 111, 219, 163, 197, 228,
+			105, 252, 130, 38, 103, 191, 45, 28, 131, 58, 155, 223, 166, 117,
+			81, 58, 196, 183, 137, 99, 80, 166, 153, 111, 19, 199, 128, 135,
+			242, 77, 251, 139, 4, 126, 60, 22, 111, 246, 19, 248, 29, 4,
+			255, 45, 218, 113, 250, 144, 186, 69, 148, 73, 31, 81, 183, 104,
+			135, 209, 249, 116, 91, 131, 155, 104, 240, 109, 135, 192, 75, 20,
+			248, 246, 216, 184, 41, 110, 11, 118, 135, 40, 141, 69, 251, 230,
+			142, 51, 154, 166, 225, 187, 152, 48, 197, 153, 96, 159, 119, 104,
+			173, 105, 19, 124, 222, 25, 76, 211, 182, 96, 159, 31, 25, 53,
+			197, 29, 193, 170, 206, 25, 243, 25, 144, 179, 74, 236, 11, 180,
+			238, 216, 130, 85, 159, 147, 166, 56, 26, 189, 141, 155, 207, 125,
+			96, 244, 70, 212, 1, 138, 247, 217, 130, 109, 142, 142, 241, 111,
+			88, 84, 62, 47, 216, 61, 167, 88, 236, 200, 67, 215, 104, 189,
+			40, 200, 187, 100, 182, 243, 108, 44, 205, 77, 50, 150, 15, 189,
+			118, 34, 27, 174, 223, 132, 227, 20, 252, 225, 149, 152, 77, 250,
+			196, 87, 172, 221, 148, 187, 96, 164, 215, 105, 195, 1, 29, 121,
+			73, 212, 165, 240, 16, 45, 226, 99, 44, 178, 194, 191, 167, 55,
+			8, 164, 109, 193, 238, 205, 28, 231, 195, 100, 114, 221, 119, 223,
+			254, 186, 165, 55, 23, 224, 193, 125, 34, 199, 100, 115, 253, 46,
+			145, 99, 76, 230, 5, 123, 215, 57, 213, 99, 35, 253, 238, 233,
+			115, 61, 54, 210, 239, 18, 57, 134, 242, 150, 96, 95, 34, 205,
+			33, 38, 243, 144, 78, 171, 67, 111, 95, 58, 253, 66, 154, 102,
+			130, 125, 105, 254, 69, 83, 221, 22, 236, 61, 231, 180, 249, 12,
+			199, 212, 123, 102, 111, 170, 243, 247, 189, 193, 227, 105, 154, 9,
+			246, 222, 201, 83, 100, 191, 143, 214, 253, 219, 218, 234, 16, 211,
+			150, 96, 219, 67, 69, 84, 60, 98, 26, 144, 247, 65, 190, 76,
+			150, 241, 218, 94, 253, 65, 254, 185, 108, 14, 216, 171, 201, 217,
+			108, 14, 216, 171, 189, 120, 222, 116, 99, 9, 167, 230, 120, 105,
+			55, 48, 171, 218, 208, 148, 233, 6, 161, 88, 207, 151, 76, 19,
+			138, 175, 174, 231, 135, 178, 57, 150, 96, 245, 225, 83, 217, 28,
+			38, 88, 93, 158, 49, 221, 216, 194, 217, 117, 190, 146, 118, 3,
+			179, 223, 29, 154, 54, 221, 32, 79, 177, 151, 63, 105, 154, 80,
+			92, 197, 94, 166, 27, 197, 87, 236, 13, 107, 127, 1, 109, 43,
+			190, 87, 60, 145, 105, 6, 236, 199, 242, 233, 72, 20, 119, 225,
+			247, 52, 3, 83, 244, 135, 103, 178, 57, 96, 101, 134, 74, 94,
+			53, 90, 38, 156, 166, 19, 164, 163, 133, 243, 168, 57, 52, 97,
+			186, 193, 147, 190, 149, 233, 70, 153, 143, 181, 50, 221, 40, 19,
+			178, 86, 166, 27, 101, 70, 214, 202, 116, 227, 8, 167, 237, 36,
+			105, 55, 176, 133, 219, 67, 218, 65, 194, 98, 14, 116, 243, 126,
+			126, 209, 52, 225, 32, 153, 126, 63, 179, 196, 14, 118, 243, 190,
+			124, 49, 155, 195, 4, 123, 127, 161, 146, 105, 198, 18, 44, 202,
+			44, 161, 131, 64, 137, 50, 163, 117, 144, 150, 69, 153, 37, 116,
+			16, 40, 145, 60, 147, 105, 198, 22, 44, 206, 76, 218, 65, 156,
+			142, 123, 154, 129, 117, 141, 51, 147, 118, 16, 175, 227, 204, 164,
+			251, 132, 243, 200, 121, 156, 78, 26, 8, 209, 163, 12, 94, 247,
+			193, 164, 247, 201, 14, 147, 50, 250, 32, 39, 237, 166, 15, 39,
+			189, 63, 60, 145, 205, 97, 130, 237, 163, 57, 39, 46, 97, 33,
+			39, 250, 62, 112, 126, 135, 101, 250, 65, 255, 128, 15, 136, 135,
+			133, 237, 203, 4, 251, 48, 63, 175, 63, 35, 3, 242, 97, 158,
+			136, 53, 164, 45, 193, 62, 156, 58, 155, 166, 161, 252, 236, 156,
+			169, 238, 8, 246, 213, 124, 186, 187, 193, 78, 227, 171, 121, 162,
+			237, 240, 217, 18, 236, 171, 99, 233, 238, 6, 102, 225, 171, 39,
+			79, 153, 234, 125, 130, 125, 148, 39, 226, 13, 201, 60, 164, 245,
+			57, 175, 44, 54, 62, 26, 39, 98, 14, 223, 153, 96, 31, 105,
+			98, 206, 44, 224, 199, 62, 206, 23, 205, 103, 48, 189, 255, 56,
+			175, 207, 121, 101, 124, 255, 241, 136, 102, 43, 148, 249, 253, 199,
+			72, 53, 17, 56, 118, 191, 96, 95, 203, 167, 173, 131, 9, 254,
+			215, 242, 35, 105, 218, 18, 236, 107, 163, 199, 210, 52, 19, 236,
+			107, 197, 19, 122, 9, 237, 130, 112, 190, 199, 202, 207, 243, 81,
+			250, 94, 200, 99, 198, 100, 154, 97, 65, 198, 212, 217, 52, 131,
+			65, 198, 236, 156, 58, 148, 109, 91, 56, 223, 107, 217, 99, 124,
+			88, 59, 76, 64, 50, 175, 147, 22, 124, 237, 31, 212, 73, 6,
+			201, 145, 81, 254, 42, 25, 247, 56, 223, 103, 217, 115, 197, 243,
+			79, 231, 48, 83, 145, 38, 240, 152, 195, 218, 195, 2, 234, 206,
+			232, 164, 37, 156, 239, 179, 142, 63, 175, 147, 12, 146, 47, 204,
+			210, 16, 29, 225, 252, 78, 203, 62, 77, 67, 116, 242, 152, 212,
+			35, 6, 219, 179, 223, 105, 141, 31, 215, 73, 6, 201, 147, 167,
+			168, 106, 159, 112, 126, 151, 101, 63, 71, 31, 251, 242, 152, 212,
+			85, 251, 44, 72, 142, 23, 117, 146, 65, 242, 20, 32, 18, 28,
+			104, 76, 228, 127, 208, 178, 255, 172, 197, 212, 103, 198, 44, 225,
+			252, 160, 197, 199, 249, 23, 16, 244, 64, 119, 156, 111, 89, 142,
+			40, 94, 149, 192, 207, 30, 226, 125, 233, 36, 198, 111, 100, 191,
+			148, 90, 194, 3, 115, 235, 213, 179, 60, 48, 167, 5, 66, 242,
+			5, 13, 23, 210, 12, 75, 56, 223, 178, 6, 134, 211, 12, 6,
+			25, 99, 227, 188, 69, 67, 177, 132, 243, 251, 44, 103, 166, 248,
+			94, 246, 212, 63, 56, 160, 204, 167, 125, 47, 50, 204, 185, 30,
+			6, 200, 146, 148, 65, 52, 72, 226, 188, 22, 4, 145, 81, 38,
+			203, 96, 169, 128, 18, 13, 221, 191, 213, 135, 253, 165, 35, 180,
+			112, 0, 3, 19, 105, 6, 131, 140, 233, 99, 188, 70, 35, 180,
+			133, 243, 35, 150, 51, 93, 220, 148, 87, 149, 82, 185, 103, 120,
+			58, 239, 233, 227, 234, 29, 22, 63, 60, 46, 187, 15, 123, 73,
+			199, 101, 91, 144, 49, 48, 158, 102, 48, 200, 152, 156, 226, 30,
+			141, 139, 9, 231, 199, 45, 231, 88, 241, 174, 188, 76, 106, 155,
+			158, 129, 153, 204, 223, 226, 200, 88, 31, 246, 147, 142, 12, 176,
+			233, 199, 173, 1, 145, 102, 224, 72, 166, 166, 249, 152, 26, 153,
+			147, 19, 249, 159, 176, 156, 255, 198, 234, 211, 69, 128, 99, 118,
+			126, 194, 26, 24, 229, 215, 144, 62, 51, 224, 138, 133, 243, 251,
+			173, 252, 120, 241, 162, 84, 186, 33, 138, 111, 66, 130, 12, 10,
+			156, 1, 162, 169, 125, 55, 198, 40, 84, 13, 183, 150, 148, 211,
+			241, 141, 35, 209, 198, 150, 44, 108, 106, 40, 155, 101, 67, 214,
+			232, 24, 255, 126, 203, 116, 104, 9, 231, 15, 89, 249, 169, 98,
+			71, 166, 10, 41, 205, 132, 250, 250, 210, 7, 130, 22, 228, 55,
+			149, 159, 1, 244, 102, 12, 59, 33, 142, 154, 7, 247, 62, 46,
+			93, 153, 68, 224, 123, 11, 54, 236, 40, 16, 79, 131, 125, 32,
+			102, 202, 8, 130, 170, 117, 208, 191, 141, 156, 115, 211, 193, 1,
+			202, 253, 33, 43, 63, 150, 25, 175, 101, 67, 214, 196, 36, 255,
+			102, 58, 94, 91, 56, 127, 212, 202, 31, 47, 198, 178, 87, 97,
+			118, 96, 204, 239, 131, 133, 32, 140, 74, 186, 157, 100, 207, 11,
+			18, 116, 179, 160, 48, 183, 110, 144, 238, 29, 78, 18, 63, 218,
+			221, 40, 57, 132, 27, 108, 86, 190, 5, 230, 172, 65, 239, 104,
+			1, 17, 255, 168, 149, 159, 204, 102, 225, 208, 142, 205, 240, 170,
+			25, 44, 19, 206, 159, 176, 242, 197, 226, 231, 228, 1, 77, 222,
+			129, 209, 182, 220, 199, 104, 217, 133, 218, 89, 21, 254, 26, 199,
+			30, 65, 24, 192, 3, 235, 10, 136, 246, 39, 172, 252, 84, 54,
+			203, 134, 172, 153, 227, 252, 79, 164, 112, 114, 132, 243, 39, 173,
+			188, 40, 254, 136, 37, 73, 97, 120, 160, 79, 154, 240, 108, 124,
+			148, 176, 83, 75, 89, 233, 91, 172, 194, 5, 238, 186, 209, 142,
+			187, 235, 45, 212, 194, 102, 19, 77, 104, 213, 109, 67, 129, 10,
+			109, 24, 123, 238, 44, 210, 221, 133, 176, 69, 96, 126, 201, 51,
+			242, 80, 37, 3, 72, 71, 15, 4, 255, 79, 90, 249, 225, 108,
+			150, 13, 89, 99, 227, 252, 71, 45, 218, 214, 142, 112, 254, 12,
+			144, 155, 239, 179, 244, 112, 211, 217, 80, 119, 85, 109, 243, 123,
+			96, 103, 195, 16, 51, 38, 126, 219, 20, 137, 146, 75, 173, 94,
+			87, 155, 31, 132, 25, 113, 216, 130, 170, 160, 126, 203, 98, 126,
+			70, 176, 113, 136, 16, 192, 233, 245, 103, 44, 39, 147, 97, 65,
+			198, 96, 74, 162, 28, 6, 25, 147, 83, 252, 87, 44, 114, 250,
+			115, 254, 130, 101, 31, 43, 254, 143, 150, 17, 179, 80, 144, 48,
+			128, 241, 51, 196, 45, 52, 87, 56, 132, 202, 153, 3, 0, 195,
+			234, 0, 93, 59, 74, 10, 115, 72, 8, 3, 100, 23, 221, 128,
+			50, 98, 30, 178, 215, 46, 115, 16, 207, 182, 60, 87, 203, 78,
+			131, 80, 146, 182, 21, 98, 61, 187, 74, 59, 145, 6, 135, 163,
+			241, 84, 52, 31, 144, 87, 179, 27, 208, 73, 11, 146, 124, 92,
+			39, 25, 36, 209, 204, 17, 214, 56, 255, 151, 172, 220, 255, 110,
+			89, 124, 144, 35, 31, 237, 252, 37, 171, 48, 206, 191, 101, 145,
+			225, 105, 254, 175, 88, 246, 127, 103, 177, 226, 71, 233, 60, 183,
+			65, 119, 5, 120, 236, 202, 164, 211, 110, 26, 105, 7, 193, 9,
+			80, 208, 148, 221, 88, 75, 87, 190, 150, 248, 143, 96, 251, 243,
+			244, 179, 68, 13, 168, 156, 139, 41, 106, 212, 42, 149, 73, 11,
+			68, 237, 218, 60, 205, 11, 45, 95, 157, 191, 98, 241, 17, 237,
+			184, 6, 132, 250, 175, 90, 14, 220, 142, 200, 118, 53, 143, 25,
+			19, 105, 134, 5, 25, 147, 211, 105, 6, 131, 140, 227, 69, 211,
+			132, 37, 156, 159, 177, 156, 73, 211, 4, 156, 194, 63, 99, 57,
+			3, 105, 6, 150, 224, 163, 105, 6, 131, 12, 116, 159, 3, 24,
+			89, 34, 255, 215, 44, 251, 191, 39, 142, 198, 6, 157, 141, 243,
+			215, 44, 62, 68, 61, 192, 245, 210, 249, 235, 150, 51, 69, 245,
+			241, 118, 9, 25, 60, 205, 176, 132, 243, 215, 173, 193, 177, 52,
+			131, 65, 198, 196, 164, 105, 194, 18, 206, 183, 45, 231, 152, 105,
+			2, 6, 249, 237, 108, 19, 208, 233, 183, 173, 65, 145, 102, 48,
+			200, 152, 154, 54, 77, 216, 194, 249, 57, 203, 73, 11, 192, 169,
+			254, 115, 217, 38, 96, 237, 127, 206, 26, 28, 78, 51, 24, 100,
+			140, 129, 143, 177, 178, 251, 117, 254, 134, 101, 15, 169, 89, 194,
+			53, 13, 146, 142, 78, 90, 194, 249, 27, 86, 95, 191, 78, 50,
+			72, 114, 16, 237, 56, 14, 4, 152, 200, 255, 188, 101, 255, 207,
+			26, 68, 120, 228, 254, 188, 213, 63, 196, 191, 4, 131, 131, 51,
+			81, 56, 127, 11, 152, 190, 27, 168, 135, 48, 70, 34, 72, 13,
+			149, 143, 35, 6, 15, 5, 79, 6, 116, 19, 72, 67, 154, 133,
+			145, 218, 108, 202, 113, 65, 70, 157, 32, 0, 4, 236, 122, 196,
+			250, 217, 116, 8, 255, 45, 203, 161, 153, 209, 17, 252, 183, 172,
+			177, 113, 254, 34, 245, 111, 9, 231, 239, 88, 206, 88, 177, 120,
+			160, 127, 136, 169, 170, 90, 76, 91, 3, 80, 255, 29, 203, 25,
+			76, 51, 108, 200, 24, 25, 229, 55, 168, 53, 91, 56, 127, 215,
+			114, 198, 139, 159, 61, 208, 26, 28, 38, 157, 64, 211, 131, 29,
+			239, 105, 179, 74, 251, 131, 117, 249, 187, 150, 51, 148, 102, 96,
+			251, 163, 99, 252, 62, 245, 199, 132, 243, 139, 0, 189, 235, 71,
+			244, 247, 76, 192, 33, 123, 5, 103, 109, 212, 9, 76, 76, 195,
+			180, 119, 56, 232, 126, 49, 11, 59, 102, 67, 198, 216, 56, 95,
+			166, 222, 29, 225, 252, 18, 204, 246, 204, 129, 222, 129, 205, 128,
+			189, 175, 233, 94, 61, 109, 20, 14, 155, 95, 202, 78, 9, 172,
+			244, 127, 201, 26, 29, 227, 155, 212, 104, 159, 112, 126, 217, 114,
+			70, 139, 87, 14, 55, 170, 214, 251, 192, 92, 100, 173, 233, 250,
+			173, 24, 200, 102, 221, 175, 35, 42, 232, 126, 211, 110, 225, 102,
+			242, 203, 6, 229, 161, 23, 27, 50, 134, 71, 120, 149, 186, 205,
+			11, 231, 159, 0, 30, 92, 150, 91, 71, 183, 159, 132, 216, 54,
+			4, 69, 148, 110, 208, 77, 246, 0, 217, 220, 157, 176, 67, 113,
+			23, 204, 72, 211, 78, 242, 22, 54, 154, 226, 75, 222, 134, 140,
+			145, 81, 12, 71, 0, 25, 253, 194, 249, 167, 176, 126, 55, 229,
+			38, 28, 125, 192, 39, 37, 93, 57, 183, 118, 179, 44, 175, 117,
+			90, 48, 227, 181, 116, 36, 243, 233, 169, 154, 137, 247, 144, 66,
+			136, 118, 65, 102, 9, 251, 45, 108, 63, 93, 194, 126, 27, 50,
+			198, 198, 249, 59, 52, 128, 130, 112, 254, 185, 229, 76, 20, 55,
+			64, 107, 27, 121, 53, 240, 112, 172, 147, 114, 142, 218, 85, 80,
+			223, 83, 138, 133, 61, 55, 128, 2, 62, 5, 192, 237, 133, 84,
+			215, 75, 50, 157, 23, 44, 108, 123, 36, 205, 176, 33, 99, 92,
+			16, 245, 180, 69, 254, 95, 88, 246, 191, 55, 212, 19, 176, 253,
+			95, 164, 36, 30, 201, 206, 175, 166, 244, 89, 17, 158, 95, 77,
+			233, 51, 202, 204, 156, 95, 77, 233, 51, 138, 204, 156, 95, 181,
+			140, 123, 51, 94, 183, 127, 205, 114, 78, 154, 2, 64, 61, 127,
+			45, 37, 125, 54, 146, 248, 95, 179, 6, 53, 125, 69, 133, 156,
+			243, 107, 22, 9, 4, 32, 195, 22, 206, 191, 180, 156, 23, 77,
+			1, 59, 143, 25, 167, 210, 12, 11, 50, 78, 159, 75, 51, 24,
+			100, 204, 205, 155, 38, 152, 112, 254, 181, 229, 156, 49, 5, 224,
+			242, 242, 175, 179, 163, 128, 173, 246, 175, 173, 193, 116, 156, 12,
+			171, 60, 39, 77, 19, 142, 112, 254, 141, 229, 156, 53, 77, 56,
+			125, 152, 145, 54, 1, 27, 235, 223, 88, 131, 207, 165, 25, 12,
+			50, 74, 207, 243, 31, 179, 168, 141, 62, 225, 252, 59, 203, 185,
+			80, 252, 126, 75, 222, 232, 181, 7, 210, 204, 28, 240, 96, 230,
+			0, 142, 201, 253, 5, 111, 106, 184, 201, 200, 73, 136, 203, 43,
+			144, 50, 5, 77, 48, 32, 64, 27, 184, 87, 64, 64, 32, 85,
+			55, 69, 164, 48, 154, 141, 65, 219, 29, 107, 181, 111, 172, 81,
+			5, 174, 153, 121, 28, 90, 10, 83, 216, 175, 255, 206, 58, 93,
+			81, 251, 21, 74, 48, 200, 88, 94, 161, 19, 201, 18, 206, 127,
+			208, 114, 7, 219, 182, 242, 152, 204, 235, 36, 126, 37, 33, 139,
+			90, 209, 255, 96, 141, 140, 242, 16, 171, 218, 194, 249, 13, 203,
+			158, 44, 186, 89, 238, 235, 25, 92, 95, 10, 147, 79, 166, 101,
+			35, 65, 191, 26, 14, 28, 183, 191, 145, 142, 14, 112, 242, 55,
+			172, 254, 17, 157, 100, 144, 28, 159, 224, 255, 138, 161, 63, 111,
+			254, 235, 54, 216, 18, 21, 127, 133, 201, 84, 155, 110, 98, 48,
+			152, 203, 49, 108, 87, 244, 72, 81, 92, 33, 146, 3, 120, 103,
+			9, 253, 235, 32, 226, 162, 190, 107, 173, 221, 4, 183, 103, 10,
+			30, 223, 236, 42, 151, 175, 123, 110, 243, 33, 182, 110, 244, 244,
+			166, 47, 58, 83, 213, 69, 162, 209, 73, 224, 217, 18, 21, 171,
+			27, 162, 77, 210, 101, 194, 123, 220, 110, 194, 115, 13, 80, 70,
+			141, 2, 156, 163, 177, 20, 196, 105, 55, 60, 127, 119, 22, 76,
+			156, 176, 11, 50, 104, 2, 135, 39, 28, 177, 122, 199, 163, 19,
+			121, 250, 41, 150, 186, 23, 251, 187, 129, 49, 94, 208, 193, 50,
+			193, 155, 49, 72, 224, 97, 30, 188, 250, 186, 160, 86, 147, 181,
+			166, 143, 143, 248, 36, 161, 244, 220, 216, 7, 63, 174, 90, 173,
+			211, 194, 16, 30, 92, 62, 2, 7, 172, 78, 102, 78, 10, 18,
+			224, 209, 8, 97, 180, 19, 50, 171, 138, 141, 71, 55, 52, 220,
+			114, 253, 32, 129, 235, 146, 11, 103, 225, 66, 203, 107, 133, 81,
+			23, 60, 252, 193, 188, 42, 108, 100, 192, 156, 114, 224, 64, 166,
+			85, 164, 122, 252, 80, 39, 169, 85, 221, 143, 107, 225, 35, 47,
+			130, 199, 129, 106, 81, 24, 199, 60, 117, 1, 171, 222, 185, 2,
+			136, 15, 28, 55, 108, 250, 175, 219, 133, 113, 222, 36, 135, 109,
+			231, 155, 182, 45, 139, 95, 86, 118, 27, 177, 222, 80, 48, 48,
+			10, 255, 163, 94, 44, 130, 77, 10, 82, 146, 20, 248, 208, 113,
+			133, 63, 93, 104, 136, 141, 106, 121, 33, 170, 239, 161, 187, 73,
+			157, 180, 132, 243, 77, 123, 234, 132, 78, 50, 72, 158, 126, 142,
+			255, 5, 155, 188, 194, 157, 111, 217, 246, 116, 241, 143, 219, 242,
+			154, 54, 173, 59, 160, 190, 212, 215, 90, 24, 75, 234, 34, 167,
+			61, 236, 241, 202, 160, 239, 49, 143, 124, 111, 159, 203, 176, 103,
+			179, 121, 117, 5, 225, 122, 7, 118, 20, 46, 121, 20, 64, 72,
+			83, 16, 99, 160, 91, 107, 186, 47, 13, 158, 183, 209, 58, 211,
+			132, 52, 85, 88, 1, 113, 121, 100, 13, 101, 10, 184, 172, 15,
+			61, 25, 183, 220, 102, 211, 139, 192, 211, 46, 242, 113, 221, 233,
+			240, 106, 135, 145, 9, 46, 141, 14, 121, 10, 158, 184, 154, 112,
+			25, 196, 216, 180, 193, 46, 160, 232, 70, 227, 240, 212, 175, 41,
+			75, 66, 220, 20, 210, 109, 198, 200, 217, 197, 120, 8, 42, 56,
+			194, 113, 243, 45, 155, 182, 190, 146, 234, 125, 203, 238, 31, 215,
+			73, 144, 58, 218, 147, 83, 252, 103, 24, 66, 217, 22, 206, 143,
+			217, 246, 100, 241, 79, 49, 211, 242, 209, 48, 214, 128, 136, 147,
+			176, 221, 246, 234, 224, 205, 25, 145, 217, 15, 197, 124, 129, 153,
+			118, 185, 190, 63, 195, 88, 9, 176, 200, 182, 196, 169, 227, 220,
+			139, 16, 250, 40, 13, 6, 155, 202, 63, 210, 143, 72, 209, 149,
+			76, 100, 14, 163, 90, 24, 234, 81, 245, 222, 175, 220, 116, 31,
+			131, 157, 233, 188, 220, 241, 128, 33, 218, 211, 53, 33, 162, 118,
+			2, 209, 125, 211, 85, 196, 232, 252, 29, 88, 89, 88, 237, 78,
+			68, 42, 81, 51, 157, 185, 20, 192, 6, 162, 208, 152, 212, 113,
+			48, 204, 173, 183, 230, 198, 30, 220, 13, 229, 173, 48, 161, 205,
+			167, 53, 234, 233, 46, 79, 246, 92, 40, 238, 25, 58, 15, 81,
+			67, 225, 98, 125, 73, 57, 73, 71, 94, 195, 139, 98, 110, 34,
+			221, 161, 63, 40, 120, 24, 251, 73, 135, 34, 16, 163, 22, 248,
+			243, 132, 53, 245, 80, 115, 88, 153, 59, 61, 72, 225, 245, 106,
+			3, 161, 255, 177, 116, 181, 97, 215, 252, 152, 221, 63, 170, 147,
+			12, 146, 98, 194, 56, 21, 254, 232, 24, 159, 209, 55, 227, 212,
+			23, 34, 227, 92, 120, 200, 229, 176, 180, 203, 39, 15, 221, 147,
+			171, 222, 251, 226, 5, 238, 128, 152, 109, 198, 58, 202, 146, 27,
+			238, 236, 85, 252, 46, 102, 249, 168, 233, 136, 76, 147, 193, 182,
+			117, 168, 58, 98, 178, 241, 90, 126, 253, 215, 71, 148, 15, 127,
+			249, 187, 192, 168, 181, 72, 230, 171, 133, 220, 32, 255, 151, 5,
+			101, 191, 122, 38, 87, 182, 138, 255, 164, 32, 143, 130, 166, 58,
+			99, 128, 96, 209, 211, 45, 205, 238, 130, 190, 92, 154, 114, 128,
+			2, 122, 241, 164, 159, 196, 94, 179, 81, 225, 114, 45, 132, 66,
+			56, 75, 106, 100, 237, 38, 148, 196, 75, 3, 161, 105, 70, 111,
+			128, 151, 92, 244, 182, 214, 114, 50, 36, 34, 30, 94, 20, 99,
+			126, 160, 48, 246, 88, 195, 176, 219, 233, 121, 12, 27, 57, 221,
+			144, 101, 185, 90, 71, 193, 63, 182, 166, 37, 108, 169, 140, 232,
+			240, 124, 77, 156, 25, 112, 135, 206, 58, 129, 31, 148, 227, 80,
+			77, 53, 144, 135, 94, 64, 11, 177, 118, 51, 141, 165, 98, 108,
+			38, 210, 214, 247, 211, 9, 166, 77, 103, 174, 12, 68, 89, 123,
+			43, 1, 84, 96, 167, 209, 245, 159, 100, 71, 229, 131, 77, 147,
+			87, 121, 203, 141, 30, 194, 73, 27, 115, 57, 75, 133, 253, 96,
+			119, 150, 88, 16, 159, 104, 9, 49, 33, 110, 32, 111, 191, 141,
+			225, 54, 43, 114, 149, 136, 6, 158, 170, 101, 112, 78, 142, 136,
+			177, 224, 120, 134, 144, 112, 61, 237, 18, 183, 134, 154, 6, 60,
+			15, 8, 71, 185, 2, 2, 170, 161, 178, 66, 109, 216, 13, 234,
+			248, 133, 9, 34, 181, 169, 222, 185, 130, 173, 170, 129, 16, 237,
+			189, 253, 182, 92, 144, 91, 217, 62, 96, 71, 0, 178, 152, 105,
+			87, 84, 201, 141, 0, 109, 135, 86, 163, 221, 22, 198, 46, 164,
+			122, 154, 116, 2, 156, 91, 110, 19, 34, 51, 121, 245, 138, 172,
+			106, 139, 19, 164, 163, 0, 203, 61, 175, 217, 166, 166, 238, 152,
+			152, 235, 107, 94, 224, 123, 117, 106, 202, 44, 163, 123, 96, 165,
+			81, 152, 224, 7, 181, 48, 138, 188, 90, 66, 79, 112, 61, 181,
+			135, 213, 102, 228, 185, 245, 238, 186, 98, 148, 84, 243, 71, 182,
+			74, 109, 42, 158, 19, 133, 191, 138, 213, 84, 247, 96, 213, 147,
+			171, 26, 75, 33, 162, 86, 205, 64, 140, 222, 77, 196, 133, 211,
+			113, 13, 106, 120, 207, 128, 216, 5, 46, 197, 58, 123, 234, 160,
+			213, 131, 134, 212, 181, 58, 170, 168, 33, 100, 51, 146, 200, 207,
+			162, 175, 247, 216, 173, 37, 202, 117, 255, 208, 118, 170, 122, 239,
+			115, 98, 199, 140, 121, 252, 153, 194, 73, 176, 163, 38, 35, 246,
+			179, 246, 169, 226, 71, 82, 203, 81, 123, 240, 75, 73, 87, 193,
+			150, 218, 85, 33, 224, 51, 114, 81, 35, 49, 45, 67, 152, 48,
+			189, 86, 252, 168, 91, 248, 19, 182, 33, 57, 168, 24, 243, 63,
+			176, 182, 56, 75, 254, 239, 40, 40, 99, 103, 39, 77, 96, 18,
+			38, 216, 217, 19, 39, 249, 95, 183, 181, 17, 253, 121, 251, 100,
+			241, 207, 217, 105, 147, 56, 22, 13, 110, 128, 146, 27, 212, 195,
+			86, 179, 171, 223, 50, 73, 197, 78, 45, 183, 182, 231, 7, 158,
+			145, 207, 33, 159, 101, 142, 27, 140, 211, 116, 88, 64, 37, 225,
+			228, 240, 107, 16, 101, 173, 70, 252, 24, 240, 151, 251, 161, 140,
+			220, 90, 15, 33, 142, 185, 122, 87, 136, 180, 11, 0, 16, 189,
+			42, 233, 146, 225, 98, 61, 153, 162, 17, 96, 113, 100, 135, 118,
+			123, 179, 41, 231, 218, 81, 184, 227, 238, 52, 187, 192, 229, 100,
+			56, 12, 138, 3, 213, 50, 111, 90, 226, 229, 10, 252, 6, 0,
+			193, 184, 220, 247, 3, 3, 110, 176, 74, 57, 223, 243, 242, 199,
+			249, 194, 177, 204, 203, 31, 231, 139, 39, 12, 103, 240, 245, 62,
+			62, 89, 131, 247, 112, 155, 219, 52, 173, 167, 114, 5, 91, 124,
+			236, 10, 150, 38, 66, 15, 28, 193, 92, 234, 61, 117, 180, 159,
+			180, 241, 166, 74, 93, 8, 237, 172, 11, 225, 245, 255, 200, 20,
+			11, 48, 254, 93, 198, 2, 220, 208, 177, 123, 198, 173, 226, 231,
+			228, 65, 192, 65, 179, 53, 175, 9, 81, 108, 180, 110, 133, 68,
+			25, 36, 0, 211, 94, 182, 36, 236, 210, 155, 124, 176, 48, 195,
+			143, 233, 61, 62, 108, 159, 44, 114, 243, 180, 71, 118, 195, 13,
+			219, 58, 50, 48, 84, 26, 30, 211, 24, 0, 214, 83, 195, 197,
+			19, 252, 158, 222, 111, 99, 246, 100, 241, 186, 172, 226, 82, 0,
+			136, 92, 185, 7, 242, 192, 5, 160, 133, 192, 29, 128, 16, 165,
+			233, 6, 38, 4, 207, 254, 94, 183, 71, 245, 7, 52, 91, 77,
+			165, 153, 217, 243, 128, 132, 99, 20, 7, 76, 33, 225, 24, 197,
+			163, 84, 72, 56, 150, 97, 79, 127, 246, 50, 63, 166, 158, 45,
+			221, 62, 136, 113, 136, 135, 217, 232, 20, 135, 145, 242, 183, 18,
+			248, 162, 244, 85, 62, 165, 195, 9, 208, 227, 127, 62, 78, 244,
+			83, 133, 79, 88, 230, 3, 58, 44, 77, 68, 110, 227, 19, 71,
+			132, 15, 175, 166, 165, 174, 59, 5, 54, 230, 148, 254, 152, 195,
+			197, 1, 119, 6, 216, 81, 103, 83, 47, 69, 118, 68, 136, 4,
+			242, 90, 60, 159, 9, 164, 161, 67, 139, 167, 27, 79, 57, 246,
+			235, 2, 226, 115, 124, 68, 15, 104, 27, 171, 83, 88, 5, 116,
+			123, 59, 18, 2, 85, 19, 52, 29, 239, 243, 226, 77, 62, 102,
+			90, 160, 102, 41, 110, 193, 209, 97, 186, 70, 117, 105, 250, 34,
+			94, 225, 195, 141, 48, 227, 138, 61, 211, 247, 196, 43, 196, 80,
+			35, 76, 125, 179, 197, 10, 239, 195, 59, 33, 121, 195, 159, 196,
+			10, 135, 160, 86, 185, 1, 101, 170, 170, 168, 248, 12, 239, 199,
+			39, 145, 234, 30, 249, 193, 159, 126, 66, 173, 13, 85, 170, 170,
+			139, 23, 95, 225, 125, 216, 146, 40, 241, 225, 30, 101, 61, 58,
+			245, 13, 87, 7, 91, 238, 99, 232, 18, 252, 168, 175, 59, 5,
+			107, 204, 190, 238, 20, 236, 49, 86, 188, 207, 251, 169, 49, 8,
+			58, 64, 32, 210, 30, 214, 128, 21, 133, 234, 48, 229, 42, 253,
+			117, 182, 182, 66, 136, 235, 78, 161, 111, 44, 127, 221, 41, 228,
+			199, 250, 175, 59, 133, 254, 177, 194, 117, 167, 80, 24, 27, 40,
+			253, 93, 235, 48, 162, 196, 109, 8, 240, 175, 85, 47, 228, 21,
+			109, 210, 98, 145, 155, 0, 247, 219, 126, 93, 135, 181, 239, 141,
+			226, 50, 168, 75, 108, 212, 99, 24, 180, 169, 128, 183, 104, 68,
+			145, 129, 20, 15, 80, 34, 33, 206, 25, 207, 104, 39, 245, 221,
+			78, 71, 69, 31, 193, 195, 92, 29, 217, 219, 123, 46, 121, 81,
+			23, 170, 92, 101, 93, 115, 155, 201, 245, 111, 95, 82, 222, 140,
+			127, 208, 250, 46, 160, 250, 83, 105, 240, 182, 162, 246, 102, 4,
+			199, 70, 117, 29, 228, 169, 55, 227, 80, 238, 100, 234, 205, 56,
+			84, 56, 197, 111, 106, 74, 62, 98, 79, 21, 63, 39, 137, 200,
+			104, 134, 13, 41, 153, 10, 90, 99, 62, 169, 51, 66, 203, 105,
+			161, 148, 94, 32, 67, 124, 193, 138, 118, 196, 16, 95, 232, 106,
+			100, 96, 236, 64, 48, 251, 223, 52, 143, 161, 77, 218, 227, 197,
+			127, 5, 198, 22, 13, 221, 237, 174, 159, 96, 146, 228, 60, 186,
+			103, 4, 139, 234, 152, 88, 99, 211, 51, 152, 24, 164, 118, 5,
+			233, 101, 72, 223, 108, 142, 8, 11, 172, 68, 165, 153, 246, 23,
+			246, 253, 58, 172, 25, 204, 182, 163, 180, 5, 202, 227, 11, 120,
+			254, 100, 239, 192, 39, 124, 38, 93, 63, 54, 165, 12, 23, 106,
+			157, 166, 27, 225, 192, 231, 224, 178, 202, 229, 108, 228, 53, 226,
+			197, 61, 207, 173, 199, 139, 45, 55, 78, 188, 104, 118, 222, 0,
+			9, 78, 168, 73, 3, 36, 56, 161, 38, 41, 156, 181, 58, 161,
+			38, 71, 199, 248, 34, 156, 185, 3, 130, 29, 179, 135, 139, 207,
+			107, 100, 147, 23, 80, 82, 159, 93, 27, 10, 151, 3, 210, 86,
+			199, 201, 65, 144, 184, 99, 20, 22, 46, 55, 144, 179, 122, 82,
+			182, 74, 125, 191, 2, 191, 45, 216, 9, 251, 249, 226, 7, 38,
+			174, 89, 100, 126, 197, 218, 160, 161, 227, 54, 83, 248, 65, 4,
+			30, 100, 204, 247, 61, 144, 91, 1, 247, 208, 237, 9, 218, 75,
+			129, 71, 189, 196, 139, 98, 163, 26, 113, 147, 180, 133, 22, 236,
+			28, 205, 50, 24, 104, 128, 45, 236, 9, 10, 192, 137, 234, 96,
+			118, 98, 74, 199, 209, 7, 219, 227, 19, 103, 74, 252, 47, 228,
+			85, 188, 192, 74, 206, 183, 138, 63, 149, 63, 232, 137, 151, 17,
+			104, 64, 248, 173, 36, 148, 110, 28, 123, 17, 93, 183, 72, 28,
+			168, 213, 152, 240, 148, 56, 140, 6, 99, 250, 205, 198, 36, 15,
+			165, 203, 42, 62, 242, 186, 4, 252, 58, 120, 83, 131, 48, 53,
+			6, 51, 173, 24, 159, 203, 4, 78, 92, 217, 166, 168, 151, 216,
+			30, 224, 231, 248, 1, 160, 10, 84, 151, 242, 129, 158, 171, 58,
+			234, 72, 60, 61, 95, 57, 178, 113, 34, 201, 189, 77, 234, 76,
+			93, 21, 106, 154, 186, 9, 74, 124, 51, 101, 64, 126, 171, 159,
+			244, 175, 129, 252, 46, 108, 244, 162, 164, 57, 243, 56, 151, 183,
+			3, 47, 141, 99, 6, 83, 212, 237, 200, 155, 119, 55, 183, 228,
+			78, 122, 61, 134, 136, 118, 179, 176, 192, 100, 97, 103, 238, 0,
+			16, 218, 57, 76, 246, 56, 20, 134, 33, 116, 43, 114, 53, 232,
+			30, 6, 83, 38, 204, 116, 38, 190, 44, 236, 163, 72, 137, 97,
+			33, 150, 137, 230, 227, 184, 12, 58, 173, 29, 47, 162, 71, 101,
+			97, 5, 97, 94, 176, 247, 218, 112, 141, 199, 16, 143, 176, 166,
+			117, 120, 141, 149, 60, 104, 253, 100, 15, 150, 19, 101, 73, 46,
+			134, 70, 145, 110, 194, 51, 93, 81, 227, 48, 99, 95, 61, 175,
+			186, 17, 24, 25, 112, 25, 84, 75, 112, 127, 145, 9, 32, 51,
+			116, 185, 175, 37, 87, 27, 107, 177, 14, 243, 171, 195, 25, 147,
+			182, 98, 145, 218, 84, 150, 106, 21, 45, 212, 201, 172, 7, 170,
+			17, 149, 144, 152, 174, 162, 176, 81, 178, 43, 164, 35, 62, 70,
+			158, 190, 244, 115, 77, 226, 181, 169, 152, 194, 79, 10, 4, 167,
+			133, 31, 53, 48, 55, 128, 201, 134, 109, 84, 71, 16, 211, 0,
+			21, 249, 65, 227, 180, 186, 15, 66, 140, 102, 151, 88, 119, 32,
+			48, 149, 66, 145, 255, 110, 75, 123, 141, 45, 219, 207, 23, 191,
+			70, 144, 52, 225, 141, 13, 110, 152, 133, 196, 35, 79, 3, 42,
+			251, 186, 63, 118, 218, 187, 150, 164, 207, 33, 47, 49, 5, 153,
+			174, 10, 107, 89, 54, 90, 178, 29, 239, 192, 13, 221, 194, 39,
+			27, 150, 41, 116, 168, 242, 240, 89, 166, 208, 161, 104, 56, 196,
+			150, 143, 235, 176, 135, 112, 124, 44, 159, 41, 241, 191, 103, 156,
+			216, 46, 218, 167, 139, 223, 54, 113, 15, 227, 52, 82, 51, 41,
+			55, 144, 6, 144, 248, 170, 199, 95, 45, 197, 124, 197, 22, 112,
+			237, 221, 6, 82, 22, 5, 3, 191, 158, 218, 159, 65, 140, 67,
+			133, 209, 244, 52, 224, 142, 135, 210, 78, 144, 18, 162, 126, 44,
+			140, 48, 94, 57, 28, 63, 158, 31, 101, 55, 2, 52, 130, 11,
+			101, 14, 114, 218, 126, 184, 187, 165, 187, 19, 62, 210, 148, 80,
+			57, 40, 93, 36, 159, 77, 180, 121, 98, 23, 199, 117, 8, 72,
+			56, 23, 46, 158, 60, 197, 191, 199, 210, 145, 53, 47, 217, 47,
+			21, 99, 19, 158, 25, 225, 175, 137, 32, 76, 22, 54, 133, 190,
+			56, 81, 159, 138, 38, 195, 222, 170, 123, 145, 255, 136, 180, 167,
+			105, 228, 246, 24, 201, 187, 62, 102, 213, 233, 230, 69, 11, 250,
+			16, 222, 113, 99, 63, 54, 131, 5, 63, 206, 75, 102, 225, 128,
+			136, 95, 26, 148, 58, 101, 9, 118, 233, 204, 162, 78, 49, 193,
+			46, 173, 92, 224, 191, 108, 145, 3, 0, 123, 211, 126, 169, 248,
+			183, 45, 211, 47, 45, 224, 147, 70, 111, 22, 235, 153, 227, 167,
+			200, 176, 90, 220, 139, 225, 96, 1, 238, 234, 205, 46, 88, 173,
+			94, 250, 92, 225, 114, 221, 173, 237, 145, 230, 17, 149, 156, 45,
+			183, 29, 203, 229, 75, 203, 25, 81, 216, 251, 29, 255, 145, 219,
+			4, 201, 27, 161, 70, 112, 168, 25, 154, 41, 115, 192, 219, 84,
+			195, 4, 60, 82, 222, 28, 212, 232, 11, 60, 218, 155, 207, 105,
+			152, 48, 128, 194, 202, 5, 254, 211, 76, 71, 46, 189, 110, 191,
+			80, 252, 73, 38, 175, 102, 238, 31, 136, 208, 65, 214, 184, 24,
+			50, 219, 174, 31, 201, 57, 83, 104, 123, 99, 173, 172, 236, 12,
+			231, 141, 160, 89, 105, 137, 52, 53, 7, 180, 11, 76, 140, 82,
+			228, 90, 37, 217, 44, 135, 145, 255, 65, 54, 174, 61, 237, 79,
+			200, 0, 139, 126, 56, 225, 205, 33, 167, 17, 153, 132, 248, 240,
+			73, 175, 204, 3, 19, 99, 190, 110, 68, 214, 160, 143, 3, 123,
+			237, 158, 227, 73, 247, 98, 142, 165, 236, 144, 53, 23, 167, 198,
+			155, 85, 154, 17, 203, 16, 171, 243, 188, 19, 123, 209, 226, 78,
+			152, 28, 0, 12, 57, 233, 187, 250, 60, 138, 23, 13, 226, 24,
+			72, 232, 193, 181, 220, 186, 71, 15, 70, 67, 0, 2, 210, 249,
+			5, 33, 178, 10, 36, 216, 202, 26, 103, 123, 45, 205, 189, 161,
+			43, 9, 187, 78, 50, 69, 244, 36, 97, 215, 39, 207, 232, 20,
+			19, 236, 250, 217, 115, 169, 231, 240, 13, 251, 29, 237, 220, 8,
+			212, 236, 6, 31, 229, 255, 63, 11, 138, 178, 220, 128, 96, 183,
+			29, 81, 252, 166, 69, 214, 114, 160, 108, 115, 19, 31, 228, 30,
+			136, 123, 61, 26, 72, 188, 24, 150, 181, 44, 114, 223, 147, 128,
+			196, 112, 188, 183, 181, 10, 128, 75, 44, 163, 31, 208, 132, 192,
+			195, 251, 222, 35, 47, 42, 171, 11, 11, 10, 220, 247, 61, 121,
+			251, 214, 141, 119, 224, 65, 43, 217, 115, 195, 68, 101, 46, 24,
+			65, 35, 7, 121, 123, 88, 187, 18, 41, 30, 178, 39, 109, 171,
+			180, 46, 14, 30, 180, 99, 228, 149, 202, 114, 3, 214, 193, 52,
+			121, 212, 126, 139, 165, 254, 210, 95, 116, 78, 21, 255, 147, 45,
+			111, 166, 183, 89, 208, 255, 62, 233, 141, 154, 89, 40, 52, 43,
+			231, 252, 64, 61, 87, 51, 159, 189, 35, 113, 210, 121, 0, 86,
+			250, 13, 77, 107, 43, 189, 87, 96, 210, 100, 211, 169, 4, 145,
+			113, 17, 80, 126, 44, 247, 192, 151, 21, 176, 13, 41, 55, 188,
+			52, 17, 133, 237, 200, 7, 238, 112, 150, 20, 249, 179, 244, 54,
+			88, 22, 173, 21, 22, 131, 40, 20, 159, 172, 76, 45, 246, 118,
+			92, 120, 160, 155, 222, 26, 87, 33, 92, 213, 251, 203, 59, 158,
+			217, 62, 250, 28, 48, 33, 54, 14, 15, 107, 9, 158, 51, 215,
+			175, 39, 160, 84, 1, 0, 177, 124, 241, 230, 101, 179, 187, 32,
+			152, 241, 129, 9, 193, 33, 86, 131, 48, 118, 242, 194, 210, 205,
+			203, 61, 65, 137, 35, 175, 222, 169, 1, 33, 8, 241, 155, 118,
+			207, 133, 229, 232, 131, 245, 200, 164, 45, 193, 190, 56, 56, 211,
+			227, 175, 254, 197, 19, 39, 211, 16, 197, 247, 109, 145, 9, 81,
+			124, 159, 132, 189, 232, 22, 197, 238, 211, 51, 207, 202, 221, 237,
+			62, 153, 214, 162, 139, 234, 151, 236, 221, 172, 147, 239, 151, 248,
+			56, 255, 113, 181, 15, 172, 1, 193, 182, 157, 51, 197, 31, 248,
+			68, 251, 128, 228, 28, 207, 216, 9, 84, 234, 19, 239, 133, 94,
+			124, 49, 155, 1, 99, 110, 111, 27, 228, 183, 112, 51, 244, 164,
+			109, 193, 182, 205, 102, 176, 6, 224, 179, 65, 126, 107, 192, 58,
+			152, 182, 85, 90, 23, 135, 228, 132, 241, 88, 28, 176, 173, 3,
+			105, 250, 174, 139, 51, 193, 182, 167, 141, 83, 223, 0, 179, 14,
+			164, 109, 149, 214, 197, 29, 193, 182, 143, 27, 23, 195, 1, 199,
+			58, 144, 182, 85, 90, 23, 239, 19, 108, 251, 164, 113, 142, 30,
+			232, 179, 14, 164, 109, 149, 214, 197, 243, 130, 109, 163, 233, 158,
+			250, 156, 183, 14, 164, 109, 149, 254, 195, 54, 185, 28, 230, 4,
+			107, 56, 39, 139, 63, 100, 247, 50, 171, 10, 69, 105, 111, 244,
+			8, 119, 105, 239, 82, 24, 92, 124, 33, 30, 111, 156, 244, 93,
+			63, 90, 142, 242, 2, 32, 1, 113, 15, 83, 125, 52, 79, 109,
+			118, 91, 70, 169, 136, 104, 68, 154, 83, 188, 243, 161, 73, 5,
+			238, 168, 74, 15, 141, 212, 6, 82, 164, 67, 137, 188, 22, 105,
+			176, 8, 123, 104, 71, 147, 248, 65, 63, 177, 227, 71, 218, 52,
+			3, 131, 89, 146, 189, 82, 198, 222, 197, 108, 70, 180, 156, 103,
+			13, 167, 144, 166, 45, 193, 26, 3, 102, 133, 193, 98, 137, 53,
+			138, 39, 104, 51, 230, 5, 219, 163, 128, 27, 232, 18, 193, 246,
+			108, 29, 92, 29, 86, 99, 143, 143, 235, 20, 19, 108, 15, 223,
+			176, 3, 127, 136, 190, 135, 185, 63, 72, 113, 46, 0, 225, 30,
+			22, 138, 252, 127, 237, 35, 11, 120, 214, 181, 39, 139, 255, 184,
+			47, 53, 220, 214, 86, 131, 168, 110, 3, 123, 172, 192, 219, 207,
+			216, 99, 161, 206, 237, 43, 97, 7, 172, 152, 0, 198, 29, 84,
+			221, 99, 180, 123, 253, 122, 76, 198, 203, 67, 115, 18, 229, 12,
+			227, 80, 62, 116, 101, 206, 230, 80, 177, 7, 184, 180, 28, 248,
+			67, 20, 62, 224, 51, 119, 160, 196, 133, 227, 62, 242, 240, 41,
+			158, 122, 167, 169, 8, 28, 216, 157, 215, 117, 204, 159, 174, 90,
+			140, 89, 239, 145, 23, 36, 29, 48, 126, 153, 165, 83, 131, 52,
+			12, 143, 124, 55, 107, 105, 48, 215, 107, 106, 48, 79, 150, 144,
+			196, 143, 116, 161, 183, 244, 54, 99, 88, 126, 188, 50, 70, 94,
+			67, 249, 43, 153, 107, 131, 190, 108, 18, 214, 101, 184, 37, 180,
+			202, 137, 208, 220, 154, 88, 115, 4, 16, 222, 150, 72, 26, 160,
+			111, 137, 144, 99, 216, 23, 136, 77, 159, 66, 81, 73, 3, 52,
+			111, 79, 164, 30, 238, 42, 88, 82, 62, 80, 248, 248, 32, 229,
+			100, 33, 220, 71, 163, 233, 238, 194, 113, 171, 7, 117, 0, 250,
+			114, 207, 173, 107, 245, 240, 28, 89, 134, 25, 110, 183, 44, 119,
+			220, 58, 189, 46, 83, 150, 94, 82, 171, 204, 211, 102, 160, 26,
+			102, 16, 36, 32, 243, 131, 76, 7, 88, 230, 1, 30, 90, 169,
+			255, 163, 105, 59, 134, 118, 99, 208, 246, 102, 177, 168, 156, 94,
+			153, 240, 70, 6, 68, 32, 161, 224, 89, 32, 80, 245, 234, 135,
+			103, 160, 7, 129, 192, 50, 31, 253, 122, 252, 128, 88, 56, 21,
+			153, 160, 75, 209, 71, 208, 194, 154, 117, 251, 71, 117, 138, 9,
+			214, 21, 19, 252, 159, 90, 100, 132, 203, 62, 182, 231, 138, 127,
+			207, 50, 35, 5, 153, 182, 233, 228, 192, 155, 106, 198, 8, 177,
+			162, 165, 8, 65, 55, 51, 69, 186, 90, 113, 185, 147, 230, 110,
+			167, 151, 138, 204, 251, 19, 68, 158, 42, 114, 35, 137, 245, 245,
+			5, 222, 78, 237, 184, 145, 27, 36, 244, 76, 4, 190, 35, 164,
+			48, 144, 138, 100, 158, 65, 83, 237, 150, 201, 156, 14, 12, 248,
+			224, 122, 19, 132, 180, 186, 6, 22, 150, 35, 216, 199, 116, 103,
+			65, 43, 99, 246, 241, 160, 14, 17, 15, 7, 216, 199, 199, 158,
+			215, 41, 38, 216, 199, 47, 204, 242, 223, 103, 105, 27, 227, 175,
+			91, 246, 185, 226, 215, 51, 160, 193, 182, 129, 133, 209, 111, 232,
+			131, 140, 232, 240, 16, 116, 121, 26, 75, 153, 140, 27, 249, 19,
+			167, 129, 113, 103, 22, 96, 247, 116, 37, 216, 213, 160, 60, 47,
+			210, 245, 201, 56, 205, 70, 11, 242, 175, 91, 118, 214, 40, 249,
+			235, 214, 224, 184, 78, 130, 45, 170, 37, 164, 78, 50, 72, 62,
+			127, 150, 255, 87, 121, 156, 15, 58, 249, 218, 197, 226, 143, 228,
+			137, 146, 203, 189, 16, 98, 218, 244, 154, 160, 194, 141, 216, 152,
+			46, 132, 217, 163, 164, 156, 181, 106, 112, 131, 174, 54, 180, 68,
+			105, 14, 73, 36, 232, 112, 194, 45, 14, 4, 76, 177, 141, 104,
+			124, 72, 251, 1, 76, 148, 148, 80, 13, 72, 45, 244, 72, 7,
+			29, 144, 90, 183, 78, 22, 194, 233, 152, 84, 235, 115, 154, 98,
+			191, 254, 58, 216, 16, 206, 147, 109, 97, 187, 237, 185, 17, 153,
+			179, 63, 88, 187, 181, 174, 190, 194, 62, 32, 225, 15, 180, 175,
+			237, 16, 193, 168, 185, 215, 180, 154, 78, 92, 18, 237, 168, 219,
+			55, 14, 157, 136, 71, 218, 105, 195, 109, 130, 80, 77, 191, 247,
+			239, 7, 32, 74, 38, 211, 35, 124, 7, 65, 89, 73, 194, 65,
+			210, 37, 73, 43, 181, 58, 167, 37, 121, 48, 148, 121, 9, 102,
+			63, 126, 24, 164, 150, 21, 25, 170, 169, 142, 78, 162, 148, 96,
+			217, 2, 13, 73, 162, 151, 233, 161, 123, 96, 162, 112, 12, 41,
+			201, 217, 129, 135, 204, 90, 238, 67, 60, 42, 96, 185, 122, 46,
+			169, 174, 98, 22, 154, 94, 12, 134, 147, 248, 30, 224, 2, 173,
+			49, 26, 232, 132, 218, 178, 73, 179, 34, 26, 3, 188, 186, 156,
+			115, 227, 184, 211, 210, 118, 45, 71, 136, 200, 212, 112, 227, 121,
+			186, 100, 194, 4, 8, 109, 136, 91, 32, 123, 60, 98, 88, 128,
+			90, 18, 55, 91, 57, 192, 56, 65, 184, 188, 168, 227, 17, 5,
+			134, 134, 122, 24, 144, 30, 226, 52, 103, 168, 83, 198, 249, 55,
+			133, 53, 17, 31, 154, 134, 26, 85, 207, 179, 119, 198, 71, 17,
+			194, 108, 192, 54, 209, 150, 253, 192, 219, 255, 136, 53, 58, 165,
+			147, 184, 137, 102, 142, 243, 63, 11, 114, 13, 220, 143, 127, 0,
+			220, 80, 255, 8, 147, 115, 126, 67, 62, 232, 81, 195, 62, 192,
+			57, 104, 165, 67, 125, 94, 110, 26, 29, 157, 145, 39, 208, 201,
+			216, 51, 186, 131, 126, 95, 32, 129, 115, 131, 122, 175, 96, 65,
+			95, 16, 64, 7, 8, 190, 70, 218, 66, 206, 56, 8, 103, 5,
+			167, 114, 159, 36, 36, 59, 94, 45, 108, 121, 224, 198, 70, 241,
+			1, 231, 211, 75, 22, 241, 66, 25, 241, 132, 233, 12, 180, 8,
+			32, 140, 32, 127, 79, 141, 112, 145, 247, 40, 124, 72, 124, 10,
+			89, 99, 201, 102, 24, 236, 130, 7, 65, 24, 61, 196, 13, 214,
+			232, 104, 238, 3, 183, 126, 172, 207, 229, 35, 32, 149, 97, 3,
+			178, 203, 149, 26, 1, 194, 171, 173, 106, 35, 106, 247, 90, 162,
+			29, 189, 144, 1, 11, 61, 192, 57, 63, 232, 152, 108, 180, 255,
+			86, 107, 8, 94, 55, 127, 32, 117, 229, 0, 181, 203, 31, 176,
+			250, 133, 78, 50, 72, 78, 77, 27, 19, 138, 95, 62, 197, 79,
+			31, 124, 32, 164, 78, 154, 50, 178, 164, 24, 61, 16, 180, 183,
+			116, 137, 23, 214, 168, 8, 152, 59, 196, 94, 45, 12, 234, 49,
+			42, 146, 89, 85, 39, 33, 100, 114, 224, 6, 33, 40, 144, 173,
+			185, 190, 170, 74, 92, 254, 94, 139, 79, 212, 194, 214, 193, 64,
+			192, 151, 135, 117, 139, 119, 160, 151, 59, 214, 253, 101, 42, 177,
+			27, 54, 221, 96, 183, 18, 70, 187, 153, 23, 76, 192, 172, 99,
+			17, 101, 184, 102, 176, 237, 157, 223, 180, 172, 63, 108, 179, 183,
+			238, 92, 254, 105, 251, 244, 91, 170, 242, 29, 170, 81, 185, 231,
+			53, 155, 111, 67, 249, 45, 168, 122, 253, 87, 78, 128, 225, 208,
+			72, 110, 199, 226, 191, 224, 160, 10, 121, 36, 39, 86, 190, 237,
+			244, 168, 144, 87, 150, 22, 86, 150, 86, 94, 146, 151, 59, 13,
+			185, 229, 213, 246, 130, 176, 25, 238, 162, 14, 96, 35, 168, 193,
+			66, 147, 14, 248, 19, 106, 135, 149, 210, 169, 68, 159, 74, 243,
+			175, 113, 176, 231, 68, 131, 75, 208, 149, 40, 133, 39, 224, 0,
+			188, 138, 10, 178, 0, 21, 141, 18, 238, 211, 77, 31, 204, 100,
+			82, 30, 133, 218, 168, 112, 249, 14, 181, 16, 238, 192, 209, 5,
+			246, 192, 97, 187, 171, 153, 51, 42, 6, 154, 21, 69, 20, 228,
+			94, 146, 180, 47, 45, 46, 238, 239, 239, 87, 92, 212, 99, 35,
+			92, 155, 170, 92, 188, 72, 90, 234, 133, 149, 202, 18, 231, 242,
+			110, 0, 52, 84, 75, 239, 144, 164, 187, 160, 80, 169, 33, 235,
+			221, 116, 247, 225, 220, 119, 119, 35, 98, 103, 192, 141, 63, 194,
+			192, 244, 101, 25, 135, 141, 100, 223, 141, 60, 158, 26, 204, 245,
+			40, 209, 245, 200, 252, 184, 167, 0, 200, 172, 3, 89, 90, 221,
+			148, 27, 155, 37, 121, 121, 117, 115, 99, 179, 204, 229, 189, 141,
+			173, 107, 183, 239, 110, 201, 123, 171, 213, 234, 234, 173, 173, 141,
+			245, 77, 121, 187, 42, 175, 220, 190, 181, 182, 177, 181, 113, 251,
+			214, 166, 188, 125, 85, 174, 222, 122, 71, 190, 189, 113, 107, 173,
+			108, 88, 151, 199, 240, 2, 82, 44, 195, 136, 194, 99, 192, 179,
+			246, 155, 158, 215, 211, 189, 150, 103, 18, 17, 171, 73, 64, 181,
+			14, 184, 244, 40, 197, 63, 144, 253, 182, 49, 69, 5, 105, 110,
+			157, 43, 234, 75, 198, 247, 135, 102, 84, 225, 164, 186, 31, 203,
+			205, 192, 175, 130, 96, 34, 247, 28, 104, 246, 11, 207, 169, 159,
+			42, 115, 34, 119, 13, 51, 7, 213, 79, 149, 57, 153, 43, 99,
+			38, 104, 148, 115, 101, 202, 156, 202, 85, 48, 147, 126, 170, 204,
+			233, 92, 9, 51, 185, 250, 169, 50, 143, 229, 206, 96, 230, 89,
+			245, 83, 101, 206, 228, 94, 195, 204, 115, 234, 231, 239, 31, 83,
+			230, 4, 119, 115, 59, 86, 241, 247, 142, 201, 85, 169, 247, 93,
+			234, 16, 3, 26, 37, 240, 115, 2, 169, 92, 195, 127, 236, 213,
+			23, 136, 175, 139, 219, 110, 160, 227, 114, 100, 253, 103, 184, 164,
+			215, 167, 72, 230, 71, 20, 0, 192, 37, 27, 145, 58, 115, 227,
+			158, 15, 137, 68, 106, 128, 73, 16, 2, 198, 97, 147, 12, 101,
+			55, 18, 224, 63, 253, 64, 31, 233, 137, 102, 195, 107, 32, 195,
+			175, 187, 17, 121, 237, 6, 176, 63, 200, 182, 188, 84, 119, 187,
+			37, 14, 75, 93, 106, 133, 65, 178, 87, 210, 205, 68, 30, 240,
+			160, 136, 157, 38, 208, 56, 236, 41, 67, 85, 181, 157, 100, 205,
+			147, 59, 94, 178, 239, 161, 40, 113, 63, 91, 154, 194, 46, 192,
+			153, 144, 130, 10, 134, 224, 27, 67, 15, 197, 214, 129, 65, 104,
+			103, 39, 129, 233, 2, 68, 64, 181, 33, 221, 180, 161, 138, 172,
+			186, 193, 46, 98, 60, 202, 41, 31, 251, 45, 23, 157, 241, 206,
+			47, 44, 47, 149, 151, 150, 150, 100, 215, 115, 145, 23, 150, 207,
+			203, 245, 199, 46, 120, 110, 196, 156, 235, 159, 114, 249, 146, 188,
+			18, 182, 218, 157, 196, 75, 135, 129, 125, 244, 12, 23, 32, 39,
+			219, 177, 215, 169, 131, 247, 71, 221, 171, 208, 198, 55, 5, 128,
+			47, 137, 18, 249, 186, 172, 84, 42, 175, 29, 252, 6, 47, 97,
+			101, 191, 152, 142, 52, 141, 213, 95, 137, 197, 160, 220, 138, 94,
+			214, 215, 165, 23, 212, 77, 106, 65, 245, 165, 211, 212, 166, 110,
+			170, 130, 8, 64, 85, 212, 111, 93, 1, 83, 186, 19, 191, 33,
+			231, 76, 29, 221, 244, 103, 229, 146, 124, 225, 133, 116, 0, 88,
+			67, 190, 33, 151, 230, 245, 43, 91, 71, 140, 238, 252, 235, 114,
+			249, 181, 67, 95, 169, 235, 215, 229, 242, 146, 254, 67, 133, 62,
+			146, 94, 51, 246, 142, 30, 192, 27, 71, 14, 224, 179, 79, 31,
+			192, 194, 83, 6, 112, 254, 168, 1, 100, 150, 127, 37, 93, 254,
+			116, 189, 112, 253, 211, 228, 249, 116, 193, 62, 61, 22, 60, 113,
+			173, 159, 140, 35, 196, 191, 103, 150, 252, 245, 222, 37, 151, 231,
+			211, 105, 82, 22, 181, 151, 46, 186, 174, 66, 96, 72, 43, 28,
+			194, 130, 180, 78, 47, 156, 123, 112, 46, 11, 226, 180, 194, 249,
+			167, 47, 111, 90, 240, 141, 108, 193, 39, 244, 113, 254, 232, 62,
+			22, 158, 177, 130, 23, 158, 180, 129, 235, 110, 226, 193, 21, 178,
+			2, 255, 212, 189, 38, 56, 104, 6, 242, 78, 55, 217, 67, 191,
+			45, 104, 71, 38, 176, 49, 15, 23, 156, 171, 187, 221, 248, 245,
+			11, 101, 217, 242, 3, 136, 34, 254, 250, 242, 210, 124, 239, 54,
+			147, 175, 155, 222, 230, 14, 124, 170, 92, 141, 194, 214, 150, 105,
+			42, 169, 207, 115, 46, 159, 87, 111, 144, 223, 116, 219, 109, 8,
+			12, 139, 86, 23, 152, 3, 50, 59, 87, 169, 91, 210, 241, 83,
+			152, 28, 116, 154, 3, 26, 136, 199, 0, 28, 231, 193, 174, 140,
+			92, 228, 140, 81, 28, 11, 161, 212, 181, 178, 69, 59, 163, 122,
+			36, 9, 0, 16, 146, 174, 30, 76, 233, 27, 13, 255, 177, 44,
+			197, 37, 208, 24, 213, 253, 154, 11, 252, 132, 62, 55, 230, 129,
+			246, 115, 232, 176, 29, 121, 53, 175, 158, 94, 51, 201, 2, 37,
+			61, 98, 202, 138, 89, 74, 79, 153, 88, 115, 4, 228, 135, 163,
+			207, 37, 183, 169, 171, 84, 64, 121, 43, 61, 181, 225, 202, 242,
+			130, 206, 87, 45, 45, 245, 180, 69, 183, 19, 144, 69, 234, 185,
+			235, 231, 219, 21, 160, 224, 68, 44, 93, 136, 75, 48, 95, 224,
+			231, 210, 214, 224, 236, 88, 206, 52, 70, 55, 29, 101, 147, 99,
+			134, 120, 84, 107, 21, 141, 93, 203, 208, 46, 180, 115, 160, 85,
+			46, 91, 126, 45, 234, 109, 247, 147, 54, 187, 28, 151, 208, 222,
+			4, 236, 214, 224, 125, 245, 194, 24, 255, 39, 198, 33, 228, 29,
+			123, 178, 248, 11, 150, 220, 68, 166, 192, 244, 73, 92, 102, 150,
+			43, 168, 200, 155, 164, 188, 71, 218, 180, 112, 97, 249, 229, 242,
+			203, 175, 92, 132, 243, 13, 254, 162, 47, 200, 249, 3, 153, 74,
+			181, 22, 251, 143, 60, 16, 19, 37, 222, 37, 104, 53, 246, 228,
+			78, 216, 9, 40, 38, 49, 240, 192, 29, 29, 154, 235, 18, 151,
+			23, 151, 96, 16, 139, 45, 63, 144, 47, 66, 162, 229, 7, 139,
+			123, 145, 124, 81, 174, 188, 36, 247, 162, 197, 186, 219, 149, 47,
+			202, 11, 23, 95, 174, 172, 188, 44, 97, 139, 44, 194, 217, 42,
+			95, 84, 27, 84, 29, 180, 218, 124, 13, 100, 137, 239, 24, 159,
+			7, 144, 37, 190, 83, 80, 178, 68, 165, 61, 123, 71, 76, 240,
+			239, 101, 218, 226, 209, 181, 69, 241, 63, 218, 26, 16, 61, 188,
+			141, 75, 112, 233, 101, 110, 50, 188, 141, 230, 202, 1, 94, 60,
+			5, 152, 222, 76, 32, 39, 140, 99, 184, 240, 5, 50, 12, 60,
+			211, 90, 212, 195, 106, 145, 107, 151, 92, 226, 242, 1, 173, 131,
+			150, 172, 0, 10, 184, 178, 29, 198, 126, 226, 63, 242, 128, 27,
+			10, 188, 93, 23, 127, 63, 192, 1, 81, 65, 133, 231, 154, 10,
+			196, 56, 148, 76, 135, 100, 222, 6, 58, 76, 240, 143, 253, 192,
+			139, 66, 210, 161, 106, 110, 185, 167, 53, 237, 156, 199, 205, 244,
+			220, 22, 136, 41, 118, 3, 73, 15, 2, 28, 24, 231, 65, 20,
+			121, 245, 213, 87, 203, 244, 87, 161, 71, 38, 35, 131, 26, 122,
+			189, 192, 248, 210, 53, 235, 5, 18, 78, 151, 212, 150, 202, 248,
+			210, 29, 27, 55, 119, 219, 95, 159, 228, 83, 230, 222, 188, 13,
+			182, 101, 116, 225, 68, 231, 128, 103, 92, 123, 159, 253, 110, 230,
+			97, 47, 151, 187, 124, 188, 55, 236, 4, 24, 229, 127, 142, 143,
+			96, 215, 219, 186, 125, 242, 118, 57, 126, 232, 49, 28, 141, 10,
+			213, 97, 172, 160, 147, 240, 166, 246, 193, 118, 227, 182, 120, 46,
+			251, 36, 81, 239, 211, 47, 152, 111, 60, 110, 143, 124, 59, 41,
+			227, 113, 123, 212, 123, 61, 236, 211, 191, 215, 243, 117, 139, 79,
+			87, 189, 192, 219, 55, 157, 96, 4, 143, 79, 227, 251, 123, 24,
+			84, 246, 167, 4, 213, 246, 209, 99, 136, 219, 71, 190, 74, 100,
+			125, 250, 89, 94, 230, 51, 85, 143, 50, 191, 195, 105, 94, 255,
+			197, 81, 229, 182, 244, 202, 119, 129, 1, 251, 92, 106, 192, 62,
+			175, 13, 216, 7, 140, 51, 211, 96, 110, 144, 95, 215, 207, 241,
+			76, 89, 197, 55, 14, 132, 101, 1, 203, 95, 63, 238, 17, 135,
+			105, 42, 211, 91, 80, 70, 237, 26, 217, 67, 2, 141, 30, 41,
+			28, 231, 63, 108, 235, 227, 105, 210, 174, 192, 99, 227, 55, 178,
+			152, 0, 147, 223, 11, 247, 81, 96, 151, 145, 246, 129, 239, 33,
+			30, 139, 168, 41, 76, 66, 84, 18, 144, 10, 6, 253, 49, 3,
+			178, 142, 164, 88, 50, 233, 0, 118, 188, 6, 168, 151, 125, 245,
+			204, 55, 42, 255, 80, 218, 30, 120, 251, 154, 44, 31, 129, 121,
+			36, 15, 212, 242, 189, 78, 16, 123, 73, 214, 60, 36, 108, 200,
+			151, 53, 247, 118, 216, 58, 196, 79, 140, 81, 200, 50, 151, 123,
+			97, 39, 122, 146, 93, 200, 50, 126, 37, 173, 144, 242, 227, 154,
+			180, 139, 58, 5, 2, 133, 19, 243, 58, 197, 4, 155, 44, 47,
+			232, 55, 220, 143, 229, 206, 164, 207, 38, 28, 43, 28, 39, 245,
+			116, 78, 176, 25, 123, 138, 84, 208, 208, 220, 12, 41, 153, 148,
+			93, 231, 204, 160, 182, 115, 132, 67, 114, 102, 98, 146, 215, 181,
+			89, 231, 9, 251, 84, 241, 94, 143, 247, 40, 80, 25, 58, 184,
+			130, 140, 75, 35, 137, 91, 147, 240, 208, 243, 222, 71, 57, 129,
+			43, 4, 32, 107, 74, 180, 57, 215, 54, 92, 48, 240, 19, 228,
+			23, 106, 225, 33, 112, 226, 196, 73, 254, 207, 141, 165, 165, 180,
+			151, 139, 255, 224, 200, 120, 66, 71, 7, 3, 202, 96, 66, 214,
+			137, 55, 141, 9, 164, 141, 16, 84, 65, 63, 6, 37, 175, 70,
+			4, 165, 247, 8, 158, 228, 9, 94, 11, 131, 216, 87, 154, 207,
+			102, 24, 235, 151, 105, 122, 188, 226, 168, 36, 218, 184, 185, 143,
+			92, 191, 137, 226, 54, 216, 22, 46, 42, 149, 76, 163, 6, 28,
+			96, 189, 41, 237, 19, 58, 101, 9, 38, 79, 150, 117, 138, 9,
+			38, 23, 151, 248, 150, 122, 108, 252, 44, 4, 21, 184, 118, 20,
+			166, 62, 101, 43, 30, 81, 58, 179, 31, 97, 205, 207, 22, 78,
+			131, 97, 28, 153, 33, 204, 218, 167, 138, 223, 103, 25, 20, 32,
+			137, 28, 78, 18, 178, 82, 13, 21, 240, 136, 7, 246, 249, 28,
+			197, 15, 49, 57, 92, 135, 245, 234, 117, 181, 151, 93, 47, 153,
+			215, 154, 199, 76, 118, 218, 18, 116, 79, 32, 194, 184, 92, 108,
+			182, 231, 37, 237, 89, 194, 24, 165, 50, 158, 61, 113, 146, 255,
+			164, 81, 25, 159, 183, 43, 197, 223, 67, 24, 147, 37, 40, 48,
+			48, 88, 2, 125, 60, 105, 158, 7, 17, 161, 172, 110, 178, 112,
+			95, 65, 206, 38, 8, 247, 81, 14, 181, 231, 82, 152, 4, 244,
+			240, 165, 141, 175, 56, 118, 20, 37, 198, 192, 40, 245, 66, 1,
+			188, 210, 122, 15, 66, 51, 17, 208, 240, 158, 167, 157, 173, 52,
+			188, 231, 105, 103, 43, 13, 239, 121, 218, 217, 12, 188, 45, 150,
+			212, 206, 6, 242, 92, 41, 156, 198, 157, 13, 33, 172, 217, 162,
+			189, 140, 85, 48, 198, 14, 91, 36, 212, 193, 16, 59, 108, 145,
+			80, 71, 133, 203, 95, 92, 92, 226, 95, 228, 182, 227, 8, 231,
+			66, 238, 21, 171, 120, 67, 62, 233, 244, 123, 50, 242, 28, 81,
+			62, 131, 62, 96, 216, 116, 161, 32, 21, 250, 96, 196, 253, 139,
+			255, 185, 161, 143, 10, 251, 127, 145, 208, 71, 133, 252, 191, 72,
+			232, 227, 32, 152, 46, 158, 56, 105, 184, 206, 127, 208, 199, 39,
+			27, 232, 11, 112, 132, 103, 244, 119, 192, 84, 254, 168, 197, 199,
+			122, 130, 100, 124, 26, 142, 234, 192, 123, 173, 246, 193, 247, 90,
+			15, 60, 27, 251, 108, 182, 47, 83, 250, 250, 63, 39, 247, 235,
+			137, 239, 174, 103, 5, 53, 243, 2, 222, 119, 87, 20, 243, 50,
+			156, 155, 176, 138, 175, 244, 198, 42, 1, 130, 105, 12, 95, 27,
+			126, 128, 102, 31, 49, 29, 234, 169, 107, 54, 161, 57, 224, 204,
+			112, 214, 1, 123, 212, 62, 245, 4, 7, 236, 81, 66, 52, 101,
+			206, 57, 74, 136, 166, 174, 163, 163, 100, 204, 137, 183, 209, 113,
+			251, 56, 125, 130, 91, 209, 120, 143, 75, 218, 248, 128, 118, 201,
+			2, 170, 48, 126, 108, 38, 125, 87, 74, 216, 231, 233, 19, 28,
+			28, 130, 118, 191, 242, 221, 18, 39, 95, 208, 41, 38, 152, 152,
+			127, 209, 160, 245, 111, 158, 231, 227, 10, 55, 49, 112, 211, 19,
+			188, 172, 75, 63, 144, 231, 28, 141, 175, 32, 36, 81, 87, 172,
+			164, 143, 252, 54, 125, 115, 87, 57, 228, 111, 60, 72, 133, 32,
+			33, 62, 199, 181, 187, 235, 118, 4, 50, 115, 242, 64, 61, 97,
+			252, 69, 177, 105, 163, 79, 135, 34, 85, 221, 11, 10, 217, 197,
+			2, 207, 199, 158, 27, 213, 246, 200, 89, 121, 234, 64, 213, 77,
+			252, 88, 165, 66, 197, 235, 124, 40, 219, 216, 19, 30, 123, 29,
+			227, 172, 25, 238, 163, 54, 115, 184, 10, 63, 225, 93, 225, 61,
+			127, 119, 143, 252, 123, 241, 119, 241, 7, 28, 158, 87, 205, 139,
+			151, 120, 190, 30, 182, 92, 63, 160, 183, 94, 79, 30, 57, 138,
+			202, 26, 150, 169, 82, 89, 49, 139, 15, 196, 70, 9, 93, 193,
+			198, 161, 210, 157, 8, 226, 132, 36, 221, 47, 192, 61, 28, 159,
+			135, 141, 18, 241, 60, 103, 94, 80, 159, 113, 158, 84, 12, 190,
+			138, 219, 124, 68, 169, 27, 182, 27, 126, 19, 60, 251, 102, 250,
+			16, 34, 115, 71, 143, 101, 21, 203, 94, 85, 69, 241, 205, 209,
+			234, 176, 155, 205, 19, 55, 248, 48, 6, 23, 49, 237, 229, 177,
+			189, 217, 163, 219, 91, 135, 162, 61, 205, 13, 121, 153, 172, 226,
+			23, 185, 56, 220, 229, 17, 207, 156, 150, 123, 159, 57, 197, 55,
+			253, 111, 66, 188, 186, 3, 144, 49, 111, 158, 22, 171, 124, 252,
+			80, 231, 71, 52, 60, 219, 219, 240, 81, 208, 54, 109, 150, 36,
+			207, 171, 197, 18, 3, 188, 239, 243, 119, 215, 55, 183, 198, 114,
+			240, 146, 44, 61, 148, 56, 102, 41, 151, 236, 106, 127, 28, 70,
+			201, 246, 78, 247, 250, 95, 57, 167, 92, 149, 191, 249, 221, 225,
+			170, 156, 6, 168, 248, 93, 22, 210, 197, 190, 161, 220, 55, 45,
+			171, 248, 85, 153, 174, 124, 42, 172, 66, 89, 48, 154, 223, 128,
+			131, 97, 212, 77, 67, 218, 245, 56, 112, 166, 198, 59, 93, 101,
+			113, 5, 252, 117, 50, 11, 181, 141, 230, 217, 56, 166, 34, 75,
+			218, 99, 81, 158, 82, 215, 161, 130, 224, 255, 131, 17, 89, 142,
+			217, 165, 226, 95, 54, 206, 101, 64, 87, 180, 127, 18, 40, 221,
+			147, 144, 124, 230, 2, 207, 248, 115, 26, 37, 48, 145, 146, 88,
+			11, 176, 212, 217, 105, 4, 92, 56, 25, 180, 43, 113, 201, 180,
+			145, 44, 128, 232, 178, 67, 124, 60, 137, 164, 151, 144, 201, 0,
+			46, 24, 172, 223, 224, 250, 166, 141, 106, 103, 193, 140, 210, 244,
+			5, 19, 195, 131, 15, 155, 156, 37, 206, 67, 221, 228, 198, 204,
+			67, 128, 48, 207, 177, 241, 83, 58, 5, 175, 207, 202, 51, 244,
+			52, 98, 78, 56, 194, 158, 214, 79, 35, 66, 73, 97, 158, 140,
+			68, 144, 76, 208, 59, 94, 57, 114, 28, 152, 56, 240, 176, 237,
+			196, 224, 88, 207, 195, 182, 19, 19, 147, 166, 58, 220, 32, 205,
+			147, 145, 228, 2, 157, 169, 14, 124, 232, 164, 121, 50, 146, 220,
+			160, 205, 147, 145, 232, 173, 60, 101, 222, 124, 204, 65, 152, 87,
+			54, 149, 169, 14, 39, 207, 148, 121, 243, 81, 249, 13, 79, 137,
+			9, 254, 13, 19, 219, 231, 132, 253, 98, 241, 55, 44, 13, 46,
+			117, 46, 28, 181, 160, 46, 68, 249, 217, 245, 122, 252, 206, 208,
+			46, 34, 197, 68, 244, 27, 107, 134, 251, 38, 172, 216, 27, 114,
+			137, 158, 48, 245, 119, 247, 50, 185, 77, 96, 224, 97, 103, 70,
+			90, 115, 251, 110, 51, 220, 47, 99, 177, 249, 138, 188, 134, 165,
+			221, 46, 74, 238, 33, 79, 107, 59, 0, 11, 48, 13, 56, 151,
+			125, 202, 21, 59, 85, 129, 33, 155, 225, 126, 182, 56, 36, 227,
+			132, 235, 226, 114, 78, 63, 53, 178, 148, 113, 59, 119, 224, 210,
+			107, 82, 112, 5, 30, 212, 177, 89, 0, 254, 39, 102, 206, 233,
+			20, 92, 129, 231, 230, 249, 143, 14, 208, 163, 189, 125, 235, 246,
+			247, 90, 172, 248, 253, 3, 114, 85, 170, 83, 231, 0, 236, 16,
+			167, 201, 31, 38, 166, 215, 46, 218, 138, 12, 130, 91, 50, 90,
+			170, 102, 34, 187, 242, 212, 166, 153, 142, 129, 138, 36, 66, 43,
+			19, 23, 4, 43, 192, 18, 133, 81, 11, 202, 187, 178, 30, 38,
+			11, 117, 15, 47, 59, 184, 153, 147, 61, 148, 65, 243, 84, 217,
+			18, 187, 93, 50, 147, 244, 208, 132, 185, 215, 221, 147, 198, 117,
+			9, 149, 129, 104, 134, 55, 231, 215, 95, 175, 123, 110, 125, 199,
+			243, 26, 243, 151, 200, 14, 143, 60, 118, 95, 151, 159, 53, 76,
+			245, 27, 82, 62, 15, 196, 152, 52, 95, 198, 49, 182, 98, 172,
+			78, 194, 104, 91, 185, 226, 111, 131, 131, 188, 124, 93, 150, 26,
+			97, 88, 58, 84, 30, 153, 104, 114, 102, 144, 175, 167, 138, 193,
+			210, 67, 175, 91, 186, 36, 75, 120, 72, 148, 202, 38, 187, 5,
+			135, 83, 233, 146, 124, 183, 4, 62, 111, 165, 178, 92, 94, 42,
+			83, 41, 80, 222, 128, 121, 227, 123, 105, 233, 184, 179, 3, 101,
+			63, 44, 181, 226, 221, 210, 37, 185, 188, 252, 81, 89, 154, 196,
+			202, 71, 186, 36, 168, 19, 165, 38, 105, 89, 16, 124, 117, 249,
+			147, 3, 129, 80, 108, 27, 152, 54, 249, 58, 177, 180, 30, 57,
+			192, 235, 200, 65, 149, 148, 203, 239, 109, 234, 64, 177, 204, 221,
+			162, 7, 42, 81, 220, 76, 0, 44, 93, 47, 206, 0, 37, 124,
+			88, 186, 164, 140, 240, 210, 249, 108, 129, 233, 30, 96, 33, 70,
+			122, 167, 147, 98, 206, 232, 175, 193, 123, 53, 9, 213, 236, 20,
+			131, 116, 73, 3, 0, 178, 122, 121, 155, 75, 122, 4, 165, 236,
+			36, 1, 178, 37, 61, 205, 146, 134, 101, 233, 172, 34, 5, 79,
+			88, 227, 138, 89, 64, 28, 175, 92, 94, 250, 164, 21, 227, 206,
+			78, 69, 173, 220, 187, 203, 203, 61, 21, 143, 130, 90, 5, 97,
+			242, 110, 138, 15, 0, 147, 219, 209, 37, 254, 132, 9, 247, 240,
+			94, 233, 124, 63, 201, 100, 222, 93, 126, 15, 186, 250, 84, 51,
+			121, 119, 233, 189, 116, 50, 233, 0, 111, 248, 137, 23, 185, 77,
+			57, 91, 81, 49, 12, 103, 223, 157, 149, 181, 61, 23, 212, 97,
+			64, 2, 40, 248, 173, 23, 215, 220, 182, 150, 234, 185, 114, 199,
+			173, 61, 140, 155, 110, 172, 111, 213, 234, 253, 240, 117, 14, 135,
+			142, 121, 192, 251, 170, 179, 209, 71, 199, 128, 122, 68, 251, 234,
+			0, 132, 78, 215, 15, 84, 231, 4, 123, 43, 63, 150, 121, 116,
+			25, 138, 188, 149, 31, 204, 230, 216, 130, 189, 53, 146, 173, 100,
+			9, 118, 45, 47, 50, 69, 160, 223, 107, 249, 225, 108, 142, 45,
+			216, 181, 177, 113, 254, 243, 54, 61, 222, 156, 19, 236, 142, 51,
+			93, 252, 111, 109, 169, 56, 190, 140, 69, 171, 50, 164, 174, 53,
+			221, 24, 245, 126, 154, 104, 98, 108, 70, 133, 190, 104, 37, 231,
+			129, 136, 88, 157, 31, 168, 247, 138, 121, 70, 154, 103, 72, 46,
+			104, 55, 181, 199, 182, 54, 153, 134, 187, 191, 214, 174, 249, 193,
+			97, 75, 100, 158, 137, 26, 173, 93, 228, 32, 110, 38, 249, 92,
+			187, 205, 102, 87, 62, 32, 156, 121, 0, 210, 51, 56, 254, 128,
+			185, 113, 155, 212, 234, 89, 114, 158, 66, 87, 140, 184, 179, 147,
+			62, 255, 202, 113, 92, 62, 14, 210, 15, 14, 198, 18, 220, 215,
+			100, 26, 148, 157, 120, 140, 224, 217, 170, 31, 167, 71, 64, 237,
+			135, 209, 195, 152, 188, 162, 114, 244, 234, 231, 29, 115, 214, 43,
+			233, 241, 157, 193, 241, 52, 205, 4, 187, 51, 57, 197, 255, 25,
+			248, 19, 230, 152, 197, 5, 187, 235, 28, 43, 254, 125, 75, 174,
+			72, 63, 101, 126, 233, 237, 29, 228, 171, 241, 244, 64, 47, 64,
+			240, 144, 165, 103, 14, 80, 120, 14, 100, 14, 108, 2, 74, 68,
+			7, 75, 232, 1, 164, 222, 171, 81, 72, 217, 14, 227, 216, 167,
+			37, 32, 167, 67, 99, 134, 187, 24, 70, 250, 10, 79, 123, 192,
+			24, 38, 168, 40, 227, 220, 68, 209, 3, 39, 34, 148, 47, 120,
+			224, 53, 36, 93, 216, 143, 1, 112, 181, 48, 130, 5, 120, 161,
+			32, 210, 60, 157, 215, 138, 189, 230, 35, 144, 27, 19, 59, 100,
+			241, 156, 96, 119, 135, 233, 177, 101, 244, 160, 252, 130, 51, 170,
+			63, 130, 211, 226, 23, 200, 73, 17, 147, 214, 129, 180, 173, 210,
+			255, 144, 158, 189, 7, 222, 233, 93, 231, 185, 226, 223, 180, 228,
+			38, 200, 31, 233, 169, 115, 229, 90, 21, 182, 201, 198, 33, 242,
+			224, 188, 211, 218, 106, 154, 147, 31, 197, 16, 99, 37, 74, 244,
+			89, 223, 165, 115, 57, 8, 247, 203, 164, 133, 135, 86, 190, 2,
+			44, 209, 81, 45, 204, 18, 152, 103, 165, 57, 33, 112, 210, 74,
+			226, 203, 211, 160, 222, 50, 125, 150, 168, 124, 248, 45, 40, 197,
+			146, 168, 141, 150, 193, 28, 16, 117, 190, 107, 184, 70, 37, 231,
+			127, 119, 170, 152, 166, 225, 189, 216, 83, 167, 179, 15, 174, 235,
+			247, 97, 49, 153, 23, 236, 75, 153, 234, 0, 168, 47, 77, 205,
+			164, 105, 120, 47, 246, 196, 73, 254, 107, 14, 213, 103, 130, 181,
+			157, 75, 197, 127, 232, 200, 158, 59, 233, 1, 86, 73, 209, 94,
+			24, 250, 108, 198, 144, 111, 150, 54, 121, 133, 234, 98, 38, 167,
+			76, 92, 10, 152, 40, 16, 101, 228, 127, 180, 19, 8, 50, 4,
+			229, 52, 28, 74, 0, 62, 106, 145, 171, 2, 243, 32, 41, 143,
+			15, 178, 74, 126, 3, 204, 134, 35, 99, 224, 47, 155, 33, 90,
+			169, 55, 253, 135, 158, 58, 54, 244, 161, 128, 156, 7, 28, 126,
+			112, 213, 41, 1, 71, 17, 54, 128, 146, 151, 128, 192, 148, 202,
+			178, 228, 6, 117, 248, 95, 156, 116, 26, 141, 210, 123, 196, 102,
+			152, 67, 185, 151, 7, 203, 204, 85, 243, 124, 164, 73, 67, 230,
+			16, 15, 104, 197, 235, 200, 215, 117, 151, 239, 153, 204, 74, 216,
+			80, 249, 216, 245, 17, 249, 48, 148, 35, 178, 105, 104, 79, 250,
+			80, 150, 79, 175, 152, 206, 145, 250, 37, 127, 18, 63, 6, 29,
+			91, 163, 211, 68, 108, 85, 211, 129, 73, 106, 152, 198, 25, 43,
+			37, 220, 203, 64, 232, 205, 37, 52, 230, 64, 21, 116, 212, 21,
+			52, 251, 196, 159, 74, 17, 132, 86, 233, 120, 233, 211, 207, 196,
+			181, 128, 27, 137, 50, 136, 13, 145, 37, 218, 206, 11, 105, 26,
+			222, 157, 157, 125, 57, 77, 3, 38, 126, 230, 85, 254, 61, 26,
+			51, 209, 47, 202, 121, 169, 248, 111, 153, 204, 10, 53, 158, 140,
+			153, 30, 148, 74, 113, 18, 43, 29, 64, 70, 254, 68, 108, 68,
+			163, 11, 220, 159, 71, 226, 162, 70, 69, 141, 137, 252, 255, 109,
+			84, 244, 104, 54, 79, 65, 194, 119, 151, 222, 3, 60, 80, 104,
+			152, 230, 46, 191, 87, 9, 27, 250, 27, 118, 125, 240, 219, 178,
+			250, 6, 195, 57, 248, 105, 69, 125, 82, 152, 245, 219, 139, 73,
+			10, 99, 244, 235, 35, 136, 1, 121, 68, 129, 51, 105, 6, 122,
+			195, 149, 22, 211, 12, 6, 25, 43, 23, 148, 52, 0, 158, 120,
+			253, 134, 101, 159, 194, 39, 94, 115, 232, 201, 243, 13, 237, 89,
+			151, 195, 23, 95, 191, 161, 61, 235, 80, 240, 235, 124, 195, 18,
+			51, 58, 201, 160, 110, 70, 163, 241, 151, 251, 248, 140, 175, 130,
+			59, 103, 220, 122, 51, 18, 224, 195, 74, 139, 47, 242, 73, 10,
+			7, 157, 6, 136, 249, 127, 38, 230, 235, 31, 115, 190, 123, 148,
+			14, 90, 211, 0, 34, 181, 247, 117, 156, 191, 9, 171, 232, 201,
+			163, 128, 135, 143, 118, 184, 17, 249, 52, 18, 163, 130, 23, 108,
+			125, 179, 72, 207, 88, 205, 167, 128, 184, 73, 235, 158, 201, 117,
+			170, 174, 222, 92, 204, 232, 37, 134, 10, 39, 83, 189, 196, 200,
+			19, 3, 195, 142, 244, 4, 134, 29, 233, 9, 12, 59, 82, 60,
+			193, 107, 90, 88, 35, 236, 201, 226, 23, 190, 243, 192, 176, 233,
+			115, 147, 248, 98, 104, 138, 121, 58, 236, 148, 146, 63, 137, 30,
+			125, 135, 232, 9, 18, 43, 50, 65, 98, 191, 127, 133, 143, 237,
+			187, 205, 135, 219, 136, 164, 191, 173, 6, 96, 197, 195, 26, 146,
+			94, 237, 200, 143, 228, 249, 80, 86, 124, 249, 137, 245, 120, 103,
+			57, 104, 33, 162, 46, 201, 169, 71, 122, 197, 237, 104, 241, 21,
+			117, 197, 60, 119, 90, 97, 221, 35, 213, 1, 106, 61, 178, 189,
+			85, 110, 134, 117, 175, 138, 69, 68, 89, 199, 70, 117, 82, 137,
+			122, 79, 217, 158, 168, 168, 43, 105, 84, 84, 21, 124, 117, 230,
+			80, 249, 67, 241, 80, 127, 208, 226, 14, 116, 8, 82, 247, 122,
+			35, 166, 56, 163, 172, 222, 136, 197, 235, 124, 64, 5, 57, 211,
+			38, 94, 35, 43, 207, 29, 106, 16, 234, 86, 214, 116, 177, 106,
+			90, 163, 180, 194, 7, 76, 190, 24, 226, 133, 171, 183, 171, 247,
+			86, 171, 107, 155, 99, 57, 49, 204, 7, 46, 175, 94, 121, 91,
+			37, 45, 81, 224, 206, 229, 219, 91, 215, 198, 236, 226, 199, 58,
+			58, 235, 9, 62, 128, 113, 33, 188, 54, 129, 157, 85, 11, 16,
+			153, 21, 210, 226, 37, 14, 191, 183, 97, 161, 159, 109, 122, 214,
+			223, 82, 239, 138, 124, 146, 128, 175, 197, 159, 176, 211, 40, 175,
+			167, 56, 87, 183, 70, 240, 69, 39, 184, 12, 168, 156, 141, 122,
+			44, 78, 113, 142, 87, 18, 68, 44, 28, 70, 161, 58, 128, 57,
+			208, 154, 56, 147, 170, 212, 176, 0, 195, 2, 90, 131, 134, 69,
+			62, 89, 24, 89, 49, 207, 199, 232, 49, 87, 77, 211, 99, 138,
+			183, 58, 74, 249, 180, 35, 49, 198, 107, 208, 105, 165, 46, 141,
+			49, 198, 212, 29, 174, 14, 7, 157, 84, 251, 30, 139, 227, 188,
+			208, 216, 175, 111, 215, 189, 118, 140, 225, 115, 11, 213, 254, 134,
+			122, 230, 89, 156, 224, 3, 32, 1, 80, 223, 10, 248, 173, 0,
+			25, 240, 241, 250, 175, 188, 168, 136, 250, 215, 190, 139, 45, 226,
+			224, 39, 3, 173, 242, 9, 44, 224, 8, 54, 104, 116, 42, 195,
+			185, 65, 254, 247, 108, 117, 2, 76, 231, 190, 102, 21, 127, 206,
+			238, 9, 156, 115, 128, 211, 3, 114, 166, 204, 31, 178, 10, 12,
+			114, 201, 78, 246, 162, 176, 179, 139, 166, 76, 126, 100, 124, 100,
+			103, 241, 58, 186, 239, 70, 245, 84, 225, 226, 227, 165, 20, 56,
+			25, 144, 186, 6, 117, 120, 127, 8, 15, 11, 15, 56, 65, 224,
+			244, 51, 145, 30, 232, 97, 162, 50, 29, 35, 228, 35, 221, 130,
+			35, 14, 56, 227, 102, 211, 107, 86, 56, 220, 85, 36, 60, 72,
+			108, 36, 217, 97, 68, 74, 146, 118, 20, 214, 59, 181, 84, 204,
+			1, 237, 117, 83, 115, 182, 247, 59, 94, 199, 147, 46, 78, 141,
+			167, 207, 34, 208, 29, 26, 108, 170, 195, 186, 87, 134, 131, 1,
+			92, 211, 169, 119, 186, 223, 98, 124, 40, 57, 103, 182, 53, 5,
+			183, 200, 156, 112, 211, 133, 73, 190, 166, 79, 184, 25, 251, 84,
+			241, 21, 115, 53, 174, 160, 87, 32, 109, 77, 163, 84, 198, 93,
+			131, 157, 103, 239, 189, 230, 252, 1, 161, 198, 76, 143, 154, 126,
+			166, 71, 77, 63, 115, 226, 36, 255, 67, 198, 106, 92, 218, 199,
+			138, 63, 204, 192, 103, 63, 234, 102, 162, 180, 106, 190, 175, 97,
+			30, 125, 130, 151, 125, 240, 14, 159, 6, 8, 72, 34, 23, 98,
+			196, 186, 77, 73, 207, 72, 240, 76, 22, 46, 86, 88, 171, 117,
+			34, 208, 85, 185, 178, 19, 208, 137, 106, 0, 76, 39, 105, 12,
+			239, 109, 212, 59, 65, 221, 13, 146, 204, 107, 66, 169, 105, 26,
+			232, 26, 212, 91, 221, 58, 116, 159, 219, 148, 123, 158, 251, 168,
+			139, 190, 206, 175, 41, 73, 11, 141, 41, 0, 254, 8, 250, 70,
+			213, 71, 59, 10, 33, 108, 140, 87, 135, 139, 65, 45, 193, 215,
+			5, 106, 184, 84, 187, 110, 84, 71, 163, 246, 176, 129, 182, 152,
+			45, 8, 0, 4, 180, 21, 95, 194, 84, 225, 13, 180, 132, 133,
+			31, 28, 111, 26, 243, 137, 6, 94, 51, 81, 129, 52, 184, 96,
+			8, 52, 106, 152, 48, 238, 254, 8, 31, 187, 83, 15, 98, 64,
+			248, 210, 160, 174, 217, 38, 181, 73, 252, 64, 249, 62, 164, 54,
+			85, 57, 140, 154, 33, 237, 33, 157, 130, 21, 27, 22, 58, 5,
+			246, 115, 83, 211, 169, 142, 173, 100, 47, 103, 117, 108, 37, 62,
+			194, 255, 164, 77, 106, 46, 176, 125, 115, 198, 139, 191, 223, 150,
+			107, 87, 55, 1, 41, 145, 253, 39, 243, 37, 95, 123, 17, 194,
+			73, 179, 160, 132, 47, 115, 126, 188, 55, 15, 156, 191, 43, 47,
+			67, 108, 137, 3, 31, 80, 120, 203, 229, 166, 15, 222, 191, 48,
+			69, 200, 200, 108, 14, 12, 150, 216, 76, 252, 133, 100, 15, 106,
+			83, 240, 61, 45, 155, 83, 247, 13, 63, 150, 59, 232, 119, 239,
+			53, 26, 97, 148, 80, 72, 28, 128, 156, 91, 83, 49, 117, 96,
+			17, 225, 214, 45, 247, 186, 59, 145, 95, 215, 158, 144, 56, 7,
+			16, 38, 93, 190, 186, 73, 145, 25, 99, 47, 1, 127, 28, 120,
+			76, 48, 72, 162, 176, 169, 56, 209, 29, 223, 213, 65, 75, 51,
+			232, 71, 141, 193, 136, 97, 183, 186, 205, 221, 48, 242, 147, 189,
+			150, 185, 7, 43, 37, 228, 172, 83, 232, 81, 66, 206, 14, 12,
+			165, 105, 38, 216, 236, 232, 24, 175, 41, 240, 2, 113, 60, 239,
+			44, 246, 21, 55, 165, 57, 254, 51, 114, 89, 36, 212, 61, 195,
+			200, 18, 186, 212, 231, 83, 27, 168, 105, 191, 27, 160, 56, 233,
+			160, 144, 92, 156, 199, 55, 250, 65, 126, 156, 131, 13, 45, 88,
+			57, 63, 65, 210, 226, 28, 237, 247, 114, 126, 36, 155, 99, 11,
+			86, 30, 207, 86, 178, 4, 91, 200, 79, 102, 138, 128, 244, 106,
+			33, 63, 154, 205, 177, 5, 91, 16, 19, 153, 74, 182, 96, 149,
+			158, 34, 192, 77, 87, 242, 60, 155, 3, 101, 134, 71, 178, 138,
+			217, 37, 231, 164, 1, 25, 160, 242, 146, 51, 156, 166, 45, 193,
+			150, 70, 142, 165, 105, 38, 216, 18, 197, 160, 66, 181, 236, 138,
+			209, 45, 131, 49, 208, 10, 69, 213, 193, 59, 33, 91, 233, 31,
+			212, 41, 38, 216, 202, 200, 40, 237, 2, 75, 56, 47, 217, 55,
+			244, 46, 128, 137, 189, 196, 71, 249, 207, 106, 129, 100, 78, 176,
+			87, 157, 153, 226, 159, 182, 48, 70, 32, 96, 123, 106, 39, 69,
+			90, 241, 172, 122, 22, 68, 97, 138, 162, 121, 175, 201, 37, 173,
+			28, 247, 91, 45, 175, 14, 161, 252, 102, 185, 156, 11, 194, 158,
+			67, 107, 190, 44, 23, 150, 117, 193, 32, 148, 200, 200, 206, 42,
+			29, 235, 27, 75, 58, 142, 108, 11, 223, 144, 167, 88, 44, 228,
+			8, 3, 231, 26, 184, 250, 130, 13, 110, 12, 141, 248, 105, 152,
+			100, 131, 3, 42, 146, 215, 171, 206, 64, 154, 182, 4, 123, 149,
+			107, 245, 183, 146, 89, 191, 58, 125, 140, 255, 162, 86, 23, 88,
+			130, 173, 58, 47, 22, 191, 109, 75, 122, 107, 238, 105, 33, 17,
+			129, 2, 166, 232, 8, 196, 172, 171, 137, 104, 24, 25, 180, 4,
+			61, 42, 74, 19, 155, 154, 80, 107, 134, 166, 238, 185, 245, 38,
+			68, 99, 241, 99, 57, 11, 123, 155, 182, 246, 108, 153, 140, 152,
+			117, 208, 46, 106, 92, 61, 21, 5, 237, 73, 87, 238, 248, 137,
+			14, 98, 65, 218, 103, 176, 219, 244, 91, 158, 146, 63, 234, 136,
+			135, 250, 248, 78, 66, 178, 183, 212, 47, 6, 25, 177, 71, 250,
+			196, 12, 181, 3, 39, 86, 187, 102, 218, 130, 224, 233, 16, 190,
+			82, 13, 137, 98, 151, 130, 230, 96, 207, 141, 234, 60, 157, 132,
+			182, 19, 165, 109, 153, 89, 5, 192, 229, 85, 131, 219, 74, 254,
+			187, 122, 234, 92, 154, 102, 130, 173, 206, 205, 243, 159, 101, 180,
+			10, 182, 96, 111, 59, 167, 138, 127, 154, 253, 118, 6, 167, 76,
+			89, 243, 242, 161, 128, 149, 42, 87, 105, 18, 248, 161, 175, 116,
+			169, 5, 249, 219, 51, 226, 89, 194, 63, 252, 255, 107, 241, 44,
+			97, 31, 129, 97, 200, 219, 25, 101, 17, 80, 161, 183, 7, 123,
+			101, 246, 111, 27, 19, 72, 38, 216, 77, 138, 103, 153, 131, 200,
+			54, 236, 166, 113, 12, 3, 254, 251, 166, 113, 12, 131, 112, 189,
+			55, 41, 158, 101, 14, 158, 8, 191, 109, 127, 168, 201, 23, 116,
+			113, 155, 143, 243, 223, 171, 200, 23, 26, 176, 87, 157, 153, 226,
+			247, 88, 242, 182, 190, 146, 1, 143, 79, 135, 221, 236, 70, 157,
+			228, 170, 128, 64, 200, 114, 151, 53, 143, 141, 75, 159, 49, 33,
+			38, 213, 160, 118, 24, 232, 9, 241, 66, 203, 12, 10, 56, 146,
+			203, 106, 232, 80, 28, 93, 3, 21, 21, 239, 172, 106, 206, 73,
+			27, 201, 81, 117, 64, 147, 35, 101, 192, 94, 157, 62, 198, 235,
+			52, 3, 208, 24, 57, 51, 197, 187, 192, 113, 198, 9, 108, 4,
+			233, 7, 234, 49, 91, 45, 59, 66, 44, 62, 16, 136, 92, 102,
+			34, 28, 194, 174, 164, 251, 132, 31, 100, 20, 142, 153, 81, 129,
+			12, 230, 11, 153, 81, 193, 246, 252, 66, 102, 84, 96, 3, 244,
+			133, 233, 99, 124, 143, 70, 101, 11, 118, 223, 41, 22, 223, 209,
+			208, 250, 142, 199, 69, 245, 81, 52, 116, 228, 200, 0, 139, 238,
+			103, 70, 6, 75, 124, 127, 64, 27, 63, 97, 232, 48, 118, 127,
+			230, 56, 255, 33, 135, 134, 6, 129, 25, 157, 147, 197, 175, 59,
+			242, 64, 144, 166, 79, 25, 221, 210, 220, 182, 226, 131, 209, 45,
+			137, 129, 82, 151, 14, 21, 94, 72, 133, 37, 137, 117, 21, 154,
+			195, 129, 0, 151, 202, 118, 12, 244, 140, 26, 171, 76, 76, 189,
+			168, 43, 155, 225, 174, 95, 83, 163, 196, 103, 189, 104, 168, 230,
+			81, 85, 53, 44, 221, 131, 36, 219, 120, 211, 212, 172, 233, 156,
+			142, 215, 24, 212, 90, 16, 84, 23, 99, 42, 238, 251, 64, 62,
+			50, 251, 118, 167, 233, 6, 15, 43, 114, 43, 196, 231, 113, 51,
+			45, 43, 112, 101, 70, 150, 121, 30, 0, 13, 170, 56, 41, 27,
+			211, 160, 204, 126, 114, 120, 178, 255, 217, 68, 243, 68, 156, 200,
+			68, 243, 196, 116, 38, 154, 39, 166, 41, 154, 231, 55, 53, 221,
+			112, 4, 251, 138, 115, 186, 248, 72, 174, 247, 202, 78, 14, 220,
+			221, 21, 232, 244, 55, 253, 110, 215, 35, 253, 228, 120, 93, 143,
+			188, 204, 233, 35, 156, 23, 1, 220, 89, 64, 175, 239, 61, 38,
+			178, 109, 222, 64, 33, 155, 73, 51, 46, 167, 15, 198, 145, 142,
+			27, 252, 43, 190, 50, 112, 60, 77, 51, 193, 190, 114, 242, 20,
+			255, 97, 61, 238, 62, 193, 2, 231, 116, 241, 123, 173, 148, 114,
+			153, 135, 157, 83, 118, 45, 243, 45, 179, 59, 219, 158, 217, 142,
+			134, 252, 115, 58, 51, 244, 65, 150, 226, 27, 5, 29, 237, 165,
+			115, 153, 135, 201, 51, 179, 232, 195, 81, 101, 210, 150, 96, 193,
+			96, 58, 11, 136, 80, 28, 156, 60, 197, 127, 208, 166, 89, 228,
+			5, 75, 156, 233, 226, 255, 97, 201, 171, 74, 224, 148, 33, 45,
+			70, 146, 146, 37, 44, 135, 164, 33, 221, 236, 221, 144, 2, 184,
+			100, 78, 70, 218, 192, 176, 205, 106, 123, 110, 176, 75, 79, 232,
+			128, 103, 61, 198, 143, 9, 27, 217, 250, 116, 172, 234, 157, 248,
+			154, 222, 123, 100, 9, 27, 163, 156, 3, 240, 85, 15, 67, 227,
+			109, 150, 207, 45, 147, 77, 28, 222, 180, 21, 95, 166, 46, 251,
+			16, 15, 85, 250, 7, 206, 148, 12, 244, 242, 125, 0, 141, 20,
+			7, 32, 160, 108, 50, 160, 109, 46, 108, 59, 207, 4, 75, 38,
+			167, 248, 247, 106, 232, 245, 11, 246, 129, 115, 172, 248, 235, 150,
+			188, 76, 34, 185, 103, 129, 15, 38, 3, 226, 59, 144, 40, 101,
+			158, 200, 232, 242, 44, 20, 190, 51, 160, 105, 152, 241, 39, 2,
+			77, 119, 252, 44, 168, 41, 118, 135, 127, 66, 168, 245, 247, 1,
+			20, 82, 168, 245, 91, 130, 125, 48, 32, 210, 52, 19, 236, 131,
+			169, 105, 254, 13, 101, 100, 140, 129, 55, 167, 139, 251, 90, 118,
+			116, 96, 159, 187, 245, 122, 86, 150, 146, 157, 38, 169, 91, 53,
+			123, 153, 181, 231, 81, 91, 30, 118, 95, 183, 77, 207, 61, 195,
+			61, 168, 211, 90, 72, 194, 133, 166, 27, 237, 130, 111, 253, 7,
+			25, 77, 8, 132, 179, 255, 152, 34, 7, 231, 112, 179, 127, 76,
+			145, 131, 115, 184, 213, 63, 158, 156, 50, 154, 144, 255, 115, 138,
+			15, 211, 163, 149, 25, 53, 200, 137, 131, 106, 14, 128, 104, 247,
+			137, 42, 142, 39, 61, 184, 87, 124, 226, 67, 209, 197, 35, 221,
+			161, 138, 135, 148, 50, 197, 163, 157, 245, 139, 71, 190, 51, 89,
+			124, 162, 70, 114, 229, 159, 57, 220, 1, 10, 32, 86, 249, 232,
+			129, 151, 134, 196, 244, 209, 175, 185, 21, 143, 204, 71, 175, 241,
+			241, 67, 174, 168, 2, 149, 31, 135, 178, 85, 51, 7, 181, 5,
+			235, 0, 76, 241, 58, 31, 238, 241, 29, 18, 248, 244, 93, 79,
+			214, 211, 170, 151, 249, 128, 217, 132, 98, 236, 160, 166, 164, 216,
+			251, 146, 154, 120, 131, 143, 244, 122, 197, 9, 212, 4, 245, 230,
+			65, 119, 71, 101, 199, 109, 113, 147, 79, 28, 225, 241, 41, 138,
+			80, 250, 136, 15, 208, 210, 19, 191, 97, 115, 83, 71, 250, 0,
+			10, 244, 135, 57, 242, 211, 51, 64, 217, 243, 32, 166, 2, 101,
+			79, 214, 211, 170, 175, 243, 241, 67, 186, 85, 181, 160, 71, 169,
+			92, 159, 212, 204, 245, 159, 250, 46, 114, 225, 63, 151, 117, 125,
+			35, 133, 5, 87, 177, 228, 224, 226, 52, 168, 98, 205, 193, 227,
+			40, 67, 185, 211, 248, 179, 79, 176, 97, 42, 155, 23, 108, 68,
+			5, 184, 99, 253, 130, 141, 82, 129, 130, 96, 99, 185, 18, 41,
+			55, 132, 122, 197, 46, 159, 19, 206, 36, 0, 140, 115, 150, 135,
+			43, 204, 100, 97, 8, 46, 114, 121, 148, 176, 77, 217, 55, 129,
+			106, 65, 2, 156, 1, 242, 83, 58, 101, 11, 54, 53, 125, 94,
+			167, 152, 96, 83, 23, 223, 166, 106, 22, 188, 175, 246, 121, 250,
+			4, 215, 143, 99, 249, 99, 58, 101, 11, 118, 108, 102, 81, 167,
+			152, 96, 199, 46, 221, 166, 106, 54, 200, 238, 55, 232, 19, 220,
+			13, 102, 242, 19, 58, 5, 223, 38, 103, 117, 10, 36, 249, 43,
+			111, 81, 53, 38, 88, 209, 254, 12, 125, 2, 112, 21, 243, 163,
+			58, 101, 11, 86, 28, 123, 78, 167, 160, 228, 139, 23, 249, 255,
+			196, 176, 158, 3, 111, 24, 95, 47, 254, 77, 118, 208, 87, 181,
+			225, 7, 245, 56, 195, 69, 19, 241, 191, 5, 151, 230, 180, 20,
+			92, 41, 113, 139, 128, 84, 43, 240, 246, 121, 202, 138, 225, 109,
+			200, 79, 244, 121, 2, 199, 133, 23, 193, 153, 171, 93, 211, 181,
+			47, 195, 67, 175, 157, 72, 183, 9, 161, 96, 192, 212, 140, 31,
+			229, 255, 45, 59, 65, 226, 55, 123, 157, 103, 181, 161, 55, 200,
+			235, 211, 187, 194, 28, 42, 156, 30, 65, 84, 188, 4, 142, 123,
+			120, 206, 24, 159, 240, 2, 162, 59, 79, 124, 160, 30, 2, 57,
+			205, 31, 244, 135, 247, 227, 131, 174, 240, 135, 61, 225, 117, 52,
+			215, 103, 57, 193, 227, 132, 97, 127, 101, 248, 135, 118, 24, 100,
+			230, 159, 250, 247, 194, 244, 143, 8, 46, 160, 130, 59, 240, 76,
+			80, 191, 158, 120, 4, 20, 99, 62, 106, 215, 72, 5, 4, 203,
+			10, 110, 239, 249, 73, 157, 178, 5, 59, 59, 53, 175, 83, 240,
+			54, 245, 75, 215, 248, 13, 68, 128, 62, 193, 102, 237, 119, 138,
+			111, 30, 9, 117, 239, 113, 130, 23, 175, 20, 98, 112, 63, 60,
+			28, 127, 194, 244, 219, 7, 66, 242, 252, 113, 157, 178, 5, 155,
+			45, 94, 208, 41, 16, 152, 191, 113, 143, 127, 221, 194, 142, 209,
+			85, 252, 157, 98, 231, 9, 62, 216, 187, 62, 172, 97, 167, 253,
+			204, 190, 203, 178, 229, 34, 223, 229, 39, 177, 185, 226, 62, 115,
+			89, 212, 144, 128, 245, 60, 159, 63, 65, 3, 204, 219, 130, 157,
+			63, 249, 138, 78, 49, 193, 206, 95, 190, 135, 129, 166, 242, 57,
+			187, 95, 176, 37, 123, 3, 2, 77, 245, 80, 243, 236, 115, 199,
+			157, 224, 160, 169, 11, 184, 216, 199, 38, 234, 178, 233, 31, 28,
+			193, 1, 163, 186, 243, 210, 207, 188, 50, 108, 148, 163, 126, 146,
+			137, 60, 26, 70, 105, 4, 98, 221, 105, 43, 4, 224, 128, 46,
+			105, 245, 242, 173, 219, 213, 155, 171, 55, 182, 175, 110, 220, 218,
+			216, 188, 182, 190, 166, 101, 154, 20, 101, 24, 162, 5, 55, 117,
+			189, 184, 215, 186, 213, 167, 199, 165, 96, 118, 32, 137, 55, 132,
+			166, 223, 22, 108, 201, 16, 154, 126, 144, 202, 175, 188, 197, 127,
+			202, 70, 64, 20, 192, 217, 252, 243, 197, 159, 176, 15, 155, 1,
+			105, 101, 214, 97, 27, 32, 61, 58, 220, 158, 135, 198, 60, 159,
+			89, 183, 160, 78, 87, 50, 220, 193, 100, 13, 148, 78, 64, 63,
+			19, 154, 222, 212, 209, 126, 149, 34, 42, 75, 112, 169, 215, 181,
+			136, 65, 239, 226, 5, 27, 76, 29, 97, 79, 119, 0, 119, 0,
+			190, 52, 186, 174, 71, 130, 49, 104, 37, 141, 224, 92, 231, 56,
+			210, 140, 117, 79, 198, 86, 186, 172, 91, 158, 141, 60, 51, 179,
+			249, 84, 44, 97, 160, 90, 176, 4, 187, 104, 168, 126, 193, 22,
+			236, 162, 161, 250, 5, 112, 204, 191, 116, 123, 39, 223, 142, 194,
+			36, 188, 240, 127, 15, 0, 149, 104, 193, 142, 105, 245, 0, 0,
+		},
 	)
 }
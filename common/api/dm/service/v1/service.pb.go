@@ -37,8 +37,29 @@ type DepsClient interface {
 	ActivateExecution(ctx context.Context, in *ActivateExecutionReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	FinishAttempt(ctx context.Context, in *FinishAttemptReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	WalkGraph(ctx context.Context, in *WalkGraphReq, opts ...grpc.CallOption) (*GraphData, error)
-	// ClaimExecution is a totally temporary hack
-	ClaimExecution(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*ClaimExecutionRsp, error)
+	// ClaimExecution finds an Attempt which NeedsExecution and leases a new
+	// Execution for it to the caller. The lease must be kept alive with
+	// RenewExecutionLease until the Execution finishes its Attempt (or moves it
+	// into AddingDeps). If the lease expires, the Execution is considered lost
+	// and the Attempt becomes available for a new Execution.
+	//
+	// The Auth in the response must be activated with ActivateExecution before
+	// it can be used with the other rpcs.
+	ClaimExecution(ctx context.Context, in *ClaimExecutionReq, opts ...grpc.CallOption) (*ClaimExecutionRsp, error)
+	// RenewExecutionLease extends the lease on a claimed Execution.
+	RenewExecutionLease(ctx context.Context, in *RenewExecutionLeaseReq, opts ...grpc.CallOption) (*RenewExecutionLeaseRsp, error)
+	// ReleaseExecutionLease gives up the lease on a claimed Execution, making its
+	// Attempt available for a new Execution.
+	ReleaseExecutionLease(ctx context.Context, in *ReleaseExecutionLeaseReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// CancelAttempt cancels an unfinished Attempt. Its current Execution (if
+	// any) is cancelled through its distributor, and the Attempt moves to
+	// ABNORMAL_FINISHED, which unblocks all Attempts depending on it.
+	CancelAttempt(ctx context.Context, in *CancelAttemptReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// InvalidateAttempt discards the result of a FINISHED (or ABNORMAL_FINISHED)
+	// Attempt and executes it again. Attempts which depend on it are blocked on
+	// it again if they haven't consumed its result yet, and are re-executed
+	// (or invalidated themselves, if they're FINISHED) otherwise.
+	InvalidateAttempt(ctx context.Context, in *InvalidateAttemptReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 }
 type depsPRPCClient struct {
 	client *prpccommon.Client
@@ -84,7 +105,7 @@ func (c *depsPRPCClient) WalkGraph(ctx context.Context, in *WalkGraphReq, opts .
 	return out, nil
 }
 
-func (c *depsPRPCClient) ClaimExecution(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*ClaimExecutionRsp, error) {
+func (c *depsPRPCClient) ClaimExecution(ctx context.Context, in *ClaimExecutionReq, opts ...grpc.CallOption) (*ClaimExecutionRsp, error) {
 	out := new(ClaimExecutionRsp)
 	err := c.client.Call(ctx, "dm.Deps", "ClaimExecution", in, out, opts...)
 	if err != nil {
@@ -93,6 +114,42 @@ func (c *depsPRPCClient) ClaimExecution(ctx context.Context, in *google_protobuf
 	return out, nil
 }
 
+func (c *depsPRPCClient) RenewExecutionLease(ctx context.Context, in *RenewExecutionLeaseReq, opts ...grpc.CallOption) (*RenewExecutionLeaseRsp, error) {
+	out := new(RenewExecutionLeaseRsp)
+	err := c.client.Call(ctx, "dm.Deps", "RenewExecutionLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depsPRPCClient) ReleaseExecutionLease(ctx context.Context, in *ReleaseExecutionLeaseReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := c.client.Call(ctx, "dm.Deps", "ReleaseExecutionLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depsPRPCClient) CancelAttempt(ctx context.Context, in *CancelAttemptReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := c.client.Call(ctx, "dm.Deps", "CancelAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depsPRPCClient) InvalidateAttempt(ctx context.Context, in *InvalidateAttemptReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := c.client.Call(ctx, "dm.Deps", "InvalidateAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type depsClient struct {
 	cc *grpc.ClientConn
 }
@@ -137,7 +194,7 @@ func (c *depsClient) WalkGraph(ctx context.Context, in *WalkGraphReq, opts ...gr
 	return out, nil
 }
 
-func (c *depsClient) ClaimExecution(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*ClaimExecutionRsp, error) {
+func (c *depsClient) ClaimExecution(ctx context.Context, in *ClaimExecutionReq, opts ...grpc.CallOption) (*ClaimExecutionRsp, error) {
 	out := new(ClaimExecutionRsp)
 	err := grpc.Invoke(ctx, "/dm.Deps/ClaimExecution", in, out, c.cc, opts...)
 	if err != nil {
@@ -146,6 +203,42 @@ func (c *depsClient) ClaimExecution(ctx context.Context, in *google_protobuf1.Em
 	return out, nil
 }
 
+func (c *depsClient) RenewExecutionLease(ctx context.Context, in *RenewExecutionLeaseReq, opts ...grpc.CallOption) (*RenewExecutionLeaseRsp, error) {
+	out := new(RenewExecutionLeaseRsp)
+	err := grpc.Invoke(ctx, "/dm.Deps/RenewExecutionLease", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depsClient) ReleaseExecutionLease(ctx context.Context, in *ReleaseExecutionLeaseReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/dm.Deps/ReleaseExecutionLease", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depsClient) CancelAttempt(ctx context.Context, in *CancelAttemptReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/dm.Deps/CancelAttempt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depsClient) InvalidateAttempt(ctx context.Context, in *InvalidateAttemptReq, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/dm.Deps/InvalidateAttempt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Deps service
 
 type DepsServer interface {
@@ -153,8 +246,29 @@ type DepsServer interface {
 	ActivateExecution(context.Context, *ActivateExecutionReq) (*google_protobuf1.Empty, error)
 	FinishAttempt(context.Context, *FinishAttemptReq) (*google_protobuf1.Empty, error)
 	WalkGraph(context.Context, *WalkGraphReq) (*GraphData, error)
-	// ClaimExecution is a totally temporary hack
-	ClaimExecution(context.Context, *google_protobuf1.Empty) (*ClaimExecutionRsp, error)
+	// ClaimExecution finds an Attempt which NeedsExecution and leases a new
+	// Execution for it to the caller. The lease must be kept alive with
+	// RenewExecutionLease until the Execution finishes its Attempt (or moves it
+	// into AddingDeps). If the lease expires, the Execution is considered lost
+	// and the Attempt becomes available for a new Execution.
+	//
+	// The Auth in the response must be activated with ActivateExecution before
+	// it can be used with the other rpcs.
+	ClaimExecution(context.Context, *ClaimExecutionReq) (*ClaimExecutionRsp, error)
+	// RenewExecutionLease extends the lease on a claimed Execution.
+	RenewExecutionLease(context.Context, *RenewExecutionLeaseReq) (*RenewExecutionLeaseRsp, error)
+	// ReleaseExecutionLease gives up the lease on a claimed Execution, making its
+	// Attempt available for a new Execution.
+	ReleaseExecutionLease(context.Context, *ReleaseExecutionLeaseReq) (*google_protobuf1.Empty, error)
+	// CancelAttempt cancels an unfinished Attempt. Its current Execution (if
+	// any) is cancelled through its distributor, and the Attempt moves to
+	// ABNORMAL_FINISHED, which unblocks all Attempts depending on it.
+	CancelAttempt(context.Context, *CancelAttemptReq) (*google_protobuf1.Empty, error)
+	// InvalidateAttempt discards the result of a FINISHED (or ABNORMAL_FINISHED)
+	// Attempt and executes it again. Attempts which depend on it are blocked on
+	// it again if they haven't consumed its result yet, and are re-executed
+	// (or invalidated themselves, if they're FINISHED) otherwise.
+	InvalidateAttempt(context.Context, *InvalidateAttemptReq) (*google_protobuf1.Empty, error)
 }
 
 func RegisterDepsServer(s prpc.Registrar, srv DepsServer) {
@@ -234,7 +348,7 @@ func _Deps_WalkGraph_Handler(srv interface{}, ctx context.Context, dec func(inte
 }
 
 func _Deps_ClaimExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf1.Empty)
+	in := new(ClaimExecutionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -246,7 +360,79 @@ func _Deps_ClaimExecution_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: "/dm.Deps/ClaimExecution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DepsServer).ClaimExecution(ctx, req.(*google_protobuf1.Empty))
+		return srv.(DepsServer).ClaimExecution(ctx, req.(*ClaimExecutionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deps_RenewExecutionLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewExecutionLeaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepsServer).RenewExecutionLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dm.Deps/RenewExecutionLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepsServer).RenewExecutionLease(ctx, req.(*RenewExecutionLeaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deps_ReleaseExecutionLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseExecutionLeaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepsServer).ReleaseExecutionLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dm.Deps/ReleaseExecutionLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepsServer).ReleaseExecutionLease(ctx, req.(*ReleaseExecutionLeaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deps_CancelAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAttemptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepsServer).CancelAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dm.Deps/CancelAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepsServer).CancelAttempt(ctx, req.(*CancelAttemptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deps_InvalidateAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateAttemptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepsServer).InvalidateAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dm.Deps/InvalidateAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepsServer).InvalidateAttempt(ctx, req.(*InvalidateAttemptReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -275,27 +461,48 @@ var _Deps_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimExecution",
 			Handler:    _Deps_ClaimExecution_Handler,
 		},
+		{
+			MethodName: "RenewExecutionLease",
+			Handler:    _Deps_RenewExecutionLease_Handler,
+		},
+		{
+			MethodName: "ReleaseExecutionLease",
+			Handler:    _Deps_ReleaseExecutionLease_Handler,
+		},
+		{
+			MethodName: "CancelAttempt",
+			Handler:    _Deps_CancelAttempt_Handler,
+		},
+		{
+			MethodName: "InvalidateAttempt",
+			Handler:    _Deps_InvalidateAttempt_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
 
-var fileDescriptor6 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x90, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0x61, 0x88, 0x60, 0xa0, 0x3a, 0xc3, 0xa6, 0xa3, 0xfe, 0x05, 0xc9, 0x40, 0x9f, 0x45,
-	0x8a, 0xab, 0xbe, 0xfb, 0xe2, 0x63, 0xc9, 0xda, 0xbb, 0x2e, 0xd8, 0x36, 0xb1, 0xb9, 0x9d, 0xfa,
-	0xe7, 0xfc, 0x6d, 0xf6, 0x26, 0xa9, 0x63, 0x9b, 0xfa, 0x98, 0x2f, 0xf7, 0x9c, 0x7b, 0xcf, 0x61,
-	0x91, 0x85, 0x76, 0xa3, 0x72, 0x10, 0xa6, 0xd5, 0xa8, 0xf9, 0xa8, 0xa8, 0xe3, 0xab, 0x52, 0xeb,
-	0xb2, 0x82, 0xb9, 0x23, 0xcb, 0x6e, 0x35, 0x87, 0xda, 0xe0, 0xa7, 0x1f, 0x88, 0xc7, 0x65, 0x2b,
-	0xcd, 0x3a, 0x2b, 0x24, 0xca, 0x40, 0x2e, 0xa1, 0xb1, 0x5d, 0x0b, 0xd9, 0xc1, 0xc7, 0x4c, 0xe6,
-	0xa8, 0x36, 0x12, 0x21, 0x83, 0x0f, 0xc8, 0x3b, 0x54, 0xba, 0x09, 0x3f, 0x93, 0x95, 0x6a, 0x94,
-	0x5d, 0x67, 0x12, 0x91, 0xbc, 0x07, 0xeb, 0x77, 0x59, 0xbd, 0x7a, 0x9b, 0x40, 0xa6, 0x79, 0x25,
-	0x55, 0xbd, 0x2f, 0xbf, 0xf9, 0x1a, 0xb1, 0xa3, 0x05, 0x18, 0xcb, 0x13, 0x76, 0x96, 0xba, 0xe5,
-	0x4f, 0x24, 0x5a, 0xf4, 0xab, 0xf9, 0x85, 0x28, 0x6a, 0xb1, 0x07, 0x9f, 0xe1, 0x2d, 0xfe, 0x95,
-	0x5b, 0xc3, 0x53, 0x76, 0x9e, 0x84, 0x33, 0xd3, 0x61, 0x0d, 0x9f, 0xd1, 0xf0, 0x01, 0xf6, 0x36,
-	0xbe, 0x1c, 0x31, 0x94, 0x23, 0x52, 0x2a, 0x87, 0xdf, 0xb1, 0xe8, 0xd1, 0x65, 0x4a, 0x7c, 0x24,
-	0x3e, 0x21, 0x8b, 0x1d, 0xf4, 0x9f, 0xfc, 0x9a, 0x9d, 0xbc, 0xf4, 0xe1, 0xdd, 0x65, 0x7c, 0x4c,
-	0xd2, 0x9f, 0x27, 0xc9, 0x22, 0x22, 0xdb, 0x8c, 0xf7, 0xec, 0xf4, 0x81, 0x8a, 0xd9, 0x1e, 0xfc,
-	0x87, 0x6f, 0x3c, 0x25, 0xe1, 0xee, 0x6c, 0x1f, 0x7a, 0x79, 0xec, 0xc6, 0x6e, 0xbf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x4b, 0x84, 0xce, 0xf2, 0xfd, 0x01, 0x00, 0x00,
+var fileDescriptor8 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x92, 0x51, 0x4f, 0xc2, 0x30,
+	0x10, 0xc7, 0x13, 0x43, 0x4c, 0x6c, 0x82, 0x42, 0x05, 0x25, 0xd3, 0x8f, 0x60, 0x46, 0xa2, 0xcf,
+	0x9a, 0x10, 0x98, 0xc6, 0x44, 0x5f, 0x78, 0xf1, 0x71, 0x39, 0xb6, 0x03, 0x1a, 0xba, 0xad, 0xae,
+	0x05, 0xf4, 0xe3, 0xfa, 0x4d, 0xcc, 0xb5, 0xdb, 0xc8, 0x60, 0xe2, 0x63, 0x7f, 0x77, 0xff, 0x7f,
+	0xaf, 0xff, 0x2b, 0x6b, 0x6b, 0xcc, 0x37, 0x22, 0x42, 0x5f, 0xe5, 0x99, 0xc9, 0xf8, 0x49, 0x9c,
+	0x78, 0x37, 0x8b, 0x2c, 0x5b, 0x48, 0x1c, 0x5a, 0x32, 0x5b, 0xcf, 0x87, 0x98, 0x28, 0xf3, 0xed,
+	0x1a, 0xbc, 0xce, 0x22, 0x07, 0xb5, 0x0c, 0x63, 0x30, 0x50, 0x90, 0x6b, 0x4c, 0xf5, 0x3a, 0xc7,
+	0xf0, 0xa0, 0x30, 0x80, 0xc8, 0x88, 0x0d, 0x18, 0x0c, 0xf1, 0x0b, 0xa3, 0xb5, 0x11, 0x59, 0x5a,
+	0x54, 0x7a, 0x73, 0x91, 0x0a, 0xbd, 0x0c, 0xc1, 0x18, 0xf2, 0x2e, 0xad, 0xb7, 0x20, 0x57, 0xce,
+	0xa6, 0x20, 0xfd, 0x4a, 0x18, 0x4a, 0x04, 0x8d, 0xa5, 0x3c, 0x82, 0x34, 0x42, 0xb9, 0x27, 0x1f,
+	0x88, 0x74, 0x03, 0x52, 0xc4, 0x74, 0x61, 0xad, 0x72, 0xff, 0xd3, 0x62, 0xad, 0x09, 0x2a, 0xcd,
+	0x47, 0xec, 0x22, 0xb0, 0xc3, 0xbe, 0xd0, 0x25, 0x13, 0x30, 0xc0, 0xaf, 0xfc, 0x38, 0xf1, 0xf7,
+	0xe0, 0x14, 0x3f, 0xbd, 0x46, 0xae, 0x15, 0x0f, 0x58, 0x77, 0x54, 0x3c, 0x2b, 0x28, 0x87, 0xe3,
+	0x03, 0x6a, 0x3e, 0xc0, 0xce, 0xc6, 0x85, 0xe9, 0x97, 0x61, 0xfa, 0x01, 0x85, 0xc9, 0x1f, 0x59,
+	0xfb, 0xd9, 0x66, 0x30, 0x72, 0x93, 0xf2, 0x1e, 0x59, 0xd4, 0xd0, 0x31, 0xf9, 0x1d, 0x3b, 0xfb,
+	0x00, 0xb9, 0xb2, 0x93, 0xf1, 0x0e, 0x49, 0xab, 0x23, 0xc9, 0xda, 0x44, 0x76, 0x6f, 0x7c, 0x62,
+	0xe7, 0x63, 0x09, 0x22, 0xd9, 0x0d, 0xdc, 0xa7, 0x86, 0x3a, 0x23, 0x5d, 0x13, 0xd6, 0x8a, 0xbf,
+	0xb3, 0xcb, 0x29, 0xa6, 0xb8, 0xad, 0xe0, 0x1b, 0x2d, 0x83, 0x7b, 0xd4, 0xdd, 0x50, 0x20, 0xa7,
+	0x3f, 0x6b, 0xd6, 0xae, 0x3f, 0x45, 0xbb, 0xcf, 0x3d, 0xc3, 0x5b, 0x27, 0x6a, 0x28, 0xfd, 0x13,
+	0xe5, 0xd8, 0xfe, 0x87, 0x5a, 0x94, 0x35, 0x74, 0x4c, 0x1e, 0xb0, 0xee, 0x6b, 0xf5, 0x71, 0x4a,
+	0x0b, 0xbb, 0xd0, 0x03, 0x7c, 0xc4, 0x66, 0x76, 0x6a, 0xcf, 0x0f, 0xbf, 0x03, 0x00, 0x2d, 0x7e,
+	0xc9, 0x29, 0x50, 0x03, 0x00, 0x00,
 }
@@ -22,7 +22,7 @@ type MultiPropertyValue struct {
 func (m *MultiPropertyValue) Reset()                    { *m = MultiPropertyValue{} }
 func (m *MultiPropertyValue) String() string            { return proto.CompactTextString(m) }
 func (*MultiPropertyValue) ProtoMessage()               {}
-func (*MultiPropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
+func (*MultiPropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
 
 func (m *MultiPropertyValue) GetValues() []*PropertyValue {
 	if m != nil {
@@ -45,7 +45,7 @@ type PropertyValue struct {
 func (m *PropertyValue) Reset()                    { *m = PropertyValue{} }
 func (m *PropertyValue) String() string            { return proto.CompactTextString(m) }
 func (*PropertyValue) ProtoMessage()               {}
-func (*PropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
+func (*PropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{1} }
 
 type isPropertyValue_Value interface {
 	isPropertyValue_Value()
@@ -278,7 +278,7 @@ type AttemptList struct {
 func (m *AttemptList) Reset()                    { *m = AttemptList{} }
 func (m *AttemptList) String() string            { return proto.CompactTextString(m) }
 func (*AttemptList) ProtoMessage()               {}
-func (*AttemptList) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
+func (*AttemptList) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{2} }
 
 func (m *AttemptList) GetTo() map[string]*AttemptList_Nums {
 	if m != nil {
@@ -294,7 +294,7 @@ type AttemptList_Nums struct {
 func (m *AttemptList_Nums) Reset()                    { *m = AttemptList_Nums{} }
 func (m *AttemptList_Nums) String() string            { return proto.CompactTextString(m) }
 func (*AttemptList_Nums) ProtoMessage()               {}
-func (*AttemptList_Nums) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2, 0} }
+func (*AttemptList_Nums) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{2, 0} }
 
 func init() {
 	proto.RegisterType((*MultiPropertyValue)(nil), "dm.MultiPropertyValue")
@@ -303,28 +303,28 @@ func init() {
 	proto.RegisterType((*AttemptList_Nums)(nil), "dm.AttemptList.Nums")
 }
 
-var fileDescriptor7 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x64, 0x90, 0x5d, 0x4b, 0xc3, 0x30,
-	0x14, 0x86, 0xed, 0xc7, 0x3e, 0x3c, 0x75, 0x30, 0x83, 0xcc, 0x52, 0x41, 0x65, 0x37, 0x4e, 0x91,
-	0x4c, 0xe6, 0x8d, 0x78, 0x23, 0x0e, 0x06, 0xe2, 0x17, 0x52, 0x86, 0xf7, 0x1b, 0x8b, 0xa3, 0xd8,
-	0x34, 0xa5, 0x3d, 0x15, 0xfa, 0x7b, 0xfc, 0x3f, 0xfe, 0x26, 0x73, 0x92, 0x0d, 0xdc, 0xbc, 0xeb,
-	0x79, 0xf2, 0x9c, 0x26, 0xef, 0x0b, 0x01, 0xd6, 0xb9, 0x28, 0x79, 0x5e, 0x28, 0x54, 0xcc, 0x5d,
-	0xc8, 0xe8, 0x64, 0xa9, 0xd4, 0x32, 0x15, 0x43, 0x43, 0xe6, 0xd5, 0xc7, 0x10, 0x13, 0x29, 0x4a,
-	0x9c, 0xc9, 0xdc, 0x4a, 0xd1, 0xd1, 0xb6, 0x20, 0x64, 0x8e, 0xb5, 0x3d, 0xec, 0xdf, 0x01, 0x7b,
-	0xa9, 0x52, 0x4c, 0xde, 0x0a, 0x95, 0x8b, 0x02, 0xeb, 0xf7, 0x59, 0x5a, 0x09, 0x76, 0x0e, 0xcd,
-	0x2f, 0xfa, 0x28, 0x43, 0xe7, 0xd4, 0x1b, 0x04, 0xa3, 0x7d, 0xbe, 0x90, 0x7c, 0x43, 0x89, 0x57,
-	0x42, 0xff, 0xc7, 0x81, 0xce, 0xe6, 0x32, 0x03, 0xaf, 0xc4, 0x42, 0x6f, 0x3a, 0x83, 0xdd, 0x87,
-	0x9d, 0x98, 0x06, 0x62, 0x8b, 0x19, 0x86, 0xae, 0x66, 0x7b, 0xc4, 0xf4, 0x40, 0x2c, 0xab, 0x64,
-	0xe8, 0x69, 0xe6, 0x11, 0xd3, 0x03, 0xb1, 0x79, 0x92, 0x85, 0x0d, 0xcd, 0xda, 0xc4, 0xf4, 0xc0,
-	0xae, 0xc0, 0xa7, 0x48, 0x61, 0x53, 0xc3, 0x60, 0x14, 0x71, 0x1b, 0x87, 0xaf, 0xe3, 0xf0, 0xe9,
-	0x3a, 0xaf, 0x5e, 0x30, 0x26, 0xbb, 0x04, 0x3f, 0xab, 0xd2, 0x34, 0x6c, 0x99, 0x8d, 0xde, 0xbf,
-	0x8d, 0x09, 0x15, 0x40, 0x36, 0x59, 0xe3, 0x16, 0x34, 0x4c, 0x96, 0x47, 0xbf, 0xed, 0x77, 0x1b,
-	0xfd, 0x6f, 0x07, 0x82, 0x7b, 0x44, 0x2a, 0xe9, 0x39, 0x29, 0x91, 0x9d, 0x81, 0x8b, 0x4a, 0xbf,
-	0x9c, 0x7a, 0x38, 0xa4, 0x1e, 0xfe, 0x1c, 0xf2, 0xa9, 0x9a, 0x64, 0x58, 0xd4, 0xb1, 0x56, 0xa2,
-	0x63, 0xf0, 0x5f, 0x2b, 0x59, 0xb2, 0x1e, 0xdd, 0x2e, 0x6d, 0x75, 0x9d, 0xb1, 0xdb, 0x75, 0x62,
-	0x33, 0x47, 0x4f, 0xd0, 0x5a, 0xe9, 0xac, 0x0b, 0xde, 0xa7, 0xa8, 0x6d, 0x45, 0x31, 0x7d, 0xb2,
-	0x8b, 0xd5, 0x23, 0x4c, 0x45, 0xc1, 0xe8, 0x60, 0xfb, 0x22, 0xfa, 0x73, 0x6c, 0x95, 0x5b, 0xf7,
-	0xc6, 0x99, 0x37, 0x4d, 0x98, 0xeb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0x51, 0x52, 0x94,
-	0x0f, 0x02, 0x00, 0x00,
+var fileDescriptor9 = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x64, 0x90, 0xdd, 0x4a, 0xfb, 0x40,
+	0x10, 0xc5, 0xbb, 0x49, 0xfa, 0xf1, 0xdf, 0xfc, 0x0b, 0x75, 0x91, 0xba, 0x44, 0xd0, 0xd0, 0x1b,
+	0xa3, 0x48, 0x2a, 0xf5, 0x46, 0xbc, 0x11, 0x0b, 0x85, 0xe2, 0x17, 0x12, 0x8a, 0xf7, 0x09, 0x59,
+	0x4b, 0x30, 0x9b, 0x0d, 0xc9, 0xac, 0x90, 0xe7, 0xf1, 0x7d, 0x7c, 0x26, 0x99, 0x4d, 0x0b, 0xb6,
+	0xde, 0xcd, 0x9c, 0xf9, 0xcd, 0xce, 0x9e, 0x43, 0x5d, 0x68, 0x4a, 0x51, 0x87, 0x65, 0xa5, 0x40,
+	0x31, 0x2b, 0x95, 0xde, 0xe9, 0x5a, 0xa9, 0x75, 0x2e, 0xa6, 0x46, 0x49, 0xf4, 0xfb, 0x14, 0x32,
+	0x29, 0x6a, 0x88, 0x65, 0xd9, 0x42, 0xde, 0xf1, 0x3e, 0x20, 0x64, 0x09, 0x4d, 0x3b, 0x9c, 0xdc,
+	0x51, 0xf6, 0xac, 0x73, 0xc8, 0x5e, 0x2b, 0x55, 0x8a, 0x0a, 0x9a, 0xb7, 0x38, 0xd7, 0x82, 0x9d,
+	0xd3, 0xde, 0x27, 0x16, 0x35, 0x27, 0xbe, 0x1d, 0xb8, 0xb3, 0x83, 0x30, 0x95, 0xe1, 0x0e, 0x12,
+	0x6d, 0x80, 0xc9, 0x37, 0xa1, 0xc3, 0xdd, 0x65, 0x46, 0xed, 0x1a, 0x2a, 0x4e, 0x7c, 0x12, 0xfc,
+	0x5b, 0x76, 0x22, 0x6c, 0x50, 0x4b, 0x63, 0xe0, 0x96, 0x4f, 0x82, 0xff, 0xa8, 0xa5, 0x31, 0xa0,
+	0x56, 0x68, 0xc9, 0x6d, 0x9f, 0x04, 0x36, 0x6a, 0x85, 0x96, 0xa8, 0x25, 0x59, 0xc1, 0xbb, 0x3e,
+	0x09, 0x06, 0xa8, 0x25, 0x59, 0xc1, 0xae, 0xa8, 0x83, 0x96, 0x78, 0xcf, 0x27, 0x81, 0x3b, 0xf3,
+	0xc2, 0xd6, 0x4e, 0xb8, 0xb5, 0x13, 0xae, 0xb6, 0x7e, 0x97, 0x9d, 0xc8, 0x90, 0xec, 0x92, 0x3a,
+	0x85, 0xce, 0x73, 0xde, 0x37, 0x1b, 0xe3, 0x3f, 0x1b, 0x0b, 0x0c, 0x00, 0x69, 0xa4, 0xe6, 0x7d,
+	0xda, 0x35, 0x5e, 0x1e, 0x9c, 0x81, 0x33, 0xea, 0x4e, 0xbe, 0x08, 0x75, 0xef, 0x01, 0x30, 0xa4,
+	0xa7, 0xac, 0x06, 0x76, 0x46, 0x2d, 0x50, 0xdc, 0x32, 0x39, 0x1c, 0x61, 0x0e, 0xbf, 0x86, 0xe1,
+	0x4a, 0x2d, 0x0a, 0xa8, 0x9a, 0xc8, 0x02, 0xe5, 0x9d, 0x50, 0xe7, 0x45, 0xcb, 0x9a, 0x8d, 0xf1,
+	0xba, 0x6c, 0xa3, 0x1b, 0xce, 0xad, 0x11, 0x89, 0x4c, 0xef, 0x3d, 0xd2, 0xfe, 0x06, 0x67, 0x23,
+	0x6a, 0x7f, 0x88, 0xa6, 0x8d, 0x28, 0xc2, 0x92, 0x5d, 0x6c, 0x3e, 0x61, 0x22, 0x72, 0x67, 0x87,
+	0xfb, 0x87, 0xf0, 0xe5, 0xa8, 0x45, 0x6e, 0xad, 0x1b, 0x92, 0xf4, 0x8c, 0x99, 0xeb, 0x9f, 0x01,
+	0x00, 0x29, 0x51, 0x52, 0x94, 0x0f, 0x02, 0x00, 0x00,
 }
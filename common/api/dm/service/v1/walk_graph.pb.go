@@ -40,13 +40,12 @@ func (x WalkGraphReq_Mode_Direction) String() string {
 	return proto.EnumName(WalkGraphReq_Mode_Direction_name, int32(x))
 }
 func (WalkGraphReq_Mode_Direction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor8, []int{0, 0, 0}
+	return fileDescriptor10, []int{0, 0, 0}
 }
 
 // WalkGraphReq allows you to walk from one or more Quests through their
 // Attempt's forward dependencies.
 //
-//
 // The handler will evaluate all of the queries, executing them in parallel.
 // For each attempt or quest produced by the query, it will queue a walk
 // operation for that node, respecting the options set (max_depth, etc.).
@@ -70,7 +69,7 @@ type WalkGraphReq struct {
 func (m *WalkGraphReq) Reset()                    { *m = WalkGraphReq{} }
 func (m *WalkGraphReq) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq) ProtoMessage()               {}
-func (*WalkGraphReq) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
+func (*WalkGraphReq) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
 
 func (m *WalkGraphReq) GetAuth() *Execution_Auth {
 	if m != nil {
@@ -119,7 +118,7 @@ type WalkGraphReq_Mode struct {
 func (m *WalkGraphReq_Mode) Reset()                    { *m = WalkGraphReq_Mode{} }
 func (m *WalkGraphReq_Mode) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Mode) ProtoMessage()               {}
-func (*WalkGraphReq_Mode) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0, 0} }
+func (*WalkGraphReq_Mode) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0, 0} }
 
 type WalkGraphReq_Limit struct {
 	// MaxDepth sets the number of attempts to traverse; 0 means 'immediate'
@@ -148,7 +147,7 @@ type WalkGraphReq_Limit struct {
 func (m *WalkGraphReq_Limit) Reset()                    { *m = WalkGraphReq_Limit{} }
 func (m *WalkGraphReq_Limit) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Limit) ProtoMessage()               {}
-func (*WalkGraphReq_Limit) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0, 1} }
+func (*WalkGraphReq_Limit) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0, 1} }
 
 func (m *WalkGraphReq_Limit) GetMaxTime() *google_protobuf2.Duration {
 	if m != nil {
@@ -199,7 +198,7 @@ type WalkGraphReq_Include struct {
 func (m *WalkGraphReq_Include) Reset()                    { *m = WalkGraphReq_Include{} }
 func (m *WalkGraphReq_Include) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Include) ProtoMessage()               {}
-func (*WalkGraphReq_Include) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0, 2} }
+func (*WalkGraphReq_Include) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0, 2} }
 
 func init() {
 	proto.RegisterType((*WalkGraphReq)(nil), "dm.WalkGraphReq")
@@ -209,40 +208,40 @@ func init() {
 	proto.RegisterEnum("dm.WalkGraphReq_Mode_Direction", WalkGraphReq_Mode_Direction_name, WalkGraphReq_Mode_Direction_value)
 }
 
-var fileDescriptor8 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x92, 0xdd, 0x6f, 0xd3, 0x30,
-	0x14, 0xc5, 0xe9, 0x77, 0x7a, 0xfb, 0x41, 0xb0, 0x04, 0xca, 0x82, 0x60, 0x50, 0x01, 0x02, 0x09,
-	0x65, 0x52, 0xe1, 0x95, 0x87, 0x8e, 0xf2, 0x31, 0x01, 0x9a, 0xf0, 0x26, 0xed, 0x31, 0x72, 0x6b,
-	0xb7, 0x0b, 0x4b, 0x9a, 0x2c, 0x76, 0xb4, 0x8e, 0x07, 0xfe, 0x01, 0x9e, 0x79, 0xe2, 0x9f, 0xc5,
-	0xbe, 0x76, 0x06, 0x62, 0x7b, 0xab, 0xcf, 0xf9, 0xf5, 0xc4, 0xf7, 0xf8, 0x82, 0x7f, 0xc1, 0xd2,
-	0xb3, 0x78, 0x5d, 0xb2, 0xe2, 0x34, 0x2a, 0xca, 0x5c, 0xe5, 0xa4, 0xc9, 0xb3, 0xf0, 0xe1, 0x3a,
-	0xcf, 0xd7, 0xa9, 0xd8, 0x43, 0x65, 0x51, 0xad, 0xf6, 0x78, 0x55, 0x32, 0x95, 0xe4, 0x1b, 0xcb,
-	0x84, 0xbb, 0xff, 0xfb, 0x2a, 0xc9, 0x84, 0x54, 0x2c, 0x2b, 0x1c, 0xe0, 0x63, 0x62, 0xcc, 0x99,
-	0x62, 0x4e, 0xb9, 0x63, 0x95, 0xf3, 0x4a, 0x94, 0x97, 0x4e, 0x1a, 0xa8, 0xcb, 0x42, 0x48, 0x7b,
-	0x98, 0xfc, 0xea, 0xc2, 0xf0, 0x44, 0xdf, 0xe5, 0x83, 0xc1, 0xa8, 0x38, 0x27, 0xcf, 0xa0, 0xcd,
-	0x2a, 0x75, 0x1a, 0x34, 0x1e, 0x35, 0x9e, 0x0f, 0xa6, 0x24, 0xe2, 0x59, 0xf4, 0x6e, 0x2b, 0x96,
-	0x15, 0x5e, 0x63, 0xa6, 0x1d, 0x8a, 0x3e, 0x79, 0x02, 0x1d, 0x0c, 0x0d, 0x9a, 0x08, 0x8e, 0x0d,
-	0x88, 0x21, 0x5f, 0x8d, 0x4a, 0xad, 0x49, 0x5e, 0x40, 0x3b, 0xcb, 0xb9, 0x08, 0x5a, 0x08, 0xdd,
-	0x35, 0xd0, 0xbf, 0x5f, 0x8b, 0xbe, 0x68, 0x93, 0x22, 0x42, 0x5e, 0x42, 0x27, 0x4d, 0xb2, 0x44,
-	0x05, 0x6d, 0x64, 0xef, 0x5d, 0x63, 0x3f, 0x1b, 0x97, 0x5a, 0x88, 0x4c, 0xa1, 0x97, 0x6c, 0x96,
-	0x69, 0xa5, 0xb3, 0x3b, 0xc8, 0x07, 0xd7, 0xf8, 0x03, 0xeb, 0xd3, 0x1a, 0x0c, 0x7f, 0x36, 0xa0,
-	0x6d, 0x3e, 0x48, 0x7c, 0x68, 0xf1, 0x95, 0xc4, 0x11, 0x3d, 0x6a, 0x7e, 0x92, 0x37, 0xd0, 0xe7,
-	0x49, 0x29, 0x96, 0x66, 0x4a, 0x9c, 0x68, 0x3c, 0xdd, 0xbd, 0xf1, 0xb2, 0xd1, 0xbc, 0xc6, 0xe8,
-	0xdf, 0x7f, 0x4c, 0xa6, 0xd0, 0xbf, 0xd2, 0xc9, 0x10, 0xbc, 0xf7, 0x87, 0xf4, 0x64, 0x46, 0xe7,
-	0x47, 0xfe, 0x2d, 0x32, 0x82, 0xfe, 0xfe, 0xec, 0xed, 0x27, 0x7b, 0x6c, 0x10, 0x0f, 0xda, 0xfb,
-	0x87, 0xc7, 0x1f, 0xfd, 0x66, 0xf8, 0x03, 0x3a, 0x38, 0x11, 0xb9, 0x0f, 0xfd, 0x8c, 0x6d, 0x63,
-	0x2e, 0x0a, 0x57, 0x7b, 0x8b, 0x7a, 0x5a, 0x98, 0x9b, 0x33, 0x79, 0x0d, 0xe6, 0x77, 0x6c, 0x1e,
-	0xda, 0x35, 0xbd, 0x13, 0xd9, 0x2d, 0x88, 0xea, 0x2d, 0x88, 0xe6, 0x6e, 0x4b, 0x68, 0x4f, 0xa3,
-	0xc7, 0x9a, 0x24, 0x13, 0x18, 0x61, 0xa4, 0xde, 0x83, 0x58, 0x26, 0xdf, 0x6d, 0xff, 0x23, 0x3a,
-	0x30, 0xb1, 0x5a, 0x3b, 0xd2, 0x52, 0xf8, 0xbb, 0x09, 0x3d, 0x57, 0x11, 0x79, 0x00, 0x90, 0x2f,
-	0xbe, 0xe9, 0xfb, 0xc7, 0x09, 0xaf, 0x7b, 0xe9, 0x5b, 0xe5, 0x80, 0x4b, 0x63, 0xeb, 0xe7, 0x94,
-	0x0a, 0x03, 0xf1, 0x1a, 0xda, 0x46, 0xc5, 0xa4, 0x91, 0xc7, 0x30, 0x64, 0x4a, 0x89, 0xac, 0x70,
-	0x40, 0x0b, 0x81, 0x81, 0xd3, 0x10, 0x79, 0x0a, 0xe3, 0x1a, 0x29, 0x85, 0xac, 0x52, 0xfb, 0xca,
-	0x1e, 0x1d, 0x39, 0x95, 0xa2, 0xa8, 0xd7, 0xc5, 0x17, 0xdb, 0x42, 0x37, 0xc9, 0x63, 0x67, 0x48,
-	0x7c, 0x5e, 0x8f, 0xde, 0x76, 0xfa, 0xcc, 0xc9, 0x26, 0x71, 0x53, 0x65, 0xb1, 0xa8, 0x77, 0x53,
-	0x06, 0x5d, 0x9c, 0x71, 0xa4, 0xd5, 0xab, 0x85, 0x95, 0x64, 0x07, 0xbc, 0xd5, 0x05, 0x37, 0xe5,
-	0xca, 0xa0, 0x87, 0x49, 0x3d, 0x7d, 0xd6, 0xdd, 0x4a, 0xd3, 0xfb, 0x82, 0x2d, 0xcf, 0xac, 0xe7,
-	0xa1, 0xe7, 0x19, 0xc1, 0x98, 0x8b, 0x2e, 0xb6, 0xfb, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x81, 0x23, 0xf5, 0x35, 0xa9, 0x03, 0x00, 0x00,
+var fileDescriptor10 = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x92, 0x5d, 0x6f, 0xd3, 0x3e,
+	0x14, 0xc6, 0xff, 0x7d, 0x4f, 0x4e, 0x5f, 0xfe, 0xc1, 0x12, 0x28, 0x2b, 0x82, 0x41, 0x05, 0x88,
+	0x49, 0x28, 0x93, 0x0a, 0xb7, 0x5c, 0x74, 0x94, 0x97, 0x09, 0xd0, 0x84, 0x37, 0x69, 0x97, 0x91,
+	0x5b, 0xbb, 0x6d, 0x68, 0xdc, 0xa4, 0xb1, 0xa3, 0x76, 0xbb, 0xe0, 0x0b, 0x70, 0xcd, 0x15, 0x5f,
+	0x16, 0xf9, 0xd8, 0x19, 0x88, 0x71, 0x57, 0x3f, 0xcf, 0xaf, 0x4f, 0x7c, 0x1e, 0x1f, 0x08, 0x76,
+	0x2c, 0x5d, 0xc7, 0xcb, 0x82, 0xe5, 0xab, 0x28, 0x2f, 0x32, 0x9d, 0x91, 0x3a, 0x97, 0xc3, 0x87,
+	0xcb, 0x2c, 0x5b, 0xa6, 0xe2, 0x18, 0x95, 0x59, 0xb9, 0x38, 0xe6, 0x65, 0xc1, 0x74, 0x92, 0x6d,
+	0x2c, 0x33, 0x3c, 0xfc, 0xdb, 0xd7, 0x89, 0x14, 0x4a, 0x33, 0x99, 0x3b, 0x20, 0xc0, 0xc4, 0x98,
+	0x33, 0xcd, 0x9c, 0x72, 0xc7, 0x2a, 0xdb, 0x52, 0x14, 0x57, 0x4e, 0xea, 0xea, 0xab, 0x5c, 0x28,
+	0x7b, 0x18, 0xfd, 0x68, 0x43, 0xef, 0x92, 0xa5, 0xeb, 0xf7, 0x06, 0xa3, 0x62, 0x4b, 0x9e, 0x41,
+	0x93, 0x95, 0x7a, 0x15, 0xd6, 0x1e, 0xd5, 0x9e, 0x77, 0xc7, 0x24, 0xe2, 0x32, 0x7a, 0xbb, 0x17,
+	0xf3, 0x12, 0xaf, 0x31, 0x29, 0xf5, 0x8a, 0xa2, 0x4f, 0x9e, 0x40, 0x0b, 0x43, 0xc3, 0x3a, 0x82,
+	0x03, 0x03, 0x62, 0xc8, 0x17, 0xa3, 0x52, 0x6b, 0x92, 0x23, 0x68, 0xca, 0x8c, 0x8b, 0xb0, 0x81,
+	0xd0, 0x5d, 0x03, 0xfd, 0xf9, 0xb5, 0xe8, 0x73, 0xc6, 0x05, 0x45, 0x84, 0xbc, 0x80, 0x56, 0x9a,
+	0xc8, 0x44, 0x87, 0x4d, 0x64, 0xef, 0xdd, 0x62, 0x3f, 0x19, 0x97, 0x5a, 0x88, 0x8c, 0xa1, 0x93,
+	0x6c, 0xe6, 0x69, 0xc9, 0x45, 0xd8, 0x42, 0x3e, 0xbc, 0xc5, 0x9f, 0x5a, 0x9f, 0x56, 0xe0, 0xf0,
+	0x7b, 0x0d, 0x9a, 0xe6, 0x83, 0x24, 0x80, 0x06, 0x5f, 0x28, 0x1c, 0xd1, 0xa3, 0xe6, 0x27, 0x79,
+	0x0d, 0x3e, 0x4f, 0x0a, 0x31, 0x37, 0x53, 0xe2, 0x44, 0x83, 0xf1, 0xe1, 0x3f, 0x2f, 0x1b, 0x4d,
+	0x2b, 0x8c, 0xfe, 0xfe, 0xc7, 0x68, 0x0c, 0xfe, 0x8d, 0x4e, 0x7a, 0xe0, 0xbd, 0x3b, 0xa3, 0x97,
+	0x13, 0x3a, 0x3d, 0x0f, 0xfe, 0x23, 0x7d, 0xf0, 0x4f, 0x26, 0x6f, 0x3e, 0xda, 0x63, 0x8d, 0x78,
+	0xd0, 0x3c, 0x39, 0xbb, 0xf8, 0x10, 0xd4, 0x87, 0xdf, 0xa0, 0x85, 0x13, 0x91, 0xfb, 0xe0, 0x4b,
+	0xb6, 0x8f, 0xb9, 0xc8, 0x5d, 0xed, 0x0d, 0xea, 0x49, 0xb6, 0x9f, 0x9a, 0x33, 0x79, 0x05, 0xe6,
+	0x77, 0x6c, 0x1e, 0xda, 0x35, 0x7d, 0x10, 0xd9, 0x2d, 0x88, 0xaa, 0x2d, 0x88, 0xa6, 0x6e, 0x4b,
+	0x68, 0x47, 0xb2, 0xfd, 0x45, 0x22, 0x05, 0x19, 0x41, 0x1f, 0x23, 0x99, 0x66, 0xb1, 0x4a, 0xae,
+	0x6d, 0xff, 0x7d, 0xda, 0x35, 0xb1, 0x4c, 0xb3, 0xf3, 0xe4, 0x5a, 0x0c, 0x7f, 0xd6, 0xa1, 0xe3,
+	0x2a, 0x22, 0x0f, 0x00, 0xb2, 0xd9, 0x57, 0x31, 0xd7, 0x71, 0xc2, 0xab, 0x5e, 0x7c, 0xab, 0x9c,
+	0x72, 0x65, 0xec, 0x6d, 0x29, 0x94, 0xc6, 0x40, 0xbc, 0x86, 0x47, 0x7d, 0x54, 0x4c, 0x1a, 0x79,
+	0x0c, 0x3d, 0xa6, 0xb5, 0x90, 0xb9, 0x03, 0x1a, 0x08, 0x74, 0x9d, 0x86, 0xc8, 0x53, 0x18, 0x54,
+	0x48, 0x21, 0x54, 0x99, 0xda, 0x57, 0xf6, 0x68, 0xdf, 0xa9, 0x14, 0x45, 0x72, 0x04, 0x81, 0xd8,
+	0xe7, 0x49, 0x21, 0x78, 0xec, 0x0c, 0x85, 0xcf, 0xeb, 0xd1, 0xff, 0x9d, 0x3e, 0x71, 0xb2, 0x49,
+	0xdc, 0x94, 0x32, 0x16, 0xd5, 0x6e, 0xaa, 0xb0, 0x8d, 0x33, 0xf6, 0x37, 0xa5, 0xbc, 0x59, 0x58,
+	0x45, 0x0e, 0xc0, 0x5b, 0xec, 0xb8, 0x29, 0x57, 0x85, 0x1d, 0x4c, 0xea, 0x2c, 0x76, 0x7c, 0x2a,
+	0x72, 0x65, 0x7a, 0x9f, 0xb1, 0xf9, 0xda, 0x7a, 0x1e, 0x7a, 0x9e, 0x11, 0x8c, 0x39, 0x6b, 0x63,
+	0xbb, 0x2f, 0x7f, 0x0d, 0x00, 0x81, 0x23, 0xf5, 0x35, 0xa9, 0x03, 0x00, 0x00,
 }
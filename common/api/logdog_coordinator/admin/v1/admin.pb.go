@@ -10,6 +10,8 @@ It is generated from these files:
 
 It has these top-level messages:
 	SetConfigRequest
+	PurgeReportRequest
+	PurgeReportResponse
 */
 package logdog
 
@@ -20,6 +22,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import google_protobuf "github.com/luci/luci-go/common/proto/google"
+import google_protobuf1 "github.com/luci/luci-go/common/proto/google"
 
 import (
 	context "golang.org/x/net/context"
@@ -61,8 +64,73 @@ func (m *SetConfigRequest) String() string            { return proto.CompactText
 func (*SetConfigRequest) ProtoMessage()               {}
 func (*SetConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// PurgeReportRequest is the request message for the PurgeReport RPC.
+type PurgeReportRequest struct {
+	// The project to report on.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The maximum number of log streams to report. If zero, a default maximum
+	// will be used.
+	MaxResults int32 `protobuf:"varint,2,opt,name=max_results,json=maxResults" json:"max_results,omitempty"`
+	// If not empty, continue the report from this cursor, returned as "next" in
+	// a previous PurgeReportResponse.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *PurgeReportRequest) Reset()                    { *m = PurgeReportRequest{} }
+func (m *PurgeReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeReportRequest) ProtoMessage()               {}
+func (*PurgeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// PurgeReportResponse is the response message for the PurgeReport RPC.
+type PurgeReportResponse struct {
+	// The log streams that would be purged.
+	Streams []*PurgeReportResponse_Stream `protobuf:"bytes,1,rep,name=streams" json:"streams,omitempty"`
+	// If not empty, there may be more log streams to report. Pass it as "cursor"
+	// in the next PurgeReportRequest to continue.
+	Next string `protobuf:"bytes,2,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *PurgeReportResponse) Reset()                    { *m = PurgeReportResponse{} }
+func (m *PurgeReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeReportResponse) ProtoMessage()               {}
+func (*PurgeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *PurgeReportResponse) GetStreams() []*PurgeReportResponse_Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// Stream describes a single log stream that is eligible to be purged.
+type PurgeReportResponse_Stream struct {
+	// The log stream's path.
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	// The name of the retention policy that applies to this log stream.
+	Policy string `protobuf:"bytes,2,opt,name=policy" json:"policy,omitempty"`
+	// The time when the log stream was created.
+	Created *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=created" json:"created,omitempty"`
+	// The Google Storage URLs of the archived data that would be deleted.
+	ArchiveUrl []string `protobuf:"bytes,4,rep,name=archive_url,json=archiveUrl" json:"archive_url,omitempty"`
+}
+
+func (m *PurgeReportResponse_Stream) Reset()                    { *m = PurgeReportResponse_Stream{} }
+func (m *PurgeReportResponse_Stream) String() string            { return proto.CompactTextString(m) }
+func (*PurgeReportResponse_Stream) ProtoMessage()               {}
+func (*PurgeReportResponse_Stream) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
+func (m *PurgeReportResponse_Stream) GetCreated() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SetConfigRequest)(nil), "logdog.SetConfigRequest")
+	proto.RegisterType((*PurgeReportRequest)(nil), "logdog.PurgeReportRequest")
+	proto.RegisterType((*PurgeReportResponse)(nil), "logdog.PurgeReportResponse")
+	proto.RegisterType((*PurgeReportResponse_Stream)(nil), "logdog.PurgeReportResponse.Stream")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -79,6 +147,9 @@ type AdminClient interface {
 	// SetConfig loads the supplied configuration into a config.GlobalConfig
 	// instance.
 	SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// PurgeReport returns the set of log streams that would be purged by the
+	// project's retention policies, without actually purging them.
+	PurgeReport(ctx context.Context, in *PurgeReportRequest, opts ...grpc.CallOption) (*PurgeReportResponse, error)
 }
 type adminPRPCClient struct {
 	client *prpccommon.Client
@@ -97,6 +168,15 @@ func (c *adminPRPCClient) SetConfig(ctx context.Context, in *SetConfigRequest, o
 	return out, nil
 }
 
+func (c *adminPRPCClient) PurgeReport(ctx context.Context, in *PurgeReportRequest, opts ...grpc.CallOption) (*PurgeReportResponse, error) {
+	out := new(PurgeReportResponse)
+	err := c.client.Call(ctx, "logdog.Admin", "PurgeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type adminClient struct {
 	cc *grpc.ClientConn
 }
@@ -114,12 +194,24 @@ func (c *adminClient) SetConfig(ctx context.Context, in *SetConfigRequest, opts
 	return out, nil
 }
 
+func (c *adminClient) PurgeReport(ctx context.Context, in *PurgeReportRequest, opts ...grpc.CallOption) (*PurgeReportResponse, error) {
+	out := new(PurgeReportResponse)
+	err := grpc.Invoke(ctx, "/logdog.Admin/PurgeReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Admin service
 
 type AdminServer interface {
 	// SetConfig loads the supplied configuration into a config.GlobalConfig
 	// instance.
 	SetConfig(context.Context, *SetConfigRequest) (*google_protobuf.Empty, error)
+	// PurgeReport returns the set of log streams that would be purged by the
+	// project's retention policies, without actually purging them.
+	PurgeReport(context.Context, *PurgeReportRequest) (*PurgeReportResponse, error)
 }
 
 func RegisterAdminServer(s prpc.Registrar, srv AdminServer) {
@@ -144,6 +236,24 @@ func _Admin_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_PurgeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PurgeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Admin/PurgeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PurgeReport(ctx, req.(*PurgeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -152,25 +262,41 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetConfig",
 			Handler:    _Admin_SetConfig_Handler,
 		},
+		{
+			MethodName: "PurgeReport",
+			Handler:    _Admin_PurgeReport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
 
 var fileDescriptor0 = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4b, 0xc3, 0x40,
-	0x10, 0x85, 0x89, 0x62, 0x21, 0x53, 0x0f, 0x65, 0x0e, 0xb2, 0x56, 0x45, 0xf1, 0xe4, 0x41, 0xb6,
-	0xa0, 0x67, 0x91, 0x22, 0x7a, 0xf0, 0x24, 0x29, 0x9e, 0x43, 0x9a, 0x4c, 0xd7, 0x48, 0x9a, 0x89,
-	0x9b, 0x59, 0xc1, 0x9f, 0xe7, 0x3f, 0x33, 0xdd, 0xdd, 0xe6, 0xe0, 0x71, 0xde, 0xfb, 0x86, 0xc7,
-	0x7b, 0x30, 0x2d, 0xaa, 0x6d, 0xdd, 0xea, 0xce, 0xb2, 0x30, 0x4e, 0x1a, 0x36, 0x15, 0x9b, 0xf9,
-	0x99, 0x61, 0x36, 0x0d, 0x2d, 0xbc, 0xba, 0x76, 0x9b, 0x05, 0x6d, 0x3b, 0xf9, 0x09, 0xd0, 0xf5,
-	0x6f, 0x02, 0xb3, 0x15, 0xc9, 0x13, 0xb7, 0x9b, 0xda, 0x64, 0xf4, 0xe5, 0xa8, 0x17, 0xbc, 0x05,
-	0x2c, 0xbd, 0x90, 0xf7, 0x64, 0xbf, 0xeb, 0x92, 0x72, 0x67, 0x1b, 0x95, 0x5c, 0x25, 0x37, 0x69,
-	0x36, 0x0b, 0xce, 0x2a, 0x18, 0xef, 0xb6, 0xc1, 0x0b, 0x80, 0x91, 0x16, 0x75, 0xe0, 0xa9, 0x74,
-	0x4f, 0x09, 0x5e, 0xc2, 0x34, 0xda, 0x5d, 0x21, 0x1f, 0xea, 0xd0, 0xfb, 0xf1, 0xe3, 0x6d, 0x50,
-	0xf0, 0x11, 0xce, 0x7b, 0x61, 0x5b, 0x18, 0x1a, 0xe3, 0x8a, 0xb2, 0x64, 0xd7, 0x4a, 0xfe, 0xd9,
-	0x73, 0xab, 0xaa, 0xe1, 0xe3, 0x38, 0x3b, 0x8d, 0x4c, 0x0c, 0x5e, 0x06, 0xe2, 0x75, 0x00, 0xee,
-	0x5e, 0xe0, 0x68, 0xb9, 0xeb, 0x8d, 0x0f, 0x90, 0x8e, 0x5d, 0x50, 0xe9, 0xd0, 0x5f, 0xff, 0xaf,
-	0x37, 0x3f, 0xd1, 0x61, 0x11, 0xbd, 0x5f, 0x44, 0x3f, 0xef, 0x16, 0x59, 0x4f, 0xfc, 0x7d, 0xff,
-	0x17, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xb4, 0x6c, 0x90, 0x46, 0x01, 0x00, 0x00,
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x95, 0x49, 0x9b, 0x28, 0x63, 0x0e, 0xd5, 0x22, 0x55, 0xc6, 0x05, 0x35, 0xca, 0x29, 0x07,
+	0xe4, 0x4a, 0x81, 0x23, 0x08, 0x55, 0x08, 0x0e, 0x9c, 0x2a, 0x87, 0x9e, 0xa3, 0xed, 0x66, 0xea,
+	0xb8, 0xb2, 0x3d, 0xcb, 0xee, 0xb8, 0x4a, 0x7f, 0x03, 0x12, 0xff, 0x89, 0x1f, 0xc5, 0x1d, 0x79,
+	0x3f, 0x22, 0x28, 0x51, 0x6f, 0xbb, 0xf3, 0xde, 0xee, 0xbc, 0x79, 0xf3, 0x20, 0x95, 0x9b, 0xb6,
+	0xee, 0x0a, 0x6d, 0x88, 0x49, 0x8c, 0x1b, 0xaa, 0x36, 0x54, 0xe5, 0x67, 0x15, 0x51, 0xd5, 0xe0,
+	0x85, 0xab, 0xde, 0xf4, 0xb7, 0x17, 0xd8, 0x6a, 0x7e, 0xf0, 0xa4, 0xfc, 0xfc, 0x31, 0xc8, 0x75,
+	0x8b, 0x96, 0x65, 0xab, 0x3d, 0x61, 0xfe, 0x2b, 0x81, 0x93, 0x15, 0xf2, 0x27, 0xea, 0x6e, 0xeb,
+	0xaa, 0xc4, 0xef, 0x3d, 0x5a, 0x16, 0x6f, 0x40, 0x28, 0x57, 0x58, 0x5b, 0x34, 0xf7, 0xb5, 0xc2,
+	0x75, 0x6f, 0x9a, 0x2c, 0x99, 0x25, 0x8b, 0x69, 0x79, 0xe2, 0x91, 0x95, 0x07, 0xae, 0x4d, 0x23,
+	0x5e, 0x03, 0xec, 0xd9, 0x9c, 0x3d, 0x73, 0xac, 0x69, 0x64, 0xb1, 0x38, 0x87, 0x34, 0xc0, 0x5a,
+	0xf2, 0x36, 0x1b, 0x39, 0x3c, 0xbc, 0xb8, 0x92, 0xbc, 0x15, 0x1f, 0xe1, 0x95, 0x65, 0x32, 0xb2,
+	0xc2, 0x7d, 0x3b, 0xa9, 0x14, 0xf5, 0x1d, 0xaf, 0xef, 0x2c, 0x75, 0xd9, 0x66, 0x96, 0x2c, 0x9e,
+	0x97, 0x2f, 0x03, 0x27, 0x34, 0xbe, 0xf4, 0x8c, 0xaf, 0x96, 0xba, 0x79, 0x05, 0xe2, 0xaa, 0x37,
+	0x15, 0x96, 0xa8, 0xc9, 0x70, 0x1c, 0x22, 0x83, 0x89, 0x36, 0x74, 0x87, 0x8a, 0x83, 0xf2, 0x78,
+	0x1d, 0x14, 0xb5, 0x72, 0xb7, 0x36, 0x68, 0xfb, 0x86, 0xad, 0x53, 0x7c, 0x5c, 0x42, 0x2b, 0x77,
+	0xa5, 0xaf, 0x88, 0x53, 0x18, 0xab, 0xde, 0x58, 0x32, 0x41, 0x6d, 0xb8, 0xcd, 0x7f, 0x27, 0xf0,
+	0xe2, 0x9f, 0x4e, 0x56, 0x53, 0x67, 0x51, 0xbc, 0x87, 0x89, 0x65, 0x83, 0xb2, 0xb5, 0x59, 0x32,
+	0x1b, 0x2d, 0xd2, 0xe5, 0xbc, 0xf0, 0xcb, 0x29, 0x0e, 0xb0, 0x8b, 0x95, 0xa3, 0x96, 0xf1, 0x89,
+	0x10, 0x70, 0xd4, 0xe1, 0x2e, 0x3a, 0xe7, 0xce, 0xf9, 0x8f, 0x04, 0xc6, 0x9e, 0x37, 0xc0, 0xce,
+	0x38, 0x3f, 0x84, 0x3b, 0x0f, 0x02, 0x35, 0x35, 0xb5, 0x7a, 0x08, 0x8f, 0xc2, 0x4d, 0xbc, 0x83,
+	0x89, 0x32, 0x28, 0x19, 0x37, 0x4e, 0x79, 0xba, 0xcc, 0x0b, 0x1f, 0x80, 0x22, 0x06, 0xa0, 0xf8,
+	0x16, 0x03, 0x50, 0x46, 0xea, 0xe0, 0x87, 0x34, 0x6a, 0x5b, 0xdf, 0xfb, 0x3d, 0x1f, 0xcd, 0x46,
+	0xc3, 0x86, 0x42, 0xe9, 0xda, 0x34, 0xcb, 0x9f, 0x09, 0x1c, 0x5f, 0x0e, 0xd1, 0x13, 0x1f, 0x60,
+	0xba, 0x4f, 0x8b, 0xc8, 0xe2, 0x94, 0x8f, 0x03, 0x94, 0x9f, 0xfe, 0xd7, 0xf6, 0xf3, 0x10, 0x4a,
+	0xf1, 0x05, 0xd2, 0xbf, 0x1c, 0x11, 0xf9, 0x41, 0x9b, 0xfc, 0x17, 0x67, 0x4f, 0x58, 0x78, 0x33,
+	0x76, 0xff, 0xbe, 0xfd, 0x33, 0x00, 0x08, 0x90, 0x90, 0x75, 0x11, 0x03, 0x00, 0x00,
 }
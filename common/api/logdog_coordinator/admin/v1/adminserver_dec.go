@@ -24,3 +24,11 @@ func (s *DecoratedAdmin) SetConfig(c context.Context, req *SetConfigRequest) (*g
 	}
 	return s.Service.SetConfig(c, req)
 }
+
+func (s *DecoratedAdmin) PurgeReport(c context.Context, req *PurgeReportRequest) (*PurgeReportResponse, error) {
+	c, err := s.Prelude(c, "PurgeReport", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.PurgeReport(c, req)
+}
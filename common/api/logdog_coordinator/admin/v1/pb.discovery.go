@@ -10,173 +10,328 @@ func init() {
 			"logdog.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 164, 87, 75, 115, 219, 200,
-			241, 39, 192, 135, 164, 89, 219, 107, 115, 245, 151, 181, 180, 173,
-			237, 149, 188, 150, 108, 83, 212, 254, 237, 170, 84, 202, 174, 60,
-			64, 18, 146, 224, 165, 72, 6, 0, 173, 232, 100, 131, 4, 72,
-			193, 69, 98, 24, 60, 228, 85, 182, 246, 146, 47, 146, 84, 78,
-			201, 94, 114, 203, 45, 135, 61, 166, 42, 231, 124, 132, 228, 152,
-			107, 170, 114, 73, 247, 204, 128, 146, 229, 221, 228, 16, 149, 74,
-			66, 99, 122, 126, 253, 235, 199, 76, 55, 216, 159, 254, 143, 221,
-			153, 112, 62, 153, 6, 123, 243, 152, 167, 124, 152, 141, 247, 130,
-			217, 60, 61, 111, 8, 177, 250, 161, 92, 108, 228, 139, 155, 75,
-			172, 108, 210, 122, 179, 207, 62, 26, 241, 89, 227, 202, 122, 147,
-			137, 213, 62, 137, 125, 237, 55, 154, 246, 79, 77, 251, 173, 94,
-			60, 232, 55, 191, 209, 55, 14, 164, 110, 95, 233, 54, 142, 131,
-			233, 244, 139, 136, 191, 141, 220, 243, 121, 144, 188, 248, 253, 42,
-			171, 84, 75, 27, 133, 167, 55, 217, 95, 174, 49, 237, 90, 181,
-			184, 81, 168, 62, 249, 246, 26, 136, 13, 35, 62, 133, 102, 54,
-			30, 7, 113, 2, 187, 32, 161, 182, 19, 240, 189, 212, 131, 48,
-			74, 131, 120, 116, 234, 69, 147, 0, 198, 60, 158, 121, 41, 131,
-			22, 159, 159, 199, 225, 228, 52, 133, 39, 159, 127, 254, 67, 181,
-			1, 172, 104, 212, 0, 48, 166, 83, 16, 107, 9, 196, 65, 18,
-			196, 103, 129, 223, 96, 112, 154, 166, 243, 228, 217, 222, 158, 31,
-			156, 5, 83, 62, 71, 67, 185, 119, 232, 168, 12, 15, 146, 216,
-			29, 74, 18, 123, 140, 129, 29, 248, 97, 146, 198, 225, 48, 75,
-			67, 30, 129, 23, 249, 144, 37, 1, 210, 129, 132, 103, 241, 40,
-			16, 111, 134, 97, 228, 197, 231, 130, 87, 82, 135, 183, 97, 122,
-			10, 60, 22, 255, 121, 134, 60, 103, 220, 15, 199, 225, 200, 35,
-			132, 58, 120, 113, 0, 104, 121, 22, 166, 105, 224, 3, 218, 60,
-			11, 125, 124, 72, 79, 189, 20, 255, 144, 119, 211, 41, 127, 27,
-			70, 19, 24, 241, 200, 15, 105, 83, 66, 155, 16, 39, 72, 159,
-			33, 37, 250, 121, 116, 133, 88, 2, 124, 156, 51, 26, 113, 63,
-			128, 89, 150, 164, 232, 121, 234, 33, 83, 66, 245, 134, 252, 140,
-			150, 84, 196, 24, 68, 60, 13, 71, 65, 29, 23, 195, 4, 166,
-			8, 69, 8, 151, 45, 70, 254, 21, 58, 104, 111, 52, 245, 194,
-			89, 16, 55, 190, 143, 4, 26, 187, 20, 139, 156, 4, 250, 232,
-			103, 72, 108, 193, 131, 93, 16, 249, 159, 120, 48, 80, 222, 249,
-			124, 148, 205, 130, 40, 245, 242, 36, 237, 97, 252, 57, 174, 196,
-			128, 149, 18, 196, 161, 55, 77, 46, 66, 45, 18, 132, 139, 12,
-			46, 179, 95, 56, 213, 13, 66, 177, 147, 128, 35, 111, 22, 16,
-			161, 203, 181, 21, 241, 139, 53, 17, 247, 48, 77, 200, 163, 72,
-			66, 113, 172, 222, 153, 119, 14, 195, 128, 42, 5, 201, 115, 8,
-			34, 31, 223, 6, 84, 20, 72, 98, 198, 211, 0, 100, 76, 176,
-			58, 125, 100, 135, 197, 9, 99, 92, 96, 50, 10, 9, 31, 167,
-			111, 169, 76, 84, 5, 65, 50, 15, 70, 84, 65, 184, 43, 164,
-			194, 138, 169, 118, 34, 89, 69, 73, 34, 184, 51, 112, 15, 45,
-			7, 156, 222, 190, 123, 108, 216, 38, 224, 115, 223, 238, 189, 180,
-			218, 102, 27, 154, 39, 184, 104, 66, 171, 215, 63, 177, 173, 131,
-			67, 23, 14, 123, 157, 182, 105, 59, 96, 116, 219, 248, 182, 235,
-			218, 86, 115, 224, 246, 108, 135, 193, 166, 225, 224, 214, 77, 177,
-			98, 116, 79, 192, 252, 121, 223, 54, 29, 7, 122, 54, 88, 71,
-			253, 142, 133, 104, 8, 111, 27, 93, 215, 50, 157, 58, 88, 221,
-			86, 103, 208, 182, 186, 7, 117, 64, 4, 232, 246, 92, 6, 29,
-			235, 200, 114, 81, 207, 237, 213, 133, 217, 247, 247, 65, 111, 31,
-			142, 76, 187, 117, 136, 162, 209, 180, 58, 150, 123, 34, 12, 238,
-			91, 110, 151, 140, 237, 247, 108, 6, 6, 244, 13, 219, 181, 90,
-			131, 142, 97, 67, 127, 96, 247, 123, 142, 9, 228, 89, 219, 114,
-			90, 29, 195, 58, 50, 219, 13, 180, 143, 54, 193, 124, 105, 118,
-			93, 112, 14, 141, 78, 231, 93, 71, 25, 244, 142, 187, 166, 77,
-			236, 47, 187, 9, 77, 19, 89, 26, 205, 142, 73, 166, 132, 159,
-			109, 203, 54, 91, 46, 57, 116, 241, 212, 194, 224, 33, 193, 78,
-			157, 129, 211, 55, 91, 22, 62, 97, 60, 76, 116, 199, 176, 79,
-			234, 10, 212, 49, 127, 54, 64, 45, 92, 132, 182, 113, 100, 28,
-			160, 119, 59, 255, 45, 42, 152, 152, 214, 192, 54, 143, 136, 53,
-			134, 194, 25, 52, 29, 215, 114, 7, 174, 9, 7, 189, 94, 91,
-			4, 219, 49, 237, 151, 86, 203, 116, 158, 67, 167, 231, 136, 128,
-			13, 28, 19, 137, 180, 13, 215, 16, 166, 17, 3, 195, 133, 235,
-			248, 220, 28, 56, 150, 8, 156, 213, 117, 77, 219, 30, 244, 93,
-			171, 215, 125, 136, 89, 62, 198, 200, 32, 75, 3, 247, 182, 69,
-			132, 123, 93, 242, 150, 106, 197, 236, 217, 39, 4, 75, 113, 16,
-			25, 168, 195, 241, 161, 137, 239, 109, 10, 170, 136, 150, 65, 97,
-			112, 48, 106, 45, 247, 178, 26, 26, 196, 32, 162, 75, 23, 126,
-			66, 215, 60, 232, 88, 7, 102, 183, 101, 210, 114, 143, 96, 142,
-			45, 199, 124, 136, 9, 179, 28, 82, 176, 132, 97, 172, 1, 52,
-			58, 16, 94, 83, 162, 144, 23, 147, 207, 151, 74, 183, 46, 242,
-			9, 214, 62, 24, 237, 151, 22, 49, 87, 218, 88, 1, 142, 165,
-			202, 69, 132, 173, 117, 168, 98, 142, 229, 191, 204, 52, 189, 90,
-			132, 229, 219, 244, 180, 92, 45, 110, 22, 158, 179, 15, 88, 105,
-			249, 239, 75, 5, 41, 92, 99, 101, 18, 80, 105, 115, 233, 54,
-			187, 206, 42, 66, 42, 72, 241, 6, 91, 146, 162, 38, 101, 165,
-			188, 132, 82, 237, 153, 66, 220, 42, 212, 21, 162, 38, 5, 169,
-			68, 102, 183, 150, 62, 82, 136, 26, 33, 146, 40, 17, 53, 129,
-			72, 178, 82, 70, 196, 173, 181, 199, 10, 241, 126, 225, 177, 66,
-			212, 165, 32, 149, 116, 146, 150, 238, 40, 68, 157, 16, 73, 148,
-			136, 186, 64, 36, 89, 41, 35, 226, 253, 141, 71, 10, 241, 179,
-			194, 166, 66, 44, 74, 65, 42, 21, 17, 241, 179, 165, 154, 66,
-			44, 18, 34, 137, 18, 177, 40, 16, 73, 86, 202, 180, 245, 222,
-			167, 10, 241, 193, 194, 235, 146, 20, 164, 82, 9, 17, 31, 44,
-			221, 87, 136, 37, 66, 36, 81, 34, 150, 4, 34, 201, 74, 25,
-			17, 31, 108, 231, 94, 111, 23, 62, 85, 136, 101, 41, 72, 165,
-			50, 34, 110, 47, 173, 43, 196, 50, 33, 146, 40, 17, 203, 2,
-			145, 100, 165, 140, 94, 111, 223, 1, 133, 184, 83, 248, 68, 33,
-			86, 164, 32, 149, 42, 136, 184, 179, 200, 117, 133, 16, 119, 22,
-			185, 174, 8, 196, 157, 69, 174, 43, 200, 113, 167, 182, 193, 254,
-			165, 51, 189, 132, 154, 79, 11, 55, 107, 255, 208, 241, 22, 154,
-			4, 17, 222, 208, 35, 16, 35, 19, 182, 224, 36, 241, 38, 129,
-			236, 214, 231, 60, 131, 145, 23, 97, 135, 219, 165, 153, 0, 47,
-			122, 239, 140, 135, 62, 94, 233, 227, 48, 18, 157, 42, 155, 79,
-			169, 239, 7, 62, 123, 119, 191, 232, 148, 184, 61, 6, 163, 111,
-			37, 13, 52, 147, 158, 207, 81, 115, 10, 193, 151, 222, 108, 142,
-			157, 6, 219, 0, 226, 137, 81, 35, 5, 47, 17, 13, 39, 14,
-			126, 145, 5, 9, 246, 110, 213, 128, 112, 176, 153, 99, 139, 12,
-			104, 179, 232, 82, 200, 5, 241, 104, 78, 56, 229, 126, 3, 246,
-			81, 47, 140, 146, 212, 139, 70, 65, 62, 56, 208, 40, 132, 253,
-			22, 215, 56, 124, 37, 95, 1, 196, 243, 17, 52, 189, 120, 231,
-			202, 164, 215, 16, 131, 222, 67, 26, 35, 178, 24, 91, 241, 247,
-			172, 63, 151, 48, 95, 83, 15, 66, 86, 47, 28, 188, 101, 168,
-			233, 227, 216, 149, 119, 100, 156, 6, 224, 181, 208, 126, 77, 158,
-			201, 88, 8, 69, 62, 124, 19, 140, 82, 120, 253, 213, 215, 175,
-			241, 20, 51, 86, 44, 81, 94, 158, 46, 95, 31, 86, 132, 153,
-			167, 236, 15, 215, 217, 7, 158, 63, 11, 35, 53, 175, 86, 166,
-			124, 226, 243, 73, 237, 63, 13, 181, 155, 223, 106, 236, 166, 19,
-			164, 45, 30, 141, 195, 137, 45, 227, 86, 173, 179, 234, 72, 188,
-			120, 165, 162, 240, 42, 139, 167, 235, 26, 104, 59, 43, 246, 77,
-			185, 226, 200, 133, 65, 60, 173, 222, 99, 108, 161, 157, 174, 235,
-			66, 107, 37, 215, 74, 171, 88, 114, 106, 121, 238, 165, 167, 235,
-			69, 177, 174, 118, 244, 241, 77, 245, 39, 236, 110, 130, 195, 0,
-			102, 123, 97, 206, 27, 141, 120, 22, 165, 175, 222, 36, 60, 90,
-			247, 113, 199, 53, 251, 99, 165, 163, 12, 27, 82, 227, 5, 42,
-			60, 217, 103, 101, 131, 252, 174, 254, 136, 173, 44, 124, 169, 174,
-			55, 164, 255, 141, 171, 238, 213, 214, 174, 78, 234, 50, 63, 47,
-			254, 198, 104, 232, 46, 21, 238, 107, 236, 27, 77, 12, 221, 88,
-			224, 79, 126, 173, 189, 51, 63, 255, 255, 15, 68, 242, 90, 167,
-			56, 134, 132, 217, 12, 140, 12, 107, 40, 166, 210, 252, 206, 65,
-			122, 144, 136, 130, 83, 227, 202, 197, 216, 137, 226, 4, 7, 188,
-			56, 194, 137, 102, 120, 14, 30, 52, 157, 246, 110, 146, 158, 99,
-			69, 227, 73, 8, 68, 177, 210, 209, 161, 99, 51, 196, 1, 108,
-			140, 222, 250, 249, 20, 215, 193, 142, 135, 13, 21, 198, 33, 58,
-			193, 216, 10, 211, 139, 120, 18, 43, 120, 143, 200, 219, 125, 121,
-			249, 6, 251, 171, 38, 206, 103, 233, 70, 225, 158, 86, 251, 179,
-			6, 7, 83, 62, 244, 166, 50, 16, 226, 208, 16, 14, 159, 180,
-			249, 4, 221, 227, 177, 143, 211, 40, 6, 24, 38, 66, 13, 100,
-			122, 178, 216, 75, 243, 161, 137, 28, 160, 95, 252, 188, 136, 124,
-			57, 173, 121, 35, 113, 226, 144, 33, 214, 47, 142, 178, 115, 142,
-			171, 13, 17, 158, 153, 247, 134, 227, 240, 117, 46, 125, 15, 222,
-			197, 99, 56, 175, 97, 176, 112, 238, 163, 156, 6, 194, 45, 15,
-			54, 167, 217, 40, 220, 149, 138, 155, 139, 227, 167, 8, 47, 50,
-			117, 81, 250, 55, 150, 215, 217, 175, 52, 134, 249, 162, 11, 235,
-			150, 14, 181, 76, 105, 231, 181, 105, 119, 114, 71, 233, 176, 147,
-			168, 216, 12, 61, 12, 239, 119, 218, 195, 73, 105, 172, 174, 160,
-			186, 28, 152, 131, 113, 230, 77, 211, 5, 31, 66, 201, 217, 211,
-			212, 138, 132, 174, 179, 50, 113, 40, 85, 75, 183, 244, 27, 162,
-			57, 144, 88, 38, 78, 203, 185, 132, 124, 111, 173, 220, 201, 37,
-			188, 66, 111, 109, 124, 194, 190, 16, 228, 113, 105, 85, 95, 175,
-			253, 120, 65, 62, 205, 89, 231, 51, 245, 123, 1, 68, 54, 41,
-			37, 96, 202, 61, 57, 15, 47, 88, 104, 200, 98, 85, 191, 5,
-			202, 146, 86, 38, 240, 156, 133, 70, 166, 86, 62, 202, 37, 100,
-			177, 186, 118, 155, 185, 130, 5, 86, 205, 154, 254, 113, 237, 64,
-			177, 160, 131, 153, 211, 160, 99, 155, 211, 72, 131, 47, 211, 221,
-			68, 124, 39, 132, 191, 196, 204, 189, 75, 235, 82, 150, 36, 29,
-			29, 233, 172, 233, 171, 235, 202, 164, 94, 38, 43, 57, 29, 242,
-			124, 109, 101, 53, 151, 144, 206, 218, 237, 117, 246, 71, 153, 82,
-			148, 238, 234, 143, 107, 191, 211, 48, 33, 244, 233, 147, 231, 68,
-			113, 202, 211, 161, 46, 10, 121, 69, 210, 113, 144, 31, 193, 226,
-			232, 92, 78, 19, 93, 172, 56, 157, 202, 43, 132, 118, 97, 123,
-			17, 101, 221, 107, 247, 118, 252, 232, 205, 195, 103, 248, 137, 54,
-			163, 47, 63, 113, 82, 249, 92, 248, 195, 35, 170, 192, 41, 207,
-			124, 104, 134, 19, 215, 27, 34, 254, 41, 86, 252, 40, 230, 73,
-			178, 139, 222, 138, 43, 217, 104, 117, 146, 133, 199, 69, 244, 248,
-			174, 190, 246, 177, 242, 170, 88, 38, 63, 150, 114, 9, 61, 190,
-			187, 124, 63, 151, 200, 199, 237, 71, 236, 132, 233, 21, 60, 168,
-			128, 247, 78, 237, 8, 196, 77, 182, 240, 47, 20, 199, 75, 220,
-			234, 244, 5, 134, 97, 62, 187, 112, 30, 15, 162, 56, 114, 162,
-			107, 188, 127, 150, 229, 89, 169, 80, 237, 193, 242, 117, 246, 138,
-			149, 42, 226, 168, 108, 233, 205, 26, 14, 206, 249, 173, 40, 170,
-			72, 69, 53, 155, 99, 31, 126, 47, 173, 104, 2, 15, 187, 122,
-			217, 184, 124, 145, 176, 69, 219, 68, 99, 232, 85, 69, 150, 250,
-			86, 229, 195, 92, 162, 161, 239, 38, 228, 18, 122, 188, 245, 248,
-			167, 121, 199, 250, 119, 0, 0, 0, 255, 255, 65, 232, 119, 222,
-			114, 17, 0, 0},
+			8, 0, 0, 9, 110, 136, 0, 255, 236, 122, 205, 111, 28, 201,
+			117, 248, 244, 244, 136, 34, 75, 187, 43, 109, 235, 139, 158, 181,
+			118, 159, 71, 43, 115, 198, 26, 246, 240, 67, 210, 66, 148, 119,
+			127, 63, 146, 250, 240, 200, 20, 69, 240, 35, 235, 149, 96, 72,
+			53, 221, 53, 51, 181, 219, 83, 213, 169, 170, 230, 144, 171, 200,
+			70, 2, 196, 151, 0, 62, 229, 152, 83, 78, 129, 17, 32, 167,
+			36, 8, 114, 113, 144, 83, 16, 32, 71, 35, 127, 64, 254, 133,
+			0, 57, 6, 175, 170, 123, 62, 40, 106, 215, 54, 144, 155, 5,
+			10, 232, 87, 245, 170, 222, 103, 189, 247, 234, 213, 144, 63, 37,
+			228, 131, 158, 148, 189, 132, 181, 82, 37, 141, 236, 100, 221, 22,
+			27, 164, 230, 56, 180, 96, 112, 222, 77, 134, 197, 100, 237, 44,
+			57, 243, 0, 231, 55, 94, 147, 139, 145, 28, 132, 39, 230, 55,
+			136, 157, 221, 65, 112, 199, 123, 86, 76, 247, 100, 66, 69, 47,
+			148, 170, 55, 38, 99, 142, 83, 166, 91, 95, 9, 57, 20, 142,
+			100, 218, 249, 31, 207, 251, 235, 178, 255, 104, 103, 227, 87, 229,
+			15, 31, 185, 149, 59, 57, 122, 248, 57, 75, 146, 31, 35, 242,
+			62, 174, 123, 252, 235, 89, 50, 19, 84, 222, 43, 213, 46, 144,
+			255, 168, 16, 239, 157, 192, 127, 175, 20, 172, 252, 107, 5, 54,
+			101, 122, 172, 120, 175, 111, 96, 101, 105, 101, 105, 113, 101, 105,
+			229, 22, 108, 100, 93, 216, 103, 81, 95, 200, 68, 246, 56, 211,
+			77, 104, 139, 40, 36, 4, 182, 120, 196, 132, 102, 49, 100, 34,
+			102, 10, 76, 159, 193, 122, 74, 163, 62, 43, 102, 154, 240, 71,
+			76, 105, 46, 5, 172, 132, 75, 80, 71, 132, 90, 62, 85, 107,
+			220, 35, 112, 44, 51, 24, 208, 99, 16, 210, 64, 166, 25, 152,
+			62, 215, 208, 229, 9, 3, 118, 20, 177, 212, 0, 23, 16, 201,
+			65, 154, 112, 42, 34, 6, 67, 110, 250, 96, 198, 219, 135, 4,
+			190, 200, 119, 144, 29, 67, 185, 0, 10, 145, 76, 143, 65, 118,
+			39, 209, 128, 26, 66, 192, 254, 235, 27, 147, 174, 181, 90, 195,
+			225, 48, 164, 150, 83, 171, 212, 196, 225, 233, 214, 86, 123, 243,
+			193, 246, 222, 131, 197, 149, 112, 137, 16, 56, 16, 9, 211, 26,
+			20, 251, 227, 140, 43, 22, 67, 231, 24, 104, 154, 38, 60, 162,
+			157, 132, 65, 66, 135, 32, 21, 208, 158, 98, 44, 6, 35, 145,
+			215, 161, 226, 134, 139, 94, 19, 180, 236, 154, 33, 85, 140, 64,
+			204, 181, 81, 188, 147, 153, 41, 53, 21, 156, 113, 61, 133, 32,
+			5, 80, 1, 181, 245, 61, 104, 239, 213, 96, 99, 125, 175, 189,
+			215, 36, 240, 121, 123, 255, 71, 79, 15, 246, 225, 243, 245, 221,
+			221, 245, 237, 253, 246, 131, 61, 120, 186, 11, 155, 79, 183, 239,
+			183, 247, 219, 79, 183, 247, 224, 233, 67, 88, 223, 254, 2, 126,
+			220, 222, 190, 223, 4, 198, 77, 159, 41, 96, 71, 169, 66, 238,
+			165, 2, 142, 10, 100, 113, 72, 96, 143, 177, 41, 242, 93, 233,
+			172, 166, 83, 22, 241, 46, 143, 0, 253, 44, 163, 61, 6, 61,
+			121, 200, 148, 224, 162, 7, 41, 83, 3, 174, 209, 136, 26, 168,
+			136, 9, 36, 124, 192, 13, 53, 118, 224, 13, 137, 66, 66, 102,
+			137, 87, 14, 252, 11, 165, 121, 252, 154, 13, 252, 160, 244, 128,
+			204, 145, 242, 236, 57, 247, 233, 6, 47, 150, 154, 118, 208, 115,
+			159, 110, 240, 82, 233, 166, 29, 204, 63, 221, 224, 229, 82, 205,
+			14, 18, 247, 233, 6, 175, 148, 190, 103, 7, 63, 118, 159, 110,
+			240, 106, 233, 158, 29, 188, 225, 62, 221, 224, 124, 233, 35, 59,
+			248, 145, 251, 252, 231, 50, 41, 87, 74, 129, 95, 43, 93, 168,
+			254, 93, 25, 214, 161, 199, 4, 83, 60, 2, 123, 134, 96, 192,
+			180, 70, 241, 77, 159, 26, 235, 157, 17, 21, 160, 216, 162, 117,
+			78, 9, 244, 80, 242, 24, 98, 214, 229, 86, 53, 113, 102, 189,
+			193, 176, 152, 76, 175, 215, 232, 12, 199, 50, 83, 176, 190, 211,
+			214, 33, 172, 131, 57, 78, 121, 68, 19, 96, 71, 116, 144, 38,
+			214, 240, 70, 90, 159, 231, 6, 168, 182, 86, 64, 71, 99, 218,
+			16, 200, 173, 162, 152, 78, 37, 154, 9, 207, 58, 250, 52, 21,
+			184, 31, 12, 152, 233, 203, 56, 132, 135, 104, 91, 161, 13, 158,
+			141, 181, 220, 195, 53, 83, 135, 60, 98, 240, 80, 74, 120, 149,
+			59, 61, 168, 52, 130, 13, 170, 234, 39, 162, 77, 104, 131, 77,
+			3, 20, 51, 153, 18, 26, 222, 50, 127, 207, 109, 243, 154, 224,
+			63, 191, 82, 242, 2, 191, 54, 251, 110, 103, 198, 162, 173, 146,
+			127, 106, 144, 143, 78, 198, 64, 195, 7, 76, 27, 58, 72, 223,
+			22, 7, 239, 145, 185, 253, 2, 39, 152, 39, 103, 53, 139, 164,
+			136, 245, 188, 7, 94, 221, 223, 45, 192, 224, 18, 57, 35, 168,
+			144, 122, 190, 12, 94, 253, 204, 174, 3, 54, 254, 220, 59, 61,
+			120, 190, 55, 218, 178, 8, 160, 43, 191, 101, 0, 29, 241, 251,
+			59, 5, 209, 127, 92, 112, 65, 244, 231, 222, 31, 130, 232, 31,
+			130, 232, 255, 117, 16, 29, 133, 49, 252, 44, 130, 104, 187, 136,
+			172, 248, 89, 4, 209, 81, 100, 189, 52, 138, 172, 151, 75, 173,
+			34, 178, 226, 103, 17, 68, 71, 145, 245, 202, 40, 178, 94, 29,
+			71, 214, 171, 163, 200, 58, 63, 142, 172, 248, 249, 159, 215, 108,
+			16, 173, 152, 210, 207, 189, 234, 191, 95, 131, 117, 24, 157, 60,
+			80, 12, 85, 198, 132, 209, 64, 33, 149, 92, 88, 255, 195, 3,
+			6, 92, 196, 44, 101, 34, 102, 194, 160, 115, 81, 113, 236, 198,
+			191, 150, 130, 129, 84, 144, 200, 136, 38, 4, 34, 154, 48, 17,
+			83, 213, 4, 38, 34, 25, 179, 24, 227, 35, 250, 100, 230, 214,
+			229, 193, 1, 245, 8, 93, 69, 35, 167, 196, 201, 9, 67, 192,
+			70, 10, 11, 131, 98, 90, 38, 25, 98, 133, 176, 223, 103, 249,
+			70, 28, 125, 50, 161, 134, 31, 186, 200, 46, 128, 165, 50, 234,
+			3, 53, 112, 176, 191, 9, 3, 30, 11, 123, 130, 165, 32, 240,
+			152, 138, 140, 170, 99, 88, 110, 194, 242, 221, 79, 150, 154, 86,
+			162, 62, 131, 84, 201, 132, 165, 134, 71, 240, 72, 177, 158, 84,
+			156, 138, 17, 247, 48, 236, 243, 168, 15, 236, 200, 48, 100, 214,
+			244, 25, 57, 13, 171, 67, 163, 175, 134, 84, 33, 134, 132, 99,
+			70, 21, 72, 129, 246, 135, 245, 36, 129, 1, 23, 153, 97, 26,
+			168, 98, 112, 103, 105, 36, 95, 34, 69, 47, 132, 45, 70, 211,
+			177, 200, 138, 65, 77, 15, 24, 85, 44, 174, 129, 150, 46, 129,
+			9, 9, 9, 163, 41, 201, 209, 192, 216, 51, 199, 53, 8, 198,
+			80, 175, 152, 254, 185, 48, 76, 165, 138, 57, 103, 108, 66, 166,
+			49, 179, 81, 120, 190, 114, 107, 177, 143, 25, 44, 225, 130, 81,
+			69, 192, 238, 254, 211, 58, 30, 126, 189, 214, 106, 197, 236, 144,
+			37, 50, 101, 74, 23, 113, 56, 146, 131, 22, 218, 179, 101, 49,
+			27, 40, 4, 170, 91, 81, 209, 179, 103, 180, 171, 228, 0, 150,
+			150, 150, 150, 23, 237, 223, 254, 210, 210, 154, 253, 123, 134, 162,
+			223, 189, 123, 247, 238, 226, 242, 202, 226, 234, 242, 254, 202, 234,
+			218, 237, 187, 107, 183, 239, 134, 119, 139, 127, 207, 66, 216, 56,
+			38, 104, 72, 163, 120, 132, 193, 1, 151, 88, 17, 237, 238, 77,
+			24, 50, 96, 66, 103, 42, 207, 220, 67, 102, 19, 119, 36, 197,
+			33, 83, 6, 145, 157, 179, 200, 1, 60, 223, 125, 184, 73, 96,
+			117, 117, 245, 238, 88, 22, 44, 7, 57, 51, 93, 91, 12, 170,
+			110, 212, 82, 221, 8, 49, 66, 115, 100, 26, 16, 83, 195, 0,
+			227, 143, 232, 105, 20, 234, 58, 60, 112, 73, 92, 19, 82, 124,
+			194, 242, 26, 108, 202, 65, 154, 25, 54, 113, 22, 44, 193, 157,
+			167, 123, 237, 159, 192, 75, 212, 76, 189, 241, 50, 204, 131, 232,
+			24, 105, 148, 123, 242, 60, 59, 130, 67, 205, 204, 139, 220, 192,
+			117, 28, 173, 111, 31, 108, 109, 53, 26, 167, 226, 89, 127, 175,
+			47, 53, 238, 77, 240, 180, 242, 109, 60, 245, 152, 193, 93, 100,
+			55, 166, 199, 19, 188, 105, 163, 178, 200, 216, 179, 121, 72, 19,
+			48, 135, 57, 197, 41, 244, 239, 155, 195, 38, 88, 134, 238, 253,
+			190, 34, 29, 134, 230, 16, 5, 252, 38, 137, 28, 82, 166, 89,
+			4, 63, 128, 229, 165, 165, 105, 9, 87, 223, 42, 225, 231, 92,
+			172, 174, 192, 203, 71, 204, 236, 29, 107, 195, 6, 56, 189, 174,
+			31, 242, 132, 237, 79, 27, 226, 97, 123, 235, 193, 126, 251, 201,
+			3, 232, 154, 156, 141, 183, 173, 249, 126, 215, 20, 156, 30, 180,
+			183, 247, 239, 220, 2, 195, 163, 175, 52, 124, 10, 245, 122, 221,
+			141, 52, 186, 38, 140, 135, 63, 226, 189, 254, 125, 106, 236, 170,
+			6, 252, 240, 135, 176, 186, 210, 128, 63, 1, 59, 183, 37, 135,
+			197, 84, 161, 183, 86, 11, 214, 225, 115, 46, 98, 57, 212, 118,
+			75, 60, 44, 203, 75, 75, 19, 49, 76, 135, 35, 4, 23, 165,
+			150, 239, 188, 121, 140, 70, 187, 225, 242, 229, 59, 183, 110, 221,
+			250, 100, 245, 206, 210, 56, 108, 116, 88, 87, 42, 6, 7, 130,
+			31, 229, 177, 14, 131, 217, 201, 93, 194, 223, 207, 152, 117, 39,
+			63, 212, 235, 40, 129, 134, 150, 53, 22, 254, 53, 96, 113, 146,
+			157, 111, 241, 96, 220, 103, 117, 101, 188, 207, 141, 137, 125, 172,
+			3, 52, 166, 28, 224, 214, 91, 29, 224, 49, 61, 164, 240, 210,
+			25, 63, 140, 50, 165, 152, 48, 136, 242, 132, 39, 9, 215, 19,
+			14, 128, 209, 20, 6, 118, 20, 62, 133, 183, 47, 248, 6, 55,
+			135, 79, 199, 163, 161, 96, 195, 141, 140, 39, 49, 83, 245, 6,
+			10, 182, 151, 107, 40, 39, 225, 20, 211, 40, 74, 115, 0, 196,
+			217, 182, 190, 94, 231, 194, 160, 228, 57, 166, 19, 61, 23, 27,
+			85, 208, 104, 132, 29, 220, 185, 62, 165, 130, 219, 223, 162, 130,
+			182, 189, 33, 152, 80, 200, 225, 132, 212, 249, 40, 8, 57, 132,
+			79, 97, 10, 231, 27, 5, 29, 243, 253, 237, 18, 11, 57, 12,
+			123, 204, 60, 64, 95, 115, 99, 245, 198, 132, 224, 211, 194, 231,
+			200, 8, 212, 79, 23, 244, 206, 91, 5, 205, 173, 85, 84, 25,
+			176, 115, 108, 250, 82, 20, 162, 158, 106, 166, 122, 227, 196, 100,
+			248, 136, 153, 205, 177, 213, 235, 13, 27, 233, 31, 239, 61, 221,
+			134, 39, 52, 77, 185, 232, 17, 2, 109, 225, 70, 186, 82, 13,
+			168, 105, 218, 178, 111, 204, 139, 189, 166, 113, 61, 93, 182, 184,
+			196, 145, 87, 12, 196, 166, 159, 223, 41, 251, 56, 82, 88, 185,
+			80, 3, 92, 91, 154, 4, 107, 206, 129, 133, 161, 246, 10, 171,
+			134, 215, 139, 175, 6, 82, 152, 254, 235, 197, 87, 49, 61, 126,
+			189, 255, 10, 83, 247, 235, 181, 87, 3, 46, 94, 175, 189, 210,
+			44, 122, 253, 60, 124, 133, 197, 18, 198, 219, 215, 63, 125, 86,
+			35, 48, 236, 51, 197, 192, 173, 198, 141, 104, 50, 164, 199, 186,
+			40, 121, 241, 62, 98, 43, 129, 46, 214, 0, 49, 239, 113, 163,
+			177, 164, 73, 24, 228, 148, 154, 96, 73, 53, 9, 56, 98, 77,
+			176, 212, 154, 182, 46, 179, 36, 109, 85, 242, 53, 83, 114, 49,
+			165, 49, 214, 27, 152, 180, 135, 178, 216, 141, 209, 168, 143, 114,
+			177, 81, 21, 135, 213, 95, 30, 80, 154, 121, 253, 20, 81, 1,
+			61, 9, 89, 138, 73, 252, 110, 177, 180, 206, 67, 22, 230, 131,
+			203, 167, 215, 122, 141, 38, 177, 244, 101, 138, 16, 77, 28, 165,
+			218, 179, 26, 232, 172, 219, 229, 71, 88, 141, 242, 136, 98, 121,
+			133, 86, 68, 39, 177, 117, 104, 189, 118, 176, 191, 89, 107, 220,
+			155, 26, 37, 192, 199, 87, 24, 188, 206, 219, 91, 228, 170, 115,
+			6, 205, 20, 167, 9, 255, 154, 41, 208, 125, 153, 37, 113, 161,
+			202, 76, 51, 91, 75, 214, 169, 30, 81, 195, 46, 18, 129, 218,
+			179, 90, 3, 13, 32, 32, 85, 92, 184, 130, 230, 77, 87, 66,
+			69, 210, 41, 82, 41, 85, 122, 76, 166, 195, 8, 216, 138, 14,
+			235, 155, 200, 246, 203, 58, 210, 244, 45, 77, 92, 43, 109, 35,
+			168, 144, 65, 191, 193, 7, 22, 189, 178, 219, 213, 204, 216, 98,
+			13, 219, 9, 121, 123, 162, 9, 181, 149, 165, 229, 79, 22, 151,
+			150, 23, 151, 111, 239, 47, 45, 175, 173, 46, 173, 45, 223, 14,
+			151, 150, 159, 213, 114, 239, 214, 96, 225, 81, 114, 73, 41, 54,
+			46, 44, 166, 165, 47, 197, 184, 106, 190, 221, 4, 220, 45, 204,
+			15, 16, 61, 164, 123, 145, 226, 169, 105, 98, 173, 59, 85, 168,
+			81, 192, 228, 8, 178, 243, 37, 195, 2, 68, 230, 119, 89, 231,
+			236, 174, 50, 181, 238, 143, 209, 42, 166, 42, 38, 240, 220, 200,
+			246, 222, 211, 61, 123, 200, 234, 141, 83, 202, 211, 112, 32, 191,
+			230, 73, 66, 109, 109, 199, 196, 226, 193, 94, 43, 150, 145, 110,
+			125, 206, 58, 173, 49, 43, 173, 93, 214, 101, 138, 137, 136, 181,
+			30, 37, 178, 67, 147, 23, 79, 45, 15, 186, 133, 12, 181, 38,
+			136, 52, 200, 168, 255, 210, 46, 34, 77, 211, 158, 115, 199, 18,
+			188, 196, 122, 17, 149, 30, 22, 31, 47, 11, 129, 80, 212, 14,
+			43, 164, 197, 174, 209, 105, 34, 18, 120, 254, 82, 27, 213, 181,
+			75, 39, 36, 146, 145, 14, 83, 23, 217, 80, 150, 149, 86, 194,
+			59, 138, 170, 99, 91, 116, 135, 125, 51, 72, 174, 219, 175, 98,
+			109, 195, 246, 75, 201, 200, 145, 11, 34, 216, 236, 131, 133, 27,
+			95, 44, 222, 24, 44, 222, 136, 247, 111, 252, 104, 237, 198, 147,
+			181, 27, 123, 225, 141, 238, 179, 133, 16, 182, 248, 87, 108, 200,
+			177, 117, 203, 209, 132, 135, 116, 108, 165, 76, 51, 183, 219, 99,
+			25, 83, 235, 172, 11, 26, 158, 191, 108, 239, 61, 45, 74, 154,
+			135, 150, 130, 21, 60, 47, 179, 126, 90, 39, 69, 191, 224, 75,
+			25, 211, 69, 100, 44, 212, 50, 83, 17, 86, 35, 61, 22, 10,
+			102, 90, 52, 229, 214, 38, 40, 22, 98, 89, 137, 90, 142, 221,
+			214, 155, 219, 91, 81, 199, 52, 8, 52, 208, 85, 70, 205, 11,
+			183, 206, 48, 5, 17, 77, 237, 249, 144, 93, 215, 230, 163, 238,
+			164, 21, 167, 12, 79, 195, 164, 250, 195, 137, 14, 151, 153, 125,
+			159, 252, 149, 71, 42, 149, 82, 185, 20, 248, 71, 229, 75, 213,
+			95, 122, 176, 59, 190, 219, 22, 126, 47, 187, 214, 221, 145, 97,
+			208, 92, 68, 147, 245, 21, 57, 189, 192, 130, 39, 153, 54, 208,
+			97, 223, 120, 33, 34, 167, 221, 136, 158, 1, 23, 81, 146, 105,
+			126, 136, 87, 196, 119, 200, 25, 228, 238, 12, 178, 119, 182, 128,
+			188, 192, 63, 154, 61, 95, 64, 126, 224, 31, 5, 23, 201, 127,
+			57, 65, 188, 192, 255, 89, 57, 168, 254, 198, 131, 109, 41, 22,
+			5, 235, 185, 219, 111, 17, 125, 173, 48, 52, 151, 12, 239, 193,
+			167, 198, 213, 16, 182, 243, 133, 163, 107, 229, 33, 77, 50, 166,
+			173, 183, 77, 108, 54, 64, 41, 181, 225, 73, 2, 125, 122, 200,
+			64, 76, 210, 180, 91, 231, 11, 209, 167, 168, 201, 175, 229, 93,
+			169, 240, 58, 92, 244, 12, 78, 42, 43, 191, 42, 54, 243, 255,
+			228, 20, 133, 120, 103, 80, 204, 66, 33, 30, 10, 61, 251, 110,
+			1, 249, 129, 255, 179, 11, 239, 143, 122, 151, 191, 190, 70, 206,
+			209, 120, 192, 69, 222, 167, 156, 73, 100, 47, 150, 189, 234, 55,
+			61, 234, 84, 191, 173, 219, 89, 251, 23, 143, 92, 216, 99, 102,
+			83, 138, 46, 239, 237, 186, 166, 110, 208, 36, 65, 100, 7, 94,
+			228, 45, 218, 23, 153, 74, 108, 191, 115, 110, 247, 130, 155, 217,
+			115, 19, 7, 42, 9, 174, 17, 50, 194, 54, 182, 251, 57, 183,
+			59, 87, 96, 153, 224, 35, 114, 46, 159, 78, 169, 233, 207, 251,
+			118, 62, 95, 177, 67, 77, 63, 248, 127, 228, 187, 218, 72, 69,
+			123, 108, 68, 142, 70, 86, 195, 47, 190, 212, 82, 204, 199, 224,
+			213, 223, 217, 253, 78, 142, 147, 19, 94, 119, 24, 143, 181, 20,
+			181, 30, 9, 118, 50, 213, 99, 187, 44, 149, 202, 20, 66, 204,
+			147, 179, 169, 146, 24, 24, 115, 206, 11, 16, 57, 26, 208, 163,
+			23, 138, 233, 44, 49, 69, 191, 150, 12, 232, 209, 174, 27, 9,
+			174, 144, 153, 40, 83, 90, 170, 156, 219, 28, 170, 253, 183, 71,
+			46, 78, 81, 114, 61, 239, 224, 135, 228, 172, 54, 138, 209, 1,
+			54, 133, 253, 250, 185, 149, 90, 232, 140, 19, 158, 130, 29, 238,
+			89, 212, 221, 98, 73, 16, 144, 138, 96, 71, 133, 230, 236, 119,
+			245, 47, 60, 50, 227, 240, 112, 218, 42, 206, 9, 97, 191, 145,
+			193, 84, 38, 60, 58, 206, 23, 229, 80, 112, 139, 156, 141, 20,
+			163, 134, 197, 150, 243, 115, 43, 213, 147, 141, 231, 112, 148, 183,
+			119, 11, 84, 212, 7, 85, 81, 159, 31, 58, 59, 87, 192, 71,
+			11, 229, 67, 7, 42, 89, 249, 165, 71, 206, 172, 163, 235, 5,
+			159, 146, 185, 145, 183, 4, 243, 133, 148, 39, 29, 168, 122, 229,
+			13, 178, 182, 125, 31, 60, 36, 231, 38, 52, 18, 84, 79, 85,
+			147, 53, 95, 245, 131, 83, 231, 156, 194, 31, 255, 237, 85, 236,
+			102, 87, 74, 219, 30, 249, 123, 207, 62, 9, 86, 74, 193, 202,
+			175, 188, 169, 110, 246, 242, 29, 91, 70, 109, 29, 108, 182, 97,
+			61, 51, 125, 169, 240, 117, 35, 73, 192, 190, 25, 98, 141, 132,
+			238, 102, 219, 164, 7, 154, 97, 64, 177, 241, 213, 5, 124, 192,
+			110, 30, 86, 82, 238, 113, 233, 183, 109, 121, 23, 241, 193, 37,
+			207, 174, 204, 68, 92, 52, 224, 242, 86, 179, 125, 50, 12, 9,
+			182, 40, 253, 82, 224, 207, 148, 110, 216, 79, 47, 240, 207, 150,
+			26, 121, 43, 117, 174, 116, 158, 252, 198, 115, 125, 203, 243, 165,
+			15, 189, 234, 191, 121, 224, 82, 189, 59, 167, 200, 151, 221, 82,
+			246, 238, 203, 30, 108, 74, 169, 98, 46, 168, 145, 10, 122, 22,
+			13, 211, 118, 151, 247, 50, 101, 27, 101, 88, 204, 236, 163, 104,
+			248, 39, 176, 199, 231, 234, 91, 26, 25, 91, 247, 11, 96, 194,
+			168, 99, 215, 1, 117, 181, 231, 128, 126, 41, 21, 55, 163, 198,
+			250, 212, 126, 4, 134, 24, 41, 59, 216, 105, 146, 248, 216, 104,
+			83, 89, 45, 201, 34, 190, 232, 16, 107, 144, 159, 100, 200, 25,
+			30, 249, 193, 56, 107, 157, 159, 157, 39, 127, 54, 202, 90, 65,
+			25, 170, 25, 108, 78, 197, 150, 221, 173, 66, 80, 124, 73, 58,
+			216, 221, 42, 184, 233, 80, 205, 78, 167, 23, 66, 187, 155, 191,
+			111, 217, 219, 7, 190, 127, 101, 52, 49, 35, 126, 112, 151, 130,
+			251, 76, 163, 241, 243, 112, 91, 58, 19, 248, 65, 121, 182, 128,
+			188, 192, 15, 230, 62, 40, 32, 63, 240, 131, 15, 63, 34, 63,
+			46, 82, 211, 229, 242, 124, 245, 179, 17, 183, 166, 96, 83, 208,
+			1, 59, 85, 99, 160, 153, 45, 17, 19, 73, 93, 107, 111, 68,
+			22, 51, 192, 229, 17, 89, 204, 0, 151, 231, 46, 22, 144, 31,
+			248, 151, 175, 92, 37, 251, 150, 108, 57, 240, 175, 150, 191, 83,
+			125, 148, 147, 197, 208, 89, 208, 197, 152, 80, 208, 53, 236, 200,
+			44, 142, 202, 252, 248, 4, 31, 19, 118, 112, 52, 202, 103, 112,
+			219, 130, 62, 202, 118, 117, 238, 82, 1, 249, 129, 127, 245, 234,
+			60, 249, 7, 103, 37, 63, 240, 175, 149, 111, 86, 255, 198, 131,
+			118, 215, 62, 224, 228, 106, 206, 153, 40, 52, 156, 199, 238, 252,
+			242, 137, 239, 227, 49, 53, 52, 239, 117, 78, 104, 30, 75, 60,
+			2, 123, 46, 170, 219, 59, 129, 214, 214, 83, 159, 222, 127, 90,
+			143, 197, 151, 141, 53, 216, 101, 3, 121, 152, 63, 18, 185, 187,
+			17, 72, 44, 91, 54, 19, 153, 197, 176, 193, 123, 251, 182, 100,
+			234, 83, 13, 145, 146, 90, 47, 230, 17, 30, 214, 55, 183, 244,
+			72, 68, 255, 12, 50, 94, 36, 89, 223, 11, 252, 107, 179, 31,
+			23, 16, 10, 181, 240, 3, 178, 67, 202, 21, 47, 168, 124, 175,
+			212, 244, 170, 247, 225, 205, 112, 4, 124, 234, 217, 115, 244, 246,
+			90, 188, 167, 76, 172, 128, 221, 157, 77, 91, 157, 249, 21, 52,
+			231, 247, 102, 171, 164, 78, 42, 21, 15, 107, 179, 235, 229, 203,
+			213, 15, 236, 1, 43, 56, 53, 18, 95, 33, 112, 153, 109, 2,
+			32, 87, 158, 173, 147, 174, 231, 70, 241, 172, 47, 94, 159, 187,
+			80, 64, 126, 224, 95, 191, 120, 137, 48, 187, 167, 23, 248, 11,
+			229, 249, 234, 79, 242, 67, 123, 196, 7, 217, 0, 68, 54, 232,
+			48, 133, 14, 145, 200, 30, 228, 105, 102, 76, 9, 207, 136, 189,
+			234, 226, 85, 32, 102, 93, 154, 37, 166, 88, 75, 78, 59, 28,
+			158, 245, 210, 133, 92, 133, 94, 25, 197, 90, 152, 189, 88, 64,
+			126, 224, 47, 92, 185, 74, 142, 45, 67, 229, 192, 191, 89, 190,
+			84, 77, 78, 248, 72, 36, 241, 230, 152, 177, 92, 137, 200, 134,
+			61, 7, 206, 182, 46, 189, 54, 243, 199, 93, 215, 141, 168, 97,
+			30, 172, 1, 23, 4, 223, 102, 20, 59, 228, 50, 211, 112, 90,
+			62, 45, 152, 68, 87, 190, 57, 210, 26, 186, 242, 205, 185, 243,
+			5, 228, 7, 254, 205, 224, 34, 217, 37, 229, 74, 57, 168, 180,
+			74, 155, 94, 245, 225, 105, 187, 141, 13, 157, 195, 191, 157, 165,
+			145, 90, 107, 246, 3, 178, 71, 42, 149, 178, 95, 10, 42, 203,
+			229, 123, 126, 245, 1, 184, 28, 14, 49, 211, 145, 226, 29, 124,
+			30, 193, 202, 187, 151, 176, 9, 211, 184, 163, 129, 189, 152, 132,
+			247, 120, 126, 71, 238, 48, 72, 145, 187, 194, 6, 101, 31, 221,
+			96, 153, 92, 32, 11, 100, 6, 73, 160, 59, 173, 86, 46, 85,
+			231, 173, 233, 199, 187, 45, 104, 27, 14, 66, 66, 222, 35, 103,
+			29, 226, 25, 196, 156, 128, 189, 192, 95, 61, 119, 126, 12, 251,
+			129, 191, 106, 117, 227, 54, 246, 2, 255, 118, 229, 74, 117, 19,
+			246, 79, 132, 52, 197, 12, 19, 246, 20, 186, 114, 195, 49, 110,
+			95, 72, 153, 30, 93, 15, 199, 172, 76, 240, 128, 14, 116, 123,
+			130, 7, 116, 161, 219, 231, 222, 31, 195, 126, 224, 223, 190, 116,
+			153, 172, 229, 194, 149, 3, 255, 147, 202, 15, 170, 55, 45, 15,
+			88, 92, 187, 14, 132, 153, 18, 21, 134, 246, 224, 219, 122, 103,
+			130, 86, 121, 6, 23, 95, 27, 195, 94, 224, 127, 242, 225, 141,
+			49, 236, 7, 254, 39, 245, 6, 217, 207, 105, 249, 129, 191, 86,
+			249, 184, 250, 192, 210, 114, 207, 232, 163, 176, 116, 176, 187, 165,
+			11, 241, 243, 130, 40, 158, 140, 103, 121, 111, 3, 98, 150, 176,
+			105, 46, 252, 10, 110, 251, 206, 24, 62, 19, 248, 107, 239, 94,
+			28, 195, 94, 224, 175, 93, 250, 104, 12, 35, 27, 181, 235, 228,
+			22, 122, 16, 26, 247, 179, 242, 135, 213, 133, 19, 198, 213, 39,
+			200, 78, 187, 72, 185, 84, 193, 101, 35, 104, 38, 240, 63, 59,
+			247, 126, 1, 121, 129, 255, 89, 240, 157, 2, 242, 3, 255, 179,
+			239, 94, 35, 191, 192, 208, 110, 53, 180, 81, 14, 170, 199, 39,
+			78, 45, 246, 100, 152, 253, 209, 83, 135, 193, 64, 170, 19, 172,
+			140, 3, 202, 14, 213, 58, 255, 113, 72, 45, 175, 149, 73, 81,
+			247, 224, 57, 158, 62, 105, 46, 132, 26, 57, 138, 10, 35, 1,
+			208, 77, 54, 242, 35, 92, 182, 113, 102, 99, 238, 221, 2, 242,
+			3, 127, 227, 194, 251, 228, 11, 82, 158, 41, 5, 149, 135, 165,
+			109, 175, 250, 4, 108, 89, 58, 202, 238, 216, 26, 20, 96, 111,
+			73, 248, 200, 238, 238, 140, 197, 36, 19, 177, 173, 112, 48, 241,
+			156, 82, 58, 185, 152, 61, 131, 199, 236, 225, 236, 187, 228, 5,
+			169, 204, 216, 202, 164, 93, 222, 168, 238, 194, 168, 196, 181, 57,
+			60, 207, 120, 153, 117, 253, 147, 57, 150, 11, 35, 237, 143, 187,
+			112, 48, 156, 172, 219, 200, 232, 39, 48, 78, 98, 36, 224, 5,
+			126, 123, 230, 124, 1, 149, 3, 191, 125, 1, 10, 200, 15, 252,
+			246, 205, 255, 79, 254, 210, 179, 188, 120, 129, 255, 164, 124, 191,
+			250, 11, 111, 42, 6, 21, 63, 136, 177, 12, 49, 243, 70, 212,
+			63, 197, 95, 240, 119, 13, 182, 53, 149, 39, 161, 5, 125, 242,
+			104, 219, 223, 124, 224, 29, 89, 102, 6, 104, 100, 50, 154, 36,
+			199, 118, 117, 222, 214, 202, 11, 24, 228, 10, 217, 154, 121, 191,
+			128, 202, 129, 255, 36, 248, 184, 128, 252, 192, 127, 210, 218, 232,
+			204, 164, 74, 26, 185, 250, 191, 3, 0, 21, 213, 157, 246, 131,
+			40, 0, 0},
 	)
 }
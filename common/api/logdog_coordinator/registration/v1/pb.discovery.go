@@ -10,242 +10,227 @@ func init() {
 			"logdog.Registration",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 164, 89, 205, 114, 27, 199,
-			241, 39, 62, 248, 161, 145, 40, 211, 160, 100, 203, 208, 159, 116,
-			11, 166, 37, 202, 4, 33, 74, 46, 251, 31, 81, 150, 42, 32,
-			176, 162, 214, 6, 1, 100, 23, 164, 172, 28, 44, 47, 176, 3,
-			112, 227, 197, 238, 102, 119, 65, 138, 78, 229, 228, 55, 200, 11,
-			196, 149, 170, 28, 226, 99, 94, 32, 241, 61, 149, 99, 94, 32,
-			135, 228, 158, 202, 61, 221, 51, 179, 192, 2, 164, 236, 74, 133,
-			85, 162, 182, 231, 163, 251, 215, 31, 211, 221, 51, 100, 255, 186,
-			197, 214, 7, 190, 63, 112, 249, 189, 32, 244, 99, 191, 59, 234,
-			223, 179, 71, 161, 21, 59, 190, 87, 17, 35, 133, 55, 228, 124,
-			37, 153, 47, 237, 178, 165, 186, 90, 82, 184, 193, 22, 35, 222,
-			243, 61, 59, 186, 145, 129, 204, 102, 206, 72, 200, 194, 53, 54,
-			239, 89, 158, 31, 221, 200, 226, 248, 188, 33, 137, 189, 54, 91,
-			237, 249, 195, 202, 12, 203, 189, 229, 132, 97, 155, 70, 218, 153,
-			111, 51, 153, 223, 101, 115, 251, 237, 189, 239, 178, 235, 251, 114,
-			109, 91, 173, 173, 60, 231, 174, 251, 153, 231, 159, 122, 157, 179,
-			128, 71, 159, 254, 1, 216, 66, 33, 191, 62, 247, 50, 195, 254,
-			114, 133, 101, 174, 20, 114, 235, 115, 133, 7, 223, 95, 1, 177,
-			161, 231, 187, 176, 55, 234, 247, 121, 24, 193, 54, 72, 86, 119,
-			34, 176, 173, 216, 2, 199, 139, 121, 216, 59, 182, 188, 1, 135,
-			190, 31, 14, 173, 152, 65, 205, 15, 206, 66, 103, 112, 28, 195,
-			131, 157, 157, 159, 168, 13, 160, 123, 189, 10, 64, 213, 117, 65,
-			204, 69, 16, 242, 136, 135, 39, 220, 174, 48, 56, 142, 227, 32,
-			218, 189, 119, 207, 230, 39, 220, 245, 3, 20, 148, 104, 135, 138,
-			74, 163, 34, 136, 237, 174, 4, 113, 143, 49, 48, 184, 237, 68,
-			113, 232, 116, 71, 164, 49, 88, 158, 13, 163, 136, 35, 28, 136,
-			252, 81, 216, 227, 98, 164, 235, 120, 86, 120, 38, 112, 69, 101,
-			56, 117, 226, 99, 240, 67, 241, 191, 63, 66, 156, 67, 223, 118,
-			250, 78, 79, 216, 172, 12, 86, 200, 1, 37, 15, 157, 56, 230,
-			54, 160, 204, 19, 199, 198, 143, 248, 216, 138, 241, 23, 105, 231,
-			186, 254, 169, 227, 13, 128, 124, 227, 208, 166, 136, 54, 33, 31,
-			30, 239, 34, 36, 250, 249, 96, 6, 88, 4, 126, 63, 65, 212,
-			243, 109, 14, 195, 81, 20, 163, 230, 177, 133, 72, 137, 171, 213,
-			245, 79, 104, 74, 89, 140, 129, 231, 199, 78, 143, 151, 113, 210,
-			137, 192, 69, 86, 196, 33, 45, 209, 179, 103, 224, 160, 188, 158,
-			107, 57, 67, 30, 86, 94, 7, 2, 133, 165, 108, 145, 128, 64,
-			29, 237, 17, 2, 27, 227, 96, 19, 32, 255, 19, 14, 6, 74,
-			59, 219, 239, 141, 134, 220, 139, 173, 196, 73, 247, 208, 254, 62,
-			206, 132, 128, 145, 194, 67, 199, 114, 163, 137, 169, 133, 131, 112,
-			146, 65, 26, 253, 88, 169, 38, 119, 196, 78, 98, 236, 89, 67,
-			78, 128, 210, 177, 229, 249, 147, 57, 97, 119, 39, 142, 72, 35,
-			79, 178, 242, 49, 122, 135, 214, 25, 116, 57, 69, 10, 130, 247,
-			129, 123, 54, 142, 114, 10, 10, 4, 49, 244, 99, 14, 210, 38,
-			24, 157, 54, 162, 195, 224, 132, 62, 78, 48, 105, 133, 200, 239,
-			199, 167, 20, 38, 42, 130, 32, 10, 120, 143, 34, 8, 119, 57,
-			20, 88, 33, 197, 142, 39, 163, 40, 138, 4, 118, 6, 157, 103,
-			186, 9, 102, 235, 105, 231, 121, 213, 208, 0, 191, 219, 70, 235,
-			72, 175, 107, 117, 216, 123, 129, 147, 26, 212, 90, 237, 23, 134,
-			190, 255, 172, 3, 207, 90, 141, 186, 102, 152, 80, 109, 214, 113,
-			180, 217, 49, 244, 189, 195, 78, 203, 48, 25, 148, 170, 38, 110,
-			45, 137, 153, 106, 243, 5, 104, 159, 183, 13, 205, 52, 161, 101,
-			128, 126, 208, 110, 232, 200, 13, 217, 27, 213, 102, 71, 215, 204,
-			50, 232, 205, 90, 227, 176, 174, 55, 247, 203, 128, 28, 160, 217,
-			234, 48, 104, 232, 7, 122, 7, 215, 117, 90, 101, 33, 246, 252,
-			62, 104, 61, 133, 3, 205, 168, 61, 67, 178, 186, 167, 55, 244,
-			206, 11, 33, 240, 169, 222, 105, 146, 176, 167, 45, 131, 65, 21,
-			218, 85, 163, 163, 215, 14, 27, 85, 3, 218, 135, 70, 187, 101,
-			106, 64, 154, 213, 117, 179, 214, 168, 234, 7, 90, 189, 130, 242,
-			81, 38, 104, 71, 90, 179, 3, 230, 179, 106, 163, 49, 173, 40,
-			131, 214, 243, 166, 102, 16, 250, 180, 154, 176, 167, 33, 202, 234,
-			94, 67, 35, 81, 66, 207, 186, 110, 104, 181, 14, 41, 52, 249,
-			170, 161, 241, 16, 96, 163, 204, 192, 108, 107, 53, 29, 191, 208,
-			30, 26, 170, 83, 53, 94, 148, 21, 83, 83, 251, 217, 33, 174,
-			194, 73, 168, 87, 15, 170, 251, 168, 221, 230, 143, 89, 5, 29,
-			83, 59, 52, 180, 3, 66, 141, 166, 48, 15, 247, 204, 142, 222,
-			57, 236, 104, 176, 223, 106, 213, 133, 177, 77, 205, 56, 210, 107,
-			154, 249, 8, 26, 45, 83, 24, 236, 208, 212, 16, 72, 189, 218,
-			169, 10, 209, 200, 3, 205, 133, 243, 248, 189, 119, 104, 234, 194,
-			112, 122, 179, 163, 25, 198, 97, 187, 163, 183, 154, 119, 209, 203,
-			207, 209, 50, 136, 178, 138, 123, 235, 194, 194, 173, 38, 105, 75,
-			177, 162, 181, 140, 23, 196, 150, 236, 32, 60, 80, 134, 231, 207,
-			52, 28, 55, 200, 168, 194, 90, 85, 50, 131, 137, 86, 171, 117,
-			210, 203, 80, 32, 26, 17, 85, 154, 232, 9, 77, 109, 191, 161,
-			239, 107, 205, 154, 70, 211, 45, 98, 243, 92, 55, 181, 187, 232,
-			48, 221, 164, 5, 186, 16, 140, 49, 128, 66, 15, 133, 214, 228,
-			40, 196, 197, 228, 119, 42, 116, 203, 194, 159, 160, 63, 133, 106,
-			253, 72, 39, 228, 106, 53, 70, 128, 169, 171, 112, 17, 102, 171,
-			61, 83, 54, 199, 240, 95, 98, 153, 108, 33, 7, 75, 111, 211,
-			215, 82, 33, 87, 154, 123, 196, 46, 179, 252, 210, 63, 22, 231,
-			36, 113, 133, 205, 19, 129, 139, 74, 139, 111, 179, 101, 182, 32,
-			168, 57, 73, 94, 101, 139, 146, 204, 72, 90, 45, 94, 68, 170,
-			184, 171, 56, 190, 55, 87, 86, 28, 51, 146, 144, 139, 72, 236,
-			123, 139, 171, 138, 99, 134, 56, 18, 41, 57, 102, 4, 71, 162,
-			213, 98, 228, 248, 222, 91, 91, 138, 227, 198, 92, 69, 113, 204,
-			74, 66, 46, 202, 18, 181, 120, 83, 113, 204, 18, 71, 34, 37,
-			199, 172, 224, 72, 180, 90, 140, 28, 55, 214, 183, 21, 199, 247,
-			231, 74, 138, 99, 78, 18, 114, 81, 14, 57, 190, 191, 88, 84,
-			28, 115, 196, 145, 72, 201, 49, 39, 56, 18, 173, 22, 211, 214,
-			181, 91, 138, 227, 237, 177, 214, 121, 73, 200, 69, 121, 228, 120,
-			123, 113, 67, 113, 204, 19, 71, 34, 37, 199, 188, 224, 72, 180,
-			90, 140, 28, 111, 223, 73, 180, 190, 51, 119, 75, 113, 156, 151,
-			132, 92, 52, 143, 28, 239, 44, 222, 80, 28, 231, 137, 35, 145,
-			146, 227, 188, 224, 72, 180, 90, 140, 90, 223, 185, 9, 236, 239,
-			140, 101, 243, 115, 133, 188, 129, 29, 69, 241, 111, 148, 52, 146,
-			222, 68, 148, 29, 44, 252, 30, 166, 88, 11, 34, 103, 224, 113,
-			187, 12, 125, 231, 21, 183, 183, 93, 238, 13, 176, 2, 68, 129,
-			229, 81, 250, 142, 177, 148, 76, 150, 115, 155, 129, 69, 123, 122,
-			254, 200, 19, 197, 72, 53, 73, 162, 18, 245, 67, 171, 55, 169,
-			183, 201, 4, 86, 49, 106, 152, 4, 201, 168, 223, 240, 93, 89,
-			82, 64, 143, 193, 161, 178, 104, 243, 0, 139, 0, 151, 12, 45,
-			239, 12, 122, 22, 162, 176, 173, 80, 112, 197, 125, 61, 30, 196,
-			84, 255, 190, 226, 80, 178, 173, 179, 18, 163, 98, 81, 26, 98,
-			81, 57, 46, 37, 108, 66, 238, 90, 177, 172, 40, 29, 196, 28,
-			197, 214, 48, 144, 21, 80, 181, 14, 216, 102, 96, 223, 194, 145,
-			25, 86, 159, 248, 148, 115, 15, 43, 202, 105, 122, 245, 137, 229,
-			142, 176, 104, 57, 164, 224, 216, 84, 4, 193, 137, 17, 146, 71,
-			69, 203, 178, 169, 70, 162, 244, 104, 212, 141, 73, 93, 178, 8,
-			85, 39, 220, 50, 102, 84, 1, 67, 180, 98, 196, 40, 192, 90,
-			246, 202, 161, 58, 235, 158, 193, 214, 246, 253, 157, 242, 206, 206,
-			14, 156, 113, 11, 187, 43, 172, 75, 218, 43, 92, 143, 181, 243,
-			254, 46, 54, 108, 195, 96, 132, 197, 111, 44, 89, 176, 157, 66,
-			40, 122, 136, 32, 226, 35, 219, 23, 141, 76, 69, 53, 60, 19,
-			21, 240, 119, 24, 195, 99, 168, 84, 42, 143, 102, 231, 208, 164,
-			83, 51, 99, 65, 73, 155, 156, 204, 202, 233, 113, 243, 156, 120,
-			242, 49, 113, 24, 83, 219, 82, 86, 66, 63, 154, 217, 36, 124,
-			174, 182, 200, 239, 100, 131, 160, 18, 33, 78, 31, 54, 207, 9,
-			250, 4, 118, 224, 246, 237, 89, 94, 79, 96, 231, 46, 252, 74,
-			110, 187, 0, 221, 214, 99, 184, 255, 232, 220, 172, 18, 141, 115,
-			59, 201, 143, 90, 244, 107, 224, 46, 245, 170, 41, 0, 209, 152,
-			217, 147, 11, 17, 124, 242, 195, 8, 182, 127, 0, 193, 214, 69,
-			8, 82, 254, 127, 48, 241, 255, 196, 97, 34, 0, 38, 228, 214,
-			196, 99, 255, 125, 24, 188, 214, 217, 175, 15, 18, 57, 149, 246,
-			249, 227, 105, 159, 35, 162, 89, 35, 60, 154, 108, 74, 34, 32,
-			229, 245, 244, 134, 115, 97, 48, 217, 51, 109, 231, 169, 160, 75,
-			155, 120, 178, 225, 66, 235, 78, 252, 59, 89, 248, 36, 189, 240,
-			53, 50, 182, 46, 150, 113, 97, 12, 49, 250, 201, 229, 41, 255,
-			26, 75, 43, 44, 96, 249, 188, 40, 155, 71, 217, 107, 197, 30,
-			152, 34, 177, 142, 51, 33, 101, 83, 204, 67, 233, 204, 90, 129,
-			3, 186, 5, 96, 98, 17, 190, 222, 254, 240, 254, 71, 229, 143,
-			254, 255, 99, 202, 17, 244, 143, 81, 54, 219, 154, 25, 68, 231,
-			247, 220, 81, 132, 125, 49, 250, 125, 153, 205, 147, 196, 124, 33,
-			127, 148, 53, 10, 84, 0, 136, 156, 39, 4, 139, 9, 133, 224,
-			142, 150, 222, 72, 40, 44, 55, 71, 133, 85, 246, 77, 78, 96,
-			197, 185, 47, 178, 133, 226, 191, 179, 9, 216, 169, 28, 110, 41,
-			236, 211, 73, 60, 149, 195, 211, 58, 177, 137, 82, 73, 176, 97,
-			206, 230, 81, 68, 41, 24, 87, 122, 124, 204, 45, 156, 42, 41,
-			242, 202, 97, 1, 170, 251, 165, 178, 213, 151, 88, 140, 184, 107,
-			139, 228, 107, 65, 224, 71, 120, 223, 57, 17, 87, 4, 143, 15,
-			44, 241, 253, 165, 0, 164, 22, 86, 224, 41, 206, 141, 207, 177,
-			128, 146, 18, 136, 115, 67, 63, 196, 123, 148, 133, 247, 19, 111,
-			251, 107, 30, 250, 50, 217, 211, 77, 76, 40, 48, 197, 77, 94,
-			205, 186, 156, 141, 213, 163, 139, 14, 149, 73, 42, 126, 98, 249,
-			52, 206, 89, 55, 62, 124, 248, 176, 172, 254, 73, 23, 166, 6,
-			46, 112, 95, 6, 221, 247, 69, 246, 232, 154, 114, 81, 102, 158,
-			156, 146, 184, 47, 67, 46, 90, 90, 78, 40, 116, 223, 23, 43,
-			111, 118, 23, 196, 117, 252, 67, 246, 207, 235, 108, 153, 46, 240,
-			120, 75, 84, 143, 28, 11, 174, 63, 176, 253, 65, 241, 71, 30,
-			67, 74, 191, 207, 176, 235, 6, 31, 224, 29, 143, 135, 237, 144,
-			99, 241, 55, 248, 47, 177, 252, 197, 244, 18, 130, 75, 126, 193,
-			123, 177, 120, 9, 185, 100, 36, 100, 225, 45, 134, 114, 105, 169,
-			120, 10, 185, 100, 40, 170, 240, 46, 187, 44, 175, 215, 47, 29,
-			175, 239, 223, 200, 65, 14, 39, 153, 28, 210, 113, 164, 240, 144,
-			49, 254, 42, 112, 36, 128, 27, 12, 55, 95, 126, 240, 206, 236,
-			219, 73, 37, 9, 27, 35, 181, 184, 244, 115, 246, 214, 44, 204,
-			40, 64, 31, 115, 66, 131, 110, 192, 107, 188, 128, 121, 197, 80,
-			84, 97, 147, 173, 160, 13, 94, 118, 71, 158, 237, 242, 151, 177,
-			31, 56, 61, 133, 247, 42, 142, 239, 137, 225, 14, 141, 62, 120,
-			201, 174, 72, 222, 234, 13, 168, 197, 174, 78, 203, 42, 172, 85,
-			164, 57, 43, 23, 154, 170, 184, 254, 186, 105, 9, 241, 211, 239,
-			11, 244, 164, 147, 159, 123, 148, 97, 223, 101, 196, 147, 14, 54,
-			100, 15, 126, 155, 153, 122, 157, 185, 255, 49, 116, 48, 166, 106,
-			199, 24, 59, 206, 104, 8, 213, 17, 94, 108, 177, 61, 120, 205,
-			51, 205, 97, 196, 101, 92, 138, 203, 240, 228, 81, 3, 201, 129,
-			127, 194, 67, 58, 197, 221, 51, 12, 245, 61, 179, 190, 29, 197,
-			103, 88, 93, 92, 12, 15, 132, 35, 123, 33, 217, 201, 96, 215,
-			130, 45, 156, 157, 188, 17, 52, 240, 62, 133, 215, 53, 12, 103,
-			151, 143, 47, 12, 11, 75, 87, 217, 37, 150, 205, 97, 66, 91,
-			90, 188, 195, 218, 178, 157, 188, 60, 183, 158, 41, 214, 225, 66,
-			131, 32, 78, 57, 74, 45, 148, 199, 79, 65, 206, 142, 31, 21,
-			80, 111, 63, 180, 29, 207, 138, 253, 176, 50, 201, 157, 151, 151,
-			214, 216, 102, 146, 59, 151, 179, 215, 139, 55, 133, 69, 208, 182,
-			88, 61, 66, 110, 13, 239, 136, 7, 10, 138, 193, 169, 156, 183,
-			156, 189, 252, 110, 42, 231, 45, 103, 151, 82, 57, 111, 249, 210,
-			74, 42, 231, 45, 175, 94, 99, 247, 147, 148, 183, 130, 233, 121,
-			99, 70, 4, 200, 88, 166, 227, 154, 40, 49, 117, 64, 87, 178,
-			203, 215, 83, 7, 116, 101, 44, 139, 14, 232, 202, 165, 55, 82,
-			7, 116, 5, 243, 235, 79, 133, 44, 52, 226, 106, 182, 84, 252,
-			16, 90, 1, 5, 152, 229, 2, 29, 16, 122, 160, 19, 173, 101,
-			151, 222, 47, 200, 44, 137, 68, 122, 193, 177, 6, 152, 21, 81,
-			180, 228, 151, 205, 19, 139, 49, 133, 146, 87, 47, 191, 153, 80,
-			40, 121, 181, 176, 150, 80, 40, 121, 21, 110, 177, 111, 101, 102,
-			71, 106, 45, 187, 85, 252, 77, 78, 232, 169, 148, 155, 156, 43,
-			149, 169, 245, 62, 124, 242, 24, 118, 202, 2, 133, 50, 49, 189,
-			42, 242, 190, 53, 114, 99, 181, 141, 165, 247, 5, 136, 210, 167,
-			156, 141, 193, 73, 13, 113, 16, 184, 14, 197, 37, 75, 203, 73,
-			102, 123, 174, 31, 201, 112, 156, 113, 62, 88, 125, 84, 151, 30,
-			135, 206, 243, 198, 24, 111, 81, 151, 46, 55, 151, 69, 28, 41,
-			31, 133, 169, 211, 138, 132, 136, 185, 72, 74, 243, 124, 116, 38,
-			118, 221, 161, 64, 213, 163, 43, 131, 132, 133, 42, 70, 35, 137,
-			82, 61, 164, 201, 212, 31, 29, 251, 35, 204, 245, 252, 85, 143,
-			115, 249, 138, 70, 38, 33, 143, 168, 172, 239, 250, 120, 15, 129,
-			216, 138, 190, 42, 139, 94, 130, 225, 113, 27, 242, 100, 150, 198,
-			209, 82, 18, 216, 248, 81, 203, 14, 253, 32, 64, 118, 54, 10,
-			192, 72, 234, 91, 142, 75, 62, 77, 227, 30, 71, 85, 14, 163,
-			106, 45, 187, 90, 82, 254, 203, 45, 144, 199, 138, 9, 133, 190,
-			93, 187, 249, 126, 66, 145, 55, 55, 63, 96, 79, 240, 8, 102,
-			10, 249, 91, 115, 119, 51, 197, 7, 194, 224, 161, 74, 53, 128,
-			13, 92, 100, 13, 38, 21, 109, 250, 124, 130, 209, 174, 169, 3,
-			71, 241, 122, 107, 105, 157, 125, 147, 193, 56, 17, 87, 242, 141,
-			236, 106, 241, 4, 76, 145, 61, 41, 141, 196, 99, 71, 146, 130,
-			98, 184, 130, 194, 112, 70, 21, 70, 89, 195, 232, 38, 132, 249,
-			166, 139, 90, 13, 41, 143, 236, 141, 98, 151, 158, 29, 101, 226,
-			141, 72, 127, 43, 194, 180, 133, 6, 61, 245, 48, 37, 28, 59,
-			193, 56, 113, 73, 246, 202, 20, 25, 113, 152, 55, 178, 183, 64,
-			168, 155, 17, 135, 121, 67, 85, 64, 249, 74, 176, 129, 169, 72,
-			81, 104, 138, 141, 55, 11, 140, 11, 244, 56, 181, 153, 93, 47,
-			126, 46, 108, 145, 188, 71, 18, 254, 246, 168, 123, 207, 28, 117,
-			65, 164, 127, 130, 18, 140, 186, 174, 19, 29, 35, 58, 66, 41,
-			106, 206, 182, 60, 138, 212, 130, 72, 236, 148, 14, 210, 248, 199,
-			248, 40, 1, 108, 102, 55, 86, 21, 6, 74, 0, 155, 42, 1,
-			100, 68, 2, 216, 188, 244, 78, 66, 33, 190, 205, 255, 91, 99,
-			127, 202, 176, 236, 2, 166, 203, 10, 38, 255, 226, 31, 51, 144,
-			46, 52, 160, 74, 182, 188, 106, 54, 252, 65, 29, 211, 80, 250,
-			116, 96, 219, 25, 248, 142, 23, 203, 140, 45, 158, 247, 251, 86,
-			143, 71, 34, 145, 178, 100, 135, 2, 237, 120, 216, 98, 123, 52,
-			75, 237, 18, 247, 172, 46, 161, 159, 206, 106, 83, 7, 135, 150,
-			37, 206, 146, 203, 40, 70, 29, 15, 155, 44, 203, 117, 190, 78,
-			162, 20, 131, 101, 129, 108, 95, 89, 186, 198, 254, 154, 103, 249,
-			5, 145, 158, 119, 179, 7, 197, 63, 231, 199, 225, 101, 74, 49,
-			22, 189, 63, 147, 50, 51, 152, 210, 233, 20, 103, 83, 233, 118,
-			242, 206, 156, 174, 9, 112, 24, 144, 121, 70, 120, 126, 163, 168,
-			124, 46, 107, 136, 147, 142, 225, 56, 10, 189, 41, 149, 216, 116,
-			94, 69, 253, 38, 122, 5, 86, 136, 113, 33, 106, 18, 130, 33,
-			142, 18, 163, 76, 89, 84, 68, 101, 34, 160, 116, 133, 106, 140,
-			171, 231, 121, 69, 6, 147, 191, 23, 224, 177, 142, 207, 82, 97,
-			37, 218, 60, 43, 177, 54, 182, 195, 216, 143, 137, 152, 20, 44,
-			67, 121, 112, 142, 45, 236, 91, 159, 27, 122, 71, 19, 9, 42,
-			18, 128, 40, 5, 142, 19, 111, 226, 143, 132, 3, 230, 174, 169,
-			245, 136, 214, 243, 69, 90, 166, 214, 89, 165, 51, 97, 20, 202,
-			52, 41, 155, 150, 218, 227, 231, 239, 58, 247, 48, 245, 149, 96,
-			128, 41, 96, 124, 93, 172, 206, 196, 135, 74, 95, 137, 171, 184,
-			104, 249, 135, 62, 253, 193, 193, 35, 32, 85, 91, 254, 201, 193,
-			114, 103, 236, 62, 157, 132, 167, 80, 64, 169, 234, 162, 12, 251,
-			76, 123, 133, 27, 162, 105, 4, 120, 88, 22, 100, 157, 222, 93,
-			184, 150, 80, 88, 46, 119, 175, 127, 144, 80, 120, 144, 118, 63,
-			250, 44, 105, 117, 255, 19, 0, 0, 255, 255, 246, 212, 229, 122,
-			225, 27, 0, 0},
+			8, 0, 0, 9, 110, 136, 0, 255, 148, 57, 77, 111, 28, 71,
+			118, 211, 211, 36, 151, 42, 125, 152, 110, 202, 182, 118, 188, 146,
+			159, 39, 218, 53, 69, 14, 155, 67, 114, 229, 192, 178, 105, 132,
+			148, 228, 245, 248, 67, 36, 134, 100, 12, 229, 98, 215, 116, 191,
+			153, 169, 184, 167, 170, 183, 170, 90, 228, 108, 16, 32, 128, 143,
+			65, 128, 32, 183, 0, 9, 16, 4, 200, 197, 199, 0, 57, 39,
+			64, 238, 123, 15, 54, 127, 35, 64, 142, 193, 171, 234, 158, 47,
+			74, 182, 51, 0, 133, 174, 87, 175, 94, 189, 239, 143, 18, 251,
+			175, 187, 236, 222, 64, 169, 65, 134, 59, 185, 86, 86, 245, 138,
+			254, 78, 90, 104, 110, 133, 146, 177, 131, 68, 175, 249, 253, 184,
+			218, 111, 62, 98, 171, 79, 74, 148, 232, 14, 251, 153, 193, 68,
+			201, 212, 220, 9, 32, 216, 8, 187, 213, 50, 186, 205, 150, 37,
+			151, 202, 220, 169, 67, 176, 177, 220, 245, 139, 163, 239, 2, 182,
+			158, 168, 81, 188, 64, 243, 232, 102, 69, 241, 132, 32, 39, 193,
+			159, 237, 150, 24, 3, 149, 113, 57, 136, 149, 30, 76, 25, 180,
+			227, 28, 205, 206, 183, 82, 93, 200, 9, 179, 121, 239, 127, 131,
+			224, 159, 235, 225, 111, 78, 142, 190, 175, 223, 251, 141, 63, 124,
+			82, 158, 136, 191, 194, 44, 251, 156, 240, 207, 232, 232, 103, 127,
+			120, 155, 173, 68, 75, 183, 106, 189, 128, 253, 126, 137, 5, 55,
+			162, 240, 86, 45, 218, 251, 207, 37, 120, 172, 242, 177, 22, 131,
+			161, 133, 189, 246, 94, 123, 123, 175, 189, 247, 107, 56, 42, 250,
+			112, 134, 201, 80, 170, 76, 13, 4, 154, 22, 116, 100, 18, 51,
+			6, 95, 136, 4, 165, 193, 20, 10, 153, 162, 6, 59, 68, 56,
+			204, 121, 50, 196, 106, 167, 5, 127, 138, 218, 8, 37, 97, 47,
+			110, 195, 6, 33, 52, 203, 173, 230, 131, 15, 25, 140, 85, 1,
+			35, 62, 6, 169, 44, 20, 6, 193, 14, 133, 129, 190, 200, 16,
+			240, 50, 193, 220, 130, 144, 144, 168, 81, 158, 9, 46, 19, 132,
+			11, 97, 135, 96, 167, 228, 99, 6, 207, 75, 10, 170, 103, 185,
+			144, 192, 33, 81, 249, 24, 84, 127, 22, 13, 184, 101, 12, 220,
+			111, 104, 109, 254, 104, 103, 231, 226, 226, 34, 230, 142, 83, 167,
+			215, 204, 227, 153, 157, 47, 58, 143, 159, 62, 59, 125, 186, 189,
+			23, 183, 25, 131, 115, 153, 161, 49, 160, 241, 183, 133, 208, 152,
+			66, 111, 12, 60, 207, 51, 145, 240, 94, 134, 144, 241, 11, 80,
+			26, 248, 64, 35, 166, 96, 21, 241, 122, 161, 133, 21, 114, 208,
+			2, 163, 250, 246, 130, 107, 100, 144, 10, 99, 181, 232, 21, 118,
+			78, 77, 21, 103, 194, 204, 33, 40, 9, 92, 66, 243, 240, 20,
+			58, 167, 77, 56, 58, 60, 237, 156, 182, 24, 124, 213, 57, 251,
+			244, 248, 252, 12, 190, 58, 236, 118, 15, 159, 157, 117, 158, 158,
+			194, 113, 23, 30, 31, 63, 123, 210, 57, 235, 28, 63, 59, 133,
+			227, 79, 224, 240, 217, 115, 248, 188, 243, 236, 73, 11, 80, 216,
+			33, 106, 192, 203, 92, 19, 247, 74, 131, 32, 5, 98, 26, 51,
+			56, 69, 156, 187, 190, 175, 188, 213, 76, 142, 137, 232, 139, 4,
+			200, 213, 10, 62, 64, 24, 168, 23, 168, 165, 144, 3, 200, 81,
+			143, 132, 33, 35, 26, 224, 50, 101, 144, 137, 145, 176, 206, 229,
+			204, 85, 137, 98, 198, 86, 89, 80, 143, 194, 181, 218, 29, 250,
+			90, 141, 194, 168, 246, 14, 187, 198, 234, 171, 239, 248, 79, 15,
+			92, 175, 125, 234, 128, 215, 253, 167, 7, 222, 174, 181, 28, 48,
+			240, 159, 30, 248, 70, 45, 118, 192, 242, 211, 3, 223, 172, 53,
+			29, 144, 249, 79, 15, 124, 171, 246, 174, 3, 222, 247, 159, 30,
+			120, 167, 246, 161, 3, 254, 210, 127, 254, 227, 26, 171, 47, 213,
+			162, 165, 243, 90, 47, 104, 252, 237, 26, 28, 66, 21, 119, 160,
+			145, 52, 134, 210, 26, 224, 96, 196, 64, 98, 218, 130, 190, 184,
+			196, 116, 59, 67, 57, 176, 67, 48, 57, 151, 206, 183, 196, 8,
+			167, 232, 152, 50, 224, 116, 38, 81, 133, 180, 180, 95, 102, 0,
+			82, 23, 244, 53, 79, 136, 188, 153, 219, 176, 224, 178, 129, 195,
+			99, 160, 209, 168, 172, 32, 172, 24, 58, 22, 132, 1, 33, 83,
+			204, 81, 166, 232, 9, 114, 57, 134, 132, 103, 40, 83, 174, 29,
+			213, 68, 73, 138, 15, 3, 153, 248, 22, 161, 153, 242, 113, 147,
+			129, 210, 208, 28, 41, 105, 135, 205, 138, 140, 198, 140, 147, 227,
+			89, 5, 103, 98, 132, 198, 242, 81, 78, 126, 106, 135, 220, 58,
+			171, 165, 162, 223, 71, 141, 20, 92, 61, 180, 23, 136, 146, 129,
+			189, 152, 197, 126, 193, 179, 2, 13, 17, 227, 83, 85, 17, 11,
+			194, 66, 194, 37, 244, 16, 120, 154, 146, 239, 106, 48, 69, 207,
+			146, 184, 164, 145, 190, 86, 35, 224, 83, 66, 49, 116, 185, 28,
+			56, 143, 231, 121, 174, 213, 165, 24, 113, 139, 217, 24, 182, 182,
+			119, 219, 173, 118, 187, 13, 99, 228, 218, 80, 90, 249, 35, 120,
+			122, 201, 71, 121, 134, 134, 177, 234, 19, 118, 31, 193, 99, 53,
+			202, 11, 139, 83, 54, 220, 29, 115, 236, 146, 230, 32, 55, 88,
+			164, 10, 18, 149, 98, 92, 6, 254, 4, 1, 140, 229, 218, 194,
+			1, 196, 113, 252, 225, 226, 30, 202, 116, 110, 103, 114, 81, 149,
+			99, 171, 93, 127, 176, 130, 198, 149, 89, 15, 0, 101, 58, 89,
+			109, 251, 187, 170, 245, 135, 11, 135, 156, 3, 148, 71, 252, 119,
+			117, 192, 173, 170, 75, 68, 31, 54, 174, 92, 244, 17, 180, 225,
+			87, 191, 90, 164, 245, 49, 180, 31, 192, 95, 148, 153, 14, 174,
+			28, 218, 58, 128, 221, 146, 137, 43, 108, 108, 31, 192, 110, 187,
+			250, 149, 72, 127, 9, 152, 25, 124, 57, 3, 31, 191, 148, 129,
+			143, 126, 152, 129, 237, 31, 96, 96, 235, 101, 12, 204, 152, 127,
+			111, 106, 254, 169, 189, 156, 253, 167, 203, 173, 169, 103, 252, 255,
+			189, 224, 149, 182, 126, 181, 143, 248, 131, 179, 38, 63, 152, 55,
+			57, 108, 77, 197, 44, 65, 37, 189, 169, 209, 171, 35, 165, 26,
+			166, 7, 174, 120, 193, 244, 204, 188, 158, 231, 124, 110, 86, 197,
+			211, 3, 91, 63, 108, 222, 41, 226, 199, 179, 136, 175, 184, 99,
+			235, 229, 119, 108, 255, 136, 5, 247, 95, 21, 192, 41, 183, 104,
+			197, 8, 99, 250, 39, 197, 204, 114, 10, 226, 147, 177, 29, 42,
+			89, 89, 206, 82, 96, 94, 69, 220, 72, 249, 216, 28, 236, 183,
+			96, 36, 100, 97, 209, 28, 236, 182, 31, 204, 135, 25, 28, 76,
+			110, 219, 88, 216, 138, 63, 209, 106, 116, 54, 33, 101, 211, 7,
+			46, 247, 124, 118, 122, 252, 12, 190, 228, 121, 46, 228, 128, 49,
+			232, 72, 15, 233, 43, 61, 226, 182, 229, 178, 230, 132, 127, 234,
+			195, 40, 163, 161, 36, 55, 75, 125, 25, 160, 122, 47, 7, 160,
+			185, 171, 195, 118, 200, 41, 95, 50, 80, 189, 63, 199, 196, 182,
+			224, 98, 136, 218, 87, 225, 18, 17, 201, 112, 46, 41, 35, 152,
+			162, 223, 23, 151, 208, 52, 77, 216, 16, 50, 21, 9, 167, 126,
+			162, 170, 27, 15, 40, 247, 51, 186, 48, 215, 152, 32, 221, 216,
+			27, 187, 115, 178, 24, 245, 80, 207, 148, 152, 150, 111, 150, 166,
+			85, 198, 84, 29, 129, 99, 147, 77, 234, 18, 207, 170, 35, 49,
+			124, 162, 168, 111, 112, 230, 106, 193, 126, 5, 247, 148, 218, 115,
+			180, 204, 80, 21, 89, 10, 61, 100, 19, 217, 197, 156, 162, 72,
+			21, 205, 125, 211, 36, 121, 169, 159, 155, 82, 163, 218, 177, 59,
+			67, 172, 164, 197, 168, 146, 76, 89, 124, 25, 181, 184, 242, 174,
+			93, 162, 75, 116, 22, 168, 50, 24, 137, 68, 207, 211, 253, 169,
+			100, 119, 77, 51, 102, 244, 11, 151, 106, 65, 20, 158, 175, 174,
+			177, 255, 14, 216, 210, 82, 173, 94, 139, 194, 231, 245, 219, 141,
+			223, 7, 112, 234, 154, 130, 201, 157, 101, 151, 57, 219, 21, 196,
+			240, 101, 97, 44, 221, 233, 114, 211, 246, 254, 238, 195, 214, 195,
+			63, 126, 159, 234, 27, 253, 49, 234, 19, 183, 22, 128, 32, 100,
+			146, 21, 70, 188, 192, 24, 158, 41, 139, 143, 136, 170, 65, 232,
+			169, 194, 73, 166, 209, 245, 192, 174, 65, 36, 170, 143, 24, 188,
+			223, 38, 38, 118, 70, 66, 194, 38, 45, 70, 66, 238, 12, 53,
+			108, 194, 222, 175, 97, 168, 119, 82, 62, 134, 77, 216, 127, 255,
+			97, 188, 247, 16, 40, 68, 118, 168, 182, 194, 166, 15, 80, 95,
+			104, 25, 187, 193, 150, 73, 186, 101, 18, 239, 103, 213, 42, 136,
+			194, 231, 171, 175, 85, 171, 48, 10, 159, 71, 235, 236, 187, 208,
+			41, 34, 136, 66, 94, 143, 26, 255, 83, 175, 20, 49, 215, 219,
+			240, 82, 47, 243, 205, 205, 76, 111, 83, 117, 229, 164, 47, 54,
+			85, 88, 21, 76, 6, 92, 183, 237, 226, 69, 73, 156, 80, 211,
+			115, 173, 150, 119, 70, 14, 109, 6, 223, 148, 118, 248, 6, 250,
+			2, 179, 148, 98, 3, 56, 228, 202, 8, 43, 94, 32, 245, 35,
+			18, 7, 220, 125, 127, 227, 24, 42, 17, 189, 159, 87, 89, 192,
+			56, 86, 102, 46, 84, 26, 70, 74, 99, 11, 56, 72, 37, 183,
+			127, 135, 90, 249, 38, 104, 210, 45, 207, 81, 131, 145, 55, 56,
+			155, 136, 199, 71, 232, 218, 71, 242, 46, 146, 119, 129, 207, 69,
+			23, 249, 224, 131, 15, 90, 229, 159, 119, 143, 25, 192, 140, 107,
+			84, 246, 10, 150, 201, 10, 149, 189, 2, 178, 201, 234, 205, 106,
+			21, 70, 33, 95, 123, 189, 183, 226, 6, 197, 125, 246, 135, 183,
+			216, 77, 131, 250, 133, 72, 202, 65, 51, 90, 201, 212, 32, 85,
+			131, 198, 143, 140, 188, 205, 127, 9, 216, 27, 93, 28, 8, 99,
+			81, 159, 104, 236, 139, 203, 46, 254, 182, 64, 99, 105, 222, 205,
+			181, 162, 84, 230, 230, 221, 107, 221, 106, 25, 189, 201, 86, 114,
+			135, 234, 6, 222, 107, 221, 114, 21, 189, 195, 174, 27, 85, 232,
+			4, 191, 22, 178, 175, 238, 132, 16, 110, 92, 235, 50, 15, 234,
+			200, 190, 138, 62, 96, 12, 47, 115, 225, 237, 113, 135, 65, 176,
+			113, 125, 239, 231, 139, 3, 114, 92, 249, 73, 119, 6, 185, 249,
+			87, 1, 123, 115, 145, 79, 147, 43, 105, 144, 216, 49, 152, 104,
+			244, 124, 222, 232, 150, 171, 104, 131, 173, 101, 106, 240, 117, 175,
+			144, 105, 134, 95, 91, 149, 139, 164, 100, 248, 86, 166, 6, 71,
+			14, 124, 70, 208, 232, 62, 187, 53, 131, 89, 232, 236, 78, 232,
+			240, 110, 76, 240, 206, 117, 182, 247, 53, 187, 225, 57, 40, 31,
+			4, 142, 217, 173, 121, 142, 162, 187, 177, 215, 122, 188, 200, 169,
+			211, 104, 227, 222, 171, 182, 189, 32, 159, 253, 205, 109, 154, 213,
+			151, 106, 71, 1, 251, 183, 192, 205, 234, 75, 181, 104, 239, 251,
+			96, 110, 86, 223, 125, 31, 206, 104, 10, 59, 127, 220, 129, 195,
+			194, 14, 149, 54, 49, 28, 102, 25, 184, 97, 158, 38, 1, 114,
+			4, 55, 4, 158, 27, 244, 190, 42, 12, 120, 43, 184, 86, 153,
+			202, 138, 159, 250, 126, 234, 64, 207, 252, 28, 81, 78, 1, 125,
+			74, 87, 85, 29, 43, 7, 105, 55, 203, 79, 70, 194, 149, 218,
+			107, 52, 138, 133, 181, 40, 92, 173, 109, 176, 19, 63, 137, 93,
+			175, 221, 11, 26, 79, 224, 165, 186, 1, 93, 66, 169, 174, 74,
+			188, 0, 191, 59, 125, 8, 120, 172, 148, 78, 133, 228, 86, 233,
+			120, 154, 188, 175, 175, 222, 101, 27, 85, 238, 190, 89, 127, 163,
+			241, 182, 83, 78, 166, 6, 96, 172, 70, 62, 122, 143, 42, 168,
+			115, 226, 120, 54, 15, 222, 172, 175, 86, 171, 32, 10, 111, 94,
+			91, 171, 86, 97, 20, 222, 92, 191, 205, 118, 171, 52, 184, 86,
+			191, 221, 184, 191, 64, 19, 188, 187, 83, 8, 87, 92, 79, 136,
+			7, 203, 116, 166, 34, 78, 65, 187, 118, 173, 74, 178, 65, 24,
+			133, 107, 209, 58, 251, 19, 71, 188, 30, 133, 235, 245, 102, 99,
+			31, 142, 243, 178, 58, 83, 208, 80, 177, 162, 12, 202, 123, 170,
+			240, 131, 91, 117, 5, 117, 7, 124, 128, 210, 78, 238, 170, 47,
+			17, 137, 201, 106, 57, 10, 215, 175, 191, 94, 173, 130, 40, 92,
+			143, 238, 86, 171, 48, 10, 215, 225, 93, 246, 79, 62, 189, 135,
+			81, 120, 183, 190, 213, 248, 187, 208, 9, 86, 74, 51, 141, 181,
+			50, 93, 119, 250, 240, 209, 1, 180, 125, 35, 84, 42, 241, 61,
+			3, 41, 246, 121, 145, 217, 82, 9, 108, 246, 92, 142, 90, 168,
+			20, 46, 68, 150, 81, 214, 115, 79, 40, 228, 136, 108, 246, 158,
+			106, 55, 201, 148, 153, 182, 53, 51, 230, 5, 222, 183, 168, 65,
+			88, 115, 149, 118, 204, 224, 152, 70, 88, 127, 184, 229, 60, 165,
+			52, 138, 158, 9, 77, 247, 146, 131, 198, 26, 207, 139, 84, 144,
+			41, 57, 64, 77, 247, 242, 132, 230, 105, 207, 86, 167, 15, 166,
+			240, 92, 146, 152, 194, 148, 249, 191, 108, 40, 232, 105, 138, 102,
+			234, 33, 58, 149, 144, 69, 202, 212, 159, 169, 132, 103, 96, 185,
+			249, 182, 229, 102, 39, 6, 70, 141, 202, 96, 67, 7, 127, 207,
+			148, 140, 25, 247, 106, 213, 67, 72, 181, 202, 115, 76, 33, 45,
+			144, 92, 167, 207, 69, 70, 54, 157, 229, 123, 98, 218, 112, 133,
+			76, 212, 168, 86, 65, 20, 222, 125, 251, 151, 213, 138, 204, 183,
+			177, 201, 62, 102, 245, 165, 32, 90, 122, 183, 214, 14, 26, 123,
+			78, 195, 186, 76, 36, 48, 66, 99, 248, 96, 90, 199, 230, 67,
+			14, 186, 39, 143, 203, 24, 34, 7, 125, 119, 245, 30, 251, 142,
+			26, 160, 128, 130, 232, 126, 125, 189, 241, 2, 78, 93, 6, 165,
+			68, 97, 39, 150, 35, 137, 28, 56, 134, 51, 122, 189, 43, 203,
+			161, 175, 92, 212, 161, 190, 64, 221, 227, 86, 140, 40, 53, 28,
+			21, 54, 67, 205, 192, 39, 95, 67, 2, 115, 99, 80, 91, 80,
+			23, 18, 181, 25, 138, 124, 146, 154, 60, 249, 82, 246, 192, 245,
+			41, 247, 203, 186, 23, 184, 248, 188, 191, 122, 171, 90, 133, 81,
+			120, 255, 245, 136, 125, 239, 249, 13, 162, 112, 179, 126, 175, 241,
+			15, 129, 19, 95, 242, 169, 9, 78, 138, 222, 206, 105, 209, 3,
+			151, 245, 233, 246, 188, 232, 101, 194, 12, 161, 231, 24, 115, 181,
+			102, 219, 135, 27, 245, 26, 158, 93, 138, 241, 89, 150, 201, 69,
+			156, 168, 212, 248, 143, 114, 59, 166, 25, 138, 20, 82, 150, 90,
+			122, 201, 164, 124, 101, 53, 151, 38, 87, 36, 220, 116, 18, 40,
+			57, 152, 136, 69, 153, 97, 179, 204, 12, 65, 157, 20, 191, 121,
+			237, 231, 213, 42, 140, 194, 205, 95, 220, 101, 127, 239, 197, 170,
+			71, 225, 78, 253, 23, 141, 191, 246, 98, 157, 119, 191, 32, 1,
+			78, 142, 79, 207, 126, 148, 251, 31, 100, 190, 144, 101, 223, 181,
+			32, 0, 1, 82, 161, 49, 177, 240, 233, 217, 217, 9, 8, 57,
+			64, 67, 238, 56, 21, 108, 34, 69, 125, 153, 120, 171, 164, 160,
+			12, 185, 115, 237, 173, 106, 21, 70, 225, 78, 227, 109, 246, 239,
+			1, 171, 175, 212, 162, 165, 135, 181, 163, 160, 241, 175, 1, 116,
+			103, 35, 179, 186, 153, 94, 135, 224, 11, 53, 120, 162, 6, 115,
+			193, 143, 50, 205, 149, 144, 150, 148, 72, 175, 194, 22, 117, 159,
+			39, 72, 145, 108, 135, 172, 58, 81, 74, 44, 164, 177, 244, 94,
+			236, 167, 2, 148, 244, 92, 91, 197, 93, 149, 111, 230, 242, 2,
+			161, 85, 174, 233, 209, 40, 4, 133, 20, 86, 240, 76, 252, 174,
+			10, 66, 198, 194, 21, 114, 188, 135, 171, 183, 233, 213, 124, 105,
+			197, 205, 6, 135, 245, 47, 27, 255, 177, 52, 9, 166, 83, 119,
+			30, 120, 150, 169, 11, 18, 102, 129, 167, 217, 242, 0, 124, 182,
+			124, 84, 53, 141, 205, 10, 30, 195, 121, 174, 36, 152, 34, 73,
+			208, 152, 214, 149, 164, 232, 18, 153, 70, 91, 104, 57, 39, 18,
+			155, 47, 27, 50, 157, 145, 43, 231, 154, 143, 208, 21, 85, 171,
+			28, 69, 207, 227, 196, 53, 166, 131, 83, 194, 179, 172, 124, 248,
+			126, 153, 32, 3, 94, 150, 123, 222, 19, 153, 176, 227, 153, 136,
+			114, 173, 44, 175, 180, 109, 114, 158, 32, 37, 136, 146, 164, 246,
+			93, 243, 144, 191, 64, 248, 170, 219, 57, 123, 234, 242, 47, 117,
+			255, 202, 101, 248, 73, 93, 169, 236, 81, 81, 232, 244, 231, 241,
+			133, 113, 255, 119, 80, 142, 7, 101, 182, 118, 74, 161, 68, 58,
+			163, 211, 230, 201, 228, 25, 251, 9, 74, 129, 105, 19, 6, 221,
+			147, 199, 147, 231, 161, 195, 5, 255, 40, 179, 115, 101, 42, 26,
+			154, 45, 140, 148, 177, 64, 47, 175, 49, 28, 166, 169, 40, 11,
+			244, 188, 222, 231, 107, 204, 28, 23, 208, 60, 204, 52, 242, 116,
+			252, 244, 82, 24, 107, 230, 57, 184, 193, 150, 201, 159, 130, 40,
+			60, 92, 185, 93, 173, 234, 81, 120, 248, 198, 102, 181, 10, 163,
+			240, 240, 225, 231, 189, 149, 92, 43, 171, 246, 255, 111, 0, 82,
+			37, 246, 162, 186, 26, 0, 0},
 	)
 }
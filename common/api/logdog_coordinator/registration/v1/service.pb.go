@@ -74,7 +74,13 @@ type RegisterPrefixResponse struct {
 	Secret []byte `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 	// The name of the Pub/Sub topic to publish butlerproto-formatted Butler log
 	// bundles to.
+	//
+	// This is empty if the service uses a transport other than Pub/Sub.
 	LogBundleTopic string `protobuf:"bytes,2,opt,name=log_bundle_topic,json=logBundleTopic" json:"log_bundle_topic,omitempty"`
+	// The URL to POST butlerproto-formatted Butler log bundles to.
+	//
+	// This is empty unless the service uses the direct HTTP ingestion transport.
+	LogBundleUrl string `protobuf:"bytes,3,opt,name=log_bundle_url,json=logBundleUrl" json:"log_bundle_url,omitempty"`
 }
 
 func (m *RegisterPrefixResponse) Reset()                    { *m = RegisterPrefixResponse{} }
@@ -197,22 +203,23 @@ var _Registration_serviceDesc = grpc.ServiceDesc{
 }
 
 var fileDescriptor0 = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x90, 0x4d, 0x4b, 0xc3, 0x40,
-	0x10, 0x86, 0x89, 0x85, 0x88, 0xd3, 0x5a, 0x64, 0xc1, 0x12, 0x0b, 0xd6, 0xd2, 0x53, 0x4f, 0x5b,
-	0xa8, 0x27, 0xaf, 0xe2, 0xc5, 0x93, 0x12, 0x3c, 0x79, 0x09, 0x66, 0x33, 0x59, 0x56, 0x42, 0x66,
-	0xdd, 0x0f, 0xe9, 0x1f, 0xf2, 0x7f, 0xba, 0xdd, 0x4d, 0x41, 0xc5, 0x1e, 0xe7, 0x99, 0x37, 0x99,
-	0x67, 0x5f, 0x38, 0xb7, 0x68, 0x3e, 0x95, 0x40, 0xae, 0x0d, 0x39, 0x62, 0x79, 0x47, 0xb2, 0x21,
-	0x39, 0x5f, 0x48, 0x22, 0xd9, 0xe1, 0x26, 0xd2, 0xda, 0xb7, 0x9b, 0xc6, 0x9b, 0x37, 0xa7, 0xa8,
-	0x4f, 0xb9, 0xd5, 0x57, 0x06, 0x97, 0x25, 0x4a, 0x65, 0x1d, 0x9a, 0x67, 0x83, 0xad, 0xda, 0x95,
-	0xf8, 0xe1, 0xd1, 0x3a, 0x56, 0xc0, 0x69, 0x88, 0xbc, 0xa3, 0x70, 0x45, 0xb6, 0xcc, 0xd6, 0x67,
-	0xe5, 0x61, 0x64, 0x33, 0xc8, 0x75, 0x8c, 0x16, 0x27, 0x71, 0x31, 0x4c, 0xec, 0x06, 0xc6, 0x96,
-	0xbc, 0x11, 0x58, 0xa9, 0xbe, 0xa5, 0x62, 0xb4, 0x1c, 0x85, 0x25, 0x24, 0xf4, 0x18, 0x08, 0xbb,
-	0x03, 0xc0, 0x9d, 0x56, 0x49, 0xa0, 0x80, 0xf0, 0xf1, 0x78, 0x7b, 0xc5, 0x93, 0x21, 0x3f, 0x18,
-	0xf2, 0x87, 0xc1, 0xb0, 0xfc, 0x11, 0x5e, 0xbd, 0xc2, 0xec, 0xaf, 0xa6, 0xd5, 0xd4, 0x5b, 0xdc,
-	0xdb, 0x58, 0x14, 0x06, 0x93, 0xe6, 0xa4, 0x1c, 0x26, 0xb6, 0x86, 0x8b, 0xd0, 0x41, 0x55, 0xfb,
-	0xbe, 0xe9, 0xb0, 0x72, 0xa4, 0x95, 0x18, 0x7c, 0xa7, 0x81, 0xdf, 0x47, 0xfc, 0xb2, 0xa7, 0xdb,
-	0x0a, 0x26, 0xe9, 0xdf, 0xe9, 0x16, 0x7b, 0x82, 0xe9, 0xef, 0x5b, 0xec, 0x9a, 0xa7, 0x3a, 0xf9,
-	0xbf, 0x55, 0xcd, 0x17, 0xc7, 0xd6, 0x49, 0xb1, 0xce, 0xe3, 0xdb, 0x6e, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x70, 0xdd, 0xcb, 0x0b, 0xa4, 0x01, 0x00, 0x00,
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x90, 0x4f, 0x4b, 0x03, 0x31,
+	0x10, 0xc5, 0x59, 0x0b, 0x95, 0x4e, 0x6b, 0x91, 0x80, 0x25, 0x16, 0xac, 0xa5, 0x78, 0xd8, 0x53,
+	0x0a, 0xf5, 0xe4, 0x55, 0xbc, 0x78, 0x52, 0x82, 0x9e, 0x17, 0xbb, 0x9d, 0x0d, 0x91, 0xb0, 0x13,
+	0xf3, 0x47, 0x7a, 0xf4, 0xcb, 0xf8, 0x3d, 0xa5, 0xc9, 0x56, 0xaa, 0xe8, 0xf1, 0xbd, 0xf9, 0x1d,
+	0x7e, 0xf3, 0xe0, 0xc4, 0xa3, 0x7b, 0xd7, 0x35, 0x0a, 0xeb, 0x28, 0x10, 0xeb, 0x1b, 0x52, 0x1b,
+	0x52, 0xd3, 0x99, 0x22, 0x52, 0x06, 0x97, 0xa9, 0x5d, 0xc7, 0x66, 0xb9, 0x89, 0xee, 0x25, 0x68,
+	0x6a, 0x33, 0xb7, 0xf8, 0x2c, 0xe0, 0x4c, 0xa2, 0xd2, 0x3e, 0xa0, 0x7b, 0x74, 0xd8, 0xe8, 0xad,
+	0xc4, 0xb7, 0x88, 0x3e, 0x30, 0x0e, 0xc7, 0xd6, 0xd1, 0x2b, 0xd6, 0x81, 0x17, 0xf3, 0xa2, 0x1c,
+	0xc8, 0x7d, 0x64, 0x13, 0xe8, 0xdb, 0x84, 0xf2, 0xa3, 0x74, 0xe8, 0x12, 0xbb, 0x84, 0xa1, 0xa7,
+	0xe8, 0x6a, 0xac, 0x74, 0xdb, 0x10, 0xef, 0xcd, 0x7b, 0xe5, 0x40, 0x42, 0xae, 0xee, 0xdb, 0x86,
+	0xd8, 0x0d, 0x00, 0x6e, 0xad, 0xce, 0x02, 0x1c, 0xe6, 0x45, 0x39, 0x5c, 0x9d, 0x8b, 0x6c, 0x28,
+	0xf6, 0x86, 0xe2, 0xae, 0x33, 0x94, 0x07, 0xf0, 0xe2, 0xa3, 0x80, 0xc9, 0x6f, 0x4f, 0x6f, 0xa9,
+	0xf5, 0xb8, 0xd3, 0xf1, 0x58, 0x3b, 0xcc, 0x9e, 0x23, 0xd9, 0x25, 0x56, 0xc2, 0xa9, 0x21, 0x55,
+	0xad, 0x63, 0xbb, 0x31, 0x58, 0x05, 0xb2, 0xba, 0xee, 0x84, 0xc7, 0x86, 0xd4, 0x6d, 0xaa, 0x9f,
+	0x76, 0x2d, 0xbb, 0x82, 0xf1, 0x01, 0x19, 0x9d, 0xe1, 0xbd, 0xc4, 0x8d, 0xbe, 0xb9, 0x67, 0x67,
+	0x56, 0x15, 0x8c, 0xb2, 0x41, 0x56, 0x62, 0x0f, 0x30, 0xfe, 0x69, 0xc4, 0x2e, 0x44, 0x5e, 0x5d,
+	0xfc, 0xb9, 0xe8, 0x74, 0xf6, 0xdf, 0x39, 0x3f, 0xb2, 0xee, 0xa7, 0x09, 0xae, 0xbf, 0x06, 0x00,
+	0xb1, 0xb4, 0xa3, 0x1f, 0xcb, 0x01, 0x00, 0x00,
 }
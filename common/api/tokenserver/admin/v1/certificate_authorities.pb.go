@@ -8,6 +8,7 @@ Package admin is a generated protocol buffer package.
 It is generated from these files:
 	certificate_authorities.proto
 	config.proto
+	mint_log.proto
 	service_accounts.proto
 
 It has these top-level messages:
@@ -26,6 +27,12 @@ It has these top-level messages:
 	TokenServerConfig
 	CertificateAuthorityConfig
 	DomainConfig
+	JWTIssuerConfig
+	DelegationRule
+	QueryMintLogRequest
+	MintLogEntry
+	QueryMintLogResponse
+	ExportMintLogResponse
 	CreateServiceAccountRequest
 	CreateServiceAccountResponse
 */
@@ -212,10 +219,14 @@ func (*CheckCertificateResponse) Descriptor() ([]byte, []int) { return fileDescr
 
 // CRLStatus describes the latest known state of imported CRL.
 type CRLStatus struct {
-	LastUpdateTime    *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=last_update_time,json=lastUpdateTime" json:"last_update_time,omitempty"`
-	LastFetchTime     *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=last_fetch_time,json=lastFetchTime" json:"last_fetch_time,omitempty"`
-	LastFetchEtag     string                      `protobuf:"bytes,3,opt,name=last_fetch_etag,json=lastFetchEtag" json:"last_fetch_etag,omitempty"`
-	RevokedCertsCount int64                       `protobuf:"varint,4,opt,name=revoked_certs_count,json=revokedCertsCount" json:"revoked_certs_count,omitempty"`
+	LastUpdateTime         *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=last_update_time,json=lastUpdateTime" json:"last_update_time,omitempty"`
+	LastFetchTime          *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=last_fetch_time,json=lastFetchTime" json:"last_fetch_time,omitempty"`
+	LastFetchEtag          string                      `protobuf:"bytes,3,opt,name=last_fetch_etag,json=lastFetchEtag" json:"last_fetch_etag,omitempty"`
+	RevokedCertsCount      int64                       `protobuf:"varint,4,opt,name=revoked_certs_count,json=revokedCertsCount" json:"revoked_certs_count,omitempty"`
+	LastDeltaUpdateTime    *google_protobuf1.Timestamp `protobuf:"bytes,5,opt,name=last_delta_update_time,json=lastDeltaUpdateTime" json:"last_delta_update_time,omitempty"`
+	LastDeltaFetchTime     *google_protobuf1.Timestamp `protobuf:"bytes,6,opt,name=last_delta_fetch_time,json=lastDeltaFetchTime" json:"last_delta_fetch_time,omitempty"`
+	LastDeltaFetchEtag     string                      `protobuf:"bytes,7,opt,name=last_delta_fetch_etag,json=lastDeltaFetchEtag" json:"last_delta_fetch_etag,omitempty"`
+	DeltaRevokedCertsCount int64                       `protobuf:"varint,8,opt,name=delta_revoked_certs_count,json=deltaRevokedCertsCount" json:"delta_revoked_certs_count,omitempty"`
 }
 
 func (m *CRLStatus) Reset()                    { *m = CRLStatus{} }
@@ -237,6 +248,20 @@ func (m *CRLStatus) GetLastFetchTime() *google_protobuf1.Timestamp {
 	return nil
 }
 
+func (m *CRLStatus) GetLastDeltaUpdateTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.LastDeltaUpdateTime
+	}
+	return nil
+}
+
+func (m *CRLStatus) GetLastDeltaFetchTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.LastDeltaFetchTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ImportConfigRequest)(nil), "tokenserver.admin.ImportConfigRequest")
 	proto.RegisterType((*ImportConfigResponse)(nil), "tokenserver.admin.ImportConfigResponse")
@@ -570,54 +595,58 @@ var _CertificateAuthorities_serviceDesc = grpc.ServiceDesc{
 }
 
 var fileDescriptor0 = []byte{
-	// 770 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0x56, 0x92, 0xb6, 0x49, 0x26, 0x6d, 0x9a, 0x6e, 0x7b, 0x7a, 0x72, 0xdc, 0x73, 0xd4, 0x83,
-	0xa1, 0xa5, 0x02, 0xe1, 0x8a, 0xf0, 0x2b, 0xe0, 0xa6, 0xa4, 0x01, 0x21, 0x55, 0x02, 0xb9, 0x85,
-	0xab, 0x4a, 0x96, 0x6b, 0x6f, 0xd2, 0x55, 0x62, 0x3b, 0x78, 0xd7, 0x96, 0xf2, 0x48, 0xbc, 0x03,
-	0x97, 0xbc, 0x0e, 0xef, 0xc0, 0xfe, 0xd9, 0xcd, 0x8f, 0x45, 0xca, 0x9d, 0x67, 0xf6, 0x9b, 0x9f,
-	0x7c, 0x33, 0xf3, 0x05, 0xfe, 0xf3, 0x70, 0xcc, 0x48, 0x9f, 0x78, 0x2e, 0xc3, 0x8e, 0x9b, 0xb0,
-	0xeb, 0x28, 0x26, 0x8c, 0x60, 0x6a, 0x8d, 0xe3, 0x88, 0x45, 0x68, 0x8b, 0x45, 0x43, 0x1c, 0x52,
-	0x1c, 0xa7, 0x38, 0xb6, 0x5c, 0x3f, 0x20, 0xa1, 0xb1, 0x37, 0x88, 0xa2, 0xc1, 0x08, 0x1f, 0x4b,
-	0xc0, 0x55, 0xd2, 0x3f, 0xc6, 0xc1, 0x98, 0x4d, 0x14, 0xde, 0xd8, 0x9f, 0x7f, 0x64, 0x24, 0xc0,
-	0x94, 0xb9, 0xc1, 0x58, 0x03, 0xd6, 0xbd, 0x28, 0xec, 0x93, 0x81, 0xb2, 0xcc, 0x6f, 0x25, 0xd8,
-	0xfe, 0x10, 0x8c, 0xa3, 0x98, 0x75, 0xa5, 0xdb, 0xc6, 0x5f, 0x13, 0x0e, 0x47, 0x17, 0x00, 0x3e,
-	0x4e, 0x1d, 0x85, 0x6d, 0x97, 0xfe, 0xaf, 0x1c, 0x35, 0x3a, 0xcf, 0xac, 0x85, 0x5e, 0xac, 0x82,
-	0x58, 0xeb, 0x14, 0xa7, 0xca, 0xd1, 0x0b, 0x59, 0x3c, 0xb1, 0xeb, 0x7e, 0x66, 0x1b, 0x6f, 0xa0,
-	0x39, 0xfb, 0x88, 0x5a, 0x50, 0x19, 0xe2, 0x09, 0x2f, 0x50, 0x3a, 0xaa, 0xdb, 0xe2, 0x13, 0xed,
-	0xc0, 0x6a, 0xea, 0x8e, 0x12, 0xdc, 0x2e, 0x4b, 0x9f, 0x32, 0x5e, 0x95, 0x5f, 0x96, 0xcc, 0x0e,
-	0xec, 0xcc, 0x96, 0xa3, 0xe3, 0x88, 0x77, 0x83, 0x0c, 0xa8, 0xc5, 0x38, 0x25, 0x94, 0x44, 0xa1,
-	0x4e, 0x94, 0xdb, 0xe6, 0x0b, 0xd8, 0x7c, 0x87, 0x99, 0x77, 0xdd, 0xb5, 0xcf, 0xb2, 0x9f, 0xd6,
-	0x84, 0xb2, 0x97, 0x01, 0xf9, 0x97, 0x28, 0xd8, 0x8f, 0x62, 0x4f, 0x15, 0xac, 0xd9, 0xca, 0x30,
-	0x3f, 0x42, 0xeb, 0x26, 0x50, 0x17, 0x7a, 0x0d, 0xe0, 0xc5, 0x23, 0x87, 0xb3, 0xc9, 0x12, 0x2a,
-	0x33, 0x34, 0x3a, 0xff, 0x16, 0x90, 0xc2, 0x63, 0xce, 0x25, 0xc6, 0xae, 0x73, 0xbc, 0xfa, 0x34,
-	0xef, 0xc0, 0xe6, 0x19, 0xa1, 0xac, 0x7b, 0x42, 0xf3, 0x7c, 0x59, 0x27, 0x15, 0xd5, 0x89, 0x79,
-	0x0f, 0xd0, 0x7b, 0xcc, 0x11, 0x3a, 0xb8, 0xb8, 0x5f, 0xf3, 0x7b, 0x19, 0xb6, 0x67, 0x60, 0x3a,
-	0x5b, 0x0f, 0xd6, 0xf2, 0x71, 0x89, 0xce, 0x1e, 0x15, 0x75, 0x76, 0xb3, 0x6b, 0x27, 0x7a, 0xd5,
-	0x26, 0x9a, 0x4d, 0x1d, 0x8c, 0x10, 0xac, 0x88, 0x8d, 0xd4, 0xf4, 0xcb, 0x6f, 0xd4, 0x86, 0x6a,
-	0x8c, 0x83, 0x28, 0xc5, 0x7e, 0xbb, 0x22, 0x49, 0xca, 0x4c, 0x41, 0x5e, 0x8c, 0x5d, 0x7f, 0xd2,
-	0x5e, 0x51, 0xe4, 0x49, 0x03, 0xed, 0x41, 0xdd, 0xf5, 0x7d, 0xec, 0x3b, 0x7c, 0x0e, 0xed, 0x55,
-	0x35, 0x12, 0xe9, 0xb0, 0x71, 0x8a, 0xf6, 0xa1, 0x91, 0x8c, 0x7d, 0xde, 0x81, 0x7a, 0x5e, 0x93,
-	0xcf, 0xa0, 0x5d, 0x1a, 0xa0, 0xd3, 0x4b, 0x40, 0x55, 0x01, 0xb4, 0x4b, 0x00, 0x66, 0xe7, 0x50,
-	0xfb, 0xb3, 0x39, 0x3c, 0xe7, 0x5b, 0xc4, 0x49, 0x4b, 0x39, 0xda, 0x17, 0x74, 0x4c, 0xd3, 0xec,
-	0xe6, 0x34, 0xbb, 0xc2, 0xa6, 0xa1, 0x66, 0x81, 0x7f, 0x99, 0x8f, 0xe1, 0xaf, 0xb9, 0x38, 0xcd,
-	0xbb, 0x24, 0x47, 0xba, 0x65, 0xb4, 0x24, 0x47, 0x9a, 0xe6, 0x53, 0xf8, 0xbb, 0x7b, 0x8d, 0xbd,
-	0xe1, 0x14, 0xeb, 0x59, 0xb5, 0x7f, 0xa0, 0x26, 0x98, 0x75, 0xc6, 0x38, 0xd0, 0x35, 0xab, 0xc2,
-	0xfe, 0x84, 0x03, 0xf3, 0x12, 0xda, 0x8b, 0x51, 0xba, 0x16, 0x0f, 0x23, 0xd4, 0xe1, 0x27, 0x41,
-	0xf2, 0x62, 0x84, 0x7e, 0x11, 0x26, 0x3a, 0x80, 0x26, 0x09, 0xe5, 0x0b, 0x67, 0xcd, 0xa5, 0x51,
-	0xd6, 0xfb, 0x86, 0xf6, 0xda, 0xd2, 0x69, 0xfe, 0x2c, 0x41, 0x3d, 0xe7, 0x05, 0x9d, 0x42, 0x6b,
-	0xe4, 0x52, 0xe6, 0x28, 0xf6, 0x1d, 0xa1, 0x15, 0x7a, 0x7b, 0x0c, 0x4b, 0x09, 0x89, 0x95, 0x09,
-	0x89, 0x75, 0x91, 0x09, 0x89, 0xdd, 0x14, 0x31, 0x9f, 0x65, 0x88, 0x70, 0xa2, 0xb7, 0xb0, 0x29,
-	0xb3, 0xf4, 0xc5, 0xc1, 0xa8, 0x24, 0xe5, 0xa5, 0x49, 0x36, 0x44, 0x88, 0x3c, 0x31, 0x99, 0xe3,
-	0x70, 0x26, 0x07, 0x66, 0xee, 0x40, 0xae, 0x5a, 0x7d, 0x0a, 0xd7, 0xe3, 0x4e, 0x64, 0xc1, 0xb6,
-	0xa6, 0xd7, 0x11, 0x84, 0x51, 0x2e, 0x51, 0x49, 0xc8, 0xe4, 0xfa, 0x55, 0xec, 0xad, 0xf8, 0x66,
-	0x3e, 0xb4, 0x2b, 0x1e, 0x3a, 0x3f, 0x56, 0x60, 0xb7, 0x60, 0xeb, 0xb9, 0xc0, 0x22, 0x07, 0xd6,
-	0xa7, 0xf5, 0x04, 0x1d, 0xde, 0x4e, 0xdf, 0x8c, 0xfb, 0x4b, 0x71, 0x7a, 0x5a, 0xe7, 0x50, 0xcb,
-	0x34, 0x04, 0x99, 0x05, 0x41, 0x73, 0xca, 0x64, 0xdc, 0xfd, 0x2d, 0x26, 0x3f, 0xf3, 0xaa, 0xd6,
-	0x11, 0xb4, 0xbb, 0x40, 0x6f, 0x4f, 0xfc, 0x13, 0x18, 0x45, 0xb5, 0xe6, 0xb5, 0xe7, 0x12, 0x1a,
-	0x53, 0x22, 0x82, 0x0e, 0x0a, 0x42, 0x16, 0xb5, 0xc8, 0x38, 0x5c, 0x06, 0xd3, 0xd9, 0xaf, 0x60,
-	0x63, 0xe6, 0x58, 0x50, 0x21, 0x67, 0x05, 0x67, 0x68, 0x1c, 0x2d, 0x07, 0xea, 0x1a, 0x43, 0x68,
-	0xcd, 0xdf, 0x09, 0x7a, 0x50, 0xa4, 0x02, 0xc5, 0x27, 0x68, 0x3c, 0xbc, 0x15, 0x56, 0x15, 0xbb,
-	0x5a, 0x93, 0x14, 0x3f, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xcc, 0x4c, 0xa1, 0xae, 0x07,
-	0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x55, 0xdb, 0x6e, 0xdb, 0x46,
+	0x10, 0x85, 0x24, 0x5b, 0x97, 0x51, 0x2c, 0x2b, 0x2b, 0x47, 0xa5, 0x99, 0x16, 0x49, 0xd9, 0xc6,
+	0x35, 0x5a, 0x94, 0x41, 0xd4, 0xfb, 0xe5, 0xc5, 0x95, 0xd5, 0x22, 0x80, 0x8b, 0x14, 0x4c, 0xda,
+	0xa7, 0x00, 0xc4, 0x9a, 0x1c, 0xc9, 0x84, 0x44, 0x52, 0xdd, 0x5d, 0x11, 0xd0, 0x27, 0xf5, 0x1f,
+	0xfa, 0xd8, 0xbf, 0xea, 0x4b, 0xb1, 0x17, 0x52, 0x94, 0x44, 0x54, 0xce, 0x1b, 0x67, 0xf6, 0xcc,
+	0x99, 0xd9, 0xb3, 0xa3, 0x23, 0xf8, 0x20, 0x40, 0x26, 0xa2, 0x69, 0x14, 0x50, 0x81, 0x3e, 0x5d,
+	0x89, 0xbb, 0x94, 0x45, 0x22, 0x42, 0xee, 0x2e, 0x59, 0x2a, 0x52, 0xf2, 0x50, 0xa4, 0x73, 0x4c,
+	0x38, 0xb2, 0x0c, 0x99, 0x4b, 0xc3, 0x38, 0x4a, 0xec, 0xc7, 0xb3, 0x34, 0x9d, 0x2d, 0xf0, 0xb9,
+	0x02, 0xdc, 0xae, 0xa6, 0xcf, 0x31, 0x5e, 0x8a, 0xb5, 0xc6, 0xdb, 0x4f, 0x76, 0x0f, 0x45, 0x14,
+	0x23, 0x17, 0x34, 0x5e, 0x1a, 0xc0, 0x83, 0x20, 0x4d, 0xa6, 0xd1, 0x4c, 0x47, 0xce, 0x5f, 0x35,
+	0x18, 0xbc, 0x8c, 0x97, 0x29, 0x13, 0x63, 0x95, 0xf6, 0xf0, 0xcf, 0x15, 0x72, 0x41, 0xde, 0x00,
+	0x84, 0x98, 0xf9, 0x1a, 0x6b, 0xd5, 0x9e, 0x36, 0x2e, 0xbb, 0xa3, 0xaf, 0xdc, 0xbd, 0x59, 0xdc,
+	0x8a, 0x5a, 0xf7, 0x1a, 0x33, 0x9d, 0x98, 0x24, 0x82, 0xad, 0xbd, 0x4e, 0x98, 0xc7, 0xf6, 0x8f,
+	0xd0, 0xdb, 0x3e, 0x24, 0x7d, 0x68, 0xcc, 0x71, 0x6d, 0xd5, 0x9e, 0xd6, 0x2e, 0x3b, 0x9e, 0xfc,
+	0x24, 0x67, 0x70, 0x9c, 0xd1, 0xc5, 0x0a, 0xad, 0xba, 0xca, 0xe9, 0xe0, 0xfb, 0xfa, 0xb7, 0x35,
+	0x67, 0x04, 0x67, 0xdb, 0xed, 0xf8, 0x32, 0x4d, 0x38, 0x12, 0x1b, 0xda, 0x0c, 0xb3, 0x88, 0x47,
+	0x69, 0x62, 0x88, 0x8a, 0xd8, 0xf9, 0x06, 0x4e, 0x7f, 0x46, 0x11, 0xdc, 0x8d, 0xbd, 0x9b, 0xfc,
+	0x6a, 0x3d, 0xa8, 0x07, 0x39, 0xb0, 0x1e, 0x24, 0xb2, 0xe1, 0x34, 0x65, 0x81, 0x6e, 0xd8, 0xf6,
+	0x74, 0xe0, 0xbc, 0x82, 0xfe, 0xa6, 0xd0, 0x34, 0xfa, 0x01, 0x20, 0x60, 0x0b, 0x9f, 0x0b, 0x2a,
+	0x56, 0x5c, 0x31, 0x74, 0x47, 0xef, 0x57, 0x88, 0x32, 0xf6, 0x6e, 0x5e, 0x2b, 0x8c, 0xd7, 0x09,
+	0xd8, 0x42, 0x7f, 0x3a, 0x1f, 0xc2, 0xe9, 0x4d, 0xc4, 0xc5, 0xf8, 0x8a, 0x17, 0x7c, 0xf9, 0x24,
+	0x0d, 0x3d, 0x89, 0xf3, 0x31, 0x90, 0x5f, 0x50, 0x8c, 0xaf, 0x4c, 0x71, 0xf5, 0xbc, 0xce, 0xdf,
+	0x75, 0x18, 0x6c, 0xc1, 0x0c, 0xdb, 0x04, 0x9a, 0xc5, 0x73, 0xc9, 0xc9, 0x3e, 0xaf, 0x9a, 0x6c,
+	0xb3, 0x6b, 0x57, 0x66, 0xd5, 0xd6, 0x46, 0x4d, 0x53, 0x4c, 0x08, 0x1c, 0xc9, 0x8d, 0x34, 0xf2,
+	0xab, 0x6f, 0x62, 0x41, 0x8b, 0x61, 0x9c, 0x66, 0x18, 0x5a, 0x0d, 0x25, 0x52, 0x1e, 0x4a, 0xf1,
+	0x18, 0xd2, 0x70, 0x6d, 0x1d, 0x69, 0xf1, 0x54, 0x40, 0x1e, 0x43, 0x87, 0x86, 0x21, 0x86, 0x3e,
+	0xc3, 0xcc, 0x3a, 0xd6, 0x4f, 0xa2, 0x12, 0x1e, 0x66, 0xe4, 0x09, 0x74, 0x57, 0xcb, 0x90, 0x0a,
+	0x73, 0xdc, 0x54, 0xc7, 0x60, 0x52, 0x06, 0x60, 0xe8, 0x15, 0xa0, 0xa5, 0x01, 0x26, 0x25, 0x01,
+	0xdb, 0xef, 0xd0, 0x7e, 0xb7, 0x77, 0xf8, 0x1a, 0xce, 0x5e, 0x72, 0x0f, 0xb3, 0x74, 0x8e, 0xa1,
+	0x94, 0xa3, 0x2c, 0x33, 0x2d, 0x64, 0xa6, 0x32, 0xe6, 0x89, 0x51, 0xa1, 0xce, 0x13, 0xe7, 0x05,
+	0x3c, 0xda, 0xa9, 0x33, 0xba, 0x2b, 0x71, 0x54, 0xda, 0xaa, 0xe5, 0xe2, 0xa8, 0xd0, 0xf9, 0x12,
+	0xde, 0x1b, 0xdf, 0x61, 0x30, 0x2f, 0xa9, 0x9e, 0x77, 0x3b, 0x87, 0xb6, 0x54, 0xd6, 0x5f, 0x62,
+	0x6c, 0x7a, 0xb6, 0x64, 0xfc, 0x1b, 0xc6, 0xce, 0x5b, 0xb0, 0xf6, 0xab, 0x4c, 0xaf, 0x73, 0x68,
+	0x47, 0xdc, 0xcf, 0xe8, 0x22, 0x2a, 0x9a, 0x45, 0xfc, 0x0f, 0x19, 0x92, 0x67, 0xd0, 0x8b, 0x12,
+	0x75, 0xe2, 0x33, 0xa4, 0x3c, 0xcd, 0x67, 0x3f, 0x31, 0x59, 0x4f, 0x25, 0x9d, 0x7f, 0x1b, 0xd0,
+	0x29, 0x74, 0x21, 0xd7, 0xd0, 0x5f, 0x50, 0x2e, 0x7c, 0xad, 0xbe, 0x2f, 0xbd, 0xc2, 0x6c, 0x8f,
+	0xed, 0x6a, 0x23, 0x71, 0x73, 0x23, 0x71, 0xdf, 0xe4, 0x46, 0xe2, 0xf5, 0x64, 0xcd, 0xef, 0xaa,
+	0x44, 0x26, 0xc9, 0x4f, 0x70, 0xaa, 0x58, 0xa6, 0xf2, 0x07, 0xa3, 0x49, 0xea, 0x07, 0x49, 0x4e,
+	0x64, 0x89, 0xfa, 0x89, 0x29, 0x8e, 0x8b, 0x2d, 0x0e, 0x14, 0x74, 0xa6, 0x56, 0xad, 0x53, 0xc2,
+	0x4d, 0x04, 0x9d, 0x11, 0x17, 0x06, 0x46, 0x5e, 0x5f, 0x0a, 0xc6, 0xfd, 0x20, 0x5d, 0x25, 0x42,
+	0xad, 0x5f, 0xc3, 0x7b, 0xc8, 0x36, 0xef, 0xc3, 0xc7, 0xf2, 0x80, 0xbc, 0x82, 0xa1, 0xe2, 0x0d,
+	0x71, 0x21, 0xe8, 0xd6, 0x3d, 0x8f, 0x0f, 0x8e, 0x38, 0x90, 0x95, 0xd7, 0xb2, 0xb0, 0x74, 0xd9,
+	0x5f, 0xe1, 0x51, 0x89, 0xb0, 0x74, 0xe5, 0xe6, 0x41, 0x3e, 0x52, 0xf0, 0x6d, 0xee, 0xfd, 0xa2,
+	0x82, 0x4e, 0xdd, 0x5e, 0xaf, 0xfd, 0x4e, 0x89, 0x92, 0xe0, 0x3b, 0x38, 0xd7, 0xe8, 0x2a, 0x21,
+	0xda, 0x4a, 0x88, 0xa1, 0x02, 0x78, 0xbb, 0x6a, 0x8c, 0xfe, 0x39, 0x82, 0x61, 0x85, 0x07, 0x44,
+	0xc8, 0x89, 0x0f, 0x0f, 0xca, 0xee, 0x4a, 0x2e, 0xee, 0xe7, 0xf6, 0xf6, 0x27, 0x07, 0x71, 0x66,
+	0x77, 0x5f, 0x43, 0x3b, 0x77, 0x54, 0xe2, 0x54, 0x14, 0xed, 0xf8, 0xb4, 0xfd, 0xd1, 0xff, 0x62,
+	0x0a, 0xd3, 0x6b, 0x19, 0x57, 0x25, 0xc3, 0x3d, 0xe5, 0x27, 0xf2, 0x7f, 0xd1, 0xae, 0xea, 0xb5,
+	0xeb, 0xc4, 0x6f, 0xa1, 0x5b, 0xb2, 0x54, 0xf2, 0xac, 0xa2, 0x64, 0xdf, 0x99, 0xed, 0x8b, 0x43,
+	0x30, 0xc3, 0x7e, 0x0b, 0x27, 0x5b, 0xd6, 0x41, 0x2a, 0x35, 0xab, 0x30, 0x25, 0xfb, 0xf2, 0x30,
+	0xd0, 0xf4, 0x98, 0x43, 0x7f, 0xd7, 0x35, 0xc8, 0xa7, 0x55, 0x9e, 0x58, 0x6d, 0x48, 0xf6, 0x67,
+	0xf7, 0xc2, 0xea, 0x66, 0xb7, 0x4d, 0x25, 0xf1, 0x17, 0xff, 0x0d, 0x00, 0x3e, 0x07, 0x3a, 0x6c,
+	0xbc, 0x08, 0x00, 0x00,
 }
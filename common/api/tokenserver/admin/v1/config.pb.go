@@ -17,6 +17,14 @@ var _ = math.Inf
 type TokenServerConfig struct {
 	// List of CAs we trust.
 	CertificateAuthority []*CertificateAuthorityConfig `protobuf:"bytes,1,rep,name=certificate_authority,json=certificateAuthority" json:"certificate_authority,omitempty"`
+	// Rules that define who can mint delegation tokens, on behalf of whom and
+	// for what services.
+	DelegationRule []*DelegationRule `protobuf:"bytes,2,rep,name=delegation_rule,json=delegationRule" json:"delegation_rule,omitempty"`
+	// List of issuers of JWT identity tokens we trust.
+	//
+	// Machines that don't have certificates signed by some trusted CA can use
+	// such JWTs to get machine tokens (see MintMachineTokenByJWT RPC).
+	JwtIssuer []*JWTIssuerConfig `protobuf:"bytes,3,rep,name=jwt_issuer,json=jwtIssuer" json:"jwt_issuer,omitempty"`
 }
 
 func (m *TokenServerConfig) Reset()                    { *m = TokenServerConfig{} }
@@ -31,6 +39,20 @@ func (m *TokenServerConfig) GetCertificateAuthority() []*CertificateAuthorityCon
 	return nil
 }
 
+func (m *TokenServerConfig) GetDelegationRule() []*DelegationRule {
+	if m != nil {
+		return m.DelegationRule
+	}
+	return nil
+}
+
+func (m *TokenServerConfig) GetJwtIssuer() []*JWTIssuerConfig {
+	if m != nil {
+		return m.JwtIssuer
+	}
+	return nil
+}
+
 // CertificateAuthorityConfig defines a single CA we trust.
 //
 // Such CA issues certificates for nodes that use The Token Service. Each node
@@ -48,6 +70,23 @@ type CertificateAuthorityConfig struct {
 	CertPath string `protobuf:"bytes,2,opt,name=cert_path,json=certPath" json:"cert_path,omitempty"`
 	CrlUrl   string `protobuf:"bytes,3,opt,name=crl_url,json=crlUrl" json:"crl_url,omitempty"`
 	UseOauth bool   `protobuf:"varint,4,opt,name=use_oauth,json=useOauth" json:"use_oauth,omitempty"`
+	// DeltaCrlUrl is where to fetch delta CRLs from, if the CA publishes them.
+	//
+	// Delta CRLs list only changes since the base CRL (fetched from crl_url).
+	// They are applied on top of the imported base CRL, so the base CRL can be
+	// refetched only when it expires. Requires crl_url.
+	DeltaCrlUrl string `protobuf:"bytes,7,opt,name=delta_crl_url,json=deltaCrlUrl" json:"delta_crl_url,omitempty"`
+	// UseOcsp is true to check revocation status of certificates via OCSP.
+	//
+	// It is done in addition to CRL checks if crl_url is set, or instead of them
+	// otherwise. OCSP responses are cached until their next_update time, but no
+	// longer than one hour.
+	UseOcsp bool `protobuf:"varint,8,opt,name=use_ocsp,json=useOcsp" json:"use_ocsp,omitempty"`
+	// OcspUrl is URL of OCSP responder to use.
+	//
+	// Default is to use the responder specified in the certificate being checked
+	// (in its Authority Information Access extension).
+	OcspUrl string `protobuf:"bytes,9,opt,name=ocsp_url,json=ocspUrl" json:"ocsp_url,omitempty"`
 	// KnownDomains describes parameters to use for each particular domain.
 	KnownDomains []*DomainConfig `protobuf:"bytes,5,rep,name=known_domains,json=knownDomains" json:"known_domains,omitempty"`
 }
@@ -64,7 +103,7 @@ func (m *CertificateAuthorityConfig) GetKnownDomains() []*DomainConfig {
 	return nil
 }
 
-// DomainConfig is used inside CertificateAuthorityConfig.
+// DomainConfig is used inside CertificateAuthorityConfig and JWTIssuerConfig.
 type DomainConfig struct {
 	// Domain is domain names of hosts this config applies to.
 	Domain []string `protobuf:"bytes,1,rep,name=domain" json:"domain,omitempty"`
@@ -88,36 +127,130 @@ func (m *DomainConfig) String() string            { return proto.CompactTextStri
 func (*DomainConfig) ProtoMessage()               {}
 func (*DomainConfig) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
 
+// JWTIssuerConfig defines a single issuer of JWT identity tokens we trust.
+//
+// Such issuer (e.g. an orchestrator of ephemeral containers) signs JWTs that
+// assert an identity of a machine. Only RS256 signatures are supported.
+//
+// The Token Server extracts machine FQDN from a claim in the JWT (see
+// fqdn_claim) and then uses "known_domains" exactly as it is used for
+// certificates issued by a CA.
+type JWTIssuerConfig struct {
+	// ID of this issuer, will be embedded into tokens (as ca_id).
+	//
+	// Must not clash with unique_id of any CertificateAuthorityConfig.
+	UniqueId int64 `protobuf:"varint,1,opt,name=unique_id,json=uniqueId" json:"unique_id,omitempty"`
+	// Issuer is expected value of "iss" claim.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer" json:"issuer,omitempty"`
+	// JwksPath is a path to a file in luci-config with JSON Web Key Set of the
+	// issuer (its public keys).
+	JwksPath string `protobuf:"bytes,3,opt,name=jwks_path,json=jwksPath" json:"jwks_path,omitempty"`
+	// Audience is a list of accepted values of "aud" claim. Required.
+	Audience []string `protobuf:"bytes,4,rep,name=audience" json:"audience,omitempty"`
+	// FqdnClaim is a name of a claim with machine FQDN. Default is "sub".
+	FqdnClaim string `protobuf:"bytes,5,opt,name=fqdn_claim,json=fqdnClaim" json:"fqdn_claim,omitempty"`
+	// KnownDomains describes parameters to use for each particular domain.
+	KnownDomains []*DomainConfig `protobuf:"bytes,6,rep,name=known_domains,json=knownDomains" json:"known_domains,omitempty"`
+}
+
+func (m *JWTIssuerConfig) Reset()                    { *m = JWTIssuerConfig{} }
+func (m *JWTIssuerConfig) String() string            { return proto.CompactTextString(m) }
+func (*JWTIssuerConfig) ProtoMessage()               {}
+func (*JWTIssuerConfig) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
+
+func (m *JWTIssuerConfig) GetKnownDomains() []*DomainConfig {
+	if m != nil {
+		return m.KnownDomains
+	}
+	return nil
+}
+
+// DelegationRule describes who is allowed to mint delegation tokens.
+//
+// MintDelegationToken request is allowed if there's a rule that matches it.
+// A rule matches a request if all of the following are true:
+//   - The caller is in 'requestor' set.
+//   - The identity to delegate is in 'allowed_to_impersonate' set.
+//   - All requested services are in 'allowed_audience' set.
+//
+// Sets are specified as lists of identity strings (e.g. "user:a@example.com"),
+// "group:<name>" entries that refer to groups in the auth database, or a
+// special value "*" that matches everything.
+type DelegationRule struct {
+	// Name is a human readable name of this rule, used in logs. Required.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Requestor is a set of identities allowed to mint tokens (e.g. service
+	// accounts of LUCI services).
+	Requestor []string `protobuf:"bytes,2,rep,name=requestor" json:"requestor,omitempty"`
+	// AllowedToImpersonate is a set of identities that can be put into the
+	// token as the delegated identity. Use "REQUESTOR" to allow the requestor
+	// to delegate only its own identity.
+	AllowedToImpersonate []string `protobuf:"bytes,3,rep,name=allowed_to_impersonate,json=allowedToImpersonate" json:"allowed_to_impersonate,omitempty"`
+	// AllowedAudience is a set of service identities (e.g. "service:luci-dm")
+	// that can be put into the token as the services that accept it.
+	//
+	// Use "*" to allow requesting tokens accepted by any service.
+	AllowedAudience []string `protobuf:"bytes,4,rep,name=allowed_audience,json=allowedAudience" json:"allowed_audience,omitempty"`
+	// MaxValidityDuration is the maximum lifetime of a token, in seconds.
+	//
+	// Required, must be positive.
+	MaxValidityDuration int64 `protobuf:"varint,5,opt,name=max_validity_duration,json=maxValidityDuration" json:"max_validity_duration,omitempty"`
+}
+
+func (m *DelegationRule) Reset()                    { *m = DelegationRule{} }
+func (m *DelegationRule) String() string            { return proto.CompactTextString(m) }
+func (*DelegationRule) ProtoMessage()               {}
+func (*DelegationRule) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+
 func init() {
 	proto.RegisterType((*TokenServerConfig)(nil), "tokenserver.admin.TokenServerConfig")
 	proto.RegisterType((*CertificateAuthorityConfig)(nil), "tokenserver.admin.CertificateAuthorityConfig")
 	proto.RegisterType((*DomainConfig)(nil), "tokenserver.admin.DomainConfig")
+	proto.RegisterType((*JWTIssuerConfig)(nil), "tokenserver.admin.JWTIssuerConfig")
+	proto.RegisterType((*DelegationRule)(nil), "tokenserver.admin.DelegationRule")
 }
 
 var fileDescriptor1 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x92, 0xdf, 0x4e, 0xc2, 0x30,
-	0x14, 0xc6, 0x33, 0xfe, 0x8c, 0x51, 0xd1, 0x40, 0x83, 0xb8, 0xe8, 0x85, 0x84, 0x2b, 0x2e, 0x74,
-	0x31, 0xe8, 0x0b, 0x18, 0xb8, 0xd1, 0x18, 0x25, 0xa0, 0xd7, 0x4d, 0xe9, 0x8a, 0x54, 0xb6, 0x16,
-	0xbb, 0x4e, 0xe2, 0x1b, 0xfa, 0x1c, 0x3e, 0x89, 0xed, 0xd9, 0x8c, 0x26, 0xe2, 0x0d, 0xe1, 0x7c,
-	0xbf, 0xaf, 0xe7, 0x9c, 0xaf, 0x2b, 0x6a, 0x31, 0x25, 0x97, 0xe2, 0x39, 0xda, 0x68, 0x65, 0x14,
-	0xee, 0x18, 0xb5, 0xe6, 0x32, 0xe3, 0xfa, 0x8d, 0xeb, 0x88, 0xc6, 0xa9, 0x90, 0x83, 0x2d, 0xea,
-	0x3c, 0x3a, 0x71, 0x0e, 0xe2, 0x18, 0xdc, 0x78, 0x81, 0x0e, 0x19, 0xd7, 0x46, 0x2c, 0x05, 0xa3,
-	0x86, 0x13, 0x9a, 0x9b, 0x95, 0xd2, 0xc2, 0xbc, 0x87, 0x5e, 0xbf, 0x3a, 0xdc, 0x1b, 0x9d, 0x47,
-	0x7f, 0xfa, 0x44, 0xe3, 0x1f, 0xff, 0xf5, 0xb7, 0xbd, 0xe8, 0x36, 0xeb, 0xb2, 0x1d, 0x6c, 0xf0,
-	0xe9, 0xa1, 0xe3, 0xff, 0x0f, 0xe1, 0x13, 0xd4, 0xcc, 0xa5, 0x78, 0xcd, 0x39, 0x11, 0x71, 0xe8,
-	0xf7, 0xbd, 0x61, 0x75, 0x16, 0x14, 0xc2, 0x4d, 0x8c, 0x0f, 0x50, 0x85, 0x49, 0xbb, 0x8c, 0x37,
-	0x6c, 0xce, 0xec, 0x3f, 0x67, 0x76, 0x33, 0xc8, 0x86, 0x9a, 0x55, 0x58, 0x01, 0x39, 0x70, 0xc2,
-	0xd4, 0xd6, 0xf8, 0x08, 0x35, 0x98, 0x4e, 0x48, 0xae, 0x93, 0xb0, 0x0a, 0xc8, 0xb7, 0xe5, 0x93,
-	0x4e, 0x60, 0x44, 0xc6, 0x89, 0x72, 0xf1, 0xc2, 0x9a, 0x45, 0x81, 0x1d, 0x91, 0xf1, 0x07, 0x57,
-	0xe3, 0x09, 0xda, 0x5f, 0x4b, 0xb5, 0x95, 0x24, 0x56, 0x29, 0x15, 0x32, 0x0b, 0xeb, 0x10, 0xfd,
-	0x74, 0x47, 0xf4, 0x09, 0x38, 0xca, 0xb0, 0x2d, 0x38, 0x55, 0x48, 0xd9, 0xe0, 0xc3, 0x43, 0xad,
-	0xdf, 0x18, 0xf7, 0x90, 0x5f, 0x34, 0x84, 0xab, 0xb4, 0xbb, 0x14, 0x15, 0x3e, 0x43, 0x98, 0x25,
-	0x2a, 0x8f, 0x89, 0xfd, 0x50, 0x2f, 0x9c, 0x19, 0x22, 0x69, 0xca, 0xcb, 0x28, 0x6d, 0x20, 0xd3,
-	0x02, 0xdc, 0x5b, 0x1d, 0x5f, 0xa0, 0x2e, 0x4d, 0x12, 0xb5, 0xe5, 0x71, 0xb1, 0xfd, 0x88, 0x64,
-	0x4c, 0x6d, 0xb8, 0xcd, 0xe7, 0x7a, 0xe2, 0x92, 0x41, 0x90, 0xd1, 0xdc, 0x11, 0x7c, 0x85, 0x7a,
-	0x29, 0x65, 0x2b, 0x21, 0x39, 0x81, 0x00, 0x24, 0x11, 0x4b, 0x6e, 0x84, 0x9d, 0x51, 0x87, 0xbb,
-	0xed, 0x96, 0x14, 0xde, 0xc2, 0x5d, 0xc9, 0x6e, 0x6b, 0x41, 0xad, 0x5d, 0xb7, 0xbf, 0x7e, 0xbb,
-	0xb1, 0xf0, 0xe1, 0x09, 0x5d, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x58, 0x54, 0x0e, 0x52,
-	0x02, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0x5d, 0x52, 0xdb, 0x3a,
+	0x14, 0x1e, 0x27, 0x21, 0xb1, 0x0f, 0x7f, 0x41, 0x17, 0xb8, 0xbe, 0xdc, 0x7b, 0xa7, 0x69, 0x9e,
+	0xd2, 0x99, 0x36, 0xd3, 0x49, 0xbb, 0x01, 0x26, 0xbc, 0x90, 0xe9, 0xb4, 0x8c, 0xa1, 0xed, 0xa3,
+	0x46, 0xc8, 0x0a, 0x51, 0x90, 0xa5, 0x20, 0xcb, 0x04, 0x36, 0xd0, 0x55, 0x75, 0x01, 0xdd, 0x41,
+	0x37, 0xd0, 0x85, 0x74, 0x74, 0x6c, 0x43, 0x81, 0xf4, 0xa5, 0x6f, 0x9c, 0xef, 0x3b, 0xdf, 0x41,
+	0xe7, 0xfb, 0x8e, 0x03, 0x1b, 0xdc, 0xe8, 0xa9, 0xbc, 0x18, 0x2e, 0xac, 0x71, 0x86, 0xec, 0x38,
+	0x73, 0x29, 0x74, 0x2e, 0xec, 0xb5, 0xb0, 0x43, 0x96, 0x66, 0x52, 0xf7, 0xbf, 0x34, 0x60, 0xe7,
+	0xcc, 0xa3, 0xa7, 0x88, 0x8e, 0xb1, 0x9d, 0x9c, 0xc3, 0x1e, 0x17, 0xd6, 0xc9, 0xa9, 0xe4, 0xcc,
+	0x09, 0xca, 0x0a, 0x37, 0x33, 0x56, 0xba, 0xdb, 0x38, 0xe8, 0x35, 0x07, 0xeb, 0xa3, 0x57, 0xc3,
+	0x27, 0x83, 0x86, 0xe3, 0xfb, 0xfe, 0xc3, 0xba, 0xbd, 0x9c, 0x96, 0xec, 0xf2, 0x15, 0x1c, 0x99,
+	0xc0, 0x76, 0x2a, 0x94, 0xb8, 0x60, 0x4e, 0x1a, 0x4d, 0x6d, 0xa1, 0x44, 0xdc, 0xc0, 0xe9, 0xcf,
+	0x57, 0x4c, 0x3f, 0xba, 0xeb, 0x4c, 0x0a, 0x25, 0x92, 0xad, 0xf4, 0x41, 0x4d, 0x0e, 0x01, 0xe6,
+	0x4b, 0x47, 0x65, 0x9e, 0x17, 0xc2, 0xc6, 0x4d, 0x1c, 0xd3, 0x5f, 0x31, 0x66, 0xf2, 0xf9, 0xec,
+	0x18, 0x7b, 0xaa, 0x97, 0x45, 0xf3, 0xa5, 0x2b, 0x81, 0xfe, 0xd7, 0x06, 0x1c, 0xfc, 0x7e, 0x07,
+	0xf2, 0x2f, 0x44, 0x85, 0x96, 0x57, 0x85, 0xa0, 0x32, 0x8d, 0xdb, 0xbd, 0x60, 0xd0, 0x4c, 0xc2,
+	0x12, 0x38, 0x4e, 0xc9, 0x16, 0x34, 0xb8, 0x8e, 0x83, 0x5e, 0x30, 0x88, 0x92, 0x06, 0xd7, 0xbe,
+	0xd9, 0xaf, 0x4c, 0x17, 0xcc, 0xcd, 0xe2, 0x06, 0xc2, 0xa1, 0x07, 0x4e, 0x98, 0x9b, 0x91, 0xbf,
+	0xa1, 0xc3, 0xad, 0xa2, 0x85, 0x55, 0x71, 0x13, 0xa9, 0x36, 0xb7, 0xea, 0xa3, 0x55, 0xf8, 0x2f,
+	0x72, 0x41, 0x8d, 0x77, 0x3b, 0x6e, 0xf5, 0x82, 0x41, 0x98, 0x84, 0x45, 0x2e, 0x3e, 0xf8, 0x9a,
+	0xf4, 0x61, 0x33, 0x15, 0xca, 0x31, 0x5a, 0x6b, 0x3b, 0xa8, 0x5d, 0x47, 0x70, 0x5c, 0x0e, 0xf8,
+	0x07, 0x42, 0x1c, 0xc0, 0xf3, 0x45, 0x1c, 0xa2, 0xbe, 0xe3, 0xf5, 0x3c, 0x5f, 0x78, 0xca, 0xc3,
+	0xa8, 0x8c, 0x50, 0xd9, 0xf1, 0xb5, 0x57, 0x1d, 0xc1, 0xe6, 0xa5, 0x36, 0x4b, 0x4d, 0x53, 0x93,
+	0x31, 0xa9, 0xf3, 0x78, 0x0d, 0xed, 0x7b, 0xb6, 0x2a, 0x05, 0xec, 0xa8, 0xbc, 0xdb, 0x40, 0x55,
+	0x09, 0xe5, 0xfd, 0x6f, 0x01, 0x6c, 0xfc, 0x4a, 0x93, 0x7d, 0x68, 0x97, 0x03, 0xf1, 0x66, 0xa2,
+	0xa4, 0xaa, 0xc8, 0x4b, 0x20, 0x5c, 0x99, 0x22, 0xa5, 0x0b, 0x6b, 0xe6, 0x82, 0x3b, 0xaa, 0x59,
+	0x26, 0x2a, 0x93, 0xba, 0xc8, 0x9c, 0x94, 0xc4, 0x7b, 0x96, 0x09, 0xf2, 0x1a, 0x76, 0x99, 0x52,
+	0x66, 0x29, 0xd2, 0xd2, 0x97, 0x11, 0xcd, 0xb9, 0x59, 0x08, 0x8c, 0x38, 0x4a, 0x48, 0xc5, 0xa1,
+	0x45, 0xa3, 0x53, 0xcf, 0x90, 0xb7, 0xb0, 0x9f, 0x31, 0x3e, 0x93, 0x5a, 0x50, 0x5c, 0x80, 0x2a,
+	0x39, 0x15, 0x4e, 0x66, 0x22, 0x5e, 0xc3, 0xd4, 0x76, 0x2b, 0x16, 0x8f, 0xfe, 0x5d, 0xc5, 0x4d,
+	0x5a, 0x61, 0xab, 0xbb, 0x36, 0x69, 0x85, 0xed, 0x6e, 0xa7, 0xff, 0x23, 0x80, 0xed, 0x47, 0x87,
+	0xf2, 0x30, 0xfe, 0xe0, 0x51, 0xfc, 0xfb, 0xd0, 0xae, 0x2e, 0xaf, 0x5c, 0xa3, 0xaa, 0xbc, 0x68,
+	0xbe, 0xbc, 0xcc, 0xcb, 0x33, 0x28, 0xb3, 0x0e, 0x3d, 0x80, 0x67, 0x70, 0x00, 0x21, 0x2b, 0x52,
+	0x29, 0x34, 0x17, 0x71, 0x0b, 0xb7, 0xb9, 0xab, 0xc9, 0xff, 0x00, 0xd3, 0xab, 0x54, 0x53, 0xae,
+	0x98, 0xcc, 0xf0, 0xdd, 0x51, 0x12, 0x79, 0x64, 0xec, 0x81, 0xa7, 0x89, 0xb5, 0xff, 0x24, 0xb1,
+	0xef, 0x01, 0x6c, 0x3d, 0xfc, 0xac, 0x08, 0x81, 0x16, 0xa6, 0x51, 0x5e, 0x32, 0xfe, 0x4d, 0xfe,
+	0x83, 0xc8, 0x8a, 0xab, 0x42, 0xe4, 0xce, 0x58, 0xfc, 0x40, 0xa3, 0xe4, 0x1e, 0xf0, 0x6e, 0xd7,
+	0xf9, 0x38, 0x43, 0x65, 0xb6, 0x10, 0x36, 0x37, 0x9a, 0xb9, 0x3a, 0xa1, 0x3a, 0xbd, 0x33, 0x73,
+	0x7c, 0xcf, 0x91, 0x17, 0xd0, 0xad, 0x55, 0x8f, 0x3c, 0xd8, 0xae, 0xf0, 0xc3, 0xda, 0x8a, 0x11,
+	0xec, 0x65, 0xec, 0x86, 0x5e, 0x33, 0x25, 0x53, 0xe9, 0x6e, 0x69, 0x5a, 0x58, 0x7c, 0x6f, 0x95,
+	0xe6, 0x5f, 0x19, 0xbb, 0xf9, 0x54, 0x71, 0x47, 0x15, 0x75, 0xde, 0xc6, 0x5f, 0xbb, 0x37, 0x3f,
+	0x07, 0x00, 0xc1, 0x63, 0x02, 0xed, 0xfd, 0x04, 0x00, 0x00,
 }
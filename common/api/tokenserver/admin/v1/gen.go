@@ -5,6 +5,7 @@
 //go:generate cproto -import-path=admin
 //go:generate svcdec -type CertificateAuthoritiesServer
 //go:generate svcdec -type ServiceAccountsServer
+//go:generate svcdec -type MintLogServer
 
 // Package admin contains The Token Server Administrative API.
 //
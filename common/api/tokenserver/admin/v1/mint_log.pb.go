@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go.
+// source: mint_log.proto
+// DO NOT EDIT!
+
+package admin
+
+import prpccommon "github.com/luci/luci-go/common/prpc"
+import prpc "github.com/luci/luci-go/server/prpc"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf1 "github.com/luci/luci-go/common/proto/google"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// QueryMintLogRequest defines what log entries to return.
+//
+// Only one of 'fqdn', 'ca' and 'cert_sn' filters can be used at once, except
+// 'ca' and 'cert_sn' that can be used together. All of them can be combined
+// with a time range.
+type QueryMintLogRequest struct {
+	Fqdn   string                      `protobuf:"bytes,1,opt,name=fqdn" json:"fqdn,omitempty"`
+	Ca     string                      `protobuf:"bytes,2,opt,name=ca" json:"ca,omitempty"`
+	CertSn uint64                      `protobuf:"varint,3,opt,name=cert_sn,json=certSn" json:"cert_sn,omitempty"`
+	Since  *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=since" json:"since,omitempty"`
+	Until  *google_protobuf1.Timestamp `protobuf:"bytes,5,opt,name=until" json:"until,omitempty"`
+	Limit  int32                       `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`
+	Cursor string                      `protobuf:"bytes,7,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *QueryMintLogRequest) Reset()                    { *m = QueryMintLogRequest{} }
+func (m *QueryMintLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueryMintLogRequest) ProtoMessage()               {}
+func (*QueryMintLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+
+func (m *QueryMintLogRequest) GetSince() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *QueryMintLogRequest) GetUntil() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+// MintLogEntry describes a single attempt to mint a machine or delegation
+// token.
+type MintLogEntry struct {
+	Timestamp         *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	Rpc               string                      `protobuf:"bytes,2,opt,name=rpc" json:"rpc,omitempty"`
+	TokenType         string                      `protobuf:"bytes,3,opt,name=token_type,json=tokenType" json:"token_type,omitempty"`
+	PeerIp            string                      `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp" json:"peer_ip,omitempty"`
+	Fqdn              string                      `protobuf:"bytes,5,opt,name=fqdn" json:"fqdn,omitempty"`
+	Ca                string                      `protobuf:"bytes,6,opt,name=ca" json:"ca,omitempty"`
+	CaId              int64                       `protobuf:"varint,7,opt,name=ca_id,json=caId" json:"ca_id,omitempty"`
+	CertSn            uint64                      `protobuf:"varint,8,opt,name=cert_sn,json=certSn" json:"cert_sn,omitempty"`
+	Oauth2Scopes      []string                    `protobuf:"bytes,9,rep,name=oauth2_scopes,json=oauth2Scopes" json:"oauth2_scopes,omitempty"`
+	ErrorCode         string                      `protobuf:"bytes,10,opt,name=error_code,json=errorCode" json:"error_code,omitempty"`
+	ErrorMessage      string                      `protobuf:"bytes,11,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+	ServiceAccount    string                      `protobuf:"bytes,12,opt,name=service_account,json=serviceAccount" json:"service_account,omitempty"`
+	TokenExpiry       *google_protobuf1.Timestamp `protobuf:"bytes,13,opt,name=token_expiry,json=tokenExpiry" json:"token_expiry,omitempty"`
+	TokenSha256       string                      `protobuf:"bytes,14,opt,name=token_sha256,json=tokenSha256" json:"token_sha256,omitempty"`
+	ServiceVersion    string                      `protobuf:"bytes,15,opt,name=service_version,json=serviceVersion" json:"service_version,omitempty"`
+	Requestor         string                      `protobuf:"bytes,16,opt,name=requestor" json:"requestor,omitempty"`
+	DelegatedIdentity string                      `protobuf:"bytes,17,opt,name=delegated_identity,json=delegatedIdentity" json:"delegated_identity,omitempty"`
+	Services          []string                    `protobuf:"bytes,18,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *MintLogEntry) Reset()                    { *m = MintLogEntry{} }
+func (m *MintLogEntry) String() string            { return proto.CompactTextString(m) }
+func (*MintLogEntry) ProtoMessage()               {}
+func (*MintLogEntry) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+
+func (m *MintLogEntry) GetTimestamp() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *MintLogEntry) GetTokenExpiry() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.TokenExpiry
+	}
+	return nil
+}
+
+// QueryMintLogResponse is returned by QueryMintLog.
+type QueryMintLogResponse struct {
+	Entries []*MintLogEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	Cursor  string          `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *QueryMintLogResponse) Reset()                    { *m = QueryMintLogResponse{} }
+func (m *QueryMintLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*QueryMintLogResponse) ProtoMessage()               {}
+func (*QueryMintLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+
+func (m *QueryMintLogResponse) GetEntries() []*MintLogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// ExportMintLogResponse is returned by ExportMintLog.
+type ExportMintLogResponse struct {
+	Ndjson string `protobuf:"bytes,1,opt,name=ndjson" json:"ndjson,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *ExportMintLogResponse) Reset()                    { *m = ExportMintLogResponse{} }
+func (m *ExportMintLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportMintLogResponse) ProtoMessage()               {}
+func (*ExportMintLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+
+func init() {
+	proto.RegisterType((*QueryMintLogRequest)(nil), "tokenserver.admin.QueryMintLogRequest")
+	proto.RegisterType((*MintLogEntry)(nil), "tokenserver.admin.MintLogEntry")
+	proto.RegisterType((*QueryMintLogResponse)(nil), "tokenserver.admin.QueryMintLogResponse")
+	proto.RegisterType((*ExportMintLogResponse)(nil), "tokenserver.admin.ExportMintLogResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion2
+
+// Client API for MintLog service
+
+type MintLogClient interface {
+	// QueryMintLog returns log entries matching the query, most recent first.
+	QueryMintLog(ctx context.Context, in *QueryMintLogRequest, opts ...grpc.CallOption) (*QueryMintLogResponse, error)
+	// ExportMintLog returns log entries matching the query as newline-delimited
+	// JSON, suitable for loading into BigQuery.
+	ExportMintLog(ctx context.Context, in *QueryMintLogRequest, opts ...grpc.CallOption) (*ExportMintLogResponse, error)
+}
+type mintLogPRPCClient struct {
+	client *prpccommon.Client
+}
+
+func NewMintLogPRPCClient(client *prpccommon.Client) MintLogClient {
+	return &mintLogPRPCClient{client}
+}
+
+func (c *mintLogPRPCClient) QueryMintLog(ctx context.Context, in *QueryMintLogRequest, opts ...grpc.CallOption) (*QueryMintLogResponse, error) {
+	out := new(QueryMintLogResponse)
+	err := c.client.Call(ctx, "tokenserver.admin.MintLog", "QueryMintLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintLogPRPCClient) ExportMintLog(ctx context.Context, in *QueryMintLogRequest, opts ...grpc.CallOption) (*ExportMintLogResponse, error) {
+	out := new(ExportMintLogResponse)
+	err := c.client.Call(ctx, "tokenserver.admin.MintLog", "ExportMintLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type mintLogClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMintLogClient(cc *grpc.ClientConn) MintLogClient {
+	return &mintLogClient{cc}
+}
+
+func (c *mintLogClient) QueryMintLog(ctx context.Context, in *QueryMintLogRequest, opts ...grpc.CallOption) (*QueryMintLogResponse, error) {
+	out := new(QueryMintLogResponse)
+	err := grpc.Invoke(ctx, "/tokenserver.admin.MintLog/QueryMintLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintLogClient) ExportMintLog(ctx context.Context, in *QueryMintLogRequest, opts ...grpc.CallOption) (*ExportMintLogResponse, error) {
+	out := new(ExportMintLogResponse)
+	err := grpc.Invoke(ctx, "/tokenserver.admin.MintLog/ExportMintLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MintLog service
+
+type MintLogServer interface {
+	// QueryMintLog returns log entries matching the query, most recent first.
+	QueryMintLog(context.Context, *QueryMintLogRequest) (*QueryMintLogResponse, error)
+	// ExportMintLog returns log entries matching the query as newline-delimited
+	// JSON, suitable for loading into BigQuery.
+	ExportMintLog(context.Context, *QueryMintLogRequest) (*ExportMintLogResponse, error)
+}
+
+func RegisterMintLogServer(s prpc.Registrar, srv MintLogServer) {
+	s.RegisterService(&_MintLog_serviceDesc, srv)
+}
+
+func _MintLog_QueryMintLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintLogServer).QueryMintLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenserver.admin.MintLog/QueryMintLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintLogServer).QueryMintLog(ctx, req.(*QueryMintLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MintLog_ExportMintLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintLogServer).ExportMintLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenserver.admin.MintLog/ExportMintLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintLogServer).ExportMintLog(ctx, req.(*QueryMintLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MintLog_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tokenserver.admin.MintLog",
+	HandlerType: (*MintLogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryMintLog",
+			Handler:    _MintLog_QueryMintLog_Handler,
+		},
+		{
+			MethodName: "ExportMintLog",
+			Handler:    _MintLog_ExportMintLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+var fileDescriptor2 = []byte{
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x95, 0xf3, 0xc7, 0xa9, 0x27, 0x69, 0xda, 0x6e, 0xfb, 0xeb, 0x6f, 0x15, 0x81, 0x1a, 0x8a,
+	0x44, 0x7d, 0xc1, 0x45, 0x41, 0x20, 0x38, 0x70, 0x40, 0xa8, 0x42, 0x95, 0xe8, 0x01, 0xa7, 0xe2,
+	0x6a, 0xb9, 0xeb, 0x69, 0xba, 0x90, 0xec, 0xba, 0xbb, 0xeb, 0xaa, 0xf9, 0x90, 0x7c, 0x0b, 0x4e,
+	0x7c, 0x0a, 0xe4, 0x5d, 0xc7, 0x24, 0x34, 0x28, 0xdc, 0x3c, 0x6f, 0xdf, 0xcc, 0xbc, 0x79, 0x9a,
+	0x31, 0xf4, 0x67, 0x5c, 0x98, 0x64, 0x2a, 0x27, 0x51, 0xae, 0xa4, 0x91, 0x64, 0xcf, 0xc8, 0x6f,
+	0x28, 0x34, 0xaa, 0x3b, 0x54, 0x51, 0x9a, 0xcd, 0xb8, 0x18, 0x1c, 0x4d, 0xa4, 0x9c, 0x4c, 0xf1,
+	0xd4, 0x12, 0xae, 0x8a, 0xeb, 0x53, 0xc3, 0x67, 0xa8, 0x4d, 0x3a, 0xcb, 0x5d, 0xce, 0xf1, 0x0f,
+	0x0f, 0xf6, 0x3f, 0x17, 0xa8, 0xe6, 0x17, 0x5c, 0x98, 0x4f, 0x72, 0x12, 0xe3, 0x6d, 0x81, 0xda,
+	0x10, 0x02, 0xad, 0xeb, 0xdb, 0x4c, 0x50, 0x6f, 0xe8, 0x85, 0x41, 0x6c, 0xbf, 0x49, 0x1f, 0x1a,
+	0x2c, 0xa5, 0x0d, 0x8b, 0x34, 0x58, 0x4a, 0xfe, 0x87, 0x0e, 0x43, 0x65, 0x12, 0x2d, 0x68, 0x73,
+	0xe8, 0x85, 0xad, 0xd8, 0x2f, 0xc3, 0xb1, 0x20, 0x2f, 0xa0, 0xad, 0xb9, 0x60, 0x48, 0x5b, 0x43,
+	0x2f, 0xec, 0x8e, 0x06, 0x91, 0x53, 0x11, 0x2d, 0x54, 0x44, 0x97, 0x0b, 0x15, 0xb1, 0x23, 0x96,
+	0x19, 0x85, 0x30, 0x7c, 0x4a, 0xdb, 0x9b, 0x33, 0x2c, 0x91, 0x1c, 0x40, 0x7b, 0xca, 0x67, 0xdc,
+	0x50, 0x7f, 0xe8, 0x85, 0xed, 0xd8, 0x05, 0xe4, 0x10, 0x7c, 0x56, 0x28, 0x2d, 0x15, 0xed, 0x58,
+	0x99, 0x55, 0x74, 0xfc, 0xb3, 0x05, 0xbd, 0x6a, 0xc2, 0x33, 0x61, 0xd4, 0x9c, 0xbc, 0x81, 0xa0,
+	0xb6, 0x82, 0x7a, 0x1b, 0x9b, 0xfe, 0x26, 0x93, 0x5d, 0x68, 0xaa, 0x9c, 0x55, 0x36, 0x94, 0x9f,
+	0xe4, 0x31, 0x80, 0x75, 0x3e, 0x31, 0xf3, 0x1c, 0xad, 0x15, 0x41, 0x1c, 0x58, 0xe4, 0x72, 0x9e,
+	0x63, 0x69, 0x53, 0x8e, 0xa8, 0x12, 0x9e, 0x5b, 0x3f, 0x82, 0xd8, 0x2f, 0xc3, 0xf3, 0xbc, 0xf6,
+	0xb8, 0xfd, 0xc0, 0x63, 0xbf, 0xf6, 0x78, 0x1f, 0xda, 0x2c, 0x4d, 0x78, 0x66, 0xe7, 0x69, 0xc6,
+	0x2d, 0x96, 0x9e, 0x67, 0xcb, 0xc6, 0x6f, 0xad, 0x18, 0xff, 0x14, 0xb6, 0x65, 0x5a, 0x98, 0x9b,
+	0x51, 0xa2, 0x99, 0xcc, 0x51, 0xd3, 0x60, 0xd8, 0x0c, 0x83, 0xb8, 0xe7, 0xc0, 0xb1, 0xc5, 0x4a,
+	0xb9, 0xa8, 0x94, 0x54, 0x09, 0x93, 0x19, 0x52, 0x70, 0x72, 0x2d, 0xf2, 0x41, 0x66, 0x58, 0xd6,
+	0x70, 0xcf, 0x33, 0xd4, 0x3a, 0x9d, 0x20, 0xed, 0x5a, 0x46, 0xcf, 0x82, 0x17, 0x0e, 0x23, 0x27,
+	0xb0, 0x53, 0xee, 0x19, 0x67, 0x98, 0xa4, 0x8c, 0xc9, 0x42, 0x18, 0xda, 0xb3, 0xb4, 0x7e, 0x05,
+	0xbf, 0x77, 0x28, 0x79, 0x07, 0x3d, 0xe7, 0x0d, 0xde, 0xe7, 0x5c, 0xcd, 0xe9, 0xf6, 0x46, 0xab,
+	0xbb, 0x96, 0x7f, 0x66, 0xe9, 0xe4, 0xc9, 0x22, 0x5d, 0xdf, 0xa4, 0xa3, 0x57, 0xaf, 0x69, 0xdf,
+	0x36, 0x71, 0x94, 0xb1, 0x85, 0x96, 0xa5, 0xdc, 0xa1, 0xd2, 0x5c, 0x0a, 0xba, 0xb3, 0x22, 0xe5,
+	0x8b, 0x43, 0xc9, 0x23, 0x08, 0x94, 0xdb, 0x6e, 0xa9, 0xe8, 0xae, 0x1b, 0xbb, 0x06, 0xc8, 0x73,
+	0x20, 0x19, 0x4e, 0x71, 0x92, 0x1a, 0xcc, 0x12, 0x9e, 0xa1, 0x30, 0xdc, 0xcc, 0xe9, 0x9e, 0xa5,
+	0xed, 0xd5, 0x2f, 0xe7, 0xd5, 0x03, 0x19, 0xc0, 0x56, 0x55, 0x5e, 0x53, 0x62, 0x4d, 0xae, 0xe3,
+	0x63, 0x0e, 0x07, 0xab, 0x27, 0xa5, 0x73, 0x29, 0x34, 0x92, 0xb7, 0xd0, 0x41, 0x61, 0x14, 0x47,
+	0x4d, 0xbd, 0x61, 0x33, 0xec, 0x8e, 0x8e, 0xa2, 0x07, 0x17, 0x1b, 0x2d, 0x6f, 0x69, 0xbc, 0xe0,
+	0x2f, 0xed, 0x75, 0x63, 0x65, 0xaf, 0x3f, 0xc2, 0x7f, 0x67, 0xf7, 0xb9, 0x54, 0xe6, 0xcf, 0x5e,
+	0x87, 0xe0, 0x8b, 0xec, 0xab, 0x96, 0x8b, 0x0b, 0xae, 0xa2, 0xbf, 0x15, 0x1a, 0x7d, 0xf7, 0xa0,
+	0x53, 0xd5, 0x20, 0x09, 0xf4, 0x96, 0xf5, 0x93, 0x67, 0x6b, 0x64, 0xae, 0xf9, 0x67, 0x0c, 0x4e,
+	0x36, 0xf2, 0x2a, 0x71, 0x29, 0x6c, 0xaf, 0xa8, 0xfe, 0xe7, 0x0e, 0xe1, 0x1a, 0xde, 0xda, 0xf9,
+	0xaf, 0x7c, 0xbb, 0x59, 0x2f, 0x7f, 0x0d, 0x00, 0xe5, 0x87, 0x15, 0xa8, 0x24, 0x05, 0x00, 0x00,
+}
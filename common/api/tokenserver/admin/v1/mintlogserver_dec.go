@@ -0,0 +1,32 @@
+// Code generated by svcdec; DO NOT EDIT
+
+package admin
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+type DecoratedMintLog struct {
+	// Service is the service to decorate.
+	Service MintLogServer
+	// Prelude is called in each method before forwarding the call to Service.
+	// If Prelude returns an error, it is returned without forwarding the call.
+	Prelude func(c context.Context, methodName string, req proto.Message) (context.Context, error)
+}
+
+func (s *DecoratedMintLog) QueryMintLog(c context.Context, req *QueryMintLogRequest) (*QueryMintLogResponse, error) {
+	c, err := s.Prelude(c, "QueryMintLog", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.QueryMintLog(c, req)
+}
+
+func (s *DecoratedMintLog) ExportMintLog(c context.Context, req *QueryMintLogRequest) (*ExportMintLogResponse, error) {
+	c, err := s.Prelude(c, "ExportMintLog", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.ExportMintLog(c, req)
+}
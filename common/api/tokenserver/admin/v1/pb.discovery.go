@@ -7,608 +7,901 @@ import discovery "github.com/luci/luci-go/server/discovery"
 func init() {
 	discovery.RegisterDescriptorSetCompressed(
 		[]string{
-			"tokenserver.admin.CertificateAuthorities", "tokenserver.admin.ServiceAccounts",
+			"tokenserver.admin.CertificateAuthorities", "tokenserver.admin.MintLog", "tokenserver.admin.ServiceAccounts",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 124, 77, 108, 27, 75,
-			182, 158, 216, 162, 40, 178, 100, 235, 167, 37, 75, 114, 235, 199,
-			101, 218, 150, 73, 91, 34, 101, 73, 215, 247, 202, 190, 127, 20,
-			69, 219, 244, 200, 146, 76, 82, 246, 220, 153, 123, 163, 219, 34,
-			155, 82, 199, 100, 55, 135, 221, 148, 174, 222, 100, 128, 32, 1,
-			130, 252, 32, 171, 4, 65, 178, 202, 207, 238, 189, 0, 1, 130,
-			108, 18, 224, 5, 72, 144, 183, 200, 34, 200, 34, 139, 108, 19,
-			60, 4, 200, 42, 8, 178, 11, 16, 32, 47, 231, 156, 170, 106,
-			54, 105, 202, 190, 111, 50, 155, 1, 174, 49, 227, 203, 211, 85,
-			125, 206, 169, 83, 85, 231, 124, 231, 84, 181, 217, 191, 190, 193,
-			22, 78, 93, 247, 180, 97, 101, 91, 109, 215, 119, 79, 58, 245,
-			172, 213, 108, 249, 151, 25, 34, 245, 9, 209, 152, 81, 141, 201,
-			81, 54, 82, 192, 246, 157, 67, 54, 93, 117, 155, 153, 190, 246,
-			29, 70, 173, 135, 72, 30, 70, 254, 81, 36, 242, 191, 35, 145,
-			127, 162, 13, 63, 63, 220, 249, 35, 109, 249, 185, 232, 123, 40,
-			251, 102, 222, 90, 141, 198, 207, 28, 247, 194, 169, 92, 182, 44,
-			239, 229, 31, 206, 176, 152, 30, 93, 30, 218, 156, 100, 255, 225,
-			26, 139, 92, 211, 135, 151, 135, 244, 141, 127, 123, 141, 211, 11,
-			85, 183, 193, 119, 58, 245, 186, 213, 246, 248, 26, 23, 172, 238,
-			123, 188, 102, 250, 38, 183, 29, 223, 106, 87, 207, 76, 231, 212,
-			226, 117, 183, 221, 52, 125, 198, 243, 110, 235, 178, 109, 159, 158,
-			249, 124, 99, 125, 253, 51, 249, 2, 47, 58, 213, 12, 231, 185,
-			70, 131, 83, 155, 199, 219, 150, 103, 181, 207, 173, 90, 134, 241,
-			51, 223, 111, 121, 79, 178, 217, 154, 117, 110, 53, 220, 22, 8,
-			82, 163, 131, 129, 10, 243, 128, 18, 107, 39, 66, 137, 44, 99,
-			188, 100, 213, 108, 207, 111, 219, 39, 29, 223, 118, 29, 110, 58,
-			53, 222, 241, 44, 80, 135, 123, 110, 167, 93, 181, 232, 201, 137,
-			237, 152, 237, 75, 210, 203, 91, 229, 23, 182, 127, 198, 221, 54,
-			253, 215, 237, 128, 158, 77, 183, 102, 215, 237, 170, 137, 28, 86,
-			185, 217, 182, 56, 72, 110, 218, 190, 111, 213, 56, 200, 60, 183,
-			107, 240, 195, 63, 51, 125, 248, 11, 71, 215, 104, 184, 23, 182,
-			115, 202, 171, 174, 83, 179, 241, 37, 15, 95, 2, 62, 150, 255,
-			4, 84, 194, 63, 15, 250, 20, 243, 184, 91, 87, 26, 85, 221,
-			154, 197, 155, 29, 207, 135, 145, 251, 38, 104, 138, 92, 205, 19,
-			247, 28, 155, 164, 197, 24, 119, 92, 223, 174, 90, 171, 208, 104,
-			123, 188, 1, 172, 144, 67, 88, 162, 83, 235, 83, 7, 228, 85,
-			27, 166, 221, 180, 218, 153, 171, 148, 0, 97, 33, 91, 40, 37,
-			96, 140, 181, 14, 40, 22, 232, 193, 186, 138, 252, 127, 233, 193,
-			184, 28, 93, 205, 173, 118, 154, 150, 227, 155, 106, 146, 178, 96,
-			127, 23, 90, 218, 28, 86, 138, 213, 182, 205, 134, 215, 53, 53,
-			77, 16, 52, 50, 30, 214, 62, 24, 212, 190, 101, 211, 155, 200,
-			216, 49, 155, 22, 42, 20, 94, 91, 142, 219, 109, 35, 187, 219,
-			190, 135, 35, 114, 4, 43, 23, 86, 111, 211, 188, 228, 39, 22,
-			174, 20, 80, 222, 229, 150, 83, 131, 167, 22, 46, 10, 80, 162,
-			233, 250, 22, 23, 54, 129, 213, 89, 3, 237, 96, 113, 242, 58,
-			52, 48, 97, 5, 207, 173, 251, 23, 184, 76, 228, 10, 226, 94,
-			203, 170, 226, 10, 130, 183, 108, 92, 88, 109, 92, 59, 142, 88,
-			69, 158, 71, 186, 51, 94, 121, 81, 44, 243, 242, 193, 179, 202,
-			219, 92, 169, 192, 225, 247, 97, 233, 224, 77, 113, 183, 176, 203,
-			119, 190, 129, 198, 2, 207, 31, 28, 126, 83, 42, 62, 127, 81,
-			225, 47, 14, 246, 118, 11, 165, 50, 207, 237, 239, 194, 211, 253,
-			74, 169, 184, 115, 84, 57, 40, 149, 25, 79, 230, 202, 240, 106,
-			146, 90, 114, 251, 223, 240, 194, 207, 15, 75, 133, 114, 153, 31,
-			148, 120, 241, 213, 225, 94, 17, 184, 1, 251, 82, 110, 191, 82,
-			44, 148, 87, 121, 113, 63, 191, 119, 180, 91, 220, 127, 190, 202,
-			129, 3, 223, 63, 168, 48, 190, 87, 124, 85, 172, 64, 191, 202,
-			193, 42, 137, 125, 255, 61, 126, 240, 140, 191, 42, 148, 242, 47,
-			128, 204, 237, 20, 247, 138, 149, 111, 72, 224, 179, 98, 101, 31,
-			133, 61, 59, 40, 49, 158, 227, 135, 185, 82, 165, 152, 63, 218,
-			203, 149, 248, 225, 81, 233, 240, 160, 92, 224, 56, 178, 221, 98,
-			57, 191, 151, 43, 190, 42, 236, 102, 64, 62, 200, 228, 133, 55,
-			133, 253, 10, 47, 191, 200, 237, 237, 245, 14, 148, 241, 131, 183,
-			251, 133, 18, 106, 31, 30, 38, 223, 41, 128, 150, 185, 157, 189,
-			2, 138, 162, 113, 238, 22, 75, 133, 124, 5, 7, 212, 253, 149,
-			7, 227, 129, 130, 123, 171, 140, 151, 15, 11, 249, 34, 252, 2,
-			123, 20, 96, 56, 185, 210, 55, 171, 146, 105, 185, 240, 250, 8,
-			122, 65, 35, 223, 205, 189, 202, 61, 135, 209, 165, 62, 102, 21,
-			152, 152, 252, 81, 169, 240, 10, 181, 6, 83, 148, 143, 118, 202,
-			149, 98, 229, 168, 82, 224, 207, 15, 14, 118, 201, 216, 229, 66,
-			233, 77, 49, 95, 40, 63, 229, 123, 7, 101, 50, 216, 81, 185,
-			0, 138, 236, 230, 42, 57, 18, 13, 60, 192, 92, 208, 14, 191,
-			119, 142, 202, 69, 50, 92, 113, 191, 82, 40, 149, 142, 14, 43,
-			197, 131, 253, 52, 204, 242, 91, 176, 12, 104, 153, 131, 119, 119,
-			201, 194, 7, 251, 56, 90, 92, 43, 133, 131, 210, 55, 200, 22,
-			237, 64, 51, 176, 202, 223, 190, 40, 192, 243, 18, 26, 149, 172,
-			149, 67, 51, 148, 193, 106, 249, 74, 184, 27, 8, 4, 35, 194,
-			144, 186, 227, 228, 251, 133, 231, 123, 197, 231, 133, 253, 124, 1,
-			155, 15, 144, 205, 219, 98, 185, 144, 134, 9, 43, 150, 177, 67,
-			145, 4, 195, 26, 0, 161, 71, 52, 106, 156, 40, 208, 139, 137,
-			223, 161, 165, 187, 74, 243, 201, 139, 207, 120, 110, 247, 77, 17,
-			53, 151, 189, 97, 5, 148, 139, 114, 185, 144, 217, 242, 47, 164,
-			205, 97, 249, 199, 89, 68, 211, 135, 121, 124, 14, 127, 197, 245,
-			225, 228, 208, 83, 54, 198, 162, 241, 255, 62, 58, 36, 136, 107,
-			108, 4, 9, 232, 148, 28, 157, 99, 215, 89, 140, 168, 33, 65,
-			142, 179, 81, 65, 70, 4, 45, 59, 143, 2, 101, 60, 145, 28,
-			239, 12, 173, 74, 142, 17, 65, 136, 78, 40, 246, 206, 232, 180,
-			228, 24, 65, 142, 72, 10, 142, 17, 226, 136, 180, 236, 12, 28,
-			239, 204, 62, 148, 28, 239, 14, 61, 148, 28, 53, 65, 136, 78,
-			26, 82, 163, 11, 146, 163, 134, 28, 145, 20, 28, 53, 226, 136,
-			180, 236, 12, 28, 239, 46, 63, 144, 28, 239, 13, 37, 37, 199,
-			97, 65, 136, 78, 195, 192, 241, 222, 168, 33, 57, 14, 35, 71,
-			36, 5, 199, 97, 226, 136, 180, 236, 140, 175, 46, 221, 150, 28,
-			87, 130, 81, 71, 5, 33, 58, 69, 129, 227, 202, 232, 93, 201,
-			49, 138, 28, 145, 20, 28, 163, 196, 17, 105, 217, 25, 56, 174,
-			220, 87, 163, 190, 63, 116, 91, 114, 28, 17, 132, 232, 52, 2,
-			28, 239, 143, 206, 75, 142, 35, 200, 17, 73, 193, 113, 132, 56,
-			34, 45, 59, 195, 168, 239, 47, 112, 201, 49, 53, 116, 75, 114,
-			140, 9, 66, 116, 138, 1, 199, 84, 48, 215, 49, 228, 152, 10,
-			230, 58, 70, 28, 83, 193, 92, 199, 64, 199, 148, 177, 204, 254,
-			143, 198, 180, 40, 244, 220, 28, 154, 52, 254, 167, 6, 94, 232,
-			212, 114, 192, 67, 87, 57, 65, 38, 8, 193, 158, 103, 158, 90,
-			34, 90, 95, 186, 29, 94, 53, 29, 136, 112, 107, 136, 9, 192,
-			209, 155, 231, 174, 93, 3, 151, 94, 183, 29, 138, 84, 157, 86,
-			3, 227, 190, 85, 99, 189, 239, 83, 164, 132, 215, 219, 60, 119,
-			88, 244, 50, 32, 198, 191, 108, 65, 207, 6, 183, 126, 48, 155,
-			45, 136, 52, 16, 6, 128, 31, 65, 13, 159, 155, 30, 5, 156,
-			182, 245, 171, 142, 229, 65, 236, 150, 1, 8, 128, 77, 11, 66,
-			164, 133, 47, 83, 148, 2, 93, 128, 31, 226, 132, 51, 183, 150,
-			225, 207, 160, 159, 237, 120, 190, 233, 84, 45, 5, 28, 16, 10,
-			65, 188, 133, 54, 151, 255, 90, 60, 226, 188, 221, 170, 242, 29,
-			179, 157, 234, 67, 122, 25, 2, 122, 105, 132, 17, 157, 54, 132,
-			226, 43, 218, 159, 10, 54, 191, 193, 24, 4, 90, 189, 44, 131,
-			151, 193, 160, 15, 176, 75, 69, 100, 64, 3, 252, 123, 234, 253,
-			61, 142, 76, 216, 130, 58, 186, 39, 127, 209, 170, 250, 252, 251,
-			95, 255, 230, 123, 216, 197, 140, 13, 71, 113, 94, 54, 227, 215,
-			79, 98, 36, 102, 147, 253, 245, 251, 236, 86, 63, 126, 245, 33,
-			250, 195, 184, 154, 173, 171, 48, 236, 83, 150, 168, 168, 62, 250,
-			60, 27, 245, 44, 132, 20, 222, 124, 132, 71, 82, 195, 37, 69,
-			234, 51, 108, 196, 49, 29, 215, 155, 215, 224, 249, 72, 73, 16,
-			59, 149, 193, 184, 119, 60, 224, 248, 91, 97, 223, 127, 121, 79,
-			96, 223, 102, 228, 39, 236, 251, 19, 246, 253, 9, 251, 254, 132,
-			125, 127, 194, 190, 63, 97, 223, 223, 23, 236, 123, 43, 140, 125,
-			111, 245, 96, 223, 185, 94, 236, 59, 215, 135, 125, 21, 199, 8,
-			32, 172, 59, 128, 176, 20, 246, 93, 13, 99, 223, 213, 30, 236,
-			59, 221, 139, 125, 167, 251, 176, 239, 116, 24, 251, 6, 104, 250,
-			222, 80, 54, 140, 125, 179, 61, 216, 119, 161, 23, 251, 46, 244,
-			97, 95, 133, 166, 135, 129, 227, 189, 229, 76, 128, 125, 147, 97,
-			236, 155, 236, 193, 190, 70, 47, 246, 53, 250, 176, 175, 17, 198,
-			190, 1, 154, 190, 31, 140, 122, 68, 16, 97, 236, 123, 183, 23,
-			251, 222, 237, 195, 190, 10, 77, 143, 0, 199, 251, 1, 154, 78,
-			5, 104, 58, 38, 136, 48, 246, 157, 239, 197, 190, 243, 125, 216,
-			87, 161, 233, 24, 140, 58, 5, 104, 250, 111, 79, 18, 246, 141,
-			154, 128, 81, 140, 191, 60, 9, 110, 40, 64, 60, 93, 64, 7,
-			81, 137, 183, 92, 128, 36, 20, 127, 160, 29, 254, 91, 179, 90,
-			224, 230, 161, 81, 128, 208, 75, 241, 252, 15, 92, 199, 34, 172,
-			10, 184, 22, 154, 205, 246, 106, 151, 11, 56, 125, 0, 181, 18,
-			134, 81, 156, 171, 183, 205, 106, 55, 154, 171, 6, 12, 214, 136,
-			201, 136, 70, 52, 227, 54, 4, 24, 1, 225, 71, 149, 60, 47,
-			180, 220, 234, 25, 137, 3, 135, 232, 19, 182, 116, 16, 3, 32,
-			82, 193, 120, 73, 145, 14, 0, 86, 195, 106, 65, 168, 229, 207,
-			219, 214, 169, 11, 17, 209, 225, 121, 169, 19, 191, 56, 179, 129,
-			131, 245, 3, 4, 149, 154, 128, 217, 221, 78, 74, 113, 198, 79,
-			204, 234, 59, 8, 75, 53, 66, 229, 151, 22, 188, 7, 131, 235,
-			23, 105, 122, 94, 167, 137, 82, 77, 0, 96, 240, 163, 227, 91,
-			132, 94, 248, 227, 117, 22, 12, 169, 225, 58, 167, 171, 220, 206,
-			192, 219, 13, 203, 108, 117, 135, 10, 253, 146, 94, 19, 88, 91,
-			181, 36, 132, 65, 1, 138, 28, 55, 220, 11, 98, 164, 121, 34,
-			146, 3, 199, 178, 80, 100, 157, 32, 62, 68, 249, 22, 226, 29,
-			10, 229, 188, 68, 64, 17, 250, 136, 176, 186, 190, 190, 254, 104,
-			141, 254, 87, 89, 95, 127, 66, 255, 251, 5, 142, 98, 27, 254,
-			172, 61, 218, 88, 219, 124, 84, 217, 216, 124, 242, 201, 54, 252,
-			47, 179, 173, 254, 252, 2, 16, 193, 206, 37, 26, 28, 2, 59,
-			204, 11, 154, 82, 170, 212, 70, 246, 128, 244, 44, 24, 183, 215,
-			105, 203, 92, 8, 72, 76, 133, 64, 203, 115, 171, 13, 96, 206,
-			101, 114, 86, 221, 38, 231, 165, 103, 121, 190, 185, 185, 185, 141,
-			112, 214, 226, 200, 210, 57, 245, 64, 66, 217, 178, 248, 47, 21,
-			46, 189, 184, 184, 200, 216, 150, 95, 207, 184, 237, 211, 108, 187,
-			94, 197, 255, 227, 75, 25, 255, 7, 255, 187, 212, 143, 233, 149,
-			70, 40, 80, 144, 9, 212, 163, 39, 128, 145, 155, 45, 152, 131,
-			208, 42, 38, 117, 192, 151, 22, 127, 206, 191, 199, 69, 147, 74,
-			99, 214, 65, 216, 167, 219, 41, 72, 46, 100, 98, 211, 77, 54,
-			60, 203, 63, 150, 243, 149, 162, 215, 247, 143, 246, 246, 210, 233,
-			129, 253, 104, 217, 166, 214, 161, 177, 171, 211, 198, 199, 116, 58,
-			181, 124, 228, 226, 214, 107, 230, 101, 72, 55, 176, 24, 224, 37,
-			18, 112, 14, 57, 162, 127, 46, 37, 246, 116, 95, 241, 207, 87,
-			57, 41, 244, 244, 183, 29, 210, 121, 198, 63, 71, 234, 67, 35,
-			18, 157, 0, 221, 85, 1, 46, 62, 130, 197, 213, 51, 194, 205,
-			43, 71, 248, 214, 118, 54, 55, 248, 247, 207, 45, 191, 124, 233,
-			249, 86, 19, 155, 115, 222, 51, 187, 97, 85, 122, 39, 226, 89,
-			113, 175, 80, 1, 136, 195, 235, 190, 84, 227, 170, 119, 86, 234,
-			190, 210, 244, 8, 194, 255, 227, 45, 80, 184, 250, 206, 227, 95,
-			240, 84, 42, 37, 158, 164, 235, 126, 166, 118, 241, 2, 208, 246,
-			46, 44, 61, 124, 43, 205, 63, 255, 156, 111, 110, 164, 249, 95,
-			226, 212, 182, 231, 94, 168, 38, 101, 183, 108, 22, 92, 31, 232,
-			91, 115, 47, 60, 98, 137, 155, 9, 134, 26, 114, 69, 144, 179,
-			171, 14, 22, 185, 160, 71, 143, 223, 223, 101, 1, 55, 124, 253,
-			209, 227, 173, 173, 173, 79, 55, 31, 3, 27, 181, 229, 79, 44,
-			216, 190, 22, 63, 114, 236, 31, 20, 151, 237, 79, 215, 251, 185,
-			100, 126, 187, 201, 76, 137, 241, 131, 41, 132, 81, 178, 52, 89,
-			248, 39, 13, 9, 102, 72, 157, 143, 172, 96, 228, 131, 230, 82,
-			124, 238, 133, 248, 208, 2, 72, 247, 44, 128, 173, 43, 23, 192,
-			75, 243, 220, 228, 223, 139, 137, 204, 84, 59, 237, 54, 132, 1,
-			236, 242, 202, 110, 64, 234, 19, 90, 0, 232, 33, 193, 125, 226,
-			83, 152, 202, 171, 95, 248, 192, 50, 135, 247, 130, 167, 25, 199,
-			186, 216, 233, 216, 13, 72, 54, 82, 105, 28, 88, 89, 90, 72,
-			138, 16, 134, 73, 171, 90, 8, 231, 216, 103, 95, 140, 29, 92,
-			43, 142, 92, 246, 20, 67, 151, 195, 38, 11, 164, 51, 39, 200,
-			153, 116, 233, 218, 224, 147, 43, 109, 32, 71, 161, 226, 38, 63,
-			188, 132, 36, 199, 81, 3, 119, 220, 11, 80, 156, 130, 153, 112,
-			78, 170, 100, 35, 150, 203, 23, 232, 233, 83, 208, 73, 62, 167,
-			249, 145, 79, 241, 49, 76, 171, 236, 42, 84, 124, 240, 96, 59,
-			221, 55, 175, 97, 187, 164, 100, 231, 47, 228, 127, 87, 5, 195,
-			47, 232, 239, 52, 99, 221, 106, 140, 25, 159, 98, 255, 56, 194,
-			162, 81, 194, 140, 117, 109, 198, 248, 59, 17, 72, 127, 3, 64,
-			160, 20, 132, 192, 141, 49, 153, 6, 7, 209, 183, 26, 94, 218,
-			108, 240, 218, 230, 175, 48, 77, 134, 116, 145, 204, 67, 127, 93,
-			17, 175, 216, 160, 128, 245, 11, 24, 124, 181, 1, 161, 254, 220,
-			2, 35, 94, 103, 35, 168, 98, 84, 143, 214, 53, 147, 64, 34,
-			146, 35, 168, 242, 168, 162, 96, 56, 245, 248, 132, 162, 0, 74,
-			213, 245, 105, 246, 223, 196, 224, 160, 173, 161, 233, 198, 127, 142,
-			240, 125, 215, 89, 115, 172, 83, 8, 168, 231, 86, 47, 50, 49,
-			229, 104, 57, 6, 231, 65, 200, 36, 3, 57, 180, 120, 81, 197,
-			124, 14, 62, 187, 3, 72, 128, 82, 239, 46, 51, 42, 16, 120,
-			62, 44, 45, 126, 102, 130, 24, 39, 44, 83, 204, 173, 120, 145,
-			137, 8, 91, 117, 59, 176, 114, 192, 99, 32, 14, 81, 224, 171,
-			207, 128, 235, 50, 176, 175, 202, 255, 179, 1, 246, 137, 128, 125,
-			26, 90, 125, 70, 218, 32, 50, 130, 163, 86, 246, 137, 160, 13,
-			226, 215, 21, 5, 246, 105, 76, 78, 5, 165, 184, 191, 15, 168,
-			22, 70, 84, 183, 79, 101, 221, 109, 202, 119, 223, 1, 10, 192,
-			18, 83, 59, 99, 214, 0, 243, 36, 47, 216, 84, 5, 31, 150,
-			233, 97, 158, 122, 235, 39, 236, 70, 21, 96, 129, 168, 4, 89,
-			199, 102, 7, 150, 61, 36, 242, 151, 243, 17, 62, 156, 26, 219,
-			88, 203, 188, 199, 39, 147, 239, 246, 207, 169, 238, 130, 91, 105,
-			166, 58, 160, 45, 249, 167, 17, 102, 92, 253, 146, 190, 192, 18,
-			29, 199, 254, 85, 199, 58, 182, 107, 243, 49, 42, 3, 198, 197,
-			131, 98, 77, 31, 103, 90, 213, 161, 226, 96, 162, 4, 191, 176,
-			51, 202, 56, 110, 153, 254, 25, 213, 6, 19, 165, 56, 62, 56,
-			4, 90, 159, 99, 163, 213, 118, 227, 184, 211, 110, 204, 15, 83,
-			83, 12, 200, 163, 118, 131, 68, 120, 214, 177, 139, 195, 155, 143,
-			66, 83, 28, 68, 120, 214, 1, 210, 250, 46, 187, 254, 14, 139,
-			129, 199, 53, 183, 105, 218, 142, 55, 63, 66, 67, 191, 53, 96,
-			232, 187, 212, 67, 14, 246, 26, 189, 37, 30, 121, 201, 127, 31,
-			97, 215, 194, 205, 250, 44, 139, 9, 134, 100, 74, 208, 69, 80,
-			250, 42, 211, 171, 13, 183, 83, 59, 134, 137, 194, 10, 235, 49,
-			86, 108, 228, 80, 38, 169, 229, 80, 52, 236, 195, 115, 125, 157,
-			205, 152, 88, 98, 178, 106, 66, 251, 141, 99, 175, 234, 182, 44,
-			24, 31, 242, 212, 101, 27, 13, 100, 163, 140, 45, 250, 22, 155,
-			109, 154, 213, 51, 219, 177, 142, 105, 0, 199, 13, 187, 110, 225,
-			138, 132, 113, 161, 109, 103, 100, 43, 173, 133, 61, 217, 246, 50,
-			26, 143, 78, 142, 192, 223, 177, 201, 209, 151, 255, 238, 6, 214,
-			67, 163, 67, 79, 34, 236, 143, 34, 84, 15, 133, 220, 99, 227,
-			31, 70, 122, 74, 155, 143, 30, 83, 77, 57, 127, 6, 171, 219,
-			238, 52, 185, 152, 85, 172, 152, 15, 172, 113, 30, 121, 84, 177,
-			146, 149, 164, 110, 69, 16, 200, 83, 23, 12, 236, 0, 100, 62,
-			185, 132, 77, 188, 83, 222, 93, 243, 252, 75, 112, 214, 13, 187,
-			106, 81, 13, 157, 246, 24, 64, 216, 19, 216, 184, 117, 216, 107,
-			53, 85, 96, 219, 43, 230, 11, 251, 101, 192, 35, 128, 59, 130,
-			108, 59, 22, 191, 201, 246, 68, 186, 148, 24, 186, 22, 49, 190,
-			230, 239, 173, 122, 148, 218, 182, 76, 9, 130, 195, 211, 92, 173,
-			159, 34, 243, 70, 167, 106, 175, 201, 253, 212, 245, 182, 9, 224,
-			124, 87, 57, 219, 49, 237, 107, 99, 142, 239, 201, 210, 96, 62,
-			231, 33, 208, 6, 52, 232, 249, 240, 198, 53, 229, 239, 160, 91,
-			64, 65, 26, 56, 54, 118, 47, 228, 238, 198, 86, 158, 134, 220,
-			221, 216, 151, 95, 177, 191, 53, 2, 138, 71, 244, 232, 205, 161,
-			100, 196, 248, 191, 81, 126, 245, 174, 17, 71, 25, 152, 197, 160,
-			67, 199, 42, 96, 62, 23, 214, 128, 151, 59, 128, 90, 224, 153,
-			13, 169, 15, 116, 11, 109, 76, 143, 114, 19, 7, 172, 239, 9,
-			219, 98, 233, 24, 231, 146, 236, 196, 203, 226, 28, 34, 195, 11,
-			176, 78, 168, 27, 3, 55, 72, 201, 101, 219, 62, 199, 52, 225,
-			157, 117, 73, 41, 68, 136, 167, 112, 161, 16, 91, 155, 144, 1,
-			226, 194, 5, 15, 235, 139, 196, 196, 226, 207, 94, 239, 238, 171,
-			201, 103, 196, 113, 149, 91, 153, 211, 12, 79, 230, 247, 191, 240,
-			26, 224, 98, 183, 54, 215, 170, 143, 50, 213, 76, 21, 87, 19,
-			184, 102, 10, 209, 161, 34, 120, 134, 50, 41, 199, 108, 36, 51,
-			242, 44, 163, 171, 171, 213, 198, 1, 120, 98, 105, 229, 247, 81,
-			168, 168, 94, 226, 161, 139, 141, 185, 47, 24, 77, 166, 54, 52,
-			114, 147, 203, 29, 128, 89, 34, 132, 130, 86, 195, 134, 181, 74,
-			74, 130, 24, 23, 218, 207, 92, 207, 167, 26, 107, 42, 217, 85,
-			47, 153, 166, 65, 155, 92, 236, 99, 42, 180, 50, 232, 241, 99,
-			180, 78, 175, 130, 61, 204, 118, 245, 76, 26, 191, 135, 9, 72,
-			101, 60, 217, 227, 130, 146, 104, 190, 85, 148, 7, 9, 41, 150,
-			114, 113, 197, 202, 168, 190, 42, 134, 219, 50, 219, 240, 174, 143,
-			167, 18, 48, 145, 213, 182, 125, 66, 229, 122, 171, 77, 155, 164,
-			45, 78, 199, 76, 202, 15, 77, 177, 196, 197, 28, 41, 223, 96,
-			215, 248, 231, 106, 156, 95, 126, 253, 57, 245, 88, 19, 187, 96,
-			13, 28, 232, 151, 114, 221, 99, 216, 185, 25, 79, 178, 167, 176,
-			238, 169, 140, 100, 104, 115, 73, 48, 220, 110, 176, 155, 243, 57,
-			60, 92, 128, 77, 15, 161, 206, 106, 130, 22, 152, 253, 146, 33,
-			197, 198, 146, 225, 45, 66, 225, 223, 208, 110, 82, 181, 4, 201,
-			17, 100, 54, 170, 40, 144, 99, 36, 116, 69, 193, 126, 48, 110,
-			204, 178, 175, 73, 42, 52, 45, 104, 147, 201, 77, 92, 206, 161,
-			37, 182, 42, 2, 117, 211, 244, 97, 157, 150, 59, 226, 184, 42,
-			191, 175, 156, 3, 174, 78, 37, 26, 35, 235, 130, 102, 204, 73,
-			246, 24, 89, 23, 180, 184, 162, 144, 127, 98, 76, 81, 32, 122,
-			97, 124, 130, 125, 69, 162, 193, 159, 44, 194, 128, 55, 56, 198,
-			27, 181, 158, 219, 174, 235, 247, 44, 126, 244, 63, 125, 110, 67,
-			73, 214, 64, 242, 162, 182, 48, 41, 185, 107, 35, 200, 80, 73,
-			198, 145, 45, 6, 131, 214, 64, 242, 34, 12, 122, 133, 36, 3,
-			177, 164, 221, 72, 222, 228, 23, 56, 167, 40, 26, 60, 53, 110,
-			233, 210, 158, 40, 31, 72, 1, 195, 32, 96, 73, 91, 84, 67,
-			27, 30, 193, 247, 148, 128, 97, 16, 176, 148, 80, 194, 241, 252,
-			120, 105, 122, 134, 237, 144, 0, 112, 78, 203, 48, 180, 79, 208,
-			101, 16, 127, 88, 92, 53, 233, 198, 237, 63, 16, 103, 22, 103,
-			224, 36, 45, 172, 196, 192, 234, 33, 241, 184, 156, 64, 3, 37,
-			60, 10, 194, 151, 181, 165, 27, 82, 64, 116, 4, 121, 198, 20,
-			5, 194, 151, 131, 209, 97, 185, 109, 25, 70, 87, 38, 225, 208,
-			241, 182, 246, 192, 120, 198, 127, 22, 138, 161, 193, 66, 238, 89,
-			221, 242, 176, 21, 215, 180, 133, 30, 9, 154, 32, 223, 233, 52,
-			204, 182, 220, 68, 210, 223, 2, 211, 40, 114, 13, 40, 240, 183,
-			183, 199, 148, 93, 70, 64, 153, 219, 243, 247, 20, 5, 202, 220,
-			6, 20, 95, 0, 119, 171, 233, 209, 123, 16, 234, 140, 109, 30,
-			14, 223, 184, 225, 232, 160, 4, 244, 2, 39, 242, 1, 79, 44,
-			55, 10, 206, 229, 189, 248, 12, 123, 136, 191, 19, 250, 240, 202,
-			216, 100, 242, 22, 140, 168, 97, 97, 73, 173, 110, 91, 13, 4,
-			242, 53, 23, 143, 150, 32, 248, 0, 111, 120, 113, 12, 140, 161,
-			37, 176, 76, 57, 118, 141, 84, 3, 34, 210, 67, 105, 130, 18,
-			29, 177, 105, 124, 66, 54, 69, 122, 41, 77, 80, 207, 176, 35,
-			238, 211, 180, 182, 20, 12, 9, 7, 19, 114, 56, 4, 151, 113,
-			239, 75, 167, 41, 86, 44, 55, 91, 224, 9, 209, 145, 186, 210,
-			162, 26, 69, 176, 180, 22, 80, 48, 107, 233, 177, 41, 69, 129,
-			252, 180, 62, 175, 40, 176, 104, 122, 97, 145, 253, 167, 8, 41,
-			0, 109, 235, 26, 55, 254, 4, 48, 67, 31, 162, 65, 93, 204,
-			254, 19, 44, 234, 196, 101, 47, 156, 241, 42, 132, 103, 44, 72,
-			137, 88, 196, 184, 89, 37, 144, 77, 103, 121, 41, 154, 23, 92,
-			15, 7, 56, 21, 27, 210, 207, 164, 7, 133, 134, 251, 48, 214,
-			11, 39, 56, 91, 151, 108, 132, 227, 32, 108, 95, 168, 217, 62,
-			30, 128, 5, 135, 86, 194, 125, 96, 160, 146, 248, 76, 250, 47,
-			141, 156, 200, 186, 150, 94, 146, 35, 70, 39, 178, 46, 119, 154,
-			70, 78, 100, 61, 177, 160, 40, 176, 198, 250, 242, 45, 246, 87,
-			133, 53, 96, 110, 182, 180, 135, 198, 57, 98, 163, 62, 184, 38,
-			204, 113, 113, 102, 251, 150, 58, 102, 148, 163, 34, 156, 39, 234,
-			158, 194, 125, 11, 231, 204, 81, 53, 244, 183, 178, 242, 215, 196,
-			130, 47, 68, 58, 249, 22, 140, 208, 242, 188, 144, 195, 15, 230,
-			18, 55, 252, 86, 48, 151, 184, 3, 183, 130, 185, 196, 249, 218,
-			210, 87, 20, 5, 218, 111, 165, 31, 176, 191, 34, 180, 7, 106,
-			91, 187, 99, 116, 248, 171, 1, 192, 17, 245, 63, 131, 12, 151,
-			74, 3, 50, 230, 192, 236, 200, 40, 35, 167, 6, 160, 220, 57,
-			184, 107, 60, 158, 150, 53, 26, 152, 170, 98, 157, 175, 175, 246,
-			119, 196, 90, 43, 110, 16, 137, 107, 3, 219, 163, 151, 219, 214,
-			182, 30, 74, 13, 209, 203, 109, 203, 216, 161, 145, 151, 219, 142,
-			47, 43, 10, 245, 189, 157, 12, 82, 163, 63, 253, 140, 45, 13,
-			74, 113, 96, 169, 95, 153, 43, 25, 31, 186, 151, 107, 124, 236,
-			210, 131, 209, 147, 138, 37, 255, 48, 194, 166, 139, 205, 150, 219,
-			246, 101, 226, 32, 46, 139, 232, 21, 198, 106, 214, 249, 177, 232,
-			43, 243, 173, 79, 6, 36, 29, 3, 222, 205, 236, 90, 231, 226,
-			65, 193, 241, 219, 151, 165, 68, 77, 209, 198, 231, 108, 188, 183,
-			81, 159, 100, 195, 128, 216, 100, 14, 133, 63, 241, 114, 5, 37,
-			176, 50, 235, 16, 196, 19, 237, 179, 72, 114, 131, 205, 244, 138,
-			19, 247, 88, 116, 131, 197, 219, 214, 185, 141, 27, 68, 50, 10,
-			232, 228, 167, 108, 226, 25, 198, 6, 136, 11, 106, 104, 253, 89,
-			27, 8, 132, 253, 90, 21, 2, 227, 37, 65, 36, 15, 216, 100,
-			247, 69, 41, 232, 41, 99, 152, 194, 129, 53, 253, 142, 184, 20,
-			50, 182, 177, 56, 40, 9, 45, 237, 149, 169, 79, 41, 1, 253,
-			197, 207, 228, 109, 54, 129, 128, 28, 192, 120, 192, 79, 105, 50,
-			44, 52, 73, 222, 101, 250, 115, 11, 122, 200, 151, 7, 235, 155,
-			252, 99, 141, 77, 247, 116, 147, 220, 10, 44, 22, 76, 87, 228,
-			207, 159, 30, 203, 151, 117, 157, 69, 113, 69, 74, 243, 211, 111,
-			188, 10, 211, 182, 154, 144, 10, 213, 40, 119, 141, 151, 20, 137,
-			198, 195, 100, 229, 82, 38, 174, 130, 192, 148, 214, 68, 168, 117,
-			12, 243, 64, 153, 29, 76, 9, 61, 40, 89, 231, 250, 45, 54,
-			214, 105, 97, 73, 95, 52, 199, 168, 153, 201, 71, 178, 131, 100,
-			79, 29, 70, 69, 7, 249, 8, 59, 244, 206, 67, 252, 207, 55,
-			15, 143, 97, 21, 129, 209, 206, 161, 119, 13, 205, 17, 54, 179,
-			25, 152, 217, 68, 218, 115, 164, 21, 224, 87, 242, 17, 187, 209,
-			247, 158, 180, 59, 25, 135, 30, 211, 219, 100, 28, 34, 147, 91,
-			108, 46, 127, 102, 85, 223, 133, 172, 174, 164, 221, 100, 113, 81,
-			42, 176, 154, 82, 230, 40, 85, 10, 172, 102, 242, 91, 54, 255,
-			254, 91, 82, 22, 188, 102, 123, 199, 176, 37, 236, 64, 152, 237,
-			189, 65, 82, 191, 199, 198, 109, 135, 90, 192, 106, 166, 231, 42,
-			221, 175, 203, 167, 37, 122, 152, 252, 31, 17, 150, 8, 236, 162,
-			239, 178, 201, 134, 233, 249, 199, 194, 250, 199, 148, 137, 139, 213,
-			99, 244, 95, 100, 202, 4, 37, 192, 210, 56, 190, 115, 68, 175,
-			224, 67, 125, 135, 77, 16, 23, 66, 97, 130, 137, 246, 81, 38,
-			215, 241, 21, 218, 98, 196, 99, 165, 135, 135, 229, 155, 167, 178,
-			76, 210, 237, 87, 128, 135, 122, 134, 77, 75, 243, 30, 163, 193,
-			188, 99, 10, 154, 180, 252, 134, 75, 83, 237, 238, 252, 120, 121,
-			108, 216, 248, 147, 40, 155, 29, 176, 234, 193, 193, 234, 199, 236,
-			90, 216, 159, 232, 43, 63, 206, 191, 25, 247, 63, 218, 79, 206,
-			86, 153, 197, 149, 15, 209, 147, 3, 94, 234, 243, 76, 198, 157,
-			15, 246, 9, 182, 249, 168, 244, 35, 250, 236, 123, 230, 165, 203,
-			114, 198, 32, 89, 253, 190, 231, 91, 54, 22, 114, 34, 250, 189,
-			1, 175, 188, 239, 139, 140, 65, 38, 26, 228, 139, 78, 216, 245,
-			158, 205, 162, 15, 180, 217, 128, 109, 104, 164, 62, 222, 81, 202,
-			120, 199, 38, 251, 247, 137, 254, 96, 144, 23, 24, 188, 5, 141,
-			135, 63, 170, 175, 16, 246, 242, 159, 167, 69, 217, 233, 135, 223,
-			219, 178, 83, 130, 105, 195, 0, 189, 227, 163, 119, 233, 39, 150,
-			140, 70, 83, 244, 19, 58, 140, 141, 222, 96, 255, 38, 194, 180,
-			216, 144, 30, 157, 26, 186, 31, 49, 254, 69, 100, 80, 86, 129,
-			8, 92, 72, 21, 249, 7, 104, 23, 160, 87, 180, 31, 222, 13,
-			51, 233, 58, 23, 130, 63, 211, 193, 59, 175, 38, 30, 64, 67,
-			162, 210, 162, 12, 56, 231, 5, 111, 246, 195, 97, 194, 93, 84,
-			76, 168, 2, 194, 162, 19, 107, 232, 213, 11, 49, 81, 146, 237,
-			123, 86, 163, 46, 110, 17, 138, 118, 146, 237, 137, 92, 39, 134,
-			208, 127, 10, 0, 215, 159, 1, 64, 140, 81, 53, 108, 78, 123,
-			110, 252, 175, 8, 15, 111, 81, 80, 238, 157, 196, 175, 18, 185,
-			82, 201, 13, 107, 45, 50, 225, 160, 234, 91, 40, 103, 230, 234,
-			238, 221, 5, 234, 185, 143, 23, 210, 196, 185, 182, 117, 138, 233,
-			94, 227, 82, 190, 40, 96, 162, 224, 230, 208, 5, 128, 211, 54,
-			205, 15, 229, 135, 159, 32, 36, 206, 244, 168, 194, 122, 44, 138,
-			169, 180, 43, 86, 130, 144, 106, 9, 86, 205, 166, 85, 179, 97,
-			38, 26, 151, 116, 125, 64, 212, 52, 26, 110, 245, 29, 7, 39,
-			103, 55, 148, 112, 22, 72, 23, 208, 58, 38, 138, 121, 115, 49,
-			93, 81, 48, 217, 115, 211, 43, 138, 2, 56, 58, 247, 168, 192,
-			114, 100, 42, 172, 114, 104, 79, 141, 45, 174, 124, 206, 251, 86,
-			18, 105, 190, 41, 18, 125, 72, 77, 60, 183, 137, 197, 189, 64,
-			24, 102, 26, 70, 108, 92, 81, 32, 204, 152, 88, 86, 20, 214,
-			77, 210, 219, 236, 5, 9, 211, 176, 20, 240, 149, 241, 148, 75,
-			199, 20, 92, 23, 54, 131, 43, 141, 161, 114, 10, 101, 133, 96,
-			106, 104, 1, 131, 212, 112, 29, 5, 50, 81, 239, 165, 216, 117,
-			69, 33, 227, 241, 59, 138, 194, 170, 66, 230, 11, 86, 34, 153,
-			64, 112, 109, 215, 40, 240, 144, 203, 10, 228, 10, 11, 118, 218,
-			162, 184, 64, 119, 67, 105, 108, 178, 152, 25, 108, 176, 160, 242,
-			42, 36, 224, 70, 226, 177, 41, 69, 225, 141, 42, 253, 174, 162,
-			80, 96, 118, 135, 85, 72, 58, 164, 56, 119, 180, 151, 198, 115,
-			222, 227, 206, 184, 103, 94, 122, 88, 196, 160, 11, 148, 102, 79,
-			233, 198, 163, 107, 152, 220, 233, 52, 79, 40, 183, 82, 42, 128,
-			245, 3, 249, 88, 200, 184, 19, 155, 86, 20, 94, 173, 154, 73,
-			41, 10, 239, 78, 109, 190, 96, 63, 35, 249, 144, 150, 172, 104,
-			101, 227, 75, 222, 239, 228, 62, 164, 130, 77, 199, 73, 118, 141,
-			83, 85, 214, 15, 196, 98, 201, 98, 37, 54, 171, 40, 76, 240,
-			231, 50, 138, 194, 203, 75, 219, 175, 217, 231, 162, 208, 253, 96,
-			104, 61, 98, 172, 243, 1, 145, 148, 10, 135, 166, 39, 215, 125,
-			184, 67, 168, 176, 253, 32, 190, 192, 254, 56, 56, 70, 204, 106,
-			247, 140, 127, 22, 225, 65, 22, 129, 44, 154, 102, 171, 133, 9,
-			39, 204, 217, 175, 213, 238, 197, 162, 23, 229, 241, 107, 95, 242,
-			240, 179, 19, 183, 118, 249, 155, 174, 167, 161, 61, 231, 58, 176,
-			123, 97, 206, 33, 79, 145, 203, 28, 180, 177, 73, 27, 177, 8,
-			154, 184, 207, 36, 23, 44, 88, 56, 190, 117, 42, 214, 9, 227,
-			62, 140, 3, 60, 124, 241, 212, 113, 113, 97, 98, 161, 21, 171,
-			42, 192, 17, 122, 226, 149, 213, 158, 19, 198, 172, 246, 96, 41,
-			84, 114, 207, 106, 55, 66, 37, 247, 236, 44, 15, 149, 220, 179,
-			119, 238, 178, 151, 162, 226, 190, 57, 244, 73, 4, 230, 109, 16,
-			198, 16, 167, 5, 184, 130, 133, 83, 237, 113, 114, 48, 38, 175,
-			67, 57, 119, 168, 96, 186, 25, 95, 100, 25, 85, 48, 221, 210,
-			102, 147, 183, 185, 202, 150, 68, 52, 178, 228, 216, 105, 52, 225,
-			114, 33, 14, 96, 75, 219, 92, 14, 213, 72, 183, 130, 106, 30,
-			14, 96, 43, 49, 21, 170, 145, 110, 205, 220, 96, 207, 69, 13,
-			235, 179, 161, 167, 17, 216, 234, 125, 120, 71, 22, 192, 235, 182,
-			21, 174, 186, 192, 142, 235, 173, 39, 186, 237, 80, 21, 235, 179,
-			248, 28, 29, 115, 80, 25, 105, 91, 155, 76, 206, 245, 20, 246,
-			229, 0, 242, 57, 149, 155, 15, 81, 110, 254, 217, 205, 80, 149,
-			104, 59, 168, 139, 160, 206, 219, 178, 184, 42, 170, 68, 219, 227,
-			19, 236, 11, 85, 36, 122, 162, 77, 37, 215, 149, 203, 3, 15,
-			222, 50, 241, 70, 50, 234, 100, 157, 67, 60, 178, 235, 120, 166,
-			65, 133, 26, 252, 216, 162, 65, 169, 79, 184, 30, 243, 68, 219,
-			158, 12, 213, 99, 158, 200, 226, 163, 168, 199, 60, 25, 189, 22,
-			170, 199, 60, 153, 152, 100, 159, 50, 220, 176, 209, 47, 135, 190,
-			142, 24, 15, 121, 63, 238, 235, 159, 104, 213, 46, 109, 131, 110,
-			232, 203, 248, 60, 91, 7, 229, 233, 182, 226, 87, 218, 66, 242,
-			14, 23, 41, 82, 96, 22, 80, 221, 172, 251, 242, 162, 54, 13,
-			76, 232, 59, 76, 118, 250, 74, 251, 146, 238, 31, 34, 25, 67,
-			14, 99, 138, 2, 230, 95, 93, 155, 85, 20, 232, 251, 213, 77,
-			131, 125, 194, 168, 196, 154, 31, 42, 68, 140, 52, 239, 195, 151,
-			253, 234, 202, 102, 169, 45, 58, 173, 188, 156, 73, 186, 9, 185,
-			171, 221, 188, 122, 38, 81, 108, 148, 202, 125, 187, 90, 64, 129,
-			65, 119, 101, 137, 72, 92, 158, 220, 213, 103, 20, 5, 10, 238,
-			206, 205, 179, 29, 134, 69, 223, 232, 139, 161, 151, 17, 227, 49,
-			127, 31, 205, 126, 116, 253, 73, 109, 209, 215, 189, 136, 27, 164,
-			45, 221, 178, 44, 126, 108, 221, 141, 144, 61, 139, 218, 139, 69,
-			82, 105, 132, 212, 45, 202, 117, 39, 110, 102, 22, 229, 186, 27,
-			33, 117, 139, 176, 238, 126, 13, 234, 2, 198, 60, 24, 250, 121,
-			196, 112, 249, 0, 84, 221, 111, 211, 112, 20, 147, 31, 239, 136,
-			210, 149, 252, 226, 135, 226, 36, 175, 185, 150, 231, 220, 247, 185,
-			245, 3, 76, 193, 42, 93, 50, 20, 5, 95, 130, 10, 162, 116,
-			36, 198, 25, 3, 181, 14, 192, 219, 46, 193, 56, 233, 238, 231,
-			161, 150, 74, 78, 6, 183, 79, 194, 206, 32, 70, 3, 60, 212,
-			14, 132, 55, 139, 209, 130, 57, 212, 22, 21, 5, 156, 14, 151,
-			238, 40, 10, 6, 120, 184, 114, 159, 165, 137, 47, 52, 189, 214,
-			244, 228, 34, 135, 212, 119, 77, 221, 129, 4, 61, 67, 81, 71,
-			201, 192, 77, 244, 90, 59, 76, 73, 62, 184, 137, 94, 75, 35,
-			198, 104, 19, 189, 78, 92, 87, 20, 200, 120, 61, 57, 197, 158,
-			144, 12, 8, 71, 37, 109, 58, 185, 38, 142, 15, 236, 224, 48,
-			136, 95, 152, 104, 66, 42, 36, 200, 243, 213, 32, 162, 43, 161,
-			120, 40, 82, 210, 94, 235, 146, 49, 134, 205, 146, 220, 185, 49,
-			114, 11, 165, 209, 113, 69, 129, 208, 210, 148, 206, 30, 145, 80,
-			32, 202, 224, 49, 238, 190, 39, 84, 30, 232, 210, 23, 30, 16,
-			113, 0, 20, 43, 89, 88, 57, 44, 107, 165, 105, 201, 15, 43,
-			135, 229, 64, 22, 110, 233, 178, 244, 18, 49, 194, 18, 101, 240,
-			18, 89, 146, 5, 123, 161, 162, 205, 37, 147, 93, 152, 120, 46,
-			14, 66, 148, 76, 8, 137, 116, 39, 84, 73, 194, 157, 90, 209,
-			202, 83, 146, 27, 30, 134, 84, 2, 83, 226, 118, 172, 36, 212,
-			136, 17, 53, 84, 110, 204, 178, 13, 146, 4, 29, 143, 96, 115,
-			222, 187, 82, 18, 154, 84, 22, 111, 148, 176, 17, 16, 118, 164,
-			85, 230, 36, 195, 17, 226, 161, 132, 225, 110, 58, 74, 204, 40,
-			10, 132, 29, 193, 94, 21, 194, 96, 17, 189, 249, 168, 48, 57,
-			127, 74, 88, 12, 132, 189, 209, 142, 110, 74, 134, 177, 17, 228,
-			161, 132, 225, 146, 126, 19, 8, 195, 175, 245, 222, 128, 176, 79,
-			73, 216, 168, 62, 252, 22, 156, 228, 3, 142, 5, 6, 78, 71,
-			156, 3, 252, 101, 157, 62, 93, 33, 233, 74, 226, 40, 72, 124,
-			171, 189, 81, 18, 241, 10, 245, 91, 233, 43, 129, 2, 137, 111,
-			165, 175, 4, 10, 36, 190, 5, 95, 249, 6, 246, 246, 168, 30,
-			253, 229, 208, 95, 136, 24, 47, 249, 160, 196, 151, 190, 134, 161,
-			131, 166, 174, 43, 18, 46, 69, 158, 236, 86, 9, 44, 134, 17,
-			161, 220, 182, 40, 241, 151, 16, 212, 209, 61, 141, 226, 182, 253,
-			246, 99, 238, 105, 148, 118, 239, 183, 218, 47, 69, 40, 31, 37,
-			247, 244, 173, 52, 218, 40, 237, 222, 111, 165, 123, 26, 165, 221,
-			251, 45, 184, 167, 34, 177, 135, 166, 239, 128, 253, 231, 164, 206,
-			125, 175, 15, 162, 166, 78, 0, 186, 21, 193, 87, 116, 239, 54,
-			227, 113, 178, 85, 181, 155, 208, 73, 28, 115, 167, 149, 14, 184,
-			187, 191, 211, 190, 157, 148, 114, 112, 119, 127, 23, 232, 128, 187,
-			251, 187, 64, 7, 220, 221, 223, 129, 14, 95, 131, 25, 227, 120,
-			211, 188, 26, 129, 52, 101, 96, 89, 224, 61, 64, 20, 238, 36,
-			12, 22, 167, 203, 105, 75, 180, 12, 226, 104, 176, 19, 240, 21,
-			15, 66, 219, 86, 156, 207, 138, 35, 233, 83, 27, 99, 125, 121,
-			95, 194, 239, 224, 124, 49, 78, 54, 60, 209, 76, 250, 170, 1,
-			201, 17, 100, 20, 83, 20, 200, 56, 145, 142, 34, 78, 54, 60,
-			1, 71, 81, 2, 253, 19, 122, 180, 62, 116, 22, 49, 158, 189,
-			135, 195, 7, 172, 4, 240, 145, 129, 45, 195, 176, 28, 79, 161,
-			240, 109, 185, 4, 18, 116, 63, 237, 22, 32, 238, 104, 52, 129,
-			35, 58, 5, 92, 151, 253, 232, 219, 180, 184, 9, 222, 219, 190,
-			68, 46, 9, 26, 214, 169, 86, 167, 79, 2, 144, 28, 65, 110,
-			113, 69, 129, 160, 83, 137, 242, 18, 52, 172, 83, 137, 242, 152,
-			30, 125, 55, 228, 32, 202, 187, 170, 134, 210, 63, 51, 253, 253,
-			228, 88, 24, 136, 120, 23, 231, 108, 13, 198, 194, 112, 44, 13,
-			109, 38, 201, 197, 236, 144, 47, 24, 148, 158, 8, 229, 25, 41,
-			223, 208, 222, 209, 55, 23, 72, 210, 45, 181, 152, 162, 240, 150,
-			218, 232, 132, 162, 240, 150, 154, 62, 205, 242, 36, 7, 154, 154,
-			218, 98, 242, 49, 216, 92, 84, 79, 201, 54, 61, 162, 28, 101,
-			169, 238, 173, 7, 249, 76, 73, 199, 21, 221, 212, 26, 51, 82,
-			2, 174, 232, 166, 52, 29, 163, 21, 221, 76, 204, 41, 10, 164,
-			55, 141, 5, 246, 15, 34, 96, 187, 49, 61, 234, 13, 253, 16,
-			49, 190, 224, 65, 101, 54, 116, 220, 140, 235, 177, 129, 87, 99,
-			194, 78, 138, 246, 116, 128, 211, 9, 7, 110, 236, 103, 127, 151,
-			127, 196, 108, 140, 129, 214, 94, 124, 138, 109, 129, 149, 198, 112,
-			54, 124, 237, 81, 242, 190, 184, 172, 73, 179, 129, 62, 18, 189,
-			114, 247, 120, 77, 22, 101, 148, 179, 25, 163, 73, 241, 53, 79,
-			68, 185, 49, 130, 10, 190, 182, 160, 40, 16, 224, 47, 174, 42,
-			10, 204, 226, 103, 215, 89, 138, 196, 65, 83, 71, 91, 79, 46,
-			12, 16, 71, 248, 76, 5, 129, 49, 178, 124, 71, 243, 31, 73,
-			54, 145, 24, 190, 169, 68, 160, 229, 59, 139, 15, 21, 5, 34,
-			58, 153, 172, 28, 17, 32, 133, 115, 109, 9, 70, 132, 21, 103,
-			180, 41, 5, 3, 153, 53, 213, 59, 13, 200, 227, 164, 168, 238,
-			214, 31, 35, 140, 112, 174, 117, 214, 37, 75, 12, 146, 231, 114,
-			162, 199, 8, 35, 156, 39, 230, 21, 5, 226, 206, 23, 22, 217,
-			103, 36, 14, 136, 11, 141, 39, 31, 42, 135, 73, 85, 13, 114,
-			79, 189, 151, 160, 186, 137, 190, 18, 137, 80, 225, 66, 59, 95,
-			146, 108, 17, 42, 92, 200, 67, 198, 49, 130, 10, 23, 113, 53,
-			94, 132, 10, 23, 203, 183, 130, 67, 198, 255, 122, 141, 237, 158,
-			130, 47, 235, 156, 208, 55, 187, 88, 212, 162, 191, 214, 78, 221,
-			108, 149, 162, 68, 214, 108, 217, 217, 80, 53, 52, 43, 107, 109,
-			199, 242, 76, 90, 158, 69, 142, 133, 186, 124, 244, 160, 49, 249,
-			103, 17, 54, 46, 239, 107, 229, 4, 27, 125, 137, 49, 117, 177,
-			80, 30, 96, 36, 74, 9, 249, 164, 88, 235, 189, 108, 41, 239,
-			79, 6, 151, 45, 103, 216, 136, 213, 52, 109, 117, 123, 82, 16,
-			58, 184, 169, 154, 237, 181, 26, 230, 165, 184, 170, 24, 165, 198,
-			49, 249, 140, 110, 41, 166, 216, 164, 188, 157, 88, 109, 216, 0,
-			100, 145, 185, 56, 147, 26, 23, 207, 243, 244, 24, 68, 232, 44,
-			90, 255, 85, 205, 145, 71, 82, 244, 91, 127, 194, 88, 183, 238,
-			68, 103, 81, 31, 62, 214, 8, 245, 126, 249, 95, 18, 162, 104,
-			60, 255, 123, 90, 52, 158, 238, 22, 141, 83, 120, 149, 155, 202,
-			57, 215, 97, 56, 198, 223, 141, 240, 222, 169, 13, 249, 43, 83,
-			222, 152, 40, 230, 94, 169, 78, 92, 246, 162, 187, 127, 150, 21,
-			124, 84, 78, 55, 74, 195, 223, 147, 219, 102, 51, 219, 182, 234,
-			96, 61, 167, 106, 101, 241, 59, 162, 236, 249, 163, 172, 92, 33,
-			94, 198, 235, 145, 233, 221, 233, 213, 161, 91, 51, 186, 30, 159,
-			165, 139, 68, 84, 50, 26, 215, 230, 241, 34, 17, 214, 53, 106,
-			10, 9, 181, 212, 109, 14, 52, 9, 248, 212, 110, 145, 51, 116,
-			19, 35, 92, 184, 25, 215, 174, 207, 171, 226, 204, 8, 242, 140,
-			135, 10, 55, 227, 137, 233, 80, 225, 102, 124, 118, 142, 125, 169,
-			110, 134, 79, 0, 74, 127, 68, 204, 197, 66, 38, 48, 231, 201,
-			239, 177, 2, 117, 174, 16, 140, 94, 109, 66, 27, 87, 130, 49,
-			158, 76, 4, 130, 209, 171, 77, 72, 208, 46, 138, 171, 19, 0,
-			218, 63, 37, 193, 48, 123, 147, 2, 211, 156, 97, 138, 7, 27,
-			133, 155, 181, 90, 27, 47, 95, 124, 88, 34, 58, 182, 73, 109,
-			98, 78, 114, 69, 199, 54, 25, 72, 196, 241, 76, 38, 198, 21,
-			5, 18, 39, 1, 211, 124, 66, 18, 129, 152, 210, 140, 100, 74,
-			124, 91, 78, 107, 161, 229, 135, 74, 74, 87, 200, 67, 175, 54,
-			165, 77, 42, 243, 161, 87, 155, 10, 228, 161, 87, 155, 74, 168,
-			10, 25, 122, 181, 169, 249, 155, 116, 217, 143, 138, 169, 186, 182,
-			156, 204, 16, 115, 121, 189, 68, 108, 111, 52, 107, 93, 126, 111,
-			126, 133, 84, 76, 134, 116, 109, 202, 144, 156, 49, 25, 210, 3,
-			169, 152, 12, 233, 137, 155, 138, 2, 169, 250, 226, 18, 251, 129,
-			164, 66, 199, 89, 202, 93, 213, 173, 81, 216, 76, 166, 231, 185,
-			85, 155, 34, 31, 94, 91, 98, 27, 175, 104, 63, 203, 28, 251,
-			196, 106, 184, 23, 226, 31, 48, 80, 223, 210, 191, 119, 168, 129,
-			181, 93, 58, 211, 88, 165, 235, 37, 8, 141, 212, 6, 10, 138,
-			135, 152, 82, 129, 232, 101, 169, 214, 8, 105, 162, 84, 198, 148,
-			106, 54, 161, 174, 223, 99, 74, 53, 11, 169, 112, 154, 84, 142,
-			225, 249, 199, 67, 80, 89, 230, 81, 221, 123, 70, 24, 70, 233,
-			80, 94, 201, 192, 76, 106, 78, 155, 85, 75, 42, 70, 175, 46,
-			40, 10, 143, 17, 22, 87, 20, 133, 7, 7, 233, 7, 65, 136,
-			249, 143, 227, 108, 182, 47, 102, 124, 224, 2, 203, 239, 36, 26,
-			37, 223, 178, 133, 60, 221, 196, 234, 245, 2, 87, 157, 231, 43,
-			231, 174, 133, 156, 123, 112, 245, 99, 56, 124, 245, 163, 198, 22,
-			7, 51, 150, 7, 143, 187, 108, 162, 79, 35, 121, 102, 190, 208,
-			115, 162, 216, 247, 246, 120, 175, 243, 218, 248, 155, 17, 54, 209,
-			219, 197, 211, 47, 216, 204, 32, 201, 122, 102, 208, 81, 229, 213,
-			99, 55, 178, 63, 186, 191, 60, 222, 252, 107, 99, 34, 82, 221,
-			249, 61, 141, 84, 161, 227, 205, 87, 236, 239, 201, 131, 204, 235,
-			67, 115, 17, 227, 111, 244, 71, 42, 79, 228, 33, 52, 112, 27,
-			221, 48, 58, 11, 58, 171, 164, 251, 163, 87, 133, 46, 239, 119,
-			120, 60, 121, 61, 62, 199, 254, 149, 166, 142, 39, 103, 181, 99,
-			227, 159, 106, 124, 208, 252, 200, 171, 134, 94, 239, 85, 68, 212,
-			174, 255, 210, 96, 215, 11, 177, 112, 222, 138, 30, 73, 42, 46,
-			238, 161, 135, 157, 78, 239, 137, 23, 36, 133, 45, 252, 104, 209,
-			228, 20, 149, 131, 16, 73, 255, 154, 136, 186, 182, 206, 222, 19,
-			92, 220, 205, 80, 72, 239, 253, 247, 68, 208, 166, 31, 248, 62,
-			128, 54, 48, 11, 254, 89, 36, 154, 1, 252, 166, 209, 118, 234,
-			174, 184, 41, 9, 102, 198, 127, 108, 70, 112, 195, 76, 171, 102,
-			65, 178, 227, 91, 142, 223, 115, 176, 57, 27, 51, 66, 7, 155,
-			179, 11, 143, 67, 7, 155, 179, 185, 239, 168, 238, 2, 43, 193,
-			24, 90, 198, 186, 203, 7, 182, 140, 56, 138, 10, 238, 245, 146,
-			254, 3, 39, 4, 230, 62, 116, 56, 101, 196, 239, 80, 210, 77,
-			19, 137, 247, 192, 179, 120, 201, 155, 62, 248, 194, 58, 50, 46,
-			99, 207, 62, 117, 196, 237, 119, 154, 141, 251, 222, 251, 149, 78,
-			1, 49, 22, 52, 227, 94, 8, 98, 44, 244, 64, 12, 117, 7,
-			92, 12, 13, 239, 128, 31, 42, 136, 177, 8, 17, 41, 47, 63,
-			12, 8, 93, 79, 237, 13, 77, 253, 211, 134, 3, 76, 41, 85,
-			81, 161, 116, 24, 116, 4, 151, 194, 5, 232, 88, 236, 1, 29,
-			139, 137, 240, 135, 94, 139, 16, 105, 94, 41, 208, 177, 164, 77,
-			37, 191, 166, 26, 10, 100, 235, 171, 97, 77, 164, 88, 117, 112,
-			18, 58, 82, 6, 255, 224, 188, 243, 48, 161, 166, 10, 181, 23,
-			134, 34, 75, 218, 162, 30, 130, 34, 75, 50, 149, 151, 103, 190,
-			178, 54, 42, 79, 121, 39, 38, 89, 69, 28, 151, 221, 6, 55,
-			102, 188, 184, 98, 182, 175, 168, 69, 124, 100, 174, 113, 220, 183,
-			227, 119, 229, 245, 119, 252, 39, 48, 32, 13, 190, 41, 7, 248,
-			158, 117, 195, 7, 102, 73, 237, 246, 138, 58, 20, 139, 225, 123,
-			139, 161, 3, 179, 228, 210, 106, 232, 192, 44, 153, 93, 87, 1,
-			245, 255, 5, 0, 0, 255, 255, 78, 15, 50, 18, 127, 85, 0,
-			0},
+			8, 0, 0, 9, 110, 136, 0, 255, 236, 125, 91, 108, 28, 201,
+			118, 216, 116, 215, 112, 56, 83, 148, 248, 40, 241, 165, 33, 37,
+			149, 90, 15, 14, 181, 195, 225, 67, 143, 189, 146, 246, 69, 141,
+			184, 90, 234, 74, 148, 118, 72, 173, 124, 119, 239, 134, 219, 236,
+			169, 153, 233, 85, 79, 247, 220, 238, 30, 82, 179, 186, 107, 199,
+			143, 56, 136, 3, 216, 48, 224, 4, 200, 27, 201, 159, 29, 32,
+			129, 97, 4, 134, 17, 35, 48, 28, 3, 9, 144, 199, 71, 128,
+			56, 249, 203, 143, 243, 227, 191, 4, 8, 16, 32, 128, 29, 156,
+			83, 85, 61, 61, 228, 72, 218, 107, 56, 31, 49, 44, 236, 189,
+			152, 211, 93, 125, 78, 213, 169, 83, 167, 206, 171, 138, 244, 103,
+			41, 93, 104, 6, 65, 211, 19, 171, 157, 48, 136, 131, 131, 110,
+			99, 85, 180, 59, 113, 175, 130, 32, 155, 144, 47, 43, 250, 165,
+			53, 74, 71, 182, 224, 253, 189, 111, 233, 25, 39, 104, 87, 142,
+			189, 191, 71, 241, 237, 83, 0, 159, 26, 159, 235, 215, 205, 192,
+			179, 253, 102, 37, 8, 155, 125, 50, 113, 175, 35, 162, 213, 23,
+			126, 112, 228, 75, 146, 157, 131, 255, 109, 24, 255, 196, 36, 15,
+			158, 222, 251, 13, 243, 252, 3, 249, 229, 83, 213, 188, 242, 92,
+			120, 222, 247, 161, 241, 30, 124, 247, 240, 247, 242, 52, 199, 178,
+			227, 25, 107, 146, 254, 199, 44, 53, 78, 49, 50, 158, 97, 27,
+			255, 58, 203, 171, 65, 167, 23, 186, 205, 86, 204, 55, 214, 54,
+			214, 86, 54, 214, 54, 110, 240, 123, 221, 6, 223, 19, 78, 203,
+			15, 188, 160, 233, 138, 168, 204, 183, 125, 167, 66, 41, 127, 228,
+			58, 194, 143, 68, 157, 119, 253, 186, 8, 121, 220, 18, 124, 179,
+			99, 59, 45, 161, 223, 148, 249, 103, 34, 140, 220, 192, 231, 27,
+			149, 53, 94, 130, 6, 150, 122, 101, 45, 223, 165, 188, 23, 116,
+			121, 219, 238, 113, 63, 136, 121, 55, 18, 60, 110, 185, 17, 111,
+			184, 158, 224, 226, 165, 35, 58, 49, 119, 125, 238, 4, 237, 142,
+			231, 218, 190, 35, 248, 145, 27, 183, 120, 220, 71, 95, 161, 252,
+			7, 10, 67, 112, 16, 219, 174, 207, 109, 238, 4, 157, 30, 15,
+			26, 233, 102, 220, 142, 41, 229, 248, 175, 21, 199, 157, 59, 171,
+			171, 71, 71, 71, 21, 27, 123, 138, 76, 245, 100, 187, 104, 245,
+			209, 118, 117, 107, 103, 119, 107, 101, 163, 178, 70, 41, 127, 230,
+			123, 34, 138, 120, 40, 126, 212, 117, 67, 81, 231, 7, 61, 110,
+			119, 58, 158, 235, 216, 7, 158, 224, 158, 125, 196, 131, 144, 219,
+			205, 80, 136, 58, 143, 3, 232, 235, 81, 232, 198, 174, 223, 44,
+			243, 40, 104, 196, 71, 118, 40, 40, 175, 187, 81, 28, 186, 7,
+			221, 120, 128, 77, 186, 103, 110, 52, 208, 32, 240, 185, 237, 115,
+			107, 115, 151, 111, 239, 90, 252, 222, 230, 238, 246, 110, 153, 242,
+			231, 219, 123, 159, 60, 121, 182, 199, 159, 111, 214, 106, 155, 59,
+			123, 219, 91, 187, 252, 73, 141, 87, 159, 236, 220, 223, 222, 219,
+			126, 178, 179, 203, 159, 124, 204, 55, 119, 126, 192, 191, 191, 189,
+			115, 191, 204, 133, 27, 183, 68, 200, 197, 203, 78, 8, 189, 15,
+			66, 238, 2, 3, 69, 189, 66, 249, 174, 16, 3, 228, 27, 129,
+			156, 181, 168, 35, 28, 183, 225, 58, 28, 228, 172, 107, 55, 5,
+			111, 6, 135, 34, 244, 93, 191, 201, 59, 34, 108, 187, 17, 76,
+			98, 196, 109, 191, 78, 185, 231, 182, 221, 216, 142, 241, 193, 137,
+			17, 85, 40, 205, 83, 195, 100, 100, 50, 51, 15, 191, 242, 140,
+			176, 204, 22, 45, 80, 51, 63, 38, 127, 202, 135, 103, 50, 101,
+			124, 104, 200, 159, 242, 225, 116, 230, 29, 124, 168, 126, 202, 135,
+			51, 25, 11, 31, 82, 249, 83, 62, 156, 205, 92, 196, 135, 151,
+			229, 79, 249, 112, 46, 115, 23, 31, 94, 145, 63, 229, 195, 249,
+			204, 5, 124, 120, 65, 254, 252, 151, 38, 53, 179, 25, 70, 172,
+			204, 100, 241, 159, 155, 124, 147, 55, 133, 47, 66, 215, 225, 184,
+			134, 120, 91, 68, 17, 12, 63, 110, 217, 49, 74, 167, 99, 251,
+			60, 20, 43, 40, 156, 1, 183, 15, 3, 183, 206, 235, 162, 225,
+			34, 107, 234, 93, 148, 134, 88, 212, 233, 224, 247, 17, 8, 67,
+			47, 232, 134, 124, 243, 233, 118, 84, 225, 155, 60, 238, 117, 92,
+			199, 246, 184, 120, 105, 183, 59, 30, 78, 124, 28, 160, 204, 187,
+			49, 183, 35, 156, 5, 16, 52, 17, 197, 148, 171, 89, 9, 69,
+			212, 9, 96, 154, 96, 173, 131, 76, 219, 62, 224, 227, 109, 17,
+			183, 130, 122, 133, 127, 12, 115, 235, 71, 49, 172, 141, 59, 74,
+			194, 35, 17, 30, 186, 142, 224, 31, 7, 1, 127, 165, 132, 158,
+			135, 29, 135, 223, 179, 195, 210, 49, 109, 83, 65, 101, 179, 204,
+			67, 17, 119, 67, 63, 226, 175, 121, 127, 87, 162, 249, 150, 194,
+			63, 146, 205, 24, 140, 88, 249, 211, 7, 57, 108, 118, 157, 254,
+			206, 50, 189, 112, 92, 7, 198, 110, 91, 68, 177, 221, 238, 188,
+			78, 15, 222, 165, 133, 61, 221, 134, 205, 211, 209, 72, 56, 129,
+			95, 143, 230, 13, 110, 148, 72, 77, 131, 108, 154, 142, 248, 182,
+			31, 68, 243, 38, 55, 74, 35, 53, 9, 220, 251, 107, 198, 112,
+			229, 57, 158, 160, 212, 10, 116, 227, 59, 42, 208, 164, 191, 63,
+			145, 18, 253, 237, 37, 169, 68, 127, 198, 248, 75, 37, 250, 151,
+			74, 244, 255, 181, 18, 77, 212, 24, 252, 212, 74, 116, 91, 107,
+			86, 248, 169, 149, 104, 162, 89, 167, 19, 205, 58, 147, 89, 213,
+			154, 21, 126, 106, 37, 154, 104, 214, 217, 68, 179, 206, 245, 53,
+			235, 92, 162, 89, 231, 251, 154, 21, 126, 254, 215, 115, 168, 68,
+			179, 113, 230, 103, 140, 226, 191, 59, 199, 55, 121, 178, 242, 120,
+			40, 128, 101, 194, 143, 35, 110, 243, 78, 224, 250, 40, 127, 176,
+			192, 184, 235, 215, 69, 71, 248, 117, 225, 199, 32, 92, 182, 223,
+			147, 207, 191, 9, 124, 193, 131, 144, 123, 129, 99, 123, 148, 59,
+			182, 39, 252, 186, 29, 150, 185, 240, 157, 160, 46, 234, 160, 31,
+			65, 38, 187, 242, 59, 165, 28, 128, 143, 188, 17, 218, 142, 100,
+			98, 250, 69, 76, 57, 106, 10, 132, 121, 40, 162, 192, 235, 66,
+			171, 10, 223, 107, 9, 133, 200, 5, 153, 244, 236, 216, 61, 148,
+			154, 221, 231, 162, 19, 56, 45, 110, 199, 252, 217, 94, 149, 183,
+			221, 186, 143, 43, 56, 240, 41, 127, 104, 251, 93, 59, 236, 241,
+			245, 50, 95, 191, 253, 238, 90, 25, 71, 212, 18, 188, 19, 6,
+			158, 232, 196, 174, 195, 31, 132, 162, 25, 132, 174, 237, 39, 189,
+			231, 71, 45, 215, 105, 113, 241, 50, 22, 208, 217, 184, 37, 232,
+			176, 86, 7, 182, 243, 226, 200, 14, 161, 69, 192, 123, 194, 14,
+			121, 224, 195, 252, 243, 77, 207, 227, 109, 215, 239, 198, 34, 226,
+			118, 40, 248, 173, 181, 100, 124, 94, 224, 55, 43, 252, 145, 176,
+			59, 253, 33, 135, 130, 91, 81, 91, 216, 161, 168, 91, 60, 10,
+			228, 6, 230, 7, 220, 19, 118, 135, 170, 102, 60, 198, 53, 231,
+			70, 220, 23, 2, 248, 10, 219, 191, 235, 199, 34, 236, 132, 66,
+			10, 99, 153, 119, 35, 216, 217, 108, 254, 197, 198, 141, 149, 22,
+			236, 96, 158, 235, 11, 59, 164, 28, 177, 127, 89, 130, 197, 31,
+			221, 89, 93, 173, 139, 67, 225, 5, 29, 17, 70, 90, 15, 59,
+			65, 123, 21, 230, 115, 21, 91, 46, 195, 32, 128, 221, 161, 237,
+			55, 113, 141, 54, 194, 160, 205, 215, 214, 214, 214, 87, 240, 191,
+			189, 181, 181, 59, 248, 223, 231, 48, 244, 219, 183, 111, 223, 94,
+			89, 223, 88, 185, 190, 190, 183, 113, 253, 206, 205, 219, 119, 110,
+			222, 174, 220, 214, 255, 62, 175, 240, 123, 61, 10, 19, 25, 135,
+			174, 3, 202, 1, 62, 193, 33, 34, 246, 50, 63, 18, 92, 248,
+			81, 55, 84, 59, 247, 145, 192, 141, 219, 9, 252, 67, 17, 198,
+			208, 88, 10, 75, 208, 230, 95, 212, 62, 174, 82, 126, 253, 250,
+			245, 219, 253, 177, 128, 57, 232, 138, 184, 129, 198, 96, 216, 112,
+			86, 195, 134, 3, 45, 42, 241, 203, 120, 153, 215, 237, 88, 112,
+			208, 63, 126, 51, 130, 65, 93, 226, 91, 114, 19, 143, 40, 213,
+			63, 249, 250, 29, 94, 13, 218, 157, 110, 44, 82, 107, 1, 9,
+			62, 125, 178, 187, 253, 83, 252, 43, 224, 76, 105, 249, 171, 138,
+			82, 162, 253, 70, 201, 222, 163, 246, 217, 4, 174, 68, 34, 222,
+			87, 19, 92, 130, 167, 165, 157, 103, 143, 30, 45, 47, 15, 109,
+			135, 242, 94, 90, 91, 190, 155, 234, 211, 198, 219, 250, 212, 20,
+			49, 96, 9, 26, 117, 187, 151, 234, 91, 20, 135, 93, 39, 198,
+			181, 121, 104, 123, 60, 62, 84, 20, 7, 154, 95, 141, 15, 203,
+			28, 59, 116, 247, 207, 58, 164, 195, 74, 124, 8, 3, 124, 211,
+			136, 100, 163, 110, 36, 28, 126, 141, 175, 175, 173, 13, 142, 240,
+			250, 107, 71, 248, 220, 245, 175, 111, 240, 175, 30, 136, 120, 183,
+			23, 197, 162, 13, 175, 55, 163, 143, 93, 79, 236, 13, 78, 196,
+			199, 219, 143, 182, 246, 182, 31, 111, 241, 70, 172, 186, 241, 186,
+			111, 174, 54, 98, 221, 211, 103, 219, 59, 123, 183, 110, 240, 216,
+			117, 94, 68, 252, 125, 94, 42, 149, 228, 147, 229, 70, 92, 169,
+			31, 125, 226, 54, 91, 247, 237, 24, 191, 90, 230, 239, 189, 199,
+			175, 111, 44, 243, 31, 115, 124, 247, 40, 56, 210, 175, 52, 223,
+			86, 87, 249, 38, 127, 238, 250, 245, 224, 40, 66, 148, 176, 88,
+			214, 215, 214, 82, 58, 44, 170, 36, 13, 164, 150, 90, 191, 117,
+			114, 25, 37, 216, 224, 243, 245, 91, 55, 110, 220, 120, 247, 250,
+			173, 181, 190, 218, 56, 16, 141, 32, 20, 252, 153, 239, 190, 84,
+			186, 14, 148, 217, 113, 44, 149, 63, 219, 100, 150, 228, 248, 121,
+			169, 4, 35, 136, 248, 42, 78, 22, 252, 183, 204, 87, 210, 221,
+			121, 139, 4, 3, 158, 235, 27, 125, 60, 87, 82, 120, 80, 0,
+			150, 7, 4, 224, 198, 107, 5, 224, 161, 125, 104, 243, 175, 228,
+			228, 87, 156, 110, 24, 10, 63, 134, 38, 143, 93, 207, 115, 163,
+			148, 0, 128, 54, 229, 109, 124, 202, 223, 231, 175, 255, 224, 13,
+			98, 206, 223, 239, 63, 173, 248, 226, 232, 94, 215, 245, 234, 34,
+			44, 45, 195, 192, 118, 21, 135, 20, 9, 201, 152, 101, 109, 154,
+			115, 14, 109, 118, 80, 214, 75, 174, 31, 195, 200, 85, 75, 57,
+			116, 53, 108, 96, 193, 242, 114, 229, 0, 48, 151, 6, 88, 112,
+			243, 45, 44, 216, 70, 15, 33, 174, 248, 193, 81, 106, 212, 234,
+			41, 247, 131, 35, 254, 62, 31, 104, 243, 198, 129, 246, 251, 253,
+			246, 17, 251, 193, 81, 165, 41, 226, 45, 144, 53, 249, 172, 180,
+			156, 26, 248, 224, 224, 85, 99, 0, 74, 195, 7, 122, 235, 181,
+			3, 85, 179, 165, 173, 12, 254, 180, 23, 183, 2, 95, 15, 117,
+			232, 52, 149, 150, 143, 189, 172, 60, 16, 113, 181, 63, 235, 165,
+			101, 212, 244, 15, 119, 159, 236, 240, 199, 118, 167, 227, 250, 77,
+			74, 249, 182, 47, 159, 52, 130, 176, 109, 199, 101, 52, 251, 250,
+			125, 65, 55, 205, 141, 6, 205, 22, 185, 113, 40, 139, 129, 226,
+			246, 243, 19, 237, 62, 146, 20, 88, 46, 118, 204, 221, 8, 105,
+			82, 176, 57, 219, 8, 115, 235, 21, 88, 13, 223, 174, 188, 106,
+			7, 126, 220, 250, 118, 229, 85, 221, 238, 125, 187, 247, 10, 182,
+			238, 111, 239, 188, 106, 187, 254, 183, 119, 94, 69, 194, 249, 246,
+			139, 202, 43, 48, 150, 64, 223, 126, 251, 229, 231, 22, 229, 71,
+			45, 17, 10, 46, 191, 6, 68, 182, 119, 100, 247, 34, 109, 242,
+			130, 63, 130, 150, 64, 3, 108, 128, 186, 219, 116, 227, 8, 76,
+			26, 79, 112, 69, 169, 204, 145, 84, 153, 114, 73, 172, 204, 145,
+			90, 25, 237, 50, 36, 137, 86, 201, 55, 34, 12, 86, 58, 118,
+			29, 236, 13, 216, 180, 143, 2, 141, 77, 216, 78, 11, 198, 37,
+			18, 43, 14, 172, 63, 165, 80, 202, 202, 126, 114, 108, 159, 55,
+			3, 222, 237, 192, 38, 126, 91, 127, 90, 114, 43, 162, 162, 30,
+			174, 15, 183, 245, 150, 203, 20, 233, 7, 29, 128, 108, 79, 82,
+			178, 62, 183, 120, 212, 109, 52, 220, 151, 96, 141, 186, 142, 13,
+			230, 21, 204, 34, 8, 9, 218, 161, 37, 235, 217, 94, 213, 90,
+			190, 59, 240, 148, 114, 183, 239, 194, 128, 59, 143, 94, 228, 117,
+			41, 12, 145, 8, 93, 219, 115, 191, 17, 33, 143, 90, 65, 215,
+			171, 107, 86, 118, 35, 129, 182, 100, 201, 142, 18, 106, 16, 69,
+			162, 220, 250, 220, 90, 134, 9, 240, 121, 39, 116, 125, 105, 208,
+			156, 20, 37, 96, 164, 61, 64, 170, 99, 135, 81, 159, 204, 129,
+			160, 28, 45, 58, 176, 111, 28, 140, 151, 29, 4, 113, 11, 105,
+			194, 183, 1, 6, 130, 244, 24, 162, 19, 253, 0, 163, 55, 104,
+			52, 34, 17, 163, 177, 6, 225, 4, 21, 158, 40, 115, 107, 99,
+			109, 253, 221, 149, 181, 245, 149, 245, 155, 123, 107, 235, 119, 174,
+			175, 221, 89, 191, 89, 89, 91, 255, 220, 82, 210, 29, 113, 132,
+			147, 205, 165, 99, 67, 224, 2, 91, 34, 253, 192, 239, 91, 205,
+			55, 203, 28, 176, 85, 212, 2, 178, 15, 237, 93, 39, 116, 59,
+			113, 25, 108, 221, 1, 67, 205, 230, 176, 57, 242, 224, 224, 107,
+			1, 6, 72, 160, 124, 89, 41, 236, 210, 50, 69, 241, 7, 109,
+			85, 183, 195, 58, 229, 95, 196, 193, 246, 238, 147, 93, 92, 100,
+			165, 229, 33, 230, 105, 165, 29, 124, 227, 122, 158, 141, 182, 157,
+			240, 87, 158, 237, 174, 214, 3, 39, 90, 125, 46, 14, 86, 251,
+			93, 89, 173, 137, 134, 8, 133, 239, 136, 213, 7, 94, 112, 96,
+			123, 251, 79, 176, 15, 209, 42, 116, 104, 53, 69, 100, 153, 38,
+			241, 151, 109, 173, 105, 202, 184, 206, 101, 151, 248, 87, 96, 47,
+			2, 211, 43, 250, 199, 87, 122, 64, 48, 212, 3, 161, 71, 11,
+			81, 163, 97, 67, 164, 252, 139, 175, 162, 56, 108, 224, 167, 169,
+			17, 5, 78, 84, 233, 72, 205, 6, 99, 217, 88, 245, 220, 131,
+			208, 14, 123, 104, 116, 87, 90, 113, 219, 187, 132, 191, 244, 183,
+			203, 24, 47, 165, 137, 32, 107, 34, 16, 236, 227, 75, 87, 126,
+			176, 114, 165, 189, 114, 165, 190, 119, 229, 147, 59, 87, 30, 223,
+			185, 178, 91, 185, 210, 248, 124, 169, 194, 31, 185, 47, 196, 145,
+			11, 161, 91, 23, 166, 240, 208, 238, 207, 82, 55, 18, 18, 219,
+			195, 160, 110, 163, 176, 46, 69, 252, 139, 175, 182, 119, 159, 104,
+			147, 230, 99, 164, 128, 3, 87, 102, 214, 151, 37, 170, 227, 5,
+			95, 7, 117, 123, 5, 58, 86, 137, 130, 110, 232, 128, 53, 210,
+			20, 21, 95, 196, 171, 118, 199, 197, 57, 129, 97, 65, 43, 28,
+			209, 170, 236, 238, 234, 73, 244, 56, 212, 62, 13, 202, 151, 65,
+			84, 146, 224, 133, 252, 46, 22, 33, 119, 236, 14, 174, 143, 160,
+			33, 195, 124, 182, 92, 105, 122, 149, 193, 106, 72, 179, 191, 146,
+			138, 112, 197, 249, 41, 250, 247, 13, 154, 205, 102, 204, 12, 35,
+			47, 205, 233, 226, 47, 27, 188, 214, 247, 109, 181, 220, 7, 13,
+			20, 119, 232, 48, 143, 92, 223, 73, 219, 87, 116, 184, 129, 197,
+			31, 119, 163, 152, 31, 136, 55, 58, 68, 116, 152, 71, 244, 57,
+			119, 125, 199, 235, 70, 238, 33, 184, 136, 167, 232, 8, 244, 110,
+			4, 186, 55, 170, 33, 131, 145, 151, 249, 9, 13, 17, 70, 94,
+			178, 51, 244, 143, 228, 64, 12, 70, 126, 218, 100, 197, 63, 52,
+			248, 78, 224, 175, 248, 162, 41, 189, 95, 173, 125, 113, 48, 182,
+			26, 25, 248, 193, 67, 245, 106, 133, 239, 168, 15, 19, 183, 242,
+			208, 246, 186, 34, 66, 105, 75, 33, 107, 195, 40, 163, 216, 245,
+			60, 222, 178, 15, 5, 247, 211, 52, 17, 181, 250, 16, 100, 202,
+			142, 149, 91, 222, 8, 66, 112, 135, 117, 204, 224, 56, 179, 148,
+			171, 88, 86, 255, 163, 67, 24, 98, 140, 192, 48, 53, 67, 12,
+			24, 116, 254, 180, 134, 8, 35, 63, 61, 57, 149, 196, 46, 127,
+			243, 67, 122, 202, 9, 252, 134, 219, 84, 129, 202, 169, 56, 120,
+			1, 65, 157, 240, 80, 132, 21, 187, 222, 118, 125, 235, 23, 77,
+			58, 181, 7, 79, 119, 241, 105, 21, 155, 179, 3, 58, 227, 136,
+			48, 134, 160, 185, 29, 139, 125, 187, 27, 183, 130, 208, 141, 123,
+			243, 6, 39, 165, 177, 141, 149, 202, 9, 68, 149, 106, 191, 253,
+			166, 110, 46, 177, 213, 166, 157, 33, 239, 216, 67, 58, 81, 23,
+			30, 178, 44, 240, 247, 195, 174, 39, 230, 77, 196, 126, 113, 8,
+			246, 251, 73, 203, 90, 215, 19, 181, 241, 254, 151, 0, 179, 77,
+			74, 191, 62, 138, 247, 221, 40, 234, 138, 112, 158, 32, 26, 107,
+			8, 154, 135, 207, 247, 182, 177, 141, 234, 89, 225, 235, 163, 88,
+			62, 176, 126, 215, 164, 197, 215, 143, 129, 45, 208, 66, 215, 119,
+			127, 212, 21, 251, 110, 125, 62, 135, 113, 220, 188, 124, 176, 93,
+			103, 227, 212, 116, 124, 140, 238, 22, 106, 166, 227, 67, 99, 24,
+			242, 126, 199, 142, 91, 24, 220, 45, 212, 242, 240, 224, 169, 29,
+			183, 216, 28, 29, 117, 66, 111, 191, 27, 122, 243, 4, 95, 229,
+			156, 208, 123, 22, 122, 240, 85, 55, 18, 251, 1, 112, 123, 62,
+			203, 141, 82, 190, 150, 239, 70, 226, 9, 192, 204, 162, 167, 235,
+			194, 139, 237, 125, 253, 237, 40, 126, 59, 134, 15, 171, 18, 193,
+			89, 154, 71, 4, 78, 212, 153, 207, 227, 247, 163, 240, 189, 19,
+			117, 216, 89, 154, 15, 156, 168, 131, 84, 11, 248, 229, 40, 192,
+			240, 213, 125, 122, 26, 243, 108, 251, 245, 160, 109, 187, 126, 52,
+			63, 130, 236, 187, 48, 108, 22, 176, 133, 226, 221, 41, 252, 74,
+			62, 138, 172, 127, 107, 208, 83, 233, 215, 108, 150, 230, 36, 66,
+			148, 153, 66, 77, 65, 172, 76, 153, 227, 5, 221, 250, 126, 39,
+			12, 96, 15, 218, 247, 237, 182, 80, 76, 154, 196, 55, 79, 229,
+			139, 29, 187, 45, 216, 26, 157, 182, 61, 47, 56, 18, 117, 201,
+			151, 141, 253, 200, 9, 58, 2, 167, 184, 80, 99, 234, 29, 178,
+			104, 99, 23, 222, 176, 27, 116, 182, 109, 59, 45, 215, 23, 251,
+			56, 128, 125, 207, 109, 8, 88, 108, 243, 35, 56, 107, 211, 234,
+			45, 10, 253, 35, 245, 238, 97, 54, 159, 157, 28, 121, 152, 205,
+			231, 38, 71, 173, 63, 50, 232, 196, 49, 65, 25, 156, 126, 227,
+			216, 244, 207, 210, 156, 146, 60, 57, 12, 5, 193, 71, 95, 31,
+			189, 136, 164, 24, 200, 185, 206, 195, 3, 20, 131, 34, 205, 219,
+			221, 186, 11, 155, 242, 124, 22, 71, 147, 192, 236, 28, 165, 141,
+			31, 213, 253, 125, 199, 179, 221, 54, 246, 187, 80, 43, 192, 147,
+			42, 60, 56, 57, 99, 185, 63, 203, 140, 253, 23, 131, 142, 15,
+			46, 43, 198, 104, 22, 103, 195, 192, 217, 192, 223, 108, 145, 22,
+			84, 230, 38, 8, 113, 129, 22, 106, 253, 7, 192, 109, 61, 63,
+			113, 176, 239, 182, 33, 98, 22, 248, 118, 172, 103, 72, 207, 222,
+			94, 176, 221, 127, 199, 150, 233, 164, 254, 234, 24, 15, 38, 212,
+			243, 77, 205, 138, 13, 58, 211, 182, 95, 238, 31, 218, 158, 91,
+			119, 227, 222, 126, 189, 27, 162, 26, 80, 179, 121, 166, 109, 191,
+			252, 76, 189, 187, 175, 94, 61, 252, 205, 155, 116, 148, 141, 100,
+			51, 127, 207, 48, 232, 191, 48, 48, 255, 155, 205, 176, 141, 223,
+			48, 6, 82, 23, 235, 183, 208, 102, 126, 244, 172, 186, 205, 165,
+			198, 130, 84, 150, 231, 113, 76, 16, 131, 65, 140, 156, 132, 196,
+			226, 179, 72, 192, 238, 129, 155, 169, 220, 221, 57, 132, 110, 193,
+			108, 150, 153, 196, 239, 154, 223, 208, 155, 129, 180, 148, 26, 65,
+			215, 175, 235, 104, 171, 202, 43, 96, 126, 56, 137, 144, 231, 50,
+			69, 250, 72, 70, 163, 11, 153, 89, 163, 248, 17, 63, 161, 171,
+			161, 19, 161, 176, 85, 252, 47, 45, 1, 78, 163, 9, 200, 189,
+			174, 227, 174, 168, 109, 160, 111, 1, 20, 242, 103, 233, 101, 109,
+			0, 140, 153, 31, 21, 231, 248, 35, 55, 194, 8, 116, 117, 51,
+			226, 71, 130, 199, 97, 55, 138, 251, 27, 113, 22, 154, 37, 80,
+			142, 145, 177, 177, 43, 26, 50, 24, 25, 187, 122, 87, 67, 132,
+			145, 177, 15, 62, 164, 95, 235, 93, 121, 194, 172, 20, 191, 228,
+			32, 96, 224, 140, 216, 177, 204, 48, 10, 126, 212, 10, 144, 19,
+			109, 136, 162, 247, 21, 186, 26, 3, 24, 102, 252, 64, 180, 108,
+			175, 1, 157, 58, 106, 5, 109, 240, 189, 40, 216, 121, 252, 8,
+			130, 163, 42, 25, 24, 245, 247, 198, 44, 16, 75, 160, 28, 35,
+			19, 99, 103, 53, 100, 48, 50, 81, 92, 214, 16, 97, 100, 162,
+			188, 66, 255, 189, 52, 29, 76, 70, 102, 204, 107, 197, 127, 101,
+			36, 60, 144, 43, 24, 109, 134, 135, 207, 247, 184, 11, 81, 125,
+			55, 238, 169, 190, 165, 217, 195, 31, 75, 125, 162, 199, 22, 248,
+			75, 177, 52, 9, 156, 254, 110, 18, 241, 200, 109, 250, 50, 63,
+			20, 5, 109, 245, 181, 168, 243, 234, 102, 223, 246, 140, 186, 78,
+			139, 63, 124, 190, 135, 193, 242, 166, 136, 185, 210, 84, 154, 104,
+			41, 18, 130, 63, 118, 253, 88, 81, 68, 81, 184, 215, 131, 254,
+			213, 158, 86, 151, 19, 54, 152, 89, 24, 78, 2, 229, 24, 153,
+			25, 43, 106, 200, 96, 100, 102, 65, 79, 156, 73, 24, 153, 41,
+			45, 211, 191, 57, 66, 205, 172, 193, 178, 151, 50, 31, 25, 197,
+			63, 201, 242, 215, 239, 131, 106, 238, 208, 245, 119, 253, 166, 39,
+			96, 4, 105, 110, 236, 194, 32, 170, 155, 146, 129, 209, 32, 15,
+			96, 230, 124, 244, 174, 144, 85, 224, 64, 194, 10, 196, 113, 112,
+			176, 63, 92, 71, 84, 248, 150, 237, 180, 176, 25, 229, 45, 76,
+			141, 116, 66, 247, 16, 252, 166, 23, 162, 7, 18, 144, 198, 41,
+			173, 178, 106, 208, 110, 7, 62, 135, 13, 131, 71, 66, 121, 86,
+			130, 127, 252, 233, 253, 29, 189, 108, 41, 98, 44, 115, 81, 105,
+			86, 184, 85, 221, 121, 63, 242, 236, 67, 113, 227, 250, 138, 179,
+			94, 113, 42, 78, 43, 12, 218, 2, 74, 49, 186, 177, 72, 5,
+			252, 43, 152, 62, 240, 109, 207, 210, 225, 254, 126, 95, 69, 8,
+			179, 6, 179, 238, 70, 188, 186, 3, 68, 235, 34, 4, 235, 207,
+			246, 251, 242, 162, 226, 35, 48, 114, 91, 207, 103, 133, 111, 199,
+			148, 71, 29, 15, 124, 125, 236, 164, 235, 199, 1, 183, 121, 43,
+			136, 98, 208, 185, 188, 100, 245, 187, 103, 45, 43, 79, 89, 42,
+			123, 14, 13, 40, 47, 89, 223, 165, 215, 203, 101, 30, 9, 59,
+			116, 90, 138, 249, 3, 72, 184, 235, 83, 110, 13, 108, 36, 22,
+			176, 15, 67, 28, 101, 238, 54, 184, 139, 33, 24, 229, 20, 148,
+			229, 112, 59, 118, 104, 183, 69, 12, 107, 163, 46, 34, 39, 116,
+			15, 32, 242, 1, 193, 22, 138, 36, 82, 158, 136, 45, 5, 87,
+			206, 145, 26, 251, 190, 91, 231, 239, 233, 113, 126, 240, 209, 123,
+			216, 98, 5, 86, 178, 8, 87, 186, 161, 247, 1, 122, 41, 36,
+			11, 134, 237, 165, 188, 69, 239, 210, 108, 214, 0, 21, 117, 217,
+			156, 179, 42, 124, 251, 126, 162, 135, 171, 155, 101, 126, 4, 230,
+			247, 129, 224, 162, 125, 32, 48, 4, 131, 140, 68, 156, 90, 43,
+			24, 232, 66, 92, 86, 22, 179, 129, 186, 234, 114, 129, 105, 136,
+			48, 114, 121, 102, 150, 126, 132, 100, 12, 70, 174, 152, 147, 214,
+			117, 144, 233, 148, 76, 149, 165, 177, 223, 182, 99, 167, 197, 119,
+			187, 210, 217, 173, 238, 104, 197, 13, 226, 168, 105, 129, 117, 126,
+			197, 204, 107, 8, 16, 22, 198, 52, 68, 24, 185, 50, 62, 65,
+			63, 68, 90, 38, 35, 87, 205, 57, 107, 131, 131, 113, 160, 37,
+			54, 12, 130, 120, 64, 188, 97, 51, 56, 166, 195, 53, 41, 115,
+			4, 48, 104, 82, 160, 103, 175, 38, 195, 130, 117, 125, 117, 102,
+			150, 94, 69, 82, 132, 145, 37, 115, 198, 58, 171, 98, 98, 113,
+			192, 27, 2, 134, 82, 173, 61, 194, 77, 67, 99, 36, 35, 208,
+			80, 99, 36, 6, 35, 75, 133, 73, 13, 1, 146, 51, 211, 244,
+			30, 98, 204, 50, 82, 50, 231, 172, 155, 176, 236, 49, 191, 24,
+			9, 191, 174, 54, 81, 247, 27, 220, 132, 121, 75, 216, 144, 247,
+			197, 48, 16, 210, 115, 253, 38, 175, 214, 30, 105, 106, 217, 17,
+			64, 146, 211, 144, 193, 72, 41, 233, 127, 150, 48, 82, 154, 153,
+			165, 255, 200, 68, 114, 35, 140, 172, 154, 11, 197, 95, 53, 249,
+			253, 190, 181, 11, 162, 121, 108, 64, 104, 11, 3, 141, 8, 199,
+			133, 50, 12, 108, 173, 110, 242, 78, 247, 192, 115, 35, 88, 7,
+			113, 75, 180, 97, 53, 223, 239, 55, 246, 80, 233, 251, 94, 143,
+			59, 45, 72, 184, 69, 202, 215, 133, 111, 15, 236, 72, 0, 74,
+			94, 194, 65, 8, 181, 207, 42, 43, 124, 185, 130, 106, 161, 135,
+			177, 56, 76, 252, 203, 12, 125, 28, 116, 116, 129, 129, 219, 238,
+			4, 33, 70, 164, 20, 38, 200, 252, 15, 162, 134, 29, 0, 162,
+			93, 161, 208, 52, 176, 51, 200, 58, 55, 134, 152, 165, 27, 138,
+			168, 194, 107, 178, 84, 43, 210, 212, 19, 17, 31, 65, 14, 233,
+			153, 27, 49, 24, 89, 45, 204, 106, 136, 48, 178, 122, 182, 72,
+			127, 94, 242, 50, 199, 200, 45, 115, 186, 248, 191, 12, 254, 76,
+			186, 6, 192, 71, 61, 141, 78, 75, 56, 47, 120, 40, 14, 3,
+			71, 78, 98, 20, 219, 113, 23, 119, 193, 148, 84, 70, 252, 208,
+			181, 249, 147, 234, 238, 83, 224, 227, 54, 42, 137, 58, 68, 81,
+			32, 62, 81, 175, 187, 106, 19, 71, 174, 33, 198, 8, 38, 66,
+			245, 25, 26, 163, 146, 81, 213, 61, 96, 185, 72, 78, 181, 169,
+			12, 233, 65, 120, 166, 130, 232, 147, 18, 161, 8, 249, 235, 64,
+			69, 27, 24, 90, 177, 235, 193, 7, 110, 200, 125, 241, 50, 222,
+			239, 118, 32, 90, 130, 129, 143, 50, 63, 232, 198, 220, 15, 40,
+			230, 61, 208, 30, 131, 40, 160, 47, 56, 4, 113, 19, 126, 229,
+			70, 128, 11, 90, 246, 114, 6, 35, 183, 70, 39, 148, 236, 229,
+			8, 35, 183, 216, 25, 250, 219, 6, 202, 222, 40, 35, 239, 153,
+			179, 197, 95, 55, 56, 48, 75, 201, 221, 179, 218, 35, 224, 73,
+			170, 143, 32, 234, 178, 226, 9, 120, 114, 95, 52, 236, 174, 23,
+			167, 202, 160, 250, 5, 79, 208, 82, 213, 82, 136, 196, 248, 75,
+			175, 249, 3, 1, 43, 5, 25, 7, 49, 180, 146, 235, 115, 216,
+			40, 146, 141, 152, 111, 251, 50, 160, 3, 108, 222, 116, 28, 168,
+			224, 192, 60, 60, 24, 153, 218, 10, 48, 204, 209, 17, 232, 185,
+			150, 137, 81, 131, 145, 247, 10, 83, 26, 34, 140, 188, 55, 61,
+			67, 119, 113, 136, 121, 70, 62, 52, 175, 21, 63, 230, 223, 79,
+			185, 7, 137, 118, 31, 80, 249, 106, 52, 13, 136, 165, 194, 54,
+			221, 177, 195, 216, 117, 186, 158, 29, 170, 157, 37, 33, 159, 207,
+			2, 214, 4, 202, 49, 242, 225, 216, 156, 134, 12, 70, 62, 156,
+			191, 162, 33, 194, 200, 135, 165, 101, 250, 3, 106, 102, 77, 150,
+			173, 102, 126, 202, 40, 62, 230, 105, 199, 5, 248, 216, 133, 66,
+			76, 215, 143, 220, 186, 120, 147, 121, 2, 91, 229, 49, 223, 77,
+			237, 40, 160, 33, 171, 249, 105, 250, 14, 252, 46, 48, 114, 223,
+			156, 180, 46, 160, 217, 9, 139, 179, 225, 10, 175, 30, 149, 121,
+			61, 192, 66, 163, 80, 200, 153, 28, 163, 217, 172, 89, 200, 48,
+			114, 127, 236, 20, 118, 215, 44, 100, 140, 1, 200, 148, 144, 108,
+			8, 175, 198, 39, 212, 43, 99, 16, 50, 37, 244, 49, 52, 132,
+			13, 237, 129, 121, 174, 120, 91, 13, 19, 6, 152, 218, 153, 113,
+			193, 193, 38, 169, 172, 11, 71, 141, 13, 245, 11, 88, 135, 138,
+			203, 38, 90, 229, 15, 20, 151, 77, 220, 233, 30, 140, 77, 105,
+			200, 96, 228, 1, 155, 215, 16, 97, 228, 193, 194, 34, 253, 79,
+			32, 214, 104, 6, 238, 152, 188, 248, 7, 6, 175, 30, 115, 185,
+			161, 47, 54, 118, 3, 122, 33, 107, 196, 100, 35, 174, 90, 129,
+			76, 59, 161, 0, 89, 85, 38, 56, 133, 0, 61, 4, 184, 48,
+			230, 88, 194, 185, 2, 25, 121, 2, 211, 179, 161, 236, 215, 229,
+			97, 54, 212, 82, 196, 131, 35, 95, 227, 209, 104, 228, 134, 139,
+			70, 244, 86, 221, 141, 131, 48, 85, 97, 164, 163, 154, 148, 171,
+			0, 66, 194, 12, 216, 124, 119, 148, 196, 155, 184, 249, 238, 20,
+			22, 52, 68, 24, 217, 57, 127, 129, 254, 188, 28, 190, 201, 72,
+			205, 124, 167, 120, 200, 55, 79, 4, 16, 228, 248, 143, 90, 110,
+			44, 60, 229, 12, 168, 97, 96, 228, 65, 229, 83, 112, 8, 17,
+			14, 129, 67, 95, 192, 18, 129, 101, 27, 7, 80, 214, 18, 67,
+			238, 65, 125, 101, 203, 21, 218, 55, 133, 146, 254, 130, 157, 94,
+			75, 38, 15, 246, 243, 90, 50, 121, 48, 65, 53, 118, 85, 67,
+			132, 145, 218, 242, 53, 250, 115, 178, 247, 132, 145, 231, 230, 165,
+			98, 151, 63, 30, 18, 202, 128, 254, 183, 130, 35, 84, 128, 58,
+			46, 44, 234, 199, 125, 9, 207, 61, 148, 49, 112, 21, 233, 133,
+			185, 217, 110, 240, 181, 242, 241, 134, 160, 120, 97, 69, 40, 191,
+			60, 233, 60, 24, 11, 207, 149, 85, 101, 162, 177, 240, 60, 127,
+			94, 67, 208, 193, 139, 22, 253, 53, 66, 205, 44, 97, 217, 131,
+			204, 145, 81, 252, 235, 228, 248, 194, 60, 233, 75, 160, 211, 16,
+			126, 23, 167, 11, 221, 12, 213, 188, 132, 70, 61, 232, 121, 180,
+			116, 227, 208, 6, 137, 9, 26, 92, 116, 90, 162, 45, 66, 219,
+			131, 156, 4, 132, 207, 69, 24, 45, 163, 39, 22, 41, 63, 171,
+			101, 199, 148, 219, 81, 4, 101, 52, 105, 179, 29, 67, 197, 138,
+			19, 21, 254, 4, 118, 227, 218, 238, 198, 205, 91, 248, 177, 29,
+			119, 67, 85, 182, 20, 117, 59, 114, 119, 31, 234, 31, 136, 151,
+			49, 196, 139, 163, 132, 167, 104, 236, 131, 105, 2, 149, 220, 16,
+			202, 209, 250, 31, 134, 11, 238, 29, 229, 253, 184, 143, 180, 251,
+			99, 48, 2, 208, 242, 62, 110, 170, 139, 151, 182, 19, 123, 61,
+			200, 195, 74, 67, 93, 47, 59, 154, 222, 78, 34, 233, 137, 161,
+			231, 105, 243, 234, 166, 210, 134, 48, 97, 7, 249, 57, 250, 203,
+			32, 81, 4, 244, 81, 195, 156, 45, 254, 85, 35, 109, 97, 227,
+			135, 225, 155, 173, 108, 204, 186, 57, 246, 190, 91, 199, 229, 141,
+			225, 108, 16, 23, 199, 179, 163, 150, 180, 252, 147, 184, 153, 174,
+			138, 123, 189, 250, 86, 210, 69, 80, 147, 53, 148, 116, 17, 180,
+			217, 27, 249, 41, 13, 17, 70, 26, 211, 51, 244, 93, 236, 185,
+			193, 136, 107, 78, 23, 175, 113, 41, 89, 192, 7, 241, 178, 35,
+			156, 88, 168, 200, 61, 80, 181, 220, 40, 178, 36, 199, 19, 18,
+			160, 45, 92, 165, 45, 8, 106, 11, 183, 48, 161, 33, 194, 136,
+			203, 206, 208, 16, 73, 152, 140, 180, 205, 185, 162, 224, 15, 85,
+			236, 14, 136, 216, 137, 225, 110, 15, 179, 210, 229, 208, 49, 171,
+			249, 92, 28, 240, 239, 139, 30, 223, 21, 177, 178, 116, 168, 22,
+			244, 18, 108, 236, 104, 154, 58, 252, 133, 232, 69, 122, 247, 38,
+			168, 13, 218, 73, 239, 64, 27, 180, 149, 117, 76, 112, 253, 183,
+			103, 102, 233, 14, 246, 142, 48, 210, 49, 47, 20, 55, 185, 14,
+			153, 201, 222, 105, 221, 5, 234, 167, 147, 48, 3, 119, 22, 203,
+			238, 214, 53, 55, 180, 77, 89, 79, 40, 147, 44, 32, 76, 160,
+			17, 70, 58, 74, 43, 17, 92, 230, 29, 86, 212, 16, 144, 62,
+			119, 158, 214, 176, 31, 89, 70, 34, 115, 190, 184, 197, 63, 214,
+			113, 202, 193, 157, 68, 75, 124, 218, 27, 68, 23, 189, 146, 54,
+			151, 172, 168, 123, 96, 37, 125, 1, 31, 33, 74, 184, 144, 53,
+			24, 137, 10, 103, 52, 68, 24, 137, 102, 231, 208, 134, 33, 192,
+			174, 195, 63, 103, 27, 134, 152, 35, 89, 192, 154, 64, 57, 70,
+			14, 149, 13, 67, 208, 200, 62, 84, 54, 12, 65, 35, 251, 176,
+			180, 76, 127, 63, 75, 205, 108, 150, 229, 126, 193, 128, 224, 99,
+			241, 183, 178, 124, 48, 194, 154, 234, 17, 68, 191, 128, 65, 82,
+			175, 38, 27, 199, 137, 56, 24, 46, 43, 215, 143, 251, 136, 164,
+			142, 81, 49, 216, 52, 14, 233, 238, 132, 98, 9, 248, 14, 9,
+			21, 48, 127, 149, 227, 42, 64, 75, 84, 40, 223, 148, 47, 244,
+			51, 187, 143, 167, 1, 125, 81, 18, 202, 27, 1, 244, 11, 189,
+			120, 112, 25, 195, 174, 184, 3, 21, 37, 215, 112, 3, 119, 108,
+			207, 147, 75, 205, 245, 249, 146, 66, 16, 132, 75, 16, 61, 168,
+			244, 155, 165, 244, 183, 14, 239, 9, 253, 209, 240, 160, 113, 26,
+			3, 84, 136, 42, 212, 162, 174, 13, 4, 169, 117, 211, 8, 116,
+			252, 88, 125, 10, 135, 64, 98, 165, 155, 19, 35, 219, 134, 253,
+			14, 172, 169, 160, 209, 239, 148, 42, 121, 84, 187, 135, 213, 141,
+			68, 120, 199, 254, 72, 85, 8, 64, 24, 197, 90, 46, 83, 110,
+			53, 195, 160, 219, 185, 243, 30, 72, 241, 7, 80, 29, 16, 135,
+			174, 142, 95, 129, 187, 134, 86, 63, 182, 81, 233, 86, 193, 33,
+			23, 1, 85, 149, 54, 184, 142, 101, 168, 252, 181, 33, 218, 35,
+			28, 215, 246, 148, 74, 178, 174, 89, 131, 83, 35, 14, 69, 216,
+			139, 91, 174, 223, 68, 163, 147, 100, 33, 26, 247, 11, 70, 126,
+			22, 197, 59, 107, 102, 88, 246, 23, 13, 147, 21, 183, 120, 223,
+			68, 107, 117, 219, 120, 122, 195, 174, 99, 22, 88, 175, 51, 176,
+			141, 112, 142, 203, 218, 102, 230, 94, 208, 236, 123, 143, 176, 210,
+			79, 211, 145, 108, 22, 148, 44, 96, 205, 107, 208, 0, 34, 133,
+			211, 26, 36, 0, 78, 78, 161, 18, 204, 154, 6, 203, 254, 146,
+			97, 242, 98, 29, 241, 224, 124, 195, 92, 66, 66, 53, 78, 241,
+			21, 152, 115, 92, 164, 245, 78, 129, 140, 62, 105, 49, 6, 13,
+			25, 131, 215, 83, 188, 156, 116, 208, 200, 34, 209, 4, 28, 1,
+			112, 108, 74, 131, 216, 37, 182, 160, 65, 2, 224, 249, 11, 244,
+			247, 97, 75, 203, 154, 38, 203, 254, 138, 97, 174, 20, 127, 203,
+			224, 155, 67, 114, 16, 175, 235, 124, 58, 62, 223, 233, 198, 106,
+			175, 3, 157, 141, 195, 208, 135, 89, 180, 60, 215, 245, 183, 189,
+			10, 56, 212, 220, 170, 109, 125, 250, 108, 107, 119, 239, 73, 205,
+			2, 201, 64, 86, 40, 231, 79, 113, 141, 14, 44, 7, 116, 244,
+			97, 23, 8, 142, 250, 230, 71, 194, 0, 51, 139, 131, 72, 192,
+			17, 0, 19, 6, 192, 156, 252, 138, 193, 74, 26, 36, 0, 190,
+			83, 166, 127, 40, 25, 64, 88, 246, 111, 25, 230, 82, 241, 223,
+			36, 12, 24, 220, 33, 212, 216, 21, 219, 53, 113, 87, 36, 75,
+			66, 189, 185, 131, 193, 167, 122, 219, 90, 166, 175, 229, 206, 32,
+			115, 212, 135, 138, 153, 170, 118, 7, 180, 143, 204, 164, 88, 215,
+			82, 188, 81, 124, 145, 182, 51, 154, 121, 201, 142, 117, 0, 225,
+			222, 158, 198, 150, 48, 133, 100, 113, 96, 9, 56, 2, 96, 194,
+			20, 98, 0, 8, 233, 82, 9, 34, 23, 174, 92, 165, 63, 66,
+			158, 100, 89, 246, 239, 26, 166, 85, 116, 248, 227, 147, 121, 35,
+			96, 11, 116, 191, 109, 191, 116, 219, 221, 54, 215, 137, 67, 16,
+			111, 21, 203, 60, 110, 50, 235, 53, 165, 66, 132, 7, 130, 119,
+			130, 200, 133, 186, 131, 164, 187, 217, 17, 164, 57, 170, 65, 3,
+			192, 252, 57, 13, 18, 0, 249, 197, 36, 103, 255, 15, 239, 210,
+			115, 195, 82, 239, 174, 136, 94, 155, 196, 47, 190, 233, 148, 102,
+			241, 109, 199, 151, 138, 3, 53, 2, 214, 175, 27, 244, 204, 54,
+			198, 172, 84, 214, 80, 78, 16, 219, 163, 180, 46, 14, 247, 101,
+			91, 85, 7, 112, 115, 72, 198, 113, 200, 183, 149, 251, 226, 80,
+			62, 216, 242, 227, 176, 87, 43, 212, 53, 92, 124, 143, 142, 15,
+			190, 100, 147, 148, 188, 16, 61, 149, 76, 135, 159, 112, 76, 10,
+			213, 166, 74, 18, 75, 224, 142, 249, 61, 195, 218, 160, 211, 131,
+			228, 32, 192, 18, 9, 86, 164, 249, 80, 28, 186, 16, 16, 81,
+			136, 18, 216, 122, 151, 78, 124, 12, 81, 182, 106, 237, 145, 234,
+			222, 137, 244, 253, 52, 29, 105, 4, 161, 35, 9, 230, 107, 18,
+			176, 158, 208, 201, 254, 135, 138, 208, 93, 74, 33, 174, 37, 195,
+			100, 136, 97, 108, 99, 113, 8, 83, 170, 181, 71, 187, 216, 166,
+			86, 112, 66, 79, 254, 180, 46, 210, 9, 72, 55, 85, 55, 163,
+			4, 159, 238, 9, 145, 61, 177, 46, 83, 6, 133, 155, 155, 234,
+			227, 225, 253, 133, 210, 133, 51, 3, 205, 20, 182, 45, 154, 75,
+			166, 203, 248, 201, 203, 54, 212, 199, 144, 21, 6, 137, 84, 236,
+			199, 223, 112, 168, 45, 20, 237, 224, 80, 212, 177, 136, 33, 95,
+			211, 32, 204, 22, 164, 35, 123, 170, 130, 65, 2, 144, 10, 199,
+			34, 201, 253, 80, 28, 170, 132, 118, 30, 31, 212, 196, 33, 187,
+			64, 199, 100, 12, 79, 190, 206, 225, 107, 170, 30, 169, 6, 10,
+			61, 54, 144, 165, 15, 84, 61, 130, 6, 131, 243, 144, 255, 201,
+			230, 225, 22, 157, 222, 142, 106, 226, 48, 120, 33, 234, 224, 155,
+			164, 197, 194, 78, 216, 108, 3, 219, 35, 95, 113, 193, 140, 124,
+			107, 157, 206, 28, 251, 78, 241, 29, 153, 131, 143, 241, 107, 100,
+			14, 130, 214, 13, 58, 87, 133, 192, 94, 138, 235, 154, 218, 89,
+			138, 37, 34, 251, 29, 209, 86, 52, 71, 1, 126, 42, 218, 214,
+			15, 233, 252, 201, 175, 20, 173, 179, 52, 239, 70, 50, 61, 174,
+			137, 185, 17, 106, 54, 118, 133, 142, 187, 62, 190, 217, 15, 133,
+			29, 5, 186, 239, 167, 213, 211, 26, 62, 180, 254, 15, 161, 133,
+			132, 47, 236, 62, 157, 244, 236, 72, 7, 85, 247, 65, 255, 41,
+			233, 41, 234, 20, 147, 86, 36, 149, 164, 160, 179, 54, 14, 223,
+			60, 235, 232, 170, 53, 118, 143, 78, 192, 147, 125, 140, 103, 75,
+			36, 230, 91, 145, 156, 246, 236, 40, 198, 37, 6, 207, 216, 213,
+			1, 28, 34, 182, 155, 170, 134, 162, 223, 110, 43, 182, 155, 172,
+			66, 207, 40, 246, 238, 3, 195, 162, 125, 44, 181, 66, 241, 35,
+			181, 41, 245, 10, 24, 30, 85, 225, 5, 123, 66, 103, 1, 193,
+			62, 102, 11, 6, 198, 57, 242, 214, 113, 158, 241, 236, 8, 44,
+			241, 216, 78, 13, 246, 49, 157, 73, 33, 76, 13, 57, 247, 214,
+			33, 179, 4, 95, 127, 220, 235, 67, 208, 225, 232, 165, 216, 31,
+			251, 4, 89, 112, 155, 158, 149, 163, 25, 198, 136, 60, 50, 98,
+			22, 27, 164, 164, 85, 114, 99, 227, 15, 178, 116, 118, 136, 14,
+			112, 69, 196, 246, 233, 169, 180, 118, 101, 87, 135, 44, 168, 65,
+			245, 139, 146, 92, 92, 122, 107, 59, 37, 187, 187, 52, 175, 53,
+			42, 27, 86, 173, 213, 87, 183, 18, 241, 165, 55, 182, 81, 72,
+			183, 232, 168, 210, 170, 108, 246, 4, 231, 241, 72, 112, 113, 24,
+			173, 227, 154, 248, 135, 116, 44, 165, 82, 217, 149, 33, 159, 12,
+			168, 92, 217, 195, 171, 111, 107, 166, 176, 31, 208, 211, 3, 170,
+			131, 13, 229, 217, 160, 114, 145, 20, 74, 111, 111, 168, 104, 188,
+			160, 147, 199, 181, 6, 187, 54, 228, 235, 147, 170, 69, 82, 122,
+			231, 59, 181, 149, 196, 30, 254, 183, 101, 56, 44, 156, 205, 124,
+			251, 23, 161, 226, 166, 64, 77, 146, 97, 36, 159, 185, 2, 199,
+			66, 33, 232, 81, 200, 44, 227, 79, 147, 145, 177, 204, 44, 253,
+			61, 131, 154, 185, 12, 203, 78, 101, 150, 12, 112, 48, 134, 47,
+			32, 77, 20, 221, 176, 131, 196, 144, 229, 56, 187, 112, 162, 23,
+			226, 148, 16, 25, 230, 109, 219, 135, 99, 249, 88, 222, 226, 250,
+			224, 43, 198, 88, 133, 163, 191, 60, 30, 89, 236, 231, 221, 192,
+			21, 71, 247, 239, 160, 119, 44, 48, 13, 148, 220, 56, 18, 30,
+			4, 221, 48, 252, 7, 239, 145, 118, 36, 131, 128, 57, 136, 171,
+			77, 229, 207, 211, 63, 53, 104, 54, 135, 149, 192, 115, 230, 131,
+			226, 255, 48, 120, 122, 181, 242, 182, 253, 66, 157, 34, 80, 241,
+			110, 216, 209, 49, 37, 165, 66, 93, 24, 208, 76, 199, 190, 228,
+			124, 251, 193, 17, 244, 115, 39, 136, 85, 28, 34, 20, 77, 200,
+			20, 121, 61, 8, 199, 54, 220, 166, 116, 211, 37, 54, 31, 143,
+			138, 130, 35, 237, 215, 101, 106, 233, 38, 56, 143, 149, 129, 174,
+			208, 1, 142, 66, 218, 23, 12, 51, 133, 141, 135, 66, 162, 106,
+			183, 69, 221, 181, 99, 225, 245, 160, 208, 66, 69, 47, 189, 192,
+			121, 161, 50, 135, 138, 56, 77, 168, 203, 120, 15, 48, 192, 96,
+			100, 46, 199, 52, 100, 50, 50, 119, 230, 170, 134, 8, 35, 115,
+			235, 91, 116, 19, 89, 101, 48, 82, 52, 239, 22, 111, 112, 173,
+			126, 78, 114, 9, 247, 1, 142, 25, 102, 232, 167, 44, 251, 193,
+			248, 171, 68, 8, 17, 199, 98, 110, 92, 161, 7, 209, 43, 78,
+			156, 215, 16, 97, 164, 184, 124, 155, 126, 130, 196, 76, 70, 206,
+			153, 31, 22, 239, 114, 165, 163, 146, 27, 13, 250, 81, 190, 84,
+			181, 2, 58, 212, 161, 104, 186, 81, 44, 224, 90, 143, 234, 166,
+			42, 133, 0, 76, 6, 35, 231, 114, 167, 53, 4, 136, 199, 47,
+			105, 136, 48, 114, 174, 242, 62, 68, 243, 114, 25, 8, 49, 114,
+			243, 126, 113, 139, 167, 180, 87, 66, 87, 114, 80, 123, 77, 224,
+			67, 202, 177, 169, 128, 126, 178, 192, 146, 162, 51, 73, 1, 22,
+			18, 207, 77, 41, 122, 196, 100, 132, 179, 203, 26, 2, 130, 171,
+			247, 232, 30, 82, 207, 50, 114, 201, 124, 88, 124, 192, 7, 52,
+			27, 143, 224, 216, 201, 81, 75, 64, 150, 24, 34, 232, 253, 85,
+			167, 78, 169, 112, 191, 219, 62, 72, 34, 83, 32, 239, 213, 218,
+			163, 132, 62, 196, 15, 47, 229, 206, 104, 200, 100, 228, 210, 116,
+			73, 67, 132, 145, 75, 215, 63, 161, 223, 71, 250, 88, 90, 177,
+			91, 252, 128, 31, 215, 119, 111, 234, 130, 27, 65, 116, 199, 173,
+			67, 196, 199, 15, 84, 90, 10, 144, 65, 105, 70, 110, 86, 67,
+			80, 248, 49, 87, 209, 16, 20, 106, 220, 254, 148, 190, 39, 107,
+			252, 174, 101, 214, 140, 226, 218, 128, 204, 43, 109, 12, 67, 234,
+			64, 162, 2, 229, 62, 221, 64, 5, 244, 97, 45, 95, 203, 47,
+			208, 223, 77, 170, 250, 87, 205, 43, 197, 127, 102, 240, 196, 189,
+			2, 20, 109, 121, 214, 11, 68, 228, 149, 90, 54, 24, 188, 198,
+			224, 209, 202, 7, 122, 41, 225, 179, 131, 160, 222, 251, 182, 175,
+			105, 80, 23, 97, 184, 34, 240, 121, 93, 28, 42, 49, 135, 155,
+			97, 176, 55, 82, 8, 218, 176, 206, 20, 22, 72, 92, 251, 177,
+			104, 74, 57, 161, 60, 22, 81, 28, 85, 248, 118, 211, 15, 64,
+			48, 161, 112, 9, 146, 175, 16, 0, 241, 121, 39, 12, 116, 12,
+			58, 99, 102, 114, 80, 207, 48, 163, 33, 168, 103, 152, 229, 26,
+			130, 122, 134, 75, 151, 233, 67, 89, 178, 118, 61, 115, 211, 40,
+			126, 112, 140, 101, 234, 98, 17, 87, 47, 21, 169, 69, 211, 109,
+			120, 224, 243, 168, 139, 169, 57, 197, 64, 88, 143, 215, 243, 139,
+			180, 162, 43, 142, 110, 152, 179, 214, 69, 174, 253, 198, 19, 229,
+			28, 131, 213, 56, 144, 176, 184, 161, 34, 213, 178, 200, 232, 70,
+			146, 109, 207, 16, 70, 110, 76, 207, 208, 7, 50, 193, 253, 189,
+			204, 93, 163, 120, 55, 209, 28, 201, 4, 99, 240, 167, 225, 138,
+			116, 208, 188, 186, 217, 175, 110, 81, 138, 68, 117, 23, 82, 2,
+			223, 203, 207, 97, 13, 167, 9, 221, 189, 109, 78, 90, 115, 105,
+			69, 160, 123, 92, 221, 212, 57, 59, 232, 228, 109, 213, 73, 19,
+			59, 121, 91, 85, 39, 153, 200, 214, 219, 227, 19, 244, 125, 157,
+			30, 190, 99, 78, 89, 107, 90, 139, 249, 117, 8, 147, 171, 114,
+			21, 113, 8, 117, 41, 13, 40, 130, 196, 20, 45, 92, 241, 226,
+			129, 34, 237, 105, 66, 144, 91, 185, 163, 234, 43, 100, 38, 246,
+			206, 168, 202, 152, 155, 6, 97, 228, 206, 196, 36, 125, 87, 230,
+			6, 63, 128, 146, 195, 119, 82, 220, 24, 62, 119, 250, 189, 26,
+			61, 168, 146, 15, 242, 243, 116, 77, 103, 175, 62, 52, 23, 172,
+			75, 169, 114, 21, 181, 246, 185, 221, 136, 149, 141, 128, 35, 209,
+			177, 124, 16, 176, 15, 205, 49, 13, 65, 61, 194, 169, 89, 13,
+			65, 61, 194, 217, 34, 189, 137, 161, 252, 108, 53, 179, 101, 20,
+			151, 181, 238, 125, 93, 255, 212, 107, 213, 61, 208, 52, 85, 53,
+			57, 16, 188, 37, 247, 205, 179, 111, 158, 156, 44, 166, 242, 239,
+			171, 100, 3, 198, 102, 201, 125, 149, 119, 201, 226, 84, 221, 103,
+			211, 26, 34, 140, 220, 159, 155, 167, 247, 40, 6, 151, 62, 201,
+			60, 52, 138, 183, 210, 138, 250, 187, 138, 148, 234, 237, 136, 193,
+			200, 39, 249, 34, 246, 118, 4, 122, 187, 253, 54, 81, 26, 193,
+			254, 109, 43, 81, 26, 193, 254, 109, 43, 81, 26, 193, 254, 109,
+			143, 79, 208, 87, 212, 204, 230, 88, 246, 9, 20, 116, 4, 131,
+			253, 27, 206, 196, 84, 19, 125, 10, 13, 212, 79, 35, 149, 7,
+			168, 110, 242, 122, 32, 34, 168, 177, 21, 47, 221, 40, 46, 67,
+			24, 81, 85, 111, 224, 134, 142, 23, 21, 169, 129, 65, 97, 207,
+			147, 252, 2, 61, 71, 179, 217, 28, 12, 236, 169, 89, 178, 38,
+			147, 243, 180, 233, 21, 156, 67, 157, 243, 212, 92, 212, 144, 193,
+			200, 211, 115, 151, 52, 68, 24, 121, 122, 117, 137, 46, 35, 34,
+			131, 145, 79, 77, 102, 45, 242, 142, 104, 175, 232, 211, 176, 213,
+			205, 244, 102, 160, 145, 194, 66, 248, 84, 177, 41, 135, 11, 225,
+			211, 194, 105, 13, 17, 70, 62, 157, 156, 162, 119, 16, 41, 86,
+			36, 156, 177, 86, 48, 215, 194, 221, 164, 200, 145, 31, 217, 192,
+			36, 140, 116, 168, 18, 239, 150, 56, 214, 117, 200, 126, 213, 212,
+			114, 203, 225, 38, 95, 27, 29, 215, 16, 97, 164, 54, 197, 232,
+			58, 82, 33, 140, 236, 154, 83, 214, 229, 19, 84, 84, 17, 121,
+			15, 77, 149, 110, 100, 55, 147, 33, 64, 162, 127, 55, 65, 14,
+			11, 111, 87, 173, 229, 28, 238, 218, 187, 19, 147, 116, 21, 145,
+			103, 25, 217, 51, 231, 44, 75, 245, 14, 84, 167, 172, 97, 211,
+			68, 236, 78, 7, 175, 240, 208, 168, 33, 189, 183, 151, 112, 7,
+			22, 205, 158, 74, 114, 230, 64, 55, 144, 189, 153, 89, 186, 129,
+			168, 71, 24, 121, 102, 158, 181, 174, 188, 22, 53, 112, 73, 5,
+			140, 52, 118, 40, 138, 123, 150, 96, 7, 33, 127, 86, 152, 214,
+			16, 97, 228, 217, 220, 188, 194, 158, 99, 228, 179, 183, 98, 87,
+			115, 160, 177, 67, 9, 217, 103, 9, 118, 144, 180, 207, 18, 236,
+			80, 66, 246, 217, 220, 60, 102, 168, 115, 230, 40, 20, 74, 44,
+			88, 215, 56, 56, 238, 28, 147, 248, 67, 20, 21, 240, 93, 145,
+			211, 36, 70, 115, 240, 229, 152, 134, 160, 196, 66, 41, 169, 28,
+			86, 112, 61, 63, 91, 164, 159, 81, 51, 59, 202, 178, 95, 100,
+			254, 138, 81, 124, 56, 104, 46, 105, 45, 160, 106, 31, 210, 58,
+			64, 21, 69, 130, 95, 32, 109, 152, 65, 251, 73, 45, 31, 168,
+			25, 251, 34, 191, 136, 122, 97, 20, 150, 207, 15, 223, 166, 23,
+			70, 81, 47, 252, 80, 177, 101, 20, 87, 209, 15, 149, 94, 24,
+			69, 189, 240, 195, 241, 9, 186, 141, 248, 12, 70, 190, 52, 39,
+			173, 247, 144, 254, 82, 116, 204, 130, 43, 29, 184, 205, 202, 182,
+			31, 15, 158, 54, 175, 11, 199, 109, 219, 158, 202, 221, 45, 107,
+			162, 176, 202, 190, 76, 136, 194, 42, 251, 50, 33, 10, 219, 205,
+			151, 227, 19, 244, 35, 106, 102, 243, 44, 107, 103, 28, 163, 120,
+			227, 56, 163, 134, 171, 163, 129, 70, 146, 37, 121, 131, 17, 59,
+			127, 14, 103, 54, 15, 44, 57, 48, 207, 88, 215, 82, 171, 73,
+			150, 242, 201, 236, 127, 211, 133, 141, 114, 119, 71, 153, 163, 73,
+			237, 107, 30, 185, 116, 160, 214, 84, 30, 185, 116, 160, 22, 108,
+			30, 185, 116, 48, 197, 104, 141, 154, 217, 2, 203, 54, 50, 45,
+			163, 248, 241, 9, 67, 116, 200, 228, 118, 68, 59, 225, 86, 218,
+			46, 213, 117, 157, 106, 86, 11, 88, 76, 113, 129, 190, 71, 179,
+			217, 2, 12, 161, 105, 206, 90, 171, 111, 253, 26, 21, 131, 62,
+			133, 35, 25, 95, 192, 113, 52, 21, 227, 11, 56, 142, 166, 178,
+			122, 10, 56, 142, 166, 178, 122, 40, 203, 190, 200, 248, 96, 245,
+			156, 28, 199, 112, 222, 31, 111, 167, 58, 79, 13, 70, 94, 228,
+			57, 93, 161, 217, 44, 133, 206, 123, 230, 180, 197, 37, 255, 113,
+			197, 14, 51, 200, 101, 111, 41, 246, 214, 83, 92, 167, 216, 91,
+			79, 85, 125, 82, 236, 173, 199, 206, 208, 42, 34, 134, 114, 11,
+			115, 209, 186, 5, 105, 116, 12, 146, 226, 232, 7, 112, 251, 154,
+			23, 253, 66, 121, 215, 31, 32, 103, 164, 74, 56, 40, 234, 254,
+			118, 97, 78, 67, 80, 194, 81, 92, 160, 127, 199, 160, 102, 118,
+			140, 101, 163, 204, 183, 70, 241, 125, 158, 132, 97, 83, 117, 2,
+			176, 190, 60, 168, 186, 77, 235, 14, 220, 201, 19, 83, 20, 157,
+			156, 141, 157, 213, 63, 207, 127, 146, 223, 99, 80, 114, 145, 159,
+			162, 55, 104, 54, 59, 6, 252, 142, 205, 117, 107, 137, 67, 116,
+			83, 170, 246, 106, 237, 17, 106, 199, 126, 161, 217, 65, 111, 64,
+			37, 140, 225, 198, 26, 155, 11, 26, 130, 211, 199, 139, 101, 13,
+			17, 70, 226, 213, 53, 90, 66, 252, 6, 35, 93, 115, 205, 90,
+			24, 130, 95, 21, 70, 107, 156, 70, 14, 154, 106, 156, 192, 219,
+			238, 226, 59, 26, 34, 140, 116, 43, 171, 170, 207, 38, 148, 112,
+			156, 179, 150, 56, 132, 80, 129, 107, 168, 133, 149, 233, 223, 232,
+			122, 94, 143, 235, 162, 235, 100, 129, 142, 97, 249, 205, 161, 154,
+			187, 49, 220, 81, 15, 11, 243, 26, 130, 90, 143, 133, 69, 250,
+			61, 196, 79, 24, 57, 50, 185, 245, 142, 86, 92, 232, 124, 163,
+			214, 72, 47, 163, 180, 63, 170, 105, 192, 198, 122, 164, 106, 156,
+			198, 176, 180, 230, 40, 175, 71, 4, 27, 235, 209, 249, 11, 244,
+			3, 164, 145, 101, 164, 103, 190, 107, 173, 167, 248, 162, 114, 208,
+			177, 253, 93, 102, 32, 155, 3, 4, 26, 55, 236, 179, 189, 197,
+			13, 13, 17, 70, 122, 55, 111, 225, 78, 56, 6, 195, 254, 198,
+			188, 101, 93, 121, 35, 165, 99, 115, 49, 146, 131, 143, 52, 118,
+			216, 103, 191, 89, 92, 215, 16, 97, 228, 155, 27, 55, 209, 198,
+			25, 51, 115, 140, 188, 50, 47, 89, 43, 223, 97, 46, 18, 138,
+			154, 10, 236, 183, 175, 146, 25, 129, 253, 246, 85, 225, 188, 134,
+			8, 35, 175, 46, 90, 104, 227, 140, 193, 126, 251, 99, 243, 170,
+			117, 57, 53, 35, 186, 108, 195, 61, 54, 28, 141, 28, 106, 165,
+			127, 156, 76, 5, 236, 123, 63, 206, 95, 212, 16, 97, 228, 199,
+			151, 175, 36, 9, 218, 95, 93, 166, 227, 80, 169, 179, 239, 5,
+			175, 63, 86, 253, 214, 164, 43, 28, 50, 61, 243, 105, 87, 132,
+			61, 56, 208, 245, 40, 208, 193, 115, 72, 182, 65, 9, 160, 74,
+			1, 225, 111, 149, 136, 82, 137, 39, 199, 198, 19, 196, 34, 140,
+			247, 35, 31, 51, 34, 217, 90, 14, 68, 109, 215, 103, 107, 116,
+			4, 79, 46, 204, 103, 223, 154, 121, 144, 13, 225, 11, 140, 138,
+			125, 135, 220, 135, 108, 8, 249, 61, 188, 221, 15, 15, 65, 143,
+			212, 36, 0, 167, 125, 157, 110, 24, 5, 161, 58, 151, 172, 32,
+			235, 143, 179, 244, 148, 26, 33, 230, 126, 217, 247, 104, 33, 97,
+			197, 119, 72, 44, 245, 27, 67, 98, 56, 236, 56, 42, 135, 69,
+			194, 142, 3, 199, 100, 49, 68, 189, 15, 87, 211, 168, 228, 80,
+			1, 159, 236, 245, 58, 2, 216, 212, 17, 34, 220, 119, 59, 200,
+			143, 66, 45, 7, 224, 118, 39, 225, 241, 200, 9, 30, 231, 18,
+			30, 159, 161, 35, 88, 214, 136, 227, 33, 181, 172, 99, 111, 215,
+			211, 140, 207, 15, 48, 254, 18, 61, 157, 62, 158, 28, 205, 23,
+			240, 52, 235, 169, 160, 95, 87, 28, 65, 119, 69, 24, 6, 225,
+			62, 120, 12, 243, 20, 73, 21, 240, 73, 53, 168, 11, 118, 137,
+			158, 70, 96, 95, 221, 178, 58, 63, 134, 45, 78, 225, 195, 199,
+			242, 25, 91, 162, 19, 42, 184, 187, 175, 10, 104, 230, 79, 97,
+			179, 113, 245, 120, 83, 62, 101, 239, 211, 83, 200, 137, 125, 60,
+			61, 210, 155, 63, 253, 86, 86, 143, 97, 251, 45, 108, 206, 46,
+			234, 207, 163, 150, 189, 113, 243, 214, 252, 56, 18, 145, 77, 118,
+			241, 81, 186, 43, 135, 50, 222, 62, 63, 49, 208, 21, 21, 133,
+			31, 60, 65, 60, 201, 141, 193, 19, 196, 43, 148, 37, 197, 52,
+			251, 186, 2, 102, 126, 10, 155, 77, 37, 111, 182, 213, 11, 72,
+			237, 43, 244, 209, 60, 67, 38, 39, 176, 229, 210, 233, 193, 37,
+			165, 50, 33, 183, 233, 168, 210, 0, 243, 198, 107, 79, 74, 167,
+			165, 180, 166, 219, 167, 228, 218, 28, 144, 235, 7, 116, 102, 235,
+			37, 108, 191, 199, 105, 205, 210, 156, 95, 255, 58, 74, 10, 15,
+			20, 244, 58, 68, 27, 255, 193, 160, 163, 10, 7, 36, 220, 210,
+			253, 31, 154, 112, 27, 28, 224, 235, 19, 110, 67, 25, 97, 211,
+			211, 3, 189, 254, 206, 20, 134, 101, 157, 134, 142, 255, 225, 31,
+			159, 151, 137, 160, 167, 127, 161, 18, 65, 203, 244, 79, 84, 202,
+			103, 50, 51, 103, 64, 138, 68, 141, 27, 141, 124, 89, 179, 20,
+			69, 250, 24, 31, 212, 36, 198, 80, 117, 135, 61, 7, 6, 67,
+			46, 3, 206, 68, 66, 56, 117, 11, 106, 253, 78, 156, 227, 45,
+			159, 120, 34, 79, 246, 130, 143, 54, 172, 252, 19, 18, 63, 101,
+			154, 218, 60, 85, 216, 185, 12, 156, 9, 133, 19, 132, 245, 254,
+			241, 34, 216, 170, 248, 150, 218, 4, 33, 74, 242, 2, 110, 83,
+			2, 155, 246, 246, 26, 175, 219, 189, 232, 245, 9, 165, 19, 9,
+			163, 201, 252, 4, 253, 76, 231, 139, 152, 249, 160, 184, 205, 211,
+			18, 163, 172, 248, 8, 135, 175, 86, 145, 172, 64, 213, 215, 64,
+			253, 8, 90, 151, 121, 59, 136, 32, 37, 228, 64, 36, 166, 225,
+			134, 81, 60, 144, 134, 97, 3, 105, 24, 54, 144, 134, 97, 235,
+			91, 120, 142, 3, 210, 38, 140, 204, 154, 219, 197, 67, 62, 32,
+			140, 223, 177, 15, 224, 83, 250, 226, 8, 110, 0, 93, 169, 11,
+			220, 199, 224, 192, 21, 20, 109, 151, 121, 212, 117, 229, 125, 162,
+			192, 37, 47, 176, 235, 240, 41, 150, 187, 221, 115, 155, 56, 224,
+			164, 191, 96, 126, 206, 38, 121, 5, 144, 157, 217, 233, 37, 13,
+			17, 70, 102, 55, 30, 208, 255, 9, 134, 126, 134, 101, 207, 103,
+			74, 70, 241, 191, 27, 124, 200, 34, 75, 142, 67, 224, 225, 246,
+			116, 215, 227, 64, 141, 8, 166, 9, 15, 35, 192, 105, 187, 160,
+			193, 151, 96, 27, 91, 42, 243, 37, 199, 94, 194, 32, 237, 146,
+			218, 158, 150, 64, 150, 177, 218, 57, 157, 49, 179, 225, 148, 163,
+			35, 202, 234, 230, 100, 58, 236, 187, 244, 34, 81, 105, 182, 38,
+			102, 59, 228, 221, 8, 234, 168, 158, 110, 225, 4, 237, 3, 200,
+			248, 80, 233, 241, 218, 210, 110, 196, 91, 75, 149, 199, 6, 110,
+			214, 249, 252, 2, 181, 116, 90, 226, 130, 201, 172, 153, 212, 217,
+			108, 161, 139, 193, 147, 224, 255, 8, 52, 202, 107, 200, 96, 228,
+			130, 138, 153, 201, 44, 220, 133, 201, 41, 72, 140, 101, 113, 246,
+			185, 57, 105, 221, 229, 85, 196, 101, 67, 140, 169, 4, 245, 182,
+			112, 186, 67, 213, 216, 47, 203, 220, 227, 97, 208, 69, 187, 82,
+			223, 102, 124, 140, 38, 248, 106, 60, 161, 9, 19, 202, 85, 4,
+			65, 38, 227, 248, 248, 4, 250, 252, 120, 85, 192, 69, 115, 198,
+			186, 118, 44, 94, 49, 56, 148, 180, 233, 175, 73, 128, 109, 125,
+			49, 33, 1, 125, 191, 168, 78, 215, 202, 115, 248, 23, 207, 76,
+			211, 121, 36, 1, 169, 40, 243, 170, 53, 214, 191, 253, 71, 227,
+			32, 57, 120, 181, 160, 33, 200, 103, 45, 94, 212, 16, 124, 118,
+			249, 138, 194, 145, 133, 19, 211, 128, 67, 188, 60, 134, 3, 156,
+			129, 203, 9, 14, 112, 6, 46, 39, 56, 32, 232, 118, 249, 242,
+			21, 186, 133, 56, 48, 11, 198, 172, 239, 241, 182, 253, 114, 136,
+			49, 157, 72, 101, 25, 36, 23, 11, 248, 215, 215, 240, 0, 209,
+			75, 188, 218, 81, 19, 132, 56, 220, 85, 101, 92, 171, 108, 88,
+			114, 99, 17, 212, 205, 95, 157, 156, 194, 51, 209, 25, 240, 15,
+			150, 204, 105, 107, 3, 114, 123, 184, 205, 246, 3, 158, 29, 200,
+			195, 4, 221, 72, 29, 162, 132, 194, 106, 125, 73, 66, 16, 38,
+			99, 203, 165, 78, 48, 103, 204, 28, 158, 96, 214, 183, 69, 65,
+			80, 110, 137, 157, 161, 95, 202, 188, 81, 57, 115, 223, 40, 126,
+			170, 213, 56, 232, 198, 94, 202, 233, 78, 78, 36, 193, 77, 91,
+			237, 78, 156, 212, 50, 39, 167, 130, 64, 221, 42, 235, 68, 38,
+			183, 64, 205, 43, 153, 7, 249, 41, 231, 167, 209, 171, 197, 195,
+			235, 43, 230, 53, 107, 161, 239, 78, 105, 164, 224, 182, 181, 237,
+			186, 234, 190, 129, 158, 242, 138, 154, 26, 153, 68, 90, 89, 212,
+			167, 36, 65, 242, 87, 74, 203, 234, 72, 183, 193, 72, 197, 156,
+			178, 206, 170, 251, 12, 142, 111, 29, 150, 198, 8, 114, 93, 81,
+			12, 49, 48, 6, 81, 41, 156, 210, 16, 97, 164, 50, 49, 137,
+			65, 109, 3, 228, 122, 213, 156, 183, 22, 83, 177, 118, 216, 179,
+			68, 88, 217, 211, 6, 181, 70, 106, 14, 156, 54, 54, 241, 180,
+			241, 25, 117, 218, 24, 36, 121, 117, 118, 142, 46, 233, 147, 231,
+			107, 230, 140, 85, 228, 219, 79, 225, 100, 48, 220, 188, 168, 23,
+			61, 236, 51, 34, 212, 40, 193, 23, 94, 75, 80, 130, 47, 188,
+			166, 22, 135, 129, 65, 230, 181, 51, 211, 180, 172, 143, 158, 111,
+			152, 204, 186, 48, 76, 131, 224, 129, 111, 12, 146, 104, 188, 16,
+			97, 222, 72, 240, 130, 176, 111, 40, 93, 98, 160, 231, 187, 49,
+			57, 69, 175, 235, 51, 230, 215, 205, 73, 235, 106, 90, 151, 28,
+			83, 37, 39, 209, 131, 104, 95, 79, 208, 131, 104, 95, 87, 106,
+			195, 64, 215, 247, 250, 248, 4, 186, 240, 120, 236, 250, 134, 201,
+			172, 245, 193, 211, 80, 42, 14, 171, 116, 210, 155, 40, 129, 100,
+			223, 80, 139, 72, 158, 88, 190, 161, 22, 145, 60, 177, 124, 99,
+			114, 138, 222, 214, 7, 150, 111, 154, 51, 86, 121, 184, 130, 74,
+			41, 166, 147, 68, 192, 13, 190, 153, 12, 7, 220, 224, 155, 201,
+			44, 64, 192, 249, 230, 153, 105, 37, 127, 121, 70, 222, 53, 47,
+			91, 103, 83, 210, 50, 112, 72, 82, 99, 132, 83, 192, 239, 170,
+			164, 150, 97, 230, 71, 24, 121, 87, 37, 181, 12, 19, 98, 169,
+			239, 178, 11, 26, 34, 140, 188, 107, 93, 82, 233, 215, 2, 36,
+			42, 207, 90, 23, 181, 12, 110, 105, 47, 9, 150, 157, 85, 123,
+			90, 221, 223, 170, 213, 158, 212, 18, 57, 47, 164, 50, 155, 6,
+			158, 195, 189, 157, 136, 100, 1, 50, 155, 179, 243, 244, 29, 196,
+			76, 33, 51, 185, 104, 157, 231, 117, 17, 219, 174, 151, 200, 35,
+			122, 89, 200, 18, 219, 87, 241, 77, 195, 164, 152, 199, 212, 104,
+			33, 248, 120, 39, 57, 87, 79, 33, 143, 121, 118, 65, 73, 250,
+			24, 35, 239, 153, 231, 173, 98, 250, 216, 107, 250, 228, 103, 164,
+			81, 142, 13, 28, 203, 30, 195, 99, 217, 243, 234, 88, 246, 24,
+			28, 203, 94, 56, 167, 36, 253, 20, 35, 239, 155, 21, 235, 66,
+			95, 111, 32, 51, 234, 202, 162, 84, 183, 2, 104, 188, 167, 114,
+			208, 92, 235, 142, 83, 6, 35, 239, 47, 150, 148, 238, 56, 69,
+			24, 121, 255, 157, 21, 140, 154, 26, 230, 105, 70, 62, 48, 23,
+			44, 206, 91, 226, 37, 223, 253, 100, 115, 227, 230, 45, 205, 132,
+			52, 1, 141, 248, 244, 8, 180, 215, 29, 62, 13, 137, 215, 194,
+			140, 134, 8, 35, 31, 204, 23, 233, 53, 68, 60, 14, 57, 213,
+			243, 214, 57, 174, 220, 65, 141, 181, 95, 147, 212, 95, 240, 227,
+			35, 208, 88, 99, 29, 135, 4, 108, 194, 134, 113, 72, 192, 38,
+			108, 152, 96, 100, 211, 156, 183, 46, 40, 141, 1, 72, 135, 216,
+			195, 26, 239, 196, 8, 52, 215, 120, 39, 12, 70, 54, 85, 74,
+			201, 48, 39, 8, 35, 155, 51, 115, 152, 173, 50, 204, 73, 70,
+			238, 153, 23, 45, 75, 101, 73, 227, 158, 54, 150, 251, 90, 125,
+			144, 17, 147, 35, 240, 133, 70, 61, 105, 48, 114, 79, 29, 47,
+			54, 204, 73, 194, 200, 189, 243, 92, 233, 146, 41, 70, 170, 38,
+			183, 174, 234, 58, 172, 104, 40, 94, 176, 182, 27, 65, 194, 145,
+			169, 44, 124, 149, 64, 35, 140, 84, 147, 165, 50, 5, 249, 100,
+			117, 238, 206, 48, 167, 8, 35, 213, 115, 23, 232, 135, 178, 158,
+			224, 1, 228, 127, 175, 31, 179, 40, 135, 71, 213, 211, 109, 212,
+			94, 5, 234, 251, 65, 126, 145, 142, 233, 58, 130, 79, 204, 203,
+			42, 83, 15, 25, 233, 79, 84, 143, 76, 220, 156, 62, 81, 199,
+			223, 100, 241, 192, 39, 243, 23, 82, 197, 3, 159, 88, 151, 144,
+			179, 24, 45, 221, 54, 167, 45, 11, 143, 6, 233, 51, 105, 248,
+			23, 56, 14, 160, 64, 4, 146, 180, 210, 132, 72, 151, 11, 232,
+			100, 178, 44, 23, 216, 86, 219, 182, 137, 214, 215, 54, 59, 67,
+			55, 101, 185, 192, 227, 204, 83, 163, 120, 147, 15, 245, 61, 143,
+			143, 118, 160, 145, 26, 46, 108, 45, 143, 243, 231, 208, 224, 192,
+			99, 175, 59, 104, 112, 4, 190, 144, 135, 53, 213, 85, 166, 29,
+			33, 255, 50, 0, 92, 66, 37, 240, 23, 156, 64, 79, 252, 9,
+			165, 32, 228, 57, 85, 125, 228, 92, 214, 17, 236, 168, 158, 203,
+			58, 130, 29, 118, 6, 153, 66, 128, 41, 79, 126, 2, 166, 16,
+			100, 202, 147, 4, 53, 48, 229, 73, 130, 26, 152, 242, 132, 157,
+			73, 98, 146, 63, 119, 138, 222, 111, 186, 113, 171, 123, 0, 7,
+			215, 86, 161, 22, 15, 255, 111, 165, 25, 172, 58, 152, 174, 131,
+			75, 72, 87, 83, 62, 252, 234, 177, 40, 146, 138, 100, 142, 165,
+			34, 153, 111, 143, 97, 254, 169, 65, 199, 119, 7, 195, 78, 231,
+			40, 213, 247, 250, 169, 130, 244, 66, 173, 160, 158, 108, 215, 7,
+			175, 209, 83, 23, 35, 38, 215, 232, 77, 211, 17, 209, 182, 93,
+			125, 45, 162, 4, 32, 18, 85, 119, 163, 142, 103, 247, 228, 77,
+			129, 89, 117, 239, 161, 124, 6, 89, 72, 86, 162, 147, 42, 250,
+			230, 120, 174, 240, 145, 180, 140, 237, 141, 203, 231, 85, 124, 188,
+			93, 79, 34, 127, 185, 84, 228, 239, 14, 165, 253, 114, 185, 249,
+			209, 183, 198, 201, 82, 173, 31, 254, 108, 65, 70, 59, 230, 255,
+			255, 143, 118, 76, 167, 163, 29, 255, 88, 185, 171, 167, 51, 243,
+			70, 241, 215, 12, 62, 56, 203, 3, 134, 178, 188, 26, 98, 123,
+			243, 177, 110, 196, 85, 43, 112, 86, 225, 47, 114, 233, 27, 126,
+			241, 110, 71, 205, 87, 16, 83, 215, 110, 175, 134, 201, 197, 196,
+			240, 231, 47, 86, 15, 215, 87, 149, 176, 68, 149, 104, 128, 102,
+			116, 105, 176, 15, 125, 239, 242, 116, 126, 22, 175, 37, 194, 162,
+			183, 113, 115, 30, 174, 37, 194, 211, 167, 122, 255, 81, 24, 165,
+			35, 24, 28, 249, 253, 50, 205, 212, 149, 19, 218, 133, 128, 21,
+			61, 174, 150, 157, 244, 62, 199, 149, 37, 33, 189, 207, 241, 217,
+			57, 52, 233, 208, 251, 156, 48, 231, 32, 43, 211, 18, 202, 172,
+			67, 31, 60, 146, 113, 131, 62, 253, 215, 80, 130, 5, 62, 145,
+			80, 130, 5, 62, 81, 96, 233, 251, 233, 102, 102, 251, 62, 231,
+			164, 204, 51, 131, 161, 2, 171, 226, 184, 53, 253, 26, 18, 96,
+			213, 78, 38, 36, 160, 199, 147, 133, 113, 13, 17, 70, 38, 167,
+			24, 189, 169, 125, 206, 41, 179, 104, 149, 212, 78, 21, 225, 13,
+			214, 169, 61, 252, 53, 4, 192, 110, 159, 74, 8, 128, 114, 157,
+			82, 198, 129, 116, 72, 167, 230, 207, 226, 21, 94, 25, 176, 219,
+			153, 121, 222, 170, 32, 54, 101, 32, 201, 213, 202, 221, 190, 91,
+			254, 26, 50, 96, 198, 179, 132, 12, 152, 241, 172, 160, 47, 246,
+			3, 51, 158, 45, 158, 163, 47, 181, 207, 58, 139, 181, 57, 218,
+			61, 176, 125, 184, 223, 33, 112, 160, 34, 184, 142, 55, 172, 209,
+			141, 199, 184, 30, 85, 209, 208, 129, 128, 35, 154, 16, 20, 235,
+			132, 193, 161, 91, 23, 245, 147, 181, 212, 80, 82, 26, 71, 194,
+			107, 148, 241, 118, 152, 131, 158, 186, 16, 101, 123, 243, 113, 82,
+			179, 8, 190, 192, 108, 210, 71, 240, 5, 102, 147, 176, 197, 8,
+			68, 129, 38, 167, 208, 213, 66, 55, 119, 206, 124, 199, 90, 76,
+			57, 132, 106, 93, 129, 67, 136, 167, 162, 52, 210, 28, 182, 213,
+			206, 58, 152, 253, 115, 139, 87, 53, 4, 21, 201, 203, 215, 146,
+			77, 224, 31, 140, 211, 217, 99, 90, 253, 13, 71, 6, 255, 92,
+			246, 11, 235, 57, 93, 168, 226, 77, 48, 131, 139, 83, 69, 180,
+			78, 156, 160, 210, 234, 87, 157, 36, 3, 85, 12, 249, 36, 60,
+			95, 167, 206, 145, 169, 195, 118, 117, 186, 56, 28, 177, 138, 100,
+			223, 63, 153, 7, 129, 120, 251, 216, 198, 194, 64, 164, 250, 216,
+			215, 199, 146, 36, 27, 191, 100, 208, 137, 193, 38, 17, 59, 162,
+			211, 195, 40, 179, 202, 144, 16, 248, 240, 46, 226, 216, 139, 171,
+			223, 185, 189, 28, 210, 195, 255, 76, 229, 94, 114, 233, 47, 84,
+			228, 124, 135, 254, 109, 21, 57, 63, 13, 145, 243, 191, 113, 124,
+			47, 129, 107, 70, 116, 80, 199, 213, 241, 86, 60, 15, 225, 250,
+			205, 254, 50, 59, 190, 185, 188, 33, 98, 253, 19, 31, 129, 56,
+			157, 159, 163, 191, 99, 234, 144, 246, 172, 185, 95, 252, 167, 38,
+			31, 54, 85, 234, 214, 163, 104, 240, 86, 36, 216, 250, 52, 53,
+			37, 139, 41, 149, 163, 34, 163, 144, 38, 240, 81, 253, 168, 142,
+			227, 149, 46, 3, 26, 102, 176, 170, 62, 14, 120, 199, 117, 94,
+			64, 40, 12, 121, 160, 55, 49, 216, 97, 146, 171, 38, 233, 9,
+			194, 219, 247, 43, 248, 23, 220, 234, 129, 211, 109, 11, 63, 150,
+			200, 128, 167, 67, 206, 168, 168, 131, 158, 80, 123, 13, 87, 178,
+			171, 188, 35, 186, 176, 104, 166, 187, 126, 35, 128, 238, 238, 65,
+			93, 26, 252, 49, 172, 228, 152, 180, 91, 23, 237, 78, 16, 11,
+			127, 48, 106, 63, 155, 43, 166, 162, 246, 179, 11, 183, 52, 4,
+			250, 111, 243, 75, 172, 86, 203, 176, 108, 49, 115, 30, 170, 213,
+			134, 175, 6, 85, 186, 58, 112, 101, 7, 244, 104, 248, 132, 216,
+			158, 167, 44, 123, 160, 95, 204, 95, 194, 186, 38, 52, 5, 22,
+			204, 73, 107, 53, 29, 206, 65, 41, 86, 247, 176, 130, 32, 192,
+			108, 44, 13, 92, 81, 170, 213, 46, 24, 1, 11, 137, 46, 7,
+			204, 11, 73, 56, 24, 140, 128, 133, 241, 9, 250, 84, 27, 1,
+			139, 38, 179, 170, 234, 246, 206, 212, 213, 88, 131, 27, 207, 241,
+			121, 130, 17, 149, 116, 223, 160, 7, 170, 152, 45, 131, 118, 255,
+			98, 66, 27, 204, 130, 197, 100, 31, 1, 187, 127, 113, 114, 138,
+			62, 214, 102, 193, 57, 115, 202, 250, 8, 221, 137, 176, 43, 202,
+			105, 210, 138, 142, 174, 213, 214, 155, 43, 174, 118, 215, 127, 1,
+			183, 129, 200, 10, 90, 229, 112, 200, 0, 245, 57, 85, 30, 165,
+			142, 138, 168, 66, 79, 117, 56, 100, 98, 146, 238, 201, 224, 233,
+			197, 204, 37, 163, 248, 201, 107, 38, 112, 184, 51, 246, 182, 233,
+			131, 129, 94, 204, 95, 86, 241, 165, 12, 35, 150, 185, 110, 157,
+			85, 35, 58, 193, 63, 237, 38, 131, 83, 106, 169, 162, 93, 25,
+			49, 181, 206, 149, 83, 17, 83, 107, 117, 237, 32, 215, 9, 131,
+			56, 184, 254, 127, 7, 0, 162, 198, 62, 185, 206, 120, 0, 0,
+		},
 	)
 }
@@ -32,7 +32,7 @@ type CreateServiceAccountRequest struct {
 func (m *CreateServiceAccountRequest) Reset()                    { *m = CreateServiceAccountRequest{} }
 func (m *CreateServiceAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateServiceAccountRequest) ProtoMessage()               {}
-func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 // CreateServiceAccountResponse is returned by CreateServiceAccount call.
 type CreateServiceAccountResponse struct {
@@ -42,7 +42,7 @@ type CreateServiceAccountResponse struct {
 func (m *CreateServiceAccountResponse) Reset()                    { *m = CreateServiceAccountResponse{} }
 func (m *CreateServiceAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateServiceAccountResponse) ProtoMessage()               {}
-func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 func (m *CreateServiceAccountResponse) GetServiceAccount() *tokenserver.ServiceAccount {
 	if m != nil {
@@ -159,22 +159,21 @@ var _ServiceAccounts_serviceDesc = grpc.ServiceDesc{
 	Streams: []grpc.StreamDesc{},
 }
 
-var fileDescriptor2 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0x4e, 0x2d, 0x2a,
-	0xcb, 0x4c, 0x4e, 0x8d, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x2c, 0xc9, 0xcf, 0x4e, 0xcd, 0x03, 0x49, 0xa6, 0x16, 0xe9, 0x25, 0xa6,
-	0xe4, 0x66, 0xe6, 0x49, 0xb9, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0xe7, 0x94, 0x26, 0x67, 0x82, 0x09, 0xdd, 0xf4, 0x7c, 0x7d, 0xa0, 0x40, 0x6e, 0x7e, 0x9e, 0x7e,
-	0x62, 0x41, 0xa6, 0x3e, 0x92, 0x2e, 0x7d, 0x34, 0x93, 0x21, 0x06, 0x2b, 0x85, 0x73, 0x49, 0x3b,
-	0x17, 0xa5, 0x26, 0x96, 0xa4, 0x06, 0x43, 0xa4, 0x1d, 0x21, 0xb2, 0x41, 0xa9, 0x85, 0xa5, 0xa9,
-	0xc5, 0x25, 0x42, 0x7c, 0x5c, 0x4c, 0xc9, 0x89, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x40,
-	0x96, 0x90, 0x10, 0x17, 0x4b, 0x5a, 0x61, 0x4a, 0x9e, 0x04, 0x13, 0x58, 0x04, 0xcc, 0x16, 0x12,
-	0xe1, 0x62, 0x4d, 0xcb, 0x2f, 0x4a, 0x4e, 0x95, 0x60, 0x06, 0x0a, 0x72, 0x04, 0x41, 0x38, 0x4a,
-	0x29, 0x5c, 0x32, 0xd8, 0x0d, 0x2e, 0x2e, 0xc8, 0x07, 0x3a, 0x49, 0xc8, 0x85, 0x8b, 0x1f, 0xcd,
-	0x45, 0x60, 0x6b, 0xb8, 0x8d, 0xa4, 0xf5, 0x90, 0xfd, 0x8a, 0xa6, 0x9b, 0xaf, 0x18, 0x85, 0x6f,
-	0xd4, 0xc5, 0xc8, 0xc5, 0x8f, 0xaa, 0xa4, 0x58, 0xa8, 0x9c, 0x4b, 0x04, 0x9b, 0xcd, 0x42, 0x7a,
-	0x7a, 0x18, 0x81, 0xa8, 0x87, 0xc7, 0xef, 0x52, 0xfa, 0x44, 0xab, 0x87, 0x78, 0x29, 0x89, 0x0d,
-	0x1c, 0xa4, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xf5, 0x3b, 0xad, 0xc5, 0x01, 0x00,
-	0x00,
+var fileDescriptor3 = []byte{
+	// 239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x50, 0xcb, 0x4e, 0xc3, 0x30,
+	0x10, 0x54, 0xc2, 0x43, 0xb0, 0x48, 0xad, 0xb0, 0x2a, 0x14, 0xb5, 0x1c, 0xaa, 0x9e, 0x7a, 0xc1,
+	0x96, 0xca, 0x17, 0x20, 0xfa, 0x05, 0xe1, 0xc0, 0x11, 0xb9, 0x9b, 0x6d, 0xb1, 0x20, 0xde, 0xd4,
+	0x0f, 0xf8, 0x07, 0xbe, 0x1a, 0x61, 0x5f, 0xda, 0x10, 0x21, 0x2e, 0x96, 0xd7, 0xb3, 0x33, 0xe3,
+	0x19, 0xb8, 0xf1, 0xe4, 0x3e, 0x0c, 0xd2, 0x8b, 0x46, 0xe4, 0x68, 0x83, 0x97, 0x9d, 0xe3, 0xc0,
+	0xe2, 0x3a, 0xf0, 0x1b, 0xd9, 0x1f, 0x90, 0x9c, 0xd4, 0x4d, 0x6b, 0xec, 0x74, 0xbd, 0x33, 0xe1,
+	0x35, 0x6e, 0x24, 0x72, 0xab, 0xde, 0x23, 0x9a, 0x74, 0xdc, 0xed, 0x58, 0x21, 0xb7, 0x2d, 0x5b,
+	0xa5, 0x3b, 0xa3, 0x0e, 0x58, 0xaa, 0xa7, 0x9c, 0x85, 0x17, 0xcf, 0x30, 0x7b, 0x74, 0xa4, 0x03,
+	0x3d, 0x65, 0xf8, 0x21, 0xa3, 0x35, 0xed, 0x23, 0xf9, 0x20, 0x46, 0x50, 0xa2, 0xae, 0x8a, 0x79,
+	0xb1, 0xbc, 0xac, 0x4b, 0xd4, 0x42, 0xc0, 0xe9, 0x76, 0xdf, 0xd8, 0xaa, 0x4c, 0x2f, 0xe9, 0x2e,
+	0x26, 0x70, 0xb6, 0x65, 0x87, 0x54, 0x9d, 0xcc, 0x8b, 0xe5, 0x45, 0x9d, 0x87, 0x45, 0x03, 0xb7,
+	0xc3, 0xc2, 0xbe, 0x63, 0xeb, 0x49, 0xac, 0x61, 0xdc, 0xfb, 0x51, 0xb2, 0xb9, 0x5a, 0xcd, 0xe4,
+	0x61, 0xd6, 0x1e, 0x7b, 0xe4, 0x8f, 0xe6, 0xd5, 0x57, 0x01, 0xe3, 0xe3, 0x15, 0x2f, 0x3e, 0x61,
+	0x32, 0xe4, 0x2c, 0xa4, 0xfc, 0x55, 0xa2, 0xfc, 0x23, 0xfb, 0x54, 0xfd, 0x7b, 0x3f, 0x47, 0xda,
+	0x9c, 0xa7, 0x4a, 0xef, 0xbf, 0x07, 0x00, 0x5e, 0xf5, 0x3b, 0xad, 0xc5, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go.
+// source: delegation_token.proto
+// DO NOT EDIT!
+
+/*
+Package tokenserver is a generated protocol buffer package.
+
+It is generated from these files:
+	delegation_token.proto
+	machine_token.proto
+	service_account.proto
+	token_file.proto
+
+It has these top-level messages:
+	DelegationTokenBody
+	DelegationTokenEnvelope
+	MachineTokenBody
+	MachineTokenEnvelope
+	ServiceAccount
+	TokenFile
+*/
+package tokenserver
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Kinds of tokens signed by the token server, see MachineTokenBody.TokenType.
+type DelegationTokenBody_TokenType int32
+
+const (
+	DelegationTokenBody_UNKNOWN_TOKEN_TYPE DelegationTokenBody_TokenType = 0
+	DelegationTokenBody_MACHINE_TOKEN      DelegationTokenBody_TokenType = 1
+	DelegationTokenBody_DELEGATION_TOKEN   DelegationTokenBody_TokenType = 2
+)
+
+var DelegationTokenBody_TokenType_name = map[int32]string{
+	0: "UNKNOWN_TOKEN_TYPE",
+	1: "MACHINE_TOKEN",
+	2: "DELEGATION_TOKEN",
+}
+var DelegationTokenBody_TokenType_value = map[string]int32{
+	"UNKNOWN_TOKEN_TYPE": 0,
+	"MACHINE_TOKEN":      1,
+	"DELEGATION_TOKEN":   2,
+}
+
+func (x DelegationTokenBody_TokenType) String() string {
+	return proto.EnumName(DelegationTokenBody_TokenType_name, int32(x))
+}
+func (DelegationTokenBody_TokenType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 0}
+}
+
+// DelegationTokenBody describes internal structure of the delegation token.
+//
+// A delegation token allows some service (the requestor) to call other LUCI
+// services on behalf of some end user (the delegated identity). Services that
+// accept the token authenticate such requests as coming from the delegated
+// identity.
+//
+// Like the machine token, it is put in HTTP headers, so we use unix timestamps
+// instead of google.protobuf.Timestamp to keep the body small.
+type DelegationTokenBody struct {
+	// Identity the token conveys, e.g. "user:someone@example.com". Required.
+	DelegatedIdentity string `protobuf:"bytes,1,opt,name=delegated_identity,json=delegatedIdentity" json:"delegated_identity,omitempty"`
+	// Identity of whoever requested the token, e.g. "user:cron@appspot...".
+	//
+	// Informational. It is recorded in the token to aid debugging and auditing.
+	RequestorIdentity string `protobuf:"bytes,2,opt,name=requestor_identity,json=requestorIdentity" json:"requestor_identity,omitempty"`
+	// Service account email of the token server that signed this token.
+	//
+	// When verifying the token backends will check that the issuer is in
+	// "auth-token-servers" group.
+	IssuedBy string `protobuf:"bytes,3,opt,name=issued_by,json=issuedBy" json:"issued_by,omitempty"`
+	// Unix timestamp in seconds when this token was issued. Required.
+	IssuedAt uint64 `protobuf:"varint,4,opt,name=issued_at,json=issuedAt" json:"issued_at,omitempty"`
+	// Number of seconds the token is considered valid. Required.
+	Lifetime uint64 `protobuf:"varint,5,opt,name=lifetime" json:"lifetime,omitempty"`
+	// Identities of services that accept this token, e.g. "service:luci-dm".
+	//
+	// A special value "*" means the token is accepted by any service. Required.
+	Services []string `protobuf:"bytes,6,rep,name=services" json:"services,omitempty"`
+	// Kind of the token, always DELEGATION_TOKEN. Required.
+	TokenType DelegationTokenBody_TokenType `protobuf:"varint,7,opt,name=token_type,json=tokenType,enum=tokenserver.DelegationTokenBody_TokenType" json:"token_type,omitempty"`
+}
+
+func (m *DelegationTokenBody) Reset()                    { *m = DelegationTokenBody{} }
+func (m *DelegationTokenBody) String() string            { return proto.CompactTextString(m) }
+func (*DelegationTokenBody) ProtoMessage()               {}
+func (*DelegationTokenBody) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// DelegationTokenEnvelope is what is actually being serialized and represented
+// as a delegation token (after being encoded using base64 standard raw
+// encoding).
+type DelegationTokenEnvelope struct {
+	TokenBody []byte `protobuf:"bytes,1,opt,name=token_body,json=tokenBody,proto3" json:"token_body,omitempty"`
+	KeyId     string `protobuf:"bytes,2,opt,name=key_id,json=keyId" json:"key_id,omitempty"`
+	RsaSha256 []byte `protobuf:"bytes,3,opt,name=rsa_sha256,json=rsaSha256,proto3" json:"rsa_sha256,omitempty"`
+}
+
+func (m *DelegationTokenEnvelope) Reset()                    { *m = DelegationTokenEnvelope{} }
+func (m *DelegationTokenEnvelope) String() string            { return proto.CompactTextString(m) }
+func (*DelegationTokenEnvelope) ProtoMessage()               {}
+func (*DelegationTokenEnvelope) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func init() {
+	proto.RegisterType((*DelegationTokenBody)(nil), "tokenserver.DelegationTokenBody")
+	proto.RegisterType((*DelegationTokenEnvelope)(nil), "tokenserver.DelegationTokenEnvelope")
+	proto.RegisterEnum("tokenserver.DelegationTokenBody_TokenType", DelegationTokenBody_TokenType_name, DelegationTokenBody_TokenType_value)
+}
+
+var fileDescriptor0 = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x92, 0xdd, 0x4b, 0xe3, 0x40,
+	0x14, 0xc5, 0x37, 0xfd, 0xda, 0x66, 0xb6, 0xbb, 0xa4, 0xb3, 0x5a, 0x83, 0x22, 0x84, 0x3e, 0x15,
+	0xc1, 0x3c, 0x54, 0xf4, 0xbd, 0xb5, 0x41, 0x43, 0x6b, 0x2a, 0x35, 0x22, 0x3e, 0x0d, 0xa9, 0x73,
+	0xd5, 0xa1, 0x35, 0x13, 0x33, 0xd3, 0xc2, 0xfc, 0xf3, 0x22, 0x99, 0x7c, 0x58, 0xc4, 0xb7, 0x7b,
+	0xcf, 0xef, 0xdc, 0xe4, 0xde, 0x93, 0xa0, 0x1e, 0x85, 0x35, 0xbc, 0x44, 0x92, 0xf1, 0x98, 0x48,
+	0xbe, 0x82, 0xd8, 0x4d, 0x52, 0x2e, 0x39, 0xfe, 0xa3, 0x1b, 0x01, 0xe9, 0x16, 0xd2, 0xfe, 0x47,
+	0x0d, 0xfd, 0x9f, 0x54, 0xbe, 0x30, 0x23, 0x63, 0x4e, 0x15, 0x3e, 0x45, 0xb8, 0x18, 0x07, 0x4a,
+	0x18, 0x85, 0x58, 0x32, 0xa9, 0x6c, 0xc3, 0x31, 0x06, 0xe6, 0xa2, 0x5b, 0x11, 0xbf, 0x00, 0x99,
+	0x3d, 0x85, 0xf7, 0x0d, 0x08, 0xc9, 0xd3, 0x2f, 0x7b, 0x2d, 0xb7, 0x57, 0xa4, 0xb2, 0x1f, 0x21,
+	0x93, 0x09, 0xb1, 0x01, 0x4a, 0x96, 0xca, 0xae, 0x6b, 0x57, 0x3b, 0x17, 0xc6, 0xbb, 0x30, 0x92,
+	0x76, 0xc3, 0x31, 0x06, 0x8d, 0x12, 0x8e, 0x24, 0x3e, 0x44, 0xed, 0x35, 0x7b, 0x06, 0xc9, 0xde,
+	0xc0, 0x6e, 0xe6, 0xac, 0xec, 0x33, 0x96, 0x5d, 0xc5, 0x9e, 0x40, 0xd8, 0x2d, 0xa7, 0x9e, 0x3d,
+	0xb4, 0xec, 0xb1, 0x8f, 0x90, 0x3e, 0x9b, 0x48, 0x95, 0x80, 0xfd, 0xdb, 0x31, 0x06, 0xff, 0x86,
+	0x27, 0xee, 0x4e, 0x12, 0xee, 0x0f, 0x29, 0xb8, 0xba, 0x0a, 0x55, 0x02, 0x0b, 0x53, 0x96, 0x65,
+	0x7f, 0x86, 0xcc, 0x4a, 0xc7, 0x3d, 0x84, 0xef, 0x83, 0x69, 0x30, 0x7f, 0x08, 0x48, 0x38, 0x9f,
+	0x7a, 0x01, 0x09, 0x1f, 0x6f, 0x3d, 0xeb, 0x17, 0xee, 0xa2, 0xbf, 0x37, 0xa3, 0xcb, 0x6b, 0x3f,
+	0xf0, 0x72, 0xdd, 0x32, 0xf0, 0x1e, 0xb2, 0x26, 0xde, 0xcc, 0xbb, 0x1a, 0x85, 0xfe, 0xbc, 0x70,
+	0x5b, 0xb5, 0x7e, 0x8c, 0x0e, 0xbe, 0xbd, 0xd9, 0x8b, 0xb7, 0xb0, 0xe6, 0x09, 0xe0, 0xe3, 0x72,
+	0xe7, 0x25, 0xa7, 0x79, 0xf6, 0x9d, 0x62, 0x0f, 0xfd, 0x89, 0xf6, 0x51, 0x6b, 0x05, 0x8a, 0x30,
+	0x5a, 0xe4, 0xdc, 0x5c, 0x81, 0xf2, 0x69, 0x36, 0x95, 0x8a, 0x88, 0x88, 0xd7, 0x68, 0x78, 0x7e,
+	0xa1, 0xc3, 0xed, 0x2c, 0xcc, 0x54, 0x44, 0x77, 0x5a, 0x58, 0xb6, 0xf4, 0x4f, 0x70, 0xf6, 0x39,
+	0x00, 0x91, 0xcf, 0x3b, 0x21, 0x1e, 0x02, 0x00, 0x00,
+}
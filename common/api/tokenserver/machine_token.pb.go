@@ -2,20 +2,6 @@
 // source: machine_token.proto
 // DO NOT EDIT!
 
-/*
-Package tokenserver is a generated protocol buffer package.
-
-It is generated from these files:
-	machine_token.proto
-	service_account.proto
-	token_file.proto
-
-It has these top-level messages:
-	MachineTokenBody
-	MachineTokenEnvelope
-	ServiceAccount
-	TokenFile
-*/
 package tokenserver
 
 import proto "github.com/golang/protobuf/proto"
@@ -27,11 +13,36 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+// Kinds of tokens signed by the token server.
+//
+// Machine and delegation tokens are signed by the same key. The enum and the
+// field number match DelegationTokenBody's, so a token of one kind can't be
+// passed off as a token of the other kind.
+type MachineTokenBody_TokenType int32
+
+const (
+	MachineTokenBody_UNKNOWN_TOKEN_TYPE MachineTokenBody_TokenType = 0
+	MachineTokenBody_MACHINE_TOKEN      MachineTokenBody_TokenType = 1
+	MachineTokenBody_DELEGATION_TOKEN   MachineTokenBody_TokenType = 2
+)
+
+var MachineTokenBody_TokenType_name = map[int32]string{
+	0: "UNKNOWN_TOKEN_TYPE",
+	1: "MACHINE_TOKEN",
+	2: "DELEGATION_TOKEN",
+}
+var MachineTokenBody_TokenType_value = map[string]int32{
+	"UNKNOWN_TOKEN_TYPE": 0,
+	"MACHINE_TOKEN":      1,
+	"DELEGATION_TOKEN":   2,
+}
+
+func (x MachineTokenBody_TokenType) String() string {
+	return proto.EnumName(MachineTokenBody_TokenType_name, int32(x))
+}
+func (MachineTokenBody_TokenType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{0, 0}
+}
 
 // MachineTokenBody describes internal structure of the machine token.
 //
@@ -67,12 +78,17 @@ type MachineTokenBody struct {
 	// the given certificate is in CRL). Revocation checks are optional, most
 	// callers can rely on expiration checks only.
 	CertSn uint64 `protobuf:"varint,6,opt,name=cert_sn,json=certSn" json:"cert_sn,omitempty"`
+	// Kind of the token, always MACHINE_TOKEN.
+	//
+	// Tokens without it (UNKNOWN_TOKEN_TYPE) are accepted for compatibility with
+	// tokens minted before the field was added.
+	TokenType MachineTokenBody_TokenType `protobuf:"varint,7,opt,name=token_type,json=tokenType,enum=tokenserver.MachineTokenBody_TokenType" json:"token_type,omitempty"`
 }
 
 func (m *MachineTokenBody) Reset()                    { *m = MachineTokenBody{} }
 func (m *MachineTokenBody) String() string            { return proto.CompactTextString(m) }
 func (*MachineTokenBody) ProtoMessage()               {}
-func (*MachineTokenBody) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (*MachineTokenBody) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
 
 // MachineTokenEnvelope is what is actually being serialized and represented
 // as a machine token (after being encoded using base64 standard raw encoding).
@@ -87,29 +103,36 @@ type MachineTokenEnvelope struct {
 func (m *MachineTokenEnvelope) Reset()                    { *m = MachineTokenEnvelope{} }
 func (m *MachineTokenEnvelope) String() string            { return proto.CompactTextString(m) }
 func (*MachineTokenEnvelope) ProtoMessage()               {}
-func (*MachineTokenEnvelope) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*MachineTokenEnvelope) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
 
 func init() {
 	proto.RegisterType((*MachineTokenBody)(nil), "tokenserver.MachineTokenBody")
 	proto.RegisterType((*MachineTokenEnvelope)(nil), "tokenserver.MachineTokenEnvelope")
+	proto.RegisterEnum("tokenserver.MachineTokenBody_TokenType", MachineTokenBody_TokenType_name, MachineTokenBody_TokenType_value)
 }
 
-var fileDescriptor0 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x15, 0x9a, 0x84, 0xe6, 0xda, 0x01, 0xb9, 0x20, 0x2c, 0x10, 0x12, 0x74, 0x62, 0x62,
-	0x00, 0xc1, 0x4e, 0x25, 0x90, 0x18, 0x58, 0x52, 0x76, 0xcb, 0x89, 0xaf, 0xaa, 0x95, 0xd6, 0x2e,
-	0xb6, 0xa9, 0x94, 0x47, 0xe3, 0xed, 0xb8, 0x38, 0xa4, 0xea, 0xf8, 0x7f, 0x9f, 0x4f, 0xf7, 0x9f,
-	0x61, 0xb6, 0x95, 0xf5, 0x5a, 0x1b, 0x14, 0xc1, 0x36, 0x68, 0x1e, 0x76, 0xce, 0x06, 0xcb, 0x26,
-	0x31, 0x78, 0x74, 0x7b, 0x74, 0xf3, 0xdf, 0x04, 0xce, 0x3e, 0xfb, 0x47, 0x5f, 0x1d, 0x5e, 0x58,
-	0xd5, 0xb2, 0x3b, 0x98, 0x0e, 0x83, 0xab, 0x6f, 0x65, 0x78, 0x72, 0x9b, 0xdc, 0x17, 0xe5, 0xe4,
-	0x9f, 0xbd, 0x13, 0x62, 0xd7, 0x50, 0x68, 0xef, 0x7f, 0x50, 0x89, 0xaa, 0xe5, 0x27, 0xd1, 0x8f,
-	0x7b, 0xb0, 0x68, 0x8f, 0xa4, 0x0c, 0x7c, 0x44, 0x32, 0x1d, 0xe4, 0x6b, 0x60, 0x57, 0x30, 0xde,
-	0xe8, 0x15, 0x06, 0xbd, 0x45, 0x9e, 0xf6, 0x6e, 0xc8, 0x6c, 0x06, 0x59, 0x2d, 0x85, 0x56, 0x3c,
-	0x23, 0x31, 0x2a, 0xd3, 0x5a, 0x7e, 0x28, 0x76, 0x09, 0xa7, 0x35, 0xba, 0x20, 0xbc, 0xe1, 0x79,
-	0x7c, 0x9f, 0x77, 0x71, 0x69, 0xe6, 0x0d, 0x9c, 0x1f, 0x57, 0x7f, 0x33, 0x7b, 0xdc, 0xd8, 0x1d,
-	0xb2, 0x1b, 0x80, 0x78, 0xa2, 0xa8, 0xe8, 0x98, 0x58, 0x7e, 0x5a, 0x16, 0xe1, 0x70, 0xdd, 0x05,
-	0xe4, 0x0d, 0xb6, 0xdd, 0x96, 0xbe, 0x77, 0x46, 0x89, 0xd6, 0xd0, 0x94, 0xf3, 0x52, 0xf8, 0xb5,
-	0x7c, 0x7c, 0x7e, 0x89, 0xad, 0x69, 0x8a, 0xc8, 0x32, 0x82, 0x2a, 0x8f, 0x9f, 0xf7, 0xf4, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0xa3, 0x7a, 0xd2, 0xe2, 0x53, 0x01, 0x00, 0x00,
+var fileDescriptor1 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x5c, 0x91, 0x4f, 0x6f, 0xaa, 0x40,
+	0x14, 0xc5, 0x1f, 0xfe, 0x41, 0xb9, 0xfa, 0x5e, 0x78, 0xa3, 0x6d, 0x49, 0x9b, 0x26, 0xd4, 0x4d,
+	0x59, 0xb1, 0xb0, 0x69, 0xf7, 0xda, 0x62, 0x4b, 0x54, 0x6c, 0x90, 0xa6, 0xe9, 0x6a, 0x82, 0xcc,
+	0x18, 0x09, 0x0a, 0x08, 0x53, 0x93, 0xf9, 0x94, 0xfd, 0x4a, 0x0d, 0x83, 0x34, 0xa6, 0xbb, 0x7b,
+	0x7f, 0xe7, 0xde, 0xcc, 0x3d, 0x67, 0xa0, 0xb7, 0xf3, 0x83, 0x4d, 0x18, 0x53, 0xcc, 0x92, 0x88,
+	0xc6, 0x66, 0x9a, 0x25, 0x2c, 0x41, 0x1d, 0xd1, 0xe4, 0x34, 0x3b, 0xd0, 0x6c, 0xf0, 0x55, 0x03,
+	0x75, 0x5e, 0x0e, 0x79, 0x05, 0x1e, 0x27, 0x84, 0xa3, 0x1b, 0xe8, 0x56, 0x8b, 0xeb, 0x3d, 0x89,
+	0x35, 0x49, 0x97, 0x0c, 0xc5, 0xed, 0x1c, 0xd9, 0x64, 0x4f, 0x62, 0x74, 0x05, 0x4a, 0x98, 0xe7,
+	0x9f, 0x94, 0xe0, 0x15, 0xd7, 0x6a, 0x42, 0x6f, 0x97, 0x60, 0xcc, 0x4f, 0x44, 0x9f, 0x69, 0x75,
+	0x5d, 0x32, 0x1a, 0x95, 0x38, 0x62, 0xe8, 0x12, 0xda, 0xdb, 0x70, 0x4d, 0x59, 0xb8, 0xa3, 0x5a,
+	0xa3, 0xd4, 0xaa, 0x1e, 0xf5, 0xa0, 0x19, 0xf8, 0x38, 0x24, 0x5a, 0x53, 0x97, 0x8c, 0xba, 0xdb,
+	0x08, 0x7c, 0x9b, 0xa0, 0x0b, 0x68, 0x05, 0x34, 0x63, 0x38, 0x8f, 0x35, 0x59, 0xcc, 0xcb, 0x45,
+	0xbb, 0x8c, 0xd1, 0x04, 0x40, 0x58, 0xc1, 0x8c, 0xa7, 0x54, 0x6b, 0xe9, 0x92, 0xf1, 0x6f, 0x78,
+	0x6b, 0x9e, 0xb8, 0x33, 0x7f, 0x3b, 0x33, 0x45, 0xe5, 0xf1, 0x94, 0xba, 0x0a, 0xab, 0xca, 0xc1,
+	0x0c, 0x94, 0x1f, 0x8e, 0xce, 0x01, 0xbd, 0x39, 0x53, 0x67, 0xf1, 0xee, 0x60, 0x6f, 0x31, 0xb5,
+	0x1c, 0xec, 0x7d, 0xbc, 0x5a, 0xea, 0x1f, 0xf4, 0x1f, 0xfe, 0xce, 0x47, 0x8f, 0x2f, 0xb6, 0x63,
+	0x95, 0x5c, 0x95, 0x50, 0x1f, 0xd4, 0x27, 0x6b, 0x66, 0x3d, 0x8f, 0x3c, 0x7b, 0x71, 0x9c, 0x56,
+	0x6b, 0x83, 0x08, 0xfa, 0xa7, 0xcf, 0x5a, 0xf1, 0x81, 0x6e, 0x93, 0x94, 0xa2, 0xeb, 0xea, 0xda,
+	0x55, 0x42, 0xb8, 0x88, 0xb4, 0x7b, 0x3c, 0x42, 0x64, 0x7e, 0x06, 0x72, 0x44, 0x79, 0xe1, 0xbd,
+	0x4c, 0xb3, 0x19, 0x51, 0x6e, 0x93, 0x62, 0x2b, 0xcb, 0x7d, 0x9c, 0x6f, 0xfc, 0xe1, 0xfd, 0x83,
+	0xc8, 0xb2, 0xeb, 0x2a, 0x59, 0xee, 0x2f, 0x05, 0x58, 0xc9, 0xe2, 0x4b, 0xef, 0xbe, 0x07, 0x00,
+	0x7e, 0x45, 0x8a, 0x30, 0xe9, 0x01, 0x00, 0x00,
 }
@@ -10,684 +10,851 @@ func init() {
 			"tokenserver.minter.TokenMinter",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 212, 124, 109, 108, 91, 217,
-			149, 152, 222, 227, 135, 164, 107, 91, 150, 40, 91, 146, 105, 217,
-			190, 166, 63, 68, 121, 36, 202, 150, 199, 242, 120, 62, 178, 165,
-			40, 218, 98, 70, 34, 21, 146, 26, 143, 211, 89, 40, 79, 228,
-			163, 244, 106, 242, 61, 14, 223, 163, 100, 38, 109, 38, 77, 55,
-			232, 22, 105, 17, 4, 219, 34, 232, 143, 52, 187, 253, 151, 100,
-			138, 254, 108, 155, 108, 23, 104, 126, 164, 72, 145, 65, 177, 11,
-			116, 27, 244, 43, 11, 116, 129, 0, 69, 90, 244, 71, 128, 244,
-			71, 128, 246, 156, 115, 239, 125, 239, 145, 162, 198, 147, 221, 160,
-			192, 14, 146, 25, 157, 247, 238, 59, 247, 156, 115, 207, 61, 95,
-			247, 92, 178, 223, 93, 96, 215, 14, 28, 231, 160, 105, 174, 180,
-			59, 142, 231, 236, 119, 27, 43, 158, 213, 50, 93, 207, 104, 181,
-			51, 244, 40, 113, 94, 12, 200, 168, 1, 169, 55, 216, 120, 85,
-			141, 73, 204, 177, 81, 215, 172, 57, 118, 221, 157, 211, 184, 150,
-			142, 148, 21, 152, 184, 192, 98, 182, 97, 59, 238, 156, 14, 207,
-			99, 101, 1, 172, 87, 217, 116, 205, 105, 101, 6, 112, 174, 79,
-			248, 24, 119, 240, 209, 142, 246, 251, 154, 246, 127, 52, 237, 159,
-			234, 145, 39, 59, 235, 223, 209, 175, 62, 17, 227, 119, 228, 248,
-			204, 83, 179, 217, 124, 219, 118, 142, 237, 106, 175, 109, 186, 159,
-			254, 151, 183, 88, 60, 17, 189, 58, 210, 210, 216, 143, 207, 50,
-			237, 108, 34, 114, 117, 36, 177, 250, 111, 206, 114, 250, 160, 230,
-			52, 249, 122, 183, 209, 48, 59, 46, 95, 230, 2, 213, 130, 203,
-			235, 134, 103, 112, 203, 246, 204, 78, 237, 208, 176, 15, 76, 222,
-			112, 58, 45, 195, 99, 60, 231, 180, 123, 29, 235, 224, 208, 227,
-			171, 119, 239, 190, 38, 63, 224, 5, 187, 150, 225, 60, 219, 108,
-			114, 122, 231, 242, 142, 233, 154, 157, 35, 179, 158, 97, 252, 208,
-			243, 218, 238, 235, 43, 43, 117, 243, 200, 108, 58, 109, 152, 72,
-			113, 8, 204, 10, 209, 2, 17, 203, 251, 130, 136, 21, 198, 120,
-			217, 172, 91, 174, 215, 177, 246, 187, 158, 229, 216, 220, 176, 235,
-			188, 235, 154, 64, 14, 119, 157, 110, 167, 102, 210, 147, 125, 203,
-			54, 58, 61, 162, 203, 93, 226, 199, 150, 119, 200, 157, 14, 253,
-			215, 233, 2, 157, 45, 167, 110, 53, 172, 154, 129, 24, 150, 184,
-			209, 49, 57, 204, 220, 178, 60, 207, 172, 115, 152, 243, 200, 170,
-			195, 31, 222, 161, 225, 193, 191, 144, 187, 102, 211, 57, 182, 236,
-			3, 142, 235, 99, 225, 71, 46, 126, 4, 120, 76, 239, 117, 32,
-			9, 255, 185, 51, 64, 152, 203, 157, 134, 162, 168, 230, 212, 77,
-			222, 234, 186, 30, 112, 238, 25, 64, 41, 98, 53, 246, 157, 35,
-			124, 37, 37, 198, 184, 237, 120, 86, 205, 92, 130, 151, 150, 203,
-			155, 128, 10, 49, 132, 103, 180, 235, 3, 228, 192, 124, 181, 166,
-			1, 235, 223, 201, 156, 70, 4, 76, 22, 146, 133, 34, 2, 120,
-			172, 119, 129, 48, 159, 14, 22, 16, 242, 151, 162, 131, 113, 201,
-			93, 221, 169, 117, 91, 166, 237, 25, 106, 145, 86, 64, 254, 14,
-			188, 233, 112, 208, 20, 179, 99, 25, 77, 55, 16, 53, 45, 16,
-			188, 100, 60, 76, 189, 207, 84, 209, 180, 232, 75, 68, 108, 27,
-			45, 19, 9, 10, 235, 150, 237, 4, 239, 72, 238, 150, 231, 34,
-			71, 182, 64, 229, 128, 246, 182, 140, 30, 223, 55, 81, 83, 128,
-			120, 135, 155, 118, 29, 158, 154, 168, 20, 64, 68, 203, 241, 76,
-			46, 100, 2, 218, 89, 7, 234, 64, 57, 121, 3, 94, 48, 33,
-			5, 215, 105, 120, 199, 168, 38, 82, 131, 184, 219, 54, 107, 168,
-			65, 240, 149, 133, 138, 213, 65, 221, 177, 133, 22, 185, 46, 209,
-			206, 120, 117, 179, 80, 225, 149, 210, 227, 234, 211, 108, 57, 207,
-			225, 239, 157, 114, 233, 157, 194, 70, 126, 131, 175, 63, 131, 151,
-			121, 158, 43, 237, 60, 43, 23, 158, 108, 86, 249, 102, 105, 107,
-			35, 95, 174, 240, 108, 113, 3, 158, 22, 171, 229, 194, 250, 110,
-			181, 84, 174, 48, 158, 202, 86, 224, 211, 20, 189, 201, 22, 159,
-			241, 252, 187, 59, 229, 124, 165, 194, 75, 101, 94, 216, 222, 217,
-			42, 0, 54, 64, 95, 206, 22, 171, 133, 124, 101, 137, 23, 138,
-			185, 173, 221, 141, 66, 241, 201, 18, 7, 12, 188, 88, 170, 50,
-			190, 85, 216, 46, 84, 97, 92, 181, 180, 68, 211, 158, 252, 142,
-			151, 30, 243, 237, 124, 57, 183, 9, 96, 118, 189, 176, 85, 168,
-			62, 163, 9, 31, 23, 170, 69, 156, 236, 113, 169, 204, 120, 150,
-			239, 100, 203, 213, 66, 110, 119, 43, 91, 230, 59, 187, 229, 157,
-			82, 37, 207, 145, 179, 141, 66, 37, 183, 149, 45, 108, 231, 55,
-			50, 48, 63, 204, 201, 243, 239, 228, 139, 85, 94, 217, 204, 110,
-			109, 245, 51, 202, 120, 233, 105, 49, 95, 70, 234, 195, 108, 242,
-			245, 60, 80, 153, 93, 223, 202, 227, 84, 196, 231, 70, 161, 156,
-			207, 85, 145, 161, 224, 175, 28, 8, 15, 8, 220, 90, 98, 188,
-			178, 147, 207, 21, 224, 47, 144, 71, 30, 216, 201, 150, 159, 45,
-			73, 164, 149, 252, 103, 118, 97, 20, 188, 228, 27, 217, 237, 236,
-			19, 224, 46, 253, 50, 169, 192, 194, 228, 118, 203, 249, 109, 164,
-			26, 68, 81, 217, 93, 175, 84, 11, 213, 221, 106, 158, 63, 41,
-			149, 54, 72, 216, 149, 124, 249, 157, 66, 46, 95, 121, 131, 111,
-			149, 42, 36, 176, 221, 74, 30, 8, 217, 200, 86, 179, 52, 53,
-			224, 0, 113, 193, 123, 248, 123, 125, 183, 82, 32, 193, 21, 138,
-			213, 124, 185, 188, 187, 83, 45, 148, 138, 139, 176, 202, 79, 65,
-			50, 64, 101, 22, 190, 221, 32, 9, 151, 138, 200, 45, 234, 74,
-			190, 84, 126, 134, 104, 81, 14, 180, 2, 75, 252, 233, 102, 30,
-			158, 151, 81, 168, 36, 173, 44, 138, 161, 2, 82, 203, 85, 195,
-			195, 96, 66, 16, 34, 176, 20, 240, 201, 139, 249, 39, 91, 133,
-			39, 249, 98, 46, 143, 175, 75, 136, 230, 105, 161, 146, 95, 132,
-			5, 43, 84, 112, 64, 129, 38, 6, 29, 128, 73, 119, 137, 107,
-			92, 40, 160, 139, 137, 191, 67, 170, 187, 68, 235, 201, 11, 143,
-			121, 118, 227, 157, 2, 82, 46, 71, 131, 6, 84, 10, 82, 93,
-			72, 108, 185, 77, 41, 115, 80, 255, 49, 166, 233, 137, 8, 31,
-			155, 197, 191, 198, 18, 145, 212, 200, 27, 236, 12, 139, 142, 253,
-			108, 116, 68, 0, 103, 89, 12, 1, 24, 148, 26, 157, 101, 231,
-			88, 156, 160, 17, 1, 78, 176, 81, 1, 106, 2, 150, 131, 71,
-			1, 74, 190, 46, 49, 222, 24, 185, 38, 49, 106, 2, 16, 131,
-			112, 218, 27, 62, 70, 13, 49, 222, 240, 49, 106, 132, 241, 134,
-			143, 81, 139, 0, 148, 188, 42, 49, 222, 28, 89, 146, 24, 117,
-			1, 136, 65, 58, 66, 163, 211, 18, 163, 142, 24, 17, 20, 24,
-			117, 194, 136, 176, 28, 12, 52, 222, 156, 121, 69, 98, 188, 53,
-			178, 34, 49, 70, 4, 32, 6, 69, 0, 227, 173, 209, 203, 18,
-			99, 4, 49, 34, 40, 48, 70, 8, 35, 194, 114, 48, 96, 188,
-			117, 53, 35, 49, 222, 30, 73, 73, 140, 81, 1, 136, 65, 81,
-			192, 120, 123, 52, 41, 49, 70, 17, 35, 130, 2, 99, 148, 48,
-			34, 44, 7, 3, 49, 183, 175, 92, 151, 24, 23, 124, 174, 99,
-			2, 16, 131, 98, 128, 113, 97, 244, 166, 196, 24, 67, 140, 8,
-			10, 140, 49, 194, 136, 176, 28, 12, 24, 23, 22, 20, 215, 233,
-			145, 235, 18, 99, 92, 0, 98, 80, 28, 48, 166, 71, 231, 36,
-			198, 56, 98, 68, 80, 96, 140, 19, 70, 132, 229, 96, 224, 58,
-			125, 153, 179, 127, 48, 201, 244, 232, 72, 34, 106, 64, 140, 146,
-			252, 210, 36, 152, 33, 63, 226, 33, 79, 6, 177, 132, 13, 86,
-			219, 224, 109, 7, 66, 18, 242, 63, 240, 30, 254, 91, 55, 219,
-			96, 230, 225, 37, 122, 4, 195, 238, 137, 231, 159, 119, 108, 112,
-			51, 96, 179, 107, 70, 19, 94, 27, 157, 165, 0, 11, 24, 125,
-			3, 140, 189, 8, 195, 200, 207, 53, 58, 70, 45, 240, 230, 234,
-			5, 58, 107, 140, 201, 8, 198, 104, 198, 105, 138, 96, 4, 38,
-			223, 173, 230, 120, 190, 237, 212, 14, 105, 58, 48, 136, 64, 146,
-			11, 254, 6, 99, 0, 140, 84, 208, 95, 146, 167, 131, 0, 171,
-			105, 182, 193, 213, 242, 39, 29, 243, 192, 1, 143, 104, 243, 156,
-			164, 137, 31, 31, 90, 128, 193, 124, 1, 78, 5, 38, 68, 223,
-			22, 12, 82, 132, 51, 190, 111, 212, 158, 131, 91, 194, 17, 14,
-			239, 153, 240, 29, 48, 55, 56, 165, 225, 186, 221, 22, 206, 106,
-			64, 0, 6, 127, 116, 61, 147, 162, 23, 190, 118, 151, 249, 44,
-			53, 29, 251, 96, 137, 91, 25, 248, 186, 105, 26, 237, 128, 85,
-			24, 151, 114, 91, 128, 218, 172, 167, 192, 13, 138, 160, 200, 118,
-			194, 163, 192, 71, 26, 251, 224, 138, 97, 78, 219, 52, 113, 74,
-			8, 53, 68, 120, 216, 198, 120, 135, 92, 57, 47, 83, 160, 8,
-			99, 132, 91, 189, 123, 247, 238, 189, 101, 250, 95, 245, 238, 221,
-			215, 233, 127, 159, 69, 46, 30, 193, 63, 203, 247, 86, 151, 239,
-			223, 171, 174, 222, 127, 253, 193, 35, 248, 95, 230, 145, 250, 231,
-			179, 16, 17, 172, 247, 80, 224, 224, 216, 97, 93, 80, 148, 146,
-			164, 14, 162, 135, 72, 207, 4, 190, 221, 110, 199, 20, 79, 1,
-			172, 161, 196, 28, 251, 200, 236, 64, 48, 231, 48, 185, 170, 78,
-			139, 243, 242, 227, 28, 191, 127, 255, 254, 35, 12, 103, 77, 142,
-			40, 237, 3, 23, 102, 168, 152, 38, 255, 235, 42, 46, 61, 62,
-			62, 206, 88, 166, 215, 200, 56, 157, 131, 149, 78, 163, 134, 255,
-			199, 143, 50, 222, 11, 239, 183, 211, 159, 100, 212, 34, 134, 2,
-			249, 23, 160, 174, 32, 163, 123, 175, 67, 140, 220, 106, 195, 26,
-			132, 180, 152, 200, 1, 91, 90, 120, 151, 127, 14, 149, 38, 189,
-			248, 185, 140, 140, 42, 131, 65, 126, 114, 241, 134, 120, 19, 36,
-			27, 174, 233, 237, 201, 245, 74, 211, 231, 197, 221, 173, 173, 197,
-			197, 161, 227, 72, 109, 211, 119, 225, 101, 64, 211, 234, 203, 104,
-			58, 48, 61, 196, 226, 52, 234, 70, 47, 68, 27, 72, 12, 226,
-			37, 154, 224, 200, 104, 114, 239, 72, 206, 216, 55, 252, 182, 119,
-			180, 196, 137, 160, 55, 254, 162, 44, 29, 101, 188, 35, 132, 62,
-			142, 35, 49, 8, 162, 187, 26, 132, 139, 247, 64, 185, 250, 56,
-			188, 127, 42, 135, 79, 45, 251, 254, 42, 255, 220, 19, 211, 171,
-			244, 92, 207, 108, 225, 235, 172, 251, 216, 106, 154, 213, 254, 133,
-			120, 92, 216, 202, 87, 33, 196, 225, 13, 79, 146, 113, 218, 55,
-			183, 27, 158, 162, 116, 23, 220, 255, 218, 171, 64, 112, 237, 185,
-			203, 223, 226, 233, 116, 90, 60, 89, 108, 120, 153, 250, 241, 38,
-			68, 219, 27, 160, 122, 248, 213, 34, 127, 243, 77, 126, 127, 117,
-			145, 255, 77, 78, 239, 182, 156, 99, 245, 74, 201, 109, 101, 5,
-			76, 31, 208, 91, 119, 142, 93, 66, 137, 155, 9, 88, 13, 153,
-			34, 55, 227, 15, 48, 201, 4, 221, 91, 59, 185, 203, 124, 108,
-			248, 249, 189, 181, 87, 95, 125, 245, 225, 253, 53, 64, 163, 182,
-			252, 190, 9, 219, 215, 228, 187, 182, 245, 66, 97, 121, 244, 240,
-			238, 32, 150, 204, 95, 108, 49, 211, 130, 127, 16, 133, 16, 202,
-			10, 45, 22, 254, 179, 8, 9, 102, 136, 156, 151, 104, 48, 226,
-			65, 113, 41, 60, 183, 66, 120, 72, 1, 22, 251, 20, 224, 213,
-			83, 21, 224, 211, 198, 145, 193, 63, 39, 22, 50, 83, 235, 118,
-			58, 224, 6, 112, 200, 182, 213, 132, 212, 39, 164, 0, 104, 33,
-			193, 124, 226, 83, 88, 202, 211, 63, 248, 24, 53, 135, 239, 252,
-			167, 25, 219, 60, 94, 239, 90, 77, 72, 54, 210, 139, 200, 88,
-			69, 74, 72, 78, 33, 4, 179, 40, 112, 225, 63, 56, 166, 40,
-			120, 7, 211, 138, 156, 203, 145, 130, 117, 201, 54, 73, 96, 49,
-			179, 143, 152, 137, 150, 64, 6, 15, 78, 149, 129, 228, 66, 249,
-			77, 190, 211, 131, 36, 199, 86, 140, 219, 206, 49, 16, 78, 206,
-			76, 24, 39, 185, 251, 165, 186, 188, 133, 150, 62, 13, 131, 228,
-			115, 90, 31, 249, 20, 31, 195, 178, 202, 161, 130, 196, 59, 119,
-			30, 45, 14, 172, 107, 88, 46, 105, 57, 248, 45, 249, 223, 37,
-			129, 240, 45, 250, 247, 34, 163, 127, 34, 81, 140, 20, 140, 177,
-			41, 246, 7, 26, 139, 70, 41, 102, 108, 232, 23, 146, 191, 167,
-			65, 250, 235, 7, 4, 138, 64, 112, 220, 232, 147, 137, 57, 240,
-			190, 181, 176, 106, 179, 225, 186, 205, 183, 49, 77, 134, 116, 145,
-			196, 67, 255, 58, 197, 95, 177, 97, 14, 235, 179, 192, 124, 173,
-			9, 174, 254, 200, 4, 33, 158, 99, 49, 36, 49, 154, 136, 54,
-			116, 131, 130, 68, 4, 99, 72, 242, 168, 130, 128, 157, 198, 216,
-			121, 5, 65, 40, 213, 72, 76, 179, 63, 23, 204, 193, 187, 166,
-			158, 72, 254, 169, 198, 139, 142, 189, 108, 155, 7, 224, 80, 143,
-			204, 254, 200, 196, 144, 220, 114, 116, 206, 195, 34, 147, 12, 228,
-			208, 226, 67, 229, 243, 57, 216, 236, 46, 68, 2, 148, 122, 7,
-			200, 168, 64, 224, 122, 160, 90, 252, 208, 128, 105, 236, 240, 156,
-			98, 109, 197, 135, 76, 120, 216, 154, 211, 5, 205, 1, 139, 129,
-			113, 136, 10, 190, 6, 4, 120, 87, 58, 246, 37, 249, 127, 54,
-			68, 62, 26, 200, 167, 169, 55, 46, 72, 25, 104, 49, 228, 90,
-			201, 71, 67, 25, 140, 157, 83, 16, 200, 167, 57, 57, 181, 31,
-			167, 234, 208, 125, 246, 199, 51, 108, 253, 0, 184, 232, 238, 83,
-			205, 168, 217, 173, 89, 244, 175, 229, 3, 103, 5, 30, 180, 28,
-			123, 197, 104, 91, 43, 158, 243, 28, 66, 3, 172, 59, 117, 86,
-			90, 70, 237, 208, 178, 205, 61, 122, 38, 171, 117, 103, 66, 3,
-			82, 255, 74, 99, 147, 219, 98, 80, 21, 31, 175, 59, 245, 94,
-			2, 194, 88, 245, 97, 227, 253, 186, 77, 101, 187, 241, 242, 25,
-			249, 236, 49, 60, 74, 92, 102, 227, 144, 255, 119, 205, 250, 222,
-			126, 143, 202, 119, 227, 229, 49, 241, 96, 189, 23, 122, 105, 120,
-			115, 17, 120, 25, 85, 47, 179, 94, 34, 201, 198, 154, 86, 195,
-			68, 241, 205, 69, 197, 59, 5, 131, 46, 196, 106, 198, 158, 85,
-			159, 139, 81, 161, 48, 90, 51, 10, 245, 196, 44, 27, 173, 65,
-			80, 179, 231, 218, 115, 113, 26, 31, 71, 176, 98, 167, 158, 179,
-			11, 97, 210, 243, 182, 168, 172, 37, 174, 48, 70, 44, 238, 237,
-			3, 51, 68, 252, 217, 242, 184, 231, 115, 119, 145, 197, 159, 155,
-			61, 156, 69, 208, 29, 3, 8, 166, 129, 175, 58, 174, 177, 231,
-			30, 26, 171, 15, 214, 136, 106, 248, 10, 158, 84, 232, 193, 167,
-			127, 60, 141, 245, 195, 232, 200, 35, 141, 125, 71, 163, 250, 33,
-			196, 234, 171, 223, 210, 250, 74, 129, 247, 214, 120, 21, 66, 216,
-			220, 33, 104, 131, 213, 109, 241, 108, 23, 12, 76, 7, 188, 213,
-			240, 154, 224, 174, 75, 21, 30, 89, 121, 9, 42, 104, 0, 30,
-			56, 176, 58, 54, 132, 152, 251, 61, 80, 250, 245, 202, 198, 178,
-			235, 245, 192, 184, 53, 173, 26, 174, 157, 212, 73, 8, 249, 246,
-			65, 209, 27, 160, 155, 117, 85, 144, 218, 130, 228, 189, 88, 1,
-			255, 13, 126, 218, 207, 78, 227, 99, 211, 236, 127, 232, 34, 191,
-			152, 24, 89, 209, 146, 255, 85, 231, 131, 203, 206, 235, 166, 91,
-			235, 88, 251, 166, 43, 98, 90, 27, 98, 29, 17, 250, 96, 152,
-			73, 116, 154, 92, 234, 0, 23, 10, 133, 181, 159, 67, 9, 192,
-			6, 3, 38, 97, 43, 128, 229, 69, 90, 54, 171, 213, 29, 126,
-			104, 26, 117, 172, 169, 98, 48, 106, 1, 243, 184, 34, 220, 61,
-			116, 186, 205, 186, 189, 64, 27, 199, 115, 32, 198, 54, 58, 7,
-			64, 43, 127, 76, 229, 45, 12, 114, 77, 195, 133, 44, 227, 152,
-			234, 88, 188, 139, 102, 204, 55, 162, 72, 29, 248, 36, 163, 142,
-			36, 13, 20, 137, 51, 190, 121, 101, 28, 108, 50, 133, 233, 20,
-			164, 183, 172, 90, 71, 25, 11, 48, 156, 53, 11, 75, 87, 139,
-			75, 68, 23, 229, 13, 32, 121, 80, 42, 81, 43, 5, 243, 73,
-			117, 59, 110, 119, 91, 251, 64, 61, 163, 236, 192, 109, 25, 232,
-			134, 14, 121, 23, 164, 3, 193, 14, 202, 232, 0, 121, 131, 137,
-			40, 44, 175, 131, 5, 216, 111, 58, 251, 46, 6, 194, 202, 120,
-			79, 140, 205, 177, 127, 236, 27, 239, 105, 61, 153, 252, 170, 166,
-			36, 207, 45, 76, 218, 44, 175, 39, 52, 64, 72, 145, 66, 248,
-			30, 96, 85, 146, 126, 252, 153, 141, 34, 133, 214, 50, 219, 121,
-			225, 161, 13, 147, 101, 59, 208, 141, 28, 237, 124, 94, 148, 229,
-			66, 163, 143, 17, 42, 3, 26, 152, 52, 238, 27, 46, 230, 36,
-			78, 135, 209, 58, 170, 245, 11, 140, 246, 180, 62, 145, 12, 25,
-			237, 105, 125, 44, 100, 180, 167, 199, 47, 134, 140, 246, 244, 220,
-			37, 246, 45, 223, 104, 95, 210, 103, 147, 95, 215, 32, 155, 232,
-			28, 89, 88, 149, 174, 9, 83, 105, 182, 12, 171, 41, 150, 211,
-			181, 14, 108, 170, 51, 43, 46, 145, 157, 167, 135, 192, 45, 40,
-			185, 213, 232, 201, 84, 81, 74, 0, 147, 61, 202, 6, 73, 161,
-			106, 135, 38, 68, 128, 126, 137, 154, 204, 72, 7, 5, 97, 217,
-			140, 167, 12, 216, 96, 203, 244, 217, 178, 48, 104, 110, 138, 31,
-			116, 156, 110, 187, 207, 222, 94, 210, 167, 147, 33, 123, 123, 201,
-			103, 13, 237, 237, 165, 241, 68, 200, 222, 94, 186, 56, 195, 138,
-			196, 25, 236, 154, 121, 224, 44, 43, 188, 104, 224, 195, 177, 254,
-			46, 61, 238, 49, 178, 16, 90, 187, 99, 144, 180, 176, 115, 144,
-			6, 154, 239, 119, 173, 14, 110, 115, 73, 136, 14, 132, 204, 235,
-			151, 102, 229, 100, 122, 12, 241, 43, 66, 80, 142, 243, 62, 33,
-			58, 16, 50, 15, 132, 116, 136, 16, 0, 174, 3, 33, 38, 47,
-			146, 58, 134, 19, 244, 64, 104, 64, 3, 60, 114, 65, 163, 96,
-			78, 244, 92, 22, 206, 12, 22, 166, 11, 90, 219, 227, 24, 105,
-			102, 96, 137, 60, 180, 39, 193, 87, 66, 102, 67, 136, 141, 0,
-			177, 215, 245, 121, 69, 108, 36, 134, 52, 40, 98, 35, 64, 236,
-			245, 241, 41, 5, 33, 125, 64, 172, 212, 114, 172, 213, 128, 23,
-			7, 45, 47, 212, 133, 62, 230, 178, 98, 249, 132, 100, 124, 11,
-			114, 66, 77, 193, 119, 182, 140, 231, 230, 128, 154, 128, 125, 1,
-			19, 80, 216, 16, 41, 122, 221, 108, 88, 168, 74, 104, 235, 66,
-			28, 32, 239, 13, 235, 128, 167, 143, 44, 3, 205, 197, 251, 93,
-			19, 236, 59, 152, 64, 179, 89, 95, 244, 153, 138, 2, 83, 183,
-			245, 235, 138, 169, 104, 12, 73, 85, 174, 55, 138, 117, 35, 223,
-			245, 82, 221, 104, 114, 138, 253, 103, 157, 152, 130, 145, 25, 253,
-			98, 242, 35, 29, 181, 60, 176, 12, 131, 70, 241, 19, 178, 68,
-			46, 142, 76, 143, 244, 107, 240, 10, 114, 73, 44, 252, 11, 218,
-			97, 193, 132, 105, 104, 136, 197, 10, 225, 21, 38, 75, 25, 126,
-			53, 137, 216, 36, 104, 232, 194, 36, 116, 204, 35, 71, 28, 253,
-			240, 52, 186, 17, 247, 185, 40, 36, 132, 228, 6, 26, 140, 243,
-			10, 155, 112, 0, 113, 138, 221, 135, 129, 182, 25, 207, 149, 183,
-			22, 81, 71, 124, 108, 52, 157, 88, 16, 167, 141, 79, 140, 230,
-			18, 111, 57, 174, 135, 188, 53, 155, 104, 20, 145, 194, 14, 50,
-			2, 195, 205, 23, 109, 171, 211, 247, 165, 99, 55, 123, 254, 178,
-			196, 96, 89, 50, 250, 109, 165, 252, 49, 18, 182, 210, 181, 24,
-			44, 75, 102, 124, 82, 65, 176, 44, 153, 233, 11, 236, 127, 107,
-			12, 23, 44, 250, 0, 220, 113, 242, 191, 107, 124, 88, 24, 128,
-			196, 31, 11, 189, 3, 155, 228, 137, 125, 176, 111, 162, 8, 132,
-			121, 183, 62, 111, 138, 85, 8, 149, 197, 152, 48, 150, 125, 94,
-			142, 167, 141, 6, 184, 67, 249, 109, 127, 129, 11, 172, 170, 9,
-			190, 0, 44, 3, 22, 170, 0, 149, 113, 44, 70, 192, 75, 50,
-			218, 101, 211, 237, 54, 189, 64, 238, 105, 138, 8, 235, 161, 111,
-			253, 225, 72, 104, 87, 238, 215, 47, 62, 128, 68, 117, 191, 135,
-			21, 44, 204, 199, 164, 67, 65, 115, 245, 96, 108, 158, 221, 1,
-			165, 164, 114, 239, 154, 62, 155, 186, 18, 102, 103, 208, 167, 11,
-			25, 107, 100, 224, 215, 244, 7, 87, 73, 142, 26, 25, 248, 53,
-			169, 250, 162, 80, 188, 54, 150, 80, 16, 200, 120, 13, 246, 243,
-			95, 163, 73, 224, 213, 67, 253, 66, 234, 62, 40, 164, 216, 205,
-			125, 234, 211, 238, 88, 71, 168, 39, 16, 65, 9, 93, 68, 21,
-			68, 107, 15, 252, 168, 169, 209, 0, 63, 212, 215, 102, 37, 122,
-			52, 192, 15, 229, 242, 106, 100, 128, 31, 142, 159, 87, 16, 76,
-			253, 16, 130, 192, 52, 77, 13, 6, 248, 53, 224, 239, 50, 33,
-			52, 84, 16, 178, 16, 132, 118, 11, 106, 10, 52, 173, 175, 233,
-			15, 47, 72, 52, 184, 93, 95, 243, 185, 67, 22, 94, 243, 185,
-			67, 107, 250, 218, 197, 25, 63, 166, 254, 179, 179, 108, 227, 215,
-			140, 169, 93, 225, 232, 246, 164, 163, 27, 18, 85, 39, 95, 118,
-			98, 158, 250, 191, 26, 155, 144, 14, 51, 43, 208, 96, 252, 9,
-			239, 254, 134, 89, 243, 48, 52, 21, 33, 247, 184, 124, 2, 225,
-			41, 196, 212, 190, 97, 83, 1, 183, 120, 0, 47, 47, 176, 24,
-			185, 91, 10, 91, 33, 162, 37, 0, 195, 248, 186, 229, 182, 155,
-			70, 111, 15, 15, 12, 41, 218, 134, 48, 94, 62, 195, 128, 33,
-			145, 102, 147, 14, 250, 208, 213, 189, 90, 211, 130, 29, 160, 98,
-			239, 241, 242, 132, 120, 158, 163, 199, 48, 69, 130, 69, 41, 23,
-			136, 211, 91, 250, 59, 241, 58, 132, 204, 230, 129, 5, 209, 24,
-			248, 142, 185, 81, 120, 115, 102, 53, 57, 120, 112, 31, 196, 100,
-			229, 208, 232, 79, 255, 116, 92, 196, 211, 115, 127, 101, 227, 233,
-			113, 166, 71, 96, 15, 142, 141, 166, 49, 61, 167, 208, 250, 28,
-			176, 147, 252, 135, 126, 44, 36, 151, 54, 20, 88, 131, 55, 108,
-			58, 221, 58, 47, 100, 183, 253, 128, 73, 142, 66, 107, 129, 21,
-			89, 85, 106, 173, 225, 192, 112, 143, 128, 101, 180, 86, 58, 102,
-			3, 164, 7, 185, 253, 10, 214, 134, 87, 142, 238, 173, 72, 13,
-			113, 51, 110, 223, 156, 238, 141, 126, 26, 130, 136, 244, 220, 24,
-			164, 145, 42, 32, 157, 208, 231, 82, 15, 68, 96, 85, 87, 222,
-			76, 98, 20, 34, 113, 142, 109, 17, 102, 184, 253, 225, 93, 56,
-			114, 156, 208, 207, 205, 133, 34, 199, 137, 190, 200, 113, 98, 124,
-			58, 20, 57, 78, 204, 204, 178, 79, 169, 192, 241, 60, 236, 238,
-			123, 132, 92, 40, 50, 25, 99, 87, 214, 216, 125, 114, 78, 153,
-			24, 205, 202, 121, 125, 98, 46, 20, 215, 157, 239, 139, 235, 206,
-			247, 197, 117, 231, 193, 162, 61, 84, 113, 221, 164, 62, 157, 186,
-			67, 200, 69, 144, 106, 212, 235, 32, 78, 247, 37, 51, 162, 149,
-			153, 212, 207, 135, 3, 184, 201, 190, 0, 110, 114, 124, 34, 20,
-			192, 77, 78, 37, 216, 3, 21, 192, 77, 233, 201, 84, 90, 244,
-			11, 144, 46, 144, 215, 124, 201, 124, 24, 131, 77, 233, 147, 211,
-			161, 24, 108, 170, 47, 6, 155, 242, 131, 114, 140, 193, 166, 32,
-			40, 127, 67, 133, 96, 9, 253, 106, 42, 67, 200, 75, 184, 103,
-			86, 185, 216, 222, 40, 214, 134, 236, 33, 56, 101, 86, 12, 146,
-			18, 250, 84, 50, 20, 36, 37, 252, 89, 49, 72, 74, 140, 95,
-			10, 5, 73, 137, 249, 43, 236, 133, 138, 145, 102, 244, 68, 106,
-			158, 18, 22, 113, 18, 133, 169, 149, 83, 179, 12, 204, 86, 14,
-			49, 60, 88, 221, 166, 253, 76, 113, 25, 22, 96, 155, 206, 177,
-			104, 74, 81, 253, 17, 67, 162, 83, 204, 27, 205, 102, 99, 9,
-			219, 53, 240, 189, 191, 129, 250, 2, 8, 152, 250, 106, 40, 128,
-			152, 233, 11, 32, 102, 198, 207, 133, 2, 136, 25, 136, 235, 22,
-			137, 228, 120, 34, 50, 171, 191, 2, 36, 203, 64, 62, 72, 94,
-			48, 146, 7, 173, 128, 128, 64, 206, 17, 135, 57, 102, 245, 25,
-			165, 82, 113, 250, 244, 178, 130, 96, 142, 217, 249, 219, 10, 130,
-			57, 102, 23, 239, 248, 46, 230, 191, 152, 44, 33, 156, 86, 139,
-			242, 106, 233, 48, 18, 33, 135, 145, 17, 111, 94, 234, 55, 146,
-			191, 129, 250, 79, 242, 55, 226, 239, 82, 239, 179, 217, 109, 32,
-			58, 28, 112, 96, 22, 1, 212, 38, 94, 99, 115, 65, 72, 34,
-			166, 222, 235, 136, 119, 178, 26, 51, 19, 188, 239, 251, 114, 158,
-			141, 251, 206, 158, 156, 220, 217, 114, 240, 32, 245, 161, 206, 166,
-			135, 205, 199, 217, 153, 80, 208, 42, 167, 8, 63, 74, 60, 101,
-			211, 62, 154, 61, 163, 137, 231, 139, 222, 97, 139, 102, 152, 88,
-			189, 157, 57, 185, 18, 153, 138, 26, 158, 85, 163, 203, 9, 247,
-			196, 179, 196, 195, 193, 74, 215, 199, 59, 192, 160, 10, 246, 166,
-			170, 81, 121, 189, 182, 240, 204, 19, 171, 87, 134, 17, 66, 156,
-			98, 11, 155, 44, 97, 225, 159, 137, 27, 236, 156, 116, 219, 110,
-			13, 66, 93, 23, 124, 118, 4, 188, 242, 89, 241, 176, 66, 207,
-			82, 191, 212, 216, 220, 201, 37, 114, 219, 144, 38, 154, 56, 191,
-			217, 233, 56, 157, 61, 116, 144, 36, 178, 83, 230, 207, 227, 168,
-			28, 12, 42, 143, 155, 234, 79, 156, 95, 124, 13, 140, 185, 198,
-			129, 41, 3, 146, 179, 244, 112, 91, 60, 75, 148, 216, 132, 90,
-			123, 49, 169, 20, 80, 122, 216, 52, 195, 136, 44, 159, 243, 250,
-			104, 94, 96, 231, 149, 46, 98, 166, 15, 22, 84, 134, 52, 19,
-			242, 241, 59, 226, 105, 234, 223, 235, 253, 149, 65, 31, 195, 70,
-			128, 65, 106, 51, 177, 126, 102, 245, 114, 31, 77, 253, 174, 211,
-			71, 175, 34, 181, 33, 116, 232, 195, 232, 72, 212, 89, 82, 232,
-			194, 158, 92, 45, 152, 20, 132, 35, 182, 196, 220, 5, 154, 249,
-			214, 48, 105, 8, 163, 157, 165, 209, 196, 193, 230, 72, 121, 86,
-			160, 42, 25, 3, 175, 18, 85, 150, 192, 29, 188, 215, 183, 215,
-			231, 46, 18, 246, 155, 195, 176, 111, 193, 232, 176, 120, 0, 249,
-			100, 115, 224, 217, 250, 217, 176, 130, 166, 254, 158, 198, 166, 78,
-			16, 133, 1, 102, 31, 71, 178, 78, 108, 132, 134, 92, 233, 211,
-			115, 33, 166, 144, 34, 175, 178, 56, 101, 135, 189, 79, 176, 121,
-			228, 200, 212, 115, 54, 57, 200, 1, 42, 100, 63, 251, 130, 20,
-			85, 198, 22, 131, 130, 201, 244, 79, 60, 89, 155, 37, 11, 54,
-			54, 193, 13, 181, 116, 253, 187, 248, 99, 118, 209, 208, 93, 12,
-			81, 187, 32, 86, 214, 161, 9, 72, 253, 84, 103, 151, 135, 78,
-			41, 117, 24, 190, 162, 242, 14, 77, 55, 86, 22, 64, 226, 21,
-			54, 101, 217, 244, 167, 229, 245, 246, 68, 253, 84, 226, 157, 12,
-			94, 148, 233, 121, 98, 134, 197, 69, 73, 142, 164, 62, 86, 150,
-			80, 226, 26, 59, 99, 59, 246, 30, 177, 14, 47, 151, 233, 37,
-			131, 71, 121, 241, 68, 13, 192, 194, 194, 115, 83, 100, 10, 98,
-			64, 89, 60, 73, 220, 100, 19, 50, 253, 219, 147, 53, 118, 145,
-			47, 156, 149, 79, 223, 166, 82, 59, 103, 103, 169, 242, 81, 51,
-			68, 98, 50, 74, 99, 24, 62, 203, 25, 148, 151, 108, 15, 213,
-			105, 177, 99, 250, 5, 60, 152, 241, 190, 92, 153, 239, 236, 178,
-			113, 127, 61, 18, 147, 236, 236, 110, 241, 237, 98, 233, 105, 113,
-			175, 250, 108, 39, 63, 57, 2, 113, 68, 242, 73, 169, 244, 100,
-			43, 191, 87, 202, 238, 86, 55, 87, 247, 178, 185, 92, 190, 82,
-			217, 171, 150, 222, 206, 23, 39, 53, 144, 94, 98, 107, 55, 87,
-			216, 219, 206, 230, 54, 11, 197, 188, 124, 174, 223, 121, 131, 37,
-			78, 122, 141, 48, 254, 236, 214, 147, 18, 224, 159, 102, 231, 43,
-			155, 217, 213, 7, 107, 123, 229, 74, 86, 60, 212, 238, 252, 55,
-			141, 141, 251, 166, 54, 113, 134, 141, 86, 118, 105, 86, 24, 127,
-			137, 93, 220, 45, 86, 118, 119, 118, 74, 229, 106, 126, 99, 175,
-			82, 120, 82, 204, 86, 119, 203, 121, 32, 37, 201, 102, 194, 175,
-			136, 18, 193, 134, 158, 152, 98, 231, 214, 179, 240, 172, 176, 157,
-			175, 84, 179, 219, 59, 147, 17, 28, 142, 143, 114, 249, 114, 181,
-			240, 184, 144, 203, 86, 243, 123, 143, 75, 229, 237, 108, 117, 50,
-			170, 134, 7, 216, 99, 98, 226, 106, 121, 183, 130, 184, 67, 31,
-			77, 198, 19, 179, 108, 154, 144, 211, 132, 217, 242, 147, 93, 236,
-			40, 172, 76, 142, 226, 11, 241, 112, 187, 80, 172, 22, 138, 79,
-			246, 242, 229, 114, 169, 60, 57, 182, 250, 11, 141, 157, 33, 169,
-			111, 211, 142, 72, 180, 216, 228, 160, 115, 74, 188, 50, 212, 51,
-			12, 143, 50, 146, 75, 159, 108, 176, 220, 53, 71, 108, 122, 200,
-			166, 74, 100, 134, 33, 57, 125, 195, 39, 87, 62, 241, 120, 49,
-			239, 167, 255, 227, 103, 216, 104, 2, 162, 194, 191, 163, 255, 21,
-			205, 115, 147, 225, 60, 23, 255, 132, 88, 151, 141, 190, 77, 127,
-			194, 128, 51, 163, 219, 236, 77, 166, 199, 232, 92, 41, 161, 37,
-			239, 18, 35, 207, 45, 121, 32, 45, 164, 117, 50, 166, 71, 10,
-			80, 122, 162, 176, 21, 163, 68, 49, 54, 193, 238, 179, 104, 140,
-			242, 210, 243, 250, 245, 212, 109, 81, 82, 178, 68, 146, 68, 57,
-			3, 181, 94, 57, 120, 188, 100, 121, 50, 126, 100, 24, 117, 199,
-			68, 174, 9, 249, 160, 130, 128, 180, 243, 215, 56, 91, 37, 132,
-			152, 155, 1, 194, 91, 42, 25, 26, 72, 125, 184, 112, 88, 130,
-			66, 133, 79, 163, 143, 230, 21, 132, 89, 35, 224, 91, 35, 124,
-			58, 102, 98, 215, 83, 139, 156, 34, 103, 191, 69, 218, 133, 53,
-			243, 64, 210, 71, 161, 42, 119, 31, 78, 36, 100, 74, 191, 160,
-			32, 68, 3, 56, 95, 101, 152, 164, 68, 47, 98, 237, 32, 205,
-			43, 221, 118, 27, 208, 96, 175, 184, 209, 19, 221, 120, 96, 55,
-			213, 9, 137, 12, 163, 165, 216, 144, 198, 139, 177, 89, 18, 27,
-			213, 3, 103, 244, 153, 95, 75, 108, 162, 246, 55, 35, 197, 166,
-			145, 216, 102, 46, 92, 196, 188, 53, 70, 181, 63, 72, 121, 32,
-			111, 109, 25, 94, 237, 208, 116, 249, 139, 7, 119, 31, 45, 128,
-			202, 209, 137, 232, 83, 48, 111, 96, 192, 242, 118, 173, 211, 163,
-			116, 86, 161, 212, 232, 187, 41, 5, 1, 202, 89, 64, 105, 3,
-			151, 122, 34, 122, 101, 100, 65, 75, 238, 243, 29, 199, 117, 45,
-			204, 243, 125, 77, 105, 24, 158, 209, 228, 20, 64, 186, 88, 10,
-			41, 66, 126, 28, 126, 70, 121, 98, 199, 4, 227, 106, 139, 35,
-			173, 131, 78, 187, 6, 91, 79, 30, 84, 202, 65, 242, 104, 80,
-			202, 7, 57, 184, 2, 106, 117, 6, 216, 161, 102, 214, 171, 250,
-			21, 34, 75, 180, 178, 94, 213, 199, 21, 4, 68, 94, 189, 60,
-			207, 94, 163, 129, 240, 234, 154, 126, 37, 245, 138, 16, 228, 144,
-			196, 65, 201, 211, 85, 107, 197, 36, 30, 141, 62, 157, 85, 16,
-			96, 189, 6, 88, 95, 37, 172, 216, 35, 12, 88, 23, 212, 26,
-			82, 229, 94, 249, 164, 83, 49, 34, 49, 92, 159, 83, 16, 34,
-			1, 140, 11, 132, 145, 14, 134, 174, 164, 146, 220, 79, 65, 130,
-			229, 62, 238, 56, 88, 130, 21, 159, 209, 129, 141, 126, 94, 65,
-			128, 228, 58, 32, 185, 79, 72, 162, 137, 72, 10, 144, 220, 134,
-			69, 110, 226, 205, 9, 32, 203, 193, 115, 8, 159, 146, 240, 129,
-			128, 66, 136, 117, 128, 148, 79, 21, 118, 228, 166, 0, 225, 27,
-			132, 16, 178, 239, 27, 128, 48, 19, 42, 219, 214, 29, 211, 197,
-			227, 94, 210, 35, 209, 146, 42, 79, 127, 233, 252, 207, 10, 216,
-			197, 108, 253, 134, 79, 41, 54, 230, 222, 0, 196, 143, 8, 49,
-			164, 220, 55, 1, 241, 18, 151, 241, 76, 223, 73, 133, 143, 84,
-			156, 40, 114, 203, 227, 61, 211, 83, 104, 49, 65, 191, 233, 175,
-			11, 118, 231, 222, 4, 180, 111, 18, 90, 236, 57, 6, 180, 43,
-			178, 110, 209, 225, 34, 125, 34, 125, 83, 51, 193, 83, 92, 157,
-			227, 67, 203, 51, 241, 162, 72, 64, 48, 118, 100, 223, 210, 47,
-			42, 8, 187, 157, 125, 73, 96, 15, 51, 73, 162, 107, 75, 27,
-			129, 21, 242, 64, 167, 69, 217, 1, 205, 32, 117, 173, 134, 109,
-			5, 124, 140, 231, 81, 62, 226, 49, 108, 122, 6, 196, 235, 76,
-			143, 131, 169, 189, 51, 82, 208, 146, 224, 51, 2, 127, 202, 45,
-			236, 125, 106, 81, 67, 80, 11, 111, 222, 100, 119, 10, 170, 190,
-			212, 119, 214, 71, 59, 35, 142, 234, 127, 103, 108, 154, 253, 167,
-			40, 139, 198, 201, 226, 62, 212, 43, 201, 31, 71, 249, 160, 3,
-			229, 7, 166, 109, 118, 12, 106, 165, 229, 182, 121, 44, 145, 97,
-			17, 9, 235, 59, 96, 78, 241, 156, 170, 70, 37, 30, 105, 243,
-			228, 89, 181, 60, 234, 33, 135, 227, 215, 119, 194, 75, 134, 53,
-			22, 121, 50, 12, 206, 202, 117, 90, 64, 105, 167, 75, 27, 35,
-			151, 93, 146, 45, 3, 12, 149, 89, 180, 233, 136, 165, 72, 99,
-			163, 142, 140, 80, 105, 204, 161, 225, 10, 101, 50, 109, 46, 3,
-			211, 69, 106, 13, 70, 210, 194, 84, 176, 176, 5, 29, 152, 157,
-			78, 217, 156, 142, 200, 84, 233, 116, 38, 116, 180, 145, 225, 143,
-			45, 155, 142, 101, 44, 197, 22, 11, 78, 164, 197, 137, 23, 157,
-			50, 145, 215, 166, 115, 24, 207, 241, 5, 23, 218, 239, 104, 236,
-			124, 175, 72, 98, 106, 112, 19, 22, 165, 7, 158, 28, 47, 93,
-			201, 195, 177, 174, 183, 132, 51, 133, 36, 79, 135, 84, 104, 250,
-			212, 42, 156, 104, 195, 40, 245, 89, 75, 252, 92, 125, 80, 199,
-			155, 88, 77, 152, 93, 188, 81, 201, 56, 199, 163, 82, 23, 98,
-			109, 118, 98, 205, 85, 240, 146, 65, 172, 94, 199, 176, 93, 170,
-			29, 42, 19, 27, 160, 38, 10, 173, 26, 27, 102, 139, 51, 164,
-			200, 113, 225, 152, 31, 198, 103, 20, 4, 138, 252, 112, 54, 163,
-			32, 60, 240, 121, 244, 25, 246, 231, 66, 13, 97, 228, 166, 254,
-			94, 242, 79, 163, 124, 72, 72, 5, 140, 96, 144, 115, 242, 120,
-			14, 165, 35, 237, 8, 81, 103, 185, 193, 249, 119, 193, 11, 94,
-			5, 91, 161, 127, 237, 141, 190, 99, 172, 33, 123, 6, 103, 96,
-			190, 38, 225, 139, 5, 55, 124, 168, 73, 29, 119, 98, 141, 252,
-			35, 82, 200, 34, 189, 174, 43, 73, 240, 58, 52, 191, 67, 39,
-			172, 232, 187, 90, 93, 176, 131, 150, 45, 110, 19, 210, 189, 177,
-			125, 188, 108, 21, 76, 172, 250, 101, 4, 26, 248, 132, 241, 182,
-			114, 153, 105, 51, 115, 144, 9, 84, 145, 246, 226, 137, 153, 81,
-			175, 108, 10, 2, 84, 123, 128, 209, 132, 172, 176, 222, 99, 92,
-			110, 30, 58, 158, 204, 214, 193, 252, 224, 77, 52, 131, 46, 143,
-			97, 88, 214, 117, 229, 121, 53, 42, 54, 18, 93, 55, 247, 187,
-			7, 194, 199, 200, 46, 58, 17, 213, 137, 227, 77, 210, 132, 215,
-			177, 209, 241, 99, 194, 96, 81, 116, 22, 177, 32, 70, 52, 190,
-			111, 193, 29, 65, 29, 182, 82, 131, 104, 225, 178, 157, 3, 186,
-			82, 39, 53, 182, 49, 224, 143, 4, 71, 131, 95, 134, 117, 79,
-			76, 55, 160, 184, 190, 66, 162, 147, 222, 140, 95, 82, 16, 40,
-			228, 102, 242, 85, 5, 129, 66, 110, 254, 214, 103, 153, 33, 206,
-			115, 182, 71, 158, 105, 201, 221, 33, 27, 68, 218, 144, 142, 209,
-			118, 169, 39, 177, 239, 60, 89, 170, 214, 144, 47, 240, 34, 37,
-			21, 199, 66, 29, 66, 219, 99, 215, 216, 63, 210, 213, 129, 76,
-			69, 191, 149, 252, 187, 58, 5, 209, 170, 42, 177, 124, 202, 249,
-			174, 162, 66, 162, 92, 26, 48, 103, 82, 169, 25, 105, 53, 89,
-			43, 21, 198, 13, 65, 146, 9, 25, 102, 106, 206, 49, 69, 87,
-			36, 44, 191, 107, 146, 46, 202, 46, 162, 30, 108, 19, 108, 114,
-			194, 55, 198, 145, 3, 6, 185, 110, 2, 109, 96, 189, 72, 51,
-			124, 37, 85, 196, 51, 95, 56, 134, 188, 56, 66, 109, 42, 96,
-			14, 237, 26, 110, 138, 99, 223, 72, 171, 16, 215, 15, 27, 250,
-			186, 147, 42, 250, 246, 245, 208, 25, 83, 165, 175, 165, 180, 50,
-			198, 67, 103, 76, 149, 27, 55, 217, 239, 248, 221, 73, 239, 234,
-			51, 201, 99, 146, 103, 255, 49, 242, 137, 10, 117, 219, 232, 128,
-			53, 4, 53, 114, 23, 136, 67, 117, 30, 55, 76, 90, 195, 98,
-			66, 212, 57, 243, 133, 81, 195, 5, 49, 176, 214, 209, 215, 130,
-			244, 174, 94, 185, 21, 58, 170, 122, 183, 175, 229, 243, 221, 177,
-			169, 208, 81, 213, 187, 16, 45, 23, 68, 127, 195, 123, 35, 158,
-			150, 124, 107, 232, 170, 227, 165, 79, 240, 243, 174, 60, 182, 192,
-			254, 6, 223, 183, 5, 172, 132, 26, 7, 222, 27, 187, 204, 190,
-			167, 169, 206, 1, 67, 159, 75, 126, 168, 145, 92, 194, 62, 89,
-			52, 234, 136, 222, 19, 139, 108, 173, 116, 111, 105, 88, 254, 108,
-			165, 120, 47, 172, 140, 40, 37, 213, 153, 166, 154, 255, 252, 123,
-			167, 162, 77, 141, 135, 187, 216, 120, 218, 242, 192, 114, 230, 138,
-			202, 200, 226, 204, 139, 180, 101, 114, 89, 186, 211, 202, 240, 83,
-			50, 64, 142, 223, 248, 37, 134, 101, 194, 157, 12, 134, 254, 222,
-			149, 80, 39, 131, 209, 215, 201, 96, 140, 77, 135, 58, 25, 140,
-			153, 89, 246, 69, 213, 201, 208, 208, 151, 147, 239, 15, 232, 66,
-			176, 128, 138, 116, 124, 39, 44, 96, 144, 109, 241, 13, 106, 60,
-			18, 29, 37, 11, 212, 21, 115, 138, 81, 8, 116, 67, 4, 227,
-			153, 112, 31, 68, 67, 55, 230, 84, 175, 67, 156, 122, 185, 67,
-			125, 16, 141, 139, 233, 80, 31, 68, 227, 149, 37, 182, 165, 250,
-			32, 44, 253, 78, 242, 183, 66, 221, 236, 65, 227, 89, 56, 156,
-			169, 129, 129, 7, 3, 185, 164, 44, 128, 236, 149, 171, 53, 157,
-			218, 243, 76, 184, 87, 194, 210, 27, 203, 170, 31, 34, 142, 216,
-			47, 135, 122, 37, 172, 249, 91, 161, 94, 9, 43, 189, 200, 126,
-			155, 200, 0, 192, 214, 47, 39, 119, 66, 29, 159, 148, 185, 136,
-			182, 24, 63, 200, 25, 20, 150, 58, 236, 147, 75, 238, 135, 31,
-			212, 82, 105, 6, 235, 138, 167, 157, 182, 110, 221, 145, 115, 71,
-			226, 56, 221, 25, 5, 1, 93, 246, 217, 25, 5, 33, 41, 151,
-			146, 236, 171, 66, 155, 33, 131, 113, 245, 155, 201, 47, 18, 97,
-			234, 26, 55, 134, 191, 226, 108, 132, 252, 46, 248, 2, 79, 92,
-			161, 242, 79, 68, 131, 32, 44, 8, 25, 40, 140, 53, 14, 112,
-			95, 129, 193, 11, 226, 125, 30, 196, 239, 150, 45, 155, 205, 50,
-			27, 14, 6, 218, 57, 1, 48, 73, 91, 148, 168, 241, 33, 208,
-			78, 247, 204, 148, 130, 128, 11, 55, 113, 77, 65, 192, 133, 155,
-			186, 193, 254, 3, 246, 14, 232, 137, 216, 23, 70, 254, 190, 166,
-			37, 255, 173, 118, 106, 56, 198, 105, 185, 101, 2, 12, 75, 188,
-			48, 56, 112, 65, 37, 253, 34, 108, 97, 104, 132, 177, 176, 97,
-			214, 7, 106, 7, 192, 242, 66, 8, 151, 10, 21, 100, 180, 211,
-			49, 27, 176, 21, 72, 110, 152, 155, 168, 196, 132, 98, 234, 62,
-			171, 67, 126, 149, 201, 0, 211, 21, 117, 36, 170, 46, 185, 202,
-			191, 161, 62, 125, 1, 140, 179, 3, 11, 69, 9, 248, 7, 160,
-			65, 255, 63, 178, 127, 212, 41, 157, 108, 197, 7, 250, 23, 232,
-			230, 40, 130, 113, 36, 224, 140, 130, 128, 182, 15, 164, 78, 233,
-			100, 43, 62, 0, 157, 186, 75, 148, 130, 233, 253, 178, 6, 164,
-			166, 120, 73, 246, 183, 13, 134, 209, 129, 31, 159, 160, 239, 97,
-			119, 199, 224, 147, 15, 46, 203, 169, 193, 200, 35, 138, 49, 5,
-			18, 198, 241, 25, 5, 70, 16, 132, 233, 182, 104, 58, 61, 17,
-			253, 138, 6, 91, 252, 83, 24, 112, 187, 93, 81, 141, 74, 203,
-			10, 176, 156, 16, 99, 223, 197, 126, 249, 203, 31, 55, 168, 251,
-			170, 124, 158, 144, 195, 134, 136, 3, 186, 47, 107, 138, 22, 216,
-			227, 136, 95, 77, 142, 220, 125, 69, 155, 189, 165, 192, 8, 130,
-			176, 205, 127, 168, 17, 49, 0, 126, 85, 211, 175, 36, 255, 5,
-			118, 112, 74, 71, 208, 57, 209, 170, 32, 227, 83, 121, 254, 229,
-			103, 121, 146, 158, 208, 70, 23, 110, 148, 110, 78, 240, 125, 7,
-			66, 4, 201, 95, 163, 219, 244, 199, 136, 12, 71, 174, 126, 72,
-			6, 75, 50, 156, 23, 14, 9, 60, 17, 249, 135, 254, 67, 197,
-			204, 192, 81, 156, 47, 6, 48, 40, 113, 96, 228, 43, 218, 29,
-			201, 104, 36, 70, 156, 169, 37, 1, 155, 2, 224, 248, 156, 2,
-			137, 111, 72, 177, 159, 50, 220, 154, 241, 175, 105, 35, 127, 0,
-			251, 177, 192, 135, 110, 69, 127, 29, 84, 67, 92, 144, 228, 193,
-			206, 28, 230, 22, 24, 59, 3, 27, 2, 39, 253, 154, 54, 54,
-			207, 118, 64, 214, 120, 27, 58, 250, 117, 77, 191, 151, 92, 23,
-			78, 105, 176, 44, 25, 116, 79, 168, 223, 141, 8, 246, 128, 106,
-			39, 164, 27, 191, 139, 146, 235, 8, 170, 124, 28, 80, 126, 77,
-			187, 74, 124, 69, 80, 233, 113, 142, 121, 5, 106, 8, 94, 89,
-			82, 96, 4, 193, 149, 187, 236, 35, 141, 8, 130, 183, 223, 192,
-			197, 255, 163, 191, 220, 226, 251, 182, 117, 211, 112, 229, 79, 104,
-			116, 90, 60, 245, 166, 209, 110, 47, 91, 245, 79, 173, 188, 217,
-			130, 193, 77, 115, 89, 98, 248, 84, 10, 219, 75, 253, 240, 94,
-			244, 212, 58, 182, 5, 57, 9, 53, 74, 246, 68, 234, 46, 122,
-			88, 50, 32, 43, 212, 9, 151, 239, 217, 142, 183, 135, 233, 155,
-			209, 241, 250, 242, 54, 95, 26, 176, 43, 227, 192, 207, 215, 181,
-			123, 146, 95, 220, 150, 223, 80, 58, 16, 161, 109, 249, 13, 165,
-			3, 17, 218, 150, 223, 64, 29, 248, 58, 73, 99, 108, 36, 17,
-			255, 166, 166, 255, 190, 22, 73, 126, 73, 132, 74, 42, 37, 175,
-			247, 223, 127, 16, 97, 159, 168, 189, 129, 79, 227, 135, 38, 181,
-			42, 183, 169, 139, 29, 179, 187, 112, 205, 15, 125, 102, 152, 88,
-			136, 219, 77, 76, 194, 135, 232, 76, 80, 31, 148, 38, 13, 72,
-			2, 138, 191, 169, 81, 59, 35, 174, 23, 88, 142, 127, 162, 69,
-			215, 36, 253, 184, 209, 1, 188, 168, 64, 13, 193, 153, 187, 10,
-			140, 32, 120, 255, 129, 252, 20, 160, 111, 105, 209, 101, 249, 18,
-			252, 45, 130, 23, 20, 168, 33, 120, 113, 65, 129, 52, 248, 206,
-			18, 251, 51, 116, 86, 32, 212, 111, 107, 35, 255, 12, 118, 199,
-			31, 107, 252, 196, 169, 112, 208, 78, 219, 87, 16, 151, 94, 55,
-			92, 106, 15, 31, 70, 224, 173, 17, 188, 46, 5, 171, 159, 149,
-			101, 20, 145, 48, 136, 27, 36, 225, 94, 118, 97, 19, 252, 150,
-			219, 176, 59, 199, 76, 25, 114, 69, 188, 114, 226, 57, 14, 237,
-			69, 249, 243, 41, 162, 107, 104, 167, 105, 120, 168, 138, 180, 112,
-			165, 141, 82, 250, 200, 168, 91, 45, 247, 112, 241, 117, 208, 191,
-			150, 67, 215, 180, 112, 155, 98, 236, 253, 109, 109, 236, 18, 227,
-			32, 42, 252, 137, 129, 232, 119, 52, 61, 153, 74, 132, 131, 109,
-			89, 173, 67, 69, 139, 210, 182, 131, 33, 223, 150, 54, 55, 138,
-			113, 41, 126, 51, 166, 64, 13, 193, 241, 139, 10, 140, 32, 56,
-			119, 9, 107, 176, 81, 244, 146, 209, 239, 106, 250, 92, 234, 214,
-			48, 237, 80, 45, 193, 169, 117, 188, 156, 222, 73, 169, 41, 81,
-			183, 225, 171, 239, 104, 73, 137, 20, 117, 251, 187, 193, 148, 26,
-			97, 29, 159, 86, 96, 4, 65, 136, 134, 111, 211, 148, 160, 56,
-			31, 106, 250, 66, 106, 46, 232, 125, 18, 107, 34, 170, 3, 174,
-			154, 5, 157, 9, 12, 252, 174, 54, 39, 241, 160, 142, 193, 3,
-			197, 39, 210, 254, 161, 54, 159, 82, 96, 4, 193, 91, 183, 217,
-			135, 81, 134, 209, 79, 252, 123, 218, 200, 191, 6, 69, 249, 102,
-			148, 15, 158, 217, 211, 34, 158, 118, 98, 18, 196, 101, 161, 213,
-			196, 174, 113, 92, 82, 60, 125, 245, 111, 137, 168, 95, 1, 128,
-			220, 148, 228, 163, 196, 70, 186, 101, 182, 246, 205, 186, 203, 124,
-			220, 216, 132, 70, 191, 171, 131, 38, 76, 197, 45, 65, 237, 101,
-			88, 31, 191, 229, 133, 174, 117, 160, 29, 203, 16, 101, 135, 70,
-			64, 189, 184, 188, 198, 211, 106, 165, 238, 29, 246, 221, 215, 193,
-			162, 136, 176, 107, 251, 104, 67, 69, 32, 234, 95, 114, 129, 168,
-			105, 225, 221, 101, 20, 206, 178, 148, 206, 178, 12, 230, 66, 23,
-			168, 250, 47, 90, 145, 121, 65, 233, 97, 73, 196, 245, 81, 123,
-			34, 252, 167, 68, 221, 230, 78, 219, 192, 150, 74, 209, 100, 190,
-			172, 186, 214, 49, 101, 91, 98, 124, 95, 92, 209, 234, 96, 234,
-			238, 249, 59, 106, 120, 153, 65, 245, 211, 147, 157, 226, 67, 26,
-			202, 152, 172, 125, 25, 117, 89, 70, 109, 81, 241, 198, 35, 50,
-			233, 242, 149, 67, 165, 244, 134, 115, 162, 204, 37, 10, 96, 114,
-			211, 225, 33, 216, 247, 180, 177, 57, 218, 116, 248, 43, 28, 209,
-			239, 131, 158, 157, 186, 233, 98, 180, 233, 96, 200, 247, 228, 14,
-			136, 209, 166, 251, 190, 218, 1, 244, 195, 29, 0, 202, 160, 43,
-			70, 155, 238, 251, 24, 116, 221, 38, 252, 240, 242, 15, 95, 190,
-			3, 98, 180, 207, 96, 224, 247, 229, 214, 142, 97, 230, 134, 95,
-			250, 32, 33, 146, 59, 32, 70, 251, 236, 15, 113, 7, 252, 45,
-			216, 0, 241, 68, 252, 7, 218, 200, 191, 131, 13, 224, 12, 47,
-			143, 137, 228, 205, 146, 23, 227, 96, 227, 15, 43, 124, 150, 119,
-			114, 148, 134, 171, 194, 186, 138, 61, 250, 203, 160, 244, 43, 92,
-			45, 83, 22, 198, 72, 162, 113, 32, 237, 7, 218, 88, 138, 237,
-			3, 199, 248, 43, 36, 209, 31, 98, 84, 91, 21, 186, 164, 76,
-			13, 125, 45, 178, 56, 202, 185, 69, 14, 151, 19, 119, 165, 233,
-			6, 71, 83, 236, 185, 254, 142, 7, 95, 3, 59, 34, 235, 67,
-			105, 197, 105, 77, 96, 146, 31, 104, 55, 73, 30, 113, 138, 63,
-			224, 193, 25, 5, 106, 8, 158, 157, 81, 96, 4, 65, 88, 147,
-			13, 162, 16, 94, 254, 72, 211, 167, 147, 107, 33, 109, 71, 201,
-			100, 240, 114, 119, 80, 89, 9, 251, 214, 62, 31, 41, 104, 192,
-			21, 3, 52, 63, 148, 43, 22, 39, 203, 248, 35, 165, 23, 113,
-			90, 177, 31, 105, 227, 19, 10, 140, 32, 56, 149, 96, 239, 193,
-			138, 141, 38, 226, 31, 105, 120, 90, 159, 44, 126, 108, 65, 211,
-			207, 197, 196, 45, 225, 79, 176, 116, 184, 32, 163, 48, 243, 71,
-			218, 216, 13, 246, 71, 24, 96, 140, 226, 138, 252, 137, 166, 79,
-			37, 255, 57, 132, 23, 29, 192, 98, 53, 250, 175, 116, 249, 117,
-			236, 236, 192, 51, 28, 73, 245, 97, 191, 216, 64, 23, 192, 96,
-			201, 106, 226, 240, 64, 158, 138, 168, 131, 146, 30, 68, 37, 84,
-			197, 166, 29, 10, 79, 48, 182, 195, 95, 38, 147, 230, 13, 239,
-			209, 123, 226, 70, 161, 40, 47, 247, 93, 35, 92, 196, 49, 136,
-			78, 158, 175, 72, 73, 143, 210, 106, 3, 3, 31, 105, 34, 153,
-			24, 165, 29, 8, 15, 226, 10, 212, 16, 28, 61, 171, 192, 8,
-			130, 231, 39, 217, 239, 9, 246, 225, 237, 79, 52, 253, 90, 242,
-			119, 52, 190, 217, 109, 209, 141, 33, 163, 78, 237, 223, 110, 183,
-			213, 194, 223, 114, 3, 177, 30, 31, 246, 2, 230, 229, 129, 28,
-			37, 21, 52, 4, 204, 149, 171, 242, 13, 63, 8, 12, 151, 28,
-			40, 84, 192, 75, 172, 226, 250, 27, 41, 51, 8, 111, 129, 240,
-			64, 198, 12, 222, 161, 1, 130, 48, 125, 158, 80, 123, 128, 170,
-			63, 209, 166, 36, 213, 168, 61, 63, 81, 218, 51, 74, 218, 243,
-			19, 109, 60, 169, 192, 8, 130, 87, 174, 178, 95, 69, 137, 39,
-			112, 172, 63, 211, 244, 68, 242, 127, 70, 135, 44, 105, 176, 92,
-			40, 116, 117, 248, 41, 247, 53, 42, 247, 224, 41, 69, 16, 112,
-			170, 206, 104, 121, 66, 22, 156, 76, 4, 247, 106, 197, 25, 50,
-			152, 99, 126, 96, 116, 246, 49, 59, 133, 60, 90, 21, 245, 84,
-			162, 34, 195, 45, 169, 43, 32, 12, 81, 53, 94, 146, 62, 65,
-			30, 178, 145, 122, 49, 242, 114, 74, 133, 240, 188, 210, 112, 131,
-			35, 54, 113, 124, 181, 32, 62, 95, 160, 31, 188, 65, 57, 46,
-			133, 79, 181, 69, 135, 55, 254, 36, 93, 26, 253, 0, 252, 23,
-			8, 92, 196, 249, 3, 26, 195, 158, 77, 114, 69, 229, 135, 208,
-			161, 12, 154, 58, 27, 198, 158, 30, 208, 161, 191, 55, 123, 226,
-			162, 46, 22, 152, 33, 56, 244, 252, 235, 94, 224, 164, 132, 99,
-			4, 97, 165, 156, 38, 254, 190, 143, 90, 7, 108, 66, 175, 57,
-			173, 208, 73, 47, 206, 143, 238, 156, 241, 180, 168, 77, 247, 157,
-			10, 18, 49, 84, 139, 144, 56, 209, 159, 129, 158, 153, 47, 68,
-			73, 219, 252, 24, 185, 136, 131, 149, 126, 77, 245, 203, 97, 164,
-			151, 32, 222, 199, 207, 10, 75, 170, 138, 222, 99, 225, 3, 104,
-			170, 210, 180, 0, 170, 89, 78, 215, 133, 209, 196, 39, 106, 118,
-			104, 67, 98, 184, 6, 234, 247, 19, 237, 154, 84, 79, 61, 70,
-			250, 168, 54, 36, 110, 185, 159, 105, 163, 231, 20, 24, 65, 112,
-			114, 74, 109, 72, 0, 127, 174, 233, 179, 184, 33, 63, 169, 242,
-			138, 95, 73, 20, 113, 201, 9, 155, 35, 143, 18, 144, 243, 20,
-			54, 14, 166, 240, 242, 117, 3, 175, 211, 202, 139, 145, 117, 179,
-			97, 116, 193, 242, 44, 144, 140, 22, 164, 49, 117, 141, 6, 197,
-			60, 130, 39, 76, 228, 129, 170, 159, 105, 9, 73, 53, 38, 242,
-			63, 15, 120, 194, 92, 229, 231, 218, 168, 255, 150, 152, 184, 48,
-			195, 254, 151, 224, 41, 154, 136, 254, 2, 121, 250, 233, 175, 203,
-			83, 120, 35, 246, 27, 63, 25, 218, 249, 135, 29, 242, 52, 92,
-			49, 46, 147, 27, 10, 202, 128, 107, 255, 67, 84, 244, 158, 236,
-			43, 240, 127, 179, 139, 170, 116, 109, 180, 181, 166, 251, 27, 17,
-			23, 166, 103, 192, 240, 207, 181, 89, 41, 16, 8, 195, 81, 2,
-			74, 92, 152, 219, 252, 34, 16, 87, 52, 130, 224, 197, 25, 246,
-			54, 73, 11, 198, 254, 82, 211, 231, 147, 111, 249, 215, 119, 79,
-			220, 241, 235, 43, 145, 203, 104, 27, 203, 16, 237, 54, 232, 38,
-			90, 111, 159, 148, 24, 144, 2, 216, 126, 225, 147, 18, 19, 232,
-			149, 41, 197, 136, 239, 151, 218, 184, 255, 54, 130, 96, 242, 50,
-			251, 93, 177, 114, 16, 57, 252, 10, 210, 174, 228, 231, 67, 151,
-			219, 7, 46, 19, 171, 227, 129, 126, 183, 137, 65, 47, 166, 11,
-			39, 233, 194, 251, 152, 78, 83, 253, 232, 37, 95, 160, 171, 184,
-			11, 50, 117, 239, 59, 250, 165, 176, 67, 49, 18, 7, 70, 128,
-			148, 95, 106, 243, 146, 212, 120, 140, 104, 83, 140, 96, 160, 245,
-			43, 149, 222, 141, 226, 13, 16, 0, 33, 189, 251, 26, 49, 130,
-			117, 132, 47, 233, 250, 151, 245, 72, 242, 3, 50, 118, 226, 36,
-			187, 30, 154, 137, 167, 135, 134, 52, 39, 79, 116, 22, 33, 20,
-			106, 181, 49, 102, 111, 176, 144, 159, 8, 154, 119, 232, 122, 112,
-			232, 247, 21, 234, 102, 16, 215, 203, 42, 194, 40, 85, 17, 190,
-			164, 203, 42, 2, 254, 144, 95, 244, 111, 235, 209, 71, 146, 250,
-			209, 56, 129, 92, 129, 26, 130, 215, 239, 43, 48, 130, 224, 218,
-			107, 234, 122, 203, 255, 11, 0, 0, 255, 255, 226, 124, 88, 74,
-			33, 88, 0, 0},
+			8, 0, 0, 9, 110, 136, 0, 255, 220, 189, 13, 140, 28, 201,
+			117, 24, 60, 93, 221, 59, 59, 91, 228, 145, 187, 189, 228, 238,
+			114, 118, 73, 214, 205, 29, 185, 51, 167, 217, 217, 229, 242, 231,
+			68, 242, 78, 167, 229, 114, 73, 46, 143, 220, 165, 119, 151, 166,
+			117, 103, 125, 123, 53, 51, 53, 51, 45, 246, 116, 207, 117, 247,
+			236, 114, 116, 62, 201, 250, 224, 252, 24, 49, 28, 59, 150, 97,
+			8, 145, 16, 199, 177, 0, 255, 40, 118, 0, 217, 176, 229, 192,
+			134, 140, 0, 103, 43, 177, 35, 199, 178, 141, 32, 129, 225, 0,
+			62, 5, 65, 140, 68, 137, 140, 56, 1, 28, 56, 193, 123, 85,
+			213, 221, 51, 59, 228, 241, 100, 57, 65, 124, 160, 132, 121, 221,
+			213, 85, 239, 189, 122, 245, 234, 253, 85, 45, 253, 82, 137, 158,
+			110, 250, 126, 211, 21, 139, 157, 192, 143, 252, 106, 183, 177, 24,
+			57, 109, 17, 70, 188, 221, 169, 224, 35, 251, 168, 108, 80, 209,
+			13, 10, 87, 233, 216, 142, 110, 99, 207, 208, 209, 80, 212, 124,
+			175, 30, 206, 24, 204, 40, 154, 91, 26, 180, 143, 209, 17, 143,
+			123, 126, 56, 67, 152, 81, 28, 217, 146, 192, 181, 191, 97, 208,
+			201, 154, 223, 174, 12, 116, 122, 237, 72, 220, 229, 61, 120, 116,
+			207, 120, 109, 89, 53, 105, 250, 46, 247, 154, 21, 63, 104, 166,
+			112, 236, 117, 68, 184, 248, 208, 243, 247, 189, 4, 223, 78, 245,
+			127, 24, 198, 79, 16, 243, 230, 189, 107, 63, 67, 78, 221, 148,
+			95, 223, 83, 159, 84, 30, 8, 215, 125, 21, 62, 216, 129, 111,
+			111, 255, 210, 60, 205, 218, 214, 145, 204, 39, 13, 250, 47, 45,
+			106, 28, 182, 205, 35, 25, 123, 249, 159, 89, 108, 213, 239, 244,
+			2, 167, 217, 138, 216, 242, 210, 242, 210, 194, 242, 210, 242, 5,
+			118, 173, 219, 96, 59, 162, 214, 242, 124, 215, 111, 58, 34, 44,
+			179, 117, 175, 86, 161, 148, 221, 113, 106, 194, 11, 69, 157, 117,
+			189, 186, 8, 88, 212, 18, 108, 165, 195, 107, 45, 161, 223, 148,
+			217, 119, 138, 32, 116, 124, 143, 45, 87, 150, 88, 17, 26, 20,
+			212, 171, 66, 233, 42, 101, 61, 191, 203, 218, 188, 199, 60, 63,
+			98, 221, 80, 176, 168, 229, 132, 172, 225, 184, 130, 137, 71, 53,
+			209, 137, 152, 227, 177, 154, 223, 238, 184, 14, 247, 106, 130, 237,
+			59, 81, 139, 69, 73, 247, 21, 202, 62, 162, 122, 240, 171, 17,
+			119, 60, 198, 89, 205, 239, 244, 152, 223, 72, 55, 99, 60, 162,
+			148, 225, 127, 173, 40, 234, 92, 89, 92, 220, 223, 223, 175, 112,
+			196, 20, 25, 235, 202, 118, 225, 226, 157, 245, 213, 181, 141, 237,
+			181, 133, 229, 202, 18, 165, 236, 190, 231, 138, 48, 100, 129, 120,
+			179, 235, 4, 162, 206, 170, 61, 198, 59, 29, 215, 169, 241, 170,
+			43, 152, 203, 247, 153, 31, 48, 222, 12, 132, 168, 179, 200, 7,
+			92, 247, 3, 39, 114, 188, 102, 153, 133, 126, 35, 218, 231, 129,
+			160, 172, 238, 132, 81, 224, 84, 187, 81, 31, 155, 52, 102, 78,
+			216, 215, 192, 247, 24, 247, 88, 97, 101, 155, 173, 111, 23, 216,
+			181, 149, 237, 245, 237, 50, 101, 15, 214, 119, 110, 109, 222, 223,
+			97, 15, 86, 182, 182, 86, 54, 118, 214, 215, 182, 217, 230, 22,
+			91, 221, 220, 184, 190, 190, 179, 190, 185, 177, 205, 54, 111, 176,
+			149, 141, 143, 176, 87, 215, 55, 174, 151, 153, 112, 162, 150, 8,
+			152, 120, 212, 9, 0, 123, 63, 96, 14, 48, 80, 212, 43, 148,
+			109, 11, 209, 55, 124, 195, 151, 179, 22, 118, 68, 205, 105, 56,
+			53, 6, 178, 214, 229, 77, 193, 154, 254, 158, 8, 60, 199, 107,
+			178, 142, 8, 218, 78, 8, 147, 24, 50, 238, 213, 41, 115, 157,
+			182, 19, 241, 8, 31, 28, 160, 168, 66, 105, 142, 26, 196, 54,
+			199, 51, 51, 240, 43, 103, 155, 118, 230, 52, 29, 163, 36, 119,
+			90, 254, 148, 15, 39, 51, 235, 248, 240, 144, 252, 41, 31, 30,
+			203, 148, 241, 161, 33, 127, 202, 135, 199, 51, 139, 248, 80, 253,
+			148, 15, 167, 50, 5, 124, 72, 229, 79, 249, 112, 58, 243, 44,
+			62, 124, 94, 254, 148, 15, 103, 50, 87, 241, 225, 25, 249, 243,
+			95, 159, 164, 196, 202, 216, 86, 148, 249, 164, 145, 255, 23, 39,
+			217, 10, 139, 87, 30, 11, 4, 176, 76, 120, 81, 200, 56, 235,
+			248, 142, 135, 242, 7, 11, 140, 57, 94, 93, 116, 132, 87, 23,
+			94, 4, 194, 197, 189, 158, 124, 254, 113, 223, 19, 204, 15, 152,
+			235, 215, 184, 75, 89, 141, 187, 194, 171, 243, 160, 204, 132, 87,
+			243, 235, 162, 206, 56, 244, 85, 243, 187, 242, 59, 165, 28, 128,
+			143, 172, 17, 240, 154, 100, 98, 250, 69, 68, 25, 106, 10, 132,
+			89, 32, 66, 223, 237, 66, 171, 10, 219, 105, 9, 213, 145, 3,
+			50, 233, 242, 200, 217, 19, 32, 119, 220, 99, 162, 227, 215, 90,
+			140, 71, 236, 254, 206, 42, 107, 59, 117, 15, 87, 176, 239, 81,
+			118, 155, 123, 93, 30, 244, 216, 185, 50, 59, 119, 249, 197, 165,
+			50, 82, 212, 18, 172, 19, 248, 174, 232, 68, 78, 141, 221, 12,
+			68, 211, 15, 28, 238, 197, 216, 179, 253, 150, 83, 107, 49, 241,
+			40, 18, 128, 108, 212, 18, 116, 88, 171, 42, 175, 61, 220, 231,
+			1, 180, 240, 89, 79, 240, 128, 249, 158, 0, 181, 176, 226, 186,
+			172, 237, 120, 221, 72, 132, 140, 7, 130, 93, 90, 138, 233, 115,
+			125, 175, 89, 97, 119, 4, 239, 36, 36, 7, 130, 21, 194, 182,
+			224, 129, 168, 23, 88, 232, 179, 168, 197, 35, 230, 249, 204, 21,
+			188, 67, 85, 51, 22, 225, 154, 115, 66, 230, 9, 1, 124, 5,
+			201, 117, 188, 72, 4, 157, 64, 72, 97, 44, 179, 110, 8, 242,
+			202, 217, 235, 203, 23, 22, 90, 126, 55, 96, 174, 227, 9, 30,
+			80, 134, 189, 127, 180, 8, 139, 63, 188, 178, 184, 88, 23, 123,
+			194, 245, 59, 34, 8, 181, 30, 174, 249, 109, 84, 164, 139, 216,
+			178, 4, 68, 0, 187, 3, 238, 53, 113, 141, 54, 2, 191, 205,
+			150, 150, 150, 206, 45, 224, 191, 157, 165, 165, 43, 248, 239, 53,
+			32, 253, 242, 229, 203, 151, 23, 206, 45, 47, 156, 63, 183, 179,
+			124, 254, 202, 197, 203, 87, 46, 94, 174, 92, 214, 255, 189, 86,
+			97, 215, 122, 20, 38, 50, 10, 156, 26, 40, 7, 248, 4, 73,
+			196, 222, 203, 108, 95, 48, 225, 133, 221, 0, 86, 38, 143, 0,
+			172, 1, 151, 125, 111, 79, 4, 17, 52, 150, 194, 226, 183, 217,
+			235, 91, 55, 86, 41, 59, 127, 254, 252, 229, 132, 22, 208, 100,
+			142, 136, 26, 168, 199, 130, 70, 109, 49, 104, 212, 160, 69, 37,
+			122, 20, 149, 88, 157, 71, 130, 129, 254, 241, 154, 33, 16, 245,
+			28, 91, 123, 196, 219, 29, 87, 132, 148, 234, 159, 236, 220, 21,
+			182, 234, 183, 59, 221, 72, 164, 214, 2, 14, 120, 111, 115, 123,
+			253, 187, 216, 27, 192, 153, 98, 233, 141, 138, 82, 162, 73, 163,
+			120, 239, 185, 42, 223, 196, 112, 37, 20, 209, 174, 154, 224, 34,
+			60, 45, 110, 220, 191, 115, 167, 84, 26, 218, 14, 229, 189, 184,
+			84, 186, 154, 194, 105, 249, 189, 112, 106, 138, 8, 122, 241, 27,
+			117, 222, 75, 225, 22, 70, 65, 183, 22, 225, 218, 220, 227, 46,
+			139, 246, 212, 136, 125, 205, 207, 70, 123, 101, 134, 8, 93, 253,
+			86, 73, 218, 171, 68, 123, 64, 224, 147, 40, 146, 141, 186, 161,
+			168, 177, 23, 216, 185, 165, 165, 126, 10, 207, 63, 150, 194, 7,
+			142, 119, 126, 153, 189, 113, 83, 68, 219, 189, 48, 18, 109, 120,
+			189, 18, 222, 112, 92, 177, 211, 63, 17, 55, 214, 239, 172, 237,
+			172, 223, 93, 99, 141, 72, 161, 241, 184, 111, 206, 54, 34, 141,
+			233, 253, 245, 141, 157, 75, 23, 88, 228, 212, 30, 134, 236, 101,
+			86, 44, 22, 229, 147, 82, 35, 170, 212, 247, 111, 57, 205, 214,
+			117, 30, 225, 87, 37, 246, 210, 75, 236, 252, 114, 137, 125, 15,
+			195, 119, 119, 252, 125, 253, 74, 243, 109, 113, 145, 173, 176, 7,
+			142, 87, 247, 247, 67, 236, 18, 22, 203, 185, 165, 165, 148, 14,
+			11, 43, 113, 3, 169, 165, 206, 93, 58, 184, 140, 226, 222, 224,
+			243, 115, 151, 46, 92, 184, 240, 226, 249, 75, 75, 137, 218, 168,
+			138, 134, 31, 8, 118, 223, 115, 30, 41, 93, 7, 202, 108, 176,
+			151, 202, 183, 54, 153, 69, 73, 63, 43, 22, 129, 130, 144, 45,
+			226, 100, 193, 191, 18, 91, 72, 163, 243, 30, 18, 12, 253, 156,
+			95, 78, 250, 57, 147, 234, 7, 5, 160, 212, 39, 0, 23, 30,
+			43, 0, 183, 249, 30, 103, 111, 200, 201, 175, 212, 186, 65, 32,
+			188, 8, 154, 220, 117, 92, 215, 9, 83, 2, 0, 218, 148, 181,
+			241, 41, 123, 153, 61, 254, 131, 39, 136, 57, 123, 57, 121, 90,
+			241, 196, 254, 181, 174, 227, 214, 69, 80, 44, 1, 97, 219, 138,
+			67, 106, 8, 201, 152, 146, 50, 165, 24, 99, 208, 102, 3, 101,
+			189, 232, 120, 17, 80, 174, 90, 74, 210, 21, 217, 192, 130, 82,
+			169, 82, 133, 158, 139, 125, 44, 184, 248, 30, 44, 88, 247, 194,
+			136, 123, 81, 197, 243, 247, 83, 84, 171, 167, 204, 243, 247, 217,
+			203, 172, 175, 205, 19, 9, 77, 240, 126, 111, 138, 61, 127, 191,
+			210, 20, 209, 26, 200, 154, 124, 86, 44, 165, 8, 239, 39, 94,
+			53, 6, 160, 56, 156, 208, 75, 143, 37, 84, 205, 150, 182, 50,
+			216, 189, 94, 212, 242, 61, 77, 234, 208, 105, 42, 150, 6, 94,
+			86, 110, 138, 104, 53, 153, 245, 98, 9, 53, 253, 237, 237, 205,
+			13, 118, 151, 119, 58, 142, 215, 164, 148, 173, 123, 242, 73, 195,
+			15, 218, 60, 42, 163, 217, 151, 224, 18, 245, 58, 184, 209, 245,
+			153, 45, 114, 227, 80, 22, 3, 197, 237, 231, 125, 237, 62, 114,
+			40, 176, 92, 120, 196, 156, 16, 199, 164, 234, 41, 12, 86, 120,
+			11, 172, 134, 183, 23, 222, 106, 251, 94, 212, 122, 123, 225, 173,
+			58, 239, 189, 189, 243, 22, 108, 221, 111, 95, 121, 171, 237, 120,
+			111, 95, 121, 43, 20, 181, 183, 95, 175, 188, 5, 198, 18, 232,
+			219, 183, 63, 250, 90, 129, 178, 253, 150, 8, 4, 147, 95, 67,
+			71, 220, 221, 231, 189, 80, 155, 188, 96, 104, 163, 37, 208, 0,
+			27, 160, 238, 52, 157, 40, 4, 147, 198, 21, 76, 141, 84, 102,
+			56, 84, 153, 50, 57, 88, 153, 225, 104, 101, 180, 203, 112, 72,
+			180, 74, 62, 46, 2, 127, 161, 195, 235, 192, 16, 216, 180, 247,
+			125, 221, 155, 224, 181, 22, 208, 37, 98, 43, 14, 172, 63, 165,
+			80, 202, 202, 126, 170, 113, 143, 53, 125, 214, 237, 192, 38, 126,
+			89, 127, 90, 116, 42, 162, 162, 30, 158, 27, 110, 235, 149, 202,
+			20, 199, 247, 59, 0, 113, 87, 142, 84, 120, 173, 192, 194, 110,
+			163, 225, 60, 2, 107, 212, 169, 113, 48, 175, 96, 22, 65, 72,
+			208, 14, 45, 22, 238, 239, 172, 22, 74, 87, 251, 158, 82, 230,
+			36, 46, 76, 133, 173, 128, 229, 23, 249, 231, 165, 48, 132, 34,
+			112, 184, 235, 124, 92, 4, 44, 108, 249, 93, 183, 174, 89, 9,
+			206, 216, 253, 157, 85, 86, 228, 97, 60, 26, 56, 64, 148, 21,
+			94, 43, 148, 96, 2, 60, 214, 9, 28, 79, 26, 52, 7, 69,
+			9, 24, 201, 251, 134, 234, 240, 32, 76, 134, 169, 10, 202, 208,
+			162, 3, 251, 166, 134, 174, 94, 213, 143, 90, 104, 191, 194, 183,
+			62, 250, 48, 154, 134, 240, 0, 30, 224, 38, 249, 141, 70, 40,
+			34, 52, 214, 110, 248, 224, 240, 224, 90, 43, 179, 194, 242, 210,
+			185, 23, 23, 150, 206, 45, 156, 187, 184, 179, 116, 238, 202, 249,
+			165, 43, 231, 46, 86, 150, 206, 189, 86, 80, 70, 121, 200, 16,
+			142, 55, 151, 14, 15, 35, 202, 176, 37, 142, 239, 123, 137, 213,
+			124, 177, 204, 160, 183, 138, 90, 64, 124, 143, 111, 215, 2, 167,
+			19, 149, 193, 214, 237, 51, 212, 56, 131, 205, 145, 249, 213, 143,
+			9, 48, 64, 124, 229, 203, 74, 97, 151, 150, 41, 138, 63, 104,
+			171, 58, 15, 234, 148, 189, 30, 249, 235, 219, 155, 219, 184, 200,
+			138, 165, 33, 230, 105, 165, 237, 127, 220, 113, 93, 142, 182, 157,
+			240, 22, 238, 111, 47, 214, 253, 90, 184, 248, 64, 84, 23, 19,
+			84, 22, 183, 68, 67, 4, 194, 171, 137, 197, 155, 174, 95, 229,
+			238, 238, 38, 226, 16, 46, 2, 66, 139, 169, 65, 74, 148, 181,
+			69, 212, 242, 235, 21, 208, 6, 82, 211, 148, 25, 143, 81, 98,
+			111, 128, 189, 8, 76, 175, 232, 31, 111, 104, 130, 128, 212, 170,
+			208, 212, 138, 58, 29, 74, 34, 101, 175, 191, 17, 70, 65, 3,
+			63, 77, 81, 228, 215, 194, 74, 7, 199, 67, 90, 150, 23, 93,
+			167, 26, 240, 160, 135, 70, 119, 165, 21, 181, 221, 231, 240, 151,
+			254, 182, 132, 174, 62, 141, 5, 89, 15, 2, 126, 42, 155, 63,
+			243, 145, 133, 51, 237, 133, 51, 245, 157, 51, 183, 174, 156, 185,
+			123, 229, 204, 118, 229, 76, 227, 181, 249, 10, 187, 227, 60, 20,
+			251, 14, 68, 29, 28, 152, 194, 61, 158, 204, 82, 55, 20, 178,
+			183, 219, 126, 157, 163, 176, 206, 135, 236, 245, 55, 214, 183, 55,
+			181, 73, 115, 3, 71, 64, 194, 149, 153, 245, 209, 34, 213, 241,
+			130, 143, 249, 117, 190, 0, 136, 85, 66, 191, 27, 212, 192, 26,
+			105, 138, 138, 39, 162, 69, 222, 113, 112, 78, 128, 44, 104, 133,
+			20, 45, 74, 116, 23, 15, 118, 143, 164, 38, 99, 80, 86, 2,
+			62, 198, 193, 11, 249, 93, 36, 2, 86, 227, 29, 92, 31, 126,
+			131, 53, 133, 39, 2, 46, 87, 154, 94, 101, 176, 42, 211, 236,
+			175, 80, 248, 207, 180, 50, 134, 109, 70, 185, 9, 250, 89, 131,
+			90, 86, 134, 100, 108, 243, 17, 57, 150, 255, 65, 131, 109, 37,
+			190, 173, 150, 123, 191, 129, 226, 14, 8, 179, 208, 241, 106, 105,
+			251, 138, 14, 55, 176, 216, 221, 110, 24, 177, 170, 120, 162, 67,
+			68, 135, 121, 68, 175, 49, 199, 171, 185, 221, 208, 217, 3, 23,
+			241, 48, 29, 1, 236, 70, 0, 189, 81, 13, 25, 182, 249, 40,
+			119, 84, 67, 166, 109, 62, 178, 39, 233, 187, 146, 16, 195, 54,
+			63, 65, 236, 252, 31, 24, 108, 195, 247, 22, 60, 209, 148, 222,
+			175, 214, 190, 72, 12, 87, 148, 129, 31, 60, 84, 175, 86, 216,
+			134, 250, 48, 118, 43, 247, 184, 219, 21, 33, 74, 91, 170, 179,
+			54, 80, 25, 70, 142, 235, 178, 22, 223, 19, 204, 75, 143, 137,
+			93, 171, 15, 65, 166, 120, 164, 220, 242, 134, 31, 128, 59, 172,
+			99, 6, 131, 204, 82, 174, 98, 89, 253, 143, 14, 97, 136, 49,
+			2, 100, 106, 134, 24, 64, 116, 238, 25, 13, 153, 182, 249, 137,
+			241, 137, 106, 86, 42, 85, 250, 11, 51, 116, 173, 233, 68, 173,
+			110, 21, 189, 87, 183, 91, 115, 240, 255, 22, 154, 254, 98, 205,
+			111, 183, 125, 15, 100, 115, 49, 242, 31, 66, 104, 43, 216, 19,
+			193, 98, 93, 184, 72, 132, 239, 237, 226, 99, 21, 225, 60, 148,
+			106, 83, 248, 11, 66, 39, 175, 199, 237, 118, 224, 205, 53, 191,
+			222, 179, 23, 168, 173, 62, 23, 245, 93, 7, 226, 32, 78, 212,
+			195, 152, 231, 216, 214, 68, 252, 102, 93, 189, 128, 230, 176, 231,
+			136, 48, 242, 131, 164, 57, 145, 205, 227, 55, 113, 243, 89, 58,
+			230, 132, 97, 87, 212, 119, 171, 189, 25, 19, 91, 229, 228, 131,
+			107, 233, 151, 60, 154, 177, 152, 81, 180, 244, 203, 149, 200, 206,
+			211, 156, 235, 52, 4, 48, 124, 102, 68, 190, 211, 48, 188, 3,
+			202, 157, 154, 8, 103, 178, 204, 132, 78, 53, 108, 175, 83, 138,
+			100, 239, 130, 229, 51, 51, 202, 140, 226, 145, 229, 23, 42, 41,
+			78, 84, 134, 112, 161, 130, 191, 32, 130, 186, 53, 22, 233, 159,
+			133, 59, 116, 44, 126, 110, 79, 81, 251, 254, 198, 171, 27, 155,
+			15, 54, 118, 119, 54, 95, 93, 219, 216, 221, 249, 200, 189, 181,
+			241, 140, 61, 65, 159, 185, 187, 178, 122, 107, 125, 99, 77, 62,
+			31, 55, 236, 99, 116, 252, 250, 218, 157, 181, 155, 43, 16, 196,
+			83, 79, 73, 193, 163, 211, 3, 35, 175, 121, 114, 63, 176, 79,
+			106, 156, 171, 126, 93, 242, 254, 176, 194, 3, 167, 232, 56, 205,
+			62, 20, 189, 93, 167, 174, 248, 60, 242, 80, 244, 214, 235, 240,
+			85, 16, 242, 221, 176, 197, 151, 47, 94, 66, 230, 30, 222, 26,
+			11, 66, 190, 141, 15, 110, 191, 59, 9, 145, 96, 43, 243, 178,
+			65, 127, 209, 192, 72, 176, 149, 177, 151, 127, 198, 232, 139, 4,
+			159, 187, 132, 38, 200, 157, 251, 171, 235, 108, 165, 27, 181, 252,
+			32, 172, 96, 152, 7, 67, 197, 96, 95, 0, 99, 49, 196, 120,
+			63, 20, 176, 24, 81, 55, 73, 101, 201, 192, 164, 4, 43, 68,
+			198, 20, 159, 54, 92, 172, 215, 150, 220, 120, 26, 126, 215, 171,
+			235, 224, 149, 10, 211, 98, 164, 56, 14, 56, 102, 51, 199, 232,
+			215, 76, 25, 221, 155, 200, 92, 48, 242, 239, 152, 108, 200, 28,
+			178, 186, 8, 107, 129, 83, 21, 160, 66, 35, 17, 120, 220, 133,
+			8, 73, 183, 22, 65, 8, 70, 5, 142, 149, 64, 67, 224, 26,
+			249, 11, 251, 255, 202, 129, 167, 140, 187, 46, 56, 190, 161, 15,
+			26, 84, 74, 150, 12, 114, 199, 18, 142, 234, 189, 198, 93, 87,
+			89, 52, 192, 65, 170, 219, 134, 204, 7, 218, 90, 220, 109, 0,
+			203, 176, 27, 225, 129, 245, 42, 2, 86, 76, 225, 33, 234, 76,
+			175, 161, 82, 133, 109, 235, 175, 129, 63, 84, 155, 79, 208, 92,
+			97, 213, 141, 90, 208, 24, 44, 68, 22, 118, 107, 45, 141, 78,
+			8, 209, 200, 154, 223, 70, 219, 24, 226, 59, 125, 67, 208, 120,
+			12, 160, 22, 246, 82, 100, 69, 155, 215, 90, 142, 167, 250, 46,
+			51, 7, 44, 121, 214, 233, 98, 104, 244, 214, 206, 206, 61, 214,
+			18, 188, 46, 130, 16, 98, 222, 108, 95, 0, 242, 172, 11, 126,
+			122, 178, 87, 129, 170, 11, 35, 193, 235, 64, 229, 64, 230, 163,
+			146, 50, 28, 125, 246, 80, 136, 14, 142, 10, 18, 206, 194, 54,
+			119, 221, 74, 178, 177, 77, 228, 102, 233, 125, 189, 175, 77, 18,
+			150, 191, 197, 180, 6, 73, 145, 143, 38, 74, 47, 44, 51, 81,
+			105, 86, 88, 1, 152, 121, 5, 88, 235, 123, 226, 195, 202, 70,
+			4, 141, 89, 168, 176, 45, 109, 21, 167, 55, 164, 73, 146, 211,
+			144, 97, 155, 147, 99, 179, 26, 50, 109, 115, 242, 212, 105, 250,
+			15, 227, 13, 105, 134, 176, 252, 143, 24, 9, 10, 126, 131, 237,
+			183, 124, 177, 39, 2, 205, 112, 81, 79, 240, 234, 195, 167, 22,
+			248, 222, 135, 121, 167, 19, 118, 252, 168, 82, 169, 20, 128, 227,
+			235, 158, 220, 202, 149, 201, 191, 174, 226, 188, 53, 63, 168, 139,
+			88, 242, 113, 22, 64, 166, 184, 83, 103, 117, 81, 237, 54, 155,
+			48, 155, 96, 50, 243, 110, 29, 147, 15, 125, 187, 201, 76, 76,
+			13, 236, 38, 51, 49, 53, 134, 105, 155, 51, 167, 78, 211, 47,
+			74, 106, 136, 109, 158, 34, 211, 249, 207, 27, 90, 184, 64, 170,
+			112, 83, 19, 109, 238, 184, 122, 89, 200, 209, 65, 122, 113, 249,
+			242, 136, 133, 78, 211, 67, 42, 157, 48, 89, 41, 15, 192, 59,
+			216, 19, 129, 211, 232, 105, 247, 0, 223, 97, 196, 24, 67, 202,
+			251, 176, 181, 214, 90, 162, 246, 80, 118, 3, 77, 80, 165, 7,
+			64, 180, 227, 81, 86, 224, 221, 168, 181, 128, 159, 45, 200, 1,
+			195, 2, 107, 6, 126, 183, 19, 211, 71, 70, 0, 107, 77, 31,
+			49, 108, 243, 212, 152, 173, 33, 211, 54, 79, 29, 159, 162, 27,
+			72, 158, 105, 155, 207, 146, 233, 252, 10, 187, 223, 39, 152, 32,
+			194, 218, 24, 66, 143, 38, 33, 131, 237, 131, 195, 1, 24, 213,
+			135, 200, 137, 57, 2, 29, 234, 145, 77, 195, 54, 159, 141, 71,
+			54, 97, 176, 227, 83, 116, 29, 71, 182, 108, 243, 121, 50, 149,
+			127, 137, 109, 116, 219, 85, 17, 164, 67, 253, 9, 91, 28, 88,
+			149, 94, 232, 212, 5, 100, 153, 246, 184, 235, 12, 27, 212, 26,
+			129, 190, 244, 160, 150, 97, 155, 207, 143, 77, 104, 200, 180, 205,
+			231, 143, 29, 167, 63, 33, 167, 115, 196, 54, 75, 228, 116, 254,
+			211, 177, 112, 58, 66, 101, 25, 82, 154, 35, 81, 28, 78, 216,
+			47, 162, 170, 217, 21, 52, 39, 234, 109, 148, 206, 21, 180, 189,
+			29, 238, 74, 203, 135, 21, 94, 40, 176, 182, 224, 222, 0, 29,
+			178, 79, 237, 162, 245, 244, 136, 67, 200, 25, 177, 0, 201, 24,
+			2, 148, 15, 105, 114, 70, 12, 219, 44, 217, 121, 13, 153, 182,
+			89, 58, 121, 138, 190, 14, 180, 129, 118, 47, 147, 37, 51, 127,
+			151, 189, 234, 40, 43, 22, 71, 15, 181, 44, 86, 211, 202, 0,
+			198, 23, 65, 153, 133, 66, 176, 187, 82, 147, 13, 217, 205, 53,
+			82, 168, 101, 202, 163, 227, 244, 25, 154, 5, 8, 244, 204, 130,
+			53, 75, 143, 208, 81, 9, 26, 0, 79, 37, 48, 177, 205, 133,
+			19, 249, 184, 185, 97, 155, 149, 84, 115, 88, 114, 21, 107, 34,
+			129, 137, 109, 86, 82, 205, 137, 109, 46, 166, 154, 131, 4, 47,
+			90, 199, 18, 24, 222, 159, 200, 211, 235, 64, 55, 201, 218, 230,
+			121, 50, 155, 127, 17, 201, 238, 91, 144, 101, 237, 180, 15, 90,
+			19, 67, 184, 158, 197, 110, 14, 105, 200, 176, 205, 243, 135, 167,
+			52, 100, 218, 230, 249, 19, 121, 250, 143, 13, 74, 44, 195, 182,
+			46, 103, 94, 54, 242, 63, 110, 176, 199, 152, 35, 176, 82, 247,
+			65, 142, 112, 218, 163, 46, 119, 221, 30, 171, 10, 199, 107, 38,
+			97, 133, 58, 70, 84, 226, 188, 26, 184, 141, 24, 89, 58, 176,
+			147, 22, 121, 3, 188, 30, 249, 185, 14, 66, 161, 75, 201, 170,
+			60, 20, 151, 46, 36, 78, 106, 192, 247, 169, 244, 228, 193, 165,
+			85, 219, 3, 176, 250, 114, 238, 52, 93, 160, 150, 101, 192, 180,
+			93, 33, 211, 5, 150, 70, 100, 136, 41, 32, 103, 221, 64, 63,
+			228, 138, 50, 187, 13, 156, 228, 43, 57, 91, 67, 166, 109, 94,
+			57, 62, 69, 63, 140, 29, 27, 182, 121, 149, 28, 43, 156, 103,
+			14, 238, 101, 188, 79, 202, 32, 32, 178, 7, 123, 238, 67, 209,
+			131, 109, 80, 38, 169, 64, 42, 193, 51, 86, 253, 129, 137, 127,
+			85, 173, 98, 131, 0, 218, 87, 199, 142, 106, 200, 180, 205, 171,
+			246, 36, 45, 226, 88, 196, 54, 95, 34, 211, 133, 89, 148, 107,
+			174, 173, 147, 249, 196, 2, 156, 215, 125, 130, 34, 124, 41, 198,
+			31, 196, 232, 165, 24, 127, 98, 218, 230, 75, 199, 167, 98, 183,
+			225, 135, 230, 232, 181, 247, 233, 54, 40, 11, 224, 241, 62, 195,
+			87, 9, 29, 31, 92, 92, 246, 179, 244, 176, 254, 176, 241, 102,
+			221, 83, 174, 194, 33, 245, 236, 198, 155, 117, 47, 101, 216, 87,
+			181, 111, 240, 24, 171, 223, 124, 130, 213, 111, 13, 88, 253, 147,
+			116, 164, 198, 193, 10, 6, 119, 192, 220, 178, 106, 124, 189, 110,
+			79, 211, 209, 154, 8, 162, 221, 208, 155, 201, 98, 251, 44, 128,
+			219, 158, 125, 99, 136, 31, 48, 223, 231, 7, 60, 94, 109, 252,
+			213, 57, 1, 15, 233, 177, 244, 176, 127, 181, 30, 192, 127, 155,
+			150, 30, 192, 237, 191, 14, 30, 192, 127, 36, 210, 3, 56, 146,
+			249, 176, 145, 255, 67, 114, 64, 233, 63, 149, 249, 175, 132, 52,
+			177, 104, 118, 226, 13, 5, 45, 151, 170, 24, 102, 1, 163, 174,
+			131, 16, 47, 76, 142, 10, 116, 122, 243, 24, 46, 137, 124, 159,
+			185, 60, 104, 138, 138, 140, 85, 34, 93, 129, 224, 161, 239, 61,
+			198, 102, 126, 42, 147, 153, 178, 162, 231, 99, 70, 28, 85, 77,
+			219, 169, 5, 58, 234, 209, 9, 68, 205, 1, 70, 150, 100, 84,
+			155, 135, 97, 183, 45, 24, 72, 61, 20, 122, 128, 154, 146, 202,
+			145, 121, 104, 153, 132, 50, 228, 140, 38, 183, 19, 182, 88, 215,
+			241, 162, 75, 23, 144, 71, 77, 17, 132, 48, 80, 196, 2, 238,
+			213, 253, 54, 171, 186, 126, 53, 212, 154, 23, 212, 229, 145, 220,
+			12, 253, 76, 28, 113, 154, 36, 249, 252, 223, 49, 52, 231, 99,
+			175, 34, 101, 104, 104, 27, 157, 21, 53, 167, 111, 124, 199, 245,
+			13, 232, 81, 153, 189, 226, 81, 4, 193, 24, 161, 18, 223, 28,
+			178, 39, 109, 223, 99, 27, 188, 141, 147, 196, 251, 8, 65, 93,
+			139, 251, 74, 149, 135, 50, 34, 169, 66, 135, 106, 254, 30, 111,
+			221, 31, 215, 16, 88, 247, 51, 39, 232, 63, 136, 173, 251, 19,
+			100, 58, 255, 195, 143, 179, 135, 255, 175, 219, 190, 176, 141, 156,
+			136, 105, 129, 109, 228, 68, 108, 129, 130, 109, 127, 34, 182, 125,
+			137, 109, 206, 125, 59, 109, 95, 216, 108, 230, 226, 145, 97, 179,
+			153, 139, 71, 38, 166, 109, 206, 29, 159, 162, 65, 218, 234, 22,
+			239, 219, 246, 133, 210, 171, 80, 218, 19, 144, 118, 5, 143, 55,
+			26, 102, 214, 61, 165, 101, 62, 49, 96, 153, 43, 73, 181, 108,
+			243, 44, 177, 65, 82, 215, 213, 102, 190, 186, 34, 103, 4, 103,
+			163, 206, 180, 108, 30, 16, 181, 200, 103, 109, 254, 80, 12, 204,
+			252, 78, 75, 132, 130, 173, 95, 15, 113, 33, 213, 69, 195, 241,
+			148, 223, 150, 182, 17, 106, 190, 215, 112, 154, 172, 184, 231, 112,
+			112, 147, 223, 236, 138, 93, 167, 206, 26, 142, 112, 235, 165, 152,
+			10, 48, 245, 207, 170, 13, 93, 154, 250, 103, 227, 56, 160, 101,
+			218, 230, 217, 241, 9, 250, 111, 137, 54, 245, 43, 228, 120, 254,
+			183, 9, 219, 78, 47, 231, 65, 77, 246, 148, 52, 224, 198, 137,
+			250, 66, 237, 150, 44, 242, 155, 2, 35, 23, 18, 89, 183, 167,
+			214, 115, 67, 78, 71, 170, 95, 169, 103, 164, 178, 166, 241, 32,
+			82, 208, 65, 59, 165, 81, 8, 196, 158, 95, 67, 147, 146, 21,
+			171, 61, 198, 195, 135, 142, 215, 236, 103, 212, 126, 11, 199, 149,
+			11, 185, 233, 236, 9, 175, 175, 7, 116, 19, 217, 234, 214, 157,
+			18, 72, 65, 220, 27, 14, 23, 246, 101, 207, 202, 172, 237, 67,
+			130, 7, 162, 48, 160, 201, 0, 195, 64, 184, 61, 8, 190, 136,
+			71, 29, 39, 232, 251, 210, 247, 220, 94, 60, 15, 35, 200, 93,
+			45, 77, 224, 149, 84, 198, 198, 53, 100, 218, 102, 101, 242, 24,
+			253, 11, 67, 187, 37, 23, 201, 101, 51, 255, 13, 227, 125, 248,
+			37, 160, 48, 180, 154, 4, 230, 13, 154, 197, 146, 144, 254, 239,
+			67, 80, 129, 15, 69, 79, 38, 5, 133, 215, 109, 35, 223, 49,
+			183, 132, 98, 164, 37, 160, 205, 163, 90, 107, 152, 217, 59, 47,
+			99, 53, 218, 120, 245, 27, 152, 37, 121, 8, 110, 69, 141, 203,
+			13, 139, 178, 14, 199, 68, 170, 223, 104, 64, 208, 40, 213, 22,
+			112, 144, 177, 44, 248, 66, 179, 10, 61, 167, 139, 163, 227, 244,
+			178, 242, 109, 50, 182, 121, 201, 154, 45, 188, 160, 9, 105, 195,
+			78, 82, 215, 133, 28, 208, 137, 68, 22, 252, 108, 204, 176, 210,
+			216, 239, 129, 174, 46, 13, 120, 89, 151, 250, 189, 172, 23, 7,
+			188, 172, 23, 7, 188, 172, 23, 251, 189, 172, 15, 14, 120, 89,
+			31, 28, 240, 178, 62, 120, 34, 79, 255, 137, 161, 221, 172, 87,
+			200, 108, 254, 39, 140, 39, 248, 89, 125, 54, 28, 204, 33, 90,
+			23, 160, 217, 163, 150, 15, 118, 65, 196, 138, 7, 237, 191, 18,
+			78, 102, 236, 32, 227, 146, 240, 219, 29, 30, 57, 85, 199, 133,
+			253, 17, 62, 167, 79, 205, 175, 88, 72, 193, 165, 123, 165, 207,
+			165, 123, 165, 207, 165, 123, 229, 68, 158, 254, 23, 229, 210, 221,
+			200, 220, 54, 242, 127, 108, 176, 97, 198, 229, 95, 198, 159, 211,
+			106, 230, 91, 113, 230, 250, 124, 57, 182, 37, 194, 174, 171, 202,
+			228, 176, 47, 204, 146, 212, 83, 223, 198, 205, 1, 209, 174, 218,
+			39, 62, 113, 113, 105, 137, 85, 123, 144, 18, 135, 162, 152, 148,
+			87, 120, 35, 55, 71, 95, 208, 94, 225, 77, 50, 93, 56, 153,
+			38, 39, 205, 136, 65, 151, 240, 102, 236, 82, 129, 68, 222, 236,
+			115, 9, 111, 166, 93, 194, 91, 127, 121, 151, 240, 150, 210, 50,
+			210, 37, 188, 213, 231, 18, 222, 74, 187, 132, 235, 79, 239, 18,
+			174, 199, 248, 3, 146, 235, 125, 46, 225, 122, 202, 37, 252, 255,
+			15, 211, 235, 239, 211, 37, 84, 97, 157, 93, 101, 21, 13, 113,
+			10, 243, 239, 85, 88, 95, 248, 95, 6, 61, 162, 172, 171, 21,
+			217, 13, 56, 42, 157, 192, 135, 228, 53, 248, 48, 210, 99, 28,
+			83, 79, 214, 235, 224, 47, 198, 91, 166, 246, 23, 229, 131, 245,
+			58, 212, 219, 163, 109, 166, 210, 71, 18, 0, 47, 180, 238, 132,
+			29, 151, 247, 118, 61, 174, 156, 197, 177, 173, 67, 234, 25, 152,
+			147, 118, 145, 142, 251, 16, 108, 92, 222, 173, 185, 142, 240, 34,
+			237, 58, 142, 109, 29, 145, 207, 87, 241, 241, 122, 221, 182, 169,
+			133, 174, 108, 22, 93, 89, 252, 109, 95, 161, 52, 16, 77, 39,
+			140, 192, 126, 65, 255, 241, 208, 114, 190, 242, 88, 139, 125, 43,
+			213, 250, 246, 167, 198, 164, 227, 53, 243, 215, 193, 241, 26, 163,
+			196, 204, 216, 102, 46, 83, 130, 16, 57, 250, 96, 207, 100, 102,
+			140, 252, 167, 99, 27, 90, 205, 114, 202, 3, 227, 108, 213, 245,
+			187, 117, 182, 190, 114, 55, 54, 180, 85, 43, 208, 5, 80, 173,
+			174, 75, 8, 106, 208, 48, 93, 174, 235, 240, 246, 98, 16, 87,
+			62, 64, 125, 237, 226, 222, 185, 69, 37, 44, 97, 37, 236, 27,
+			51, 124, 174, 31, 135, 196, 117, 121, 38, 55, 69, 175, 105, 207,
+			229, 8, 153, 41, 92, 68, 50, 157, 88, 245, 171, 30, 165, 101,
+			232, 239, 171, 128, 103, 216, 239, 22, 104, 93, 12, 106, 227, 72,
+			108, 48, 64, 255, 71, 198, 38, 53, 100, 218, 230, 145, 169, 105,
+			250, 33, 237, 97, 28, 37, 211, 133, 115, 216, 155, 20, 98, 220,
+			199, 67, 85, 232, 28, 143, 255, 152, 145, 64, 105, 28, 141, 71,
+			2, 165, 113, 180, 207, 1, 56, 122, 124, 138, 190, 168, 29, 128,
+			113, 50, 9, 59, 113, 75, 40, 247, 133, 215, 235, 80, 40, 245,
+			30, 67, 128, 14, 25, 143, 135, 0, 140, 199, 199, 142, 104, 200,
+			180, 205, 241, 9, 155, 94, 212, 150, 254, 4, 201, 23, 138, 42,
+			161, 20, 98, 137, 12, 24, 119, 79, 30, 0, 194, 232, 19, 241,
+			0, 16, 70, 159, 136, 29, 50, 48, 214, 39, 102, 78, 208, 171,
+			218, 86, 183, 201, 169, 66, 5, 123, 219, 132, 101, 176, 204, 228,
+			106, 5, 181, 27, 159, 98, 24, 62, 12, 88, 211, 118, 60, 12,
+			88, 211, 246, 216, 9, 13, 153, 182, 105, 207, 157, 164, 143, 180,
+			49, 61, 69, 236, 194, 28, 186, 163, 192, 29, 238, 49, 30, 134,
+			126, 205, 193, 178, 163, 22, 216, 145, 203, 119, 113, 61, 162, 245,
+			2, 133, 169, 174, 191, 143, 27, 123, 39, 240, 247, 156, 250, 112,
+			59, 143, 57, 81, 40, 220, 70, 25, 207, 185, 84, 123, 137, 212,
+			199, 155, 56, 88, 154, 83, 49, 142, 96, 105, 78, 141, 105, 139,
+			127, 196, 180, 205, 169, 241, 9, 90, 210, 6, 202, 52, 249, 64,
+			97, 78, 123, 109, 49, 185, 105, 83, 74, 237, 254, 216, 86, 167,
+			124, 192, 50, 152, 158, 59, 171, 33, 211, 54, 167, 75, 47, 196,
+			155, 192, 151, 191, 215, 160, 54, 234, 239, 93, 52, 213, 2, 165,
+			211, 237, 148, 78, 175, 200, 55, 239, 169, 218, 243, 223, 158, 194,
+			132, 252, 183, 33, 80, 153, 255, 182, 236, 108, 133, 55, 233, 244,
+			93, 199, 139, 210, 214, 2, 184, 158, 34, 140, 236, 15, 210, 153,
+			196, 158, 144, 232, 239, 170, 244, 159, 10, 208, 77, 37, 239, 251,
+			190, 156, 163, 99, 241, 62, 142, 219, 217, 225, 173, 228, 65, 225,
+			11, 132, 78, 14, 27, 143, 209, 67, 41, 63, 72, 13, 145, 126,
+			100, 63, 160, 147, 113, 55, 187, 220, 109, 250, 129, 19, 181, 218,
+			56, 194, 145, 229, 179, 125, 177, 77, 53, 213, 219, 186, 249, 138,
+			110, 189, 101, 135, 7, 158, 217, 47, 14, 134, 100, 159, 188, 213,
+			37, 225, 218, 151, 250, 130, 172, 22, 6, 89, 79, 14, 67, 100,
+			88, 104, 213, 126, 142, 62, 163, 54, 232, 176, 230, 119, 68, 56,
+			51, 130, 181, 28, 135, 229, 195, 109, 124, 86, 240, 232, 220, 224,
+			12, 93, 235, 221, 126, 176, 163, 217, 54, 78, 205, 143, 237, 71,
+			202, 166, 128, 159, 3, 72, 145, 247, 135, 84, 225, 191, 27, 116,
+			230, 160, 72, 132, 29, 223, 11, 5, 116, 45, 130, 192, 15, 118,
+			97, 251, 157, 49, 30, 223, 245, 26, 180, 90, 245, 235, 98, 107,
+			76, 232, 159, 64, 47, 2, 187, 109, 17, 134, 188, 41, 148, 169,
+			115, 24, 31, 222, 149, 207, 236, 77, 122, 68, 203, 154, 28, 84,
+			77, 72, 113, 216, 48, 195, 144, 220, 122, 38, 234, 195, 121, 158,
+			30, 213, 178, 191, 39, 45, 1, 101, 44, 29, 81, 143, 149, 125,
+			80, 248, 42, 161, 199, 134, 117, 104, 95, 167, 71, 7, 86, 15,
+			146, 126, 104, 121, 182, 15, 167, 254, 157, 120, 235, 72, 216, 7,
+			15, 195, 131, 12, 195, 195, 174, 211, 188, 148, 189, 93, 37, 29,
+			224, 90, 133, 161, 92, 130, 51, 199, 112, 228, 51, 195, 184, 33,
+			247, 143, 21, 108, 141, 2, 119, 43, 179, 53, 45, 187, 218, 228,
+			3, 175, 236, 29, 106, 131, 218, 216, 237, 211, 45, 51, 199, 177,
+			247, 231, 135, 245, 126, 167, 91, 115, 210, 236, 185, 149, 217, 26,
+			119, 7, 158, 93, 59, 156, 150, 189, 194, 247, 27, 116, 226, 0,
+			82, 96, 186, 246, 81, 164, 18, 40, 60, 213, 228, 228, 1, 17,
+			30, 75, 47, 156, 101, 154, 197, 0, 71, 239, 41, 22, 171, 106,
+			89, 120, 72, 199, 7, 41, 0, 129, 236, 39, 95, 162, 162, 243,
+			59, 18, 151, 100, 48, 242, 212, 131, 117, 104, 126, 221, 131, 188,
+			242, 192, 50, 146, 75, 182, 127, 129, 26, 239, 111, 129, 130, 63,
+			128, 128, 98, 139, 4, 10, 127, 68, 232, 236, 208, 33, 149, 12,
+			31, 163, 35, 152, 127, 71, 201, 205, 109, 73, 192, 254, 0, 157,
+			112, 60, 252, 233, 68, 189, 93, 25, 183, 87, 253, 142, 39, 47,
+			182, 240, 185, 61, 69, 179, 160, 59, 69, 29, 185, 158, 219, 82,
+			144, 125, 154, 30, 242, 124, 111, 23, 249, 44, 234, 51, 11, 248,
+			146, 122, 190, 183, 38, 159, 232, 6, 16, 27, 123, 40, 164, 15,
+			34, 27, 64, 124, 235, 161, 168, 219, 207, 211, 35, 202, 117, 220,
+			85, 105, 30, 233, 137, 28, 86, 79, 95, 197, 108, 15, 163, 135,
+			97, 55, 216, 173, 113, 233, 242, 140, 162, 203, 67, 225, 217, 42,
+			71, 143, 231, 238, 80, 153, 150, 43, 166, 159, 193, 105, 30, 129,
+			123, 252, 20, 194, 252, 105, 131, 230, 65, 49, 14, 4, 158, 244,
+			164, 190, 207, 58, 194, 15, 208, 137, 152, 239, 245, 174, 12, 213,
+			33, 231, 205, 173, 113, 253, 226, 186, 122, 222, 87, 239, 103, 246,
+			215, 251, 21, 254, 208, 160, 179, 67, 209, 74, 38, 62, 45, 219,
+			35, 209, 183, 42, 212, 246, 171, 116, 124, 208, 158, 81, 235, 143,
+			245, 241, 118, 0, 19, 96, 239, 214, 209, 228, 75, 124, 248, 212,
+			90, 249, 133, 251, 233, 252, 227, 56, 61, 28, 199, 159, 100, 249,
+			225, 41, 154, 191, 185, 185, 121, 243, 206, 218, 238, 230, 202, 253,
+			157, 91, 203, 187, 43, 171, 171, 107, 219, 219, 113, 26, 114, 138,
+			218, 80, 154, 182, 219, 159, 158, 36, 47, 92, 165, 246, 65, 227,
+			32, 221, 255, 202, 157, 155, 155, 227, 25, 123, 146, 30, 221, 190,
+			181, 178, 124, 241, 210, 238, 214, 246, 138, 124, 104, 188, 240, 95,
+			13, 58, 22, 239, 112, 246, 33, 58, 186, 125, 31, 71, 29, 207,
+			216, 39, 232, 241, 251, 27, 219, 247, 239, 221, 219, 220, 218, 89,
+			187, 190, 187, 189, 126, 115, 99, 101, 231, 254, 214, 218, 184, 97,
+			231, 233, 84, 250, 85, 42, 129, 74, 32, 129, 122, 109, 229, 250,
+			46, 28, 122, 219, 222, 89, 185, 123, 111, 220, 132, 230, 240, 104,
+			117, 109, 107, 103, 253, 198, 250, 234, 202, 206, 218, 238, 141, 205,
+			173, 187, 43, 59, 227, 150, 110, 158, 244, 62, 34, 7, 222, 217,
+			186, 191, 189, 179, 214, 247, 209, 120, 214, 158, 166, 147, 208, 90,
+			14, 184, 178, 117, 243, 254, 221, 181, 141, 157, 237, 241, 81, 120,
+			33, 31, 222, 93, 223, 216, 89, 223, 184, 185, 187, 182, 181, 181,
+			185, 53, 158, 131, 254, 147, 206, 110, 63, 216, 25, 31, 91, 254,
+			186, 73, 15, 225, 68, 128, 176, 137, 192, 110, 211, 241, 65, 51,
+			193, 254, 192, 48, 37, 118, 208, 152, 192, 5, 147, 47, 63, 93,
+			99, 37, 198, 251, 244, 248, 224, 59, 52, 131, 236, 165, 167, 233,
+			38, 109, 49, 189, 207, 129, 247, 232, 228, 16, 189, 106, 87, 134,
+			117, 50, 84, 1, 75, 106, 23, 159, 186, 125, 50, 238, 144, 101,
+			61, 124, 220, 161, 235, 255, 9, 227, 14, 109, 47, 199, 189, 253,
+			253, 13, 58, 106, 143, 88, 153, 223, 33, 255, 239, 7, 111, 102,
+			211, 193, 27, 248, 105, 216, 38, 205, 108, 226, 83, 98, 155, 135,
+			50, 119, 240, 167, 105, 155, 135, 51, 27, 244, 37, 74, 70, 50,
+			182, 117, 52, 51, 105, 228, 151, 144, 188, 135, 3, 9, 143, 3,
+			238, 47, 164, 92, 128, 167, 50, 44, 59, 2, 113, 145, 163, 35,
+			71, 232, 121, 106, 141, 96, 220, 101, 156, 60, 91, 56, 43, 83,
+			70, 78, 35, 21, 236, 134, 227, 222, 62, 84, 154, 58, 145, 114,
+			160, 208, 181, 133, 143, 32, 26, 65, 142, 104, 8, 238, 27, 56,
+			205, 232, 50, 118, 8, 113, 4, 242, 108, 225, 140, 14, 20, 40,
+			77, 25, 251, 201, 210, 130, 146, 200, 234, 254, 32, 128, 50, 65,
+			230, 52, 68, 108, 115, 226, 52, 163, 151, 176, 63, 2, 225, 131,
+			103, 11, 37, 6, 59, 223, 66, 124, 85, 66, 216, 242, 131, 136,
+			185, 206, 94, 42, 85, 216, 215, 39, 68, 76, 108, 114, 76, 67,
+			208, 205, 105, 70, 47, 80, 50, 98, 216, 214, 84, 230, 132, 145,
+			47, 178, 237, 110, 167, 227, 7, 16, 92, 192, 19, 108, 144, 34,
+			117, 188, 166, 206, 28, 43, 63, 82, 177, 13, 112, 156, 26, 153,
+			70, 182, 97, 141, 211, 52, 153, 122, 95, 108, 147, 181, 77, 211,
+			138, 109, 6, 38, 86, 166, 143, 29, 135, 32, 206, 136, 1, 216,
+			206, 144, 169, 66, 81, 38, 142, 68, 200, 30, 93, 92, 186, 60,
+			31, 50, 89, 54, 242, 192, 137, 90, 91, 219, 43, 107, 94, 45,
+			232, 225, 177, 52, 221, 37, 96, 53, 67, 38, 52, 68, 108, 115,
+			230, 216, 113, 234, 81, 50, 66, 108, 235, 84, 166, 100, 228, 171,
+			236, 158, 31, 134, 78, 213, 77, 73, 74, 131, 71, 220, 101, 232,
+			209, 132, 16, 234, 219, 240, 189, 190, 103, 24, 82, 9, 68, 212,
+			69, 185, 231, 33, 107, 6, 157, 90, 101, 93, 87, 108, 168, 70,
+			170, 70, 66, 241, 7, 40, 56, 53, 114, 132, 30, 162, 214, 8,
+			1, 254, 156, 38, 39, 17, 45, 130, 116, 159, 38, 99, 26, 34,
+			182, 121, 122, 118, 142, 126, 16, 27, 26, 182, 201, 200, 201, 194,
+			7, 36, 35, 135, 120, 206, 154, 159, 161, 158, 43, 170, 250, 1,
+			210, 25, 153, 214, 16, 177, 77, 54, 59, 71, 47, 96, 175, 4,
+			146, 223, 39, 11, 243, 233, 82, 224, 216, 72, 122, 108, 143, 64,
+			194, 179, 100, 70, 67, 208, 201, 236, 28, 157, 199, 30, 77, 219,
+			44, 144, 147, 133, 188, 74, 79, 239, 242, 40, 153, 238, 253, 192,
+			87, 249, 4, 104, 104, 64, 203, 163, 26, 34, 182, 89, 152, 157,
+			67, 169, 33, 16, 72, 123, 142, 156, 44, 156, 101, 109, 238, 66,
+			185, 49, 164, 248, 2, 214, 245, 98, 76, 210, 73, 86, 221, 33,
+			132, 204, 158, 139, 177, 178, 136, 109, 62, 55, 59, 7, 145, 185,
+			17, 66, 176, 40, 245, 100, 161, 146, 74, 73, 212, 125, 17, 66,
+			26, 17, 229, 8, 186, 215, 89, 69, 89, 23, 225, 36, 228, 66,
+			156, 235, 249, 24, 211, 17, 98, 155, 207, 207, 206, 209, 203, 216,
+			113, 214, 54, 207, 144, 147, 5, 56, 203, 133, 134, 94, 95, 246,
+			55, 238, 20, 123, 236, 65, 198, 173, 39, 34, 221, 45, 68, 186,
+			206, 196, 243, 146, 37, 182, 121, 102, 118, 142, 190, 132, 221, 142,
+			66, 102, 253, 100, 97, 81, 133, 248, 2, 38, 227, 7, 40, 111,
+			122, 36, 63, 64, 181, 179, 223, 114, 34, 225, 58, 97, 106, 126,
+			70, 13, 248, 252, 184, 134, 136, 109, 158, 141, 57, 145, 179, 205,
+			121, 228, 68, 215, 83, 58, 66, 212, 211, 50, 45, 3, 118, 109,
+			117, 102, 82, 229, 137, 116, 199, 57, 3, 190, 214, 29, 231, 136,
+			109, 206, 207, 206, 225, 194, 36, 100, 204, 54, 139, 228, 100, 161,
+			152, 204, 89, 153, 41, 47, 2, 184, 219, 133, 19, 121, 78, 195,
+			193, 184, 241, 237, 7, 59, 186, 203, 49, 3, 190, 211, 204, 29,
+			35, 182, 89, 156, 157, 163, 215, 40, 201, 66, 9, 109, 198, 49,
+			242, 151, 88, 202, 82, 193, 91, 98, 68, 27, 111, 63, 105, 195,
+			85, 58, 43, 247, 214, 117, 252, 54, 173, 204, 229, 98, 203, 194,
+			138, 42, 231, 38, 233, 191, 177, 168, 149, 69, 37, 126, 153, 108,
+			231, 127, 203, 98, 131, 22, 130, 62, 190, 6, 60, 102, 158, 216,
+			87, 157, 65, 204, 150, 247, 159, 85, 136, 213, 168, 170, 3, 82,
+			25, 121, 220, 205, 226, 232, 106, 90, 10, 246, 121, 58, 185, 14,
+			53, 254, 44, 10, 186, 48, 95, 108, 117, 165, 172, 202, 177, 240,
+			252, 171, 60, 203, 37, 103, 183, 8, 167, 185, 52, 255, 160, 77,
+			139, 163, 184, 86, 133, 128, 194, 0, 116, 181, 74, 21, 40, 68,
+			130, 99, 20, 186, 46, 0, 176, 160, 105, 165, 60, 48, 58, 188,
+			169, 249, 129, 140, 198, 64, 122, 50, 157, 250, 171, 176, 27, 142,
+			135, 121, 74, 71, 147, 165, 118, 105, 252, 12, 11, 19, 0, 77,
+			136, 62, 248, 1, 4, 10, 161, 62, 67, 51, 46, 165, 66, 30,
+			234, 156, 180, 46, 216, 88, 111, 48, 56, 112, 208, 139, 90, 48,
+			164, 194, 213, 239, 70, 101, 88, 18, 41, 206, 99, 21, 46, 104,
+			83, 61, 11, 125, 59, 22, 244, 180, 217, 167, 128, 225, 115, 253,
+			65, 93, 68, 220, 113, 69, 93, 189, 209, 1, 39, 6, 37, 44,
+			80, 141, 64, 15, 204, 185, 182, 146, 42, 108, 211, 99, 81, 192,
+			189, 208, 129, 51, 238, 90, 107, 39, 93, 35, 134, 78, 141, 14,
+			83, 239, 50, 54, 14, 178, 5, 181, 189, 217, 41, 13, 17, 219,
+			188, 60, 93, 209, 144, 105, 155, 151, 47, 127, 7, 253, 83, 19,
+			197, 16, 242, 189, 228, 163, 249, 175, 155, 7, 80, 66, 211, 246,
+			73, 178, 24, 115, 164, 79, 36, 41, 38, 245, 25, 103, 240, 181,
+			118, 87, 83, 236, 143, 112, 218, 60, 198, 93, 68, 93, 95, 201,
+			51, 56, 58, 14, 161, 6, 80, 18, 93, 247, 65, 232, 224, 120,
+			33, 77, 171, 182, 144, 21, 177, 8, 95, 116, 90, 162, 45, 2,
+			238, 66, 173, 28, 156, 14, 21, 65, 40, 165, 50, 37, 144, 40,
+			143, 136, 153, 22, 69, 122, 96, 37, 224, 110, 17, 148, 245, 150,
+			147, 22, 124, 172, 161, 129, 59, 126, 84, 94, 58, 94, 234, 84,
+			175, 117, 157, 31, 151, 218, 242, 96, 97, 158, 30, 191, 230, 114,
+			167, 141, 27, 249, 166, 231, 246, 216, 65, 87, 82, 113, 90, 111,
+			126, 241, 118, 3, 159, 172, 13, 217, 241, 227, 74, 151, 125, 14,
+			229, 65, 64, 213, 32, 79, 99, 249, 128, 109, 248, 70, 118, 86,
+			67, 196, 54, 111, 204, 125, 80, 67, 166, 109, 222, 88, 125, 157,
+			190, 43, 213, 20, 177, 205, 7, 228, 187, 243, 127, 96, 177, 33,
+			62, 5, 171, 11, 176, 184, 15, 214, 51, 0, 175, 212, 214, 21,
+			170, 3, 80, 113, 161, 218, 186, 218, 131, 28, 125, 24, 63, 174,
+			160, 75, 116, 3, 239, 43, 3, 24, 162, 83, 65, 85, 209, 120,
+			98, 225, 197, 124, 152, 174, 77, 130, 252, 138, 90, 195, 113, 165,
+			83, 24, 241, 168, 27, 42, 20, 162, 0, 199, 247, 177, 80, 10,
+			248, 213, 134, 99, 95, 78, 114, 162, 136, 241, 42, 84, 166, 36,
+			3, 235, 90, 85, 217, 13, 227, 33, 101, 29, 109, 165, 73, 9,
+			140, 85, 21, 202, 198, 129, 145, 65, 239, 120, 204, 81, 186, 8,
+			38, 149, 187, 129, 224, 245, 30, 213, 50, 6, 245, 108, 108, 165,
+			222, 118, 60, 184, 225, 140, 71, 190, 42, 190, 138, 47, 122, 131,
+			138, 44, 88, 45, 120, 142, 73, 154, 53, 234, 40, 46, 226, 136,
+			196, 109, 161, 68, 132, 87, 224, 202, 139, 39, 248, 129, 136, 163,
+			114, 63, 252, 70, 34, 95, 170, 72, 9, 110, 0, 146, 26, 6,
+			39, 110, 37, 104, 118, 219, 177, 70, 98, 141, 1, 19, 8, 251,
+			57, 240, 101, 90, 55, 225, 39, 131, 138, 45, 22, 72, 176, 226,
+			30, 100, 79, 104, 8, 132, 46, 127, 65, 67, 166, 109, 62, 120,
+			229, 53, 250, 103, 82, 32, 77, 219, 108, 145, 239, 206, 255, 7,
+			139, 13, 113, 54, 15, 168, 171, 36, 58, 148, 168, 160, 228, 27,
+			237, 126, 225, 169, 68, 92, 131, 58, 35, 26, 14, 57, 133, 152,
+			188, 131, 107, 194, 30, 123, 10, 17, 69, 1, 196, 6, 106, 196,
+			246, 91, 62, 139, 2, 167, 217, 132, 98, 7, 198, 217, 199, 252,
+			106, 169, 146, 20, 71, 67, 141, 93, 16, 232, 149, 112, 240, 236,
+			98, 57, 157, 165, 85, 26, 76, 95, 59, 129, 101, 81, 176, 184,
+			112, 41, 128, 100, 234, 240, 30, 219, 199, 235, 131, 84, 137, 165,
+			222, 45, 219, 234, 108, 53, 18, 171, 234, 25, 29, 47, 45, 225,
+			90, 17, 38, 12, 218, 234, 194, 21, 98, 30, 61, 184, 254, 100,
+			85, 230, 160, 188, 13, 153, 146, 88, 222, 96, 29, 116, 209, 145,
+			124, 26, 233, 74, 172, 109, 181, 151, 167, 190, 186, 23, 223, 175,
+			119, 93, 120, 78, 188, 205, 58, 13, 184, 3, 45, 53, 227, 1,
+			96, 175, 78, 156, 246, 57, 135, 223, 138, 136, 66, 178, 189, 21,
+			139, 168, 73, 108, 179, 21, 139, 40, 164, 222, 91, 175, 188, 70,
+			185, 172, 164, 111, 103, 122, 70, 254, 254, 1, 245, 171, 34, 39,
+			108, 63, 224, 29, 80, 153, 73, 146, 19, 53, 149, 210, 126, 67,
+			190, 128, 75, 40, 48, 135, 149, 42, 32, 111, 231, 78, 211, 31,
+			37, 186, 128, 60, 36, 103, 242, 127, 139, 160, 92, 233, 56, 235,
+			66, 170, 251, 97, 88, 168, 46, 203, 3, 22, 153, 210, 187, 20,
+			140, 48, 41, 111, 218, 185, 29, 210, 73, 37, 181, 13, 131, 40,
+			60, 16, 242, 244, 127, 228, 179, 16, 142, 229, 130, 112, 2, 165,
+			80, 102, 134, 53, 240, 32, 116, 124, 207, 199, 147, 152, 220, 117,
+			188, 166, 84, 94, 177, 30, 213, 200, 211, 152, 57, 24, 167, 134,
+			131, 255, 190, 23, 130, 99, 225, 213, 96, 181, 236, 199, 118, 166,
+			118, 252, 99, 103, 42, 46, 16, 128, 202, 146, 80, 21, 116, 73,
+			147, 40, 204, 49, 13, 153, 182, 25, 62, 247, 60, 253, 190, 184,
+			120, 253, 17, 153, 202, 239, 179, 157, 116, 79, 176, 182, 231, 15,
+			100, 170, 59, 60, 224, 109, 17, 137, 32, 156, 71, 146, 116, 217,
+			205, 48, 246, 12, 115, 141, 65, 184, 197, 35, 94, 131, 25, 224,
+			222, 224, 233, 211, 228, 114, 7, 3, 47, 119, 208, 149, 216, 80,
+			161, 254, 232, 216, 113, 122, 27, 171, 18, 71, 190, 39, 243, 105,
+			195, 200, 191, 60, 108, 80, 109, 253, 132, 170, 210, 1, 202, 18,
+			181, 228, 179, 4, 249, 84, 189, 223, 247, 228, 102, 233, 63, 53,
+			84, 193, 159, 245, 41, 131, 204, 228, 191, 96, 32, 43, 82, 211,
+			171, 234, 188, 209, 158, 195, 93, 157, 107, 189, 2, 23, 205, 172,
+			108, 111, 156, 75, 11, 28, 48, 70, 31, 78, 208, 231, 63, 116,
+			125, 179, 50, 136, 98, 139, 1, 13, 165, 162, 19, 205, 135, 108,
+			117, 67, 239, 245, 48, 114, 9, 151, 197, 234, 10, 131, 164, 12,
+			133, 79, 113, 31, 212, 21, 210, 186, 89, 133, 210, 103, 116, 5,
+			34, 96, 63, 170, 65, 195, 182, 62, 101, 228, 38, 53, 104, 2,
+			56, 53, 77, 63, 169, 138, 16, 173, 191, 105, 144, 133, 252, 155,
+			3, 115, 158, 76, 148, 198, 23, 38, 81, 22, 125, 199, 250, 3,
+			246, 16, 40, 86, 7, 65, 228, 128, 184, 227, 61, 110, 181, 39,
+			50, 32, 99, 15, 49, 182, 70, 22, 49, 56, 166, 65, 3, 192,
+			227, 69, 13, 154, 0, 126, 160, 76, 239, 170, 50, 70, 235, 251,
+			13, 242, 66, 254, 149, 212, 173, 65, 201, 25, 4, 61, 187, 224,
+			95, 213, 2, 1, 142, 97, 89, 175, 103, 117, 78, 162, 230, 250,
+			181, 135, 241, 216, 36, 139, 253, 205, 106, 208, 0, 112, 238, 140,
+			6, 77, 0, 139, 37, 250, 255, 225, 216, 166, 109, 253, 160, 65,
+			102, 243, 247, 146, 109, 75, 222, 88, 36, 143, 30, 170, 193, 69,
+			125, 144, 45, 186, 224, 71, 205, 104, 236, 4, 65, 220, 32, 20,
+			201, 180, 153, 89, 28, 224, 144, 6, 13, 0, 15, 79, 105, 16,
+			135, 63, 145, 167, 63, 32, 37, 212, 178, 173, 31, 49, 200, 243,
+			249, 79, 32, 54, 16, 107, 0, 145, 1, 207, 91, 150, 58, 192,
+			140, 53, 3, 184, 236, 44, 242, 211, 181, 79, 3, 14, 8, 110,
+			59, 192, 40, 198, 155, 28, 130, 98, 140, 39, 209, 11, 125, 185,
+			43, 248, 2, 158, 58, 127, 80, 185, 238, 131, 143, 191, 170, 183,
+			61, 137, 157, 37, 209, 137, 193, 17, 0, 15, 77, 104, 208, 0,
+			208, 62, 173, 65, 19, 192, 194, 115, 244, 30, 37, 22, 177, 179,
+			63, 102, 100, 62, 103, 24, 249, 107, 236, 73, 73, 5, 48, 19,
+			85, 101, 249, 16, 47, 9, 91, 86, 40, 61, 68, 77, 11, 68,
+			250, 199, 140, 220, 243, 244, 85, 106, 89, 16, 205, 179, 62, 99,
+			144, 137, 252, 203, 200, 168, 131, 206, 24, 43, 58, 30, 84, 89,
+			225, 109, 193, 176, 30, 245, 242, 149, 26, 23, 236, 224, 146, 162,
+			147, 224, 210, 250, 140, 65, 114, 26, 52, 108, 235, 51, 198, 216,
+			97, 13, 154, 0, 30, 29, 167, 139, 56, 178, 97, 91, 159, 5,
+			129, 121, 246, 189, 5, 70, 126, 15, 75, 225, 179, 90, 2, 48,
+			72, 104, 125, 86, 75, 0, 193, 165, 240, 89, 227, 68, 158, 126,
+			19, 138, 36, 77, 59, 251, 147, 70, 230, 23, 13, 89, 148, 237,
+			120, 195, 109, 91, 39, 76, 28, 163, 106, 143, 205, 15, 242, 109,
+			30, 84, 11, 61, 248, 28, 249, 57, 175, 99, 195, 202, 212, 233,
+			4, 62, 88, 45, 202, 199, 82, 232, 131, 180, 175, 71, 243, 169,
+			113, 180, 117, 175, 190, 10, 68, 163, 27, 74, 121, 132, 8, 150,
+			14, 95, 73, 135, 52, 173, 162, 209, 230, 161, 42, 102, 160, 110,
+			41, 194, 108, 68, 40, 212, 204, 154, 134, 109, 253, 164, 145, 99,
+			180, 67, 45, 203, 132, 153, 253, 41, 224, 239, 255, 137, 40, 49,
+			76, 129, 73, 50, 89, 28, 242, 144, 6, 13, 0, 213, 4, 153,
+			56, 253, 63, 5, 19, 180, 132, 232, 25, 182, 245, 179, 128, 94,
+			129, 109, 170, 243, 36, 131, 241, 144, 196, 154, 145, 253, 25, 35,
+			248, 73, 78, 131, 216, 195, 152, 238, 30, 230, 255, 103, 161, 251,
+			59, 216, 61, 177, 173, 159, 3, 85, 248, 33, 182, 25, 27, 148,
+			172, 168, 242, 164, 106, 0, 112, 74, 75, 253, 92, 238, 4, 126,
+			189, 91, 211, 174, 74, 60, 52, 104, 194, 159, 51, 136, 30, 11,
+			214, 208, 207, 25, 211, 103, 52, 104, 2, 88, 44, 209, 119, 64,
+			249, 152, 196, 180, 173, 95, 48, 200, 201, 252, 47, 233, 107, 2,
+			26, 78, 114, 112, 72, 89, 233, 177, 159, 168, 178, 207, 113, 52,
+			78, 13, 159, 82, 133, 48, 65, 112, 80, 11, 76, 2, 188, 203,
+			77, 145, 211, 232, 186, 113, 27, 136, 149, 40, 190, 133, 149, 20,
+			201, 250, 94, 17, 185, 168, 241, 42, 101, 80, 120, 125, 5, 78,
+			149, 129, 68, 120, 76, 181, 57, 130, 132, 104, 134, 131, 124, 253,
+			130, 49, 54, 163, 65, 36, 115, 118, 142, 62, 160, 196, 178, 236,
+			236, 47, 27, 153, 127, 110, 24, 249, 117, 54, 116, 169, 197, 92,
+			86, 2, 158, 172, 111, 216, 130, 250, 63, 209, 43, 7, 132, 26,
+			206, 203, 255, 178, 145, 155, 163, 247, 168, 101, 89, 32, 212, 191,
+			98, 144, 115, 249, 107, 108, 39, 205, 75, 157, 128, 74, 74, 74,
+			227, 27, 205, 99, 41, 214, 234, 12, 138, 79, 123, 90, 103, 89,
+			40, 180, 191, 98, 144, 57, 13, 26, 48, 194, 201, 178, 6, 77,
+			0, 23, 151, 232, 111, 195, 212, 162, 242, 252, 50, 76, 237, 175,
+			253, 229, 166, 54, 222, 102, 110, 113, 41, 119, 160, 71, 89, 225,
+			37, 222, 233, 44, 56, 245, 15, 45, 190, 212, 246, 235, 93, 87,
+			44, 168, 30, 62, 132, 119, 170, 196, 78, 52, 126, 209, 246, 61,
+			39, 242, 225, 138, 59, 188, 61, 16, 30, 201, 194, 93, 240, 27,
+			33, 0, 21, 178, 93, 207, 143, 118, 225, 198, 109, 30, 68, 26,
+			199, 180, 92, 91, 96, 75, 90, 95, 214, 51, 108, 161, 74, 253,
+			178, 158, 97, 11, 85, 234, 151, 141, 217, 57, 250, 195, 72, 124,
+			46, 99, 103, 223, 49, 200, 87, 12, 51, 255, 189, 210, 240, 211,
+			110, 116, 178, 92, 240, 177, 180, 91, 113, 95, 103, 161, 136, 24,
+			94, 42, 41, 175, 249, 6, 167, 152, 241, 212, 228, 163, 137, 144,
+			198, 13, 175, 148, 160, 67, 37, 34, 201, 243, 104, 2, 114, 25,
+			195, 182, 222, 49, 240, 4, 6, 76, 15, 177, 173, 223, 48, 172,
+			75, 10, 127, 88, 181, 191, 97, 88, 199, 53, 104, 0, 56, 181,
+			164, 65, 19, 192, 243, 23, 213, 167, 166, 109, 253, 166, 97, 45,
+			168, 151, 96, 109, 252, 166, 97, 29, 211, 160, 1, 224, 241, 121,
+			13, 98, 227, 23, 202, 244, 223, 193, 94, 51, 98, 103, 127, 215,
+			200, 252, 129, 97, 228, 255, 149, 193, 14, 148, 155, 37, 135, 122,
+			250, 18, 155, 202, 222, 72, 167, 76, 181, 66, 135, 115, 126, 112,
+			12, 26, 110, 247, 137, 124, 149, 222, 214, 27, 174, 188, 20, 40,
+			125, 146, 83, 46, 240, 248, 224, 79, 218, 144, 9, 193, 175, 194,
+			155, 228, 195, 200, 247, 113, 143, 147, 127, 190, 64, 21, 74, 223,
+			115, 121, 4, 146, 7, 221, 237, 108, 94, 223, 44, 238, 241, 186,
+			211, 14, 91, 165, 43, 108, 75, 180, 253, 61, 189, 179, 64, 94,
+			245, 119, 141, 220, 9, 202, 168, 101, 141, 192, 34, 252, 154, 65,
+			242, 5, 59, 237, 59, 168, 172, 11, 48, 104, 4, 13, 129, 175,
+			105, 185, 26, 193, 69, 245, 53, 99, 236, 184, 6, 77, 232, 96,
+			230, 4, 164, 206, 172, 17, 88, 83, 191, 103, 144, 153, 194, 153,
+			97, 194, 160, 207, 33, 21, 174, 193, 61, 230, 65, 65, 143, 0,
+			146, 251, 123, 201, 8, 32, 185, 191, 103, 140, 77, 106, 208, 4,
+			112, 106, 154, 158, 197, 17, 136, 109, 253, 190, 65, 230, 11, 51,
+			73, 109, 55, 162, 171, 2, 106, 161, 238, 20, 4, 230, 247, 181,
+			193, 59, 130, 6, 239, 239, 27, 115, 5, 13, 154, 0, 158, 57,
+			75, 191, 96, 81, 98, 101, 237, 236, 31, 27, 153, 127, 111, 24,
+			249, 207, 89, 108, 176, 178, 15, 103, 228, 113, 105, 236, 196, 188,
+			76, 77, 13, 28, 143, 132, 249, 193, 8, 146, 62, 210, 172, 47,
+			43, 226, 172, 138, 212, 107, 166, 160, 160, 136, 118, 85, 212, 67,
+			26, 247, 13, 69, 244, 224, 2, 169, 3, 143, 144, 90, 8, 83,
+			209, 73, 221, 44, 237, 172, 57, 210, 25, 80, 199, 114, 27, 144,
+			26, 128, 17, 91, 60, 193, 94, 222, 248, 198, 138, 122, 30, 206,
+			181, 180, 195, 134, 65, 107, 8, 245, 194, 42, 246, 65, 102, 81,
+			200, 35, 63, 57, 145, 237, 120, 108, 254, 187, 22, 128, 57, 11,
+			138, 59, 11, 202, 174, 74, 29, 239, 215, 90, 3, 105, 147, 186,
+			66, 7, 178, 149, 57, 11, 231, 252, 165, 191, 2, 242, 12, 23,
+			148, 118, 248, 155, 93, 161, 206, 173, 45, 232, 131, 112, 224, 77,
+			150, 41, 171, 194, 65, 65, 72, 56, 113, 60, 252, 167, 150, 199,
+			240, 40, 135, 62, 162, 135, 74, 135, 13, 41, 115, 167, 42, 58,
+			204, 165, 222, 134, 83, 91, 72, 36, 162, 9, 27, 114, 27, 174,
+			207, 134, 120, 240, 129, 64, 112, 124, 127, 36, 108, 99, 89, 195,
+			182, 254, 216, 200, 205, 224, 10, 202, 194, 10, 122, 215, 32, 179,
+			143, 93, 65, 89, 92, 65, 239, 106, 249, 206, 226, 10, 122, 87,
+			27, 59, 89, 220, 150, 222, 5, 99, 7, 228, 59, 11, 114, 250,
+			245, 247, 150, 239, 44, 90, 208, 95, 215, 242, 157, 69, 117, 255,
+			117, 45, 223, 89, 84, 247, 95, 55, 206, 156, 165, 111, 83, 98,
+			141, 218, 217, 63, 49, 50, 127, 106, 24, 121, 127, 120, 120, 56,
+			118, 59, 170, 126, 29, 99, 239, 67, 90, 177, 173, 123, 171, 232,
+			255, 43, 145, 137, 141, 0, 45, 140, 241, 225, 87, 12, 142, 170,
+			115, 175, 192, 175, 81, 195, 182, 254, 196, 200, 21, 104, 149, 90,
+			214, 40, 240, 235, 27, 96, 44, 238, 224, 254, 18, 171, 9, 252,
+			90, 186, 11, 232, 236, 75, 239, 82, 221, 216, 140, 7, 145, 135,
+			231, 76, 6, 18, 37, 64, 254, 40, 26, 2, 223, 208, 214, 235,
+			40, 114, 252, 27, 218, 122, 29, 69, 142, 127, 195, 80, 151, 6,
+			141, 2, 199, 191, 105, 144, 73, 200, 244, 198, 204, 6, 70, 84,
+			216, 90, 58, 104, 147, 222, 245, 14, 236, 94, 163, 184, 253, 126,
+			83, 79, 242, 40, 206, 199, 55, 141, 177, 35, 26, 52, 97, 144,
+			9, 155, 126, 55, 37, 86, 206, 206, 254, 153, 145, 249, 2, 49,
+			242, 27, 79, 12, 215, 199, 46, 141, 186, 78, 234, 189, 39, 6,
+			216, 157, 51, 108, 235, 207, 140, 220, 115, 244, 215, 96, 167, 207,
+			1, 191, 255, 28, 188, 194, 159, 55, 216, 78, 208, 21, 218, 211,
+			137, 51, 18, 113, 150, 102, 101, 224, 25, 180, 4, 211, 39, 9,
+			103, 64, 58, 2, 210, 183, 181, 8, 109, 81, 149, 19, 214, 217,
+			178, 158, 80, 129, 105, 232, 190, 22, 8, 52, 83, 185, 27, 106,
+			213, 4, 119, 155, 71, 50, 37, 38, 35, 230, 125, 23, 84, 148,
+			160, 13, 100, 251, 84, 118, 89, 49, 54, 135, 171, 231, 207, 13,
+			146, 213, 160, 1, 244, 140, 30, 214, 160, 9, 224, 209, 113, 250,
+			35, 146, 90, 136, 0, 17, 114, 58, 255, 125, 6, 187, 213, 109,
+			227, 41, 118, 94, 199, 172, 127, 216, 109, 183, 225, 143, 124, 224,
+			13, 116, 218, 35, 6, 227, 202, 139, 57, 176, 141, 77, 156, 143,
+			171, 224, 60, 156, 151, 211, 91, 88, 58, 178, 1, 34, 135, 151,
+			33, 202, 75, 23, 80, 221, 59, 13, 54, 143, 253, 204, 51, 39,
+			164, 172, 193, 221, 80, 203, 70, 14, 101, 227, 83, 68, 201, 70,
+			14, 101, 227, 83, 100, 44, 143, 178, 145, 67, 217, 248, 20, 57,
+			121, 138, 254, 79, 11, 73, 32, 182, 245, 67, 132, 216, 249, 255,
+			100, 13, 153, 176, 100, 50, 64, 219, 171, 172, 154, 78, 178, 13,
+			222, 53, 6, 77, 18, 187, 78, 133, 135, 116, 206, 83, 57, 173,
+			218, 192, 5, 137, 87, 249, 79, 202, 56, 107, 242, 160, 10, 14,
+			27, 219, 240, 117, 36, 80, 91, 251, 202, 170, 81, 146, 224, 246,
+			84, 56, 185, 172, 180, 181, 42, 32, 64, 225, 161, 184, 255, 104,
+			1, 129, 242, 14, 30, 38, 229, 3, 128, 52, 196, 91, 241, 115,
+			224, 155, 100, 91, 57, 93, 4, 84, 21, 144, 167, 129, 63, 127,
+			84, 4, 13, 45, 255, 12, 82, 9, 118, 168, 4, 199, 244, 158,
+			163, 168, 66, 63, 92, 5, 182, 33, 174, 29, 178, 54, 247, 120,
+			83, 60, 222, 110, 130, 157, 88, 244, 228, 5, 47, 112, 217, 6,
+			220, 169, 31, 159, 237, 14, 252, 72, 110, 89, 94, 157, 21, 124,
+			183, 94, 72, 230, 1, 142, 183, 213, 64, 227, 105, 202, 225, 254,
+			78, 6, 27, 45, 101, 69, 84, 225, 253, 21, 15, 136, 12, 12,
+			162, 251, 132, 148, 163, 223, 96, 226, 145, 140, 117, 139, 39, 240,
+			5, 131, 161, 3, 130, 169, 61, 63, 169, 32, 121, 200, 110, 124,
+			100, 189, 172, 195, 235, 61, 154, 160, 21, 200, 100, 100, 155, 187,
+			78, 205, 241, 187, 161, 219, 147, 116, 130, 32, 167, 150, 27, 25,
+			65, 241, 211, 203, 13, 180, 227, 15, 145, 209, 103, 52, 104, 2,
+			56, 62, 161, 151, 155, 105, 91, 63, 74, 200, 116, 254, 251, 134,
+			41, 151, 225, 178, 138, 76, 84, 6, 194, 1, 5, 162, 82, 10,
+			64, 104, 1, 234, 252, 11, 172, 19, 8, 184, 205, 93, 95, 197,
+			81, 23, 13, 222, 117, 35, 54, 143, 44, 153, 87, 154, 49, 228,
+			13, 52, 164, 37, 146, 224, 235, 254, 104, 66, 130, 105, 0, 56,
+			106, 107, 16, 113, 62, 54, 69, 255, 179, 36, 193, 178, 173, 207,
+			1, 9, 127, 244, 126, 73, 136, 27, 29, 80, 92, 202, 164, 138,
+			115, 28, 170, 142, 71, 211, 169, 60, 4, 180, 179, 60, 63, 174,
+			167, 1, 103, 79, 244, 84, 145, 85, 252, 151, 148, 40, 235, 4,
+			240, 103, 120, 34, 71, 132, 223, 22, 238, 88, 35, 72, 176, 230,
+			14, 56, 229, 159, 75, 184, 99, 153, 0, 30, 159, 194, 144, 98,
+			14, 132, 225, 199, 9, 153, 203, 191, 28, 223, 255, 146, 90, 84,
+			67, 226, 229, 202, 168, 117, 26, 169, 63, 2, 22, 143, 60, 34,
+			123, 211, 106, 112, 196, 0, 112, 108, 90, 131, 38, 128, 249, 89,
+			250, 183, 229, 188, 100, 109, 235, 243, 132, 228, 243, 31, 79, 93,
+			104, 52, 112, 249, 140, 206, 7, 164, 230, 2, 140, 24, 14, 104,
+			249, 67, 208, 128, 139, 19, 124, 119, 79, 23, 102, 204, 227, 77,
+			46, 243, 170, 66, 208, 111, 164, 243, 162, 176, 255, 107, 188, 179,
+			35, 136, 138, 198, 27, 236, 191, 207, 19, 229, 1, 229, 224, 144,
+			168, 245, 121, 50, 115, 130, 254, 93, 196, 27, 60, 235, 159, 38,
+			228, 103, 137, 153, 255, 36, 76, 168, 42, 152, 208, 179, 142, 215,
+			94, 21, 135, 154, 18, 7, 83, 54, 165, 10, 91, 107, 119, 192,
+			240, 109, 168, 59, 47, 80, 129, 38, 137, 82, 88, 74, 181, 212,
+			21, 90, 117, 145, 100, 100, 52, 250, 232, 87, 255, 52, 81, 126,
+			117, 142, 140, 218, 214, 207, 16, 235, 178, 194, 126, 52, 139, 32,
+			211, 160, 1, 224, 179, 231, 53, 104, 2, 120, 233, 131, 116, 155,
+			18, 107, 204, 206, 254, 60, 201, 252, 26, 49, 242, 107, 195, 179,
+			190, 7, 204, 200, 33, 173, 6, 173, 149, 49, 195, 182, 126, 158,
+			228, 10, 244, 239, 3, 247, 198, 192, 90, 249, 34, 33, 44, 255,
+			3, 58, 40, 19, 193, 86, 13, 46, 180, 244, 160, 135, 95, 21,
+			59, 244, 234, 218, 97, 23, 114, 110, 173, 125, 199, 253, 181, 237,
+			157, 205, 173, 244, 197, 156, 113, 148, 92, 137, 0, 88, 185, 3,
+			23, 39, 1, 59, 198, 208, 20, 249, 162, 22, 132, 49, 52, 69,
+			190, 72, 198, 102, 53, 104, 2, 238, 167, 78, 211, 127, 36, 73,
+			49, 108, 235, 75, 132, 156, 130, 195, 253, 183, 252, 125, 188, 114,
+			35, 37, 97, 241, 223, 120, 0, 212, 156, 122, 57, 117, 215, 148,
+			218, 0, 150, 202, 202, 217, 123, 228, 180, 187, 109, 166, 79, 246,
+			196, 217, 124, 181, 159, 99, 178, 22, 118, 150, 193, 52, 56, 216,
+			33, 176, 66, 213, 29, 235, 178, 176, 169, 38, 32, 197, 161, 83,
+			49, 99, 104, 154, 124, 137, 168, 12, 218, 24, 154, 38, 95, 34,
+			185, 19, 26, 52, 225, 237, 220, 73, 250, 189, 146, 36, 98, 91,
+			191, 10, 214, 85, 240, 196, 59, 83, 251, 203, 21, 6, 102, 140,
+			30, 188, 58, 181, 12, 21, 154, 112, 87, 42, 234, 73, 215, 141,
+			187, 27, 50, 5, 196, 66, 20, 98, 112, 4, 64, 149, 126, 25,
+			195, 221, 234, 87, 137, 157, 215, 160, 9, 224, 201, 83, 244, 53,
+			74, 44, 106, 103, 127, 157, 100, 126, 135, 24, 249, 59, 79, 44,
+			90, 24, 72, 36, 12, 147, 225, 116, 93, 3, 6, 85, 168, 97,
+			91, 191, 78, 114, 207, 193, 173, 14, 150, 69, 65, 138, 223, 33,
+			100, 50, 255, 247, 134, 198, 214, 202, 224, 250, 14, 56, 188, 3,
+			67, 104, 103, 246, 61, 253, 115, 205, 42, 229, 159, 39, 221, 72,
+			239, 124, 225, 59, 207, 73, 7, 157, 38, 30, 58, 240, 134, 162,
+			44, 191, 163, 101, 153, 162, 44, 191, 67, 148, 191, 66, 209, 69,
+			122, 135, 76, 216, 80, 86, 109, 81, 98, 216, 214, 87, 8, 153,
+			207, 159, 96, 15, 134, 58, 165, 113, 175, 224, 149, 126, 133, 40,
+			175, 148, 162, 56, 125, 133, 40, 175, 148, 162, 56, 125, 133, 156,
+			57, 75, 87, 177, 87, 98, 91, 191, 69, 200, 139, 249, 139, 143,
+			209, 147, 101, 44, 207, 72, 21, 104, 113, 151, 117, 186, 1, 196,
+			18, 146, 17, 33, 206, 243, 91, 132, 60, 171, 65, 3, 192, 194,
+			178, 6, 77, 0, 47, 94, 162, 91, 56, 162, 105, 91, 95, 37,
+			228, 100, 254, 250, 183, 37, 226, 43, 135, 0, 107, 227, 171, 9,
+			35, 193, 218, 248, 42, 81, 113, 87, 60, 16, 98, 125, 149, 204,
+			206, 85, 179, 157, 192, 143, 252, 243, 255, 123, 0, 64, 63, 87,
+			219, 79, 117, 0, 0},
 	)
 }
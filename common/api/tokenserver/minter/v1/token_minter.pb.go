@@ -11,12 +11,15 @@ It is generated from these files:
 It has these top-level messages:
 	MintMachineTokenRequest
 	MachineTokenRequest
+	MintMachineTokenByJWTRequest
 	MintMachineTokenResponse
 	MachineTokenResponse
 	OAuth2AccessToken
 	LuciMachineToken
 	InspectMachineTokenRequest
 	InspectMachineTokenResponse
+	MintDelegationTokenRequest
+	MintDelegationTokenResponse
 */
 package minter
 
@@ -29,6 +32,7 @@ import math "math"
 import google_protobuf "github.com/luci/luci-go/common/proto/google"
 import tokenserver "github.com/luci/luci-go/common/api/tokenserver"
 import tokenserver1 "github.com/luci/luci-go/common/api/tokenserver"
+import tokenserver2 "github.com/luci/luci-go/common/api/tokenserver"
 
 import (
 	context "golang.org/x/net/context"
@@ -108,6 +112,7 @@ const (
 	ErrorCode_UNTRUSTED_CERTIFICATE  ErrorCode = 6
 	ErrorCode_BAD_TOKEN_ARGUMENTS    ErrorCode = 7
 	ErrorCode_TOKEN_MINTING_ERROR    ErrorCode = 8
+	ErrorCode_UNTRUSTED_JWT          ErrorCode = 9
 )
 
 var ErrorCode_name = map[int32]string{
@@ -120,6 +125,7 @@ var ErrorCode_name = map[int32]string{
 	6: "UNTRUSTED_CERTIFICATE",
 	7: "BAD_TOKEN_ARGUMENTS",
 	8: "TOKEN_MINTING_ERROR",
+	9: "UNTRUSTED_JWT",
 }
 var ErrorCode_value = map[string]int32{
 	"SUCCESS":                0,
@@ -131,6 +137,7 @@ var ErrorCode_value = map[string]int32{
 	"UNTRUSTED_CERTIFICATE":  6,
 	"BAD_TOKEN_ARGUMENTS":    7,
 	"TOKEN_MINTING_ERROR":    8,
+	"UNTRUSTED_JWT":          9,
 }
 
 func (x ErrorCode) String() string {
@@ -193,8 +200,21 @@ func (m *MachineTokenRequest) GetIssuedAt() *google_protobuf.Timestamp {
 	return nil
 }
 
-// MintMachineTokenResponse is returned by 'MintMachineToken' if the server
-// processed the request.
+// MintMachineTokenByJWTRequest is passed to MintMachineTokenByJWT.
+type MintMachineTokenByJWTRequest struct {
+	// The JWT identity token (in its compact serialization form).
+	Jwt string `protobuf:"bytes,1,opt,name=jwt" json:"jwt,omitempty"`
+	// The token type being requested.
+	TokenType TokenType `protobuf:"varint,2,opt,name=token_type,json=tokenType,enum=tokenserver.minter.TokenType" json:"token_type,omitempty"`
+}
+
+func (m *MintMachineTokenByJWTRequest) Reset()                    { *m = MintMachineTokenByJWTRequest{} }
+func (m *MintMachineTokenByJWTRequest) String() string            { return proto.CompactTextString(m) }
+func (*MintMachineTokenByJWTRequest) ProtoMessage()               {}
+func (*MintMachineTokenByJWTRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+// MintMachineTokenResponse is returned by 'MintMachineToken' and
+// 'MintMachineTokenByJWT' if the server processed the request.
 //
 // It's returned even if server refuses to mint a token. It contains the error
 // details in that case.
@@ -217,7 +237,7 @@ type MintMachineTokenResponse struct {
 func (m *MintMachineTokenResponse) Reset()                    { *m = MintMachineTokenResponse{} }
 func (m *MintMachineTokenResponse) String() string            { return proto.CompactTextString(m) }
 func (*MintMachineTokenResponse) ProtoMessage()               {}
-func (*MintMachineTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*MintMachineTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *MintMachineTokenResponse) GetTokenResponse() *MachineTokenResponse {
 	if m != nil {
@@ -229,7 +249,7 @@ func (m *MintMachineTokenResponse) GetTokenResponse() *MachineTokenResponse {
 // MachineTokenResponse contains a token requested by MachineTokenRequest.
 type MachineTokenResponse struct {
 	// The service account associated with the returned token (if any).
-	ServiceAccount *tokenserver1.ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount" json:"service_account,omitempty"`
+	ServiceAccount *tokenserver2.ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount" json:"service_account,omitempty"`
 	// Identifier of the service and its version that produced the token.
 	//
 	// Has the form "<app-id>/<module-version>". Reported to the monitoring by
@@ -249,7 +269,7 @@ type MachineTokenResponse struct {
 func (m *MachineTokenResponse) Reset()                    { *m = MachineTokenResponse{} }
 func (m *MachineTokenResponse) String() string            { return proto.CompactTextString(m) }
 func (*MachineTokenResponse) ProtoMessage()               {}
-func (*MachineTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*MachineTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 type isMachineTokenResponse_TokenType interface {
 	isMachineTokenResponse_TokenType()
@@ -272,7 +292,7 @@ func (m *MachineTokenResponse) GetTokenType() isMachineTokenResponse_TokenType {
 	return nil
 }
 
-func (m *MachineTokenResponse) GetServiceAccount() *tokenserver1.ServiceAccount {
+func (m *MachineTokenResponse) GetServiceAccount() *tokenserver2.ServiceAccount {
 	if m != nil {
 		return m.ServiceAccount
 	}
@@ -382,7 +402,7 @@ type OAuth2AccessToken struct {
 func (m *OAuth2AccessToken) Reset()                    { *m = OAuth2AccessToken{} }
 func (m *OAuth2AccessToken) String() string            { return proto.CompactTextString(m) }
 func (*OAuth2AccessToken) ProtoMessage()               {}
-func (*OAuth2AccessToken) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*OAuth2AccessToken) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *OAuth2AccessToken) GetExpiry() *google_protobuf.Timestamp {
 	if m != nil {
@@ -410,7 +430,7 @@ type LuciMachineToken struct {
 func (m *LuciMachineToken) Reset()                    { *m = LuciMachineToken{} }
 func (m *LuciMachineToken) String() string            { return proto.CompactTextString(m) }
 func (*LuciMachineToken) ProtoMessage()               {}
-func (*LuciMachineToken) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*LuciMachineToken) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *LuciMachineToken) GetExpiry() *google_protobuf.Timestamp {
 	if m != nil {
@@ -434,7 +454,7 @@ type InspectMachineTokenRequest struct {
 func (m *InspectMachineTokenRequest) Reset()                    { *m = InspectMachineTokenRequest{} }
 func (m *InspectMachineTokenRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectMachineTokenRequest) ProtoMessage()               {}
-func (*InspectMachineTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*InspectMachineTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 // InspectMachineTokenResponse is return value of InspectMachineToken RPC call.
 type InspectMachineTokenResponse struct {
@@ -490,14 +510,14 @@ type InspectMachineTokenResponse struct {
 func (m *InspectMachineTokenResponse) Reset()                    { *m = InspectMachineTokenResponse{} }
 func (m *InspectMachineTokenResponse) String() string            { return proto.CompactTextString(m) }
 func (*InspectMachineTokenResponse) ProtoMessage()               {}
-func (*InspectMachineTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*InspectMachineTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 type isInspectMachineTokenResponse_TokenType interface {
 	isInspectMachineTokenResponse_TokenType()
 }
 
 type InspectMachineTokenResponse_LuciMachineToken struct {
-	LuciMachineToken *tokenserver.MachineTokenBody `protobuf:"bytes,20,opt,name=luci_machine_token,json=luciMachineToken,oneof"`
+	LuciMachineToken *tokenserver1.MachineTokenBody `protobuf:"bytes,20,opt,name=luci_machine_token,json=luciMachineToken,oneof"`
 }
 
 func (*InspectMachineTokenResponse_LuciMachineToken) isInspectMachineTokenResponse_TokenType() {}
@@ -509,7 +529,7 @@ func (m *InspectMachineTokenResponse) GetTokenType() isInspectMachineTokenRespon
 	return nil
 }
 
-func (m *InspectMachineTokenResponse) GetLuciMachineToken() *tokenserver.MachineTokenBody {
+func (m *InspectMachineTokenResponse) GetLuciMachineToken() *tokenserver1.MachineTokenBody {
 	if x, ok := m.GetTokenType().(*InspectMachineTokenResponse_LuciMachineToken); ok {
 		return x.LuciMachineToken
 	}
@@ -546,7 +566,7 @@ func _InspectMachineTokenResponse_OneofUnmarshaler(msg proto.Message, tag, wire
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(tokenserver.MachineTokenBody)
+		msg := new(tokenserver1.MachineTokenBody)
 		err := b.DecodeMessage(msg)
 		m.TokenType = &InspectMachineTokenResponse_LuciMachineToken{msg}
 		return true, err
@@ -571,15 +591,73 @@ func _InspectMachineTokenResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// MintDelegationTokenRequest is body of MintDelegationToken RPC call.
+type MintDelegationTokenRequest struct {
+	// Identity to put into the token, e.g. "user:someone@example.com".
+	//
+	// A special value "REQUESTOR" means the identity of the caller. Required.
+	DelegatedIdentity string `protobuf:"bytes,1,opt,name=delegated_identity,json=delegatedIdentity" json:"delegated_identity,omitempty"`
+	// How long the token should be valid, in seconds.
+	//
+	// If 0, the maximum duration allowed by the matching delegation rule is
+	// used. Must not exceed it.
+	ValidityDuration int64 `protobuf:"varint,2,opt,name=validity_duration,json=validityDuration" json:"validity_duration,omitempty"`
+	// Identities of services that should accept the token, e.g.
+	// "service:luci-dm", or "*" for all services. Required.
+	Services []string `protobuf:"bytes,3,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *MintDelegationTokenRequest) Reset()                    { *m = MintDelegationTokenRequest{} }
+func (m *MintDelegationTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*MintDelegationTokenRequest) ProtoMessage()               {}
+func (*MintDelegationTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+// MintDelegationTokenResponse is returned by MintDelegationToken on success.
+type MintDelegationTokenResponse struct {
+	// The generated token, as base64-encoded DelegationTokenEnvelope.
+	//
+	// It is expected to be sent to services in 'X-Delegation-Token-V1' HTTP
+	// header.
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	// When the token expires.
+	Expiry *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=expiry" json:"expiry,omitempty"`
+	// The decoded token body, for informational purposes.
+	DelegationToken *tokenserver.DelegationTokenBody `protobuf:"bytes,3,opt,name=delegation_token,json=delegationToken" json:"delegation_token,omitempty"`
+	// Identifier of the service and its version that produced the token.
+	ServiceVersion string `protobuf:"bytes,4,opt,name=service_version,json=serviceVersion" json:"service_version,omitempty"`
+}
+
+func (m *MintDelegationTokenResponse) Reset()                    { *m = MintDelegationTokenResponse{} }
+func (m *MintDelegationTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*MintDelegationTokenResponse) ProtoMessage()               {}
+func (*MintDelegationTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *MintDelegationTokenResponse) GetExpiry() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *MintDelegationTokenResponse) GetDelegationToken() *tokenserver.DelegationTokenBody {
+	if m != nil {
+		return m.DelegationToken
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MintMachineTokenRequest)(nil), "tokenserver.minter.MintMachineTokenRequest")
 	proto.RegisterType((*MachineTokenRequest)(nil), "tokenserver.minter.MachineTokenRequest")
+	proto.RegisterType((*MintMachineTokenByJWTRequest)(nil), "tokenserver.minter.MintMachineTokenByJWTRequest")
 	proto.RegisterType((*MintMachineTokenResponse)(nil), "tokenserver.minter.MintMachineTokenResponse")
 	proto.RegisterType((*MachineTokenResponse)(nil), "tokenserver.minter.MachineTokenResponse")
 	proto.RegisterType((*OAuth2AccessToken)(nil), "tokenserver.minter.OAuth2AccessToken")
 	proto.RegisterType((*LuciMachineToken)(nil), "tokenserver.minter.LuciMachineToken")
 	proto.RegisterType((*InspectMachineTokenRequest)(nil), "tokenserver.minter.InspectMachineTokenRequest")
 	proto.RegisterType((*InspectMachineTokenResponse)(nil), "tokenserver.minter.InspectMachineTokenResponse")
+	proto.RegisterType((*MintDelegationTokenRequest)(nil), "tokenserver.minter.MintDelegationTokenRequest")
+	proto.RegisterType((*MintDelegationTokenResponse)(nil), "tokenserver.minter.MintDelegationTokenResponse")
 	proto.RegisterEnum("tokenserver.minter.TokenType", TokenType_name, TokenType_value)
 	proto.RegisterEnum("tokenserver.minter.SignatureAlgorithm", SignatureAlgorithm_name, SignatureAlgorithm_value)
 	proto.RegisterEnum("tokenserver.minter.ErrorCode", ErrorCode_name, ErrorCode_value)
@@ -609,6 +687,18 @@ type TokenMinterClient interface {
 	// MintMachineTokenResponse. On transient errors it returns generic
 	// grpc.Internal error.
 	MintMachineToken(ctx context.Context, in *MintMachineTokenRequest, opts ...grpc.CallOption) (*MintMachineTokenResponse, error)
+	// MintMachineTokenByJWT generates a new token for a machine authenticated
+	// with a JWT identity token.
+	//
+	// It is an alternative to MintMachineToken for machines that don't have
+	// certificates (e.g. ephemeral containers). It checks that the JWT is signed
+	// by some trusted issuer, is not expired and is intended for the token
+	// server. Machine FQDN is extracted from the JWT claims.
+	//
+	// Only LUCI_MACHINE_TOKEN token type is supported.
+	//
+	// Errors are returned the same way as by MintMachineToken.
+	MintMachineTokenByJWT(ctx context.Context, in *MintMachineTokenByJWTRequest, opts ...grpc.CallOption) (*MintMachineTokenResponse, error)
 	// InspectMachineToken decodes a machine token and verifies it is valid.
 	//
 	// It verifies the token was signed by a private key of the token server and
@@ -625,6 +715,22 @@ type TokenMinterClient interface {
 	//   grpc.InvalidArgument error for unsupported token kind.
 	//   grpc.Internal error for transient errors.
 	InspectMachineToken(ctx context.Context, in *InspectMachineTokenRequest, opts ...grpc.CallOption) (*InspectMachineTokenResponse, error)
+	// MintDelegationToken generates a new delegation token.
+	//
+	// Delegation tokens allow LUCI services to call other LUCI services on
+	// behalf of some end user (e.g. the one who triggered a job). The token
+	// carries the delegated identity, the services that should accept it and
+	// its validity window.
+	//
+	// The caller must be allowed to mint the token by some DelegationRule in
+	// the token server config.
+	//
+	// Returns:
+	//   MintDelegationTokenResponse on success.
+	//   grpc.InvalidArgument error for malformed requests.
+	//   grpc.PermissionDenied error if no delegation rule allows the request.
+	//   grpc.Internal error for transient errors.
+	MintDelegationToken(ctx context.Context, in *MintDelegationTokenRequest, opts ...grpc.CallOption) (*MintDelegationTokenResponse, error)
 }
 type tokenMinterPRPCClient struct {
 	client *prpccommon.Client
@@ -643,6 +749,15 @@ func (c *tokenMinterPRPCClient) MintMachineToken(ctx context.Context, in *MintMa
 	return out, nil
 }
 
+func (c *tokenMinterPRPCClient) MintMachineTokenByJWT(ctx context.Context, in *MintMachineTokenByJWTRequest, opts ...grpc.CallOption) (*MintMachineTokenResponse, error) {
+	out := new(MintMachineTokenResponse)
+	err := c.client.Call(ctx, "tokenserver.minter.TokenMinter", "MintMachineTokenByJWT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tokenMinterPRPCClient) InspectMachineToken(ctx context.Context, in *InspectMachineTokenRequest, opts ...grpc.CallOption) (*InspectMachineTokenResponse, error) {
 	out := new(InspectMachineTokenResponse)
 	err := c.client.Call(ctx, "tokenserver.minter.TokenMinter", "InspectMachineToken", in, out, opts...)
@@ -652,6 +767,15 @@ func (c *tokenMinterPRPCClient) InspectMachineToken(ctx context.Context, in *Ins
 	return out, nil
 }
 
+func (c *tokenMinterPRPCClient) MintDelegationToken(ctx context.Context, in *MintDelegationTokenRequest, opts ...grpc.CallOption) (*MintDelegationTokenResponse, error) {
+	out := new(MintDelegationTokenResponse)
+	err := c.client.Call(ctx, "tokenserver.minter.TokenMinter", "MintDelegationToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type tokenMinterClient struct {
 	cc *grpc.ClientConn
 }
@@ -669,6 +793,15 @@ func (c *tokenMinterClient) MintMachineToken(ctx context.Context, in *MintMachin
 	return out, nil
 }
 
+func (c *tokenMinterClient) MintMachineTokenByJWT(ctx context.Context, in *MintMachineTokenByJWTRequest, opts ...grpc.CallOption) (*MintMachineTokenResponse, error) {
+	out := new(MintMachineTokenResponse)
+	err := grpc.Invoke(ctx, "/tokenserver.minter.TokenMinter/MintMachineTokenByJWT", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tokenMinterClient) InspectMachineToken(ctx context.Context, in *InspectMachineTokenRequest, opts ...grpc.CallOption) (*InspectMachineTokenResponse, error) {
 	out := new(InspectMachineTokenResponse)
 	err := grpc.Invoke(ctx, "/tokenserver.minter.TokenMinter/InspectMachineToken", in, out, c.cc, opts...)
@@ -678,6 +811,15 @@ func (c *tokenMinterClient) InspectMachineToken(ctx context.Context, in *Inspect
 	return out, nil
 }
 
+func (c *tokenMinterClient) MintDelegationToken(ctx context.Context, in *MintDelegationTokenRequest, opts ...grpc.CallOption) (*MintDelegationTokenResponse, error) {
+	out := new(MintDelegationTokenResponse)
+	err := grpc.Invoke(ctx, "/tokenserver.minter.TokenMinter/MintDelegationToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TokenMinter service
 
 type TokenMinterServer interface {
@@ -694,6 +836,18 @@ type TokenMinterServer interface {
 	// MintMachineTokenResponse. On transient errors it returns generic
 	// grpc.Internal error.
 	MintMachineToken(context.Context, *MintMachineTokenRequest) (*MintMachineTokenResponse, error)
+	// MintMachineTokenByJWT generates a new token for a machine authenticated
+	// with a JWT identity token.
+	//
+	// It is an alternative to MintMachineToken for machines that don't have
+	// certificates (e.g. ephemeral containers). It checks that the JWT is signed
+	// by some trusted issuer, is not expired and is intended for the token
+	// server. Machine FQDN is extracted from the JWT claims.
+	//
+	// Only LUCI_MACHINE_TOKEN token type is supported.
+	//
+	// Errors are returned the same way as by MintMachineToken.
+	MintMachineTokenByJWT(context.Context, *MintMachineTokenByJWTRequest) (*MintMachineTokenResponse, error)
 	// InspectMachineToken decodes a machine token and verifies it is valid.
 	//
 	// It verifies the token was signed by a private key of the token server and
@@ -710,6 +864,22 @@ type TokenMinterServer interface {
 	//   grpc.InvalidArgument error for unsupported token kind.
 	//   grpc.Internal error for transient errors.
 	InspectMachineToken(context.Context, *InspectMachineTokenRequest) (*InspectMachineTokenResponse, error)
+	// MintDelegationToken generates a new delegation token.
+	//
+	// Delegation tokens allow LUCI services to call other LUCI services on
+	// behalf of some end user (e.g. the one who triggered a job). The token
+	// carries the delegated identity, the services that should accept it and
+	// its validity window.
+	//
+	// The caller must be allowed to mint the token by some DelegationRule in
+	// the token server config.
+	//
+	// Returns:
+	//   MintDelegationTokenResponse on success.
+	//   grpc.InvalidArgument error for malformed requests.
+	//   grpc.PermissionDenied error if no delegation rule allows the request.
+	//   grpc.Internal error for transient errors.
+	MintDelegationToken(context.Context, *MintDelegationTokenRequest) (*MintDelegationTokenResponse, error)
 }
 
 func RegisterTokenMinterServer(s prpc.Registrar, srv TokenMinterServer) {
@@ -734,6 +904,24 @@ func _TokenMinter_MintMachineToken_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenMinter_MintMachineTokenByJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintMachineTokenByJWTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenMinterServer).MintMachineTokenByJWT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenserver.minter.TokenMinter/MintMachineTokenByJWT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenMinterServer).MintMachineTokenByJWT(ctx, req.(*MintMachineTokenByJWTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TokenMinter_InspectMachineToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InspectMachineTokenRequest)
 	if err := dec(in); err != nil {
@@ -752,6 +940,24 @@ func _TokenMinter_InspectMachineToken_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenMinter_MintDelegationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintDelegationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenMinterServer).MintDelegationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenserver.minter.TokenMinter/MintDelegationToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenMinterServer).MintDelegationToken(ctx, req.(*MintDelegationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TokenMinter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tokenserver.minter.TokenMinter",
 	HandlerType: (*TokenMinterServer)(nil),
@@ -760,81 +966,100 @@ var _TokenMinter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MintMachineToken",
 			Handler:    _TokenMinter_MintMachineToken_Handler,
 		},
+		{
+			MethodName: "MintMachineTokenByJWT",
+			Handler:    _TokenMinter_MintMachineTokenByJWT_Handler,
+		},
 		{
 			MethodName: "InspectMachineToken",
 			Handler:    _TokenMinter_InspectMachineToken_Handler,
 		},
+		{
+			MethodName: "MintDelegationToken",
+			Handler:    _TokenMinter_MintDelegationToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
 
 var fileDescriptor0 = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0xdb, 0x46,
-	0x13, 0x8d, 0xe4, 0xf8, 0x47, 0x23, 0xd9, 0xa1, 0xd7, 0x8e, 0xad, 0x4f, 0xf9, 0xd2, 0xb8, 0x6a,
-	0xda, 0x1a, 0x4e, 0x23, 0x01, 0x2a, 0xfa, 0x03, 0xb4, 0x37, 0xb4, 0xcc, 0xc8, 0x84, 0x4d, 0xd2,
-	0x58, 0x92, 0x0d, 0x7a, 0xb5, 0xa0, 0xa9, 0x8d, 0x4c, 0xd8, 0x24, 0x15, 0x92, 0x32, 0xaa, 0xbe,
-	0x41, 0xdf, 0xa5, 0xef, 0xd2, 0x37, 0x68, 0x5f, 0xa2, 0x40, 0x6f, 0xbb, 0xdc, 0x25, 0x2d, 0xd2,
-	0x62, 0x50, 0xe7, 0xc6, 0x26, 0xcf, 0x9e, 0x3d, 0x67, 0x66, 0x76, 0x66, 0x29, 0x40, 0x49, 0x78,
-	0x4d, 0x03, 0xe2, 0x7b, 0x41, 0x42, 0xa3, 0xde, 0x34, 0x0a, 0x93, 0x10, 0x09, 0x2c, 0xa6, 0xd1,
-	0x2d, 0x83, 0xc4, 0x4a, 0xe7, 0xc5, 0x24, 0x0c, 0x27, 0x37, 0xb4, 0xcf, 0x19, 0x97, 0xb3, 0x77,
-	0xfd, 0xc4, 0xf3, 0x69, 0x9c, 0x38, 0xfe, 0x54, 0x6c, 0xea, 0x1c, 0x4f, 0xbc, 0xe4, 0x6a, 0x76,
-	0xd9, 0x73, 0x43, 0xbf, 0x7f, 0x33, 0x73, 0x3d, 0xfe, 0xe7, 0xf5, 0x24, 0xec, 0x33, 0xc0, 0x0f,
-	0x83, 0xbe, 0x33, 0xf5, 0xfa, 0x05, 0xdd, 0xbe, 0xef, 0xb8, 0x57, 0x5e, 0x40, 0x09, 0xc7, 0x32,
-	0x8d, 0x93, 0x8f, 0xd4, 0x48, 0xff, 0x79, 0x2e, 0x25, 0x8e, 0xeb, 0x86, 0xb3, 0x20, 0x11, 0x2a,
-	0xdd, 0xf7, 0xb0, 0xaf, 0xb1, 0xa0, 0x35, 0x61, 0x60, 0xa5, 0x7c, 0x4c, 0xdf, 0xcf, 0x58, 0xb4,
-	0xe8, 0x7b, 0x68, 0xb3, 0x3d, 0x9e, 0x73, 0xe3, 0xfd, 0x4a, 0xc7, 0xc2, 0x9a, 0x44, 0x62, 0xad,
-	0x5d, 0x3b, 0xa8, 0x1d, 0xb6, 0xf0, 0xde, 0x62, 0xbd, 0xb4, 0xf3, 0xff, 0xd0, 0x88, 0xbd, 0x49,
-	0xe0, 0x24, 0xb3, 0x88, 0xb6, 0xeb, 0x9c, 0xba, 0x00, 0xba, 0xbf, 0xd7, 0x61, 0xa7, 0xca, 0xef,
-	0x00, 0x9a, 0x2e, 0x8d, 0x12, 0xef, 0x9d, 0xe7, 0x3a, 0x09, 0xcd, 0x2c, 0x8a, 0x10, 0x7a, 0x0b,
-	0x3b, 0x77, 0x32, 0xc4, 0xb9, 0x99, 0x84, 0x11, 0x2b, 0x81, 0xcf, 0x1d, 0xb6, 0x06, 0x5f, 0xf4,
-	0x96, 0x4f, 0xa2, 0x67, 0xe6, 0x74, 0x39, 0x67, 0x63, 0x14, 0x2f, 0x61, 0xe8, 0x3b, 0x68, 0x78,
-	0x71, 0x3c, 0x63, 0x69, 0x3a, 0x49, 0x7b, 0x85, 0xc9, 0x35, 0x07, 0x9d, 0x9e, 0x38, 0xc4, 0x5e,
-	0x7e, 0x88, 0x3d, 0x2b, 0x3f, 0x44, 0xbc, 0x21, 0xc8, 0x72, 0x82, 0x7e, 0x04, 0x10, 0x85, 0x49,
-	0xe6, 0x53, 0xda, 0x7e, 0xcc, 0x03, 0x79, 0x5e, 0x15, 0x08, 0xcf, 0xd4, 0x62, 0x24, 0xdc, 0x48,
-	0xf2, 0x47, 0xf4, 0x19, 0x6c, 0x86, 0xce, 0x2c, 0xb9, 0x1a, 0x90, 0xd8, 0x0d, 0xa7, 0x34, 0x6e,
-	0xaf, 0x1e, 0xac, 0x1c, 0x36, 0x70, 0x4b, 0x80, 0x26, 0xc7, 0xba, 0xff, 0xd4, 0xa0, 0xbd, 0x7c,
-	0x44, 0xf1, 0x34, 0x64, 0x0e, 0xa9, 0x3f, 0x8d, 0xa2, 0x30, 0x22, 0x6e, 0x38, 0x16, 0x25, 0xfb,
-	0x80, 0xbf, 0x92, 0xb2, 0x86, 0x8c, 0x84, 0x1b, 0x34, 0x7f, 0x4c, 0xfd, 0xc5, 0x6e, 0x96, 0x58,
-	0xec, 0x4c, 0xc4, 0x59, 0x31, 0x7f, 0x0e, 0x6a, 0x02, 0x43, 0x06, 0x6c, 0xe5, 0x67, 0x2f, 0x4c,
-	0xb3, 0x02, 0x1d, 0x56, 0xd9, 0x54, 0x05, 0x89, 0x37, 0x93, 0x52, 0xcc, 0x5f, 0xc2, 0x93, 0xbc,
-	0x17, 0xd9, 0xce, 0xd8, 0x0b, 0x03, 0x5e, 0xb8, 0x06, 0xde, 0xca, 0xe0, 0x9f, 0x04, 0xda, 0xfd,
-	0xa3, 0x0e, 0xbb, 0x95, 0x59, 0x9f, 0x2c, 0x14, 0xb2, 0x6e, 0xe6, 0xa9, 0x37, 0x07, 0xcf, 0x4a,
-	0x31, 0x99, 0x82, 0x23, 0x0b, 0xca, 0x9d, 0x7c, 0xf6, 0x5e, 0x15, 0x47, 0xbd, 0x2a, 0x0e, 0x34,
-	0x86, 0x8e, 0xe8, 0x05, 0x92, 0x9d, 0x16, 0x33, 0x65, 0xc5, 0x11, 0x23, 0xd1, 0xde, 0xe5, 0xce,
-	0x9f, 0x57, 0x55, 0xc3, 0x90, 0x53, 0xba, 0xcc, 0xd9, 0x3c, 0x83, 0xd3, 0x47, 0x78, 0x5f, 0x48,
-	0x19, 0xce, 0xbd, 0x25, 0x64, 0x01, 0x4a, 0x27, 0x98, 0x94, 0x66, 0xbd, 0xfd, 0x94, 0xab, 0xbf,
-	0xac, 0x52, 0x3f, 0x67, 0xec, 0x62, 0x79, 0x98, 0xb8, 0x74, 0x73, 0x0f, 0x3b, 0x6e, 0x15, 0x1b,
-	0xb4, 0xfb, 0x5b, 0x0d, 0xb6, 0x97, 0x82, 0x42, 0x9f, 0x42, 0xab, 0x94, 0x51, 0x8d, 0x57, 0xa1,
-	0xe9, 0x14, 0x28, 0xcf, 0x4b, 0x7d, 0x2e, 0xca, 0x54, 0x68, 0xe4, 0x01, 0xac, 0xd1, 0x5f, 0xa6,
-	0x5e, 0x34, 0x7f, 0xc0, 0xf0, 0x64, 0xcc, 0xee, 0x35, 0x48, 0xf7, 0x33, 0x48, 0x1b, 0xb2, 0x9c,
-	0xbe, 0x08, 0xa5, 0xe5, 0x17, 0x49, 0x0b, 0xb3, 0xfa, 0x83, 0xcd, 0xa6, 0xd0, 0x51, 0x83, 0x78,
-	0x4a, 0xdd, 0xca, 0x9b, 0xae, 0x3c, 0xc5, 0xb5, 0x8f, 0x9c, 0xe2, 0x5d, 0x58, 0x15, 0xc1, 0x8a,
-	0xb2, 0x88, 0x97, 0xee, 0x5f, 0x75, 0x78, 0x56, 0x69, 0x99, 0xf5, 0x30, 0xdb, 0x75, 0xcb, 0xae,
-	0xce, 0x31, 0xb7, 0xdb, 0xc0, 0xe2, 0x05, 0xbd, 0x82, 0x6d, 0x2f, 0xe0, 0x8f, 0x5e, 0x32, 0x67,
-	0x13, 0xe7, 0xc4, 0x77, 0x5d, 0x29, 0x2d, 0x16, 0x30, 0xc7, 0xd1, 0x1e, 0xac, 0xa5, 0x77, 0x19,
-	0x1d, 0xf3, 0xaa, 0x6f, 0xe0, 0xec, 0x0d, 0xbd, 0x80, 0x66, 0x10, 0x06, 0x84, 0xa7, 0xce, 0x16,
-	0x5f, 0xf3, 0x45, 0x60, 0x90, 0x22, 0x90, 0x9c, 0x10, 0xd1, 0x5b, 0x16, 0xd2, 0x98, 0xdd, 0x3a,
-	0x39, 0x01, 0x0b, 0x04, 0xbd, 0x84, 0xad, 0x54, 0xcb, 0x0b, 0x26, 0xe4, 0x9a, 0xce, 0x09, 0x8b,
-	0x72, 0x4d, 0x1c, 0x44, 0x86, 0x9e, 0xd1, 0xb9, 0x3a, 0x66, 0x17, 0x76, 0x2b, 0xbd, 0x9d, 0x89,
-	0xeb, 0x90, 0xc0, 0xf1, 0x69, 0x7b, 0x9d, 0x73, 0x20, 0xc5, 0x86, 0x8e, 0xce, 0x10, 0xa4, 0x55,
-	0xf6, 0xb4, 0x98, 0x98, 0x72, 0x81, 0x4b, 0x4d, 0x1b, 0x8e, 0xe7, 0xff, 0xdd, 0xcc, 0x47, 0x36,
-	0x34, 0xee, 0xce, 0x03, 0x49, 0xd0, 0xb2, 0xf5, 0x33, 0xdd, 0x78, 0xab, 0x13, 0xeb, 0xe7, 0x0b,
-	0x45, 0x7a, 0x84, 0x3e, 0x81, 0xce, 0xc8, 0x30, 0x46, 0xe7, 0x0a, 0x31, 0x64, 0xdb, 0x3a, 0x1d,
-	0x10, 0x79, 0x38, 0x54, 0x4c, 0x93, 0x58, 0xc6, 0x99, 0xa2, 0x4b, 0x35, 0x56, 0x3d, 0x74, 0x6e,
-	0x0f, 0x55, 0xa2, 0xc9, 0xc3, 0x53, 0x55, 0x57, 0x32, 0xbc, 0x7e, 0xf4, 0x03, 0xa0, 0xe5, 0xaf,
-	0x46, 0x51, 0x5f, 0x3e, 0x1f, 0x19, 0x4c, 0x7f, 0x07, 0x9e, 0x98, 0xa7, 0xf2, 0xe0, 0x9b, 0x6f,
-	0x09, 0x36, 0x65, 0x01, 0xd6, 0x8e, 0xfe, 0xac, 0x41, 0xe3, 0xee, 0xaa, 0x45, 0x4d, 0x58, 0x37,
-	0x6d, 0xee, 0xca, 0xf8, 0xff, 0x83, 0xa7, 0xb6, 0x6e, 0xda, 0x17, 0x17, 0x06, 0xb6, 0x94, 0x13,
-	0x62, 0xaa, 0x23, 0x5d, 0xb6, 0x6c, 0xac, 0xb0, 0x50, 0x3a, 0xb0, 0x57, 0x5c, 0xe2, 0x91, 0x88,
-	0x34, 0xea, 0x68, 0x1b, 0x36, 0x8f, 0x65, 0x86, 0xa9, 0x9a, 0x62, 0x5a, 0xb2, 0x76, 0x21, 0xad,
-	0xa4, 0xf4, 0x14, 0x1a, 0x2a, 0xd8, 0x52, 0xdf, 0xa8, 0x43, 0xd9, 0x52, 0xc8, 0x1b, 0x03, 0x6b,
-	0xb2, 0x25, 0x3d, 0xce, 0xe9, 0x0b, 0xf5, 0x55, 0x61, 0x6c, 0x61, 0xdb, 0x4c, 0xb5, 0x0b, 0x9b,
-	0xa4, 0x35, 0xb4, 0x0f, 0x3b, 0x5c, 0x9c, 0x1b, 0xca, 0x78, 0x64, 0x6b, 0x8a, 0x6e, 0x99, 0xd2,
-	0x7a, 0xba, 0x20, 0x40, 0x4d, 0xd5, 0x2d, 0x55, 0x1f, 0x11, 0x05, 0x63, 0x03, 0x4b, 0x1b, 0x83,
-	0xbf, 0x6b, 0xd0, 0xe4, 0x55, 0xd7, 0xf8, 0x44, 0x20, 0x1f, 0xa4, 0xfb, 0x1f, 0x27, 0xf4, 0xaa,
-	0xf2, 0xcb, 0x50, 0xfd, 0x2b, 0xa3, 0xf3, 0xd5, 0xc3, 0xc8, 0xd9, 0xd4, 0xdc, 0xc2, 0x4e, 0xc5,
-	0x50, 0xa1, 0x5e, 0x95, 0xc8, 0x87, 0x07, 0xbe, 0xd3, 0x7f, 0x30, 0x5f, 0xf8, 0x5e, 0xae, 0xf1,
-	0xbb, 0xe5, 0xeb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x09, 0xd5, 0x07, 0x6d, 0x02, 0x0a, 0x00,
-	0x00,
+	// 1248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0x8e, 0xed, 0x26, 0x8d, 0x8f, 0xdd, 0x44, 0xd9, 0xa4, 0xa9, 0x70, 0x5b, 0x1a, 0x4c, 0x81,
+	0x4c, 0x4b, 0x6d, 0xc6, 0x0c, 0x3f, 0x33, 0x70, 0xa3, 0x38, 0x6a, 0xe2, 0x26, 0xb6, 0x32, 0x2b,
+	0x99, 0x0c, 0x57, 0x3b, 0xaa, 0xb4, 0x75, 0x96, 0x58, 0x92, 0x2b, 0xad, 0x53, 0xcc, 0x1b, 0x70,
+	0xcf, 0x63, 0xf0, 0x2e, 0x3c, 0x01, 0xc3, 0x15, 0x0f, 0xc0, 0x15, 0xb7, 0x8c, 0x76, 0x25, 0xdb,
+	0xb2, 0xd5, 0x19, 0x87, 0x1b, 0x5b, 0xfa, 0xf6, 0xd3, 0x77, 0xce, 0x9e, 0xfd, 0xf6, 0xec, 0x02,
+	0xe2, 0xc1, 0x35, 0xf5, 0x89, 0xc7, 0x7c, 0x4e, 0xc3, 0xc6, 0x28, 0x0c, 0x78, 0x80, 0x24, 0x16,
+	0xd1, 0xf0, 0x86, 0x86, 0x0d, 0x39, 0x52, 0x7b, 0x32, 0x08, 0x82, 0xc1, 0x90, 0x36, 0x05, 0xe3,
+	0xf5, 0xf8, 0x4d, 0x93, 0x33, 0x8f, 0x46, 0xdc, 0xf6, 0x46, 0xf2, 0xa3, 0x9a, 0x3e, 0x60, 0xfc,
+	0x6a, 0xfc, 0xba, 0xe1, 0x04, 0x5e, 0x73, 0x38, 0x76, 0x98, 0xf8, 0x79, 0x31, 0x08, 0x9a, 0x4e,
+	0xe0, 0x79, 0x81, 0xdf, 0xb4, 0x47, 0xac, 0x39, 0xa7, 0xdb, 0x74, 0xe9, 0x90, 0x0e, 0x6c, 0xce,
+	0x02, 0x9f, 0x08, 0x38, 0x91, 0x39, 0xba, 0xa5, 0x8c, 0x67, 0x3b, 0x57, 0xcc, 0xa7, 0x19, 0x8d,
+	0xe3, 0x5b, 0x6a, 0xc4, 0x7f, 0xcc, 0xa1, 0xc4, 0x76, 0x9c, 0x60, 0xec, 0x73, 0xa9, 0x52, 0x7f,
+	0x0b, 0x0f, 0xba, 0xcc, 0xe7, 0x5d, 0x19, 0xc0, 0x8a, 0xf9, 0x98, 0xbe, 0x1d, 0xd3, 0x88, 0xa3,
+	0x6f, 0x41, 0x8d, 0x68, 0xc8, 0xec, 0x21, 0xfb, 0x85, 0xba, 0x32, 0x34, 0x09, 0xe5, 0x98, 0x5a,
+	0x38, 0x28, 0x1c, 0x56, 0xf1, 0xfe, 0x6c, 0x3c, 0xf3, 0xe5, 0x23, 0x28, 0x47, 0x6c, 0xe0, 0xdb,
+	0x7c, 0x1c, 0x52, 0xb5, 0x28, 0xa8, 0x33, 0xa0, 0xfe, 0x7b, 0x11, 0x76, 0xf3, 0xe2, 0x1d, 0x40,
+	0xc5, 0xa1, 0x21, 0x67, 0x6f, 0x98, 0x63, 0x73, 0x9a, 0x84, 0x98, 0x87, 0xd0, 0x25, 0xec, 0x4e,
+	0x65, 0x88, 0x3d, 0x1c, 0x04, 0x21, 0xe3, 0x57, 0x9e, 0x88, 0xb0, 0xd5, 0xfa, 0xb4, 0xb1, 0xbc,
+	0xa0, 0x0d, 0x33, 0xa5, 0x6b, 0x29, 0x1b, 0xa3, 0x68, 0x09, 0x43, 0xdf, 0x40, 0x99, 0x45, 0xd1,
+	0x98, 0xba, 0xc4, 0xe6, 0x6a, 0xe9, 0xa0, 0x70, 0x58, 0x69, 0xd5, 0x1a, 0xd2, 0x0b, 0x8d, 0xd4,
+	0x0b, 0x0d, 0x2b, 0xf5, 0x02, 0xde, 0x94, 0x64, 0x8d, 0xa3, 0xef, 0x01, 0x64, 0x61, 0xf8, 0x64,
+	0x44, 0xd5, 0x3b, 0x22, 0x91, 0xc7, 0x79, 0x89, 0x88, 0x99, 0x5a, 0x93, 0x11, 0xc5, 0x65, 0x9e,
+	0x3e, 0xa2, 0x8f, 0xe1, 0x5e, 0x60, 0x8f, 0xf9, 0x55, 0x8b, 0x44, 0x4e, 0x30, 0xa2, 0x91, 0xba,
+	0x7e, 0x50, 0x3a, 0x2c, 0xe3, 0xaa, 0x04, 0x4d, 0x81, 0xd5, 0x7d, 0x78, 0xb4, 0xb8, 0x42, 0x47,
+	0x93, 0x57, 0x97, 0x56, 0x5a, 0x36, 0x05, 0x4a, 0x3f, 0xbd, 0x93, 0x2b, 0x52, 0xc6, 0xf1, 0xe3,
+	0x42, 0x52, 0xc5, 0xdb, 0x25, 0x55, 0xff, 0xb7, 0x00, 0xea, 0xb2, 0x25, 0xa2, 0x51, 0xe0, 0x47,
+	0x34, 0x96, 0xa6, 0x61, 0x18, 0x84, 0xc4, 0x09, 0x5c, 0xb9, 0x44, 0xef, 0x91, 0xd6, 0x63, 0x56,
+	0x3b, 0x70, 0x29, 0x2e, 0xd3, 0xf4, 0x31, 0x9e, 0xaf, 0xfc, 0xda, 0xa3, 0x51, 0x64, 0x0f, 0x64,
+	0x6e, 0x65, 0x5c, 0x15, 0x60, 0x57, 0x62, 0xc8, 0x80, 0xad, 0xd4, 0x6b, 0x32, 0x68, 0xb2, 0x20,
+	0x87, 0x79, 0x61, 0xf2, 0x92, 0xc4, 0xf7, 0x78, 0x26, 0xe7, 0xcf, 0x60, 0x3b, 0xf5, 0xfe, 0x0d,
+	0x0d, 0x23, 0x16, 0xf8, 0x62, 0xa1, 0xca, 0x78, 0x2b, 0x81, 0x7f, 0x90, 0x68, 0xfd, 0x8f, 0x22,
+	0xec, 0xe5, 0xce, 0xfa, 0x18, 0xb6, 0x17, 0x76, 0x8f, 0x98, 0x7a, 0xa5, 0xf5, 0x30, 0x93, 0x93,
+	0x29, 0x39, 0x9a, 0xa4, 0x4c, 0xe5, 0x93, 0xf7, 0xbc, 0x3c, 0x8a, 0x79, 0x79, 0x20, 0x17, 0x6a,
+	0xd2, 0x7b, 0x24, 0x71, 0x87, 0xed, 0x38, 0x34, 0x8a, 0xe4, 0x16, 0x54, 0xf7, 0x44, 0xe4, 0x4f,
+	0xf2, 0xaa, 0x61, 0x68, 0x31, 0x5d, 0x13, 0x6c, 0x31, 0x83, 0xd3, 0x35, 0xfc, 0x40, 0x4a, 0x19,
+	0xf6, 0xc2, 0x10, 0xb2, 0x00, 0xc5, 0x1d, 0x83, 0x64, 0x7a, 0x8b, 0x7a, 0x5f, 0xa8, 0x3f, 0xcd,
+	0x53, 0x3f, 0x1f, 0x3b, 0x6c, 0xbe, 0x3c, 0xa7, 0x6b, 0x58, 0x19, 0x2e, 0x60, 0x47, 0xd5, 0x79,
+	0xef, 0xd5, 0x7f, 0x2d, 0xc0, 0xce, 0x52, 0x52, 0xe8, 0x23, 0xa8, 0x66, 0x66, 0x24, 0xad, 0x5b,
+	0xb1, 0xe7, 0x28, 0x8f, 0x97, 0x2c, 0x5c, 0x9e, 0xdf, 0x38, 0x2d, 0xd8, 0xa0, 0x3f, 0x8f, 0x58,
+	0x38, 0x59, 0x61, 0xb3, 0x26, 0xcc, 0xfa, 0x35, 0x28, 0x8b, 0x33, 0x88, 0x0d, 0x99, 0x9d, 0xbe,
+	0x4c, 0xa5, 0xea, 0xcd, 0x93, 0x66, 0xc1, 0x8a, 0x2b, 0x07, 0x1b, 0x41, 0xad, 0xe3, 0x47, 0x23,
+	0xea, 0xe4, 0x76, 0xd6, 0xec, 0x06, 0x2d, 0xdc, 0xb2, 0x6b, 0xec, 0xc1, 0xba, 0x4c, 0x56, 0x96,
+	0x45, 0xbe, 0xd4, 0xff, 0x2a, 0xc2, 0xc3, 0xdc, 0x90, 0x89, 0x87, 0xf7, 0x60, 0xfd, 0xc6, 0x1e,
+	0x32, 0x57, 0x84, 0xdb, 0xc4, 0xf2, 0x05, 0x3d, 0x87, 0x1d, 0xe6, 0x8b, 0x47, 0xc6, 0x27, 0x24,
+	0xa4, 0x76, 0x34, 0x75, 0xa5, 0x32, 0x1b, 0xc0, 0x02, 0x47, 0xfb, 0xb0, 0x11, 0xf7, 0x4e, 0xea,
+	0x8a, 0xaa, 0x6f, 0xe2, 0xe4, 0x0d, 0x3d, 0x81, 0x8a, 0x1f, 0xf8, 0x44, 0x4c, 0x9d, 0xba, 0xea,
+	0x0b, 0x31, 0x08, 0x7e, 0xe0, 0xeb, 0x12, 0x49, 0x09, 0x21, 0xbd, 0x09, 0xae, 0xa9, 0xab, 0xae,
+	0x4f, 0x09, 0x58, 0x22, 0xe8, 0x29, 0x6c, 0xc5, 0x5a, 0xcc, 0x1f, 0x90, 0x6b, 0x3a, 0x21, 0xcc,
+	0x55, 0x37, 0xe4, 0x42, 0x24, 0xe8, 0x19, 0x9d, 0x74, 0x5c, 0x74, 0x00, 0xd5, 0xf8, 0x34, 0x20,
+	0x8e, 0x4d, 0x7c, 0xdb, 0xa3, 0xea, 0x5d, 0xc1, 0x81, 0x18, 0x6b, 0xdb, 0x3d, 0xdb, 0xa3, 0xa8,
+	0x9b, 0xeb, 0x69, 0xb9, 0x63, 0xb2, 0x05, 0xce, 0x98, 0x36, 0x70, 0x27, 0x2b, 0x98, 0xf9, 0xb7,
+	0x02, 0xd4, 0xe2, 0xc6, 0x78, 0x3c, 0x3d, 0xd3, 0x33, 0x8b, 0xfa, 0x02, 0x50, 0x72, 0xda, 0x53,
+	0x97, 0x30, 0x97, 0xfa, 0x9c, 0xf1, 0x49, 0x62, 0xa8, 0x9d, 0xe9, 0x48, 0x27, 0x19, 0x88, 0x2b,
+	0x3f, 0xad, 0xbb, 0x3b, 0x0e, 0x85, 0x9e, 0xa8, 0x7c, 0x09, 0x2b, 0xe9, 0xc0, 0x71, 0x82, 0xa3,
+	0x1a, 0x6c, 0x26, 0x3d, 0x22, 0x52, 0x4b, 0xe2, 0x8c, 0x98, 0xbe, 0xd7, 0xff, 0x2c, 0xc0, 0xc3,
+	0xdc, 0xb4, 0x66, 0x0b, 0x3f, 0xef, 0xed, 0x75, 0xfe, 0x7f, 0x4d, 0x8d, 0xce, 0x40, 0x59, 0xbc,
+	0xcf, 0x24, 0xfb, 0xef, 0x20, 0x53, 0xdb, 0x85, 0x4c, 0xe2, 0xf2, 0xe2, 0x6d, 0x37, 0x0b, 0xae,
+	0xdc, 0x95, 0x9f, 0xf5, 0xa1, 0x3c, 0xdd, 0x06, 0x48, 0x81, 0x6a, 0xbf, 0x77, 0xd6, 0x33, 0x2e,
+	0x7b, 0xc4, 0xfa, 0xf1, 0x42, 0x57, 0xd6, 0xd0, 0x87, 0x50, 0x3b, 0x31, 0x8c, 0x93, 0x73, 0x9d,
+	0x18, 0x5a, 0xdf, 0x3a, 0x6d, 0x11, 0xad, 0xdd, 0xd6, 0x4d, 0x93, 0x58, 0xc6, 0x99, 0xde, 0x53,
+	0x0a, 0x68, 0x1f, 0xd0, 0x79, 0xbf, 0xdd, 0x21, 0x5d, 0xad, 0x7d, 0xda, 0xe9, 0xe9, 0x09, 0x5e,
+	0x7c, 0xf6, 0x1d, 0xa0, 0xe5, 0xcb, 0xc1, 0xbc, 0xbe, 0x76, 0x7e, 0x62, 0x28, 0x6b, 0x68, 0x17,
+	0xb6, 0xcd, 0x53, 0xad, 0xf5, 0xd5, 0xd7, 0x04, 0x9b, 0x9a, 0x04, 0x0b, 0xcf, 0xfe, 0x29, 0x40,
+	0x79, 0x7a, 0xc2, 0xa1, 0x0a, 0xdc, 0x35, 0xfb, 0x22, 0xaa, 0xb2, 0x86, 0x3e, 0x80, 0xfb, 0xfd,
+	0x9e, 0xd9, 0xbf, 0xb8, 0x30, 0xb0, 0xa5, 0x1f, 0x13, 0xb3, 0x73, 0xd2, 0xd3, 0xac, 0x3e, 0xd6,
+	0x95, 0x02, 0xaa, 0xc1, 0xfe, 0xfc, 0x90, 0xc8, 0x44, 0x4e, 0xa3, 0x88, 0x76, 0xe0, 0xde, 0x91,
+	0x76, 0x4c, 0xac, 0x4e, 0x57, 0x37, 0x2d, 0xad, 0x7b, 0xa1, 0x94, 0x62, 0x7a, 0x0c, 0xb5, 0x75,
+	0x6c, 0x75, 0x5e, 0x76, 0xda, 0x9a, 0xa5, 0x93, 0x97, 0x06, 0xee, 0x6a, 0x96, 0x72, 0x27, 0xa5,
+	0xcf, 0xd4, 0xd7, 0x65, 0x60, 0x0b, 0xf7, 0x4d, 0x4b, 0xcf, 0x7c, 0xa4, 0x6c, 0xa0, 0x07, 0xb0,
+	0x7b, 0xa4, 0xa5, 0x01, 0x35, 0x7c, 0xd2, 0xef, 0xea, 0x3d, 0xcb, 0x54, 0xee, 0xc6, 0x03, 0x12,
+	0xec, 0x76, 0x7a, 0x56, 0xa7, 0x77, 0x42, 0x74, 0x8c, 0x0d, 0xac, 0x6c, 0xc6, 0xfa, 0x33, 0xb1,
+	0x57, 0x97, 0x96, 0x52, 0x6e, 0xfd, 0x5d, 0x82, 0x8a, 0x58, 0x88, 0xae, 0xe8, 0x4d, 0xc8, 0x03,
+	0x65, 0xf1, 0x9a, 0x80, 0x9e, 0xe7, 0x9e, 0xd1, 0xf9, 0xf7, 0xcb, 0xda, 0xe7, 0xab, 0x91, 0x13,
+	0x1b, 0xbf, 0x83, 0xfb, 0xb9, 0xd7, 0x20, 0xf4, 0xc5, 0x2a, 0x32, 0xf3, 0x37, 0xa6, 0x5b, 0x06,
+	0xbe, 0x81, 0xdd, 0x9c, 0xbe, 0x8a, 0x1a, 0x79, 0x22, 0xef, 0xef, 0xf9, 0xb5, 0xe6, 0xca, 0xfc,
+	0x59, 0xdc, 0x9c, 0x6d, 0x9d, 0x1f, 0xf7, 0xfd, 0x6d, 0xa9, 0xd6, 0x5c, 0x99, 0x2f, 0xe3, 0xbe,
+	0xde, 0x10, 0x1d, 0xe0, 0xcb, 0xff, 0x06, 0x00, 0x3f, 0x67, 0x18, 0x7d, 0x34, 0x0d, 0x00, 0x00,
 }
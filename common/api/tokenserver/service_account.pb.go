@@ -30,7 +30,7 @@ type ServiceAccount struct {
 func (m *ServiceAccount) Reset()                    { *m = ServiceAccount{} }
 func (m *ServiceAccount) String() string            { return proto.CompactTextString(m) }
 func (*ServiceAccount) ProtoMessage()               {}
-func (*ServiceAccount) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (*ServiceAccount) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
 
 func (m *ServiceAccount) GetRegistered() *google_protobuf.Timestamp {
 	if m != nil {
@@ -43,22 +43,22 @@ func init() {
 	proto.RegisterType((*ServiceAccount)(nil), "tokenserver.ServiceAccount")
 }
 
-var fileDescriptor1 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x4c, 0xcf, 0xcb, 0x4b, 0x03, 0x31,
-	0x10, 0x06, 0x70, 0x56, 0xdb, 0xea, 0xce, 0x4a, 0x91, 0xa0, 0x10, 0x2a, 0xe2, 0xe3, 0xd4, 0xd3,
-	0x16, 0xea, 0xcd, 0x9b, 0x78, 0xf2, 0xe2, 0xa1, 0x7a, 0x5f, 0xd2, 0x64, 0xba, 0x46, 0x77, 0x93,
-	0x6d, 0x1e, 0x82, 0x7f, 0xbd, 0xa6, 0x93, 0x16, 0xbc, 0x25, 0xdf, 0x6f, 0x98, 0xe1, 0x83, 0x4b,
-	0x8f, 0xee, 0x5b, 0x4b, 0x6c, 0x84, 0x94, 0x36, 0x9a, 0x50, 0x0f, 0xce, 0x06, 0xcb, 0xaa, 0x60,
-	0xbf, 0xd0, 0xec, 0x0c, 0xdd, 0xec, 0xa6, 0xb5, 0xb6, 0xed, 0x70, 0x41, 0xb4, 0x8e, 0x9b, 0x45,
-	0xd0, 0x3d, 0xfa, 0x20, 0xfa, 0x21, 0x4f, 0xdf, 0xff, 0x16, 0x30, 0x7d, 0xcb, 0x7b, 0x9e, 0xf2,
-	0x1a, 0x76, 0x0d, 0x90, 0xec, 0x13, 0x65, 0x68, 0xb4, 0xe2, 0xc5, 0x6d, 0x31, 0x2f, 0x57, 0xe5,
-	0x3e, 0x79, 0x51, 0xec, 0x0a, 0xca, 0x68, 0xf4, 0x36, 0xe2, 0x4e, 0x8f, 0x48, 0x4f, 0x73, 0x90,
-	0xf0, 0x02, 0xc6, 0xd8, 0x0b, 0xdd, 0xf1, 0x63, 0x82, 0xfc, 0x61, 0x77, 0x70, 0xa6, 0xb4, 0x1f,
-	0x3a, 0xf1, 0xd3, 0x18, 0xd1, 0x23, 0x1f, 0x11, 0x56, 0xfb, 0xec, 0x35, 0x45, 0x6c, 0x0e, 0xe7,
-	0x56, 0xc4, 0xf0, 0xb1, 0x6c, 0x64, 0xa7, 0xd1, 0xd0, 0xe9, 0x31, 0x8d, 0x4d, 0x73, 0xfe, 0x4c,
-	0x71, 0x3a, 0xc1, 0x60, 0xb4, 0xd9, 0x2a, 0xc3, 0x27, 0xa4, 0xf4, 0x66, 0x8f, 0x00, 0x0e, 0x5b,
-	0xed, 0x03, 0x3a, 0x54, 0xfc, 0x24, 0x49, 0xb5, 0x9c, 0xd5, 0xb9, 0x7b, 0x7d, 0xe8, 0x5e, 0xbf,
-	0x1f, 0xba, 0xaf, 0xfe, 0x4d, 0xaf, 0x27, 0xe4, 0x0f, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe9,
-	0x06, 0x3e, 0x17, 0x4f, 0x01, 0x00, 0x00,
+var fileDescriptor2 = []byte{
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x4c, 0x8f, 0xc1, 0x4b, 0xc3, 0x30,
+	0x14, 0xc6, 0xa9, 0x6e, 0xd3, 0xbe, 0xca, 0x90, 0xa0, 0x10, 0x26, 0xe2, 0xf4, 0xd4, 0x53, 0x07,
+	0xf3, 0xe6, 0x4d, 0x3c, 0xf5, 0xe2, 0x61, 0x7a, 0x2f, 0x59, 0xf2, 0x56, 0xa3, 0x4d, 0xd2, 0xa5,
+	0x89, 0xe0, 0x5f, 0xaf, 0xec, 0x65, 0x05, 0x6f, 0xc9, 0xef, 0xf7, 0xf1, 0x3d, 0x3e, 0xb8, 0x1e,
+	0xd0, 0x7f, 0x6b, 0x89, 0x8d, 0x90, 0xd2, 0x45, 0x1b, 0xaa, 0xde, 0xbb, 0xe0, 0x58, 0x11, 0xdc,
+	0x17, 0xda, 0x83, 0x43, 0xbf, 0xb8, 0x6b, 0x9d, 0x6b, 0x3b, 0x5c, 0x91, 0xda, 0xc6, 0xdd, 0x2a,
+	0x68, 0x83, 0x43, 0x10, 0xa6, 0x4f, 0xe9, 0x87, 0xdf, 0x0c, 0xe6, 0x6f, 0xa9, 0xe7, 0x39, 0xd5,
+	0xb0, 0x5b, 0x80, 0xde, 0xbb, 0x4f, 0x94, 0xa1, 0xd1, 0x8a, 0x67, 0xcb, 0xac, 0xcc, 0x37, 0xf9,
+	0x91, 0xd4, 0x8a, 0xdd, 0x40, 0x1e, 0xad, 0xde, 0x47, 0x3c, 0xd8, 0x13, 0xb2, 0xe7, 0x09, 0xd4,
+	0x8a, 0x5d, 0xc1, 0x14, 0x8d, 0xd0, 0x1d, 0x3f, 0x25, 0x91, 0x3e, 0xec, 0x1e, 0x2e, 0x94, 0x1e,
+	0xfa, 0x4e, 0xfc, 0x34, 0x56, 0x18, 0xe4, 0x13, 0x92, 0xc5, 0x91, 0xbd, 0x0a, 0x83, 0xac, 0x84,
+	0x4b, 0x27, 0x62, 0xf8, 0x58, 0x37, 0xb2, 0xd3, 0x68, 0xe9, 0xf4, 0x94, 0x62, 0xf3, 0xc4, 0x5f,
+	0x08, 0xd7, 0x8a, 0x31, 0x98, 0xec, 0xf6, 0xca, 0xf2, 0x19, 0x59, 0x7a, 0xb3, 0x27, 0x00, 0x8f,
+	0xad, 0x1e, 0x02, 0x7a, 0x54, 0xfc, 0x6c, 0x99, 0x95, 0xc5, 0x7a, 0x51, 0xa5, 0xed, 0xd5, 0xb8,
+	0xbd, 0x7a, 0x1f, 0xb7, 0x6f, 0xfe, 0xa5, 0xb7, 0x33, 0xf2, 0x8f, 0x7f, 0x03, 0x00, 0xe9, 0x06,
+	0x3e, 0x17, 0x4f, 0x01, 0x00, 0x00,
 }
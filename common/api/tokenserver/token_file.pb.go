@@ -48,27 +48,27 @@ type TokenFile struct {
 func (m *TokenFile) Reset()                    { *m = TokenFile{} }
 func (m *TokenFile) String() string            { return proto.CompactTextString(m) }
 func (*TokenFile) ProtoMessage()               {}
-func (*TokenFile) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*TokenFile) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 func init() {
 	proto.RegisterType((*TokenFile)(nil), "tokenserver.TokenFile")
 }
 
-var fileDescriptor2 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0x03, 0x31,
-	0x0c, 0x86, 0xd5, 0x16, 0x0e, 0xea, 0x76, 0x40, 0x96, 0x40, 0x61, 0x41, 0x55, 0x27, 0xa6, 0x0e,
-	0xc0, 0xc8, 0xcc, 0x03, 0x9c, 0xd8, 0xad, 0x90, 0x33, 0xc2, 0xe2, 0x9a, 0x3b, 0x2e, 0x09, 0x6a,
-	0x9f, 0x9b, 0x17, 0xe0, 0xe2, 0x56, 0xe8, 0x8a, 0xda, 0xd1, 0xdf, 0xff, 0xd9, 0xb2, 0x13, 0xb8,
-	0x8a, 0xcd, 0x27, 0x7b, 0x7a, 0x97, 0x9a, 0x57, 0x6d, 0xd7, 0xc4, 0x06, 0x67, 0x4a, 0x02, 0x77,
-	0xdf, 0xdc, 0x2d, 0x7f, 0xc6, 0x30, 0x7d, 0xcd, 0xf5, 0x4b, 0x2f, 0xe0, 0x12, 0xe6, 0xd6, 0x39,
-	0x0e, 0x81, 0xd4, 0x31, 0xa3, 0xc5, 0xe8, 0x7e, 0x5a, 0x1e, 0x30, 0xbc, 0x03, 0xd8, 0x8d, 0x8c,
-	0xdb, 0x96, 0xcd, 0x58, 0x8d, 0x01, 0xc1, 0x15, 0x60, 0x9d, 0x9c, 0xd0, 0xda, 0xba, 0x0f, 0xf1,
-	0xbc, 0x9f, 0x34, 0x51, 0xef, 0x48, 0x82, 0x37, 0x50, 0xf0, 0xa6, 0x95, 0x6e, 0x6b, 0xce, 0x7a,
-	0x67, 0x52, 0xee, 0x2b, 0x5c, 0xc0, 0xac, 0xb6, 0x21, 0x52, 0x6a, 0x2b, 0x1b, 0xd9, 0x9c, 0x6b,
-	0x38, 0x44, 0xd9, 0xf0, 0xbc, 0xf9, 0x33, 0x8a, 0x9d, 0x31, 0x40, 0xf8, 0x04, 0xd7, 0xf9, 0x4e,
-	0x71, 0x4c, 0xfd, 0x0d, 0x4d, 0xf2, 0x91, 0x78, 0x6d, 0xa5, 0x36, 0x17, 0xba, 0xce, 0xf1, 0x10,
-	0x9f, 0xe1, 0xf6, 0x7f, 0x90, 0xbc, 0x7c, 0x25, 0x26, 0xa9, 0xcc, 0xa5, 0x76, 0x9e, 0x16, 0xf2,
-	0x1b, 0xea, 0x61, 0x15, 0x85, 0x98, 0xd7, 0x7a, 0xe8, 0x1b, 0xe6, 0xe5, 0x01, 0x7b, 0x2b, 0xf4,
-	0x27, 0x1e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x40, 0x93, 0xec, 0xb0, 0x9d, 0x01, 0x00, 0x00,
+var fileDescriptor3 = []byte{
+	// 238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xc3, 0x30,
+	0x0c, 0x86, 0xd5, 0x0d, 0x0a, 0xf3, 0x76, 0x40, 0x96, 0x40, 0xe1, 0x82, 0xaa, 0x9d, 0x7a, 0xda,
+	0x01, 0x38, 0x72, 0xe6, 0x01, 0x2a, 0xee, 0x51, 0x48, 0x8d, 0xb0, 0xc8, 0xd2, 0xd2, 0x24, 0x68,
+	0x7b, 0x6e, 0x5e, 0x00, 0xc5, 0x9b, 0x50, 0x87, 0xca, 0xd1, 0xdf, 0xff, 0xc5, 0xb2, 0x63, 0xb8,
+	0x8a, 0xdd, 0x07, 0x79, 0xfd, 0xc6, 0x8e, 0x36, 0xfd, 0xd0, 0xc5, 0x0e, 0x97, 0x42, 0x02, 0x0d,
+	0x5f, 0x34, 0xac, 0xbf, 0x67, 0xb0, 0x78, 0xc9, 0xf5, 0x33, 0x3b, 0xc2, 0x35, 0xac, 0x8c, 0xb5,
+	0x14, 0x82, 0x16, 0x47, 0x15, 0x55, 0x51, 0x2f, 0x9a, 0x13, 0x86, 0x77, 0x00, 0x87, 0x96, 0x71,
+	0xdf, 0x93, 0x9a, 0x89, 0x31, 0x22, 0xb8, 0x01, 0x74, 0xc9, 0xb2, 0xde, 0x1a, 0xfb, 0xce, 0x9e,
+	0x8e, 0x9d, 0xe6, 0xe2, 0x4d, 0x24, 0x78, 0x03, 0x25, 0xed, 0x7a, 0x1e, 0xf6, 0xea, 0xac, 0x2a,
+	0xea, 0x79, 0x73, 0xac, 0xb0, 0x82, 0xa5, 0x33, 0x21, 0xea, 0xd4, 0xb7, 0x26, 0x92, 0x3a, 0x97,
+	0x70, 0x8c, 0xb2, 0xe1, 0x69, 0xf7, 0x6b, 0x94, 0x07, 0x63, 0x84, 0xf0, 0x11, 0xae, 0xf3, 0x9e,
+	0x6c, 0x49, 0x1b, 0x6b, 0xbb, 0xe4, 0xa3, 0xa6, 0xad, 0x61, 0xa7, 0x2e, 0x64, 0x9c, 0xe9, 0x10,
+	0x9f, 0xe0, 0xf6, 0x6f, 0x90, 0x3c, 0x7f, 0x26, 0xd2, 0xdc, 0xaa, 0x4b, 0x79, 0xf9, 0xbf, 0x90,
+	0xff, 0x50, 0x16, 0x6b, 0x75, 0x88, 0x79, 0xac, 0xfb, 0xaa, 0xa8, 0x57, 0xcd, 0x09, 0x7b, 0x2d,
+	0xe5, 0x12, 0x0f, 0x3f, 0x03, 0x00, 0x40, 0x93, 0xec, 0xb0, 0x9d, 0x01, 0x00, 0x00,
 }
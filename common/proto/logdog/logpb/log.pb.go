@@ -412,6 +412,17 @@ type LogIndex struct {
 	// The frequency of Entry messages is not defined; it is up to the Archivist
 	// process to choose a frequency.
 	Entries []*LogIndex_Entry `protobuf:"bytes,2,rep,name=entries" json:"entries,omitempty"`
+	//
+	// If not empty, the archived log stream is compressed as a series of
+	// independently-decodable blocks, described here in ascending order.
+	//
+	// In this case, Entry "offset" values refer to offsets within the
+	// uncompressed log stream, and a reader must use the block list to identify
+	// and decompress the blocks that contain them.
+	//
+	// If empty, the archived log stream is uncompressed, and Entry "offset"
+	// values refer directly to offsets within the archived log stream blob.
+	Blocks []*LogIndex_Block `protobuf:"bytes,3,rep,name=blocks" json:"blocks,omitempty"`
 }
 
 func (m *LogIndex) Reset()                    { *m = LogIndex{} }
@@ -433,6 +444,13 @@ func (m *LogIndex) GetEntries() []*LogIndex_Entry {
 	return nil
 }
 
+func (m *LogIndex) GetBlocks() []*LogIndex_Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
 //
 // Entry is a single index entry.
 //
@@ -485,6 +503,31 @@ func (m *LogIndex_Entry) GetTimeOffset() *google_protobuf1.Duration {
 	return nil
 }
 
+//
+// Block is a single independently-compressed block of an archived log
+// stream.
+//
+// Each block is a zlib stream that decompresses to a contiguous series of
+// complete RecordIO frames.
+type LogIndex_Block struct {
+	//
+	// The byte offset of the compressed block in the archived log stream blob.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+	// The size, in bytes, of the compressed block.
+	Size uint64 `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	//
+	// The uncompressed byte offset of the block's first frame. This is in the
+	// same offset space as the Entry "offset" field.
+	RawOffset uint64 `protobuf:"varint,3,opt,name=raw_offset,json=rawOffset" json:"raw_offset,omitempty"`
+	// The uncompressed size, in bytes, of the block's data.
+	RawSize uint64 `protobuf:"varint,4,opt,name=raw_size,json=rawSize" json:"raw_size,omitempty"`
+}
+
+func (m *LogIndex_Block) Reset()                    { *m = LogIndex_Block{} }
+func (m *LogIndex_Block) String() string            { return proto.CompactTextString(m) }
+func (*LogIndex_Block) ProtoMessage()               {}
+func (*LogIndex_Block) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5, 1} }
+
 func init() {
 	proto.RegisterType((*LogStreamDescriptor)(nil), "logpb.LogStreamDescriptor")
 	proto.RegisterType((*Text)(nil), "logpb.Text")
@@ -495,53 +538,58 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "logpb.LogEntry")
 	proto.RegisterType((*LogIndex)(nil), "logpb.LogIndex")
 	proto.RegisterType((*LogIndex_Entry)(nil), "logpb.LogIndex.Entry")
+	proto.RegisterType((*LogIndex_Block)(nil), "logpb.LogIndex.Block")
 	proto.RegisterEnum("logpb.StreamType", StreamType_name, StreamType_value)
 }
 
 var fileDescriptor1 = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x6e, 0xd3, 0x4c,
-	0x10, 0xae, 0x1d, 0xe7, 0x34, 0x4e, 0xff, 0xe6, 0xdf, 0xff, 0x80, 0xb1, 0x10, 0x94, 0x08, 0x01,
-	0x42, 0xc2, 0x85, 0x80, 0x44, 0xd5, 0xbb, 0x54, 0x2d, 0xa5, 0x52, 0x39, 0x68, 0xc9, 0x05, 0x5c,
-	0x45, 0x4e, 0xb3, 0x89, 0x0c, 0x8e, 0x6d, 0xec, 0x0d, 0x6a, 0x78, 0x14, 0x5e, 0x01, 0x89, 0x37,
-	0xe0, 0x91, 0x78, 0x07, 0xc6, 0x33, 0x9b, 0x03, 0x2d, 0x15, 0xe2, 0x6e, 0x76, 0xe6, 0xfb, 0xc6,
-	0x73, 0xf8, 0xc6, 0xd0, 0x8c, 0xd3, 0x49, 0x90, 0xe5, 0xa9, 0x4e, 0x45, 0x15, 0xcd, 0x6c, 0xe8,
-	0xdf, 0x98, 0xa4, 0xe9, 0x24, 0x56, 0x3b, 0xe4, 0x1c, 0xce, 0xc6, 0x3b, 0x3a, 0x9a, 0xaa, 0x42,
-	0x87, 0xd3, 0x8c, 0x71, 0xfe, 0xf5, 0xf3, 0x80, 0xd1, 0x2c, 0x0f, 0x75, 0x94, 0x26, 0x1c, 0xef,
-	0x7c, 0xb7, 0xe1, 0x9f, 0x93, 0x74, 0xf2, 0x5a, 0xe7, 0x2a, 0x9c, 0x1e, 0xa8, 0xe2, 0x34, 0x8f,
-	0x32, 0x9d, 0xe6, 0xe2, 0x7f, 0xa8, 0x65, 0xb9, 0x1a, 0x47, 0x67, 0x9e, 0xb5, 0x6d, 0xdd, 0x6d,
-	0x4a, 0xf3, 0x12, 0x02, 0x9c, 0x24, 0x9c, 0x2a, 0xcf, 0x26, 0x2f, 0xd9, 0xa2, 0x0b, 0x6e, 0x41,
-	0xfc, 0x81, 0x9e, 0x67, 0xca, 0xab, 0x60, 0xe8, 0xaf, 0xee, 0xdf, 0x01, 0x55, 0x18, 0x70, 0xe6,
-	0x3e, 0x06, 0x24, 0x14, 0x4b, 0x5b, 0xdc, 0x84, 0xd6, 0x69, 0x9a, 0x68, 0x95, 0x68, 0x26, 0x39,
-	0x94, 0xcf, 0x35, 0x3e, 0x82, 0xec, 0x42, 0x73, 0xd9, 0x8d, 0x57, 0xc5, 0xb8, 0xdb, 0xf5, 0x03,
-	0x6e, 0x27, 0x58, 0xb4, 0x13, 0xf4, 0x17, 0x08, 0xb9, 0x02, 0x23, 0xd3, 0xd1, 0xe1, 0xa4, 0xf0,
-	0x6a, 0xdb, 0x15, 0x24, 0xdd, 0x32, 0x95, 0xfc, 0xa2, 0xcd, 0xa0, 0x8f, 0xb0, 0xc3, 0x44, 0xe7,
-	0x73, 0x49, 0x0c, 0x71, 0x1b, 0xb6, 0x86, 0x51, 0x12, 0xe6, 0xf3, 0xc1, 0x38, 0x8a, 0xd5, 0x40,
-	0x9d, 0x69, 0xaf, 0x4e, 0x95, 0x6d, 0xb2, 0xfb, 0x29, 0x7a, 0x0f, 0xcf, 0xb4, 0xff, 0x04, 0x9a,
-	0x4b, 0xaa, 0x68, 0x43, 0xe5, 0xbd, 0x9a, 0x9b, 0x41, 0x95, 0xa6, 0xf8, 0x17, 0xaa, 0x1f, 0xc3,
-	0x78, 0xb6, 0x18, 0x13, 0x3f, 0xf6, 0xec, 0x5d, 0xab, 0xf3, 0x0e, 0x9c, 0x3e, 0x66, 0xc5, 0x0f,
-	0x55, 0xe3, 0x28, 0x51, 0x05, 0xb2, 0xca, 0x1a, 0xdb, 0xa6, 0xc6, 0x32, 0x16, 0x9c, 0x60, 0x40,
-	0x72, 0xd8, 0xdf, 0x03, 0xa7, 0x7c, 0xae, 0x32, 0x5a, 0x6b, 0x19, 0xc5, 0x35, 0x68, 0x8e, 0x54,
-	0x1c, 0x4d, 0x23, 0xad, 0x72, 0xf3, 0xad, 0x95, 0xa3, 0xf3, 0x18, 0x6a, 0xfb, 0x54, 0x75, 0xb9,
-	0xcd, 0x74, 0x3c, 0x2e, 0x94, 0x26, 0xba, 0x23, 0xcd, 0xab, 0xdc, 0xe6, 0x28, 0xd4, 0x21, 0x51,
-	0x5b, 0x92, 0xec, 0xce, 0x67, 0x0b, 0x1a, 0x07, 0x68, 0x4c, 0xf2, 0x70, 0xba, 0x04, 0x58, 0x2b,
-	0x80, 0x78, 0x08, 0xf5, 0x2c, 0xcc, 0x75, 0x14, 0xc6, 0xc4, 0x73, 0xbb, 0x57, 0x4c, 0xf1, 0x0b,
-	0x56, 0xf0, 0x8a, 0xc3, 0x72, 0x81, 0xf3, 0x8f, 0xa0, 0x6e, 0x7c, 0x65, 0x23, 0x51, 0x32, 0x52,
-	0xac, 0xab, 0x4d, 0xc9, 0x8f, 0xf2, 0x3b, 0x45, 0xf4, 0x89, 0xe7, 0xe5, 0x48, 0xb2, 0x4b, 0x5f,
-	0x1c, 0x16, 0x9a, 0xf4, 0xd4, 0x90, 0x64, 0x77, 0xbe, 0xda, 0xd0, 0xc0, 0x3d, 0xf2, 0xdc, 0xf7,
-	0xc0, 0x2d, 0x77, 0x3e, 0x58, 0x6b, 0xcd, 0xed, 0x5e, 0xbd, 0x20, 0x91, 0x03, 0xa3, 0x78, 0x09,
-	0x25, 0xfa, 0x25, 0x77, 0x8e, 0xfa, 0x63, 0x45, 0x0f, 0xb8, 0x1a, 0xfe, 0xb0, 0xcb, 0xbe, 0x63,
-	0xaa, 0x09, 0x21, 0x46, 0xd6, 0x0c, 0xa9, 0x30, 0x84, 0x7d, 0x0c, 0xf1, 0xa1, 0x51, 0xa8, 0x0f,
-	0x33, 0x95, 0x9c, 0xb2, 0x82, 0x1d, 0xb9, 0x7c, 0x23, 0xdd, 0xd1, 0xa5, 0x7e, 0x80, 0xca, 0x72,
-	0xd7, 0x16, 0xfc, 0x6c, 0x43, 0x52, 0x48, 0xdc, 0x81, 0x1a, 0xcb, 0xca, 0x73, 0x09, 0xb4, 0x69,
-	0x40, 0xbc, 0x35, 0x84, 0x99, 0xb0, 0xb8, 0x0f, 0x8d, 0x91, 0x19, 0xae, 0xd7, 0x22, 0xe8, 0xd6,
-	0xb9, 0x99, 0x23, 0x78, 0x09, 0xd9, 0x6f, 0x42, 0xdd, 0x1c, 0x52, 0xe7, 0x0b, 0x0f, 0x8c, 0xcb,
-	0x0d, 0x70, 0x9b, 0xa8, 0x7d, 0x33, 0x29, 0xff, 0xf2, 0xbb, 0x90, 0x84, 0x13, 0x3b, 0x50, 0xc7,
-	0x1c, 0x79, 0x84, 0x32, 0xb5, 0x49, 0xa6, 0xff, 0xad, 0x28, 0x94, 0x31, 0xe0, 0xdb, 0x59, 0xa0,
-	0xfc, 0x6f, 0x16, 0x54, 0x79, 0x37, 0x97, 0x29, 0x6e, 0x7d, 0x62, 0xf6, 0x85, 0x89, 0xfd, 0xbc,
-	0x93, 0xca, 0xef, 0x77, 0xe2, 0x5c, 0xdc, 0xc9, 0x39, 0x55, 0x54, 0xff, 0x40, 0x15, 0xf7, 0x1e,
-	0x00, 0xac, 0xfe, 0x57, 0xa2, 0x81, 0xb7, 0x7a, 0xf8, 0xa6, 0xdf, 0xde, 0x10, 0x80, 0x97, 0x74,
-	0xfc, 0xa2, 0x27, 0xdf, 0xb6, 0x2d, 0xd1, 0xc2, 0xf3, 0xe8, 0xf5, 0x7b, 0x47, 0xb2, 0xf7, 0xbc,
-	0x6d, 0x0f, 0x6b, 0x94, 0xf0, 0xd1, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xa4, 0xa1, 0x8f,
-	0x9b, 0x05, 0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xeb, 0x6e, 0xda, 0x4a,
+	0x10, 0xc6, 0x60, 0xc0, 0x8c, 0xc9, 0x09, 0x67, 0xcf, 0xcd, 0xb1, 0xce, 0x05, 0xd0, 0x51, 0x0e,
+	0x3a, 0x52, 0x9c, 0x96, 0x56, 0x6a, 0x94, 0x7f, 0x20, 0xd2, 0x24, 0x52, 0x7a, 0xd1, 0x86, 0x1f,
+	0xed, 0x2f, 0xb4, 0xc0, 0x62, 0x6d, 0x63, 0x6c, 0xd7, 0x5e, 0x1a, 0xc8, 0xa3, 0xf4, 0x21, 0xfa,
+	0x06, 0x7d, 0x80, 0x3e, 0x4c, 0xdf, 0xa1, 0xf2, 0xce, 0x72, 0x69, 0x48, 0x5a, 0xf5, 0xdf, 0x5c,
+	0xbe, 0x19, 0x7f, 0x33, 0xf3, 0xad, 0xa1, 0x12, 0x44, 0xbe, 0x17, 0x27, 0x91, 0x8c, 0x48, 0x31,
+	0x88, 0xfc, 0x78, 0xe8, 0xfe, 0xe3, 0x47, 0x91, 0x1f, 0xf0, 0x43, 0x15, 0x1c, 0xce, 0x26, 0x87,
+	0x52, 0x4c, 0x79, 0x2a, 0xd9, 0x34, 0x46, 0x9c, 0xfb, 0xf7, 0x6d, 0xc0, 0x78, 0x96, 0x30, 0x29,
+	0xa2, 0x10, 0xf3, 0xcd, 0xcf, 0x79, 0xf8, 0xe5, 0x22, 0xf2, 0x2f, 0x65, 0xc2, 0xd9, 0xb4, 0xc7,
+	0xd3, 0x51, 0x22, 0x62, 0x19, 0x25, 0xe4, 0x77, 0x28, 0xc5, 0x09, 0x9f, 0x88, 0xb9, 0x63, 0xd4,
+	0x8d, 0x56, 0x85, 0x6a, 0x8f, 0x10, 0x30, 0x43, 0x36, 0xe5, 0x4e, 0x5e, 0x45, 0x95, 0x4d, 0xda,
+	0x60, 0xa7, 0xaa, 0x7e, 0x20, 0x17, 0x31, 0x77, 0x0a, 0x75, 0xa3, 0xf5, 0x53, 0xfb, 0x67, 0x4f,
+	0x31, 0xf4, 0xb0, 0x73, 0x7f, 0x11, 0x73, 0x0a, 0xe9, 0xca, 0x26, 0x0d, 0xa8, 0x8e, 0xa2, 0x50,
+	0xf2, 0x50, 0x62, 0x91, 0xa9, 0xfa, 0xd9, 0x3a, 0xa6, 0x20, 0x47, 0x50, 0x59, 0x4d, 0xe3, 0x14,
+	0xeb, 0x46, 0xcb, 0x6e, 0xbb, 0x1e, 0x8e, 0xe3, 0x2d, 0xc7, 0xf1, 0xfa, 0x4b, 0x04, 0x5d, 0x83,
+	0xc9, 0x11, 0x98, 0x92, 0xf9, 0xa9, 0x53, 0xaa, 0x17, 0x5a, 0x76, 0xfb, 0x5f, 0xcd, 0xe4, 0x8e,
+	0x31, 0xbd, 0x3e, 0xf3, 0xd3, 0x93, 0x50, 0x26, 0x0b, 0xaa, 0x2a, 0xc8, 0x3e, 0xec, 0x0e, 0x45,
+	0xc8, 0x92, 0xc5, 0x60, 0x22, 0x02, 0x3e, 0xe0, 0x73, 0xe9, 0x94, 0x15, 0xb3, 0x1d, 0x0c, 0x3f,
+	0x15, 0x01, 0x3f, 0x99, 0x4b, 0xf7, 0x09, 0x54, 0x56, 0xa5, 0xa4, 0x06, 0x85, 0x2b, 0xbe, 0xd0,
+	0x8b, 0xca, 0x4c, 0xf2, 0x2b, 0x14, 0xdf, 0xb1, 0x60, 0xb6, 0x5c, 0x13, 0x3a, 0xc7, 0xf9, 0x23,
+	0xa3, 0xf9, 0x06, 0xcc, 0x3e, 0x9f, 0x4b, 0xb2, 0x0f, 0xc5, 0x40, 0x84, 0x3c, 0x75, 0x0c, 0xc5,
+	0xb1, 0xa6, 0x39, 0x66, 0x39, 0xef, 0x42, 0x84, 0x9c, 0x62, 0xda, 0x3d, 0x06, 0x33, 0x73, 0xd7,
+	0x1d, 0x8d, 0x8d, 0x8e, 0xe4, 0x4f, 0xa8, 0x8c, 0x79, 0x20, 0xa6, 0x42, 0xf2, 0x44, 0x7f, 0x6b,
+	0x1d, 0x68, 0x3e, 0x86, 0x52, 0x57, 0xb1, 0xce, 0xae, 0x19, 0x4d, 0x26, 0x29, 0x97, 0xaa, 0xdc,
+	0xa4, 0xda, 0xcb, 0xae, 0x39, 0x66, 0x92, 0xa9, 0xd2, 0x2a, 0x55, 0x76, 0xf3, 0xbd, 0x01, 0x56,
+	0x8f, 0x49, 0xe6, 0x27, 0x6c, 0xba, 0x02, 0x18, 0x6b, 0x00, 0x79, 0x08, 0xe5, 0x98, 0x25, 0x52,
+	0xb0, 0x40, 0xd5, 0xd9, 0xed, 0x3f, 0x34, 0xf9, 0x65, 0x95, 0xf7, 0x12, 0xd3, 0x74, 0x89, 0x73,
+	0x4f, 0xa1, 0xac, 0x63, 0xd9, 0x20, 0x22, 0x1c, 0x73, 0xd4, 0xd5, 0x0e, 0x45, 0x27, 0xfb, 0x4e,
+	0x2a, 0x6e, 0x70, 0x5f, 0x26, 0x55, 0x76, 0x16, 0x0b, 0x58, 0x2a, 0x95, 0x9e, 0x2c, 0xaa, 0xec,
+	0xe6, 0x87, 0x3c, 0x58, 0x17, 0x91, 0x8f, 0x7b, 0x3f, 0x06, 0x3b, 0xbb, 0xf9, 0x60, 0x63, 0x34,
+	0xbb, 0xbd, 0xb7, 0x25, 0x91, 0x9e, 0x56, 0x3c, 0x85, 0x0c, 0xfd, 0x02, 0x27, 0x6f, 0x40, 0x15,
+	0x15, 0x3d, 0x40, 0x36, 0xf8, 0x61, 0x1b, 0x63, 0xe7, 0x8a, 0x53, 0x03, 0xaa, 0x5a, 0xd6, 0x08,
+	0x29, 0x20, 0x04, 0x63, 0x08, 0x71, 0xc1, 0x4a, 0xf9, 0xdb, 0x19, 0x0f, 0x47, 0xa8, 0x60, 0x93,
+	0xae, 0x7c, 0xd2, 0x00, 0x53, 0x66, 0xfa, 0x01, 0x45, 0xcb, 0xde, 0x38, 0xf0, 0x59, 0x8e, 0xaa,
+	0x14, 0xf9, 0x0f, 0x4a, 0x28, 0x2b, 0xc7, 0x56, 0xa0, 0x1d, 0x0d, 0xc2, 0xab, 0x9d, 0xe5, 0xa8,
+	0x4e, 0x93, 0x03, 0xb0, 0xc6, 0x7a, 0xb9, 0x4e, 0x55, 0x41, 0x77, 0x6f, 0xed, 0xfc, 0x2c, 0x47,
+	0x57, 0x90, 0x6e, 0x05, 0xca, 0xfa, 0x21, 0x35, 0x3f, 0x15, 0xd4, 0xc2, 0x90, 0xae, 0x07, 0xe6,
+	0x98, 0xa7, 0x23, 0xbd, 0x29, 0xf7, 0xfe, 0x77, 0x41, 0x15, 0x8e, 0x1c, 0x42, 0x99, 0x87, 0x32,
+	0x11, 0x3c, 0x75, 0xf2, 0x4a, 0xa6, 0xbf, 0xad, 0x4b, 0x54, 0x47, 0x0f, 0xdf, 0xce, 0x12, 0x45,
+	0x0e, 0xa0, 0x34, 0x0c, 0xa2, 0xd1, 0x55, 0xea, 0x14, 0xee, 0xc6, 0x77, 0xb3, 0x2c, 0xd5, 0x20,
+	0xf7, 0xa3, 0x01, 0x45, 0x3c, 0xe5, 0x7d, 0x02, 0xdd, 0x5c, 0x70, 0x7e, 0x6b, 0xc1, 0x5f, 0x9f,
+	0xb0, 0xf0, 0xfd, 0x13, 0x9a, 0xdb, 0x27, 0xbc, 0x25, 0xa2, 0xe2, 0x0f, 0x88, 0xc8, 0x9d, 0x42,
+	0x51, 0x0d, 0xf4, 0xad, 0xf7, 0xb5, 0x25, 0xeb, 0xbf, 0x00, 0x12, 0x76, 0xbd, 0xfc, 0x1e, 0x92,
+	0xae, 0x24, 0xec, 0x5a, 0x0b, 0x73, 0x0f, 0xac, 0x2c, 0xad, 0xca, 0x90, 0x6e, 0x39, 0x61, 0xd7,
+	0x97, 0xe2, 0x86, 0xff, 0xff, 0x00, 0x60, 0xfd, 0x37, 0x25, 0x16, 0x98, 0xfd, 0x93, 0x57, 0xfd,
+	0x5a, 0x8e, 0x00, 0x94, 0xba, 0xe7, 0xcf, 0x3b, 0xf4, 0x75, 0xcd, 0x20, 0x55, 0xb0, 0x7a, 0x9d,
+	0x7e, 0xe7, 0x94, 0x76, 0x9e, 0xd5, 0xf2, 0xc3, 0x92, 0xe2, 0xff, 0xe8, 0xcb, 0x00, 0x02, 0x71,
+	0x17, 0xad, 0x39, 0x06, 0x00, 0x00,
 }
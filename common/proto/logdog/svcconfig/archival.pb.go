@@ -19,6 +19,7 @@ It has these top-level messages:
 	Collector
 	Archivist
 	ProjectConfig
+	RetentionPolicy
 	Storage
 	Transport
 */
@@ -51,6 +52,13 @@ type ArchiveIndexConfig struct {
 	PrefixRange int32 `protobuf:"varint,2,opt,name=prefix_range,json=prefixRange" json:"prefix_range,omitempty"`
 	// If not zero, the maximum number of log data bytes between index entries.
 	ByteRange int32 `protobuf:"varint,3,opt,name=byte_range,json=byteRange" json:"byte_range,omitempty"`
+	// If not zero, the archived log stream will be compressed as a series of
+	// independently-decodable blocks, each holding approximately this many bytes
+	// of uncompressed log stream data. The index records each block's location,
+	// allowing readers to fetch and decompress only the blocks that they need.
+	//
+	// If zero, the archived log stream will not be compressed.
+	CompressBlockSize int32 `protobuf:"varint,4,opt,name=compress_block_size,json=compressBlockSize" json:"compress_block_size,omitempty"`
 }
 
 func (m *ArchiveIndexConfig) Reset()                    { *m = ArchiveIndexConfig{} }
@@ -63,14 +71,16 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 134 bytes of a gzipped FileDescriptorProto
+	// 168 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x4b, 0x2c, 0x4a, 0xce,
 	0xc8, 0x2c, 0x4b, 0xcc, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2c, 0x2e, 0x4b, 0x4e,
-	0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x57, 0xaa, 0xe4, 0x12, 0x72, 0x04, 0x4b, 0xa6, 0x7a, 0xe6, 0xa5,
-	0xa4, 0x56, 0x38, 0x83, 0x45, 0x85, 0x14, 0xb9, 0x78, 0x8a, 0x4b, 0x8a, 0x52, 0x13, 0x73, 0xe3,
-	0x8b, 0x12, 0xf3, 0xd2, 0x53, 0x25, 0x18, 0x15, 0x18, 0x35, 0x58, 0x83, 0xb8, 0x21, 0x62, 0x41,
-	0x20, 0x21, 0x90, 0x92, 0x82, 0xa2, 0xd4, 0xb4, 0xcc, 0x0a, 0xa8, 0x12, 0x26, 0x88, 0x12, 0x88,
-	0x18, 0x44, 0x89, 0x2c, 0x17, 0x57, 0x52, 0x65, 0x49, 0x2a, 0x54, 0x01, 0x33, 0x58, 0x01, 0x27,
-	0x48, 0x04, 0x2c, 0x9d, 0xc4, 0x06, 0x76, 0x8c, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xc0,
-	0xa7, 0x0f, 0x9e, 0x00, 0x00, 0x00,
+	0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x57, 0x5a, 0xc9, 0xc8, 0x25, 0xe4, 0x08, 0x96, 0x4d, 0xf5, 0xcc,
+	0x4b, 0x49, 0xad, 0x70, 0x06, 0x0b, 0x0b, 0x29, 0x72, 0xf1, 0x14, 0x97, 0x14, 0xa5, 0x26, 0xe6,
+	0xc6, 0x17, 0x25, 0xe6, 0xa5, 0xa7, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x06, 0x71, 0x43, 0xc4,
+	0x82, 0x40, 0x42, 0x20, 0x25, 0x05, 0x45, 0xa9, 0x69, 0x99, 0x15, 0x50, 0x25, 0x4c, 0x10, 0x25,
+	0x10, 0x31, 0x88, 0x12, 0x59, 0x2e, 0xae, 0xa4, 0xca, 0x92, 0x54, 0xa8, 0x02, 0x66, 0xb0, 0x02,
+	0x4e, 0x90, 0x08, 0x44, 0x5a, 0x8f, 0x4b, 0x38, 0x39, 0x3f, 0xb7, 0xa0, 0x28, 0xb5, 0xb8, 0x38,
+	0x3e, 0x29, 0x27, 0x3f, 0x39, 0x3b, 0xbe, 0x38, 0xb3, 0x2a, 0x55, 0x82, 0x05, 0xac, 0x4e, 0x10,
+	0x26, 0xe5, 0x04, 0x92, 0x09, 0xce, 0xac, 0x4a, 0x4d, 0x62, 0x03, 0xbb, 0xde, 0x18, 0x30, 0x00,
+	0x54, 0x9e, 0xdd, 0x24, 0xcf, 0x00, 0x00, 0x00,
 }
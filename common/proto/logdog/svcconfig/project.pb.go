@@ -47,7 +47,9 @@ type ProjectConfig struct {
 	// gs://<archive_gs_bucket>/<app-id>/<project-name>/<log-path>/artifact...
 	//
 	// Note that the Archivist microservice must have WRITE access to this
-	// bucket, and the Coordinator must have READ access.
+	// bucket, and the Coordinator must have READ access. If the project defines
+	// retention policies, the Coordinator must also have WRITE access in order to
+	// purge archived log stream data.
 	//
 	// If this is not set, the logs will be archived in a project-named
 	// subdirectory in the global "archive_gs_base" location.
@@ -63,6 +65,12 @@ type ProjectConfig struct {
 	// Any unspecified index configuration will default to the service archival
 	// config.
 	ArchiveIndexConfig *ArchiveIndexConfig `protobuf:"bytes,12,opt,name=archive_index_config,json=archiveIndexConfig" json:"archive_index_config,omitempty"`
+	// The set of retention policies for this project's log streams.
+	//
+	// Policies are evaluated in order, and the first policy that matches a log
+	// stream determines its retention. Log streams that do not match any policy
+	// are retained indefinitely.
+	RetentionPolicies []*RetentionPolicy `protobuf:"bytes,13,rep,name=retention_policies,json=retentionPolicies" json:"retention_policies,omitempty"`
 }
 
 func (m *ProjectConfig) Reset()                    { *m = ProjectConfig{} }
@@ -91,30 +99,81 @@ func (m *ProjectConfig) GetArchiveIndexConfig() *ArchiveIndexConfig {
 	return nil
 }
 
+func (m *ProjectConfig) GetRetentionPolicies() []*RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicies
+	}
+	return nil
+}
+
+// RetentionPolicy describes how long a set of log streams is retained before
+// its archived data is purged.
+//
+// Once a log stream's retention has elapsed, the Coordinator will delete its
+// archived data from Google Storage and mark the stream as purged.
+type RetentionPolicy struct {
+	// The name of this policy. It is used to identify the policy in reports and
+	// logs.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// If not empty, the policy applies only to log streams whose prefix is this
+	// value or is beneath it. For example, "bb/chromium.linux" matches the
+	// prefixes "bb/chromium.linux" and "bb/chromium.linux/123", but not
+	// "bb/chromium.linux2".
+	Prefix string `protobuf:"bytes,2,opt,name=prefix" json:"prefix,omitempty"`
+	// If not empty, the policy applies only to log streams that have all of
+	// these tags. A tag whose value is empty matches any value for that key.
+	Tags map[string]string `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The amount of time after a log stream's creation that it will be retained.
+	//
+	// This must be greater than zero.
+	MaxAge *google_protobuf.Duration `protobuf:"bytes,4,opt,name=max_age,json=maxAge" json:"max_age,omitempty"`
+}
+
+func (m *RetentionPolicy) Reset()                    { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()               {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+
+func (m *RetentionPolicy) GetMaxAge() *google_protobuf.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProjectConfig)(nil), "svcconfig.ProjectConfig")
+	proto.RegisterType((*RetentionPolicy)(nil), "svcconfig.RetentionPolicy")
 }
 
 var fileDescriptor2 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x91, 0xcd, 0x4a, 0x3b, 0x31,
-	0x14, 0xc5, 0xf9, 0xff, 0xab, 0x62, 0xd3, 0x0f, 0xdb, 0xe0, 0x22, 0x16, 0x94, 0xe2, 0xaa, 0x88,
-	0xa4, 0xa0, 0x0f, 0x20, 0xe3, 0x57, 0x71, 0xa5, 0x8c, 0x0f, 0x10, 0xd2, 0x69, 0x9a, 0x46, 0xd3,
-	0xc9, 0x90, 0x64, 0xea, 0xbc, 0x8d, 0xaf, 0x6a, 0x26, 0x77, 0x5a, 0x44, 0x17, 0xae, 0x86, 0xb9,
-	0xe7, 0x77, 0x0f, 0x27, 0xe7, 0xa2, 0x5e, 0x61, 0xcd, 0x9b, 0xc8, 0x3c, 0x0d, 0x5f, 0x6f, 0x70,
-	0xdb, 0x6d, 0xb2, 0xcc, 0xe4, 0x4b, 0x25, 0x47, 0x7d, 0x6e, 0xb3, 0x95, 0xda, 0x70, 0x0d, 0xd2,
-	0xe8, 0x4c, 0x1a, 0x23, 0xb5, 0x98, 0xc6, 0xbf, 0x79, 0xb9, 0x9c, 0x2e, 0x4a, 0xcb, 0xbd, 0x32,
-	0x39, 0xe8, 0xe7, 0x9f, 0x2d, 0xd4, 0x7b, 0x01, 0xb3, 0xbb, 0xe8, 0x80, 0x2f, 0x11, 0xb6, 0x82,
-	0x2f, 0x84, 0x65, 0xbc, 0xf4, 0x2b, 0x26, 0xad, 0x29, 0x0b, 0x47, 0xfe, 0x8f, 0x5b, 0x93, 0x76,
-	0x3a, 0x00, 0x25, 0x09, 0xc2, 0x2c, 0xce, 0x6b, 0xfa, 0xc3, 0x2a, 0xff, 0x83, 0x6e, 0x01, 0x0d,
-	0xca, 0x37, 0xfa, 0x06, 0xf5, 0xd7, 0xbc, 0x62, 0xce, 0x07, 0x9f, 0x35, 0xe3, 0x52, 0x90, 0xbd,
-	0xf1, 0xbf, 0x49, 0xe7, 0xea, 0x84, 0x42, 0x4c, 0xba, 0x8d, 0x49, 0xef, 0x9b, 0x98, 0x69, 0x37,
-	0x2c, 0xbc, 0x46, 0x3e, 0x91, 0x02, 0x3f, 0xa2, 0x61, 0x61, 0xc5, 0x52, 0x55, 0x4c, 0x54, 0x85,
-	0x02, 0x84, 0xec, 0xff, 0xe5, 0x31, 0x80, 0x9d, 0x87, 0xdd, 0x0a, 0xbe, 0x40, 0x43, 0x28, 0x4a,
-	0x30, 0xe9, 0xd8, 0xbc, 0xcc, 0xde, 0x85, 0x27, 0x28, 0xf8, 0xb4, 0xd3, 0xa3, 0x46, 0x98, 0xb9,
-	0xdb, 0x38, 0x86, 0x42, 0xf2, 0x58, 0x88, 0xd6, 0x4d, 0x76, 0x47, 0x3a, 0x01, 0x3e, 0xac, 0x0b,
-	0xa9, 0x95, 0x44, 0x6b, 0xc8, 0xe8, 0xf0, 0x33, 0x3a, 0xde, 0x3a, 0xab, 0x20, 0x55, 0x0c, 0x0e,
-	0x43, 0xba, 0x31, 0xe4, 0x29, 0xdd, 0x9d, 0x8a, 0x26, 0x80, 0x3d, 0xd5, 0x14, 0x74, 0x9f, 0x62,
-	0xfe, 0x6b, 0x36, 0x3f, 0x88, 0xef, 0xb9, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x9c, 0x29,
-	0x52, 0xf4, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x6f, 0xd3, 0x30,
+	0x14, 0xc6, 0x95, 0xb6, 0x2b, 0xd4, 0x5d, 0xb7, 0xd6, 0x9a, 0x90, 0xa9, 0x04, 0x8a, 0x26, 0x0e,
+	0x11, 0x42, 0x99, 0x54, 0x0e, 0x4c, 0x5c, 0x50, 0x80, 0x31, 0xed, 0xc4, 0x14, 0xb8, 0x5b, 0x6e,
+	0xfa, 0xea, 0x9a, 0x25, 0x71, 0x64, 0x3b, 0x25, 0xfd, 0x7f, 0x39, 0xf1, 0x57, 0xa0, 0xf8, 0xa5,
+	0xd5, 0x18, 0x42, 0x70, 0xcb, 0x7b, 0xdf, 0xef, 0x7d, 0xfe, 0xf2, 0x1e, 0x99, 0x54, 0x46, 0x7f,
+	0x83, 0xcc, 0xc5, 0x95, 0xd1, 0x4e, 0xd3, 0x91, 0xdd, 0x66, 0x99, 0x2e, 0xd7, 0x4a, 0xce, 0x4f,
+	0x84, 0xc9, 0x36, 0x6a, 0x2b, 0x72, 0x94, 0xe6, 0xcf, 0xa5, 0xd6, 0x32, 0x87, 0x0b, 0x5f, 0x2d,
+	0xeb, 0xf5, 0xc5, 0xaa, 0x36, 0xc2, 0x29, 0x5d, 0xa2, 0x7e, 0xfe, 0xb3, 0x4f, 0x26, 0xb7, 0x68,
+	0xf6, 0xc1, 0x3b, 0xd0, 0x57, 0x84, 0x1a, 0x10, 0x2b, 0x30, 0x5c, 0xd4, 0x6e, 0xc3, 0xa5, 0xd1,
+	0x75, 0x65, 0x59, 0x2f, 0xec, 0x47, 0xa3, 0x74, 0x8a, 0x4a, 0x52, 0xbb, 0xcd, 0xb5, 0xef, 0xb7,
+	0xf4, 0x77, 0xa3, 0xdc, 0x03, 0xba, 0x8f, 0x34, 0x2a, 0xf7, 0xe8, 0x77, 0xe4, 0xa4, 0x10, 0x0d,
+	0xb7, 0xce, 0x80, 0x28, 0xb8, 0x90, 0xc0, 0x06, 0x61, 0x10, 0x8d, 0x17, 0x4f, 0x63, 0x8c, 0x19,
+	0xef, 0x63, 0xc6, 0x1f, 0xbb, 0x98, 0xe9, 0x71, 0x21, 0x9a, 0x2f, 0x9e, 0x4f, 0x24, 0xd0, 0x4f,
+	0x64, 0x56, 0x19, 0x58, 0xab, 0x86, 0x43, 0x53, 0x29, 0x44, 0xd8, 0xd1, 0xbf, 0x3c, 0xa6, 0x38,
+	0x73, 0x75, 0x18, 0xa1, 0x2f, 0xc9, 0x0c, 0x17, 0x05, 0x5c, 0x5a, 0xbe, 0xac, 0xb3, 0x3b, 0x70,
+	0x8c, 0x84, 0x41, 0x34, 0x4a, 0x4f, 0x3b, 0xe1, 0xda, 0xbe, 0xf7, 0x6d, 0x5c, 0x48, 0xe9, 0x17,
+	0x92, 0xe7, 0x5d, 0x76, 0xcb, 0xc6, 0x61, 0x10, 0x3d, 0x4e, 0xa7, 0xa8, 0x24, 0x79, 0x8e, 0x19,
+	0x2d, 0xfd, 0x4c, 0xce, 0xf6, 0xce, 0xaa, 0x5c, 0x41, 0xc3, 0xf1, 0x30, 0xec, 0xd8, 0x87, 0x7c,
+	0x16, 0x1f, 0x4e, 0x15, 0x27, 0x88, 0xdd, 0xb4, 0x14, 0xee, 0x3e, 0xa5, 0xe2, 0x8f, 0x1e, 0xbd,
+	0x69, 0x9f, 0x77, 0x50, 0xb6, 0xb9, 0x79, 0xa5, 0x73, 0x95, 0x29, 0xb0, 0x6c, 0x12, 0xf6, 0xa3,
+	0xf1, 0x62, 0x7e, 0xcf, 0x2e, 0xdd, 0x43, 0xb7, 0x2d, 0xb3, 0x4b, 0x67, 0xe6, 0xb7, 0x86, 0x02,
+	0x7b, 0xfe, 0x23, 0x20, 0xa7, 0x0f, 0x30, 0x4a, 0xc9, 0xa0, 0x14, 0x05, 0xb0, 0xc0, 0xff, 0xbc,
+	0xff, 0xa6, 0x4f, 0xc8, 0x10, 0x37, 0xc6, 0x7a, 0xbe, 0xdb, 0x55, 0xf4, 0x92, 0x0c, 0x9c, 0x90,
+	0x78, 0xde, 0xf1, 0xe2, 0xc5, 0xdf, 0x1f, 0x8f, 0xbf, 0x0a, 0x69, 0xaf, 0x4a, 0x67, 0x76, 0xa9,
+	0x9f, 0xa0, 0x0b, 0xf2, 0xa8, 0x3d, 0xfc, 0x7f, 0x5d, 0x7c, 0x58, 0x88, 0x26, 0x91, 0x30, 0x7f,
+	0x43, 0x46, 0x07, 0x1b, 0x3a, 0x25, 0xfd, 0x3b, 0xd8, 0x75, 0x29, 0xdb, 0x4f, 0x7a, 0x46, 0x8e,
+	0xb6, 0x22, 0xaf, 0xa1, 0xcb, 0x88, 0xc5, 0xdb, 0xde, 0x65, 0xb0, 0x1c, 0x7a, 0xcf, 0xd7, 0xbf,
+	0x06, 0x00, 0xf2, 0x25, 0x53, 0xe2, 0x26, 0x03, 0x00, 0x00,
 }
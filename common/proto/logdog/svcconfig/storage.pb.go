@@ -20,6 +20,7 @@ type Storage struct {
 	//
 	// Types that are valid to be assigned to Type:
 	//	*Storage_Bigtable
+	//	*Storage_Local_
 	Type isStorage_Type `protobuf_oneof:"Type"`
 	// The maximum lifetime of a log's intermediate storage entries. The Storage
 	// instance is free to begin deleting log entries if they are older than this.
@@ -49,8 +50,12 @@ type isStorage_Type interface {
 type Storage_Bigtable struct {
 	Bigtable *Storage_BigTable `protobuf:"bytes,1,opt,name=bigtable,oneof"`
 }
+type Storage_Local_ struct {
+	Local *Storage_Local `protobuf:"bytes,3,opt,name=local,oneof"`
+}
 
 func (*Storage_Bigtable) isStorage_Type() {}
+func (*Storage_Local_) isStorage_Type()   {}
 
 func (m *Storage) GetType() isStorage_Type {
 	if m != nil {
@@ -66,6 +71,13 @@ func (m *Storage) GetBigtable() *Storage_BigTable {
 	return nil
 }
 
+func (m *Storage) GetLocal() *Storage_Local {
+	if x, ok := m.GetType().(*Storage_Local_); ok {
+		return x.Local
+	}
+	return nil
+}
+
 func (m *Storage) GetMaxLogAge() *google_protobuf.Duration {
 	if m != nil {
 		return m.MaxLogAge
@@ -77,6 +89,7 @@ func (m *Storage) GetMaxLogAge() *google_protobuf.Duration {
 func (*Storage) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Storage_OneofMarshaler, _Storage_OneofUnmarshaler, _Storage_OneofSizer, []interface{}{
 		(*Storage_Bigtable)(nil),
+		(*Storage_Local_)(nil),
 	}
 }
 
@@ -89,6 +102,11 @@ func _Storage_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Bigtable); err != nil {
 			return err
 		}
+	case *Storage_Local_:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Local); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Storage.Type has unexpected type %T", x)
@@ -107,6 +125,14 @@ func _Storage_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer
 		err := b.DecodeMessage(msg)
 		m.Type = &Storage_Bigtable{msg}
 		return true, err
+	case 3: // Type.local
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Storage_Local)
+		err := b.DecodeMessage(msg)
+		m.Type = &Storage_Local_{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -121,6 +147,11 @@ func _Storage_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(1<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Storage_Local_:
+		s := proto.Size(x.Local)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -145,27 +176,42 @@ func (m *Storage_BigTable) String() string            { return proto.CompactText
 func (*Storage_BigTable) ProtoMessage()               {}
 func (*Storage_BigTable) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0, 0} }
 
+// Local is the set of parameters for an embedded on-disk store. This is
+// intended for development and single-machine deployments.
+type Storage_Local struct {
+	// The path of the store file. It will be created if it doesn't exist.
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *Storage_Local) Reset()                    { *m = Storage_Local{} }
+func (m *Storage_Local) String() string            { return proto.CompactTextString(m) }
+func (*Storage_Local) ProtoMessage()               {}
+func (*Storage_Local) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0, 1} }
+
 func init() {
 	proto.RegisterType((*Storage)(nil), "svcconfig.Storage")
 	proto.RegisterType((*Storage_BigTable)(nil), "svcconfig.Storage.BigTable")
+	proto.RegisterType((*Storage_Local)(nil), "svcconfig.Storage.Local")
 }
 
 var fileDescriptor3 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x3c, 0x8e, 0x3f, 0x4f, 0xc3, 0x30,
-	0x10, 0xc5, 0x69, 0x89, 0xda, 0xe6, 0x0a, 0x0c, 0x9e, 0x4c, 0x91, 0x10, 0x42, 0x0c, 0x4c, 0xae,
-	0x04, 0x13, 0x23, 0x15, 0x03, 0x03, 0x62, 0x08, 0xdd, 0x23, 0x27, 0x5c, 0x4f, 0x41, 0x69, 0xae,
-	0x4a, 0x1c, 0x54, 0xd8, 0xf8, 0xe6, 0x38, 0xe7, 0x24, 0x9b, 0xef, 0xf9, 0xf7, 0xfe, 0xc0, 0x79,
-	0xe3, 0xb8, 0xb6, 0x84, 0xe6, 0x50, 0xb3, 0x63, 0x15, 0x37, 0xdf, 0x79, 0xce, 0xd5, 0xae, 0xa0,
-	0xd5, 0x35, 0x31, 0x53, 0x89, 0x6b, 0xf9, 0xc8, 0xda, 0xdd, 0xfa, 0xb3, 0xad, 0xad, 0x2b, 0xb8,
-	0x0a, 0xe8, 0xed, 0xdf, 0x14, 0xe6, 0x1f, 0xc1, 0xac, 0x9e, 0x60, 0x91, 0x15, 0xe4, 0x6c, 0x56,
-	0xa2, 0x9e, 0xdc, 0x4c, 0xee, 0x97, 0x0f, 0x57, 0x66, 0x4c, 0x32, 0x3d, 0x65, 0x36, 0x05, 0x6d,
-	0x3b, 0xe4, 0xf5, 0x24, 0x19, 0x71, 0x6f, 0x5d, 0xee, 0xed, 0x31, 0x2d, 0x99, 0x52, 0xcf, 0xe8,
-	0xa9, 0xb8, 0x2f, 0x4d, 0x28, 0x37, 0x43, 0xb9, 0x79, 0xe9, 0xcb, 0x93, 0xd8, 0xd3, 0x6f, 0x4c,
-	0xcf, 0x84, 0xab, 0x23, 0x2c, 0x86, 0x48, 0xa5, 0x61, 0xee, 0xd9, 0x2f, 0xcc, 0x9d, 0x0c, 0x88,
-	0x93, 0xe1, 0x54, 0x0a, 0xa2, 0x5f, 0xae, 0x42, 0x72, 0x9c, 0xc8, 0xbb, 0xa3, 0xf3, 0xb2, 0x6d,
-	0x1c, 0xd6, 0xfa, 0x34, 0xd0, 0xfd, 0xa9, 0xee, 0xe0, 0xa2, 0x9b, 0x22, 0xdb, 0xd2, 0xca, 0xee,
-	0x51, 0x47, 0x02, 0x9c, 0x79, 0x55, 0x9a, 0xde, 0xbd, 0xb6, 0x99, 0x41, 0xb4, 0xfd, 0x39, 0x60,
-	0x36, 0x93, 0x7d, 0x8f, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x27, 0xfb, 0x3c, 0xfe, 0x46, 0x01,
-	0x00, 0x00,
+	// 275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x8f, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0x87, 0xdb, 0x92, 0xfe, 0xc9, 0x15, 0x18, 0x3c, 0x99, 0x54, 0x42, 0x08, 0x31, 0x30, 0xb9,
+	0x08, 0xa6, 0x8e, 0x54, 0x0c, 0x1d, 0x2a, 0x06, 0xd3, 0x3d, 0x72, 0x82, 0x6b, 0x82, 0x9c, 0x5c,
+	0x94, 0x38, 0x28, 0xf0, 0x94, 0x3c, 0x12, 0xca, 0x39, 0xe9, 0xc4, 0x76, 0xa7, 0xfb, 0x7e, 0x77,
+	0xdf, 0xc1, 0x45, 0xed, 0xb0, 0x52, 0x46, 0x8b, 0xb2, 0x42, 0x87, 0x2c, 0xac, 0xbf, 0xd2, 0x14,
+	0x8b, 0x63, 0x66, 0xa2, 0x6b, 0x83, 0x68, 0xac, 0x5e, 0xd3, 0x20, 0x69, 0x8e, 0xeb, 0xf7, 0xa6,
+	0x52, 0x2e, 0xc3, 0xc2, 0xa3, 0xb7, 0xbf, 0x13, 0x98, 0xbf, 0xf9, 0x30, 0xdb, 0xc0, 0x22, 0xc9,
+	0x8c, 0x53, 0x89, 0xd5, 0x7c, 0x7c, 0x33, 0xbe, 0x5f, 0x3e, 0xae, 0xc4, 0x69, 0x93, 0xe8, 0x29,
+	0xb1, 0xcd, 0xcc, 0xa1, 0x43, 0x76, 0x23, 0x79, 0xc2, 0xd9, 0x03, 0x4c, 0x2d, 0xa6, 0xca, 0xf2,
+	0x33, 0xca, 0xf1, 0x7f, 0x72, 0xfb, 0x6e, 0xbe, 0x1b, 0x49, 0x0f, 0xb2, 0x0d, 0x2c, 0x73, 0xd5,
+	0xc6, 0x16, 0x4d, 0xac, 0x8c, 0xe6, 0x13, 0xca, 0x5d, 0x09, 0xaf, 0x2b, 0x06, 0x5d, 0xf1, 0xd2,
+	0xeb, 0xca, 0x30, 0x57, 0xed, 0x1e, 0xcd, 0xb3, 0xd1, 0x51, 0x0b, 0x8b, 0x41, 0x82, 0x71, 0x98,
+	0x97, 0x15, 0x7e, 0xea, 0xd4, 0x91, 0x72, 0x28, 0x87, 0x96, 0x31, 0x08, 0x7e, 0xb0, 0xf0, 0x9b,
+	0x43, 0x49, 0x75, 0x47, 0xa7, 0xb6, 0xa9, 0x9d, 0xae, 0x48, 0x34, 0x94, 0x43, 0xcb, 0xee, 0xe0,
+	0xb2, 0x53, 0xa1, 0x6f, 0xe2, 0x42, 0xe5, 0x9a, 0x07, 0x04, 0x9c, 0x5b, 0xf4, 0x97, 0x5e, 0x55,
+	0xae, 0xa3, 0x15, 0x4c, 0xe9, 0x8d, 0x6e, 0x79, 0xa9, 0xdc, 0x47, 0x7f, 0x93, 0xea, 0xed, 0x0c,
+	0x82, 0xc3, 0x77, 0xa9, 0x93, 0x19, 0xc9, 0x3f, 0xfd, 0x0d, 0x00, 0xcb, 0x05, 0xc6, 0x06, 0x95,
+	0x01, 0x00, 0x00,
 }
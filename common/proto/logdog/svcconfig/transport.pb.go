@@ -19,6 +19,7 @@ type Transport struct {
 	//
 	// Types that are valid to be assigned to Type:
 	//	*Transport_Pubsub
+	//	*Transport_Http
 	Type isTransport_Type `protobuf_oneof:"Type"`
 }
 
@@ -34,8 +35,12 @@ type isTransport_Type interface {
 type Transport_Pubsub struct {
 	Pubsub *Transport_PubSub `protobuf:"bytes,1,opt,name=pubsub,oneof"`
 }
+type Transport_Http struct {
+	Http *Transport_HTTP `protobuf:"bytes,2,opt,name=http,oneof"`
+}
 
 func (*Transport_Pubsub) isTransport_Type() {}
+func (*Transport_Http) isTransport_Type()   {}
 
 func (m *Transport) GetType() isTransport_Type {
 	if m != nil {
@@ -51,10 +56,18 @@ func (m *Transport) GetPubsub() *Transport_PubSub {
 	return nil
 }
 
+func (m *Transport) GetHttp() *Transport_HTTP {
+	if x, ok := m.GetType().(*Transport_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Transport) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Transport_OneofMarshaler, _Transport_OneofUnmarshaler, _Transport_OneofSizer, []interface{}{
 		(*Transport_Pubsub)(nil),
+		(*Transport_Http)(nil),
 	}
 }
 
@@ -67,6 +80,11 @@ func _Transport_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Pubsub); err != nil {
 			return err
 		}
+	case *Transport_Http:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Http); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Transport.Type has unexpected type %T", x)
@@ -85,6 +103,14 @@ func _Transport_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		err := b.DecodeMessage(msg)
 		m.Type = &Transport_Pubsub{msg}
 		return true, err
+	case 2: // Type.http
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Transport_HTTP)
+		err := b.DecodeMessage(msg)
+		m.Type = &Transport_Http{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -99,6 +125,11 @@ func _Transport_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(1<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Transport_Http:
+		s := proto.Size(x.Http)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -121,22 +152,43 @@ func (m *Transport_PubSub) String() string            { return proto.CompactText
 func (*Transport_PubSub) ProtoMessage()               {}
 func (*Transport_PubSub) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 0} }
 
+// HTTP is a transport configuration for direct HTTP ingestion. Butler
+// instances POST butlerproto-formatted log bundles directly to a Collector.
+//
+// This is intended for on-premise deployments and local end-to-end testing.
+type Transport_HTTP struct {
+	// The URL that Butler instances will POST log bundles to. The Collector
+	// will serve ingestion requests on this URL's path.
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	// The local address that the Collector will listen on (e.g., ":8080").
+	Listen string `protobuf:"bytes,2,opt,name=listen" json:"listen,omitempty"`
+}
+
+func (m *Transport_HTTP) Reset()                    { *m = Transport_HTTP{} }
+func (m *Transport_HTTP) String() string            { return proto.CompactTextString(m) }
+func (*Transport_HTTP) ProtoMessage()               {}
+func (*Transport_HTTP) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 1} }
+
 func init() {
 	proto.RegisterType((*Transport)(nil), "svcconfig.Transport")
 	proto.RegisterType((*Transport_PubSub)(nil), "svcconfig.Transport.PubSub")
+	proto.RegisterType((*Transport_HTTP)(nil), "svcconfig.Transport.HTTP")
 }
 
 var fileDescriptor4 = []byte{
-	// 167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x2f, 0x29, 0x4a, 0xcc,
-	0x2b, 0x2e, 0xc8, 0x2f, 0x2a, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2c, 0x2e, 0x4b,
-	0x4e, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x57, 0x5a, 0xc1, 0xc8, 0xc5, 0x19, 0x02, 0x93, 0x16, 0x32,
-	0xe5, 0x62, 0x2b, 0x28, 0x4d, 0x2a, 0x2e, 0x4d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92,
-	0xd6, 0x83, 0xab, 0xd4, 0x83, 0xab, 0xd2, 0x0b, 0x28, 0x4d, 0x0a, 0x2e, 0x4d, 0xf2, 0x60, 0x08,
-	0x82, 0x2a, 0x96, 0x8a, 0xe1, 0x62, 0x83, 0x88, 0x09, 0x49, 0x70, 0xb1, 0x03, 0xad, 0xc8, 0x4a,
-	0x4d, 0x2e, 0x01, 0x9b, 0xc0, 0x19, 0x04, 0xe3, 0x0a, 0x89, 0x70, 0xb1, 0x96, 0xe4, 0x17, 0x64,
-	0x26, 0x4b, 0x30, 0x81, 0xc5, 0x21, 0x1c, 0x21, 0x25, 0x2e, 0x1e, 0xa0, 0x01, 0xc5, 0xc9, 0x45,
-	0x99, 0x05, 0x25, 0x99, 0xf9, 0x79, 0x12, 0xcc, 0x60, 0x49, 0x14, 0x31, 0x27, 0x36, 0x2e, 0x96,
-	0x90, 0xca, 0x82, 0xd4, 0x24, 0x36, 0xb0, 0xe3, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x39,
-	0x0e, 0x08, 0x8a, 0xcf, 0x00, 0x00, 0x00,
+	// 218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x90, 0xcd, 0x4a, 0x43, 0x31,
+	0x10, 0x85, 0xfb, 0x73, 0x8d, 0xdc, 0x51, 0x50, 0x06, 0x91, 0x58, 0x37, 0xd2, 0x95, 0xab, 0x28,
+	0x8a, 0x2f, 0xe0, 0xea, 0x2e, 0x4b, 0xcc, 0xd2, 0x8d, 0x09, 0x57, 0x8d, 0x94, 0x64, 0x48, 0x26,
+	0x82, 0xcf, 0xe0, 0x4b, 0x4b, 0xd3, 0xb4, 0x20, 0x74, 0x97, 0x93, 0xf9, 0xe6, 0x3b, 0x30, 0x70,
+	0xc6, 0xe9, 0x2d, 0x64, 0x8a, 0x89, 0x15, 0xa5, 0xc8, 0x11, 0xfb, 0xfc, 0xed, 0x5c, 0x0c, 0xef,
+	0xfe, 0x63, 0xf9, 0x3b, 0x83, 0xde, 0xec, 0xc6, 0xf8, 0x04, 0x82, 0x8a, 0xcd, 0xc5, 0xca, 0xe9,
+	0xcd, 0xf4, 0xf6, 0xe4, 0xe1, 0x5a, 0xed, 0x49, 0xb5, 0xa7, 0xd4, 0xaa, 0xd8, 0x97, 0x62, 0x87,
+	0x89, 0x6e, 0x30, 0xde, 0x41, 0xf7, 0xc9, 0x4c, 0x72, 0x56, 0x97, 0xae, 0x0e, 0x2e, 0x0d, 0xc6,
+	0xac, 0x86, 0x89, 0xae, 0xe0, 0xe2, 0x15, 0xc4, 0x56, 0x82, 0x12, 0x8e, 0x29, 0xc5, 0xaf, 0xd1,
+	0x71, 0xad, 0xec, 0xf5, 0x2e, 0xe2, 0x05, 0x1c, 0x71, 0x24, 0xef, 0xaa, 0xb5, 0xd7, 0xdb, 0x80,
+	0x4b, 0x38, 0xcd, 0xc5, 0x66, 0x97, 0x3c, 0xb1, 0x8f, 0x41, 0xce, 0xeb, 0xf0, 0xdf, 0xdf, 0xe2,
+	0x1e, 0xba, 0x4d, 0x1b, 0x9e, 0xc3, 0xbc, 0xa4, 0x75, 0xf3, 0x6e, 0x9e, 0x78, 0x09, 0x62, 0xed,
+	0x33, 0x8f, 0xa1, 0x49, 0x5b, 0x7a, 0x16, 0xd0, 0x99, 0x1f, 0x1a, 0xad, 0xa8, 0xf7, 0x79, 0xfc,
+	0x1b, 0x00, 0x44, 0xbb, 0x19, 0x81, 0x32, 0x01, 0x00, 0x00,
 }
@@ -12,6 +12,7 @@ It has these top-level messages:
 	FailureDetails
 	Step
 	Component
+	TestResults
 	Command
 	Progress
 	LogdogStream
@@ -210,6 +211,8 @@ type Component struct {
 	// the component.
 	OtherLinks []*Component_Link     `protobuf:"bytes,8,rep,name=other_links,json=otherLinks" json:"other_links,omitempty"`
 	Property   []*Component_Property `protobuf:"bytes,9,rep,name=property" json:"property,omitempty"`
+	// A summary of the test results reported by this Component, if any.
+	TestResults *TestResults `protobuf:"bytes,10,opt,name=test_results,json=testResults" json:"test_results,omitempty"`
 }
 
 func (m *Component) Reset()                    { *m = Component{} }
@@ -259,6 +262,13 @@ func (m *Component) GetProperty() []*Component_Property {
 	return nil
 }
 
+func (m *Component) GetTestResults() *TestResults {
+	if m != nil {
+		return m.TestResults
+	}
+	return nil
+}
+
 // A Link is an optional label followed by a typed link to an external
 // resource.
 type Component_Link struct {
@@ -455,6 +465,23 @@ func (m *Component_Property) String() string            { return proto.CompactTe
 func (*Component_Property) ProtoMessage()               {}
 func (*Component_Property) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 1} }
 
+// TestResults is a summary of the test results emitted by a Component.
+type TestResults struct {
+	// The number of tests that passed.
+	Passed int32 `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
+	// The number of tests that failed.
+	Failed int32 `protobuf:"varint,2,opt,name=failed" json:"failed,omitempty"`
+	// The number of tests that failed at least once, but ultimately passed.
+	Flaky int32 `protobuf:"varint,3,opt,name=flaky" json:"flaky,omitempty"`
+	// The names of the tests that failed, sorted.
+	FailingTests []string `protobuf:"bytes,4,rep,name=failing_tests,json=failingTests" json:"failing_tests,omitempty"`
+}
+
+func (m *TestResults) Reset()                    { *m = TestResults{} }
+func (m *TestResults) String() string            { return proto.CompactTextString(m) }
+func (*TestResults) ProtoMessage()               {}
+func (*TestResults) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
 // Command contains information about a command-line invocation.
 type Command struct {
 	// The command-line invocation, expressed as an argument vector.
@@ -467,7 +494,7 @@ type Command struct {
 func (m *Command) Reset()                    { *m = Command{} }
 func (m *Command) String() string            { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()               {}
-func (*Command) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Command) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *Command) GetEnviron() *Command_Environment {
 	if m != nil {
@@ -485,7 +512,7 @@ type Command_Environment struct {
 func (m *Command_Environment) Reset()                    { *m = Command_Environment{} }
 func (m *Command_Environment) String() string            { return proto.CompactTextString(m) }
 func (*Command_Environment) ProtoMessage()               {}
-func (*Command_Environment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+func (*Command_Environment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 0} }
 
 func (m *Command_Environment) GetEntries() []*Command_Environment_Entry {
 	if m != nil {
@@ -502,10 +529,12 @@ type Command_Environment_Entry struct {
 	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
 }
 
-func (m *Command_Environment_Entry) Reset()                    { *m = Command_Environment_Entry{} }
-func (m *Command_Environment_Entry) String() string            { return proto.CompactTextString(m) }
-func (*Command_Environment_Entry) ProtoMessage()               {}
-func (*Command_Environment_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0, 0} }
+func (m *Command_Environment_Entry) Reset()         { *m = Command_Environment_Entry{} }
+func (m *Command_Environment_Entry) String() string { return proto.CompactTextString(m) }
+func (*Command_Environment_Entry) ProtoMessage()    {}
+func (*Command_Environment_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{4, 0, 0}
+}
 
 // Progress expresses a Component's overall progress. It does this using
 // arbitrary "progress units", wich are discrete units of work measured by the
@@ -525,7 +554,7 @@ type Progress struct {
 func (m *Progress) Reset()                    { *m = Progress{} }
 func (m *Progress) String() string            { return proto.CompactTextString(m) }
 func (*Progress) ProtoMessage()               {}
-func (*Progress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*Progress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 // LogdogLink is a LogDog stream link.
 type LogdogStream struct {
@@ -542,7 +571,7 @@ type LogdogStream struct {
 func (m *LogdogStream) Reset()                    { *m = LogdogStream{} }
 func (m *LogdogStream) String() string            { return proto.CompactTextString(m) }
 func (*LogdogStream) ProtoMessage()               {}
-func (*LogdogStream) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*LogdogStream) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 // IsolateObject is an Isolate service object specification.
 type IsolateObject struct {
@@ -556,7 +585,7 @@ type IsolateObject struct {
 func (m *IsolateObject) Reset()                    { *m = IsolateObject{} }
 func (m *IsolateObject) String() string            { return proto.CompactTextString(m) }
 func (*IsolateObject) ProtoMessage()               {}
-func (*IsolateObject) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*IsolateObject) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 // Dependency is a Dungeon Master execution specification.
 type DMLink struct {
@@ -574,7 +603,7 @@ type DMLink struct {
 func (m *DMLink) Reset()                    { *m = DMLink{} }
 func (m *DMLink) String() string            { return proto.CompactTextString(m) }
 func (*DMLink) ProtoMessage()               {}
-func (*DMLink) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*DMLink) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func init() {
 	proto.RegisterType((*FailureDetails)(nil), "milo.FailureDetails")
@@ -582,6 +611,7 @@ func init() {
 	proto.RegisterType((*Component)(nil), "milo.Component")
 	proto.RegisterType((*Component_Link)(nil), "milo.Component.Link")
 	proto.RegisterType((*Component_Property)(nil), "milo.Component.Property")
+	proto.RegisterType((*TestResults)(nil), "milo.TestResults")
 	proto.RegisterType((*Command)(nil), "milo.Command")
 	proto.RegisterType((*Command_Environment)(nil), "milo.Command.Environment")
 	proto.RegisterType((*Command_Environment_Entry)(nil), "milo.Command.Environment.Entry")
@@ -594,62 +624,67 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 907 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x55, 0xeb, 0x6e, 0xe3, 0x44,
-	0x14, 0xde, 0x5c, 0x9c, 0x6c, 0x4e, 0x9a, 0x6c, 0x18, 0x02, 0xf2, 0x46, 0x48, 0xbb, 0x44, 0x48,
-	0x54, 0x2b, 0xe1, 0x42, 0x96, 0xab, 0x80, 0x4a, 0xdd, 0xc4, 0x5d, 0x22, 0x75, 0xdd, 0x6a, 0xd2,
-	0x4a, 0xf0, 0xcb, 0x72, 0xe2, 0x49, 0x6a, 0xf0, 0x0d, 0x7b, 0x5c, 0x5a, 0xf1, 0x12, 0xfc, 0xe4,
-	0xa5, 0x78, 0x02, 0x5e, 0x82, 0x47, 0xe0, 0xcc, 0xc5, 0x4e, 0x02, 0xad, 0x10, 0xff, 0xce, 0xe5,
-	0xfb, 0xe6, 0xcc, 0xb9, 0xcd, 0xc0, 0x5b, 0x5e, 0x1c, 0x27, 0xdc, 0xe3, 0x41, 0x12, 0xe7, 0x56,
-	0x9a, 0x25, 0x3c, 0x21, 0xcd, 0x28, 0x08, 0x93, 0xd1, 0xb3, 0x4d, 0x92, 0x6c, 0x42, 0x76, 0x24,
-	0x6d, 0xcb, 0x62, 0x7d, 0xc4, 0x83, 0x88, 0xe5, 0xdc, 0x8b, 0x52, 0x05, 0x1b, 0xff, 0x51, 0x83,
-	0xfe, 0xa9, 0x17, 0x84, 0x45, 0xc6, 0x66, 0x8c, 0xa3, 0x90, 0x93, 0x8f, 0xa0, 0xc9, 0xef, 0x52,
-	0x66, 0xd6, 0x9e, 0xd7, 0x0e, 0xfb, 0x93, 0xa7, 0x96, 0x38, 0xc8, 0xda, 0xc7, 0x58, 0x97, 0x08,
-	0xa0, 0x12, 0x46, 0x08, 0xc2, 0xd9, 0x2d, 0x37, 0xeb, 0x08, 0xef, 0x50, 0x29, 0x93, 0x63, 0x18,
-	0xae, 0x11, 0xc7, 0x7c, 0xd7, 0x8f, 0x5c, 0x9f, 0xa5, 0x2c, 0xf6, 0x59, 0xbc, 0xba, 0x33, 0x1b,
-	0xcf, 0x1b, 0x87, 0xdd, 0xc9, 0x81, 0x3a, 0x72, 0xf6, 0xe6, 0x2c, 0x88, 0x7f, 0xa2, 0x44, 0x21,
-	0x67, 0xd1, 0xac, 0xc2, 0x8d, 0xbf, 0x84, 0xa6, 0x88, 0x40, 0xba, 0xd0, 0x7e, 0x6d, 0x3b, 0x36,
-	0x3d, 0x39, 0x1b, 0x3c, 0x22, 0x1d, 0x30, 0xe6, 0xce, 0x29, 0x3d, 0x19, 0xd4, 0x88, 0x09, 0xc3,
-	0xd9, 0x1b, 0x77, 0x66, 0x5f, 0xd8, 0xce, 0xcc, 0x76, 0xa6, 0x3f, 0xb8, 0xa7, 0x27, 0xf3, 0x33,
-	0x7b, 0x36, 0xa8, 0x8f, 0x7f, 0xab, 0x43, 0x73, 0xc1, 0x59, 0x4a, 0x3e, 0x84, 0xf6, 0x2a, 0x89,
-	0x22, 0x2f, 0xf6, 0x65, 0x22, 0xdd, 0x49, 0x4f, 0x45, 0x9d, 0x2a, 0x23, 0x2d, 0xbd, 0xe4, 0x5b,
-	0x78, 0xb2, 0x56, 0xc9, 0xe1, 0x4d, 0x65, 0x76, 0x32, 0x95, 0xee, 0x64, 0x78, 0x5f, 0xe6, 0xb4,
-	0xbf, 0xde, 0xaf, 0xd6, 0xe7, 0xd0, 0xcf, 0x31, 0x9e, 0x8b, 0xc7, 0xa5, 0x49, 0xcc, 0x62, 0x8e,
-	0x49, 0x0a, 0xf6, 0x93, 0x2a, 0x9c, 0x32, 0xd3, 0x9e, 0x80, 0x55, 0x2a, 0x39, 0x02, 0xa8, 0x28,
-	0xb9, 0xd9, 0x94, 0x85, 0xf9, 0x17, 0x67, 0x07, 0x42, 0xbe, 0x00, 0x33, 0x2f, 0x96, 0x32, 0x56,
-	0x98, 0x6c, 0xfc, 0x64, 0xe3, 0xc6, 0x5e, 0xc4, 0xdc, 0xa5, 0x97, 0x33, 0xd3, 0x40, 0x7a, 0x87,
-	0xbe, 0xa3, 0xfd, 0x67, 0xd2, 0xed, 0xa0, 0xf7, 0x15, 0x3a, 0xc7, 0xbf, 0x1b, 0xd0, 0xd9, 0xc6,
-	0xc5, 0x76, 0x09, 0x9e, 0x2c, 0x0a, 0xb6, 0x4b, 0xc8, 0xe4, 0x03, 0x68, 0xe1, 0x4c, 0xf0, 0x42,
-	0x65, 0xde, 0x2f, 0x1b, 0xb4, 0x90, 0x36, 0xaa, 0x7d, 0xe4, 0x53, 0x68, 0xa3, 0x94, 0x71, 0xe6,
-	0xeb, 0x14, 0x47, 0x96, 0x9a, 0x2e, 0xab, 0x9c, 0x2e, 0xeb, 0xb2, 0x9c, 0x2e, 0x5a, 0x42, 0xc9,
-	0xc7, 0x60, 0x88, 0xae, 0xfa, 0x98, 0xe2, 0x7f, 0x71, 0x14, 0xb0, 0x1a, 0x28, 0x95, 0x94, 0x1a,
-	0xa8, 0x17, 0xf0, 0x18, 0x09, 0x9b, 0x8c, 0xe5, 0xb9, 0xd9, 0x92, 0x07, 0xf5, 0xd5, 0x1d, 0x2f,
-	0xb4, 0x95, 0x56, 0x7e, 0x72, 0x08, 0xcd, 0x10, 0x07, 0xcb, 0x6c, 0xef, 0x76, 0xb1, 0x2a, 0x80,
-	0x25, 0x87, 0x4e, 0x22, 0xc8, 0x67, 0xd0, 0x4d, 0xf8, 0x35, 0xcb, 0x5c, 0xa1, 0xe5, 0xe6, 0x63,
-	0xd9, 0x84, 0xfb, 0x09, 0x20, 0x81, 0x42, 0x14, 0x85, 0x10, 0xc1, 0x52, 0x96, 0xf1, 0x3b, 0xb3,
-	0x23, 0x39, 0xe6, 0x3f, 0x39, 0x17, 0xda, 0x4f, 0x2b, 0xe4, 0xe8, 0xcf, 0x1a, 0x34, 0x05, 0x9f,
-	0x0c, 0xc1, 0x08, 0xbd, 0x25, 0x0b, 0x75, 0x0b, 0x94, 0x82, 0x59, 0x37, 0x8a, 0x2c, 0x54, 0x5b,
-	0xf4, 0xdd, 0x23, 0x2a, 0x14, 0xf2, 0x15, 0xf4, 0x74, 0xab, 0x73, 0x9e, 0x31, 0x2f, 0xd2, 0x75,
-	0x27, 0x2a, 0x9a, 0x6a, 0xf3, 0x42, 0x7a, 0x90, 0x71, 0x10, 0xee, 0xe8, 0xe4, 0x1b, 0xe8, 0x07,
-	0x79, 0x12, 0x7a, 0x9c, 0xb9, 0xc9, 0xf2, 0x47, 0xb6, 0xe2, 0xba, 0xfe, 0x6f, 0x2b, 0xee, 0x5c,
-	0xf9, 0xce, 0xa5, 0x0b, 0xc9, 0xbd, 0x60, 0xd7, 0x20, 0x96, 0x07, 0x17, 0x57, 0x56, 0xd1, 0x90,
-	0xb4, 0xbd, 0x95, 0x45, 0x7c, 0xcb, 0x8f, 0x84, 0xf4, 0xaa, 0x0d, 0xc6, 0x8d, 0x17, 0x16, 0x6c,
-	0x84, 0x35, 0x29, 0x73, 0xbe, 0x77, 0xc4, 0x86, 0x1a, 0xa8, 0x9f, 0x09, 0xa5, 0x8c, 0xff, 0xaa,
-	0x41, 0x5b, 0x2f, 0x24, 0x79, 0x1f, 0x0e, 0xf4, 0x4a, 0x8a, 0xc0, 0x82, 0x2d, 0xda, 0xdf, 0xd5,
-	0x36, 0x8c, 0xc6, 0xc8, 0x00, 0x1a, 0xab, 0x5f, 0x7c, 0x7d, 0x84, 0x10, 0xc9, 0x4b, 0x68, 0xb3,
-	0xf8, 0x26, 0xc8, 0x92, 0x58, 0xd7, 0xe6, 0xe9, 0xde, 0x96, 0x5b, 0xb6, 0x72, 0x46, 0x62, 0x99,
-	0x4a, 0xe4, 0xe8, 0x57, 0xe8, 0xee, 0xd8, 0xb1, 0xca, 0xe8, 0xe1, 0x59, 0xc0, 0x72, 0x19, 0xb3,
-	0x3b, 0x79, 0xf6, 0xe0, 0x19, 0x28, 0xf3, 0xec, 0x8e, 0x96, 0xf8, 0xd1, 0x27, 0x60, 0x48, 0xcb,
-	0xff, 0x48, 0xf9, 0x58, 0x16, 0x4a, 0x4d, 0x2a, 0x22, 0x38, 0x3e, 0xdb, 0x6a, 0x12, 0x0c, 0xaa,
-	0x14, 0xf2, 0x1e, 0x74, 0xc4, 0xda, 0x87, 0x4c, 0x6c, 0x5a, 0x5d, 0x7a, 0xb6, 0x86, 0x31, 0x85,
-	0x83, 0xdd, 0xc6, 0x93, 0x77, 0x71, 0x77, 0x59, 0x76, 0xc3, 0x32, 0x1d, 0x5b, 0x6b, 0xc2, 0x9e,
-	0x66, 0x6c, 0x1d, 0xdc, 0xea, 0xf0, 0x5a, 0xab, 0x6e, 0xda, 0xd8, 0xde, 0x74, 0xfc, 0x35, 0xf4,
-	0xf6, 0x06, 0xe2, 0xc1, 0x43, 0x91, 0x7c, 0xed, 0xe5, 0xd7, 0xe5, 0x5b, 0x2f, 0xe4, 0x71, 0x0c,
-	0x2d, 0x35, 0x16, 0x0f, 0xb2, 0x30, 0xcd, 0x9f, 0x0b, 0xdc, 0xf1, 0xb2, 0x10, 0x52, 0xc1, 0x37,
-	0xbc, 0xed, 0x71, 0xce, 0xa2, 0x54, 0xbd, 0x98, 0x0d, 0x5a, 0xaa, 0xa2, 0x00, 0xec, 0x96, 0xad,
-	0x0a, 0xf1, 0x9d, 0xc9, 0xb1, 0x6d, 0xd0, 0xad, 0xe1, 0xc5, 0x31, 0xb4, 0xd4, 0xc3, 0x24, 0x7e,
-	0x07, 0x7a, 0xe5, 0x38, 0x73, 0xe7, 0x35, 0xfe, 0x0e, 0xa8, 0x2c, 0xae, 0xa6, 0x53, 0x7b, 0xb1,
-	0xc0, 0xff, 0x01, 0x15, 0xf1, 0x23, 0x5c, 0x51, 0x7b, 0x50, 0x27, 0x3d, 0xe8, 0xd8, 0xdf, 0x4f,
-	0xed, 0x8b, 0xcb, 0xf9, 0xb9, 0x33, 0x68, 0x2c, 0x5b, 0xf2, 0xe5, 0x79, 0xf9, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd3, 0xc2, 0x04, 0x70, 0x34, 0x07, 0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x55, 0xeb, 0x6e, 0x1b, 0x45,
+	0x14, 0xb6, 0xb3, 0xbb, 0x76, 0x7c, 0x7c, 0xa9, 0x3b, 0x18, 0xb4, 0xb5, 0x90, 0x6a, 0x0c, 0x12,
+	0x51, 0x25, 0x1c, 0x48, 0xc3, 0xa5, 0x02, 0x22, 0xa5, 0xf1, 0xa6, 0x89, 0x94, 0xba, 0xd1, 0x38,
+	0x91, 0xe0, 0xd7, 0x6a, 0xec, 0x3d, 0x76, 0x96, 0xec, 0x8d, 0x9d, 0x71, 0x88, 0xc5, 0x4b, 0xf0,
+	0x62, 0xf0, 0x02, 0xbc, 0x04, 0x8f, 0x80, 0xe6, 0xb2, 0x1b, 0x07, 0x25, 0x42, 0xfd, 0x37, 0xdf,
+	0x39, 0xdf, 0x99, 0x33, 0xe7, 0x3a, 0xf0, 0x94, 0x25, 0x49, 0x2a, 0x98, 0x08, 0xd3, 0x84, 0x8f,
+	0xb2, 0x3c, 0x15, 0x29, 0xb1, 0xe3, 0x30, 0x4a, 0xfb, 0xcf, 0x97, 0x69, 0xba, 0x8c, 0x70, 0x57,
+	0xc9, 0x66, 0xab, 0xc5, 0xae, 0x08, 0x63, 0xe4, 0x82, 0xc5, 0x99, 0xa6, 0x0d, 0xff, 0xac, 0x42,
+	0xe7, 0x98, 0x85, 0xd1, 0x2a, 0xc7, 0x31, 0x0a, 0x16, 0x46, 0x9c, 0x7c, 0x01, 0xb6, 0x58, 0x67,
+	0xe8, 0x56, 0x07, 0xd5, 0x9d, 0xce, 0xde, 0xb3, 0x91, 0xbc, 0x68, 0x74, 0x9f, 0x33, 0xba, 0x58,
+	0x67, 0x48, 0x15, 0x8d, 0x10, 0xb0, 0x05, 0xde, 0x0a, 0x77, 0x6b, 0x50, 0xdd, 0x69, 0x50, 0x75,
+	0x26, 0x07, 0xd0, 0x5b, 0xb0, 0x30, 0xc2, 0xc0, 0x0f, 0x62, 0x3f, 0xc0, 0x0c, 0x93, 0x00, 0x93,
+	0xf9, 0xda, 0xb5, 0x06, 0xd6, 0x4e, 0x73, 0xaf, 0xa5, 0xaf, 0x1c, 0xbf, 0x3d, 0x0b, 0x93, 0x6b,
+	0x4a, 0x34, 0x73, 0x1c, 0x8f, 0x4b, 0xde, 0xf0, 0x3b, 0xb0, 0xa5, 0x07, 0xd2, 0x84, 0xfa, 0x1b,
+	0x6f, 0xe2, 0xd1, 0xc3, 0xb3, 0x6e, 0x85, 0x34, 0xc0, 0x39, 0x9d, 0x1c, 0xd3, 0xc3, 0x6e, 0x95,
+	0xb8, 0xd0, 0x1b, 0xbf, 0xf5, 0xc7, 0xde, 0xb9, 0x37, 0x19, 0x7b, 0x93, 0xa3, 0x9f, 0xfd, 0xe3,
+	0xc3, 0xd3, 0x33, 0x6f, 0xdc, 0xdd, 0x1a, 0xfe, 0xb1, 0x05, 0xf6, 0x54, 0x60, 0x46, 0x3e, 0x87,
+	0xfa, 0x3c, 0x8d, 0x63, 0x96, 0x04, 0x2a, 0x90, 0xe6, 0x5e, 0x5b, 0x7b, 0x3d, 0xd2, 0x42, 0x5a,
+	0x68, 0xc9, 0x8f, 0xf0, 0x64, 0xa1, 0x83, 0xf3, 0x03, 0x1d, 0x9d, 0x0a, 0xa5, 0xb9, 0xd7, 0x7b,
+	0x28, 0x72, 0xda, 0x59, 0xdc, 0xcf, 0xd6, 0x37, 0xd0, 0xe1, 0x02, 0x33, 0x7f, 0x9e, 0xc6, 0x59,
+	0x9a, 0x60, 0x22, 0x5c, 0x4b, 0x59, 0x3f, 0x29, 0xdd, 0x69, 0x31, 0x6d, 0x4b, 0x5a, 0x09, 0xc9,
+	0x2e, 0x40, 0x69, 0xc2, 0x5d, 0x7b, 0x60, 0x3d, 0x64, 0xb3, 0x41, 0x21, 0xdf, 0x82, 0xcb, 0x57,
+	0x33, 0xe5, 0x2b, 0x4a, 0x97, 0x41, 0xba, 0xf4, 0x13, 0x16, 0xa3, 0x3f, 0x63, 0x1c, 0x5d, 0x67,
+	0x60, 0xed, 0x34, 0xe8, 0x87, 0x46, 0x7f, 0xa6, 0xd4, 0x13, 0x16, 0xe3, 0x6b, 0xc6, 0x71, 0xf8,
+	0x97, 0x03, 0x8d, 0x3b, 0xbf, 0x04, 0x6c, 0x69, 0xa7, 0x92, 0xd2, 0xa0, 0xea, 0x4c, 0x3e, 0x83,
+	0x1a, 0x17, 0x4c, 0xac, 0x74, 0xe4, 0x9d, 0xa2, 0x40, 0x53, 0x25, 0xa3, 0x46, 0x47, 0xf6, 0xa1,
+	0xce, 0x05, 0xcb, 0x05, 0x06, 0x26, 0xc4, 0xfe, 0x48, 0x77, 0xd7, 0xa8, 0xe8, 0xae, 0xd1, 0x45,
+	0xd1, 0x5d, 0xb4, 0xa0, 0x92, 0x2f, 0xc1, 0x91, 0x55, 0x0d, 0x5c, 0xfb, 0x7f, 0x6d, 0x34, 0xb1,
+	0x6c, 0x28, 0x1d, 0x94, 0x3a, 0x93, 0x17, 0xb0, 0x9d, 0xe5, 0xe9, 0x32, 0x47, 0xce, 0xdd, 0x9a,
+	0xba, 0xa8, 0xa3, 0xdf, 0x78, 0x6e, 0xa4, 0xb4, 0xd4, 0x93, 0x1d, 0xb0, 0xa3, 0x30, 0xb9, 0x76,
+	0xeb, 0x9b, 0x55, 0x2c, 0x13, 0x30, 0x52, 0x4d, 0xa7, 0x18, 0xe4, 0x6b, 0x68, 0xa6, 0xe2, 0x0a,
+	0x73, 0x5f, 0x22, 0xee, 0x6e, 0x0f, 0xac, 0x47, 0x0d, 0x40, 0x11, 0xe5, 0x51, 0x26, 0x42, 0x3a,
+	0xcb, 0x30, 0x17, 0x6b, 0xb7, 0xa1, 0x6c, 0xdc, 0xff, 0xda, 0x9c, 0x1b, 0x3d, 0x2d, 0x99, 0x64,
+	0x1f, 0x5a, 0x02, 0xb9, 0xf0, 0x73, 0xe4, 0xab, 0x48, 0x70, 0x17, 0xd4, 0xf3, 0x9e, 0x6a, 0xcb,
+	0x0b, 0xe4, 0x82, 0x6a, 0x05, 0x6d, 0x8a, 0x3b, 0xd0, 0xff, 0xbb, 0x0a, 0xb6, 0xf4, 0x4a, 0x7a,
+	0xe0, 0x44, 0x6c, 0x86, 0x91, 0x29, 0x9c, 0x06, 0x84, 0x80, 0xb5, 0xca, 0x23, 0x3d, 0x7b, 0x27,
+	0x15, 0x2a, 0x01, 0x79, 0x05, 0x6d, 0xd3, 0x20, 0x5c, 0xe4, 0xc8, 0x62, 0x53, 0x2d, 0xa2, 0x3d,
+	0xe9, 0xe6, 0x98, 0x2a, 0xcd, 0x49, 0x85, 0xb6, 0xa2, 0x0d, 0x4c, 0x7e, 0x80, 0x4e, 0xc8, 0xd3,
+	0x88, 0x09, 0xf4, 0xd3, 0xd9, 0x2f, 0x38, 0x17, 0xa6, 0x6a, 0x1f, 0x68, 0xdb, 0x53, 0xad, 0x7b,
+	0xa7, 0x54, 0x27, 0x15, 0xda, 0x0e, 0x37, 0x05, 0x72, 0xe4, 0x82, 0x58, 0xe5, 0xd2, 0x75, 0x94,
+	0xd9, 0xbd, 0x41, 0x3f, 0xa9, 0xd0, 0x5a, 0x10, 0xcb, 0xd3, 0xeb, 0x3a, 0x38, 0x37, 0x2c, 0x5a,
+	0x61, 0x7f, 0x1f, 0xb6, 0x8b, 0x4c, 0x3d, 0xd8, 0x98, 0x3d, 0x43, 0x34, 0xcb, 0x45, 0x83, 0xe1,
+	0x2d, 0x34, 0x37, 0xf2, 0x45, 0x3e, 0x82, 0x5a, 0xc6, 0x38, 0x47, 0x3d, 0xe8, 0x0e, 0x35, 0x48,
+	0xca, 0xf5, 0x6a, 0x51, 0xd6, 0x0e, 0x35, 0x48, 0x5e, 0xba, 0x88, 0xd8, 0xf5, 0x5a, 0xe5, 0xc5,
+	0xa1, 0x1a, 0x90, 0x4f, 0xa1, 0x2d, 0xf5, 0x61, 0xb2, 0xf4, 0x65, 0xfe, 0xf5, 0x48, 0x36, 0x68,
+	0xcb, 0x08, 0xa5, 0x43, 0x3e, 0xfc, 0xa7, 0x0a, 0x75, 0xb3, 0x40, 0xc8, 0x27, 0xd0, 0x32, 0x2b,
+	0x44, 0x86, 0x2c, 0xdf, 0x2d, 0xf9, 0x4d, 0x23, 0x3b, 0x0b, 0x13, 0x24, 0x5d, 0xb0, 0xe6, 0xbf,
+	0x05, 0xe6, 0xf1, 0xf2, 0x48, 0x5e, 0x42, 0x1d, 0x93, 0x9b, 0x30, 0x4f, 0x13, 0x53, 0x95, 0x67,
+	0xf7, 0xb6, 0xd2, 0xc8, 0xd3, 0xca, 0x58, 0x0e, 0x7f, 0xc1, 0xec, 0xff, 0x0e, 0xcd, 0x0d, 0x39,
+	0x79, 0x25, 0xef, 0x10, 0x79, 0x88, 0x5c, 0xf9, 0x6c, 0xee, 0x3d, 0x7f, 0xf4, 0x8e, 0x91, 0x97,
+	0x88, 0x7c, 0x4d, 0x0b, 0x7e, 0xff, 0x2b, 0x70, 0x94, 0xe4, 0x3d, 0x92, 0x7d, 0xa0, 0x4a, 0xa4,
+	0x27, 0xab, 0x07, 0x8e, 0x48, 0x05, 0x8b, 0x4c, 0xa2, 0x35, 0x20, 0x1f, 0x43, 0x43, 0xae, 0xa9,
+	0x08, 0x45, 0x99, 0xea, 0x3b, 0xc1, 0x90, 0x42, 0x6b, 0xb3, 0xe5, 0x64, 0x55, 0x38, 0xe6, 0x37,
+	0x98, 0x1b, 0xdf, 0x06, 0xa9, 0x2a, 0xe6, 0xb8, 0x08, 0x6f, 0x8d, 0x7b, 0x83, 0xca, 0x97, 0x5a,
+	0x77, 0x2f, 0x1d, 0x7e, 0x0f, 0xed, 0x7b, 0xad, 0xf8, 0xe8, 0xa5, 0x04, 0xec, 0x2b, 0xc6, 0xaf,
+	0x8a, 0xbf, 0x49, 0x9e, 0x87, 0x09, 0xd4, 0x74, 0x43, 0x3e, 0x6a, 0xd5, 0x03, 0xe7, 0xd7, 0x15,
+	0xf2, 0xe2, 0x4b, 0xd3, 0x80, 0xb8, 0x50, 0x67, 0x42, 0x60, 0x9c, 0xe9, 0x0d, 0x6f, 0xd1, 0x02,
+	0xca, 0x04, 0xe0, 0x2d, 0xce, 0x57, 0xf2, 0xfb, 0x55, 0x03, 0x63, 0xd1, 0x3b, 0xc1, 0x8b, 0x03,
+	0xa8, 0xe9, 0x45, 0x2a, 0x7f, 0x33, 0x7a, 0x39, 0x99, 0x9c, 0x4e, 0xde, 0x74, 0x2b, 0x12, 0x4c,
+	0x2f, 0x8f, 0x8e, 0xbc, 0xe9, 0xb4, 0x5b, 0x95, 0x40, 0xfe, 0x60, 0x97, 0xd4, 0xeb, 0x6e, 0x91,
+	0x36, 0x34, 0xbc, 0x9f, 0x8e, 0xbc, 0xf3, 0x8b, 0xd3, 0x77, 0x93, 0xae, 0x35, 0xab, 0xa9, 0x4d,
+	0xf9, 0xf2, 0xdf, 0x01, 0x00, 0x85, 0x7d, 0x6c, 0xb2, 0xe4, 0x07, 0x00, 0x00,
 }
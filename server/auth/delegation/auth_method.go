@@ -0,0 +1,173 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package delegation implements authentication based on LUCI delegation
+// tokens.
+//
+// Delegation tokens are minted by the token server (see MintDelegationToken
+// RPC) and allow a LUCI service to call other LUCI services on behalf of some
+// end user.
+//
+// See also https://godoc.org/github.com/luci/luci-go/appengine/cmd/tokenserver.
+package delegation
+
+import (
+	"net/http"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/tokenserver"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
+	"github.com/luci/luci-go/server/auth/internal/tokens"
+	"github.com/luci/luci-go/server/auth/machine"
+)
+
+const (
+	// DelegationTokenHeader is an HTTP header that carries the delegation token.
+	DelegationTokenHeader = "X-Delegation-Token-V1"
+)
+
+var (
+	// ErrBadToken is returned if the supplied delegation token is not valid.
+	//
+	// See app logs for more details.
+	ErrBadToken = errors.New("bad delegation token")
+)
+
+// DelegationTokenAuthMethod implements auth.Method by verifying delegation
+// tokens.
+//
+// It looks at X-Delegation-Token-V1 header and verifies that it contains a
+// valid non-expired delegation token issued by some trusted token server
+// instance for this service.
+//
+// A list of trusted token servers is specified in 'auth-token-servers' group.
+//
+// If the token is valid, the request will be authenticated as coming from the
+// delegated identity specified in the token.
+//
+// Delegation tokens are bearer tokens, so this method should be put before
+// other methods in auth.Authenticator to take precedence over credentials of
+// whoever presents the token.
+type DelegationTokenAuthMethod struct {
+	// ServiceIdentity is an identity of this service, e.g. "service:app-id".
+	//
+	// Only tokens that list it (or "*") in their services are accepted. If
+	// empty, only tokens usable by all services are accepted.
+	ServiceIdentity identity.Identity
+
+	// certsFetcher is mocked in unit tests.
+	//
+	// In prod it is signing.FetchServiceAccountCertificates.
+	certsFetcher tokens.CertsFetcher
+}
+
+// Authenticate extracts delegated identity from the incoming request.
+//
+// It logs detailed errors in log, but returns only generic "bad credential"
+// error to the caller, to avoid leaking unnecessary information.
+func (m *DelegationTokenAuthMethod) Authenticate(c context.Context, r *http.Request) (*auth.User, error) {
+	token := r.Header.Get(DelegationTokenHeader)
+	if token == "" {
+		return nil, nil // no token -> the auth method is not applicable
+	}
+
+	// Deserialize both envelope and the body.
+	envelope := &tokenserver.DelegationTokenEnvelope{}
+	body := &tokenserver.DelegationTokenBody{}
+	err := tokens.Deserialize(token, envelope, func() []byte { return envelope.TokenBody }, body)
+	if err != nil {
+		logTokenError(c, r, nil, err, "Failed to deserialize the token")
+		return nil, ErrBadToken
+	}
+
+	// Machine tokens are signed by the same key, make sure it's not one of them.
+	if body.TokenType != tokenserver.DelegationTokenBody_DELEGATION_TOKEN {
+		logTokenError(c, r, body, nil, "Not a delegation token - %s", body.TokenType)
+		return nil, ErrBadToken
+	}
+
+	// Construct an identity of a token server that signed the token to check that
+	// it belongs to "auth-token-servers" group.
+	signerServiceAccount, err := identity.MakeIdentity("user:" + body.IssuedBy)
+	if err != nil {
+		logTokenError(c, r, body, err, "Bad issued_by field - %q", body.IssuedBy)
+		return nil, ErrBadToken
+	}
+
+	// Reject tokens from unknown token servers right away.
+	db, err := auth.GetDB(c)
+	if err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+	ok, err := db.IsMember(c, signerServiceAccount, machine.TokenServersGroup)
+	if err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+	if !ok {
+		logTokenError(c, r, body, nil, "Unknown token issuer - %q", body.IssuedBy)
+		return nil, ErrBadToken
+	}
+
+	// Check the expiration time and the audience before doing any heavier checks.
+	if err = tokens.CheckExpiration(body.IssuedAt, body.Lifetime, clock.Now(c)); err != nil {
+		logTokenError(c, r, body, err, "Token has expired or not yet valid")
+		return nil, ErrBadToken
+	}
+	if !m.isAudience(body) {
+		logTokenError(c, r, body, nil, "Token is not intended for %q", string(m.ServiceIdentity))
+		return nil, ErrBadToken
+	}
+
+	// Check the token was actually signed by the server.
+	if err = tokens.CheckSignature(c, m.certsFetcher, body.IssuedBy, envelope.KeyId, envelope.TokenBody, envelope.RsaSha256); err != nil {
+		if errors.IsTransient(err) {
+			return nil, err
+		}
+		logTokenError(c, r, body, err, "Bad signature")
+		return nil, ErrBadToken
+	}
+
+	// The token is valid. Use the delegated identity.
+	delegated, err := identity.MakeIdentity(body.DelegatedIdentity)
+	if err != nil || delegated.Kind() == identity.Anonymous {
+		logTokenError(c, r, body, err, "Bad delegated_identity - %q", body.DelegatedIdentity)
+		return nil, ErrBadToken
+	}
+	user := &auth.User{Identity: delegated}
+	if delegated.Kind() == identity.User {
+		user.Email = delegated.Value()
+	}
+	return user, nil
+}
+
+// isAudience returns true if the token can be used by this service.
+func (m *DelegationTokenAuthMethod) isAudience(body *tokenserver.DelegationTokenBody) bool {
+	for _, s := range body.Services {
+		if s == "*" || (m.ServiceIdentity != "" && s == string(m.ServiceIdentity)) {
+			return true
+		}
+	}
+	return false
+}
+
+// logTokenError adds a warning-level log entry with details about the request.
+func logTokenError(c context.Context, r *http.Request, tok *tokenserver.DelegationTokenBody, err error, msg string, args ...interface{}) {
+	fields := logging.Fields{}
+	if tok != nil {
+		// Note that if token wasn't properly signed, these fields may contain
+		// garbage.
+		fields["delegatedIdentity"] = tok.DelegatedIdentity
+		fields["requestorIdentity"] = tok.RequestorIdentity
+		fields["issuedBy"] = tok.IssuedBy
+		fields["issuedAt"] = tok.IssuedAt
+		fields["lifetime"] = tok.Lifetime
+		fields["services"] = tok.Services
+	}
+	tokens.LogError(c, r, fields, err, msg, args...)
+}
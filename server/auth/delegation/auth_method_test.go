@@ -0,0 +1,183 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package delegation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/tokenserver"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/logging/memlogger"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	"github.com/luci/luci-go/server/auth/machine"
+	"github.com/luci/luci-go/server/auth/signing"
+	"github.com/luci/luci-go/server/auth/signing/signingtest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDelegationTokenAuthMethod(t *testing.T) {
+	Convey("with mock context", t, func() {
+		ctx := makeTestContext()
+		log := logging.Get(ctx).(*memlogger.MemLogger)
+		signer := signingtest.NewSigner(0)
+		method := DelegationTokenAuthMethod{
+			ServiceIdentity: "service:this-service",
+			certsFetcher: func(c context.Context, email string) (*signing.PublicCertificates, error) {
+				if email == "valid-signer@example.com" {
+					return signer.Certificates(c)
+				}
+				return nil, fmt.Errorf("unknown signer")
+			},
+		}
+
+		mint := func(tok *tokenserver.DelegationTokenBody, sig []byte) string {
+			body, _ := proto.Marshal(tok)
+			keyID, validSig, _ := signer.SignBytes(ctx, body)
+			if sig == nil {
+				sig = validSig
+			}
+			envelope, _ := proto.Marshal(&tokenserver.DelegationTokenEnvelope{
+				TokenBody: body,
+				KeyId:     keyID,
+				RsaSha256: sig,
+			})
+			return base64.RawStdEncoding.EncodeToString(envelope)
+		}
+
+		call := func(tok string) (*auth.User, error) {
+			r, _ := http.NewRequest("GET", "/something", nil)
+			if tok != "" {
+				r.Header.Set(DelegationTokenHeader, tok)
+			}
+			return method.Authenticate(ctx, r)
+		}
+
+		hasLog := func(msg string) bool {
+			return log.HasFunc(func(m *memlogger.LogEntry) bool {
+				return strings.Contains(m.Msg, msg)
+			})
+		}
+
+		validBody := func() *tokenserver.DelegationTokenBody {
+			return &tokenserver.DelegationTokenBody{
+				DelegatedIdentity: "user:delegated@example.com",
+				RequestorIdentity: "user:requestor@example.com",
+				IssuedBy:          "valid-signer@example.com",
+				IssuedAt:          uint64(clock.Now(ctx).Unix()),
+				Lifetime:          3600,
+				Services:          []string{"service:this-service"},
+				TokenType:         tokenserver.DelegationTokenBody_DELEGATION_TOKEN,
+			}
+		}
+
+		Convey("valid token works", func() {
+			user, err := call(mint(validBody(), nil))
+			So(err, ShouldBeNil)
+			So(user, ShouldResemble, &auth.User{
+				Identity: "user:delegated@example.com",
+				Email:    "delegated@example.com",
+			})
+		})
+
+		Convey("token for all services works", func() {
+			body := validBody()
+			body.Services = []string{"*"}
+			user, err := call(mint(body, nil))
+			So(err, ShouldBeNil)
+			So(user.Identity, ShouldEqual, "user:delegated@example.com")
+		})
+
+		Convey("token of another type is rejected", func() {
+			body := validBody()
+			body.TokenType = tokenserver.DelegationTokenBody_MACHINE_TOKEN
+			_, err := call(mint(body, nil))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Not a delegation token"), ShouldBeTrue)
+		})
+
+		Convey("token without type is rejected", func() {
+			body := validBody()
+			body.TokenType = tokenserver.DelegationTokenBody_UNKNOWN_TOKEN_TYPE
+			_, err := call(mint(body, nil))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Not a delegation token"), ShouldBeTrue)
+		})
+
+		Convey("not header => not applicable", func() {
+			user, err := call("")
+			So(user, ShouldBeNil)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("broken envelope", func() {
+			_, err := call("abcdef")
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Failed to deserialize the token"), ShouldBeTrue)
+		})
+
+		Convey("unknown signer", func() {
+			body := validBody()
+			body.IssuedBy = "unknown-signer@example.com"
+			_, err := call(mint(body, nil))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Unknown token issuer"), ShouldBeTrue)
+		})
+
+		Convey("expired", func() {
+			body := validBody()
+			body.IssuedAt -= 3620
+			_, err := call(mint(body, nil))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Token has expired or not yet valid"), ShouldBeTrue)
+		})
+
+		Convey("wrong audience", func() {
+			body := validBody()
+			body.Services = []string{"service:another-service"}
+			_, err := call(mint(body, nil))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Token is not intended for"), ShouldBeTrue)
+		})
+
+		Convey("bad signature", func() {
+			_, err := call(mint(validBody(), []byte("bad signature")))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Bad signature"), ShouldBeTrue)
+		})
+
+		Convey("bad delegated_identity", func() {
+			body := validBody()
+			body.DelegatedIdentity = "anonymous:anonymous"
+			_, err := call(mint(body, nil))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Bad delegated_identity"), ShouldBeTrue)
+		})
+	})
+}
+
+func makeTestContext() context.Context {
+	ctx := context.Background()
+	ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 7, time.UTC))
+	ctx = memlogger.Use(ctx)
+
+	db := authtest.FakeDB{
+		"user:valid-signer@example.com": []string{machine.TokenServersGroup},
+	}
+	ctx = db.Use(ctx)
+
+	return ctx
+}
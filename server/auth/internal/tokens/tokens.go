@@ -0,0 +1,92 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package tokens contains helpers shared by auth methods which verify tokens
+// signed by the token server, e.g. machine and delegation tokens.
+package tokens
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth/signing"
+)
+
+// AllowedClockDrift is how much clock difference we tolerate.
+const AllowedClockDrift = 10 * time.Second
+
+// CertsFetcher fetches the certificates of a token server's service account.
+//
+// In prod it is signing.FetchServiceAccountCertificates.
+type CertsFetcher func(c context.Context, email string) (*signing.PublicCertificates, error)
+
+// Deserialize decodes a base64-encoded token into its envelope, and then the
+// serialized token body (as returned by bodyOf) into body.
+//
+// bodyOf is called after envelope is unmarshalled.
+func Deserialize(token string, envelope proto.Message, bodyOf func() []byte, body proto.Message) error {
+	tokenBinBlob, err := base64.RawStdEncoding.DecodeString(token)
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(tokenBinBlob, envelope); err != nil {
+		return err
+	}
+	return proto.Unmarshal(bodyOf(), body)
+}
+
+// CheckExpiration returns nil if a token issued at issuedAt (Unix time) and
+// valid for lifetime seconds is non-expired yet.
+//
+// Allows some clock drift, see AllowedClockDrift.
+func CheckExpiration(issuedAt, lifetime uint64, now time.Time) error {
+	notBefore := time.Unix(int64(issuedAt), 0)
+	notAfter := notBefore.Add(time.Duration(lifetime) * time.Second)
+	if now.Before(notBefore.Add(-AllowedClockDrift)) {
+		diff := notBefore.Sub(now)
+		return fmt.Errorf("token is not valid yet, will be valid in %s", diff)
+	}
+	if now.After(notAfter.Add(AllowedClockDrift)) {
+		diff := now.Sub(notAfter)
+		return fmt.Errorf("token expired %s ago", diff)
+	}
+	return nil
+}
+
+// CheckSignature verifies that the token body was signed by signerEmail,
+// using the key keyID.
+//
+// Uses signing.FetchServiceAccountCertificates if fetcher is nil. Errors
+// fetching the certificates are transient.
+func CheckSignature(c context.Context, fetcher CertsFetcher, signerEmail, keyID string, body, sig []byte) error {
+	// Note that FetchServiceAccountCertificates implements caching inside.
+	if fetcher == nil {
+		fetcher = signing.FetchServiceAccountCertificates
+	}
+	certs, err := fetcher(c, signerEmail)
+	if err != nil {
+		return errors.WrapTransient(err)
+	}
+	return certs.CheckSignature(keyID, body, sig)
+}
+
+// LogError adds a warning-level log entry with details about the request, and
+// the token fields.
+func LogError(c context.Context, r *http.Request, fields logging.Fields, err error, msg string, args ...interface{}) {
+	f := logging.Fields{"remoteAddr": r.RemoteAddr}
+	for k, v := range fields {
+		f[k] = v
+	}
+	if err != nil {
+		f[logging.ErrorKey] = err
+	}
+	f.Warningf(c, msg, args...)
+}
@@ -0,0 +1,143 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tokens
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/tokenserver"
+	lerrors "github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/logging/memlogger"
+	"github.com/luci/luci-go/server/auth/signing"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeserialize(t *testing.T) {
+	Convey("Deserialize", t, func() {
+		encode := func(env *tokenserver.MachineTokenEnvelope) string {
+			blob, err := proto.Marshal(env)
+			So(err, ShouldBeNil)
+			return base64.RawStdEncoding.EncodeToString(blob)
+		}
+
+		deserialize := func(token string) (*tokenserver.MachineTokenEnvelope, *tokenserver.MachineTokenBody, error) {
+			env := &tokenserver.MachineTokenEnvelope{}
+			body := &tokenserver.MachineTokenBody{}
+			err := Deserialize(token, env, func() []byte { return env.TokenBody }, body)
+			return env, body, err
+		}
+
+		Convey("works", func() {
+			bodyBlob, err := proto.Marshal(&tokenserver.MachineTokenBody{MachineFqdn: "host"})
+			So(err, ShouldBeNil)
+
+			env, body, err := deserialize(encode(&tokenserver.MachineTokenEnvelope{
+				TokenBody: bodyBlob,
+				KeyId:     "key",
+			}))
+			So(err, ShouldBeNil)
+			So(env.KeyId, ShouldEqual, "key")
+			So(body.MachineFqdn, ShouldEqual, "host")
+		})
+
+		Convey("bad base64", func() {
+			_, _, err := deserialize("not base64!")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("bad envelope", func() {
+			_, _, err := deserialize("abcdef")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("bad body", func() {
+			_, _, err := deserialize(encode(&tokenserver.MachineTokenEnvelope{
+				TokenBody: []byte("not a proto"),
+			}))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestCheckExpiration(t *testing.T) {
+	Convey("CheckExpiration", t, func() {
+		issued := time.Unix(1422936306, 0)
+		check := func(now time.Time) error {
+			return CheckExpiration(uint64(issued.Unix()), 3600, now)
+		}
+
+		So(check(issued), ShouldBeNil)
+		So(check(issued.Add(-AllowedClockDrift)), ShouldBeNil)
+		So(check(issued.Add(time.Hour+AllowedClockDrift)), ShouldBeNil)
+		So(check(issued.Add(-AllowedClockDrift-time.Second)), ShouldErrLike, "token is not valid yet, will be valid in 11s")
+		So(check(issued.Add(time.Hour+AllowedClockDrift+time.Second)), ShouldErrLike, "token expired 11s ago")
+	})
+}
+
+func TestCheckSignature(t *testing.T) {
+	Convey("CheckSignature", t, func() {
+		c := context.Background()
+
+		Convey("fetch errors are transient", func() {
+			fetcher := func(c context.Context, email string) (*signing.PublicCertificates, error) {
+				So(email, ShouldEqual, "signer@example.com")
+				return nil, errors.New("boom")
+			}
+			err := CheckSignature(c, fetcher, "signer@example.com", "key", []byte("body"), []byte("sig"))
+			So(lerrors.IsTransient(err), ShouldBeTrue)
+		})
+
+		Convey("unknown key", func() {
+			fetcher := func(c context.Context, email string) (*signing.PublicCertificates, error) {
+				return &signing.PublicCertificates{}, nil
+			}
+			err := CheckSignature(c, fetcher, "signer@example.com", "key", []byte("body"), []byte("sig"))
+			So(err, ShouldNotBeNil)
+			So(lerrors.IsTransient(err), ShouldBeFalse)
+		})
+	})
+}
+
+func TestLogError(t *testing.T) {
+	Convey("LogError", t, func() {
+		c := memlogger.Use(context.Background())
+		r := &http.Request{RemoteAddr: "127.0.0.1"}
+
+		LogError(c, r, logging.Fields{"issuedBy": "someone"}, errors.New("boom"), "Bad field")
+
+		log := logging.Get(c).(*memlogger.MemLogger)
+		msgs := log.Messages()
+		So(msgs, ShouldHaveLength, 1)
+		So(msgs[0].Level, ShouldEqual, logging.Warning)
+		So(msgs[0].Msg, ShouldEqual, "Bad field")
+		So(msgs[0].Data, ShouldResemble, map[string]interface{}{
+			"remoteAddr":     "127.0.0.1",
+			"issuedBy":       "someone",
+			logging.ErrorKey: errors.New("boom"),
+		})
+	})
+
+	Convey("LogError formats the message", t, func() {
+		c := memlogger.Use(context.Background())
+		r := &http.Request{RemoteAddr: "127.0.0.1"}
+
+		LogError(c, r, nil, nil, "Bad field - %q, %d", "value", 42)
+
+		log := logging.Get(c).(*memlogger.MemLogger)
+		msgs := log.Messages()
+		So(msgs, ShouldHaveLength, 1)
+		So(msgs[0].Msg, ShouldEqual, `Bad field - "value", 42`)
+	})
+}
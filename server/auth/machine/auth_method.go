@@ -8,12 +8,8 @@
 package machine
 
 import (
-	"encoding/base64"
-	"fmt"
 	"net/http"
-	"time"
 
-	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
 	"github.com/luci/luci-go/common/api/tokenserver"
@@ -22,7 +18,7 @@ import (
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/server/auth"
 	"github.com/luci/luci-go/server/auth/identity"
-	"github.com/luci/luci-go/server/auth/signing"
+	"github.com/luci/luci-go/server/auth/internal/tokens"
 )
 
 const (
@@ -33,9 +29,6 @@ const (
 	//
 	// This group should contain service account emails of token servers we trust.
 	TokenServersGroup = "auth-token-servers"
-
-	// allowedClockDrift is how much clock difference we tolerate.
-	allowedClockDrift = 10 * time.Second
 )
 
 var (
@@ -60,7 +53,7 @@ type MachineTokenAuthMethod struct {
 	// certsFetcher is mocked in unit tests.
 	//
 	// In prod it is signing.FetchServiceAccountCertificates.
-	certsFetcher func(c context.Context, email string) (*signing.PublicCertificates, error)
+	certsFetcher tokens.CertsFetcher
 }
 
 // Authenticate extracts peer's identity from the incoming request.
@@ -74,9 +67,20 @@ func (m *MachineTokenAuthMethod) Authenticate(c context.Context, r *http.Request
 	}
 
 	// Deserialize both envelope and the body.
-	envelope, body, err := deserialize(token)
+	envelope := &tokenserver.MachineTokenEnvelope{}
+	body := &tokenserver.MachineTokenBody{}
+	err := tokens.Deserialize(token, envelope, func() []byte { return envelope.TokenBody }, body)
 	if err != nil {
-		logTokenError(c, r, body, err, "Failed to deserialize the token")
+		logTokenError(c, r, nil, err, "Failed to deserialize the token")
+		return nil, ErrBadToken
+	}
+
+	// Delegation tokens are signed by the same key, make sure it's not one of
+	// them. Tokens minted before token_type was added don't have it set.
+	switch body.TokenType {
+	case tokenserver.MachineTokenBody_MACHINE_TOKEN, tokenserver.MachineTokenBody_UNKNOWN_TOKEN_TYPE:
+	default:
+		logTokenError(c, r, body, nil, "Not a machine token - %s", body.TokenType)
 		return nil, ErrBadToken
 	}
 
@@ -103,13 +107,13 @@ func (m *MachineTokenAuthMethod) Authenticate(c context.Context, r *http.Request
 	}
 
 	// Check the expiration time before doing any heavier checks.
-	if err = checkExpiration(body, clock.Now(c)); err != nil {
+	if err = tokens.CheckExpiration(body.IssuedAt, body.Lifetime, clock.Now(c)); err != nil {
 		logTokenError(c, r, body, err, "Token has expired or not yet valid")
 		return nil, ErrBadToken
 	}
 
 	// Check the token was actually signed by the server.
-	if err = m.checkSignature(c, body.IssuedBy, envelope); err != nil {
+	if err = tokens.CheckSignature(c, m.certsFetcher, body.IssuedBy, envelope.KeyId, envelope.TokenBody, envelope.RsaSha256); err != nil {
 		if errors.IsTransient(err) {
 			return nil, err
 		}
@@ -127,8 +131,8 @@ func (m *MachineTokenAuthMethod) Authenticate(c context.Context, r *http.Request
 }
 
 // logTokenError adds a warning-level log entry with details about the request.
-func logTokenError(c context.Context, r *http.Request, tok *tokenserver.MachineTokenBody, err error, msg string, args ...string) {
-	fields := logging.Fields{"remoteAddr": r.RemoteAddr}
+func logTokenError(c context.Context, r *http.Request, tok *tokenserver.MachineTokenBody, err error, msg string, args ...interface{}) {
+	fields := logging.Fields{}
 	if tok != nil {
 		// Note that if token wasn't properly signed, these fields may contain
 		// garbage.
@@ -139,56 +143,5 @@ func logTokenError(c context.Context, r *http.Request, tok *tokenserver.MachineT
 		fields["caId"] = tok.CaId
 		fields["certSn"] = tok.CertSn
 	}
-	if err != nil {
-		fields[logging.ErrorKey] = err
-	}
-	fields.Warningf(c, msg, args)
-}
-
-// deserialize parses MachineTokenEnvelope and MachineTokenBody.
-func deserialize(token string) (*tokenserver.MachineTokenEnvelope, *tokenserver.MachineTokenBody, error) {
-	tokenBinBlob, err := base64.RawStdEncoding.DecodeString(token)
-	if err != nil {
-		return nil, nil, err
-	}
-	envelope := &tokenserver.MachineTokenEnvelope{}
-	if err := proto.Unmarshal(tokenBinBlob, envelope); err != nil {
-		return nil, nil, err
-	}
-	body := &tokenserver.MachineTokenBody{}
-	if err := proto.Unmarshal(envelope.TokenBody, body); err != nil {
-		return envelope, nil, err
-	}
-	return envelope, body, nil
-}
-
-// checkExpiration returns nil if the token is non-expired yet.
-//
-// Allows some clock drift, see allowedClockDrift.
-func checkExpiration(body *tokenserver.MachineTokenBody, now time.Time) error {
-	notBefore := time.Unix(int64(body.IssuedAt), 0)
-	notAfter := notBefore.Add(time.Duration(body.Lifetime) * time.Second)
-	if now.Before(notBefore.Add(-allowedClockDrift)) {
-		diff := notBefore.Sub(now)
-		return fmt.Errorf("token is not valid yet, will be valid in %s", diff)
-	}
-	if now.After(notAfter.Add(allowedClockDrift)) {
-		diff := now.Sub(notAfter)
-		return fmt.Errorf("token expired %s ago", diff)
-	}
-	return nil
-}
-
-// checkSignature verifies the token signature.
-func (m *MachineTokenAuthMethod) checkSignature(c context.Context, signerEmail string, envelope *tokenserver.MachineTokenEnvelope) error {
-	// Note that FetchServiceAccountCertificates implements caching inside.
-	fetcher := m.certsFetcher
-	if fetcher == nil {
-		fetcher = signing.FetchServiceAccountCertificates
-	}
-	certs, err := fetcher(c, signerEmail)
-	if err != nil {
-		return errors.WrapTransient(err)
-	}
-	return certs.CheckSignature(envelope.KeyId, envelope.TokenBody, envelope.RsaSha256)
+	tokens.LogError(c, r, fields, err, msg, args...)
 }
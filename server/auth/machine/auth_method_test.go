@@ -81,6 +81,30 @@ func TestMachineTokenAuthMethod(t *testing.T) {
 			So(user, ShouldResemble, &auth.User{Identity: "bot:some-machine.location"})
 		})
 
+		Convey("valid token with token_type works", func() {
+			user, err := call(mint(&tokenserver.MachineTokenBody{
+				MachineFqdn: "some-machine.location",
+				IssuedBy:    "valid-signer@example.com",
+				IssuedAt:    uint64(clock.Now(ctx).Unix()),
+				Lifetime:    3600,
+				TokenType:   tokenserver.MachineTokenBody_MACHINE_TOKEN,
+			}, nil))
+			So(err, ShouldBeNil)
+			So(user, ShouldResemble, &auth.User{Identity: "bot:some-machine.location"})
+		})
+
+		Convey("token of another type is rejected", func() {
+			_, err := call(mint(&tokenserver.MachineTokenBody{
+				MachineFqdn: "some-machine.location",
+				IssuedBy:    "valid-signer@example.com",
+				IssuedAt:    uint64(clock.Now(ctx).Unix()),
+				Lifetime:    3600,
+				TokenType:   tokenserver.MachineTokenBody_DELEGATION_TOKEN,
+			}, nil))
+			So(err, ShouldEqual, ErrBadToken)
+			So(hasLog("Not a machine token"), ShouldBeTrue)
+		})
+
 		Convey("not header => not applicable", func() {
 			user, err := call("")
 			So(user, ShouldBeNil)
@@ -263,6 +263,8 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 			IndexPrefixRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.PrefixRange }),
 			IndexByteRange:   indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.ByteRange }),
 			AlwaysRender:     (acfg.RenderAllStreams || pcfg.RenderAllStreams),
+
+			CompressBlockSize: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.CompressBlockSize }),
 		}
 
 		// Fold project settings into loaded ones.
@@ -274,7 +276,8 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 func main() {
 	a := application{
 		Service: service.Service{
-			Name: "archivist",
+			Name:            "archivist",
+			ReadOnlyStorage: true,
 		},
 	}
 	a.Run(context.Background(), a.runArchivist)
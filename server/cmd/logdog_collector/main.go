@@ -6,19 +6,20 @@ package main
 
 import (
 	"fmt"
-	"time"
+	"net/url"
 
 	"github.com/luci/luci-go/common/auth"
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/errors"
 	gcps "github.com/luci/luci-go/common/gcloud/pubsub"
 	log "github.com/luci/luci-go/common/logging"
-	"github.com/luci/luci-go/common/parallel"
+	"github.com/luci/luci-go/common/proto/logdog/svcconfig"
 	"github.com/luci/luci-go/common/tsmon/distribution"
 	"github.com/luci/luci-go/common/tsmon/field"
 	"github.com/luci/luci-go/common/tsmon/metric"
 	"github.com/luci/luci-go/server/internal/logdog/collector"
 	"github.com/luci/luci-go/server/internal/logdog/collector/coordinator"
+	"github.com/luci/luci-go/server/internal/logdog/collector/transport"
 	"github.com/luci/luci-go/server/internal/logdog/service"
 	"golang.org/x/net/context"
 	"google.golang.org/cloud"
@@ -29,25 +30,21 @@ var (
 	errInvalidConfig = errors.New("invalid configuration")
 )
 
-const (
-	pubsubPullErrorDelay = 10 * time.Second
-)
-
 // Metrics.
 var (
-	// tsPubsubCount counts the number of Pub/Sub messages processed by the
-	// Archivist.
+	// tsMessageCount counts the number of transport messages processed by the
+	// Collector.
 	//
 	// Result tracks the outcome of each message, either "success", "failure", or
 	// "transient_failure".
-	tsPubsubCount = metric.NewCounter("logdog/collector/subscription/count",
-		"The number of Pub/Sub messages pulled.",
+	tsMessageCount = metric.NewCounter("logdog/collector/subscription/count",
+		"The number of transport messages received.",
 		field.String("result"))
 
 	// tsTaskProcessingTime tracks the amount of time a single subscription
 	// message takes to process, in milliseconds.
 	tsTaskProcessingTime = metric.NewCumulativeDistribution("logdog/collector/subscription/processing_time_ms",
-		"Amount of time in milliseconds that a single Pub/Sub message takes to process.",
+		"Amount of time in milliseconds that a single transport message takes to process.",
 		distribution.DefaultBucketer)
 )
 
@@ -64,15 +61,70 @@ func (a *application) runCollector(c context.Context) error {
 		return errors.New("no collector configuration")
 	}
 
-	pscfg := cfg.GetTransport().GetPubsub()
-	if pscfg == nil {
-		return errors.New("missing Pub/Sub configuration")
+	tr, err := a.newTransport(c, ccfg)
+	if err != nil {
+		return err
+	}
+
+	st, err := a.IntermediateStorage(c)
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+
+	// Initialize our Collector service object using a caching Coordinator
+	// interface.
+	coord := coordinator.NewCoordinator(a.Coordinator())
+	coord = coordinator.NewCache(coord, int(ccfg.StateCacheSize), ccfg.StateCacheExpiration.Duration())
+
+	coll := collector.Collector{
+		Coordinator:       coord,
+		Storage:           st,
+		MaxMessageWorkers: int(ccfg.MaxMessageWorkers),
+	}
+	defer coll.Close()
+
+	// Application shutdown will now operate by stopping the transport.
+	a.SetShutdownFunc(tr.Stop)
+
+	// Execute our main transport loop. It will run until the transport is
+	// stopped or the supplied Context is cancelled.
+	if err := tr.Run(c, func(c context.Context, msg *transport.Message) error {
+		return a.processMessage(c, &coll, msg)
+	}); err != nil {
+		log.WithError(err).Errorf(c, "Transport failed.")
+		return err
 	}
 
+	log.Debugf(c, "Collector finished.")
+	return nil
+}
+
+// newTransport returns the ingestion Transport described by the service
+// configuration.
+func (a *application) newTransport(c context.Context, ccfg *svcconfig.Collector) (transport.Transport, error) {
+	tcfg := a.Config().GetTransport()
+	switch {
+	case tcfg.GetPubsub() != nil:
+		return a.pubsubTransport(c, ccfg, tcfg.GetPubsub())
+
+	case tcfg.GetHttp() != nil:
+		return a.httpTransport(c, ccfg, tcfg.GetHttp())
+
+	default:
+		return nil, errors.New("missing transport configuration")
+	}
+}
+
+// pubsubTransport returns a Transport that pulls from the configured Pub/Sub
+// subscription.
+func (a *application) pubsubTransport(c context.Context, ccfg *svcconfig.Collector, pscfg *svcconfig.Transport_PubSub) (
+	transport.Transport, error) {
+
 	// Our Subscription must be a valid one.
 	sub := gcps.NewSubscription(pscfg.Project, pscfg.Subscription)
 	if err := sub.Validate(); err != nil {
-		return fmt.Errorf("invalid Pub/Sub subscription %q: %v", sub, err)
+		return nil, fmt.Errorf("invalid Pub/Sub subscription %q: %v", sub, err)
 	}
 
 	// New PubSub instance with the authenticated client.
@@ -81,7 +133,7 @@ func (a *application) runCollector(c context.Context) error {
 	})
 	if err != nil {
 		log.WithError(err).Errorf(c, "Failed to create Pub/Sub token source.")
-		return err
+		return nil, err
 	}
 
 	psClient, err := pubsub.NewClient(c, pscfg.Project, cloud.WithTokenSource(psAuth.TokenSource()))
@@ -90,7 +142,7 @@ func (a *application) runCollector(c context.Context) error {
 			log.ErrorKey:   err,
 			"subscription": sub,
 		}.Errorf(c, "Failed to create Pub/Sub client.")
-		return err
+		return nil, err
 	}
 
 	psSub := psClient.Subscription(pscfg.Subscription)
@@ -100,85 +152,58 @@ func (a *application) runCollector(c context.Context) error {
 			log.ErrorKey:   err,
 			"subscription": sub,
 		}.Errorf(c, "Could not confirm Pub/Sub subscription.")
-		return errInvalidConfig
+		return nil, errInvalidConfig
 	}
 	if !exists {
 		log.Fields{
 			"subscription": sub,
 		}.Errorf(c, "Subscription does not exist.")
-		return errInvalidConfig
+		return nil, errInvalidConfig
 	}
 	log.Fields{
 		"subscription": sub,
 	}.Infof(c, "Successfully validated Pub/Sub subscription.")
 
-	st, err := a.IntermediateStorage(c)
-	if err != nil {
-		return err
-	}
-	defer st.Close()
-
-	// Initialize our Collector service object using a caching Coordinator
-	// interface.
-	coord := coordinator.NewCoordinator(a.Coordinator())
-	coord = coordinator.NewCache(coord, int(ccfg.StateCacheSize), ccfg.StateCacheExpiration.Duration())
+	return &transport.PubSub{
+		Subscription:          psSub,
+		MaxConcurrentMessages: int(ccfg.MaxConcurrentMessages),
+	}, nil
+}
 
-	coll := collector.Collector{
-		Coordinator:       coord,
-		Storage:           st,
-		MaxMessageWorkers: int(ccfg.MaxMessageWorkers),
-	}
-	defer coll.Close()
+// httpTransport returns a Transport that accepts log bundles POSTed directly
+// to the Collector.
+func (a *application) httpTransport(c context.Context, ccfg *svcconfig.Collector, hcfg *svcconfig.Transport_HTTP) (
+	transport.Transport, error) {
 
-	// Execute our main subscription pull loop. It will run until the supplied
-	// Context is cancelled.
-	psIterator, err := psSub.Pull(c)
+	u, err := url.Parse(hcfg.Url)
 	if err != nil {
-		log.WithError(err).Errorf(c, "Failed to create Pub/Sub iterator.")
-		return err
+		log.Fields{
+			log.ErrorKey: err,
+			"url":        hcfg.Url,
+		}.Errorf(c, "Invalid HTTP transport URL.")
+		return nil, errInvalidConfig
+	}
+	if hcfg.Listen == "" {
+		log.Errorf(c, "HTTP transport does not specify a listen address.")
+		return nil, errInvalidConfig
 	}
-	defer psIterator.Stop()
-
-	// Application shutdown will now operate by cancelling the Collector's
-	// shutdown Context.
-	a.SetShutdownFunc(psIterator.Stop)
-
-	parallel.Ignore(parallel.Run(int(ccfg.MaxConcurrentMessages), func(taskC chan<- func() error) {
-		// Loop until shut down.
-		for {
-			msg, err := psIterator.Next()
-			switch err {
-			case nil:
-				taskC <- func() error {
-					c := log.SetField(c, "messageID", msg.ID)
-					msg.Done(a.processMessage(c, &coll, msg))
-					return nil
-				}
-
-			case pubsub.Done, context.Canceled, context.DeadlineExceeded:
-				return
-
-			default:
-				log.Fields{
-					log.ErrorKey: err,
-					"delay":      pubsubPullErrorDelay,
-				}.Errorf(c, "Failed to fetch Pub/Sub message, retry after delay...")
-				clock.Sleep(c, pubsubPullErrorDelay)
-			}
-		}
-	}))
 
-	log.Debugf(c, "Collector finished.")
-	return nil
+	return &transport.HTTP{
+		Listen:                hcfg.Listen,
+		Path:                  u.Path,
+		MaxConcurrentMessages: int(ccfg.MaxConcurrentMessages),
+	}, nil
 }
 
-// processMessage returns true if the message should be ACK'd (deleted from
-// Pub/Sub) or false if the message should not be ACK'd.
-func (a *application) processMessage(c context.Context, coll *collector.Collector, msg *pubsub.Message) bool {
+// processMessage processes a single transport message.
+//
+// The returned error is the Collector's processing error. The transport uses
+// it to determine whether the message should be consumed (nil or
+// non-transient) or redelivered (transient).
+func (a *application) processMessage(c context.Context, coll *collector.Collector, msg *transport.Message) error {
 	log.Fields{
-		"ackID": msg.AckID,
-		"size":  len(msg.Data),
-	}.Infof(c, "Received Pub/Sub Message.")
+		"size": len(msg.Data),
+	}.Infof(c, "Received transport message.")
 
 	startTime := clock.Now(c)
 	err := coll.Process(c, msg.Data)
@@ -193,29 +218,25 @@ func (a *application) processMessage(c context.Context, coll *collector.Collecto
 		log.Fields{
 			log.ErrorKey: err,
 			"duration":   duration,
-		}.Warningf(c, "TRANSIENT error ingesting Pub/Sub message.")
-		tsPubsubCount.Add(c, 1, "transient_failure")
-		return false
+		}.Warningf(c, "TRANSIENT error ingesting message.")
+		tsMessageCount.Add(c, 1, "transient_failure")
 
 	case err == nil:
 		log.Fields{
-			"ackID":    msg.AckID,
 			"size":     len(msg.Data),
 			"duration": duration,
-		}.Infof(c, "Message successfully processed; ACKing.")
-		tsPubsubCount.Add(c, 1, "success")
-		return true
+		}.Infof(c, "Message successfully processed.")
+		tsMessageCount.Add(c, 1, "success")
 
 	default:
 		log.Fields{
 			log.ErrorKey: err,
-			"ackID":      msg.AckID,
 			"size":       len(msg.Data),
 			"duration":   duration,
-		}.Errorf(c, "Non-transient error ingesting Pub/Sub message; ACKing.")
-		tsPubsubCount.Add(c, 1, "failure")
-		return true
+		}.Errorf(c, "Non-transient error ingesting message; consuming.")
+		tsMessageCount.Add(c, 1, "failure")
 	}
+	return err
 }
 
 // Entry point.
@@ -135,6 +135,10 @@ type Settings struct {
 	// IndexByteRange is the maximum number of stream data bytes in between index
 	// entries. See archive.Manifest for more information.
 	IndexByteRange int
+	// CompressBlockSize, if >0, is the approximate number of uncompressed stream
+	// bytes in each compressed archive block. See archive.Manifest for more
+	// information.
+	CompressBlockSize int
 }
 
 // SettingsLoader returns archival Settings for a given project.
@@ -602,14 +606,15 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	}
 
 	m := archive.Manifest{
-		Desc:             &sa.desc,
-		Source:           &ss,
-		LogWriter:        streamWriter,
-		IndexWriter:      indexWriter,
-		DataWriter:       dataWriter,
-		StreamIndexRange: sa.IndexStreamRange,
-		PrefixIndexRange: sa.IndexPrefixRange,
-		ByteRange:        sa.IndexByteRange,
+		Desc:              &sa.desc,
+		Source:            &ss,
+		LogWriter:         streamWriter,
+		IndexWriter:       indexWriter,
+		DataWriter:        dataWriter,
+		StreamIndexRange:  sa.IndexStreamRange,
+		PrefixIndexRange:  sa.IndexPrefixRange,
+		ByteRange:         sa.IndexByteRange,
+		CompressBlockSize: sa.CompressBlockSize,
 
 		Logger: log.Get(c),
 	}
@@ -0,0 +1,12 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package transport defines the ingestion transports that feed Butler log
+// bundles into a LogDog Collector.
+//
+// A Transport receives butlerproto-formatted log bundle messages and passes
+// each one to a Handler, typically collector.Collector's Process method. The
+// Transport is responsible for translating the Handler's result into the
+// appropriate acknowledgement for its medium (e.g., Pub/Sub ACK, HTTP status).
+package transport
@@ -0,0 +1,185 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/luci/luci-go/common/errors"
+	gcps "github.com/luci/luci-go/common/gcloud/pubsub"
+	log "github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// HTTP is a Transport that accepts butlerproto-formatted log bundles POSTed
+// directly by Butler instances.
+//
+// The request's response code communicates the Handler's result:
+//   - 200 (OK) if the message was processed successfully.
+//   - 503 (Service Unavailable) if the message encountered a transient error
+//     and should be resent.
+//   - 400 (Bad Request) if the message encountered a non-transient error.
+//     Resending it will not help.
+type HTTP struct {
+	// Listen is the local address to listen on (e.g., ":8080").
+	Listen string
+
+	// Path is the URL path to serve ingestion requests on. If empty, "/" will be
+	// used.
+	Path string
+
+	// MaxConcurrentMessages is the maximum number of messages to process
+	// concurrently. If <= 0, there will be no limit.
+	MaxConcurrentMessages int
+
+	// MaxMessageSize is the maximum accepted message size, in bytes. If <= 0,
+	// the Pub/Sub maximum publish size will be used, so that both transports
+	// accept the same bundles.
+	MaxMessageSize int64
+
+	mu       sync.Mutex
+	listener net.Listener
+	stopped  bool
+}
+
+var _ Transport = (*HTTP)(nil)
+
+// Run implements Transport.
+func (t *HTTP) Run(c context.Context, h Handler) error {
+	l, err := net.Listen("tcp", t.Listen)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"listen":     t.Listen,
+		}.Errorf(c, "Failed to listen for HTTP ingestion requests.")
+		return err
+	}
+
+	t.mu.Lock()
+	stopped := t.stopped
+	t.listener = l
+	t.mu.Unlock()
+	if stopped {
+		l.Close()
+		return nil
+	}
+
+	// Stop when our Context is cancelled.
+	finishedC := make(chan struct{})
+	defer close(finishedC)
+	go func() {
+		select {
+		case <-c.Done():
+			t.Stop()
+		case <-finishedC:
+		}
+	}()
+
+	log.Fields{
+		"addr": l.Addr(),
+		"path": t.path(),
+	}.Infof(c, "Serving HTTP ingestion requests.")
+
+	mux := http.NewServeMux()
+	mux.Handle(t.path(), t.handler(c, h))
+	err = (&http.Server{Handler: mux}).Serve(l)
+
+	t.mu.Lock()
+	stopped = t.stopped
+	t.mu.Unlock()
+	if stopped {
+		// Serve will return an error when its listener is closed.
+		return nil
+	}
+	return err
+}
+
+// Stop implements Transport.
+func (t *HTTP) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.stopped && t.listener != nil {
+		t.listener.Close()
+	}
+	t.stopped = true
+}
+
+func (t *HTTP) path() string {
+	if t.Path == "" {
+		return "/"
+	}
+	return t.Path
+}
+
+// handler returns an http.Handler that dispatches ingestion requests to h.
+func (t *HTTP) handler(c context.Context, h Handler) http.Handler {
+	maxSize := t.MaxMessageSize
+	if maxSize <= 0 {
+		maxSize = gcps.MaxPublishSize
+	}
+
+	var sem chan struct{}
+	if t.MaxConcurrentMessages > 0 {
+		sem = make(chan struct{}, t.MaxConcurrentMessages)
+	}
+	var nextID int64
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" {
+			rw.Header().Set("Allow", "POST")
+			http.Error(rw, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		id := fmt.Sprintf("%d", atomic.AddInt64(&nextID, 1))
+		c := log.SetFields(c, log.Fields{
+			"messageID":  id,
+			"remoteAddr": req.RemoteAddr,
+		})
+
+		// Read the message body, enforcing our maximum size. We read one byte past
+		// the limit so we can tell if it has been exceeded.
+		data, err := ioutil.ReadAll(io.LimitReader(req.Body, maxSize+1))
+		if err != nil {
+			log.WithError(err).Warningf(c, "Failed to read ingestion request body.")
+			http.Error(rw, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		if int64(len(data)) > maxSize {
+			log.Fields{
+				"maxSize": maxSize,
+			}.Warningf(c, "Ingestion request exceeds maximum message size.")
+			http.Error(rw, "message exceeds maximum size", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if sem != nil {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+		}
+
+		err = h(c, &Message{
+			ID:   id,
+			Data: data,
+		})
+		switch {
+		case err == nil:
+			rw.WriteHeader(http.StatusOK)
+
+		case errors.IsTransient(err):
+			http.Error(rw, "transient error processing message", http.StatusServiceUnavailable)
+
+		default:
+			http.Error(rw, "failed to process message", http.StatusBadRequest)
+		}
+	})
+}
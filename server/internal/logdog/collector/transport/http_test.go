@@ -0,0 +1,76 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lerr "github.com/luci/luci-go/common/errors"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An HTTP transport handler`, t, func() {
+		c := context.Background()
+		tr := HTTP{
+			MaxMessageSize: 16,
+		}
+
+		var (
+			msgs   []*Message
+			result error
+		)
+		h := tr.handler(c, func(c context.Context, msg *Message) error {
+			msgs = append(msgs, msg)
+			return result
+		})
+
+		post := func(method string, data []byte) int {
+			req, err := http.NewRequest(method, "/", bytes.NewReader(data))
+			So(err, ShouldBeNil)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		Convey(`Will dispatch a POSTed message.`, func() {
+			So(post("POST", []byte("hello")), ShouldEqual, http.StatusOK)
+			So(len(msgs), ShouldEqual, 1)
+			So(msgs[0].Data, ShouldResemble, []byte("hello"))
+			So(msgs[0].ID, ShouldNotEqual, "")
+		})
+
+		Convey(`Will return 503 for a transient error.`, func() {
+			result = lerr.WrapTransient(errors.New("test error"))
+			So(post("POST", []byte("hello")), ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		Convey(`Will return 400 for a non-transient error.`, func() {
+			result = errors.New("test error")
+			So(post("POST", []byte("hello")), ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey(`Will reject non-POST requests.`, func() {
+			So(post("GET", nil), ShouldEqual, http.StatusMethodNotAllowed)
+			So(msgs, ShouldBeNil)
+		})
+
+		Convey(`Will reject messages that exceed the maximum size.`, func() {
+			So(post("POST", bytes.Repeat([]byte{0x55}, 17)), ShouldEqual, http.StatusRequestEntityTooLarge)
+			So(msgs, ShouldBeNil)
+
+			So(post("POST", bytes.Repeat([]byte{0x55}, 16)), ShouldEqual, http.StatusOK)
+		})
+	})
+}
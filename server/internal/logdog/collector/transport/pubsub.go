@@ -0,0 +1,103 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/parallel"
+	"golang.org/x/net/context"
+	"google.golang.org/cloud/pubsub"
+)
+
+const (
+	pubsubPullErrorDelay = 10 * time.Second
+)
+
+// PubSub is a Transport that pulls messages from a Pub/Sub subscription.
+//
+// A message is ACK'd unless its Handler returns a transient error, in which
+// case it will be left for Pub/Sub to redeliver.
+type PubSub struct {
+	// Subscription is the Pub/Sub subscription to pull from.
+	Subscription *pubsub.Subscription
+
+	// MaxConcurrentMessages is the maximum number of messages to process
+	// concurrently. If <= 0, there will be no limit.
+	MaxConcurrentMessages int
+
+	mu      sync.Mutex
+	it      *pubsub.Iterator
+	stopped bool
+}
+
+var _ Transport = (*PubSub)(nil)
+
+// Run implements Transport.
+func (t *PubSub) Run(c context.Context, h Handler) error {
+	it, err := t.Subscription.Pull(c)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to create Pub/Sub iterator.")
+		return err
+	}
+	defer it.Stop()
+
+	t.mu.Lock()
+	stopped := t.stopped
+	t.it = it
+	t.mu.Unlock()
+	if stopped {
+		return nil
+	}
+
+	parallel.Ignore(parallel.Run(t.MaxConcurrentMessages, func(taskC chan<- func() error) {
+		// Loop until shut down.
+		for {
+			msg, err := it.Next()
+			switch err {
+			case nil:
+				taskC <- func() error {
+					c := log.SetField(c, "messageID", msg.ID)
+					log.Fields{
+						"ackID": msg.AckID,
+					}.Debugf(c, "Received Pub/Sub message.")
+
+					err := h(c, &Message{
+						ID:   msg.ID,
+						Data: msg.Data,
+					})
+					msg.Done(!errors.IsTransient(err))
+					return nil
+				}
+
+			case pubsub.Done, context.Canceled, context.DeadlineExceeded:
+				return
+
+			default:
+				log.Fields{
+					log.ErrorKey: err,
+					"delay":      pubsubPullErrorDelay,
+				}.Errorf(c, "Failed to fetch Pub/Sub message, retry after delay...")
+				clock.Sleep(c, pubsubPullErrorDelay)
+			}
+		}
+	}))
+	return nil
+}
+
+// Stop implements Transport.
+func (t *PubSub) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stopped = true
+	if t.it != nil {
+		t.it.Stop()
+	}
+}
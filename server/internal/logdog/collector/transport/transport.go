@@ -0,0 +1,42 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"golang.org/x/net/context"
+)
+
+// Message is a single butlerproto-formatted log bundle message received by a
+// Transport.
+type Message struct {
+	// ID is a transport-specific identifier for this message. It is used for
+	// logging only.
+	ID string
+
+	// Data is the message's butlerproto data.
+	Data []byte
+}
+
+// Handler processes a single Message.
+//
+// If the Handler returns a transient error, the Transport should make the
+// Message available for redelivery. Otherwise, the Message is considered
+// consumed.
+type Handler func(context.Context, *Message) error
+
+// Transport is an ingestion transport that delivers log bundle messages to a
+// Handler.
+type Transport interface {
+	// Run receives messages and dispatches them to the supplied Handler. It
+	// blocks until the Transport is stopped or the supplied Context is
+	// cancelled.
+	//
+	// Handler may be invoked concurrently.
+	Run(context.Context, Handler) error
+
+	// Stop stops a running Transport. It is safe to call Stop from another
+	// goroutine while Run is executing.
+	Stop()
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/luci/luci-go/server/internal/logdog/service/config"
 	"github.com/luci/luci-go/server/logdog/storage"
 	"github.com/luci/luci-go/server/logdog/storage/bigtable"
+	"github.com/luci/luci-go/server/logdog/storage/local"
 	"golang.org/x/net/context"
 	"google.golang.org/cloud"
 	"google.golang.org/cloud/compute/metadata"
@@ -52,9 +53,15 @@ var (
 		"Set to one when service is present and ready. Alert on missing values.")
 )
 
-// projectConfigCacheDuration is the amount of time to cache a project's
-// configuration before reloading.
-const projectConfigCacheDuration = 30 * time.Minute
+const (
+	// projectConfigCacheDuration is the amount of time to cache a project's
+	// configuration before reloading.
+	projectConfigCacheDuration = 30 * time.Minute
+
+	// localStorageMaintenanceInterval is how often local storage purges expired
+	// log entries and compacts its store file.
+	localStorageMaintenanceInterval = 30 * time.Minute
+)
 
 // Service is a base class full of common LogDog service application parameters.
 type Service struct {
@@ -65,6 +72,10 @@ type Service struct {
 	Name string
 	// Flags is the set of flags that will be used by the Service.
 	Flags flag.FlagSet
+	// ReadOnlyStorage, if true, means that the service only reads intermediate
+	// storage. Local storage is then opened without taking ownership of it, so
+	// it can be shared with the service that writes it (the collector).
+	ReadOnlyStorage bool
 
 	shutdownFunc atomic.Value
 
@@ -381,12 +392,20 @@ func (s *Service) IntermediateStorage(c context.Context) (storage.Storage, error
 		return nil, ErrInvalidConfig
 	}
 
-	btcfg := cfg.GetStorage().GetBigtable()
-	if btcfg == nil {
-		log.Errorf(c, "Missing BigTable storage configuration")
+	switch st := cfg.GetStorage(); {
+	case st.GetBigtable() != nil:
+		return s.bigTableStorage(c, st.GetBigtable())
+
+	case st.GetLocal() != nil:
+		return s.localStorage(c, st.GetLocal(), st.GetMaxLogAge().Duration())
+
+	default:
+		log.Errorf(c, "Missing BigTable or local storage configuration")
 		return nil, ErrInvalidConfig
 	}
+}
 
+func (s *Service) bigTableStorage(c context.Context, btcfg *svcconfig.Storage_BigTable) (storage.Storage, error) {
 	// Initialize Storage authentication.
 	a, err := s.Authenticator(c, func(o *auth.Options) {
 		o.Scopes = bigtable.StorageScopes
@@ -414,6 +433,40 @@ func (s *Service) IntermediateStorage(c context.Context) (storage.Storage, error
 	return bt, nil
 }
 
+func (s *Service) localStorage(c context.Context, lcfg *svcconfig.Storage_Local, maxLogAge time.Duration) (storage.Storage, error) {
+	if lcfg.Path == "" {
+		log.Errorf(c, "Missing local storage path.")
+		return nil, ErrInvalidConfig
+	}
+
+	// The store file can only be owned by a single process (see local.New). The
+	// owner (the collector) also purges and compacts it, readers just read it.
+	opts := local.Options{
+		Path:     lcfg.Path,
+		ReadOnly: s.ReadOnlyStorage,
+	}
+	if !opts.ReadOnly {
+		opts.MaintenanceInterval = localStorageMaintenanceInterval
+	}
+	st, err := local.New(c, opts)
+	if err != nil {
+		if err == local.ErrLocked {
+			log.Fields{
+				"path": lcfg.Path,
+			}.Errorf(c, "Local storage is already owned by another process.")
+		}
+		return nil, err
+	}
+
+	// Unlike BigTable, whose garbage collection policy is installed when the
+	// table is initialized, local storage enforces its maximum log age itself.
+	if err := st.Config(storage.Config{MaxLogAge: maxLogAge}); err != nil {
+		st.Close()
+		return nil, err
+	}
+	return st, nil
+}
+
 // GSClient returns an authenticated Google Storage client instance.
 func (s *Service) GSClient(c context.Context) (gs.Client, error) {
 	rt, err := s.AuthenticatedTransport(c, func(o *auth.Options) {
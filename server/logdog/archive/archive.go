@@ -47,6 +47,14 @@ type Manifest struct {
 	// successive index entries.
 	ByteRange int
 
+	// CompressBlockSize, if >0, causes the log stream record stream to be
+	// compressed as a series of independently-decodable zlib blocks, each
+	// holding approximately this many bytes of uncompressed record data.
+	//
+	// Index entry offsets will refer to the uncompressed record stream, and the
+	// index will record the location of each block.
+	CompressBlockSize int
+
 	// Logger, if not nil, will be used to log status during archival.
 	Logger logging.Logger
 
@@ -92,7 +100,7 @@ func Archive(m Manifest) error {
 			logC = make(chan *logpb.LogEntry)
 
 			taskC <- func() error {
-				if err := archiveLogs(m.LogWriter, m.Desc, logC, idx); err != nil {
+				if err := archiveLogs(m.LogWriter, m.Desc, logC, idx, m.CompressBlockSize); err != nil {
 					return err
 				}
 
@@ -154,7 +162,19 @@ func Archive(m Manifest) error {
 	return err
 }
 
-func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder) error {
+func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder,
+	blockSize int) error {
+
+	// If we're compressing, route our frames through a block writer.
+	var bw *blockWriter
+	if blockSize > 0 {
+		bw = &blockWriter{
+			Writer:    w,
+			blockSize: blockSize,
+		}
+		w = bw
+	}
+
 	offset := int64(0)
 	out := func(pb proto.Message) error {
 		d, err := proto.Marshal(pb)
@@ -164,7 +184,14 @@ func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.L
 
 		count, err := recordio.WriteFrame(w, d)
 		offset += int64(count)
-		return err
+		if err != nil {
+			return err
+		}
+
+		if bw != nil {
+			return bw.endFrame()
+		}
+		return nil
 	}
 
 	// Start with our descriptor protobuf. Defer error handling until later, as
@@ -181,5 +208,13 @@ func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.L
 		}
 		err = out(le)
 	}
+
+	if bw != nil && err == nil {
+		// Emit our final block and record our blocks in the index.
+		err = bw.flush()
+		if idx != nil {
+			idx.index.Blocks = bw.blocks
+		}
+	}
 	return err
 }
@@ -6,6 +6,7 @@ package archive
 
 import (
 	"bytes"
+	"compress/zlib"
 	"fmt"
 	"io"
 	"strconv"
@@ -207,6 +208,36 @@ func (ic *indexChecker) shouldContainIndexFor(actual interface{}, expected ...in
 	return ""
 }
 
+// decompressBlocks reconstructs the uncompressed log stream from a compressed
+// log stream and its index's block list.
+func decompressBlocks(indexB, logB *bytes.Buffer) (*bytes.Buffer, []*logpb.LogIndex_Block, error) {
+	index := logpb.LogIndex{}
+	if err := proto.Unmarshal(indexB.Bytes(), &index); err != nil {
+		return nil, nil, err
+	}
+
+	var rawB bytes.Buffer
+	data := logB.Bytes()
+	for i, b := range index.Blocks {
+		if b.RawOffset != uint64(rawB.Len()) {
+			return nil, nil, fmt.Errorf("block %d has incorrect raw offset (%d != %d)", i, b.RawOffset, rawB.Len())
+		}
+
+		zr, err := zlib.NewReader(bytes.NewReader(data[b.Offset : b.Offset+b.Size]))
+		if err != nil {
+			return nil, nil, err
+		}
+		amt, err := rawB.ReadFrom(zr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if uint64(amt) != b.RawSize {
+			return nil, nil, fmt.Errorf("block %d has incorrect raw size (%d != %d)", i, b.RawSize, amt)
+		}
+	}
+	return &rawB, index.Blocks, nil
+}
+
 func TestArchive(t *testing.T) {
 	Convey(`A Manifest connected to Buffer Writers`, t, func() {
 		var logB, indexB, dataB bytes.Buffer
@@ -321,6 +352,23 @@ func TestArchive(t *testing.T) {
 			})
 		})
 
+		Convey(`When compressing the log stream`, func() {
+			ts.add(0, 1, 2, 3, 4, 5, 6)
+			m.CompressBlockSize = 16
+
+			So(Archive(m), ShouldBeNil)
+
+			rawB, blocks, err := decompressBlocks(&indexB, &logB)
+			So(err, ShouldBeNil)
+			So(len(blocks), ShouldBeGreaterThan, 1)
+
+			last := blocks[len(blocks)-1]
+			So(last.Offset+last.Size, ShouldEqual, logB.Len())
+
+			So(&indexB, ic.shouldContainIndexFor, desc, rawB)
+			So(dataB.String(), ShouldEqual, "0\n1\n2\n3\n4\n5\n6\n")
+		})
+
 		Convey(`When building sparse index`, func() {
 			ts.add(0, 1, 2, 3, 4, 5)
 
@@ -0,0 +1,90 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+)
+
+// blockWriter is an io.Writer that compresses the data written to it as a
+// series of independently-decodable zlib blocks.
+//
+// Data is buffered until endFrame is called with at least blockSize bytes
+// buffered, at which point the buffered data is compressed and emitted as a
+// single block. This ensures that each block contains only complete frames.
+type blockWriter struct {
+	io.Writer
+
+	// blockSize is the target uncompressed size of each block.
+	blockSize int
+
+	// blocks is the set of blocks that have been emitted.
+	blocks []*logpb.LogIndex_Block
+
+	// buf holds the uncompressed data for the current block.
+	buf bytes.Buffer
+	// zbuf holds the compressed data for the current block.
+	zbuf bytes.Buffer
+	zw   *zlib.Writer
+
+	// offset is the number of compressed bytes that have been emitted.
+	offset uint64
+	// rawOffset is the number of uncompressed bytes that have been emitted.
+	rawOffset uint64
+}
+
+func (w *blockWriter) Write(d []byte) (int, error) {
+	return w.buf.Write(d)
+}
+
+// endFrame is called after each complete frame is written. If enough data is
+// buffered, it will be emitted as a block.
+func (w *blockWriter) endFrame() error {
+	if w.buf.Len() < w.blockSize {
+		return nil
+	}
+	return w.flush()
+}
+
+// flush emits any buffered data as a block.
+func (w *blockWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	rawSize := uint64(w.buf.Len())
+
+	w.zbuf.Reset()
+	if w.zw == nil {
+		w.zw = zlib.NewWriter(&w.zbuf)
+	} else {
+		w.zw.Reset(&w.zbuf)
+	}
+	if _, err := w.buf.WriteTo(w.zw); err != nil {
+		return err
+	}
+	if err := w.zw.Close(); err != nil {
+		return err
+	}
+
+	block := logpb.LogIndex_Block{
+		Offset:    w.offset,
+		Size:      uint64(w.zbuf.Len()),
+		RawOffset: w.rawOffset,
+		RawSize:   rawSize,
+	}
+	if _, err := w.zbuf.WriteTo(w.Writer); err != nil {
+		return err
+	}
+
+	w.blocks = append(w.blocks, &block)
+	w.offset += block.Size
+	w.rawOffset += block.RawSize
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+)
+
+// blockReader is an io.Reader that decompresses a contiguous series of
+// independently-compressed blocks from an underlying compressed Reader.
+type blockReader struct {
+	// r is the underlying compressed Reader. It is positioned at the beginning
+	// of the first block in blocks.
+	r io.ReadCloser
+	// blocks is the set of remaining blocks to read.
+	blocks []*logpb.LogIndex_Block
+
+	// cur is the decompressing Reader for the current block.
+	cur io.ReadCloser
+}
+
+func (br *blockReader) Read(d []byte) (int, error) {
+	for {
+		if br.cur == nil {
+			if len(br.blocks) == 0 {
+				return 0, io.EOF
+			}
+
+			// Bound our decompressor to the current block, so that it doesn't read
+			// ahead into the next one.
+			b := br.blocks[0]
+			br.blocks = br.blocks[1:]
+
+			zr, err := zlib.NewReader(io.LimitReader(br.r, int64(b.Size)))
+			if err != nil {
+				return 0, err
+			}
+			br.cur = zr
+		}
+
+		amt, err := br.cur.Read(d)
+		if err == io.EOF {
+			// Finished this block; advance to the next one.
+			if err := br.cur.Close(); err != nil {
+				return amt, err
+			}
+			br.cur = nil
+			err = nil
+
+			if amt == 0 {
+				continue
+			}
+		}
+		return amt, err
+	}
+}
+
+func (br *blockReader) Close() error {
+	if br.cur != nil {
+		br.cur.Close()
+		br.cur = nil
+	}
+	return br.r.Close()
+}
+
+// limitedReadCloser is an io.ReadCloser that reads from a bounded Reader and
+// closes a separate Closer.
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// openStream returns a Reader for the log stream's uncompressed record data,
+// beginning at the uncompressed byte "offset" and spanning "length" bytes. If
+// "length" is <0, the Reader will continue to the end of the log stream.
+//
+// If the log stream is uncompressed, this reads directly from the archived
+// stream. Otherwise, only the blocks that hold the requested range will be
+// fetched and decompressed.
+func (s *storageImpl) openStream(idx *logpb.LogIndex, offset uint64, length int64) (io.ReadCloser, error) {
+	blocks := idx.Blocks
+	if len(blocks) == 0 {
+		return s.Client.NewReader(s.streamPath, int64(offset), length)
+	}
+
+	// Identify the first block that holds data at or after "offset".
+	first := sort.Search(len(blocks), func(i int) bool {
+		return blocks[i].RawOffset+blocks[i].RawSize > offset
+	})
+	if first == len(blocks) {
+		// The offset is past the end of the stream.
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	// Identify the last block that holds data before our end offset.
+	last := len(blocks) - 1
+	if length >= 0 {
+		end := offset + uint64(length)
+		last = sort.Search(len(blocks), func(i int) bool {
+			return blocks[i].RawOffset >= end
+		}) - 1
+		if last < first {
+			last = first
+		}
+	}
+	blocks = blocks[first : last+1]
+
+	start := blocks[0].Offset
+	end := blocks[len(blocks)-1].Offset + blocks[len(blocks)-1].Size
+	r, err := s.Client.NewReader(s.streamPath, int64(start), int64(end-start))
+	if err != nil {
+		return nil, err
+	}
+
+	br := &blockReader{
+		r:      r,
+		blocks: blocks,
+	}
+
+	// Skip to our requested offset within the first block.
+	if skip := int64(offset - blocks[0].RawOffset); skip > 0 {
+		if _, err := io.CopyN(ioutil.Discard, br, skip); err != nil {
+			br.Close()
+			return nil, err
+		}
+	}
+
+	rc := limitedReadCloser{
+		Reader: br,
+		Closer: br,
+	}
+	if length >= 0 {
+		rc.Reader = io.LimitReader(br, length)
+	}
+	return &rc, nil
+}
@@ -126,7 +126,7 @@ func (s *storageImpl) Get(req storage.GetRequest, cb storage.GetCallback) error
 		"length": st.length(),
 		"path":   s.streamPath,
 	}.Debugf(s, "Creating stream reader for range.")
-	r, err := s.Client.NewReader(s.streamPath, offset, st.length())
+	r, err := s.openStream(idx, st.startOffset, st.length())
 	if err != nil {
 		log.WithError(err).Errorf(s, "Failed to create stream Reader.")
 		return err
@@ -218,7 +218,7 @@ func (s *storageImpl) Tail(project config.ProjectName, path types.StreamPath) ([
 	lle := idx.Entries[len(idx.Entries)-1]
 
 	// Get a Reader for the Tail entry.
-	r, err := s.Client.NewReader(s.streamPath, int64(lle.Offset), -1)
+	r, err := s.openStream(idx, lle.Offset, -1)
 	if err != nil {
 		log.Fields{
 			log.ErrorKey: err,
@@ -0,0 +1,243 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/server/logdog/archive"
+	"github.com/luci/luci-go/server/logdog/storage"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	testIndexURL  = "gs://testbucket/index"
+	testStreamURL = "gs://testbucket/stream"
+)
+
+// readRange is a byte range requested from the testGSClient.
+type readRange struct {
+	path           gs.Path
+	offset, length int64
+}
+
+// testGSClient is an in-memory gs.Client that records requested ranges.
+type testGSClient struct {
+	objs  map[gs.Path][]byte
+	reads []readRange
+}
+
+func (c *testGSClient) Close() error { return nil }
+
+func (c *testGSClient) NewReader(p gs.Path, offset, length int64) (io.ReadCloser, error) {
+	c.reads = append(c.reads, readRange{p, offset, length})
+
+	d, ok := c.objs[p]
+	if !ok {
+		return nil, errors.New("object does not exist")
+	}
+	if offset > int64(len(d)) {
+		offset = int64(len(d))
+	}
+	d = d[offset:]
+	if length >= 0 && length < int64(len(d)) {
+		d = d[:length]
+	}
+	return ioutil.NopCloser(bytes.NewReader(d)), nil
+}
+
+func (c *testGSClient) NewWriter(p gs.Path) (gs.Writer, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *testGSClient) Delete(p gs.Path) error {
+	return errors.New("not implemented")
+}
+
+func (c *testGSClient) Rename(src, dst gs.Path) error {
+	return errors.New("not implemented")
+}
+
+// testSource is an archive.LogEntrySource with log entries {0..count-1}.
+type testSource struct {
+	next, count int
+}
+
+func (s *testSource) NextLogEntry() (*logpb.LogEntry, error) {
+	if s.next >= s.count {
+		return nil, archive.ErrEndOfStream
+	}
+	i := s.next
+	s.next++
+	return &logpb.LogEntry{
+		PrefixIndex: uint64(i),
+		StreamIndex: uint64(i),
+		Content: &logpb.LogEntry_Text{
+			Text: &logpb.Text{
+				Lines: []*logpb.Text_Line{
+					{Value: fmt.Sprintf("line #%d: %s", i, strings.Repeat("x", i)), Delimiter: "\n"},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestArchiveStorage(t *testing.T) {
+	t.Parallel()
+
+	const count = 20
+
+	for _, blockSize := range []int{0, 64} {
+		Convey(fmt.Sprintf(`With an archived stream of %d entries and block size %d`, count, blockSize), t, func() {
+			var logB, indexB bytes.Buffer
+			err := archive.Archive(archive.Manifest{
+				Desc: &logpb.LogStreamDescriptor{
+					Prefix: "testing",
+					Name:   "foo",
+				},
+				Source:            &testSource{count: count},
+				LogWriter:         &logB,
+				IndexWriter:       &indexB,
+				CompressBlockSize: blockSize,
+			})
+			So(err, ShouldBeNil)
+
+			idx := logpb.LogIndex{}
+			So(proto.Unmarshal(indexB.Bytes(), &idx), ShouldBeNil)
+			So(len(idx.Entries), ShouldEqual, count)
+			if blockSize > 0 {
+				So(len(idx.Blocks), ShouldBeGreaterThan, 2)
+			} else {
+				So(idx.Blocks, ShouldBeNil)
+			}
+
+			client := testGSClient{
+				objs: map[gs.Path][]byte{
+					testIndexURL:  indexB.Bytes(),
+					testStreamURL: logB.Bytes(),
+				},
+			}
+			opts := Options{
+				IndexURL:  testIndexURL,
+				StreamURL: testStreamURL,
+				Client:    &client,
+			}
+			newStorage := func() storage.Storage {
+				st, err := New(context.Background(), opts)
+				So(err, ShouldBeNil)
+				return st
+			}
+			st := newStorage()
+			defer st.Close()
+
+			indices := func(req storage.GetRequest) []types.MessageIndex {
+				var out []types.MessageIndex
+				err := st.Get(req, func(i types.MessageIndex, d []byte) bool {
+					le := logpb.LogEntry{}
+					So(proto.Unmarshal(d, &le), ShouldBeNil)
+					So(le.StreamIndex, ShouldEqual, uint64(i))
+					out = append(out, i)
+					return true
+				})
+				So(err, ShouldBeNil)
+				return out
+			}
+			seq := func(start, end int) []types.MessageIndex {
+				out := make([]types.MessageIndex, 0, end-start)
+				for i := start; i < end; i++ {
+					out = append(out, types.MessageIndex(i))
+				}
+				return out
+			}
+
+			Convey(`Can read all entries.`, func() {
+				So(indices(storage.GetRequest{}), ShouldResemble, seq(0, count))
+			})
+
+			Convey(`Can read entries spanning block boundaries.`, func() {
+				if blockSize > 0 {
+					// Make sure the range really spans more than one block.
+					blockOf := func(i int) int {
+						off := idx.Entries[i].Offset
+						for bi, b := range idx.Blocks {
+							if off >= b.RawOffset && off < b.RawOffset+b.RawSize {
+								return bi
+							}
+						}
+						return -1
+					}
+					So(blockOf(7), ShouldBeLessThan, blockOf(15))
+				}
+
+				So(indices(storage.GetRequest{Index: 7, Limit: 9}), ShouldResemble, seq(7, 16))
+			})
+
+			Convey(`Will fetch only the blocks holding the requested range.`, func() {
+				So(indices(storage.GetRequest{Index: 15, Limit: 2}), ShouldResemble, seq(15, 17))
+
+				read := client.reads[len(client.reads)-1]
+				So(read.path, ShouldEqual, gs.Path(testStreamURL))
+				So(read.offset, ShouldBeGreaterThan, 0)
+				So(read.length, ShouldBeGreaterThan, 0)
+				So(read.offset+read.length, ShouldBeLessThanOrEqualTo, len(logB.Bytes()))
+			})
+
+			Convey(`Will adhere to a limit.`, func() {
+				So(indices(storage.GetRequest{Index: 3, Limit: 4}), ShouldResemble, seq(3, 7))
+			})
+
+			Convey(`Will adhere to a byte limit.`, func() {
+				// Entry #10 starts exactly at the byte limit, so it is the last one
+				// fetched.
+				opts.MaxBytes = int(idx.Entries[10].Offset - idx.Entries[5].Offset)
+				st = newStorage()
+
+				So(indices(storage.GetRequest{Index: 5}), ShouldResemble, seq(5, 11))
+			})
+
+			Convey(`Will adhere to both a limit and a byte limit.`, func() {
+				opts.MaxBytes = int(idx.Entries[10].Offset - idx.Entries[5].Offset)
+				st = newStorage()
+
+				So(indices(storage.GetRequest{Index: 5, Limit: 3}), ShouldResemble, seq(5, 8))
+			})
+
+			Convey(`Can read the last entry.`, func() {
+				So(indices(storage.GetRequest{Index: count - 1}), ShouldResemble, seq(count-1, count))
+			})
+
+			Convey(`Will return no entries for an index past the end of the stream.`, func() {
+				So(indices(storage.GetRequest{Index: count}), ShouldBeNil)
+				So(indices(storage.GetRequest{Index: count + 100, Limit: 5}), ShouldBeNil)
+			})
+
+			Convey(`Can retrieve the tail entry.`, func() {
+				d, i, err := st.Tail("", "")
+				So(err, ShouldBeNil)
+				So(i, ShouldEqual, count-1)
+
+				le := logpb.LogEntry{}
+				So(proto.Unmarshal(d, &le), ShouldBeNil)
+				So(le.StreamIndex, ShouldEqual, count-1)
+			})
+
+			Convey(`Will refuse to be modified.`, func() {
+				So(st.Put(storage.PutRequest{}), ShouldEqual, storage.ErrReadOnly)
+			})
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package local provides an implementation of the Storage interface backed by
+// an embedded on-disk key/value store (gkvlite).
+//
+// It is intended for development and small, single-machine deployments where
+// the intermediate storage must survive a restart, but where a BigTable
+// cluster is not available.
+//
+// gkvlite has no support for concurrent mutation, so the store file is owned
+// by a single Storage instance, enforced with a lock file. Other processes
+// (e.g. the archivist and the Coordinator reading logs written by the
+// collector) open it with Options.ReadOnly: gkvlite files are append-only, so
+// readers simply reload the store to see the owner's writes.
+//
+// Intermediate Log Collection
+//
+// Log entries are stored in a single collection, one item per LogEntry. Item
+// keys borrow the BigTable row key layout: logs belonging to the same stream
+// share a key prefix, so they are clustered together and suitable for
+// efficient iteration. Immediately following the prefix is the log's stream
+// index.
+//
+//   [         32 bytes         ]   [    1-9 bytes    ]
+//    SHA256(Project + "/" + Path) + cmpbin(StreamIndex)
+//
+// Unlike BigTable, gkvlite keys need not be valid UTF8, so the hash and the
+// index are stored in their raw binary form.
+//
+// Each item's value is the time that the entry was written, encoded as a
+// cmpbin integer (nanoseconds since the epoch), followed by the raw LogEntry
+// protobuf data. The write time is used to enforce the configured maximum log
+// age: expired entries are hidden from readers and removed by Purge.
+//
+// gkvlite store files are append-only. Compact rewrites the file to reclaim
+// the space used by deleted and overwritten entries. If
+// Options.MaintenanceInterval is set, both Purge and Compact are run
+// periodically in the background.
+package local
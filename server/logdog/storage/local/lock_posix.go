@@ -0,0 +1,33 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build !windows
+
+package local
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile opens (creating if necessary) the file at "path" and acquires an
+// exclusive advisory lock on it.
+//
+// The lock is released when the returned file is closed or the process exits.
+// If the lock is already held (by this or another process), ErrLocked is
+// returned.
+func lockFile(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0640)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			return nil, ErrLocked
+		}
+		return nil, err
+	}
+	return f, nil
+}
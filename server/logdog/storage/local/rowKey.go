@@ -0,0 +1,116 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	"github.com/luci/luci-go/common/cmpbin"
+)
+
+var (
+	// errMalformedKey is an error that is returned if a key or value in the
+	// store does not conform to our layout.
+	errMalformedKey = errors.New("local: malformed key")
+
+	// upperBoundSuffix is appended to a path prefix to produce a key that is
+	// larger than any key in that path's key space.
+	//
+	// A cmpbin-encoded int64 is at most cmpbin.MaxIntLen64 bytes, so a suffix of
+	// cmpbin.MaxIntLen64+1 0xFF bytes will always sort after it.
+	upperBoundSuffix = bytes.Repeat([]byte{0xFF}, cmpbin.MaxIntLen64+1)
+)
+
+// rowKey is a gkvlite item key.
+//
+// The row key is formed from a Project, Path, and Index. See the package
+// documentation for the key layout.
+type rowKey struct {
+	pathHash []byte
+	index    int64
+}
+
+// newRowKey generates the row key matching a given entry path and index.
+func newRowKey(project, path string, index int64) *rowKey {
+	h := sha256.New()
+
+	_, _ = h.Write([]byte(project))
+	_, _ = h.Write([]byte("/"))
+	_, _ = h.Write([]byte(path))
+	return &rowKey{
+		pathHash: h.Sum(nil),
+		index:    index,
+	}
+}
+
+// decodeRowKey decodes an encoded row key into its structural components.
+func decodeRowKey(v []byte) (*rowKey, error) {
+	if len(v) <= sha256.Size {
+		return nil, errMalformedKey
+	}
+
+	rk := rowKey{
+		pathHash: v[:sha256.Size],
+	}
+
+	dr := bytes.NewReader(v[sha256.Size:])
+	var err error
+	if rk.index, _, err = cmpbin.ReadInt(dr); err != nil {
+		return nil, errMalformedKey
+	}
+
+	// There should be no more data.
+	if dr.Len() > 0 {
+		return nil, errMalformedKey
+	}
+	return &rk, nil
+}
+
+// encode returns the binary key for this row.
+func (rk *rowKey) encode() []byte {
+	buf := bytes.Buffer{}
+	buf.Grow(sha256.Size + cmpbin.MaxIntLen64)
+	buf.Write(rk.pathHash)
+	cmpbin.WriteInt(&buf, rk.index)
+	return buf.Bytes()
+}
+
+// pathPrefixUpperBound returns a key that is higher than any key in this row
+// key's path space.
+func (rk *rowKey) pathPrefixUpperBound() []byte {
+	v := make([]byte, 0, len(rk.pathHash)+len(upperBoundSuffix))
+	v = append(v, rk.pathHash...)
+	return append(v, upperBoundSuffix...)
+}
+
+// sharesPathWith tests if the "path" component of the row key "rk" matches
+// the "path" component of "o".
+func (rk *rowKey) sharesPathWith(o *rowKey) bool {
+	return bytes.Equal(rk.pathHash, o.pathHash)
+}
+
+// encodeValue encodes a log entry value and its write time into an item value.
+func encodeValue(t time.Time, data []byte) []byte {
+	buf := bytes.Buffer{}
+	buf.Grow(cmpbin.MaxIntLen64 + len(data))
+	cmpbin.WriteInt(&buf, t.UnixNano())
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+// decodeValue decodes an item value into its write time and log entry data.
+//
+// The returned data slice references the supplied value.
+func decodeValue(v []byte) (time.Time, []byte, error) {
+	dr := bytes.NewReader(v)
+	ns, n, err := cmpbin.ReadInt(dr)
+	if err != nil {
+		return time.Time{}, nil, errMalformedKey
+	}
+	return time.Unix(0, ns).UTC(), v[n:], nil
+}
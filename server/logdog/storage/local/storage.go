@@ -0,0 +1,687 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/luci/gkvlite"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/logdog/types"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/logdog/storage"
+	"golang.org/x/net/context"
+)
+
+const (
+	// logCollection is the name of the gkvlite collection that holds log data.
+	logCollection = "log"
+
+	// defaultMaxGetCount is the maximum number of records that will be returned
+	// from a single Get request if Options.MaxGetCount is not set.
+	defaultMaxGetCount = 1024
+
+	// compactFlushEvery is the number of items copied between flushes when
+	// compacting the store.
+	compactFlushEvery = 10000
+)
+
+var (
+	// ErrLocked is returned by New if the store is already owned by another
+	// Storage instance, possibly in another process.
+	//
+	// gkvlite does not support concurrent mutation, so a store file must have
+	// a single owner. Other Storage instances may still read it, see
+	// Options.ReadOnly.
+	ErrLocked = errors.New("local: store is locked by another owner")
+
+	// errStop is an internal sentinel error used to indicate "stop iteration".
+	errStop = errors.New("local: stop iteration")
+)
+
+// Options is a set of configuration options for local storage.
+type Options struct {
+	// Path is the path of the on-disk store file. If it does not exist, it will
+	// be created.
+	Path string
+
+	// MaxGetCount, if not zero, is the maximum number of records to retrieve from
+	// a single Get request. If zero, a default maximum will be used.
+	MaxGetCount int
+
+	// MaintenanceInterval, if not zero, is the interval at which expired log
+	// entries are purged and the store file is compacted in the background.
+	//
+	// gkvlite files are append-only, so without compaction the store file grows
+	// without bound, even if entries are purged.
+	MaintenanceInterval time.Duration
+
+	// ReadOnly, if true, opens the store for reading only.
+	//
+	// A read-only Storage doesn't take ownership of the store, so it can be used
+	// alongside the Storage that owns it, possibly in another process (e.g. the
+	// archivist reading log entries written by the collector). It reloads the
+	// store before each operation to observe the owner's writes.
+	//
+	// Put, Purge and Compact return storage.ErrReadOnly, and
+	// MaintenanceInterval is ignored.
+	ReadOnly bool
+}
+
+// Storage is a storage.Storage implementation that uses an embedded on-disk
+// gkvlite store as a backend.
+type Storage struct {
+	*Options
+
+	// Context is the bound supplied with New. It is used for logging and to
+	// determine the current time.
+	context.Context
+
+	// mu protects the fields below, and serializes access to the underlying
+	// store, which does not support concurrent mutation.
+	mu        sync.Mutex
+	closed    bool
+	lock      *os.File
+	file      *os.File
+	store     *gkvlite.Store
+	logs      *gkvlite.Collection
+	maxLogAge time.Duration
+
+	// stopMaintenance stops the maintenance goroutine, if it is running.
+	stopMaintenance context.CancelFunc
+	maintenanceDone chan struct{}
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// New instantiates a new Storage instance backed by the store file at
+// Options.Path.
+//
+// Unless Options.ReadOnly is set, the store file is owned exclusively by the
+// returned Storage instance: a lock file ("<Path>.lock") is held until Close()
+// is called. If the store is already owned by someone else, New returns
+// ErrLocked.
+//
+// The returned Storage instance will close the store file when its Close()
+// method is called.
+func New(ctx context.Context, o Options) (*Storage, error) {
+	if o.Path == "" {
+		return nil, errors.New("a store path must be supplied")
+	}
+
+	if o.ReadOnly {
+		s := &Storage{
+			Options: &o,
+			Context: ctx,
+		}
+		if err := s.reloadLocked(); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+
+	lock, err := lockFile(o.Path + ".lock")
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       o.Path,
+		}.Errorf(ctx, "Failed to lock store file.")
+		return nil, err
+	}
+
+	s := &Storage{
+		Options: &o,
+		Context: ctx,
+		lock:    lock,
+	}
+	if err := s.openLocked(); err != nil {
+		lock.Close()
+		return nil, err
+	}
+
+	if o.MaintenanceInterval > 0 {
+		var mctx context.Context
+		mctx, s.stopMaintenance = context.WithCancel(ctx)
+		s.maintenanceDone = make(chan struct{})
+		go s.maintenanceLoop(mctx)
+	}
+	return s, nil
+}
+
+// openLocked opens the store file at Options.Path.
+//
+// s.mu must be held by the caller, or s must not be shared yet.
+func (s *Storage) openLocked() error {
+	f, err := os.OpenFile(s.Path, os.O_RDWR|os.O_CREATE, 0640)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       s.Path,
+		}.Errorf(s, "Failed to open store file.")
+		return err
+	}
+
+	st, err := gkvlite.NewStore(f)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       s.Path,
+		}.Errorf(s, "Failed to load store.")
+		f.Close()
+		return err
+	}
+
+	logs := st.GetCollection(logCollection)
+	if logs == nil {
+		logs = st.SetCollection(logCollection, nil)
+	}
+
+	s.file, s.store, s.logs = f, st, logs
+	return nil
+}
+
+// reloadLocked reopens the store of a read-only Storage to observe the changes
+// made by its owner.
+//
+// gkvlite files are append-only, so a fresh store sees everything the owner
+// has flushed so far. The file itself is reopened if the owner has replaced it
+// (see Compact). If the owner hasn't created the file yet, the store is empty.
+//
+// s.mu must be held by the caller, or s must not be shared yet.
+func (s *Storage) reloadLocked() error {
+	fi, err := os.Stat(s.Path)
+	switch {
+	case os.IsNotExist(err):
+		s.closeLocked()
+		s.store, _ = gkvlite.NewStore(nil)
+		s.logs = s.store.SetCollection(logCollection, nil)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if s.file != nil {
+		if cur, err := s.file.Stat(); err != nil || !os.SameFile(fi, cur) {
+			s.closeLocked()
+		}
+	}
+	if s.file == nil {
+		if s.file, err = os.Open(s.Path); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       s.Path,
+			}.Errorf(s, "Failed to open store file.")
+			return err
+		}
+	}
+
+	st, err := gkvlite.NewStore(s.file)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       s.Path,
+		}.Errorf(s, "Failed to load store.")
+		return err
+	}
+	logs := st.GetCollection(logCollection)
+	if logs == nil {
+		// Not flushed by the owner yet. The collection is never persisted.
+		logs = st.SetCollection(logCollection, nil)
+	}
+	s.store, s.logs = st, logs
+	return nil
+}
+
+// closeLocked flushes (unless read-only) and closes the store file.
+//
+// s.mu must be held by the caller.
+func (s *Storage) closeLocked() error {
+	var err error
+	if s.store != nil {
+		if !s.ReadOnly {
+			if err = s.store.Flush(); err != nil {
+				log.WithError(err).Errorf(s, "Failed to flush store on close.")
+			}
+		}
+		s.store.Close()
+		s.store, s.logs = nil, nil
+	}
+
+	if s.file != nil {
+		if cerr := s.file.Close(); cerr != nil {
+			log.WithError(cerr).Errorf(s, "Failed to close store file.")
+			if err == nil {
+				err = cerr
+			}
+		}
+		s.file = nil
+	}
+	return err
+}
+
+// Close implements storage.Storage.
+func (s *Storage) Close() {
+	if s.stopMaintenance != nil {
+		s.stopMaintenance()
+		<-s.maintenanceDone
+		s.stopMaintenance = nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	s.closeLocked()
+	if s.lock != nil {
+		s.lock.Close()
+		s.lock = nil
+	}
+}
+
+// Config implements storage.Storage.
+//
+// Installing a configuration with a MaxLogAge purges any log entries that are
+// already older than that age.
+func (s *Storage) Config(cfg storage.Config) error {
+	err := s.run(func() error {
+		s.maxLogAge = cfg.MaxLogAge
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Fields{
+		"maxLogAge": cfg.MaxLogAge,
+	}.Infof(s, "Set maximum log age.")
+
+	if s.ReadOnly {
+		// Expired entries are hidden, the owner purges them.
+		return nil
+	}
+	_, err = s.Purge()
+	return err
+}
+
+// Put implements storage.Storage.
+func (s *Storage) Put(r storage.PutRequest) error {
+	if s.ReadOnly {
+		return storage.ErrReadOnly
+	}
+	return s.run(func() error {
+		now := clock.Now(s)
+
+		keys := make([][]byte, len(r.Values))
+		for i := range r.Values {
+			rk := newRowKey(string(r.Project), string(r.Path), int64(r.Index)+int64(i))
+			keys[i] = rk.encode()
+
+			// Fail if any entry already exists. Expired entries are considered
+			// deleted, and may be overwritten.
+			v, err := s.logs.Get(keys[i])
+			if err != nil {
+				return err
+			}
+			if v != nil {
+				t, _, err := decodeValue(v)
+				if err != nil || !s.isExpiredLocked(now, t) {
+					return storage.ErrExists
+				}
+			}
+		}
+
+		for i, d := range r.Values {
+			if err := s.logs.Set(keys[i], encodeValue(now, d)); err != nil {
+				return err
+			}
+		}
+
+		log.Fields{
+			"project": r.Project,
+			"path":    r.Path,
+			"index":   r.Index,
+			"count":   len(r.Values),
+		}.Debugf(s, "Added entries to local storage.")
+		return s.store.Flush()
+	})
+}
+
+// Get implements storage.Storage.
+func (s *Storage) Get(r storage.GetRequest, cb storage.GetCallback) error {
+	startKey := newRowKey(string(r.Project), string(r.Path), int64(r.Index))
+	ctx := log.SetFields(s, log.Fields{
+		"project":  r.Project,
+		"path":     r.Path,
+		"index":    r.Index,
+		"limit":    r.Limit,
+		"keysOnly": r.KeysOnly,
+	})
+
+	limit := s.MaxGetCount
+	if limit <= 0 {
+		limit = defaultMaxGetCount
+	}
+	if r.Limit > 0 && r.Limit < limit {
+		limit = r.Limit
+	}
+
+	// Collect the records while holding our lock, then punt them upstream
+	// afterwards so the callback can't block other operations.
+	type rec struct {
+		index types.MessageIndex
+		data  []byte
+	}
+	var recs []*rec
+	err := s.run(func() error {
+		err := s.visitLocked(startKey, func(rk *rowKey, data []byte) error {
+			rec := rec{
+				index: types.MessageIndex(rk.index),
+			}
+			if !r.KeysOnly {
+				rec.data = make([]byte, len(data))
+				copy(rec.data, data)
+			}
+			recs = append(recs, &rec)
+
+			if len(recs) >= limit {
+				return errStop
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(recs) > 0 {
+			return nil
+		}
+
+		// We found no records. Distinguish an empty range from a stream that
+		// doesn't exist at all.
+		exists := false
+		if r.Index > 0 {
+			err := s.visitLocked(newRowKey(string(r.Project), string(r.Path), 0), func(*rowKey, []byte) error {
+				exists = true
+				return errStop
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if !exists {
+			return storage.ErrDoesNotExist
+		}
+		return nil
+	})
+	if err != nil {
+		if err != storage.ErrDoesNotExist {
+			log.WithError(err).Errorf(ctx, "Failed to retrieve log range.")
+		}
+		return err
+	}
+
+	for _, rec := range recs {
+		if !cb(rec.index, rec.data) {
+			break
+		}
+	}
+	return nil
+}
+
+// Tail implements storage.Storage.
+func (s *Storage) Tail(project config.ProjectName, path types.StreamPath) ([]byte, types.MessageIndex, error) {
+	var (
+		d     []byte
+		index types.MessageIndex
+	)
+	err := s.run(func() error {
+		now := clock.Now(s)
+		rk := newRowKey(string(project), string(path), 0)
+
+		found := false
+		var ierr error
+		err := s.logs.VisitItemsDescend(rk.pathPrefixUpperBound(), true, func(i *gkvlite.Item) bool {
+			irk, err := decodeRowKey(i.Key)
+			if err != nil {
+				ierr = storage.ErrBadData
+				return false
+			}
+			if !irk.sharesPathWith(rk) {
+				// We've moved past this stream's key space.
+				return false
+			}
+
+			t, data, err := decodeValue(i.Val)
+			if err != nil {
+				ierr = storage.ErrBadData
+				return false
+			}
+			if s.isExpiredLocked(now, t) {
+				return true
+			}
+
+			d = make([]byte, len(data))
+			copy(d, data)
+			index = types.MessageIndex(irk.index)
+			found = true
+			return false
+		})
+		switch {
+		case err != nil:
+			return err
+		case ierr != nil:
+			return ierr
+		case !found:
+			return storage.ErrDoesNotExist
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return d, index, nil
+}
+
+// Purge deletes all log entries that are older than the configured maximum log
+// age. It returns the number of entries that were deleted.
+//
+// If no maximum log age is configured, Purge does nothing.
+func (s *Storage) Purge() (int, error) {
+	if s.ReadOnly {
+		return 0, storage.ErrReadOnly
+	}
+	purged := 0
+	err := s.run(func() error {
+		if s.maxLogAge <= 0 {
+			return nil
+		}
+		now := clock.Now(s)
+
+		// Collect expired keys. gkvlite does not support mutation during
+		// iteration, so we delete them afterwards.
+		var expired [][]byte
+		err := s.logs.VisitItemsAscend(nil, true, func(i *gkvlite.Item) bool {
+			if t, _, err := decodeValue(i.Val); err == nil && s.isExpiredLocked(now, t) {
+				expired = append(expired, i.Key)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+
+		for _, k := range expired {
+			if _, err := s.logs.Delete(k); err != nil {
+				return err
+			}
+			purged++
+		}
+		return s.store.Flush()
+	})
+	if err != nil {
+		log.WithError(err).Errorf(s, "Failed to purge expired log entries.")
+		return purged, err
+	}
+
+	if purged > 0 {
+		log.Fields{
+			"count":     purged,
+			"maxLogAge": s.maxLogAge,
+		}.Infof(s, "Purged expired log entries.")
+	}
+	return purged, nil
+}
+
+// Compact rewrites the store file, dropping data that is no longer referenced.
+//
+// gkvlite files are append-only: every update (including deletions done by
+// Purge) appends new data, leaving the old data in place. Compact copies the
+// live items into a new file and replaces the store file with it.
+func (s *Storage) Compact() error {
+	if s.ReadOnly {
+		return storage.ErrReadOnly
+	}
+	err := s.run(func() error {
+		if err := s.store.Flush(); err != nil {
+			return err
+		}
+
+		tmpPath := s.Path + ".compact"
+		tmp, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0640)
+		if err != nil {
+			return err
+		}
+		compacted, err := s.store.CopyTo(tmp, compactFlushEvery)
+		if err == nil {
+			err = compacted.Flush()
+			compacted.Close()
+		}
+		if cerr := tmp.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(tmpPath)
+			return err
+		}
+
+		// Swap the files. The store must be reopened either way, since it is
+		// closed at this point.
+		if err := s.closeLocked(); err != nil {
+			os.Remove(tmpPath)
+			return err
+		}
+		rerr := os.Rename(tmpPath, s.Path)
+		if rerr != nil {
+			os.Remove(tmpPath)
+		}
+		if err := s.openLocked(); err != nil {
+			return err
+		}
+		return rerr
+	})
+	if err != nil {
+		log.WithError(err).Errorf(s, "Failed to compact store.")
+		return err
+	}
+	log.Debugf(s, "Compacted store.")
+	return nil
+}
+
+// maintenanceLoop periodically purges expired entries and compacts the store
+// until "c" is cancelled.
+func (s *Storage) maintenanceLoop(c context.Context) {
+	defer close(s.maintenanceDone)
+
+	for {
+		if tr := <-clock.After(c, s.MaintenanceInterval); tr.Incomplete() {
+			return
+		}
+
+		// Both log their errors.
+		if _, err := s.Purge(); err != nil {
+			continue
+		}
+		s.Compact()
+	}
+}
+
+// visitLocked iterates over the unexpired log entries in the stream described
+// by "rk", starting at rk's index, in index order.
+//
+// Iteration stops when the stream's entries are exhausted or when the callback
+// returns an error. If that error is errStop, visitLocked returns nil.
+//
+// s.mu must be held by the caller.
+func (s *Storage) visitLocked(rk *rowKey, cb func(*rowKey, []byte) error) error {
+	now := clock.Now(s)
+
+	var ierr error
+	err := s.logs.VisitItemsAscend(rk.encode(), true, func(i *gkvlite.Item) bool {
+		irk, err := decodeRowKey(i.Key)
+		if err != nil {
+			ierr = storage.ErrBadData
+			return false
+		}
+		if !irk.sharesPathWith(rk) {
+			// We've moved past this stream's key space.
+			return false
+		}
+
+		t, data, err := decodeValue(i.Val)
+		if err != nil {
+			ierr = storage.ErrBadData
+			return false
+		}
+		if s.isExpiredLocked(now, t) {
+			return true
+		}
+
+		if ierr = cb(irk, data); ierr != nil {
+			return false
+		}
+		return true
+	})
+	switch {
+	case err != nil:
+		return err
+	case ierr == errStop:
+		return nil
+	default:
+		return ierr
+	}
+}
+
+// isExpiredLocked returns true if an entry written at "t" has exceeded the
+// configured maximum log age.
+//
+// s.mu must be held by the caller.
+func (s *Storage) isExpiredLocked(now, t time.Time) bool {
+	return s.maxLogAge > 0 && now.Sub(t) > s.maxLogAge
+}
+
+func (s *Storage) run(f func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errors.New("storage is closed")
+	}
+	if s.ReadOnly {
+		if err := s.reloadLocked(); err != nil {
+			return err
+		}
+	}
+	if s.store == nil {
+		return errors.New("store is not open")
+	}
+	return f()
+}
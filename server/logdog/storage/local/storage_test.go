@@ -0,0 +1,406 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/server/logdog/storage"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type rec struct {
+	index types.MessageIndex
+	data  []byte
+}
+
+func numRec(v types.MessageIndex) *rec {
+	buf := bytes.Buffer{}
+	binary.Write(&buf, binary.BigEndian, v)
+	return &rec{
+		index: v,
+		data:  buf.Bytes(),
+	}
+}
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local Storage instance`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), time.Unix(1442540000, 0).UTC())
+
+		tdir, err := ioutil.TempDir("", "logdog_local_storage")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tdir)
+
+		opts := Options{
+			Path: filepath.Join(tdir, "storage.db"),
+		}
+		st, err := New(c, opts)
+		So(err, ShouldBeNil)
+		defer func() {
+			st.Close()
+		}()
+
+		project := config.ProjectName("test-project")
+		path := types.StreamPath("testing/+/foo/bar")
+
+		putRange := func(st *Storage, start types.MessageIndex, count int) error {
+			req := storage.PutRequest{
+				Project: project,
+				Path:    path,
+				Index:   start,
+			}
+			for i := 0; i < count; i++ {
+				req.Values = append(req.Values, numRec(start+types.MessageIndex(i)).data)
+			}
+			return st.Put(req)
+		}
+
+		var getRecs []*rec
+		getAllCB := func(idx types.MessageIndex, data []byte) bool {
+			getRecs = append(getRecs, &rec{
+				index: idx,
+				data:  data,
+			})
+			return true
+		}
+
+		recsFor := func(indices ...types.MessageIndex) []*rec {
+			recs := make([]*rec, len(indices))
+			for i, idx := range indices {
+				recs[i] = numRec(idx)
+			}
+			return recs
+		}
+
+		Convey(`Will fail to open without a path.`, func() {
+			_, err := New(c, Options{})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey(`With log stream records {0..5, 7, 8, 10}`, func() {
+			So(putRange(st, 0, 6), ShouldBeNil)
+			So(putRange(st, 7, 2), ShouldBeNil)
+			So(putRange(st, 10, 1), ShouldBeNil)
+
+			// Add a neighbouring stream to make sure we don't spill into it.
+			So(st.Put(storage.PutRequest{
+				Project: project,
+				Path:    "testing/+/foo/baz",
+				Values:  [][]byte{[]byte("neighbour")},
+			}), ShouldBeNil)
+
+			all := recsFor(0, 1, 2, 3, 4, 5, 7, 8, 10)
+
+			Convey(`Put() will return ErrExists when putting an existing entry.`, func() {
+				So(putRange(st, 8, 2), ShouldEqual, storage.ErrExists)
+
+				// The non-conflicting entry should not have been written.
+				So(st.Get(storage.GetRequest{Project: project, Path: path, Index: 9}, getAllCB), ShouldBeNil)
+				So(getRecs, ShouldResemble, recsFor(10))
+			})
+
+			Convey(`Get()`, func() {
+				req := storage.GetRequest{
+					Project: project,
+					Path:    path,
+				}
+
+				Convey(`Can retrieve all of the records correctly.`, func() {
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, all)
+				})
+
+				Convey(`Can retrieve records starting from an index.`, func() {
+					req.Index = 6
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recsFor(7, 8, 10))
+				})
+
+				Convey(`Will return no records if the index is past the end.`, func() {
+					req.Index = 11
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldBeNil)
+				})
+
+				Convey(`Will adhere to GetRequest limit.`, func() {
+					req.Limit = 4
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, all[:4])
+				})
+
+				Convey(`Will adhere to hard limit.`, func() {
+					st.MaxGetCount = 3
+					req.Limit = 4
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, all[:3])
+				})
+
+				Convey(`Will omit data for keys-only requests.`, func() {
+					req.KeysOnly = true
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(len(getRecs), ShouldEqual, len(all))
+					for i, r := range getRecs {
+						So(r.index, ShouldEqual, all[i].index)
+						So(r.data, ShouldBeNil)
+					}
+				})
+
+				Convey(`Will stop iterating if callback returns false.`, func() {
+					count := 0
+					err := st.Get(req, func(types.MessageIndex, []byte) bool {
+						count++
+						return false
+					})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+
+				Convey(`Will fail to retrieve records if the project doesn't exist.`, func() {
+					req.Project = "project-does-not-exist"
+
+					So(st.Get(req, getAllCB), ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Will fail to retrieve records if the path doesn't exist.`, func() {
+					req.Path = "testing/+/does/not/exist"
+
+					So(st.Get(req, getAllCB), ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey(`Tail()`, func() {
+				Convey(`Can retrieve the tail record, 10.`, func() {
+					d, idx, err := st.Tail(project, path)
+					So(err, ShouldBeNil)
+					So(d, ShouldResemble, numRec(10).data)
+					So(idx, ShouldEqual, 10)
+				})
+
+				Convey(`Will fail to retrieve records if the path doesn't exist.`, func() {
+					_, _, err := st.Tail(project, "testing/+/does/not/exist")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey(`Will retain records after being closed and reopened.`, func() {
+				st.Close()
+
+				st, err = New(c, opts)
+				So(err, ShouldBeNil)
+
+				So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+				So(getRecs, ShouldResemble, all)
+			})
+
+			Convey(`With a maximum log age of one hour`, func() {
+				So(st.Config(storage.Config{MaxLogAge: time.Hour}), ShouldBeNil)
+
+				// Add records {11, 12} thirty minutes later.
+				tc.Add(30 * time.Minute)
+				So(putRange(st, 11, 2), ShouldBeNil)
+
+				Convey(`Will hide expired records.`, func() {
+					tc.Add(31 * time.Minute)
+
+					So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recsFor(11, 12))
+
+					d, idx, err := st.Tail(project, path)
+					So(err, ShouldBeNil)
+					So(d, ShouldResemble, numRec(12).data)
+					So(idx, ShouldEqual, 12)
+				})
+
+				Convey(`Will allow expired records to be overwritten.`, func() {
+					tc.Add(31 * time.Minute)
+					So(putRange(st, 0, 1), ShouldBeNil)
+				})
+
+				Convey(`Will purge expired records.`, func() {
+					tc.Add(31 * time.Minute)
+
+					count, err := st.Purge()
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 10)
+
+					Convey(`Will report a stream with only purged records as missing.`, func() {
+						tc.Add(time.Hour)
+
+						So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB),
+							ShouldEqual, storage.ErrDoesNotExist)
+
+						_, _, err := st.Tail(project, path)
+						So(err, ShouldEqual, storage.ErrDoesNotExist)
+					})
+				})
+
+				Convey(`Will purge expired records when reconfigured.`, func() {
+					So(st.Config(storage.Config{MaxLogAge: 10 * time.Minute}), ShouldBeNil)
+
+					So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recsFor(11, 12))
+				})
+			})
+		})
+
+		Convey(`Will refuse to open a store that is already open.`, func() {
+			_, err := New(c, opts)
+			So(err, ShouldEqual, ErrLocked)
+
+			Convey(`But will open it once it is closed.`, func() {
+				st.Close()
+
+				st, err = New(c, opts)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey(`A read-only instance`, func() {
+			ropts := opts
+			ropts.ReadOnly = true
+			rst, err := New(c, ropts)
+			So(err, ShouldBeNil)
+			defer rst.Close()
+
+			Convey(`Can be opened alongside the owner.`, func() {
+				rst2, err := New(c, ropts)
+				So(err, ShouldBeNil)
+				rst2.Close()
+			})
+
+			Convey(`Sees records written by the owner after it was opened.`, func() {
+				So(rst.Get(storage.GetRequest{Project: project, Path: path}, getAllCB),
+					ShouldEqual, storage.ErrDoesNotExist)
+
+				So(putRange(st, 0, 3), ShouldBeNil)
+				So(rst.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+				So(getRecs, ShouldResemble, recsFor(0, 1, 2))
+
+				d, idx, err := rst.Tail(project, path)
+				So(err, ShouldBeNil)
+				So(d, ShouldResemble, numRec(2).data)
+				So(idx, ShouldEqual, 2)
+
+				Convey(`And after the owner compacts the store.`, func() {
+					So(st.Compact(), ShouldBeNil)
+					So(putRange(st, 3, 1), ShouldBeNil)
+
+					getRecs = nil
+					So(rst.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recsFor(0, 1, 2, 3))
+				})
+
+				Convey(`Hides expired records, but doesn't purge them.`, func() {
+					So(rst.Config(storage.Config{MaxLogAge: time.Hour}), ShouldBeNil)
+					tc.Add(2 * time.Hour)
+
+					So(rst.Get(storage.GetRequest{Project: project, Path: path}, getAllCB),
+						ShouldEqual, storage.ErrDoesNotExist)
+
+					count, err := st.Purge()
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey(`Refuses to modify the store.`, func() {
+				So(putRange(rst, 0, 1), ShouldEqual, storage.ErrReadOnly)
+				_, err := rst.Purge()
+				So(err, ShouldEqual, storage.ErrReadOnly)
+				So(rst.Compact(), ShouldEqual, storage.ErrReadOnly)
+			})
+
+			Convey(`Can be opened before the owner creates the store.`, func() {
+				mopts := Options{
+					Path:     filepath.Join(tdir, "missing.db"),
+					ReadOnly: true,
+				}
+				mst, err := New(c, mopts)
+				So(err, ShouldBeNil)
+				defer mst.Close()
+
+				So(mst.Get(storage.GetRequest{Project: project, Path: path}, getAllCB),
+					ShouldEqual, storage.ErrDoesNotExist)
+			})
+		})
+
+		Convey(`Will retain records after being compacted.`, func() {
+			So(putRange(st, 0, 3), ShouldBeNil)
+			So(st.Compact(), ShouldBeNil)
+			So(putRange(st, 3, 1), ShouldBeNil)
+
+			So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+			So(getRecs, ShouldResemble, recsFor(0, 1, 2, 3))
+
+			st.Close()
+			st, err = New(c, opts)
+			So(err, ShouldBeNil)
+
+			getRecs = nil
+			So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+			So(getRecs, ShouldResemble, recsFor(0, 1, 2, 3))
+		})
+
+		Convey(`Will periodically purge expired records when maintenance is enabled.`, func() {
+			mopts := Options{
+				Path:                filepath.Join(tdir, "maintained.db"),
+				MaintenanceInterval: time.Hour,
+			}
+
+			// Once the store is populated, the first maintenance timer moves the
+			// clock past the log age. The second one is set once the first
+			// maintenance cycle is complete.
+			populated := make(chan struct{})
+			cycleDone := make(chan struct{})
+			timers := 0
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				timers++
+				switch timers {
+				case 1:
+					<-populated
+					tc.Add(2 * time.Hour)
+				case 2:
+					close(cycleDone)
+				}
+			})
+
+			mst, err := New(c, mopts)
+			So(err, ShouldBeNil)
+			defer mst.Close()
+			So(putRange(mst, 0, 3), ShouldBeNil)
+			So(mst.Config(storage.Config{MaxLogAge: time.Hour}), ShouldBeNil)
+			close(populated)
+
+			<-cycleDone
+			count, err := mst.Purge()
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 0)
+		})
+
+		Convey(`Will fail operations once closed.`, func() {
+			st.Close()
+
+			So(putRange(st, 0, 1), ShouldNotBeNil)
+			So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldNotBeNil)
+		})
+	})
+}
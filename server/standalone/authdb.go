@@ -0,0 +1,149 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/service"
+	"github.com/luci/luci-go/server/auth/service/protocol"
+)
+
+// errNotConfigured is returned by auth.DB methods if neither AuthDB file nor
+// auth_service URL is specified.
+var errNotConfigured = errors.New("standalone: auth.DB is not configured")
+
+// fileDBUpdater loads AuthDB from a text proto file (protocol.AuthDB message).
+//
+// The file is reloaded when its content changes (as detected by comparing
+// SHA256 digests). The modification time (as unix timestamp) is used as AuthDB
+// revision, bumped if necessary to keep revisions increasing.
+type fileDBUpdater struct {
+	path string
+
+	lock   sync.Mutex
+	digest []byte // SHA256 of the file the last loaded AuthDB was read from
+}
+
+// update is auth.DBCacheUpdater.
+func (u *fileDBUpdater) update(c context.Context, prev auth.DB) (auth.DB, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	fi, err := os.Stat(u.path)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := ioutil.ReadFile(u.path)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(blob)
+	rev := fi.ModTime().Unix()
+	url := "file://" + u.path
+
+	// Not modified?
+	prevDB, _ := prev.(*auth.SnapshotDB)
+	if prevDB != nil && prevDB.AuthServiceURL == url {
+		if bytes.Equal(u.digest, digest[:]) {
+			return prevDB, nil
+		}
+		if rev <= prevDB.Rev {
+			rev = prevDB.Rev + 1
+		}
+	}
+
+	msg := &protocol.AuthDB{}
+	if err := proto.UnmarshalText(string(blob), msg); err != nil {
+		return nil, fmt.Errorf("can't parse AuthDB file %q - %s", u.path, err)
+	}
+	db, err := auth.NewSnapshotDB(msg, url, rev)
+	if err != nil {
+		logging.Errorf(c, "auth: AuthDB in %q is invalid - %s", u.path, err)
+		return nil, err
+	}
+	logging.Infof(c, "auth: loaded AuthDB from %q (rev %d)", u.path, rev)
+	u.digest = digest[:]
+	return db, nil
+}
+
+// serviceDBUpdater fetches AuthDB snapshots from auth_service.
+//
+// auth.NewDBCache calls the updater every 5-10 sec, serviceDBUpdater asks
+// auth_service for the latest revision no more often than once per
+// 'pollInterval'.
+type serviceDBUpdater struct {
+	service      service.AuthService
+	pollInterval time.Duration
+
+	lock      sync.Mutex
+	lastCheck time.Time
+}
+
+// update is auth.DBCacheUpdater.
+func (u *serviceDBUpdater) update(c context.Context, prev auth.DB) (auth.DB, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	prevDB, _ := prev.(*auth.SnapshotDB)
+	if prevDB != nil && clock.Now(c).Sub(u.lastCheck) < u.pollInterval {
+		return prevDB, nil
+	}
+
+	rev, err := u.service.GetLatestSnapshotRevision(c)
+	if err != nil {
+		return nil, err
+	}
+	u.lastCheck = clock.Now(c)
+	if prevDB != nil && prevDB.Rev == rev {
+		return prevDB, nil
+	}
+
+	start := clock.Now(c)
+	snap, err := u.service.GetSnapshot(c, rev)
+	if err != nil {
+		return nil, err
+	}
+	db, err := auth.NewSnapshotDB(snap.AuthDB, snap.AuthServiceURL, snap.Rev)
+	logging.Infof(c, "auth: AuthDB at rev %d fetched in %s", snap.Rev, clock.Now(c).Sub(start))
+	if err != nil {
+		logging.Errorf(c, "auth: AuthDB is invalid - %s", err)
+		return nil, err
+	}
+	return db, nil
+}
+
+// newDBFactory returns auth.DBFactory based on the options.
+func newDBFactory(opts *Options) (auth.DBFactory, error) {
+	switch {
+	case opts.AuthDBPath != "" && opts.AuthServiceURL != "":
+		return nil, errors.New("standalone: AuthDBPath and AuthServiceURL can't be used together")
+	case opts.AuthDBPath != "":
+		u := &fileDBUpdater{path: opts.AuthDBPath}
+		return auth.NewDBCache(u.update), nil
+	case opts.AuthServiceURL != "":
+		u := &serviceDBUpdater{
+			service:      service.AuthService{URL: opts.AuthServiceURL},
+			pollInterval: opts.AuthDBPollInterval,
+		}
+		return auth.NewDBCache(u.update), nil
+	default:
+		db := auth.ErroringDB{Error: errNotConfigured}
+		return func(context.Context) (auth.DB, error) { return db, nil }, nil
+	}
+}
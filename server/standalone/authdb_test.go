@@ -0,0 +1,299 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/service"
+	"github.com/luci/luci-go/server/auth/service/protocol"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testAuthDB = `
+oauth_client_id: "client-id"
+oauth_client_secret: "client-secret"
+groups: <
+  name: "admins"
+  members: "user:admin@example.com"
+  description: "Admins"
+  created_ts: 1
+  created_by: "user:admin@example.com"
+  modified_ts: 1
+  modified_by: "user:admin@example.com"
+>
+`
+
+func TestFileDBUpdater(t *testing.T) {
+	Convey("with temp dir", t, func() {
+		dir, err := ioutil.TempDir("", "standalone_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		c := context.Background()
+		path := filepath.Join(dir, "authdb.cfg")
+		u := &fileDBUpdater{path: path}
+
+		Convey("missing file", func() {
+			_, err := u.update(c, nil)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("loads and reloads", func() {
+			So(ioutil.WriteFile(path, []byte(testAuthDB), 0600), ShouldBeNil)
+			mtime := time.Unix(1454472306, 0)
+			So(os.Chtimes(path, mtime, mtime), ShouldBeNil)
+
+			db, err := u.update(c, nil)
+			So(err, ShouldBeNil)
+			snap := db.(*auth.SnapshotDB)
+			So(snap.Rev, ShouldEqual, mtime.Unix())
+			So(snap.AuthServiceURL, ShouldEqual, "file://"+path)
+			yes, err := db.IsMember(c, "user:admin@example.com", "admins")
+			So(err, ShouldBeNil)
+			So(yes, ShouldBeTrue)
+
+			// Not modified, reuses the existing one.
+			again, err := u.update(c, db)
+			So(err, ShouldBeNil)
+			So(again, ShouldEqual, db)
+
+			// Touched, but not modified, still reuses the existing one.
+			mtime = mtime.Add(time.Minute)
+			So(os.Chtimes(path, mtime, mtime), ShouldBeNil)
+			again, err = u.update(c, db)
+			So(err, ShouldBeNil)
+			So(again, ShouldEqual, db)
+
+			// Modified, reloads.
+			So(ioutil.WriteFile(path, []byte(testAuthDB+"\n"), 0600), ShouldBeNil)
+			mtime = mtime.Add(time.Minute)
+			So(os.Chtimes(path, mtime, mtime), ShouldBeNil)
+			again, err = u.update(c, db)
+			So(err, ShouldBeNil)
+			So(again, ShouldNotEqual, db)
+			So(again.(*auth.SnapshotDB).Rev, ShouldEqual, mtime.Unix())
+			db = again
+
+			// Modified within the same second, reloads and bumps the revision.
+			So(ioutil.WriteFile(path, []byte(testAuthDB+"\n\n"), 0600), ShouldBeNil)
+			So(os.Chtimes(path, mtime, mtime), ShouldBeNil)
+			again, err = u.update(c, db)
+			So(err, ShouldBeNil)
+			So(again, ShouldNotEqual, db)
+			So(again.(*auth.SnapshotDB).Rev, ShouldEqual, mtime.Unix()+1)
+		})
+
+		Convey("broken file", func() {
+			So(ioutil.WriteFile(path, []byte("zzz"), 0600), ShouldBeNil)
+			_, err := u.update(c, nil)
+			So(err, ShouldErrLike, "can't parse AuthDB file")
+		})
+	})
+
+	Convey("conflicting options", t, func() {
+		_, err := newDBFactory(&Options{AuthDBPath: "path", AuthServiceURL: "https://example.com"})
+		So(err, ShouldErrLike, "can't be used together")
+	})
+
+	Convey("not configured", t, func() {
+		f, err := newDBFactory(&Options{})
+		So(err, ShouldBeNil)
+		db, err := f(context.Background())
+		So(err, ShouldBeNil)
+		_, err = db.IsMember(context.Background(), "user:admin@example.com", "admins")
+		So(err, ShouldEqual, errNotConfigured)
+	})
+}
+
+func TestServiceDBUpdater(t *testing.T) {
+	Convey("with fake auth_service", t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		fake := newFakeAuthService()
+		defer fake.Close()
+
+		u := &serviceDBUpdater{
+			service:      service.AuthService{URL: fake.URL},
+			pollInterval: time.Minute,
+		}
+
+		Convey("fetches and refetches", func() {
+			fake.setRev(1)
+			db, err := u.update(c, nil)
+			So(err, ShouldBeNil)
+			snap := db.(*auth.SnapshotDB)
+			So(snap.Rev, ShouldEqual, 1)
+			So(snap.AuthServiceURL, ShouldEqual, fake.URL)
+			yes, err := db.IsMember(c, "user:admin@example.com", "admins")
+			So(err, ShouldBeNil)
+			So(yes, ShouldBeTrue)
+			So(fake.calls(), ShouldResemble, []string{"latest", "1"})
+
+			// Doesn't poll auth_service too often.
+			fake.setRev(2)
+			again, err := u.update(c, db)
+			So(err, ShouldBeNil)
+			So(again, ShouldEqual, db)
+			So(fake.calls(), ShouldResemble, []string{"latest", "1"})
+
+			// Fetches the new revision once the poll interval passes.
+			tc.Add(time.Minute)
+			again, err = u.update(c, db)
+			So(err, ShouldBeNil)
+			So(again.(*auth.SnapshotDB).Rev, ShouldEqual, 2)
+			So(fake.calls(), ShouldResemble, []string{"latest", "1", "latest", "2"})
+			db = again
+
+			// Not modified, reuses the existing one.
+			tc.Add(time.Minute)
+			again, err = u.update(c, db)
+			So(err, ShouldBeNil)
+			So(again, ShouldEqual, db)
+			So(fake.calls(), ShouldResemble, []string{"latest", "1", "latest", "2", "latest"})
+		})
+
+		Convey("auth_service errors", func() {
+			fake.setRev(1)
+			fake.fail = true
+			_, err := u.update(c, nil)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("newDBFactory uses auth_service", t, func() {
+		c := context.Background()
+		fake := newFakeAuthService()
+		defer fake.Close()
+		fake.setRev(1)
+
+		f, err := newDBFactory(&Options{AuthServiceURL: fake.URL, AuthDBPollInterval: time.Minute})
+		So(err, ShouldBeNil)
+		db, err := f(c)
+		So(err, ShouldBeNil)
+		So(db.(*auth.SnapshotDB).Rev, ShouldEqual, 1)
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// fakeAuthService serves testAuthDB via auth_service snapshot API.
+type fakeAuthService struct {
+	*httptest.Server
+
+	lock sync.Mutex
+	rev  int64
+	fail bool
+	log  []string
+}
+
+func newFakeAuthService() *fakeAuthService {
+	f := &fakeAuthService{}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	return f
+}
+
+func (f *fakeAuthService) setRev(rev int64) {
+	f.lock.Lock()
+	f.rev = rev
+	f.lock.Unlock()
+}
+
+func (f *fakeAuthService) calls() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]string(nil), f.log...)
+}
+
+func (f *fakeAuthService) serve(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.fail {
+		http.Error(w, "boom", http.StatusForbidden)
+		return
+	}
+
+	var reply interface{}
+	switch r.URL.Path {
+	case "/auth_service/api/v1/authdb/revisions/latest":
+		f.log = append(f.log, "latest")
+		reply = map[string]interface{}{
+			"snapshot": map[string]interface{}{"auth_db_rev": f.rev},
+		}
+	case fmt.Sprintf("/auth_service/api/v1/authdb/revisions/%d", f.rev):
+		f.log = append(f.log, fmt.Sprintf("%d", f.rev))
+		body, digest, err := deflatedSnapshot(f.rev)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		reply = map[string]interface{}{
+			"snapshot": map[string]interface{}{
+				"auth_db_rev":   f.rev,
+				"sha256":        digest,
+				"created_ts":    1446599918304238,
+				"deflated_body": body,
+			},
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reply)
+}
+
+// deflatedSnapshot returns base64-encoded deflated snapshot of testAuthDB at
+// the given revision and hex-encoded SHA256 of the inflated snapshot.
+func deflatedSnapshot(rev int64) (body, digest string, err error) {
+	authDB := &protocol.AuthDB{}
+	if err = proto.UnmarshalText(testAuthDB, authDB); err != nil {
+		return
+	}
+	primaryID := "primary"
+	modifiedTs := int64(1446599918304238)
+	blob, err := proto.Marshal(&protocol.ReplicationPushRequest{
+		Revision: &protocol.AuthDBRevision{
+			AuthDbRev:  &rev,
+			PrimaryId:  &primaryID,
+			ModifiedTs: &modifiedTs,
+		},
+		AuthDb: authDB,
+	})
+	if err != nil {
+		return
+	}
+	buf := bytes.Buffer{}
+	w := zlib.NewWriter(&buf)
+	if _, err = w.Write(blob); err != nil {
+		return
+	}
+	if err = w.Close(); err != nil {
+		return
+	}
+	hash := sha256.Sum256(blob)
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), hex.EncodeToString(hash[:]), nil
+}
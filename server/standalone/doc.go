@@ -0,0 +1,51 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package standalone provides a standard set of middleware tools for luci
+// services that run as plain net/http binaries (i.e. not on Appengine).
+//
+// It is a counterpart of appengine/gaemiddleware. It assembles the same kind of
+// production context, but using backends that don't depend on Appengine:
+//   * github.com/luci/luci-go/server/settings backed by a local JSON file.
+//   * github.com/luci/luci-go/server/auth with auth.DB loaded from a local
+//     text proto file or fetched from auth_service.
+//   * github.com/luci/luci-go/server/secrets backed by a local JSON file (or
+//     autogenerated in memory, if the file is not given).
+//   * github.com/luci/luci-go/common/tsmon with periodic flushing.
+//   * github.com/luci/luci-go/server/proccache (in process memory cache).
+//
+// There's no standalone implementation of signing.Signer yet, so services that
+// need to sign blobs should continue to run on Appengine.
+//
+// Usage Example
+//
+//   import (
+//     "flag"
+//     "net/http"
+//
+//     "github.com/julienschmidt/httprouter"
+//     "github.com/luci/luci-go/common/logging/gologger"
+//     "github.com/luci/luci-go/server/standalone"
+//     "golang.org/x/net/context"
+//   )
+//
+//   func main() {
+//     opts := standalone.NewOptions()
+//     opts.Register(flag.CommandLine)
+//     flag.Parse()
+//
+//     c := gologger.StdConfig.Use(context.Background())
+//     srv, err := standalone.New(c, opts)
+//     if err != nil {
+//       panic(err)
+//     }
+//     defer srv.Close()
+//
+//     router := httprouter.New()
+//     srv.InstallHandlers(router, srv.Base)
+//     router.GET("/hello", srv.Base(myHandler))
+//
+//     http.ListenAndServe(":8080", router)
+//   }
+package standalone
@@ -0,0 +1,124 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/luci/luci-go/server/secrets"
+)
+
+// secretsFile is JSON structure of a file with secrets.
+//
+// It is a dict {secret key => secret value}. Blobs are base64 encoded. Their
+// IDs are derived from SHA256 of the blob, so they are stable across restarts.
+type secretsFile map[string]struct {
+	Current  string   `json:"current"`
+	Previous []string `json:"previous,omitempty"`
+}
+
+// LoadSecrets reads secrets from a JSON file.
+//
+// The file has the following format:
+//   {
+//     "<secret key>": {
+//       "current": "<base64 blob>",
+//       "previous": ["<base64 blob>", ...]
+//     },
+//     ...
+//   }
+func LoadSecrets(path string) (secrets.StaticStore, error) {
+	blob, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := secretsFile{}
+	if err := json.Unmarshal(blob, &file); err != nil {
+		return nil, fmt.Errorf("can't parse secrets file %q - %s", path, err)
+	}
+	store := make(secrets.StaticStore, len(file))
+	for key, val := range file {
+		secret := secrets.Secret{}
+		if secret.Current, err = namedBlob(val.Current); err != nil {
+			return nil, fmt.Errorf("bad current value of secret %q - %s", key, err)
+		}
+		for _, prev := range val.Previous {
+			nb, err := namedBlob(prev)
+			if err != nil {
+				return nil, fmt.Errorf("bad previous value of secret %q - %s", key, err)
+			}
+			secret.Previous = append(secret.Previous, nb)
+		}
+		store[secrets.Key(key)] = secret
+	}
+	return store, nil
+}
+
+// namedBlob decodes base64 blob and derives its ID.
+func namedBlob(b64 string) (secrets.NamedBlob, error) {
+	blob, err := base64.StdEncoding.DecodeString(b64)
+	switch {
+	case err != nil:
+		return secrets.NamedBlob{}, err
+	case len(blob) == 0:
+		return secrets.NamedBlob{}, fmt.Errorf("empty blob")
+	}
+	return secrets.NamedBlob{ID: blobID(blob), Blob: blob}, nil
+}
+
+// blobID derives an ID of a secret blob from its SHA256 digest.
+func blobID(blob []byte) string {
+	digest := sha256.Sum256(blob)
+	return hex.EncodeToString(digest[:4])
+}
+
+// memorySecrets is secrets.Store that autogenerates secrets and keeps them in
+// memory.
+//
+// Secrets are lost when the process restarts, thus anything derived from them
+// (e.g. cookies, tokens) is invalidated too. Use LoadSecrets in production.
+type memorySecrets struct {
+	secretLen int
+	entropy   io.Reader
+
+	lock    sync.Mutex
+	secrets map[secrets.Key]secrets.Secret
+}
+
+// newMemorySecrets returns memorySecrets that generates 32 byte secrets.
+func newMemorySecrets() *memorySecrets {
+	return &memorySecrets{secretLen: 32, entropy: rand.Reader}
+}
+
+// GetSecret returns a secret by its key, generating it if necessary.
+func (m *memorySecrets) GetSecret(k secrets.Key) (secrets.Secret, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if secret, ok := m.secrets[k]; ok {
+		return secret.Clone(), nil
+	}
+
+	blob := make([]byte, m.secretLen)
+	if _, err := io.ReadFull(m.entropy, blob); err != nil {
+		return secrets.Secret{}, err
+	}
+	secret := secrets.Secret{
+		Current: secrets.NamedBlob{ID: blobID(blob), Blob: blob},
+	}
+	if m.secrets == nil {
+		m.secrets = make(map[secrets.Key]secrets.Secret, 1)
+	}
+	m.secrets[k] = secret
+	return secret.Clone(), nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/luci/luci-go/server/secrets"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSecrets(t *testing.T) {
+	Convey("LoadSecrets works", t, func() {
+		dir, err := ioutil.TempDir("", "standalone_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "secrets.json")
+
+		So(ioutil.WriteFile(path, []byte(`{
+			"key": {"current": "AQID", "previous": ["BAUG"]}
+		}`), 0600), ShouldBeNil)
+
+		store, err := LoadSecrets(path)
+		So(err, ShouldBeNil)
+		secret, err := store.GetSecret("key")
+		So(err, ShouldBeNil)
+		So(secret, ShouldResemble, secrets.Secret{
+			Current:  secrets.NamedBlob{ID: "039058c6", Blob: []byte{1, 2, 3}},
+			Previous: []secrets.NamedBlob{{ID: "787c798e", Blob: []byte{4, 5, 6}}},
+		})
+
+		_, err = store.GetSecret("unknown")
+		So(err, ShouldEqual, secrets.ErrNoSuchSecret)
+
+		Convey("rejects bad blobs", func() {
+			So(ioutil.WriteFile(path, []byte(`{"key": {"current": ""}}`), 0600), ShouldBeNil)
+			_, err := LoadSecrets(path)
+			So(err, ShouldErrLike, `bad current value of secret "key"`)
+		})
+	})
+
+	Convey("memorySecrets autogenerates secrets", t, func() {
+		store := newMemorySecrets()
+		s1, err := store.GetSecret("key")
+		So(err, ShouldBeNil)
+		So(len(s1.Current.Blob), ShouldEqual, 32)
+		s2, err := store.GetSecret("key")
+		So(err, ShouldBeNil)
+		So(s2, ShouldResemble, s1)
+		s3, err := store.GetSecret("another")
+		So(err, ShouldBeNil)
+		So(s3, ShouldNotResemble, s1)
+	})
+}
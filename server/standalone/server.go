@@ -0,0 +1,251 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"errors"
+	"flag"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/net/context"
+
+	clientauth "github.com/luci/luci-go/common/auth"
+	"github.com/luci/luci-go/common/cacheContext"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/transport"
+	"github.com/luci/luci-go/common/tsmon"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/middleware"
+	"github.com/luci/luci-go/server/proccache"
+	"github.com/luci/luci-go/server/secrets"
+	"github.com/luci/luci-go/server/settings"
+	"github.com/luci/luci-go/server/settings/admin"
+)
+
+// GCECredentials is special value that can be passed as Options.Credentials to
+// use the service account of the GCE instance the server is running on.
+const GCECredentials = ":gce"
+
+// Options define how to configure the server. Use NewOptions() to get Options
+// with sensible default values.
+type Options struct {
+	// SettingsPath is a path to a JSON file with settings (see FileStorage).
+	//
+	// If empty, settings are stored in memory and are lost on restart.
+	SettingsPath string
+
+	// SettingsExpiration is how long to cache settings in memory.
+	SettingsExpiration time.Duration
+
+	// AuthDBPath is a path to a text proto file with protocol.AuthDB message.
+	//
+	// It is reloaded when modified. Can't be used with AuthServiceURL. If both
+	// are empty, all auth.DB calls fail.
+	AuthDBPath string
+
+	// AuthServiceURL is URL of auth_service to fetch AuthDB from.
+	//
+	// Requests are sent using Transport, so it must be authenticating: either
+	// pass it explicitly or set Credentials. Can't be used with AuthDBPath.
+	AuthServiceURL string
+
+	// AuthDBPollInterval is how often to check auth_service for AuthDB updates.
+	AuthDBPollInterval time.Duration
+
+	// SecretsPath is a path to a JSON file with secrets (see LoadSecrets).
+	//
+	// If empty, secrets are autogenerated and kept in memory. Anything derived
+	// from them (e.g. cookies) is invalidated when the process restarts.
+	SecretsPath string
+
+	// Transport is used for all outbound HTTP requests made through
+	// common/transport.
+	//
+	// If nil, it is constructed from Credentials. If Credentials is empty too,
+	// http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// Credentials is a path to a service account JSON key file, or
+	// GCECredentials, used to construct an authenticating Transport.
+	//
+	// Ignored if Transport is given.
+	Credentials string
+
+	// AdminAuth is authentication method to use for admin settings UI.
+	//
+	// It must be able to distinguish admins (aka superusers) from non-admins.
+	// If nil, admin UI is not installed.
+	AdminAuth auth.Method
+
+	// TsMon configures time series monitoring. Metrics are flushed in
+	// background, according to TsMon.Flush.
+	TsMon tsmon.Flags
+}
+
+// NewOptions returns Options with sensible default values.
+func NewOptions() Options {
+	return Options{
+		SettingsExpiration: time.Minute,
+		AuthDBPollInterval: time.Minute,
+		TsMon:              tsmon.NewFlags(),
+	}
+}
+
+// Register adds server related flags to a FlagSet.
+func (o *Options) Register(f *flag.FlagSet) {
+	f.StringVar(&o.SettingsPath, "settings-file", o.SettingsPath,
+		"path to a JSON file with server settings. If not set, settings are kept "+
+			"in memory.")
+	f.DurationVar(&o.SettingsExpiration, "settings-expiration", o.SettingsExpiration,
+		"how long to cache settings in memory before rereading the file.")
+	f.StringVar(&o.AuthDBPath, "auth-db-file", o.AuthDBPath,
+		"path to a text proto file with AuthDB. Can't be used with -auth-service-url.")
+	f.StringVar(&o.AuthServiceURL, "auth-service-url", o.AuthServiceURL,
+		"URL of auth_service to fetch AuthDB from (e.g. https://<host>). Can't be "+
+			"used with -auth-db-file.")
+	f.DurationVar(&o.AuthDBPollInterval, "auth-db-poll-interval", o.AuthDBPollInterval,
+		"how often to check auth_service for AuthDB updates.")
+	f.StringVar(&o.Credentials, "credentials", o.Credentials,
+		"path to a service account JSON key file to use for outbound requests (or "+
+			GCECredentials+" to use the GCE service account). Required for "+
+			"-auth-service-url.")
+	f.StringVar(&o.SecretsPath, "secrets-file", o.SecretsPath,
+		"path to a JSON file with secrets. If not set, secrets are autogenerated "+
+			"on startup and kept in memory.")
+
+	o.TsMon.Register(f)
+}
+
+// Server holds state shared by all requests: caches, settings, auth.DB, etc.
+//
+// Use New to create it.
+type Server struct {
+	root      context.Context
+	opts      Options
+	procCache *proccache.Cache
+	settings  *settings.Settings
+	authDB    auth.DBFactory
+	secrets   secrets.Store
+}
+
+// New initializes the server state according to the options.
+//
+// 'c' is a root context used as a base for all request contexts. It should have
+// logging configured. New also initializes tsmon, Close shuts it down.
+func New(c context.Context, opts Options) (*Server, error) {
+	s := &Server{
+		root:      c,
+		opts:      opts,
+		procCache: &proccache.Cache{},
+	}
+
+	var err error
+	switch {
+	case opts.Transport != nil:
+		// Use as is.
+	case opts.Credentials != "":
+		if s.opts.Transport, err = authTransport(c, opts.Credentials); err != nil {
+			return nil, err
+		}
+	case opts.AuthServiceURL != "":
+		return nil, errors.New("standalone: AuthServiceURL requires Transport or Credentials to be set")
+	default:
+		s.opts.Transport = http.DefaultTransport
+	}
+
+	if opts.SettingsPath != "" {
+		s.settings = settings.New(&FileStorage{
+			Path:       opts.SettingsPath,
+			Expiration: opts.SettingsExpiration,
+		})
+	} else {
+		logging.Warningf(c, "Settings file is not specified, settings will be kept in memory")
+		s.settings = settings.New(&settings.MemoryStorage{Expiration: opts.SettingsExpiration})
+	}
+
+	if s.authDB, err = newDBFactory(&s.opts); err != nil {
+		return nil, err
+	}
+
+	if opts.SecretsPath != "" {
+		if s.secrets, err = LoadSecrets(opts.SecretsPath); err != nil {
+			return nil, err
+		}
+	} else {
+		logging.Warningf(c, "Secrets file is not specified, secrets will be autogenerated")
+		s.secrets = newMemorySecrets()
+	}
+
+	if err := tsmon.InitializeFromFlags(c, &s.opts.TsMon); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// authTransport returns an authenticating transport that uses the given
+// credentials (see Options.Credentials).
+func authTransport(c context.Context, credentials string) (http.RoundTripper, error) {
+	opts := clientauth.Options{
+		Method:                 clientauth.ServiceAccountMethod,
+		ServiceAccountJSONPath: credentials,
+	}
+	if credentials == GCECredentials {
+		opts = clientauth.Options{Method: clientauth.GCEMetadataMethod}
+	}
+	return clientauth.NewAuthenticator(c, clientauth.SilentLogin, opts).Transport()
+}
+
+// Close flushes tsmon metrics and stops background flushing.
+func (s *Server) Close() {
+	tsmon.Shutdown(s.root)
+}
+
+// WithProd installs the set of standard production services:
+//   * github.com/luci/luci-go/common/transport (Options.Transport)
+//   * github.com/luci/luci-go/server/proccache (in process memory cache)
+//   * github.com/luci/luci-go/server/settings (global app settings)
+//   * github.com/luci/luci-go/server/secrets (file or in-memory secrets)
+//   * github.com/luci/luci-go/server/auth (user groups database)
+//
+// It also sets logging level based on the server settings.
+func (s *Server) WithProd(c context.Context, req *http.Request) context.Context {
+	c = settings.Use(c, s.settings)
+	if cfg, ok := fetchCachedSettings(c); ok {
+		c = logging.SetLevel(c, cfg.LoggingLevel)
+	}
+
+	c = proccache.Use(c, s.procCache)
+	c = transport.Set(c, s.opts.Transport)
+	c = secrets.Set(c, s.secrets)
+	c = auth.UseDB(c, s.authDB)
+	return cacheContext.Wrap(c)
+}
+
+// Base adapts a middleware-style handler to a httprouter.Handle.
+//
+// It installs services using WithProd on top of the root context and installs
+// a panic catcher.
+func (s *Server) Base(h middleware.Handler) httprouter.Handle {
+	h = middleware.WithPanicCatcher(h)
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		h(s.WithProd(s.root, r), rw, r, p)
+	}
+}
+
+// InstallHandlers installs HTTP handlers for various default routes.
+//
+// These are auth related debug routes and admin settings UI (if
+// Options.AdminAuth is set).
+//
+// 'base' is expected to be Server.Base or its derivative.
+func (s *Server) InstallHandlers(r *httprouter.Router, base middleware.Base) {
+	auth.InstallHandlers(r, base)
+	if s.opts.AdminAuth != nil {
+		admin.InstallHandlers(r, base, s.opts.AdminAuth)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/transport"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/proccache"
+	"github.com/luci/luci-go/server/secrets"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServer(t *testing.T) {
+	Convey("with temp dir", t, func() {
+		dir, err := ioutil.TempDir("", "standalone_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		c := context.Background()
+		opts := NewOptions()
+		opts.TsMon.Endpoint = "none"
+
+		Convey("AuthServiceURL requires authenticating transport", func() {
+			opts.AuthServiceURL = "https://example.com"
+			_, err := New(c, opts)
+			So(err, ShouldErrLike, "AuthServiceURL requires Transport or Credentials")
+		})
+
+		Convey("bad secrets file", func() {
+			opts.SecretsPath = filepath.Join(dir, "missing.json")
+			_, err := New(c, opts)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("WithProd with defaults", func() {
+			srv, err := New(c, opts)
+			So(err, ShouldBeNil)
+			defer srv.Close()
+
+			req, _ := http.NewRequest("GET", "http://localhost/", nil)
+			ctx := srv.WithProd(c, req)
+			So(transport.Get(ctx), ShouldEqual, http.DefaultTransport)
+			So(proccache.GetCache(ctx), ShouldEqual, srv.procCache)
+
+			// Secrets are autogenerated and stable.
+			s1, err := secrets.GetSecret(ctx, "key")
+			So(err, ShouldBeNil)
+			s2, err := secrets.GetSecret(srv.WithProd(c, req), "key")
+			So(err, ShouldBeNil)
+			So(s2, ShouldResemble, s1)
+
+			// auth.DB is not configured.
+			db, err := auth.GetDB(ctx)
+			So(err, ShouldBeNil)
+			_, err = db.IsMember(ctx, "user:admin@example.com", "admins")
+			So(err, ShouldEqual, errNotConfigured)
+		})
+
+		Convey("WithProd applies the logging level from settings", func() {
+			blob, err := json.Marshal(map[string]serverSettings{
+				settingsKey: {LoggingLevel: logging.Warning},
+			})
+			So(err, ShouldBeNil)
+			opts.SettingsPath = filepath.Join(dir, "settings.json")
+			So(ioutil.WriteFile(opts.SettingsPath, blob, 0600), ShouldBeNil)
+
+			srv, err := New(logging.SetLevel(c, logging.Debug), opts)
+			So(err, ShouldBeNil)
+			defer srv.Close()
+
+			req, _ := http.NewRequest("GET", "http://localhost/", nil)
+			ctx := srv.WithProd(srv.root, req)
+			So(logging.GetLevel(ctx), ShouldEqual, logging.Warning)
+		})
+
+		Convey("with fake auth_service", func() {
+			fake := newFakeAuthService()
+			defer fake.Close()
+			fake.setRev(1)
+
+			opts.AuthServiceURL = fake.URL
+			opts.AuthDBPollInterval = time.Minute
+			opts.Transport = &countingTransport{base: http.DefaultTransport}
+
+			srv, err := New(c, opts)
+			So(err, ShouldBeNil)
+			defer srv.Close()
+
+			Convey("WithProd fetches AuthDB using the transport", func() {
+				req, _ := http.NewRequest("GET", "http://localhost/", nil)
+				ctx := srv.WithProd(c, req)
+				So(transport.Get(ctx), ShouldEqual, opts.Transport)
+
+				db, err := auth.GetDB(ctx)
+				So(err, ShouldBeNil)
+				yes, err := db.IsMember(ctx, "user:admin@example.com", "admins")
+				So(err, ShouldBeNil)
+				So(yes, ShouldBeTrue)
+				So(opts.Transport.(*countingTransport).count, ShouldEqual, 2)
+			})
+
+			Convey("Base installs the production context", func() {
+				router := httprouter.New()
+				router.GET("/admin", srv.Base(func(c context.Context, w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+					db, err := auth.GetDB(c)
+					if err != nil {
+						panic(err)
+					}
+					switch yes, err := db.IsMember(c, "user:admin@example.com", "admins"); {
+					case err != nil:
+						panic(err)
+					case yes:
+						w.Write([]byte("admin"))
+					default:
+						w.Write([]byte("not admin"))
+					}
+				}))
+				router.GET("/panic", srv.Base(func(c context.Context, w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+					panic("boom")
+				}))
+
+				get := func(path string) *httptest.ResponseRecorder {
+					req, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+					w := httptest.NewRecorder()
+					router.ServeHTTP(w, req)
+					return w
+				}
+
+				w := get("/admin")
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Body.String(), ShouldEqual, "admin")
+
+				So(get("/panic").Code, ShouldEqual, http.StatusInternalServerError)
+			})
+		})
+	})
+}
+
+// countingTransport counts requests passing through it.
+type countingTransport struct {
+	base  http.RoundTripper
+	count int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.count++
+	return t.base.RoundTrip(r)
+}
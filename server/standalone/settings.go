@@ -0,0 +1,186 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/settings"
+)
+
+// FileStorage implements settings.Storage interface on top of a local JSON
+// file.
+//
+// The file contains a JSON dict with settings keys as keys and settings values
+// as values. A missing file is treated as empty. UpdateSetting rewrites the
+// file atomically, so the settings can be modified via admin UI too.
+type FileStorage struct {
+	Path       string        // path to the JSON file with settings
+	Expiration time.Duration // how long to cache settings in memory
+
+	lock sync.Mutex // serializes UpdateSetting calls
+}
+
+// FetchAllSettings fetches all latest settings at once.
+func (f *FileStorage) FetchAllSettings(c context.Context) (*settings.Bundle, error) {
+	values, err := f.readFile()
+	if err != nil {
+		return nil, err
+	}
+	return &settings.Bundle{Values: values, Exp: clock.Now(c).Add(f.Expiration)}, nil
+}
+
+// UpdateSetting updates a setting at the given key.
+func (f *FileStorage) UpdateSetting(c context.Context, key string, value json.RawMessage, who, why string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	values, err := f.readFile()
+	if err != nil {
+		return err
+	}
+	cpy := append(json.RawMessage(nil), value...)
+	values[key] = &cpy
+
+	blob, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temp file in the same directory and then rename it, so readers
+	// never see partially written file.
+	tmp, err := ioutil.TempFile(filepath.Dir(f.Path), filepath.Base(f.Path)+".tmp")
+	if err != nil {
+		return errors.WrapTransient(err)
+	}
+	_, err = tmp.Write(blob)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.Path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return errors.WrapTransient(err)
+	}
+
+	logging.Infof(c, "settings: %q updated by %q - %s", key, who, why)
+	return nil
+}
+
+// readFile reads and deserializes the settings file.
+func (f *FileStorage) readFile() (map[string]*json.RawMessage, error) {
+	values := map[string]*json.RawMessage{}
+	blob, err := ioutil.ReadFile(f.Path)
+	switch {
+	case os.IsNotExist(err):
+		return values, nil
+	case err != nil:
+		return nil, errors.WrapTransient(err)
+	}
+	if err := json.Unmarshal(blob, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// settingsKey is key for server settings (described by serverSettings struct)
+// in the settings store. See github.com/luci/luci-go/server/settings.
+const settingsKey = "standalone"
+
+// serverSettings contain global tweaks of the standalone server. They are
+// stored in app settings store under settingsKey key.
+type serverSettings struct {
+	// LoggingLevel is logging level to set the default logger to.
+	//
+	// Log entries below this level will be completely ignored. Default is the
+	// level of the root context passed to New.
+	LoggingLevel logging.Level `json:"logging_level"`
+}
+
+// fetchCachedSettings fetches serverSettings from the settings store.
+//
+// Uses in-process cache to avoid hitting the settings file often. Returns
+// false if the settings are not set or can't be fetched. Errors are logged.
+func fetchCachedSettings(c context.Context) (serverSettings, bool) {
+	s := serverSettings{}
+	switch err := settings.Get(c, settingsKey, &s); {
+	case err == nil:
+		return s, true
+	case err == settings.ErrNoSettings:
+		return s, false
+	default:
+		logging.Errorf(c, "Could not fetch server settings - %s", err)
+		return s, false
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// UI for server settings.
+
+type settingsUIPage struct {
+	settings.BaseUIPage
+}
+
+func (settingsUIPage) Title(c context.Context) (string, error) {
+	return "Server related settings", nil
+}
+
+func (settingsUIPage) Fields(c context.Context) ([]settings.UIField, error) {
+	return []settings.UIField{
+		{
+			ID:    "LoggingLevel",
+			Title: "Minimal logging level",
+			Type:  settings.UIFieldChoice,
+			ChoiceVariants: []string{
+				"debug",
+				"info",
+				"warning",
+				"error",
+			},
+			Validator: func(v string) error {
+				var l logging.Level
+				return l.Set(v)
+			},
+			Help: `Log entries below this level will be <b>completely</b> ignored.`,
+		},
+	}, nil
+}
+
+func (settingsUIPage) ReadSettings(c context.Context) (map[string]string, error) {
+	s := serverSettings{}
+	err := settings.GetUncached(c, settingsKey, &s)
+	if err != nil && err != settings.ErrNoSettings {
+		return nil, err
+	}
+	return map[string]string{
+		"LoggingLevel": s.LoggingLevel.String(),
+	}, nil
+}
+
+func (settingsUIPage) WriteSettings(c context.Context, values map[string]string, who, why string) error {
+	modified := serverSettings{}
+	if err := modified.LoggingLevel.Set(values["LoggingLevel"]); err != nil {
+		return err
+	}
+	return settings.SetIfChanged(c, settingsKey, &modified, who, why)
+}
+
+func init() {
+	settings.RegisterUIPage(settingsKey, settingsUIPage{})
+}
@@ -0,0 +1,62 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package standalone
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/settings"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFileStorage(t *testing.T) {
+	Convey("with temp dir", t, func() {
+		dir, err := ioutil.TempDir("", "standalone_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		c := context.Background()
+		path := filepath.Join(dir, "settings.json")
+		s := settings.New(&FileStorage{Path: path, Expiration: time.Second})
+
+		type exampleSettings struct {
+			Greetings string `json:"greetings"`
+		}
+
+		Convey("missing file means no settings", func() {
+			val := exampleSettings{}
+			So(s.Get(c, "key", &val), ShouldEqual, settings.ErrNoSettings)
+		})
+
+		Convey("roundtrip", func() {
+			So(s.Set(c, "key", &exampleSettings{"hi"}, "who", "why"), ShouldBeNil)
+			So(s.Set(c, "another", &exampleSettings{"hey"}, "who", "why"), ShouldBeNil)
+
+			val := exampleSettings{}
+			So(s.GetUncached(c, "key", &val), ShouldBeNil)
+			So(val.Greetings, ShouldEqual, "hi")
+			So(s.GetUncached(c, "another", &val), ShouldBeNil)
+			So(val.Greetings, ShouldEqual, "hey")
+
+			// Picks up direct modifications of the file.
+			So(ioutil.WriteFile(path, []byte(`{"key": {"greetings": "hello"}}`), 0600), ShouldBeNil)
+			So(s.GetUncached(c, "key", &val), ShouldBeNil)
+			So(val.Greetings, ShouldEqual, "hello")
+		})
+
+		Convey("broken file", func() {
+			So(ioutil.WriteFile(path, []byte(`zzz`), 0600), ShouldBeNil)
+			val := exampleSettings{}
+			So(s.GetUncached(c, "key", &val), ShouldNotBeNil)
+		})
+	})
+}